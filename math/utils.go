@@ -669,3 +669,119 @@ func MedianAbsoluteDeviation(data []Dec) (medianAbsoluteDeviation Dec, median De
 
 	return medianAbsoluteDeviation, median, nil
 }
+
+// PinballLoss calculates the pinball (quantile) loss of predicting `quantile`
+// at quantile `level` when `observed` is realized:
+// level * (observed - quantile) if observed >= quantile, (1 - level) * (quantile - observed) otherwise
+func PinballLoss(level, quantile, observed Dec) (Dec, error) {
+	diff, err := observed.Sub(quantile)
+	if err != nil {
+		return Dec{}, err
+	}
+	if diff.IsNegative() {
+		oneMinusLevel, err := OneDec().Sub(level)
+		if err != nil {
+			return Dec{}, err
+		}
+		diff, err = diff.Neg()
+		if err != nil {
+			return Dec{}, err
+		}
+		return oneMinusLevel.Mul(diff)
+	}
+	return level.Mul(diff)
+}
+
+// SquaredError calculates (prediction - observed)^2
+func SquaredError(prediction, observed Dec) (Dec, error) {
+	diff, err := prediction.Sub(observed)
+	if err != nil {
+		return Dec{}, err
+	}
+	return diff.Mul(diff)
+}
+
+// AbsoluteError calculates |prediction - observed|
+func AbsoluteError(prediction, observed Dec) (Dec, error) {
+	diff, err := prediction.Sub(observed)
+	if err != nil {
+		return Dec{}, err
+	}
+	return diff.Abs()
+}
+
+// LogLoss calculates the binary cross-entropy -(observed * ln(p) + (1 - observed) * ln(1 - p))
+// of predicting probability p for an outcome `observed` in [0, 1].
+// p is clamped to [epsilon, 1 - epsilon] so that confident mistakes have a finite loss
+func LogLoss(prediction, observed, epsilon Dec) (Dec, error) {
+	if observed.IsNegative() || observed.Gt(OneDec()) {
+		return Dec{}, errorsmod.Wrapf(ErrOutOfRange, "log loss outcome %s is not between 0 and 1", observed)
+	}
+	oneMinusEpsilon, err := OneDec().Sub(epsilon)
+	if err != nil {
+		return Dec{}, err
+	}
+	p, err := Max(prediction, epsilon)
+	if err != nil {
+		return Dec{}, err
+	}
+	p, err = Min(p, oneMinusEpsilon)
+	if err != nil {
+		return Dec{}, err
+	}
+	lnP, err := Ln(p)
+	if err != nil {
+		return Dec{}, err
+	}
+	oneMinusP, err := OneDec().Sub(p)
+	if err != nil {
+		return Dec{}, err
+	}
+	lnOneMinusP, err := Ln(oneMinusP)
+	if err != nil {
+		return Dec{}, err
+	}
+	oneMinusObserved, err := OneDec().Sub(observed)
+	if err != nil {
+		return Dec{}, err
+	}
+	positiveTerm, err := observed.Mul(lnP)
+	if err != nil {
+		return Dec{}, err
+	}
+	negativeTerm, err := oneMinusObserved.Mul(lnOneMinusP)
+	if err != nil {
+		return Dec{}, err
+	}
+	sum, err := positiveTerm.Add(negativeTerm)
+	if err != nil {
+		return Dec{}, err
+	}
+	return sum.Neg()
+}
+
+// HuberLoss calculates 0.5 * e^2 if |e| <= delta, delta * (|e| - 0.5 * delta) otherwise,
+// with e = prediction - observed
+func HuberLoss(prediction, observed, delta Dec) (Dec, error) {
+	absErr, err := AbsoluteError(prediction, observed)
+	if err != nil {
+		return Dec{}, err
+	}
+	half := MustNewDecFromString("0.5")
+	if absErr.Lte(delta) {
+		squared, err := absErr.Mul(absErr)
+		if err != nil {
+			return Dec{}, err
+		}
+		return squared.Mul(half)
+	}
+	halfDelta, err := delta.Mul(half)
+	if err != nil {
+		return Dec{}, err
+	}
+	linear, err := absErr.Sub(halfDelta)
+	if err != nil {
+		return Dec{}, err
+	}
+	return delta.Mul(linear)
+}
@@ -930,3 +930,60 @@ func TestMedianAbsoluteDeviation(t *testing.T) {
 		})
 	}
 }
+
+func TestPinballLoss(t *testing.T) {
+	level := alloraMath.MustNewDecFromString("0.9")
+	quantile := alloraMath.MustNewDecFromString("10")
+
+	// Under-prediction is penalized by the level
+	loss, err := alloraMath.PinballLoss(level, quantile, alloraMath.MustNewDecFromString("12"))
+	require.NoError(t, err)
+	require.True(t, loss.Equal(alloraMath.MustNewDecFromString("1.8")), "got %v", loss)
+
+	// Over-prediction is penalized by one minus the level
+	loss, err = alloraMath.PinballLoss(level, quantile, alloraMath.MustNewDecFromString("8"))
+	require.NoError(t, err)
+	require.True(t, loss.Equal(alloraMath.MustNewDecFromString("0.2")), "got %v", loss)
+}
+
+func TestPointLosses(t *testing.T) {
+	prediction := alloraMath.MustNewDecFromString("3")
+	observed := alloraMath.MustNewDecFromString("1")
+
+	squared, err := alloraMath.SquaredError(prediction, observed)
+	require.NoError(t, err)
+	require.True(t, squared.Equal(alloraMath.MustNewDecFromString("4")), "got %v", squared)
+
+	absolute, err := alloraMath.AbsoluteError(observed, prediction)
+	require.NoError(t, err)
+	require.True(t, absolute.Equal(alloraMath.MustNewDecFromString("2")), "got %v", absolute)
+
+	// Quadratic within delta, linear beyond it
+	delta := alloraMath.OneDec()
+	huber, err := alloraMath.HuberLoss(alloraMath.MustNewDecFromString("1.5"), observed, delta)
+	require.NoError(t, err)
+	require.True(t, huber.Equal(alloraMath.MustNewDecFromString("0.125")), "got %v", huber)
+	huber, err = alloraMath.HuberLoss(prediction, observed, delta)
+	require.NoError(t, err)
+	require.True(t, huber.Equal(alloraMath.MustNewDecFromString("1.5")), "got %v", huber)
+}
+
+func TestLogLoss(t *testing.T) {
+	epsilon := alloraMath.MustNewDecFromString("0.000001")
+	half := alloraMath.MustNewDecFromString("0.5")
+
+	// -ln(0.5) whatever the outcome
+	loss, err := alloraMath.LogLoss(half, alloraMath.OneDec(), epsilon)
+	require.NoError(t, err)
+	inDelta, err := alloraMath.InDelta(alloraMath.MustNewDecFromString("0.693147180559945"), loss, alloraMath.MustNewDecFromString("0.000000001"))
+	require.NoError(t, err)
+	require.True(t, inDelta, "got %v", loss)
+
+	// Certain mistakes are clamped to a finite loss
+	loss, err = alloraMath.LogLoss(alloraMath.ZeroDec(), alloraMath.OneDec(), epsilon)
+	require.NoError(t, err)
+	require.True(t, loss.IsPositive())
+
+	_, err = alloraMath.LogLoss(half, alloraMath.MustNewDecFromString("2"), epsilon)
+	require.ErrorIs(t, err, alloraMath.ErrOutOfRange)
+}
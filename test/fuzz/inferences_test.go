@@ -199,6 +199,7 @@ func createWorkerDataBundle(
 		forecastElements = append(forecastElements, &emissionstypes.ForecastElement{
 			Inferer: workers[key].addr,
 			Value:   alloraMath.NewDecFromInt64(int64(m.Client.Rand.Intn(51) + 50)),
+			Values:  nil,
 		})
 	}
 	infererAddress := inferer.addr
@@ -218,6 +219,7 @@ func createWorkerDataBundle(
 				Value:       infererValue,
 				ExtraData:   nil,
 				Proof:       "",
+				Values:      nil,
 			},
 			Forecast: &emissionstypes.Forecast{
 				TopicId:          topicId,
@@ -374,6 +376,8 @@ func createReputerValueBundle(
 			ReputerNonce: reputerNonce,
 		},
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 }
 
@@ -415,6 +419,7 @@ func generateWorkerAttributedValueLosses(
 		values = append(values, &emissionstypes.WorkerAttributedValue{
 			Worker: worker.addr,
 			Value:  alloraMath.NewDecFromInt64(int64(m.Client.Rand.Intn(lowLimit) + sum)),
+			Values: nil,
 		})
 	}
 	return values
@@ -431,6 +436,7 @@ func generateWithheldWorkerAttributedValueLosses(
 		values = append(values, &emissionstypes.WithheldWorkerAttributedValue{
 			Worker: worker.addr,
 			Value:  alloraMath.NewDecFromInt64(int64(rand.Intn(lowLimit) + sum)),
+			Values: nil,
 		})
 	}
 	return values
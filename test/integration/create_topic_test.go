@@ -55,6 +55,10 @@ func CreateTopic(m testCommon.TestConfig) (topicId uint64) {
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		EnableWorkerWhitelist:    true,
 		EnableReputerWhitelist:   true,
+		Tags:                     nil,
+		OutputDimension:          0,
+		VectorSynthesisMode:      emissionstypes.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:           nil,
 	}
 	txResp, err := m.Client.BroadcastTx(ctx, m.AliceAcc, createTopicRequest)
 	require.NoError(m.T, err)
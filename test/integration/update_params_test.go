@@ -96,6 +96,16 @@ func UpdateParamsChecks(m testCommon.TestConfig) {
 			GlobalReputerWhitelistEnabled:       nil,
 			GlobalAdminWhitelistAppended:        nil,
 			MaxWhitelistInputArrayLength:        nil,
+			MaxArchivedTopicPrunesPerBlock:      nil,
+			MaxReputerStakeShare:                nil,
+			StakeAboveCapExponent:               nil,
+			StakeLockTiers:                      nil,
+			RewardHistoryRetentionBlocks:        nil,
+			MaxRewardPayoutsPerBlock:            nil,
+			MaxRewardHistoryPrunesPerBlock:      nil,
+			MaxReputerCommissionRate:            nil,
+			MaxReputerCommissionChangeRate:      nil,
+			MaxRewardPayoutAttempts:             nil,
 		},
 	}
 	txResp, err := m.Client.BroadcastTx(ctx, m.AliceAcc, updateParamRequest)
@@ -162,6 +172,16 @@ func UpdateParamsChecks(m testCommon.TestConfig) {
 			GlobalReputerWhitelistEnabled:       nil,
 			GlobalAdminWhitelistAppended:        nil,
 			MaxWhitelistInputArrayLength:        nil,
+			MaxArchivedTopicPrunesPerBlock:      nil,
+			MaxReputerStakeShare:                nil,
+			StakeAboveCapExponent:               nil,
+			StakeLockTiers:                      nil,
+			RewardHistoryRetentionBlocks:        nil,
+			MaxRewardPayoutsPerBlock:            nil,
+			MaxRewardHistoryPrunesPerBlock:      nil,
+			MaxReputerCommissionRate:            nil,
+			MaxReputerCommissionChangeRate:      nil,
+			MaxRewardPayoutAttempts:             nil,
 		},
 	}
 	_, err = m.Client.BroadcastTx(ctx, m.BobAcc, updateParamRequest)
@@ -232,6 +252,16 @@ func UpdateParamsChecks(m testCommon.TestConfig) {
 			GlobalReputerWhitelistEnabled:       nil,
 			GlobalAdminWhitelistAppended:        nil,
 			MaxWhitelistInputArrayLength:        nil,
+			MaxArchivedTopicPrunesPerBlock:      nil,
+			MaxReputerStakeShare:                nil,
+			StakeAboveCapExponent:               nil,
+			StakeLockTiers:                      nil,
+			RewardHistoryRetentionBlocks:        nil,
+			MaxRewardPayoutsPerBlock:            nil,
+			MaxRewardHistoryPrunesPerBlock:      nil,
+			MaxReputerCommissionRate:            nil,
+			MaxReputerCommissionChangeRate:      nil,
+			MaxRewardPayoutAttempts:             nil,
 		},
 	}
 	txResp, err = m.Client.BroadcastTx(ctx, m.AliceAcc, updateParamRequest)
@@ -50,6 +50,7 @@ func InsertSingleWorkerPayload(m testCommon.TestConfig, topic *types.Topic, bloc
 					Value:       alloraMath.NewDecFromInt64(100),
 					ExtraData:   nil,
 					Proof:       "",
+					Values:      nil,
 				},
 				Forecast: &types.Forecast{
 					TopicId:     topicId,
@@ -59,6 +60,7 @@ func InsertSingleWorkerPayload(m testCommon.TestConfig, topic *types.Topic, bloc
 						{
 							Inferer: InfererAddress1,
 							Value:   alloraMath.NewDecFromInt64(100),
+							Values:  nil,
 						},
 					},
 					ExtraData: nil,
@@ -147,12 +149,14 @@ func InsertReputerBundle(m testCommon.TestConfig, topic *types.Topic, BlockHeigh
 			{
 				Worker: workerAddr,
 				Value:  alloraMath.NewDecFromInt64(100),
+				Values: nil,
 			},
 		},
 		ForecasterValues: []*types.WorkerAttributedValue{
 			{
 				Worker: workerAddr,
 				Value:  alloraMath.NewDecFromInt64(100),
+				Values: nil,
 			},
 		},
 		NaiveValue: alloraMath.NewDecFromInt64(100),
@@ -161,12 +165,14 @@ func InsertReputerBundle(m testCommon.TestConfig, topic *types.Topic, BlockHeigh
 			{
 				Worker: workerAddr,
 				Value:  alloraMath.NewDecFromInt64(100),
+				Values: nil,
 			},
 		},
 		OneOutForecasterValues: []*types.WithheldWorkerAttributedValue{
 			{
 				Worker: workerAddr,
 				Value:  alloraMath.NewDecFromInt64(100),
+				Values: nil,
 			},
 		},
 		// Just as valid:
@@ -176,9 +182,12 @@ func InsertReputerBundle(m testCommon.TestConfig, topic *types.Topic, BlockHeigh
 			{
 				Worker: workerAddr,
 				Value:  alloraMath.NewDecFromInt64(100),
+				Values: nil,
 			},
 		},
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 
 	// Sign
@@ -34,6 +34,10 @@ func createTopic(
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		EnableWorkerWhitelist:    true,
 		EnableReputerWhitelist:   true,
+		Tags:                     nil,
+		OutputDimension:          0,
+		VectorSynthesisMode:      emissionstypes.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:           nil,
 	}
 
 	txResp, err := m.Client.BroadcastTx(ctx, creator.aa.acc, createTopicRequest)
@@ -169,6 +169,7 @@ func generateWorkerAttributedValueLosses(
 		values = append(values, &emissionstypes.WorkerAttributedValue{
 			Worker: workerAddresses[key].addr,
 			Value:  alloraMath.NewDecFromInt64(int64(rand.Intn(lowLimit) + sum)),
+			Values: nil,
 		})
 	}
 	return values
@@ -185,6 +186,7 @@ func generateWithheldWorkerAttributedValueLosses(
 		values = append(values, &emissionstypes.WithheldWorkerAttributedValue{
 			Worker: workerAddresses[key].addr,
 			Value:  alloraMath.NewDecFromInt64(int64(rand.Intn(lowLimit) + sum)),
+			Values: nil,
 		})
 	}
 	return values
@@ -211,6 +213,8 @@ func generateValueBundle(
 		OneOutForecasterValues:        generateWithheldWorkerAttributedValueLosses(workerAddresses, 50, 50),
 		OneInForecasterValues:         generateWorkerAttributedValueLosses(workerAddresses, 50, 50),
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 }
 
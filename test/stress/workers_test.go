@@ -176,6 +176,7 @@ func generateSingleWorkerBundle(
 		forecastElements = append(forecastElements, &emissionstypes.ForecastElement{
 			Inferer: workers[key].addr,
 			Value:   alloraMath.NewDecFromInt64(int64(rand.Intn(51) + 50)),
+			Values:  nil,
 		})
 	}
 	infererAddress := workers[workerAddressName].addr
@@ -194,6 +195,7 @@ func generateSingleWorkerBundle(
 				Value:       infererValue,
 				ExtraData:   nil,
 				Proof:       "",
+				Values:      nil,
 			},
 			Forecast: &emissionstypes.Forecast{
 				TopicId:          topicId,
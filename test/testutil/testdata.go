@@ -263,22 +263,27 @@ func GetForecastsFromCsv(
 					{
 						Inferer: inferers[0],
 						Value:   epochGet("forecasted_loss_0_for_0"),
+						Values:  nil,
 					},
 					{
 						Inferer: inferers[1],
 						Value:   epochGet("forecasted_loss_0_for_1"),
+						Values:  nil,
 					},
 					{
 						Inferer: inferers[2],
 						Value:   epochGet("forecasted_loss_0_for_2"),
+						Values:  nil,
 					},
 					{
 						Inferer: inferers[3],
 						Value:   epochGet("forecasted_loss_0_for_3"),
+						Values:  nil,
 					},
 					{
 						Inferer: inferers[4],
 						Value:   epochGet("forecasted_loss_0_for_4"),
+						Values:  nil,
 					},
 				},
 				TopicId:     topicId,
@@ -291,22 +296,27 @@ func GetForecastsFromCsv(
 					{
 						Inferer: inferers[0],
 						Value:   epochGet("forecasted_loss_1_for_0"),
+						Values:  nil,
 					},
 					{
 						Inferer: inferers[1],
 						Value:   epochGet("forecasted_loss_1_for_1"),
+						Values:  nil,
 					},
 					{
 						Inferer: inferers[2],
 						Value:   epochGet("forecasted_loss_1_for_2"),
+						Values:  nil,
 					},
 					{
 						Inferer: inferers[3],
 						Value:   epochGet("forecasted_loss_1_for_3"),
+						Values:  nil,
 					},
 					{
 						Inferer: inferers[4],
 						Value:   epochGet("forecasted_loss_1_for_4"),
+						Values:  nil,
 					},
 				},
 				TopicId:     topicId,
@@ -319,22 +329,27 @@ func GetForecastsFromCsv(
 					{
 						Inferer: inferers[0],
 						Value:   epochGet("forecasted_loss_2_for_0"),
+						Values:  nil,
 					},
 					{
 						Inferer: inferers[1],
 						Value:   epochGet("forecasted_loss_2_for_1"),
+						Values:  nil,
 					},
 					{
 						Inferer: inferers[2],
 						Value:   epochGet("forecasted_loss_2_for_2"),
+						Values:  nil,
 					},
 					{
 						Inferer: inferers[3],
 						Value:   epochGet("forecasted_loss_2_for_3"),
+						Values:  nil,
 					},
 					{
 						Inferer: inferers[4],
 						Value:   epochGet("forecasted_loss_2_for_4"),
+						Values:  nil,
 					},
 				},
 				TopicId:     topicId,
@@ -370,22 +385,27 @@ func GetNetworkLossFromCsv(
 			{
 				Worker: inferers[0],
 				Value:  epochGet("inference_loss_0"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[1],
 				Value:  epochGet("inference_loss_1"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[2],
 				Value:  epochGet("inference_loss_2"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[3],
 				Value:  epochGet("inference_loss_3"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[4],
 				Value:  epochGet("inference_loss_4"),
+				Values: nil,
 			},
 		},
 		NaiveValue: epochGet("network_naive_loss"),
@@ -393,14 +413,17 @@ func GetNetworkLossFromCsv(
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("forecast_implied_inference_loss_0"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("forecast_implied_inference_loss_1"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("forecast_implied_inference_loss_2"),
+				Values: nil,
 			},
 		},
 		OneOutInfererForecasterValues: []*emissionstypes.OneOutInfererForecasterValues{
@@ -410,22 +433,27 @@ func GetNetworkLossFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("forecast_implied_inference_loss_0_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("forecast_implied_inference_loss_0_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("forecast_implied_inference_loss_0_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("forecast_implied_inference_loss_0_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("forecast_implied_inference_loss_0_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
@@ -435,22 +463,27 @@ func GetNetworkLossFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("forecast_implied_inference_loss_1_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("forecast_implied_inference_loss_1_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("forecast_implied_inference_loss_1_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("forecast_implied_inference_loss_1_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("forecast_implied_inference_loss_1_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
@@ -460,22 +493,27 @@ func GetNetworkLossFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("forecast_implied_inference_loss_2_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("forecast_implied_inference_loss_2_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("forecast_implied_inference_loss_2_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("forecast_implied_inference_loss_2_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("forecast_implied_inference_loss_2_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
@@ -484,52 +522,65 @@ func GetNetworkLossFromCsv(
 			{
 				Worker: inferers[0],
 				Value:  epochGet("network_loss_oneout_0"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[1],
 				Value:  epochGet("network_loss_oneout_1"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[2],
 				Value:  epochGet("network_loss_oneout_2"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[3],
 				Value:  epochGet("network_loss_oneout_3"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[4],
 				Value:  epochGet("network_loss_oneout_4"),
+				Values: nil,
 			},
 		},
 		OneOutForecasterValues: []*emissionstypes.WithheldWorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("network_loss_oneout_5"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("network_loss_oneout_6"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("network_loss_oneout_7"),
+				Values: nil,
 			},
 		},
 		OneInForecasterValues: []*emissionstypes.WorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("network_naive_loss_onein_0"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("network_naive_loss_onein_1"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("network_naive_loss_onein_2"),
+				Values: nil,
 			},
 		},
+		CombinedValues: nil,
+		NaiveValues:    nil,
 	}, nil
 }
 
@@ -585,36 +636,44 @@ func GetReputersDataFromCsv(
 			{
 				Worker: inferers[0],
 				Value:  epochGet("reputer_0_loss_inference_0"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[1],
 				Value:  epochGet("reputer_0_loss_inference_1"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[2],
 				Value:  epochGet("reputer_0_loss_inference_2"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[3],
 				Value:  epochGet("reputer_0_loss_inference_3"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[4],
 				Value:  epochGet("reputer_0_loss_inference_4"),
+				Values: nil,
 			},
 		},
 		ForecasterValues: []*emissionstypes.WorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("reputer_0_loss_forecast_implied_inference_0"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("reputer_0_loss_forecast_implied_inference_1"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("reputer_0_loss_forecast_implied_inference_2"),
+				Values: nil,
 			},
 		},
 		NaiveValue: epochGet("reputer_0_loss_naive_network_inference"),
@@ -622,50 +681,61 @@ func GetReputersDataFromCsv(
 			{
 				Worker: inferers[0],
 				Value:  epochGet("reputer_0_loss_network_inference_oneout_0"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[1],
 				Value:  epochGet("reputer_0_loss_network_inference_oneout_1"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[2],
 				Value:  epochGet("reputer_0_loss_network_inference_oneout_2"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[3],
 				Value:  epochGet("reputer_0_loss_network_inference_oneout_3"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[4],
 				Value:  epochGet("reputer_0_loss_network_inference_oneout_4"),
+				Values: nil,
 			},
 		},
 		OneOutForecasterValues: []*emissionstypes.WithheldWorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("reputer_0_loss_network_inference_oneout_5"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("reputer_0_loss_network_inference_oneout_6"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("reputer_0_loss_network_inference_oneout_7"),
+				Values: nil,
 			},
 		},
 		OneInForecasterValues: []*emissionstypes.WorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("reputer_0_loss_naive_network_inference_onein_0"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("reputer_0_loss_naive_network_inference_onein_1"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("reputer_0_loss_naive_network_inference_onein_2"),
+				Values: nil,
 			},
 		},
 		OneOutInfererForecasterValues: []*emissionstypes.OneOutInfererForecasterValues{
@@ -675,22 +745,27 @@ func GetReputersDataFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("reputer_0_loss_forecast_implied_inference_0_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("reputer_0_loss_forecast_implied_inference_0_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("reputer_0_loss_forecast_implied_inference_0_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("reputer_0_loss_forecast_implied_inference_0_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("reputer_0_loss_forecast_implied_inference_0_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
@@ -700,22 +775,27 @@ func GetReputersDataFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("reputer_0_loss_forecast_implied_inference_1_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("reputer_0_loss_forecast_implied_inference_1_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("reputer_0_loss_forecast_implied_inference_1_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("reputer_0_loss_forecast_implied_inference_1_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("reputer_0_loss_forecast_implied_inference_1_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
@@ -725,26 +805,33 @@ func GetReputersDataFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("reputer_0_loss_forecast_implied_inference_2_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("reputer_0_loss_forecast_implied_inference_2_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("reputer_0_loss_forecast_implied_inference_2_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("reputer_0_loss_forecast_implied_inference_2_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("reputer_0_loss_forecast_implied_inference_2_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
 		},
+		CombinedValues: nil,
+		NaiveValues:    nil,
 	}
 	signature1 := signValueBundle(valueBundle1, reputers[0].PrivateKey)
 	reputerValueBundle1 := &emissionstypes.ReputerValueBundle{
@@ -765,36 +852,44 @@ func GetReputersDataFromCsv(
 			{
 				Worker: inferers[0],
 				Value:  epochGet("reputer_1_loss_inference_0"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[1],
 				Value:  epochGet("reputer_1_loss_inference_1"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[2],
 				Value:  epochGet("reputer_1_loss_inference_2"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[3],
 				Value:  epochGet("reputer_1_loss_inference_3"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[4],
 				Value:  epochGet("reputer_1_loss_inference_4"),
+				Values: nil,
 			},
 		},
 		ForecasterValues: []*emissionstypes.WorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("reputer_1_loss_forecast_implied_inference_0"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("reputer_1_loss_forecast_implied_inference_1"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("reputer_1_loss_forecast_implied_inference_2"),
+				Values: nil,
 			},
 		},
 		NaiveValue: epochGet("reputer_1_loss_naive_network_inference"),
@@ -802,50 +897,61 @@ func GetReputersDataFromCsv(
 			{
 				Worker: inferers[0],
 				Value:  epochGet("reputer_1_loss_network_inference_oneout_0"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[1],
 				Value:  epochGet("reputer_1_loss_network_inference_oneout_1"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[2],
 				Value:  epochGet("reputer_1_loss_network_inference_oneout_2"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[3],
 				Value:  epochGet("reputer_1_loss_network_inference_oneout_3"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[4],
 				Value:  epochGet("reputer_1_loss_network_inference_oneout_4"),
+				Values: nil,
 			},
 		},
 		OneOutForecasterValues: []*emissionstypes.WithheldWorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("reputer_1_loss_network_inference_oneout_5"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("reputer_1_loss_network_inference_oneout_6"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("reputer_1_loss_network_inference_oneout_7"),
+				Values: nil,
 			},
 		},
 		OneInForecasterValues: []*emissionstypes.WorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("reputer_1_loss_naive_network_inference_onein_0"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("reputer_1_loss_naive_network_inference_onein_1"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("reputer_1_loss_naive_network_inference_onein_2"),
+				Values: nil,
 			},
 		},
 		OneOutInfererForecasterValues: []*emissionstypes.OneOutInfererForecasterValues{
@@ -855,22 +961,27 @@ func GetReputersDataFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("reputer_1_loss_forecast_implied_inference_0_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("reputer_1_loss_forecast_implied_inference_0_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("reputer_1_loss_forecast_implied_inference_0_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("reputer_1_loss_forecast_implied_inference_0_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("reputer_1_loss_forecast_implied_inference_0_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
@@ -880,22 +991,27 @@ func GetReputersDataFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("reputer_1_loss_forecast_implied_inference_1_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("reputer_1_loss_forecast_implied_inference_1_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("reputer_1_loss_forecast_implied_inference_1_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("reputer_1_loss_forecast_implied_inference_1_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("reputer_1_loss_forecast_implied_inference_1_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
@@ -905,26 +1021,33 @@ func GetReputersDataFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("reputer_1_loss_forecast_implied_inference_2_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("reputer_1_loss_forecast_implied_inference_2_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("reputer_1_loss_forecast_implied_inference_2_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("reputer_1_loss_forecast_implied_inference_2_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("reputer_1_loss_forecast_implied_inference_2_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
 		},
+		CombinedValues: nil,
+		NaiveValues:    nil,
 	}
 	signature2 := signValueBundle(valueBundle2, reputers[1].PrivateKey)
 	reputerValueBundle2 := &emissionstypes.ReputerValueBundle{
@@ -945,36 +1068,44 @@ func GetReputersDataFromCsv(
 			{
 				Worker: inferers[0],
 				Value:  epochGet("reputer_2_loss_inference_0"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[1],
 				Value:  epochGet("reputer_2_loss_inference_1"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[2],
 				Value:  epochGet("reputer_2_loss_inference_2"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[3],
 				Value:  epochGet("reputer_2_loss_inference_3"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[4],
 				Value:  epochGet("reputer_2_loss_inference_4"),
+				Values: nil,
 			},
 		},
 		ForecasterValues: []*emissionstypes.WorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("reputer_2_loss_forecast_implied_inference_0"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("reputer_2_loss_forecast_implied_inference_1"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("reputer_2_loss_forecast_implied_inference_2"),
+				Values: nil,
 			},
 		},
 		NaiveValue: epochGet("reputer_2_loss_naive_network_inference"),
@@ -982,50 +1113,61 @@ func GetReputersDataFromCsv(
 			{
 				Worker: inferers[0],
 				Value:  epochGet("reputer_2_loss_network_inference_oneout_0"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[1],
 				Value:  epochGet("reputer_2_loss_network_inference_oneout_1"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[2],
 				Value:  epochGet("reputer_2_loss_network_inference_oneout_2"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[3],
 				Value:  epochGet("reputer_2_loss_network_inference_oneout_3"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[4],
 				Value:  epochGet("reputer_2_loss_network_inference_oneout_4"),
+				Values: nil,
 			},
 		},
 		OneOutForecasterValues: []*emissionstypes.WithheldWorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("reputer_2_loss_network_inference_oneout_5"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("reputer_2_loss_network_inference_oneout_6"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("reputer_2_loss_network_inference_oneout_7"),
+				Values: nil,
 			},
 		},
 		OneInForecasterValues: []*emissionstypes.WorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("reputer_2_loss_naive_network_inference_onein_0"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("reputer_2_loss_naive_network_inference_onein_1"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("reputer_2_loss_naive_network_inference_onein_2"),
+				Values: nil,
 			},
 		},
 		OneOutInfererForecasterValues: []*emissionstypes.OneOutInfererForecasterValues{
@@ -1035,22 +1177,27 @@ func GetReputersDataFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("reputer_2_loss_forecast_implied_inference_0_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("reputer_2_loss_forecast_implied_inference_0_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("reputer_2_loss_forecast_implied_inference_0_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("reputer_2_loss_forecast_implied_inference_0_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("reputer_2_loss_forecast_implied_inference_0_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
@@ -1060,22 +1207,27 @@ func GetReputersDataFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("reputer_2_loss_forecast_implied_inference_1_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("reputer_2_loss_forecast_implied_inference_1_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("reputer_2_loss_forecast_implied_inference_1_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("reputer_2_loss_forecast_implied_inference_1_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("reputer_2_loss_forecast_implied_inference_1_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
@@ -1085,26 +1237,33 @@ func GetReputersDataFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("reputer_2_loss_forecast_implied_inference_2_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("reputer_2_loss_forecast_implied_inference_2_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("reputer_2_loss_forecast_implied_inference_2_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("reputer_2_loss_forecast_implied_inference_2_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("reputer_2_loss_forecast_implied_inference_2_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
 		},
+		CombinedValues: nil,
+		NaiveValues:    nil,
 	}
 	signature3 := signValueBundle(valueBundle3, reputers[2].PrivateKey)
 	reputerValueBundle3 := &emissionstypes.ReputerValueBundle{
@@ -1125,36 +1284,44 @@ func GetReputersDataFromCsv(
 			{
 				Worker: inferers[0],
 				Value:  epochGet("reputer_3_loss_inference_0"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[1],
 				Value:  epochGet("reputer_3_loss_inference_1"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[2],
 				Value:  epochGet("reputer_3_loss_inference_2"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[3],
 				Value:  epochGet("reputer_3_loss_inference_3"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[4],
 				Value:  epochGet("reputer_3_loss_inference_4"),
+				Values: nil,
 			},
 		},
 		ForecasterValues: []*emissionstypes.WorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("reputer_3_loss_forecast_implied_inference_0"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("reputer_3_loss_forecast_implied_inference_1"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("reputer_3_loss_forecast_implied_inference_2"),
+				Values: nil,
 			},
 		},
 		NaiveValue: epochGet("reputer_3_loss_naive_network_inference"),
@@ -1162,50 +1329,61 @@ func GetReputersDataFromCsv(
 			{
 				Worker: inferers[0],
 				Value:  epochGet("reputer_3_loss_network_inference_oneout_0"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[1],
 				Value:  epochGet("reputer_3_loss_network_inference_oneout_1"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[2],
 				Value:  epochGet("reputer_3_loss_network_inference_oneout_2"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[3],
 				Value:  epochGet("reputer_3_loss_network_inference_oneout_3"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[4],
 				Value:  epochGet("reputer_3_loss_network_inference_oneout_4"),
+				Values: nil,
 			},
 		},
 		OneOutForecasterValues: []*emissionstypes.WithheldWorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("reputer_3_loss_network_inference_oneout_5"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("reputer_3_loss_network_inference_oneout_6"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("reputer_3_loss_network_inference_oneout_7"),
+				Values: nil,
 			},
 		},
 		OneInForecasterValues: []*emissionstypes.WorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("reputer_3_loss_naive_network_inference_onein_0"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("reputer_3_loss_naive_network_inference_onein_1"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("reputer_3_loss_naive_network_inference_onein_2"),
+				Values: nil,
 			},
 		},
 		OneOutInfererForecasterValues: []*emissionstypes.OneOutInfererForecasterValues{
@@ -1215,22 +1393,27 @@ func GetReputersDataFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("reputer_3_loss_forecast_implied_inference_0_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("reputer_3_loss_forecast_implied_inference_0_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("reputer_3_loss_forecast_implied_inference_0_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("reputer_3_loss_forecast_implied_inference_0_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("reputer_3_loss_forecast_implied_inference_0_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
@@ -1240,22 +1423,27 @@ func GetReputersDataFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("reputer_3_loss_forecast_implied_inference_1_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("reputer_3_loss_forecast_implied_inference_1_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("reputer_3_loss_forecast_implied_inference_1_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("reputer_3_loss_forecast_implied_inference_1_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("reputer_3_loss_forecast_implied_inference_1_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
@@ -1265,26 +1453,33 @@ func GetReputersDataFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("reputer_3_loss_forecast_implied_inference_2_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("reputer_3_loss_forecast_implied_inference_2_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("reputer_3_loss_forecast_implied_inference_2_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("reputer_3_loss_forecast_implied_inference_2_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("reputer_3_loss_forecast_implied_inference_2_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
 		},
+		CombinedValues: nil,
+		NaiveValues:    nil,
 	}
 	signature4 := signValueBundle(valueBundle4, reputers[3].PrivateKey)
 	reputerValueBundle4 := &emissionstypes.ReputerValueBundle{
@@ -1305,36 +1500,44 @@ func GetReputersDataFromCsv(
 			{
 				Worker: inferers[0],
 				Value:  epochGet("reputer_4_loss_inference_0"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[1],
 				Value:  epochGet("reputer_4_loss_inference_1"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[2],
 				Value:  epochGet("reputer_4_loss_inference_2"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[3],
 				Value:  epochGet("reputer_4_loss_inference_3"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[4],
 				Value:  epochGet("reputer_4_loss_inference_4"),
+				Values: nil,
 			},
 		},
 		ForecasterValues: []*emissionstypes.WorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("reputer_4_loss_forecast_implied_inference_0"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("reputer_4_loss_forecast_implied_inference_1"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("reputer_4_loss_forecast_implied_inference_2"),
+				Values: nil,
 			},
 		},
 		NaiveValue: epochGet("reputer_4_loss_naive_network_inference"),
@@ -1342,50 +1545,61 @@ func GetReputersDataFromCsv(
 			{
 				Worker: inferers[0],
 				Value:  epochGet("reputer_4_loss_network_inference_oneout_0"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[1],
 				Value:  epochGet("reputer_4_loss_network_inference_oneout_1"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[2],
 				Value:  epochGet("reputer_4_loss_network_inference_oneout_2"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[3],
 				Value:  epochGet("reputer_4_loss_network_inference_oneout_3"),
+				Values: nil,
 			},
 			{
 				Worker: inferers[4],
 				Value:  epochGet("reputer_4_loss_network_inference_oneout_4"),
+				Values: nil,
 			},
 		},
 		OneOutForecasterValues: []*emissionstypes.WithheldWorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("reputer_4_loss_network_inference_oneout_5"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("reputer_4_loss_network_inference_oneout_6"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("reputer_4_loss_network_inference_oneout_7"),
+				Values: nil,
 			},
 		},
 		OneInForecasterValues: []*emissionstypes.WorkerAttributedValue{
 			{
 				Worker: forecasters[0],
 				Value:  epochGet("reputer_4_loss_naive_network_inference_onein_0"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[1],
 				Value:  epochGet("reputer_4_loss_naive_network_inference_onein_1"),
+				Values: nil,
 			},
 			{
 				Worker: forecasters[2],
 				Value:  epochGet("reputer_4_loss_naive_network_inference_onein_2"),
+				Values: nil,
 			},
 		},
 		OneOutInfererForecasterValues: []*emissionstypes.OneOutInfererForecasterValues{
@@ -1395,22 +1609,27 @@ func GetReputersDataFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("reputer_4_loss_forecast_implied_inference_0_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("reputer_4_loss_forecast_implied_inference_0_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("reputer_4_loss_forecast_implied_inference_0_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("reputer_4_loss_forecast_implied_inference_0_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("reputer_4_loss_forecast_implied_inference_0_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
@@ -1420,22 +1639,27 @@ func GetReputersDataFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("reputer_4_loss_forecast_implied_inference_1_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("reputer_4_loss_forecast_implied_inference_1_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("reputer_4_loss_forecast_implied_inference_1_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("reputer_4_loss_forecast_implied_inference_1_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("reputer_4_loss_forecast_implied_inference_1_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
@@ -1445,26 +1669,33 @@ func GetReputersDataFromCsv(
 					{
 						Worker: inferers[0],
 						Value:  epochGet("reputer_4_loss_forecast_implied_inference_2_oneout_0"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[1],
 						Value:  epochGet("reputer_4_loss_forecast_implied_inference_2_oneout_1"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[2],
 						Value:  epochGet("reputer_4_loss_forecast_implied_inference_2_oneout_2"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[3],
 						Value:  epochGet("reputer_4_loss_forecast_implied_inference_2_oneout_3"),
+						Values: nil,
 					},
 					{
 						Worker: inferers[4],
 						Value:  epochGet("reputer_4_loss_forecast_implied_inference_2_oneout_4"),
+						Values: nil,
 					},
 				},
 			},
 		},
+		CombinedValues: nil,
+		NaiveValues:    nil,
 	}
 	signature5 := signValueBundle(valueBundle5, reputers[4].PrivateKey)
 	reputerValueBundle5 := &emissionstypes.ReputerValueBundle{
@@ -1593,7 +1824,7 @@ func SetRegretsFromPreviousEpoch(
 			ctx,
 			topicId,
 			inferer,
-			emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret},
+			emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret, Values: nil},
 		)
 		if err != nil {
 			return fmt.Errorf("error setting inferer network regret: %v", err)
@@ -1611,7 +1842,7 @@ func SetRegretsFromPreviousEpoch(
 			ctx,
 			topicId,
 			forecaster,
-			emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret},
+			emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret, Values: nil},
 		)
 		if err != nil {
 			return fmt.Errorf("error setting forecaster network regret: %v", err)
@@ -1629,7 +1860,7 @@ func SetRegretsFromPreviousEpoch(
 			ctx,
 			topicId,
 			inferer,
-			emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret},
+			emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret, Values: nil},
 		)
 		if err != nil {
 			return fmt.Errorf("error setting naive inferer network regret: %v", err)
@@ -1648,6 +1879,7 @@ func SetRegretsFromPreviousEpoch(
 				emissionstypes.TimestampedValue{
 					BlockHeight: blockHeight,
 					Value:       epochPrevGet(headerName),
+					Values:      nil,
 				},
 			)
 			if err != nil {
@@ -1668,6 +1900,7 @@ func SetRegretsFromPreviousEpoch(
 				emissionstypes.TimestampedValue{
 					BlockHeight: blockHeight,
 					Value:       epochPrevGet(headerName),
+					Values:      nil,
 				},
 			)
 			if err != nil {
@@ -1688,6 +1921,7 @@ func SetRegretsFromPreviousEpoch(
 				emissionstypes.TimestampedValue{
 					BlockHeight: blockHeight,
 					Value:       epochPrevGet(headerName),
+					Values:      nil,
 				},
 			)
 			if err != nil {
@@ -1708,6 +1942,7 @@ func SetRegretsFromPreviousEpoch(
 				emissionstypes.TimestampedValue{
 					BlockHeight: blockHeight,
 					Value:       epochPrevGet(headerName),
+					Values:      nil,
 				},
 			)
 			if err != nil {
@@ -1727,6 +1962,7 @@ func SetRegretsFromPreviousEpoch(
 			emissionstypes.TimestampedValue{
 				BlockHeight: blockHeight,
 				Value:       epochPrevGet(headerName),
+				Values:      nil,
 			},
 		)
 		if err != nil {
@@ -1742,6 +1978,7 @@ func SetRegretsFromPreviousEpoch(
 				emissionstypes.TimestampedValue{
 					BlockHeight: blockHeight,
 					Value:       epochPrevGet(headerName),
+					Values:      nil,
 				},
 			)
 			if err != nil {
@@ -13,10 +13,57 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_WorkerAttributedValue_3_list)(nil)
+
+type _WorkerAttributedValue_3_list struct {
+	list *[]string
+}
+
+func (x *_WorkerAttributedValue_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_WorkerAttributedValue_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_WorkerAttributedValue_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_WorkerAttributedValue_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_WorkerAttributedValue_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message WorkerAttributedValue at list field Values as it is not of Message kind"))
+}
+
+func (x *_WorkerAttributedValue_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_WorkerAttributedValue_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_WorkerAttributedValue_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_WorkerAttributedValue        protoreflect.MessageDescriptor
 	fd_WorkerAttributedValue_worker protoreflect.FieldDescriptor
 	fd_WorkerAttributedValue_value  protoreflect.FieldDescriptor
+	fd_WorkerAttributedValue_values protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -24,6 +71,7 @@ func init() {
 	md_WorkerAttributedValue = File_emissions_v3_reputer_proto.Messages().ByName("WorkerAttributedValue")
 	fd_WorkerAttributedValue_worker = md_WorkerAttributedValue.Fields().ByName("worker")
 	fd_WorkerAttributedValue_value = md_WorkerAttributedValue.Fields().ByName("value")
+	fd_WorkerAttributedValue_values = md_WorkerAttributedValue.Fields().ByName("values")
 }
 
 var _ protoreflect.Message = (*fastReflection_WorkerAttributedValue)(nil)
@@ -103,6 +151,12 @@ func (x *fastReflection_WorkerAttributedValue) Range(f func(protoreflect.FieldDe
 			return
 		}
 	}
+	if len(x.Values) != 0 {
+		value := protoreflect.ValueOfList(&_WorkerAttributedValue_3_list{list: &x.Values})
+		if !f(fd_WorkerAttributedValue_values, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -122,6 +176,8 @@ func (x *fastReflection_WorkerAttributedValue) Has(fd protoreflect.FieldDescript
 		return x.Worker != ""
 	case "emissions.v3.WorkerAttributedValue.value":
 		return x.Value != ""
+	case "emissions.v3.WorkerAttributedValue.values":
+		return len(x.Values) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerAttributedValue"))
@@ -142,6 +198,8 @@ func (x *fastReflection_WorkerAttributedValue) Clear(fd protoreflect.FieldDescri
 		x.Worker = ""
 	case "emissions.v3.WorkerAttributedValue.value":
 		x.Value = ""
+	case "emissions.v3.WorkerAttributedValue.values":
+		x.Values = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerAttributedValue"))
@@ -164,6 +222,12 @@ func (x *fastReflection_WorkerAttributedValue) Get(descriptor protoreflect.Field
 	case "emissions.v3.WorkerAttributedValue.value":
 		value := x.Value
 		return protoreflect.ValueOfString(value)
+	case "emissions.v3.WorkerAttributedValue.values":
+		if len(x.Values) == 0 {
+			return protoreflect.ValueOfList(&_WorkerAttributedValue_3_list{})
+		}
+		listValue := &_WorkerAttributedValue_3_list{list: &x.Values}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerAttributedValue"))
@@ -188,6 +252,10 @@ func (x *fastReflection_WorkerAttributedValue) Set(fd protoreflect.FieldDescript
 		x.Worker = value.Interface().(string)
 	case "emissions.v3.WorkerAttributedValue.value":
 		x.Value = value.Interface().(string)
+	case "emissions.v3.WorkerAttributedValue.values":
+		lv := value.List()
+		clv := lv.(*_WorkerAttributedValue_3_list)
+		x.Values = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerAttributedValue"))
@@ -208,6 +276,12 @@ func (x *fastReflection_WorkerAttributedValue) Set(fd protoreflect.FieldDescript
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_WorkerAttributedValue) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "emissions.v3.WorkerAttributedValue.values":
+		if x.Values == nil {
+			x.Values = []string{}
+		}
+		value := &_WorkerAttributedValue_3_list{list: &x.Values}
+		return protoreflect.ValueOfList(value)
 	case "emissions.v3.WorkerAttributedValue.worker":
 		panic(fmt.Errorf("field worker of message emissions.v3.WorkerAttributedValue is not mutable"))
 	case "emissions.v3.WorkerAttributedValue.value":
@@ -229,6 +303,9 @@ func (x *fastReflection_WorkerAttributedValue) NewField(fd protoreflect.FieldDes
 		return protoreflect.ValueOfString("")
 	case "emissions.v3.WorkerAttributedValue.value":
 		return protoreflect.ValueOfString("")
+	case "emissions.v3.WorkerAttributedValue.values":
+		list := []string{}
+		return protoreflect.ValueOfList(&_WorkerAttributedValue_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerAttributedValue"))
@@ -306,6 +383,12 @@ func (x *fastReflection_WorkerAttributedValue) ProtoMethods() *protoiface.Method
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Values) > 0 {
+			for _, s := range x.Values {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -335,6 +418,15 @@ func (x *fastReflection_WorkerAttributedValue) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Values) > 0 {
+			for iNdEx := len(x.Values) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Values[iNdEx])
+				copy(dAtA[i:], x.Values[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Values[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if len(x.Value) > 0 {
 			i -= len(x.Value)
 			copy(dAtA[i:], x.Value)
@@ -462,6 +554,38 @@ func (x *fastReflection_WorkerAttributedValue) ProtoMethods() *protoiface.Method
 				}
 				x.Value = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Values = append(x.Values, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -497,10 +621,57 @@ func (x *fastReflection_WorkerAttributedValue) ProtoMethods() *protoiface.Method
 	}
 }
 
+var _ protoreflect.List = (*_WithheldWorkerAttributedValue_3_list)(nil)
+
+type _WithheldWorkerAttributedValue_3_list struct {
+	list *[]string
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message WithheldWorkerAttributedValue at list field Values as it is not of Message kind"))
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_WithheldWorkerAttributedValue        protoreflect.MessageDescriptor
 	fd_WithheldWorkerAttributedValue_worker protoreflect.FieldDescriptor
 	fd_WithheldWorkerAttributedValue_value  protoreflect.FieldDescriptor
+	fd_WithheldWorkerAttributedValue_values protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -508,6 +679,7 @@ func init() {
 	md_WithheldWorkerAttributedValue = File_emissions_v3_reputer_proto.Messages().ByName("WithheldWorkerAttributedValue")
 	fd_WithheldWorkerAttributedValue_worker = md_WithheldWorkerAttributedValue.Fields().ByName("worker")
 	fd_WithheldWorkerAttributedValue_value = md_WithheldWorkerAttributedValue.Fields().ByName("value")
+	fd_WithheldWorkerAttributedValue_values = md_WithheldWorkerAttributedValue.Fields().ByName("values")
 }
 
 var _ protoreflect.Message = (*fastReflection_WithheldWorkerAttributedValue)(nil)
@@ -587,6 +759,12 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Range(f func(protoreflect
 			return
 		}
 	}
+	if len(x.Values) != 0 {
+		value := protoreflect.ValueOfList(&_WithheldWorkerAttributedValue_3_list{list: &x.Values})
+		if !f(fd_WithheldWorkerAttributedValue_values, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -606,6 +784,8 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Has(fd protoreflect.Field
 		return x.Worker != ""
 	case "emissions.v3.WithheldWorkerAttributedValue.value":
 		return x.Value != ""
+	case "emissions.v3.WithheldWorkerAttributedValue.values":
+		return len(x.Values) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WithheldWorkerAttributedValue"))
@@ -626,6 +806,8 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Clear(fd protoreflect.Fie
 		x.Worker = ""
 	case "emissions.v3.WithheldWorkerAttributedValue.value":
 		x.Value = ""
+	case "emissions.v3.WithheldWorkerAttributedValue.values":
+		x.Values = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WithheldWorkerAttributedValue"))
@@ -648,6 +830,12 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Get(descriptor protorefle
 	case "emissions.v3.WithheldWorkerAttributedValue.value":
 		value := x.Value
 		return protoreflect.ValueOfString(value)
+	case "emissions.v3.WithheldWorkerAttributedValue.values":
+		if len(x.Values) == 0 {
+			return protoreflect.ValueOfList(&_WithheldWorkerAttributedValue_3_list{})
+		}
+		listValue := &_WithheldWorkerAttributedValue_3_list{list: &x.Values}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WithheldWorkerAttributedValue"))
@@ -672,6 +860,10 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Set(fd protoreflect.Field
 		x.Worker = value.Interface().(string)
 	case "emissions.v3.WithheldWorkerAttributedValue.value":
 		x.Value = value.Interface().(string)
+	case "emissions.v3.WithheldWorkerAttributedValue.values":
+		lv := value.List()
+		clv := lv.(*_WithheldWorkerAttributedValue_3_list)
+		x.Values = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WithheldWorkerAttributedValue"))
@@ -692,6 +884,12 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Set(fd protoreflect.Field
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_WithheldWorkerAttributedValue) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "emissions.v3.WithheldWorkerAttributedValue.values":
+		if x.Values == nil {
+			x.Values = []string{}
+		}
+		value := &_WithheldWorkerAttributedValue_3_list{list: &x.Values}
+		return protoreflect.ValueOfList(value)
 	case "emissions.v3.WithheldWorkerAttributedValue.worker":
 		panic(fmt.Errorf("field worker of message emissions.v3.WithheldWorkerAttributedValue is not mutable"))
 	case "emissions.v3.WithheldWorkerAttributedValue.value":
@@ -713,6 +911,9 @@ func (x *fastReflection_WithheldWorkerAttributedValue) NewField(fd protoreflect.
 		return protoreflect.ValueOfString("")
 	case "emissions.v3.WithheldWorkerAttributedValue.value":
 		return protoreflect.ValueOfString("")
+	case "emissions.v3.WithheldWorkerAttributedValue.values":
+		list := []string{}
+		return protoreflect.ValueOfList(&_WithheldWorkerAttributedValue_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WithheldWorkerAttributedValue"))
@@ -790,6 +991,12 @@ func (x *fastReflection_WithheldWorkerAttributedValue) ProtoMethods() *protoifac
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Values) > 0 {
+			for _, s := range x.Values {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -819,6 +1026,15 @@ func (x *fastReflection_WithheldWorkerAttributedValue) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Values) > 0 {
+			for iNdEx := len(x.Values) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Values[iNdEx])
+				copy(dAtA[i:], x.Values[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Values[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if len(x.Value) > 0 {
 			i -= len(x.Value)
 			copy(dAtA[i:], x.Value)
@@ -946,6 +1162,38 @@ func (x *fastReflection_WithheldWorkerAttributedValue) ProtoMethods() *protoifac
 				}
 				x.Value = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Values = append(x.Values, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1845,6 +2093,98 @@ func (x *_ValueBundle_12_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_ValueBundle_13_list)(nil)
+
+type _ValueBundle_13_list struct {
+	list *[]string
+}
+
+func (x *_ValueBundle_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValueBundle_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ValueBundle_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValueBundle_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValueBundle_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ValueBundle at list field CombinedValues as it is not of Message kind"))
+}
+
+func (x *_ValueBundle_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValueBundle_13_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ValueBundle_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ValueBundle_14_list)(nil)
+
+type _ValueBundle_14_list struct {
+	list *[]string
+}
+
+func (x *_ValueBundle_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValueBundle_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ValueBundle_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValueBundle_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValueBundle_14_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ValueBundle at list field NaiveValues as it is not of Message kind"))
+}
+
+func (x *_ValueBundle_14_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValueBundle_14_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ValueBundle_14_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_ValueBundle                                   protoreflect.MessageDescriptor
 	fd_ValueBundle_topic_id                          protoreflect.FieldDescriptor
@@ -1859,6 +2199,8 @@ var (
 	fd_ValueBundle_one_out_forecaster_values         protoreflect.FieldDescriptor
 	fd_ValueBundle_one_in_forecaster_values          protoreflect.FieldDescriptor
 	fd_ValueBundle_one_out_inferer_forecaster_values protoreflect.FieldDescriptor
+	fd_ValueBundle_combined_values                   protoreflect.FieldDescriptor
+	fd_ValueBundle_naive_values                      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1876,6 +2218,8 @@ func init() {
 	fd_ValueBundle_one_out_forecaster_values = md_ValueBundle.Fields().ByName("one_out_forecaster_values")
 	fd_ValueBundle_one_in_forecaster_values = md_ValueBundle.Fields().ByName("one_in_forecaster_values")
 	fd_ValueBundle_one_out_inferer_forecaster_values = md_ValueBundle.Fields().ByName("one_out_inferer_forecaster_values")
+	fd_ValueBundle_combined_values = md_ValueBundle.Fields().ByName("combined_values")
+	fd_ValueBundle_naive_values = md_ValueBundle.Fields().ByName("naive_values")
 }
 
 var _ protoreflect.Message = (*fastReflection_ValueBundle)(nil)
@@ -2015,6 +2359,18 @@ func (x *fastReflection_ValueBundle) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.CombinedValues) != 0 {
+		value := protoreflect.ValueOfList(&_ValueBundle_13_list{list: &x.CombinedValues})
+		if !f(fd_ValueBundle_combined_values, value) {
+			return
+		}
+	}
+	if len(x.NaiveValues) != 0 {
+		value := protoreflect.ValueOfList(&_ValueBundle_14_list{list: &x.NaiveValues})
+		if !f(fd_ValueBundle_naive_values, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2054,6 +2410,10 @@ func (x *fastReflection_ValueBundle) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.OneInForecasterValues) != 0
 	case "emissions.v3.ValueBundle.one_out_inferer_forecaster_values":
 		return len(x.OneOutInfererForecasterValues) != 0
+	case "emissions.v3.ValueBundle.combined_values":
+		return len(x.CombinedValues) != 0
+	case "emissions.v3.ValueBundle.naive_values":
+		return len(x.NaiveValues) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ValueBundle"))
@@ -2094,6 +2454,10 @@ func (x *fastReflection_ValueBundle) Clear(fd protoreflect.FieldDescriptor) {
 		x.OneInForecasterValues = nil
 	case "emissions.v3.ValueBundle.one_out_inferer_forecaster_values":
 		x.OneOutInfererForecasterValues = nil
+	case "emissions.v3.ValueBundle.combined_values":
+		x.CombinedValues = nil
+	case "emissions.v3.ValueBundle.naive_values":
+		x.NaiveValues = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ValueBundle"))
@@ -2164,6 +2528,18 @@ func (x *fastReflection_ValueBundle) Get(descriptor protoreflect.FieldDescriptor
 		}
 		listValue := &_ValueBundle_12_list{list: &x.OneOutInfererForecasterValues}
 		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.ValueBundle.combined_values":
+		if len(x.CombinedValues) == 0 {
+			return protoreflect.ValueOfList(&_ValueBundle_13_list{})
+		}
+		listValue := &_ValueBundle_13_list{list: &x.CombinedValues}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.ValueBundle.naive_values":
+		if len(x.NaiveValues) == 0 {
+			return protoreflect.ValueOfList(&_ValueBundle_14_list{})
+		}
+		listValue := &_ValueBundle_14_list{list: &x.NaiveValues}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ValueBundle"))
@@ -2220,6 +2596,14 @@ func (x *fastReflection_ValueBundle) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_ValueBundle_12_list)
 		x.OneOutInfererForecasterValues = *clv.list
+	case "emissions.v3.ValueBundle.combined_values":
+		lv := value.List()
+		clv := lv.(*_ValueBundle_13_list)
+		x.CombinedValues = *clv.list
+	case "emissions.v3.ValueBundle.naive_values":
+		lv := value.List()
+		clv := lv.(*_ValueBundle_14_list)
+		x.NaiveValues = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ValueBundle"))
@@ -2281,6 +2665,18 @@ func (x *fastReflection_ValueBundle) Mutable(fd protoreflect.FieldDescriptor) pr
 		}
 		value := &_ValueBundle_12_list{list: &x.OneOutInfererForecasterValues}
 		return protoreflect.ValueOfList(value)
+	case "emissions.v3.ValueBundle.combined_values":
+		if x.CombinedValues == nil {
+			x.CombinedValues = []string{}
+		}
+		value := &_ValueBundle_13_list{list: &x.CombinedValues}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.ValueBundle.naive_values":
+		if x.NaiveValues == nil {
+			x.NaiveValues = []string{}
+		}
+		value := &_ValueBundle_14_list{list: &x.NaiveValues}
+		return protoreflect.ValueOfList(value)
 	case "emissions.v3.ValueBundle.topic_id":
 		panic(fmt.Errorf("field topic_id of message emissions.v3.ValueBundle is not mutable"))
 	case "emissions.v3.ValueBundle.reputer":
@@ -2335,6 +2731,12 @@ func (x *fastReflection_ValueBundle) NewField(fd protoreflect.FieldDescriptor) p
 	case "emissions.v3.ValueBundle.one_out_inferer_forecaster_values":
 		list := []*OneOutInfererForecasterValues{}
 		return protoreflect.ValueOfList(&_ValueBundle_12_list{list: &list})
+	case "emissions.v3.ValueBundle.combined_values":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ValueBundle_13_list{list: &list})
+	case "emissions.v3.ValueBundle.naive_values":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ValueBundle_14_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ValueBundle"))
@@ -2463,6 +2865,18 @@ func (x *fastReflection_ValueBundle) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.CombinedValues) > 0 {
+			for _, s := range x.CombinedValues {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NaiveValues) > 0 {
+			for _, s := range x.NaiveValues {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2492,6 +2906,24 @@ func (x *fastReflection_ValueBundle) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.NaiveValues) > 0 {
+			for iNdEx := len(x.NaiveValues) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.NaiveValues[iNdEx])
+				copy(dAtA[i:], x.NaiveValues[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NaiveValues[iNdEx])))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.CombinedValues) > 0 {
+			for iNdEx := len(x.CombinedValues) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CombinedValues[iNdEx])
+				copy(dAtA[i:], x.CombinedValues[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CombinedValues[iNdEx])))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
 		if len(x.OneOutInfererForecasterValues) > 0 {
 			for iNdEx := len(x.OneOutInfererForecasterValues) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.OneOutInfererForecasterValues[iNdEx])
@@ -3073,20 +3505,84 @@ func (x *fastReflection_ValueBundle) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CombinedValues", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.CombinedValues = append(x.CombinedValues, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NaiveValues", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NaiveValues = append(x.NaiveValues, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -4167,225 +4663,887 @@ func (x *fastReflection_ReputerValueBundles) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: emissions/v3/reputer.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-type WorkerAttributedValue struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.List = (*_GroundTruth_4_list)(nil)
 
-	Worker string `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"` // worker who created the value
-	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+type _GroundTruth_4_list struct {
+	list *[]string
 }
 
-func (x *WorkerAttributedValue) Reset() {
-	*x = WorkerAttributedValue{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_reputer_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_GroundTruth_4_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *WorkerAttributedValue) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_GroundTruth_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (*WorkerAttributedValue) ProtoMessage() {}
-
-// Deprecated: Use WorkerAttributedValue.ProtoReflect.Descriptor instead.
-func (*WorkerAttributedValue) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_reputer_proto_rawDescGZIP(), []int{0}
+func (x *_GroundTruth_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x *WorkerAttributedValue) GetWorker() string {
-	if x != nil {
-		return x.Worker
-	}
-	return ""
+func (x *_GroundTruth_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *WorkerAttributedValue) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
+func (x *_GroundTruth_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GroundTruth at list field Values as it is not of Message kind"))
 }
 
-type WithheldWorkerAttributedValue struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Worker string `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
-	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (x *_GroundTruth_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-func (x *WithheldWorkerAttributedValue) Reset() {
-	*x = WithheldWorkerAttributedValue{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_reputer_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *_GroundTruth_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *WithheldWorkerAttributedValue) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_GroundTruth_4_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (*WithheldWorkerAttributedValue) ProtoMessage() {}
-
-// Deprecated: Use WithheldWorkerAttributedValue.ProtoReflect.Descriptor instead.
-func (*WithheldWorkerAttributedValue) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_reputer_proto_rawDescGZIP(), []int{1}
-}
+var (
+	md_GroundTruth              protoreflect.MessageDescriptor
+	fd_GroundTruth_topic_id     protoreflect.FieldDescriptor
+	fd_GroundTruth_block_height protoreflect.FieldDescriptor
+	fd_GroundTruth_reputer      protoreflect.FieldDescriptor
+	fd_GroundTruth_values       protoreflect.FieldDescriptor
+)
 
-func (x *WithheldWorkerAttributedValue) GetWorker() string {
-	if x != nil {
-		return x.Worker
-	}
-	return ""
+func init() {
+	file_emissions_v3_reputer_proto_init()
+	md_GroundTruth = File_emissions_v3_reputer_proto.Messages().ByName("GroundTruth")
+	fd_GroundTruth_topic_id = md_GroundTruth.Fields().ByName("topic_id")
+	fd_GroundTruth_block_height = md_GroundTruth.Fields().ByName("block_height")
+	fd_GroundTruth_reputer = md_GroundTruth.Fields().ByName("reputer")
+	fd_GroundTruth_values = md_GroundTruth.Fields().ByName("values")
 }
 
-func (x *WithheldWorkerAttributedValue) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
-}
+var _ protoreflect.Message = (*fastReflection_GroundTruth)(nil)
 
-type OneOutInfererForecasterValues struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_GroundTruth GroundTruth
 
-	Forecaster          string                           `protobuf:"bytes,3,opt,name=forecaster,proto3" json:"forecaster,omitempty"`
-	OneOutInfererValues []*WithheldWorkerAttributedValue `protobuf:"bytes,4,rep,name=one_out_inferer_values,json=oneOutInfererValues,proto3" json:"one_out_inferer_values,omitempty"`
+func (x *GroundTruth) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GroundTruth)(x)
 }
 
-func (x *OneOutInfererForecasterValues) Reset() {
-	*x = OneOutInfererForecasterValues{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_reputer_proto_msgTypes[2]
+func (x *GroundTruth) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_reputer_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *OneOutInfererForecasterValues) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_GroundTruth_messageType fastReflection_GroundTruth_messageType
+var _ protoreflect.MessageType = fastReflection_GroundTruth_messageType{}
 
-func (*OneOutInfererForecasterValues) ProtoMessage() {}
+type fastReflection_GroundTruth_messageType struct{}
 
-// Deprecated: Use OneOutInfererForecasterValues.ProtoReflect.Descriptor instead.
-func (*OneOutInfererForecasterValues) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_reputer_proto_rawDescGZIP(), []int{2}
+func (x fastReflection_GroundTruth_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GroundTruth)(nil)
 }
-
-func (x *OneOutInfererForecasterValues) GetForecaster() string {
-	if x != nil {
-		return x.Forecaster
-	}
-	return ""
+func (x fastReflection_GroundTruth_messageType) New() protoreflect.Message {
+	return new(fastReflection_GroundTruth)
 }
-
-func (x *OneOutInfererForecasterValues) GetOneOutInfererValues() []*WithheldWorkerAttributedValue {
-	if x != nil {
-		return x.OneOutInfererValues
-	}
-	return nil
+func (x fastReflection_GroundTruth_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GroundTruth
 }
 
-// These losses and inferences are calculated per reputer or by the network
-// The `m`s in the comments below exist for when they're made by reputers,
-// but they may be absent implying that they were made by the network
-type ValueBundle struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	TopicId             uint64               `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	ReputerRequestNonce *ReputerRequestNonce `protobuf:"bytes,2,opt,name=reputer_request_nonce,json=reputerRequestNonce,proto3" json:"reputer_request_nonce,omitempty"`
-	Reputer             string               `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
-	ExtraData           []byte               `protobuf:"bytes,4,opt,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
-	// R_im || log10 L_im || I_i
-	CombinedValue string `protobuf:"bytes,5,opt,name=combined_value,json=combinedValue,proto3" json:"combined_value,omitempty"`
-	// R_ijm || log10 L_ijm || I_ij
-	InfererValues []*WorkerAttributedValue `protobuf:"bytes,6,rep,name=inferer_values,json=infererValues,proto3" json:"inferer_values,omitempty"`
-	// R_ikm || log10 L_ikm || I_ik
-	ForecasterValues []*WorkerAttributedValue `protobuf:"bytes,7,rep,name=forecaster_values,json=forecasterValues,proto3" json:"forecaster_values,omitempty"`
-	// R^-_im  || log10 L^-_im || I^-_i
-	NaiveValue string `protobuf:"bytes,8,opt,name=naive_value,json=naiveValue,proto3" json:"naive_value,omitempty"`
-	// Note: forecast-implied inferences are recomputed for each left-out
-	// inference
-	OneOutInfererValues []*WithheldWorkerAttributedValue `protobuf:"bytes,9,rep,name=one_out_inferer_values,json=oneOutInfererValues,proto3" json:"one_out_inferer_values,omitempty"`
-	// R^-_ilm || log10 L^-_ilm || I^-_il where l = any k
-	OneOutForecasterValues []*WithheldWorkerAttributedValue `protobuf:"bytes,10,rep,name=one_out_forecaster_values,json=oneOutForecasterValues,proto3" json:"one_out_forecaster_values,omitempty"`
-	// R^+_ikm || log10 L^+_ikm || I^+_ik
-	// aka one_in_forecaster_values because equivalent to using only one
-	// forecast-implied inference
-	OneInForecasterValues []*WorkerAttributedValue `protobuf:"bytes,11,rep,name=one_in_forecaster_values,json=oneInForecasterValues,proto3" json:"one_in_forecaster_values,omitempty"`
-	// R^-_ilm || log10 L^-_ilm || I^-_il where l = any j
-	// log10 L^-_j'ikm || I^-_j'ik
-	OneOutInfererForecasterValues []*OneOutInfererForecasterValues `protobuf:"bytes,12,rep,name=one_out_inferer_forecaster_values,json=oneOutInfererForecasterValues,proto3" json:"one_out_inferer_forecaster_values,omitempty"`
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GroundTruth) Descriptor() protoreflect.MessageDescriptor {
+	return md_GroundTruth
 }
 
-func (x *ValueBundle) Reset() {
-	*x = ValueBundle{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_reputer_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GroundTruth) Type() protoreflect.MessageType {
+	return _fastReflection_GroundTruth_messageType
 }
 
-func (x *ValueBundle) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GroundTruth) New() protoreflect.Message {
+	return new(fastReflection_GroundTruth)
 }
 
-func (*ValueBundle) ProtoMessage() {}
-
-// Deprecated: Use ValueBundle.ProtoReflect.Descriptor instead.
-func (*ValueBundle) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_reputer_proto_rawDescGZIP(), []int{3}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GroundTruth) Interface() protoreflect.ProtoMessage {
+	return (*GroundTruth)(x)
 }
 
-func (x *ValueBundle) GetTopicId() uint64 {
-	if x != nil {
-		return x.TopicId
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GroundTruth) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_GroundTruth_topic_id, value) {
+			return
+		}
 	}
-	return 0
-}
-
-func (x *ValueBundle) GetReputerRequestNonce() *ReputerRequestNonce {
-	if x != nil {
-		return x.ReputerRequestNonce
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_GroundTruth_block_height, value) {
+			return
+		}
 	}
-	return nil
-}
-
-func (x *ValueBundle) GetReputer() string {
-	if x != nil {
-		return x.Reputer
+	if x.Reputer != "" {
+		value := protoreflect.ValueOfString(x.Reputer)
+		if !f(fd_GroundTruth_reputer, value) {
+			return
+		}
+	}
+	if len(x.Values) != 0 {
+		value := protoreflect.ValueOfList(&_GroundTruth_4_list{list: &x.Values})
+		if !f(fd_GroundTruth_values, value) {
+			return
+		}
 	}
-	return ""
 }
 
-func (x *ValueBundle) GetExtraData() []byte {
-	if x != nil {
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GroundTruth) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.GroundTruth.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.GroundTruth.block_height":
+		return x.BlockHeight != int64(0)
+	case "emissions.v3.GroundTruth.reputer":
+		return x.Reputer != ""
+	case "emissions.v3.GroundTruth.values":
+		return len(x.Values) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.GroundTruth"))
+		}
+		panic(fmt.Errorf("message emissions.v3.GroundTruth does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GroundTruth) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.GroundTruth.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.GroundTruth.block_height":
+		x.BlockHeight = int64(0)
+	case "emissions.v3.GroundTruth.reputer":
+		x.Reputer = ""
+	case "emissions.v3.GroundTruth.values":
+		x.Values = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.GroundTruth"))
+		}
+		panic(fmt.Errorf("message emissions.v3.GroundTruth does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GroundTruth) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.GroundTruth.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.GroundTruth.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.GroundTruth.reputer":
+		value := x.Reputer
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.GroundTruth.values":
+		if len(x.Values) == 0 {
+			return protoreflect.ValueOfList(&_GroundTruth_4_list{})
+		}
+		listValue := &_GroundTruth_4_list{list: &x.Values}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.GroundTruth"))
+		}
+		panic(fmt.Errorf("message emissions.v3.GroundTruth does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GroundTruth) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.GroundTruth.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.GroundTruth.block_height":
+		x.BlockHeight = value.Int()
+	case "emissions.v3.GroundTruth.reputer":
+		x.Reputer = value.Interface().(string)
+	case "emissions.v3.GroundTruth.values":
+		lv := value.List()
+		clv := lv.(*_GroundTruth_4_list)
+		x.Values = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.GroundTruth"))
+		}
+		panic(fmt.Errorf("message emissions.v3.GroundTruth does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GroundTruth) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.GroundTruth.values":
+		if x.Values == nil {
+			x.Values = []string{}
+		}
+		value := &_GroundTruth_4_list{list: &x.Values}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.GroundTruth.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.GroundTruth is not mutable"))
+	case "emissions.v3.GroundTruth.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v3.GroundTruth is not mutable"))
+	case "emissions.v3.GroundTruth.reputer":
+		panic(fmt.Errorf("field reputer of message emissions.v3.GroundTruth is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.GroundTruth"))
+		}
+		panic(fmt.Errorf("message emissions.v3.GroundTruth does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GroundTruth) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.GroundTruth.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.GroundTruth.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.GroundTruth.reputer":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.GroundTruth.values":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GroundTruth_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.GroundTruth"))
+		}
+		panic(fmt.Errorf("message emissions.v3.GroundTruth does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GroundTruth) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.GroundTruth", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GroundTruth) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GroundTruth) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GroundTruth) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GroundTruth) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GroundTruth)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.Reputer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Values) > 0 {
+			for _, s := range x.Values {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GroundTruth)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Values) > 0 {
+			for iNdEx := len(x.Values) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Values[iNdEx])
+				copy(dAtA[i:], x.Values[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Values[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Reputer) > 0 {
+			i -= len(x.Reputer)
+			copy(dAtA[i:], x.Reputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reputer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GroundTruth)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroundTruth: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroundTruth: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Values = append(x.Values, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: emissions/v3/reputer.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WorkerAttributedValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Worker string `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"` // worker who created the value
+	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// per-component values for topics synthesized per component
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *WorkerAttributedValue) Reset() {
+	*x = WorkerAttributedValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_reputer_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerAttributedValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerAttributedValue) ProtoMessage() {}
+
+// Deprecated: Use WorkerAttributedValue.ProtoReflect.Descriptor instead.
+func (*WorkerAttributedValue) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_reputer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WorkerAttributedValue) GetWorker() string {
+	if x != nil {
+		return x.Worker
+	}
+	return ""
+}
+
+func (x *WorkerAttributedValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *WorkerAttributedValue) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type WithheldWorkerAttributedValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Worker string `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
+	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// per-component values for topics synthesized per component
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *WithheldWorkerAttributedValue) Reset() {
+	*x = WithheldWorkerAttributedValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_reputer_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithheldWorkerAttributedValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithheldWorkerAttributedValue) ProtoMessage() {}
+
+// Deprecated: Use WithheldWorkerAttributedValue.ProtoReflect.Descriptor instead.
+func (*WithheldWorkerAttributedValue) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_reputer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WithheldWorkerAttributedValue) GetWorker() string {
+	if x != nil {
+		return x.Worker
+	}
+	return ""
+}
+
+func (x *WithheldWorkerAttributedValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *WithheldWorkerAttributedValue) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type OneOutInfererForecasterValues struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Forecaster          string                           `protobuf:"bytes,3,opt,name=forecaster,proto3" json:"forecaster,omitempty"`
+	OneOutInfererValues []*WithheldWorkerAttributedValue `protobuf:"bytes,4,rep,name=one_out_inferer_values,json=oneOutInfererValues,proto3" json:"one_out_inferer_values,omitempty"`
+}
+
+func (x *OneOutInfererForecasterValues) Reset() {
+	*x = OneOutInfererForecasterValues{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_reputer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OneOutInfererForecasterValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneOutInfererForecasterValues) ProtoMessage() {}
+
+// Deprecated: Use OneOutInfererForecasterValues.ProtoReflect.Descriptor instead.
+func (*OneOutInfererForecasterValues) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_reputer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OneOutInfererForecasterValues) GetForecaster() string {
+	if x != nil {
+		return x.Forecaster
+	}
+	return ""
+}
+
+func (x *OneOutInfererForecasterValues) GetOneOutInfererValues() []*WithheldWorkerAttributedValue {
+	if x != nil {
+		return x.OneOutInfererValues
+	}
+	return nil
+}
+
+// These losses and inferences are calculated per reputer or by the network
+// The `m`s in the comments below exist for when they're made by reputers,
+// but they may be absent implying that they were made by the network
+type ValueBundle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId             uint64               `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ReputerRequestNonce *ReputerRequestNonce `protobuf:"bytes,2,opt,name=reputer_request_nonce,json=reputerRequestNonce,proto3" json:"reputer_request_nonce,omitempty"`
+	Reputer             string               `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	ExtraData           []byte               `protobuf:"bytes,4,opt,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
+	// R_im || log10 L_im || I_i
+	CombinedValue string `protobuf:"bytes,5,opt,name=combined_value,json=combinedValue,proto3" json:"combined_value,omitempty"`
+	// R_ijm || log10 L_ijm || I_ij
+	InfererValues []*WorkerAttributedValue `protobuf:"bytes,6,rep,name=inferer_values,json=infererValues,proto3" json:"inferer_values,omitempty"`
+	// R_ikm || log10 L_ikm || I_ik
+	ForecasterValues []*WorkerAttributedValue `protobuf:"bytes,7,rep,name=forecaster_values,json=forecasterValues,proto3" json:"forecaster_values,omitempty"`
+	// R^-_im  || log10 L^-_im || I^-_i
+	NaiveValue string `protobuf:"bytes,8,opt,name=naive_value,json=naiveValue,proto3" json:"naive_value,omitempty"`
+	// Note: forecast-implied inferences are recomputed for each left-out
+	// inference
+	OneOutInfererValues []*WithheldWorkerAttributedValue `protobuf:"bytes,9,rep,name=one_out_inferer_values,json=oneOutInfererValues,proto3" json:"one_out_inferer_values,omitempty"`
+	// R^-_ilm || log10 L^-_ilm || I^-_il where l = any k
+	OneOutForecasterValues []*WithheldWorkerAttributedValue `protobuf:"bytes,10,rep,name=one_out_forecaster_values,json=oneOutForecasterValues,proto3" json:"one_out_forecaster_values,omitempty"`
+	// R^+_ikm || log10 L^+_ikm || I^+_ik
+	// aka one_in_forecaster_values because equivalent to using only one
+	// forecast-implied inference
+	OneInForecasterValues []*WorkerAttributedValue `protobuf:"bytes,11,rep,name=one_in_forecaster_values,json=oneInForecasterValues,proto3" json:"one_in_forecaster_values,omitempty"`
+	// R^-_ilm || log10 L^-_ilm || I^-_il where l = any j
+	// log10 L^-_j'ikm || I^-_j'ik
+	OneOutInfererForecasterValues []*OneOutInfererForecasterValues `protobuf:"bytes,12,rep,name=one_out_inferer_forecaster_values,json=oneOutInfererForecasterValues,proto3" json:"one_out_inferer_forecaster_values,omitempty"`
+	// per-component counterparts of combined_value and naive_value for topics
+	// with a vector output
+	CombinedValues []string `protobuf:"bytes,13,rep,name=combined_values,json=combinedValues,proto3" json:"combined_values,omitempty"`
+	NaiveValues    []string `protobuf:"bytes,14,rep,name=naive_values,json=naiveValues,proto3" json:"naive_values,omitempty"`
+}
+
+func (x *ValueBundle) Reset() {
+	*x = ValueBundle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_reputer_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValueBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueBundle) ProtoMessage() {}
+
+// Deprecated: Use ValueBundle.ProtoReflect.Descriptor instead.
+func (*ValueBundle) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_reputer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ValueBundle) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *ValueBundle) GetReputerRequestNonce() *ReputerRequestNonce {
+	if x != nil {
+		return x.ReputerRequestNonce
+	}
+	return nil
+}
+
+func (x *ValueBundle) GetReputer() string {
+	if x != nil {
+		return x.Reputer
+	}
+	return ""
+}
+
+func (x *ValueBundle) GetExtraData() []byte {
+	if x != nil {
 		return x.ExtraData
 	}
 	return nil
@@ -4447,6 +5605,20 @@ func (x *ValueBundle) GetOneOutInfererForecasterValues() []*OneOutInfererForecas
 	return nil
 }
 
+func (x *ValueBundle) GetCombinedValues() []string {
+	if x != nil {
+		return x.CombinedValues
+	}
+	return nil
+}
+
+func (x *ValueBundle) GetNaiveValues() []string {
+	if x != nil {
+		return x.NaiveValues
+	}
+	return nil
+}
+
 // For when the bundle is computed on a per-reputer basis (ie.. if there is an
 // index `m` in the above)
 type ReputerValueBundle struct {
@@ -4535,6 +5707,68 @@ func (x *ReputerValueBundles) GetReputerValueBundles() []*ReputerValueBundle {
 	return nil
 }
 
+// Ground truth of a topic for the inferences made at a nonce. Holds one value
+// per output component for vector topics, and a single value otherwise.
+// The reputer is empty for the stake-weighted consensus of all reputers
+type GroundTruth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId     uint64   `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64    `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Reputer     string   `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Values      []string `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GroundTruth) Reset() {
+	*x = GroundTruth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_reputer_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroundTruth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroundTruth) ProtoMessage() {}
+
+// Deprecated: Use GroundTruth.ProtoReflect.Descriptor instead.
+func (*GroundTruth) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_reputer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GroundTruth) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *GroundTruth) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *GroundTruth) GetReputer() string {
+	if x != nil {
+		return x.Reputer
+	}
+	return ""
+}
+
+func (x *GroundTruth) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 var File_emissions_v3_reputer_proto protoreflect.FileDescriptor
 
 var file_emissions_v3_reputer_proto_rawDesc = []byte{
@@ -4543,7 +5777,7 @@ var file_emissions_v3_reputer_proto_rawDesc = []byte{
 	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x1a, 0x18, 0x65, 0x6d, 0x69, 0x73,
 	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x2f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x01, 0x0a, 0x15, 0x57,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5, 0x01, 0x0a, 0x15, 0x57,
 	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56,
 	0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x05,
@@ -4551,86 +5785,108 @@ var file_emissions_v3_reputer_proto_rawDesc = []byte{
 	0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
 	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
 	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
-	0x01, 0x22, 0x8c, 0x01, 0x0a, 0x1d, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57, 0x6f,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xdd, 0x01, 0x0a, 0x1d, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xa7, 0x01, 0x0a, 0x1d, 0x4f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x60, 0x0a, 0x16, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x5f,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x33, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x13, 0x6f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xf3, 0x08, 0x0a,
+	0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x55, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x5e, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69,
+	0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e,
+	0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x57, 0x6f,
 	0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00,
+	0x6c, 0x75, 0x65, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x0b, 0x6e, 0x61, 0x69, 0x76, 0x65, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00,
 	0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
 	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c,
 	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
-	0x22, 0xa7, 0x01, 0x0a, 0x1d, 0x4f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x12, 0x60, 0x0a, 0x16, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x69, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
-	0x33, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x13, 0x6f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb5, 0x07, 0x0a, 0x0b, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x55, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
-	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
-	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x5e, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65,
-	0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8,
-	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x0d, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x12, 0x50, 0x0a, 0x11, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x44, 0x65, 0x63, 0x52, 0x0a, 0x6e, 0x61, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x60, 0x0a, 0x16, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x57,
+	0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x6f, 0x6e,
+	0x65, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x66, 0x0a, 0x19, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x66, 0x6f, 0x72,
+	0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x33, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57, 0x6f, 0x72, 0x6b,
 	0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x0b, 0x6e, 0x61, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
-	0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c,
-	0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
-	0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65,
-	0x63, 0x52, 0x0a, 0x6e, 0x61, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x60, 0x0a,
-	0x16, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
-	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x57, 0x69, 0x74,
-	0x68, 0x68, 0x65, 0x6c, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x6f, 0x6e, 0x65, 0x4f,
-	0x75, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
-	0x66, 0x0a, 0x19, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x65, 0x52, 0x16, 0x6f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x18, 0x6f, 0x6e, 0x65,
+	0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x15, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x75, 0x0a, 0x21, 0x6f, 0x6e, 0x65, 0x5f, 0x6f,
+	0x75, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
-	0x33, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x16, 0x6f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65,
-	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x18, 0x6f, 0x6e, 0x65, 0x5f, 0x69,
-	0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15,
-	0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x75, 0x0a, 0x21, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x75, 0x74,
-	0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
-	0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e,
-	0x4f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x46, 0x6f, 0x72,
-	0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x1d, 0x6f,
-	0x6e, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65,
-	0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0,
+	0x33, 0x2e, 0x4f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x46,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
+	0x1d, 0x6f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x46, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x60,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x5a, 0x0a, 0x0c, 0x6e, 0x61, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x0b, 0x6e, 0x61, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0,
 	0x1f, 0x01, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61,
 	0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x76, 0x61, 0x6c,
 	0x75, 0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
@@ -4647,19 +5903,31 @@ var file_emissions_v3_reputer_proto_rawDesc = []byte{
 	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
 	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x13, 0x72, 0x65, 0x70,
 	0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73,
-	0x42, 0xc2, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x76, 0x33, 0x42, 0x0c, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f,
-	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x76, 0x33, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x33, 0xca, 0x02, 0x0c, 0x45, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x3a, 0x3a, 0x56, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0xb6, 0x01, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x75, 0x74, 0x68,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x42, 0xc2, 0x01, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x42, 0x0c,
+	0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x76, 0x33, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x33, 0xa2,
+	0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x56, 0x33, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x5c, 0x56, 0x33, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c,
+	0x56, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x33, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4674,7 +5942,7 @@ func file_emissions_v3_reputer_proto_rawDescGZIP() []byte {
 	return file_emissions_v3_reputer_proto_rawDescData
 }
 
-var file_emissions_v3_reputer_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_emissions_v3_reputer_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_emissions_v3_reputer_proto_goTypes = []interface{}{
 	(*WorkerAttributedValue)(nil),         // 0: emissions.v3.WorkerAttributedValue
 	(*WithheldWorkerAttributedValue)(nil), // 1: emissions.v3.WithheldWorkerAttributedValue
@@ -4682,11 +5950,12 @@ var file_emissions_v3_reputer_proto_goTypes = []interface{}{
 	(*ValueBundle)(nil),                   // 3: emissions.v3.ValueBundle
 	(*ReputerValueBundle)(nil),            // 4: emissions.v3.ReputerValueBundle
 	(*ReputerValueBundles)(nil),           // 5: emissions.v3.ReputerValueBundles
-	(*ReputerRequestNonce)(nil),           // 6: emissions.v3.ReputerRequestNonce
+	(*GroundTruth)(nil),                   // 6: emissions.v3.GroundTruth
+	(*ReputerRequestNonce)(nil),           // 7: emissions.v3.ReputerRequestNonce
 }
 var file_emissions_v3_reputer_proto_depIdxs = []int32{
 	1,  // 0: emissions.v3.OneOutInfererForecasterValues.one_out_inferer_values:type_name -> emissions.v3.WithheldWorkerAttributedValue
-	6,  // 1: emissions.v3.ValueBundle.reputer_request_nonce:type_name -> emissions.v3.ReputerRequestNonce
+	7,  // 1: emissions.v3.ValueBundle.reputer_request_nonce:type_name -> emissions.v3.ReputerRequestNonce
 	0,  // 2: emissions.v3.ValueBundle.inferer_values:type_name -> emissions.v3.WorkerAttributedValue
 	0,  // 3: emissions.v3.ValueBundle.forecaster_values:type_name -> emissions.v3.WorkerAttributedValue
 	1,  // 4: emissions.v3.ValueBundle.one_out_inferer_values:type_name -> emissions.v3.WithheldWorkerAttributedValue
@@ -4781,6 +6050,18 @@ func file_emissions_v3_reputer_proto_init() {
 				return nil
 			}
 		}
+		file_emissions_v3_reputer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroundTruth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4788,7 +6069,7 @@ func file_emissions_v3_reputer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v3_reputer_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
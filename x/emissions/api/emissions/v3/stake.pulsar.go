@@ -3092,27 +3092,31 @@ func (x *fastReflection_DelegateStakeRemovalInfo) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_DelegatorInfo             protoreflect.MessageDescriptor
-	fd_DelegatorInfo_amount      protoreflect.FieldDescriptor
-	fd_DelegatorInfo_reward_debt protoreflect.FieldDescriptor
+	md_WorkerBond                       protoreflect.MessageDescriptor
+	fd_WorkerBond_topic_id              protoreflect.FieldDescriptor
+	fd_WorkerBond_worker                protoreflect.FieldDescriptor
+	fd_WorkerBond_amount                protoreflect.FieldDescriptor
+	fd_WorkerBond_liveness_block_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v3_stake_proto_init()
-	md_DelegatorInfo = File_emissions_v3_stake_proto.Messages().ByName("DelegatorInfo")
-	fd_DelegatorInfo_amount = md_DelegatorInfo.Fields().ByName("amount")
-	fd_DelegatorInfo_reward_debt = md_DelegatorInfo.Fields().ByName("reward_debt")
+	md_WorkerBond = File_emissions_v3_stake_proto.Messages().ByName("WorkerBond")
+	fd_WorkerBond_topic_id = md_WorkerBond.Fields().ByName("topic_id")
+	fd_WorkerBond_worker = md_WorkerBond.Fields().ByName("worker")
+	fd_WorkerBond_amount = md_WorkerBond.Fields().ByName("amount")
+	fd_WorkerBond_liveness_block_height = md_WorkerBond.Fields().ByName("liveness_block_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_DelegatorInfo)(nil)
+var _ protoreflect.Message = (*fastReflection_WorkerBond)(nil)
 
-type fastReflection_DelegatorInfo DelegatorInfo
+type fastReflection_WorkerBond WorkerBond
 
-func (x *DelegatorInfo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DelegatorInfo)(x)
+func (x *WorkerBond) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WorkerBond)(x)
 }
 
-func (x *DelegatorInfo) slowProtoReflect() protoreflect.Message {
+func (x *WorkerBond) slowProtoReflect() protoreflect.Message {
 	mi := &file_emissions_v3_stake_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3124,43 +3128,43 @@ func (x *DelegatorInfo) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DelegatorInfo_messageType fastReflection_DelegatorInfo_messageType
-var _ protoreflect.MessageType = fastReflection_DelegatorInfo_messageType{}
+var _fastReflection_WorkerBond_messageType fastReflection_WorkerBond_messageType
+var _ protoreflect.MessageType = fastReflection_WorkerBond_messageType{}
 
-type fastReflection_DelegatorInfo_messageType struct{}
+type fastReflection_WorkerBond_messageType struct{}
 
-func (x fastReflection_DelegatorInfo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DelegatorInfo)(nil)
+func (x fastReflection_WorkerBond_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WorkerBond)(nil)
 }
-func (x fastReflection_DelegatorInfo_messageType) New() protoreflect.Message {
-	return new(fastReflection_DelegatorInfo)
+func (x fastReflection_WorkerBond_messageType) New() protoreflect.Message {
+	return new(fastReflection_WorkerBond)
 }
-func (x fastReflection_DelegatorInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DelegatorInfo
+func (x fastReflection_WorkerBond_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WorkerBond
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DelegatorInfo) Descriptor() protoreflect.MessageDescriptor {
-	return md_DelegatorInfo
+func (x *fastReflection_WorkerBond) Descriptor() protoreflect.MessageDescriptor {
+	return md_WorkerBond
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DelegatorInfo) Type() protoreflect.MessageType {
-	return _fastReflection_DelegatorInfo_messageType
+func (x *fastReflection_WorkerBond) Type() protoreflect.MessageType {
+	return _fastReflection_WorkerBond_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DelegatorInfo) New() protoreflect.Message {
-	return new(fastReflection_DelegatorInfo)
+func (x *fastReflection_WorkerBond) New() protoreflect.Message {
+	return new(fastReflection_WorkerBond)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DelegatorInfo) Interface() protoreflect.ProtoMessage {
-	return (*DelegatorInfo)(x)
+func (x *fastReflection_WorkerBond) Interface() protoreflect.ProtoMessage {
+	return (*WorkerBond)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3168,16 +3172,28 @@ func (x *fastReflection_DelegatorInfo) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DelegatorInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_WorkerBond) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_WorkerBond_topic_id, value) {
+			return
+		}
+	}
+	if x.Worker != "" {
+		value := protoreflect.ValueOfString(x.Worker)
+		if !f(fd_WorkerBond_worker, value) {
+			return
+		}
+	}
 	if x.Amount != "" {
 		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_DelegatorInfo_amount, value) {
+		if !f(fd_WorkerBond_amount, value) {
 			return
 		}
 	}
-	if x.RewardDebt != "" {
-		value := protoreflect.ValueOfString(x.RewardDebt)
-		if !f(fd_DelegatorInfo_reward_debt, value) {
+	if x.LivenessBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LivenessBlockHeight)
+		if !f(fd_WorkerBond_liveness_block_height, value) {
 			return
 		}
 	}
@@ -3194,17 +3210,21 @@ func (x *fastReflection_DelegatorInfo) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DelegatorInfo) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_WorkerBond) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v3.DelegatorInfo.amount":
+	case "emissions.v3.WorkerBond.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.WorkerBond.worker":
+		return x.Worker != ""
+	case "emissions.v3.WorkerBond.amount":
 		return x.Amount != ""
-	case "emissions.v3.DelegatorInfo.reward_debt":
-		return x.RewardDebt != ""
+	case "emissions.v3.WorkerBond.liveness_block_height":
+		return x.LivenessBlockHeight != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.DelegatorInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerBond"))
 		}
-		panic(fmt.Errorf("message emissions.v3.DelegatorInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.WorkerBond does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3214,17 +3234,21 @@ func (x *fastReflection_DelegatorInfo) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegatorInfo) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_WorkerBond) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v3.DelegatorInfo.amount":
+	case "emissions.v3.WorkerBond.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.WorkerBond.worker":
+		x.Worker = ""
+	case "emissions.v3.WorkerBond.amount":
 		x.Amount = ""
-	case "emissions.v3.DelegatorInfo.reward_debt":
-		x.RewardDebt = ""
+	case "emissions.v3.WorkerBond.liveness_block_height":
+		x.LivenessBlockHeight = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.DelegatorInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerBond"))
 		}
-		panic(fmt.Errorf("message emissions.v3.DelegatorInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.WorkerBond does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3234,19 +3258,25 @@ func (x *fastReflection_DelegatorInfo) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DelegatorInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WorkerBond) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v3.DelegatorInfo.amount":
-		value := x.Amount
+	case "emissions.v3.WorkerBond.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.WorkerBond.worker":
+		value := x.Worker
 		return protoreflect.ValueOfString(value)
-	case "emissions.v3.DelegatorInfo.reward_debt":
-		value := x.RewardDebt
+	case "emissions.v3.WorkerBond.amount":
+		value := x.Amount
 		return protoreflect.ValueOfString(value)
+	case "emissions.v3.WorkerBond.liveness_block_height":
+		value := x.LivenessBlockHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.DelegatorInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerBond"))
 		}
-		panic(fmt.Errorf("message emissions.v3.DelegatorInfo does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v3.WorkerBond does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3260,17 +3290,21 @@ func (x *fastReflection_DelegatorInfo) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegatorInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_WorkerBond) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v3.DelegatorInfo.amount":
+	case "emissions.v3.WorkerBond.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.WorkerBond.worker":
+		x.Worker = value.Interface().(string)
+	case "emissions.v3.WorkerBond.amount":
 		x.Amount = value.Interface().(string)
-	case "emissions.v3.DelegatorInfo.reward_debt":
-		x.RewardDebt = value.Interface().(string)
+	case "emissions.v3.WorkerBond.liveness_block_height":
+		x.LivenessBlockHeight = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.DelegatorInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerBond"))
 		}
-		panic(fmt.Errorf("message emissions.v3.DelegatorInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.WorkerBond does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3284,44 +3318,52 @@ func (x *fastReflection_DelegatorInfo) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegatorInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WorkerBond) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.DelegatorInfo.amount":
-		panic(fmt.Errorf("field amount of message emissions.v3.DelegatorInfo is not mutable"))
-	case "emissions.v3.DelegatorInfo.reward_debt":
-		panic(fmt.Errorf("field reward_debt of message emissions.v3.DelegatorInfo is not mutable"))
+	case "emissions.v3.WorkerBond.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.WorkerBond is not mutable"))
+	case "emissions.v3.WorkerBond.worker":
+		panic(fmt.Errorf("field worker of message emissions.v3.WorkerBond is not mutable"))
+	case "emissions.v3.WorkerBond.amount":
+		panic(fmt.Errorf("field amount of message emissions.v3.WorkerBond is not mutable"))
+	case "emissions.v3.WorkerBond.liveness_block_height":
+		panic(fmt.Errorf("field liveness_block_height of message emissions.v3.WorkerBond is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.DelegatorInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerBond"))
 		}
-		panic(fmt.Errorf("message emissions.v3.DelegatorInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.WorkerBond does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DelegatorInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WorkerBond) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.DelegatorInfo.amount":
+	case "emissions.v3.WorkerBond.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.WorkerBond.worker":
 		return protoreflect.ValueOfString("")
-	case "emissions.v3.DelegatorInfo.reward_debt":
+	case "emissions.v3.WorkerBond.amount":
 		return protoreflect.ValueOfString("")
+	case "emissions.v3.WorkerBond.liveness_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.DelegatorInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerBond"))
 		}
-		panic(fmt.Errorf("message emissions.v3.DelegatorInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.WorkerBond does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DelegatorInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_WorkerBond) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.DelegatorInfo", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.WorkerBond", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3329,7 +3371,7 @@ func (x *fastReflection_DelegatorInfo) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DelegatorInfo) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_WorkerBond) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3340,7 +3382,7 @@ func (x *fastReflection_DelegatorInfo) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegatorInfo) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_WorkerBond) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3352,7 +3394,7 @@ func (x *fastReflection_DelegatorInfo) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DelegatorInfo) IsValid() bool {
+func (x *fastReflection_WorkerBond) IsValid() bool {
 	return x != nil
 }
 
@@ -3362,9 +3404,9 @@ func (x *fastReflection_DelegatorInfo) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DelegatorInfo) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_WorkerBond) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DelegatorInfo)
+		x := input.Message.Interface().(*WorkerBond)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3376,14 +3418,20 @@ func (x *fastReflection_DelegatorInfo) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Amount)
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		l = len(x.Worker)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.RewardDebt)
+		l = len(x.Amount)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.LivenessBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LivenessBlockHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3394,7 +3442,7 @@ func (x *fastReflection_DelegatorInfo) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DelegatorInfo)
+		x := input.Message.Interface().(*WorkerBond)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3413,19 +3461,29 @@ func (x *fastReflection_DelegatorInfo) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RewardDebt) > 0 {
-			i -= len(x.RewardDebt)
-			copy(dAtA[i:], x.RewardDebt)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardDebt)))
+		if x.LivenessBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LivenessBlockHeight))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x20
 		}
 		if len(x.Amount) > 0 {
 			i -= len(x.Amount)
 			copy(dAtA[i:], x.Amount)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+		if len(x.Worker) > 0 {
+			i -= len(x.Worker)
+			copy(dAtA[i:], x.Worker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Worker)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3438,7 +3496,7 @@ func (x *fastReflection_DelegatorInfo) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DelegatorInfo)
+		x := input.Message.Interface().(*WorkerBond)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3470,15 +3528,34 @@ func (x *fastReflection_DelegatorInfo) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatorInfo: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerBond: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerBond: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3506,11 +3583,11 @@ func (x *fastReflection_DelegatorInfo) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
+				x.Worker = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardDebt", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3538,8 +3615,27 @@ func (x *fastReflection_DelegatorInfo) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RewardDebt = string(dAtA[iNdEx:postIndex])
+				x.Amount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LivenessBlockHeight", wireType)
+				}
+				x.LivenessBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LivenessBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3575,388 +3671,6396 @@ func (x *fastReflection_DelegatorInfo) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: emissions/v3/stake.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_RewardEscrow                protoreflect.MessageDescriptor
+	fd_RewardEscrow_topic_id       protoreflect.FieldDescriptor
+	fd_RewardEscrow_address        protoreflect.FieldDescriptor
+	fd_RewardEscrow_amount         protoreflect.FieldDescriptor
+	fd_RewardEscrow_claimed        protoreflect.FieldDescriptor
+	fd_RewardEscrow_block_escrowed protoreflect.FieldDescriptor
+	fd_RewardEscrow_block_vested   protoreflect.FieldDescriptor
 )
 
-// Bad naming convention, only used in StakeRemovals
-// DEPRECATED in ConsensusVersion = 2
-// TO BE DELETED in ConsensusVersion = 3
-type StakePlacement struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_emissions_v3_stake_proto_init()
+	md_RewardEscrow = File_emissions_v3_stake_proto.Messages().ByName("RewardEscrow")
+	fd_RewardEscrow_topic_id = md_RewardEscrow.Fields().ByName("topic_id")
+	fd_RewardEscrow_address = md_RewardEscrow.Fields().ByName("address")
+	fd_RewardEscrow_amount = md_RewardEscrow.Fields().ByName("amount")
+	fd_RewardEscrow_claimed = md_RewardEscrow.Fields().ByName("claimed")
+	fd_RewardEscrow_block_escrowed = md_RewardEscrow.Fields().ByName("block_escrowed")
+	fd_RewardEscrow_block_vested = md_RewardEscrow.Fields().ByName("block_vested")
+}
 
-	BlockRemovalStarted int64  `protobuf:"varint,1,opt,name=block_removal_started,json=blockRemovalStarted,proto3" json:"block_removal_started,omitempty"`
-	TopicId             uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Reputer             string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
-	Amount              string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+var _ protoreflect.Message = (*fastReflection_RewardEscrow)(nil)
+
+type fastReflection_RewardEscrow RewardEscrow
+
+func (x *RewardEscrow) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RewardEscrow)(x)
 }
 
-func (x *StakePlacement) Reset() {
-	*x = StakePlacement{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_stake_proto_msgTypes[0]
+func (x *RewardEscrow) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_stake_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *StakePlacement) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_RewardEscrow_messageType fastReflection_RewardEscrow_messageType
+var _ protoreflect.MessageType = fastReflection_RewardEscrow_messageType{}
 
-func (*StakePlacement) ProtoMessage() {}
+type fastReflection_RewardEscrow_messageType struct{}
 
-// Deprecated: Use StakePlacement.ProtoReflect.Descriptor instead.
-func (*StakePlacement) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_RewardEscrow_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RewardEscrow)(nil)
 }
-
-func (x *StakePlacement) GetBlockRemovalStarted() int64 {
-	if x != nil {
-		return x.BlockRemovalStarted
-	}
-	return 0
+func (x fastReflection_RewardEscrow_messageType) New() protoreflect.Message {
+	return new(fastReflection_RewardEscrow)
 }
-
-func (x *StakePlacement) GetTopicId() uint64 {
-	if x != nil {
-		return x.TopicId
-	}
-	return 0
+func (x fastReflection_RewardEscrow_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RewardEscrow
 }
 
-func (x *StakePlacement) GetReputer() string {
-	if x != nil {
-		return x.Reputer
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RewardEscrow) Descriptor() protoreflect.MessageDescriptor {
+	return md_RewardEscrow
 }
 
-func (x *StakePlacement) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RewardEscrow) Type() protoreflect.MessageType {
+	return _fastReflection_RewardEscrow_messageType
 }
 
-// Bad naming convention, only used in StakeRemovals
-// DEPRECATED in ConsensusVersion = 2
-// TO BE DELETED in ConsensusVersion = 3
-type DelegateStakePlacement struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RewardEscrow) New() protoreflect.Message {
+	return new(fastReflection_RewardEscrow)
+}
 
-	BlockRemovalStarted int64  `protobuf:"varint,1,opt,name=block_removal_started,json=blockRemovalStarted,proto3" json:"block_removal_started,omitempty"`
-	TopicId             uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Reputer             string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
-	Delegator           string `protobuf:"bytes,4,opt,name=delegator,proto3" json:"delegator,omitempty"`
-	Amount              string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RewardEscrow) Interface() protoreflect.ProtoMessage {
+	return (*RewardEscrow)(x)
 }
 
-func (x *DelegateStakePlacement) Reset() {
-	*x = DelegateStakePlacement{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_stake_proto_msgTypes[1]
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RewardEscrow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_RewardEscrow_topic_id, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_RewardEscrow_address, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_RewardEscrow_amount, value) {
+			return
+		}
+	}
+	if x.Claimed != "" {
+		value := protoreflect.ValueOfString(x.Claimed)
+		if !f(fd_RewardEscrow_claimed, value) {
+			return
+		}
+	}
+	if x.BlockEscrowed != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockEscrowed)
+		if !f(fd_RewardEscrow_block_escrowed, value) {
+			return
+		}
+	}
+	if x.BlockVested != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockVested)
+		if !f(fd_RewardEscrow_block_vested, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RewardEscrow) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.RewardEscrow.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.RewardEscrow.address":
+		return x.Address != ""
+	case "emissions.v3.RewardEscrow.amount":
+		return x.Amount != ""
+	case "emissions.v3.RewardEscrow.claimed":
+		return x.Claimed != ""
+	case "emissions.v3.RewardEscrow.block_escrowed":
+		return x.BlockEscrowed != int64(0)
+	case "emissions.v3.RewardEscrow.block_vested":
+		return x.BlockVested != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.RewardEscrow"))
+		}
+		panic(fmt.Errorf("message emissions.v3.RewardEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardEscrow) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.RewardEscrow.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.RewardEscrow.address":
+		x.Address = ""
+	case "emissions.v3.RewardEscrow.amount":
+		x.Amount = ""
+	case "emissions.v3.RewardEscrow.claimed":
+		x.Claimed = ""
+	case "emissions.v3.RewardEscrow.block_escrowed":
+		x.BlockEscrowed = int64(0)
+	case "emissions.v3.RewardEscrow.block_vested":
+		x.BlockVested = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.RewardEscrow"))
+		}
+		panic(fmt.Errorf("message emissions.v3.RewardEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RewardEscrow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.RewardEscrow.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.RewardEscrow.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.RewardEscrow.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.RewardEscrow.claimed":
+		value := x.Claimed
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.RewardEscrow.block_escrowed":
+		value := x.BlockEscrowed
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.RewardEscrow.block_vested":
+		value := x.BlockVested
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.RewardEscrow"))
+		}
+		panic(fmt.Errorf("message emissions.v3.RewardEscrow does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardEscrow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.RewardEscrow.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.RewardEscrow.address":
+		x.Address = value.Interface().(string)
+	case "emissions.v3.RewardEscrow.amount":
+		x.Amount = value.Interface().(string)
+	case "emissions.v3.RewardEscrow.claimed":
+		x.Claimed = value.Interface().(string)
+	case "emissions.v3.RewardEscrow.block_escrowed":
+		x.BlockEscrowed = value.Int()
+	case "emissions.v3.RewardEscrow.block_vested":
+		x.BlockVested = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.RewardEscrow"))
+		}
+		panic(fmt.Errorf("message emissions.v3.RewardEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardEscrow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.RewardEscrow.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.RewardEscrow is not mutable"))
+	case "emissions.v3.RewardEscrow.address":
+		panic(fmt.Errorf("field address of message emissions.v3.RewardEscrow is not mutable"))
+	case "emissions.v3.RewardEscrow.amount":
+		panic(fmt.Errorf("field amount of message emissions.v3.RewardEscrow is not mutable"))
+	case "emissions.v3.RewardEscrow.claimed":
+		panic(fmt.Errorf("field claimed of message emissions.v3.RewardEscrow is not mutable"))
+	case "emissions.v3.RewardEscrow.block_escrowed":
+		panic(fmt.Errorf("field block_escrowed of message emissions.v3.RewardEscrow is not mutable"))
+	case "emissions.v3.RewardEscrow.block_vested":
+		panic(fmt.Errorf("field block_vested of message emissions.v3.RewardEscrow is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.RewardEscrow"))
+		}
+		panic(fmt.Errorf("message emissions.v3.RewardEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RewardEscrow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.RewardEscrow.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.RewardEscrow.address":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.RewardEscrow.amount":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.RewardEscrow.claimed":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.RewardEscrow.block_escrowed":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.RewardEscrow.block_vested":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.RewardEscrow"))
+		}
+		panic(fmt.Errorf("message emissions.v3.RewardEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RewardEscrow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.RewardEscrow", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RewardEscrow) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardEscrow) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RewardEscrow) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RewardEscrow) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RewardEscrow)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Claimed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockEscrowed != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockEscrowed))
+		}
+		if x.BlockVested != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockVested))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RewardEscrow)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockVested != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockVested))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.BlockEscrowed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockEscrowed))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Claimed) > 0 {
+			i -= len(x.Claimed)
+			copy(dAtA[i:], x.Claimed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Claimed)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RewardEscrow)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardEscrow: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Claimed = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockEscrowed", wireType)
+				}
+				x.BlockEscrowed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockEscrowed |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockVested", wireType)
+				}
+				x.BlockVested = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockVested |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueuedRewardPayout                 protoreflect.MessageDescriptor
+	fd_QueuedRewardPayout_id              protoreflect.FieldDescriptor
+	fd_QueuedRewardPayout_topic_id        protoreflect.FieldDescriptor
+	fd_QueuedRewardPayout_address         protoreflect.FieldDescriptor
+	fd_QueuedRewardPayout_type            protoreflect.FieldDescriptor
+	fd_QueuedRewardPayout_amount          protoreflect.FieldDescriptor
+	fd_QueuedRewardPayout_block_queued    protoreflect.FieldDescriptor
+	fd_QueuedRewardPayout_failed_attempts protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_stake_proto_init()
+	md_QueuedRewardPayout = File_emissions_v3_stake_proto.Messages().ByName("QueuedRewardPayout")
+	fd_QueuedRewardPayout_id = md_QueuedRewardPayout.Fields().ByName("id")
+	fd_QueuedRewardPayout_topic_id = md_QueuedRewardPayout.Fields().ByName("topic_id")
+	fd_QueuedRewardPayout_address = md_QueuedRewardPayout.Fields().ByName("address")
+	fd_QueuedRewardPayout_type = md_QueuedRewardPayout.Fields().ByName("type")
+	fd_QueuedRewardPayout_amount = md_QueuedRewardPayout.Fields().ByName("amount")
+	fd_QueuedRewardPayout_block_queued = md_QueuedRewardPayout.Fields().ByName("block_queued")
+	fd_QueuedRewardPayout_failed_attempts = md_QueuedRewardPayout.Fields().ByName("failed_attempts")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueuedRewardPayout)(nil)
+
+type fastReflection_QueuedRewardPayout QueuedRewardPayout
+
+func (x *QueuedRewardPayout) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueuedRewardPayout)(x)
+}
+
+func (x *QueuedRewardPayout) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_stake_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueuedRewardPayout_messageType fastReflection_QueuedRewardPayout_messageType
+var _ protoreflect.MessageType = fastReflection_QueuedRewardPayout_messageType{}
+
+type fastReflection_QueuedRewardPayout_messageType struct{}
+
+func (x fastReflection_QueuedRewardPayout_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueuedRewardPayout)(nil)
+}
+func (x fastReflection_QueuedRewardPayout_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueuedRewardPayout)
+}
+func (x fastReflection_QueuedRewardPayout_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueuedRewardPayout
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueuedRewardPayout) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueuedRewardPayout
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueuedRewardPayout) Type() protoreflect.MessageType {
+	return _fastReflection_QueuedRewardPayout_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueuedRewardPayout) New() protoreflect.Message {
+	return new(fastReflection_QueuedRewardPayout)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueuedRewardPayout) Interface() protoreflect.ProtoMessage {
+	return (*QueuedRewardPayout)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueuedRewardPayout) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QueuedRewardPayout_id, value) {
+			return
+		}
+	}
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_QueuedRewardPayout_topic_id, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueuedRewardPayout_address, value) {
+			return
+		}
+	}
+	if x.Type_ != "" {
+		value := protoreflect.ValueOfString(x.Type_)
+		if !f(fd_QueuedRewardPayout_type, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_QueuedRewardPayout_amount, value) {
+			return
+		}
+	}
+	if x.BlockQueued != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockQueued)
+		if !f(fd_QueuedRewardPayout_block_queued, value) {
+			return
+		}
+	}
+	if x.FailedAttempts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FailedAttempts)
+		if !f(fd_QueuedRewardPayout_failed_attempts, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueuedRewardPayout) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.QueuedRewardPayout.id":
+		return x.Id != uint64(0)
+	case "emissions.v3.QueuedRewardPayout.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.QueuedRewardPayout.address":
+		return x.Address != ""
+	case "emissions.v3.QueuedRewardPayout.type":
+		return x.Type_ != ""
+	case "emissions.v3.QueuedRewardPayout.amount":
+		return x.Amount != ""
+	case "emissions.v3.QueuedRewardPayout.block_queued":
+		return x.BlockQueued != int64(0)
+	case "emissions.v3.QueuedRewardPayout.failed_attempts":
+		return x.FailedAttempts != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.QueuedRewardPayout"))
+		}
+		panic(fmt.Errorf("message emissions.v3.QueuedRewardPayout does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueuedRewardPayout) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.QueuedRewardPayout.id":
+		x.Id = uint64(0)
+	case "emissions.v3.QueuedRewardPayout.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.QueuedRewardPayout.address":
+		x.Address = ""
+	case "emissions.v3.QueuedRewardPayout.type":
+		x.Type_ = ""
+	case "emissions.v3.QueuedRewardPayout.amount":
+		x.Amount = ""
+	case "emissions.v3.QueuedRewardPayout.block_queued":
+		x.BlockQueued = int64(0)
+	case "emissions.v3.QueuedRewardPayout.failed_attempts":
+		x.FailedAttempts = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.QueuedRewardPayout"))
+		}
+		panic(fmt.Errorf("message emissions.v3.QueuedRewardPayout does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueuedRewardPayout) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.QueuedRewardPayout.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.QueuedRewardPayout.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.QueuedRewardPayout.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.QueuedRewardPayout.type":
+		value := x.Type_
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.QueuedRewardPayout.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.QueuedRewardPayout.block_queued":
+		value := x.BlockQueued
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.QueuedRewardPayout.failed_attempts":
+		value := x.FailedAttempts
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.QueuedRewardPayout"))
+		}
+		panic(fmt.Errorf("message emissions.v3.QueuedRewardPayout does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueuedRewardPayout) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.QueuedRewardPayout.id":
+		x.Id = value.Uint()
+	case "emissions.v3.QueuedRewardPayout.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.QueuedRewardPayout.address":
+		x.Address = value.Interface().(string)
+	case "emissions.v3.QueuedRewardPayout.type":
+		x.Type_ = value.Interface().(string)
+	case "emissions.v3.QueuedRewardPayout.amount":
+		x.Amount = value.Interface().(string)
+	case "emissions.v3.QueuedRewardPayout.block_queued":
+		x.BlockQueued = value.Int()
+	case "emissions.v3.QueuedRewardPayout.failed_attempts":
+		x.FailedAttempts = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.QueuedRewardPayout"))
+		}
+		panic(fmt.Errorf("message emissions.v3.QueuedRewardPayout does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueuedRewardPayout) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.QueuedRewardPayout.id":
+		panic(fmt.Errorf("field id of message emissions.v3.QueuedRewardPayout is not mutable"))
+	case "emissions.v3.QueuedRewardPayout.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.QueuedRewardPayout is not mutable"))
+	case "emissions.v3.QueuedRewardPayout.address":
+		panic(fmt.Errorf("field address of message emissions.v3.QueuedRewardPayout is not mutable"))
+	case "emissions.v3.QueuedRewardPayout.type":
+		panic(fmt.Errorf("field type of message emissions.v3.QueuedRewardPayout is not mutable"))
+	case "emissions.v3.QueuedRewardPayout.amount":
+		panic(fmt.Errorf("field amount of message emissions.v3.QueuedRewardPayout is not mutable"))
+	case "emissions.v3.QueuedRewardPayout.block_queued":
+		panic(fmt.Errorf("field block_queued of message emissions.v3.QueuedRewardPayout is not mutable"))
+	case "emissions.v3.QueuedRewardPayout.failed_attempts":
+		panic(fmt.Errorf("field failed_attempts of message emissions.v3.QueuedRewardPayout is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.QueuedRewardPayout"))
+		}
+		panic(fmt.Errorf("message emissions.v3.QueuedRewardPayout does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueuedRewardPayout) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.QueuedRewardPayout.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.QueuedRewardPayout.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.QueuedRewardPayout.address":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.QueuedRewardPayout.type":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.QueuedRewardPayout.amount":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.QueuedRewardPayout.block_queued":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.QueuedRewardPayout.failed_attempts":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.QueuedRewardPayout"))
+		}
+		panic(fmt.Errorf("message emissions.v3.QueuedRewardPayout does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueuedRewardPayout) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.QueuedRewardPayout", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueuedRewardPayout) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueuedRewardPayout) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueuedRewardPayout) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueuedRewardPayout) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueuedRewardPayout)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Type_)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockQueued != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockQueued))
+		}
+		if x.FailedAttempts != 0 {
+			n += 1 + runtime.Sov(uint64(x.FailedAttempts))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueuedRewardPayout)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FailedAttempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FailedAttempts))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.BlockQueued != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockQueued))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Type_) > 0 {
+			i -= len(x.Type_)
+			copy(dAtA[i:], x.Type_)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueuedRewardPayout)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueuedRewardPayout: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueuedRewardPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Type_ = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockQueued", wireType)
+				}
+				x.BlockQueued = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockQueued |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
+				}
+				x.FailedAttempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FailedAttempts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_WorkerBondRemovalInfo                         protoreflect.MessageDescriptor
+	fd_WorkerBondRemovalInfo_block_removal_started   protoreflect.FieldDescriptor
+	fd_WorkerBondRemovalInfo_topic_id                protoreflect.FieldDescriptor
+	fd_WorkerBondRemovalInfo_worker                  protoreflect.FieldDescriptor
+	fd_WorkerBondRemovalInfo_amount                  protoreflect.FieldDescriptor
+	fd_WorkerBondRemovalInfo_block_removal_completed protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_stake_proto_init()
+	md_WorkerBondRemovalInfo = File_emissions_v3_stake_proto.Messages().ByName("WorkerBondRemovalInfo")
+	fd_WorkerBondRemovalInfo_block_removal_started = md_WorkerBondRemovalInfo.Fields().ByName("block_removal_started")
+	fd_WorkerBondRemovalInfo_topic_id = md_WorkerBondRemovalInfo.Fields().ByName("topic_id")
+	fd_WorkerBondRemovalInfo_worker = md_WorkerBondRemovalInfo.Fields().ByName("worker")
+	fd_WorkerBondRemovalInfo_amount = md_WorkerBondRemovalInfo.Fields().ByName("amount")
+	fd_WorkerBondRemovalInfo_block_removal_completed = md_WorkerBondRemovalInfo.Fields().ByName("block_removal_completed")
+}
+
+var _ protoreflect.Message = (*fastReflection_WorkerBondRemovalInfo)(nil)
+
+type fastReflection_WorkerBondRemovalInfo WorkerBondRemovalInfo
+
+func (x *WorkerBondRemovalInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WorkerBondRemovalInfo)(x)
+}
+
+func (x *WorkerBondRemovalInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_stake_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_WorkerBondRemovalInfo_messageType fastReflection_WorkerBondRemovalInfo_messageType
+var _ protoreflect.MessageType = fastReflection_WorkerBondRemovalInfo_messageType{}
+
+type fastReflection_WorkerBondRemovalInfo_messageType struct{}
+
+func (x fastReflection_WorkerBondRemovalInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WorkerBondRemovalInfo)(nil)
+}
+func (x fastReflection_WorkerBondRemovalInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_WorkerBondRemovalInfo)
+}
+func (x fastReflection_WorkerBondRemovalInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WorkerBondRemovalInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_WorkerBondRemovalInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_WorkerBondRemovalInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_WorkerBondRemovalInfo) Type() protoreflect.MessageType {
+	return _fastReflection_WorkerBondRemovalInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_WorkerBondRemovalInfo) New() protoreflect.Message {
+	return new(fastReflection_WorkerBondRemovalInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_WorkerBondRemovalInfo) Interface() protoreflect.ProtoMessage {
+	return (*WorkerBondRemovalInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_WorkerBondRemovalInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockRemovalStarted != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockRemovalStarted)
+		if !f(fd_WorkerBondRemovalInfo_block_removal_started, value) {
+			return
+		}
+	}
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_WorkerBondRemovalInfo_topic_id, value) {
+			return
+		}
+	}
+	if x.Worker != "" {
+		value := protoreflect.ValueOfString(x.Worker)
+		if !f(fd_WorkerBondRemovalInfo_worker, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_WorkerBondRemovalInfo_amount, value) {
+			return
+		}
+	}
+	if x.BlockRemovalCompleted != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockRemovalCompleted)
+		if !f(fd_WorkerBondRemovalInfo_block_removal_completed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_WorkerBondRemovalInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerBondRemovalInfo.block_removal_started":
+		return x.BlockRemovalStarted != int64(0)
+	case "emissions.v3.WorkerBondRemovalInfo.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.WorkerBondRemovalInfo.worker":
+		return x.Worker != ""
+	case "emissions.v3.WorkerBondRemovalInfo.amount":
+		return x.Amount != ""
+	case "emissions.v3.WorkerBondRemovalInfo.block_removal_completed":
+		return x.BlockRemovalCompleted != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerBondRemovalInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerBondRemovalInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerBondRemovalInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerBondRemovalInfo.block_removal_started":
+		x.BlockRemovalStarted = int64(0)
+	case "emissions.v3.WorkerBondRemovalInfo.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.WorkerBondRemovalInfo.worker":
+		x.Worker = ""
+	case "emissions.v3.WorkerBondRemovalInfo.amount":
+		x.Amount = ""
+	case "emissions.v3.WorkerBondRemovalInfo.block_removal_completed":
+		x.BlockRemovalCompleted = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerBondRemovalInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerBondRemovalInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_WorkerBondRemovalInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.WorkerBondRemovalInfo.block_removal_started":
+		value := x.BlockRemovalStarted
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.WorkerBondRemovalInfo.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.WorkerBondRemovalInfo.worker":
+		value := x.Worker
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.WorkerBondRemovalInfo.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.WorkerBondRemovalInfo.block_removal_completed":
+		value := x.BlockRemovalCompleted
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerBondRemovalInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerBondRemovalInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerBondRemovalInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerBondRemovalInfo.block_removal_started":
+		x.BlockRemovalStarted = value.Int()
+	case "emissions.v3.WorkerBondRemovalInfo.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.WorkerBondRemovalInfo.worker":
+		x.Worker = value.Interface().(string)
+	case "emissions.v3.WorkerBondRemovalInfo.amount":
+		x.Amount = value.Interface().(string)
+	case "emissions.v3.WorkerBondRemovalInfo.block_removal_completed":
+		x.BlockRemovalCompleted = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerBondRemovalInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerBondRemovalInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerBondRemovalInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerBondRemovalInfo.block_removal_started":
+		panic(fmt.Errorf("field block_removal_started of message emissions.v3.WorkerBondRemovalInfo is not mutable"))
+	case "emissions.v3.WorkerBondRemovalInfo.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.WorkerBondRemovalInfo is not mutable"))
+	case "emissions.v3.WorkerBondRemovalInfo.worker":
+		panic(fmt.Errorf("field worker of message emissions.v3.WorkerBondRemovalInfo is not mutable"))
+	case "emissions.v3.WorkerBondRemovalInfo.amount":
+		panic(fmt.Errorf("field amount of message emissions.v3.WorkerBondRemovalInfo is not mutable"))
+	case "emissions.v3.WorkerBondRemovalInfo.block_removal_completed":
+		panic(fmt.Errorf("field block_removal_completed of message emissions.v3.WorkerBondRemovalInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerBondRemovalInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerBondRemovalInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_WorkerBondRemovalInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerBondRemovalInfo.block_removal_started":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.WorkerBondRemovalInfo.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.WorkerBondRemovalInfo.worker":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.WorkerBondRemovalInfo.amount":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.WorkerBondRemovalInfo.block_removal_completed":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerBondRemovalInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerBondRemovalInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_WorkerBondRemovalInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.WorkerBondRemovalInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_WorkerBondRemovalInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerBondRemovalInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_WorkerBondRemovalInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_WorkerBondRemovalInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*WorkerBondRemovalInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BlockRemovalStarted != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockRemovalStarted))
+		}
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		l = len(x.Worker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockRemovalCompleted != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockRemovalCompleted))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*WorkerBondRemovalInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockRemovalCompleted != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockRemovalCompleted))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Worker) > 0 {
+			i -= len(x.Worker)
+			copy(dAtA[i:], x.Worker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Worker)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.BlockRemovalStarted != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockRemovalStarted))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*WorkerBondRemovalInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerBondRemovalInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerBondRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+				}
+				x.BlockRemovalStarted = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockRemovalStarted |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Worker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+				}
+				x.BlockRemovalCompleted = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockRemovalCompleted |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ReputerConsensusDeviation                    protoreflect.MessageDescriptor
+	fd_ReputerConsensusDeviation_topic_id           protoreflect.FieldDescriptor
+	fd_ReputerConsensusDeviation_reputer            protoreflect.FieldDescriptor
+	fd_ReputerConsensusDeviation_consecutive_epochs protoreflect.FieldDescriptor
+	fd_ReputerConsensusDeviation_distance           protoreflect.FieldDescriptor
+	fd_ReputerConsensusDeviation_block_height       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_stake_proto_init()
+	md_ReputerConsensusDeviation = File_emissions_v3_stake_proto.Messages().ByName("ReputerConsensusDeviation")
+	fd_ReputerConsensusDeviation_topic_id = md_ReputerConsensusDeviation.Fields().ByName("topic_id")
+	fd_ReputerConsensusDeviation_reputer = md_ReputerConsensusDeviation.Fields().ByName("reputer")
+	fd_ReputerConsensusDeviation_consecutive_epochs = md_ReputerConsensusDeviation.Fields().ByName("consecutive_epochs")
+	fd_ReputerConsensusDeviation_distance = md_ReputerConsensusDeviation.Fields().ByName("distance")
+	fd_ReputerConsensusDeviation_block_height = md_ReputerConsensusDeviation.Fields().ByName("block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_ReputerConsensusDeviation)(nil)
+
+type fastReflection_ReputerConsensusDeviation ReputerConsensusDeviation
+
+func (x *ReputerConsensusDeviation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReputerConsensusDeviation)(x)
+}
+
+func (x *ReputerConsensusDeviation) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_stake_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ReputerConsensusDeviation_messageType fastReflection_ReputerConsensusDeviation_messageType
+var _ protoreflect.MessageType = fastReflection_ReputerConsensusDeviation_messageType{}
+
+type fastReflection_ReputerConsensusDeviation_messageType struct{}
+
+func (x fastReflection_ReputerConsensusDeviation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReputerConsensusDeviation)(nil)
+}
+func (x fastReflection_ReputerConsensusDeviation_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReputerConsensusDeviation)
+}
+func (x fastReflection_ReputerConsensusDeviation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReputerConsensusDeviation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReputerConsensusDeviation) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReputerConsensusDeviation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReputerConsensusDeviation) Type() protoreflect.MessageType {
+	return _fastReflection_ReputerConsensusDeviation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReputerConsensusDeviation) New() protoreflect.Message {
+	return new(fastReflection_ReputerConsensusDeviation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReputerConsensusDeviation) Interface() protoreflect.ProtoMessage {
+	return (*ReputerConsensusDeviation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReputerConsensusDeviation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_ReputerConsensusDeviation_topic_id, value) {
+			return
+		}
+	}
+	if x.Reputer != "" {
+		value := protoreflect.ValueOfString(x.Reputer)
+		if !f(fd_ReputerConsensusDeviation_reputer, value) {
+			return
+		}
+	}
+	if x.ConsecutiveEpochs != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ConsecutiveEpochs)
+		if !f(fd_ReputerConsensusDeviation_consecutive_epochs, value) {
+			return
+		}
+	}
+	if x.Distance != "" {
+		value := protoreflect.ValueOfString(x.Distance)
+		if !f(fd_ReputerConsensusDeviation_distance, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_ReputerConsensusDeviation_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReputerConsensusDeviation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerConsensusDeviation.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.ReputerConsensusDeviation.reputer":
+		return x.Reputer != ""
+	case "emissions.v3.ReputerConsensusDeviation.consecutive_epochs":
+		return x.ConsecutiveEpochs != int64(0)
+	case "emissions.v3.ReputerConsensusDeviation.distance":
+		return x.Distance != ""
+	case "emissions.v3.ReputerConsensusDeviation.block_height":
+		return x.BlockHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerConsensusDeviation"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerConsensusDeviation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerConsensusDeviation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerConsensusDeviation.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.ReputerConsensusDeviation.reputer":
+		x.Reputer = ""
+	case "emissions.v3.ReputerConsensusDeviation.consecutive_epochs":
+		x.ConsecutiveEpochs = int64(0)
+	case "emissions.v3.ReputerConsensusDeviation.distance":
+		x.Distance = ""
+	case "emissions.v3.ReputerConsensusDeviation.block_height":
+		x.BlockHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerConsensusDeviation"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerConsensusDeviation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReputerConsensusDeviation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.ReputerConsensusDeviation.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.ReputerConsensusDeviation.reputer":
+		value := x.Reputer
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.ReputerConsensusDeviation.consecutive_epochs":
+		value := x.ConsecutiveEpochs
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.ReputerConsensusDeviation.distance":
+		value := x.Distance
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.ReputerConsensusDeviation.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerConsensusDeviation"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerConsensusDeviation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerConsensusDeviation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerConsensusDeviation.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.ReputerConsensusDeviation.reputer":
+		x.Reputer = value.Interface().(string)
+	case "emissions.v3.ReputerConsensusDeviation.consecutive_epochs":
+		x.ConsecutiveEpochs = value.Int()
+	case "emissions.v3.ReputerConsensusDeviation.distance":
+		x.Distance = value.Interface().(string)
+	case "emissions.v3.ReputerConsensusDeviation.block_height":
+		x.BlockHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerConsensusDeviation"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerConsensusDeviation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerConsensusDeviation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerConsensusDeviation.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.ReputerConsensusDeviation is not mutable"))
+	case "emissions.v3.ReputerConsensusDeviation.reputer":
+		panic(fmt.Errorf("field reputer of message emissions.v3.ReputerConsensusDeviation is not mutable"))
+	case "emissions.v3.ReputerConsensusDeviation.consecutive_epochs":
+		panic(fmt.Errorf("field consecutive_epochs of message emissions.v3.ReputerConsensusDeviation is not mutable"))
+	case "emissions.v3.ReputerConsensusDeviation.distance":
+		panic(fmt.Errorf("field distance of message emissions.v3.ReputerConsensusDeviation is not mutable"))
+	case "emissions.v3.ReputerConsensusDeviation.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v3.ReputerConsensusDeviation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerConsensusDeviation"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerConsensusDeviation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReputerConsensusDeviation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerConsensusDeviation.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.ReputerConsensusDeviation.reputer":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.ReputerConsensusDeviation.consecutive_epochs":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.ReputerConsensusDeviation.distance":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.ReputerConsensusDeviation.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerConsensusDeviation"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerConsensusDeviation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReputerConsensusDeviation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.ReputerConsensusDeviation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReputerConsensusDeviation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerConsensusDeviation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReputerConsensusDeviation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReputerConsensusDeviation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReputerConsensusDeviation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		l = len(x.Reputer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ConsecutiveEpochs != 0 {
+			n += 1 + runtime.Sov(uint64(x.ConsecutiveEpochs))
+		}
+		l = len(x.Distance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReputerConsensusDeviation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Distance) > 0 {
+			i -= len(x.Distance)
+			copy(dAtA[i:], x.Distance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Distance)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ConsecutiveEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConsecutiveEpochs))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Reputer) > 0 {
+			i -= len(x.Reputer)
+			copy(dAtA[i:], x.Reputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reputer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReputerConsensusDeviation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReputerConsensusDeviation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReputerConsensusDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveEpochs", wireType)
+				}
+				x.ConsecutiveEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ConsecutiveEpochs |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Distance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Distance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RedelegationInfo                              protoreflect.MessageDescriptor
+	fd_RedelegationInfo_topic_id                     protoreflect.FieldDescriptor
+	fd_RedelegationInfo_delegator                    protoreflect.FieldDescriptor
+	fd_RedelegationInfo_src_reputer                  protoreflect.FieldDescriptor
+	fd_RedelegationInfo_dst_reputer                  protoreflect.FieldDescriptor
+	fd_RedelegationInfo_amount                       protoreflect.FieldDescriptor
+	fd_RedelegationInfo_block_redelegation_started   protoreflect.FieldDescriptor
+	fd_RedelegationInfo_block_redelegation_completed protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_stake_proto_init()
+	md_RedelegationInfo = File_emissions_v3_stake_proto.Messages().ByName("RedelegationInfo")
+	fd_RedelegationInfo_topic_id = md_RedelegationInfo.Fields().ByName("topic_id")
+	fd_RedelegationInfo_delegator = md_RedelegationInfo.Fields().ByName("delegator")
+	fd_RedelegationInfo_src_reputer = md_RedelegationInfo.Fields().ByName("src_reputer")
+	fd_RedelegationInfo_dst_reputer = md_RedelegationInfo.Fields().ByName("dst_reputer")
+	fd_RedelegationInfo_amount = md_RedelegationInfo.Fields().ByName("amount")
+	fd_RedelegationInfo_block_redelegation_started = md_RedelegationInfo.Fields().ByName("block_redelegation_started")
+	fd_RedelegationInfo_block_redelegation_completed = md_RedelegationInfo.Fields().ByName("block_redelegation_completed")
+}
+
+var _ protoreflect.Message = (*fastReflection_RedelegationInfo)(nil)
+
+type fastReflection_RedelegationInfo RedelegationInfo
+
+func (x *RedelegationInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RedelegationInfo)(x)
+}
+
+func (x *RedelegationInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_stake_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RedelegationInfo_messageType fastReflection_RedelegationInfo_messageType
+var _ protoreflect.MessageType = fastReflection_RedelegationInfo_messageType{}
+
+type fastReflection_RedelegationInfo_messageType struct{}
+
+func (x fastReflection_RedelegationInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RedelegationInfo)(nil)
+}
+func (x fastReflection_RedelegationInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_RedelegationInfo)
+}
+func (x fastReflection_RedelegationInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RedelegationInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RedelegationInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_RedelegationInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RedelegationInfo) Type() protoreflect.MessageType {
+	return _fastReflection_RedelegationInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RedelegationInfo) New() protoreflect.Message {
+	return new(fastReflection_RedelegationInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RedelegationInfo) Interface() protoreflect.ProtoMessage {
+	return (*RedelegationInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RedelegationInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_RedelegationInfo_topic_id, value) {
+			return
+		}
+	}
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_RedelegationInfo_delegator, value) {
+			return
+		}
+	}
+	if x.SrcReputer != "" {
+		value := protoreflect.ValueOfString(x.SrcReputer)
+		if !f(fd_RedelegationInfo_src_reputer, value) {
+			return
+		}
+	}
+	if x.DstReputer != "" {
+		value := protoreflect.ValueOfString(x.DstReputer)
+		if !f(fd_RedelegationInfo_dst_reputer, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_RedelegationInfo_amount, value) {
+			return
+		}
+	}
+	if x.BlockRedelegationStarted != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockRedelegationStarted)
+		if !f(fd_RedelegationInfo_block_redelegation_started, value) {
+			return
+		}
+	}
+	if x.BlockRedelegationCompleted != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockRedelegationCompleted)
+		if !f(fd_RedelegationInfo_block_redelegation_completed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RedelegationInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.RedelegationInfo.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.RedelegationInfo.delegator":
+		return x.Delegator != ""
+	case "emissions.v3.RedelegationInfo.src_reputer":
+		return x.SrcReputer != ""
+	case "emissions.v3.RedelegationInfo.dst_reputer":
+		return x.DstReputer != ""
+	case "emissions.v3.RedelegationInfo.amount":
+		return x.Amount != ""
+	case "emissions.v3.RedelegationInfo.block_redelegation_started":
+		return x.BlockRedelegationStarted != int64(0)
+	case "emissions.v3.RedelegationInfo.block_redelegation_completed":
+		return x.BlockRedelegationCompleted != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.RedelegationInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.RedelegationInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.RedelegationInfo.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.RedelegationInfo.delegator":
+		x.Delegator = ""
+	case "emissions.v3.RedelegationInfo.src_reputer":
+		x.SrcReputer = ""
+	case "emissions.v3.RedelegationInfo.dst_reputer":
+		x.DstReputer = ""
+	case "emissions.v3.RedelegationInfo.amount":
+		x.Amount = ""
+	case "emissions.v3.RedelegationInfo.block_redelegation_started":
+		x.BlockRedelegationStarted = int64(0)
+	case "emissions.v3.RedelegationInfo.block_redelegation_completed":
+		x.BlockRedelegationCompleted = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.RedelegationInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.RedelegationInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RedelegationInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.RedelegationInfo.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.RedelegationInfo.delegator":
+		value := x.Delegator
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.RedelegationInfo.src_reputer":
+		value := x.SrcReputer
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.RedelegationInfo.dst_reputer":
+		value := x.DstReputer
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.RedelegationInfo.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.RedelegationInfo.block_redelegation_started":
+		value := x.BlockRedelegationStarted
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.RedelegationInfo.block_redelegation_completed":
+		value := x.BlockRedelegationCompleted
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.RedelegationInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.RedelegationInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.RedelegationInfo.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.RedelegationInfo.delegator":
+		x.Delegator = value.Interface().(string)
+	case "emissions.v3.RedelegationInfo.src_reputer":
+		x.SrcReputer = value.Interface().(string)
+	case "emissions.v3.RedelegationInfo.dst_reputer":
+		x.DstReputer = value.Interface().(string)
+	case "emissions.v3.RedelegationInfo.amount":
+		x.Amount = value.Interface().(string)
+	case "emissions.v3.RedelegationInfo.block_redelegation_started":
+		x.BlockRedelegationStarted = value.Int()
+	case "emissions.v3.RedelegationInfo.block_redelegation_completed":
+		x.BlockRedelegationCompleted = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.RedelegationInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.RedelegationInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.RedelegationInfo.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.RedelegationInfo is not mutable"))
+	case "emissions.v3.RedelegationInfo.delegator":
+		panic(fmt.Errorf("field delegator of message emissions.v3.RedelegationInfo is not mutable"))
+	case "emissions.v3.RedelegationInfo.src_reputer":
+		panic(fmt.Errorf("field src_reputer of message emissions.v3.RedelegationInfo is not mutable"))
+	case "emissions.v3.RedelegationInfo.dst_reputer":
+		panic(fmt.Errorf("field dst_reputer of message emissions.v3.RedelegationInfo is not mutable"))
+	case "emissions.v3.RedelegationInfo.amount":
+		panic(fmt.Errorf("field amount of message emissions.v3.RedelegationInfo is not mutable"))
+	case "emissions.v3.RedelegationInfo.block_redelegation_started":
+		panic(fmt.Errorf("field block_redelegation_started of message emissions.v3.RedelegationInfo is not mutable"))
+	case "emissions.v3.RedelegationInfo.block_redelegation_completed":
+		panic(fmt.Errorf("field block_redelegation_completed of message emissions.v3.RedelegationInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.RedelegationInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.RedelegationInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RedelegationInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.RedelegationInfo.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.RedelegationInfo.delegator":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.RedelegationInfo.src_reputer":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.RedelegationInfo.dst_reputer":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.RedelegationInfo.amount":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.RedelegationInfo.block_redelegation_started":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.RedelegationInfo.block_redelegation_completed":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.RedelegationInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.RedelegationInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RedelegationInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.RedelegationInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RedelegationInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedelegationInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RedelegationInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RedelegationInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RedelegationInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SrcReputer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DstReputer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockRedelegationStarted != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockRedelegationStarted))
+		}
+		if x.BlockRedelegationCompleted != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockRedelegationCompleted))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RedelegationInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockRedelegationCompleted != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockRedelegationCompleted))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.BlockRedelegationStarted != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockRedelegationStarted))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.DstReputer) > 0 {
+			i -= len(x.DstReputer)
+			copy(dAtA[i:], x.DstReputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DstReputer)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.SrcReputer) > 0 {
+			i -= len(x.SrcReputer)
+			copy(dAtA[i:], x.SrcReputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SrcReputer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RedelegationInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SrcReputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SrcReputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DstReputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DstReputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRedelegationStarted", wireType)
+				}
+				x.BlockRedelegationStarted = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockRedelegationStarted |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockRedelegationCompleted", wireType)
+				}
+				x.BlockRedelegationCompleted = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockRedelegationCompleted |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DelegatorInfo             protoreflect.MessageDescriptor
+	fd_DelegatorInfo_amount      protoreflect.FieldDescriptor
+	fd_DelegatorInfo_reward_debt protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_stake_proto_init()
+	md_DelegatorInfo = File_emissions_v3_stake_proto.Messages().ByName("DelegatorInfo")
+	fd_DelegatorInfo_amount = md_DelegatorInfo.Fields().ByName("amount")
+	fd_DelegatorInfo_reward_debt = md_DelegatorInfo.Fields().ByName("reward_debt")
+}
+
+var _ protoreflect.Message = (*fastReflection_DelegatorInfo)(nil)
+
+type fastReflection_DelegatorInfo DelegatorInfo
+
+func (x *DelegatorInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DelegatorInfo)(x)
+}
+
+func (x *DelegatorInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_stake_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DelegatorInfo_messageType fastReflection_DelegatorInfo_messageType
+var _ protoreflect.MessageType = fastReflection_DelegatorInfo_messageType{}
+
+type fastReflection_DelegatorInfo_messageType struct{}
+
+func (x fastReflection_DelegatorInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DelegatorInfo)(nil)
+}
+func (x fastReflection_DelegatorInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_DelegatorInfo)
+}
+func (x fastReflection_DelegatorInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegatorInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DelegatorInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegatorInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DelegatorInfo) Type() protoreflect.MessageType {
+	return _fastReflection_DelegatorInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DelegatorInfo) New() protoreflect.Message {
+	return new(fastReflection_DelegatorInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DelegatorInfo) Interface() protoreflect.ProtoMessage {
+	return (*DelegatorInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DelegatorInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_DelegatorInfo_amount, value) {
+			return
+		}
+	}
+	if x.RewardDebt != "" {
+		value := protoreflect.ValueOfString(x.RewardDebt)
+		if !f(fd_DelegatorInfo_reward_debt, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DelegatorInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.DelegatorInfo.amount":
+		return x.Amount != ""
+	case "emissions.v3.DelegatorInfo.reward_debt":
+		return x.RewardDebt != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.DelegatorInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.DelegatorInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegatorInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.DelegatorInfo.amount":
+		x.Amount = ""
+	case "emissions.v3.DelegatorInfo.reward_debt":
+		x.RewardDebt = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.DelegatorInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.DelegatorInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DelegatorInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.DelegatorInfo.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.DelegatorInfo.reward_debt":
+		value := x.RewardDebt
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.DelegatorInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.DelegatorInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegatorInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.DelegatorInfo.amount":
+		x.Amount = value.Interface().(string)
+	case "emissions.v3.DelegatorInfo.reward_debt":
+		x.RewardDebt = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.DelegatorInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.DelegatorInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegatorInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.DelegatorInfo.amount":
+		panic(fmt.Errorf("field amount of message emissions.v3.DelegatorInfo is not mutable"))
+	case "emissions.v3.DelegatorInfo.reward_debt":
+		panic(fmt.Errorf("field reward_debt of message emissions.v3.DelegatorInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.DelegatorInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.DelegatorInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DelegatorInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.DelegatorInfo.amount":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.DelegatorInfo.reward_debt":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.DelegatorInfo"))
+		}
+		panic(fmt.Errorf("message emissions.v3.DelegatorInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DelegatorInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.DelegatorInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DelegatorInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DelegatorInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DelegatorInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DelegatorInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DelegatorInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RewardDebt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DelegatorInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RewardDebt) > 0 {
+			i -= len(x.RewardDebt)
+			copy(dAtA[i:], x.RewardDebt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardDebt)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DelegatorInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatorInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardDebt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardDebt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ReputerCommission                     protoreflect.MessageDescriptor
+	fd_ReputerCommission_topic_id            protoreflect.FieldDescriptor
+	fd_ReputerCommission_reputer             protoreflect.FieldDescriptor
+	fd_ReputerCommission_rate                protoreflect.FieldDescriptor
+	fd_ReputerCommission_max_rate            protoreflect.FieldDescriptor
+	fd_ReputerCommission_max_change_rate     protoreflect.FieldDescriptor
+	fd_ReputerCommission_update_block_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_stake_proto_init()
+	md_ReputerCommission = File_emissions_v3_stake_proto.Messages().ByName("ReputerCommission")
+	fd_ReputerCommission_topic_id = md_ReputerCommission.Fields().ByName("topic_id")
+	fd_ReputerCommission_reputer = md_ReputerCommission.Fields().ByName("reputer")
+	fd_ReputerCommission_rate = md_ReputerCommission.Fields().ByName("rate")
+	fd_ReputerCommission_max_rate = md_ReputerCommission.Fields().ByName("max_rate")
+	fd_ReputerCommission_max_change_rate = md_ReputerCommission.Fields().ByName("max_change_rate")
+	fd_ReputerCommission_update_block_height = md_ReputerCommission.Fields().ByName("update_block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_ReputerCommission)(nil)
+
+type fastReflection_ReputerCommission ReputerCommission
+
+func (x *ReputerCommission) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReputerCommission)(x)
+}
+
+func (x *ReputerCommission) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_stake_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ReputerCommission_messageType fastReflection_ReputerCommission_messageType
+var _ protoreflect.MessageType = fastReflection_ReputerCommission_messageType{}
+
+type fastReflection_ReputerCommission_messageType struct{}
+
+func (x fastReflection_ReputerCommission_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReputerCommission)(nil)
+}
+func (x fastReflection_ReputerCommission_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReputerCommission)
+}
+func (x fastReflection_ReputerCommission_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReputerCommission
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReputerCommission) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReputerCommission
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReputerCommission) Type() protoreflect.MessageType {
+	return _fastReflection_ReputerCommission_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReputerCommission) New() protoreflect.Message {
+	return new(fastReflection_ReputerCommission)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReputerCommission) Interface() protoreflect.ProtoMessage {
+	return (*ReputerCommission)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReputerCommission) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_ReputerCommission_topic_id, value) {
+			return
+		}
+	}
+	if x.Reputer != "" {
+		value := protoreflect.ValueOfString(x.Reputer)
+		if !f(fd_ReputerCommission_reputer, value) {
+			return
+		}
+	}
+	if x.Rate != "" {
+		value := protoreflect.ValueOfString(x.Rate)
+		if !f(fd_ReputerCommission_rate, value) {
+			return
+		}
+	}
+	if x.MaxRate != "" {
+		value := protoreflect.ValueOfString(x.MaxRate)
+		if !f(fd_ReputerCommission_max_rate, value) {
+			return
+		}
+	}
+	if x.MaxChangeRate != "" {
+		value := protoreflect.ValueOfString(x.MaxChangeRate)
+		if !f(fd_ReputerCommission_max_change_rate, value) {
+			return
+		}
+	}
+	if x.UpdateBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UpdateBlockHeight)
+		if !f(fd_ReputerCommission_update_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReputerCommission) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerCommission.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.ReputerCommission.reputer":
+		return x.Reputer != ""
+	case "emissions.v3.ReputerCommission.rate":
+		return x.Rate != ""
+	case "emissions.v3.ReputerCommission.max_rate":
+		return x.MaxRate != ""
+	case "emissions.v3.ReputerCommission.max_change_rate":
+		return x.MaxChangeRate != ""
+	case "emissions.v3.ReputerCommission.update_block_height":
+		return x.UpdateBlockHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerCommission"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerCommission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerCommission) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerCommission.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.ReputerCommission.reputer":
+		x.Reputer = ""
+	case "emissions.v3.ReputerCommission.rate":
+		x.Rate = ""
+	case "emissions.v3.ReputerCommission.max_rate":
+		x.MaxRate = ""
+	case "emissions.v3.ReputerCommission.max_change_rate":
+		x.MaxChangeRate = ""
+	case "emissions.v3.ReputerCommission.update_block_height":
+		x.UpdateBlockHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerCommission"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerCommission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReputerCommission) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.ReputerCommission.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.ReputerCommission.reputer":
+		value := x.Reputer
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.ReputerCommission.rate":
+		value := x.Rate
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.ReputerCommission.max_rate":
+		value := x.MaxRate
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.ReputerCommission.max_change_rate":
+		value := x.MaxChangeRate
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.ReputerCommission.update_block_height":
+		value := x.UpdateBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerCommission"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerCommission does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerCommission) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerCommission.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.ReputerCommission.reputer":
+		x.Reputer = value.Interface().(string)
+	case "emissions.v3.ReputerCommission.rate":
+		x.Rate = value.Interface().(string)
+	case "emissions.v3.ReputerCommission.max_rate":
+		x.MaxRate = value.Interface().(string)
+	case "emissions.v3.ReputerCommission.max_change_rate":
+		x.MaxChangeRate = value.Interface().(string)
+	case "emissions.v3.ReputerCommission.update_block_height":
+		x.UpdateBlockHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerCommission"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerCommission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerCommission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerCommission.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.ReputerCommission is not mutable"))
+	case "emissions.v3.ReputerCommission.reputer":
+		panic(fmt.Errorf("field reputer of message emissions.v3.ReputerCommission is not mutable"))
+	case "emissions.v3.ReputerCommission.rate":
+		panic(fmt.Errorf("field rate of message emissions.v3.ReputerCommission is not mutable"))
+	case "emissions.v3.ReputerCommission.max_rate":
+		panic(fmt.Errorf("field max_rate of message emissions.v3.ReputerCommission is not mutable"))
+	case "emissions.v3.ReputerCommission.max_change_rate":
+		panic(fmt.Errorf("field max_change_rate of message emissions.v3.ReputerCommission is not mutable"))
+	case "emissions.v3.ReputerCommission.update_block_height":
+		panic(fmt.Errorf("field update_block_height of message emissions.v3.ReputerCommission is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerCommission"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerCommission does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReputerCommission) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerCommission.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.ReputerCommission.reputer":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.ReputerCommission.rate":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.ReputerCommission.max_rate":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.ReputerCommission.max_change_rate":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.ReputerCommission.update_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerCommission"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerCommission does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReputerCommission) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.ReputerCommission", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReputerCommission) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerCommission) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReputerCommission) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReputerCommission) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReputerCommission)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		l = len(x.Reputer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Rate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxChangeRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UpdateBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpdateBlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReputerCommission)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UpdateBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpdateBlockHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.MaxChangeRate) > 0 {
+			i -= len(x.MaxChangeRate)
+			copy(dAtA[i:], x.MaxChangeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxChangeRate)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.MaxRate) > 0 {
+			i -= len(x.MaxRate)
+			copy(dAtA[i:], x.MaxRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxRate)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Rate) > 0 {
+			i -= len(x.Rate)
+			copy(dAtA[i:], x.Rate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rate)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Reputer) > 0 {
+			i -= len(x.Reputer)
+			copy(dAtA[i:], x.Reputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reputer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReputerCommission)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReputerCommission: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReputerCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxChangeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateBlockHeight", wireType)
+				}
+				x.UpdateBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpdateBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_StakeLock                   protoreflect.MessageDescriptor
+	fd_StakeLock_topic_id          protoreflect.FieldDescriptor
+	fd_StakeLock_reputer           protoreflect.FieldDescriptor
+	fd_StakeLock_delegator         protoreflect.FieldDescriptor
+	fd_StakeLock_amount            protoreflect.FieldDescriptor
+	fd_StakeLock_reward_multiplier protoreflect.FieldDescriptor
+	fd_StakeLock_block_locked      protoreflect.FieldDescriptor
+	fd_StakeLock_block_unlocked    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_stake_proto_init()
+	md_StakeLock = File_emissions_v3_stake_proto.Messages().ByName("StakeLock")
+	fd_StakeLock_topic_id = md_StakeLock.Fields().ByName("topic_id")
+	fd_StakeLock_reputer = md_StakeLock.Fields().ByName("reputer")
+	fd_StakeLock_delegator = md_StakeLock.Fields().ByName("delegator")
+	fd_StakeLock_amount = md_StakeLock.Fields().ByName("amount")
+	fd_StakeLock_reward_multiplier = md_StakeLock.Fields().ByName("reward_multiplier")
+	fd_StakeLock_block_locked = md_StakeLock.Fields().ByName("block_locked")
+	fd_StakeLock_block_unlocked = md_StakeLock.Fields().ByName("block_unlocked")
+}
+
+var _ protoreflect.Message = (*fastReflection_StakeLock)(nil)
+
+type fastReflection_StakeLock StakeLock
+
+func (x *StakeLock) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StakeLock)(x)
+}
+
+func (x *StakeLock) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_stake_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StakeLock_messageType fastReflection_StakeLock_messageType
+var _ protoreflect.MessageType = fastReflection_StakeLock_messageType{}
+
+type fastReflection_StakeLock_messageType struct{}
+
+func (x fastReflection_StakeLock_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StakeLock)(nil)
+}
+func (x fastReflection_StakeLock_messageType) New() protoreflect.Message {
+	return new(fastReflection_StakeLock)
+}
+func (x fastReflection_StakeLock_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StakeLock
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StakeLock) Descriptor() protoreflect.MessageDescriptor {
+	return md_StakeLock
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StakeLock) Type() protoreflect.MessageType {
+	return _fastReflection_StakeLock_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StakeLock) New() protoreflect.Message {
+	return new(fastReflection_StakeLock)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StakeLock) Interface() protoreflect.ProtoMessage {
+	return (*StakeLock)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StakeLock) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_StakeLock_topic_id, value) {
+			return
+		}
+	}
+	if x.Reputer != "" {
+		value := protoreflect.ValueOfString(x.Reputer)
+		if !f(fd_StakeLock_reputer, value) {
+			return
+		}
+	}
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_StakeLock_delegator, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_StakeLock_amount, value) {
+			return
+		}
+	}
+	if x.RewardMultiplier != "" {
+		value := protoreflect.ValueOfString(x.RewardMultiplier)
+		if !f(fd_StakeLock_reward_multiplier, value) {
+			return
+		}
+	}
+	if x.BlockLocked != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockLocked)
+		if !f(fd_StakeLock_block_locked, value) {
+			return
+		}
+	}
+	if x.BlockUnlocked != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockUnlocked)
+		if !f(fd_StakeLock_block_unlocked, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StakeLock) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.StakeLock.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.StakeLock.reputer":
+		return x.Reputer != ""
+	case "emissions.v3.StakeLock.delegator":
+		return x.Delegator != ""
+	case "emissions.v3.StakeLock.amount":
+		return x.Amount != ""
+	case "emissions.v3.StakeLock.reward_multiplier":
+		return x.RewardMultiplier != ""
+	case "emissions.v3.StakeLock.block_locked":
+		return x.BlockLocked != int64(0)
+	case "emissions.v3.StakeLock.block_unlocked":
+		return x.BlockUnlocked != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.StakeLock"))
+		}
+		panic(fmt.Errorf("message emissions.v3.StakeLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StakeLock) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.StakeLock.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.StakeLock.reputer":
+		x.Reputer = ""
+	case "emissions.v3.StakeLock.delegator":
+		x.Delegator = ""
+	case "emissions.v3.StakeLock.amount":
+		x.Amount = ""
+	case "emissions.v3.StakeLock.reward_multiplier":
+		x.RewardMultiplier = ""
+	case "emissions.v3.StakeLock.block_locked":
+		x.BlockLocked = int64(0)
+	case "emissions.v3.StakeLock.block_unlocked":
+		x.BlockUnlocked = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.StakeLock"))
+		}
+		panic(fmt.Errorf("message emissions.v3.StakeLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StakeLock) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.StakeLock.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.StakeLock.reputer":
+		value := x.Reputer
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.StakeLock.delegator":
+		value := x.Delegator
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.StakeLock.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.StakeLock.reward_multiplier":
+		value := x.RewardMultiplier
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.StakeLock.block_locked":
+		value := x.BlockLocked
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.StakeLock.block_unlocked":
+		value := x.BlockUnlocked
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.StakeLock"))
+		}
+		panic(fmt.Errorf("message emissions.v3.StakeLock does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StakeLock) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.StakeLock.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.StakeLock.reputer":
+		x.Reputer = value.Interface().(string)
+	case "emissions.v3.StakeLock.delegator":
+		x.Delegator = value.Interface().(string)
+	case "emissions.v3.StakeLock.amount":
+		x.Amount = value.Interface().(string)
+	case "emissions.v3.StakeLock.reward_multiplier":
+		x.RewardMultiplier = value.Interface().(string)
+	case "emissions.v3.StakeLock.block_locked":
+		x.BlockLocked = value.Int()
+	case "emissions.v3.StakeLock.block_unlocked":
+		x.BlockUnlocked = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.StakeLock"))
+		}
+		panic(fmt.Errorf("message emissions.v3.StakeLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StakeLock) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.StakeLock.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.StakeLock is not mutable"))
+	case "emissions.v3.StakeLock.reputer":
+		panic(fmt.Errorf("field reputer of message emissions.v3.StakeLock is not mutable"))
+	case "emissions.v3.StakeLock.delegator":
+		panic(fmt.Errorf("field delegator of message emissions.v3.StakeLock is not mutable"))
+	case "emissions.v3.StakeLock.amount":
+		panic(fmt.Errorf("field amount of message emissions.v3.StakeLock is not mutable"))
+	case "emissions.v3.StakeLock.reward_multiplier":
+		panic(fmt.Errorf("field reward_multiplier of message emissions.v3.StakeLock is not mutable"))
+	case "emissions.v3.StakeLock.block_locked":
+		panic(fmt.Errorf("field block_locked of message emissions.v3.StakeLock is not mutable"))
+	case "emissions.v3.StakeLock.block_unlocked":
+		panic(fmt.Errorf("field block_unlocked of message emissions.v3.StakeLock is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.StakeLock"))
+		}
+		panic(fmt.Errorf("message emissions.v3.StakeLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StakeLock) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.StakeLock.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.StakeLock.reputer":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.StakeLock.delegator":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.StakeLock.amount":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.StakeLock.reward_multiplier":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.StakeLock.block_locked":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.StakeLock.block_unlocked":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.StakeLock"))
+		}
+		panic(fmt.Errorf("message emissions.v3.StakeLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StakeLock) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.StakeLock", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StakeLock) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StakeLock) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StakeLock) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StakeLock) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StakeLock)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		l = len(x.Reputer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RewardMultiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockLocked != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockLocked))
+		}
+		if x.BlockUnlocked != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockUnlocked))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StakeLock)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockUnlocked != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockUnlocked))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.BlockLocked != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockLocked))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.RewardMultiplier) > 0 {
+			i -= len(x.RewardMultiplier)
+			copy(dAtA[i:], x.RewardMultiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardMultiplier)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Reputer) > 0 {
+			i -= len(x.Reputer)
+			copy(dAtA[i:], x.Reputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reputer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StakeLock)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StakeLock: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StakeLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardMultiplier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardMultiplier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockLocked", wireType)
+				}
+				x.BlockLocked = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockLocked |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockUnlocked", wireType)
+				}
+				x.BlockUnlocked = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockUnlocked |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: emissions/v3/stake.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Bad naming convention, only used in StakeRemovals
+// DEPRECATED in ConsensusVersion = 2
+// TO BE DELETED in ConsensusVersion = 3
+type StakePlacement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockRemovalStarted int64  `protobuf:"varint,1,opt,name=block_removal_started,json=blockRemovalStarted,proto3" json:"block_removal_started,omitempty"`
+	TopicId             uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer             string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Amount              string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *StakePlacement) Reset() {
+	*x = StakePlacement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_stake_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakePlacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakePlacement) ProtoMessage() {}
+
+// Deprecated: Use StakePlacement.ProtoReflect.Descriptor instead.
+func (*StakePlacement) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StakePlacement) GetBlockRemovalStarted() int64 {
+	if x != nil {
+		return x.BlockRemovalStarted
+	}
+	return 0
+}
+
+func (x *StakePlacement) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *StakePlacement) GetReputer() string {
+	if x != nil {
+		return x.Reputer
+	}
+	return ""
+}
+
+func (x *StakePlacement) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// Bad naming convention, only used in StakeRemovals
+// DEPRECATED in ConsensusVersion = 2
+// TO BE DELETED in ConsensusVersion = 3
+type DelegateStakePlacement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockRemovalStarted int64  `protobuf:"varint,1,opt,name=block_removal_started,json=blockRemovalStarted,proto3" json:"block_removal_started,omitempty"`
+	TopicId             uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer             string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Delegator           string `protobuf:"bytes,4,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Amount              string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *DelegateStakePlacement) Reset() {
+	*x = DelegateStakePlacement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_stake_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegateStakePlacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegateStakePlacement) ProtoMessage() {}
+
+// Deprecated: Use DelegateStakePlacement.ProtoReflect.Descriptor instead.
+func (*DelegateStakePlacement) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DelegateStakePlacement) GetBlockRemovalStarted() int64 {
+	if x != nil {
+		return x.BlockRemovalStarted
+	}
+	return 0
+}
+
+func (x *DelegateStakePlacement) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *DelegateStakePlacement) GetReputer() string {
+	if x != nil {
+		return x.Reputer
+	}
+	return ""
+}
+
+func (x *DelegateStakePlacement) GetDelegator() string {
+	if x != nil {
+		return x.Delegator
+	}
+	return ""
+}
+
+func (x *DelegateStakePlacement) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// Introduced in ConsensusVersion = 2
+type StakeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *StakeInfo) Reset() {
+	*x = StakeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_stake_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakeInfo) ProtoMessage() {}
+
+// Deprecated: Use StakeInfo.ProtoReflect.Descriptor instead.
+func (*StakeInfo) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StakeInfo) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *StakeInfo) GetReputer() string {
+	if x != nil {
+		return x.Reputer
+	}
+	return ""
+}
+
+func (x *StakeInfo) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// Introduced in ConsensusVersion = 2
+type StakeRemovalInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockRemovalStarted   int64  `protobuf:"varint,1,opt,name=block_removal_started,json=blockRemovalStarted,proto3" json:"block_removal_started,omitempty"`
+	TopicId               uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer               string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Amount                string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockRemovalCompleted int64  `protobuf:"varint,5,opt,name=block_removal_completed,json=blockRemovalCompleted,proto3" json:"block_removal_completed,omitempty"`
+}
+
+func (x *StakeRemovalInfo) Reset() {
+	*x = StakeRemovalInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_stake_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakeRemovalInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakeRemovalInfo) ProtoMessage() {}
+
+// Deprecated: Use StakeRemovalInfo.ProtoReflect.Descriptor instead.
+func (*StakeRemovalInfo) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StakeRemovalInfo) GetBlockRemovalStarted() int64 {
+	if x != nil {
+		return x.BlockRemovalStarted
+	}
+	return 0
+}
+
+func (x *StakeRemovalInfo) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *StakeRemovalInfo) GetReputer() string {
+	if x != nil {
+		return x.Reputer
+	}
+	return ""
+}
+
+func (x *StakeRemovalInfo) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *StakeRemovalInfo) GetBlockRemovalCompleted() int64 {
+	if x != nil {
+		return x.BlockRemovalCompleted
+	}
+	return 0
+}
+
+// Introduced in ConsensusVersion = 2
+type DelegateStakeRemovalInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockRemovalStarted   int64  `protobuf:"varint,1,opt,name=block_removal_started,json=blockRemovalStarted,proto3" json:"block_removal_started,omitempty"`
+	TopicId               uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer               string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Delegator             string `protobuf:"bytes,4,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Amount                string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockRemovalCompleted int64  `protobuf:"varint,6,opt,name=block_removal_completed,json=blockRemovalCompleted,proto3" json:"block_removal_completed,omitempty"`
+}
+
+func (x *DelegateStakeRemovalInfo) Reset() {
+	*x = DelegateStakeRemovalInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_stake_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegateStakeRemovalInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegateStakeRemovalInfo) ProtoMessage() {}
+
+// Deprecated: Use DelegateStakeRemovalInfo.ProtoReflect.Descriptor instead.
+func (*DelegateStakeRemovalInfo) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DelegateStakeRemovalInfo) GetBlockRemovalStarted() int64 {
+	if x != nil {
+		return x.BlockRemovalStarted
+	}
+	return 0
+}
+
+func (x *DelegateStakeRemovalInfo) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *DelegateStakeRemovalInfo) GetReputer() string {
+	if x != nil {
+		return x.Reputer
+	}
+	return ""
+}
+
+func (x *DelegateStakeRemovalInfo) GetDelegator() string {
+	if x != nil {
+		return x.Delegator
+	}
+	return ""
+}
+
+func (x *DelegateStakeRemovalInfo) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *DelegateStakeRemovalInfo) GetBlockRemovalCompleted() int64 {
+	if x != nil {
+		return x.BlockRemovalCompleted
+	}
+	return 0
+}
+
+type WorkerBond struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Worker  string `protobuf:"bytes,2,opt,name=worker,proto3" json:"worker,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// height from which missed epochs are counted when the worker has
+	// not submitted since, i.e. when the bond was added or last slashed
+	LivenessBlockHeight int64 `protobuf:"varint,4,opt,name=liveness_block_height,json=livenessBlockHeight,proto3" json:"liveness_block_height,omitempty"`
+}
+
+func (x *WorkerBond) Reset() {
+	*x = WorkerBond{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_stake_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DelegateStakePlacement) String() string {
+func (x *WorkerBond) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelegateStakePlacement) ProtoMessage() {}
+func (*WorkerBond) ProtoMessage() {}
+
+// Deprecated: Use WorkerBond.ProtoReflect.Descriptor instead.
+func (*WorkerBond) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WorkerBond) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *WorkerBond) GetWorker() string {
+	if x != nil {
+		return x.Worker
+	}
+	return ""
+}
+
+func (x *WorkerBond) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WorkerBond) GetLivenessBlockHeight() int64 {
+	if x != nil {
+		return x.LivenessBlockHeight
+	}
+	return 0
+}
+
+// A share of a reward paid out to a worker or reputer of a topic held in escrow, vesting
+// linearly from the block it was escrowed until block_vested
+type RewardEscrow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount escrowed, less any forfeited unvested amount
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// part of the amount already claimed
+	Claimed       string `protobuf:"bytes,4,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	BlockEscrowed int64  `protobuf:"varint,5,opt,name=block_escrowed,json=blockEscrowed,proto3" json:"block_escrowed,omitempty"`
+	BlockVested   int64  `protobuf:"varint,6,opt,name=block_vested,json=blockVested,proto3" json:"block_vested,omitempty"`
+}
+
+func (x *RewardEscrow) Reset() {
+	*x = RewardEscrow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_stake_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardEscrow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardEscrow) ProtoMessage() {}
+
+// Deprecated: Use RewardEscrow.ProtoReflect.Descriptor instead.
+func (*RewardEscrow) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RewardEscrow) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *RewardEscrow) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RewardEscrow) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RewardEscrow) GetClaimed() string {
+	if x != nil {
+		return x.Claimed
+	}
+	return ""
+}
+
+func (x *RewardEscrow) GetBlockEscrowed() int64 {
+	if x != nil {
+		return x.BlockEscrowed
+	}
+	return 0
+}
+
+func (x *RewardEscrow) GetBlockVested() int64 {
+	if x != nil {
+		return x.BlockVested
+	}
+	return 0
+}
+
+// A reward of a worker or reputer of a topic waiting in the reward payout queue, paid out
+// by the ABCI endBlocker in ascending id order within the max reward payouts per block
+type QueuedRewardPayout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// one of the task reward types: reputer, inferer or forecaster
+	Type_       string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Amount      string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockQueued int64  `protobuf:"varint,6,opt,name=block_queued,json=blockQueued,proto3" json:"block_queued,omitempty"`
+	// number of times the reward failed to be paid out
+	FailedAttempts uint64 `protobuf:"varint,7,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+}
+
+func (x *QueuedRewardPayout) Reset() {
+	*x = QueuedRewardPayout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_stake_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueuedRewardPayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedRewardPayout) ProtoMessage() {}
+
+// Deprecated: Use QueuedRewardPayout.ProtoReflect.Descriptor instead.
+func (*QueuedRewardPayout) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueuedRewardPayout) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QueuedRewardPayout) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *QueuedRewardPayout) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueuedRewardPayout) GetType_() string {
+	if x != nil {
+		return x.Type_
+	}
+	return ""
+}
+
+func (x *QueuedRewardPayout) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *QueuedRewardPayout) GetBlockQueued() int64 {
+	if x != nil {
+		return x.BlockQueued
+	}
+	return 0
+}
+
+func (x *QueuedRewardPayout) GetFailedAttempts() uint64 {
+	if x != nil {
+		return x.FailedAttempts
+	}
+	return 0
+}
+
+type WorkerBondRemovalInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockRemovalStarted   int64  `protobuf:"varint,1,opt,name=block_removal_started,json=blockRemovalStarted,proto3" json:"block_removal_started,omitempty"`
+	TopicId               uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Worker                string `protobuf:"bytes,3,opt,name=worker,proto3" json:"worker,omitempty"`
+	Amount                string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockRemovalCompleted int64  `protobuf:"varint,5,opt,name=block_removal_completed,json=blockRemovalCompleted,proto3" json:"block_removal_completed,omitempty"`
+}
+
+func (x *WorkerBondRemovalInfo) Reset() {
+	*x = WorkerBondRemovalInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_stake_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerBondRemovalInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerBondRemovalInfo) ProtoMessage() {}
+
+// Deprecated: Use WorkerBondRemovalInfo.ProtoReflect.Descriptor instead.
+func (*WorkerBondRemovalInfo) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WorkerBondRemovalInfo) GetBlockRemovalStarted() int64 {
+	if x != nil {
+		return x.BlockRemovalStarted
+	}
+	return 0
+}
+
+func (x *WorkerBondRemovalInfo) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *WorkerBondRemovalInfo) GetWorker() string {
+	if x != nil {
+		return x.Worker
+	}
+	return ""
+}
+
+func (x *WorkerBondRemovalInfo) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WorkerBondRemovalInfo) GetBlockRemovalCompleted() int64 {
+	if x != nil {
+		return x.BlockRemovalCompleted
+	}
+	return 0
+}
+
+// run of consecutive reports in which a reputer's losses were further from the
+// loss consensus than its topic allows, with the evidence of the latest report
+type ReputerConsensusDeviation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId           uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer           string `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	ConsecutiveEpochs int64  `protobuf:"varint,3,opt,name=consecutive_epochs,json=consecutiveEpochs,proto3" json:"consecutive_epochs,omitempty"`
+	// normalized distance to consensus of the latest deviating report
+	Distance string `protobuf:"bytes,4,opt,name=distance,proto3" json:"distance,omitempty"`
+	// reputer nonce of the latest deviating report
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *ReputerConsensusDeviation) Reset() {
+	*x = ReputerConsensusDeviation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_stake_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReputerConsensusDeviation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReputerConsensusDeviation) ProtoMessage() {}
+
+// Deprecated: Use ReputerConsensusDeviation.ProtoReflect.Descriptor instead.
+func (*ReputerConsensusDeviation) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReputerConsensusDeviation) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *ReputerConsensusDeviation) GetReputer() string {
+	if x != nil {
+		return x.Reputer
+	}
+	return ""
+}
+
+func (x *ReputerConsensusDeviation) GetConsecutiveEpochs() int64 {
+	if x != nil {
+		return x.ConsecutiveEpochs
+	}
+	return 0
+}
+
+func (x *ReputerConsensusDeviation) GetDistance() string {
+	if x != nil {
+		return x.Distance
+	}
+	return ""
+}
+
+func (x *ReputerConsensusDeviation) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// stake a delegator moved from one reputer to another that can still be slashed
+// for faults of the source reputer until the redelegation completes
+type RedelegationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId                    uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Delegator                  string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	SrcReputer                 string `protobuf:"bytes,3,opt,name=src_reputer,json=srcReputer,proto3" json:"src_reputer,omitempty"`
+	DstReputer                 string `protobuf:"bytes,4,opt,name=dst_reputer,json=dstReputer,proto3" json:"dst_reputer,omitempty"`
+	Amount                     string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockRedelegationStarted   int64  `protobuf:"varint,6,opt,name=block_redelegation_started,json=blockRedelegationStarted,proto3" json:"block_redelegation_started,omitempty"`
+	BlockRedelegationCompleted int64  `protobuf:"varint,7,opt,name=block_redelegation_completed,json=blockRedelegationCompleted,proto3" json:"block_redelegation_completed,omitempty"`
+}
+
+func (x *RedelegationInfo) Reset() {
+	*x = RedelegationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_stake_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedelegationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedelegationInfo) ProtoMessage() {}
 
-// Deprecated: Use DelegateStakePlacement.ProtoReflect.Descriptor instead.
-func (*DelegateStakePlacement) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use RedelegationInfo.ProtoReflect.Descriptor instead.
+func (*RedelegationInfo) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DelegateStakePlacement) GetBlockRemovalStarted() int64 {
+func (x *RedelegationInfo) GetTopicId() uint64 {
 	if x != nil {
-		return x.BlockRemovalStarted
+		return x.TopicId
 	}
 	return 0
 }
 
-func (x *DelegateStakePlacement) GetTopicId() uint64 {
+func (x *RedelegationInfo) GetDelegator() string {
 	if x != nil {
-		return x.TopicId
+		return x.Delegator
 	}
-	return 0
+	return ""
 }
 
-func (x *DelegateStakePlacement) GetReputer() string {
+func (x *RedelegationInfo) GetSrcReputer() string {
 	if x != nil {
-		return x.Reputer
+		return x.SrcReputer
 	}
 	return ""
 }
 
-func (x *DelegateStakePlacement) GetDelegator() string {
+func (x *RedelegationInfo) GetDstReputer() string {
 	if x != nil {
-		return x.Delegator
+		return x.DstReputer
 	}
 	return ""
 }
 
-func (x *DelegateStakePlacement) GetAmount() string {
+func (x *RedelegationInfo) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
 	return ""
 }
 
-// Introduced in ConsensusVersion = 2
-type StakeInfo struct {
+func (x *RedelegationInfo) GetBlockRedelegationStarted() int64 {
+	if x != nil {
+		return x.BlockRedelegationStarted
+	}
+	return 0
+}
+
+func (x *RedelegationInfo) GetBlockRedelegationCompleted() int64 {
+	if x != nil {
+		return x.BlockRedelegationCompleted
+	}
+	return 0
+}
+
+type DelegatorInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Reputer string `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
-	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount     string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	RewardDebt string `protobuf:"bytes,2,opt,name=reward_debt,json=rewardDebt,proto3" json:"reward_debt,omitempty"`
 }
 
-func (x *StakeInfo) Reset() {
-	*x = StakeInfo{}
+func (x *DelegatorInfo) Reset() {
+	*x = DelegatorInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_stake_proto_msgTypes[2]
+		mi := &file_emissions_v3_stake_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StakeInfo) String() string {
+func (x *DelegatorInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StakeInfo) ProtoMessage() {}
-
-// Deprecated: Use StakeInfo.ProtoReflect.Descriptor instead.
-func (*StakeInfo) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{2}
-}
+func (*DelegatorInfo) ProtoMessage() {}
 
-func (x *StakeInfo) GetTopicId() uint64 {
-	if x != nil {
-		return x.TopicId
-	}
-	return 0
+// Deprecated: Use DelegatorInfo.ProtoReflect.Descriptor instead.
+func (*DelegatorInfo) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *StakeInfo) GetReputer() string {
+func (x *DelegatorInfo) GetAmount() string {
 	if x != nil {
-		return x.Reputer
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *StakeInfo) GetAmount() string {
+func (x *DelegatorInfo) GetRewardDebt() string {
 	if x != nil {
-		return x.Amount
+		return x.RewardDebt
 	}
 	return ""
 }
 
-// Introduced in ConsensusVersion = 2
-type StakeRemovalInfo struct {
+// cut of the rewards of its delegators that a reputer keeps on a topic. The
+// bounds are fixed when the commission is first set so that delegators know
+// how far the rate can ever go and how fast it can get there.
+type ReputerCommission struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockRemovalStarted   int64  `protobuf:"varint,1,opt,name=block_removal_started,json=blockRemovalStarted,proto3" json:"block_removal_started,omitempty"`
-	TopicId               uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Reputer               string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
-	Amount                string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	BlockRemovalCompleted int64  `protobuf:"varint,5,opt,name=block_removal_completed,json=blockRemovalCompleted,proto3" json:"block_removal_completed,omitempty"`
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Rate    string `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	MaxRate string `protobuf:"bytes,4,opt,name=max_rate,json=maxRate,proto3" json:"max_rate,omitempty"`
+	// largest change of the rate allowed in a topic epoch
+	MaxChangeRate string `protobuf:"bytes,5,opt,name=max_change_rate,json=maxChangeRate,proto3" json:"max_change_rate,omitempty"`
+	// block at which the rate was last set
+	UpdateBlockHeight int64 `protobuf:"varint,6,opt,name=update_block_height,json=updateBlockHeight,proto3" json:"update_block_height,omitempty"`
 }
 
-func (x *StakeRemovalInfo) Reset() {
-	*x = StakeRemovalInfo{}
+func (x *ReputerCommission) Reset() {
+	*x = ReputerCommission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_stake_proto_msgTypes[3]
+		mi := &file_emissions_v3_stake_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StakeRemovalInfo) String() string {
+func (x *ReputerCommission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StakeRemovalInfo) ProtoMessage() {}
+func (*ReputerCommission) ProtoMessage() {}
 
-// Deprecated: Use StakeRemovalInfo.ProtoReflect.Descriptor instead.
-func (*StakeRemovalInfo) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ReputerCommission.ProtoReflect.Descriptor instead.
+func (*ReputerCommission) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *StakeRemovalInfo) GetBlockRemovalStarted() int64 {
+func (x *ReputerCommission) GetTopicId() uint64 {
 	if x != nil {
-		return x.BlockRemovalStarted
+		return x.TopicId
 	}
 	return 0
 }
 
-func (x *StakeRemovalInfo) GetTopicId() uint64 {
+func (x *ReputerCommission) GetReputer() string {
 	if x != nil {
-		return x.TopicId
+		return x.Reputer
 	}
-	return 0
+	return ""
 }
 
-func (x *StakeRemovalInfo) GetReputer() string {
+func (x *ReputerCommission) GetRate() string {
 	if x != nil {
-		return x.Reputer
+		return x.Rate
 	}
 	return ""
 }
 
-func (x *StakeRemovalInfo) GetAmount() string {
+func (x *ReputerCommission) GetMaxRate() string {
 	if x != nil {
-		return x.Amount
+		return x.MaxRate
 	}
 	return ""
 }
 
-func (x *StakeRemovalInfo) GetBlockRemovalCompleted() int64 {
+func (x *ReputerCommission) GetMaxChangeRate() string {
 	if x != nil {
-		return x.BlockRemovalCompleted
+		return x.MaxChangeRate
+	}
+	return ""
+}
+
+func (x *ReputerCommission) GetUpdateBlockHeight() int64 {
+	if x != nil {
+		return x.UpdateBlockHeight
 	}
 	return 0
 }
 
-// Introduced in ConsensusVersion = 2
-type DelegateStakeRemovalInfo struct {
+// Stake locked until a block height, which can't be removed before then and is weighted by the
+// reward multiplier of its lock tier in reputer reward fractions
+type StakeLock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockRemovalStarted   int64  `protobuf:"varint,1,opt,name=block_removal_started,json=blockRemovalStarted,proto3" json:"block_removal_started,omitempty"`
-	TopicId               uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Reputer               string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
-	Delegator             string `protobuf:"bytes,4,opt,name=delegator,proto3" json:"delegator,omitempty"`
-	Amount                string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	BlockRemovalCompleted int64  `protobuf:"varint,6,opt,name=block_removal_completed,json=blockRemovalCompleted,proto3" json:"block_removal_completed,omitempty"`
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	// empty for stake of the reputer itself
+	Delegator        string `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Amount           string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	RewardMultiplier string `protobuf:"bytes,5,opt,name=reward_multiplier,json=rewardMultiplier,proto3" json:"reward_multiplier,omitempty"`
+	BlockLocked      int64  `protobuf:"varint,6,opt,name=block_locked,json=blockLocked,proto3" json:"block_locked,omitempty"`
+	BlockUnlocked    int64  `protobuf:"varint,7,opt,name=block_unlocked,json=blockUnlocked,proto3" json:"block_unlocked,omitempty"`
 }
 
-func (x *DelegateStakeRemovalInfo) Reset() {
-	*x = DelegateStakeRemovalInfo{}
+func (x *StakeLock) Reset() {
+	*x = StakeLock{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_stake_proto_msgTypes[4]
+		mi := &file_emissions_v3_stake_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DelegateStakeRemovalInfo) String() string {
+func (x *StakeLock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelegateStakeRemovalInfo) ProtoMessage() {}
-
-// Deprecated: Use DelegateStakeRemovalInfo.ProtoReflect.Descriptor instead.
-func (*DelegateStakeRemovalInfo) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{4}
-}
+func (*StakeLock) ProtoMessage() {}
 
-func (x *DelegateStakeRemovalInfo) GetBlockRemovalStarted() int64 {
-	if x != nil {
-		return x.BlockRemovalStarted
-	}
-	return 0
+// Deprecated: Use StakeLock.ProtoReflect.Descriptor instead.
+func (*StakeLock) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DelegateStakeRemovalInfo) GetTopicId() uint64 {
+func (x *StakeLock) GetTopicId() uint64 {
 	if x != nil {
 		return x.TopicId
 	}
 	return 0
 }
 
-func (x *DelegateStakeRemovalInfo) GetReputer() string {
+func (x *StakeLock) GetReputer() string {
 	if x != nil {
 		return x.Reputer
 	}
 	return ""
 }
 
-func (x *DelegateStakeRemovalInfo) GetDelegator() string {
+func (x *StakeLock) GetDelegator() string {
 	if x != nil {
 		return x.Delegator
 	}
 	return ""
 }
 
-func (x *DelegateStakeRemovalInfo) GetAmount() string {
+func (x *StakeLock) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
 	return ""
 }
 
-func (x *DelegateStakeRemovalInfo) GetBlockRemovalCompleted() int64 {
+func (x *StakeLock) GetRewardMultiplier() string {
 	if x != nil {
-		return x.BlockRemovalCompleted
-	}
-	return 0
-}
-
-type DelegatorInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Amount     string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	RewardDebt string `protobuf:"bytes,2,opt,name=reward_debt,json=rewardDebt,proto3" json:"reward_debt,omitempty"`
-}
-
-func (x *DelegatorInfo) Reset() {
-	*x = DelegatorInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_stake_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		return x.RewardMultiplier
 	}
+	return ""
 }
 
-func (x *DelegatorInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DelegatorInfo) ProtoMessage() {}
-
-// Deprecated: Use DelegatorInfo.ProtoReflect.Descriptor instead.
-func (*DelegatorInfo) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_stake_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *DelegatorInfo) GetAmount() string {
+func (x *StakeLock) GetBlockLocked() int64 {
 	if x != nil {
-		return x.Amount
+		return x.BlockLocked
 	}
-	return ""
+	return 0
 }
 
-func (x *DelegatorInfo) GetRewardDebt() string {
+func (x *StakeLock) GetBlockUnlocked() int64 {
 	if x != nil {
-		return x.RewardDebt
+		return x.BlockUnlocked
 	}
-	return ""
+	return 0
 }
 
 var File_emissions_v3_stake_proto protoreflect.FileDescriptor
@@ -4039,31 +10143,177 @@ var file_emissions_v3_stake_proto_rawDesc = []byte{
 	0x63, 0x6b, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
 	0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x62, 0x6c, 0x6f, 0x63,
 	0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x22, 0xba, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x4f, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x64,
-	0x65, 0x62, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
-	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c,
-	0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44,
-	0x65, 0x63, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x65, 0x62, 0x74, 0x42, 0xc0,
-	0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x76, 0x33, 0x42, 0x0a, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
-	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c,
-	0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x76, 0x33, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x33, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x5c, 0x56, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56,
-	0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x22, 0xbd, 0x01, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x42, 0x6f, 0x6e, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a,
+	0x15, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x69,
+	0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0xa3, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x48, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x4a, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x76, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x56, 0x65, 0x73, 0x74, 0x65, 0x64, 0x22, 0x83, 0x02, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0x80, 0x02,
+	0x0a, 0x15, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x42, 0x6f, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x15, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x48,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x22, 0xf7, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x73, 0x12, 0x53, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xd7, 0x02, 0x0a, 0x10, 0x52,
+	0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x72, 0x63, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x73, 0x74,
+	0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x73, 0x74, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x1a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x12, 0x40, 0x0a, 0x1c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x22, 0xba, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4f, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x64, 0x65, 0x62, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x65, 0x62,
+	0x74, 0x22, 0xfa, 0x02, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x08, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x5f, 0x0a,
+	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x0d, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2e,
+	0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xd8,
+	0x02, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x48, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x64, 0x0a, 0x11, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x10, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0xc0, 0x01, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x42, 0x0a,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76,
+	0x33, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x33, 0xa2, 0x02, 0x03,
+	0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x56, 0x33, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56,
+	0x33, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x33, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4078,14 +10328,22 @@ func file_emissions_v3_stake_proto_rawDescGZIP() []byte {
 	return file_emissions_v3_stake_proto_rawDescData
 }
 
-var file_emissions_v3_stake_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_emissions_v3_stake_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_emissions_v3_stake_proto_goTypes = []interface{}{
-	(*StakePlacement)(nil),           // 0: emissions.v3.StakePlacement
-	(*DelegateStakePlacement)(nil),   // 1: emissions.v3.DelegateStakePlacement
-	(*StakeInfo)(nil),                // 2: emissions.v3.StakeInfo
-	(*StakeRemovalInfo)(nil),         // 3: emissions.v3.StakeRemovalInfo
-	(*DelegateStakeRemovalInfo)(nil), // 4: emissions.v3.DelegateStakeRemovalInfo
-	(*DelegatorInfo)(nil),            // 5: emissions.v3.DelegatorInfo
+	(*StakePlacement)(nil),            // 0: emissions.v3.StakePlacement
+	(*DelegateStakePlacement)(nil),    // 1: emissions.v3.DelegateStakePlacement
+	(*StakeInfo)(nil),                 // 2: emissions.v3.StakeInfo
+	(*StakeRemovalInfo)(nil),          // 3: emissions.v3.StakeRemovalInfo
+	(*DelegateStakeRemovalInfo)(nil),  // 4: emissions.v3.DelegateStakeRemovalInfo
+	(*WorkerBond)(nil),                // 5: emissions.v3.WorkerBond
+	(*RewardEscrow)(nil),              // 6: emissions.v3.RewardEscrow
+	(*QueuedRewardPayout)(nil),        // 7: emissions.v3.QueuedRewardPayout
+	(*WorkerBondRemovalInfo)(nil),     // 8: emissions.v3.WorkerBondRemovalInfo
+	(*ReputerConsensusDeviation)(nil), // 9: emissions.v3.ReputerConsensusDeviation
+	(*RedelegationInfo)(nil),          // 10: emissions.v3.RedelegationInfo
+	(*DelegatorInfo)(nil),             // 11: emissions.v3.DelegatorInfo
+	(*ReputerCommission)(nil),         // 12: emissions.v3.ReputerCommission
+	(*StakeLock)(nil),                 // 13: emissions.v3.StakeLock
 }
 var file_emissions_v3_stake_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -4162,6 +10420,78 @@ func file_emissions_v3_stake_proto_init() {
 			}
 		}
 		file_emissions_v3_stake_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerBond); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_stake_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardEscrow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_stake_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueuedRewardPayout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_stake_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerBondRemovalInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_stake_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReputerConsensusDeviation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_stake_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedelegationInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_stake_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DelegatorInfo); i {
 			case 0:
 				return &v.state
@@ -4173,6 +10503,30 @@ func file_emissions_v3_stake_proto_init() {
 				return nil
 			}
 		}
+		file_emissions_v3_stake_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReputerCommission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_stake_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakeLock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4180,7 +10534,7 @@ func file_emissions_v3_stake_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v3_stake_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
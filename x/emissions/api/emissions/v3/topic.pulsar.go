@@ -3,7 +3,9 @@ package emissionsv3
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
@@ -13,25 +15,85 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Topic_24_list)(nil)
+
+type _Topic_24_list struct {
+	list *[]string
+}
+
+func (x *_Topic_24_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Topic_24_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Topic_24_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Topic_24_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Topic_24_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Topic at list field QuantileLevels as it is not of Message kind"))
+}
+
+func (x *_Topic_24_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Topic_24_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Topic_24_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Topic                            protoreflect.MessageDescriptor
-	fd_Topic_id                         protoreflect.FieldDescriptor
-	fd_Topic_creator                    protoreflect.FieldDescriptor
-	fd_Topic_metadata                   protoreflect.FieldDescriptor
-	fd_Topic_loss_method                protoreflect.FieldDescriptor
-	fd_Topic_epoch_last_ended           protoreflect.FieldDescriptor
-	fd_Topic_epoch_length               protoreflect.FieldDescriptor
-	fd_Topic_ground_truth_lag           protoreflect.FieldDescriptor
-	fd_Topic_p_norm                     protoreflect.FieldDescriptor
-	fd_Topic_alpha_regret               protoreflect.FieldDescriptor
-	fd_Topic_allow_negative             protoreflect.FieldDescriptor
-	fd_Topic_epsilon                    protoreflect.FieldDescriptor
-	fd_Topic_initial_regret             protoreflect.FieldDescriptor
-	fd_Topic_worker_submission_window   protoreflect.FieldDescriptor
-	fd_Topic_merit_sortition_alpha      protoreflect.FieldDescriptor
-	fd_Topic_active_inferer_quantile    protoreflect.FieldDescriptor
-	fd_Topic_active_forecaster_quantile protoreflect.FieldDescriptor
-	fd_Topic_active_reputer_quantile    protoreflect.FieldDescriptor
+	md_Topic                                  protoreflect.MessageDescriptor
+	fd_Topic_id                               protoreflect.FieldDescriptor
+	fd_Topic_creator                          protoreflect.FieldDescriptor
+	fd_Topic_metadata                         protoreflect.FieldDescriptor
+	fd_Topic_loss_method                      protoreflect.FieldDescriptor
+	fd_Topic_epoch_last_ended                 protoreflect.FieldDescriptor
+	fd_Topic_epoch_length                     protoreflect.FieldDescriptor
+	fd_Topic_ground_truth_lag                 protoreflect.FieldDescriptor
+	fd_Topic_p_norm                           protoreflect.FieldDescriptor
+	fd_Topic_alpha_regret                     protoreflect.FieldDescriptor
+	fd_Topic_allow_negative                   protoreflect.FieldDescriptor
+	fd_Topic_epsilon                          protoreflect.FieldDescriptor
+	fd_Topic_initial_regret                   protoreflect.FieldDescriptor
+	fd_Topic_worker_submission_window         protoreflect.FieldDescriptor
+	fd_Topic_merit_sortition_alpha            protoreflect.FieldDescriptor
+	fd_Topic_active_inferer_quantile          protoreflect.FieldDescriptor
+	fd_Topic_active_forecaster_quantile       protoreflect.FieldDescriptor
+	fd_Topic_active_reputer_quantile          protoreflect.FieldDescriptor
+	fd_Topic_output_dimension                 protoreflect.FieldDescriptor
+	fd_Topic_vector_synthesis_mode            protoreflect.FieldDescriptor
+	fd_Topic_quantile_levels                  protoreflect.FieldDescriptor
+	fd_Topic_loss_computation_mode            protoreflect.FieldDescriptor
+	fd_Topic_worker_reveal_window             protoreflect.FieldDescriptor
+	fd_Topic_worker_bond                      protoreflect.FieldDescriptor
+	fd_Topic_worker_bond_slash_missed_epochs  protoreflect.FieldDescriptor
+	fd_Topic_worker_bond_slash_fraction       protoreflect.FieldDescriptor
+	fd_Topic_reputer_slash_distance_threshold protoreflect.FieldDescriptor
+	fd_Topic_reputer_slash_epochs             protoreflect.FieldDescriptor
+	fd_Topic_reputer_self_slash_fraction      protoreflect.FieldDescriptor
+	fd_Topic_reputer_delegate_slash_fraction  protoreflect.FieldDescriptor
+	fd_Topic_reward_escrow_fraction           protoreflect.FieldDescriptor
+	fd_Topic_reward_escrow_vesting_blocks     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -54,6 +116,20 @@ func init() {
 	fd_Topic_active_inferer_quantile = md_Topic.Fields().ByName("active_inferer_quantile")
 	fd_Topic_active_forecaster_quantile = md_Topic.Fields().ByName("active_forecaster_quantile")
 	fd_Topic_active_reputer_quantile = md_Topic.Fields().ByName("active_reputer_quantile")
+	fd_Topic_output_dimension = md_Topic.Fields().ByName("output_dimension")
+	fd_Topic_vector_synthesis_mode = md_Topic.Fields().ByName("vector_synthesis_mode")
+	fd_Topic_quantile_levels = md_Topic.Fields().ByName("quantile_levels")
+	fd_Topic_loss_computation_mode = md_Topic.Fields().ByName("loss_computation_mode")
+	fd_Topic_worker_reveal_window = md_Topic.Fields().ByName("worker_reveal_window")
+	fd_Topic_worker_bond = md_Topic.Fields().ByName("worker_bond")
+	fd_Topic_worker_bond_slash_missed_epochs = md_Topic.Fields().ByName("worker_bond_slash_missed_epochs")
+	fd_Topic_worker_bond_slash_fraction = md_Topic.Fields().ByName("worker_bond_slash_fraction")
+	fd_Topic_reputer_slash_distance_threshold = md_Topic.Fields().ByName("reputer_slash_distance_threshold")
+	fd_Topic_reputer_slash_epochs = md_Topic.Fields().ByName("reputer_slash_epochs")
+	fd_Topic_reputer_self_slash_fraction = md_Topic.Fields().ByName("reputer_self_slash_fraction")
+	fd_Topic_reputer_delegate_slash_fraction = md_Topic.Fields().ByName("reputer_delegate_slash_fraction")
+	fd_Topic_reward_escrow_fraction = md_Topic.Fields().ByName("reward_escrow_fraction")
+	fd_Topic_reward_escrow_vesting_blocks = md_Topic.Fields().ByName("reward_escrow_vesting_blocks")
 }
 
 var _ protoreflect.Message = (*fastReflection_Topic)(nil)
@@ -223,6 +299,90 @@ func (x *fastReflection_Topic) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.OutputDimension != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutputDimension)
+		if !f(fd_Topic_output_dimension, value) {
+			return
+		}
+	}
+	if x.VectorSynthesisMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.VectorSynthesisMode))
+		if !f(fd_Topic_vector_synthesis_mode, value) {
+			return
+		}
+	}
+	if len(x.QuantileLevels) != 0 {
+		value := protoreflect.ValueOfList(&_Topic_24_list{list: &x.QuantileLevels})
+		if !f(fd_Topic_quantile_levels, value) {
+			return
+		}
+	}
+	if x.LossComputationMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.LossComputationMode))
+		if !f(fd_Topic_loss_computation_mode, value) {
+			return
+		}
+	}
+	if x.WorkerRevealWindow != int64(0) {
+		value := protoreflect.ValueOfInt64(x.WorkerRevealWindow)
+		if !f(fd_Topic_worker_reveal_window, value) {
+			return
+		}
+	}
+	if x.WorkerBond != "" {
+		value := protoreflect.ValueOfString(x.WorkerBond)
+		if !f(fd_Topic_worker_bond, value) {
+			return
+		}
+	}
+	if x.WorkerBondSlashMissedEpochs != int64(0) {
+		value := protoreflect.ValueOfInt64(x.WorkerBondSlashMissedEpochs)
+		if !f(fd_Topic_worker_bond_slash_missed_epochs, value) {
+			return
+		}
+	}
+	if x.WorkerBondSlashFraction != "" {
+		value := protoreflect.ValueOfString(x.WorkerBondSlashFraction)
+		if !f(fd_Topic_worker_bond_slash_fraction, value) {
+			return
+		}
+	}
+	if x.ReputerSlashDistanceThreshold != "" {
+		value := protoreflect.ValueOfString(x.ReputerSlashDistanceThreshold)
+		if !f(fd_Topic_reputer_slash_distance_threshold, value) {
+			return
+		}
+	}
+	if x.ReputerSlashEpochs != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ReputerSlashEpochs)
+		if !f(fd_Topic_reputer_slash_epochs, value) {
+			return
+		}
+	}
+	if x.ReputerSelfSlashFraction != "" {
+		value := protoreflect.ValueOfString(x.ReputerSelfSlashFraction)
+		if !f(fd_Topic_reputer_self_slash_fraction, value) {
+			return
+		}
+	}
+	if x.ReputerDelegateSlashFraction != "" {
+		value := protoreflect.ValueOfString(x.ReputerDelegateSlashFraction)
+		if !f(fd_Topic_reputer_delegate_slash_fraction, value) {
+			return
+		}
+	}
+	if x.RewardEscrowFraction != "" {
+		value := protoreflect.ValueOfString(x.RewardEscrowFraction)
+		if !f(fd_Topic_reward_escrow_fraction, value) {
+			return
+		}
+	}
+	if x.RewardEscrowVestingBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RewardEscrowVestingBlocks)
+		if !f(fd_Topic_reward_escrow_vesting_blocks, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -272,6 +432,34 @@ func (x *fastReflection_Topic) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ActiveForecasterQuantile != ""
 	case "emissions.v3.Topic.active_reputer_quantile":
 		return x.ActiveReputerQuantile != ""
+	case "emissions.v3.Topic.output_dimension":
+		return x.OutputDimension != uint64(0)
+	case "emissions.v3.Topic.vector_synthesis_mode":
+		return x.VectorSynthesisMode != 0
+	case "emissions.v3.Topic.quantile_levels":
+		return len(x.QuantileLevels) != 0
+	case "emissions.v3.Topic.loss_computation_mode":
+		return x.LossComputationMode != 0
+	case "emissions.v3.Topic.worker_reveal_window":
+		return x.WorkerRevealWindow != int64(0)
+	case "emissions.v3.Topic.worker_bond":
+		return x.WorkerBond != ""
+	case "emissions.v3.Topic.worker_bond_slash_missed_epochs":
+		return x.WorkerBondSlashMissedEpochs != int64(0)
+	case "emissions.v3.Topic.worker_bond_slash_fraction":
+		return x.WorkerBondSlashFraction != ""
+	case "emissions.v3.Topic.reputer_slash_distance_threshold":
+		return x.ReputerSlashDistanceThreshold != ""
+	case "emissions.v3.Topic.reputer_slash_epochs":
+		return x.ReputerSlashEpochs != int64(0)
+	case "emissions.v3.Topic.reputer_self_slash_fraction":
+		return x.ReputerSelfSlashFraction != ""
+	case "emissions.v3.Topic.reputer_delegate_slash_fraction":
+		return x.ReputerDelegateSlashFraction != ""
+	case "emissions.v3.Topic.reward_escrow_fraction":
+		return x.RewardEscrowFraction != ""
+	case "emissions.v3.Topic.reward_escrow_vesting_blocks":
+		return x.RewardEscrowVestingBlocks != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -322,6 +510,34 @@ func (x *fastReflection_Topic) Clear(fd protoreflect.FieldDescriptor) {
 		x.ActiveForecasterQuantile = ""
 	case "emissions.v3.Topic.active_reputer_quantile":
 		x.ActiveReputerQuantile = ""
+	case "emissions.v3.Topic.output_dimension":
+		x.OutputDimension = uint64(0)
+	case "emissions.v3.Topic.vector_synthesis_mode":
+		x.VectorSynthesisMode = 0
+	case "emissions.v3.Topic.quantile_levels":
+		x.QuantileLevels = nil
+	case "emissions.v3.Topic.loss_computation_mode":
+		x.LossComputationMode = 0
+	case "emissions.v3.Topic.worker_reveal_window":
+		x.WorkerRevealWindow = int64(0)
+	case "emissions.v3.Topic.worker_bond":
+		x.WorkerBond = ""
+	case "emissions.v3.Topic.worker_bond_slash_missed_epochs":
+		x.WorkerBondSlashMissedEpochs = int64(0)
+	case "emissions.v3.Topic.worker_bond_slash_fraction":
+		x.WorkerBondSlashFraction = ""
+	case "emissions.v3.Topic.reputer_slash_distance_threshold":
+		x.ReputerSlashDistanceThreshold = ""
+	case "emissions.v3.Topic.reputer_slash_epochs":
+		x.ReputerSlashEpochs = int64(0)
+	case "emissions.v3.Topic.reputer_self_slash_fraction":
+		x.ReputerSelfSlashFraction = ""
+	case "emissions.v3.Topic.reputer_delegate_slash_fraction":
+		x.ReputerDelegateSlashFraction = ""
+	case "emissions.v3.Topic.reward_escrow_fraction":
+		x.RewardEscrowFraction = ""
+	case "emissions.v3.Topic.reward_escrow_vesting_blocks":
+		x.RewardEscrowVestingBlocks = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -389,6 +605,51 @@ func (x *fastReflection_Topic) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "emissions.v3.Topic.active_reputer_quantile":
 		value := x.ActiveReputerQuantile
 		return protoreflect.ValueOfString(value)
+	case "emissions.v3.Topic.output_dimension":
+		value := x.OutputDimension
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.Topic.vector_synthesis_mode":
+		value := x.VectorSynthesisMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "emissions.v3.Topic.quantile_levels":
+		if len(x.QuantileLevels) == 0 {
+			return protoreflect.ValueOfList(&_Topic_24_list{})
+		}
+		listValue := &_Topic_24_list{list: &x.QuantileLevels}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.Topic.loss_computation_mode":
+		value := x.LossComputationMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "emissions.v3.Topic.worker_reveal_window":
+		value := x.WorkerRevealWindow
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.Topic.worker_bond":
+		value := x.WorkerBond
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.Topic.worker_bond_slash_missed_epochs":
+		value := x.WorkerBondSlashMissedEpochs
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.Topic.worker_bond_slash_fraction":
+		value := x.WorkerBondSlashFraction
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.Topic.reputer_slash_distance_threshold":
+		value := x.ReputerSlashDistanceThreshold
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.Topic.reputer_slash_epochs":
+		value := x.ReputerSlashEpochs
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.Topic.reputer_self_slash_fraction":
+		value := x.ReputerSelfSlashFraction
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.Topic.reputer_delegate_slash_fraction":
+		value := x.ReputerDelegateSlashFraction
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.Topic.reward_escrow_fraction":
+		value := x.RewardEscrowFraction
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.Topic.reward_escrow_vesting_blocks":
+		value := x.RewardEscrowVestingBlocks
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -443,6 +704,36 @@ func (x *fastReflection_Topic) Set(fd protoreflect.FieldDescriptor, value protor
 		x.ActiveForecasterQuantile = value.Interface().(string)
 	case "emissions.v3.Topic.active_reputer_quantile":
 		x.ActiveReputerQuantile = value.Interface().(string)
+	case "emissions.v3.Topic.output_dimension":
+		x.OutputDimension = value.Uint()
+	case "emissions.v3.Topic.vector_synthesis_mode":
+		x.VectorSynthesisMode = (VectorSynthesisMode)(value.Enum())
+	case "emissions.v3.Topic.quantile_levels":
+		lv := value.List()
+		clv := lv.(*_Topic_24_list)
+		x.QuantileLevels = *clv.list
+	case "emissions.v3.Topic.loss_computation_mode":
+		x.LossComputationMode = (LossComputationMode)(value.Enum())
+	case "emissions.v3.Topic.worker_reveal_window":
+		x.WorkerRevealWindow = value.Int()
+	case "emissions.v3.Topic.worker_bond":
+		x.WorkerBond = value.Interface().(string)
+	case "emissions.v3.Topic.worker_bond_slash_missed_epochs":
+		x.WorkerBondSlashMissedEpochs = value.Int()
+	case "emissions.v3.Topic.worker_bond_slash_fraction":
+		x.WorkerBondSlashFraction = value.Interface().(string)
+	case "emissions.v3.Topic.reputer_slash_distance_threshold":
+		x.ReputerSlashDistanceThreshold = value.Interface().(string)
+	case "emissions.v3.Topic.reputer_slash_epochs":
+		x.ReputerSlashEpochs = value.Int()
+	case "emissions.v3.Topic.reputer_self_slash_fraction":
+		x.ReputerSelfSlashFraction = value.Interface().(string)
+	case "emissions.v3.Topic.reputer_delegate_slash_fraction":
+		x.ReputerDelegateSlashFraction = value.Interface().(string)
+	case "emissions.v3.Topic.reward_escrow_fraction":
+		x.RewardEscrowFraction = value.Interface().(string)
+	case "emissions.v3.Topic.reward_escrow_vesting_blocks":
+		x.RewardEscrowVestingBlocks = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -463,6 +754,12 @@ func (x *fastReflection_Topic) Set(fd protoreflect.FieldDescriptor, value protor
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Topic) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "emissions.v3.Topic.quantile_levels":
+		if x.QuantileLevels == nil {
+			x.QuantileLevels = []string{}
+		}
+		value := &_Topic_24_list{list: &x.QuantileLevels}
+		return protoreflect.ValueOfList(value)
 	case "emissions.v3.Topic.id":
 		panic(fmt.Errorf("field id of message emissions.v3.Topic is not mutable"))
 	case "emissions.v3.Topic.creator":
@@ -497,6 +794,32 @@ func (x *fastReflection_Topic) Mutable(fd protoreflect.FieldDescriptor) protoref
 		panic(fmt.Errorf("field active_forecaster_quantile of message emissions.v3.Topic is not mutable"))
 	case "emissions.v3.Topic.active_reputer_quantile":
 		panic(fmt.Errorf("field active_reputer_quantile of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.output_dimension":
+		panic(fmt.Errorf("field output_dimension of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.vector_synthesis_mode":
+		panic(fmt.Errorf("field vector_synthesis_mode of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.loss_computation_mode":
+		panic(fmt.Errorf("field loss_computation_mode of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.worker_reveal_window":
+		panic(fmt.Errorf("field worker_reveal_window of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.worker_bond":
+		panic(fmt.Errorf("field worker_bond of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.worker_bond_slash_missed_epochs":
+		panic(fmt.Errorf("field worker_bond_slash_missed_epochs of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.worker_bond_slash_fraction":
+		panic(fmt.Errorf("field worker_bond_slash_fraction of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.reputer_slash_distance_threshold":
+		panic(fmt.Errorf("field reputer_slash_distance_threshold of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.reputer_slash_epochs":
+		panic(fmt.Errorf("field reputer_slash_epochs of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.reputer_self_slash_fraction":
+		panic(fmt.Errorf("field reputer_self_slash_fraction of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.reputer_delegate_slash_fraction":
+		panic(fmt.Errorf("field reputer_delegate_slash_fraction of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.reward_escrow_fraction":
+		panic(fmt.Errorf("field reward_escrow_fraction of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.reward_escrow_vesting_blocks":
+		panic(fmt.Errorf("field reward_escrow_vesting_blocks of message emissions.v3.Topic is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -544,6 +867,35 @@ func (x *fastReflection_Topic) NewField(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfString("")
 	case "emissions.v3.Topic.active_reputer_quantile":
 		return protoreflect.ValueOfString("")
+	case "emissions.v3.Topic.output_dimension":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.Topic.vector_synthesis_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "emissions.v3.Topic.quantile_levels":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Topic_24_list{list: &list})
+	case "emissions.v3.Topic.loss_computation_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "emissions.v3.Topic.worker_reveal_window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.Topic.worker_bond":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.Topic.worker_bond_slash_missed_epochs":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.Topic.worker_bond_slash_fraction":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.Topic.reputer_slash_distance_threshold":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.Topic.reputer_slash_epochs":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.Topic.reputer_self_slash_fraction":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.Topic.reputer_delegate_slash_fraction":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.Topic.reward_escrow_fraction":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.Topic.reward_escrow_vesting_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -675,6 +1027,57 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		if x.OutputDimension != 0 {
+			n += 2 + runtime.Sov(uint64(x.OutputDimension))
+		}
+		if x.VectorSynthesisMode != 0 {
+			n += 2 + runtime.Sov(uint64(x.VectorSynthesisMode))
+		}
+		if len(x.QuantileLevels) > 0 {
+			for _, s := range x.QuantileLevels {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LossComputationMode != 0 {
+			n += 2 + runtime.Sov(uint64(x.LossComputationMode))
+		}
+		if x.WorkerRevealWindow != 0 {
+			n += 2 + runtime.Sov(uint64(x.WorkerRevealWindow))
+		}
+		l = len(x.WorkerBond)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.WorkerBondSlashMissedEpochs != 0 {
+			n += 2 + runtime.Sov(uint64(x.WorkerBondSlashMissedEpochs))
+		}
+		l = len(x.WorkerBondSlashFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReputerSlashDistanceThreshold)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReputerSlashEpochs != 0 {
+			n += 2 + runtime.Sov(uint64(x.ReputerSlashEpochs))
+		}
+		l = len(x.ReputerSelfSlashFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReputerDelegateSlashFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RewardEscrowFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.RewardEscrowVestingBlocks != 0 {
+			n += 2 + runtime.Sov(uint64(x.RewardEscrowVestingBlocks))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -704,6 +1107,120 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.RewardEscrowVestingBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RewardEscrowVestingBlocks))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x98
+		}
+		if len(x.RewardEscrowFraction) > 0 {
+			i -= len(x.RewardEscrowFraction)
+			copy(dAtA[i:], x.RewardEscrowFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardEscrowFraction)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.ReputerDelegateSlashFraction) > 0 {
+			i -= len(x.ReputerDelegateSlashFraction)
+			copy(dAtA[i:], x.ReputerDelegateSlashFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReputerDelegateSlashFraction)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.ReputerSelfSlashFraction) > 0 {
+			i -= len(x.ReputerSelfSlashFraction)
+			copy(dAtA[i:], x.ReputerSelfSlashFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReputerSelfSlashFraction)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.ReputerSlashEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReputerSlashEpochs))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf8
+		}
+		if len(x.ReputerSlashDistanceThreshold) > 0 {
+			i -= len(x.ReputerSlashDistanceThreshold)
+			copy(dAtA[i:], x.ReputerSlashDistanceThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReputerSlashDistanceThreshold)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+		if len(x.WorkerBondSlashFraction) > 0 {
+			i -= len(x.WorkerBondSlashFraction)
+			copy(dAtA[i:], x.WorkerBondSlashFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WorkerBondSlashFraction)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+		if x.WorkerBondSlashMissedEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WorkerBondSlashMissedEpochs))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe0
+		}
+		if len(x.WorkerBond) > 0 {
+			i -= len(x.WorkerBond)
+			copy(dAtA[i:], x.WorkerBond)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WorkerBond)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+		if x.WorkerRevealWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WorkerRevealWindow))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd0
+		}
+		if x.LossComputationMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LossComputationMode))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc8
+		}
+		if len(x.QuantileLevels) > 0 {
+			for iNdEx := len(x.QuantileLevels) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.QuantileLevels[iNdEx])
+				copy(dAtA[i:], x.QuantileLevels[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.QuantileLevels[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xc2
+			}
+		}
+		if x.VectorSynthesisMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VectorSynthesisMode))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
+		}
+		if x.OutputDimension != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputDimension))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
 		if len(x.ActiveReputerQuantile) > 0 {
 			i -= len(x.ActiveReputerQuantile)
 			copy(dAtA[i:], x.ActiveReputerQuantile)
@@ -1344,113 +1861,915 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 				}
 				x.ActiveReputerQuantile = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputDimension", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.OutputDimension = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutputDimension |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VectorSynthesisMode", wireType)
+				}
+				x.VectorSynthesisMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VectorSynthesisMode |= VectorSynthesisMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuantileLevels", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.QuantileLevels = append(x.QuantileLevels, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 25:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LossComputationMode", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
+				x.LossComputationMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LossComputationMode |= LossComputationMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 26:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerRevealWindow", wireType)
+				}
+				x.WorkerRevealWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WorkerRevealWindow |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 27:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerBond", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WorkerBond = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 28:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerBondSlashMissedEpochs", wireType)
+				}
+				x.WorkerBondSlashMissedEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WorkerBondSlashMissedEpochs |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 29:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerBondSlashFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WorkerBondSlashFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 30:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashDistanceThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputerSlashDistanceThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 31:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashEpochs", wireType)
+				}
+				x.ReputerSlashEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReputerSlashEpochs |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 32:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerSelfSlashFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputerSelfSlashFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 33:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerDelegateSlashFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputerDelegateSlashFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 34:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardEscrowFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardEscrowFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 35:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardEscrowVestingBlocks", wireType)
+				}
+				x.RewardEscrowVestingBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RewardEscrowVestingBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TopicList_1_list)(nil)
+
+type _TopicList_1_list struct {
+	list *[]*Topic
+}
+
+func (x *_TopicList_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicList_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TopicList_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Topic)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicList_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Topic)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicList_1_list) AppendMutable() protoreflect.Value {
+	v := new(Topic)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TopicList_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicList_1_list) NewElement() protoreflect.Value {
+	v := new(Topic)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TopicList_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TopicList        protoreflect.MessageDescriptor
+	fd_TopicList_topics protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_topic_proto_init()
+	md_TopicList = File_emissions_v3_topic_proto.Messages().ByName("TopicList")
+	fd_TopicList_topics = md_TopicList.Fields().ByName("topics")
+}
+
+var _ protoreflect.Message = (*fastReflection_TopicList)(nil)
+
+type fastReflection_TopicList TopicList
+
+func (x *TopicList) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicList)(x)
+}
+
+func (x *TopicList) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_topic_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TopicList_messageType fastReflection_TopicList_messageType
+var _ protoreflect.MessageType = fastReflection_TopicList_messageType{}
+
+type fastReflection_TopicList_messageType struct{}
+
+func (x fastReflection_TopicList_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicList)(nil)
+}
+func (x fastReflection_TopicList_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicList)
+}
+func (x fastReflection_TopicList_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicList
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TopicList) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicList
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TopicList) Type() protoreflect.MessageType {
+	return _fastReflection_TopicList_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TopicList) New() protoreflect.Message {
+	return new(fastReflection_TopicList)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TopicList) Interface() protoreflect.ProtoMessage {
+	return (*TopicList)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TopicList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Topics) != 0 {
+		value := protoreflect.ValueOfList(&_TopicList_1_list{list: &x.Topics})
+		if !f(fd_TopicList_topics, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TopicList) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.TopicList.topics":
+		return len(x.Topics) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicList"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicList) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.TopicList.topics":
+		x.Topics = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicList"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TopicList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.TopicList.topics":
+		if len(x.Topics) == 0 {
+			return protoreflect.ValueOfList(&_TopicList_1_list{})
+		}
+		listValue := &_TopicList_1_list{list: &x.Topics}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicList"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicList does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.TopicList.topics":
+		lv := value.List()
+		clv := lv.(*_TopicList_1_list)
+		x.Topics = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicList"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.TopicList.topics":
+		if x.Topics == nil {
+			x.Topics = []*Topic{}
+		}
+		value := &_TopicList_1_list{list: &x.Topics}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicList"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicList does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TopicList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.TopicList.topics":
+		list := []*Topic{}
+		return protoreflect.ValueOfList(&_TopicList_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicList"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicList does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TopicList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.TopicList", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TopicList) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicList) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TopicList) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TopicList)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Topics) > 0 {
+			for _, e := range x.Topics {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TopicList)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Topics) > 0 {
+			for iNdEx := len(x.Topics) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Topics[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TopicList)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicList: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicList: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Topics = append(x.Topics, &Topic{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Topics[len(x.Topics)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
 		Unmarshal:         unmarshal,
 		Merge:             nil,
 		CheckInitialized:  nil,
 	}
 }
 
-var _ protoreflect.List = (*_TopicList_1_list)(nil)
-
-type _TopicList_1_list struct {
-	list *[]*Topic
-}
-
-func (x *_TopicList_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TopicList_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_TopicList_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Topic)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TopicList_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Topic)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TopicList_1_list) AppendMutable() protoreflect.Value {
-	v := new(Topic)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_TopicList_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TopicList_1_list) NewElement() protoreflect.Value {
-	v := new(Topic)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_TopicList_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_TopicList        protoreflect.MessageDescriptor
-	fd_TopicList_topics protoreflect.FieldDescriptor
+	md_TimestampedActorNonce              protoreflect.MessageDescriptor
+	fd_TimestampedActorNonce_block_height protoreflect.FieldDescriptor
+	fd_TimestampedActorNonce_nonce        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v3_topic_proto_init()
-	md_TopicList = File_emissions_v3_topic_proto.Messages().ByName("TopicList")
-	fd_TopicList_topics = md_TopicList.Fields().ByName("topics")
+	md_TimestampedActorNonce = File_emissions_v3_topic_proto.Messages().ByName("TimestampedActorNonce")
+	fd_TimestampedActorNonce_block_height = md_TimestampedActorNonce.Fields().ByName("block_height")
+	fd_TimestampedActorNonce_nonce = md_TimestampedActorNonce.Fields().ByName("nonce")
 }
 
-var _ protoreflect.Message = (*fastReflection_TopicList)(nil)
+var _ protoreflect.Message = (*fastReflection_TimestampedActorNonce)(nil)
 
-type fastReflection_TopicList TopicList
+type fastReflection_TimestampedActorNonce TimestampedActorNonce
 
-func (x *TopicList) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TopicList)(x)
+func (x *TimestampedActorNonce) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TimestampedActorNonce)(x)
 }
 
-func (x *TopicList) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v3_topic_proto_msgTypes[1]
+func (x *TimestampedActorNonce) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_topic_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1461,43 +2780,43 @@ func (x *TopicList) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TopicList_messageType fastReflection_TopicList_messageType
-var _ protoreflect.MessageType = fastReflection_TopicList_messageType{}
+var _fastReflection_TimestampedActorNonce_messageType fastReflection_TimestampedActorNonce_messageType
+var _ protoreflect.MessageType = fastReflection_TimestampedActorNonce_messageType{}
 
-type fastReflection_TopicList_messageType struct{}
+type fastReflection_TimestampedActorNonce_messageType struct{}
 
-func (x fastReflection_TopicList_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TopicList)(nil)
+func (x fastReflection_TimestampedActorNonce_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TimestampedActorNonce)(nil)
 }
-func (x fastReflection_TopicList_messageType) New() protoreflect.Message {
-	return new(fastReflection_TopicList)
+func (x fastReflection_TimestampedActorNonce_messageType) New() protoreflect.Message {
+	return new(fastReflection_TimestampedActorNonce)
 }
-func (x fastReflection_TopicList_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TopicList
+func (x fastReflection_TimestampedActorNonce_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TimestampedActorNonce
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TopicList) Descriptor() protoreflect.MessageDescriptor {
-	return md_TopicList
+func (x *fastReflection_TimestampedActorNonce) Descriptor() protoreflect.MessageDescriptor {
+	return md_TimestampedActorNonce
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TopicList) Type() protoreflect.MessageType {
-	return _fastReflection_TopicList_messageType
+func (x *fastReflection_TimestampedActorNonce) Type() protoreflect.MessageType {
+	return _fastReflection_TimestampedActorNonce_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TopicList) New() protoreflect.Message {
-	return new(fastReflection_TopicList)
+func (x *fastReflection_TimestampedActorNonce) New() protoreflect.Message {
+	return new(fastReflection_TimestampedActorNonce)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TopicList) Interface() protoreflect.ProtoMessage {
-	return (*TopicList)(x)
+func (x *fastReflection_TimestampedActorNonce) Interface() protoreflect.ProtoMessage {
+	return (*TimestampedActorNonce)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1505,10 +2824,16 @@ func (x *fastReflection_TopicList) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TopicList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Topics) != 0 {
-		value := protoreflect.ValueOfList(&_TopicList_1_list{list: &x.Topics})
-		if !f(fd_TopicList_topics, value) {
+func (x *fastReflection_TimestampedActorNonce) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_TimestampedActorNonce_block_height, value) {
+			return
+		}
+	}
+	if x.Nonce != nil {
+		value := protoreflect.ValueOfMessage(x.Nonce.ProtoReflect())
+		if !f(fd_TimestampedActorNonce_nonce, value) {
 			return
 		}
 	}
@@ -1525,15 +2850,17 @@ func (x *fastReflection_TopicList) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TopicList) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TimestampedActorNonce) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v3.TopicList.topics":
-		return len(x.Topics) != 0
+	case "emissions.v3.TimestampedActorNonce.block_height":
+		return x.BlockHeight != int64(0)
+	case "emissions.v3.TimestampedActorNonce.nonce":
+		return x.Nonce != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TimestampedActorNonce"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TimestampedActorNonce does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1543,15 +2870,17 @@ func (x *fastReflection_TopicList) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicList) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TimestampedActorNonce) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v3.TopicList.topics":
-		x.Topics = nil
+	case "emissions.v3.TimestampedActorNonce.block_height":
+		x.BlockHeight = int64(0)
+	case "emissions.v3.TimestampedActorNonce.nonce":
+		x.Nonce = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TimestampedActorNonce"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TimestampedActorNonce does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1561,19 +2890,19 @@ func (x *fastReflection_TopicList) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TopicList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TimestampedActorNonce) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v3.TopicList.topics":
-		if len(x.Topics) == 0 {
-			return protoreflect.ValueOfList(&_TopicList_1_list{})
-		}
-		listValue := &_TopicList_1_list{list: &x.Topics}
-		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.TimestampedActorNonce.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.TimestampedActorNonce.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TimestampedActorNonce"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicList does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TimestampedActorNonce does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1587,17 +2916,17 @@ func (x *fastReflection_TopicList) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TimestampedActorNonce) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v3.TopicList.topics":
-		lv := value.List()
-		clv := lv.(*_TopicList_1_list)
-		x.Topics = *clv.list
+	case "emissions.v3.TimestampedActorNonce.block_height":
+		x.BlockHeight = value.Int()
+	case "emissions.v3.TimestampedActorNonce.nonce":
+		x.Nonce = value.Message().Interface().(*Nonce)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TimestampedActorNonce"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TimestampedActorNonce does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1611,45 +2940,48 @@ func (x *fastReflection_TopicList) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TimestampedActorNonce) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.TopicList.topics":
-		if x.Topics == nil {
-			x.Topics = []*Topic{}
+	case "emissions.v3.TimestampedActorNonce.nonce":
+		if x.Nonce == nil {
+			x.Nonce = new(Nonce)
 		}
-		value := &_TopicList_1_list{list: &x.Topics}
-		return protoreflect.ValueOfList(value)
+		return protoreflect.ValueOfMessage(x.Nonce.ProtoReflect())
+	case "emissions.v3.TimestampedActorNonce.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v3.TimestampedActorNonce is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TimestampedActorNonce"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TimestampedActorNonce does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TopicList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TimestampedActorNonce) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.TopicList.topics":
-		list := []*Topic{}
-		return protoreflect.ValueOfList(&_TopicList_1_list{list: &list})
+	case "emissions.v3.TimestampedActorNonce.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.TimestampedActorNonce.nonce":
+		m := new(Nonce)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TimestampedActorNonce"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TimestampedActorNonce does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TopicList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TimestampedActorNonce) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.TopicList", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.TimestampedActorNonce", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1657,7 +2989,7 @@ func (x *fastReflection_TopicList) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TopicList) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TimestampedActorNonce) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1668,7 +3000,7 @@ func (x *fastReflection_TopicList) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicList) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TimestampedActorNonce) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1680,7 +3012,7 @@ func (x *fastReflection_TopicList) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TopicList) IsValid() bool {
+func (x *fastReflection_TimestampedActorNonce) IsValid() bool {
 	return x != nil
 }
 
@@ -1690,9 +3022,9 @@ func (x *fastReflection_TopicList) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TopicList)
+		x := input.Message.Interface().(*TimestampedActorNonce)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1704,11 +3036,12 @@ func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Topics) > 0 {
-			for _, e := range x.Topics {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.Nonce != nil {
+			l = options.Size(x.Nonce)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1720,7 +3053,7 @@ func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TopicList)
+		x := input.Message.Interface().(*TimestampedActorNonce)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1739,21 +3072,24 @@ func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Topics) > 0 {
-			for iNdEx := len(x.Topics) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Topics[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.Nonce != nil {
+			encoded, err := options.Marshal(x.Nonce)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1766,7 +3102,7 @@ func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TopicList)
+		x := input.Message.Interface().(*TimestampedActorNonce)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1798,15 +3134,34 @@ func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicList: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TimestampedActorNonce: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicList: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TimestampedActorNonce: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1833,8 +3188,10 @@ func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Topics = append(x.Topics, &Topic{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Topics[len(x.Topics)-1]); err != nil {
+				if x.Nonce == nil {
+					x.Nonce = &Nonce{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Nonce); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1873,29 +3230,73 @@ func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_TopicIds_1_list)(nil)
+
+type _TopicIds_1_list struct {
+	list *[]uint64
+}
+
+func (x *_TopicIds_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicIds_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_TopicIds_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicIds_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicIds_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicIds at list field TopicIds as it is not of Message kind"))
+}
+
+func (x *_TopicIds_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicIds_1_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_TopicIds_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_TimestampedActorNonce              protoreflect.MessageDescriptor
-	fd_TimestampedActorNonce_block_height protoreflect.FieldDescriptor
-	fd_TimestampedActorNonce_nonce        protoreflect.FieldDescriptor
+	md_TopicIds           protoreflect.MessageDescriptor
+	fd_TopicIds_topic_ids protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v3_topic_proto_init()
-	md_TimestampedActorNonce = File_emissions_v3_topic_proto.Messages().ByName("TimestampedActorNonce")
-	fd_TimestampedActorNonce_block_height = md_TimestampedActorNonce.Fields().ByName("block_height")
-	fd_TimestampedActorNonce_nonce = md_TimestampedActorNonce.Fields().ByName("nonce")
+	md_TopicIds = File_emissions_v3_topic_proto.Messages().ByName("TopicIds")
+	fd_TopicIds_topic_ids = md_TopicIds.Fields().ByName("topic_ids")
 }
 
-var _ protoreflect.Message = (*fastReflection_TimestampedActorNonce)(nil)
+var _ protoreflect.Message = (*fastReflection_TopicIds)(nil)
 
-type fastReflection_TimestampedActorNonce TimestampedActorNonce
+type fastReflection_TopicIds TopicIds
 
-func (x *TimestampedActorNonce) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TimestampedActorNonce)(x)
+func (x *TopicIds) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicIds)(x)
 }
 
-func (x *TimestampedActorNonce) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v3_topic_proto_msgTypes[2]
+func (x *TopicIds) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_topic_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1906,43 +3307,43 @@ func (x *TimestampedActorNonce) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TimestampedActorNonce_messageType fastReflection_TimestampedActorNonce_messageType
-var _ protoreflect.MessageType = fastReflection_TimestampedActorNonce_messageType{}
+var _fastReflection_TopicIds_messageType fastReflection_TopicIds_messageType
+var _ protoreflect.MessageType = fastReflection_TopicIds_messageType{}
 
-type fastReflection_TimestampedActorNonce_messageType struct{}
+type fastReflection_TopicIds_messageType struct{}
 
-func (x fastReflection_TimestampedActorNonce_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TimestampedActorNonce)(nil)
+func (x fastReflection_TopicIds_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicIds)(nil)
 }
-func (x fastReflection_TimestampedActorNonce_messageType) New() protoreflect.Message {
-	return new(fastReflection_TimestampedActorNonce)
+func (x fastReflection_TopicIds_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicIds)
 }
-func (x fastReflection_TimestampedActorNonce_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TimestampedActorNonce
+func (x fastReflection_TopicIds_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicIds
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TimestampedActorNonce) Descriptor() protoreflect.MessageDescriptor {
-	return md_TimestampedActorNonce
+func (x *fastReflection_TopicIds) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicIds
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TimestampedActorNonce) Type() protoreflect.MessageType {
-	return _fastReflection_TimestampedActorNonce_messageType
+func (x *fastReflection_TopicIds) Type() protoreflect.MessageType {
+	return _fastReflection_TopicIds_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TimestampedActorNonce) New() protoreflect.Message {
-	return new(fastReflection_TimestampedActorNonce)
+func (x *fastReflection_TopicIds) New() protoreflect.Message {
+	return new(fastReflection_TopicIds)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TimestampedActorNonce) Interface() protoreflect.ProtoMessage {
-	return (*TimestampedActorNonce)(x)
+func (x *fastReflection_TopicIds) Interface() protoreflect.ProtoMessage {
+	return (*TopicIds)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1950,16 +3351,10 @@ func (x *fastReflection_TimestampedActorNonce) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TimestampedActorNonce) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BlockHeight != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockHeight)
-		if !f(fd_TimestampedActorNonce_block_height, value) {
-			return
-		}
-	}
-	if x.Nonce != nil {
-		value := protoreflect.ValueOfMessage(x.Nonce.ProtoReflect())
-		if !f(fd_TimestampedActorNonce_nonce, value) {
+func (x *fastReflection_TopicIds) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TopicIds) != 0 {
+		value := protoreflect.ValueOfList(&_TopicIds_1_list{list: &x.TopicIds})
+		if !f(fd_TopicIds_topic_ids, value) {
 			return
 		}
 	}
@@ -1976,17 +3371,15 @@ func (x *fastReflection_TimestampedActorNonce) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TimestampedActorNonce) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TopicIds) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v3.TimestampedActorNonce.block_height":
-		return x.BlockHeight != int64(0)
-	case "emissions.v3.TimestampedActorNonce.nonce":
-		return x.Nonce != nil
+	case "emissions.v3.TopicIds.topic_ids":
+		return len(x.TopicIds) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TimestampedActorNonce"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIds"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TimestampedActorNonce does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TopicIds does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1996,17 +3389,15 @@ func (x *fastReflection_TimestampedActorNonce) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TimestampedActorNonce) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TopicIds) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v3.TimestampedActorNonce.block_height":
-		x.BlockHeight = int64(0)
-	case "emissions.v3.TimestampedActorNonce.nonce":
-		x.Nonce = nil
+	case "emissions.v3.TopicIds.topic_ids":
+		x.TopicIds = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TimestampedActorNonce"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIds"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TimestampedActorNonce does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TopicIds does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2016,19 +3407,19 @@ func (x *fastReflection_TimestampedActorNonce) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TimestampedActorNonce) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicIds) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v3.TimestampedActorNonce.block_height":
-		value := x.BlockHeight
-		return protoreflect.ValueOfInt64(value)
-	case "emissions.v3.TimestampedActorNonce.nonce":
-		value := x.Nonce
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v3.TopicIds.topic_ids":
+		if len(x.TopicIds) == 0 {
+			return protoreflect.ValueOfList(&_TopicIds_1_list{})
+		}
+		listValue := &_TopicIds_1_list{list: &x.TopicIds}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TimestampedActorNonce"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIds"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TimestampedActorNonce does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TopicIds does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2042,17 +3433,17 @@ func (x *fastReflection_TimestampedActorNonce) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TimestampedActorNonce) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TopicIds) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v3.TimestampedActorNonce.block_height":
-		x.BlockHeight = value.Int()
-	case "emissions.v3.TimestampedActorNonce.nonce":
-		x.Nonce = value.Message().Interface().(*Nonce)
+	case "emissions.v3.TopicIds.topic_ids":
+		lv := value.List()
+		clv := lv.(*_TopicIds_1_list)
+		x.TopicIds = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TimestampedActorNonce"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIds"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TimestampedActorNonce does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TopicIds does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2066,48 +3457,45 @@ func (x *fastReflection_TimestampedActorNonce) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TimestampedActorNonce) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicIds) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.TimestampedActorNonce.nonce":
-		if x.Nonce == nil {
-			x.Nonce = new(Nonce)
+	case "emissions.v3.TopicIds.topic_ids":
+		if x.TopicIds == nil {
+			x.TopicIds = []uint64{}
 		}
-		return protoreflect.ValueOfMessage(x.Nonce.ProtoReflect())
-	case "emissions.v3.TimestampedActorNonce.block_height":
-		panic(fmt.Errorf("field block_height of message emissions.v3.TimestampedActorNonce is not mutable"))
+		value := &_TopicIds_1_list{list: &x.TopicIds}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TimestampedActorNonce"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIds"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TimestampedActorNonce does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TopicIds does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TimestampedActorNonce) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicIds) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.TimestampedActorNonce.block_height":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "emissions.v3.TimestampedActorNonce.nonce":
-		m := new(Nonce)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v3.TopicIds.topic_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_TopicIds_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TimestampedActorNonce"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIds"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TimestampedActorNonce does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TopicIds does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TimestampedActorNonce) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TopicIds) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.TimestampedActorNonce", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.TopicIds", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2115,7 +3503,7 @@ func (x *fastReflection_TimestampedActorNonce) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TimestampedActorNonce) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TopicIds) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2126,7 +3514,7 @@ func (x *fastReflection_TimestampedActorNonce) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TimestampedActorNonce) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TopicIds) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2138,7 +3526,7 @@ func (x *fastReflection_TimestampedActorNonce) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TimestampedActorNonce) IsValid() bool {
+func (x *fastReflection_TopicIds) IsValid() bool {
 	return x != nil
 }
 
@@ -2148,9 +3536,9 @@ func (x *fastReflection_TimestampedActorNonce) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TopicIds) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TimestampedActorNonce)
+		x := input.Message.Interface().(*TopicIds)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2162,12 +3550,12 @@ func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if x.BlockHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockHeight))
-		}
-		if x.Nonce != nil {
-			l = options.Size(x.Nonce)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.TopicIds) > 0 {
+			l = 0
+			for _, e := range x.TopicIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2179,7 +3567,7 @@ func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TimestampedActorNonce)
+		x := input.Message.Interface().(*TopicIds)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2198,24 +3586,25 @@ func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Nonce != nil {
-			encoded, err := options.Marshal(x.Nonce)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.TopicIds) > 0 {
+			var pksize2 int
+			for _, num := range x.TopicIds {
+				pksize2 += runtime.Sov(uint64(num))
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.BlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i -= pksize2
+			j1 := i
+			for _, num := range x.TopicIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2228,7 +3617,7 @@ func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TimestampedActorNonce)
+		x := input.Message.Interface().(*TopicIds)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2260,67 +3649,88 @@ func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TimestampedActorNonce: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIds: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TimestampedActorNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIds: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-				}
-				x.BlockHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					x.TopicIds = append(x.TopicIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockHeight |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 					}
-				}
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 					}
-					if iNdEx >= l {
+					if postIndex > l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
 					}
+					elementCount = count
+					if elementCount != 0 && len(x.TopicIds) == 0 {
+						x.TopicIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.TopicIds = append(x.TopicIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicIds", wireType)
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Nonce == nil {
-					x.Nonce = &Nonce{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Nonce); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2356,73 +3766,29 @@ func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Method
 	}
 }
 
-var _ protoreflect.List = (*_TopicIds_1_list)(nil)
-
-type _TopicIds_1_list struct {
-	list *[]uint64
-}
-
-func (x *_TopicIds_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TopicIds_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfUint64((*x.list)[i])
-}
-
-func (x *_TopicIds_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TopicIds_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TopicIds_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TopicIds at list field TopicIds as it is not of Message kind"))
-}
-
-func (x *_TopicIds_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TopicIds_1_list) NewElement() protoreflect.Value {
-	v := uint64(0)
-	return protoreflect.ValueOfUint64(v)
-}
-
-func (x *_TopicIds_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_TopicIds           protoreflect.MessageDescriptor
-	fd_TopicIds_topic_ids protoreflect.FieldDescriptor
+	md_TopicIdWeightPair          protoreflect.MessageDescriptor
+	fd_TopicIdWeightPair_topic_id protoreflect.FieldDescriptor
+	fd_TopicIdWeightPair_weight   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v3_topic_proto_init()
-	md_TopicIds = File_emissions_v3_topic_proto.Messages().ByName("TopicIds")
-	fd_TopicIds_topic_ids = md_TopicIds.Fields().ByName("topic_ids")
+	md_TopicIdWeightPair = File_emissions_v3_topic_proto.Messages().ByName("TopicIdWeightPair")
+	fd_TopicIdWeightPair_topic_id = md_TopicIdWeightPair.Fields().ByName("topic_id")
+	fd_TopicIdWeightPair_weight = md_TopicIdWeightPair.Fields().ByName("weight")
 }
 
-var _ protoreflect.Message = (*fastReflection_TopicIds)(nil)
+var _ protoreflect.Message = (*fastReflection_TopicIdWeightPair)(nil)
 
-type fastReflection_TopicIds TopicIds
+type fastReflection_TopicIdWeightPair TopicIdWeightPair
 
-func (x *TopicIds) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TopicIds)(x)
+func (x *TopicIdWeightPair) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicIdWeightPair)(x)
 }
 
-func (x *TopicIds) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v3_topic_proto_msgTypes[3]
+func (x *TopicIdWeightPair) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_topic_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2433,43 +3799,43 @@ func (x *TopicIds) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TopicIds_messageType fastReflection_TopicIds_messageType
-var _ protoreflect.MessageType = fastReflection_TopicIds_messageType{}
+var _fastReflection_TopicIdWeightPair_messageType fastReflection_TopicIdWeightPair_messageType
+var _ protoreflect.MessageType = fastReflection_TopicIdWeightPair_messageType{}
 
-type fastReflection_TopicIds_messageType struct{}
+type fastReflection_TopicIdWeightPair_messageType struct{}
 
-func (x fastReflection_TopicIds_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TopicIds)(nil)
+func (x fastReflection_TopicIdWeightPair_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicIdWeightPair)(nil)
 }
-func (x fastReflection_TopicIds_messageType) New() protoreflect.Message {
-	return new(fastReflection_TopicIds)
+func (x fastReflection_TopicIdWeightPair_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicIdWeightPair)
 }
-func (x fastReflection_TopicIds_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TopicIds
+func (x fastReflection_TopicIdWeightPair_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicIdWeightPair
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TopicIds) Descriptor() protoreflect.MessageDescriptor {
-	return md_TopicIds
+func (x *fastReflection_TopicIdWeightPair) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicIdWeightPair
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TopicIds) Type() protoreflect.MessageType {
-	return _fastReflection_TopicIds_messageType
+func (x *fastReflection_TopicIdWeightPair) Type() protoreflect.MessageType {
+	return _fastReflection_TopicIdWeightPair_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TopicIds) New() protoreflect.Message {
-	return new(fastReflection_TopicIds)
+func (x *fastReflection_TopicIdWeightPair) New() protoreflect.Message {
+	return new(fastReflection_TopicIdWeightPair)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TopicIds) Interface() protoreflect.ProtoMessage {
-	return (*TopicIds)(x)
+func (x *fastReflection_TopicIdWeightPair) Interface() protoreflect.ProtoMessage {
+	return (*TopicIdWeightPair)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2477,10 +3843,16 @@ func (x *fastReflection_TopicIds) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TopicIds) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.TopicIds) != 0 {
-		value := protoreflect.ValueOfList(&_TopicIds_1_list{list: &x.TopicIds})
-		if !f(fd_TopicIds_topic_ids, value) {
+func (x *fastReflection_TopicIdWeightPair) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_TopicIdWeightPair_topic_id, value) {
+			return
+		}
+	}
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_TopicIdWeightPair_weight, value) {
 			return
 		}
 	}
@@ -2497,15 +3869,17 @@ func (x *fastReflection_TopicIds) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TopicIds) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TopicIdWeightPair) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v3.TopicIds.topic_ids":
-		return len(x.TopicIds) != 0
+	case "emissions.v3.TopicIdWeightPair.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.TopicIdWeightPair.weight":
+		return x.Weight != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIdWeightPair"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicIds does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TopicIdWeightPair does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2515,15 +3889,17 @@ func (x *fastReflection_TopicIds) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicIds) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TopicIdWeightPair) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v3.TopicIds.topic_ids":
-		x.TopicIds = nil
+	case "emissions.v3.TopicIdWeightPair.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.TopicIdWeightPair.weight":
+		x.Weight = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIdWeightPair"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicIds does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TopicIdWeightPair does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2533,19 +3909,19 @@ func (x *fastReflection_TopicIds) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TopicIds) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicIdWeightPair) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v3.TopicIds.topic_ids":
-		if len(x.TopicIds) == 0 {
-			return protoreflect.ValueOfList(&_TopicIds_1_list{})
-		}
-		listValue := &_TopicIds_1_list{list: &x.TopicIds}
-		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.TopicIdWeightPair.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.TopicIdWeightPair.weight":
+		value := x.Weight
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIdWeightPair"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicIds does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TopicIdWeightPair does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2559,17 +3935,17 @@ func (x *fastReflection_TopicIds) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicIds) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TopicIdWeightPair) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v3.TopicIds.topic_ids":
-		lv := value.List()
-		clv := lv.(*_TopicIds_1_list)
-		x.TopicIds = *clv.list
+	case "emissions.v3.TopicIdWeightPair.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.TopicIdWeightPair.weight":
+		x.Weight = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIdWeightPair"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicIds does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TopicIdWeightPair does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2583,45 +3959,44 @@ func (x *fastReflection_TopicIds) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicIds) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicIdWeightPair) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.TopicIds.topic_ids":
-		if x.TopicIds == nil {
-			x.TopicIds = []uint64{}
-		}
-		value := &_TopicIds_1_list{list: &x.TopicIds}
-		return protoreflect.ValueOfList(value)
+	case "emissions.v3.TopicIdWeightPair.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.TopicIdWeightPair is not mutable"))
+	case "emissions.v3.TopicIdWeightPair.weight":
+		panic(fmt.Errorf("field weight of message emissions.v3.TopicIdWeightPair is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIdWeightPair"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicIds does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TopicIdWeightPair does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TopicIds) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicIdWeightPair) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.TopicIds.topic_ids":
-		list := []uint64{}
-		return protoreflect.ValueOfList(&_TopicIds_1_list{list: &list})
+	case "emissions.v3.TopicIdWeightPair.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.TopicIdWeightPair.weight":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIds"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIdWeightPair"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicIds does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.TopicIdWeightPair does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TopicIds) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TopicIdWeightPair) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.TopicIds", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.TopicIdWeightPair", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2629,7 +4004,7 @@ func (x *fastReflection_TopicIds) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TopicIds) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TopicIdWeightPair) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2640,7 +4015,7 @@ func (x *fastReflection_TopicIds) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicIds) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TopicIdWeightPair) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2652,7 +4027,7 @@ func (x *fastReflection_TopicIds) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TopicIds) IsValid() bool {
+func (x *fastReflection_TopicIdWeightPair) IsValid() bool {
 	return x != nil
 }
 
@@ -2662,9 +4037,9 @@ func (x *fastReflection_TopicIds) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TopicIds) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TopicIdWeightPair) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TopicIds)
+		x := input.Message.Interface().(*TopicIdWeightPair)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2676,12 +4051,12 @@ func (x *fastReflection_TopicIds) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.TopicIds) > 0 {
-			l = 0
-			for _, e := range x.TopicIds {
-				l += runtime.Sov(uint64(e))
-			}
-			n += 1 + runtime.Sov(uint64(l)) + l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		l = len(x.Weight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2693,7 +4068,7 @@ func (x *fastReflection_TopicIds) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TopicIds)
+		x := input.Message.Interface().(*TopicIdWeightPair)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2712,25 +4087,17 @@ func (x *fastReflection_TopicIds) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.TopicIds) > 0 {
-			var pksize2 int
-			for _, num := range x.TopicIds {
-				pksize2 += runtime.Sov(uint64(num))
-			}
-			i -= pksize2
-			j1 := i
-			for _, num := range x.TopicIds {
-				for num >= 1<<7 {
-					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j1++
-				}
-				dAtA[j1] = uint8(num)
-				j1++
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2743,7 +4110,7 @@ func (x *fastReflection_TopicIds) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TopicIds)
+		x := input.Message.Interface().(*TopicIdWeightPair)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2775,88 +4142,63 @@ func (x *fastReflection_TopicIds) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIds: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIdWeightPair: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIds: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIdWeightPair: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType == 0 {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					x.TopicIds = append(x.TopicIds, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
-					}
-					elementCount = count
-					if elementCount != 0 && len(x.TopicIds) == 0 {
-						x.TopicIds = make([]uint64, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.TopicIds = append(x.TopicIds, v)
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicIds", wireType)
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Weight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2892,29 +4234,409 @@ func (x *fastReflection_TopicIds) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_OptionalTopicParams_1_list)(nil)
+
+type _OptionalTopicParams_1_list struct {
+	list *[]int64
+}
+
+func (x *_OptionalTopicParams_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field EpochLength as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_1_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_OptionalTopicParams_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_2_list)(nil)
+
+type _OptionalTopicParams_2_list struct {
+	list *[]int64
+}
+
+func (x *_OptionalTopicParams_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field WorkerSubmissionWindow as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_2_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_OptionalTopicParams_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_3_list)(nil)
+
+type _OptionalTopicParams_3_list struct {
+	list *[]string
+}
+
+func (x *_OptionalTopicParams_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field AlphaRegret as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_OptionalTopicParams_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_4_list)(nil)
+
+type _OptionalTopicParams_4_list struct {
+	list *[]string
+}
+
+func (x *_OptionalTopicParams_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field PNorm as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_OptionalTopicParams_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_5_list)(nil)
+
+type _OptionalTopicParams_5_list struct {
+	list *[]string
+}
+
+func (x *_OptionalTopicParams_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field MeritSortitionAlpha as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_OptionalTopicParams_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_6_list)(nil)
+
+type _OptionalTopicParams_6_list struct {
+	list *[]string
+}
+
+func (x *_OptionalTopicParams_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field ActiveInfererQuantile as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_OptionalTopicParams_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_7_list)(nil)
+
+type _OptionalTopicParams_7_list struct {
+	list *[]string
+}
+
+func (x *_OptionalTopicParams_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field ActiveForecasterQuantile as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_OptionalTopicParams_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OptionalTopicParams_8_list)(nil)
+
+type _OptionalTopicParams_8_list struct {
+	list *[]string
+}
+
+func (x *_OptionalTopicParams_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OptionalTopicParams_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_OptionalTopicParams_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OptionalTopicParams_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OptionalTopicParams_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OptionalTopicParams at list field ActiveReputerQuantile as it is not of Message kind"))
+}
+
+func (x *_OptionalTopicParams_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OptionalTopicParams_8_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_OptionalTopicParams_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_TopicIdWeightPair          protoreflect.MessageDescriptor
-	fd_TopicIdWeightPair_topic_id protoreflect.FieldDescriptor
-	fd_TopicIdWeightPair_weight   protoreflect.FieldDescriptor
+	md_OptionalTopicParams                            protoreflect.MessageDescriptor
+	fd_OptionalTopicParams_epoch_length               protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_worker_submission_window   protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_alpha_regret               protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_p_norm                     protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_merit_sortition_alpha      protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_active_inferer_quantile    protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_active_forecaster_quantile protoreflect.FieldDescriptor
+	fd_OptionalTopicParams_active_reputer_quantile    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v3_topic_proto_init()
-	md_TopicIdWeightPair = File_emissions_v3_topic_proto.Messages().ByName("TopicIdWeightPair")
-	fd_TopicIdWeightPair_topic_id = md_TopicIdWeightPair.Fields().ByName("topic_id")
-	fd_TopicIdWeightPair_weight = md_TopicIdWeightPair.Fields().ByName("weight")
+	md_OptionalTopicParams = File_emissions_v3_topic_proto.Messages().ByName("OptionalTopicParams")
+	fd_OptionalTopicParams_epoch_length = md_OptionalTopicParams.Fields().ByName("epoch_length")
+	fd_OptionalTopicParams_worker_submission_window = md_OptionalTopicParams.Fields().ByName("worker_submission_window")
+	fd_OptionalTopicParams_alpha_regret = md_OptionalTopicParams.Fields().ByName("alpha_regret")
+	fd_OptionalTopicParams_p_norm = md_OptionalTopicParams.Fields().ByName("p_norm")
+	fd_OptionalTopicParams_merit_sortition_alpha = md_OptionalTopicParams.Fields().ByName("merit_sortition_alpha")
+	fd_OptionalTopicParams_active_inferer_quantile = md_OptionalTopicParams.Fields().ByName("active_inferer_quantile")
+	fd_OptionalTopicParams_active_forecaster_quantile = md_OptionalTopicParams.Fields().ByName("active_forecaster_quantile")
+	fd_OptionalTopicParams_active_reputer_quantile = md_OptionalTopicParams.Fields().ByName("active_reputer_quantile")
 }
 
-var _ protoreflect.Message = (*fastReflection_TopicIdWeightPair)(nil)
+var _ protoreflect.Message = (*fastReflection_OptionalTopicParams)(nil)
 
-type fastReflection_TopicIdWeightPair TopicIdWeightPair
+type fastReflection_OptionalTopicParams OptionalTopicParams
 
-func (x *TopicIdWeightPair) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TopicIdWeightPair)(x)
+func (x *OptionalTopicParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OptionalTopicParams)(x)
 }
 
-func (x *TopicIdWeightPair) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v3_topic_proto_msgTypes[4]
+func (x *OptionalTopicParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_topic_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2925,43 +4647,43 @@ func (x *TopicIdWeightPair) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TopicIdWeightPair_messageType fastReflection_TopicIdWeightPair_messageType
-var _ protoreflect.MessageType = fastReflection_TopicIdWeightPair_messageType{}
+var _fastReflection_OptionalTopicParams_messageType fastReflection_OptionalTopicParams_messageType
+var _ protoreflect.MessageType = fastReflection_OptionalTopicParams_messageType{}
 
-type fastReflection_TopicIdWeightPair_messageType struct{}
+type fastReflection_OptionalTopicParams_messageType struct{}
 
-func (x fastReflection_TopicIdWeightPair_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TopicIdWeightPair)(nil)
+func (x fastReflection_OptionalTopicParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OptionalTopicParams)(nil)
 }
-func (x fastReflection_TopicIdWeightPair_messageType) New() protoreflect.Message {
-	return new(fastReflection_TopicIdWeightPair)
+func (x fastReflection_OptionalTopicParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_OptionalTopicParams)
 }
-func (x fastReflection_TopicIdWeightPair_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TopicIdWeightPair
+func (x fastReflection_OptionalTopicParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OptionalTopicParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TopicIdWeightPair) Descriptor() protoreflect.MessageDescriptor {
-	return md_TopicIdWeightPair
+func (x *fastReflection_OptionalTopicParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_OptionalTopicParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TopicIdWeightPair) Type() protoreflect.MessageType {
-	return _fastReflection_TopicIdWeightPair_messageType
+func (x *fastReflection_OptionalTopicParams) Type() protoreflect.MessageType {
+	return _fastReflection_OptionalTopicParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TopicIdWeightPair) New() protoreflect.Message {
-	return new(fastReflection_TopicIdWeightPair)
+func (x *fastReflection_OptionalTopicParams) New() protoreflect.Message {
+	return new(fastReflection_OptionalTopicParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TopicIdWeightPair) Interface() protoreflect.ProtoMessage {
-	return (*TopicIdWeightPair)(x)
+func (x *fastReflection_OptionalTopicParams) Interface() protoreflect.ProtoMessage {
+	return (*OptionalTopicParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2969,16 +4691,52 @@ func (x *fastReflection_TopicIdWeightPair) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TopicIdWeightPair) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TopicId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.TopicId)
-		if !f(fd_TopicIdWeightPair_topic_id, value) {
+func (x *fastReflection_OptionalTopicParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.EpochLength) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_1_list{list: &x.EpochLength})
+		if !f(fd_OptionalTopicParams_epoch_length, value) {
 			return
 		}
 	}
-	if x.Weight != "" {
-		value := protoreflect.ValueOfString(x.Weight)
-		if !f(fd_TopicIdWeightPair_weight, value) {
+	if len(x.WorkerSubmissionWindow) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_2_list{list: &x.WorkerSubmissionWindow})
+		if !f(fd_OptionalTopicParams_worker_submission_window, value) {
+			return
+		}
+	}
+	if len(x.AlphaRegret) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_3_list{list: &x.AlphaRegret})
+		if !f(fd_OptionalTopicParams_alpha_regret, value) {
+			return
+		}
+	}
+	if len(x.PNorm) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_4_list{list: &x.PNorm})
+		if !f(fd_OptionalTopicParams_p_norm, value) {
+			return
+		}
+	}
+	if len(x.MeritSortitionAlpha) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_5_list{list: &x.MeritSortitionAlpha})
+		if !f(fd_OptionalTopicParams_merit_sortition_alpha, value) {
+			return
+		}
+	}
+	if len(x.ActiveInfererQuantile) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_6_list{list: &x.ActiveInfererQuantile})
+		if !f(fd_OptionalTopicParams_active_inferer_quantile, value) {
+			return
+		}
+	}
+	if len(x.ActiveForecasterQuantile) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_7_list{list: &x.ActiveForecasterQuantile})
+		if !f(fd_OptionalTopicParams_active_forecaster_quantile, value) {
+			return
+		}
+	}
+	if len(x.ActiveReputerQuantile) != 0 {
+		value := protoreflect.ValueOfList(&_OptionalTopicParams_8_list{list: &x.ActiveReputerQuantile})
+		if !f(fd_OptionalTopicParams_active_reputer_quantile, value) {
 			return
 		}
 	}
@@ -2995,17 +4753,29 @@ func (x *fastReflection_TopicIdWeightPair) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TopicIdWeightPair) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OptionalTopicParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v3.TopicIdWeightPair.topic_id":
-		return x.TopicId != uint64(0)
-	case "emissions.v3.TopicIdWeightPair.weight":
-		return x.Weight != ""
+	case "emissions.v3.OptionalTopicParams.epoch_length":
+		return len(x.EpochLength) != 0
+	case "emissions.v3.OptionalTopicParams.worker_submission_window":
+		return len(x.WorkerSubmissionWindow) != 0
+	case "emissions.v3.OptionalTopicParams.alpha_regret":
+		return len(x.AlphaRegret) != 0
+	case "emissions.v3.OptionalTopicParams.p_norm":
+		return len(x.PNorm) != 0
+	case "emissions.v3.OptionalTopicParams.merit_sortition_alpha":
+		return len(x.MeritSortitionAlpha) != 0
+	case "emissions.v3.OptionalTopicParams.active_inferer_quantile":
+		return len(x.ActiveInfererQuantile) != 0
+	case "emissions.v3.OptionalTopicParams.active_forecaster_quantile":
+		return len(x.ActiveForecasterQuantile) != 0
+	case "emissions.v3.OptionalTopicParams.active_reputer_quantile":
+		return len(x.ActiveReputerQuantile) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIdWeightPair"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.OptionalTopicParams"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicIdWeightPair does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.OptionalTopicParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3015,17 +4785,29 @@ func (x *fastReflection_TopicIdWeightPair) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicIdWeightPair) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OptionalTopicParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v3.TopicIdWeightPair.topic_id":
-		x.TopicId = uint64(0)
-	case "emissions.v3.TopicIdWeightPair.weight":
-		x.Weight = ""
+	case "emissions.v3.OptionalTopicParams.epoch_length":
+		x.EpochLength = nil
+	case "emissions.v3.OptionalTopicParams.worker_submission_window":
+		x.WorkerSubmissionWindow = nil
+	case "emissions.v3.OptionalTopicParams.alpha_regret":
+		x.AlphaRegret = nil
+	case "emissions.v3.OptionalTopicParams.p_norm":
+		x.PNorm = nil
+	case "emissions.v3.OptionalTopicParams.merit_sortition_alpha":
+		x.MeritSortitionAlpha = nil
+	case "emissions.v3.OptionalTopicParams.active_inferer_quantile":
+		x.ActiveInfererQuantile = nil
+	case "emissions.v3.OptionalTopicParams.active_forecaster_quantile":
+		x.ActiveForecasterQuantile = nil
+	case "emissions.v3.OptionalTopicParams.active_reputer_quantile":
+		x.ActiveReputerQuantile = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIdWeightPair"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.OptionalTopicParams"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicIdWeightPair does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.OptionalTopicParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3035,19 +4817,61 @@ func (x *fastReflection_TopicIdWeightPair) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TopicIdWeightPair) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OptionalTopicParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v3.TopicIdWeightPair.topic_id":
-		value := x.TopicId
-		return protoreflect.ValueOfUint64(value)
-	case "emissions.v3.TopicIdWeightPair.weight":
-		value := x.Weight
-		return protoreflect.ValueOfString(value)
+	case "emissions.v3.OptionalTopicParams.epoch_length":
+		if len(x.EpochLength) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_1_list{})
+		}
+		listValue := &_OptionalTopicParams_1_list{list: &x.EpochLength}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.worker_submission_window":
+		if len(x.WorkerSubmissionWindow) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_2_list{})
+		}
+		listValue := &_OptionalTopicParams_2_list{list: &x.WorkerSubmissionWindow}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.alpha_regret":
+		if len(x.AlphaRegret) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_3_list{})
+		}
+		listValue := &_OptionalTopicParams_3_list{list: &x.AlphaRegret}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.p_norm":
+		if len(x.PNorm) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_4_list{})
+		}
+		listValue := &_OptionalTopicParams_4_list{list: &x.PNorm}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.merit_sortition_alpha":
+		if len(x.MeritSortitionAlpha) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_5_list{})
+		}
+		listValue := &_OptionalTopicParams_5_list{list: &x.MeritSortitionAlpha}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.active_inferer_quantile":
+		if len(x.ActiveInfererQuantile) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_6_list{})
+		}
+		listValue := &_OptionalTopicParams_6_list{list: &x.ActiveInfererQuantile}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.active_forecaster_quantile":
+		if len(x.ActiveForecasterQuantile) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_7_list{})
+		}
+		listValue := &_OptionalTopicParams_7_list{list: &x.ActiveForecasterQuantile}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.OptionalTopicParams.active_reputer_quantile":
+		if len(x.ActiveReputerQuantile) == 0 {
+			return protoreflect.ValueOfList(&_OptionalTopicParams_8_list{})
+		}
+		listValue := &_OptionalTopicParams_8_list{list: &x.ActiveReputerQuantile}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIdWeightPair"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.OptionalTopicParams"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicIdWeightPair does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v3.OptionalTopicParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3061,17 +4885,45 @@ func (x *fastReflection_TopicIdWeightPair) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicIdWeightPair) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OptionalTopicParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v3.TopicIdWeightPair.topic_id":
-		x.TopicId = value.Uint()
-	case "emissions.v3.TopicIdWeightPair.weight":
-		x.Weight = value.Interface().(string)
+	case "emissions.v3.OptionalTopicParams.epoch_length":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_1_list)
+		x.EpochLength = *clv.list
+	case "emissions.v3.OptionalTopicParams.worker_submission_window":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_2_list)
+		x.WorkerSubmissionWindow = *clv.list
+	case "emissions.v3.OptionalTopicParams.alpha_regret":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_3_list)
+		x.AlphaRegret = *clv.list
+	case "emissions.v3.OptionalTopicParams.p_norm":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_4_list)
+		x.PNorm = *clv.list
+	case "emissions.v3.OptionalTopicParams.merit_sortition_alpha":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_5_list)
+		x.MeritSortitionAlpha = *clv.list
+	case "emissions.v3.OptionalTopicParams.active_inferer_quantile":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_6_list)
+		x.ActiveInfererQuantile = *clv.list
+	case "emissions.v3.OptionalTopicParams.active_forecaster_quantile":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_7_list)
+		x.ActiveForecasterQuantile = *clv.list
+	case "emissions.v3.OptionalTopicParams.active_reputer_quantile":
+		lv := value.List()
+		clv := lv.(*_OptionalTopicParams_8_list)
+		x.ActiveReputerQuantile = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIdWeightPair"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.OptionalTopicParams"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicIdWeightPair does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.OptionalTopicParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3085,44 +4937,108 @@ func (x *fastReflection_TopicIdWeightPair) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicIdWeightPair) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OptionalTopicParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.TopicIdWeightPair.topic_id":
-		panic(fmt.Errorf("field topic_id of message emissions.v3.TopicIdWeightPair is not mutable"))
-	case "emissions.v3.TopicIdWeightPair.weight":
-		panic(fmt.Errorf("field weight of message emissions.v3.TopicIdWeightPair is not mutable"))
+	case "emissions.v3.OptionalTopicParams.epoch_length":
+		if x.EpochLength == nil {
+			x.EpochLength = []int64{}
+		}
+		value := &_OptionalTopicParams_1_list{list: &x.EpochLength}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.worker_submission_window":
+		if x.WorkerSubmissionWindow == nil {
+			x.WorkerSubmissionWindow = []int64{}
+		}
+		value := &_OptionalTopicParams_2_list{list: &x.WorkerSubmissionWindow}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.alpha_regret":
+		if x.AlphaRegret == nil {
+			x.AlphaRegret = []string{}
+		}
+		value := &_OptionalTopicParams_3_list{list: &x.AlphaRegret}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.p_norm":
+		if x.PNorm == nil {
+			x.PNorm = []string{}
+		}
+		value := &_OptionalTopicParams_4_list{list: &x.PNorm}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.merit_sortition_alpha":
+		if x.MeritSortitionAlpha == nil {
+			x.MeritSortitionAlpha = []string{}
+		}
+		value := &_OptionalTopicParams_5_list{list: &x.MeritSortitionAlpha}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.active_inferer_quantile":
+		if x.ActiveInfererQuantile == nil {
+			x.ActiveInfererQuantile = []string{}
+		}
+		value := &_OptionalTopicParams_6_list{list: &x.ActiveInfererQuantile}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.active_forecaster_quantile":
+		if x.ActiveForecasterQuantile == nil {
+			x.ActiveForecasterQuantile = []string{}
+		}
+		value := &_OptionalTopicParams_7_list{list: &x.ActiveForecasterQuantile}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.OptionalTopicParams.active_reputer_quantile":
+		if x.ActiveReputerQuantile == nil {
+			x.ActiveReputerQuantile = []string{}
+		}
+		value := &_OptionalTopicParams_8_list{list: &x.ActiveReputerQuantile}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIdWeightPair"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.OptionalTopicParams"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicIdWeightPair does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.OptionalTopicParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TopicIdWeightPair) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OptionalTopicParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.TopicIdWeightPair.topic_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "emissions.v3.TopicIdWeightPair.weight":
-		return protoreflect.ValueOfString("")
+	case "emissions.v3.OptionalTopicParams.epoch_length":
+		list := []int64{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_1_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.worker_submission_window":
+		list := []int64{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_2_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.alpha_regret":
+		list := []string{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_3_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.p_norm":
+		list := []string{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_4_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.merit_sortition_alpha":
+		list := []string{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_5_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.active_inferer_quantile":
+		list := []string{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_6_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.active_forecaster_quantile":
+		list := []string{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_7_list{list: &list})
+	case "emissions.v3.OptionalTopicParams.active_reputer_quantile":
+		list := []string{}
+		return protoreflect.ValueOfList(&_OptionalTopicParams_8_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicIdWeightPair"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.OptionalTopicParams"))
 		}
-		panic(fmt.Errorf("message emissions.v3.TopicIdWeightPair does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.OptionalTopicParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TopicIdWeightPair) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OptionalTopicParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.TopicIdWeightPair", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.OptionalTopicParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3130,7 +5046,7 @@ func (x *fastReflection_TopicIdWeightPair) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TopicIdWeightPair) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OptionalTopicParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3141,7 +5057,7 @@ func (x *fastReflection_TopicIdWeightPair) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicIdWeightPair) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OptionalTopicParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3153,7 +5069,7 @@ func (x *fastReflection_TopicIdWeightPair) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TopicIdWeightPair) IsValid() bool {
+func (x *fastReflection_OptionalTopicParams) IsValid() bool {
 	return x != nil
 }
 
@@ -3163,9 +5079,9 @@ func (x *fastReflection_TopicIdWeightPair) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TopicIdWeightPair) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OptionalTopicParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TopicIdWeightPair)
+		x := input.Message.Interface().(*OptionalTopicParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3177,12 +5093,55 @@ func (x *fastReflection_TopicIdWeightPair) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.TopicId != 0 {
-			n += 1 + runtime.Sov(uint64(x.TopicId))
+		if len(x.EpochLength) > 0 {
+			l = 0
+			for _, e := range x.EpochLength {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
 		}
-		l = len(x.Weight)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.WorkerSubmissionWindow) > 0 {
+			l = 0
+			for _, e := range x.WorkerSubmissionWindow {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.AlphaRegret) > 0 {
+			for _, s := range x.AlphaRegret {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PNorm) > 0 {
+			for _, s := range x.PNorm {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MeritSortitionAlpha) > 0 {
+			for _, s := range x.MeritSortitionAlpha {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ActiveInfererQuantile) > 0 {
+			for _, s := range x.ActiveInfererQuantile {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ActiveForecasterQuantile) > 0 {
+			for _, s := range x.ActiveForecasterQuantile {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ActiveReputerQuantile) > 0 {
+			for _, s := range x.ActiveReputerQuantile {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3194,7 +5153,7 @@ func (x *fastReflection_TopicIdWeightPair) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TopicIdWeightPair)
+		x := input.Message.Interface().(*OptionalTopicParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3213,17 +5172,101 @@ func (x *fastReflection_TopicIdWeightPair) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Weight) > 0 {
-			i -= len(x.Weight)
-			copy(dAtA[i:], x.Weight)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+		if len(x.ActiveReputerQuantile) > 0 {
+			for iNdEx := len(x.ActiveReputerQuantile) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ActiveReputerQuantile[iNdEx])
+				copy(dAtA[i:], x.ActiveReputerQuantile[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ActiveReputerQuantile[iNdEx])))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.ActiveForecasterQuantile) > 0 {
+			for iNdEx := len(x.ActiveForecasterQuantile) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ActiveForecasterQuantile[iNdEx])
+				copy(dAtA[i:], x.ActiveForecasterQuantile[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ActiveForecasterQuantile[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.ActiveInfererQuantile) > 0 {
+			for iNdEx := len(x.ActiveInfererQuantile) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ActiveInfererQuantile[iNdEx])
+				copy(dAtA[i:], x.ActiveInfererQuantile[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ActiveInfererQuantile[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.MeritSortitionAlpha) > 0 {
+			for iNdEx := len(x.MeritSortitionAlpha) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.MeritSortitionAlpha[iNdEx])
+				copy(dAtA[i:], x.MeritSortitionAlpha[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MeritSortitionAlpha[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.PNorm) > 0 {
+			for iNdEx := len(x.PNorm) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PNorm[iNdEx])
+				copy(dAtA[i:], x.PNorm[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PNorm[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.AlphaRegret) > 0 {
+			for iNdEx := len(x.AlphaRegret) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AlphaRegret[iNdEx])
+				copy(dAtA[i:], x.AlphaRegret[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AlphaRegret[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.WorkerSubmissionWindow) > 0 {
+			var pksize2 int
+			for _, num := range x.WorkerSubmissionWindow {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.WorkerSubmissionWindow {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.TopicId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+		if len(x.EpochLength) > 0 {
+			var pksize4 int
+			for _, num := range x.EpochLength {
+				pksize4 += runtime.Sov(uint64(num))
+			}
+			i -= pksize4
+			j3 := i
+			for _, num1 := range x.EpochLength {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j3++
+				}
+				dAtA[j3] = uint8(num)
+				j3++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize4))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3236,7 +5279,7 @@ func (x *fastReflection_TopicIdWeightPair) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TopicIdWeightPair)
+		x := input.Message.Interface().(*OptionalTopicParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3268,17 +5311,297 @@ func (x *fastReflection_TopicIdWeightPair) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIdWeightPair: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OptionalTopicParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIdWeightPair: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OptionalTopicParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				if wireType == 0 {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.EpochLength = append(x.EpochLength, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.EpochLength) == 0 {
+						x.EpochLength = make([]int64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v int64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= int64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.EpochLength = append(x.EpochLength, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+				}
+			case 2:
+				if wireType == 0 {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.WorkerSubmissionWindow = append(x.WorkerSubmissionWindow, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.WorkerSubmissionWindow) == 0 {
+						x.WorkerSubmissionWindow = make([]int64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v int64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= int64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.WorkerSubmissionWindow = append(x.WorkerSubmissionWindow, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerSubmissionWindow", wireType)
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AlphaRegret = append(x.AlphaRegret, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PNorm = append(x.PNorm, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MeritSortitionAlpha", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MeritSortitionAlpha = append(x.MeritSortitionAlpha, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveInfererQuantile", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				x.TopicId = 0
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ActiveInfererQuantile = append(x.ActiveInfererQuantile, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasterQuantile", wireType)
+				}
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3288,14 +5611,27 @@ func (x *fastReflection_TopicIdWeightPair) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.TopicId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 2:
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ActiveForecasterQuantile = append(x.ActiveForecasterQuantile, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveReputerQuantile", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3323,7 +5659,7 @@ func (x *fastReflection_TopicIdWeightPair) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Weight = string(dAtA[iNdEx:postIndex])
+				x.ActiveReputerQuantile = append(x.ActiveReputerQuantile, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3373,6 +5709,106 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How inference synthesis treats topics with a vector output
+type VectorSynthesisMode int32
+
+const (
+	// Losses are reported over the whole vector (per the loss method) and a
+	// single set of weights is applied to every component
+	VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED VectorSynthesisMode = 0
+	// Losses, regrets and weights are tracked separately for each component
+	VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_PER_COMPONENT VectorSynthesisMode = 1
+)
+
+// Enum value maps for VectorSynthesisMode.
+var (
+	VectorSynthesisMode_name = map[int32]string{
+		0: "VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED",
+		1: "VECTOR_SYNTHESIS_MODE_PER_COMPONENT",
+	}
+	VectorSynthesisMode_value = map[string]int32{
+		"VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED": 0,
+		"VECTOR_SYNTHESIS_MODE_PER_COMPONENT":    1,
+	}
+)
+
+func (x VectorSynthesisMode) Enum() *VectorSynthesisMode {
+	p := new(VectorSynthesisMode)
+	*p = x
+	return p
+}
+
+func (x VectorSynthesisMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VectorSynthesisMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v3_topic_proto_enumTypes[0].Descriptor()
+}
+
+func (VectorSynthesisMode) Type() protoreflect.EnumType {
+	return &file_emissions_v3_topic_proto_enumTypes[0]
+}
+
+func (x VectorSynthesisMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VectorSynthesisMode.Descriptor instead.
+func (VectorSynthesisMode) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{0}
+}
+
+// Who computes the losses of a topic's inferences
+type LossComputationMode int32
+
+const (
+	// Reputers compute losses off-chain and submit them in value bundles
+	LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED LossComputationMode = 0
+	// Reputers submit the ground truth and the chain computes losses with the
+	// built-in loss function named by the topic loss method
+	LossComputationMode_LOSS_COMPUTATION_MODE_CHAIN LossComputationMode = 1
+)
+
+// Enum value maps for LossComputationMode.
+var (
+	LossComputationMode_name = map[int32]string{
+		0: "LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED",
+		1: "LOSS_COMPUTATION_MODE_CHAIN",
+	}
+	LossComputationMode_value = map[string]int32{
+		"LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED": 0,
+		"LOSS_COMPUTATION_MODE_CHAIN":               1,
+	}
+)
+
+func (x LossComputationMode) Enum() *LossComputationMode {
+	p := new(LossComputationMode)
+	*p = x
+	return p
+}
+
+func (x LossComputationMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LossComputationMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v3_topic_proto_enumTypes[1].Descriptor()
+}
+
+func (LossComputationMode) Type() protoreflect.EnumType {
+	return &file_emissions_v3_topic_proto_enumTypes[1]
+}
+
+func (x LossComputationMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LossComputationMode.Descriptor instead.
+func (LossComputationMode) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{1}
+}
+
 type Topic struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3397,6 +5833,37 @@ type Topic struct {
 	ActiveInfererQuantile    string `protobuf:"bytes,19,opt,name=active_inferer_quantile,json=activeInfererQuantile,proto3" json:"active_inferer_quantile,omitempty"`
 	ActiveForecasterQuantile string `protobuf:"bytes,20,opt,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3" json:"active_forecaster_quantile,omitempty"`
 	ActiveReputerQuantile    string `protobuf:"bytes,21,opt,name=active_reputer_quantile,json=activeReputerQuantile,proto3" json:"active_reputer_quantile,omitempty"`
+	// number of values each inference holds. 0 and 1 both mean a scalar output
+	OutputDimension     uint64              `protobuf:"varint,22,opt,name=output_dimension,json=outputDimension,proto3" json:"output_dimension,omitempty"`
+	VectorSynthesisMode VectorSynthesisMode `protobuf:"varint,23,opt,name=vector_synthesis_mode,json=vectorSynthesisMode,proto3,enum=emissions.v3.VectorSynthesisMode" json:"vector_synthesis_mode,omitempty"`
+	// strictly increasing levels in (0, 1) of the quantiles that inferences hold
+	// for probabilistic topics. Empty for point estimate topics
+	QuantileLevels      []string            `protobuf:"bytes,24,rep,name=quantile_levels,json=quantileLevels,proto3" json:"quantile_levels,omitempty"`
+	LossComputationMode LossComputationMode `protobuf:"varint,25,opt,name=loss_computation_mode,json=lossComputationMode,proto3,enum=emissions.v3.LossComputationMode" json:"loss_computation_mode,omitempty"`
+	// number of blocks after the worker submission window during which workers reveal
+	// the payloads they committed to. 0 means workers submit their payloads in the clear
+	WorkerRevealWindow int64 `protobuf:"varint,26,opt,name=worker_reveal_window,json=workerRevealWindow,proto3" json:"worker_reveal_window,omitempty"`
+	// amount each worker must have bonded on the topic to submit payloads.
+	// 0 means workers do not need to bond
+	WorkerBond string `protobuf:"bytes,27,opt,name=worker_bond,json=workerBond,proto3" json:"worker_bond,omitempty"`
+	// number of contiguous epochs a bonded worker may miss before its bond is slashed
+	WorkerBondSlashMissedEpochs int64 `protobuf:"varint,28,opt,name=worker_bond_slash_missed_epochs,json=workerBondSlashMissedEpochs,proto3" json:"worker_bond_slash_missed_epochs,omitempty"`
+	// fraction of the bond slashed each time a worker misses that many epochs
+	WorkerBondSlashFraction string `protobuf:"bytes,29,opt,name=worker_bond_slash_fraction,json=workerBondSlashFraction,proto3" json:"worker_bond_slash_fraction,omitempty"`
+	// normalized distance from the loss consensus above which a reputer report counts as deviating
+	ReputerSlashDistanceThreshold string `protobuf:"bytes,30,opt,name=reputer_slash_distance_threshold,json=reputerSlashDistanceThreshold,proto3" json:"reputer_slash_distance_threshold,omitempty"`
+	// number of consecutive deviating reports after which a reputer is slashed.
+	// 0 means reputers are never slashed
+	ReputerSlashEpochs int64 `protobuf:"varint,31,opt,name=reputer_slash_epochs,json=reputerSlashEpochs,proto3" json:"reputer_slash_epochs,omitempty"`
+	// fraction of the reputer's own stake slashed
+	ReputerSelfSlashFraction string `protobuf:"bytes,32,opt,name=reputer_self_slash_fraction,json=reputerSelfSlashFraction,proto3" json:"reputer_self_slash_fraction,omitempty"`
+	// fraction of the stake delegated to the reputer slashed
+	ReputerDelegateSlashFraction string `protobuf:"bytes,33,opt,name=reputer_delegate_slash_fraction,json=reputerDelegateSlashFraction,proto3" json:"reputer_delegate_slash_fraction,omitempty"`
+	// fraction of each worker and reputer reward held in escrow
+	RewardEscrowFraction string `protobuf:"bytes,34,opt,name=reward_escrow_fraction,json=rewardEscrowFraction,proto3" json:"reward_escrow_fraction,omitempty"`
+	// number of blocks escrowed rewards vest linearly over.
+	// 0 means rewards are paid out in full without escrow
+	RewardEscrowVestingBlocks int64 `protobuf:"varint,35,opt,name=reward_escrow_vesting_blocks,json=rewardEscrowVestingBlocks,proto3" json:"reward_escrow_vesting_blocks,omitempty"`
 }
 
 func (x *Topic) Reset() {
@@ -3538,6 +6005,104 @@ func (x *Topic) GetActiveReputerQuantile() string {
 	return ""
 }
 
+func (x *Topic) GetOutputDimension() uint64 {
+	if x != nil {
+		return x.OutputDimension
+	}
+	return 0
+}
+
+func (x *Topic) GetVectorSynthesisMode() VectorSynthesisMode {
+	if x != nil {
+		return x.VectorSynthesisMode
+	}
+	return VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED
+}
+
+func (x *Topic) GetQuantileLevels() []string {
+	if x != nil {
+		return x.QuantileLevels
+	}
+	return nil
+}
+
+func (x *Topic) GetLossComputationMode() LossComputationMode {
+	if x != nil {
+		return x.LossComputationMode
+	}
+	return LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED
+}
+
+func (x *Topic) GetWorkerRevealWindow() int64 {
+	if x != nil {
+		return x.WorkerRevealWindow
+	}
+	return 0
+}
+
+func (x *Topic) GetWorkerBond() string {
+	if x != nil {
+		return x.WorkerBond
+	}
+	return ""
+}
+
+func (x *Topic) GetWorkerBondSlashMissedEpochs() int64 {
+	if x != nil {
+		return x.WorkerBondSlashMissedEpochs
+	}
+	return 0
+}
+
+func (x *Topic) GetWorkerBondSlashFraction() string {
+	if x != nil {
+		return x.WorkerBondSlashFraction
+	}
+	return ""
+}
+
+func (x *Topic) GetReputerSlashDistanceThreshold() string {
+	if x != nil {
+		return x.ReputerSlashDistanceThreshold
+	}
+	return ""
+}
+
+func (x *Topic) GetReputerSlashEpochs() int64 {
+	if x != nil {
+		return x.ReputerSlashEpochs
+	}
+	return 0
+}
+
+func (x *Topic) GetReputerSelfSlashFraction() string {
+	if x != nil {
+		return x.ReputerSelfSlashFraction
+	}
+	return ""
+}
+
+func (x *Topic) GetReputerDelegateSlashFraction() string {
+	if x != nil {
+		return x.ReputerDelegateSlashFraction
+	}
+	return ""
+}
+
+func (x *Topic) GetRewardEscrowFraction() string {
+	if x != nil {
+		return x.RewardEscrowFraction
+	}
+	return ""
+}
+
+func (x *Topic) GetRewardEscrowVestingBlocks() int64 {
+	if x != nil {
+		return x.RewardEscrowVestingBlocks
+	}
+	return 0
+}
+
 type TopicList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3694,126 +6259,359 @@ func (x *TopicIdWeightPair) GetWeight() string {
 	return ""
 }
 
+// Topic parameters that may be changed after creation. Each field is either
+// empty (keep the current value) or holds exactly one new value.
+type OptionalTopicParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochLength              []int64  `protobuf:"varint,1,rep,packed,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	WorkerSubmissionWindow   []int64  `protobuf:"varint,2,rep,packed,name=worker_submission_window,json=workerSubmissionWindow,proto3" json:"worker_submission_window,omitempty"`
+	AlphaRegret              []string `protobuf:"bytes,3,rep,name=alpha_regret,json=alphaRegret,proto3" json:"alpha_regret,omitempty"`
+	PNorm                    []string `protobuf:"bytes,4,rep,name=p_norm,json=pNorm,proto3" json:"p_norm,omitempty"`
+	MeritSortitionAlpha      []string `protobuf:"bytes,5,rep,name=merit_sortition_alpha,json=meritSortitionAlpha,proto3" json:"merit_sortition_alpha,omitempty"`
+	ActiveInfererQuantile    []string `protobuf:"bytes,6,rep,name=active_inferer_quantile,json=activeInfererQuantile,proto3" json:"active_inferer_quantile,omitempty"`
+	ActiveForecasterQuantile []string `protobuf:"bytes,7,rep,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3" json:"active_forecaster_quantile,omitempty"`
+	ActiveReputerQuantile    []string `protobuf:"bytes,8,rep,name=active_reputer_quantile,json=activeReputerQuantile,proto3" json:"active_reputer_quantile,omitempty"`
+}
+
+func (x *OptionalTopicParams) Reset() {
+	*x = OptionalTopicParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_topic_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptionalTopicParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionalTopicParams) ProtoMessage() {}
+
+// Deprecated: Use OptionalTopicParams.ProtoReflect.Descriptor instead.
+func (*OptionalTopicParams) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OptionalTopicParams) GetEpochLength() []int64 {
+	if x != nil {
+		return x.EpochLength
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetWorkerSubmissionWindow() []int64 {
+	if x != nil {
+		return x.WorkerSubmissionWindow
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetAlphaRegret() []string {
+	if x != nil {
+		return x.AlphaRegret
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetPNorm() []string {
+	if x != nil {
+		return x.PNorm
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetMeritSortitionAlpha() []string {
+	if x != nil {
+		return x.MeritSortitionAlpha
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetActiveInfererQuantile() []string {
+	if x != nil {
+		return x.ActiveInfererQuantile
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetActiveForecasterQuantile() []string {
+	if x != nil {
+		return x.ActiveForecasterQuantile
+	}
+	return nil
+}
+
+func (x *OptionalTopicParams) GetActiveReputerQuantile() []string {
+	if x != nil {
+		return x.ActiveReputerQuantile
+	}
+	return nil
+}
+
 var File_emissions_v3_topic_proto protoreflect.FileDescriptor
 
 var file_emissions_v3_topic_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x2f, 0x74,
 	0x6f, 0x70, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x65, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x1a, 0x18, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x2f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x09, 0x0a, 0x05, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x73, 0x73,
-	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c,
-	0x6f, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x6e,
-	0x64, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e,
-	0x67, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x5f, 0x74, 0x72, 0x75, 0x74, 0x68, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x75, 0x74, 0x68, 0x4c, 0x61, 0x67,
-	0x12, 0x4e, 0x0a, 0x06, 0x70, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f,
+	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x76, 0x33, 0x2f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x98, 0x13, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x73,
+	0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x65,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74,
+	0x72, 0x75, 0x74, 0x68, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x75, 0x74, 0x68, 0x4c, 0x61, 0x67, 0x12, 0x4e,
+	0x0a, 0x06, 0x70, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x12, 0x5a,
+	0x0a, 0x0c, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x72, 0x65, 0x67, 0x72, 0x65, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x52, 0x65, 0x67, 0x72, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x5f, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x51, 0x0a, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x07, 0x65, 0x70, 0x73,
+	0x69, 0x6c, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x67, 0x72, 0x65, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x67, 0x72, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x6b,
+	0x0a, 0x15, 0x6d, 0x65, 0x72, 0x69, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x6d, 0x65, 0x72, 0x69, 0x74, 0x53, 0x6f, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x6f, 0x0a, 0x17, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x75, 0x0a, 0x1a,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x70, 0x4e, 0x6f, 0x72, 0x6d,
-	0x12, 0x5a, 0x0a, 0x0c, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x72, 0x65, 0x67, 0x72, 0x65, 0x74,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
-	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
-	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
-	0x0b, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x52, 0x65, 0x67, 0x72, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4e, 0x65, 0x67, 0x61, 0x74,
-	0x69, 0x76, 0x65, 0x12, 0x51, 0x0a, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x18, 0x0f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x18, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x12, 0x6f, 0x0a, 0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65,
+	0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x15,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69,
 	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
 	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x07, 0x65,
-	0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x6c, 0x5f, 0x72, 0x65, 0x67, 0x72, 0x65, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
-	0x52, 0x65, 0x67, 0x72, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x12, 0x6b, 0x0a, 0x15, 0x6d, 0x65, 0x72, 0x69, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x15, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x64,
+	0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x55, 0x0a, 0x15, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x79, 0x6e, 0x74, 0x68, 0x65,
+	0x73, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
+	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x13, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x73,
+	0x69, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x09, 0x42,
 	0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
 	0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x6d, 0x65, 0x72, 0x69, 0x74, 0x53,
-	0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x6f, 0x0a,
-	0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x55, 0x0a, 0x15, 0x6c, 0x6f, 0x73, 0x73,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x6f, 0x73, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x13, 0x6c, 0x6f, 0x73, 0x73,
+	0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x30, 0x0a, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x51, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6e, 0x64,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x42, 0x6f, 0x6e, 0x64, 0x12, 0x44, 0x0a, 0x1f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x62,
+	0x6f, 0x6e, 0x64, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1b, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x42, 0x6f, 0x6e, 0x64, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x4d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x74, 0x0a, 0x1a, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f,
+	0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
 	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
 	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
 	0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x75,
-	0x0a, 0x1a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
-	0x74, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x14, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x18, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x51, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x6f, 0x0a, 0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65,
-	0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
+	0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x17, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x42,
+	0x6f, 0x6e, 0x64, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x80, 0x01, 0x0a, 0x20, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x1d, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x12, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x76, 0x0a, 0x1b, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x18, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x66,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x7e, 0x0a,
+	0x1f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
 	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
 	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
-	0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x51, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x06,
-	0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x52, 0x0a,
-	0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x52, 0x0f, 0x69, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x52, 0x10, 0x69, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0b, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x22, 0x38, 0x0a, 0x09, 0x54, 0x6f,
-	0x70, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x73, 0x22, 0x78, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x65, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x29, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x4e,
-	0x6f, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
-	0x01, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x2d,
-	0x0a, 0x08, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x74,
-	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x7f, 0x0a,
-	0x11, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61,
-	0x69, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x4f, 0x0a,
-	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8,
+	0x1c, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6d, 0x0a,
+	0x16, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x66,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8,
 	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
 	0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
 	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0xc0,
-	0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x76, 0x33, 0x42, 0x0a, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
-	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c,
-	0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x76, 0x33, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x33, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x5c, 0x56, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56,
-	0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x14, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x1c,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x76, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x23, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x19, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x4a, 0x04, 0x08,
+	0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x4a,
+	0x04, 0x08, 0x0b, 0x10, 0x0c, 0x52, 0x0a, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x52, 0x0f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x52, 0x10, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x72,
+	0x67, 0x22, 0x38, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x78, 0x0a, 0x15, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x05,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x2d, 0x0a, 0x08, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x7f, 0x0a, 0x11, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x69, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x49, 0x64, 0x12, 0x4f, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xe4, 0x05, 0x0a, 0x13, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x38, 0x0a, 0x18, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x5a, 0x0a, 0x0c, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x5f, 0x72, 0x65, 0x67, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x52, 0x65, 0x67, 0x72, 0x65, 0x74, 0x12, 0x4e, 0x0a, 0x06, 0x70, 0x5f, 0x6e, 0x6f, 0x72, 0x6d,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x05, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x12, 0x6b, 0x0a, 0x15, 0x6d, 0x65, 0x72, 0x69, 0x74, 0x5f,
+	0x73, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13,
+	0x6d, 0x65, 0x72, 0x69, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c,
+	0x70, 0x68, 0x61, 0x12, 0x6f, 0x0a, 0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x15, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x12, 0x75, 0x0a, 0x1a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x18, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x6f, 0x0a, 0x17, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x2a, 0x6a, 0x0a, 0x13,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x26, 0x56, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x59,
+	0x4e, 0x54, 0x48, 0x45, 0x53, 0x49, 0x53, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x52,
+	0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x27, 0x0a, 0x23, 0x56, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x59, 0x4e, 0x54, 0x48, 0x45,
+	0x53, 0x49, 0x53, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4d,
+	0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x2a, 0x65, 0x0a, 0x13, 0x4c, 0x6f, 0x73, 0x73,
+	0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x2d, 0x0a, 0x29, 0x4c, 0x4f, 0x53, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x55, 0x54, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x55, 0x54, 0x45, 0x52,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f,
+	0x0a, 0x1b, 0x4c, 0x4f, 0x53, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x55, 0x54, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x42,
+	0xc0, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x33, 0x42, 0x0a, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x76, 0x33, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x33, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a,
+	0x56, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3828,23 +6626,29 @@ func file_emissions_v3_topic_proto_rawDescGZIP() []byte {
 	return file_emissions_v3_topic_proto_rawDescData
 }
 
-var file_emissions_v3_topic_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_emissions_v3_topic_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_emissions_v3_topic_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_emissions_v3_topic_proto_goTypes = []interface{}{
-	(*Topic)(nil),                 // 0: emissions.v3.Topic
-	(*TopicList)(nil),             // 1: emissions.v3.TopicList
-	(*TimestampedActorNonce)(nil), // 2: emissions.v3.TimestampedActorNonce
-	(*TopicIds)(nil),              // 3: emissions.v3.TopicIds
-	(*TopicIdWeightPair)(nil),     // 4: emissions.v3.TopicIdWeightPair
-	(*Nonce)(nil),                 // 5: emissions.v3.Nonce
+	(VectorSynthesisMode)(0),      // 0: emissions.v3.VectorSynthesisMode
+	(LossComputationMode)(0),      // 1: emissions.v3.LossComputationMode
+	(*Topic)(nil),                 // 2: emissions.v3.Topic
+	(*TopicList)(nil),             // 3: emissions.v3.TopicList
+	(*TimestampedActorNonce)(nil), // 4: emissions.v3.TimestampedActorNonce
+	(*TopicIds)(nil),              // 5: emissions.v3.TopicIds
+	(*TopicIdWeightPair)(nil),     // 6: emissions.v3.TopicIdWeightPair
+	(*OptionalTopicParams)(nil),   // 7: emissions.v3.OptionalTopicParams
+	(*Nonce)(nil),                 // 8: emissions.v3.Nonce
 }
 var file_emissions_v3_topic_proto_depIdxs = []int32{
-	0, // 0: emissions.v3.TopicList.topics:type_name -> emissions.v3.Topic
-	5, // 1: emissions.v3.TimestampedActorNonce.nonce:type_name -> emissions.v3.Nonce
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0, // 0: emissions.v3.Topic.vector_synthesis_mode:type_name -> emissions.v3.VectorSynthesisMode
+	1, // 1: emissions.v3.Topic.loss_computation_mode:type_name -> emissions.v3.LossComputationMode
+	2, // 2: emissions.v3.TopicList.topics:type_name -> emissions.v3.Topic
+	8, // 3: emissions.v3.TimestampedActorNonce.nonce:type_name -> emissions.v3.Nonce
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_emissions_v3_topic_proto_init() }
@@ -3914,19 +6718,32 @@ func file_emissions_v3_topic_proto_init() {
 				return nil
 			}
 		}
+		file_emissions_v3_topic_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptionalTopicParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v3_topic_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_emissions_v3_topic_proto_goTypes,
 		DependencyIndexes: file_emissions_v3_topic_proto_depIdxs,
+		EnumInfos:         file_emissions_v3_topic_proto_enumTypes,
 		MessageInfos:      file_emissions_v3_topic_proto_msgTypes,
 	}.Build()
 	File_emissions_v3_topic_proto = out.File
@@ -4143,2053 +4143,2317 @@ func (x *_GenesisState_91_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState                                                      protoreflect.MessageDescriptor
-	fd_GenesisState_params                                               protoreflect.FieldDescriptor
-	fd_GenesisState_core_team_addresses                                  protoreflect.FieldDescriptor
-	fd_GenesisState_next_topic_id                                        protoreflect.FieldDescriptor
-	fd_GenesisState_topics                                               protoreflect.FieldDescriptor
-	fd_GenesisState_active_topics                                        protoreflect.FieldDescriptor
-	fd_GenesisState_rewardable_topics                                    protoreflect.FieldDescriptor
-	fd_GenesisState_topic_workers                                        protoreflect.FieldDescriptor
-	fd_GenesisState_topic_reputers                                       protoreflect.FieldDescriptor
-	fd_GenesisState_topic_reward_nonce                                   protoreflect.FieldDescriptor
-	fd_GenesisState_inferer_scores_by_block                              protoreflect.FieldDescriptor
-	fd_GenesisState_forecaster_scores_by_block                           protoreflect.FieldDescriptor
-	fd_GenesisState_reputer_scores_by_block                              protoreflect.FieldDescriptor
-	fd_GenesisState_reputer_listening_coefficient                        protoreflect.FieldDescriptor
-	fd_GenesisState_previous_reputer_reward_fraction                     protoreflect.FieldDescriptor
-	fd_GenesisState_previous_inference_reward_fraction                   protoreflect.FieldDescriptor
-	fd_GenesisState_previous_forecast_reward_fraction                    protoreflect.FieldDescriptor
-	fd_GenesisState_previous_forecaster_score_ratio                      protoreflect.FieldDescriptor
-	fd_GenesisState_total_stake                                          protoreflect.FieldDescriptor
-	fd_GenesisState_topic_stake                                          protoreflect.FieldDescriptor
-	fd_GenesisState_stake_reputer_authority                              protoreflect.FieldDescriptor
-	fd_GenesisState_stake_sum_from_delegator                             protoreflect.FieldDescriptor
-	fd_GenesisState_delegated_stakes                                     protoreflect.FieldDescriptor
-	fd_GenesisState_stake_from_delegators_upon_reputer                   protoreflect.FieldDescriptor
-	fd_GenesisState_delegate_reward_per_share                            protoreflect.FieldDescriptor
-	fd_GenesisState_stake_removals_by_block                              protoreflect.FieldDescriptor
-	fd_GenesisState_stake_removals_by_actor                              protoreflect.FieldDescriptor
-	fd_GenesisState_delegate_stake_removals_by_block                     protoreflect.FieldDescriptor
-	fd_GenesisState_delegate_stake_removals_by_actor                     protoreflect.FieldDescriptor
-	fd_GenesisState_inferences                                           protoreflect.FieldDescriptor
-	fd_GenesisState_forecasts                                            protoreflect.FieldDescriptor
-	fd_GenesisState_workers                                              protoreflect.FieldDescriptor
-	fd_GenesisState_reputers                                             protoreflect.FieldDescriptor
-	fd_GenesisState_topic_fee_revenue                                    protoreflect.FieldDescriptor
-	fd_GenesisState_previous_topic_weight                                protoreflect.FieldDescriptor
-	fd_GenesisState_all_inferences                                       protoreflect.FieldDescriptor
-	fd_GenesisState_all_forecasts                                        protoreflect.FieldDescriptor
-	fd_GenesisState_all_loss_bundles                                     protoreflect.FieldDescriptor
-	fd_GenesisState_network_loss_bundles                                 protoreflect.FieldDescriptor
-	fd_GenesisState_previous_percentage_reward_to_staked_reputers        protoreflect.FieldDescriptor
-	fd_GenesisState_unfulfilled_worker_nonces                            protoreflect.FieldDescriptor
-	fd_GenesisState_unfulfilled_reputer_nonces                           protoreflect.FieldDescriptor
-	fd_GenesisState_latest_inferer_network_regrets                       protoreflect.FieldDescriptor
-	fd_GenesisState_latest_forecaster_network_regrets                    protoreflect.FieldDescriptor
-	fd_GenesisState_latest_one_in_forecaster_network_regrets             protoreflect.FieldDescriptor
-	fd_GenesisState_latest_naive_inferer_network_regrets                 protoreflect.FieldDescriptor
-	fd_GenesisState_latest_one_out_inferer_inferer_network_regrets       protoreflect.FieldDescriptor
-	fd_GenesisState_latest_one_out_inferer_forecaster_network_regrets    protoreflect.FieldDescriptor
-	fd_GenesisState_latest_one_out_forecaster_inferer_network_regrets    protoreflect.FieldDescriptor
-	fd_GenesisState_latest_one_out_forecaster_forecaster_network_regrets protoreflect.FieldDescriptor
-	fd_GenesisState_topic_last_worker_commit                             protoreflect.FieldDescriptor
-	fd_GenesisState_topic_last_reputer_commit                            protoreflect.FieldDescriptor
-	fd_GenesisState_open_worker_windows                                  protoreflect.FieldDescriptor
-	fd_GenesisState_last_drip_block                                      protoreflect.FieldDescriptor
-	fd_GenesisState_topic_to_next_possible_churning_block                protoreflect.FieldDescriptor
-	fd_GenesisState_block_to_active_topics                               protoreflect.FieldDescriptor
-	fd_GenesisState_block_to_lowest_active_topic_weight                  protoreflect.FieldDescriptor
-	fd_GenesisState_inferer_score_emas                                   protoreflect.FieldDescriptor
-	fd_GenesisState_forecaster_score_emas                                protoreflect.FieldDescriptor
-	fd_GenesisState_reputer_score_emas                                   protoreflect.FieldDescriptor
-	fd_GenesisState_previous_topic_quantile_inferer_score_ema            protoreflect.FieldDescriptor
-	fd_GenesisState_previous_topic_quantile_forecaster_score_ema         protoreflect.FieldDescriptor
-	fd_GenesisState_previous_topic_quantile_reputer_score_ema            protoreflect.FieldDescriptor
-	fd_GenesisState_count_inferer_inclusions_in_topic_active_set         protoreflect.FieldDescriptor
-	fd_GenesisState_count_forecaster_inclusions_in_topic_active_set      protoreflect.FieldDescriptor
-	fd_GenesisState_active_inferers                                      protoreflect.FieldDescriptor
-	fd_GenesisState_active_forecasters                                   protoreflect.FieldDescriptor
-	fd_GenesisState_lowest_inferer_score_ema                             protoreflect.FieldDescriptor
-	fd_GenesisState_lowest_forecaster_score_ema                          protoreflect.FieldDescriptor
-	fd_GenesisState_active_reputers                                      protoreflect.FieldDescriptor
-	fd_GenesisState_lowest_reputer_score_ema                             protoreflect.FieldDescriptor
-	fd_GenesisState_loss_bundles                                         protoreflect.FieldDescriptor
-	fd_GenesisState_total_sum_previous_topic_weights                     protoreflect.FieldDescriptor
-	fd_GenesisState_reward_current_block_emission                        protoreflect.FieldDescriptor
-	fd_GenesisState_whitelist_admins                                     protoreflect.FieldDescriptor
-	fd_GenesisState_global_whitelist                                     protoreflect.FieldDescriptor
-	fd_GenesisState_topic_creator_whitelist                              protoreflect.FieldDescriptor
-	fd_GenesisState_topic_worker_whitelist                               protoreflect.FieldDescriptor
-	fd_GenesisState_topic_reputer_whitelist                              protoreflect.FieldDescriptor
-	fd_GenesisState_topic_worker_whitelist_enabled                       protoreflect.FieldDescriptor
-	fd_GenesisState_topic_reputer_whitelist_enabled                      protoreflect.FieldDescriptor
-	fd_GenesisState_last_median_inferences                               protoreflect.FieldDescriptor
-	fd_GenesisState_mad_inferences                                       protoreflect.FieldDescriptor
-	fd_GenesisState_initial_inferer_ema_score                            protoreflect.FieldDescriptor
-	fd_GenesisState_initial_forecaster_ema_score                         protoreflect.FieldDescriptor
-	fd_GenesisState_initial_reputer_ema_score                            protoreflect.FieldDescriptor
-	fd_GenesisState_global_worker_whitelist                              protoreflect.FieldDescriptor
-	fd_GenesisState_global_reputer_whitelist                             protoreflect.FieldDescriptor
-	fd_GenesisState_global_admin_whitelist                               protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_92_list)(nil)
 
-func init() {
-	file_emissions_v7_genesis_proto_init()
-	md_GenesisState = File_emissions_v7_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
-	fd_GenesisState_core_team_addresses = md_GenesisState.Fields().ByName("core_team_addresses")
-	fd_GenesisState_next_topic_id = md_GenesisState.Fields().ByName("next_topic_id")
-	fd_GenesisState_topics = md_GenesisState.Fields().ByName("topics")
-	fd_GenesisState_active_topics = md_GenesisState.Fields().ByName("active_topics")
-	fd_GenesisState_rewardable_topics = md_GenesisState.Fields().ByName("rewardable_topics")
-	fd_GenesisState_topic_workers = md_GenesisState.Fields().ByName("topic_workers")
-	fd_GenesisState_topic_reputers = md_GenesisState.Fields().ByName("topic_reputers")
-	fd_GenesisState_topic_reward_nonce = md_GenesisState.Fields().ByName("topic_reward_nonce")
-	fd_GenesisState_inferer_scores_by_block = md_GenesisState.Fields().ByName("inferer_scores_by_block")
-	fd_GenesisState_forecaster_scores_by_block = md_GenesisState.Fields().ByName("forecaster_scores_by_block")
-	fd_GenesisState_reputer_scores_by_block = md_GenesisState.Fields().ByName("reputer_scores_by_block")
-	fd_GenesisState_reputer_listening_coefficient = md_GenesisState.Fields().ByName("reputer_listening_coefficient")
-	fd_GenesisState_previous_reputer_reward_fraction = md_GenesisState.Fields().ByName("previous_reputer_reward_fraction")
-	fd_GenesisState_previous_inference_reward_fraction = md_GenesisState.Fields().ByName("previous_inference_reward_fraction")
-	fd_GenesisState_previous_forecast_reward_fraction = md_GenesisState.Fields().ByName("previous_forecast_reward_fraction")
-	fd_GenesisState_previous_forecaster_score_ratio = md_GenesisState.Fields().ByName("previous_forecaster_score_ratio")
-	fd_GenesisState_total_stake = md_GenesisState.Fields().ByName("total_stake")
-	fd_GenesisState_topic_stake = md_GenesisState.Fields().ByName("topic_stake")
-	fd_GenesisState_stake_reputer_authority = md_GenesisState.Fields().ByName("stake_reputer_authority")
-	fd_GenesisState_stake_sum_from_delegator = md_GenesisState.Fields().ByName("stake_sum_from_delegator")
-	fd_GenesisState_delegated_stakes = md_GenesisState.Fields().ByName("delegated_stakes")
-	fd_GenesisState_stake_from_delegators_upon_reputer = md_GenesisState.Fields().ByName("stake_from_delegators_upon_reputer")
-	fd_GenesisState_delegate_reward_per_share = md_GenesisState.Fields().ByName("delegate_reward_per_share")
-	fd_GenesisState_stake_removals_by_block = md_GenesisState.Fields().ByName("stake_removals_by_block")
-	fd_GenesisState_stake_removals_by_actor = md_GenesisState.Fields().ByName("stake_removals_by_actor")
-	fd_GenesisState_delegate_stake_removals_by_block = md_GenesisState.Fields().ByName("delegate_stake_removals_by_block")
-	fd_GenesisState_delegate_stake_removals_by_actor = md_GenesisState.Fields().ByName("delegate_stake_removals_by_actor")
-	fd_GenesisState_inferences = md_GenesisState.Fields().ByName("inferences")
-	fd_GenesisState_forecasts = md_GenesisState.Fields().ByName("forecasts")
-	fd_GenesisState_workers = md_GenesisState.Fields().ByName("workers")
-	fd_GenesisState_reputers = md_GenesisState.Fields().ByName("reputers")
-	fd_GenesisState_topic_fee_revenue = md_GenesisState.Fields().ByName("topic_fee_revenue")
-	fd_GenesisState_previous_topic_weight = md_GenesisState.Fields().ByName("previous_topic_weight")
-	fd_GenesisState_all_inferences = md_GenesisState.Fields().ByName("all_inferences")
-	fd_GenesisState_all_forecasts = md_GenesisState.Fields().ByName("all_forecasts")
-	fd_GenesisState_all_loss_bundles = md_GenesisState.Fields().ByName("all_loss_bundles")
-	fd_GenesisState_network_loss_bundles = md_GenesisState.Fields().ByName("network_loss_bundles")
-	fd_GenesisState_previous_percentage_reward_to_staked_reputers = md_GenesisState.Fields().ByName("previous_percentage_reward_to_staked_reputers")
-	fd_GenesisState_unfulfilled_worker_nonces = md_GenesisState.Fields().ByName("unfulfilled_worker_nonces")
-	fd_GenesisState_unfulfilled_reputer_nonces = md_GenesisState.Fields().ByName("unfulfilled_reputer_nonces")
-	fd_GenesisState_latest_inferer_network_regrets = md_GenesisState.Fields().ByName("latest_inferer_network_regrets")
-	fd_GenesisState_latest_forecaster_network_regrets = md_GenesisState.Fields().ByName("latest_forecaster_network_regrets")
-	fd_GenesisState_latest_one_in_forecaster_network_regrets = md_GenesisState.Fields().ByName("latest_one_in_forecaster_network_regrets")
-	fd_GenesisState_latest_naive_inferer_network_regrets = md_GenesisState.Fields().ByName("latest_naive_inferer_network_regrets")
-	fd_GenesisState_latest_one_out_inferer_inferer_network_regrets = md_GenesisState.Fields().ByName("latest_one_out_inferer_inferer_network_regrets")
-	fd_GenesisState_latest_one_out_inferer_forecaster_network_regrets = md_GenesisState.Fields().ByName("latest_one_out_inferer_forecaster_network_regrets")
-	fd_GenesisState_latest_one_out_forecaster_inferer_network_regrets = md_GenesisState.Fields().ByName("latest_one_out_forecaster_inferer_network_regrets")
-	fd_GenesisState_latest_one_out_forecaster_forecaster_network_regrets = md_GenesisState.Fields().ByName("latest_one_out_forecaster_forecaster_network_regrets")
-	fd_GenesisState_topic_last_worker_commit = md_GenesisState.Fields().ByName("topic_last_worker_commit")
-	fd_GenesisState_topic_last_reputer_commit = md_GenesisState.Fields().ByName("topic_last_reputer_commit")
-	fd_GenesisState_open_worker_windows = md_GenesisState.Fields().ByName("open_worker_windows")
-	fd_GenesisState_last_drip_block = md_GenesisState.Fields().ByName("last_drip_block")
-	fd_GenesisState_topic_to_next_possible_churning_block = md_GenesisState.Fields().ByName("topic_to_next_possible_churning_block")
-	fd_GenesisState_block_to_active_topics = md_GenesisState.Fields().ByName("block_to_active_topics")
-	fd_GenesisState_block_to_lowest_active_topic_weight = md_GenesisState.Fields().ByName("block_to_lowest_active_topic_weight")
-	fd_GenesisState_inferer_score_emas = md_GenesisState.Fields().ByName("inferer_score_emas")
-	fd_GenesisState_forecaster_score_emas = md_GenesisState.Fields().ByName("forecaster_score_emas")
-	fd_GenesisState_reputer_score_emas = md_GenesisState.Fields().ByName("reputer_score_emas")
-	fd_GenesisState_previous_topic_quantile_inferer_score_ema = md_GenesisState.Fields().ByName("previous_topic_quantile_inferer_score_ema")
-	fd_GenesisState_previous_topic_quantile_forecaster_score_ema = md_GenesisState.Fields().ByName("previous_topic_quantile_forecaster_score_ema")
-	fd_GenesisState_previous_topic_quantile_reputer_score_ema = md_GenesisState.Fields().ByName("previous_topic_quantile_reputer_score_ema")
-	fd_GenesisState_count_inferer_inclusions_in_topic_active_set = md_GenesisState.Fields().ByName("count_inferer_inclusions_in_topic_active_set")
-	fd_GenesisState_count_forecaster_inclusions_in_topic_active_set = md_GenesisState.Fields().ByName("count_forecaster_inclusions_in_topic_active_set")
-	fd_GenesisState_active_inferers = md_GenesisState.Fields().ByName("active_inferers")
-	fd_GenesisState_active_forecasters = md_GenesisState.Fields().ByName("active_forecasters")
-	fd_GenesisState_lowest_inferer_score_ema = md_GenesisState.Fields().ByName("lowest_inferer_score_ema")
-	fd_GenesisState_lowest_forecaster_score_ema = md_GenesisState.Fields().ByName("lowest_forecaster_score_ema")
-	fd_GenesisState_active_reputers = md_GenesisState.Fields().ByName("active_reputers")
-	fd_GenesisState_lowest_reputer_score_ema = md_GenesisState.Fields().ByName("lowest_reputer_score_ema")
-	fd_GenesisState_loss_bundles = md_GenesisState.Fields().ByName("loss_bundles")
-	fd_GenesisState_total_sum_previous_topic_weights = md_GenesisState.Fields().ByName("total_sum_previous_topic_weights")
-	fd_GenesisState_reward_current_block_emission = md_GenesisState.Fields().ByName("reward_current_block_emission")
-	fd_GenesisState_whitelist_admins = md_GenesisState.Fields().ByName("whitelist_admins")
-	fd_GenesisState_global_whitelist = md_GenesisState.Fields().ByName("global_whitelist")
-	fd_GenesisState_topic_creator_whitelist = md_GenesisState.Fields().ByName("topic_creator_whitelist")
-	fd_GenesisState_topic_worker_whitelist = md_GenesisState.Fields().ByName("topic_worker_whitelist")
-	fd_GenesisState_topic_reputer_whitelist = md_GenesisState.Fields().ByName("topic_reputer_whitelist")
-	fd_GenesisState_topic_worker_whitelist_enabled = md_GenesisState.Fields().ByName("topic_worker_whitelist_enabled")
-	fd_GenesisState_topic_reputer_whitelist_enabled = md_GenesisState.Fields().ByName("topic_reputer_whitelist_enabled")
-	fd_GenesisState_last_median_inferences = md_GenesisState.Fields().ByName("last_median_inferences")
-	fd_GenesisState_mad_inferences = md_GenesisState.Fields().ByName("mad_inferences")
-	fd_GenesisState_initial_inferer_ema_score = md_GenesisState.Fields().ByName("initial_inferer_ema_score")
-	fd_GenesisState_initial_forecaster_ema_score = md_GenesisState.Fields().ByName("initial_forecaster_ema_score")
-	fd_GenesisState_initial_reputer_ema_score = md_GenesisState.Fields().ByName("initial_reputer_ema_score")
-	fd_GenesisState_global_worker_whitelist = md_GenesisState.Fields().ByName("global_worker_whitelist")
-	fd_GenesisState_global_reputer_whitelist = md_GenesisState.Fields().ByName("global_reputer_whitelist")
-	fd_GenesisState_global_admin_whitelist = md_GenesisState.Fields().ByName("global_admin_whitelist")
+type _GenesisState_92_list struct {
+	list *[]*TopicIdAndOptionalTopicParams
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
-
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_92_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_92_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_92_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TopicIdAndOptionalTopicParams)
+	(*x.list)[i] = concreteValue
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_92_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TopicIdAndOptionalTopicParams)
+	*x.list = append(*x.list, concreteValue)
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_92_list) AppendMutable() protoreflect.Value {
+	v := new(TopicIdAndOptionalTopicParams)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_92_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_92_list) NewElement() protoreflect.Value {
+	v := new(TopicIdAndOptionalTopicParams)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+func (x *_GenesisState_92_list) IsValid() bool {
+	return x.list != nil
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+var _ protoreflect.List = (*_GenesisState_93_list)(nil)
+
+type _GenesisState_93_list struct {
+	list *[]uint64
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_93_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_93_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_93_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
+func (x *_GenesisState_93_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_93_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field ArchivedTopics as it is not of Message kind"))
+}
+
+func (x *_GenesisState_93_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_93_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_GenesisState_93_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_94_list)(nil)
+
+type _GenesisState_94_list struct {
+	list *[]uint64
+}
+
+func (x *_GenesisState_94_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.CoreTeamAddresses) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.CoreTeamAddresses})
-		if !f(fd_GenesisState_core_team_addresses, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_94_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_GenesisState_94_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_94_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_94_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field ArchivedTopicsToPrune as it is not of Message kind"))
+}
+
+func (x *_GenesisState_94_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_94_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_GenesisState_94_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_95_list)(nil)
+
+type _GenesisState_95_list struct {
+	list *[]*TopicAndActorId
+}
+
+func (x *_GenesisState_95_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if x.NextTopicId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.NextTopicId)
-		if !f(fd_GenesisState_next_topic_id, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_95_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_95_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TopicAndActorId)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_95_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TopicAndActorId)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_95_list) AppendMutable() protoreflect.Value {
+	v := new(TopicAndActorId)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_95_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.Topics) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.Topics})
-		if !f(fd_GenesisState_topics, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_95_list) NewElement() protoreflect.Value {
+	v := new(TopicAndActorId)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_95_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_96_list)(nil)
+
+type _GenesisState_96_list struct {
+	list *[]*TopicAndActorId
+}
+
+func (x *_GenesisState_96_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.ActiveTopics) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.ActiveTopics})
-		if !f(fd_GenesisState_active_topics, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_96_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_96_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TopicAndActorId)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_96_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TopicAndActorId)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_96_list) AppendMutable() protoreflect.Value {
+	v := new(TopicAndActorId)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_96_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.RewardableTopics) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.RewardableTopics})
-		if !f(fd_GenesisState_rewardable_topics, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_96_list) NewElement() protoreflect.Value {
+	v := new(TopicAndActorId)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_96_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_97_list)(nil)
+
+type _GenesisState_97_list struct {
+	list *[]*TopicIdAndTag
+}
+
+func (x *_GenesisState_97_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.TopicWorkers) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.TopicWorkers})
-		if !f(fd_GenesisState_topic_workers, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_97_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_97_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TopicIdAndTag)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_97_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TopicIdAndTag)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_97_list) AppendMutable() protoreflect.Value {
+	v := new(TopicIdAndTag)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_97_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.TopicReputers) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.TopicReputers})
-		if !f(fd_GenesisState_topic_reputers, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_97_list) NewElement() protoreflect.Value {
+	v := new(TopicIdAndTag)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_97_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_98_list)(nil)
+
+type _GenesisState_98_list struct {
+	list *[]*v3.GroundTruth
+}
+
+func (x *_GenesisState_98_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.TopicRewardNonce) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.TopicRewardNonce})
-		if !f(fd_GenesisState_topic_reward_nonce, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_98_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_98_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.GroundTruth)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_98_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.GroundTruth)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_98_list) AppendMutable() protoreflect.Value {
+	v := new(v3.GroundTruth)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_98_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.InfererScoresByBlock) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.InfererScoresByBlock})
-		if !f(fd_GenesisState_inferer_scores_by_block, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_98_list) NewElement() protoreflect.Value {
+	v := new(v3.GroundTruth)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_98_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_99_list)(nil)
+
+type _GenesisState_99_list struct {
+	list *[]*v3.GroundTruth
+}
+
+func (x *_GenesisState_99_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.ForecasterScoresByBlock) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.ForecasterScoresByBlock})
-		if !f(fd_GenesisState_forecaster_scores_by_block, value) {
-			return
-		}
-	}
-	if len(x.ReputerScoresByBlock) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.ReputerScoresByBlock})
-		if !f(fd_GenesisState_reputer_scores_by_block, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_99_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_99_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.GroundTruth)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_99_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.GroundTruth)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_99_list) AppendMutable() protoreflect.Value {
+	v := new(v3.GroundTruth)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_99_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.ReputerListeningCoefficient) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.ReputerListeningCoefficient})
-		if !f(fd_GenesisState_reputer_listening_coefficient, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_99_list) NewElement() protoreflect.Value {
+	v := new(v3.GroundTruth)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_99_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_100_list)(nil)
+
+type _GenesisState_100_list struct {
+	list *[]*v3.WorkerPayloadCommit
+}
+
+func (x *_GenesisState_100_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.PreviousReputerRewardFraction) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.PreviousReputerRewardFraction})
-		if !f(fd_GenesisState_previous_reputer_reward_fraction, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_100_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_100_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.WorkerPayloadCommit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_100_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.WorkerPayloadCommit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_100_list) AppendMutable() protoreflect.Value {
+	v := new(v3.WorkerPayloadCommit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_100_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.PreviousInferenceRewardFraction) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.PreviousInferenceRewardFraction})
-		if !f(fd_GenesisState_previous_inference_reward_fraction, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_100_list) NewElement() protoreflect.Value {
+	v := new(v3.WorkerPayloadCommit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_100_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_101_list)(nil)
+
+type _GenesisState_101_list struct {
+	list *[]*TopicActorOperator
+}
+
+func (x *_GenesisState_101_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.PreviousForecastRewardFraction) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_19_list{list: &x.PreviousForecastRewardFraction})
-		if !f(fd_GenesisState_previous_forecast_reward_fraction, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_101_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_101_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TopicActorOperator)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_101_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TopicActorOperator)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_101_list) AppendMutable() protoreflect.Value {
+	v := new(TopicActorOperator)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_101_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.PreviousForecasterScoreRatio) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_20_list{list: &x.PreviousForecasterScoreRatio})
-		if !f(fd_GenesisState_previous_forecaster_score_ratio, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_101_list) NewElement() protoreflect.Value {
+	v := new(TopicActorOperator)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_101_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_102_list)(nil)
+
+type _GenesisState_102_list struct {
+	list *[]*v3.WorkerBond
+}
+
+func (x *_GenesisState_102_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if x.TotalStake != "" {
-		value := protoreflect.ValueOfString(x.TotalStake)
-		if !f(fd_GenesisState_total_stake, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_102_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_102_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.WorkerBond)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_102_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.WorkerBond)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_102_list) AppendMutable() protoreflect.Value {
+	v := new(v3.WorkerBond)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_102_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.TopicStake) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_22_list{list: &x.TopicStake})
-		if !f(fd_GenesisState_topic_stake, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_102_list) NewElement() protoreflect.Value {
+	v := new(v3.WorkerBond)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_102_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_103_list)(nil)
+
+type _GenesisState_103_list struct {
+	list *[]*v3.WorkerBondRemovalInfo
+}
+
+func (x *_GenesisState_103_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.StakeReputerAuthority) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_23_list{list: &x.StakeReputerAuthority})
-		if !f(fd_GenesisState_stake_reputer_authority, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_103_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_103_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.WorkerBondRemovalInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_103_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.WorkerBondRemovalInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_103_list) AppendMutable() protoreflect.Value {
+	v := new(v3.WorkerBondRemovalInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_103_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.StakeSumFromDelegator) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_24_list{list: &x.StakeSumFromDelegator})
-		if !f(fd_GenesisState_stake_sum_from_delegator, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_103_list) NewElement() protoreflect.Value {
+	v := new(v3.WorkerBondRemovalInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_103_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_104_list)(nil)
+
+type _GenesisState_104_list struct {
+	list *[]*v3.ReputerConsensusDeviation
+}
+
+func (x *_GenesisState_104_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.DelegatedStakes) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_25_list{list: &x.DelegatedStakes})
-		if !f(fd_GenesisState_delegated_stakes, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_104_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_104_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.ReputerConsensusDeviation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_104_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.ReputerConsensusDeviation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_104_list) AppendMutable() protoreflect.Value {
+	v := new(v3.ReputerConsensusDeviation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_104_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.StakeFromDelegatorsUponReputer) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_26_list{list: &x.StakeFromDelegatorsUponReputer})
-		if !f(fd_GenesisState_stake_from_delegators_upon_reputer, value) {
-			return
-		}
-	}
-	if len(x.DelegateRewardPerShare) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_27_list{list: &x.DelegateRewardPerShare})
-		if !f(fd_GenesisState_delegate_reward_per_share, value) {
-			return
-		}
-	}
-	if len(x.StakeRemovalsByBlock) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_28_list{list: &x.StakeRemovalsByBlock})
-		if !f(fd_GenesisState_stake_removals_by_block, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_104_list) NewElement() protoreflect.Value {
+	v := new(v3.ReputerConsensusDeviation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_104_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_105_list)(nil)
+
+type _GenesisState_105_list struct {
+	list *[]*v3.RedelegationInfo
+}
+
+func (x *_GenesisState_105_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.StakeRemovalsByActor) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_29_list{list: &x.StakeRemovalsByActor})
-		if !f(fd_GenesisState_stake_removals_by_actor, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_105_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_105_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.RedelegationInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_105_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.RedelegationInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_105_list) AppendMutable() protoreflect.Value {
+	v := new(v3.RedelegationInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_105_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.DelegateStakeRemovalsByBlock) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_30_list{list: &x.DelegateStakeRemovalsByBlock})
-		if !f(fd_GenesisState_delegate_stake_removals_by_block, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_105_list) NewElement() protoreflect.Value {
+	v := new(v3.RedelegationInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_105_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_106_list)(nil)
+
+type _GenesisState_106_list struct {
+	list *[]*TopicAndActorId
+}
+
+func (x *_GenesisState_106_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.DelegateStakeRemovalsByActor) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_31_list{list: &x.DelegateStakeRemovalsByActor})
-		if !f(fd_GenesisState_delegate_stake_removals_by_actor, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_106_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_106_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TopicAndActorId)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_106_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TopicAndActorId)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_106_list) AppendMutable() protoreflect.Value {
+	v := new(TopicAndActorId)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_106_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.Inferences) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_32_list{list: &x.Inferences})
-		if !f(fd_GenesisState_inferences, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_106_list) NewElement() protoreflect.Value {
+	v := new(TopicAndActorId)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_106_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_107_list)(nil)
+
+type _GenesisState_107_list struct {
+	list *[]*v3.ReputerCommission
+}
+
+func (x *_GenesisState_107_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.Forecasts) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_33_list{list: &x.Forecasts})
-		if !f(fd_GenesisState_forecasts, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_107_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_107_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.ReputerCommission)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_107_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.ReputerCommission)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_107_list) AppendMutable() protoreflect.Value {
+	v := new(v3.ReputerCommission)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_107_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.Workers) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_34_list{list: &x.Workers})
-		if !f(fd_GenesisState_workers, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_107_list) NewElement() protoreflect.Value {
+	v := new(v3.ReputerCommission)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_107_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_108_list)(nil)
+
+type _GenesisState_108_list struct {
+	list *[]*v3.StakeLock
+}
+
+func (x *_GenesisState_108_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.Reputers) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_35_list{list: &x.Reputers})
-		if !f(fd_GenesisState_reputers, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_108_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_108_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.StakeLock)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_108_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.StakeLock)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_108_list) AppendMutable() protoreflect.Value {
+	v := new(v3.StakeLock)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_108_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.TopicFeeRevenue) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_36_list{list: &x.TopicFeeRevenue})
-		if !f(fd_GenesisState_topic_fee_revenue, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_108_list) NewElement() protoreflect.Value {
+	v := new(v3.StakeLock)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_108_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_109_list)(nil)
+
+type _GenesisState_109_list struct {
+	list *[]*v3.RewardRecord
+}
+
+func (x *_GenesisState_109_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.PreviousTopicWeight) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_37_list{list: &x.PreviousTopicWeight})
-		if !f(fd_GenesisState_previous_topic_weight, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_109_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_109_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.RewardRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_109_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.RewardRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_109_list) AppendMutable() protoreflect.Value {
+	v := new(v3.RewardRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_109_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.AllInferences) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_38_list{list: &x.AllInferences})
-		if !f(fd_GenesisState_all_inferences, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_109_list) NewElement() protoreflect.Value {
+	v := new(v3.RewardRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_109_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_110_list)(nil)
+
+type _GenesisState_110_list struct {
+	list *[]*v3.RewardEscrow
+}
+
+func (x *_GenesisState_110_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.AllForecasts) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_39_list{list: &x.AllForecasts})
-		if !f(fd_GenesisState_all_forecasts, value) {
-			return
-		}
-	}
-	if len(x.AllLossBundles) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_40_list{list: &x.AllLossBundles})
-		if !f(fd_GenesisState_all_loss_bundles, value) {
-			return
-		}
-	}
-	if len(x.NetworkLossBundles) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_41_list{list: &x.NetworkLossBundles})
-		if !f(fd_GenesisState_network_loss_bundles, value) {
-			return
-		}
-	}
-	if x.PreviousPercentageRewardToStakedReputers != "" {
-		value := protoreflect.ValueOfString(x.PreviousPercentageRewardToStakedReputers)
-		if !f(fd_GenesisState_previous_percentage_reward_to_staked_reputers, value) {
-			return
-		}
-	}
-	if len(x.UnfulfilledWorkerNonces) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_43_list{list: &x.UnfulfilledWorkerNonces})
-		if !f(fd_GenesisState_unfulfilled_worker_nonces, value) {
-			return
-		}
-	}
-	if len(x.UnfulfilledReputerNonces) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_44_list{list: &x.UnfulfilledReputerNonces})
-		if !f(fd_GenesisState_unfulfilled_reputer_nonces, value) {
-			return
-		}
-	}
-	if len(x.LatestInfererNetworkRegrets) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_45_list{list: &x.LatestInfererNetworkRegrets})
-		if !f(fd_GenesisState_latest_inferer_network_regrets, value) {
-			return
-		}
-	}
-	if len(x.LatestForecasterNetworkRegrets) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_46_list{list: &x.LatestForecasterNetworkRegrets})
-		if !f(fd_GenesisState_latest_forecaster_network_regrets, value) {
-			return
-		}
-	}
-	if len(x.LatestOneInForecasterNetworkRegrets) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_47_list{list: &x.LatestOneInForecasterNetworkRegrets})
-		if !f(fd_GenesisState_latest_one_in_forecaster_network_regrets, value) {
-			return
-		}
-	}
-	if len(x.LatestNaiveInfererNetworkRegrets) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_48_list{list: &x.LatestNaiveInfererNetworkRegrets})
-		if !f(fd_GenesisState_latest_naive_inferer_network_regrets, value) {
-			return
-		}
-	}
-	if len(x.LatestOneOutInfererInfererNetworkRegrets) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_49_list{list: &x.LatestOneOutInfererInfererNetworkRegrets})
-		if !f(fd_GenesisState_latest_one_out_inferer_inferer_network_regrets, value) {
-			return
-		}
-	}
-	if len(x.LatestOneOutInfererForecasterNetworkRegrets) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_50_list{list: &x.LatestOneOutInfererForecasterNetworkRegrets})
-		if !f(fd_GenesisState_latest_one_out_inferer_forecaster_network_regrets, value) {
-			return
-		}
-	}
-	if len(x.LatestOneOutForecasterInfererNetworkRegrets) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_51_list{list: &x.LatestOneOutForecasterInfererNetworkRegrets})
-		if !f(fd_GenesisState_latest_one_out_forecaster_inferer_network_regrets, value) {
-			return
-		}
-	}
-	if len(x.LatestOneOutForecasterForecasterNetworkRegrets) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_52_list{list: &x.LatestOneOutForecasterForecasterNetworkRegrets})
-		if !f(fd_GenesisState_latest_one_out_forecaster_forecaster_network_regrets, value) {
-			return
-		}
-	}
-	if len(x.TopicLastWorkerCommit) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_53_list{list: &x.TopicLastWorkerCommit})
-		if !f(fd_GenesisState_topic_last_worker_commit, value) {
-			return
-		}
-	}
-	if len(x.TopicLastReputerCommit) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_54_list{list: &x.TopicLastReputerCommit})
-		if !f(fd_GenesisState_topic_last_reputer_commit, value) {
-			return
-		}
-	}
-	if len(x.OpenWorkerWindows) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_55_list{list: &x.OpenWorkerWindows})
-		if !f(fd_GenesisState_open_worker_windows, value) {
-			return
-		}
-	}
-	if len(x.LastDripBlock) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_56_list{list: &x.LastDripBlock})
-		if !f(fd_GenesisState_last_drip_block, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_110_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_110_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.RewardEscrow)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_110_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.RewardEscrow)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_110_list) AppendMutable() protoreflect.Value {
+	v := new(v3.RewardEscrow)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_110_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.TopicToNextPossibleChurningBlock) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_57_list{list: &x.TopicToNextPossibleChurningBlock})
-		if !f(fd_GenesisState_topic_to_next_possible_churning_block, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_110_list) NewElement() protoreflect.Value {
+	v := new(v3.RewardEscrow)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_110_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_111_list)(nil)
+
+type _GenesisState_111_list struct {
+	list *[]*v3.QueuedRewardPayout
+}
+
+func (x *_GenesisState_111_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.BlockToActiveTopics) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_58_list{list: &x.BlockToActiveTopics})
-		if !f(fd_GenesisState_block_to_active_topics, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_111_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_111_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.QueuedRewardPayout)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_111_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.QueuedRewardPayout)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_111_list) AppendMutable() protoreflect.Value {
+	v := new(v3.QueuedRewardPayout)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_111_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.BlockToLowestActiveTopicWeight) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_59_list{list: &x.BlockToLowestActiveTopicWeight})
-		if !f(fd_GenesisState_block_to_lowest_active_topic_weight, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_111_list) NewElement() protoreflect.Value {
+	v := new(v3.QueuedRewardPayout)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_111_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_113_list)(nil)
+
+type _GenesisState_113_list struct {
+	list *[]*TopicIdDelegatorReputer
+}
+
+func (x *_GenesisState_113_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.InfererScoreEmas) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_60_list{list: &x.InfererScoreEmas})
-		if !f(fd_GenesisState_inferer_score_emas, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_113_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_113_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TopicIdDelegatorReputer)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_113_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TopicIdDelegatorReputer)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_113_list) AppendMutable() protoreflect.Value {
+	v := new(TopicIdDelegatorReputer)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_113_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.ForecasterScoreEmas) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_61_list{list: &x.ForecasterScoreEmas})
-		if !f(fd_GenesisState_forecaster_score_emas, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_113_list) NewElement() protoreflect.Value {
+	v := new(TopicIdDelegatorReputer)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_113_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_114_list)(nil)
+
+type _GenesisState_114_list struct {
+	list *[]*v3.QueuedRewardPayout
+}
+
+func (x *_GenesisState_114_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if len(x.ReputerScoreEmas) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_62_list{list: &x.ReputerScoreEmas})
-		if !f(fd_GenesisState_reputer_score_emas, value) {
-			return
-		}
-	}
-	if len(x.PreviousTopicQuantileInfererScoreEma) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_63_list{list: &x.PreviousTopicQuantileInfererScoreEma})
-		if !f(fd_GenesisState_previous_topic_quantile_inferer_score_ema, value) {
-			return
-		}
-	}
-	if len(x.PreviousTopicQuantileForecasterScoreEma) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_64_list{list: &x.PreviousTopicQuantileForecasterScoreEma})
-		if !f(fd_GenesisState_previous_topic_quantile_forecaster_score_ema, value) {
-			return
-		}
-	}
-	if len(x.PreviousTopicQuantileReputerScoreEma) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_65_list{list: &x.PreviousTopicQuantileReputerScoreEma})
-		if !f(fd_GenesisState_previous_topic_quantile_reputer_score_ema, value) {
-			return
-		}
-	}
-	if len(x.CountInfererInclusionsInTopicActiveSet) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_66_list{list: &x.CountInfererInclusionsInTopicActiveSet})
-		if !f(fd_GenesisState_count_inferer_inclusions_in_topic_active_set, value) {
-			return
-		}
-	}
-	if len(x.CountForecasterInclusionsInTopicActiveSet) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_67_list{list: &x.CountForecasterInclusionsInTopicActiveSet})
-		if !f(fd_GenesisState_count_forecaster_inclusions_in_topic_active_set, value) {
-			return
-		}
-	}
-	if len(x.ActiveInferers) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_68_list{list: &x.ActiveInferers})
-		if !f(fd_GenesisState_active_inferers, value) {
-			return
-		}
-	}
-	if len(x.ActiveForecasters) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_69_list{list: &x.ActiveForecasters})
-		if !f(fd_GenesisState_active_forecasters, value) {
-			return
-		}
-	}
-	if len(x.LowestInfererScoreEma) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_70_list{list: &x.LowestInfererScoreEma})
-		if !f(fd_GenesisState_lowest_inferer_score_ema, value) {
-			return
-		}
-	}
-	if len(x.LowestForecasterScoreEma) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_71_list{list: &x.LowestForecasterScoreEma})
-		if !f(fd_GenesisState_lowest_forecaster_score_ema, value) {
-			return
-		}
-	}
-	if len(x.ActiveReputers) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_72_list{list: &x.ActiveReputers})
-		if !f(fd_GenesisState_active_reputers, value) {
-			return
-		}
-	}
-	if len(x.LowestReputerScoreEma) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_73_list{list: &x.LowestReputerScoreEma})
-		if !f(fd_GenesisState_lowest_reputer_score_ema, value) {
-			return
-		}
-	}
-	if len(x.LossBundles) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_74_list{list: &x.LossBundles})
-		if !f(fd_GenesisState_loss_bundles, value) {
-			return
-		}
-	}
-	if x.TotalSumPreviousTopicWeights != "" {
-		value := protoreflect.ValueOfString(x.TotalSumPreviousTopicWeights)
-		if !f(fd_GenesisState_total_sum_previous_topic_weights, value) {
-			return
-		}
-	}
-	if x.RewardCurrentBlockEmission != "" {
-		value := protoreflect.ValueOfString(x.RewardCurrentBlockEmission)
-		if !f(fd_GenesisState_reward_current_block_emission, value) {
-			return
-		}
-	}
-	if len(x.WhitelistAdmins) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_77_list{list: &x.WhitelistAdmins})
-		if !f(fd_GenesisState_whitelist_admins, value) {
-			return
-		}
-	}
-	if len(x.GlobalWhitelist) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_78_list{list: &x.GlobalWhitelist})
-		if !f(fd_GenesisState_global_whitelist, value) {
-			return
-		}
-	}
-	if len(x.TopicCreatorWhitelist) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_79_list{list: &x.TopicCreatorWhitelist})
-		if !f(fd_GenesisState_topic_creator_whitelist, value) {
-			return
-		}
-	}
-	if len(x.TopicWorkerWhitelist) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_80_list{list: &x.TopicWorkerWhitelist})
-		if !f(fd_GenesisState_topic_worker_whitelist, value) {
-			return
-		}
-	}
-	if len(x.TopicReputerWhitelist) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_81_list{list: &x.TopicReputerWhitelist})
-		if !f(fd_GenesisState_topic_reputer_whitelist, value) {
-			return
-		}
-	}
-	if len(x.TopicWorkerWhitelistEnabled) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_82_list{list: &x.TopicWorkerWhitelistEnabled})
-		if !f(fd_GenesisState_topic_worker_whitelist_enabled, value) {
-			return
-		}
-	}
-	if len(x.TopicReputerWhitelistEnabled) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_83_list{list: &x.TopicReputerWhitelistEnabled})
-		if !f(fd_GenesisState_topic_reputer_whitelist_enabled, value) {
-			return
-		}
-	}
-	if len(x.LastMedianInferences) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_84_list{list: &x.LastMedianInferences})
-		if !f(fd_GenesisState_last_median_inferences, value) {
-			return
-		}
-	}
-	if len(x.MadInferences) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_85_list{list: &x.MadInferences})
-		if !f(fd_GenesisState_mad_inferences, value) {
-			return
-		}
-	}
-	if len(x.InitialInfererEmaScore) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_86_list{list: &x.InitialInfererEmaScore})
-		if !f(fd_GenesisState_initial_inferer_ema_score, value) {
-			return
-		}
-	}
-	if len(x.InitialForecasterEmaScore) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_87_list{list: &x.InitialForecasterEmaScore})
-		if !f(fd_GenesisState_initial_forecaster_ema_score, value) {
-			return
-		}
-	}
-	if len(x.InitialReputerEmaScore) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_88_list{list: &x.InitialReputerEmaScore})
-		if !f(fd_GenesisState_initial_reputer_ema_score, value) {
-			return
-		}
-	}
-	if len(x.GlobalWorkerWhitelist) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_89_list{list: &x.GlobalWorkerWhitelist})
-		if !f(fd_GenesisState_global_worker_whitelist, value) {
-			return
-		}
-	}
-	if len(x.GlobalReputerWhitelist) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_90_list{list: &x.GlobalReputerWhitelist})
-		if !f(fd_GenesisState_global_reputer_whitelist, value) {
-			return
-		}
-	}
-	if len(x.GlobalAdminWhitelist) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_91_list{list: &x.GlobalAdminWhitelist})
-		if !f(fd_GenesisState_global_admin_whitelist, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_114_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_114_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.QueuedRewardPayout)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_114_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v3.QueuedRewardPayout)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_114_list) AppendMutable() protoreflect.Value {
+	v := new(v3.QueuedRewardPayout)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_114_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "emissions.v7.GenesisState.params":
-		return x.Params != nil
-	case "emissions.v7.GenesisState.core_team_addresses":
-		return len(x.CoreTeamAddresses) != 0
-	case "emissions.v7.GenesisState.next_topic_id":
-		return x.NextTopicId != uint64(0)
-	case "emissions.v7.GenesisState.topics":
-		return len(x.Topics) != 0
-	case "emissions.v7.GenesisState.active_topics":
-		return len(x.ActiveTopics) != 0
-	case "emissions.v7.GenesisState.rewardable_topics":
-		return len(x.RewardableTopics) != 0
-	case "emissions.v7.GenesisState.topic_workers":
-		return len(x.TopicWorkers) != 0
-	case "emissions.v7.GenesisState.topic_reputers":
-		return len(x.TopicReputers) != 0
-	case "emissions.v7.GenesisState.topic_reward_nonce":
-		return len(x.TopicRewardNonce) != 0
-	case "emissions.v7.GenesisState.inferer_scores_by_block":
-		return len(x.InfererScoresByBlock) != 0
-	case "emissions.v7.GenesisState.forecaster_scores_by_block":
-		return len(x.ForecasterScoresByBlock) != 0
-	case "emissions.v7.GenesisState.reputer_scores_by_block":
-		return len(x.ReputerScoresByBlock) != 0
-	case "emissions.v7.GenesisState.reputer_listening_coefficient":
-		return len(x.ReputerListeningCoefficient) != 0
-	case "emissions.v7.GenesisState.previous_reputer_reward_fraction":
-		return len(x.PreviousReputerRewardFraction) != 0
-	case "emissions.v7.GenesisState.previous_inference_reward_fraction":
-		return len(x.PreviousInferenceRewardFraction) != 0
-	case "emissions.v7.GenesisState.previous_forecast_reward_fraction":
-		return len(x.PreviousForecastRewardFraction) != 0
-	case "emissions.v7.GenesisState.previous_forecaster_score_ratio":
-		return len(x.PreviousForecasterScoreRatio) != 0
-	case "emissions.v7.GenesisState.total_stake":
-		return x.TotalStake != ""
-	case "emissions.v7.GenesisState.topic_stake":
-		return len(x.TopicStake) != 0
-	case "emissions.v7.GenesisState.stake_reputer_authority":
-		return len(x.StakeReputerAuthority) != 0
-	case "emissions.v7.GenesisState.stake_sum_from_delegator":
-		return len(x.StakeSumFromDelegator) != 0
-	case "emissions.v7.GenesisState.delegated_stakes":
-		return len(x.DelegatedStakes) != 0
-	case "emissions.v7.GenesisState.stake_from_delegators_upon_reputer":
-		return len(x.StakeFromDelegatorsUponReputer) != 0
-	case "emissions.v7.GenesisState.delegate_reward_per_share":
-		return len(x.DelegateRewardPerShare) != 0
-	case "emissions.v7.GenesisState.stake_removals_by_block":
-		return len(x.StakeRemovalsByBlock) != 0
-	case "emissions.v7.GenesisState.stake_removals_by_actor":
-		return len(x.StakeRemovalsByActor) != 0
-	case "emissions.v7.GenesisState.delegate_stake_removals_by_block":
-		return len(x.DelegateStakeRemovalsByBlock) != 0
-	case "emissions.v7.GenesisState.delegate_stake_removals_by_actor":
-		return len(x.DelegateStakeRemovalsByActor) != 0
-	case "emissions.v7.GenesisState.inferences":
-		return len(x.Inferences) != 0
-	case "emissions.v7.GenesisState.forecasts":
-		return len(x.Forecasts) != 0
-	case "emissions.v7.GenesisState.workers":
-		return len(x.Workers) != 0
-	case "emissions.v7.GenesisState.reputers":
-		return len(x.Reputers) != 0
-	case "emissions.v7.GenesisState.topic_fee_revenue":
-		return len(x.TopicFeeRevenue) != 0
-	case "emissions.v7.GenesisState.previous_topic_weight":
-		return len(x.PreviousTopicWeight) != 0
-	case "emissions.v7.GenesisState.all_inferences":
-		return len(x.AllInferences) != 0
-	case "emissions.v7.GenesisState.all_forecasts":
-		return len(x.AllForecasts) != 0
-	case "emissions.v7.GenesisState.all_loss_bundles":
-		return len(x.AllLossBundles) != 0
-	case "emissions.v7.GenesisState.network_loss_bundles":
-		return len(x.NetworkLossBundles) != 0
-	case "emissions.v7.GenesisState.previous_percentage_reward_to_staked_reputers":
-		return x.PreviousPercentageRewardToStakedReputers != ""
-	case "emissions.v7.GenesisState.unfulfilled_worker_nonces":
-		return len(x.UnfulfilledWorkerNonces) != 0
-	case "emissions.v7.GenesisState.unfulfilled_reputer_nonces":
-		return len(x.UnfulfilledReputerNonces) != 0
-	case "emissions.v7.GenesisState.latest_inferer_network_regrets":
-		return len(x.LatestInfererNetworkRegrets) != 0
-	case "emissions.v7.GenesisState.latest_forecaster_network_regrets":
-		return len(x.LatestForecasterNetworkRegrets) != 0
-	case "emissions.v7.GenesisState.latest_one_in_forecaster_network_regrets":
-		return len(x.LatestOneInForecasterNetworkRegrets) != 0
-	case "emissions.v7.GenesisState.latest_naive_inferer_network_regrets":
-		return len(x.LatestNaiveInfererNetworkRegrets) != 0
-	case "emissions.v7.GenesisState.latest_one_out_inferer_inferer_network_regrets":
-		return len(x.LatestOneOutInfererInfererNetworkRegrets) != 0
-	case "emissions.v7.GenesisState.latest_one_out_inferer_forecaster_network_regrets":
-		return len(x.LatestOneOutInfererForecasterNetworkRegrets) != 0
-	case "emissions.v7.GenesisState.latest_one_out_forecaster_inferer_network_regrets":
-		return len(x.LatestOneOutForecasterInfererNetworkRegrets) != 0
-	case "emissions.v7.GenesisState.latest_one_out_forecaster_forecaster_network_regrets":
-		return len(x.LatestOneOutForecasterForecasterNetworkRegrets) != 0
-	case "emissions.v7.GenesisState.topic_last_worker_commit":
-		return len(x.TopicLastWorkerCommit) != 0
-	case "emissions.v7.GenesisState.topic_last_reputer_commit":
-		return len(x.TopicLastReputerCommit) != 0
-	case "emissions.v7.GenesisState.open_worker_windows":
-		return len(x.OpenWorkerWindows) != 0
-	case "emissions.v7.GenesisState.last_drip_block":
-		return len(x.LastDripBlock) != 0
-	case "emissions.v7.GenesisState.topic_to_next_possible_churning_block":
-		return len(x.TopicToNextPossibleChurningBlock) != 0
-	case "emissions.v7.GenesisState.block_to_active_topics":
-		return len(x.BlockToActiveTopics) != 0
-	case "emissions.v7.GenesisState.block_to_lowest_active_topic_weight":
-		return len(x.BlockToLowestActiveTopicWeight) != 0
-	case "emissions.v7.GenesisState.inferer_score_emas":
-		return len(x.InfererScoreEmas) != 0
-	case "emissions.v7.GenesisState.forecaster_score_emas":
-		return len(x.ForecasterScoreEmas) != 0
-	case "emissions.v7.GenesisState.reputer_score_emas":
-		return len(x.ReputerScoreEmas) != 0
-	case "emissions.v7.GenesisState.previous_topic_quantile_inferer_score_ema":
-		return len(x.PreviousTopicQuantileInfererScoreEma) != 0
-	case "emissions.v7.GenesisState.previous_topic_quantile_forecaster_score_ema":
-		return len(x.PreviousTopicQuantileForecasterScoreEma) != 0
-	case "emissions.v7.GenesisState.previous_topic_quantile_reputer_score_ema":
-		return len(x.PreviousTopicQuantileReputerScoreEma) != 0
-	case "emissions.v7.GenesisState.count_inferer_inclusions_in_topic_active_set":
-		return len(x.CountInfererInclusionsInTopicActiveSet) != 0
-	case "emissions.v7.GenesisState.count_forecaster_inclusions_in_topic_active_set":
-		return len(x.CountForecasterInclusionsInTopicActiveSet) != 0
-	case "emissions.v7.GenesisState.active_inferers":
-		return len(x.ActiveInferers) != 0
-	case "emissions.v7.GenesisState.active_forecasters":
-		return len(x.ActiveForecasters) != 0
-	case "emissions.v7.GenesisState.lowest_inferer_score_ema":
-		return len(x.LowestInfererScoreEma) != 0
-	case "emissions.v7.GenesisState.lowest_forecaster_score_ema":
-		return len(x.LowestForecasterScoreEma) != 0
-	case "emissions.v7.GenesisState.active_reputers":
-		return len(x.ActiveReputers) != 0
-	case "emissions.v7.GenesisState.lowest_reputer_score_ema":
-		return len(x.LowestReputerScoreEma) != 0
-	case "emissions.v7.GenesisState.loss_bundles":
-		return len(x.LossBundles) != 0
-	case "emissions.v7.GenesisState.total_sum_previous_topic_weights":
-		return x.TotalSumPreviousTopicWeights != ""
-	case "emissions.v7.GenesisState.reward_current_block_emission":
-		return x.RewardCurrentBlockEmission != ""
-	case "emissions.v7.GenesisState.whitelist_admins":
-		return len(x.WhitelistAdmins) != 0
-	case "emissions.v7.GenesisState.global_whitelist":
-		return len(x.GlobalWhitelist) != 0
-	case "emissions.v7.GenesisState.topic_creator_whitelist":
-		return len(x.TopicCreatorWhitelist) != 0
-	case "emissions.v7.GenesisState.topic_worker_whitelist":
-		return len(x.TopicWorkerWhitelist) != 0
-	case "emissions.v7.GenesisState.topic_reputer_whitelist":
-		return len(x.TopicReputerWhitelist) != 0
-	case "emissions.v7.GenesisState.topic_worker_whitelist_enabled":
-		return len(x.TopicWorkerWhitelistEnabled) != 0
-	case "emissions.v7.GenesisState.topic_reputer_whitelist_enabled":
-		return len(x.TopicReputerWhitelistEnabled) != 0
-	case "emissions.v7.GenesisState.last_median_inferences":
-		return len(x.LastMedianInferences) != 0
-	case "emissions.v7.GenesisState.mad_inferences":
-		return len(x.MadInferences) != 0
-	case "emissions.v7.GenesisState.initial_inferer_ema_score":
-		return len(x.InitialInfererEmaScore) != 0
-	case "emissions.v7.GenesisState.initial_forecaster_ema_score":
-		return len(x.InitialForecasterEmaScore) != 0
-	case "emissions.v7.GenesisState.initial_reputer_ema_score":
-		return len(x.InitialReputerEmaScore) != 0
-	case "emissions.v7.GenesisState.global_worker_whitelist":
-		return len(x.GlobalWorkerWhitelist) != 0
-	case "emissions.v7.GenesisState.global_reputer_whitelist":
-		return len(x.GlobalReputerWhitelist) != 0
-	case "emissions.v7.GenesisState.global_admin_whitelist":
-		return len(x.GlobalAdminWhitelist) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.GenesisState"))
-		}
-		panic(fmt.Errorf("message emissions.v7.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_114_list) NewElement() protoreflect.Value {
+	v := new(v3.QueuedRewardPayout)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "emissions.v7.GenesisState.params":
-		x.Params = nil
-	case "emissions.v7.GenesisState.core_team_addresses":
-		x.CoreTeamAddresses = nil
-	case "emissions.v7.GenesisState.next_topic_id":
-		x.NextTopicId = uint64(0)
-	case "emissions.v7.GenesisState.topics":
-		x.Topics = nil
-	case "emissions.v7.GenesisState.active_topics":
-		x.ActiveTopics = nil
-	case "emissions.v7.GenesisState.rewardable_topics":
-		x.RewardableTopics = nil
-	case "emissions.v7.GenesisState.topic_workers":
-		x.TopicWorkers = nil
-	case "emissions.v7.GenesisState.topic_reputers":
-		x.TopicReputers = nil
-	case "emissions.v7.GenesisState.topic_reward_nonce":
-		x.TopicRewardNonce = nil
-	case "emissions.v7.GenesisState.inferer_scores_by_block":
-		x.InfererScoresByBlock = nil
-	case "emissions.v7.GenesisState.forecaster_scores_by_block":
-		x.ForecasterScoresByBlock = nil
-	case "emissions.v7.GenesisState.reputer_scores_by_block":
-		x.ReputerScoresByBlock = nil
-	case "emissions.v7.GenesisState.reputer_listening_coefficient":
-		x.ReputerListeningCoefficient = nil
-	case "emissions.v7.GenesisState.previous_reputer_reward_fraction":
-		x.PreviousReputerRewardFraction = nil
-	case "emissions.v7.GenesisState.previous_inference_reward_fraction":
-		x.PreviousInferenceRewardFraction = nil
-	case "emissions.v7.GenesisState.previous_forecast_reward_fraction":
-		x.PreviousForecastRewardFraction = nil
-	case "emissions.v7.GenesisState.previous_forecaster_score_ratio":
-		x.PreviousForecasterScoreRatio = nil
-	case "emissions.v7.GenesisState.total_stake":
-		x.TotalStake = ""
-	case "emissions.v7.GenesisState.topic_stake":
-		x.TopicStake = nil
-	case "emissions.v7.GenesisState.stake_reputer_authority":
-		x.StakeReputerAuthority = nil
-	case "emissions.v7.GenesisState.stake_sum_from_delegator":
-		x.StakeSumFromDelegator = nil
-	case "emissions.v7.GenesisState.delegated_stakes":
-		x.DelegatedStakes = nil
-	case "emissions.v7.GenesisState.stake_from_delegators_upon_reputer":
-		x.StakeFromDelegatorsUponReputer = nil
-	case "emissions.v7.GenesisState.delegate_reward_per_share":
-		x.DelegateRewardPerShare = nil
-	case "emissions.v7.GenesisState.stake_removals_by_block":
-		x.StakeRemovalsByBlock = nil
-	case "emissions.v7.GenesisState.stake_removals_by_actor":
-		x.StakeRemovalsByActor = nil
-	case "emissions.v7.GenesisState.delegate_stake_removals_by_block":
-		x.DelegateStakeRemovalsByBlock = nil
-	case "emissions.v7.GenesisState.delegate_stake_removals_by_actor":
-		x.DelegateStakeRemovalsByActor = nil
-	case "emissions.v7.GenesisState.inferences":
-		x.Inferences = nil
-	case "emissions.v7.GenesisState.forecasts":
-		x.Forecasts = nil
-	case "emissions.v7.GenesisState.workers":
-		x.Workers = nil
-	case "emissions.v7.GenesisState.reputers":
-		x.Reputers = nil
-	case "emissions.v7.GenesisState.topic_fee_revenue":
-		x.TopicFeeRevenue = nil
-	case "emissions.v7.GenesisState.previous_topic_weight":
-		x.PreviousTopicWeight = nil
-	case "emissions.v7.GenesisState.all_inferences":
-		x.AllInferences = nil
-	case "emissions.v7.GenesisState.all_forecasts":
-		x.AllForecasts = nil
-	case "emissions.v7.GenesisState.all_loss_bundles":
-		x.AllLossBundles = nil
-	case "emissions.v7.GenesisState.network_loss_bundles":
-		x.NetworkLossBundles = nil
-	case "emissions.v7.GenesisState.previous_percentage_reward_to_staked_reputers":
-		x.PreviousPercentageRewardToStakedReputers = ""
-	case "emissions.v7.GenesisState.unfulfilled_worker_nonces":
-		x.UnfulfilledWorkerNonces = nil
-	case "emissions.v7.GenesisState.unfulfilled_reputer_nonces":
-		x.UnfulfilledReputerNonces = nil
-	case "emissions.v7.GenesisState.latest_inferer_network_regrets":
-		x.LatestInfererNetworkRegrets = nil
-	case "emissions.v7.GenesisState.latest_forecaster_network_regrets":
-		x.LatestForecasterNetworkRegrets = nil
-	case "emissions.v7.GenesisState.latest_one_in_forecaster_network_regrets":
-		x.LatestOneInForecasterNetworkRegrets = nil
-	case "emissions.v7.GenesisState.latest_naive_inferer_network_regrets":
-		x.LatestNaiveInfererNetworkRegrets = nil
-	case "emissions.v7.GenesisState.latest_one_out_inferer_inferer_network_regrets":
-		x.LatestOneOutInfererInfererNetworkRegrets = nil
-	case "emissions.v7.GenesisState.latest_one_out_inferer_forecaster_network_regrets":
-		x.LatestOneOutInfererForecasterNetworkRegrets = nil
-	case "emissions.v7.GenesisState.latest_one_out_forecaster_inferer_network_regrets":
-		x.LatestOneOutForecasterInfererNetworkRegrets = nil
-	case "emissions.v7.GenesisState.latest_one_out_forecaster_forecaster_network_regrets":
-		x.LatestOneOutForecasterForecasterNetworkRegrets = nil
-	case "emissions.v7.GenesisState.topic_last_worker_commit":
-		x.TopicLastWorkerCommit = nil
-	case "emissions.v7.GenesisState.topic_last_reputer_commit":
-		x.TopicLastReputerCommit = nil
-	case "emissions.v7.GenesisState.open_worker_windows":
-		x.OpenWorkerWindows = nil
-	case "emissions.v7.GenesisState.last_drip_block":
-		x.LastDripBlock = nil
-	case "emissions.v7.GenesisState.topic_to_next_possible_churning_block":
-		x.TopicToNextPossibleChurningBlock = nil
-	case "emissions.v7.GenesisState.block_to_active_topics":
-		x.BlockToActiveTopics = nil
-	case "emissions.v7.GenesisState.block_to_lowest_active_topic_weight":
-		x.BlockToLowestActiveTopicWeight = nil
-	case "emissions.v7.GenesisState.inferer_score_emas":
-		x.InfererScoreEmas = nil
-	case "emissions.v7.GenesisState.forecaster_score_emas":
-		x.ForecasterScoreEmas = nil
-	case "emissions.v7.GenesisState.reputer_score_emas":
-		x.ReputerScoreEmas = nil
-	case "emissions.v7.GenesisState.previous_topic_quantile_inferer_score_ema":
-		x.PreviousTopicQuantileInfererScoreEma = nil
-	case "emissions.v7.GenesisState.previous_topic_quantile_forecaster_score_ema":
-		x.PreviousTopicQuantileForecasterScoreEma = nil
-	case "emissions.v7.GenesisState.previous_topic_quantile_reputer_score_ema":
-		x.PreviousTopicQuantileReputerScoreEma = nil
-	case "emissions.v7.GenesisState.count_inferer_inclusions_in_topic_active_set":
-		x.CountInfererInclusionsInTopicActiveSet = nil
-	case "emissions.v7.GenesisState.count_forecaster_inclusions_in_topic_active_set":
-		x.CountForecasterInclusionsInTopicActiveSet = nil
-	case "emissions.v7.GenesisState.active_inferers":
-		x.ActiveInferers = nil
-	case "emissions.v7.GenesisState.active_forecasters":
-		x.ActiveForecasters = nil
-	case "emissions.v7.GenesisState.lowest_inferer_score_ema":
-		x.LowestInfererScoreEma = nil
-	case "emissions.v7.GenesisState.lowest_forecaster_score_ema":
-		x.LowestForecasterScoreEma = nil
-	case "emissions.v7.GenesisState.active_reputers":
-		x.ActiveReputers = nil
-	case "emissions.v7.GenesisState.lowest_reputer_score_ema":
-		x.LowestReputerScoreEma = nil
-	case "emissions.v7.GenesisState.loss_bundles":
-		x.LossBundles = nil
-	case "emissions.v7.GenesisState.total_sum_previous_topic_weights":
-		x.TotalSumPreviousTopicWeights = ""
-	case "emissions.v7.GenesisState.reward_current_block_emission":
-		x.RewardCurrentBlockEmission = ""
-	case "emissions.v7.GenesisState.whitelist_admins":
-		x.WhitelistAdmins = nil
-	case "emissions.v7.GenesisState.global_whitelist":
-		x.GlobalWhitelist = nil
-	case "emissions.v7.GenesisState.topic_creator_whitelist":
-		x.TopicCreatorWhitelist = nil
-	case "emissions.v7.GenesisState.topic_worker_whitelist":
-		x.TopicWorkerWhitelist = nil
-	case "emissions.v7.GenesisState.topic_reputer_whitelist":
-		x.TopicReputerWhitelist = nil
-	case "emissions.v7.GenesisState.topic_worker_whitelist_enabled":
-		x.TopicWorkerWhitelistEnabled = nil
-	case "emissions.v7.GenesisState.topic_reputer_whitelist_enabled":
-		x.TopicReputerWhitelistEnabled = nil
-	case "emissions.v7.GenesisState.last_median_inferences":
-		x.LastMedianInferences = nil
-	case "emissions.v7.GenesisState.mad_inferences":
-		x.MadInferences = nil
-	case "emissions.v7.GenesisState.initial_inferer_ema_score":
-		x.InitialInfererEmaScore = nil
-	case "emissions.v7.GenesisState.initial_forecaster_ema_score":
-		x.InitialForecasterEmaScore = nil
-	case "emissions.v7.GenesisState.initial_reputer_ema_score":
-		x.InitialReputerEmaScore = nil
-	case "emissions.v7.GenesisState.global_worker_whitelist":
-		x.GlobalWorkerWhitelist = nil
-	case "emissions.v7.GenesisState.global_reputer_whitelist":
-		x.GlobalReputerWhitelist = nil
-	case "emissions.v7.GenesisState.global_admin_whitelist":
-		x.GlobalAdminWhitelist = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.GenesisState"))
+func (x *_GenesisState_114_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                                                      protoreflect.MessageDescriptor
+	fd_GenesisState_params                                               protoreflect.FieldDescriptor
+	fd_GenesisState_core_team_addresses                                  protoreflect.FieldDescriptor
+	fd_GenesisState_next_topic_id                                        protoreflect.FieldDescriptor
+	fd_GenesisState_topics                                               protoreflect.FieldDescriptor
+	fd_GenesisState_active_topics                                        protoreflect.FieldDescriptor
+	fd_GenesisState_rewardable_topics                                    protoreflect.FieldDescriptor
+	fd_GenesisState_topic_workers                                        protoreflect.FieldDescriptor
+	fd_GenesisState_topic_reputers                                       protoreflect.FieldDescriptor
+	fd_GenesisState_topic_reward_nonce                                   protoreflect.FieldDescriptor
+	fd_GenesisState_inferer_scores_by_block                              protoreflect.FieldDescriptor
+	fd_GenesisState_forecaster_scores_by_block                           protoreflect.FieldDescriptor
+	fd_GenesisState_reputer_scores_by_block                              protoreflect.FieldDescriptor
+	fd_GenesisState_reputer_listening_coefficient                        protoreflect.FieldDescriptor
+	fd_GenesisState_previous_reputer_reward_fraction                     protoreflect.FieldDescriptor
+	fd_GenesisState_previous_inference_reward_fraction                   protoreflect.FieldDescriptor
+	fd_GenesisState_previous_forecast_reward_fraction                    protoreflect.FieldDescriptor
+	fd_GenesisState_previous_forecaster_score_ratio                      protoreflect.FieldDescriptor
+	fd_GenesisState_total_stake                                          protoreflect.FieldDescriptor
+	fd_GenesisState_topic_stake                                          protoreflect.FieldDescriptor
+	fd_GenesisState_stake_reputer_authority                              protoreflect.FieldDescriptor
+	fd_GenesisState_stake_sum_from_delegator                             protoreflect.FieldDescriptor
+	fd_GenesisState_delegated_stakes                                     protoreflect.FieldDescriptor
+	fd_GenesisState_stake_from_delegators_upon_reputer                   protoreflect.FieldDescriptor
+	fd_GenesisState_delegate_reward_per_share                            protoreflect.FieldDescriptor
+	fd_GenesisState_stake_removals_by_block                              protoreflect.FieldDescriptor
+	fd_GenesisState_stake_removals_by_actor                              protoreflect.FieldDescriptor
+	fd_GenesisState_delegate_stake_removals_by_block                     protoreflect.FieldDescriptor
+	fd_GenesisState_delegate_stake_removals_by_actor                     protoreflect.FieldDescriptor
+	fd_GenesisState_inferences                                           protoreflect.FieldDescriptor
+	fd_GenesisState_forecasts                                            protoreflect.FieldDescriptor
+	fd_GenesisState_workers                                              protoreflect.FieldDescriptor
+	fd_GenesisState_reputers                                             protoreflect.FieldDescriptor
+	fd_GenesisState_topic_fee_revenue                                    protoreflect.FieldDescriptor
+	fd_GenesisState_previous_topic_weight                                protoreflect.FieldDescriptor
+	fd_GenesisState_all_inferences                                       protoreflect.FieldDescriptor
+	fd_GenesisState_all_forecasts                                        protoreflect.FieldDescriptor
+	fd_GenesisState_all_loss_bundles                                     protoreflect.FieldDescriptor
+	fd_GenesisState_network_loss_bundles                                 protoreflect.FieldDescriptor
+	fd_GenesisState_previous_percentage_reward_to_staked_reputers        protoreflect.FieldDescriptor
+	fd_GenesisState_unfulfilled_worker_nonces                            protoreflect.FieldDescriptor
+	fd_GenesisState_unfulfilled_reputer_nonces                           protoreflect.FieldDescriptor
+	fd_GenesisState_latest_inferer_network_regrets                       protoreflect.FieldDescriptor
+	fd_GenesisState_latest_forecaster_network_regrets                    protoreflect.FieldDescriptor
+	fd_GenesisState_latest_one_in_forecaster_network_regrets             protoreflect.FieldDescriptor
+	fd_GenesisState_latest_naive_inferer_network_regrets                 protoreflect.FieldDescriptor
+	fd_GenesisState_latest_one_out_inferer_inferer_network_regrets       protoreflect.FieldDescriptor
+	fd_GenesisState_latest_one_out_inferer_forecaster_network_regrets    protoreflect.FieldDescriptor
+	fd_GenesisState_latest_one_out_forecaster_inferer_network_regrets    protoreflect.FieldDescriptor
+	fd_GenesisState_latest_one_out_forecaster_forecaster_network_regrets protoreflect.FieldDescriptor
+	fd_GenesisState_topic_last_worker_commit                             protoreflect.FieldDescriptor
+	fd_GenesisState_topic_last_reputer_commit                            protoreflect.FieldDescriptor
+	fd_GenesisState_open_worker_windows                                  protoreflect.FieldDescriptor
+	fd_GenesisState_last_drip_block                                      protoreflect.FieldDescriptor
+	fd_GenesisState_topic_to_next_possible_churning_block                protoreflect.FieldDescriptor
+	fd_GenesisState_block_to_active_topics                               protoreflect.FieldDescriptor
+	fd_GenesisState_block_to_lowest_active_topic_weight                  protoreflect.FieldDescriptor
+	fd_GenesisState_inferer_score_emas                                   protoreflect.FieldDescriptor
+	fd_GenesisState_forecaster_score_emas                                protoreflect.FieldDescriptor
+	fd_GenesisState_reputer_score_emas                                   protoreflect.FieldDescriptor
+	fd_GenesisState_previous_topic_quantile_inferer_score_ema            protoreflect.FieldDescriptor
+	fd_GenesisState_previous_topic_quantile_forecaster_score_ema         protoreflect.FieldDescriptor
+	fd_GenesisState_previous_topic_quantile_reputer_score_ema            protoreflect.FieldDescriptor
+	fd_GenesisState_count_inferer_inclusions_in_topic_active_set         protoreflect.FieldDescriptor
+	fd_GenesisState_count_forecaster_inclusions_in_topic_active_set      protoreflect.FieldDescriptor
+	fd_GenesisState_active_inferers                                      protoreflect.FieldDescriptor
+	fd_GenesisState_active_forecasters                                   protoreflect.FieldDescriptor
+	fd_GenesisState_lowest_inferer_score_ema                             protoreflect.FieldDescriptor
+	fd_GenesisState_lowest_forecaster_score_ema                          protoreflect.FieldDescriptor
+	fd_GenesisState_active_reputers                                      protoreflect.FieldDescriptor
+	fd_GenesisState_lowest_reputer_score_ema                             protoreflect.FieldDescriptor
+	fd_GenesisState_loss_bundles                                         protoreflect.FieldDescriptor
+	fd_GenesisState_total_sum_previous_topic_weights                     protoreflect.FieldDescriptor
+	fd_GenesisState_reward_current_block_emission                        protoreflect.FieldDescriptor
+	fd_GenesisState_whitelist_admins                                     protoreflect.FieldDescriptor
+	fd_GenesisState_global_whitelist                                     protoreflect.FieldDescriptor
+	fd_GenesisState_topic_creator_whitelist                              protoreflect.FieldDescriptor
+	fd_GenesisState_topic_worker_whitelist                               protoreflect.FieldDescriptor
+	fd_GenesisState_topic_reputer_whitelist                              protoreflect.FieldDescriptor
+	fd_GenesisState_topic_worker_whitelist_enabled                       protoreflect.FieldDescriptor
+	fd_GenesisState_topic_reputer_whitelist_enabled                      protoreflect.FieldDescriptor
+	fd_GenesisState_last_median_inferences                               protoreflect.FieldDescriptor
+	fd_GenesisState_mad_inferences                                       protoreflect.FieldDescriptor
+	fd_GenesisState_initial_inferer_ema_score                            protoreflect.FieldDescriptor
+	fd_GenesisState_initial_forecaster_ema_score                         protoreflect.FieldDescriptor
+	fd_GenesisState_initial_reputer_ema_score                            protoreflect.FieldDescriptor
+	fd_GenesisState_global_worker_whitelist                              protoreflect.FieldDescriptor
+	fd_GenesisState_global_reputer_whitelist                             protoreflect.FieldDescriptor
+	fd_GenesisState_global_admin_whitelist                               protoreflect.FieldDescriptor
+	fd_GenesisState_pending_topic_updates                                protoreflect.FieldDescriptor
+	fd_GenesisState_archived_topics                                      protoreflect.FieldDescriptor
+	fd_GenesisState_archived_topics_to_prune                             protoreflect.FieldDescriptor
+	fd_GenesisState_topic_owners                                         protoreflect.FieldDescriptor
+	fd_GenesisState_pending_topic_owners                                 protoreflect.FieldDescriptor
+	fd_GenesisState_topic_tags                                           protoreflect.FieldDescriptor
+	fd_GenesisState_reputer_ground_truths                                protoreflect.FieldDescriptor
+	fd_GenesisState_consensus_ground_truths                              protoreflect.FieldDescriptor
+	fd_GenesisState_worker_payload_commits                               protoreflect.FieldDescriptor
+	fd_GenesisState_actor_operators                                      protoreflect.FieldDescriptor
+	fd_GenesisState_worker_bonds                                         protoreflect.FieldDescriptor
+	fd_GenesisState_worker_bond_removals                                 protoreflect.FieldDescriptor
+	fd_GenesisState_reputer_consensus_deviations                         protoreflect.FieldDescriptor
+	fd_GenesisState_redelegations                                        protoreflect.FieldDescriptor
+	fd_GenesisState_auto_compound_delegators                             protoreflect.FieldDescriptor
+	fd_GenesisState_reputer_commissions                                  protoreflect.FieldDescriptor
+	fd_GenesisState_stake_locks                                          protoreflect.FieldDescriptor
+	fd_GenesisState_reward_history                                       protoreflect.FieldDescriptor
+	fd_GenesisState_reward_escrows                                       protoreflect.FieldDescriptor
+	fd_GenesisState_queued_reward_payouts                                protoreflect.FieldDescriptor
+	fd_GenesisState_next_reward_payout_id                                protoreflect.FieldDescriptor
+	fd_GenesisState_archived_topic_delegate_removal_cursors              protoreflect.FieldDescriptor
+	fd_GenesisState_dead_lettered_reward_payouts                         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v7_genesis_proto_init()
+	md_GenesisState = File_emissions_v7_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_core_team_addresses = md_GenesisState.Fields().ByName("core_team_addresses")
+	fd_GenesisState_next_topic_id = md_GenesisState.Fields().ByName("next_topic_id")
+	fd_GenesisState_topics = md_GenesisState.Fields().ByName("topics")
+	fd_GenesisState_active_topics = md_GenesisState.Fields().ByName("active_topics")
+	fd_GenesisState_rewardable_topics = md_GenesisState.Fields().ByName("rewardable_topics")
+	fd_GenesisState_topic_workers = md_GenesisState.Fields().ByName("topic_workers")
+	fd_GenesisState_topic_reputers = md_GenesisState.Fields().ByName("topic_reputers")
+	fd_GenesisState_topic_reward_nonce = md_GenesisState.Fields().ByName("topic_reward_nonce")
+	fd_GenesisState_inferer_scores_by_block = md_GenesisState.Fields().ByName("inferer_scores_by_block")
+	fd_GenesisState_forecaster_scores_by_block = md_GenesisState.Fields().ByName("forecaster_scores_by_block")
+	fd_GenesisState_reputer_scores_by_block = md_GenesisState.Fields().ByName("reputer_scores_by_block")
+	fd_GenesisState_reputer_listening_coefficient = md_GenesisState.Fields().ByName("reputer_listening_coefficient")
+	fd_GenesisState_previous_reputer_reward_fraction = md_GenesisState.Fields().ByName("previous_reputer_reward_fraction")
+	fd_GenesisState_previous_inference_reward_fraction = md_GenesisState.Fields().ByName("previous_inference_reward_fraction")
+	fd_GenesisState_previous_forecast_reward_fraction = md_GenesisState.Fields().ByName("previous_forecast_reward_fraction")
+	fd_GenesisState_previous_forecaster_score_ratio = md_GenesisState.Fields().ByName("previous_forecaster_score_ratio")
+	fd_GenesisState_total_stake = md_GenesisState.Fields().ByName("total_stake")
+	fd_GenesisState_topic_stake = md_GenesisState.Fields().ByName("topic_stake")
+	fd_GenesisState_stake_reputer_authority = md_GenesisState.Fields().ByName("stake_reputer_authority")
+	fd_GenesisState_stake_sum_from_delegator = md_GenesisState.Fields().ByName("stake_sum_from_delegator")
+	fd_GenesisState_delegated_stakes = md_GenesisState.Fields().ByName("delegated_stakes")
+	fd_GenesisState_stake_from_delegators_upon_reputer = md_GenesisState.Fields().ByName("stake_from_delegators_upon_reputer")
+	fd_GenesisState_delegate_reward_per_share = md_GenesisState.Fields().ByName("delegate_reward_per_share")
+	fd_GenesisState_stake_removals_by_block = md_GenesisState.Fields().ByName("stake_removals_by_block")
+	fd_GenesisState_stake_removals_by_actor = md_GenesisState.Fields().ByName("stake_removals_by_actor")
+	fd_GenesisState_delegate_stake_removals_by_block = md_GenesisState.Fields().ByName("delegate_stake_removals_by_block")
+	fd_GenesisState_delegate_stake_removals_by_actor = md_GenesisState.Fields().ByName("delegate_stake_removals_by_actor")
+	fd_GenesisState_inferences = md_GenesisState.Fields().ByName("inferences")
+	fd_GenesisState_forecasts = md_GenesisState.Fields().ByName("forecasts")
+	fd_GenesisState_workers = md_GenesisState.Fields().ByName("workers")
+	fd_GenesisState_reputers = md_GenesisState.Fields().ByName("reputers")
+	fd_GenesisState_topic_fee_revenue = md_GenesisState.Fields().ByName("topic_fee_revenue")
+	fd_GenesisState_previous_topic_weight = md_GenesisState.Fields().ByName("previous_topic_weight")
+	fd_GenesisState_all_inferences = md_GenesisState.Fields().ByName("all_inferences")
+	fd_GenesisState_all_forecasts = md_GenesisState.Fields().ByName("all_forecasts")
+	fd_GenesisState_all_loss_bundles = md_GenesisState.Fields().ByName("all_loss_bundles")
+	fd_GenesisState_network_loss_bundles = md_GenesisState.Fields().ByName("network_loss_bundles")
+	fd_GenesisState_previous_percentage_reward_to_staked_reputers = md_GenesisState.Fields().ByName("previous_percentage_reward_to_staked_reputers")
+	fd_GenesisState_unfulfilled_worker_nonces = md_GenesisState.Fields().ByName("unfulfilled_worker_nonces")
+	fd_GenesisState_unfulfilled_reputer_nonces = md_GenesisState.Fields().ByName("unfulfilled_reputer_nonces")
+	fd_GenesisState_latest_inferer_network_regrets = md_GenesisState.Fields().ByName("latest_inferer_network_regrets")
+	fd_GenesisState_latest_forecaster_network_regrets = md_GenesisState.Fields().ByName("latest_forecaster_network_regrets")
+	fd_GenesisState_latest_one_in_forecaster_network_regrets = md_GenesisState.Fields().ByName("latest_one_in_forecaster_network_regrets")
+	fd_GenesisState_latest_naive_inferer_network_regrets = md_GenesisState.Fields().ByName("latest_naive_inferer_network_regrets")
+	fd_GenesisState_latest_one_out_inferer_inferer_network_regrets = md_GenesisState.Fields().ByName("latest_one_out_inferer_inferer_network_regrets")
+	fd_GenesisState_latest_one_out_inferer_forecaster_network_regrets = md_GenesisState.Fields().ByName("latest_one_out_inferer_forecaster_network_regrets")
+	fd_GenesisState_latest_one_out_forecaster_inferer_network_regrets = md_GenesisState.Fields().ByName("latest_one_out_forecaster_inferer_network_regrets")
+	fd_GenesisState_latest_one_out_forecaster_forecaster_network_regrets = md_GenesisState.Fields().ByName("latest_one_out_forecaster_forecaster_network_regrets")
+	fd_GenesisState_topic_last_worker_commit = md_GenesisState.Fields().ByName("topic_last_worker_commit")
+	fd_GenesisState_topic_last_reputer_commit = md_GenesisState.Fields().ByName("topic_last_reputer_commit")
+	fd_GenesisState_open_worker_windows = md_GenesisState.Fields().ByName("open_worker_windows")
+	fd_GenesisState_last_drip_block = md_GenesisState.Fields().ByName("last_drip_block")
+	fd_GenesisState_topic_to_next_possible_churning_block = md_GenesisState.Fields().ByName("topic_to_next_possible_churning_block")
+	fd_GenesisState_block_to_active_topics = md_GenesisState.Fields().ByName("block_to_active_topics")
+	fd_GenesisState_block_to_lowest_active_topic_weight = md_GenesisState.Fields().ByName("block_to_lowest_active_topic_weight")
+	fd_GenesisState_inferer_score_emas = md_GenesisState.Fields().ByName("inferer_score_emas")
+	fd_GenesisState_forecaster_score_emas = md_GenesisState.Fields().ByName("forecaster_score_emas")
+	fd_GenesisState_reputer_score_emas = md_GenesisState.Fields().ByName("reputer_score_emas")
+	fd_GenesisState_previous_topic_quantile_inferer_score_ema = md_GenesisState.Fields().ByName("previous_topic_quantile_inferer_score_ema")
+	fd_GenesisState_previous_topic_quantile_forecaster_score_ema = md_GenesisState.Fields().ByName("previous_topic_quantile_forecaster_score_ema")
+	fd_GenesisState_previous_topic_quantile_reputer_score_ema = md_GenesisState.Fields().ByName("previous_topic_quantile_reputer_score_ema")
+	fd_GenesisState_count_inferer_inclusions_in_topic_active_set = md_GenesisState.Fields().ByName("count_inferer_inclusions_in_topic_active_set")
+	fd_GenesisState_count_forecaster_inclusions_in_topic_active_set = md_GenesisState.Fields().ByName("count_forecaster_inclusions_in_topic_active_set")
+	fd_GenesisState_active_inferers = md_GenesisState.Fields().ByName("active_inferers")
+	fd_GenesisState_active_forecasters = md_GenesisState.Fields().ByName("active_forecasters")
+	fd_GenesisState_lowest_inferer_score_ema = md_GenesisState.Fields().ByName("lowest_inferer_score_ema")
+	fd_GenesisState_lowest_forecaster_score_ema = md_GenesisState.Fields().ByName("lowest_forecaster_score_ema")
+	fd_GenesisState_active_reputers = md_GenesisState.Fields().ByName("active_reputers")
+	fd_GenesisState_lowest_reputer_score_ema = md_GenesisState.Fields().ByName("lowest_reputer_score_ema")
+	fd_GenesisState_loss_bundles = md_GenesisState.Fields().ByName("loss_bundles")
+	fd_GenesisState_total_sum_previous_topic_weights = md_GenesisState.Fields().ByName("total_sum_previous_topic_weights")
+	fd_GenesisState_reward_current_block_emission = md_GenesisState.Fields().ByName("reward_current_block_emission")
+	fd_GenesisState_whitelist_admins = md_GenesisState.Fields().ByName("whitelist_admins")
+	fd_GenesisState_global_whitelist = md_GenesisState.Fields().ByName("global_whitelist")
+	fd_GenesisState_topic_creator_whitelist = md_GenesisState.Fields().ByName("topic_creator_whitelist")
+	fd_GenesisState_topic_worker_whitelist = md_GenesisState.Fields().ByName("topic_worker_whitelist")
+	fd_GenesisState_topic_reputer_whitelist = md_GenesisState.Fields().ByName("topic_reputer_whitelist")
+	fd_GenesisState_topic_worker_whitelist_enabled = md_GenesisState.Fields().ByName("topic_worker_whitelist_enabled")
+	fd_GenesisState_topic_reputer_whitelist_enabled = md_GenesisState.Fields().ByName("topic_reputer_whitelist_enabled")
+	fd_GenesisState_last_median_inferences = md_GenesisState.Fields().ByName("last_median_inferences")
+	fd_GenesisState_mad_inferences = md_GenesisState.Fields().ByName("mad_inferences")
+	fd_GenesisState_initial_inferer_ema_score = md_GenesisState.Fields().ByName("initial_inferer_ema_score")
+	fd_GenesisState_initial_forecaster_ema_score = md_GenesisState.Fields().ByName("initial_forecaster_ema_score")
+	fd_GenesisState_initial_reputer_ema_score = md_GenesisState.Fields().ByName("initial_reputer_ema_score")
+	fd_GenesisState_global_worker_whitelist = md_GenesisState.Fields().ByName("global_worker_whitelist")
+	fd_GenesisState_global_reputer_whitelist = md_GenesisState.Fields().ByName("global_reputer_whitelist")
+	fd_GenesisState_global_admin_whitelist = md_GenesisState.Fields().ByName("global_admin_whitelist")
+	fd_GenesisState_pending_topic_updates = md_GenesisState.Fields().ByName("pending_topic_updates")
+	fd_GenesisState_archived_topics = md_GenesisState.Fields().ByName("archived_topics")
+	fd_GenesisState_archived_topics_to_prune = md_GenesisState.Fields().ByName("archived_topics_to_prune")
+	fd_GenesisState_topic_owners = md_GenesisState.Fields().ByName("topic_owners")
+	fd_GenesisState_pending_topic_owners = md_GenesisState.Fields().ByName("pending_topic_owners")
+	fd_GenesisState_topic_tags = md_GenesisState.Fields().ByName("topic_tags")
+	fd_GenesisState_reputer_ground_truths = md_GenesisState.Fields().ByName("reputer_ground_truths")
+	fd_GenesisState_consensus_ground_truths = md_GenesisState.Fields().ByName("consensus_ground_truths")
+	fd_GenesisState_worker_payload_commits = md_GenesisState.Fields().ByName("worker_payload_commits")
+	fd_GenesisState_actor_operators = md_GenesisState.Fields().ByName("actor_operators")
+	fd_GenesisState_worker_bonds = md_GenesisState.Fields().ByName("worker_bonds")
+	fd_GenesisState_worker_bond_removals = md_GenesisState.Fields().ByName("worker_bond_removals")
+	fd_GenesisState_reputer_consensus_deviations = md_GenesisState.Fields().ByName("reputer_consensus_deviations")
+	fd_GenesisState_redelegations = md_GenesisState.Fields().ByName("redelegations")
+	fd_GenesisState_auto_compound_delegators = md_GenesisState.Fields().ByName("auto_compound_delegators")
+	fd_GenesisState_reputer_commissions = md_GenesisState.Fields().ByName("reputer_commissions")
+	fd_GenesisState_stake_locks = md_GenesisState.Fields().ByName("stake_locks")
+	fd_GenesisState_reward_history = md_GenesisState.Fields().ByName("reward_history")
+	fd_GenesisState_reward_escrows = md_GenesisState.Fields().ByName("reward_escrows")
+	fd_GenesisState_queued_reward_payouts = md_GenesisState.Fields().ByName("queued_reward_payouts")
+	fd_GenesisState_next_reward_payout_id = md_GenesisState.Fields().ByName("next_reward_payout_id")
+	fd_GenesisState_archived_topic_delegate_removal_cursors = md_GenesisState.Fields().ByName("archived_topic_delegate_removal_cursors")
+	fd_GenesisState_dead_lettered_reward_payouts = md_GenesisState.Fields().ByName("dead_lettered_reward_payouts")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v7_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		panic(fmt.Errorf("message emissions.v7.GenesisState does not contain field %s", fd.FullName()))
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "emissions.v7.GenesisState.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "emissions.v7.GenesisState.core_team_addresses":
-		if len(x.CoreTeamAddresses) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
 		}
-		listValue := &_GenesisState_2_list{list: &x.CoreTeamAddresses}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.next_topic_id":
-		value := x.NextTopicId
-		return protoreflect.ValueOfUint64(value)
-	case "emissions.v7.GenesisState.topics":
-		if len(x.Topics) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+	}
+	if len(x.CoreTeamAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.CoreTeamAddresses})
+		if !f(fd_GenesisState_core_team_addresses, value) {
+			return
 		}
-		listValue := &_GenesisState_4_list{list: &x.Topics}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.active_topics":
-		if len(x.ActiveTopics) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+	}
+	if x.NextTopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextTopicId)
+		if !f(fd_GenesisState_next_topic_id, value) {
+			return
 		}
-		listValue := &_GenesisState_5_list{list: &x.ActiveTopics}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.rewardable_topics":
-		if len(x.RewardableTopics) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+	}
+	if len(x.Topics) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.Topics})
+		if !f(fd_GenesisState_topics, value) {
+			return
 		}
-		listValue := &_GenesisState_6_list{list: &x.RewardableTopics}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.topic_workers":
-		if len(x.TopicWorkers) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+	}
+	if len(x.ActiveTopics) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.ActiveTopics})
+		if !f(fd_GenesisState_active_topics, value) {
+			return
 		}
-		listValue := &_GenesisState_7_list{list: &x.TopicWorkers}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.topic_reputers":
-		if len(x.TopicReputers) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+	}
+	if len(x.RewardableTopics) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.RewardableTopics})
+		if !f(fd_GenesisState_rewardable_topics, value) {
+			return
 		}
-		listValue := &_GenesisState_8_list{list: &x.TopicReputers}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.topic_reward_nonce":
-		if len(x.TopicRewardNonce) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+	}
+	if len(x.TopicWorkers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.TopicWorkers})
+		if !f(fd_GenesisState_topic_workers, value) {
+			return
 		}
-		listValue := &_GenesisState_9_list{list: &x.TopicRewardNonce}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.inferer_scores_by_block":
-		if len(x.InfererScoresByBlock) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+	}
+	if len(x.TopicReputers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.TopicReputers})
+		if !f(fd_GenesisState_topic_reputers, value) {
+			return
 		}
-		listValue := &_GenesisState_10_list{list: &x.InfererScoresByBlock}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.forecaster_scores_by_block":
-		if len(x.ForecasterScoresByBlock) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+	}
+	if len(x.TopicRewardNonce) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.TopicRewardNonce})
+		if !f(fd_GenesisState_topic_reward_nonce, value) {
+			return
 		}
-		listValue := &_GenesisState_11_list{list: &x.ForecasterScoresByBlock}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.reputer_scores_by_block":
-		if len(x.ReputerScoresByBlock) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+	}
+	if len(x.InfererScoresByBlock) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.InfererScoresByBlock})
+		if !f(fd_GenesisState_inferer_scores_by_block, value) {
+			return
 		}
-		listValue := &_GenesisState_12_list{list: &x.ReputerScoresByBlock}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.reputer_listening_coefficient":
-		if len(x.ReputerListeningCoefficient) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+	}
+	if len(x.ForecasterScoresByBlock) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.ForecasterScoresByBlock})
+		if !f(fd_GenesisState_forecaster_scores_by_block, value) {
+			return
 		}
-		listValue := &_GenesisState_16_list{list: &x.ReputerListeningCoefficient}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.previous_reputer_reward_fraction":
-		if len(x.PreviousReputerRewardFraction) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+	}
+	if len(x.ReputerScoresByBlock) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.ReputerScoresByBlock})
+		if !f(fd_GenesisState_reputer_scores_by_block, value) {
+			return
 		}
-		listValue := &_GenesisState_17_list{list: &x.PreviousReputerRewardFraction}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.previous_inference_reward_fraction":
-		if len(x.PreviousInferenceRewardFraction) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+	}
+	if len(x.ReputerListeningCoefficient) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.ReputerListeningCoefficient})
+		if !f(fd_GenesisState_reputer_listening_coefficient, value) {
+			return
 		}
-		listValue := &_GenesisState_18_list{list: &x.PreviousInferenceRewardFraction}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.previous_forecast_reward_fraction":
-		if len(x.PreviousForecastRewardFraction) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_19_list{})
+	}
+	if len(x.PreviousReputerRewardFraction) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.PreviousReputerRewardFraction})
+		if !f(fd_GenesisState_previous_reputer_reward_fraction, value) {
+			return
 		}
-		listValue := &_GenesisState_19_list{list: &x.PreviousForecastRewardFraction}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.previous_forecaster_score_ratio":
-		if len(x.PreviousForecasterScoreRatio) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_20_list{})
+	}
+	if len(x.PreviousInferenceRewardFraction) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.PreviousInferenceRewardFraction})
+		if !f(fd_GenesisState_previous_inference_reward_fraction, value) {
+			return
 		}
-		listValue := &_GenesisState_20_list{list: &x.PreviousForecasterScoreRatio}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.total_stake":
-		value := x.TotalStake
-		return protoreflect.ValueOfString(value)
-	case "emissions.v7.GenesisState.topic_stake":
-		if len(x.TopicStake) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_22_list{})
+	}
+	if len(x.PreviousForecastRewardFraction) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_19_list{list: &x.PreviousForecastRewardFraction})
+		if !f(fd_GenesisState_previous_forecast_reward_fraction, value) {
+			return
 		}
-		listValue := &_GenesisState_22_list{list: &x.TopicStake}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.stake_reputer_authority":
-		if len(x.StakeReputerAuthority) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_23_list{})
+	}
+	if len(x.PreviousForecasterScoreRatio) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_20_list{list: &x.PreviousForecasterScoreRatio})
+		if !f(fd_GenesisState_previous_forecaster_score_ratio, value) {
+			return
 		}
-		listValue := &_GenesisState_23_list{list: &x.StakeReputerAuthority}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.stake_sum_from_delegator":
-		if len(x.StakeSumFromDelegator) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_24_list{})
+	}
+	if x.TotalStake != "" {
+		value := protoreflect.ValueOfString(x.TotalStake)
+		if !f(fd_GenesisState_total_stake, value) {
+			return
 		}
-		listValue := &_GenesisState_24_list{list: &x.StakeSumFromDelegator}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.delegated_stakes":
-		if len(x.DelegatedStakes) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_25_list{})
+	}
+	if len(x.TopicStake) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_22_list{list: &x.TopicStake})
+		if !f(fd_GenesisState_topic_stake, value) {
+			return
 		}
-		listValue := &_GenesisState_25_list{list: &x.DelegatedStakes}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.stake_from_delegators_upon_reputer":
-		if len(x.StakeFromDelegatorsUponReputer) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_26_list{})
+	}
+	if len(x.StakeReputerAuthority) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_23_list{list: &x.StakeReputerAuthority})
+		if !f(fd_GenesisState_stake_reputer_authority, value) {
+			return
 		}
-		listValue := &_GenesisState_26_list{list: &x.StakeFromDelegatorsUponReputer}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.delegate_reward_per_share":
-		if len(x.DelegateRewardPerShare) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_27_list{})
-		}
-		listValue := &_GenesisState_27_list{list: &x.DelegateRewardPerShare}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.stake_removals_by_block":
-		if len(x.StakeRemovalsByBlock) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_28_list{})
+	}
+	if len(x.StakeSumFromDelegator) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_24_list{list: &x.StakeSumFromDelegator})
+		if !f(fd_GenesisState_stake_sum_from_delegator, value) {
+			return
 		}
-		listValue := &_GenesisState_28_list{list: &x.StakeRemovalsByBlock}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.stake_removals_by_actor":
-		if len(x.StakeRemovalsByActor) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_29_list{})
+	}
+	if len(x.DelegatedStakes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_25_list{list: &x.DelegatedStakes})
+		if !f(fd_GenesisState_delegated_stakes, value) {
+			return
 		}
-		listValue := &_GenesisState_29_list{list: &x.StakeRemovalsByActor}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.delegate_stake_removals_by_block":
-		if len(x.DelegateStakeRemovalsByBlock) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_30_list{})
+	}
+	if len(x.StakeFromDelegatorsUponReputer) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_26_list{list: &x.StakeFromDelegatorsUponReputer})
+		if !f(fd_GenesisState_stake_from_delegators_upon_reputer, value) {
+			return
 		}
-		listValue := &_GenesisState_30_list{list: &x.DelegateStakeRemovalsByBlock}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.delegate_stake_removals_by_actor":
-		if len(x.DelegateStakeRemovalsByActor) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_31_list{})
+	}
+	if len(x.DelegateRewardPerShare) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_27_list{list: &x.DelegateRewardPerShare})
+		if !f(fd_GenesisState_delegate_reward_per_share, value) {
+			return
 		}
-		listValue := &_GenesisState_31_list{list: &x.DelegateStakeRemovalsByActor}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.inferences":
-		if len(x.Inferences) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_32_list{})
+	}
+	if len(x.StakeRemovalsByBlock) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_28_list{list: &x.StakeRemovalsByBlock})
+		if !f(fd_GenesisState_stake_removals_by_block, value) {
+			return
 		}
-		listValue := &_GenesisState_32_list{list: &x.Inferences}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.forecasts":
-		if len(x.Forecasts) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_33_list{})
+	}
+	if len(x.StakeRemovalsByActor) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_29_list{list: &x.StakeRemovalsByActor})
+		if !f(fd_GenesisState_stake_removals_by_actor, value) {
+			return
 		}
-		listValue := &_GenesisState_33_list{list: &x.Forecasts}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.workers":
-		if len(x.Workers) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_34_list{})
+	}
+	if len(x.DelegateStakeRemovalsByBlock) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_30_list{list: &x.DelegateStakeRemovalsByBlock})
+		if !f(fd_GenesisState_delegate_stake_removals_by_block, value) {
+			return
 		}
-		listValue := &_GenesisState_34_list{list: &x.Workers}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.reputers":
-		if len(x.Reputers) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_35_list{})
+	}
+	if len(x.DelegateStakeRemovalsByActor) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_31_list{list: &x.DelegateStakeRemovalsByActor})
+		if !f(fd_GenesisState_delegate_stake_removals_by_actor, value) {
+			return
 		}
-		listValue := &_GenesisState_35_list{list: &x.Reputers}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.topic_fee_revenue":
-		if len(x.TopicFeeRevenue) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_36_list{})
+	}
+	if len(x.Inferences) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_32_list{list: &x.Inferences})
+		if !f(fd_GenesisState_inferences, value) {
+			return
 		}
-		listValue := &_GenesisState_36_list{list: &x.TopicFeeRevenue}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.previous_topic_weight":
-		if len(x.PreviousTopicWeight) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_37_list{})
+	}
+	if len(x.Forecasts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_33_list{list: &x.Forecasts})
+		if !f(fd_GenesisState_forecasts, value) {
+			return
 		}
-		listValue := &_GenesisState_37_list{list: &x.PreviousTopicWeight}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.all_inferences":
-		if len(x.AllInferences) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_38_list{})
+	}
+	if len(x.Workers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_34_list{list: &x.Workers})
+		if !f(fd_GenesisState_workers, value) {
+			return
 		}
-		listValue := &_GenesisState_38_list{list: &x.AllInferences}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.all_forecasts":
-		if len(x.AllForecasts) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_39_list{})
+	}
+	if len(x.Reputers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_35_list{list: &x.Reputers})
+		if !f(fd_GenesisState_reputers, value) {
+			return
 		}
-		listValue := &_GenesisState_39_list{list: &x.AllForecasts}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.all_loss_bundles":
-		if len(x.AllLossBundles) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_40_list{})
+	}
+	if len(x.TopicFeeRevenue) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_36_list{list: &x.TopicFeeRevenue})
+		if !f(fd_GenesisState_topic_fee_revenue, value) {
+			return
 		}
-		listValue := &_GenesisState_40_list{list: &x.AllLossBundles}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.network_loss_bundles":
-		if len(x.NetworkLossBundles) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_41_list{})
+	}
+	if len(x.PreviousTopicWeight) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_37_list{list: &x.PreviousTopicWeight})
+		if !f(fd_GenesisState_previous_topic_weight, value) {
+			return
 		}
-		listValue := &_GenesisState_41_list{list: &x.NetworkLossBundles}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.previous_percentage_reward_to_staked_reputers":
-		value := x.PreviousPercentageRewardToStakedReputers
-		return protoreflect.ValueOfString(value)
-	case "emissions.v7.GenesisState.unfulfilled_worker_nonces":
-		if len(x.UnfulfilledWorkerNonces) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_43_list{})
+	}
+	if len(x.AllInferences) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_38_list{list: &x.AllInferences})
+		if !f(fd_GenesisState_all_inferences, value) {
+			return
 		}
-		listValue := &_GenesisState_43_list{list: &x.UnfulfilledWorkerNonces}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.unfulfilled_reputer_nonces":
-		if len(x.UnfulfilledReputerNonces) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_44_list{})
+	}
+	if len(x.AllForecasts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_39_list{list: &x.AllForecasts})
+		if !f(fd_GenesisState_all_forecasts, value) {
+			return
 		}
-		listValue := &_GenesisState_44_list{list: &x.UnfulfilledReputerNonces}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.latest_inferer_network_regrets":
-		if len(x.LatestInfererNetworkRegrets) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_45_list{})
+	}
+	if len(x.AllLossBundles) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_40_list{list: &x.AllLossBundles})
+		if !f(fd_GenesisState_all_loss_bundles, value) {
+			return
 		}
-		listValue := &_GenesisState_45_list{list: &x.LatestInfererNetworkRegrets}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.latest_forecaster_network_regrets":
-		if len(x.LatestForecasterNetworkRegrets) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_46_list{})
+	}
+	if len(x.NetworkLossBundles) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_41_list{list: &x.NetworkLossBundles})
+		if !f(fd_GenesisState_network_loss_bundles, value) {
+			return
 		}
-		listValue := &_GenesisState_46_list{list: &x.LatestForecasterNetworkRegrets}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.latest_one_in_forecaster_network_regrets":
-		if len(x.LatestOneInForecasterNetworkRegrets) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_47_list{})
+	}
+	if x.PreviousPercentageRewardToStakedReputers != "" {
+		value := protoreflect.ValueOfString(x.PreviousPercentageRewardToStakedReputers)
+		if !f(fd_GenesisState_previous_percentage_reward_to_staked_reputers, value) {
+			return
 		}
-		listValue := &_GenesisState_47_list{list: &x.LatestOneInForecasterNetworkRegrets}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.latest_naive_inferer_network_regrets":
-		if len(x.LatestNaiveInfererNetworkRegrets) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_48_list{})
+	}
+	if len(x.UnfulfilledWorkerNonces) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_43_list{list: &x.UnfulfilledWorkerNonces})
+		if !f(fd_GenesisState_unfulfilled_worker_nonces, value) {
+			return
 		}
-		listValue := &_GenesisState_48_list{list: &x.LatestNaiveInfererNetworkRegrets}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.latest_one_out_inferer_inferer_network_regrets":
-		if len(x.LatestOneOutInfererInfererNetworkRegrets) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_49_list{})
+	}
+	if len(x.UnfulfilledReputerNonces) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_44_list{list: &x.UnfulfilledReputerNonces})
+		if !f(fd_GenesisState_unfulfilled_reputer_nonces, value) {
+			return
 		}
-		listValue := &_GenesisState_49_list{list: &x.LatestOneOutInfererInfererNetworkRegrets}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.latest_one_out_inferer_forecaster_network_regrets":
-		if len(x.LatestOneOutInfererForecasterNetworkRegrets) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_50_list{})
+	}
+	if len(x.LatestInfererNetworkRegrets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_45_list{list: &x.LatestInfererNetworkRegrets})
+		if !f(fd_GenesisState_latest_inferer_network_regrets, value) {
+			return
 		}
-		listValue := &_GenesisState_50_list{list: &x.LatestOneOutInfererForecasterNetworkRegrets}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.latest_one_out_forecaster_inferer_network_regrets":
-		if len(x.LatestOneOutForecasterInfererNetworkRegrets) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_51_list{})
+	}
+	if len(x.LatestForecasterNetworkRegrets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_46_list{list: &x.LatestForecasterNetworkRegrets})
+		if !f(fd_GenesisState_latest_forecaster_network_regrets, value) {
+			return
 		}
-		listValue := &_GenesisState_51_list{list: &x.LatestOneOutForecasterInfererNetworkRegrets}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.latest_one_out_forecaster_forecaster_network_regrets":
-		if len(x.LatestOneOutForecasterForecasterNetworkRegrets) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_52_list{})
+	}
+	if len(x.LatestOneInForecasterNetworkRegrets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_47_list{list: &x.LatestOneInForecasterNetworkRegrets})
+		if !f(fd_GenesisState_latest_one_in_forecaster_network_regrets, value) {
+			return
 		}
-		listValue := &_GenesisState_52_list{list: &x.LatestOneOutForecasterForecasterNetworkRegrets}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.topic_last_worker_commit":
-		if len(x.TopicLastWorkerCommit) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_53_list{})
+	}
+	if len(x.LatestNaiveInfererNetworkRegrets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_48_list{list: &x.LatestNaiveInfererNetworkRegrets})
+		if !f(fd_GenesisState_latest_naive_inferer_network_regrets, value) {
+			return
 		}
-		listValue := &_GenesisState_53_list{list: &x.TopicLastWorkerCommit}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.topic_last_reputer_commit":
-		if len(x.TopicLastReputerCommit) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_54_list{})
+	}
+	if len(x.LatestOneOutInfererInfererNetworkRegrets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_49_list{list: &x.LatestOneOutInfererInfererNetworkRegrets})
+		if !f(fd_GenesisState_latest_one_out_inferer_inferer_network_regrets, value) {
+			return
 		}
-		listValue := &_GenesisState_54_list{list: &x.TopicLastReputerCommit}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.open_worker_windows":
-		if len(x.OpenWorkerWindows) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_55_list{})
+	}
+	if len(x.LatestOneOutInfererForecasterNetworkRegrets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_50_list{list: &x.LatestOneOutInfererForecasterNetworkRegrets})
+		if !f(fd_GenesisState_latest_one_out_inferer_forecaster_network_regrets, value) {
+			return
 		}
-		listValue := &_GenesisState_55_list{list: &x.OpenWorkerWindows}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.last_drip_block":
-		if len(x.LastDripBlock) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_56_list{})
+	}
+	if len(x.LatestOneOutForecasterInfererNetworkRegrets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_51_list{list: &x.LatestOneOutForecasterInfererNetworkRegrets})
+		if !f(fd_GenesisState_latest_one_out_forecaster_inferer_network_regrets, value) {
+			return
 		}
-		listValue := &_GenesisState_56_list{list: &x.LastDripBlock}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.topic_to_next_possible_churning_block":
-		if len(x.TopicToNextPossibleChurningBlock) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_57_list{})
+	}
+	if len(x.LatestOneOutForecasterForecasterNetworkRegrets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_52_list{list: &x.LatestOneOutForecasterForecasterNetworkRegrets})
+		if !f(fd_GenesisState_latest_one_out_forecaster_forecaster_network_regrets, value) {
+			return
 		}
-		listValue := &_GenesisState_57_list{list: &x.TopicToNextPossibleChurningBlock}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.block_to_active_topics":
-		if len(x.BlockToActiveTopics) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_58_list{})
+	}
+	if len(x.TopicLastWorkerCommit) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_53_list{list: &x.TopicLastWorkerCommit})
+		if !f(fd_GenesisState_topic_last_worker_commit, value) {
+			return
 		}
-		listValue := &_GenesisState_58_list{list: &x.BlockToActiveTopics}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.block_to_lowest_active_topic_weight":
-		if len(x.BlockToLowestActiveTopicWeight) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_59_list{})
+	}
+	if len(x.TopicLastReputerCommit) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_54_list{list: &x.TopicLastReputerCommit})
+		if !f(fd_GenesisState_topic_last_reputer_commit, value) {
+			return
 		}
-		listValue := &_GenesisState_59_list{list: &x.BlockToLowestActiveTopicWeight}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.inferer_score_emas":
-		if len(x.InfererScoreEmas) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_60_list{})
+	}
+	if len(x.OpenWorkerWindows) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_55_list{list: &x.OpenWorkerWindows})
+		if !f(fd_GenesisState_open_worker_windows, value) {
+			return
 		}
-		listValue := &_GenesisState_60_list{list: &x.InfererScoreEmas}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.forecaster_score_emas":
-		if len(x.ForecasterScoreEmas) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_61_list{})
+	}
+	if len(x.LastDripBlock) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_56_list{list: &x.LastDripBlock})
+		if !f(fd_GenesisState_last_drip_block, value) {
+			return
 		}
-		listValue := &_GenesisState_61_list{list: &x.ForecasterScoreEmas}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.reputer_score_emas":
-		if len(x.ReputerScoreEmas) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_62_list{})
+	}
+	if len(x.TopicToNextPossibleChurningBlock) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_57_list{list: &x.TopicToNextPossibleChurningBlock})
+		if !f(fd_GenesisState_topic_to_next_possible_churning_block, value) {
+			return
 		}
-		listValue := &_GenesisState_62_list{list: &x.ReputerScoreEmas}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.previous_topic_quantile_inferer_score_ema":
-		if len(x.PreviousTopicQuantileInfererScoreEma) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_63_list{})
+	}
+	if len(x.BlockToActiveTopics) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_58_list{list: &x.BlockToActiveTopics})
+		if !f(fd_GenesisState_block_to_active_topics, value) {
+			return
 		}
-		listValue := &_GenesisState_63_list{list: &x.PreviousTopicQuantileInfererScoreEma}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.previous_topic_quantile_forecaster_score_ema":
-		if len(x.PreviousTopicQuantileForecasterScoreEma) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_64_list{})
+	}
+	if len(x.BlockToLowestActiveTopicWeight) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_59_list{list: &x.BlockToLowestActiveTopicWeight})
+		if !f(fd_GenesisState_block_to_lowest_active_topic_weight, value) {
+			return
 		}
-		listValue := &_GenesisState_64_list{list: &x.PreviousTopicQuantileForecasterScoreEma}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.previous_topic_quantile_reputer_score_ema":
-		if len(x.PreviousTopicQuantileReputerScoreEma) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_65_list{})
+	}
+	if len(x.InfererScoreEmas) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_60_list{list: &x.InfererScoreEmas})
+		if !f(fd_GenesisState_inferer_score_emas, value) {
+			return
 		}
-		listValue := &_GenesisState_65_list{list: &x.PreviousTopicQuantileReputerScoreEma}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.count_inferer_inclusions_in_topic_active_set":
-		if len(x.CountInfererInclusionsInTopicActiveSet) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_66_list{})
+	}
+	if len(x.ForecasterScoreEmas) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_61_list{list: &x.ForecasterScoreEmas})
+		if !f(fd_GenesisState_forecaster_score_emas, value) {
+			return
 		}
-		listValue := &_GenesisState_66_list{list: &x.CountInfererInclusionsInTopicActiveSet}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.count_forecaster_inclusions_in_topic_active_set":
-		if len(x.CountForecasterInclusionsInTopicActiveSet) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_67_list{})
+	}
+	if len(x.ReputerScoreEmas) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_62_list{list: &x.ReputerScoreEmas})
+		if !f(fd_GenesisState_reputer_score_emas, value) {
+			return
 		}
-		listValue := &_GenesisState_67_list{list: &x.CountForecasterInclusionsInTopicActiveSet}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.active_inferers":
-		if len(x.ActiveInferers) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_68_list{})
+	}
+	if len(x.PreviousTopicQuantileInfererScoreEma) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_63_list{list: &x.PreviousTopicQuantileInfererScoreEma})
+		if !f(fd_GenesisState_previous_topic_quantile_inferer_score_ema, value) {
+			return
 		}
-		listValue := &_GenesisState_68_list{list: &x.ActiveInferers}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.active_forecasters":
-		if len(x.ActiveForecasters) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_69_list{})
+	}
+	if len(x.PreviousTopicQuantileForecasterScoreEma) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_64_list{list: &x.PreviousTopicQuantileForecasterScoreEma})
+		if !f(fd_GenesisState_previous_topic_quantile_forecaster_score_ema, value) {
+			return
 		}
-		listValue := &_GenesisState_69_list{list: &x.ActiveForecasters}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.lowest_inferer_score_ema":
-		if len(x.LowestInfererScoreEma) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_70_list{})
+	}
+	if len(x.PreviousTopicQuantileReputerScoreEma) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_65_list{list: &x.PreviousTopicQuantileReputerScoreEma})
+		if !f(fd_GenesisState_previous_topic_quantile_reputer_score_ema, value) {
+			return
 		}
-		listValue := &_GenesisState_70_list{list: &x.LowestInfererScoreEma}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.lowest_forecaster_score_ema":
-		if len(x.LowestForecasterScoreEma) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_71_list{})
+	}
+	if len(x.CountInfererInclusionsInTopicActiveSet) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_66_list{list: &x.CountInfererInclusionsInTopicActiveSet})
+		if !f(fd_GenesisState_count_inferer_inclusions_in_topic_active_set, value) {
+			return
 		}
-		listValue := &_GenesisState_71_list{list: &x.LowestForecasterScoreEma}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.active_reputers":
-		if len(x.ActiveReputers) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_72_list{})
+	}
+	if len(x.CountForecasterInclusionsInTopicActiveSet) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_67_list{list: &x.CountForecasterInclusionsInTopicActiveSet})
+		if !f(fd_GenesisState_count_forecaster_inclusions_in_topic_active_set, value) {
+			return
 		}
-		listValue := &_GenesisState_72_list{list: &x.ActiveReputers}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.lowest_reputer_score_ema":
-		if len(x.LowestReputerScoreEma) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_73_list{})
+	}
+	if len(x.ActiveInferers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_68_list{list: &x.ActiveInferers})
+		if !f(fd_GenesisState_active_inferers, value) {
+			return
 		}
-		listValue := &_GenesisState_73_list{list: &x.LowestReputerScoreEma}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.loss_bundles":
-		if len(x.LossBundles) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_74_list{})
+	}
+	if len(x.ActiveForecasters) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_69_list{list: &x.ActiveForecasters})
+		if !f(fd_GenesisState_active_forecasters, value) {
+			return
 		}
-		listValue := &_GenesisState_74_list{list: &x.LossBundles}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.total_sum_previous_topic_weights":
-		value := x.TotalSumPreviousTopicWeights
-		return protoreflect.ValueOfString(value)
-	case "emissions.v7.GenesisState.reward_current_block_emission":
-		value := x.RewardCurrentBlockEmission
-		return protoreflect.ValueOfString(value)
-	case "emissions.v7.GenesisState.whitelist_admins":
-		if len(x.WhitelistAdmins) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_77_list{})
+	}
+	if len(x.LowestInfererScoreEma) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_70_list{list: &x.LowestInfererScoreEma})
+		if !f(fd_GenesisState_lowest_inferer_score_ema, value) {
+			return
 		}
-		listValue := &_GenesisState_77_list{list: &x.WhitelistAdmins}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.global_whitelist":
-		if len(x.GlobalWhitelist) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_78_list{})
+	}
+	if len(x.LowestForecasterScoreEma) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_71_list{list: &x.LowestForecasterScoreEma})
+		if !f(fd_GenesisState_lowest_forecaster_score_ema, value) {
+			return
 		}
-		listValue := &_GenesisState_78_list{list: &x.GlobalWhitelist}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.topic_creator_whitelist":
-		if len(x.TopicCreatorWhitelist) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_79_list{})
+	}
+	if len(x.ActiveReputers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_72_list{list: &x.ActiveReputers})
+		if !f(fd_GenesisState_active_reputers, value) {
+			return
 		}
-		listValue := &_GenesisState_79_list{list: &x.TopicCreatorWhitelist}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.topic_worker_whitelist":
-		if len(x.TopicWorkerWhitelist) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_80_list{})
+	}
+	if len(x.LowestReputerScoreEma) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_73_list{list: &x.LowestReputerScoreEma})
+		if !f(fd_GenesisState_lowest_reputer_score_ema, value) {
+			return
 		}
-		listValue := &_GenesisState_80_list{list: &x.TopicWorkerWhitelist}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.topic_reputer_whitelist":
-		if len(x.TopicReputerWhitelist) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_81_list{})
+	}
+	if len(x.LossBundles) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_74_list{list: &x.LossBundles})
+		if !f(fd_GenesisState_loss_bundles, value) {
+			return
 		}
-		listValue := &_GenesisState_81_list{list: &x.TopicReputerWhitelist}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.topic_worker_whitelist_enabled":
-		if len(x.TopicWorkerWhitelistEnabled) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_82_list{})
+	}
+	if x.TotalSumPreviousTopicWeights != "" {
+		value := protoreflect.ValueOfString(x.TotalSumPreviousTopicWeights)
+		if !f(fd_GenesisState_total_sum_previous_topic_weights, value) {
+			return
 		}
-		listValue := &_GenesisState_82_list{list: &x.TopicWorkerWhitelistEnabled}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.topic_reputer_whitelist_enabled":
-		if len(x.TopicReputerWhitelistEnabled) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_83_list{})
+	}
+	if x.RewardCurrentBlockEmission != "" {
+		value := protoreflect.ValueOfString(x.RewardCurrentBlockEmission)
+		if !f(fd_GenesisState_reward_current_block_emission, value) {
+			return
 		}
-		listValue := &_GenesisState_83_list{list: &x.TopicReputerWhitelistEnabled}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.last_median_inferences":
-		if len(x.LastMedianInferences) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_84_list{})
+	}
+	if len(x.WhitelistAdmins) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_77_list{list: &x.WhitelistAdmins})
+		if !f(fd_GenesisState_whitelist_admins, value) {
+			return
 		}
-		listValue := &_GenesisState_84_list{list: &x.LastMedianInferences}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.mad_inferences":
-		if len(x.MadInferences) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_85_list{})
+	}
+	if len(x.GlobalWhitelist) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_78_list{list: &x.GlobalWhitelist})
+		if !f(fd_GenesisState_global_whitelist, value) {
+			return
 		}
-		listValue := &_GenesisState_85_list{list: &x.MadInferences}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.initial_inferer_ema_score":
-		if len(x.InitialInfererEmaScore) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_86_list{})
+	}
+	if len(x.TopicCreatorWhitelist) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_79_list{list: &x.TopicCreatorWhitelist})
+		if !f(fd_GenesisState_topic_creator_whitelist, value) {
+			return
 		}
-		listValue := &_GenesisState_86_list{list: &x.InitialInfererEmaScore}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.initial_forecaster_ema_score":
-		if len(x.InitialForecasterEmaScore) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_87_list{})
+	}
+	if len(x.TopicWorkerWhitelist) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_80_list{list: &x.TopicWorkerWhitelist})
+		if !f(fd_GenesisState_topic_worker_whitelist, value) {
+			return
 		}
-		listValue := &_GenesisState_87_list{list: &x.InitialForecasterEmaScore}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.initial_reputer_ema_score":
-		if len(x.InitialReputerEmaScore) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_88_list{})
+	}
+	if len(x.TopicReputerWhitelist) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_81_list{list: &x.TopicReputerWhitelist})
+		if !f(fd_GenesisState_topic_reputer_whitelist, value) {
+			return
 		}
-		listValue := &_GenesisState_88_list{list: &x.InitialReputerEmaScore}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.global_worker_whitelist":
-		if len(x.GlobalWorkerWhitelist) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_89_list{})
+	}
+	if len(x.TopicWorkerWhitelistEnabled) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_82_list{list: &x.TopicWorkerWhitelistEnabled})
+		if !f(fd_GenesisState_topic_worker_whitelist_enabled, value) {
+			return
 		}
-		listValue := &_GenesisState_89_list{list: &x.GlobalWorkerWhitelist}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.global_reputer_whitelist":
-		if len(x.GlobalReputerWhitelist) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_90_list{})
+	}
+	if len(x.TopicReputerWhitelistEnabled) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_83_list{list: &x.TopicReputerWhitelistEnabled})
+		if !f(fd_GenesisState_topic_reputer_whitelist_enabled, value) {
+			return
 		}
-		listValue := &_GenesisState_90_list{list: &x.GlobalReputerWhitelist}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v7.GenesisState.global_admin_whitelist":
-		if len(x.GlobalAdminWhitelist) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_91_list{})
+	}
+	if len(x.LastMedianInferences) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_84_list{list: &x.LastMedianInferences})
+		if !f(fd_GenesisState_last_median_inferences, value) {
+			return
 		}
-		listValue := &_GenesisState_91_list{list: &x.GlobalAdminWhitelist}
-		return protoreflect.ValueOfList(listValue)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.GenesisState"))
+	}
+	if len(x.MadInferences) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_85_list{list: &x.MadInferences})
+		if !f(fd_GenesisState_mad_inferences, value) {
+			return
+		}
+	}
+	if len(x.InitialInfererEmaScore) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_86_list{list: &x.InitialInfererEmaScore})
+		if !f(fd_GenesisState_initial_inferer_ema_score, value) {
+			return
+		}
+	}
+	if len(x.InitialForecasterEmaScore) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_87_list{list: &x.InitialForecasterEmaScore})
+		if !f(fd_GenesisState_initial_forecaster_ema_score, value) {
+			return
+		}
+	}
+	if len(x.InitialReputerEmaScore) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_88_list{list: &x.InitialReputerEmaScore})
+		if !f(fd_GenesisState_initial_reputer_ema_score, value) {
+			return
+		}
+	}
+	if len(x.GlobalWorkerWhitelist) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_89_list{list: &x.GlobalWorkerWhitelist})
+		if !f(fd_GenesisState_global_worker_whitelist, value) {
+			return
+		}
+	}
+	if len(x.GlobalReputerWhitelist) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_90_list{list: &x.GlobalReputerWhitelist})
+		if !f(fd_GenesisState_global_reputer_whitelist, value) {
+			return
+		}
+	}
+	if len(x.GlobalAdminWhitelist) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_91_list{list: &x.GlobalAdminWhitelist})
+		if !f(fd_GenesisState_global_admin_whitelist, value) {
+			return
+		}
+	}
+	if len(x.PendingTopicUpdates) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_92_list{list: &x.PendingTopicUpdates})
+		if !f(fd_GenesisState_pending_topic_updates, value) {
+			return
+		}
+	}
+	if len(x.ArchivedTopics) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_93_list{list: &x.ArchivedTopics})
+		if !f(fd_GenesisState_archived_topics, value) {
+			return
+		}
+	}
+	if len(x.ArchivedTopicsToPrune) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_94_list{list: &x.ArchivedTopicsToPrune})
+		if !f(fd_GenesisState_archived_topics_to_prune, value) {
+			return
+		}
+	}
+	if len(x.TopicOwners) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_95_list{list: &x.TopicOwners})
+		if !f(fd_GenesisState_topic_owners, value) {
+			return
+		}
+	}
+	if len(x.PendingTopicOwners) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_96_list{list: &x.PendingTopicOwners})
+		if !f(fd_GenesisState_pending_topic_owners, value) {
+			return
+		}
+	}
+	if len(x.TopicTags) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_97_list{list: &x.TopicTags})
+		if !f(fd_GenesisState_topic_tags, value) {
+			return
+		}
+	}
+	if len(x.ReputerGroundTruths) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_98_list{list: &x.ReputerGroundTruths})
+		if !f(fd_GenesisState_reputer_ground_truths, value) {
+			return
+		}
+	}
+	if len(x.ConsensusGroundTruths) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_99_list{list: &x.ConsensusGroundTruths})
+		if !f(fd_GenesisState_consensus_ground_truths, value) {
+			return
+		}
+	}
+	if len(x.WorkerPayloadCommits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_100_list{list: &x.WorkerPayloadCommits})
+		if !f(fd_GenesisState_worker_payload_commits, value) {
+			return
+		}
+	}
+	if len(x.ActorOperators) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_101_list{list: &x.ActorOperators})
+		if !f(fd_GenesisState_actor_operators, value) {
+			return
+		}
+	}
+	if len(x.WorkerBonds) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_102_list{list: &x.WorkerBonds})
+		if !f(fd_GenesisState_worker_bonds, value) {
+			return
+		}
+	}
+	if len(x.WorkerBondRemovals) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_103_list{list: &x.WorkerBondRemovals})
+		if !f(fd_GenesisState_worker_bond_removals, value) {
+			return
+		}
+	}
+	if len(x.ReputerConsensusDeviations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_104_list{list: &x.ReputerConsensusDeviations})
+		if !f(fd_GenesisState_reputer_consensus_deviations, value) {
+			return
+		}
+	}
+	if len(x.Redelegations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_105_list{list: &x.Redelegations})
+		if !f(fd_GenesisState_redelegations, value) {
+			return
+		}
+	}
+	if len(x.AutoCompoundDelegators) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_106_list{list: &x.AutoCompoundDelegators})
+		if !f(fd_GenesisState_auto_compound_delegators, value) {
+			return
+		}
+	}
+	if len(x.ReputerCommissions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_107_list{list: &x.ReputerCommissions})
+		if !f(fd_GenesisState_reputer_commissions, value) {
+			return
+		}
+	}
+	if len(x.StakeLocks) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_108_list{list: &x.StakeLocks})
+		if !f(fd_GenesisState_stake_locks, value) {
+			return
+		}
+	}
+	if len(x.RewardHistory) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_109_list{list: &x.RewardHistory})
+		if !f(fd_GenesisState_reward_history, value) {
+			return
+		}
+	}
+	if len(x.RewardEscrows) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_110_list{list: &x.RewardEscrows})
+		if !f(fd_GenesisState_reward_escrows, value) {
+			return
+		}
+	}
+	if len(x.QueuedRewardPayouts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_111_list{list: &x.QueuedRewardPayouts})
+		if !f(fd_GenesisState_queued_reward_payouts, value) {
+			return
+		}
+	}
+	if x.NextRewardPayoutId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextRewardPayoutId)
+		if !f(fd_GenesisState_next_reward_payout_id, value) {
+			return
+		}
+	}
+	if len(x.ArchivedTopicDelegateRemovalCursors) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_113_list{list: &x.ArchivedTopicDelegateRemovalCursors})
+		if !f(fd_GenesisState_archived_topic_delegate_removal_cursors, value) {
+			return
+		}
+	}
+	if len(x.DeadLetteredRewardPayouts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_114_list{list: &x.DeadLetteredRewardPayouts})
+		if !f(fd_GenesisState_dead_lettered_reward_payouts, value) {
+			return
 		}
-		panic(fmt.Errorf("message emissions.v7.GenesisState does not contain field %s", descriptor.FullName()))
 	}
 }
 
-// Set stores the value for a field.
+// Has reports whether a field is populated.
 //
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
 //
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "emissions.v7.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
+		return x.Params != nil
 	case "emissions.v7.GenesisState.core_team_addresses":
-		lv := value.List()
-		clv := lv.(*_GenesisState_2_list)
-		x.CoreTeamAddresses = *clv.list
+		return len(x.CoreTeamAddresses) != 0
 	case "emissions.v7.GenesisState.next_topic_id":
-		x.NextTopicId = value.Uint()
+		return x.NextTopicId != uint64(0)
 	case "emissions.v7.GenesisState.topics":
-		lv := value.List()
-		clv := lv.(*_GenesisState_4_list)
-		x.Topics = *clv.list
+		return len(x.Topics) != 0
 	case "emissions.v7.GenesisState.active_topics":
-		lv := value.List()
-		clv := lv.(*_GenesisState_5_list)
-		x.ActiveTopics = *clv.list
+		return len(x.ActiveTopics) != 0
 	case "emissions.v7.GenesisState.rewardable_topics":
-		lv := value.List()
-		clv := lv.(*_GenesisState_6_list)
-		x.RewardableTopics = *clv.list
+		return len(x.RewardableTopics) != 0
 	case "emissions.v7.GenesisState.topic_workers":
-		lv := value.List()
-		clv := lv.(*_GenesisState_7_list)
-		x.TopicWorkers = *clv.list
+		return len(x.TopicWorkers) != 0
 	case "emissions.v7.GenesisState.topic_reputers":
-		lv := value.List()
-		clv := lv.(*_GenesisState_8_list)
-		x.TopicReputers = *clv.list
+		return len(x.TopicReputers) != 0
 	case "emissions.v7.GenesisState.topic_reward_nonce":
-		lv := value.List()
-		clv := lv.(*_GenesisState_9_list)
-		x.TopicRewardNonce = *clv.list
+		return len(x.TopicRewardNonce) != 0
 	case "emissions.v7.GenesisState.inferer_scores_by_block":
-		lv := value.List()
-		clv := lv.(*_GenesisState_10_list)
-		x.InfererScoresByBlock = *clv.list
+		return len(x.InfererScoresByBlock) != 0
 	case "emissions.v7.GenesisState.forecaster_scores_by_block":
-		lv := value.List()
-		clv := lv.(*_GenesisState_11_list)
-		x.ForecasterScoresByBlock = *clv.list
+		return len(x.ForecasterScoresByBlock) != 0
 	case "emissions.v7.GenesisState.reputer_scores_by_block":
-		lv := value.List()
-		clv := lv.(*_GenesisState_12_list)
-		x.ReputerScoresByBlock = *clv.list
+		return len(x.ReputerScoresByBlock) != 0
 	case "emissions.v7.GenesisState.reputer_listening_coefficient":
-		lv := value.List()
-		clv := lv.(*_GenesisState_16_list)
-		x.ReputerListeningCoefficient = *clv.list
+		return len(x.ReputerListeningCoefficient) != 0
 	case "emissions.v7.GenesisState.previous_reputer_reward_fraction":
-		lv := value.List()
-		clv := lv.(*_GenesisState_17_list)
-		x.PreviousReputerRewardFraction = *clv.list
+		return len(x.PreviousReputerRewardFraction) != 0
 	case "emissions.v7.GenesisState.previous_inference_reward_fraction":
-		lv := value.List()
-		clv := lv.(*_GenesisState_18_list)
-		x.PreviousInferenceRewardFraction = *clv.list
+		return len(x.PreviousInferenceRewardFraction) != 0
 	case "emissions.v7.GenesisState.previous_forecast_reward_fraction":
-		lv := value.List()
-		clv := lv.(*_GenesisState_19_list)
-		x.PreviousForecastRewardFraction = *clv.list
+		return len(x.PreviousForecastRewardFraction) != 0
 	case "emissions.v7.GenesisState.previous_forecaster_score_ratio":
-		lv := value.List()
-		clv := lv.(*_GenesisState_20_list)
-		x.PreviousForecasterScoreRatio = *clv.list
+		return len(x.PreviousForecasterScoreRatio) != 0
 	case "emissions.v7.GenesisState.total_stake":
-		x.TotalStake = value.Interface().(string)
+		return x.TotalStake != ""
 	case "emissions.v7.GenesisState.topic_stake":
-		lv := value.List()
-		clv := lv.(*_GenesisState_22_list)
-		x.TopicStake = *clv.list
+		return len(x.TopicStake) != 0
 	case "emissions.v7.GenesisState.stake_reputer_authority":
-		lv := value.List()
-		clv := lv.(*_GenesisState_23_list)
-		x.StakeReputerAuthority = *clv.list
+		return len(x.StakeReputerAuthority) != 0
 	case "emissions.v7.GenesisState.stake_sum_from_delegator":
-		lv := value.List()
-		clv := lv.(*_GenesisState_24_list)
-		x.StakeSumFromDelegator = *clv.list
+		return len(x.StakeSumFromDelegator) != 0
 	case "emissions.v7.GenesisState.delegated_stakes":
-		lv := value.List()
-		clv := lv.(*_GenesisState_25_list)
-		x.DelegatedStakes = *clv.list
+		return len(x.DelegatedStakes) != 0
 	case "emissions.v7.GenesisState.stake_from_delegators_upon_reputer":
-		lv := value.List()
-		clv := lv.(*_GenesisState_26_list)
-		x.StakeFromDelegatorsUponReputer = *clv.list
+		return len(x.StakeFromDelegatorsUponReputer) != 0
 	case "emissions.v7.GenesisState.delegate_reward_per_share":
-		lv := value.List()
-		clv := lv.(*_GenesisState_27_list)
-		x.DelegateRewardPerShare = *clv.list
+		return len(x.DelegateRewardPerShare) != 0
 	case "emissions.v7.GenesisState.stake_removals_by_block":
-		lv := value.List()
-		clv := lv.(*_GenesisState_28_list)
-		x.StakeRemovalsByBlock = *clv.list
+		return len(x.StakeRemovalsByBlock) != 0
 	case "emissions.v7.GenesisState.stake_removals_by_actor":
-		lv := value.List()
-		clv := lv.(*_GenesisState_29_list)
-		x.StakeRemovalsByActor = *clv.list
+		return len(x.StakeRemovalsByActor) != 0
 	case "emissions.v7.GenesisState.delegate_stake_removals_by_block":
-		lv := value.List()
-		clv := lv.(*_GenesisState_30_list)
-		x.DelegateStakeRemovalsByBlock = *clv.list
+		return len(x.DelegateStakeRemovalsByBlock) != 0
 	case "emissions.v7.GenesisState.delegate_stake_removals_by_actor":
-		lv := value.List()
-		clv := lv.(*_GenesisState_31_list)
-		x.DelegateStakeRemovalsByActor = *clv.list
+		return len(x.DelegateStakeRemovalsByActor) != 0
 	case "emissions.v7.GenesisState.inferences":
-		lv := value.List()
-		clv := lv.(*_GenesisState_32_list)
-		x.Inferences = *clv.list
+		return len(x.Inferences) != 0
 	case "emissions.v7.GenesisState.forecasts":
-		lv := value.List()
-		clv := lv.(*_GenesisState_33_list)
-		x.Forecasts = *clv.list
+		return len(x.Forecasts) != 0
 	case "emissions.v7.GenesisState.workers":
-		lv := value.List()
-		clv := lv.(*_GenesisState_34_list)
-		x.Workers = *clv.list
+		return len(x.Workers) != 0
 	case "emissions.v7.GenesisState.reputers":
-		lv := value.List()
-		clv := lv.(*_GenesisState_35_list)
-		x.Reputers = *clv.list
+		return len(x.Reputers) != 0
 	case "emissions.v7.GenesisState.topic_fee_revenue":
-		lv := value.List()
-		clv := lv.(*_GenesisState_36_list)
-		x.TopicFeeRevenue = *clv.list
+		return len(x.TopicFeeRevenue) != 0
 	case "emissions.v7.GenesisState.previous_topic_weight":
-		lv := value.List()
-		clv := lv.(*_GenesisState_37_list)
-		x.PreviousTopicWeight = *clv.list
+		return len(x.PreviousTopicWeight) != 0
 	case "emissions.v7.GenesisState.all_inferences":
-		lv := value.List()
-		clv := lv.(*_GenesisState_38_list)
-		x.AllInferences = *clv.list
+		return len(x.AllInferences) != 0
 	case "emissions.v7.GenesisState.all_forecasts":
-		lv := value.List()
-		clv := lv.(*_GenesisState_39_list)
-		x.AllForecasts = *clv.list
+		return len(x.AllForecasts) != 0
 	case "emissions.v7.GenesisState.all_loss_bundles":
-		lv := value.List()
-		clv := lv.(*_GenesisState_40_list)
-		x.AllLossBundles = *clv.list
+		return len(x.AllLossBundles) != 0
 	case "emissions.v7.GenesisState.network_loss_bundles":
-		lv := value.List()
-		clv := lv.(*_GenesisState_41_list)
-		x.NetworkLossBundles = *clv.list
+		return len(x.NetworkLossBundles) != 0
 	case "emissions.v7.GenesisState.previous_percentage_reward_to_staked_reputers":
-		x.PreviousPercentageRewardToStakedReputers = value.Interface().(string)
+		return x.PreviousPercentageRewardToStakedReputers != ""
 	case "emissions.v7.GenesisState.unfulfilled_worker_nonces":
-		lv := value.List()
-		clv := lv.(*_GenesisState_43_list)
-		x.UnfulfilledWorkerNonces = *clv.list
+		return len(x.UnfulfilledWorkerNonces) != 0
 	case "emissions.v7.GenesisState.unfulfilled_reputer_nonces":
-		lv := value.List()
-		clv := lv.(*_GenesisState_44_list)
-		x.UnfulfilledReputerNonces = *clv.list
+		return len(x.UnfulfilledReputerNonces) != 0
 	case "emissions.v7.GenesisState.latest_inferer_network_regrets":
-		lv := value.List()
-		clv := lv.(*_GenesisState_45_list)
-		x.LatestInfererNetworkRegrets = *clv.list
+		return len(x.LatestInfererNetworkRegrets) != 0
 	case "emissions.v7.GenesisState.latest_forecaster_network_regrets":
-		lv := value.List()
-		clv := lv.(*_GenesisState_46_list)
-		x.LatestForecasterNetworkRegrets = *clv.list
+		return len(x.LatestForecasterNetworkRegrets) != 0
 	case "emissions.v7.GenesisState.latest_one_in_forecaster_network_regrets":
-		lv := value.List()
-		clv := lv.(*_GenesisState_47_list)
-		x.LatestOneInForecasterNetworkRegrets = *clv.list
+		return len(x.LatestOneInForecasterNetworkRegrets) != 0
 	case "emissions.v7.GenesisState.latest_naive_inferer_network_regrets":
-		lv := value.List()
-		clv := lv.(*_GenesisState_48_list)
-		x.LatestNaiveInfererNetworkRegrets = *clv.list
+		return len(x.LatestNaiveInfererNetworkRegrets) != 0
 	case "emissions.v7.GenesisState.latest_one_out_inferer_inferer_network_regrets":
-		lv := value.List()
-		clv := lv.(*_GenesisState_49_list)
-		x.LatestOneOutInfererInfererNetworkRegrets = *clv.list
+		return len(x.LatestOneOutInfererInfererNetworkRegrets) != 0
 	case "emissions.v7.GenesisState.latest_one_out_inferer_forecaster_network_regrets":
-		lv := value.List()
-		clv := lv.(*_GenesisState_50_list)
-		x.LatestOneOutInfererForecasterNetworkRegrets = *clv.list
+		return len(x.LatestOneOutInfererForecasterNetworkRegrets) != 0
 	case "emissions.v7.GenesisState.latest_one_out_forecaster_inferer_network_regrets":
-		lv := value.List()
-		clv := lv.(*_GenesisState_51_list)
-		x.LatestOneOutForecasterInfererNetworkRegrets = *clv.list
+		return len(x.LatestOneOutForecasterInfererNetworkRegrets) != 0
 	case "emissions.v7.GenesisState.latest_one_out_forecaster_forecaster_network_regrets":
-		lv := value.List()
-		clv := lv.(*_GenesisState_52_list)
-		x.LatestOneOutForecasterForecasterNetworkRegrets = *clv.list
+		return len(x.LatestOneOutForecasterForecasterNetworkRegrets) != 0
 	case "emissions.v7.GenesisState.topic_last_worker_commit":
-		lv := value.List()
-		clv := lv.(*_GenesisState_53_list)
-		x.TopicLastWorkerCommit = *clv.list
+		return len(x.TopicLastWorkerCommit) != 0
 	case "emissions.v7.GenesisState.topic_last_reputer_commit":
-		lv := value.List()
-		clv := lv.(*_GenesisState_54_list)
-		x.TopicLastReputerCommit = *clv.list
+		return len(x.TopicLastReputerCommit) != 0
 	case "emissions.v7.GenesisState.open_worker_windows":
-		lv := value.List()
-		clv := lv.(*_GenesisState_55_list)
-		x.OpenWorkerWindows = *clv.list
+		return len(x.OpenWorkerWindows) != 0
 	case "emissions.v7.GenesisState.last_drip_block":
-		lv := value.List()
-		clv := lv.(*_GenesisState_56_list)
-		x.LastDripBlock = *clv.list
+		return len(x.LastDripBlock) != 0
 	case "emissions.v7.GenesisState.topic_to_next_possible_churning_block":
-		lv := value.List()
-		clv := lv.(*_GenesisState_57_list)
-		x.TopicToNextPossibleChurningBlock = *clv.list
+		return len(x.TopicToNextPossibleChurningBlock) != 0
 	case "emissions.v7.GenesisState.block_to_active_topics":
-		lv := value.List()
-		clv := lv.(*_GenesisState_58_list)
-		x.BlockToActiveTopics = *clv.list
+		return len(x.BlockToActiveTopics) != 0
 	case "emissions.v7.GenesisState.block_to_lowest_active_topic_weight":
-		lv := value.List()
-		clv := lv.(*_GenesisState_59_list)
-		x.BlockToLowestActiveTopicWeight = *clv.list
+		return len(x.BlockToLowestActiveTopicWeight) != 0
 	case "emissions.v7.GenesisState.inferer_score_emas":
-		lv := value.List()
-		clv := lv.(*_GenesisState_60_list)
-		x.InfererScoreEmas = *clv.list
+		return len(x.InfererScoreEmas) != 0
 	case "emissions.v7.GenesisState.forecaster_score_emas":
-		lv := value.List()
-		clv := lv.(*_GenesisState_61_list)
-		x.ForecasterScoreEmas = *clv.list
+		return len(x.ForecasterScoreEmas) != 0
 	case "emissions.v7.GenesisState.reputer_score_emas":
-		lv := value.List()
-		clv := lv.(*_GenesisState_62_list)
-		x.ReputerScoreEmas = *clv.list
+		return len(x.ReputerScoreEmas) != 0
 	case "emissions.v7.GenesisState.previous_topic_quantile_inferer_score_ema":
-		lv := value.List()
-		clv := lv.(*_GenesisState_63_list)
-		x.PreviousTopicQuantileInfererScoreEma = *clv.list
+		return len(x.PreviousTopicQuantileInfererScoreEma) != 0
 	case "emissions.v7.GenesisState.previous_topic_quantile_forecaster_score_ema":
-		lv := value.List()
-		clv := lv.(*_GenesisState_64_list)
-		x.PreviousTopicQuantileForecasterScoreEma = *clv.list
+		return len(x.PreviousTopicQuantileForecasterScoreEma) != 0
 	case "emissions.v7.GenesisState.previous_topic_quantile_reputer_score_ema":
-		lv := value.List()
-		clv := lv.(*_GenesisState_65_list)
-		x.PreviousTopicQuantileReputerScoreEma = *clv.list
+		return len(x.PreviousTopicQuantileReputerScoreEma) != 0
 	case "emissions.v7.GenesisState.count_inferer_inclusions_in_topic_active_set":
-		lv := value.List()
-		clv := lv.(*_GenesisState_66_list)
-		x.CountInfererInclusionsInTopicActiveSet = *clv.list
+		return len(x.CountInfererInclusionsInTopicActiveSet) != 0
 	case "emissions.v7.GenesisState.count_forecaster_inclusions_in_topic_active_set":
-		lv := value.List()
-		clv := lv.(*_GenesisState_67_list)
-		x.CountForecasterInclusionsInTopicActiveSet = *clv.list
+		return len(x.CountForecasterInclusionsInTopicActiveSet) != 0
 	case "emissions.v7.GenesisState.active_inferers":
-		lv := value.List()
-		clv := lv.(*_GenesisState_68_list)
-		x.ActiveInferers = *clv.list
+		return len(x.ActiveInferers) != 0
 	case "emissions.v7.GenesisState.active_forecasters":
-		lv := value.List()
-		clv := lv.(*_GenesisState_69_list)
-		x.ActiveForecasters = *clv.list
+		return len(x.ActiveForecasters) != 0
 	case "emissions.v7.GenesisState.lowest_inferer_score_ema":
-		lv := value.List()
-		clv := lv.(*_GenesisState_70_list)
-		x.LowestInfererScoreEma = *clv.list
+		return len(x.LowestInfererScoreEma) != 0
 	case "emissions.v7.GenesisState.lowest_forecaster_score_ema":
-		lv := value.List()
-		clv := lv.(*_GenesisState_71_list)
-		x.LowestForecasterScoreEma = *clv.list
+		return len(x.LowestForecasterScoreEma) != 0
 	case "emissions.v7.GenesisState.active_reputers":
-		lv := value.List()
-		clv := lv.(*_GenesisState_72_list)
-		x.ActiveReputers = *clv.list
+		return len(x.ActiveReputers) != 0
 	case "emissions.v7.GenesisState.lowest_reputer_score_ema":
-		lv := value.List()
-		clv := lv.(*_GenesisState_73_list)
-		x.LowestReputerScoreEma = *clv.list
+		return len(x.LowestReputerScoreEma) != 0
 	case "emissions.v7.GenesisState.loss_bundles":
-		lv := value.List()
-		clv := lv.(*_GenesisState_74_list)
-		x.LossBundles = *clv.list
+		return len(x.LossBundles) != 0
 	case "emissions.v7.GenesisState.total_sum_previous_topic_weights":
-		x.TotalSumPreviousTopicWeights = value.Interface().(string)
+		return x.TotalSumPreviousTopicWeights != ""
 	case "emissions.v7.GenesisState.reward_current_block_emission":
-		x.RewardCurrentBlockEmission = value.Interface().(string)
+		return x.RewardCurrentBlockEmission != ""
 	case "emissions.v7.GenesisState.whitelist_admins":
-		lv := value.List()
-		clv := lv.(*_GenesisState_77_list)
-		x.WhitelistAdmins = *clv.list
+		return len(x.WhitelistAdmins) != 0
 	case "emissions.v7.GenesisState.global_whitelist":
-		lv := value.List()
-		clv := lv.(*_GenesisState_78_list)
-		x.GlobalWhitelist = *clv.list
+		return len(x.GlobalWhitelist) != 0
 	case "emissions.v7.GenesisState.topic_creator_whitelist":
-		lv := value.List()
-		clv := lv.(*_GenesisState_79_list)
-		x.TopicCreatorWhitelist = *clv.list
+		return len(x.TopicCreatorWhitelist) != 0
 	case "emissions.v7.GenesisState.topic_worker_whitelist":
-		lv := value.List()
-		clv := lv.(*_GenesisState_80_list)
-		x.TopicWorkerWhitelist = *clv.list
+		return len(x.TopicWorkerWhitelist) != 0
 	case "emissions.v7.GenesisState.topic_reputer_whitelist":
-		lv := value.List()
-		clv := lv.(*_GenesisState_81_list)
-		x.TopicReputerWhitelist = *clv.list
+		return len(x.TopicReputerWhitelist) != 0
 	case "emissions.v7.GenesisState.topic_worker_whitelist_enabled":
-		lv := value.List()
-		clv := lv.(*_GenesisState_82_list)
-		x.TopicWorkerWhitelistEnabled = *clv.list
+		return len(x.TopicWorkerWhitelistEnabled) != 0
 	case "emissions.v7.GenesisState.topic_reputer_whitelist_enabled":
-		lv := value.List()
-		clv := lv.(*_GenesisState_83_list)
-		x.TopicReputerWhitelistEnabled = *clv.list
+		return len(x.TopicReputerWhitelistEnabled) != 0
 	case "emissions.v7.GenesisState.last_median_inferences":
-		lv := value.List()
-		clv := lv.(*_GenesisState_84_list)
-		x.LastMedianInferences = *clv.list
+		return len(x.LastMedianInferences) != 0
 	case "emissions.v7.GenesisState.mad_inferences":
-		lv := value.List()
-		clv := lv.(*_GenesisState_85_list)
-		x.MadInferences = *clv.list
+		return len(x.MadInferences) != 0
 	case "emissions.v7.GenesisState.initial_inferer_ema_score":
-		lv := value.List()
-		clv := lv.(*_GenesisState_86_list)
-		x.InitialInfererEmaScore = *clv.list
+		return len(x.InitialInfererEmaScore) != 0
 	case "emissions.v7.GenesisState.initial_forecaster_ema_score":
-		lv := value.List()
-		clv := lv.(*_GenesisState_87_list)
-		x.InitialForecasterEmaScore = *clv.list
+		return len(x.InitialForecasterEmaScore) != 0
 	case "emissions.v7.GenesisState.initial_reputer_ema_score":
-		lv := value.List()
-		clv := lv.(*_GenesisState_88_list)
-		x.InitialReputerEmaScore = *clv.list
+		return len(x.InitialReputerEmaScore) != 0
 	case "emissions.v7.GenesisState.global_worker_whitelist":
-		lv := value.List()
-		clv := lv.(*_GenesisState_89_list)
-		x.GlobalWorkerWhitelist = *clv.list
+		return len(x.GlobalWorkerWhitelist) != 0
 	case "emissions.v7.GenesisState.global_reputer_whitelist":
-		lv := value.List()
-		clv := lv.(*_GenesisState_90_list)
-		x.GlobalReputerWhitelist = *clv.list
+		return len(x.GlobalReputerWhitelist) != 0
 	case "emissions.v7.GenesisState.global_admin_whitelist":
-		lv := value.List()
-		clv := lv.(*_GenesisState_91_list)
-		x.GlobalAdminWhitelist = *clv.list
+		return len(x.GlobalAdminWhitelist) != 0
+	case "emissions.v7.GenesisState.pending_topic_updates":
+		return len(x.PendingTopicUpdates) != 0
+	case "emissions.v7.GenesisState.archived_topics":
+		return len(x.ArchivedTopics) != 0
+	case "emissions.v7.GenesisState.archived_topics_to_prune":
+		return len(x.ArchivedTopicsToPrune) != 0
+	case "emissions.v7.GenesisState.topic_owners":
+		return len(x.TopicOwners) != 0
+	case "emissions.v7.GenesisState.pending_topic_owners":
+		return len(x.PendingTopicOwners) != 0
+	case "emissions.v7.GenesisState.topic_tags":
+		return len(x.TopicTags) != 0
+	case "emissions.v7.GenesisState.reputer_ground_truths":
+		return len(x.ReputerGroundTruths) != 0
+	case "emissions.v7.GenesisState.consensus_ground_truths":
+		return len(x.ConsensusGroundTruths) != 0
+	case "emissions.v7.GenesisState.worker_payload_commits":
+		return len(x.WorkerPayloadCommits) != 0
+	case "emissions.v7.GenesisState.actor_operators":
+		return len(x.ActorOperators) != 0
+	case "emissions.v7.GenesisState.worker_bonds":
+		return len(x.WorkerBonds) != 0
+	case "emissions.v7.GenesisState.worker_bond_removals":
+		return len(x.WorkerBondRemovals) != 0
+	case "emissions.v7.GenesisState.reputer_consensus_deviations":
+		return len(x.ReputerConsensusDeviations) != 0
+	case "emissions.v7.GenesisState.redelegations":
+		return len(x.Redelegations) != 0
+	case "emissions.v7.GenesisState.auto_compound_delegators":
+		return len(x.AutoCompoundDelegators) != 0
+	case "emissions.v7.GenesisState.reputer_commissions":
+		return len(x.ReputerCommissions) != 0
+	case "emissions.v7.GenesisState.stake_locks":
+		return len(x.StakeLocks) != 0
+	case "emissions.v7.GenesisState.reward_history":
+		return len(x.RewardHistory) != 0
+	case "emissions.v7.GenesisState.reward_escrows":
+		return len(x.RewardEscrows) != 0
+	case "emissions.v7.GenesisState.queued_reward_payouts":
+		return len(x.QueuedRewardPayouts) != 0
+	case "emissions.v7.GenesisState.next_reward_payout_id":
+		return x.NextRewardPayoutId != uint64(0)
+	case "emissions.v7.GenesisState.archived_topic_delegate_removal_cursors":
+		return len(x.ArchivedTopicDelegateRemovalCursors) != 0
+	case "emissions.v7.GenesisState.dead_lettered_reward_payouts":
+		return len(x.DeadLetteredRewardPayouts) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.GenesisState"))
@@ -6198,525 +6462,236 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 	}
 }
 
-// Mutable returns a mutable reference to a composite type.
+// Clear clears the field such that a subsequent Has call reports false.
 //
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
 //
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "emissions.v7.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		x.Params = nil
 	case "emissions.v7.GenesisState.core_team_addresses":
-		if x.CoreTeamAddresses == nil {
-			x.CoreTeamAddresses = []string{}
-		}
-		value := &_GenesisState_2_list{list: &x.CoreTeamAddresses}
-		return protoreflect.ValueOfList(value)
+		x.CoreTeamAddresses = nil
+	case "emissions.v7.GenesisState.next_topic_id":
+		x.NextTopicId = uint64(0)
 	case "emissions.v7.GenesisState.topics":
-		if x.Topics == nil {
-			x.Topics = []*TopicIdAndTopic{}
-		}
-		value := &_GenesisState_4_list{list: &x.Topics}
-		return protoreflect.ValueOfList(value)
+		x.Topics = nil
 	case "emissions.v7.GenesisState.active_topics":
-		if x.ActiveTopics == nil {
-			x.ActiveTopics = []uint64{}
-		}
-		value := &_GenesisState_5_list{list: &x.ActiveTopics}
-		return protoreflect.ValueOfList(value)
+		x.ActiveTopics = nil
 	case "emissions.v7.GenesisState.rewardable_topics":
-		if x.RewardableTopics == nil {
-			x.RewardableTopics = []uint64{}
-		}
-		value := &_GenesisState_6_list{list: &x.RewardableTopics}
-		return protoreflect.ValueOfList(value)
+		x.RewardableTopics = nil
 	case "emissions.v7.GenesisState.topic_workers":
-		if x.TopicWorkers == nil {
-			x.TopicWorkers = []*TopicAndActorId{}
-		}
-		value := &_GenesisState_7_list{list: &x.TopicWorkers}
-		return protoreflect.ValueOfList(value)
+		x.TopicWorkers = nil
 	case "emissions.v7.GenesisState.topic_reputers":
-		if x.TopicReputers == nil {
-			x.TopicReputers = []*TopicAndActorId{}
-		}
-		value := &_GenesisState_8_list{list: &x.TopicReputers}
-		return protoreflect.ValueOfList(value)
+		x.TopicReputers = nil
 	case "emissions.v7.GenesisState.topic_reward_nonce":
-		if x.TopicRewardNonce == nil {
-			x.TopicRewardNonce = []*TopicIdAndBlockHeight{}
-		}
-		value := &_GenesisState_9_list{list: &x.TopicRewardNonce}
-		return protoreflect.ValueOfList(value)
+		x.TopicRewardNonce = nil
 	case "emissions.v7.GenesisState.inferer_scores_by_block":
-		if x.InfererScoresByBlock == nil {
-			x.InfererScoresByBlock = []*TopicIdBlockHeightScores{}
-		}
-		value := &_GenesisState_10_list{list: &x.InfererScoresByBlock}
-		return protoreflect.ValueOfList(value)
+		x.InfererScoresByBlock = nil
 	case "emissions.v7.GenesisState.forecaster_scores_by_block":
-		if x.ForecasterScoresByBlock == nil {
-			x.ForecasterScoresByBlock = []*TopicIdBlockHeightScores{}
-		}
-		value := &_GenesisState_11_list{list: &x.ForecasterScoresByBlock}
-		return protoreflect.ValueOfList(value)
+		x.ForecasterScoresByBlock = nil
 	case "emissions.v7.GenesisState.reputer_scores_by_block":
-		if x.ReputerScoresByBlock == nil {
-			x.ReputerScoresByBlock = []*TopicIdBlockHeightScores{}
-		}
-		value := &_GenesisState_12_list{list: &x.ReputerScoresByBlock}
-		return protoreflect.ValueOfList(value)
+		x.ReputerScoresByBlock = nil
 	case "emissions.v7.GenesisState.reputer_listening_coefficient":
-		if x.ReputerListeningCoefficient == nil {
-			x.ReputerListeningCoefficient = []*TopicIdActorIdListeningCoefficient{}
-		}
-		value := &_GenesisState_16_list{list: &x.ReputerListeningCoefficient}
-		return protoreflect.ValueOfList(value)
+		x.ReputerListeningCoefficient = nil
 	case "emissions.v7.GenesisState.previous_reputer_reward_fraction":
-		if x.PreviousReputerRewardFraction == nil {
-			x.PreviousReputerRewardFraction = []*TopicIdActorIdDec{}
-		}
-		value := &_GenesisState_17_list{list: &x.PreviousReputerRewardFraction}
-		return protoreflect.ValueOfList(value)
+		x.PreviousReputerRewardFraction = nil
 	case "emissions.v7.GenesisState.previous_inference_reward_fraction":
-		if x.PreviousInferenceRewardFraction == nil {
-			x.PreviousInferenceRewardFraction = []*TopicIdActorIdDec{}
-		}
-		value := &_GenesisState_18_list{list: &x.PreviousInferenceRewardFraction}
-		return protoreflect.ValueOfList(value)
+		x.PreviousInferenceRewardFraction = nil
 	case "emissions.v7.GenesisState.previous_forecast_reward_fraction":
-		if x.PreviousForecastRewardFraction == nil {
-			x.PreviousForecastRewardFraction = []*TopicIdActorIdDec{}
-		}
-		value := &_GenesisState_19_list{list: &x.PreviousForecastRewardFraction}
-		return protoreflect.ValueOfList(value)
+		x.PreviousForecastRewardFraction = nil
 	case "emissions.v7.GenesisState.previous_forecaster_score_ratio":
-		if x.PreviousForecasterScoreRatio == nil {
-			x.PreviousForecasterScoreRatio = []*TopicIdAndDec{}
-		}
-		value := &_GenesisState_20_list{list: &x.PreviousForecasterScoreRatio}
-		return protoreflect.ValueOfList(value)
+		x.PreviousForecasterScoreRatio = nil
+	case "emissions.v7.GenesisState.total_stake":
+		x.TotalStake = ""
 	case "emissions.v7.GenesisState.topic_stake":
-		if x.TopicStake == nil {
-			x.TopicStake = []*TopicIdAndInt{}
-		}
-		value := &_GenesisState_22_list{list: &x.TopicStake}
-		return protoreflect.ValueOfList(value)
+		x.TopicStake = nil
 	case "emissions.v7.GenesisState.stake_reputer_authority":
-		if x.StakeReputerAuthority == nil {
-			x.StakeReputerAuthority = []*TopicIdActorIdInt{}
-		}
-		value := &_GenesisState_23_list{list: &x.StakeReputerAuthority}
-		return protoreflect.ValueOfList(value)
+		x.StakeReputerAuthority = nil
 	case "emissions.v7.GenesisState.stake_sum_from_delegator":
-		if x.StakeSumFromDelegator == nil {
-			x.StakeSumFromDelegator = []*TopicIdActorIdInt{}
-		}
-		value := &_GenesisState_24_list{list: &x.StakeSumFromDelegator}
-		return protoreflect.ValueOfList(value)
+		x.StakeSumFromDelegator = nil
 	case "emissions.v7.GenesisState.delegated_stakes":
-		if x.DelegatedStakes == nil {
-			x.DelegatedStakes = []*TopicIdDelegatorReputerDelegatorInfo{}
-		}
-		value := &_GenesisState_25_list{list: &x.DelegatedStakes}
-		return protoreflect.ValueOfList(value)
+		x.DelegatedStakes = nil
 	case "emissions.v7.GenesisState.stake_from_delegators_upon_reputer":
-		if x.StakeFromDelegatorsUponReputer == nil {
-			x.StakeFromDelegatorsUponReputer = []*TopicIdActorIdInt{}
-		}
-		value := &_GenesisState_26_list{list: &x.StakeFromDelegatorsUponReputer}
-		return protoreflect.ValueOfList(value)
+		x.StakeFromDelegatorsUponReputer = nil
 	case "emissions.v7.GenesisState.delegate_reward_per_share":
-		if x.DelegateRewardPerShare == nil {
-			x.DelegateRewardPerShare = []*TopicIdActorIdDec{}
-		}
-		value := &_GenesisState_27_list{list: &x.DelegateRewardPerShare}
-		return protoreflect.ValueOfList(value)
+		x.DelegateRewardPerShare = nil
 	case "emissions.v7.GenesisState.stake_removals_by_block":
-		if x.StakeRemovalsByBlock == nil {
-			x.StakeRemovalsByBlock = []*BlockHeightTopicIdReputerStakeRemovalInfo{}
-		}
-		value := &_GenesisState_28_list{list: &x.StakeRemovalsByBlock}
-		return protoreflect.ValueOfList(value)
+		x.StakeRemovalsByBlock = nil
 	case "emissions.v7.GenesisState.stake_removals_by_actor":
-		if x.StakeRemovalsByActor == nil {
-			x.StakeRemovalsByActor = []*ActorIdTopicIdBlockHeight{}
-		}
-		value := &_GenesisState_29_list{list: &x.StakeRemovalsByActor}
-		return protoreflect.ValueOfList(value)
+		x.StakeRemovalsByActor = nil
 	case "emissions.v7.GenesisState.delegate_stake_removals_by_block":
-		if x.DelegateStakeRemovalsByBlock == nil {
-			x.DelegateStakeRemovalsByBlock = []*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{}
-		}
-		value := &_GenesisState_30_list{list: &x.DelegateStakeRemovalsByBlock}
-		return protoreflect.ValueOfList(value)
+		x.DelegateStakeRemovalsByBlock = nil
 	case "emissions.v7.GenesisState.delegate_stake_removals_by_actor":
-		if x.DelegateStakeRemovalsByActor == nil {
-			x.DelegateStakeRemovalsByActor = []*DelegatorReputerTopicIdBlockHeight{}
-		}
-		value := &_GenesisState_31_list{list: &x.DelegateStakeRemovalsByActor}
-		return protoreflect.ValueOfList(value)
+		x.DelegateStakeRemovalsByActor = nil
 	case "emissions.v7.GenesisState.inferences":
-		if x.Inferences == nil {
-			x.Inferences = []*TopicIdActorIdInference{}
-		}
-		value := &_GenesisState_32_list{list: &x.Inferences}
-		return protoreflect.ValueOfList(value)
+		x.Inferences = nil
 	case "emissions.v7.GenesisState.forecasts":
-		if x.Forecasts == nil {
-			x.Forecasts = []*TopicIdActorIdForecast{}
-		}
-		value := &_GenesisState_33_list{list: &x.Forecasts}
-		return protoreflect.ValueOfList(value)
+		x.Forecasts = nil
 	case "emissions.v7.GenesisState.workers":
-		if x.Workers == nil {
-			x.Workers = []*LibP2PKeyAndOffchainNode{}
-		}
-		value := &_GenesisState_34_list{list: &x.Workers}
-		return protoreflect.ValueOfList(value)
+		x.Workers = nil
 	case "emissions.v7.GenesisState.reputers":
-		if x.Reputers == nil {
-			x.Reputers = []*LibP2PKeyAndOffchainNode{}
-		}
-		value := &_GenesisState_35_list{list: &x.Reputers}
-		return protoreflect.ValueOfList(value)
+		x.Reputers = nil
 	case "emissions.v7.GenesisState.topic_fee_revenue":
-		if x.TopicFeeRevenue == nil {
-			x.TopicFeeRevenue = []*TopicIdAndInt{}
-		}
-		value := &_GenesisState_36_list{list: &x.TopicFeeRevenue}
-		return protoreflect.ValueOfList(value)
+		x.TopicFeeRevenue = nil
 	case "emissions.v7.GenesisState.previous_topic_weight":
-		if x.PreviousTopicWeight == nil {
-			x.PreviousTopicWeight = []*TopicIdAndDec{}
-		}
-		value := &_GenesisState_37_list{list: &x.PreviousTopicWeight}
-		return protoreflect.ValueOfList(value)
+		x.PreviousTopicWeight = nil
 	case "emissions.v7.GenesisState.all_inferences":
-		if x.AllInferences == nil {
-			x.AllInferences = []*TopicIdBlockHeightInferences{}
-		}
-		value := &_GenesisState_38_list{list: &x.AllInferences}
-		return protoreflect.ValueOfList(value)
+		x.AllInferences = nil
 	case "emissions.v7.GenesisState.all_forecasts":
-		if x.AllForecasts == nil {
-			x.AllForecasts = []*TopicIdBlockHeightForecasts{}
-		}
-		value := &_GenesisState_39_list{list: &x.AllForecasts}
-		return protoreflect.ValueOfList(value)
+		x.AllForecasts = nil
 	case "emissions.v7.GenesisState.all_loss_bundles":
-		if x.AllLossBundles == nil {
-			x.AllLossBundles = []*TopicIdBlockHeightReputerValueBundles{}
-		}
-		value := &_GenesisState_40_list{list: &x.AllLossBundles}
-		return protoreflect.ValueOfList(value)
+		x.AllLossBundles = nil
 	case "emissions.v7.GenesisState.network_loss_bundles":
-		if x.NetworkLossBundles == nil {
-			x.NetworkLossBundles = []*TopicIdBlockHeightValueBundles{}
-		}
-		value := &_GenesisState_41_list{list: &x.NetworkLossBundles}
-		return protoreflect.ValueOfList(value)
+		x.NetworkLossBundles = nil
+	case "emissions.v7.GenesisState.previous_percentage_reward_to_staked_reputers":
+		x.PreviousPercentageRewardToStakedReputers = ""
 	case "emissions.v7.GenesisState.unfulfilled_worker_nonces":
-		if x.UnfulfilledWorkerNonces == nil {
-			x.UnfulfilledWorkerNonces = []*TopicIdAndNonces{}
-		}
-		value := &_GenesisState_43_list{list: &x.UnfulfilledWorkerNonces}
-		return protoreflect.ValueOfList(value)
+		x.UnfulfilledWorkerNonces = nil
 	case "emissions.v7.GenesisState.unfulfilled_reputer_nonces":
-		if x.UnfulfilledReputerNonces == nil {
-			x.UnfulfilledReputerNonces = []*TopicIdAndReputerRequestNonces{}
-		}
-		value := &_GenesisState_44_list{list: &x.UnfulfilledReputerNonces}
-		return protoreflect.ValueOfList(value)
+		x.UnfulfilledReputerNonces = nil
 	case "emissions.v7.GenesisState.latest_inferer_network_regrets":
-		if x.LatestInfererNetworkRegrets == nil {
-			x.LatestInfererNetworkRegrets = []*TopicIdActorIdTimeStampedValue{}
-		}
-		value := &_GenesisState_45_list{list: &x.LatestInfererNetworkRegrets}
-		return protoreflect.ValueOfList(value)
+		x.LatestInfererNetworkRegrets = nil
 	case "emissions.v7.GenesisState.latest_forecaster_network_regrets":
-		if x.LatestForecasterNetworkRegrets == nil {
-			x.LatestForecasterNetworkRegrets = []*TopicIdActorIdTimeStampedValue{}
-		}
-		value := &_GenesisState_46_list{list: &x.LatestForecasterNetworkRegrets}
-		return protoreflect.ValueOfList(value)
+		x.LatestForecasterNetworkRegrets = nil
 	case "emissions.v7.GenesisState.latest_one_in_forecaster_network_regrets":
-		if x.LatestOneInForecasterNetworkRegrets == nil {
-			x.LatestOneInForecasterNetworkRegrets = []*TopicIdActorIdActorIdTimeStampedValue{}
-		}
-		value := &_GenesisState_47_list{list: &x.LatestOneInForecasterNetworkRegrets}
-		return protoreflect.ValueOfList(value)
+		x.LatestOneInForecasterNetworkRegrets = nil
 	case "emissions.v7.GenesisState.latest_naive_inferer_network_regrets":
-		if x.LatestNaiveInfererNetworkRegrets == nil {
-			x.LatestNaiveInfererNetworkRegrets = []*TopicIdActorIdTimeStampedValue{}
-		}
-		value := &_GenesisState_48_list{list: &x.LatestNaiveInfererNetworkRegrets}
-		return protoreflect.ValueOfList(value)
+		x.LatestNaiveInfererNetworkRegrets = nil
 	case "emissions.v7.GenesisState.latest_one_out_inferer_inferer_network_regrets":
-		if x.LatestOneOutInfererInfererNetworkRegrets == nil {
-			x.LatestOneOutInfererInfererNetworkRegrets = []*TopicIdActorIdActorIdTimeStampedValue{}
-		}
-		value := &_GenesisState_49_list{list: &x.LatestOneOutInfererInfererNetworkRegrets}
-		return protoreflect.ValueOfList(value)
+		x.LatestOneOutInfererInfererNetworkRegrets = nil
 	case "emissions.v7.GenesisState.latest_one_out_inferer_forecaster_network_regrets":
-		if x.LatestOneOutInfererForecasterNetworkRegrets == nil {
-			x.LatestOneOutInfererForecasterNetworkRegrets = []*TopicIdActorIdActorIdTimeStampedValue{}
-		}
-		value := &_GenesisState_50_list{list: &x.LatestOneOutInfererForecasterNetworkRegrets}
-		return protoreflect.ValueOfList(value)
+		x.LatestOneOutInfererForecasterNetworkRegrets = nil
 	case "emissions.v7.GenesisState.latest_one_out_forecaster_inferer_network_regrets":
-		if x.LatestOneOutForecasterInfererNetworkRegrets == nil {
-			x.LatestOneOutForecasterInfererNetworkRegrets = []*TopicIdActorIdActorIdTimeStampedValue{}
-		}
-		value := &_GenesisState_51_list{list: &x.LatestOneOutForecasterInfererNetworkRegrets}
-		return protoreflect.ValueOfList(value)
+		x.LatestOneOutForecasterInfererNetworkRegrets = nil
 	case "emissions.v7.GenesisState.latest_one_out_forecaster_forecaster_network_regrets":
-		if x.LatestOneOutForecasterForecasterNetworkRegrets == nil {
-			x.LatestOneOutForecasterForecasterNetworkRegrets = []*TopicIdActorIdActorIdTimeStampedValue{}
-		}
-		value := &_GenesisState_52_list{list: &x.LatestOneOutForecasterForecasterNetworkRegrets}
-		return protoreflect.ValueOfList(value)
+		x.LatestOneOutForecasterForecasterNetworkRegrets = nil
 	case "emissions.v7.GenesisState.topic_last_worker_commit":
-		if x.TopicLastWorkerCommit == nil {
-			x.TopicLastWorkerCommit = []*TopicIdTimestampedActorNonce{}
-		}
-		value := &_GenesisState_53_list{list: &x.TopicLastWorkerCommit}
-		return protoreflect.ValueOfList(value)
+		x.TopicLastWorkerCommit = nil
 	case "emissions.v7.GenesisState.topic_last_reputer_commit":
-		if x.TopicLastReputerCommit == nil {
-			x.TopicLastReputerCommit = []*TopicIdTimestampedActorNonce{}
-		}
-		value := &_GenesisState_54_list{list: &x.TopicLastReputerCommit}
-		return protoreflect.ValueOfList(value)
+		x.TopicLastReputerCommit = nil
 	case "emissions.v7.GenesisState.open_worker_windows":
-		if x.OpenWorkerWindows == nil {
-			x.OpenWorkerWindows = []*BlockHeightAndTopicIds{}
-		}
-		value := &_GenesisState_55_list{list: &x.OpenWorkerWindows}
-		return protoreflect.ValueOfList(value)
+		x.OpenWorkerWindows = nil
 	case "emissions.v7.GenesisState.last_drip_block":
-		if x.LastDripBlock == nil {
-			x.LastDripBlock = []*TopicIdAndBlockHeight{}
-		}
-		value := &_GenesisState_56_list{list: &x.LastDripBlock}
-		return protoreflect.ValueOfList(value)
+		x.LastDripBlock = nil
 	case "emissions.v7.GenesisState.topic_to_next_possible_churning_block":
-		if x.TopicToNextPossibleChurningBlock == nil {
-			x.TopicToNextPossibleChurningBlock = []*TopicIdAndBlockHeight{}
-		}
-		value := &_GenesisState_57_list{list: &x.TopicToNextPossibleChurningBlock}
-		return protoreflect.ValueOfList(value)
+		x.TopicToNextPossibleChurningBlock = nil
 	case "emissions.v7.GenesisState.block_to_active_topics":
-		if x.BlockToActiveTopics == nil {
-			x.BlockToActiveTopics = []*BlockHeightTopicIds{}
-		}
-		value := &_GenesisState_58_list{list: &x.BlockToActiveTopics}
-		return protoreflect.ValueOfList(value)
+		x.BlockToActiveTopics = nil
 	case "emissions.v7.GenesisState.block_to_lowest_active_topic_weight":
-		if x.BlockToLowestActiveTopicWeight == nil {
-			x.BlockToLowestActiveTopicWeight = []*BlockHeightTopicIdWeightPair{}
-		}
-		value := &_GenesisState_59_list{list: &x.BlockToLowestActiveTopicWeight}
-		return protoreflect.ValueOfList(value)
+		x.BlockToLowestActiveTopicWeight = nil
 	case "emissions.v7.GenesisState.inferer_score_emas":
-		if x.InfererScoreEmas == nil {
-			x.InfererScoreEmas = []*TopicIdActorIdScore{}
-		}
-		value := &_GenesisState_60_list{list: &x.InfererScoreEmas}
-		return protoreflect.ValueOfList(value)
+		x.InfererScoreEmas = nil
 	case "emissions.v7.GenesisState.forecaster_score_emas":
-		if x.ForecasterScoreEmas == nil {
-			x.ForecasterScoreEmas = []*TopicIdActorIdScore{}
-		}
-		value := &_GenesisState_61_list{list: &x.ForecasterScoreEmas}
-		return protoreflect.ValueOfList(value)
+		x.ForecasterScoreEmas = nil
 	case "emissions.v7.GenesisState.reputer_score_emas":
-		if x.ReputerScoreEmas == nil {
-			x.ReputerScoreEmas = []*TopicIdActorIdScore{}
-		}
-		value := &_GenesisState_62_list{list: &x.ReputerScoreEmas}
-		return protoreflect.ValueOfList(value)
+		x.ReputerScoreEmas = nil
 	case "emissions.v7.GenesisState.previous_topic_quantile_inferer_score_ema":
-		if x.PreviousTopicQuantileInfererScoreEma == nil {
-			x.PreviousTopicQuantileInfererScoreEma = []*TopicIdAndDec{}
-		}
-		value := &_GenesisState_63_list{list: &x.PreviousTopicQuantileInfererScoreEma}
-		return protoreflect.ValueOfList(value)
+		x.PreviousTopicQuantileInfererScoreEma = nil
 	case "emissions.v7.GenesisState.previous_topic_quantile_forecaster_score_ema":
-		if x.PreviousTopicQuantileForecasterScoreEma == nil {
-			x.PreviousTopicQuantileForecasterScoreEma = []*TopicIdAndDec{}
-		}
-		value := &_GenesisState_64_list{list: &x.PreviousTopicQuantileForecasterScoreEma}
-		return protoreflect.ValueOfList(value)
+		x.PreviousTopicQuantileForecasterScoreEma = nil
 	case "emissions.v7.GenesisState.previous_topic_quantile_reputer_score_ema":
-		if x.PreviousTopicQuantileReputerScoreEma == nil {
-			x.PreviousTopicQuantileReputerScoreEma = []*TopicIdAndDec{}
-		}
-		value := &_GenesisState_65_list{list: &x.PreviousTopicQuantileReputerScoreEma}
-		return protoreflect.ValueOfList(value)
+		x.PreviousTopicQuantileReputerScoreEma = nil
 	case "emissions.v7.GenesisState.count_inferer_inclusions_in_topic_active_set":
-		if x.CountInfererInclusionsInTopicActiveSet == nil {
-			x.CountInfererInclusionsInTopicActiveSet = []*TopicIdActorIdUint64{}
-		}
-		value := &_GenesisState_66_list{list: &x.CountInfererInclusionsInTopicActiveSet}
-		return protoreflect.ValueOfList(value)
+		x.CountInfererInclusionsInTopicActiveSet = nil
 	case "emissions.v7.GenesisState.count_forecaster_inclusions_in_topic_active_set":
-		if x.CountForecasterInclusionsInTopicActiveSet == nil {
-			x.CountForecasterInclusionsInTopicActiveSet = []*TopicIdActorIdUint64{}
-		}
-		value := &_GenesisState_67_list{list: &x.CountForecasterInclusionsInTopicActiveSet}
-		return protoreflect.ValueOfList(value)
+		x.CountForecasterInclusionsInTopicActiveSet = nil
 	case "emissions.v7.GenesisState.active_inferers":
-		if x.ActiveInferers == nil {
-			x.ActiveInferers = []*TopicAndActorId{}
-		}
-		value := &_GenesisState_68_list{list: &x.ActiveInferers}
-		return protoreflect.ValueOfList(value)
+		x.ActiveInferers = nil
 	case "emissions.v7.GenesisState.active_forecasters":
-		if x.ActiveForecasters == nil {
-			x.ActiveForecasters = []*TopicAndActorId{}
-		}
-		value := &_GenesisState_69_list{list: &x.ActiveForecasters}
-		return protoreflect.ValueOfList(value)
+		x.ActiveForecasters = nil
 	case "emissions.v7.GenesisState.lowest_inferer_score_ema":
-		if x.LowestInfererScoreEma == nil {
-			x.LowestInfererScoreEma = []*TopicIdActorIdScore{}
-		}
-		value := &_GenesisState_70_list{list: &x.LowestInfererScoreEma}
-		return protoreflect.ValueOfList(value)
+		x.LowestInfererScoreEma = nil
 	case "emissions.v7.GenesisState.lowest_forecaster_score_ema":
-		if x.LowestForecasterScoreEma == nil {
-			x.LowestForecasterScoreEma = []*TopicIdActorIdScore{}
-		}
-		value := &_GenesisState_71_list{list: &x.LowestForecasterScoreEma}
-		return protoreflect.ValueOfList(value)
+		x.LowestForecasterScoreEma = nil
 	case "emissions.v7.GenesisState.active_reputers":
-		if x.ActiveReputers == nil {
-			x.ActiveReputers = []*TopicAndActorId{}
-		}
-		value := &_GenesisState_72_list{list: &x.ActiveReputers}
-		return protoreflect.ValueOfList(value)
+		x.ActiveReputers = nil
 	case "emissions.v7.GenesisState.lowest_reputer_score_ema":
-		if x.LowestReputerScoreEma == nil {
-			x.LowestReputerScoreEma = []*TopicIdActorIdScore{}
-		}
-		value := &_GenesisState_73_list{list: &x.LowestReputerScoreEma}
-		return protoreflect.ValueOfList(value)
+		x.LowestReputerScoreEma = nil
 	case "emissions.v7.GenesisState.loss_bundles":
-		if x.LossBundles == nil {
-			x.LossBundles = []*TopicIdReputerReputerValueBundle{}
-		}
-		value := &_GenesisState_74_list{list: &x.LossBundles}
-		return protoreflect.ValueOfList(value)
+		x.LossBundles = nil
+	case "emissions.v7.GenesisState.total_sum_previous_topic_weights":
+		x.TotalSumPreviousTopicWeights = ""
+	case "emissions.v7.GenesisState.reward_current_block_emission":
+		x.RewardCurrentBlockEmission = ""
 	case "emissions.v7.GenesisState.whitelist_admins":
-		if x.WhitelistAdmins == nil {
-			x.WhitelistAdmins = []string{}
-		}
-		value := &_GenesisState_77_list{list: &x.WhitelistAdmins}
-		return protoreflect.ValueOfList(value)
+		x.WhitelistAdmins = nil
 	case "emissions.v7.GenesisState.global_whitelist":
-		if x.GlobalWhitelist == nil {
-			x.GlobalWhitelist = []string{}
-		}
-		value := &_GenesisState_78_list{list: &x.GlobalWhitelist}
-		return protoreflect.ValueOfList(value)
+		x.GlobalWhitelist = nil
 	case "emissions.v7.GenesisState.topic_creator_whitelist":
-		if x.TopicCreatorWhitelist == nil {
-			x.TopicCreatorWhitelist = []string{}
-		}
-		value := &_GenesisState_79_list{list: &x.TopicCreatorWhitelist}
-		return protoreflect.ValueOfList(value)
+		x.TopicCreatorWhitelist = nil
 	case "emissions.v7.GenesisState.topic_worker_whitelist":
-		if x.TopicWorkerWhitelist == nil {
-			x.TopicWorkerWhitelist = []*TopicAndActorId{}
-		}
-		value := &_GenesisState_80_list{list: &x.TopicWorkerWhitelist}
-		return protoreflect.ValueOfList(value)
+		x.TopicWorkerWhitelist = nil
 	case "emissions.v7.GenesisState.topic_reputer_whitelist":
-		if x.TopicReputerWhitelist == nil {
-			x.TopicReputerWhitelist = []*TopicAndActorId{}
-		}
-		value := &_GenesisState_81_list{list: &x.TopicReputerWhitelist}
-		return protoreflect.ValueOfList(value)
+		x.TopicReputerWhitelist = nil
 	case "emissions.v7.GenesisState.topic_worker_whitelist_enabled":
-		if x.TopicWorkerWhitelistEnabled == nil {
-			x.TopicWorkerWhitelistEnabled = []uint64{}
-		}
-		value := &_GenesisState_82_list{list: &x.TopicWorkerWhitelistEnabled}
-		return protoreflect.ValueOfList(value)
+		x.TopicWorkerWhitelistEnabled = nil
 	case "emissions.v7.GenesisState.topic_reputer_whitelist_enabled":
-		if x.TopicReputerWhitelistEnabled == nil {
-			x.TopicReputerWhitelistEnabled = []uint64{}
-		}
-		value := &_GenesisState_83_list{list: &x.TopicReputerWhitelistEnabled}
-		return protoreflect.ValueOfList(value)
+		x.TopicReputerWhitelistEnabled = nil
 	case "emissions.v7.GenesisState.last_median_inferences":
-		if x.LastMedianInferences == nil {
-			x.LastMedianInferences = []*TopicIdAndDec{}
-		}
-		value := &_GenesisState_84_list{list: &x.LastMedianInferences}
-		return protoreflect.ValueOfList(value)
+		x.LastMedianInferences = nil
 	case "emissions.v7.GenesisState.mad_inferences":
-		if x.MadInferences == nil {
-			x.MadInferences = []*TopicIdAndDec{}
-		}
-		value := &_GenesisState_85_list{list: &x.MadInferences}
-		return protoreflect.ValueOfList(value)
+		x.MadInferences = nil
 	case "emissions.v7.GenesisState.initial_inferer_ema_score":
-		if x.InitialInfererEmaScore == nil {
-			x.InitialInfererEmaScore = []*TopicIdAndDec{}
-		}
-		value := &_GenesisState_86_list{list: &x.InitialInfererEmaScore}
-		return protoreflect.ValueOfList(value)
+		x.InitialInfererEmaScore = nil
 	case "emissions.v7.GenesisState.initial_forecaster_ema_score":
-		if x.InitialForecasterEmaScore == nil {
-			x.InitialForecasterEmaScore = []*TopicIdAndDec{}
-		}
-		value := &_GenesisState_87_list{list: &x.InitialForecasterEmaScore}
-		return protoreflect.ValueOfList(value)
+		x.InitialForecasterEmaScore = nil
 	case "emissions.v7.GenesisState.initial_reputer_ema_score":
-		if x.InitialReputerEmaScore == nil {
-			x.InitialReputerEmaScore = []*TopicIdAndDec{}
-		}
-		value := &_GenesisState_88_list{list: &x.InitialReputerEmaScore}
-		return protoreflect.ValueOfList(value)
+		x.InitialReputerEmaScore = nil
 	case "emissions.v7.GenesisState.global_worker_whitelist":
-		if x.GlobalWorkerWhitelist == nil {
-			x.GlobalWorkerWhitelist = []string{}
-		}
-		value := &_GenesisState_89_list{list: &x.GlobalWorkerWhitelist}
-		return protoreflect.ValueOfList(value)
+		x.GlobalWorkerWhitelist = nil
 	case "emissions.v7.GenesisState.global_reputer_whitelist":
-		if x.GlobalReputerWhitelist == nil {
-			x.GlobalReputerWhitelist = []string{}
-		}
-		value := &_GenesisState_90_list{list: &x.GlobalReputerWhitelist}
-		return protoreflect.ValueOfList(value)
+		x.GlobalReputerWhitelist = nil
 	case "emissions.v7.GenesisState.global_admin_whitelist":
-		if x.GlobalAdminWhitelist == nil {
-			x.GlobalAdminWhitelist = []string{}
-		}
-		value := &_GenesisState_91_list{list: &x.GlobalAdminWhitelist}
-		return protoreflect.ValueOfList(value)
-	case "emissions.v7.GenesisState.next_topic_id":
-		panic(fmt.Errorf("field next_topic_id of message emissions.v7.GenesisState is not mutable"))
-	case "emissions.v7.GenesisState.total_stake":
-		panic(fmt.Errorf("field total_stake of message emissions.v7.GenesisState is not mutable"))
-	case "emissions.v7.GenesisState.previous_percentage_reward_to_staked_reputers":
-		panic(fmt.Errorf("field previous_percentage_reward_to_staked_reputers of message emissions.v7.GenesisState is not mutable"))
-	case "emissions.v7.GenesisState.total_sum_previous_topic_weights":
-		panic(fmt.Errorf("field total_sum_previous_topic_weights of message emissions.v7.GenesisState is not mutable"))
-	case "emissions.v7.GenesisState.reward_current_block_emission":
-		panic(fmt.Errorf("field reward_current_block_emission of message emissions.v7.GenesisState is not mutable"))
+		x.GlobalAdminWhitelist = nil
+	case "emissions.v7.GenesisState.pending_topic_updates":
+		x.PendingTopicUpdates = nil
+	case "emissions.v7.GenesisState.archived_topics":
+		x.ArchivedTopics = nil
+	case "emissions.v7.GenesisState.archived_topics_to_prune":
+		x.ArchivedTopicsToPrune = nil
+	case "emissions.v7.GenesisState.topic_owners":
+		x.TopicOwners = nil
+	case "emissions.v7.GenesisState.pending_topic_owners":
+		x.PendingTopicOwners = nil
+	case "emissions.v7.GenesisState.topic_tags":
+		x.TopicTags = nil
+	case "emissions.v7.GenesisState.reputer_ground_truths":
+		x.ReputerGroundTruths = nil
+	case "emissions.v7.GenesisState.consensus_ground_truths":
+		x.ConsensusGroundTruths = nil
+	case "emissions.v7.GenesisState.worker_payload_commits":
+		x.WorkerPayloadCommits = nil
+	case "emissions.v7.GenesisState.actor_operators":
+		x.ActorOperators = nil
+	case "emissions.v7.GenesisState.worker_bonds":
+		x.WorkerBonds = nil
+	case "emissions.v7.GenesisState.worker_bond_removals":
+		x.WorkerBondRemovals = nil
+	case "emissions.v7.GenesisState.reputer_consensus_deviations":
+		x.ReputerConsensusDeviations = nil
+	case "emissions.v7.GenesisState.redelegations":
+		x.Redelegations = nil
+	case "emissions.v7.GenesisState.auto_compound_delegators":
+		x.AutoCompoundDelegators = nil
+	case "emissions.v7.GenesisState.reputer_commissions":
+		x.ReputerCommissions = nil
+	case "emissions.v7.GenesisState.stake_locks":
+		x.StakeLocks = nil
+	case "emissions.v7.GenesisState.reward_history":
+		x.RewardHistory = nil
+	case "emissions.v7.GenesisState.reward_escrows":
+		x.RewardEscrows = nil
+	case "emissions.v7.GenesisState.queued_reward_payouts":
+		x.QueuedRewardPayouts = nil
+	case "emissions.v7.GenesisState.next_reward_payout_id":
+		x.NextRewardPayoutId = uint64(0)
+	case "emissions.v7.GenesisState.archived_topic_delegate_removal_cursors":
+		x.ArchivedTopicDelegateRemovalCursors = nil
+	case "emissions.v7.GenesisState.dead_lettered_reward_payouts":
+		x.DeadLetteredRewardPayouts = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.GenesisState"))
@@ -6725,2417 +6700,6196 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 	}
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
 	case "emissions.v7.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	case "emissions.v7.GenesisState.core_team_addresses":
-		list := []string{}
-		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+		if len(x.CoreTeamAddresses) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.CoreTeamAddresses}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.next_topic_id":
-		return protoreflect.ValueOfUint64(uint64(0))
+		value := x.NextTopicId
+		return protoreflect.ValueOfUint64(value)
 	case "emissions.v7.GenesisState.topics":
-		list := []*TopicIdAndTopic{}
-		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+		if len(x.Topics) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.Topics}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.active_topics":
-		list := []uint64{}
-		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+		if len(x.ActiveTopics) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.ActiveTopics}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.rewardable_topics":
-		list := []uint64{}
-		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+		if len(x.RewardableTopics) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.RewardableTopics}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.topic_workers":
-		list := []*TopicAndActorId{}
-		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+		if len(x.TopicWorkers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.TopicWorkers}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.topic_reputers":
-		list := []*TopicAndActorId{}
-		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+		if len(x.TopicReputers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.TopicReputers}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.topic_reward_nonce":
-		list := []*TopicIdAndBlockHeight{}
-		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+		if len(x.TopicRewardNonce) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.TopicRewardNonce}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.inferer_scores_by_block":
-		list := []*TopicIdBlockHeightScores{}
-		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+		if len(x.InfererScoresByBlock) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.InfererScoresByBlock}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.forecaster_scores_by_block":
-		list := []*TopicIdBlockHeightScores{}
-		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+		if len(x.ForecasterScoresByBlock) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.ForecasterScoresByBlock}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.reputer_scores_by_block":
-		list := []*TopicIdBlockHeightScores{}
-		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+		if len(x.ReputerScoresByBlock) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.ReputerScoresByBlock}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.reputer_listening_coefficient":
-		list := []*TopicIdActorIdListeningCoefficient{}
-		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+		if len(x.ReputerListeningCoefficient) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.ReputerListeningCoefficient}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.previous_reputer_reward_fraction":
-		list := []*TopicIdActorIdDec{}
-		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+		if len(x.PreviousReputerRewardFraction) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.PreviousReputerRewardFraction}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.previous_inference_reward_fraction":
-		list := []*TopicIdActorIdDec{}
-		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+		if len(x.PreviousInferenceRewardFraction) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.PreviousInferenceRewardFraction}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.previous_forecast_reward_fraction":
-		list := []*TopicIdActorIdDec{}
-		return protoreflect.ValueOfList(&_GenesisState_19_list{list: &list})
+		if len(x.PreviousForecastRewardFraction) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_19_list{})
+		}
+		listValue := &_GenesisState_19_list{list: &x.PreviousForecastRewardFraction}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.previous_forecaster_score_ratio":
-		list := []*TopicIdAndDec{}
-		return protoreflect.ValueOfList(&_GenesisState_20_list{list: &list})
+		if len(x.PreviousForecasterScoreRatio) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_20_list{})
+		}
+		listValue := &_GenesisState_20_list{list: &x.PreviousForecasterScoreRatio}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.total_stake":
-		return protoreflect.ValueOfString("")
+		value := x.TotalStake
+		return protoreflect.ValueOfString(value)
 	case "emissions.v7.GenesisState.topic_stake":
-		list := []*TopicIdAndInt{}
-		return protoreflect.ValueOfList(&_GenesisState_22_list{list: &list})
+		if len(x.TopicStake) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_22_list{})
+		}
+		listValue := &_GenesisState_22_list{list: &x.TopicStake}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.stake_reputer_authority":
-		list := []*TopicIdActorIdInt{}
-		return protoreflect.ValueOfList(&_GenesisState_23_list{list: &list})
+		if len(x.StakeReputerAuthority) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_23_list{})
+		}
+		listValue := &_GenesisState_23_list{list: &x.StakeReputerAuthority}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.stake_sum_from_delegator":
-		list := []*TopicIdActorIdInt{}
-		return protoreflect.ValueOfList(&_GenesisState_24_list{list: &list})
+		if len(x.StakeSumFromDelegator) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_24_list{})
+		}
+		listValue := &_GenesisState_24_list{list: &x.StakeSumFromDelegator}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.delegated_stakes":
-		list := []*TopicIdDelegatorReputerDelegatorInfo{}
-		return protoreflect.ValueOfList(&_GenesisState_25_list{list: &list})
+		if len(x.DelegatedStakes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_25_list{})
+		}
+		listValue := &_GenesisState_25_list{list: &x.DelegatedStakes}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.stake_from_delegators_upon_reputer":
-		list := []*TopicIdActorIdInt{}
-		return protoreflect.ValueOfList(&_GenesisState_26_list{list: &list})
+		if len(x.StakeFromDelegatorsUponReputer) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_26_list{})
+		}
+		listValue := &_GenesisState_26_list{list: &x.StakeFromDelegatorsUponReputer}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.delegate_reward_per_share":
-		list := []*TopicIdActorIdDec{}
-		return protoreflect.ValueOfList(&_GenesisState_27_list{list: &list})
+		if len(x.DelegateRewardPerShare) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_27_list{})
+		}
+		listValue := &_GenesisState_27_list{list: &x.DelegateRewardPerShare}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.stake_removals_by_block":
-		list := []*BlockHeightTopicIdReputerStakeRemovalInfo{}
-		return protoreflect.ValueOfList(&_GenesisState_28_list{list: &list})
+		if len(x.StakeRemovalsByBlock) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_28_list{})
+		}
+		listValue := &_GenesisState_28_list{list: &x.StakeRemovalsByBlock}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.stake_removals_by_actor":
-		list := []*ActorIdTopicIdBlockHeight{}
-		return protoreflect.ValueOfList(&_GenesisState_29_list{list: &list})
+		if len(x.StakeRemovalsByActor) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_29_list{})
+		}
+		listValue := &_GenesisState_29_list{list: &x.StakeRemovalsByActor}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.delegate_stake_removals_by_block":
-		list := []*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{}
-		return protoreflect.ValueOfList(&_GenesisState_30_list{list: &list})
+		if len(x.DelegateStakeRemovalsByBlock) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_30_list{})
+		}
+		listValue := &_GenesisState_30_list{list: &x.DelegateStakeRemovalsByBlock}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.delegate_stake_removals_by_actor":
-		list := []*DelegatorReputerTopicIdBlockHeight{}
-		return protoreflect.ValueOfList(&_GenesisState_31_list{list: &list})
+		if len(x.DelegateStakeRemovalsByActor) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_31_list{})
+		}
+		listValue := &_GenesisState_31_list{list: &x.DelegateStakeRemovalsByActor}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.inferences":
-		list := []*TopicIdActorIdInference{}
-		return protoreflect.ValueOfList(&_GenesisState_32_list{list: &list})
+		if len(x.Inferences) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_32_list{})
+		}
+		listValue := &_GenesisState_32_list{list: &x.Inferences}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.forecasts":
-		list := []*TopicIdActorIdForecast{}
-		return protoreflect.ValueOfList(&_GenesisState_33_list{list: &list})
+		if len(x.Forecasts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_33_list{})
+		}
+		listValue := &_GenesisState_33_list{list: &x.Forecasts}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.workers":
-		list := []*LibP2PKeyAndOffchainNode{}
-		return protoreflect.ValueOfList(&_GenesisState_34_list{list: &list})
+		if len(x.Workers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_34_list{})
+		}
+		listValue := &_GenesisState_34_list{list: &x.Workers}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.reputers":
-		list := []*LibP2PKeyAndOffchainNode{}
-		return protoreflect.ValueOfList(&_GenesisState_35_list{list: &list})
+		if len(x.Reputers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_35_list{})
+		}
+		listValue := &_GenesisState_35_list{list: &x.Reputers}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.topic_fee_revenue":
-		list := []*TopicIdAndInt{}
-		return protoreflect.ValueOfList(&_GenesisState_36_list{list: &list})
+		if len(x.TopicFeeRevenue) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_36_list{})
+		}
+		listValue := &_GenesisState_36_list{list: &x.TopicFeeRevenue}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.previous_topic_weight":
-		list := []*TopicIdAndDec{}
-		return protoreflect.ValueOfList(&_GenesisState_37_list{list: &list})
+		if len(x.PreviousTopicWeight) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_37_list{})
+		}
+		listValue := &_GenesisState_37_list{list: &x.PreviousTopicWeight}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.all_inferences":
-		list := []*TopicIdBlockHeightInferences{}
-		return protoreflect.ValueOfList(&_GenesisState_38_list{list: &list})
+		if len(x.AllInferences) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_38_list{})
+		}
+		listValue := &_GenesisState_38_list{list: &x.AllInferences}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.all_forecasts":
-		list := []*TopicIdBlockHeightForecasts{}
-		return protoreflect.ValueOfList(&_GenesisState_39_list{list: &list})
+		if len(x.AllForecasts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_39_list{})
+		}
+		listValue := &_GenesisState_39_list{list: &x.AllForecasts}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.all_loss_bundles":
-		list := []*TopicIdBlockHeightReputerValueBundles{}
-		return protoreflect.ValueOfList(&_GenesisState_40_list{list: &list})
+		if len(x.AllLossBundles) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_40_list{})
+		}
+		listValue := &_GenesisState_40_list{list: &x.AllLossBundles}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.network_loss_bundles":
-		list := []*TopicIdBlockHeightValueBundles{}
-		return protoreflect.ValueOfList(&_GenesisState_41_list{list: &list})
+		if len(x.NetworkLossBundles) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_41_list{})
+		}
+		listValue := &_GenesisState_41_list{list: &x.NetworkLossBundles}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.previous_percentage_reward_to_staked_reputers":
-		return protoreflect.ValueOfString("")
+		value := x.PreviousPercentageRewardToStakedReputers
+		return protoreflect.ValueOfString(value)
 	case "emissions.v7.GenesisState.unfulfilled_worker_nonces":
-		list := []*TopicIdAndNonces{}
-		return protoreflect.ValueOfList(&_GenesisState_43_list{list: &list})
+		if len(x.UnfulfilledWorkerNonces) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_43_list{})
+		}
+		listValue := &_GenesisState_43_list{list: &x.UnfulfilledWorkerNonces}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.unfulfilled_reputer_nonces":
-		list := []*TopicIdAndReputerRequestNonces{}
-		return protoreflect.ValueOfList(&_GenesisState_44_list{list: &list})
+		if len(x.UnfulfilledReputerNonces) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_44_list{})
+		}
+		listValue := &_GenesisState_44_list{list: &x.UnfulfilledReputerNonces}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.latest_inferer_network_regrets":
-		list := []*TopicIdActorIdTimeStampedValue{}
-		return protoreflect.ValueOfList(&_GenesisState_45_list{list: &list})
+		if len(x.LatestInfererNetworkRegrets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_45_list{})
+		}
+		listValue := &_GenesisState_45_list{list: &x.LatestInfererNetworkRegrets}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.latest_forecaster_network_regrets":
-		list := []*TopicIdActorIdTimeStampedValue{}
-		return protoreflect.ValueOfList(&_GenesisState_46_list{list: &list})
+		if len(x.LatestForecasterNetworkRegrets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_46_list{})
+		}
+		listValue := &_GenesisState_46_list{list: &x.LatestForecasterNetworkRegrets}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.latest_one_in_forecaster_network_regrets":
-		list := []*TopicIdActorIdActorIdTimeStampedValue{}
-		return protoreflect.ValueOfList(&_GenesisState_47_list{list: &list})
+		if len(x.LatestOneInForecasterNetworkRegrets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_47_list{})
+		}
+		listValue := &_GenesisState_47_list{list: &x.LatestOneInForecasterNetworkRegrets}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.latest_naive_inferer_network_regrets":
-		list := []*TopicIdActorIdTimeStampedValue{}
-		return protoreflect.ValueOfList(&_GenesisState_48_list{list: &list})
+		if len(x.LatestNaiveInfererNetworkRegrets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_48_list{})
+		}
+		listValue := &_GenesisState_48_list{list: &x.LatestNaiveInfererNetworkRegrets}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.latest_one_out_inferer_inferer_network_regrets":
-		list := []*TopicIdActorIdActorIdTimeStampedValue{}
-		return protoreflect.ValueOfList(&_GenesisState_49_list{list: &list})
+		if len(x.LatestOneOutInfererInfererNetworkRegrets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_49_list{})
+		}
+		listValue := &_GenesisState_49_list{list: &x.LatestOneOutInfererInfererNetworkRegrets}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.latest_one_out_inferer_forecaster_network_regrets":
-		list := []*TopicIdActorIdActorIdTimeStampedValue{}
-		return protoreflect.ValueOfList(&_GenesisState_50_list{list: &list})
+		if len(x.LatestOneOutInfererForecasterNetworkRegrets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_50_list{})
+		}
+		listValue := &_GenesisState_50_list{list: &x.LatestOneOutInfererForecasterNetworkRegrets}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.latest_one_out_forecaster_inferer_network_regrets":
-		list := []*TopicIdActorIdActorIdTimeStampedValue{}
-		return protoreflect.ValueOfList(&_GenesisState_51_list{list: &list})
+		if len(x.LatestOneOutForecasterInfererNetworkRegrets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_51_list{})
+		}
+		listValue := &_GenesisState_51_list{list: &x.LatestOneOutForecasterInfererNetworkRegrets}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.latest_one_out_forecaster_forecaster_network_regrets":
-		list := []*TopicIdActorIdActorIdTimeStampedValue{}
-		return protoreflect.ValueOfList(&_GenesisState_52_list{list: &list})
+		if len(x.LatestOneOutForecasterForecasterNetworkRegrets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_52_list{})
+		}
+		listValue := &_GenesisState_52_list{list: &x.LatestOneOutForecasterForecasterNetworkRegrets}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.topic_last_worker_commit":
-		list := []*TopicIdTimestampedActorNonce{}
-		return protoreflect.ValueOfList(&_GenesisState_53_list{list: &list})
+		if len(x.TopicLastWorkerCommit) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_53_list{})
+		}
+		listValue := &_GenesisState_53_list{list: &x.TopicLastWorkerCommit}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.topic_last_reputer_commit":
-		list := []*TopicIdTimestampedActorNonce{}
-		return protoreflect.ValueOfList(&_GenesisState_54_list{list: &list})
+		if len(x.TopicLastReputerCommit) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_54_list{})
+		}
+		listValue := &_GenesisState_54_list{list: &x.TopicLastReputerCommit}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.open_worker_windows":
-		list := []*BlockHeightAndTopicIds{}
-		return protoreflect.ValueOfList(&_GenesisState_55_list{list: &list})
+		if len(x.OpenWorkerWindows) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_55_list{})
+		}
+		listValue := &_GenesisState_55_list{list: &x.OpenWorkerWindows}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.last_drip_block":
-		list := []*TopicIdAndBlockHeight{}
-		return protoreflect.ValueOfList(&_GenesisState_56_list{list: &list})
+		if len(x.LastDripBlock) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_56_list{})
+		}
+		listValue := &_GenesisState_56_list{list: &x.LastDripBlock}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.topic_to_next_possible_churning_block":
-		list := []*TopicIdAndBlockHeight{}
-		return protoreflect.ValueOfList(&_GenesisState_57_list{list: &list})
+		if len(x.TopicToNextPossibleChurningBlock) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_57_list{})
+		}
+		listValue := &_GenesisState_57_list{list: &x.TopicToNextPossibleChurningBlock}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.block_to_active_topics":
-		list := []*BlockHeightTopicIds{}
-		return protoreflect.ValueOfList(&_GenesisState_58_list{list: &list})
+		if len(x.BlockToActiveTopics) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_58_list{})
+		}
+		listValue := &_GenesisState_58_list{list: &x.BlockToActiveTopics}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.block_to_lowest_active_topic_weight":
-		list := []*BlockHeightTopicIdWeightPair{}
-		return protoreflect.ValueOfList(&_GenesisState_59_list{list: &list})
+		if len(x.BlockToLowestActiveTopicWeight) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_59_list{})
+		}
+		listValue := &_GenesisState_59_list{list: &x.BlockToLowestActiveTopicWeight}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.inferer_score_emas":
-		list := []*TopicIdActorIdScore{}
-		return protoreflect.ValueOfList(&_GenesisState_60_list{list: &list})
+		if len(x.InfererScoreEmas) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_60_list{})
+		}
+		listValue := &_GenesisState_60_list{list: &x.InfererScoreEmas}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.forecaster_score_emas":
-		list := []*TopicIdActorIdScore{}
-		return protoreflect.ValueOfList(&_GenesisState_61_list{list: &list})
+		if len(x.ForecasterScoreEmas) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_61_list{})
+		}
+		listValue := &_GenesisState_61_list{list: &x.ForecasterScoreEmas}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.reputer_score_emas":
-		list := []*TopicIdActorIdScore{}
-		return protoreflect.ValueOfList(&_GenesisState_62_list{list: &list})
+		if len(x.ReputerScoreEmas) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_62_list{})
+		}
+		listValue := &_GenesisState_62_list{list: &x.ReputerScoreEmas}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.previous_topic_quantile_inferer_score_ema":
-		list := []*TopicIdAndDec{}
-		return protoreflect.ValueOfList(&_GenesisState_63_list{list: &list})
+		if len(x.PreviousTopicQuantileInfererScoreEma) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_63_list{})
+		}
+		listValue := &_GenesisState_63_list{list: &x.PreviousTopicQuantileInfererScoreEma}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.previous_topic_quantile_forecaster_score_ema":
-		list := []*TopicIdAndDec{}
-		return protoreflect.ValueOfList(&_GenesisState_64_list{list: &list})
-	case "emissions.v7.GenesisState.previous_topic_quantile_reputer_score_ema":
-		list := []*TopicIdAndDec{}
-		return protoreflect.ValueOfList(&_GenesisState_65_list{list: &list})
+		if len(x.PreviousTopicQuantileForecasterScoreEma) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_64_list{})
+		}
+		listValue := &_GenesisState_64_list{list: &x.PreviousTopicQuantileForecasterScoreEma}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.previous_topic_quantile_reputer_score_ema":
+		if len(x.PreviousTopicQuantileReputerScoreEma) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_65_list{})
+		}
+		listValue := &_GenesisState_65_list{list: &x.PreviousTopicQuantileReputerScoreEma}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.count_inferer_inclusions_in_topic_active_set":
-		list := []*TopicIdActorIdUint64{}
-		return protoreflect.ValueOfList(&_GenesisState_66_list{list: &list})
+		if len(x.CountInfererInclusionsInTopicActiveSet) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_66_list{})
+		}
+		listValue := &_GenesisState_66_list{list: &x.CountInfererInclusionsInTopicActiveSet}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.count_forecaster_inclusions_in_topic_active_set":
-		list := []*TopicIdActorIdUint64{}
-		return protoreflect.ValueOfList(&_GenesisState_67_list{list: &list})
+		if len(x.CountForecasterInclusionsInTopicActiveSet) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_67_list{})
+		}
+		listValue := &_GenesisState_67_list{list: &x.CountForecasterInclusionsInTopicActiveSet}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.active_inferers":
-		list := []*TopicAndActorId{}
-		return protoreflect.ValueOfList(&_GenesisState_68_list{list: &list})
+		if len(x.ActiveInferers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_68_list{})
+		}
+		listValue := &_GenesisState_68_list{list: &x.ActiveInferers}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.active_forecasters":
-		list := []*TopicAndActorId{}
-		return protoreflect.ValueOfList(&_GenesisState_69_list{list: &list})
+		if len(x.ActiveForecasters) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_69_list{})
+		}
+		listValue := &_GenesisState_69_list{list: &x.ActiveForecasters}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.lowest_inferer_score_ema":
-		list := []*TopicIdActorIdScore{}
-		return protoreflect.ValueOfList(&_GenesisState_70_list{list: &list})
+		if len(x.LowestInfererScoreEma) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_70_list{})
+		}
+		listValue := &_GenesisState_70_list{list: &x.LowestInfererScoreEma}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.lowest_forecaster_score_ema":
-		list := []*TopicIdActorIdScore{}
-		return protoreflect.ValueOfList(&_GenesisState_71_list{list: &list})
+		if len(x.LowestForecasterScoreEma) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_71_list{})
+		}
+		listValue := &_GenesisState_71_list{list: &x.LowestForecasterScoreEma}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.active_reputers":
-		list := []*TopicAndActorId{}
-		return protoreflect.ValueOfList(&_GenesisState_72_list{list: &list})
+		if len(x.ActiveReputers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_72_list{})
+		}
+		listValue := &_GenesisState_72_list{list: &x.ActiveReputers}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.lowest_reputer_score_ema":
-		list := []*TopicIdActorIdScore{}
-		return protoreflect.ValueOfList(&_GenesisState_73_list{list: &list})
+		if len(x.LowestReputerScoreEma) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_73_list{})
+		}
+		listValue := &_GenesisState_73_list{list: &x.LowestReputerScoreEma}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.loss_bundles":
-		list := []*TopicIdReputerReputerValueBundle{}
-		return protoreflect.ValueOfList(&_GenesisState_74_list{list: &list})
+		if len(x.LossBundles) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_74_list{})
+		}
+		listValue := &_GenesisState_74_list{list: &x.LossBundles}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.total_sum_previous_topic_weights":
-		return protoreflect.ValueOfString("")
+		value := x.TotalSumPreviousTopicWeights
+		return protoreflect.ValueOfString(value)
 	case "emissions.v7.GenesisState.reward_current_block_emission":
-		return protoreflect.ValueOfString("")
+		value := x.RewardCurrentBlockEmission
+		return protoreflect.ValueOfString(value)
 	case "emissions.v7.GenesisState.whitelist_admins":
-		list := []string{}
-		return protoreflect.ValueOfList(&_GenesisState_77_list{list: &list})
+		if len(x.WhitelistAdmins) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_77_list{})
+		}
+		listValue := &_GenesisState_77_list{list: &x.WhitelistAdmins}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.global_whitelist":
-		list := []string{}
-		return protoreflect.ValueOfList(&_GenesisState_78_list{list: &list})
+		if len(x.GlobalWhitelist) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_78_list{})
+		}
+		listValue := &_GenesisState_78_list{list: &x.GlobalWhitelist}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.topic_creator_whitelist":
-		list := []string{}
-		return protoreflect.ValueOfList(&_GenesisState_79_list{list: &list})
+		if len(x.TopicCreatorWhitelist) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_79_list{})
+		}
+		listValue := &_GenesisState_79_list{list: &x.TopicCreatorWhitelist}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.topic_worker_whitelist":
-		list := []*TopicAndActorId{}
-		return protoreflect.ValueOfList(&_GenesisState_80_list{list: &list})
+		if len(x.TopicWorkerWhitelist) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_80_list{})
+		}
+		listValue := &_GenesisState_80_list{list: &x.TopicWorkerWhitelist}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.topic_reputer_whitelist":
-		list := []*TopicAndActorId{}
-		return protoreflect.ValueOfList(&_GenesisState_81_list{list: &list})
+		if len(x.TopicReputerWhitelist) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_81_list{})
+		}
+		listValue := &_GenesisState_81_list{list: &x.TopicReputerWhitelist}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.topic_worker_whitelist_enabled":
-		list := []uint64{}
-		return protoreflect.ValueOfList(&_GenesisState_82_list{list: &list})
+		if len(x.TopicWorkerWhitelistEnabled) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_82_list{})
+		}
+		listValue := &_GenesisState_82_list{list: &x.TopicWorkerWhitelistEnabled}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.topic_reputer_whitelist_enabled":
-		list := []uint64{}
-		return protoreflect.ValueOfList(&_GenesisState_83_list{list: &list})
+		if len(x.TopicReputerWhitelistEnabled) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_83_list{})
+		}
+		listValue := &_GenesisState_83_list{list: &x.TopicReputerWhitelistEnabled}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.last_median_inferences":
-		list := []*TopicIdAndDec{}
-		return protoreflect.ValueOfList(&_GenesisState_84_list{list: &list})
+		if len(x.LastMedianInferences) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_84_list{})
+		}
+		listValue := &_GenesisState_84_list{list: &x.LastMedianInferences}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.mad_inferences":
-		list := []*TopicIdAndDec{}
-		return protoreflect.ValueOfList(&_GenesisState_85_list{list: &list})
+		if len(x.MadInferences) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_85_list{})
+		}
+		listValue := &_GenesisState_85_list{list: &x.MadInferences}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.initial_inferer_ema_score":
-		list := []*TopicIdAndDec{}
-		return protoreflect.ValueOfList(&_GenesisState_86_list{list: &list})
+		if len(x.InitialInfererEmaScore) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_86_list{})
+		}
+		listValue := &_GenesisState_86_list{list: &x.InitialInfererEmaScore}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.initial_forecaster_ema_score":
-		list := []*TopicIdAndDec{}
-		return protoreflect.ValueOfList(&_GenesisState_87_list{list: &list})
+		if len(x.InitialForecasterEmaScore) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_87_list{})
+		}
+		listValue := &_GenesisState_87_list{list: &x.InitialForecasterEmaScore}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.initial_reputer_ema_score":
-		list := []*TopicIdAndDec{}
-		return protoreflect.ValueOfList(&_GenesisState_88_list{list: &list})
+		if len(x.InitialReputerEmaScore) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_88_list{})
+		}
+		listValue := &_GenesisState_88_list{list: &x.InitialReputerEmaScore}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.global_worker_whitelist":
-		list := []string{}
-		return protoreflect.ValueOfList(&_GenesisState_89_list{list: &list})
+		if len(x.GlobalWorkerWhitelist) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_89_list{})
+		}
+		listValue := &_GenesisState_89_list{list: &x.GlobalWorkerWhitelist}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.global_reputer_whitelist":
-		list := []string{}
-		return protoreflect.ValueOfList(&_GenesisState_90_list{list: &list})
+		if len(x.GlobalReputerWhitelist) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_90_list{})
+		}
+		listValue := &_GenesisState_90_list{list: &x.GlobalReputerWhitelist}
+		return protoreflect.ValueOfList(listValue)
 	case "emissions.v7.GenesisState.global_admin_whitelist":
-		list := []string{}
-		return protoreflect.ValueOfList(&_GenesisState_91_list{list: &list})
+		if len(x.GlobalAdminWhitelist) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_91_list{})
+		}
+		listValue := &_GenesisState_91_list{list: &x.GlobalAdminWhitelist}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.pending_topic_updates":
+		if len(x.PendingTopicUpdates) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_92_list{})
+		}
+		listValue := &_GenesisState_92_list{list: &x.PendingTopicUpdates}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.archived_topics":
+		if len(x.ArchivedTopics) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_93_list{})
+		}
+		listValue := &_GenesisState_93_list{list: &x.ArchivedTopics}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.archived_topics_to_prune":
+		if len(x.ArchivedTopicsToPrune) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_94_list{})
+		}
+		listValue := &_GenesisState_94_list{list: &x.ArchivedTopicsToPrune}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.topic_owners":
+		if len(x.TopicOwners) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_95_list{})
+		}
+		listValue := &_GenesisState_95_list{list: &x.TopicOwners}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.pending_topic_owners":
+		if len(x.PendingTopicOwners) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_96_list{})
+		}
+		listValue := &_GenesisState_96_list{list: &x.PendingTopicOwners}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.topic_tags":
+		if len(x.TopicTags) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_97_list{})
+		}
+		listValue := &_GenesisState_97_list{list: &x.TopicTags}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.reputer_ground_truths":
+		if len(x.ReputerGroundTruths) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_98_list{})
+		}
+		listValue := &_GenesisState_98_list{list: &x.ReputerGroundTruths}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.consensus_ground_truths":
+		if len(x.ConsensusGroundTruths) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_99_list{})
+		}
+		listValue := &_GenesisState_99_list{list: &x.ConsensusGroundTruths}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.worker_payload_commits":
+		if len(x.WorkerPayloadCommits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_100_list{})
+		}
+		listValue := &_GenesisState_100_list{list: &x.WorkerPayloadCommits}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.actor_operators":
+		if len(x.ActorOperators) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_101_list{})
+		}
+		listValue := &_GenesisState_101_list{list: &x.ActorOperators}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.worker_bonds":
+		if len(x.WorkerBonds) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_102_list{})
+		}
+		listValue := &_GenesisState_102_list{list: &x.WorkerBonds}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.worker_bond_removals":
+		if len(x.WorkerBondRemovals) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_103_list{})
+		}
+		listValue := &_GenesisState_103_list{list: &x.WorkerBondRemovals}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.reputer_consensus_deviations":
+		if len(x.ReputerConsensusDeviations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_104_list{})
+		}
+		listValue := &_GenesisState_104_list{list: &x.ReputerConsensusDeviations}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.redelegations":
+		if len(x.Redelegations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_105_list{})
+		}
+		listValue := &_GenesisState_105_list{list: &x.Redelegations}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.auto_compound_delegators":
+		if len(x.AutoCompoundDelegators) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_106_list{})
+		}
+		listValue := &_GenesisState_106_list{list: &x.AutoCompoundDelegators}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.reputer_commissions":
+		if len(x.ReputerCommissions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_107_list{})
+		}
+		listValue := &_GenesisState_107_list{list: &x.ReputerCommissions}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.stake_locks":
+		if len(x.StakeLocks) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_108_list{})
+		}
+		listValue := &_GenesisState_108_list{list: &x.StakeLocks}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.reward_history":
+		if len(x.RewardHistory) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_109_list{})
+		}
+		listValue := &_GenesisState_109_list{list: &x.RewardHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.reward_escrows":
+		if len(x.RewardEscrows) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_110_list{})
+		}
+		listValue := &_GenesisState_110_list{list: &x.RewardEscrows}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.queued_reward_payouts":
+		if len(x.QueuedRewardPayouts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_111_list{})
+		}
+		listValue := &_GenesisState_111_list{list: &x.QueuedRewardPayouts}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.next_reward_payout_id":
+		value := x.NextRewardPayoutId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v7.GenesisState.archived_topic_delegate_removal_cursors":
+		if len(x.ArchivedTopicDelegateRemovalCursors) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_113_list{})
+		}
+		listValue := &_GenesisState_113_list{list: &x.ArchivedTopicDelegateRemovalCursors}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.GenesisState.dead_lettered_reward_payouts":
+		if len(x.DeadLetteredRewardPayouts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_114_list{})
+		}
+		listValue := &_GenesisState_114_list{list: &x.DeadLetteredRewardPayouts}
+		return protoreflect.ValueOfList(listValue)
 	default:
-		if fd.IsExtension() {
+		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.GenesisState"))
 		}
-		panic(fmt.Errorf("message emissions.v7.GenesisState does not contain field %s", fd.FullName()))
-	}
-}
-
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v7.GenesisState", d.FullName()))
+		panic(fmt.Errorf("message emissions.v7.GenesisState does not contain field %s", descriptor.FullName()))
 	}
-	panic("unreachable")
-}
-
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
-}
-
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
+// Set stores the value for a field.
 //
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
-}
-
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
 //
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.CoreTeamAddresses) > 0 {
-			for _, s := range x.CoreTeamAddresses {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.NextTopicId != 0 {
-			n += 1 + runtime.Sov(uint64(x.NextTopicId))
-		}
-		if len(x.Topics) > 0 {
-			for _, e := range x.Topics {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.ActiveTopics) > 0 {
-			l = 0
-			for _, e := range x.ActiveTopics {
-				l += runtime.Sov(uint64(e))
-			}
-			n += 1 + runtime.Sov(uint64(l)) + l
-		}
-		if len(x.RewardableTopics) > 0 {
-			l = 0
-			for _, e := range x.RewardableTopics {
-				l += runtime.Sov(uint64(e))
-			}
-			n += 1 + runtime.Sov(uint64(l)) + l
-		}
-		if len(x.TopicWorkers) > 0 {
-			for _, e := range x.TopicWorkers {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.TopicReputers) > 0 {
-			for _, e := range x.TopicReputers {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.TopicRewardNonce) > 0 {
-			for _, e := range x.TopicRewardNonce {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.InfererScoresByBlock) > 0 {
-			for _, e := range x.InfererScoresByBlock {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.ForecasterScoresByBlock) > 0 {
-			for _, e := range x.ForecasterScoresByBlock {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.ReputerScoresByBlock) > 0 {
-			for _, e := range x.ReputerScoresByBlock {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.ReputerListeningCoefficient) > 0 {
-			for _, e := range x.ReputerListeningCoefficient {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.PreviousReputerRewardFraction) > 0 {
-			for _, e := range x.PreviousReputerRewardFraction {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.PreviousInferenceRewardFraction) > 0 {
-			for _, e := range x.PreviousInferenceRewardFraction {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.PreviousForecastRewardFraction) > 0 {
-			for _, e := range x.PreviousForecastRewardFraction {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.PreviousForecasterScoreRatio) > 0 {
-			for _, e := range x.PreviousForecasterScoreRatio {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.TotalStake)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.TopicStake) > 0 {
-			for _, e := range x.TopicStake {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.StakeReputerAuthority) > 0 {
-			for _, e := range x.StakeReputerAuthority {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.StakeSumFromDelegator) > 0 {
-			for _, e := range x.StakeSumFromDelegator {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.DelegatedStakes) > 0 {
-			for _, e := range x.DelegatedStakes {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.StakeFromDelegatorsUponReputer) > 0 {
-			for _, e := range x.StakeFromDelegatorsUponReputer {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.DelegateRewardPerShare) > 0 {
-			for _, e := range x.DelegateRewardPerShare {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.StakeRemovalsByBlock) > 0 {
-			for _, e := range x.StakeRemovalsByBlock {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.StakeRemovalsByActor) > 0 {
-			for _, e := range x.StakeRemovalsByActor {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.DelegateStakeRemovalsByBlock) > 0 {
-			for _, e := range x.DelegateStakeRemovalsByBlock {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.DelegateStakeRemovalsByActor) > 0 {
-			for _, e := range x.DelegateStakeRemovalsByActor {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.Inferences) > 0 {
-			for _, e := range x.Inferences {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.Forecasts) > 0 {
-			for _, e := range x.Forecasts {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.Workers) > 0 {
-			for _, e := range x.Workers {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.Reputers) > 0 {
-			for _, e := range x.Reputers {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.TopicFeeRevenue) > 0 {
-			for _, e := range x.TopicFeeRevenue {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.PreviousTopicWeight) > 0 {
-			for _, e := range x.PreviousTopicWeight {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.AllInferences) > 0 {
-			for _, e := range x.AllInferences {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.AllForecasts) > 0 {
-			for _, e := range x.AllForecasts {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v7.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "emissions.v7.GenesisState.core_team_addresses":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.CoreTeamAddresses = *clv.list
+	case "emissions.v7.GenesisState.next_topic_id":
+		x.NextTopicId = value.Uint()
+	case "emissions.v7.GenesisState.topics":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.Topics = *clv.list
+	case "emissions.v7.GenesisState.active_topics":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.ActiveTopics = *clv.list
+	case "emissions.v7.GenesisState.rewardable_topics":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.RewardableTopics = *clv.list
+	case "emissions.v7.GenesisState.topic_workers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.TopicWorkers = *clv.list
+	case "emissions.v7.GenesisState.topic_reputers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.TopicReputers = *clv.list
+	case "emissions.v7.GenesisState.topic_reward_nonce":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.TopicRewardNonce = *clv.list
+	case "emissions.v7.GenesisState.inferer_scores_by_block":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.InfererScoresByBlock = *clv.list
+	case "emissions.v7.GenesisState.forecaster_scores_by_block":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.ForecasterScoresByBlock = *clv.list
+	case "emissions.v7.GenesisState.reputer_scores_by_block":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.ReputerScoresByBlock = *clv.list
+	case "emissions.v7.GenesisState.reputer_listening_coefficient":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.ReputerListeningCoefficient = *clv.list
+	case "emissions.v7.GenesisState.previous_reputer_reward_fraction":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.PreviousReputerRewardFraction = *clv.list
+	case "emissions.v7.GenesisState.previous_inference_reward_fraction":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.PreviousInferenceRewardFraction = *clv.list
+	case "emissions.v7.GenesisState.previous_forecast_reward_fraction":
+		lv := value.List()
+		clv := lv.(*_GenesisState_19_list)
+		x.PreviousForecastRewardFraction = *clv.list
+	case "emissions.v7.GenesisState.previous_forecaster_score_ratio":
+		lv := value.List()
+		clv := lv.(*_GenesisState_20_list)
+		x.PreviousForecasterScoreRatio = *clv.list
+	case "emissions.v7.GenesisState.total_stake":
+		x.TotalStake = value.Interface().(string)
+	case "emissions.v7.GenesisState.topic_stake":
+		lv := value.List()
+		clv := lv.(*_GenesisState_22_list)
+		x.TopicStake = *clv.list
+	case "emissions.v7.GenesisState.stake_reputer_authority":
+		lv := value.List()
+		clv := lv.(*_GenesisState_23_list)
+		x.StakeReputerAuthority = *clv.list
+	case "emissions.v7.GenesisState.stake_sum_from_delegator":
+		lv := value.List()
+		clv := lv.(*_GenesisState_24_list)
+		x.StakeSumFromDelegator = *clv.list
+	case "emissions.v7.GenesisState.delegated_stakes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_25_list)
+		x.DelegatedStakes = *clv.list
+	case "emissions.v7.GenesisState.stake_from_delegators_upon_reputer":
+		lv := value.List()
+		clv := lv.(*_GenesisState_26_list)
+		x.StakeFromDelegatorsUponReputer = *clv.list
+	case "emissions.v7.GenesisState.delegate_reward_per_share":
+		lv := value.List()
+		clv := lv.(*_GenesisState_27_list)
+		x.DelegateRewardPerShare = *clv.list
+	case "emissions.v7.GenesisState.stake_removals_by_block":
+		lv := value.List()
+		clv := lv.(*_GenesisState_28_list)
+		x.StakeRemovalsByBlock = *clv.list
+	case "emissions.v7.GenesisState.stake_removals_by_actor":
+		lv := value.List()
+		clv := lv.(*_GenesisState_29_list)
+		x.StakeRemovalsByActor = *clv.list
+	case "emissions.v7.GenesisState.delegate_stake_removals_by_block":
+		lv := value.List()
+		clv := lv.(*_GenesisState_30_list)
+		x.DelegateStakeRemovalsByBlock = *clv.list
+	case "emissions.v7.GenesisState.delegate_stake_removals_by_actor":
+		lv := value.List()
+		clv := lv.(*_GenesisState_31_list)
+		x.DelegateStakeRemovalsByActor = *clv.list
+	case "emissions.v7.GenesisState.inferences":
+		lv := value.List()
+		clv := lv.(*_GenesisState_32_list)
+		x.Inferences = *clv.list
+	case "emissions.v7.GenesisState.forecasts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_33_list)
+		x.Forecasts = *clv.list
+	case "emissions.v7.GenesisState.workers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_34_list)
+		x.Workers = *clv.list
+	case "emissions.v7.GenesisState.reputers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_35_list)
+		x.Reputers = *clv.list
+	case "emissions.v7.GenesisState.topic_fee_revenue":
+		lv := value.List()
+		clv := lv.(*_GenesisState_36_list)
+		x.TopicFeeRevenue = *clv.list
+	case "emissions.v7.GenesisState.previous_topic_weight":
+		lv := value.List()
+		clv := lv.(*_GenesisState_37_list)
+		x.PreviousTopicWeight = *clv.list
+	case "emissions.v7.GenesisState.all_inferences":
+		lv := value.List()
+		clv := lv.(*_GenesisState_38_list)
+		x.AllInferences = *clv.list
+	case "emissions.v7.GenesisState.all_forecasts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_39_list)
+		x.AllForecasts = *clv.list
+	case "emissions.v7.GenesisState.all_loss_bundles":
+		lv := value.List()
+		clv := lv.(*_GenesisState_40_list)
+		x.AllLossBundles = *clv.list
+	case "emissions.v7.GenesisState.network_loss_bundles":
+		lv := value.List()
+		clv := lv.(*_GenesisState_41_list)
+		x.NetworkLossBundles = *clv.list
+	case "emissions.v7.GenesisState.previous_percentage_reward_to_staked_reputers":
+		x.PreviousPercentageRewardToStakedReputers = value.Interface().(string)
+	case "emissions.v7.GenesisState.unfulfilled_worker_nonces":
+		lv := value.List()
+		clv := lv.(*_GenesisState_43_list)
+		x.UnfulfilledWorkerNonces = *clv.list
+	case "emissions.v7.GenesisState.unfulfilled_reputer_nonces":
+		lv := value.List()
+		clv := lv.(*_GenesisState_44_list)
+		x.UnfulfilledReputerNonces = *clv.list
+	case "emissions.v7.GenesisState.latest_inferer_network_regrets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_45_list)
+		x.LatestInfererNetworkRegrets = *clv.list
+	case "emissions.v7.GenesisState.latest_forecaster_network_regrets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_46_list)
+		x.LatestForecasterNetworkRegrets = *clv.list
+	case "emissions.v7.GenesisState.latest_one_in_forecaster_network_regrets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_47_list)
+		x.LatestOneInForecasterNetworkRegrets = *clv.list
+	case "emissions.v7.GenesisState.latest_naive_inferer_network_regrets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_48_list)
+		x.LatestNaiveInfererNetworkRegrets = *clv.list
+	case "emissions.v7.GenesisState.latest_one_out_inferer_inferer_network_regrets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_49_list)
+		x.LatestOneOutInfererInfererNetworkRegrets = *clv.list
+	case "emissions.v7.GenesisState.latest_one_out_inferer_forecaster_network_regrets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_50_list)
+		x.LatestOneOutInfererForecasterNetworkRegrets = *clv.list
+	case "emissions.v7.GenesisState.latest_one_out_forecaster_inferer_network_regrets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_51_list)
+		x.LatestOneOutForecasterInfererNetworkRegrets = *clv.list
+	case "emissions.v7.GenesisState.latest_one_out_forecaster_forecaster_network_regrets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_52_list)
+		x.LatestOneOutForecasterForecasterNetworkRegrets = *clv.list
+	case "emissions.v7.GenesisState.topic_last_worker_commit":
+		lv := value.List()
+		clv := lv.(*_GenesisState_53_list)
+		x.TopicLastWorkerCommit = *clv.list
+	case "emissions.v7.GenesisState.topic_last_reputer_commit":
+		lv := value.List()
+		clv := lv.(*_GenesisState_54_list)
+		x.TopicLastReputerCommit = *clv.list
+	case "emissions.v7.GenesisState.open_worker_windows":
+		lv := value.List()
+		clv := lv.(*_GenesisState_55_list)
+		x.OpenWorkerWindows = *clv.list
+	case "emissions.v7.GenesisState.last_drip_block":
+		lv := value.List()
+		clv := lv.(*_GenesisState_56_list)
+		x.LastDripBlock = *clv.list
+	case "emissions.v7.GenesisState.topic_to_next_possible_churning_block":
+		lv := value.List()
+		clv := lv.(*_GenesisState_57_list)
+		x.TopicToNextPossibleChurningBlock = *clv.list
+	case "emissions.v7.GenesisState.block_to_active_topics":
+		lv := value.List()
+		clv := lv.(*_GenesisState_58_list)
+		x.BlockToActiveTopics = *clv.list
+	case "emissions.v7.GenesisState.block_to_lowest_active_topic_weight":
+		lv := value.List()
+		clv := lv.(*_GenesisState_59_list)
+		x.BlockToLowestActiveTopicWeight = *clv.list
+	case "emissions.v7.GenesisState.inferer_score_emas":
+		lv := value.List()
+		clv := lv.(*_GenesisState_60_list)
+		x.InfererScoreEmas = *clv.list
+	case "emissions.v7.GenesisState.forecaster_score_emas":
+		lv := value.List()
+		clv := lv.(*_GenesisState_61_list)
+		x.ForecasterScoreEmas = *clv.list
+	case "emissions.v7.GenesisState.reputer_score_emas":
+		lv := value.List()
+		clv := lv.(*_GenesisState_62_list)
+		x.ReputerScoreEmas = *clv.list
+	case "emissions.v7.GenesisState.previous_topic_quantile_inferer_score_ema":
+		lv := value.List()
+		clv := lv.(*_GenesisState_63_list)
+		x.PreviousTopicQuantileInfererScoreEma = *clv.list
+	case "emissions.v7.GenesisState.previous_topic_quantile_forecaster_score_ema":
+		lv := value.List()
+		clv := lv.(*_GenesisState_64_list)
+		x.PreviousTopicQuantileForecasterScoreEma = *clv.list
+	case "emissions.v7.GenesisState.previous_topic_quantile_reputer_score_ema":
+		lv := value.List()
+		clv := lv.(*_GenesisState_65_list)
+		x.PreviousTopicQuantileReputerScoreEma = *clv.list
+	case "emissions.v7.GenesisState.count_inferer_inclusions_in_topic_active_set":
+		lv := value.List()
+		clv := lv.(*_GenesisState_66_list)
+		x.CountInfererInclusionsInTopicActiveSet = *clv.list
+	case "emissions.v7.GenesisState.count_forecaster_inclusions_in_topic_active_set":
+		lv := value.List()
+		clv := lv.(*_GenesisState_67_list)
+		x.CountForecasterInclusionsInTopicActiveSet = *clv.list
+	case "emissions.v7.GenesisState.active_inferers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_68_list)
+		x.ActiveInferers = *clv.list
+	case "emissions.v7.GenesisState.active_forecasters":
+		lv := value.List()
+		clv := lv.(*_GenesisState_69_list)
+		x.ActiveForecasters = *clv.list
+	case "emissions.v7.GenesisState.lowest_inferer_score_ema":
+		lv := value.List()
+		clv := lv.(*_GenesisState_70_list)
+		x.LowestInfererScoreEma = *clv.list
+	case "emissions.v7.GenesisState.lowest_forecaster_score_ema":
+		lv := value.List()
+		clv := lv.(*_GenesisState_71_list)
+		x.LowestForecasterScoreEma = *clv.list
+	case "emissions.v7.GenesisState.active_reputers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_72_list)
+		x.ActiveReputers = *clv.list
+	case "emissions.v7.GenesisState.lowest_reputer_score_ema":
+		lv := value.List()
+		clv := lv.(*_GenesisState_73_list)
+		x.LowestReputerScoreEma = *clv.list
+	case "emissions.v7.GenesisState.loss_bundles":
+		lv := value.List()
+		clv := lv.(*_GenesisState_74_list)
+		x.LossBundles = *clv.list
+	case "emissions.v7.GenesisState.total_sum_previous_topic_weights":
+		x.TotalSumPreviousTopicWeights = value.Interface().(string)
+	case "emissions.v7.GenesisState.reward_current_block_emission":
+		x.RewardCurrentBlockEmission = value.Interface().(string)
+	case "emissions.v7.GenesisState.whitelist_admins":
+		lv := value.List()
+		clv := lv.(*_GenesisState_77_list)
+		x.WhitelistAdmins = *clv.list
+	case "emissions.v7.GenesisState.global_whitelist":
+		lv := value.List()
+		clv := lv.(*_GenesisState_78_list)
+		x.GlobalWhitelist = *clv.list
+	case "emissions.v7.GenesisState.topic_creator_whitelist":
+		lv := value.List()
+		clv := lv.(*_GenesisState_79_list)
+		x.TopicCreatorWhitelist = *clv.list
+	case "emissions.v7.GenesisState.topic_worker_whitelist":
+		lv := value.List()
+		clv := lv.(*_GenesisState_80_list)
+		x.TopicWorkerWhitelist = *clv.list
+	case "emissions.v7.GenesisState.topic_reputer_whitelist":
+		lv := value.List()
+		clv := lv.(*_GenesisState_81_list)
+		x.TopicReputerWhitelist = *clv.list
+	case "emissions.v7.GenesisState.topic_worker_whitelist_enabled":
+		lv := value.List()
+		clv := lv.(*_GenesisState_82_list)
+		x.TopicWorkerWhitelistEnabled = *clv.list
+	case "emissions.v7.GenesisState.topic_reputer_whitelist_enabled":
+		lv := value.List()
+		clv := lv.(*_GenesisState_83_list)
+		x.TopicReputerWhitelistEnabled = *clv.list
+	case "emissions.v7.GenesisState.last_median_inferences":
+		lv := value.List()
+		clv := lv.(*_GenesisState_84_list)
+		x.LastMedianInferences = *clv.list
+	case "emissions.v7.GenesisState.mad_inferences":
+		lv := value.List()
+		clv := lv.(*_GenesisState_85_list)
+		x.MadInferences = *clv.list
+	case "emissions.v7.GenesisState.initial_inferer_ema_score":
+		lv := value.List()
+		clv := lv.(*_GenesisState_86_list)
+		x.InitialInfererEmaScore = *clv.list
+	case "emissions.v7.GenesisState.initial_forecaster_ema_score":
+		lv := value.List()
+		clv := lv.(*_GenesisState_87_list)
+		x.InitialForecasterEmaScore = *clv.list
+	case "emissions.v7.GenesisState.initial_reputer_ema_score":
+		lv := value.List()
+		clv := lv.(*_GenesisState_88_list)
+		x.InitialReputerEmaScore = *clv.list
+	case "emissions.v7.GenesisState.global_worker_whitelist":
+		lv := value.List()
+		clv := lv.(*_GenesisState_89_list)
+		x.GlobalWorkerWhitelist = *clv.list
+	case "emissions.v7.GenesisState.global_reputer_whitelist":
+		lv := value.List()
+		clv := lv.(*_GenesisState_90_list)
+		x.GlobalReputerWhitelist = *clv.list
+	case "emissions.v7.GenesisState.global_admin_whitelist":
+		lv := value.List()
+		clv := lv.(*_GenesisState_91_list)
+		x.GlobalAdminWhitelist = *clv.list
+	case "emissions.v7.GenesisState.pending_topic_updates":
+		lv := value.List()
+		clv := lv.(*_GenesisState_92_list)
+		x.PendingTopicUpdates = *clv.list
+	case "emissions.v7.GenesisState.archived_topics":
+		lv := value.List()
+		clv := lv.(*_GenesisState_93_list)
+		x.ArchivedTopics = *clv.list
+	case "emissions.v7.GenesisState.archived_topics_to_prune":
+		lv := value.List()
+		clv := lv.(*_GenesisState_94_list)
+		x.ArchivedTopicsToPrune = *clv.list
+	case "emissions.v7.GenesisState.topic_owners":
+		lv := value.List()
+		clv := lv.(*_GenesisState_95_list)
+		x.TopicOwners = *clv.list
+	case "emissions.v7.GenesisState.pending_topic_owners":
+		lv := value.List()
+		clv := lv.(*_GenesisState_96_list)
+		x.PendingTopicOwners = *clv.list
+	case "emissions.v7.GenesisState.topic_tags":
+		lv := value.List()
+		clv := lv.(*_GenesisState_97_list)
+		x.TopicTags = *clv.list
+	case "emissions.v7.GenesisState.reputer_ground_truths":
+		lv := value.List()
+		clv := lv.(*_GenesisState_98_list)
+		x.ReputerGroundTruths = *clv.list
+	case "emissions.v7.GenesisState.consensus_ground_truths":
+		lv := value.List()
+		clv := lv.(*_GenesisState_99_list)
+		x.ConsensusGroundTruths = *clv.list
+	case "emissions.v7.GenesisState.worker_payload_commits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_100_list)
+		x.WorkerPayloadCommits = *clv.list
+	case "emissions.v7.GenesisState.actor_operators":
+		lv := value.List()
+		clv := lv.(*_GenesisState_101_list)
+		x.ActorOperators = *clv.list
+	case "emissions.v7.GenesisState.worker_bonds":
+		lv := value.List()
+		clv := lv.(*_GenesisState_102_list)
+		x.WorkerBonds = *clv.list
+	case "emissions.v7.GenesisState.worker_bond_removals":
+		lv := value.List()
+		clv := lv.(*_GenesisState_103_list)
+		x.WorkerBondRemovals = *clv.list
+	case "emissions.v7.GenesisState.reputer_consensus_deviations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_104_list)
+		x.ReputerConsensusDeviations = *clv.list
+	case "emissions.v7.GenesisState.redelegations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_105_list)
+		x.Redelegations = *clv.list
+	case "emissions.v7.GenesisState.auto_compound_delegators":
+		lv := value.List()
+		clv := lv.(*_GenesisState_106_list)
+		x.AutoCompoundDelegators = *clv.list
+	case "emissions.v7.GenesisState.reputer_commissions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_107_list)
+		x.ReputerCommissions = *clv.list
+	case "emissions.v7.GenesisState.stake_locks":
+		lv := value.List()
+		clv := lv.(*_GenesisState_108_list)
+		x.StakeLocks = *clv.list
+	case "emissions.v7.GenesisState.reward_history":
+		lv := value.List()
+		clv := lv.(*_GenesisState_109_list)
+		x.RewardHistory = *clv.list
+	case "emissions.v7.GenesisState.reward_escrows":
+		lv := value.List()
+		clv := lv.(*_GenesisState_110_list)
+		x.RewardEscrows = *clv.list
+	case "emissions.v7.GenesisState.queued_reward_payouts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_111_list)
+		x.QueuedRewardPayouts = *clv.list
+	case "emissions.v7.GenesisState.next_reward_payout_id":
+		x.NextRewardPayoutId = value.Uint()
+	case "emissions.v7.GenesisState.archived_topic_delegate_removal_cursors":
+		lv := value.List()
+		clv := lv.(*_GenesisState_113_list)
+		x.ArchivedTopicDelegateRemovalCursors = *clv.list
+	case "emissions.v7.GenesisState.dead_lettered_reward_payouts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_114_list)
+		x.DeadLetteredRewardPayouts = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.GenesisState"))
+		}
+		panic(fmt.Errorf("message emissions.v7.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v7.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "emissions.v7.GenesisState.core_team_addresses":
+		if x.CoreTeamAddresses == nil {
+			x.CoreTeamAddresses = []string{}
+		}
+		value := &_GenesisState_2_list{list: &x.CoreTeamAddresses}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topics":
+		if x.Topics == nil {
+			x.Topics = []*TopicIdAndTopic{}
+		}
+		value := &_GenesisState_4_list{list: &x.Topics}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.active_topics":
+		if x.ActiveTopics == nil {
+			x.ActiveTopics = []uint64{}
+		}
+		value := &_GenesisState_5_list{list: &x.ActiveTopics}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.rewardable_topics":
+		if x.RewardableTopics == nil {
+			x.RewardableTopics = []uint64{}
+		}
+		value := &_GenesisState_6_list{list: &x.RewardableTopics}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topic_workers":
+		if x.TopicWorkers == nil {
+			x.TopicWorkers = []*TopicAndActorId{}
+		}
+		value := &_GenesisState_7_list{list: &x.TopicWorkers}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topic_reputers":
+		if x.TopicReputers == nil {
+			x.TopicReputers = []*TopicAndActorId{}
+		}
+		value := &_GenesisState_8_list{list: &x.TopicReputers}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topic_reward_nonce":
+		if x.TopicRewardNonce == nil {
+			x.TopicRewardNonce = []*TopicIdAndBlockHeight{}
+		}
+		value := &_GenesisState_9_list{list: &x.TopicRewardNonce}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.inferer_scores_by_block":
+		if x.InfererScoresByBlock == nil {
+			x.InfererScoresByBlock = []*TopicIdBlockHeightScores{}
+		}
+		value := &_GenesisState_10_list{list: &x.InfererScoresByBlock}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.forecaster_scores_by_block":
+		if x.ForecasterScoresByBlock == nil {
+			x.ForecasterScoresByBlock = []*TopicIdBlockHeightScores{}
+		}
+		value := &_GenesisState_11_list{list: &x.ForecasterScoresByBlock}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.reputer_scores_by_block":
+		if x.ReputerScoresByBlock == nil {
+			x.ReputerScoresByBlock = []*TopicIdBlockHeightScores{}
+		}
+		value := &_GenesisState_12_list{list: &x.ReputerScoresByBlock}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.reputer_listening_coefficient":
+		if x.ReputerListeningCoefficient == nil {
+			x.ReputerListeningCoefficient = []*TopicIdActorIdListeningCoefficient{}
+		}
+		value := &_GenesisState_16_list{list: &x.ReputerListeningCoefficient}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.previous_reputer_reward_fraction":
+		if x.PreviousReputerRewardFraction == nil {
+			x.PreviousReputerRewardFraction = []*TopicIdActorIdDec{}
+		}
+		value := &_GenesisState_17_list{list: &x.PreviousReputerRewardFraction}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.previous_inference_reward_fraction":
+		if x.PreviousInferenceRewardFraction == nil {
+			x.PreviousInferenceRewardFraction = []*TopicIdActorIdDec{}
+		}
+		value := &_GenesisState_18_list{list: &x.PreviousInferenceRewardFraction}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.previous_forecast_reward_fraction":
+		if x.PreviousForecastRewardFraction == nil {
+			x.PreviousForecastRewardFraction = []*TopicIdActorIdDec{}
+		}
+		value := &_GenesisState_19_list{list: &x.PreviousForecastRewardFraction}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.previous_forecaster_score_ratio":
+		if x.PreviousForecasterScoreRatio == nil {
+			x.PreviousForecasterScoreRatio = []*TopicIdAndDec{}
+		}
+		value := &_GenesisState_20_list{list: &x.PreviousForecasterScoreRatio}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topic_stake":
+		if x.TopicStake == nil {
+			x.TopicStake = []*TopicIdAndInt{}
+		}
+		value := &_GenesisState_22_list{list: &x.TopicStake}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.stake_reputer_authority":
+		if x.StakeReputerAuthority == nil {
+			x.StakeReputerAuthority = []*TopicIdActorIdInt{}
+		}
+		value := &_GenesisState_23_list{list: &x.StakeReputerAuthority}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.stake_sum_from_delegator":
+		if x.StakeSumFromDelegator == nil {
+			x.StakeSumFromDelegator = []*TopicIdActorIdInt{}
+		}
+		value := &_GenesisState_24_list{list: &x.StakeSumFromDelegator}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.delegated_stakes":
+		if x.DelegatedStakes == nil {
+			x.DelegatedStakes = []*TopicIdDelegatorReputerDelegatorInfo{}
+		}
+		value := &_GenesisState_25_list{list: &x.DelegatedStakes}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.stake_from_delegators_upon_reputer":
+		if x.StakeFromDelegatorsUponReputer == nil {
+			x.StakeFromDelegatorsUponReputer = []*TopicIdActorIdInt{}
+		}
+		value := &_GenesisState_26_list{list: &x.StakeFromDelegatorsUponReputer}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.delegate_reward_per_share":
+		if x.DelegateRewardPerShare == nil {
+			x.DelegateRewardPerShare = []*TopicIdActorIdDec{}
+		}
+		value := &_GenesisState_27_list{list: &x.DelegateRewardPerShare}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.stake_removals_by_block":
+		if x.StakeRemovalsByBlock == nil {
+			x.StakeRemovalsByBlock = []*BlockHeightTopicIdReputerStakeRemovalInfo{}
+		}
+		value := &_GenesisState_28_list{list: &x.StakeRemovalsByBlock}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.stake_removals_by_actor":
+		if x.StakeRemovalsByActor == nil {
+			x.StakeRemovalsByActor = []*ActorIdTopicIdBlockHeight{}
+		}
+		value := &_GenesisState_29_list{list: &x.StakeRemovalsByActor}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.delegate_stake_removals_by_block":
+		if x.DelegateStakeRemovalsByBlock == nil {
+			x.DelegateStakeRemovalsByBlock = []*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{}
+		}
+		value := &_GenesisState_30_list{list: &x.DelegateStakeRemovalsByBlock}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.delegate_stake_removals_by_actor":
+		if x.DelegateStakeRemovalsByActor == nil {
+			x.DelegateStakeRemovalsByActor = []*DelegatorReputerTopicIdBlockHeight{}
+		}
+		value := &_GenesisState_31_list{list: &x.DelegateStakeRemovalsByActor}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.inferences":
+		if x.Inferences == nil {
+			x.Inferences = []*TopicIdActorIdInference{}
+		}
+		value := &_GenesisState_32_list{list: &x.Inferences}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.forecasts":
+		if x.Forecasts == nil {
+			x.Forecasts = []*TopicIdActorIdForecast{}
+		}
+		value := &_GenesisState_33_list{list: &x.Forecasts}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.workers":
+		if x.Workers == nil {
+			x.Workers = []*LibP2PKeyAndOffchainNode{}
+		}
+		value := &_GenesisState_34_list{list: &x.Workers}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.reputers":
+		if x.Reputers == nil {
+			x.Reputers = []*LibP2PKeyAndOffchainNode{}
+		}
+		value := &_GenesisState_35_list{list: &x.Reputers}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topic_fee_revenue":
+		if x.TopicFeeRevenue == nil {
+			x.TopicFeeRevenue = []*TopicIdAndInt{}
+		}
+		value := &_GenesisState_36_list{list: &x.TopicFeeRevenue}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.previous_topic_weight":
+		if x.PreviousTopicWeight == nil {
+			x.PreviousTopicWeight = []*TopicIdAndDec{}
+		}
+		value := &_GenesisState_37_list{list: &x.PreviousTopicWeight}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.all_inferences":
+		if x.AllInferences == nil {
+			x.AllInferences = []*TopicIdBlockHeightInferences{}
+		}
+		value := &_GenesisState_38_list{list: &x.AllInferences}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.all_forecasts":
+		if x.AllForecasts == nil {
+			x.AllForecasts = []*TopicIdBlockHeightForecasts{}
+		}
+		value := &_GenesisState_39_list{list: &x.AllForecasts}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.all_loss_bundles":
+		if x.AllLossBundles == nil {
+			x.AllLossBundles = []*TopicIdBlockHeightReputerValueBundles{}
+		}
+		value := &_GenesisState_40_list{list: &x.AllLossBundles}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.network_loss_bundles":
+		if x.NetworkLossBundles == nil {
+			x.NetworkLossBundles = []*TopicIdBlockHeightValueBundles{}
+		}
+		value := &_GenesisState_41_list{list: &x.NetworkLossBundles}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.unfulfilled_worker_nonces":
+		if x.UnfulfilledWorkerNonces == nil {
+			x.UnfulfilledWorkerNonces = []*TopicIdAndNonces{}
+		}
+		value := &_GenesisState_43_list{list: &x.UnfulfilledWorkerNonces}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.unfulfilled_reputer_nonces":
+		if x.UnfulfilledReputerNonces == nil {
+			x.UnfulfilledReputerNonces = []*TopicIdAndReputerRequestNonces{}
+		}
+		value := &_GenesisState_44_list{list: &x.UnfulfilledReputerNonces}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.latest_inferer_network_regrets":
+		if x.LatestInfererNetworkRegrets == nil {
+			x.LatestInfererNetworkRegrets = []*TopicIdActorIdTimeStampedValue{}
+		}
+		value := &_GenesisState_45_list{list: &x.LatestInfererNetworkRegrets}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.latest_forecaster_network_regrets":
+		if x.LatestForecasterNetworkRegrets == nil {
+			x.LatestForecasterNetworkRegrets = []*TopicIdActorIdTimeStampedValue{}
+		}
+		value := &_GenesisState_46_list{list: &x.LatestForecasterNetworkRegrets}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.latest_one_in_forecaster_network_regrets":
+		if x.LatestOneInForecasterNetworkRegrets == nil {
+			x.LatestOneInForecasterNetworkRegrets = []*TopicIdActorIdActorIdTimeStampedValue{}
+		}
+		value := &_GenesisState_47_list{list: &x.LatestOneInForecasterNetworkRegrets}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.latest_naive_inferer_network_regrets":
+		if x.LatestNaiveInfererNetworkRegrets == nil {
+			x.LatestNaiveInfererNetworkRegrets = []*TopicIdActorIdTimeStampedValue{}
+		}
+		value := &_GenesisState_48_list{list: &x.LatestNaiveInfererNetworkRegrets}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.latest_one_out_inferer_inferer_network_regrets":
+		if x.LatestOneOutInfererInfererNetworkRegrets == nil {
+			x.LatestOneOutInfererInfererNetworkRegrets = []*TopicIdActorIdActorIdTimeStampedValue{}
+		}
+		value := &_GenesisState_49_list{list: &x.LatestOneOutInfererInfererNetworkRegrets}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.latest_one_out_inferer_forecaster_network_regrets":
+		if x.LatestOneOutInfererForecasterNetworkRegrets == nil {
+			x.LatestOneOutInfererForecasterNetworkRegrets = []*TopicIdActorIdActorIdTimeStampedValue{}
+		}
+		value := &_GenesisState_50_list{list: &x.LatestOneOutInfererForecasterNetworkRegrets}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.latest_one_out_forecaster_inferer_network_regrets":
+		if x.LatestOneOutForecasterInfererNetworkRegrets == nil {
+			x.LatestOneOutForecasterInfererNetworkRegrets = []*TopicIdActorIdActorIdTimeStampedValue{}
+		}
+		value := &_GenesisState_51_list{list: &x.LatestOneOutForecasterInfererNetworkRegrets}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.latest_one_out_forecaster_forecaster_network_regrets":
+		if x.LatestOneOutForecasterForecasterNetworkRegrets == nil {
+			x.LatestOneOutForecasterForecasterNetworkRegrets = []*TopicIdActorIdActorIdTimeStampedValue{}
+		}
+		value := &_GenesisState_52_list{list: &x.LatestOneOutForecasterForecasterNetworkRegrets}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topic_last_worker_commit":
+		if x.TopicLastWorkerCommit == nil {
+			x.TopicLastWorkerCommit = []*TopicIdTimestampedActorNonce{}
+		}
+		value := &_GenesisState_53_list{list: &x.TopicLastWorkerCommit}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topic_last_reputer_commit":
+		if x.TopicLastReputerCommit == nil {
+			x.TopicLastReputerCommit = []*TopicIdTimestampedActorNonce{}
+		}
+		value := &_GenesisState_54_list{list: &x.TopicLastReputerCommit}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.open_worker_windows":
+		if x.OpenWorkerWindows == nil {
+			x.OpenWorkerWindows = []*BlockHeightAndTopicIds{}
+		}
+		value := &_GenesisState_55_list{list: &x.OpenWorkerWindows}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.last_drip_block":
+		if x.LastDripBlock == nil {
+			x.LastDripBlock = []*TopicIdAndBlockHeight{}
+		}
+		value := &_GenesisState_56_list{list: &x.LastDripBlock}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topic_to_next_possible_churning_block":
+		if x.TopicToNextPossibleChurningBlock == nil {
+			x.TopicToNextPossibleChurningBlock = []*TopicIdAndBlockHeight{}
+		}
+		value := &_GenesisState_57_list{list: &x.TopicToNextPossibleChurningBlock}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.block_to_active_topics":
+		if x.BlockToActiveTopics == nil {
+			x.BlockToActiveTopics = []*BlockHeightTopicIds{}
+		}
+		value := &_GenesisState_58_list{list: &x.BlockToActiveTopics}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.block_to_lowest_active_topic_weight":
+		if x.BlockToLowestActiveTopicWeight == nil {
+			x.BlockToLowestActiveTopicWeight = []*BlockHeightTopicIdWeightPair{}
+		}
+		value := &_GenesisState_59_list{list: &x.BlockToLowestActiveTopicWeight}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.inferer_score_emas":
+		if x.InfererScoreEmas == nil {
+			x.InfererScoreEmas = []*TopicIdActorIdScore{}
+		}
+		value := &_GenesisState_60_list{list: &x.InfererScoreEmas}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.forecaster_score_emas":
+		if x.ForecasterScoreEmas == nil {
+			x.ForecasterScoreEmas = []*TopicIdActorIdScore{}
+		}
+		value := &_GenesisState_61_list{list: &x.ForecasterScoreEmas}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.reputer_score_emas":
+		if x.ReputerScoreEmas == nil {
+			x.ReputerScoreEmas = []*TopicIdActorIdScore{}
+		}
+		value := &_GenesisState_62_list{list: &x.ReputerScoreEmas}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.previous_topic_quantile_inferer_score_ema":
+		if x.PreviousTopicQuantileInfererScoreEma == nil {
+			x.PreviousTopicQuantileInfererScoreEma = []*TopicIdAndDec{}
+		}
+		value := &_GenesisState_63_list{list: &x.PreviousTopicQuantileInfererScoreEma}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.previous_topic_quantile_forecaster_score_ema":
+		if x.PreviousTopicQuantileForecasterScoreEma == nil {
+			x.PreviousTopicQuantileForecasterScoreEma = []*TopicIdAndDec{}
+		}
+		value := &_GenesisState_64_list{list: &x.PreviousTopicQuantileForecasterScoreEma}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.previous_topic_quantile_reputer_score_ema":
+		if x.PreviousTopicQuantileReputerScoreEma == nil {
+			x.PreviousTopicQuantileReputerScoreEma = []*TopicIdAndDec{}
+		}
+		value := &_GenesisState_65_list{list: &x.PreviousTopicQuantileReputerScoreEma}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.count_inferer_inclusions_in_topic_active_set":
+		if x.CountInfererInclusionsInTopicActiveSet == nil {
+			x.CountInfererInclusionsInTopicActiveSet = []*TopicIdActorIdUint64{}
+		}
+		value := &_GenesisState_66_list{list: &x.CountInfererInclusionsInTopicActiveSet}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.count_forecaster_inclusions_in_topic_active_set":
+		if x.CountForecasterInclusionsInTopicActiveSet == nil {
+			x.CountForecasterInclusionsInTopicActiveSet = []*TopicIdActorIdUint64{}
+		}
+		value := &_GenesisState_67_list{list: &x.CountForecasterInclusionsInTopicActiveSet}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.active_inferers":
+		if x.ActiveInferers == nil {
+			x.ActiveInferers = []*TopicAndActorId{}
+		}
+		value := &_GenesisState_68_list{list: &x.ActiveInferers}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.active_forecasters":
+		if x.ActiveForecasters == nil {
+			x.ActiveForecasters = []*TopicAndActorId{}
+		}
+		value := &_GenesisState_69_list{list: &x.ActiveForecasters}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.lowest_inferer_score_ema":
+		if x.LowestInfererScoreEma == nil {
+			x.LowestInfererScoreEma = []*TopicIdActorIdScore{}
+		}
+		value := &_GenesisState_70_list{list: &x.LowestInfererScoreEma}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.lowest_forecaster_score_ema":
+		if x.LowestForecasterScoreEma == nil {
+			x.LowestForecasterScoreEma = []*TopicIdActorIdScore{}
+		}
+		value := &_GenesisState_71_list{list: &x.LowestForecasterScoreEma}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.active_reputers":
+		if x.ActiveReputers == nil {
+			x.ActiveReputers = []*TopicAndActorId{}
+		}
+		value := &_GenesisState_72_list{list: &x.ActiveReputers}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.lowest_reputer_score_ema":
+		if x.LowestReputerScoreEma == nil {
+			x.LowestReputerScoreEma = []*TopicIdActorIdScore{}
+		}
+		value := &_GenesisState_73_list{list: &x.LowestReputerScoreEma}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.loss_bundles":
+		if x.LossBundles == nil {
+			x.LossBundles = []*TopicIdReputerReputerValueBundle{}
+		}
+		value := &_GenesisState_74_list{list: &x.LossBundles}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.whitelist_admins":
+		if x.WhitelistAdmins == nil {
+			x.WhitelistAdmins = []string{}
+		}
+		value := &_GenesisState_77_list{list: &x.WhitelistAdmins}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.global_whitelist":
+		if x.GlobalWhitelist == nil {
+			x.GlobalWhitelist = []string{}
+		}
+		value := &_GenesisState_78_list{list: &x.GlobalWhitelist}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topic_creator_whitelist":
+		if x.TopicCreatorWhitelist == nil {
+			x.TopicCreatorWhitelist = []string{}
+		}
+		value := &_GenesisState_79_list{list: &x.TopicCreatorWhitelist}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topic_worker_whitelist":
+		if x.TopicWorkerWhitelist == nil {
+			x.TopicWorkerWhitelist = []*TopicAndActorId{}
+		}
+		value := &_GenesisState_80_list{list: &x.TopicWorkerWhitelist}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topic_reputer_whitelist":
+		if x.TopicReputerWhitelist == nil {
+			x.TopicReputerWhitelist = []*TopicAndActorId{}
+		}
+		value := &_GenesisState_81_list{list: &x.TopicReputerWhitelist}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topic_worker_whitelist_enabled":
+		if x.TopicWorkerWhitelistEnabled == nil {
+			x.TopicWorkerWhitelistEnabled = []uint64{}
+		}
+		value := &_GenesisState_82_list{list: &x.TopicWorkerWhitelistEnabled}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topic_reputer_whitelist_enabled":
+		if x.TopicReputerWhitelistEnabled == nil {
+			x.TopicReputerWhitelistEnabled = []uint64{}
+		}
+		value := &_GenesisState_83_list{list: &x.TopicReputerWhitelistEnabled}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.last_median_inferences":
+		if x.LastMedianInferences == nil {
+			x.LastMedianInferences = []*TopicIdAndDec{}
+		}
+		value := &_GenesisState_84_list{list: &x.LastMedianInferences}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.mad_inferences":
+		if x.MadInferences == nil {
+			x.MadInferences = []*TopicIdAndDec{}
+		}
+		value := &_GenesisState_85_list{list: &x.MadInferences}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.initial_inferer_ema_score":
+		if x.InitialInfererEmaScore == nil {
+			x.InitialInfererEmaScore = []*TopicIdAndDec{}
+		}
+		value := &_GenesisState_86_list{list: &x.InitialInfererEmaScore}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.initial_forecaster_ema_score":
+		if x.InitialForecasterEmaScore == nil {
+			x.InitialForecasterEmaScore = []*TopicIdAndDec{}
+		}
+		value := &_GenesisState_87_list{list: &x.InitialForecasterEmaScore}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.initial_reputer_ema_score":
+		if x.InitialReputerEmaScore == nil {
+			x.InitialReputerEmaScore = []*TopicIdAndDec{}
+		}
+		value := &_GenesisState_88_list{list: &x.InitialReputerEmaScore}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.global_worker_whitelist":
+		if x.GlobalWorkerWhitelist == nil {
+			x.GlobalWorkerWhitelist = []string{}
+		}
+		value := &_GenesisState_89_list{list: &x.GlobalWorkerWhitelist}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.global_reputer_whitelist":
+		if x.GlobalReputerWhitelist == nil {
+			x.GlobalReputerWhitelist = []string{}
+		}
+		value := &_GenesisState_90_list{list: &x.GlobalReputerWhitelist}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.global_admin_whitelist":
+		if x.GlobalAdminWhitelist == nil {
+			x.GlobalAdminWhitelist = []string{}
+		}
+		value := &_GenesisState_91_list{list: &x.GlobalAdminWhitelist}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.pending_topic_updates":
+		if x.PendingTopicUpdates == nil {
+			x.PendingTopicUpdates = []*TopicIdAndOptionalTopicParams{}
+		}
+		value := &_GenesisState_92_list{list: &x.PendingTopicUpdates}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.archived_topics":
+		if x.ArchivedTopics == nil {
+			x.ArchivedTopics = []uint64{}
+		}
+		value := &_GenesisState_93_list{list: &x.ArchivedTopics}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.archived_topics_to_prune":
+		if x.ArchivedTopicsToPrune == nil {
+			x.ArchivedTopicsToPrune = []uint64{}
+		}
+		value := &_GenesisState_94_list{list: &x.ArchivedTopicsToPrune}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topic_owners":
+		if x.TopicOwners == nil {
+			x.TopicOwners = []*TopicAndActorId{}
+		}
+		value := &_GenesisState_95_list{list: &x.TopicOwners}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.pending_topic_owners":
+		if x.PendingTopicOwners == nil {
+			x.PendingTopicOwners = []*TopicAndActorId{}
+		}
+		value := &_GenesisState_96_list{list: &x.PendingTopicOwners}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.topic_tags":
+		if x.TopicTags == nil {
+			x.TopicTags = []*TopicIdAndTag{}
+		}
+		value := &_GenesisState_97_list{list: &x.TopicTags}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.reputer_ground_truths":
+		if x.ReputerGroundTruths == nil {
+			x.ReputerGroundTruths = []*v3.GroundTruth{}
+		}
+		value := &_GenesisState_98_list{list: &x.ReputerGroundTruths}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.consensus_ground_truths":
+		if x.ConsensusGroundTruths == nil {
+			x.ConsensusGroundTruths = []*v3.GroundTruth{}
+		}
+		value := &_GenesisState_99_list{list: &x.ConsensusGroundTruths}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.worker_payload_commits":
+		if x.WorkerPayloadCommits == nil {
+			x.WorkerPayloadCommits = []*v3.WorkerPayloadCommit{}
+		}
+		value := &_GenesisState_100_list{list: &x.WorkerPayloadCommits}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.actor_operators":
+		if x.ActorOperators == nil {
+			x.ActorOperators = []*TopicActorOperator{}
+		}
+		value := &_GenesisState_101_list{list: &x.ActorOperators}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.worker_bonds":
+		if x.WorkerBonds == nil {
+			x.WorkerBonds = []*v3.WorkerBond{}
+		}
+		value := &_GenesisState_102_list{list: &x.WorkerBonds}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.worker_bond_removals":
+		if x.WorkerBondRemovals == nil {
+			x.WorkerBondRemovals = []*v3.WorkerBondRemovalInfo{}
+		}
+		value := &_GenesisState_103_list{list: &x.WorkerBondRemovals}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.reputer_consensus_deviations":
+		if x.ReputerConsensusDeviations == nil {
+			x.ReputerConsensusDeviations = []*v3.ReputerConsensusDeviation{}
+		}
+		value := &_GenesisState_104_list{list: &x.ReputerConsensusDeviations}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.redelegations":
+		if x.Redelegations == nil {
+			x.Redelegations = []*v3.RedelegationInfo{}
+		}
+		value := &_GenesisState_105_list{list: &x.Redelegations}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.auto_compound_delegators":
+		if x.AutoCompoundDelegators == nil {
+			x.AutoCompoundDelegators = []*TopicAndActorId{}
+		}
+		value := &_GenesisState_106_list{list: &x.AutoCompoundDelegators}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.reputer_commissions":
+		if x.ReputerCommissions == nil {
+			x.ReputerCommissions = []*v3.ReputerCommission{}
+		}
+		value := &_GenesisState_107_list{list: &x.ReputerCommissions}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.stake_locks":
+		if x.StakeLocks == nil {
+			x.StakeLocks = []*v3.StakeLock{}
+		}
+		value := &_GenesisState_108_list{list: &x.StakeLocks}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.reward_history":
+		if x.RewardHistory == nil {
+			x.RewardHistory = []*v3.RewardRecord{}
+		}
+		value := &_GenesisState_109_list{list: &x.RewardHistory}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.reward_escrows":
+		if x.RewardEscrows == nil {
+			x.RewardEscrows = []*v3.RewardEscrow{}
+		}
+		value := &_GenesisState_110_list{list: &x.RewardEscrows}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.queued_reward_payouts":
+		if x.QueuedRewardPayouts == nil {
+			x.QueuedRewardPayouts = []*v3.QueuedRewardPayout{}
+		}
+		value := &_GenesisState_111_list{list: &x.QueuedRewardPayouts}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.archived_topic_delegate_removal_cursors":
+		if x.ArchivedTopicDelegateRemovalCursors == nil {
+			x.ArchivedTopicDelegateRemovalCursors = []*TopicIdDelegatorReputer{}
+		}
+		value := &_GenesisState_113_list{list: &x.ArchivedTopicDelegateRemovalCursors}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.dead_lettered_reward_payouts":
+		if x.DeadLetteredRewardPayouts == nil {
+			x.DeadLetteredRewardPayouts = []*v3.QueuedRewardPayout{}
+		}
+		value := &_GenesisState_114_list{list: &x.DeadLetteredRewardPayouts}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v7.GenesisState.next_topic_id":
+		panic(fmt.Errorf("field next_topic_id of message emissions.v7.GenesisState is not mutable"))
+	case "emissions.v7.GenesisState.total_stake":
+		panic(fmt.Errorf("field total_stake of message emissions.v7.GenesisState is not mutable"))
+	case "emissions.v7.GenesisState.previous_percentage_reward_to_staked_reputers":
+		panic(fmt.Errorf("field previous_percentage_reward_to_staked_reputers of message emissions.v7.GenesisState is not mutable"))
+	case "emissions.v7.GenesisState.total_sum_previous_topic_weights":
+		panic(fmt.Errorf("field total_sum_previous_topic_weights of message emissions.v7.GenesisState is not mutable"))
+	case "emissions.v7.GenesisState.reward_current_block_emission":
+		panic(fmt.Errorf("field reward_current_block_emission of message emissions.v7.GenesisState is not mutable"))
+	case "emissions.v7.GenesisState.next_reward_payout_id":
+		panic(fmt.Errorf("field next_reward_payout_id of message emissions.v7.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.GenesisState"))
+		}
+		panic(fmt.Errorf("message emissions.v7.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v7.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v7.GenesisState.core_team_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "emissions.v7.GenesisState.next_topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v7.GenesisState.topics":
+		list := []*TopicIdAndTopic{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "emissions.v7.GenesisState.active_topics":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "emissions.v7.GenesisState.rewardable_topics":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "emissions.v7.GenesisState.topic_workers":
+		list := []*TopicAndActorId{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "emissions.v7.GenesisState.topic_reputers":
+		list := []*TopicAndActorId{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "emissions.v7.GenesisState.topic_reward_nonce":
+		list := []*TopicIdAndBlockHeight{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "emissions.v7.GenesisState.inferer_scores_by_block":
+		list := []*TopicIdBlockHeightScores{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "emissions.v7.GenesisState.forecaster_scores_by_block":
+		list := []*TopicIdBlockHeightScores{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "emissions.v7.GenesisState.reputer_scores_by_block":
+		list := []*TopicIdBlockHeightScores{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "emissions.v7.GenesisState.reputer_listening_coefficient":
+		list := []*TopicIdActorIdListeningCoefficient{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "emissions.v7.GenesisState.previous_reputer_reward_fraction":
+		list := []*TopicIdActorIdDec{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "emissions.v7.GenesisState.previous_inference_reward_fraction":
+		list := []*TopicIdActorIdDec{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	case "emissions.v7.GenesisState.previous_forecast_reward_fraction":
+		list := []*TopicIdActorIdDec{}
+		return protoreflect.ValueOfList(&_GenesisState_19_list{list: &list})
+	case "emissions.v7.GenesisState.previous_forecaster_score_ratio":
+		list := []*TopicIdAndDec{}
+		return protoreflect.ValueOfList(&_GenesisState_20_list{list: &list})
+	case "emissions.v7.GenesisState.total_stake":
+		return protoreflect.ValueOfString("")
+	case "emissions.v7.GenesisState.topic_stake":
+		list := []*TopicIdAndInt{}
+		return protoreflect.ValueOfList(&_GenesisState_22_list{list: &list})
+	case "emissions.v7.GenesisState.stake_reputer_authority":
+		list := []*TopicIdActorIdInt{}
+		return protoreflect.ValueOfList(&_GenesisState_23_list{list: &list})
+	case "emissions.v7.GenesisState.stake_sum_from_delegator":
+		list := []*TopicIdActorIdInt{}
+		return protoreflect.ValueOfList(&_GenesisState_24_list{list: &list})
+	case "emissions.v7.GenesisState.delegated_stakes":
+		list := []*TopicIdDelegatorReputerDelegatorInfo{}
+		return protoreflect.ValueOfList(&_GenesisState_25_list{list: &list})
+	case "emissions.v7.GenesisState.stake_from_delegators_upon_reputer":
+		list := []*TopicIdActorIdInt{}
+		return protoreflect.ValueOfList(&_GenesisState_26_list{list: &list})
+	case "emissions.v7.GenesisState.delegate_reward_per_share":
+		list := []*TopicIdActorIdDec{}
+		return protoreflect.ValueOfList(&_GenesisState_27_list{list: &list})
+	case "emissions.v7.GenesisState.stake_removals_by_block":
+		list := []*BlockHeightTopicIdReputerStakeRemovalInfo{}
+		return protoreflect.ValueOfList(&_GenesisState_28_list{list: &list})
+	case "emissions.v7.GenesisState.stake_removals_by_actor":
+		list := []*ActorIdTopicIdBlockHeight{}
+		return protoreflect.ValueOfList(&_GenesisState_29_list{list: &list})
+	case "emissions.v7.GenesisState.delegate_stake_removals_by_block":
+		list := []*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{}
+		return protoreflect.ValueOfList(&_GenesisState_30_list{list: &list})
+	case "emissions.v7.GenesisState.delegate_stake_removals_by_actor":
+		list := []*DelegatorReputerTopicIdBlockHeight{}
+		return protoreflect.ValueOfList(&_GenesisState_31_list{list: &list})
+	case "emissions.v7.GenesisState.inferences":
+		list := []*TopicIdActorIdInference{}
+		return protoreflect.ValueOfList(&_GenesisState_32_list{list: &list})
+	case "emissions.v7.GenesisState.forecasts":
+		list := []*TopicIdActorIdForecast{}
+		return protoreflect.ValueOfList(&_GenesisState_33_list{list: &list})
+	case "emissions.v7.GenesisState.workers":
+		list := []*LibP2PKeyAndOffchainNode{}
+		return protoreflect.ValueOfList(&_GenesisState_34_list{list: &list})
+	case "emissions.v7.GenesisState.reputers":
+		list := []*LibP2PKeyAndOffchainNode{}
+		return protoreflect.ValueOfList(&_GenesisState_35_list{list: &list})
+	case "emissions.v7.GenesisState.topic_fee_revenue":
+		list := []*TopicIdAndInt{}
+		return protoreflect.ValueOfList(&_GenesisState_36_list{list: &list})
+	case "emissions.v7.GenesisState.previous_topic_weight":
+		list := []*TopicIdAndDec{}
+		return protoreflect.ValueOfList(&_GenesisState_37_list{list: &list})
+	case "emissions.v7.GenesisState.all_inferences":
+		list := []*TopicIdBlockHeightInferences{}
+		return protoreflect.ValueOfList(&_GenesisState_38_list{list: &list})
+	case "emissions.v7.GenesisState.all_forecasts":
+		list := []*TopicIdBlockHeightForecasts{}
+		return protoreflect.ValueOfList(&_GenesisState_39_list{list: &list})
+	case "emissions.v7.GenesisState.all_loss_bundles":
+		list := []*TopicIdBlockHeightReputerValueBundles{}
+		return protoreflect.ValueOfList(&_GenesisState_40_list{list: &list})
+	case "emissions.v7.GenesisState.network_loss_bundles":
+		list := []*TopicIdBlockHeightValueBundles{}
+		return protoreflect.ValueOfList(&_GenesisState_41_list{list: &list})
+	case "emissions.v7.GenesisState.previous_percentage_reward_to_staked_reputers":
+		return protoreflect.ValueOfString("")
+	case "emissions.v7.GenesisState.unfulfilled_worker_nonces":
+		list := []*TopicIdAndNonces{}
+		return protoreflect.ValueOfList(&_GenesisState_43_list{list: &list})
+	case "emissions.v7.GenesisState.unfulfilled_reputer_nonces":
+		list := []*TopicIdAndReputerRequestNonces{}
+		return protoreflect.ValueOfList(&_GenesisState_44_list{list: &list})
+	case "emissions.v7.GenesisState.latest_inferer_network_regrets":
+		list := []*TopicIdActorIdTimeStampedValue{}
+		return protoreflect.ValueOfList(&_GenesisState_45_list{list: &list})
+	case "emissions.v7.GenesisState.latest_forecaster_network_regrets":
+		list := []*TopicIdActorIdTimeStampedValue{}
+		return protoreflect.ValueOfList(&_GenesisState_46_list{list: &list})
+	case "emissions.v7.GenesisState.latest_one_in_forecaster_network_regrets":
+		list := []*TopicIdActorIdActorIdTimeStampedValue{}
+		return protoreflect.ValueOfList(&_GenesisState_47_list{list: &list})
+	case "emissions.v7.GenesisState.latest_naive_inferer_network_regrets":
+		list := []*TopicIdActorIdTimeStampedValue{}
+		return protoreflect.ValueOfList(&_GenesisState_48_list{list: &list})
+	case "emissions.v7.GenesisState.latest_one_out_inferer_inferer_network_regrets":
+		list := []*TopicIdActorIdActorIdTimeStampedValue{}
+		return protoreflect.ValueOfList(&_GenesisState_49_list{list: &list})
+	case "emissions.v7.GenesisState.latest_one_out_inferer_forecaster_network_regrets":
+		list := []*TopicIdActorIdActorIdTimeStampedValue{}
+		return protoreflect.ValueOfList(&_GenesisState_50_list{list: &list})
+	case "emissions.v7.GenesisState.latest_one_out_forecaster_inferer_network_regrets":
+		list := []*TopicIdActorIdActorIdTimeStampedValue{}
+		return protoreflect.ValueOfList(&_GenesisState_51_list{list: &list})
+	case "emissions.v7.GenesisState.latest_one_out_forecaster_forecaster_network_regrets":
+		list := []*TopicIdActorIdActorIdTimeStampedValue{}
+		return protoreflect.ValueOfList(&_GenesisState_52_list{list: &list})
+	case "emissions.v7.GenesisState.topic_last_worker_commit":
+		list := []*TopicIdTimestampedActorNonce{}
+		return protoreflect.ValueOfList(&_GenesisState_53_list{list: &list})
+	case "emissions.v7.GenesisState.topic_last_reputer_commit":
+		list := []*TopicIdTimestampedActorNonce{}
+		return protoreflect.ValueOfList(&_GenesisState_54_list{list: &list})
+	case "emissions.v7.GenesisState.open_worker_windows":
+		list := []*BlockHeightAndTopicIds{}
+		return protoreflect.ValueOfList(&_GenesisState_55_list{list: &list})
+	case "emissions.v7.GenesisState.last_drip_block":
+		list := []*TopicIdAndBlockHeight{}
+		return protoreflect.ValueOfList(&_GenesisState_56_list{list: &list})
+	case "emissions.v7.GenesisState.topic_to_next_possible_churning_block":
+		list := []*TopicIdAndBlockHeight{}
+		return protoreflect.ValueOfList(&_GenesisState_57_list{list: &list})
+	case "emissions.v7.GenesisState.block_to_active_topics":
+		list := []*BlockHeightTopicIds{}
+		return protoreflect.ValueOfList(&_GenesisState_58_list{list: &list})
+	case "emissions.v7.GenesisState.block_to_lowest_active_topic_weight":
+		list := []*BlockHeightTopicIdWeightPair{}
+		return protoreflect.ValueOfList(&_GenesisState_59_list{list: &list})
+	case "emissions.v7.GenesisState.inferer_score_emas":
+		list := []*TopicIdActorIdScore{}
+		return protoreflect.ValueOfList(&_GenesisState_60_list{list: &list})
+	case "emissions.v7.GenesisState.forecaster_score_emas":
+		list := []*TopicIdActorIdScore{}
+		return protoreflect.ValueOfList(&_GenesisState_61_list{list: &list})
+	case "emissions.v7.GenesisState.reputer_score_emas":
+		list := []*TopicIdActorIdScore{}
+		return protoreflect.ValueOfList(&_GenesisState_62_list{list: &list})
+	case "emissions.v7.GenesisState.previous_topic_quantile_inferer_score_ema":
+		list := []*TopicIdAndDec{}
+		return protoreflect.ValueOfList(&_GenesisState_63_list{list: &list})
+	case "emissions.v7.GenesisState.previous_topic_quantile_forecaster_score_ema":
+		list := []*TopicIdAndDec{}
+		return protoreflect.ValueOfList(&_GenesisState_64_list{list: &list})
+	case "emissions.v7.GenesisState.previous_topic_quantile_reputer_score_ema":
+		list := []*TopicIdAndDec{}
+		return protoreflect.ValueOfList(&_GenesisState_65_list{list: &list})
+	case "emissions.v7.GenesisState.count_inferer_inclusions_in_topic_active_set":
+		list := []*TopicIdActorIdUint64{}
+		return protoreflect.ValueOfList(&_GenesisState_66_list{list: &list})
+	case "emissions.v7.GenesisState.count_forecaster_inclusions_in_topic_active_set":
+		list := []*TopicIdActorIdUint64{}
+		return protoreflect.ValueOfList(&_GenesisState_67_list{list: &list})
+	case "emissions.v7.GenesisState.active_inferers":
+		list := []*TopicAndActorId{}
+		return protoreflect.ValueOfList(&_GenesisState_68_list{list: &list})
+	case "emissions.v7.GenesisState.active_forecasters":
+		list := []*TopicAndActorId{}
+		return protoreflect.ValueOfList(&_GenesisState_69_list{list: &list})
+	case "emissions.v7.GenesisState.lowest_inferer_score_ema":
+		list := []*TopicIdActorIdScore{}
+		return protoreflect.ValueOfList(&_GenesisState_70_list{list: &list})
+	case "emissions.v7.GenesisState.lowest_forecaster_score_ema":
+		list := []*TopicIdActorIdScore{}
+		return protoreflect.ValueOfList(&_GenesisState_71_list{list: &list})
+	case "emissions.v7.GenesisState.active_reputers":
+		list := []*TopicAndActorId{}
+		return protoreflect.ValueOfList(&_GenesisState_72_list{list: &list})
+	case "emissions.v7.GenesisState.lowest_reputer_score_ema":
+		list := []*TopicIdActorIdScore{}
+		return protoreflect.ValueOfList(&_GenesisState_73_list{list: &list})
+	case "emissions.v7.GenesisState.loss_bundles":
+		list := []*TopicIdReputerReputerValueBundle{}
+		return protoreflect.ValueOfList(&_GenesisState_74_list{list: &list})
+	case "emissions.v7.GenesisState.total_sum_previous_topic_weights":
+		return protoreflect.ValueOfString("")
+	case "emissions.v7.GenesisState.reward_current_block_emission":
+		return protoreflect.ValueOfString("")
+	case "emissions.v7.GenesisState.whitelist_admins":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_77_list{list: &list})
+	case "emissions.v7.GenesisState.global_whitelist":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_78_list{list: &list})
+	case "emissions.v7.GenesisState.topic_creator_whitelist":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_79_list{list: &list})
+	case "emissions.v7.GenesisState.topic_worker_whitelist":
+		list := []*TopicAndActorId{}
+		return protoreflect.ValueOfList(&_GenesisState_80_list{list: &list})
+	case "emissions.v7.GenesisState.topic_reputer_whitelist":
+		list := []*TopicAndActorId{}
+		return protoreflect.ValueOfList(&_GenesisState_81_list{list: &list})
+	case "emissions.v7.GenesisState.topic_worker_whitelist_enabled":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_GenesisState_82_list{list: &list})
+	case "emissions.v7.GenesisState.topic_reputer_whitelist_enabled":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_GenesisState_83_list{list: &list})
+	case "emissions.v7.GenesisState.last_median_inferences":
+		list := []*TopicIdAndDec{}
+		return protoreflect.ValueOfList(&_GenesisState_84_list{list: &list})
+	case "emissions.v7.GenesisState.mad_inferences":
+		list := []*TopicIdAndDec{}
+		return protoreflect.ValueOfList(&_GenesisState_85_list{list: &list})
+	case "emissions.v7.GenesisState.initial_inferer_ema_score":
+		list := []*TopicIdAndDec{}
+		return protoreflect.ValueOfList(&_GenesisState_86_list{list: &list})
+	case "emissions.v7.GenesisState.initial_forecaster_ema_score":
+		list := []*TopicIdAndDec{}
+		return protoreflect.ValueOfList(&_GenesisState_87_list{list: &list})
+	case "emissions.v7.GenesisState.initial_reputer_ema_score":
+		list := []*TopicIdAndDec{}
+		return protoreflect.ValueOfList(&_GenesisState_88_list{list: &list})
+	case "emissions.v7.GenesisState.global_worker_whitelist":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_89_list{list: &list})
+	case "emissions.v7.GenesisState.global_reputer_whitelist":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_90_list{list: &list})
+	case "emissions.v7.GenesisState.global_admin_whitelist":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_91_list{list: &list})
+	case "emissions.v7.GenesisState.pending_topic_updates":
+		list := []*TopicIdAndOptionalTopicParams{}
+		return protoreflect.ValueOfList(&_GenesisState_92_list{list: &list})
+	case "emissions.v7.GenesisState.archived_topics":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_GenesisState_93_list{list: &list})
+	case "emissions.v7.GenesisState.archived_topics_to_prune":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_GenesisState_94_list{list: &list})
+	case "emissions.v7.GenesisState.topic_owners":
+		list := []*TopicAndActorId{}
+		return protoreflect.ValueOfList(&_GenesisState_95_list{list: &list})
+	case "emissions.v7.GenesisState.pending_topic_owners":
+		list := []*TopicAndActorId{}
+		return protoreflect.ValueOfList(&_GenesisState_96_list{list: &list})
+	case "emissions.v7.GenesisState.topic_tags":
+		list := []*TopicIdAndTag{}
+		return protoreflect.ValueOfList(&_GenesisState_97_list{list: &list})
+	case "emissions.v7.GenesisState.reputer_ground_truths":
+		list := []*v3.GroundTruth{}
+		return protoreflect.ValueOfList(&_GenesisState_98_list{list: &list})
+	case "emissions.v7.GenesisState.consensus_ground_truths":
+		list := []*v3.GroundTruth{}
+		return protoreflect.ValueOfList(&_GenesisState_99_list{list: &list})
+	case "emissions.v7.GenesisState.worker_payload_commits":
+		list := []*v3.WorkerPayloadCommit{}
+		return protoreflect.ValueOfList(&_GenesisState_100_list{list: &list})
+	case "emissions.v7.GenesisState.actor_operators":
+		list := []*TopicActorOperator{}
+		return protoreflect.ValueOfList(&_GenesisState_101_list{list: &list})
+	case "emissions.v7.GenesisState.worker_bonds":
+		list := []*v3.WorkerBond{}
+		return protoreflect.ValueOfList(&_GenesisState_102_list{list: &list})
+	case "emissions.v7.GenesisState.worker_bond_removals":
+		list := []*v3.WorkerBondRemovalInfo{}
+		return protoreflect.ValueOfList(&_GenesisState_103_list{list: &list})
+	case "emissions.v7.GenesisState.reputer_consensus_deviations":
+		list := []*v3.ReputerConsensusDeviation{}
+		return protoreflect.ValueOfList(&_GenesisState_104_list{list: &list})
+	case "emissions.v7.GenesisState.redelegations":
+		list := []*v3.RedelegationInfo{}
+		return protoreflect.ValueOfList(&_GenesisState_105_list{list: &list})
+	case "emissions.v7.GenesisState.auto_compound_delegators":
+		list := []*TopicAndActorId{}
+		return protoreflect.ValueOfList(&_GenesisState_106_list{list: &list})
+	case "emissions.v7.GenesisState.reputer_commissions":
+		list := []*v3.ReputerCommission{}
+		return protoreflect.ValueOfList(&_GenesisState_107_list{list: &list})
+	case "emissions.v7.GenesisState.stake_locks":
+		list := []*v3.StakeLock{}
+		return protoreflect.ValueOfList(&_GenesisState_108_list{list: &list})
+	case "emissions.v7.GenesisState.reward_history":
+		list := []*v3.RewardRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_109_list{list: &list})
+	case "emissions.v7.GenesisState.reward_escrows":
+		list := []*v3.RewardEscrow{}
+		return protoreflect.ValueOfList(&_GenesisState_110_list{list: &list})
+	case "emissions.v7.GenesisState.queued_reward_payouts":
+		list := []*v3.QueuedRewardPayout{}
+		return protoreflect.ValueOfList(&_GenesisState_111_list{list: &list})
+	case "emissions.v7.GenesisState.next_reward_payout_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v7.GenesisState.archived_topic_delegate_removal_cursors":
+		list := []*TopicIdDelegatorReputer{}
+		return protoreflect.ValueOfList(&_GenesisState_113_list{list: &list})
+	case "emissions.v7.GenesisState.dead_lettered_reward_payouts":
+		list := []*v3.QueuedRewardPayout{}
+		return protoreflect.ValueOfList(&_GenesisState_114_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.GenesisState"))
+		}
+		panic(fmt.Errorf("message emissions.v7.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v7.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.CoreTeamAddresses) > 0 {
+			for _, s := range x.CoreTeamAddresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextTopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextTopicId))
+		}
+		if len(x.Topics) > 0 {
+			for _, e := range x.Topics {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ActiveTopics) > 0 {
+			l = 0
+			for _, e := range x.ActiveTopics {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.RewardableTopics) > 0 {
+			l = 0
+			for _, e := range x.RewardableTopics {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.TopicWorkers) > 0 {
+			for _, e := range x.TopicWorkers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TopicReputers) > 0 {
+			for _, e := range x.TopicReputers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TopicRewardNonce) > 0 {
+			for _, e := range x.TopicRewardNonce {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.InfererScoresByBlock) > 0 {
+			for _, e := range x.InfererScoresByBlock {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ForecasterScoresByBlock) > 0 {
+			for _, e := range x.ForecasterScoresByBlock {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReputerScoresByBlock) > 0 {
+			for _, e := range x.ReputerScoresByBlock {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReputerListeningCoefficient) > 0 {
+			for _, e := range x.ReputerListeningCoefficient {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PreviousReputerRewardFraction) > 0 {
+			for _, e := range x.PreviousReputerRewardFraction {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PreviousInferenceRewardFraction) > 0 {
+			for _, e := range x.PreviousInferenceRewardFraction {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PreviousForecastRewardFraction) > 0 {
+			for _, e := range x.PreviousForecastRewardFraction {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PreviousForecasterScoreRatio) > 0 {
+			for _, e := range x.PreviousForecasterScoreRatio {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.TotalStake)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TopicStake) > 0 {
+			for _, e := range x.TopicStake {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.StakeReputerAuthority) > 0 {
+			for _, e := range x.StakeReputerAuthority {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.StakeSumFromDelegator) > 0 {
+			for _, e := range x.StakeSumFromDelegator {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DelegatedStakes) > 0 {
+			for _, e := range x.DelegatedStakes {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.StakeFromDelegatorsUponReputer) > 0 {
+			for _, e := range x.StakeFromDelegatorsUponReputer {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DelegateRewardPerShare) > 0 {
+			for _, e := range x.DelegateRewardPerShare {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.StakeRemovalsByBlock) > 0 {
+			for _, e := range x.StakeRemovalsByBlock {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.StakeRemovalsByActor) > 0 {
+			for _, e := range x.StakeRemovalsByActor {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DelegateStakeRemovalsByBlock) > 0 {
+			for _, e := range x.DelegateStakeRemovalsByBlock {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DelegateStakeRemovalsByActor) > 0 {
+			for _, e := range x.DelegateStakeRemovalsByActor {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Inferences) > 0 {
+			for _, e := range x.Inferences {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Forecasts) > 0 {
+			for _, e := range x.Forecasts {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Workers) > 0 {
+			for _, e := range x.Workers {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Reputers) > 0 {
+			for _, e := range x.Reputers {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TopicFeeRevenue) > 0 {
+			for _, e := range x.TopicFeeRevenue {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PreviousTopicWeight) > 0 {
+			for _, e := range x.PreviousTopicWeight {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AllInferences) > 0 {
+			for _, e := range x.AllInferences {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AllForecasts) > 0 {
+			for _, e := range x.AllForecasts {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AllLossBundles) > 0 {
+			for _, e := range x.AllLossBundles {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NetworkLossBundles) > 0 {
+			for _, e := range x.NetworkLossBundles {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.PreviousPercentageRewardToStakedReputers)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.UnfulfilledWorkerNonces) > 0 {
+			for _, e := range x.UnfulfilledWorkerNonces {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.UnfulfilledReputerNonces) > 0 {
+			for _, e := range x.UnfulfilledReputerNonces {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LatestInfererNetworkRegrets) > 0 {
+			for _, e := range x.LatestInfererNetworkRegrets {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LatestForecasterNetworkRegrets) > 0 {
+			for _, e := range x.LatestForecasterNetworkRegrets {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LatestOneInForecasterNetworkRegrets) > 0 {
+			for _, e := range x.LatestOneInForecasterNetworkRegrets {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LatestNaiveInfererNetworkRegrets) > 0 {
+			for _, e := range x.LatestNaiveInfererNetworkRegrets {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LatestOneOutInfererInfererNetworkRegrets) > 0 {
+			for _, e := range x.LatestOneOutInfererInfererNetworkRegrets {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LatestOneOutInfererForecasterNetworkRegrets) > 0 {
+			for _, e := range x.LatestOneOutInfererForecasterNetworkRegrets {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LatestOneOutForecasterInfererNetworkRegrets) > 0 {
+			for _, e := range x.LatestOneOutForecasterInfererNetworkRegrets {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LatestOneOutForecasterForecasterNetworkRegrets) > 0 {
+			for _, e := range x.LatestOneOutForecasterForecasterNetworkRegrets {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TopicLastWorkerCommit) > 0 {
+			for _, e := range x.TopicLastWorkerCommit {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TopicLastReputerCommit) > 0 {
+			for _, e := range x.TopicLastReputerCommit {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.OpenWorkerWindows) > 0 {
+			for _, e := range x.OpenWorkerWindows {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LastDripBlock) > 0 {
+			for _, e := range x.LastDripBlock {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TopicToNextPossibleChurningBlock) > 0 {
+			for _, e := range x.TopicToNextPossibleChurningBlock {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BlockToActiveTopics) > 0 {
+			for _, e := range x.BlockToActiveTopics {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BlockToLowestActiveTopicWeight) > 0 {
+			for _, e := range x.BlockToLowestActiveTopicWeight {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.InfererScoreEmas) > 0 {
+			for _, e := range x.InfererScoreEmas {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ForecasterScoreEmas) > 0 {
+			for _, e := range x.ForecasterScoreEmas {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReputerScoreEmas) > 0 {
+			for _, e := range x.ReputerScoreEmas {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PreviousTopicQuantileInfererScoreEma) > 0 {
+			for _, e := range x.PreviousTopicQuantileInfererScoreEma {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PreviousTopicQuantileForecasterScoreEma) > 0 {
+			for _, e := range x.PreviousTopicQuantileForecasterScoreEma {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PreviousTopicQuantileReputerScoreEma) > 0 {
+			for _, e := range x.PreviousTopicQuantileReputerScoreEma {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CountInfererInclusionsInTopicActiveSet) > 0 {
+			for _, e := range x.CountInfererInclusionsInTopicActiveSet {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CountForecasterInclusionsInTopicActiveSet) > 0 {
+			for _, e := range x.CountForecasterInclusionsInTopicActiveSet {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ActiveInferers) > 0 {
+			for _, e := range x.ActiveInferers {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ActiveForecasters) > 0 {
+			for _, e := range x.ActiveForecasters {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LowestInfererScoreEma) > 0 {
+			for _, e := range x.LowestInfererScoreEma {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LowestForecasterScoreEma) > 0 {
+			for _, e := range x.LowestForecasterScoreEma {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ActiveReputers) > 0 {
+			for _, e := range x.ActiveReputers {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LowestReputerScoreEma) > 0 {
+			for _, e := range x.LowestReputerScoreEma {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LossBundles) > 0 {
+			for _, e := range x.LossBundles {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.TotalSumPreviousTopicWeights)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RewardCurrentBlockEmission)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.WhitelistAdmins) > 0 {
+			for _, s := range x.WhitelistAdmins {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.GlobalWhitelist) > 0 {
+			for _, s := range x.GlobalWhitelist {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TopicCreatorWhitelist) > 0 {
+			for _, s := range x.TopicCreatorWhitelist {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TopicWorkerWhitelist) > 0 {
+			for _, e := range x.TopicWorkerWhitelist {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TopicReputerWhitelist) > 0 {
+			for _, e := range x.TopicReputerWhitelist {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TopicWorkerWhitelistEnabled) > 0 {
+			l = 0
+			for _, e := range x.TopicWorkerWhitelistEnabled {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 2 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.TopicReputerWhitelistEnabled) > 0 {
+			l = 0
+			for _, e := range x.TopicReputerWhitelistEnabled {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 2 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.LastMedianInferences) > 0 {
+			for _, e := range x.LastMedianInferences {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MadInferences) > 0 {
+			for _, e := range x.MadInferences {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.InitialInfererEmaScore) > 0 {
+			for _, e := range x.InitialInfererEmaScore {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.InitialForecasterEmaScore) > 0 {
+			for _, e := range x.InitialForecasterEmaScore {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.InitialReputerEmaScore) > 0 {
+			for _, e := range x.InitialReputerEmaScore {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.GlobalWorkerWhitelist) > 0 {
+			for _, s := range x.GlobalWorkerWhitelist {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.GlobalReputerWhitelist) > 0 {
+			for _, s := range x.GlobalReputerWhitelist {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.GlobalAdminWhitelist) > 0 {
+			for _, s := range x.GlobalAdminWhitelist {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PendingTopicUpdates) > 0 {
+			for _, e := range x.PendingTopicUpdates {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ArchivedTopics) > 0 {
+			l = 0
+			for _, e := range x.ArchivedTopics {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 2 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.ArchivedTopicsToPrune) > 0 {
+			l = 0
+			for _, e := range x.ArchivedTopicsToPrune {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 2 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.TopicOwners) > 0 {
+			for _, e := range x.TopicOwners {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PendingTopicOwners) > 0 {
+			for _, e := range x.PendingTopicOwners {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TopicTags) > 0 {
+			for _, e := range x.TopicTags {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReputerGroundTruths) > 0 {
+			for _, e := range x.ReputerGroundTruths {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ConsensusGroundTruths) > 0 {
+			for _, e := range x.ConsensusGroundTruths {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.WorkerPayloadCommits) > 0 {
+			for _, e := range x.WorkerPayloadCommits {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ActorOperators) > 0 {
+			for _, e := range x.ActorOperators {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.WorkerBonds) > 0 {
+			for _, e := range x.WorkerBonds {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.WorkerBondRemovals) > 0 {
+			for _, e := range x.WorkerBondRemovals {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReputerConsensusDeviations) > 0 {
+			for _, e := range x.ReputerConsensusDeviations {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Redelegations) > 0 {
+			for _, e := range x.Redelegations {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AutoCompoundDelegators) > 0 {
+			for _, e := range x.AutoCompoundDelegators {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReputerCommissions) > 0 {
+			for _, e := range x.ReputerCommissions {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.StakeLocks) > 0 {
+			for _, e := range x.StakeLocks {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RewardHistory) > 0 {
+			for _, e := range x.RewardHistory {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RewardEscrows) > 0 {
+			for _, e := range x.RewardEscrows {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.QueuedRewardPayouts) > 0 {
+			for _, e := range x.QueuedRewardPayouts {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextRewardPayoutId != 0 {
+			n += 2 + runtime.Sov(uint64(x.NextRewardPayoutId))
+		}
+		if len(x.ArchivedTopicDelegateRemovalCursors) > 0 {
+			for _, e := range x.ArchivedTopicDelegateRemovalCursors {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DeadLetteredRewardPayouts) > 0 {
+			for _, e := range x.DeadLetteredRewardPayouts {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DeadLetteredRewardPayouts) > 0 {
+			for iNdEx := len(x.DeadLetteredRewardPayouts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DeadLetteredRewardPayouts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.ArchivedTopicDelegateRemovalCursors) > 0 {
+			for iNdEx := len(x.ArchivedTopicDelegateRemovalCursors) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ArchivedTopicDelegateRemovalCursors[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if x.NextRewardPayoutId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextRewardPayoutId))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.QueuedRewardPayouts) > 0 {
+			for iNdEx := len(x.QueuedRewardPayouts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.QueuedRewardPayouts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xfa
+			}
+		}
+		if len(x.RewardEscrows) > 0 {
+			for iNdEx := len(x.RewardEscrows) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardEscrows[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xf2
+			}
+		}
+		if len(x.RewardHistory) > 0 {
+			for iNdEx := len(x.RewardHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xea
+			}
+		}
+		if len(x.StakeLocks) > 0 {
+			for iNdEx := len(x.StakeLocks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StakeLocks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xe2
+			}
+		}
+		if len(x.ReputerCommissions) > 0 {
+			for iNdEx := len(x.ReputerCommissions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReputerCommissions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xda
+			}
+		}
+		if len(x.AutoCompoundDelegators) > 0 {
+			for iNdEx := len(x.AutoCompoundDelegators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AutoCompoundDelegators[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xd2
+			}
+		}
+		if len(x.Redelegations) > 0 {
+			for iNdEx := len(x.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Redelegations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xca
+			}
+		}
+		if len(x.ReputerConsensusDeviations) > 0 {
+			for iNdEx := len(x.ReputerConsensusDeviations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReputerConsensusDeviations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xc2
+			}
+		}
+		if len(x.WorkerBondRemovals) > 0 {
+			for iNdEx := len(x.WorkerBondRemovals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.WorkerBondRemovals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xba
+			}
+		}
+		if len(x.WorkerBonds) > 0 {
+			for iNdEx := len(x.WorkerBonds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.WorkerBonds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if len(x.ActorOperators) > 0 {
+			for iNdEx := len(x.ActorOperators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ActorOperators[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xaa
+			}
+		}
+		if len(x.WorkerPayloadCommits) > 0 {
+			for iNdEx := len(x.WorkerPayloadCommits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.WorkerPayloadCommits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if len(x.ConsensusGroundTruths) > 0 {
+			for iNdEx := len(x.ConsensusGroundTruths) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ConsensusGroundTruths[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if len(x.ReputerGroundTruths) > 0 {
+			for iNdEx := len(x.ReputerGroundTruths) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReputerGroundTruths[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.TopicTags) > 0 {
+			for iNdEx := len(x.TopicTags) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TopicTags[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.PendingTopicOwners) > 0 {
+			for iNdEx := len(x.PendingTopicOwners) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingTopicOwners[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.TopicOwners) > 0 {
+			for iNdEx := len(x.TopicOwners) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TopicOwners[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5
+				i--
+				dAtA[i] = 0xfa
+			}
+		}
+		if len(x.ArchivedTopicsToPrune) > 0 {
+			var pksize2 int
+			for _, num := range x.ArchivedTopicsToPrune {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.ArchivedTopicsToPrune {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xf2
+		}
+		if len(x.ArchivedTopics) > 0 {
+			var pksize4 int
+			for _, num := range x.ArchivedTopics {
+				pksize4 += runtime.Sov(uint64(num))
+			}
+			i -= pksize4
+			j3 := i
+			for _, num := range x.ArchivedTopics {
+				for num >= 1<<7 {
+					dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j3++
+				}
+				dAtA[j3] = uint8(num)
+				j3++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize4))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xea
+		}
+		if len(x.PendingTopicUpdates) > 0 {
+			for iNdEx := len(x.PendingTopicUpdates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingTopicUpdates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5
+				i--
+				dAtA[i] = 0xe2
+			}
+		}
+		if len(x.GlobalAdminWhitelist) > 0 {
+			for iNdEx := len(x.GlobalAdminWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.GlobalAdminWhitelist[iNdEx])
+				copy(dAtA[i:], x.GlobalAdminWhitelist[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GlobalAdminWhitelist[iNdEx])))
+				i--
+				dAtA[i] = 0x5
+				i--
+				dAtA[i] = 0xda
+			}
+		}
+		if len(x.GlobalReputerWhitelist) > 0 {
+			for iNdEx := len(x.GlobalReputerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.GlobalReputerWhitelist[iNdEx])
+				copy(dAtA[i:], x.GlobalReputerWhitelist[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GlobalReputerWhitelist[iNdEx])))
+				i--
+				dAtA[i] = 0x5
+				i--
+				dAtA[i] = 0xd2
+			}
+		}
+		if len(x.GlobalWorkerWhitelist) > 0 {
+			for iNdEx := len(x.GlobalWorkerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.GlobalWorkerWhitelist[iNdEx])
+				copy(dAtA[i:], x.GlobalWorkerWhitelist[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GlobalWorkerWhitelist[iNdEx])))
+				i--
+				dAtA[i] = 0x5
+				i--
+				dAtA[i] = 0xca
+			}
+		}
+		if len(x.InitialReputerEmaScore) > 0 {
+			for iNdEx := len(x.InitialReputerEmaScore) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InitialReputerEmaScore[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5
+				i--
+				dAtA[i] = 0xc2
+			}
+		}
+		if len(x.InitialForecasterEmaScore) > 0 {
+			for iNdEx := len(x.InitialForecasterEmaScore) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InitialForecasterEmaScore[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5
+				i--
+				dAtA[i] = 0xba
+			}
+		}
+		if len(x.InitialInfererEmaScore) > 0 {
+			for iNdEx := len(x.InitialInfererEmaScore) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InitialInfererEmaScore[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if len(x.MadInferences) > 0 {
+			for iNdEx := len(x.MadInferences) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MadInferences[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5
+				i--
+				dAtA[i] = 0xaa
+			}
+		}
+		if len(x.LastMedianInferences) > 0 {
+			for iNdEx := len(x.LastMedianInferences) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LastMedianInferences[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if len(x.TopicReputerWhitelistEnabled) > 0 {
+			var pksize6 int
+			for _, num := range x.TopicReputerWhitelistEnabled {
+				pksize6 += runtime.Sov(uint64(num))
+			}
+			i -= pksize6
+			j5 := i
+			for _, num := range x.TopicReputerWhitelistEnabled {
+				for num >= 1<<7 {
+					dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j5++
+				}
+				dAtA[j5] = uint8(num)
+				j5++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize6))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.TopicWorkerWhitelistEnabled) > 0 {
+			var pksize8 int
+			for _, num := range x.TopicWorkerWhitelistEnabled {
+				pksize8 += runtime.Sov(uint64(num))
+			}
+			i -= pksize8
+			j7 := i
+			for _, num := range x.TopicWorkerWhitelistEnabled {
+				for num >= 1<<7 {
+					dAtA[j7] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j7++
+				}
+				dAtA[j7] = uint8(num)
+				j7++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize8))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.TopicReputerWhitelist) > 0 {
+			for iNdEx := len(x.TopicReputerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TopicReputerWhitelist[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.TopicWorkerWhitelist) > 0 {
+			for iNdEx := len(x.TopicWorkerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TopicWorkerWhitelist[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.TopicCreatorWhitelist) > 0 {
+			for iNdEx := len(x.TopicCreatorWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.TopicCreatorWhitelist[iNdEx])
+				copy(dAtA[i:], x.TopicCreatorWhitelist[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TopicCreatorWhitelist[iNdEx])))
+				i--
+				dAtA[i] = 0x4
+				i--
+				dAtA[i] = 0xfa
+			}
+		}
+		if len(x.GlobalWhitelist) > 0 {
+			for iNdEx := len(x.GlobalWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.GlobalWhitelist[iNdEx])
+				copy(dAtA[i:], x.GlobalWhitelist[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GlobalWhitelist[iNdEx])))
+				i--
+				dAtA[i] = 0x4
+				i--
+				dAtA[i] = 0xf2
+			}
+		}
+		if len(x.WhitelistAdmins) > 0 {
+			for iNdEx := len(x.WhitelistAdmins) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.WhitelistAdmins[iNdEx])
+				copy(dAtA[i:], x.WhitelistAdmins[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WhitelistAdmins[iNdEx])))
+				i--
+				dAtA[i] = 0x4
+				i--
+				dAtA[i] = 0xea
+			}
+		}
+		if len(x.RewardCurrentBlockEmission) > 0 {
+			i -= len(x.RewardCurrentBlockEmission)
+			copy(dAtA[i:], x.RewardCurrentBlockEmission)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardCurrentBlockEmission)))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xe2
+		}
+		if len(x.TotalSumPreviousTopicWeights) > 0 {
+			i -= len(x.TotalSumPreviousTopicWeights)
+			copy(dAtA[i:], x.TotalSumPreviousTopicWeights)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalSumPreviousTopicWeights)))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xda
+		}
+		if len(x.LossBundles) > 0 {
+			for iNdEx := len(x.LossBundles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LossBundles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4
+				i--
+				dAtA[i] = 0xd2
+			}
+		}
+		if len(x.LowestReputerScoreEma) > 0 {
+			for iNdEx := len(x.LowestReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LowestReputerScoreEma[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4
+				i--
+				dAtA[i] = 0xca
+			}
+		}
+		if len(x.ActiveReputers) > 0 {
+			for iNdEx := len(x.ActiveReputers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ActiveReputers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4
+				i--
+				dAtA[i] = 0xc2
+			}
+		}
+		if len(x.LowestForecasterScoreEma) > 0 {
+			for iNdEx := len(x.LowestForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LowestForecasterScoreEma[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4
+				i--
+				dAtA[i] = 0xba
+			}
+		}
+		if len(x.LowestInfererScoreEma) > 0 {
+			for iNdEx := len(x.LowestInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LowestInfererScoreEma[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if len(x.ActiveForecasters) > 0 {
+			for iNdEx := len(x.ActiveForecasters) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ActiveForecasters[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4
+				i--
+				dAtA[i] = 0xaa
+			}
+		}
+		if len(x.ActiveInferers) > 0 {
+			for iNdEx := len(x.ActiveInferers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ActiveInferers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if len(x.CountForecasterInclusionsInTopicActiveSet) > 0 {
+			for iNdEx := len(x.CountForecasterInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CountForecasterInclusionsInTopicActiveSet[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if len(x.CountInfererInclusionsInTopicActiveSet) > 0 {
+			for iNdEx := len(x.CountInfererInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CountInfererInclusionsInTopicActiveSet[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.PreviousTopicQuantileReputerScoreEma) > 0 {
+			for iNdEx := len(x.PreviousTopicQuantileReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PreviousTopicQuantileReputerScoreEma[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.PreviousTopicQuantileForecasterScoreEma) > 0 {
+			for iNdEx := len(x.PreviousTopicQuantileForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PreviousTopicQuantileForecasterScoreEma[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.PreviousTopicQuantileInfererScoreEma) > 0 {
+			for iNdEx := len(x.PreviousTopicQuantileInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PreviousTopicQuantileInfererScoreEma[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0xfa
+			}
+		}
+		if len(x.ReputerScoreEmas) > 0 {
+			for iNdEx := len(x.ReputerScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReputerScoreEmas[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0xf2
+			}
+		}
+		if len(x.ForecasterScoreEmas) > 0 {
+			for iNdEx := len(x.ForecasterScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ForecasterScoreEmas[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0xea
+			}
+		}
+		if len(x.InfererScoreEmas) > 0 {
+			for iNdEx := len(x.InfererScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InfererScoreEmas[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0xe2
+			}
+		}
+		if len(x.BlockToLowestActiveTopicWeight) > 0 {
+			for iNdEx := len(x.BlockToLowestActiveTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BlockToLowestActiveTopicWeight[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0xda
+			}
+		}
+		if len(x.BlockToActiveTopics) > 0 {
+			for iNdEx := len(x.BlockToActiveTopics) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BlockToActiveTopics[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0xd2
+			}
 		}
-		if len(x.AllLossBundles) > 0 {
-			for _, e := range x.AllLossBundles {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.TopicToNextPossibleChurningBlock) > 0 {
+			for iNdEx := len(x.TopicToNextPossibleChurningBlock) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TopicToNextPossibleChurningBlock[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0xca
 			}
 		}
-		if len(x.NetworkLossBundles) > 0 {
-			for _, e := range x.NetworkLossBundles {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.LastDripBlock) > 0 {
+			for iNdEx := len(x.LastDripBlock) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LastDripBlock[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0xc2
 			}
 		}
-		l = len(x.PreviousPercentageRewardToStakedReputers)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.OpenWorkerWindows) > 0 {
+			for iNdEx := len(x.OpenWorkerWindows) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OpenWorkerWindows[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0xba
+			}
 		}
-		if len(x.UnfulfilledWorkerNonces) > 0 {
-			for _, e := range x.UnfulfilledWorkerNonces {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.TopicLastReputerCommit) > 0 {
+			for iNdEx := len(x.TopicLastReputerCommit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TopicLastReputerCommit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0xb2
 			}
 		}
-		if len(x.UnfulfilledReputerNonces) > 0 {
-			for _, e := range x.UnfulfilledReputerNonces {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.TopicLastWorkerCommit) > 0 {
+			for iNdEx := len(x.TopicLastWorkerCommit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TopicLastWorkerCommit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0xaa
 			}
 		}
-		if len(x.LatestInfererNetworkRegrets) > 0 {
-			for _, e := range x.LatestInfererNetworkRegrets {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.LatestOneOutForecasterForecasterNetworkRegrets) > 0 {
+			for iNdEx := len(x.LatestOneOutForecasterForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LatestOneOutForecasterForecasterNetworkRegrets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0xa2
 			}
 		}
-		if len(x.LatestForecasterNetworkRegrets) > 0 {
-			for _, e := range x.LatestForecasterNetworkRegrets {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.LatestOneOutForecasterInfererNetworkRegrets) > 0 {
+			for iNdEx := len(x.LatestOneOutForecasterInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LatestOneOutForecasterInfererNetworkRegrets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0x9a
 			}
 		}
-		if len(x.LatestOneInForecasterNetworkRegrets) > 0 {
-			for _, e := range x.LatestOneInForecasterNetworkRegrets {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.LatestOneOutInfererForecasterNetworkRegrets) > 0 {
+			for iNdEx := len(x.LatestOneOutInfererForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LatestOneOutInfererForecasterNetworkRegrets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.LatestOneOutInfererInfererNetworkRegrets) > 0 {
+			for iNdEx := len(x.LatestOneOutInfererInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LatestOneOutInfererInfererNetworkRegrets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0x8a
 			}
 		}
 		if len(x.LatestNaiveInfererNetworkRegrets) > 0 {
-			for _, e := range x.LatestNaiveInfererNetworkRegrets {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+			for iNdEx := len(x.LatestNaiveInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LatestNaiveInfererNetworkRegrets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.LatestOneInForecasterNetworkRegrets) > 0 {
+			for iNdEx := len(x.LatestOneInForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LatestOneInForecasterNetworkRegrets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xfa
+			}
+		}
+		if len(x.LatestForecasterNetworkRegrets) > 0 {
+			for iNdEx := len(x.LatestForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LatestForecasterNetworkRegrets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xf2
+			}
+		}
+		if len(x.LatestInfererNetworkRegrets) > 0 {
+			for iNdEx := len(x.LatestInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LatestInfererNetworkRegrets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xea
 			}
 		}
-		if len(x.LatestOneOutInfererInfererNetworkRegrets) > 0 {
-			for _, e := range x.LatestOneOutInfererInfererNetworkRegrets {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.UnfulfilledReputerNonces) > 0 {
+			for iNdEx := len(x.UnfulfilledReputerNonces) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UnfulfilledReputerNonces[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xe2
 			}
 		}
-		if len(x.LatestOneOutInfererForecasterNetworkRegrets) > 0 {
-			for _, e := range x.LatestOneOutInfererForecasterNetworkRegrets {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.UnfulfilledWorkerNonces) > 0 {
+			for iNdEx := len(x.UnfulfilledWorkerNonces) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UnfulfilledWorkerNonces[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xda
 			}
 		}
-		if len(x.LatestOneOutForecasterInfererNetworkRegrets) > 0 {
-			for _, e := range x.LatestOneOutForecasterInfererNetworkRegrets {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
+		if len(x.PreviousPercentageRewardToStakedReputers) > 0 {
+			i -= len(x.PreviousPercentageRewardToStakedReputers)
+			copy(dAtA[i:], x.PreviousPercentageRewardToStakedReputers)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousPercentageRewardToStakedReputers)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd2
 		}
-		if len(x.LatestOneOutForecasterForecasterNetworkRegrets) > 0 {
-			for _, e := range x.LatestOneOutForecasterForecasterNetworkRegrets {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.NetworkLossBundles) > 0 {
+			for iNdEx := len(x.NetworkLossBundles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NetworkLossBundles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xca
 			}
 		}
-		if len(x.TopicLastWorkerCommit) > 0 {
-			for _, e := range x.TopicLastWorkerCommit {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.AllLossBundles) > 0 {
+			for iNdEx := len(x.AllLossBundles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AllLossBundles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xc2
 			}
 		}
-		if len(x.TopicLastReputerCommit) > 0 {
-			for _, e := range x.TopicLastReputerCommit {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.AllForecasts) > 0 {
+			for iNdEx := len(x.AllForecasts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AllForecasts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xba
 			}
 		}
-		if len(x.OpenWorkerWindows) > 0 {
-			for _, e := range x.OpenWorkerWindows {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.AllInferences) > 0 {
+			for iNdEx := len(x.AllInferences) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AllInferences[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xb2
 			}
 		}
-		if len(x.LastDripBlock) > 0 {
-			for _, e := range x.LastDripBlock {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.PreviousTopicWeight) > 0 {
+			for iNdEx := len(x.PreviousTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PreviousTopicWeight[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xaa
 			}
 		}
-		if len(x.TopicToNextPossibleChurningBlock) > 0 {
-			for _, e := range x.TopicToNextPossibleChurningBlock {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.TopicFeeRevenue) > 0 {
+			for iNdEx := len(x.TopicFeeRevenue) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TopicFeeRevenue[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xa2
 			}
 		}
-		if len(x.BlockToActiveTopics) > 0 {
-			for _, e := range x.BlockToActiveTopics {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.Reputers) > 0 {
+			for iNdEx := len(x.Reputers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Reputers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0x9a
 			}
 		}
-		if len(x.BlockToLowestActiveTopicWeight) > 0 {
-			for _, e := range x.BlockToLowestActiveTopicWeight {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.Workers) > 0 {
+			for iNdEx := len(x.Workers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Workers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0x92
 			}
 		}
-		if len(x.InfererScoreEmas) > 0 {
-			for _, e := range x.InfererScoreEmas {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.Forecasts) > 0 {
+			for iNdEx := len(x.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Forecasts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0x8a
 			}
 		}
-		if len(x.ForecasterScoreEmas) > 0 {
-			for _, e := range x.ForecasterScoreEmas {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.Inferences) > 0 {
+			for iNdEx := len(x.Inferences) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Inferences[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0x82
 			}
 		}
-		if len(x.ReputerScoreEmas) > 0 {
-			for _, e := range x.ReputerScoreEmas {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.DelegateStakeRemovalsByActor) > 0 {
+			for iNdEx := len(x.DelegateStakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DelegateStakeRemovalsByActor[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xfa
 			}
 		}
-		if len(x.PreviousTopicQuantileInfererScoreEma) > 0 {
-			for _, e := range x.PreviousTopicQuantileInfererScoreEma {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.DelegateStakeRemovalsByBlock) > 0 {
+			for iNdEx := len(x.DelegateStakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DelegateStakeRemovalsByBlock[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xf2
 			}
 		}
-		if len(x.PreviousTopicQuantileForecasterScoreEma) > 0 {
-			for _, e := range x.PreviousTopicQuantileForecasterScoreEma {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.StakeRemovalsByActor) > 0 {
+			for iNdEx := len(x.StakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StakeRemovalsByActor[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xea
 			}
 		}
-		if len(x.PreviousTopicQuantileReputerScoreEma) > 0 {
-			for _, e := range x.PreviousTopicQuantileReputerScoreEma {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.StakeRemovalsByBlock) > 0 {
+			for iNdEx := len(x.StakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StakeRemovalsByBlock[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xe2
 			}
 		}
-		if len(x.CountInfererInclusionsInTopicActiveSet) > 0 {
-			for _, e := range x.CountInfererInclusionsInTopicActiveSet {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.DelegateRewardPerShare) > 0 {
+			for iNdEx := len(x.DelegateRewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DelegateRewardPerShare[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xda
 			}
 		}
-		if len(x.CountForecasterInclusionsInTopicActiveSet) > 0 {
-			for _, e := range x.CountForecasterInclusionsInTopicActiveSet {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.StakeFromDelegatorsUponReputer) > 0 {
+			for iNdEx := len(x.StakeFromDelegatorsUponReputer) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StakeFromDelegatorsUponReputer[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xd2
 			}
 		}
-		if len(x.ActiveInferers) > 0 {
-			for _, e := range x.ActiveInferers {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.DelegatedStakes) > 0 {
+			for iNdEx := len(x.DelegatedStakes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DelegatedStakes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xca
 			}
 		}
-		if len(x.ActiveForecasters) > 0 {
-			for _, e := range x.ActiveForecasters {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.StakeSumFromDelegator) > 0 {
+			for iNdEx := len(x.StakeSumFromDelegator) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StakeSumFromDelegator[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xc2
 			}
 		}
-		if len(x.LowestInfererScoreEma) > 0 {
-			for _, e := range x.LowestInfererScoreEma {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.StakeReputerAuthority) > 0 {
+			for iNdEx := len(x.StakeReputerAuthority) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StakeReputerAuthority[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xba
 			}
 		}
-		if len(x.LowestForecasterScoreEma) > 0 {
-			for _, e := range x.LowestForecasterScoreEma {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.TopicStake) > 0 {
+			for iNdEx := len(x.TopicStake) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TopicStake[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xb2
 			}
 		}
-		if len(x.ActiveReputers) > 0 {
-			for _, e := range x.ActiveReputers {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
-			}
+		if len(x.TotalStake) > 0 {
+			i -= len(x.TotalStake)
+			copy(dAtA[i:], x.TotalStake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalStake)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
 		}
-		if len(x.LowestReputerScoreEma) > 0 {
-			for _, e := range x.LowestReputerScoreEma {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.PreviousForecasterScoreRatio) > 0 {
+			for iNdEx := len(x.PreviousForecasterScoreRatio) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PreviousForecasterScoreRatio[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xa2
 			}
 		}
-		if len(x.LossBundles) > 0 {
-			for _, e := range x.LossBundles {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.PreviousForecastRewardFraction) > 0 {
+			for iNdEx := len(x.PreviousForecastRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PreviousForecastRewardFraction[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
 			}
 		}
-		l = len(x.TotalSumPreviousTopicWeights)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.RewardCurrentBlockEmission)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.WhitelistAdmins) > 0 {
-			for _, s := range x.WhitelistAdmins {
-				l = len(s)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.PreviousInferenceRewardFraction) > 0 {
+			for iNdEx := len(x.PreviousInferenceRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PreviousInferenceRewardFraction[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
 			}
 		}
-		if len(x.GlobalWhitelist) > 0 {
-			for _, s := range x.GlobalWhitelist {
-				l = len(s)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.PreviousReputerRewardFraction) > 0 {
+			for iNdEx := len(x.PreviousReputerRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PreviousReputerRewardFraction[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
 			}
 		}
-		if len(x.TopicCreatorWhitelist) > 0 {
-			for _, s := range x.TopicCreatorWhitelist {
-				l = len(s)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.ReputerListeningCoefficient) > 0 {
+			for iNdEx := len(x.ReputerListeningCoefficient) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReputerListeningCoefficient[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
 			}
 		}
-		if len(x.TopicWorkerWhitelist) > 0 {
-			for _, e := range x.TopicWorkerWhitelist {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.ReputerScoresByBlock) > 0 {
+			for iNdEx := len(x.ReputerScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReputerScoresByBlock[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
 			}
 		}
-		if len(x.TopicReputerWhitelist) > 0 {
-			for _, e := range x.TopicReputerWhitelist {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.ForecasterScoresByBlock) > 0 {
+			for iNdEx := len(x.ForecasterScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ForecasterScoresByBlock[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
 			}
 		}
-		if len(x.TopicWorkerWhitelistEnabled) > 0 {
-			l = 0
-			for _, e := range x.TopicWorkerWhitelistEnabled {
-				l += runtime.Sov(uint64(e))
+		if len(x.InfererScoresByBlock) > 0 {
+			for iNdEx := len(x.InfererScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InfererScoresByBlock[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
 			}
-			n += 2 + runtime.Sov(uint64(l)) + l
 		}
-		if len(x.TopicReputerWhitelistEnabled) > 0 {
-			l = 0
-			for _, e := range x.TopicReputerWhitelistEnabled {
-				l += runtime.Sov(uint64(e))
+		if len(x.TopicRewardNonce) > 0 {
+			for iNdEx := len(x.TopicRewardNonce) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TopicRewardNonce[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
 			}
-			n += 2 + runtime.Sov(uint64(l)) + l
 		}
-		if len(x.LastMedianInferences) > 0 {
-			for _, e := range x.LastMedianInferences {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.TopicReputers) > 0 {
+			for iNdEx := len(x.TopicReputers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TopicReputers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
 			}
 		}
-		if len(x.MadInferences) > 0 {
-			for _, e := range x.MadInferences {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.TopicWorkers) > 0 {
+			for iNdEx := len(x.TopicWorkers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TopicWorkers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
 			}
 		}
-		if len(x.InitialInfererEmaScore) > 0 {
-			for _, e := range x.InitialInfererEmaScore {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.RewardableTopics) > 0 {
+			var pksize10 int
+			for _, num := range x.RewardableTopics {
+				pksize10 += runtime.Sov(uint64(num))
 			}
-		}
-		if len(x.InitialForecasterEmaScore) > 0 {
-			for _, e := range x.InitialForecasterEmaScore {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+			i -= pksize10
+			j9 := i
+			for _, num := range x.RewardableTopics {
+				for num >= 1<<7 {
+					dAtA[j9] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j9++
+				}
+				dAtA[j9] = uint8(num)
+				j9++
 			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize10))
+			i--
+			dAtA[i] = 0x32
 		}
-		if len(x.InitialReputerEmaScore) > 0 {
-			for _, e := range x.InitialReputerEmaScore {
-				l = options.Size(e)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.ActiveTopics) > 0 {
+			var pksize12 int
+			for _, num := range x.ActiveTopics {
+				pksize12 += runtime.Sov(uint64(num))
+			}
+			i -= pksize12
+			j11 := i
+			for _, num := range x.ActiveTopics {
+				for num >= 1<<7 {
+					dAtA[j11] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j11++
+				}
+				dAtA[j11] = uint8(num)
+				j11++
 			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize12))
+			i--
+			dAtA[i] = 0x2a
 		}
-		if len(x.GlobalWorkerWhitelist) > 0 {
-			for _, s := range x.GlobalWorkerWhitelist {
-				l = len(s)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.Topics) > 0 {
+			for iNdEx := len(x.Topics) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Topics[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
 			}
 		}
-		if len(x.GlobalReputerWhitelist) > 0 {
-			for _, s := range x.GlobalReputerWhitelist {
-				l = len(s)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if x.NextTopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextTopicId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.CoreTeamAddresses) > 0 {
+			for iNdEx := len(x.CoreTeamAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CoreTeamAddresses[iNdEx])
+				copy(dAtA[i:], x.CoreTeamAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CoreTeamAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x12
 			}
 		}
-		if len(x.GlobalAdminWhitelist) > 0 {
-			for _, s := range x.GlobalAdminWhitelist {
-				l = len(s)
-				n += 2 + l + runtime.Sov(uint64(l))
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
-		return protoiface.SizeOutput{
+		return protoiface.MarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+			Buf:               input.Buf,
+		}, nil
 	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
 		x := input.Message.Interface().(*GenesisState)
 		if x == nil {
-			return protoiface.MarshalOutput{
+			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
+				Flags:             input.Flags,
 			}, nil
 		}
-		options := runtime.MarshalInputToOptions(input)
+		options := runtime.UnmarshalInputToOptions(input)
 		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.GlobalAdminWhitelist) > 0 {
-			for iNdEx := len(x.GlobalAdminWhitelist) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.GlobalAdminWhitelist[iNdEx])
-				copy(dAtA[i:], x.GlobalAdminWhitelist[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GlobalAdminWhitelist[iNdEx])))
-				i--
-				dAtA[i] = 0x5
-				i--
-				dAtA[i] = 0xda
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		if len(x.GlobalReputerWhitelist) > 0 {
-			for iNdEx := len(x.GlobalReputerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.GlobalReputerWhitelist[iNdEx])
-				copy(dAtA[i:], x.GlobalReputerWhitelist[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GlobalReputerWhitelist[iNdEx])))
-				i--
-				dAtA[i] = 0x5
-				i--
-				dAtA[i] = 0xd2
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 			}
-		}
-		if len(x.GlobalWorkerWhitelist) > 0 {
-			for iNdEx := len(x.GlobalWorkerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.GlobalWorkerWhitelist[iNdEx])
-				copy(dAtA[i:], x.GlobalWorkerWhitelist[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GlobalWorkerWhitelist[iNdEx])))
-				i--
-				dAtA[i] = 0x5
-				i--
-				dAtA[i] = 0xca
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
-		}
-		if len(x.InitialReputerEmaScore) > 0 {
-			for iNdEx := len(x.InitialReputerEmaScore) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.InitialReputerEmaScore[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CoreTeamAddresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CoreTeamAddresses = append(x.CoreTeamAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextTopicId", wireType)
+				}
+				x.NextTopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextTopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Topics = append(x.Topics, &TopicIdAndTopic{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Topics[len(x.Topics)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.ActiveTopics = append(x.ActiveTopics, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.ActiveTopics) == 0 {
+						x.ActiveTopics = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.ActiveTopics = append(x.ActiveTopics, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveTopics", wireType)
+				}
+			case 6:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RewardableTopics = append(x.RewardableTopics, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.RewardableTopics) == 0 {
+						x.RewardableTopics = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.RewardableTopics = append(x.RewardableTopics, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardableTopics", wireType)
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicWorkers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TopicWorkers = append(x.TopicWorkers, &TopicAndActorId{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicWorkers[len(x.TopicWorkers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicReputers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TopicReputers = append(x.TopicReputers, &TopicAndActorId{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicReputers[len(x.TopicReputers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicRewardNonce", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TopicRewardNonce = append(x.TopicRewardNonce, &TopicIdAndBlockHeight{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicRewardNonce[len(x.TopicRewardNonce)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InfererScoresByBlock", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InfererScoresByBlock = append(x.InfererScoresByBlock, &TopicIdBlockHeightScores{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InfererScoresByBlock[len(x.InfererScoresByBlock)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoresByBlock", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ForecasterScoresByBlock = append(x.ForecasterScoresByBlock, &TopicIdBlockHeightScores{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ForecasterScoresByBlock[len(x.ForecasterScoresByBlock)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerScoresByBlock", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputerScoresByBlock = append(x.ReputerScoresByBlock, &TopicIdBlockHeightScores{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReputerScoresByBlock[len(x.ReputerScoresByBlock)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerListeningCoefficient", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputerListeningCoefficient = append(x.ReputerListeningCoefficient, &TopicIdActorIdListeningCoefficient{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReputerListeningCoefficient[len(x.ReputerListeningCoefficient)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousReputerRewardFraction", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousReputerRewardFraction = append(x.PreviousReputerRewardFraction, &TopicIdActorIdDec{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousReputerRewardFraction[len(x.PreviousReputerRewardFraction)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousInferenceRewardFraction", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousInferenceRewardFraction = append(x.PreviousInferenceRewardFraction, &TopicIdActorIdDec{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousInferenceRewardFraction[len(x.PreviousInferenceRewardFraction)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousForecastRewardFraction", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousForecastRewardFraction = append(x.PreviousForecastRewardFraction, &TopicIdActorIdDec{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousForecastRewardFraction[len(x.PreviousForecastRewardFraction)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousForecasterScoreRatio", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousForecasterScoreRatio = append(x.PreviousForecasterScoreRatio, &TopicIdAndDec{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousForecasterScoreRatio[len(x.PreviousForecasterScoreRatio)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x5
-				i--
-				dAtA[i] = 0xc2
-			}
-		}
-		if len(x.InitialForecasterEmaScore) > 0 {
-			for iNdEx := len(x.InitialForecasterEmaScore) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.InitialForecasterEmaScore[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalStake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicStake", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TopicStake = append(x.TopicStake, &TopicIdAndInt{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicStake[len(x.TopicStake)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeReputerAuthority", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StakeReputerAuthority = append(x.StakeReputerAuthority, &TopicIdActorIdInt{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StakeReputerAuthority[len(x.StakeReputerAuthority)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeSumFromDelegator", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StakeSumFromDelegator = append(x.StakeSumFromDelegator, &TopicIdActorIdInt{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StakeSumFromDelegator[len(x.StakeSumFromDelegator)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatedStakes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatedStakes = append(x.DelegatedStakes, &TopicIdDelegatorReputerDelegatorInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DelegatedStakes[len(x.DelegatedStakes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 26:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeFromDelegatorsUponReputer", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StakeFromDelegatorsUponReputer = append(x.StakeFromDelegatorsUponReputer, &TopicIdActorIdInt{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StakeFromDelegatorsUponReputer[len(x.StakeFromDelegatorsUponReputer)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 27:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegateRewardPerShare", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegateRewardPerShare = append(x.DelegateRewardPerShare, &TopicIdActorIdDec{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DelegateRewardPerShare[len(x.DelegateRewardPerShare)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 28:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByBlock", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x5
-				i--
-				dAtA[i] = 0xba
-			}
-		}
-		if len(x.InitialInfererEmaScore) > 0 {
-			for iNdEx := len(x.InitialInfererEmaScore) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.InitialInfererEmaScore[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x5
-				i--
-				dAtA[i] = 0xb2
-			}
-		}
-		if len(x.MadInferences) > 0 {
-			for iNdEx := len(x.MadInferences) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MadInferences[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				x.StakeRemovalsByBlock = append(x.StakeRemovalsByBlock, &BlockHeightTopicIdReputerStakeRemovalInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StakeRemovalsByBlock[len(x.StakeRemovalsByBlock)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x5
-				i--
-				dAtA[i] = 0xaa
-			}
-		}
-		if len(x.LastMedianInferences) > 0 {
-			for iNdEx := len(x.LastMedianInferences) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.LastMedianInferences[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				iNdEx = postIndex
+			case 29:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByActor", wireType)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x5
-				i--
-				dAtA[i] = 0xa2
-			}
-		}
-		if len(x.TopicReputerWhitelistEnabled) > 0 {
-			var pksize2 int
-			for _, num := range x.TopicReputerWhitelistEnabled {
-				pksize2 += runtime.Sov(uint64(num))
-			}
-			i -= pksize2
-			j1 := i
-			for _, num := range x.TopicReputerWhitelistEnabled {
-				for num >= 1<<7 {
-					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j1++
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				dAtA[j1] = uint8(num)
-				j1++
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0x9a
-		}
-		if len(x.TopicWorkerWhitelistEnabled) > 0 {
-			var pksize4 int
-			for _, num := range x.TopicWorkerWhitelistEnabled {
-				pksize4 += runtime.Sov(uint64(num))
-			}
-			i -= pksize4
-			j3 := i
-			for _, num := range x.TopicWorkerWhitelistEnabled {
-				for num >= 1<<7 {
-					dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j3++
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				dAtA[j3] = uint8(num)
-				j3++
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize4))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0x92
-		}
-		if len(x.TopicReputerWhitelist) > 0 {
-			for iNdEx := len(x.TopicReputerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TopicReputerWhitelist[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x5
-				i--
-				dAtA[i] = 0x8a
-			}
-		}
-		if len(x.TopicWorkerWhitelist) > 0 {
-			for iNdEx := len(x.TopicWorkerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TopicWorkerWhitelist[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x5
-				i--
-				dAtA[i] = 0x82
-			}
-		}
-		if len(x.TopicCreatorWhitelist) > 0 {
-			for iNdEx := len(x.TopicCreatorWhitelist) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.TopicCreatorWhitelist[iNdEx])
-				copy(dAtA[i:], x.TopicCreatorWhitelist[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TopicCreatorWhitelist[iNdEx])))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0xfa
-			}
-		}
-		if len(x.GlobalWhitelist) > 0 {
-			for iNdEx := len(x.GlobalWhitelist) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.GlobalWhitelist[iNdEx])
-				copy(dAtA[i:], x.GlobalWhitelist[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GlobalWhitelist[iNdEx])))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0xf2
-			}
-		}
-		if len(x.WhitelistAdmins) > 0 {
-			for iNdEx := len(x.WhitelistAdmins) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.WhitelistAdmins[iNdEx])
-				copy(dAtA[i:], x.WhitelistAdmins[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WhitelistAdmins[iNdEx])))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0xea
-			}
-		}
-		if len(x.RewardCurrentBlockEmission) > 0 {
-			i -= len(x.RewardCurrentBlockEmission)
-			copy(dAtA[i:], x.RewardCurrentBlockEmission)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardCurrentBlockEmission)))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xe2
-		}
-		if len(x.TotalSumPreviousTopicWeights) > 0 {
-			i -= len(x.TotalSumPreviousTopicWeights)
-			copy(dAtA[i:], x.TotalSumPreviousTopicWeights)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalSumPreviousTopicWeights)))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xda
-		}
-		if len(x.LossBundles) > 0 {
-			for iNdEx := len(x.LossBundles) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.LossBundles[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				x.StakeRemovalsByActor = append(x.StakeRemovalsByActor, &ActorIdTopicIdBlockHeight{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StakeRemovalsByActor[len(x.StakeRemovalsByActor)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0xd2
-			}
-		}
-		if len(x.LowestReputerScoreEma) > 0 {
-			for iNdEx := len(x.LowestReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.LowestReputerScoreEma[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				iNdEx = postIndex
+			case 30:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByBlock", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0xca
-			}
-		}
-		if len(x.ActiveReputers) > 0 {
-			for iNdEx := len(x.ActiveReputers) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ActiveReputers[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0xc2
-			}
-		}
-		if len(x.LowestForecasterScoreEma) > 0 {
-			for iNdEx := len(x.LowestForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.LowestForecasterScoreEma[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0xba
-			}
-		}
-		if len(x.LowestInfererScoreEma) > 0 {
-			for iNdEx := len(x.LowestInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.LowestInfererScoreEma[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0xb2
-			}
-		}
-		if len(x.ActiveForecasters) > 0 {
-			for iNdEx := len(x.ActiveForecasters) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ActiveForecasters[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				x.DelegateStakeRemovalsByBlock = append(x.DelegateStakeRemovalsByBlock, &BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DelegateStakeRemovalsByBlock[len(x.DelegateStakeRemovalsByBlock)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0xaa
-			}
-		}
-		if len(x.ActiveInferers) > 0 {
-			for iNdEx := len(x.ActiveInferers) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ActiveInferers[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				iNdEx = postIndex
+			case 31:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByActor", wireType)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0xa2
-			}
-		}
-		if len(x.CountForecasterInclusionsInTopicActiveSet) > 0 {
-			for iNdEx := len(x.CountForecasterInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.CountForecasterInclusionsInTopicActiveSet[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0x9a
-			}
-		}
-		if len(x.CountInfererInclusionsInTopicActiveSet) > 0 {
-			for iNdEx := len(x.CountInfererInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.CountInfererInclusionsInTopicActiveSet[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0x92
-			}
-		}
-		if len(x.PreviousTopicQuantileReputerScoreEma) > 0 {
-			for iNdEx := len(x.PreviousTopicQuantileReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PreviousTopicQuantileReputerScoreEma[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0x8a
-			}
-		}
-		if len(x.PreviousTopicQuantileForecasterScoreEma) > 0 {
-			for iNdEx := len(x.PreviousTopicQuantileForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PreviousTopicQuantileForecasterScoreEma[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4
-				i--
-				dAtA[i] = 0x82
-			}
-		}
-		if len(x.PreviousTopicQuantileInfererScoreEma) > 0 {
-			for iNdEx := len(x.PreviousTopicQuantileInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PreviousTopicQuantileInfererScoreEma[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				x.DelegateStakeRemovalsByActor = append(x.DelegateStakeRemovalsByActor, &DelegatorReputerTopicIdBlockHeight{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DelegateStakeRemovalsByActor[len(x.DelegateStakeRemovalsByActor)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xfa
-			}
-		}
-		if len(x.ReputerScoreEmas) > 0 {
-			for iNdEx := len(x.ReputerScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ReputerScoreEmas[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				iNdEx = postIndex
+			case 32:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xf2
-			}
-		}
-		if len(x.ForecasterScoreEmas) > 0 {
-			for iNdEx := len(x.ForecasterScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ForecasterScoreEmas[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xea
-			}
-		}
-		if len(x.InfererScoreEmas) > 0 {
-			for iNdEx := len(x.InfererScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.InfererScoreEmas[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xe2
-			}
-		}
-		if len(x.BlockToLowestActiveTopicWeight) > 0 {
-			for iNdEx := len(x.BlockToLowestActiveTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.BlockToLowestActiveTopicWeight[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xda
-			}
-		}
-		if len(x.BlockToActiveTopics) > 0 {
-			for iNdEx := len(x.BlockToActiveTopics) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.BlockToActiveTopics[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xd2
-			}
-		}
-		if len(x.TopicToNextPossibleChurningBlock) > 0 {
-			for iNdEx := len(x.TopicToNextPossibleChurningBlock) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TopicToNextPossibleChurningBlock[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				x.Inferences = append(x.Inferences, &TopicIdActorIdInference{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Inferences[len(x.Inferences)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xca
-			}
-		}
-		if len(x.LastDripBlock) > 0 {
-			for iNdEx := len(x.LastDripBlock) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.LastDripBlock[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				iNdEx = postIndex
+			case 33:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xc2
-			}
-		}
-		if len(x.OpenWorkerWindows) > 0 {
-			for iNdEx := len(x.OpenWorkerWindows) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.OpenWorkerWindows[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xba
-			}
-		}
-		if len(x.TopicLastReputerCommit) > 0 {
-			for iNdEx := len(x.TopicLastReputerCommit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TopicLastReputerCommit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xb2
-			}
-		}
-		if len(x.TopicLastWorkerCommit) > 0 {
-			for iNdEx := len(x.TopicLastWorkerCommit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TopicLastWorkerCommit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xaa
-			}
-		}
-		if len(x.LatestOneOutForecasterForecasterNetworkRegrets) > 0 {
-			for iNdEx := len(x.LatestOneOutForecasterForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.LatestOneOutForecasterForecasterNetworkRegrets[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0xa2
-			}
-		}
-		if len(x.LatestOneOutForecasterInfererNetworkRegrets) > 0 {
-			for iNdEx := len(x.LatestOneOutForecasterInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.LatestOneOutForecasterInfererNetworkRegrets[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				x.Forecasts = append(x.Forecasts, &TopicIdActorIdForecast{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Forecasts[len(x.Forecasts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0x9a
-			}
-		}
-		if len(x.LatestOneOutInfererForecasterNetworkRegrets) > 0 {
-			for iNdEx := len(x.LatestOneOutInfererForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.LatestOneOutInfererForecasterNetworkRegrets[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				iNdEx = postIndex
+			case 34:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0x92
-			}
-		}
-		if len(x.LatestOneOutInfererInfererNetworkRegrets) > 0 {
-			for iNdEx := len(x.LatestOneOutInfererInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.LatestOneOutInfererInfererNetworkRegrets[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0x8a
-			}
-		}
-		if len(x.LatestNaiveInfererNetworkRegrets) > 0 {
-			for iNdEx := len(x.LatestNaiveInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.LatestNaiveInfererNetworkRegrets[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3
-				i--
-				dAtA[i] = 0x82
-			}
-		}
-		if len(x.LatestOneInForecasterNetworkRegrets) > 0 {
-			for iNdEx := len(x.LatestOneInForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.LatestOneInForecasterNetworkRegrets[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0xfa
-			}
-		}
-		if len(x.LatestForecasterNetworkRegrets) > 0 {
-			for iNdEx := len(x.LatestForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.LatestForecasterNetworkRegrets[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				x.Workers = append(x.Workers, &LibP2PKeyAndOffchainNode{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Workers[len(x.Workers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0xf2
-			}
-		}
-		if len(x.LatestInfererNetworkRegrets) > 0 {
-			for iNdEx := len(x.LatestInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.LatestInfererNetworkRegrets[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				iNdEx = postIndex
+			case 35:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reputers", wireType)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0xea
-			}
-		}
-		if len(x.UnfulfilledReputerNonces) > 0 {
-			for iNdEx := len(x.UnfulfilledReputerNonces) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.UnfulfilledReputerNonces[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0xe2
-			}
-		}
-		if len(x.UnfulfilledWorkerNonces) > 0 {
-			for iNdEx := len(x.UnfulfilledWorkerNonces) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.UnfulfilledWorkerNonces[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0xda
-			}
-		}
-		if len(x.PreviousPercentageRewardToStakedReputers) > 0 {
-			i -= len(x.PreviousPercentageRewardToStakedReputers)
-			copy(dAtA[i:], x.PreviousPercentageRewardToStakedReputers)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousPercentageRewardToStakedReputers)))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xd2
-		}
-		if len(x.NetworkLossBundles) > 0 {
-			for iNdEx := len(x.NetworkLossBundles) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.NetworkLossBundles[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0xca
-			}
-		}
-		if len(x.AllLossBundles) > 0 {
-			for iNdEx := len(x.AllLossBundles) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.AllLossBundles[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0xc2
-			}
-		}
-		if len(x.AllForecasts) > 0 {
-			for iNdEx := len(x.AllForecasts) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.AllForecasts[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				x.Reputers = append(x.Reputers, &LibP2PKeyAndOffchainNode{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Reputers[len(x.Reputers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0xba
-			}
-		}
-		if len(x.AllInferences) > 0 {
-			for iNdEx := len(x.AllInferences) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.AllInferences[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				iNdEx = postIndex
+			case 36:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenue", wireType)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0xb2
-			}
-		}
-		if len(x.PreviousTopicWeight) > 0 {
-			for iNdEx := len(x.PreviousTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PreviousTopicWeight[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0xaa
-			}
-		}
-		if len(x.TopicFeeRevenue) > 0 {
-			for iNdEx := len(x.TopicFeeRevenue) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TopicFeeRevenue[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0xa2
-			}
-		}
-		if len(x.Reputers) > 0 {
-			for iNdEx := len(x.Reputers) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Reputers[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0x9a
-			}
-		}
-		if len(x.Workers) > 0 {
-			for iNdEx := len(x.Workers) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Workers[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0x92
-			}
-		}
-		if len(x.Forecasts) > 0 {
-			for iNdEx := len(x.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Forecasts[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				x.TopicFeeRevenue = append(x.TopicFeeRevenue, &TopicIdAndInt{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicFeeRevenue[len(x.TopicFeeRevenue)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0x8a
-			}
-		}
-		if len(x.Inferences) > 0 {
-			for iNdEx := len(x.Inferences) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Inferences[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				iNdEx = postIndex
+			case 37:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicWeight", wireType)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2
-				i--
-				dAtA[i] = 0x82
-			}
-		}
-		if len(x.DelegateStakeRemovalsByActor) > 0 {
-			for iNdEx := len(x.DelegateStakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DelegateStakeRemovalsByActor[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0xfa
-			}
-		}
-		if len(x.DelegateStakeRemovalsByBlock) > 0 {
-			for iNdEx := len(x.DelegateStakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DelegateStakeRemovalsByBlock[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0xf2
-			}
-		}
-		if len(x.StakeRemovalsByActor) > 0 {
-			for iNdEx := len(x.StakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.StakeRemovalsByActor[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0xea
-			}
-		}
-		if len(x.StakeRemovalsByBlock) > 0 {
-			for iNdEx := len(x.StakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.StakeRemovalsByBlock[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0xe2
-			}
-		}
-		if len(x.DelegateRewardPerShare) > 0 {
-			for iNdEx := len(x.DelegateRewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DelegateRewardPerShare[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				x.PreviousTopicWeight = append(x.PreviousTopicWeight, &TopicIdAndDec{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousTopicWeight[len(x.PreviousTopicWeight)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0xda
-			}
-		}
-		if len(x.StakeFromDelegatorsUponReputer) > 0 {
-			for iNdEx := len(x.StakeFromDelegatorsUponReputer) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.StakeFromDelegatorsUponReputer[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				iNdEx = postIndex
+			case 38:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllInferences", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0xd2
-			}
-		}
-		if len(x.DelegatedStakes) > 0 {
-			for iNdEx := len(x.DelegatedStakes) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DelegatedStakes[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0xca
-			}
-		}
-		if len(x.StakeSumFromDelegator) > 0 {
-			for iNdEx := len(x.StakeSumFromDelegator) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.StakeSumFromDelegator[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0xc2
-			}
-		}
-		if len(x.StakeReputerAuthority) > 0 {
-			for iNdEx := len(x.StakeReputerAuthority) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.StakeReputerAuthority[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0xba
-			}
-		}
-		if len(x.TopicStake) > 0 {
-			for iNdEx := len(x.TopicStake) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TopicStake[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				x.AllInferences = append(x.AllInferences, &TopicIdBlockHeightInferences{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllInferences[len(x.AllInferences)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0xb2
-			}
-		}
-		if len(x.TotalStake) > 0 {
-			i -= len(x.TotalStake)
-			copy(dAtA[i:], x.TotalStake)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalStake)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xaa
-		}
-		if len(x.PreviousForecasterScoreRatio) > 0 {
-			for iNdEx := len(x.PreviousForecasterScoreRatio) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PreviousForecasterScoreRatio[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				iNdEx = postIndex
+			case 39:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllForecasts", wireType)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0xa2
-			}
-		}
-		if len(x.PreviousForecastRewardFraction) > 0 {
-			for iNdEx := len(x.PreviousForecastRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PreviousForecastRewardFraction[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0x9a
-			}
-		}
-		if len(x.PreviousInferenceRewardFraction) > 0 {
-			for iNdEx := len(x.PreviousInferenceRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PreviousInferenceRewardFraction[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0x92
-			}
-		}
-		if len(x.PreviousReputerRewardFraction) > 0 {
-			for iNdEx := len(x.PreviousReputerRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PreviousReputerRewardFraction[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0x8a
-			}
-		}
-		if len(x.ReputerListeningCoefficient) > 0 {
-			for iNdEx := len(x.ReputerListeningCoefficient) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ReputerListeningCoefficient[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1
-				i--
-				dAtA[i] = 0x82
-			}
-		}
-		if len(x.ReputerScoresByBlock) > 0 {
-			for iNdEx := len(x.ReputerScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ReputerScoresByBlock[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				x.AllForecasts = append(x.AllForecasts, &TopicIdBlockHeightForecasts{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllForecasts[len(x.AllForecasts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x62
-			}
-		}
-		if len(x.ForecasterScoresByBlock) > 0 {
-			for iNdEx := len(x.ForecasterScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ForecasterScoresByBlock[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				iNdEx = postIndex
+			case 40:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllLossBundles", wireType)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x5a
-			}
-		}
-		if len(x.InfererScoresByBlock) > 0 {
-			for iNdEx := len(x.InfererScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.InfererScoresByBlock[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x52
-			}
-		}
-		if len(x.TopicRewardNonce) > 0 {
-			for iNdEx := len(x.TopicRewardNonce) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TopicRewardNonce[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4a
-			}
-		}
-		if len(x.TopicReputers) > 0 {
-			for iNdEx := len(x.TopicReputers) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TopicReputers[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x42
-			}
-		}
-		if len(x.TopicWorkers) > 0 {
-			for iNdEx := len(x.TopicWorkers) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TopicWorkers[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3a
-			}
-		}
-		if len(x.RewardableTopics) > 0 {
-			var pksize6 int
-			for _, num := range x.RewardableTopics {
-				pksize6 += runtime.Sov(uint64(num))
-			}
-			i -= pksize6
-			j5 := i
-			for _, num := range x.RewardableTopics {
-				for num >= 1<<7 {
-					dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j5++
+				x.AllLossBundles = append(x.AllLossBundles, &TopicIdBlockHeightReputerValueBundles{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllLossBundles[len(x.AllLossBundles)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				dAtA[j5] = uint8(num)
-				j5++
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize6))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.ActiveTopics) > 0 {
-			var pksize8 int
-			for _, num := range x.ActiveTopics {
-				pksize8 += runtime.Sov(uint64(num))
-			}
-			i -= pksize8
-			j7 := i
-			for _, num := range x.ActiveTopics {
-				for num >= 1<<7 {
-					dAtA[j7] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j7++
+				iNdEx = postIndex
+			case 41:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkLossBundles", wireType)
 				}
-				dAtA[j7] = uint8(num)
-				j7++
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize8))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Topics) > 0 {
-			for iNdEx := len(x.Topics) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Topics[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if x.NextTopicId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextTopicId))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.CoreTeamAddresses) > 0 {
-			for iNdEx := len(x.CoreTeamAddresses) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.CoreTeamAddresses[iNdEx])
-				copy(dAtA[i:], x.CoreTeamAddresses[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CoreTeamAddresses[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.NetworkLossBundles = append(x.NetworkLossBundles, &TopicIdBlockHeightValueBundles{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NetworkLossBundles[len(x.NetworkLossBundles)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 42:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousPercentageRewardToStakedReputers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousPercentageRewardToStakedReputers = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 43:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledWorkerNonces", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9162,18 +12916,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				x.UnfulfilledWorkerNonces = append(x.UnfulfilledWorkerNonces, &TopicIdAndNonces{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnfulfilledWorkerNonces[len(x.UnfulfilledWorkerNonces)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 44:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CoreTeamAddresses", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledReputerNonces", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9183,29 +12935,31 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CoreTeamAddresses = append(x.CoreTeamAddresses, string(dAtA[iNdEx:postIndex]))
+				x.UnfulfilledReputerNonces = append(x.UnfulfilledReputerNonces, &TopicIdAndReputerRequestNonces{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnfulfilledReputerNonces[len(x.UnfulfilledReputerNonces)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextTopicId", wireType)
+			case 45:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestInfererNetworkRegrets", wireType)
 				}
-				x.NextTopicId = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9215,14 +12969,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.NextTopicId |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 4:
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LatestInfererNetworkRegrets = append(x.LatestInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestInfererNetworkRegrets[len(x.LatestInfererNetworkRegrets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 46:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterNetworkRegrets", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9249,166 +13018,82 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Topics = append(x.Topics, &TopicIdAndTopic{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Topics[len(x.Topics)-1]); err != nil {
+				x.LatestForecasterNetworkRegrets = append(x.LatestForecasterNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestForecasterNetworkRegrets[len(x.LatestForecasterNetworkRegrets)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
-				if wireType == 0 {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					x.ActiveTopics = append(x.ActiveTopics, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 47:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterNetworkRegrets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
-					}
-					elementCount = count
-					if elementCount != 0 && len(x.ActiveTopics) == 0 {
-						x.ActiveTopics = make([]uint64, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.ActiveTopics = append(x.ActiveTopics, v)
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveTopics", wireType)
 				}
-			case 6:
-				if wireType == 0 {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					x.RewardableTopics = append(x.RewardableTopics, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LatestOneInForecasterNetworkRegrets = append(x.LatestOneInForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestOneInForecasterNetworkRegrets[len(x.LatestOneInForecasterNetworkRegrets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 48:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestNaiveInfererNetworkRegrets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
-					}
-					elementCount = count
-					if elementCount != 0 && len(x.RewardableTopics) == 0 {
-						x.RewardableTopics = make([]uint64, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.RewardableTopics = append(x.RewardableTopics, v)
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardableTopics", wireType)
 				}
-			case 7:
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LatestNaiveInfererNetworkRegrets = append(x.LatestNaiveInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestNaiveInfererNetworkRegrets[len(x.LatestNaiveInfererNetworkRegrets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 49:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicWorkers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererInfererNetworkRegrets", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9435,14 +13120,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TopicWorkers = append(x.TopicWorkers, &TopicAndActorId{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicWorkers[len(x.TopicWorkers)-1]); err != nil {
+				x.LatestOneOutInfererInfererNetworkRegrets = append(x.LatestOneOutInfererInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestOneOutInfererInfererNetworkRegrets[len(x.LatestOneOutInfererInfererNetworkRegrets)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 8:
+			case 50:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicReputers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererForecasterNetworkRegrets", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9469,14 +13154,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TopicReputers = append(x.TopicReputers, &TopicAndActorId{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicReputers[len(x.TopicReputers)-1]); err != nil {
+				x.LatestOneOutInfererForecasterNetworkRegrets = append(x.LatestOneOutInfererForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestOneOutInfererForecasterNetworkRegrets[len(x.LatestOneOutInfererForecasterNetworkRegrets)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 9:
+			case 51:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicRewardNonce", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterInfererNetworkRegrets", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9503,14 +13188,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TopicRewardNonce = append(x.TopicRewardNonce, &TopicIdAndBlockHeight{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicRewardNonce[len(x.TopicRewardNonce)-1]); err != nil {
+				x.LatestOneOutForecasterInfererNetworkRegrets = append(x.LatestOneOutForecasterInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestOneOutForecasterInfererNetworkRegrets[len(x.LatestOneOutForecasterInfererNetworkRegrets)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 10:
+			case 52:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InfererScoresByBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterForecasterNetworkRegrets", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9537,14 +13222,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InfererScoresByBlock = append(x.InfererScoresByBlock, &TopicIdBlockHeightScores{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InfererScoresByBlock[len(x.InfererScoresByBlock)-1]); err != nil {
+				x.LatestOneOutForecasterForecasterNetworkRegrets = append(x.LatestOneOutForecasterForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestOneOutForecasterForecasterNetworkRegrets[len(x.LatestOneOutForecasterForecasterNetworkRegrets)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 11:
+			case 53:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoresByBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerCommit", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9571,14 +13256,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ForecasterScoresByBlock = append(x.ForecasterScoresByBlock, &TopicIdBlockHeightScores{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ForecasterScoresByBlock[len(x.ForecasterScoresByBlock)-1]); err != nil {
+				x.TopicLastWorkerCommit = append(x.TopicLastWorkerCommit, &TopicIdTimestampedActorNonce{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicLastWorkerCommit[len(x.TopicLastWorkerCommit)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 12:
+			case 54:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerScoresByBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerCommit", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9605,14 +13290,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ReputerScoresByBlock = append(x.ReputerScoresByBlock, &TopicIdBlockHeightScores{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReputerScoresByBlock[len(x.ReputerScoresByBlock)-1]); err != nil {
+				x.TopicLastReputerCommit = append(x.TopicLastReputerCommit, &TopicIdTimestampedActorNonce{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicLastReputerCommit[len(x.TopicLastReputerCommit)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 16:
+			case 55:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerListeningCoefficient", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OpenWorkerWindows", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9639,14 +13324,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ReputerListeningCoefficient = append(x.ReputerListeningCoefficient, &TopicIdActorIdListeningCoefficient{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReputerListeningCoefficient[len(x.ReputerListeningCoefficient)-1]); err != nil {
+				x.OpenWorkerWindows = append(x.OpenWorkerWindows, &BlockHeightAndTopicIds{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OpenWorkerWindows[len(x.OpenWorkerWindows)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 17:
+			case 56:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousReputerRewardFraction", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastDripBlock", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9673,14 +13358,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PreviousReputerRewardFraction = append(x.PreviousReputerRewardFraction, &TopicIdActorIdDec{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousReputerRewardFraction[len(x.PreviousReputerRewardFraction)-1]); err != nil {
+				x.LastDripBlock = append(x.LastDripBlock, &TopicIdAndBlockHeight{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastDripBlock[len(x.LastDripBlock)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 18:
+			case 57:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousInferenceRewardFraction", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicToNextPossibleChurningBlock", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9707,14 +13392,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PreviousInferenceRewardFraction = append(x.PreviousInferenceRewardFraction, &TopicIdActorIdDec{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousInferenceRewardFraction[len(x.PreviousInferenceRewardFraction)-1]); err != nil {
+				x.TopicToNextPossibleChurningBlock = append(x.TopicToNextPossibleChurningBlock, &TopicIdAndBlockHeight{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicToNextPossibleChurningBlock[len(x.TopicToNextPossibleChurningBlock)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 19:
+			case 58:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousForecastRewardFraction", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockToActiveTopics", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9741,14 +13426,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PreviousForecastRewardFraction = append(x.PreviousForecastRewardFraction, &TopicIdActorIdDec{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousForecastRewardFraction[len(x.PreviousForecastRewardFraction)-1]); err != nil {
+				x.BlockToActiveTopics = append(x.BlockToActiveTopics, &BlockHeightTopicIds{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockToActiveTopics[len(x.BlockToActiveTopics)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 20:
+			case 59:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousForecasterScoreRatio", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockToLowestActiveTopicWeight", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9775,16 +13460,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PreviousForecasterScoreRatio = append(x.PreviousForecasterScoreRatio, &TopicIdAndDec{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousForecasterScoreRatio[len(x.PreviousForecasterScoreRatio)-1]); err != nil {
+				x.BlockToLowestActiveTopicWeight = append(x.BlockToLowestActiveTopicWeight, &BlockHeightTopicIdWeightPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockToLowestActiveTopicWeight[len(x.BlockToLowestActiveTopicWeight)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 21:
+			case 60:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InfererScoreEmas", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9794,27 +13479,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TotalStake = string(dAtA[iNdEx:postIndex])
+				x.InfererScoreEmas = append(x.InfererScoreEmas, &TopicIdActorIdScore{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InfererScoreEmas[len(x.InfererScoreEmas)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 22:
+			case 61:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicStake", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoreEmas", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9841,14 +13528,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TopicStake = append(x.TopicStake, &TopicIdAndInt{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicStake[len(x.TopicStake)-1]); err != nil {
+				x.ForecasterScoreEmas = append(x.ForecasterScoreEmas, &TopicIdActorIdScore{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ForecasterScoreEmas[len(x.ForecasterScoreEmas)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 23:
+			case 62:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeReputerAuthority", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerScoreEmas", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9875,14 +13562,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.StakeReputerAuthority = append(x.StakeReputerAuthority, &TopicIdActorIdInt{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StakeReputerAuthority[len(x.StakeReputerAuthority)-1]); err != nil {
+				x.ReputerScoreEmas = append(x.ReputerScoreEmas, &TopicIdActorIdScore{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReputerScoreEmas[len(x.ReputerScoreEmas)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 24:
+			case 63:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeSumFromDelegator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileInfererScoreEma", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9909,14 +13596,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.StakeSumFromDelegator = append(x.StakeSumFromDelegator, &TopicIdActorIdInt{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StakeSumFromDelegator[len(x.StakeSumFromDelegator)-1]); err != nil {
+				x.PreviousTopicQuantileInfererScoreEma = append(x.PreviousTopicQuantileInfererScoreEma, &TopicIdAndDec{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousTopicQuantileInfererScoreEma[len(x.PreviousTopicQuantileInfererScoreEma)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 25:
+			case 64:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatedStakes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileForecasterScoreEma", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9943,14 +13630,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DelegatedStakes = append(x.DelegatedStakes, &TopicIdDelegatorReputerDelegatorInfo{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DelegatedStakes[len(x.DelegatedStakes)-1]); err != nil {
+				x.PreviousTopicQuantileForecasterScoreEma = append(x.PreviousTopicQuantileForecasterScoreEma, &TopicIdAndDec{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousTopicQuantileForecasterScoreEma[len(x.PreviousTopicQuantileForecasterScoreEma)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 26:
+			case 65:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeFromDelegatorsUponReputer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileReputerScoreEma", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9977,14 +13664,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.StakeFromDelegatorsUponReputer = append(x.StakeFromDelegatorsUponReputer, &TopicIdActorIdInt{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StakeFromDelegatorsUponReputer[len(x.StakeFromDelegatorsUponReputer)-1]); err != nil {
+				x.PreviousTopicQuantileReputerScoreEma = append(x.PreviousTopicQuantileReputerScoreEma, &TopicIdAndDec{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousTopicQuantileReputerScoreEma[len(x.PreviousTopicQuantileReputerScoreEma)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 27:
+			case 66:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegateRewardPerShare", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CountInfererInclusionsInTopicActiveSet", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10011,14 +13698,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DelegateRewardPerShare = append(x.DelegateRewardPerShare, &TopicIdActorIdDec{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DelegateRewardPerShare[len(x.DelegateRewardPerShare)-1]); err != nil {
+				x.CountInfererInclusionsInTopicActiveSet = append(x.CountInfererInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CountInfererInclusionsInTopicActiveSet[len(x.CountInfererInclusionsInTopicActiveSet)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 28:
+			case 67:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CountForecasterInclusionsInTopicActiveSet", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10045,14 +13732,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.StakeRemovalsByBlock = append(x.StakeRemovalsByBlock, &BlockHeightTopicIdReputerStakeRemovalInfo{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StakeRemovalsByBlock[len(x.StakeRemovalsByBlock)-1]); err != nil {
+				x.CountForecasterInclusionsInTopicActiveSet = append(x.CountForecasterInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CountForecasterInclusionsInTopicActiveSet[len(x.CountForecasterInclusionsInTopicActiveSet)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 29:
+			case 68:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByActor", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveInferers", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10079,14 +13766,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.StakeRemovalsByActor = append(x.StakeRemovalsByActor, &ActorIdTopicIdBlockHeight{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StakeRemovalsByActor[len(x.StakeRemovalsByActor)-1]); err != nil {
+				x.ActiveInferers = append(x.ActiveInferers, &TopicAndActorId{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActiveInferers[len(x.ActiveInferers)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 30:
+			case 69:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasters", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10113,14 +13800,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DelegateStakeRemovalsByBlock = append(x.DelegateStakeRemovalsByBlock, &BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DelegateStakeRemovalsByBlock[len(x.DelegateStakeRemovalsByBlock)-1]); err != nil {
+				x.ActiveForecasters = append(x.ActiveForecasters, &TopicAndActorId{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActiveForecasters[len(x.ActiveForecasters)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 31:
+			case 70:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByActor", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowestInfererScoreEma", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10147,14 +13834,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DelegateStakeRemovalsByActor = append(x.DelegateStakeRemovalsByActor, &DelegatorReputerTopicIdBlockHeight{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DelegateStakeRemovalsByActor[len(x.DelegateStakeRemovalsByActor)-1]); err != nil {
+				x.LowestInfererScoreEma = append(x.LowestInfererScoreEma, &TopicIdActorIdScore{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LowestInfererScoreEma[len(x.LowestInfererScoreEma)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 32:
+			case 71:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowestForecasterScoreEma", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10181,14 +13868,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Inferences = append(x.Inferences, &TopicIdActorIdInference{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Inferences[len(x.Inferences)-1]); err != nil {
+				x.LowestForecasterScoreEma = append(x.LowestForecasterScoreEma, &TopicIdActorIdScore{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LowestForecasterScoreEma[len(x.LowestForecasterScoreEma)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 33:
+			case 72:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveReputers", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10215,14 +13902,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Forecasts = append(x.Forecasts, &TopicIdActorIdForecast{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Forecasts[len(x.Forecasts)-1]); err != nil {
+				x.ActiveReputers = append(x.ActiveReputers, &TopicAndActorId{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActiveReputers[len(x.ActiveReputers)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 34:
+			case 73:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowestReputerScoreEma", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10249,14 +13936,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Workers = append(x.Workers, &LibP2PKeyAndOffchainNode{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Workers[len(x.Workers)-1]); err != nil {
+				x.LowestReputerScoreEma = append(x.LowestReputerScoreEma, &TopicIdActorIdScore{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LowestReputerScoreEma[len(x.LowestReputerScoreEma)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 35:
+			case 74:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reputers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LossBundles", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10283,16 +13970,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Reputers = append(x.Reputers, &LibP2PKeyAndOffchainNode{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Reputers[len(x.Reputers)-1]); err != nil {
+				x.LossBundles = append(x.LossBundles, &TopicIdReputerReputerValueBundle{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LossBundles[len(x.LossBundles)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 36:
+			case 75:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalSumPreviousTopicWeights", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalSumPreviousTopicWeights = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 76:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenue", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardCurrentBlockEmission", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10302,31 +14021,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TopicFeeRevenue = append(x.TopicFeeRevenue, &TopicIdAndInt{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicFeeRevenue[len(x.TopicFeeRevenue)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.RewardCurrentBlockEmission = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 37:
+			case 77:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicWeight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WhitelistAdmins", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10336,31 +14053,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PreviousTopicWeight = append(x.PreviousTopicWeight, &TopicIdAndDec{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousTopicWeight[len(x.PreviousTopicWeight)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.WhitelistAdmins = append(x.WhitelistAdmins, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 38:
+			case 78:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllInferences", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalWhitelist", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10370,31 +14085,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AllInferences = append(x.AllInferences, &TopicIdBlockHeightInferences{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllInferences[len(x.AllInferences)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.GlobalWhitelist = append(x.GlobalWhitelist, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 39:
+			case 79:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllForecasts", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicCreatorWhitelist", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10404,29 +14117,27 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AllForecasts = append(x.AllForecasts, &TopicIdBlockHeightForecasts{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllForecasts[len(x.AllForecasts)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.TopicCreatorWhitelist = append(x.TopicCreatorWhitelist, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 40:
+			case 80:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllLossBundles", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicWorkerWhitelist", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10453,14 +14164,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AllLossBundles = append(x.AllLossBundles, &TopicIdBlockHeightReputerValueBundles{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllLossBundles[len(x.AllLossBundles)-1]); err != nil {
+				x.TopicWorkerWhitelist = append(x.TopicWorkerWhitelist, &TopicAndActorId{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicWorkerWhitelist[len(x.TopicWorkerWhitelist)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 41:
+			case 81:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkLossBundles", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicReputerWhitelist", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10487,46 +14198,166 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NetworkLossBundles = append(x.NetworkLossBundles, &TopicIdBlockHeightValueBundles{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NetworkLossBundles[len(x.NetworkLossBundles)-1]); err != nil {
+				x.TopicReputerWhitelist = append(x.TopicReputerWhitelist, &TopicAndActorId{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicReputerWhitelist[len(x.TopicReputerWhitelist)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 42:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousPercentageRewardToStakedReputers", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			case 82:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-					if iNdEx >= l {
+					x.TopicWorkerWhitelistEnabled = append(x.TopicWorkerWhitelistEnabled, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
 					}
+					elementCount = count
+					if elementCount != 0 && len(x.TopicWorkerWhitelistEnabled) == 0 {
+						x.TopicWorkerWhitelistEnabled = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.TopicWorkerWhitelistEnabled = append(x.TopicWorkerWhitelistEnabled, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicWorkerWhitelistEnabled", wireType)
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 83:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.TopicReputerWhitelistEnabled = append(x.TopicReputerWhitelistEnabled, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.TopicReputerWhitelistEnabled) == 0 {
+						x.TopicReputerWhitelistEnabled = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.TopicReputerWhitelistEnabled = append(x.TopicReputerWhitelistEnabled, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicReputerWhitelistEnabled", wireType)
 				}
-				x.PreviousPercentageRewardToStakedReputers = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 43:
+			case 84:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledWorkerNonces", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastMedianInferences", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10553,14 +14384,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.UnfulfilledWorkerNonces = append(x.UnfulfilledWorkerNonces, &TopicIdAndNonces{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnfulfilledWorkerNonces[len(x.UnfulfilledWorkerNonces)-1]); err != nil {
+				x.LastMedianInferences = append(x.LastMedianInferences, &TopicIdAndDec{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastMedianInferences[len(x.LastMedianInferences)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 44:
+			case 85:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledReputerNonces", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MadInferences", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10587,14 +14418,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.UnfulfilledReputerNonces = append(x.UnfulfilledReputerNonces, &TopicIdAndReputerRequestNonces{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnfulfilledReputerNonces[len(x.UnfulfilledReputerNonces)-1]); err != nil {
+				x.MadInferences = append(x.MadInferences, &TopicIdAndDec{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MadInferences[len(x.MadInferences)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 45:
+			case 86:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestInfererNetworkRegrets", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialInfererEmaScore", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10621,14 +14452,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LatestInfererNetworkRegrets = append(x.LatestInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestInfererNetworkRegrets[len(x.LatestInfererNetworkRegrets)-1]); err != nil {
+				x.InitialInfererEmaScore = append(x.InitialInfererEmaScore, &TopicIdAndDec{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InitialInfererEmaScore[len(x.InitialInfererEmaScore)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 46:
+			case 87:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterNetworkRegrets", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialForecasterEmaScore", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10655,14 +14486,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LatestForecasterNetworkRegrets = append(x.LatestForecasterNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestForecasterNetworkRegrets[len(x.LatestForecasterNetworkRegrets)-1]); err != nil {
+				x.InitialForecasterEmaScore = append(x.InitialForecasterEmaScore, &TopicIdAndDec{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InitialForecasterEmaScore[len(x.InitialForecasterEmaScore)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 47:
+			case 88:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterNetworkRegrets", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialReputerEmaScore", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10689,16 +14520,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LatestOneInForecasterNetworkRegrets = append(x.LatestOneInForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestOneInForecasterNetworkRegrets[len(x.LatestOneInForecasterNetworkRegrets)-1]); err != nil {
+				x.InitialReputerEmaScore = append(x.InitialReputerEmaScore, &TopicIdAndDec{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InitialReputerEmaScore[len(x.InitialReputerEmaScore)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 48:
+			case 89:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestNaiveInfererNetworkRegrets", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalWorkerWhitelist", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10708,31 +14539,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LatestNaiveInfererNetworkRegrets = append(x.LatestNaiveInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestNaiveInfererNetworkRegrets[len(x.LatestNaiveInfererNetworkRegrets)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.GlobalWorkerWhitelist = append(x.GlobalWorkerWhitelist, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 49:
+			case 90:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererInfererNetworkRegrets", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalReputerWhitelist", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10742,31 +14571,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LatestOneOutInfererInfererNetworkRegrets = append(x.LatestOneOutInfererInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestOneOutInfererInfererNetworkRegrets[len(x.LatestOneOutInfererInfererNetworkRegrets)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.GlobalReputerWhitelist = append(x.GlobalReputerWhitelist, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 50:
+			case 91:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererForecasterNetworkRegrets", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalAdminWhitelist", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10776,29 +14603,27 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LatestOneOutInfererForecasterNetworkRegrets = append(x.LatestOneOutInfererForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestOneOutInfererForecasterNetworkRegrets[len(x.LatestOneOutInfererForecasterNetworkRegrets)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.GlobalAdminWhitelist = append(x.GlobalAdminWhitelist, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 51:
+			case 92:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterInfererNetworkRegrets", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingTopicUpdates", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10825,48 +14650,166 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LatestOneOutForecasterInfererNetworkRegrets = append(x.LatestOneOutForecasterInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestOneOutForecasterInfererNetworkRegrets[len(x.LatestOneOutForecasterInfererNetworkRegrets)-1]); err != nil {
+				x.PendingTopicUpdates = append(x.PendingTopicUpdates, &TopicIdAndOptionalTopicParams{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingTopicUpdates[len(x.PendingTopicUpdates)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 52:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterForecasterNetworkRegrets", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+			case 93:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-					if iNdEx >= l {
+					x.ArchivedTopics = append(x.ArchivedTopics, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
 					}
+					elementCount = count
+					if elementCount != 0 && len(x.ArchivedTopics) == 0 {
+						x.ArchivedTopics = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.ArchivedTopics = append(x.ArchivedTopics, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArchivedTopics", wireType)
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.LatestOneOutForecasterForecasterNetworkRegrets = append(x.LatestOneOutForecasterForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestOneOutForecasterForecasterNetworkRegrets[len(x.LatestOneOutForecasterForecasterNetworkRegrets)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 94:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.ArchivedTopicsToPrune = append(x.ArchivedTopicsToPrune, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.ArchivedTopicsToPrune) == 0 {
+						x.ArchivedTopicsToPrune = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.ArchivedTopicsToPrune = append(x.ArchivedTopicsToPrune, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArchivedTopicsToPrune", wireType)
 				}
-				iNdEx = postIndex
-			case 53:
+			case 95:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerCommit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicOwners", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10893,14 +14836,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TopicLastWorkerCommit = append(x.TopicLastWorkerCommit, &TopicIdTimestampedActorNonce{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicLastWorkerCommit[len(x.TopicLastWorkerCommit)-1]); err != nil {
+				x.TopicOwners = append(x.TopicOwners, &TopicAndActorId{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicOwners[len(x.TopicOwners)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 54:
+			case 96:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerCommit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingTopicOwners", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10927,14 +14870,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TopicLastReputerCommit = append(x.TopicLastReputerCommit, &TopicIdTimestampedActorNonce{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicLastReputerCommit[len(x.TopicLastReputerCommit)-1]); err != nil {
+				x.PendingTopicOwners = append(x.PendingTopicOwners, &TopicAndActorId{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingTopicOwners[len(x.PendingTopicOwners)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 55:
+			case 97:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OpenWorkerWindows", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicTags", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10961,14 +14904,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OpenWorkerWindows = append(x.OpenWorkerWindows, &BlockHeightAndTopicIds{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OpenWorkerWindows[len(x.OpenWorkerWindows)-1]); err != nil {
+				x.TopicTags = append(x.TopicTags, &TopicIdAndTag{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicTags[len(x.TopicTags)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 56:
+			case 98:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastDripBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerGroundTruths", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10995,14 +14938,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LastDripBlock = append(x.LastDripBlock, &TopicIdAndBlockHeight{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastDripBlock[len(x.LastDripBlock)-1]); err != nil {
+				x.ReputerGroundTruths = append(x.ReputerGroundTruths, &v3.GroundTruth{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReputerGroundTruths[len(x.ReputerGroundTruths)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 57:
+			case 99:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicToNextPossibleChurningBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusGroundTruths", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11029,14 +14972,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TopicToNextPossibleChurningBlock = append(x.TopicToNextPossibleChurningBlock, &TopicIdAndBlockHeight{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicToNextPossibleChurningBlock[len(x.TopicToNextPossibleChurningBlock)-1]); err != nil {
+				x.ConsensusGroundTruths = append(x.ConsensusGroundTruths, &v3.GroundTruth{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ConsensusGroundTruths[len(x.ConsensusGroundTruths)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 58:
+			case 100:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockToActiveTopics", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerPayloadCommits", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11063,14 +15006,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BlockToActiveTopics = append(x.BlockToActiveTopics, &BlockHeightTopicIds{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockToActiveTopics[len(x.BlockToActiveTopics)-1]); err != nil {
+				x.WorkerPayloadCommits = append(x.WorkerPayloadCommits, &v3.WorkerPayloadCommit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WorkerPayloadCommits[len(x.WorkerPayloadCommits)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 59:
+			case 101:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockToLowestActiveTopicWeight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActorOperators", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11097,14 +15040,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BlockToLowestActiveTopicWeight = append(x.BlockToLowestActiveTopicWeight, &BlockHeightTopicIdWeightPair{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockToLowestActiveTopicWeight[len(x.BlockToLowestActiveTopicWeight)-1]); err != nil {
+				x.ActorOperators = append(x.ActorOperators, &TopicActorOperator{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActorOperators[len(x.ActorOperators)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 60:
+			case 102:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InfererScoreEmas", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerBonds", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11131,14 +15074,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InfererScoreEmas = append(x.InfererScoreEmas, &TopicIdActorIdScore{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InfererScoreEmas[len(x.InfererScoreEmas)-1]); err != nil {
+				x.WorkerBonds = append(x.WorkerBonds, &v3.WorkerBond{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WorkerBonds[len(x.WorkerBonds)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 61:
+			case 103:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoreEmas", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerBondRemovals", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11165,14 +15108,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ForecasterScoreEmas = append(x.ForecasterScoreEmas, &TopicIdActorIdScore{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ForecasterScoreEmas[len(x.ForecasterScoreEmas)-1]); err != nil {
+				x.WorkerBondRemovals = append(x.WorkerBondRemovals, &v3.WorkerBondRemovalInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WorkerBondRemovals[len(x.WorkerBondRemovals)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 62:
+			case 104:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerScoreEmas", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerConsensusDeviations", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11199,14 +15142,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ReputerScoreEmas = append(x.ReputerScoreEmas, &TopicIdActorIdScore{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReputerScoreEmas[len(x.ReputerScoreEmas)-1]); err != nil {
+				x.ReputerConsensusDeviations = append(x.ReputerConsensusDeviations, &v3.ReputerConsensusDeviation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReputerConsensusDeviations[len(x.ReputerConsensusDeviations)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 63:
+			case 105:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileInfererScoreEma", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11233,14 +15176,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PreviousTopicQuantileInfererScoreEma = append(x.PreviousTopicQuantileInfererScoreEma, &TopicIdAndDec{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousTopicQuantileInfererScoreEma[len(x.PreviousTopicQuantileInfererScoreEma)-1]); err != nil {
+				x.Redelegations = append(x.Redelegations, &v3.RedelegationInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Redelegations[len(x.Redelegations)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 64:
+			case 106:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileForecasterScoreEma", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegators", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11267,14 +15210,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PreviousTopicQuantileForecasterScoreEma = append(x.PreviousTopicQuantileForecasterScoreEma, &TopicIdAndDec{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousTopicQuantileForecasterScoreEma[len(x.PreviousTopicQuantileForecasterScoreEma)-1]); err != nil {
+				x.AutoCompoundDelegators = append(x.AutoCompoundDelegators, &TopicAndActorId{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AutoCompoundDelegators[len(x.AutoCompoundDelegators)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 65:
+			case 107:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileReputerScoreEma", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerCommissions", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11301,14 +15244,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PreviousTopicQuantileReputerScoreEma = append(x.PreviousTopicQuantileReputerScoreEma, &TopicIdAndDec{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousTopicQuantileReputerScoreEma[len(x.PreviousTopicQuantileReputerScoreEma)-1]); err != nil {
+				x.ReputerCommissions = append(x.ReputerCommissions, &v3.ReputerCommission{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReputerCommissions[len(x.ReputerCommissions)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 66:
+			case 108:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CountInfererInclusionsInTopicActiveSet", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeLocks", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11335,14 +15278,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CountInfererInclusionsInTopicActiveSet = append(x.CountInfererInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CountInfererInclusionsInTopicActiveSet[len(x.CountInfererInclusionsInTopicActiveSet)-1]); err != nil {
+				x.StakeLocks = append(x.StakeLocks, &v3.StakeLock{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StakeLocks[len(x.StakeLocks)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 67:
+			case 109:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CountForecasterInclusionsInTopicActiveSet", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardHistory", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11369,14 +15312,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CountForecasterInclusionsInTopicActiveSet = append(x.CountForecasterInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CountForecasterInclusionsInTopicActiveSet[len(x.CountForecasterInclusionsInTopicActiveSet)-1]); err != nil {
+				x.RewardHistory = append(x.RewardHistory, &v3.RewardRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardHistory[len(x.RewardHistory)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 68:
+			case 110:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveInferers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardEscrows", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11403,14 +15346,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ActiveInferers = append(x.ActiveInferers, &TopicAndActorId{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActiveInferers[len(x.ActiveInferers)-1]); err != nil {
+				x.RewardEscrows = append(x.RewardEscrows, &v3.RewardEscrow{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardEscrows[len(x.RewardEscrows)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 69:
+			case 111:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasters", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueuedRewardPayouts", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11437,16 +15380,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ActiveForecasters = append(x.ActiveForecasters, &TopicAndActorId{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActiveForecasters[len(x.ActiveForecasters)-1]); err != nil {
+				x.QueuedRewardPayouts = append(x.QueuedRewardPayouts, &v3.QueuedRewardPayout{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QueuedRewardPayouts[len(x.QueuedRewardPayouts)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 70:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowestInfererScoreEma", wireType)
+			case 112:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextRewardPayoutId", wireType)
 				}
-				var msglen int
+				x.NextRewardPayoutId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11456,29 +15399,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.NextRewardPayoutId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.LowestInfererScoreEma = append(x.LowestInfererScoreEma, &TopicIdActorIdScore{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LowestInfererScoreEma[len(x.LowestInfererScoreEma)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 71:
+			case 113:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowestForecasterScoreEma", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArchivedTopicDelegateRemovalCursors", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11505,14 +15433,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LowestForecasterScoreEma = append(x.LowestForecasterScoreEma, &TopicIdActorIdScore{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LowestForecasterScoreEma[len(x.LowestForecasterScoreEma)-1]); err != nil {
+				x.ArchivedTopicDelegateRemovalCursors = append(x.ArchivedTopicDelegateRemovalCursors, &TopicIdDelegatorReputer{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ArchivedTopicDelegateRemovalCursors[len(x.ArchivedTopicDelegateRemovalCursors)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 72:
+			case 114:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveReputers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeadLetteredRewardPayouts", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11536,19 +15464,447 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeadLetteredRewardPayouts = append(x.DeadLetteredRewardPayouts, &v3.QueuedRewardPayout{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DeadLetteredRewardPayouts[len(x.DeadLetteredRewardPayouts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TopicIdAndTopic          protoreflect.MessageDescriptor
+	fd_TopicIdAndTopic_topic_id protoreflect.FieldDescriptor
+	fd_TopicIdAndTopic_topic    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v7_genesis_proto_init()
+	md_TopicIdAndTopic = File_emissions_v7_genesis_proto.Messages().ByName("TopicIdAndTopic")
+	fd_TopicIdAndTopic_topic_id = md_TopicIdAndTopic.Fields().ByName("topic_id")
+	fd_TopicIdAndTopic_topic = md_TopicIdAndTopic.Fields().ByName("topic")
+}
+
+var _ protoreflect.Message = (*fastReflection_TopicIdAndTopic)(nil)
+
+type fastReflection_TopicIdAndTopic TopicIdAndTopic
+
+func (x *TopicIdAndTopic) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicIdAndTopic)(x)
+}
+
+func (x *TopicIdAndTopic) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v7_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TopicIdAndTopic_messageType fastReflection_TopicIdAndTopic_messageType
+var _ protoreflect.MessageType = fastReflection_TopicIdAndTopic_messageType{}
+
+type fastReflection_TopicIdAndTopic_messageType struct{}
+
+func (x fastReflection_TopicIdAndTopic_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicIdAndTopic)(nil)
+}
+func (x fastReflection_TopicIdAndTopic_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicIdAndTopic)
+}
+func (x fastReflection_TopicIdAndTopic_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicIdAndTopic
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TopicIdAndTopic) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicIdAndTopic
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TopicIdAndTopic) Type() protoreflect.MessageType {
+	return _fastReflection_TopicIdAndTopic_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TopicIdAndTopic) New() protoreflect.Message {
+	return new(fastReflection_TopicIdAndTopic)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TopicIdAndTopic) Interface() protoreflect.ProtoMessage {
+	return (*TopicIdAndTopic)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TopicIdAndTopic) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_TopicIdAndTopic_topic_id, value) {
+			return
+		}
+	}
+	if x.Topic != nil {
+		value := protoreflect.ValueOfMessage(x.Topic.ProtoReflect())
+		if !f(fd_TopicIdAndTopic_topic, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TopicIdAndTopic) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v7.TopicIdAndTopic.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v7.TopicIdAndTopic.topic":
+		return x.Topic != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTopic"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndTopic does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicIdAndTopic) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v7.TopicIdAndTopic.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v7.TopicIdAndTopic.topic":
+		x.Topic = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTopic"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndTopic does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TopicIdAndTopic) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v7.TopicIdAndTopic.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v7.TopicIdAndTopic.topic":
+		value := x.Topic
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTopic"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndTopic does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicIdAndTopic) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v7.TopicIdAndTopic.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v7.TopicIdAndTopic.topic":
+		x.Topic = value.Message().Interface().(*v3.Topic)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTopic"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndTopic does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicIdAndTopic) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v7.TopicIdAndTopic.topic":
+		if x.Topic == nil {
+			x.Topic = new(v3.Topic)
+		}
+		return protoreflect.ValueOfMessage(x.Topic.ProtoReflect())
+	case "emissions.v7.TopicIdAndTopic.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v7.TopicIdAndTopic is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTopic"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndTopic does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TopicIdAndTopic) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v7.TopicIdAndTopic.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v7.TopicIdAndTopic.topic":
+		m := new(v3.Topic)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTopic"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndTopic does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TopicIdAndTopic) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v7.TopicIdAndTopic", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TopicIdAndTopic) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicIdAndTopic) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TopicIdAndTopic) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TopicIdAndTopic) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TopicIdAndTopic)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.Topic != nil {
+			l = options.Size(x.Topic)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TopicIdAndTopic)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Topic != nil {
+			encoded, err := options.Marshal(x.Topic)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TopicIdAndTopic)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ActiveReputers = append(x.ActiveReputers, &TopicAndActorId{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActiveReputers[len(x.ActiveReputers)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 73:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowestReputerScoreEma", wireType)
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIdAndTopic: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIdAndTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 				}
-				var msglen int
+				x.TopicId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11558,29 +15914,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.TopicId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.LowestReputerScoreEma = append(x.LowestReputerScoreEma, &TopicIdActorIdScore{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LowestReputerScoreEma[len(x.LowestReputerScoreEma)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 74:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LossBundles", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11607,210 +15948,446 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LossBundles = append(x.LossBundles, &TopicIdReputerReputerValueBundle{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LossBundles[len(x.LossBundles)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 75:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalSumPreviousTopicWeights", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.TotalSumPreviousTopicWeights = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 76:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardCurrentBlockEmission", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RewardCurrentBlockEmission = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 77:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WhitelistAdmins", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.WhitelistAdmins = append(x.WhitelistAdmins, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 78:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalWhitelist", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Topic == nil {
+					x.Topic = &v3.Topic{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Topic); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.GlobalWhitelist = append(x.GlobalWhitelist, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 79:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicCreatorWhitelist", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TopicCreatorWhitelist = append(x.TopicCreatorWhitelist, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 80:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicWorkerWhitelist", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TopicIdAndOptionalTopicParams          protoreflect.MessageDescriptor
+	fd_TopicIdAndOptionalTopicParams_topic_id protoreflect.FieldDescriptor
+	fd_TopicIdAndOptionalTopicParams_params   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v7_genesis_proto_init()
+	md_TopicIdAndOptionalTopicParams = File_emissions_v7_genesis_proto.Messages().ByName("TopicIdAndOptionalTopicParams")
+	fd_TopicIdAndOptionalTopicParams_topic_id = md_TopicIdAndOptionalTopicParams.Fields().ByName("topic_id")
+	fd_TopicIdAndOptionalTopicParams_params = md_TopicIdAndOptionalTopicParams.Fields().ByName("params")
+}
+
+var _ protoreflect.Message = (*fastReflection_TopicIdAndOptionalTopicParams)(nil)
+
+type fastReflection_TopicIdAndOptionalTopicParams TopicIdAndOptionalTopicParams
+
+func (x *TopicIdAndOptionalTopicParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicIdAndOptionalTopicParams)(x)
+}
+
+func (x *TopicIdAndOptionalTopicParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v7_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TopicIdAndOptionalTopicParams_messageType fastReflection_TopicIdAndOptionalTopicParams_messageType
+var _ protoreflect.MessageType = fastReflection_TopicIdAndOptionalTopicParams_messageType{}
+
+type fastReflection_TopicIdAndOptionalTopicParams_messageType struct{}
+
+func (x fastReflection_TopicIdAndOptionalTopicParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicIdAndOptionalTopicParams)(nil)
+}
+func (x fastReflection_TopicIdAndOptionalTopicParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicIdAndOptionalTopicParams)
+}
+func (x fastReflection_TopicIdAndOptionalTopicParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicIdAndOptionalTopicParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicIdAndOptionalTopicParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) Type() protoreflect.MessageType {
+	return _fastReflection_TopicIdAndOptionalTopicParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) New() protoreflect.Message {
+	return new(fastReflection_TopicIdAndOptionalTopicParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) Interface() protoreflect.ProtoMessage {
+	return (*TopicIdAndOptionalTopicParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_TopicIdAndOptionalTopicParams_topic_id, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_TopicIdAndOptionalTopicParams_params, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v7.TopicIdAndOptionalTopicParams.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v7.TopicIdAndOptionalTopicParams.params":
+		return x.Params != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndOptionalTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndOptionalTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v7.TopicIdAndOptionalTopicParams.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v7.TopicIdAndOptionalTopicParams.params":
+		x.Params = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndOptionalTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndOptionalTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v7.TopicIdAndOptionalTopicParams.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v7.TopicIdAndOptionalTopicParams.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndOptionalTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndOptionalTopicParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v7.TopicIdAndOptionalTopicParams.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v7.TopicIdAndOptionalTopicParams.params":
+		x.Params = value.Message().Interface().(*v3.OptionalTopicParams)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndOptionalTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndOptionalTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v7.TopicIdAndOptionalTopicParams.params":
+		if x.Params == nil {
+			x.Params = new(v3.OptionalTopicParams)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "emissions.v7.TopicIdAndOptionalTopicParams.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v7.TopicIdAndOptionalTopicParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndOptionalTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndOptionalTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v7.TopicIdAndOptionalTopicParams.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v7.TopicIdAndOptionalTopicParams.params":
+		m := new(v3.OptionalTopicParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndOptionalTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndOptionalTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v7.TopicIdAndOptionalTopicParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TopicIdAndOptionalTopicParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TopicIdAndOptionalTopicParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TopicIdAndOptionalTopicParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TopicIdAndOptionalTopicParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TopicWorkerWhitelist = append(x.TopicWorkerWhitelist, &TopicAndActorId{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicWorkerWhitelist[len(x.TopicWorkerWhitelist)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 81:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicReputerWhitelist", wireType)
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIdAndOptionalTopicParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIdAndOptionalTopicParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 				}
-				var msglen int
+				x.TopicId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11820,181 +16397,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.TopicId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.TopicReputerWhitelist = append(x.TopicReputerWhitelist, &TopicAndActorId{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TopicReputerWhitelist[len(x.TopicReputerWhitelist)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 82:
-				if wireType == 0 {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					x.TopicWorkerWhitelistEnabled = append(x.TopicWorkerWhitelistEnabled, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					if postIndex > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
-					}
-					elementCount = count
-					if elementCount != 0 && len(x.TopicWorkerWhitelistEnabled) == 0 {
-						x.TopicWorkerWhitelistEnabled = make([]uint64, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.TopicWorkerWhitelistEnabled = append(x.TopicWorkerWhitelistEnabled, v)
-					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicWorkerWhitelistEnabled", wireType)
-				}
-			case 83:
-				if wireType == 0 {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					x.TopicReputerWhitelistEnabled = append(x.TopicReputerWhitelistEnabled, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					if postIndex > l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
-					}
-					elementCount = count
-					if elementCount != 0 && len(x.TopicReputerWhitelistEnabled) == 0 {
-						x.TopicReputerWhitelistEnabled = make([]uint64, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.TopicReputerWhitelistEnabled = append(x.TopicReputerWhitelistEnabled, v)
-					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicReputerWhitelistEnabled", wireType)
-				}
-			case 84:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastMedianInferences", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -12018,19 +16428,438 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &v3.OptionalTopicParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TopicIdAndTag          protoreflect.MessageDescriptor
+	fd_TopicIdAndTag_topic_id protoreflect.FieldDescriptor
+	fd_TopicIdAndTag_tag      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v7_genesis_proto_init()
+	md_TopicIdAndTag = File_emissions_v7_genesis_proto.Messages().ByName("TopicIdAndTag")
+	fd_TopicIdAndTag_topic_id = md_TopicIdAndTag.Fields().ByName("topic_id")
+	fd_TopicIdAndTag_tag = md_TopicIdAndTag.Fields().ByName("tag")
+}
+
+var _ protoreflect.Message = (*fastReflection_TopicIdAndTag)(nil)
+
+type fastReflection_TopicIdAndTag TopicIdAndTag
+
+func (x *TopicIdAndTag) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicIdAndTag)(x)
+}
+
+func (x *TopicIdAndTag) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v7_genesis_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TopicIdAndTag_messageType fastReflection_TopicIdAndTag_messageType
+var _ protoreflect.MessageType = fastReflection_TopicIdAndTag_messageType{}
+
+type fastReflection_TopicIdAndTag_messageType struct{}
+
+func (x fastReflection_TopicIdAndTag_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicIdAndTag)(nil)
+}
+func (x fastReflection_TopicIdAndTag_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicIdAndTag)
+}
+func (x fastReflection_TopicIdAndTag_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicIdAndTag
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TopicIdAndTag) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicIdAndTag
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TopicIdAndTag) Type() protoreflect.MessageType {
+	return _fastReflection_TopicIdAndTag_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TopicIdAndTag) New() protoreflect.Message {
+	return new(fastReflection_TopicIdAndTag)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TopicIdAndTag) Interface() protoreflect.ProtoMessage {
+	return (*TopicIdAndTag)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TopicIdAndTag) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_TopicIdAndTag_topic_id, value) {
+			return
+		}
+	}
+	if x.Tag != "" {
+		value := protoreflect.ValueOfString(x.Tag)
+		if !f(fd_TopicIdAndTag_tag, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TopicIdAndTag) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v7.TopicIdAndTag.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v7.TopicIdAndTag.tag":
+		return x.Tag != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTag"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndTag does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicIdAndTag) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v7.TopicIdAndTag.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v7.TopicIdAndTag.tag":
+		x.Tag = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTag"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndTag does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TopicIdAndTag) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v7.TopicIdAndTag.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v7.TopicIdAndTag.tag":
+		value := x.Tag
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTag"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndTag does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicIdAndTag) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v7.TopicIdAndTag.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v7.TopicIdAndTag.tag":
+		x.Tag = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTag"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndTag does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicIdAndTag) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v7.TopicIdAndTag.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v7.TopicIdAndTag is not mutable"))
+	case "emissions.v7.TopicIdAndTag.tag":
+		panic(fmt.Errorf("field tag of message emissions.v7.TopicIdAndTag is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTag"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndTag does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TopicIdAndTag) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v7.TopicIdAndTag.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v7.TopicIdAndTag.tag":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTag"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicIdAndTag does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TopicIdAndTag) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v7.TopicIdAndTag", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TopicIdAndTag) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicIdAndTag) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TopicIdAndTag) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TopicIdAndTag) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TopicIdAndTag)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		l = len(x.Tag)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TopicIdAndTag)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Tag) > 0 {
+			i -= len(x.Tag)
+			copy(dAtA[i:], x.Tag)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tag)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TopicIdAndTag)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LastMedianInferences = append(x.LastMedianInferences, &TopicIdAndDec{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastMedianInferences[len(x.LastMedianInferences)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 85:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MadInferences", wireType)
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIdAndTag: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIdAndTag: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 				}
-				var msglen int
+				x.TopicId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12040,31 +16869,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.TopicId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MadInferences = append(x.MadInferences, &TopicIdAndDec{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MadInferences[len(x.MadInferences)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 86:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialInfererEmaScore", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12074,131 +16888,446 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InitialInfererEmaScore = append(x.InitialInfererEmaScore, &TopicIdAndDec{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InitialInfererEmaScore[len(x.InitialInfererEmaScore)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Tag = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 87:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialForecasterEmaScore", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InitialForecasterEmaScore = append(x.InitialForecasterEmaScore, &TopicIdAndDec{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InitialForecasterEmaScore[len(x.InitialForecasterEmaScore)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 88:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialReputerEmaScore", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TopicAndActorId          protoreflect.MessageDescriptor
+	fd_TopicAndActorId_topic_id protoreflect.FieldDescriptor
+	fd_TopicAndActorId_actor_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v7_genesis_proto_init()
+	md_TopicAndActorId = File_emissions_v7_genesis_proto.Messages().ByName("TopicAndActorId")
+	fd_TopicAndActorId_topic_id = md_TopicAndActorId.Fields().ByName("topic_id")
+	fd_TopicAndActorId_actor_id = md_TopicAndActorId.Fields().ByName("actor_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_TopicAndActorId)(nil)
+
+type fastReflection_TopicAndActorId TopicAndActorId
+
+func (x *TopicAndActorId) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicAndActorId)(x)
+}
+
+func (x *TopicAndActorId) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v7_genesis_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TopicAndActorId_messageType fastReflection_TopicAndActorId_messageType
+var _ protoreflect.MessageType = fastReflection_TopicAndActorId_messageType{}
+
+type fastReflection_TopicAndActorId_messageType struct{}
+
+func (x fastReflection_TopicAndActorId_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicAndActorId)(nil)
+}
+func (x fastReflection_TopicAndActorId_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicAndActorId)
+}
+func (x fastReflection_TopicAndActorId_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicAndActorId
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TopicAndActorId) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicAndActorId
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TopicAndActorId) Type() protoreflect.MessageType {
+	return _fastReflection_TopicAndActorId_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TopicAndActorId) New() protoreflect.Message {
+	return new(fastReflection_TopicAndActorId)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TopicAndActorId) Interface() protoreflect.ProtoMessage {
+	return (*TopicAndActorId)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TopicAndActorId) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_TopicAndActorId_topic_id, value) {
+			return
+		}
+	}
+	if x.ActorId != "" {
+		value := protoreflect.ValueOfString(x.ActorId)
+		if !f(fd_TopicAndActorId_actor_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TopicAndActorId) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v7.TopicAndActorId.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v7.TopicAndActorId.actor_id":
+		return x.ActorId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicAndActorId"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicAndActorId does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicAndActorId) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v7.TopicAndActorId.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v7.TopicAndActorId.actor_id":
+		x.ActorId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicAndActorId"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicAndActorId does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TopicAndActorId) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v7.TopicAndActorId.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v7.TopicAndActorId.actor_id":
+		value := x.ActorId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicAndActorId"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicAndActorId does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicAndActorId) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v7.TopicAndActorId.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v7.TopicAndActorId.actor_id":
+		x.ActorId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicAndActorId"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicAndActorId does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicAndActorId) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v7.TopicAndActorId.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v7.TopicAndActorId is not mutable"))
+	case "emissions.v7.TopicAndActorId.actor_id":
+		panic(fmt.Errorf("field actor_id of message emissions.v7.TopicAndActorId is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicAndActorId"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicAndActorId does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TopicAndActorId) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v7.TopicAndActorId.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v7.TopicAndActorId.actor_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicAndActorId"))
+		}
+		panic(fmt.Errorf("message emissions.v7.TopicAndActorId does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TopicAndActorId) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v7.TopicAndActorId", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TopicAndActorId) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicAndActorId) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TopicAndActorId) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TopicAndActorId) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TopicAndActorId)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		l = len(x.ActorId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TopicAndActorId)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ActorId) > 0 {
+			i -= len(x.ActorId)
+			copy(dAtA[i:], x.ActorId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ActorId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TopicAndActorId)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InitialReputerEmaScore = append(x.InitialReputerEmaScore, &TopicIdAndDec{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InitialReputerEmaScore[len(x.InitialReputerEmaScore)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 89:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalWorkerWhitelist", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.GlobalWorkerWhitelist = append(x.GlobalWorkerWhitelist, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 90:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalReputerWhitelist", wireType)
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicAndActorId: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicAndActorId: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 				}
-				var stringLen uint64
+				x.TopicId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12208,27 +17337,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.TopicId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.GlobalReputerWhitelist = append(x.GlobalReputerWhitelist, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 91:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalAdminWhitelist", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -12256,7 +17372,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.GlobalAdminWhitelist = append(x.GlobalAdminWhitelist, string(dAtA[iNdEx:postIndex]))
+				x.ActorId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -12294,28 +17410,30 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TopicIdAndTopic          protoreflect.MessageDescriptor
-	fd_TopicIdAndTopic_topic_id protoreflect.FieldDescriptor
-	fd_TopicIdAndTopic_topic    protoreflect.FieldDescriptor
+	md_TopicIdDelegatorReputer           protoreflect.MessageDescriptor
+	fd_TopicIdDelegatorReputer_topic_id  protoreflect.FieldDescriptor
+	fd_TopicIdDelegatorReputer_delegator protoreflect.FieldDescriptor
+	fd_TopicIdDelegatorReputer_reputer   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v7_genesis_proto_init()
-	md_TopicIdAndTopic = File_emissions_v7_genesis_proto.Messages().ByName("TopicIdAndTopic")
-	fd_TopicIdAndTopic_topic_id = md_TopicIdAndTopic.Fields().ByName("topic_id")
-	fd_TopicIdAndTopic_topic = md_TopicIdAndTopic.Fields().ByName("topic")
+	md_TopicIdDelegatorReputer = File_emissions_v7_genesis_proto.Messages().ByName("TopicIdDelegatorReputer")
+	fd_TopicIdDelegatorReputer_topic_id = md_TopicIdDelegatorReputer.Fields().ByName("topic_id")
+	fd_TopicIdDelegatorReputer_delegator = md_TopicIdDelegatorReputer.Fields().ByName("delegator")
+	fd_TopicIdDelegatorReputer_reputer = md_TopicIdDelegatorReputer.Fields().ByName("reputer")
 }
 
-var _ protoreflect.Message = (*fastReflection_TopicIdAndTopic)(nil)
+var _ protoreflect.Message = (*fastReflection_TopicIdDelegatorReputer)(nil)
 
-type fastReflection_TopicIdAndTopic TopicIdAndTopic
+type fastReflection_TopicIdDelegatorReputer TopicIdDelegatorReputer
 
-func (x *TopicIdAndTopic) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TopicIdAndTopic)(x)
+func (x *TopicIdDelegatorReputer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicIdDelegatorReputer)(x)
 }
 
-func (x *TopicIdAndTopic) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[1]
+func (x *TopicIdDelegatorReputer) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v7_genesis_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12326,43 +17444,43 @@ func (x *TopicIdAndTopic) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TopicIdAndTopic_messageType fastReflection_TopicIdAndTopic_messageType
-var _ protoreflect.MessageType = fastReflection_TopicIdAndTopic_messageType{}
+var _fastReflection_TopicIdDelegatorReputer_messageType fastReflection_TopicIdDelegatorReputer_messageType
+var _ protoreflect.MessageType = fastReflection_TopicIdDelegatorReputer_messageType{}
 
-type fastReflection_TopicIdAndTopic_messageType struct{}
+type fastReflection_TopicIdDelegatorReputer_messageType struct{}
 
-func (x fastReflection_TopicIdAndTopic_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TopicIdAndTopic)(nil)
+func (x fastReflection_TopicIdDelegatorReputer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicIdDelegatorReputer)(nil)
 }
-func (x fastReflection_TopicIdAndTopic_messageType) New() protoreflect.Message {
-	return new(fastReflection_TopicIdAndTopic)
+func (x fastReflection_TopicIdDelegatorReputer_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicIdDelegatorReputer)
 }
-func (x fastReflection_TopicIdAndTopic_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TopicIdAndTopic
+func (x fastReflection_TopicIdDelegatorReputer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicIdDelegatorReputer
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TopicIdAndTopic) Descriptor() protoreflect.MessageDescriptor {
-	return md_TopicIdAndTopic
+func (x *fastReflection_TopicIdDelegatorReputer) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicIdDelegatorReputer
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TopicIdAndTopic) Type() protoreflect.MessageType {
-	return _fastReflection_TopicIdAndTopic_messageType
+func (x *fastReflection_TopicIdDelegatorReputer) Type() protoreflect.MessageType {
+	return _fastReflection_TopicIdDelegatorReputer_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TopicIdAndTopic) New() protoreflect.Message {
-	return new(fastReflection_TopicIdAndTopic)
+func (x *fastReflection_TopicIdDelegatorReputer) New() protoreflect.Message {
+	return new(fastReflection_TopicIdDelegatorReputer)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TopicIdAndTopic) Interface() protoreflect.ProtoMessage {
-	return (*TopicIdAndTopic)(x)
+func (x *fastReflection_TopicIdDelegatorReputer) Interface() protoreflect.ProtoMessage {
+	return (*TopicIdDelegatorReputer)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12370,16 +17488,22 @@ func (x *fastReflection_TopicIdAndTopic) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TopicIdAndTopic) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_TopicIdDelegatorReputer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.TopicId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.TopicId)
-		if !f(fd_TopicIdAndTopic_topic_id, value) {
+		if !f(fd_TopicIdDelegatorReputer_topic_id, value) {
 			return
 		}
 	}
-	if x.Topic != nil {
-		value := protoreflect.ValueOfMessage(x.Topic.ProtoReflect())
-		if !f(fd_TopicIdAndTopic_topic, value) {
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_TopicIdDelegatorReputer_delegator, value) {
+			return
+		}
+	}
+	if x.Reputer != "" {
+		value := protoreflect.ValueOfString(x.Reputer)
+		if !f(fd_TopicIdDelegatorReputer_reputer, value) {
 			return
 		}
 	}
@@ -12396,17 +17520,19 @@ func (x *fastReflection_TopicIdAndTopic) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TopicIdAndTopic) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TopicIdDelegatorReputer) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v7.TopicIdAndTopic.topic_id":
+	case "emissions.v7.TopicIdDelegatorReputer.topic_id":
 		return x.TopicId != uint64(0)
-	case "emissions.v7.TopicIdAndTopic.topic":
-		return x.Topic != nil
+	case "emissions.v7.TopicIdDelegatorReputer.delegator":
+		return x.Delegator != ""
+	case "emissions.v7.TopicIdDelegatorReputer.reputer":
+		return x.Reputer != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTopic"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdDelegatorReputer"))
 		}
-		panic(fmt.Errorf("message emissions.v7.TopicIdAndTopic does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v7.TopicIdDelegatorReputer does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12416,17 +17542,19 @@ func (x *fastReflection_TopicIdAndTopic) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicIdAndTopic) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TopicIdDelegatorReputer) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v7.TopicIdAndTopic.topic_id":
+	case "emissions.v7.TopicIdDelegatorReputer.topic_id":
 		x.TopicId = uint64(0)
-	case "emissions.v7.TopicIdAndTopic.topic":
-		x.Topic = nil
+	case "emissions.v7.TopicIdDelegatorReputer.delegator":
+		x.Delegator = ""
+	case "emissions.v7.TopicIdDelegatorReputer.reputer":
+		x.Reputer = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTopic"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdDelegatorReputer"))
 		}
-		panic(fmt.Errorf("message emissions.v7.TopicIdAndTopic does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v7.TopicIdDelegatorReputer does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12436,19 +17564,22 @@ func (x *fastReflection_TopicIdAndTopic) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TopicIdAndTopic) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicIdDelegatorReputer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v7.TopicIdAndTopic.topic_id":
+	case "emissions.v7.TopicIdDelegatorReputer.topic_id":
 		value := x.TopicId
 		return protoreflect.ValueOfUint64(value)
-	case "emissions.v7.TopicIdAndTopic.topic":
-		value := x.Topic
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v7.TopicIdDelegatorReputer.delegator":
+		value := x.Delegator
+		return protoreflect.ValueOfString(value)
+	case "emissions.v7.TopicIdDelegatorReputer.reputer":
+		value := x.Reputer
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTopic"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdDelegatorReputer"))
 		}
-		panic(fmt.Errorf("message emissions.v7.TopicIdAndTopic does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v7.TopicIdDelegatorReputer does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12462,17 +17593,19 @@ func (x *fastReflection_TopicIdAndTopic) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicIdAndTopic) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TopicIdDelegatorReputer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v7.TopicIdAndTopic.topic_id":
+	case "emissions.v7.TopicIdDelegatorReputer.topic_id":
 		x.TopicId = value.Uint()
-	case "emissions.v7.TopicIdAndTopic.topic":
-		x.Topic = value.Message().Interface().(*v3.Topic)
+	case "emissions.v7.TopicIdDelegatorReputer.delegator":
+		x.Delegator = value.Interface().(string)
+	case "emissions.v7.TopicIdDelegatorReputer.reputer":
+		x.Reputer = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTopic"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdDelegatorReputer"))
 		}
-		panic(fmt.Errorf("message emissions.v7.TopicIdAndTopic does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v7.TopicIdDelegatorReputer does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12486,48 +17619,48 @@ func (x *fastReflection_TopicIdAndTopic) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicIdAndTopic) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicIdDelegatorReputer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v7.TopicIdAndTopic.topic":
-		if x.Topic == nil {
-			x.Topic = new(v3.Topic)
-		}
-		return protoreflect.ValueOfMessage(x.Topic.ProtoReflect())
-	case "emissions.v7.TopicIdAndTopic.topic_id":
-		panic(fmt.Errorf("field topic_id of message emissions.v7.TopicIdAndTopic is not mutable"))
+	case "emissions.v7.TopicIdDelegatorReputer.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v7.TopicIdDelegatorReputer is not mutable"))
+	case "emissions.v7.TopicIdDelegatorReputer.delegator":
+		panic(fmt.Errorf("field delegator of message emissions.v7.TopicIdDelegatorReputer is not mutable"))
+	case "emissions.v7.TopicIdDelegatorReputer.reputer":
+		panic(fmt.Errorf("field reputer of message emissions.v7.TopicIdDelegatorReputer is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTopic"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdDelegatorReputer"))
 		}
-		panic(fmt.Errorf("message emissions.v7.TopicIdAndTopic does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v7.TopicIdDelegatorReputer does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TopicIdAndTopic) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicIdDelegatorReputer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v7.TopicIdAndTopic.topic_id":
+	case "emissions.v7.TopicIdDelegatorReputer.topic_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "emissions.v7.TopicIdAndTopic.topic":
-		m := new(v3.Topic)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v7.TopicIdDelegatorReputer.delegator":
+		return protoreflect.ValueOfString("")
+	case "emissions.v7.TopicIdDelegatorReputer.reputer":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdAndTopic"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicIdDelegatorReputer"))
 		}
-		panic(fmt.Errorf("message emissions.v7.TopicIdAndTopic does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v7.TopicIdDelegatorReputer does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TopicIdAndTopic) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TopicIdDelegatorReputer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v7.TopicIdAndTopic", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v7.TopicIdDelegatorReputer", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12535,7 +17668,7 @@ func (x *fastReflection_TopicIdAndTopic) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TopicIdAndTopic) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TopicIdDelegatorReputer) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12546,7 +17679,7 @@ func (x *fastReflection_TopicIdAndTopic) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicIdAndTopic) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TopicIdDelegatorReputer) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12558,7 +17691,7 @@ func (x *fastReflection_TopicIdAndTopic) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TopicIdAndTopic) IsValid() bool {
+func (x *fastReflection_TopicIdDelegatorReputer) IsValid() bool {
 	return x != nil
 }
 
@@ -12568,9 +17701,9 @@ func (x *fastReflection_TopicIdAndTopic) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TopicIdAndTopic) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TopicIdDelegatorReputer) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TopicIdAndTopic)
+		x := input.Message.Interface().(*TopicIdDelegatorReputer)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12585,8 +17718,12 @@ func (x *fastReflection_TopicIdAndTopic) ProtoMethods() *protoiface.Methods {
 		if x.TopicId != 0 {
 			n += 1 + runtime.Sov(uint64(x.TopicId))
 		}
-		if x.Topic != nil {
-			l = options.Size(x.Topic)
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reputer)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -12599,7 +17736,7 @@ func (x *fastReflection_TopicIdAndTopic) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TopicIdAndTopic)
+		x := input.Message.Interface().(*TopicIdDelegatorReputer)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12618,17 +17755,17 @@ func (x *fastReflection_TopicIdAndTopic) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Topic != nil {
-			encoded, err := options.Marshal(x.Topic)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Reputer) > 0 {
+			i -= len(x.Reputer)
+			copy(dAtA[i:], x.Reputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reputer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -12648,7 +17785,7 @@ func (x *fastReflection_TopicIdAndTopic) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TopicIdAndTopic)
+		x := input.Message.Interface().(*TopicIdDelegatorReputer)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12680,10 +17817,10 @@ func (x *fastReflection_TopicIdAndTopic) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIdAndTopic: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIdDelegatorReputer: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIdAndTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicIdDelegatorReputer: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -12707,9 +17844,9 @@ func (x *fastReflection_TopicIdAndTopic) ProtoMethods() *protoiface.Methods {
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12719,27 +17856,55 @@ func (x *fastReflection_TopicIdAndTopic) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Topic == nil {
-					x.Topic = &v3.Topic{}
+				x.Delegator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Topic); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reputer = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -12777,28 +17942,30 @@ func (x *fastReflection_TopicIdAndTopic) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TopicAndActorId          protoreflect.MessageDescriptor
-	fd_TopicAndActorId_topic_id protoreflect.FieldDescriptor
-	fd_TopicAndActorId_actor_id protoreflect.FieldDescriptor
+	md_TopicActorOperator          protoreflect.MessageDescriptor
+	fd_TopicActorOperator_topic_id protoreflect.FieldDescriptor
+	fd_TopicActorOperator_actor    protoreflect.FieldDescriptor
+	fd_TopicActorOperator_operator protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v7_genesis_proto_init()
-	md_TopicAndActorId = File_emissions_v7_genesis_proto.Messages().ByName("TopicAndActorId")
-	fd_TopicAndActorId_topic_id = md_TopicAndActorId.Fields().ByName("topic_id")
-	fd_TopicAndActorId_actor_id = md_TopicAndActorId.Fields().ByName("actor_id")
+	md_TopicActorOperator = File_emissions_v7_genesis_proto.Messages().ByName("TopicActorOperator")
+	fd_TopicActorOperator_topic_id = md_TopicActorOperator.Fields().ByName("topic_id")
+	fd_TopicActorOperator_actor = md_TopicActorOperator.Fields().ByName("actor")
+	fd_TopicActorOperator_operator = md_TopicActorOperator.Fields().ByName("operator")
 }
 
-var _ protoreflect.Message = (*fastReflection_TopicAndActorId)(nil)
+var _ protoreflect.Message = (*fastReflection_TopicActorOperator)(nil)
 
-type fastReflection_TopicAndActorId TopicAndActorId
+type fastReflection_TopicActorOperator TopicActorOperator
 
-func (x *TopicAndActorId) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TopicAndActorId)(x)
+func (x *TopicActorOperator) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicActorOperator)(x)
 }
 
-func (x *TopicAndActorId) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[2]
+func (x *TopicActorOperator) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v7_genesis_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12809,43 +17976,43 @@ func (x *TopicAndActorId) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TopicAndActorId_messageType fastReflection_TopicAndActorId_messageType
-var _ protoreflect.MessageType = fastReflection_TopicAndActorId_messageType{}
+var _fastReflection_TopicActorOperator_messageType fastReflection_TopicActorOperator_messageType
+var _ protoreflect.MessageType = fastReflection_TopicActorOperator_messageType{}
 
-type fastReflection_TopicAndActorId_messageType struct{}
+type fastReflection_TopicActorOperator_messageType struct{}
 
-func (x fastReflection_TopicAndActorId_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TopicAndActorId)(nil)
+func (x fastReflection_TopicActorOperator_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicActorOperator)(nil)
 }
-func (x fastReflection_TopicAndActorId_messageType) New() protoreflect.Message {
-	return new(fastReflection_TopicAndActorId)
+func (x fastReflection_TopicActorOperator_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicActorOperator)
 }
-func (x fastReflection_TopicAndActorId_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TopicAndActorId
+func (x fastReflection_TopicActorOperator_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicActorOperator
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TopicAndActorId) Descriptor() protoreflect.MessageDescriptor {
-	return md_TopicAndActorId
+func (x *fastReflection_TopicActorOperator) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicActorOperator
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TopicAndActorId) Type() protoreflect.MessageType {
-	return _fastReflection_TopicAndActorId_messageType
+func (x *fastReflection_TopicActorOperator) Type() protoreflect.MessageType {
+	return _fastReflection_TopicActorOperator_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TopicAndActorId) New() protoreflect.Message {
-	return new(fastReflection_TopicAndActorId)
+func (x *fastReflection_TopicActorOperator) New() protoreflect.Message {
+	return new(fastReflection_TopicActorOperator)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TopicAndActorId) Interface() protoreflect.ProtoMessage {
-	return (*TopicAndActorId)(x)
+func (x *fastReflection_TopicActorOperator) Interface() protoreflect.ProtoMessage {
+	return (*TopicActorOperator)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12853,16 +18020,22 @@ func (x *fastReflection_TopicAndActorId) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TopicAndActorId) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_TopicActorOperator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.TopicId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.TopicId)
-		if !f(fd_TopicAndActorId_topic_id, value) {
+		if !f(fd_TopicActorOperator_topic_id, value) {
 			return
 		}
 	}
-	if x.ActorId != "" {
-		value := protoreflect.ValueOfString(x.ActorId)
-		if !f(fd_TopicAndActorId_actor_id, value) {
+	if x.Actor != "" {
+		value := protoreflect.ValueOfString(x.Actor)
+		if !f(fd_TopicActorOperator_actor, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_TopicActorOperator_operator, value) {
 			return
 		}
 	}
@@ -12879,17 +18052,19 @@ func (x *fastReflection_TopicAndActorId) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TopicAndActorId) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TopicActorOperator) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v7.TopicAndActorId.topic_id":
+	case "emissions.v7.TopicActorOperator.topic_id":
 		return x.TopicId != uint64(0)
-	case "emissions.v7.TopicAndActorId.actor_id":
-		return x.ActorId != ""
+	case "emissions.v7.TopicActorOperator.actor":
+		return x.Actor != ""
+	case "emissions.v7.TopicActorOperator.operator":
+		return x.Operator != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicAndActorId"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicActorOperator"))
 		}
-		panic(fmt.Errorf("message emissions.v7.TopicAndActorId does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v7.TopicActorOperator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12899,17 +18074,19 @@ func (x *fastReflection_TopicAndActorId) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicAndActorId) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TopicActorOperator) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v7.TopicAndActorId.topic_id":
+	case "emissions.v7.TopicActorOperator.topic_id":
 		x.TopicId = uint64(0)
-	case "emissions.v7.TopicAndActorId.actor_id":
-		x.ActorId = ""
+	case "emissions.v7.TopicActorOperator.actor":
+		x.Actor = ""
+	case "emissions.v7.TopicActorOperator.operator":
+		x.Operator = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicAndActorId"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicActorOperator"))
 		}
-		panic(fmt.Errorf("message emissions.v7.TopicAndActorId does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v7.TopicActorOperator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12919,19 +18096,22 @@ func (x *fastReflection_TopicAndActorId) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TopicAndActorId) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicActorOperator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v7.TopicAndActorId.topic_id":
+	case "emissions.v7.TopicActorOperator.topic_id":
 		value := x.TopicId
 		return protoreflect.ValueOfUint64(value)
-	case "emissions.v7.TopicAndActorId.actor_id":
-		value := x.ActorId
+	case "emissions.v7.TopicActorOperator.actor":
+		value := x.Actor
+		return protoreflect.ValueOfString(value)
+	case "emissions.v7.TopicActorOperator.operator":
+		value := x.Operator
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicAndActorId"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicActorOperator"))
 		}
-		panic(fmt.Errorf("message emissions.v7.TopicAndActorId does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v7.TopicActorOperator does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12945,17 +18125,19 @@ func (x *fastReflection_TopicAndActorId) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicAndActorId) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TopicActorOperator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v7.TopicAndActorId.topic_id":
+	case "emissions.v7.TopicActorOperator.topic_id":
 		x.TopicId = value.Uint()
-	case "emissions.v7.TopicAndActorId.actor_id":
-		x.ActorId = value.Interface().(string)
+	case "emissions.v7.TopicActorOperator.actor":
+		x.Actor = value.Interface().(string)
+	case "emissions.v7.TopicActorOperator.operator":
+		x.Operator = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicAndActorId"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicActorOperator"))
 		}
-		panic(fmt.Errorf("message emissions.v7.TopicAndActorId does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v7.TopicActorOperator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12969,44 +18151,48 @@ func (x *fastReflection_TopicAndActorId) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicAndActorId) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicActorOperator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v7.TopicAndActorId.topic_id":
-		panic(fmt.Errorf("field topic_id of message emissions.v7.TopicAndActorId is not mutable"))
-	case "emissions.v7.TopicAndActorId.actor_id":
-		panic(fmt.Errorf("field actor_id of message emissions.v7.TopicAndActorId is not mutable"))
+	case "emissions.v7.TopicActorOperator.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v7.TopicActorOperator is not mutable"))
+	case "emissions.v7.TopicActorOperator.actor":
+		panic(fmt.Errorf("field actor of message emissions.v7.TopicActorOperator is not mutable"))
+	case "emissions.v7.TopicActorOperator.operator":
+		panic(fmt.Errorf("field operator of message emissions.v7.TopicActorOperator is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicAndActorId"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicActorOperator"))
 		}
-		panic(fmt.Errorf("message emissions.v7.TopicAndActorId does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v7.TopicActorOperator does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TopicAndActorId) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicActorOperator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v7.TopicAndActorId.topic_id":
+	case "emissions.v7.TopicActorOperator.topic_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "emissions.v7.TopicAndActorId.actor_id":
+	case "emissions.v7.TopicActorOperator.actor":
+		return protoreflect.ValueOfString("")
+	case "emissions.v7.TopicActorOperator.operator":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicAndActorId"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.TopicActorOperator"))
 		}
-		panic(fmt.Errorf("message emissions.v7.TopicAndActorId does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v7.TopicActorOperator does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TopicAndActorId) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TopicActorOperator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v7.TopicAndActorId", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v7.TopicActorOperator", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -13014,7 +18200,7 @@ func (x *fastReflection_TopicAndActorId) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TopicAndActorId) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TopicActorOperator) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -13025,7 +18211,7 @@ func (x *fastReflection_TopicAndActorId) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicAndActorId) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TopicActorOperator) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -13037,7 +18223,7 @@ func (x *fastReflection_TopicAndActorId) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TopicAndActorId) IsValid() bool {
+func (x *fastReflection_TopicActorOperator) IsValid() bool {
 	return x != nil
 }
 
@@ -13047,9 +18233,9 @@ func (x *fastReflection_TopicAndActorId) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TopicAndActorId) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TopicActorOperator) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TopicAndActorId)
+		x := input.Message.Interface().(*TopicActorOperator)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13064,7 +18250,11 @@ func (x *fastReflection_TopicAndActorId) ProtoMethods() *protoiface.Methods {
 		if x.TopicId != 0 {
 			n += 1 + runtime.Sov(uint64(x.TopicId))
 		}
-		l = len(x.ActorId)
+		l = len(x.Actor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Operator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -13078,7 +18268,7 @@ func (x *fastReflection_TopicAndActorId) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TopicAndActorId)
+		x := input.Message.Interface().(*TopicActorOperator)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13097,10 +18287,17 @@ func (x *fastReflection_TopicAndActorId) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ActorId) > 0 {
-			i -= len(x.ActorId)
-			copy(dAtA[i:], x.ActorId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ActorId)))
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Actor) > 0 {
+			i -= len(x.Actor)
+			copy(dAtA[i:], x.Actor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Actor)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -13120,7 +18317,7 @@ func (x *fastReflection_TopicAndActorId) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TopicAndActorId)
+		x := input.Message.Interface().(*TopicActorOperator)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13152,10 +18349,10 @@ func (x *fastReflection_TopicAndActorId) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicAndActorId: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicActorOperator: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicAndActorId: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicActorOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -13179,7 +18376,7 @@ func (x *fastReflection_TopicAndActorId) ProtoMethods() *protoiface.Methods {
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -13207,7 +18404,39 @@ func (x *fastReflection_TopicAndActorId) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ActorId = string(dAtA[iNdEx:postIndex])
+				x.Actor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -13266,7 +18495,7 @@ func (x *TopicIdAndBlockHeight) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdAndBlockHeight) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[3]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13764,7 +18993,7 @@ func (x *BlockHeightAndTopicIds) ProtoReflect() protoreflect.Message {
 }
 
 func (x *BlockHeightAndTopicIds) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[4]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14304,7 +19533,7 @@ func (x *TopicIdBlockHeightScores) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdBlockHeightScores) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[5]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14835,7 +20064,7 @@ func (x *TopicIdActorIdScore) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdActorIdScore) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[6]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15382,7 +20611,7 @@ func (x *TopicIdActorIdUint64) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdActorIdUint64) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[7]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15898,7 +21127,7 @@ func (x *TopicIdActorIdListeningCoefficient) ProtoReflect() protoreflect.Message
 }
 
 func (x *TopicIdActorIdListeningCoefficient) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[8]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16445,7 +21674,7 @@ func (x *TopicIdActorIdDec) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdActorIdDec) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[9]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16975,7 +22204,7 @@ func (x *TopicIdAndInt) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdAndInt) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[10]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17445,7 +22674,7 @@ func (x *TopicIdActorIdInt) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdActorIdInt) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[11]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17979,7 +23208,7 @@ func (x *TopicIdDelegatorReputerDelegatorInfo) ProtoReflect() protoreflect.Messa
 }
 
 func (x *TopicIdDelegatorReputerDelegatorInfo) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[12]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18590,7 +23819,7 @@ func (x *BlockHeightTopicIdReputerStakeRemovalInfo) ProtoReflect() protoreflect.
 }
 
 func (x *BlockHeightTopicIdReputerStakeRemovalInfo) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[13]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -19183,7 +24412,7 @@ func (x *ActorIdTopicIdBlockHeight) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ActorIdTopicIdBlockHeight) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[14]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -19703,7 +24932,7 @@ func (x *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) ProtoReflec
 }
 
 func (x *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[15]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -20360,7 +25589,7 @@ func (x *DelegatorReputerTopicIdBlockHeight) ProtoReflect() protoreflect.Message
 }
 
 func (x *DelegatorReputerTopicIdBlockHeight) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[16]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -20938,7 +26167,7 @@ func (x *TopicIdActorIdInference) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdActorIdInference) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[17]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -21485,7 +26714,7 @@ func (x *TopicIdActorIdForecast) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdActorIdForecast) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[18]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22030,7 +27259,7 @@ func (x *LibP2PKeyAndOffchainNode) ProtoReflect() protoreflect.Message {
 }
 
 func (x *LibP2PKeyAndOffchainNode) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[19]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22529,7 +27758,7 @@ func (x *TopicIdAndDec) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdAndDec) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[20]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22999,7 +28228,7 @@ func (x *TopicIdBlockHeightInferences) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdBlockHeightInferences) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[21]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -23530,7 +28759,7 @@ func (x *TopicIdBlockHeightForecasts) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdBlockHeightForecasts) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[22]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -24061,7 +29290,7 @@ func (x *TopicIdBlockHeightReputerValueBundles) ProtoReflect() protoreflect.Mess
 }
 
 func (x *TopicIdBlockHeightReputerValueBundles) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[23]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -24592,7 +29821,7 @@ func (x *TopicIdBlockHeightValueBundles) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdBlockHeightValueBundles) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[24]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -25121,7 +30350,7 @@ func (x *TopicIdAndNonces) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdAndNonces) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[25]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -25604,7 +30833,7 @@ func (x *TopicIdAndReputerRequestNonces) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdAndReputerRequestNonces) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[26]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -26089,7 +31318,7 @@ func (x *TopicIdActorIdTimeStampedValue) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdActorIdTimeStampedValue) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[27]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -26638,7 +31867,7 @@ func (x *TopicIdActorIdActorIdTimeStampedValue) ProtoReflect() protoreflect.Mess
 }
 
 func (x *TopicIdActorIdActorIdTimeStampedValue) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[28]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -27245,7 +32474,7 @@ func (x *TopicIdTimestampedActorNonce) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdTimestampedActorNonce) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[29]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -27728,7 +32957,7 @@ func (x *BlockHeightTopicIds) ProtoReflect() protoreflect.Message {
 }
 
 func (x *BlockHeightTopicIds) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[30]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -28211,7 +33440,7 @@ func (x *BlockHeightTopicIdWeightPair) ProtoReflect() protoreflect.Message {
 }
 
 func (x *BlockHeightTopicIdWeightPair) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[31]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -28696,7 +33925,7 @@ func (x *TopicIdReputerReputerValueBundle) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdReputerReputerValueBundle) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v7_genesis_proto_msgTypes[32]
+	mi := &file_emissions_v7_genesis_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29428,6 +34657,51 @@ type GenesisState struct {
 	GlobalWorkerWhitelist  []string         `protobuf:"bytes,89,rep,name=global_worker_whitelist,json=globalWorkerWhitelist,proto3" json:"global_worker_whitelist,omitempty"`
 	GlobalReputerWhitelist []string         `protobuf:"bytes,90,rep,name=global_reputer_whitelist,json=globalReputerWhitelist,proto3" json:"global_reputer_whitelist,omitempty"`
 	GlobalAdminWhitelist   []string         `protobuf:"bytes,91,rep,name=global_admin_whitelist,json=globalAdminWhitelist,proto3" json:"global_admin_whitelist,omitempty"`
+	// topic parameter changes waiting for the topic's next epoch boundary
+	PendingTopicUpdates []*TopicIdAndOptionalTopicParams `protobuf:"bytes,92,rep,name=pending_topic_updates,json=pendingTopicUpdates,proto3" json:"pending_topic_updates,omitempty"`
+	// topics that have been archived
+	ArchivedTopics []uint64 `protobuf:"varint,93,rep,packed,name=archived_topics,json=archivedTopics,proto3" json:"archived_topics,omitempty"`
+	// archived topics whose per-topic state has not been fully pruned yet
+	ArchivedTopicsToPrune []uint64 `protobuf:"varint,94,rep,packed,name=archived_topics_to_prune,json=archivedTopicsToPrune,proto3" json:"archived_topics_to_prune,omitempty"`
+	// topics whose ownership has been transferred away from their creator
+	TopicOwners []*TopicAndActorId `protobuf:"bytes,95,rep,name=topic_owners,json=topicOwners,proto3" json:"topic_owners,omitempty"`
+	// proposed owners that have not accepted the transfer yet
+	PendingTopicOwners []*TopicAndActorId `protobuf:"bytes,96,rep,name=pending_topic_owners,json=pendingTopicOwners,proto3" json:"pending_topic_owners,omitempty"`
+	// / TOPIC TAGS
+	TopicTags []*TopicIdAndTag `protobuf:"bytes,97,rep,name=topic_tags,json=topicTags,proto3" json:"topic_tags,omitempty"`
+	// latest ground truth submitted by each reputer of topics whose losses are computed by the chain
+	ReputerGroundTruths []*v3.GroundTruth `protobuf:"bytes,98,rep,name=reputer_ground_truths,json=reputerGroundTruths,proto3" json:"reputer_ground_truths,omitempty"`
+	// stake-weighted consensus ground truth of each closed reputer nonce
+	ConsensusGroundTruths []*v3.GroundTruth `protobuf:"bytes,99,rep,name=consensus_ground_truths,json=consensusGroundTruths,proto3" json:"consensus_ground_truths,omitempty"`
+	// payload commitments of workers of topics using commit-reveal, for the open worker nonce
+	WorkerPayloadCommits []*v3.WorkerPayloadCommit `protobuf:"bytes,100,rep,name=worker_payload_commits,json=workerPayloadCommits,proto3" json:"worker_payload_commits,omitempty"`
+	// operator keys authorized to submit payloads on behalf of actors of a topic
+	ActorOperators []*TopicActorOperator `protobuf:"bytes,101,rep,name=actor_operators,json=actorOperators,proto3" json:"actor_operators,omitempty"`
+	// amounts bonded by workers on topics requiring a worker bond
+	WorkerBonds []*v3.WorkerBond `protobuf:"bytes,102,rep,name=worker_bonds,json=workerBonds,proto3" json:"worker_bonds,omitempty"`
+	// pending worker bond removals
+	WorkerBondRemovals []*v3.WorkerBondRemovalInfo `protobuf:"bytes,103,rep,name=worker_bond_removals,json=workerBondRemovals,proto3" json:"worker_bond_removals,omitempty"`
+	// reputers currently deviating from the loss consensus
+	ReputerConsensusDeviations []*v3.ReputerConsensusDeviation `protobuf:"bytes,104,rep,name=reputer_consensus_deviations,json=reputerConsensusDeviations,proto3" json:"reputer_consensus_deviations,omitempty"`
+	// redelegations that have not completed yet
+	Redelegations []*v3.RedelegationInfo `protobuf:"bytes,105,rep,name=redelegations,proto3" json:"redelegations,omitempty"`
+	// delegators that restake their rewards on a topic as they are paid out
+	AutoCompoundDelegators []*TopicAndActorId `protobuf:"bytes,106,rep,name=auto_compound_delegators,json=autoCompoundDelegators,proto3" json:"auto_compound_delegators,omitempty"`
+	// commissions reputers take on the rewards of their delegators
+	ReputerCommissions []*v3.ReputerCommission `protobuf:"bytes,107,rep,name=reputer_commissions,json=reputerCommissions,proto3" json:"reputer_commissions,omitempty"`
+	// stake locked for a lockup period, with their reward multiplier
+	StakeLocks []*v3.StakeLock `protobuf:"bytes,108,rep,name=stake_locks,json=stakeLocks,proto3" json:"stake_locks,omitempty"`
+	// rewards paid out within the reward history retention
+	RewardHistory []*v3.RewardRecord `protobuf:"bytes,109,rep,name=reward_history,json=rewardHistory,proto3" json:"reward_history,omitempty"`
+	// shares of rewards held in escrow until they vest and are claimed
+	RewardEscrows []*v3.RewardEscrow `protobuf:"bytes,110,rep,name=reward_escrows,json=rewardEscrows,proto3" json:"reward_escrows,omitempty"`
+	// rewards waiting to be paid out, and the id of the next reward queued
+	QueuedRewardPayouts []*v3.QueuedRewardPayout `protobuf:"bytes,111,rep,name=queued_reward_payouts,json=queuedRewardPayouts,proto3" json:"queued_reward_payouts,omitempty"`
+	NextRewardPayoutId  uint64                   `protobuf:"varint,112,opt,name=next_reward_payout_id,json=nextRewardPayoutId,proto3" json:"next_reward_payout_id,omitempty"`
+	// last delegation of each archived topic whose stake was scheduled for removal
+	ArchivedTopicDelegateRemovalCursors []*TopicIdDelegatorReputer `protobuf:"bytes,113,rep,name=archived_topic_delegate_removal_cursors,json=archivedTopicDelegateRemovalCursors,proto3" json:"archived_topic_delegate_removal_cursors,omitempty"`
+	// rewards that failed to be paid out max_reward_payout_attempts times
+	DeadLetteredRewardPayouts []*v3.QueuedRewardPayout `protobuf:"bytes,114,rep,name=dead_lettered_reward_payouts,json=deadLetteredRewardPayouts,proto3" json:"dead_lettered_reward_payouts,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -30066,6 +35340,167 @@ func (x *GenesisState) GetGlobalAdminWhitelist() []string {
 	return nil
 }
 
+func (x *GenesisState) GetPendingTopicUpdates() []*TopicIdAndOptionalTopicParams {
+	if x != nil {
+		return x.PendingTopicUpdates
+	}
+	return nil
+}
+
+func (x *GenesisState) GetArchivedTopics() []uint64 {
+	if x != nil {
+		return x.ArchivedTopics
+	}
+	return nil
+}
+
+func (x *GenesisState) GetArchivedTopicsToPrune() []uint64 {
+	if x != nil {
+		return x.ArchivedTopicsToPrune
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTopicOwners() []*TopicAndActorId {
+	if x != nil {
+		return x.TopicOwners
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingTopicOwners() []*TopicAndActorId {
+	if x != nil {
+		return x.PendingTopicOwners
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTopicTags() []*TopicIdAndTag {
+	if x != nil {
+		return x.TopicTags
+	}
+	return nil
+}
+
+func (x *GenesisState) GetReputerGroundTruths() []*v3.GroundTruth {
+	if x != nil {
+		return x.ReputerGroundTruths
+	}
+	return nil
+}
+
+func (x *GenesisState) GetConsensusGroundTruths() []*v3.GroundTruth {
+	if x != nil {
+		return x.ConsensusGroundTruths
+	}
+	return nil
+}
+
+func (x *GenesisState) GetWorkerPayloadCommits() []*v3.WorkerPayloadCommit {
+	if x != nil {
+		return x.WorkerPayloadCommits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetActorOperators() []*TopicActorOperator {
+	if x != nil {
+		return x.ActorOperators
+	}
+	return nil
+}
+
+func (x *GenesisState) GetWorkerBonds() []*v3.WorkerBond {
+	if x != nil {
+		return x.WorkerBonds
+	}
+	return nil
+}
+
+func (x *GenesisState) GetWorkerBondRemovals() []*v3.WorkerBondRemovalInfo {
+	if x != nil {
+		return x.WorkerBondRemovals
+	}
+	return nil
+}
+
+func (x *GenesisState) GetReputerConsensusDeviations() []*v3.ReputerConsensusDeviation {
+	if x != nil {
+		return x.ReputerConsensusDeviations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRedelegations() []*v3.RedelegationInfo {
+	if x != nil {
+		return x.Redelegations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAutoCompoundDelegators() []*TopicAndActorId {
+	if x != nil {
+		return x.AutoCompoundDelegators
+	}
+	return nil
+}
+
+func (x *GenesisState) GetReputerCommissions() []*v3.ReputerCommission {
+	if x != nil {
+		return x.ReputerCommissions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStakeLocks() []*v3.StakeLock {
+	if x != nil {
+		return x.StakeLocks
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRewardHistory() []*v3.RewardRecord {
+	if x != nil {
+		return x.RewardHistory
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRewardEscrows() []*v3.RewardEscrow {
+	if x != nil {
+		return x.RewardEscrows
+	}
+	return nil
+}
+
+func (x *GenesisState) GetQueuedRewardPayouts() []*v3.QueuedRewardPayout {
+	if x != nil {
+		return x.QueuedRewardPayouts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextRewardPayoutId() uint64 {
+	if x != nil {
+		return x.NextRewardPayoutId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetArchivedTopicDelegateRemovalCursors() []*TopicIdDelegatorReputer {
+	if x != nil {
+		return x.ArchivedTopicDelegateRemovalCursors
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDeadLetteredRewardPayouts() []*v3.QueuedRewardPayout {
+	if x != nil {
+		return x.DeadLetteredRewardPayouts
+	}
+	return nil
+}
+
 type TopicIdAndTopic struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -30109,6 +35544,92 @@ func (x *TopicIdAndTopic) GetTopic() *v3.Topic {
 	return nil
 }
 
+type TopicIdAndOptionalTopicParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId uint64                  `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Params  *v3.OptionalTopicParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *TopicIdAndOptionalTopicParams) Reset() {
+	*x = TopicIdAndOptionalTopicParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v7_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicIdAndOptionalTopicParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicIdAndOptionalTopicParams) ProtoMessage() {}
+
+// Deprecated: Use TopicIdAndOptionalTopicParams.ProtoReflect.Descriptor instead.
+func (*TopicIdAndOptionalTopicParams) Descriptor() ([]byte, []int) {
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TopicIdAndOptionalTopicParams) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *TopicIdAndOptionalTopicParams) GetParams() *v3.OptionalTopicParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type TopicIdAndTag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Tag     string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *TopicIdAndTag) Reset() {
+	*x = TopicIdAndTag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v7_genesis_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicIdAndTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicIdAndTag) ProtoMessage() {}
+
+// Deprecated: Use TopicIdAndTag.ProtoReflect.Descriptor instead.
+func (*TopicIdAndTag) Descriptor() ([]byte, []int) {
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TopicIdAndTag) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *TopicIdAndTag) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
 type TopicAndActorId struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -30121,7 +35642,7 @@ type TopicAndActorId struct {
 func (x *TopicAndActorId) Reset() {
 	*x = TopicAndActorId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[2]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30135,7 +35656,7 @@ func (*TopicAndActorId) ProtoMessage() {}
 
 // Deprecated: Use TopicAndActorId.ProtoReflect.Descriptor instead.
 func (*TopicAndActorId) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{2}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TopicAndActorId) GetTopicId() uint64 {
@@ -30145,9 +35666,111 @@ func (x *TopicAndActorId) GetTopicId() uint64 {
 	return 0
 }
 
-func (x *TopicAndActorId) GetActorId() string {
+func (x *TopicAndActorId) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type TopicIdDelegatorReputer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId   uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Reputer   string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+}
+
+func (x *TopicIdDelegatorReputer) Reset() {
+	*x = TopicIdDelegatorReputer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v7_genesis_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicIdDelegatorReputer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicIdDelegatorReputer) ProtoMessage() {}
+
+// Deprecated: Use TopicIdDelegatorReputer.ProtoReflect.Descriptor instead.
+func (*TopicIdDelegatorReputer) Descriptor() ([]byte, []int) {
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TopicIdDelegatorReputer) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *TopicIdDelegatorReputer) GetDelegator() string {
+	if x != nil {
+		return x.Delegator
+	}
+	return ""
+}
+
+func (x *TopicIdDelegatorReputer) GetReputer() string {
+	if x != nil {
+		return x.Reputer
+	}
+	return ""
+}
+
+type TopicActorOperator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId  uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Actor    string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (x *TopicActorOperator) Reset() {
+	*x = TopicActorOperator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v7_genesis_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicActorOperator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicActorOperator) ProtoMessage() {}
+
+// Deprecated: Use TopicActorOperator.ProtoReflect.Descriptor instead.
+func (*TopicActorOperator) Descriptor() ([]byte, []int) {
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TopicActorOperator) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *TopicActorOperator) GetActor() string {
 	if x != nil {
-		return x.ActorId
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *TopicActorOperator) GetOperator() string {
+	if x != nil {
+		return x.Operator
 	}
 	return ""
 }
@@ -30164,7 +35787,7 @@ type TopicIdAndBlockHeight struct {
 func (x *TopicIdAndBlockHeight) Reset() {
 	*x = TopicIdAndBlockHeight{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[3]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30178,7 +35801,7 @@ func (*TopicIdAndBlockHeight) ProtoMessage() {}
 
 // Deprecated: Use TopicIdAndBlockHeight.ProtoReflect.Descriptor instead.
 func (*TopicIdAndBlockHeight) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{3}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TopicIdAndBlockHeight) GetTopicId() uint64 {
@@ -30207,7 +35830,7 @@ type BlockHeightAndTopicIds struct {
 func (x *BlockHeightAndTopicIds) Reset() {
 	*x = BlockHeightAndTopicIds{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[4]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30221,7 +35844,7 @@ func (*BlockHeightAndTopicIds) ProtoMessage() {}
 
 // Deprecated: Use BlockHeightAndTopicIds.ProtoReflect.Descriptor instead.
 func (*BlockHeightAndTopicIds) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{4}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BlockHeightAndTopicIds) GetBlockHeight() int64 {
@@ -30251,7 +35874,7 @@ type TopicIdBlockHeightScores struct {
 func (x *TopicIdBlockHeightScores) Reset() {
 	*x = TopicIdBlockHeightScores{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[5]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30265,7 +35888,7 @@ func (*TopicIdBlockHeightScores) ProtoMessage() {}
 
 // Deprecated: Use TopicIdBlockHeightScores.ProtoReflect.Descriptor instead.
 func (*TopicIdBlockHeightScores) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{5}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TopicIdBlockHeightScores) GetTopicId() uint64 {
@@ -30302,7 +35925,7 @@ type TopicIdActorIdScore struct {
 func (x *TopicIdActorIdScore) Reset() {
 	*x = TopicIdActorIdScore{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[6]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30316,7 +35939,7 @@ func (*TopicIdActorIdScore) ProtoMessage() {}
 
 // Deprecated: Use TopicIdActorIdScore.ProtoReflect.Descriptor instead.
 func (*TopicIdActorIdScore) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{6}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TopicIdActorIdScore) GetTopicId() uint64 {
@@ -30353,7 +35976,7 @@ type TopicIdActorIdUint64 struct {
 func (x *TopicIdActorIdUint64) Reset() {
 	*x = TopicIdActorIdUint64{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[7]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30367,7 +35990,7 @@ func (*TopicIdActorIdUint64) ProtoMessage() {}
 
 // Deprecated: Use TopicIdActorIdUint64.ProtoReflect.Descriptor instead.
 func (*TopicIdActorIdUint64) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{7}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TopicIdActorIdUint64) GetTopicId() uint64 {
@@ -30404,7 +36027,7 @@ type TopicIdActorIdListeningCoefficient struct {
 func (x *TopicIdActorIdListeningCoefficient) Reset() {
 	*x = TopicIdActorIdListeningCoefficient{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[8]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30418,7 +36041,7 @@ func (*TopicIdActorIdListeningCoefficient) ProtoMessage() {}
 
 // Deprecated: Use TopicIdActorIdListeningCoefficient.ProtoReflect.Descriptor instead.
 func (*TopicIdActorIdListeningCoefficient) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{8}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TopicIdActorIdListeningCoefficient) GetTopicId() uint64 {
@@ -30455,7 +36078,7 @@ type TopicIdActorIdDec struct {
 func (x *TopicIdActorIdDec) Reset() {
 	*x = TopicIdActorIdDec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[9]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30469,7 +36092,7 @@ func (*TopicIdActorIdDec) ProtoMessage() {}
 
 // Deprecated: Use TopicIdActorIdDec.ProtoReflect.Descriptor instead.
 func (*TopicIdActorIdDec) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{9}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TopicIdActorIdDec) GetTopicId() uint64 {
@@ -30505,7 +36128,7 @@ type TopicIdAndInt struct {
 func (x *TopicIdAndInt) Reset() {
 	*x = TopicIdAndInt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[10]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30519,7 +36142,7 @@ func (*TopicIdAndInt) ProtoMessage() {}
 
 // Deprecated: Use TopicIdAndInt.ProtoReflect.Descriptor instead.
 func (*TopicIdAndInt) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{10}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TopicIdAndInt) GetTopicId() uint64 {
@@ -30549,7 +36172,7 @@ type TopicIdActorIdInt struct {
 func (x *TopicIdActorIdInt) Reset() {
 	*x = TopicIdActorIdInt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[11]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30563,7 +36186,7 @@ func (*TopicIdActorIdInt) ProtoMessage() {}
 
 // Deprecated: Use TopicIdActorIdInt.ProtoReflect.Descriptor instead.
 func (*TopicIdActorIdInt) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{11}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TopicIdActorIdInt) GetTopicId() uint64 {
@@ -30601,7 +36224,7 @@ type TopicIdDelegatorReputerDelegatorInfo struct {
 func (x *TopicIdDelegatorReputerDelegatorInfo) Reset() {
 	*x = TopicIdDelegatorReputerDelegatorInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[12]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30615,7 +36238,7 @@ func (*TopicIdDelegatorReputerDelegatorInfo) ProtoMessage() {}
 
 // Deprecated: Use TopicIdDelegatorReputerDelegatorInfo.ProtoReflect.Descriptor instead.
 func (*TopicIdDelegatorReputerDelegatorInfo) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{12}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TopicIdDelegatorReputerDelegatorInfo) GetTopicId() uint64 {
@@ -30660,7 +36283,7 @@ type BlockHeightTopicIdReputerStakeRemovalInfo struct {
 func (x *BlockHeightTopicIdReputerStakeRemovalInfo) Reset() {
 	*x = BlockHeightTopicIdReputerStakeRemovalInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[13]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30674,7 +36297,7 @@ func (*BlockHeightTopicIdReputerStakeRemovalInfo) ProtoMessage() {}
 
 // Deprecated: Use BlockHeightTopicIdReputerStakeRemovalInfo.ProtoReflect.Descriptor instead.
 func (*BlockHeightTopicIdReputerStakeRemovalInfo) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{13}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *BlockHeightTopicIdReputerStakeRemovalInfo) GetBlockHeight() int64 {
@@ -30718,7 +36341,7 @@ type ActorIdTopicIdBlockHeight struct {
 func (x *ActorIdTopicIdBlockHeight) Reset() {
 	*x = ActorIdTopicIdBlockHeight{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[14]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30732,7 +36355,7 @@ func (*ActorIdTopicIdBlockHeight) ProtoMessage() {}
 
 // Deprecated: Use ActorIdTopicIdBlockHeight.ProtoReflect.Descriptor instead.
 func (*ActorIdTopicIdBlockHeight) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{14}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ActorIdTopicIdBlockHeight) GetActorId() string {
@@ -30771,7 +36394,7 @@ type BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo struct {
 func (x *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Reset() {
 	*x = BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[15]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30785,7 +36408,7 @@ func (*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) ProtoMessage(
 
 // Deprecated: Use BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo.ProtoReflect.Descriptor instead.
 func (*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{15}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) GetBlockHeight() int64 {
@@ -30837,7 +36460,7 @@ type DelegatorReputerTopicIdBlockHeight struct {
 func (x *DelegatorReputerTopicIdBlockHeight) Reset() {
 	*x = DelegatorReputerTopicIdBlockHeight{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[16]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30851,7 +36474,7 @@ func (*DelegatorReputerTopicIdBlockHeight) ProtoMessage() {}
 
 // Deprecated: Use DelegatorReputerTopicIdBlockHeight.ProtoReflect.Descriptor instead.
 func (*DelegatorReputerTopicIdBlockHeight) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{16}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DelegatorReputerTopicIdBlockHeight) GetDelegator() string {
@@ -30895,7 +36518,7 @@ type TopicIdActorIdInference struct {
 func (x *TopicIdActorIdInference) Reset() {
 	*x = TopicIdActorIdInference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[17]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30909,7 +36532,7 @@ func (*TopicIdActorIdInference) ProtoMessage() {}
 
 // Deprecated: Use TopicIdActorIdInference.ProtoReflect.Descriptor instead.
 func (*TopicIdActorIdInference) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{17}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *TopicIdActorIdInference) GetTopicId() uint64 {
@@ -30946,7 +36569,7 @@ type TopicIdActorIdForecast struct {
 func (x *TopicIdActorIdForecast) Reset() {
 	*x = TopicIdActorIdForecast{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[18]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -30960,7 +36583,7 @@ func (*TopicIdActorIdForecast) ProtoMessage() {}
 
 // Deprecated: Use TopicIdActorIdForecast.ProtoReflect.Descriptor instead.
 func (*TopicIdActorIdForecast) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{18}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *TopicIdActorIdForecast) GetTopicId() uint64 {
@@ -30996,7 +36619,7 @@ type LibP2PKeyAndOffchainNode struct {
 func (x *LibP2PKeyAndOffchainNode) Reset() {
 	*x = LibP2PKeyAndOffchainNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[19]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -31010,7 +36633,7 @@ func (*LibP2PKeyAndOffchainNode) ProtoMessage() {}
 
 // Deprecated: Use LibP2PKeyAndOffchainNode.ProtoReflect.Descriptor instead.
 func (*LibP2PKeyAndOffchainNode) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{19}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *LibP2PKeyAndOffchainNode) GetLibP2PKey() string {
@@ -31039,7 +36662,7 @@ type TopicIdAndDec struct {
 func (x *TopicIdAndDec) Reset() {
 	*x = TopicIdAndDec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[20]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -31053,7 +36676,7 @@ func (*TopicIdAndDec) ProtoMessage() {}
 
 // Deprecated: Use TopicIdAndDec.ProtoReflect.Descriptor instead.
 func (*TopicIdAndDec) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{20}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *TopicIdAndDec) GetTopicId() uint64 {
@@ -31083,7 +36706,7 @@ type TopicIdBlockHeightInferences struct {
 func (x *TopicIdBlockHeightInferences) Reset() {
 	*x = TopicIdBlockHeightInferences{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[21]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -31097,7 +36720,7 @@ func (*TopicIdBlockHeightInferences) ProtoMessage() {}
 
 // Deprecated: Use TopicIdBlockHeightInferences.ProtoReflect.Descriptor instead.
 func (*TopicIdBlockHeightInferences) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{21}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *TopicIdBlockHeightInferences) GetTopicId() uint64 {
@@ -31134,7 +36757,7 @@ type TopicIdBlockHeightForecasts struct {
 func (x *TopicIdBlockHeightForecasts) Reset() {
 	*x = TopicIdBlockHeightForecasts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[22]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -31148,7 +36771,7 @@ func (*TopicIdBlockHeightForecasts) ProtoMessage() {}
 
 // Deprecated: Use TopicIdBlockHeightForecasts.ProtoReflect.Descriptor instead.
 func (*TopicIdBlockHeightForecasts) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{22}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *TopicIdBlockHeightForecasts) GetTopicId() uint64 {
@@ -31185,7 +36808,7 @@ type TopicIdBlockHeightReputerValueBundles struct {
 func (x *TopicIdBlockHeightReputerValueBundles) Reset() {
 	*x = TopicIdBlockHeightReputerValueBundles{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[23]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -31199,7 +36822,7 @@ func (*TopicIdBlockHeightReputerValueBundles) ProtoMessage() {}
 
 // Deprecated: Use TopicIdBlockHeightReputerValueBundles.ProtoReflect.Descriptor instead.
 func (*TopicIdBlockHeightReputerValueBundles) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{23}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *TopicIdBlockHeightReputerValueBundles) GetTopicId() uint64 {
@@ -31236,7 +36859,7 @@ type TopicIdBlockHeightValueBundles struct {
 func (x *TopicIdBlockHeightValueBundles) Reset() {
 	*x = TopicIdBlockHeightValueBundles{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[24]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -31250,7 +36873,7 @@ func (*TopicIdBlockHeightValueBundles) ProtoMessage() {}
 
 // Deprecated: Use TopicIdBlockHeightValueBundles.ProtoReflect.Descriptor instead.
 func (*TopicIdBlockHeightValueBundles) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{24}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *TopicIdBlockHeightValueBundles) GetTopicId() uint64 {
@@ -31286,7 +36909,7 @@ type TopicIdAndNonces struct {
 func (x *TopicIdAndNonces) Reset() {
 	*x = TopicIdAndNonces{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[25]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -31300,7 +36923,7 @@ func (*TopicIdAndNonces) ProtoMessage() {}
 
 // Deprecated: Use TopicIdAndNonces.ProtoReflect.Descriptor instead.
 func (*TopicIdAndNonces) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{25}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *TopicIdAndNonces) GetTopicId() uint64 {
@@ -31329,7 +36952,7 @@ type TopicIdAndReputerRequestNonces struct {
 func (x *TopicIdAndReputerRequestNonces) Reset() {
 	*x = TopicIdAndReputerRequestNonces{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[26]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -31343,7 +36966,7 @@ func (*TopicIdAndReputerRequestNonces) ProtoMessage() {}
 
 // Deprecated: Use TopicIdAndReputerRequestNonces.ProtoReflect.Descriptor instead.
 func (*TopicIdAndReputerRequestNonces) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{26}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *TopicIdAndReputerRequestNonces) GetTopicId() uint64 {
@@ -31373,7 +36996,7 @@ type TopicIdActorIdTimeStampedValue struct {
 func (x *TopicIdActorIdTimeStampedValue) Reset() {
 	*x = TopicIdActorIdTimeStampedValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[27]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -31387,7 +37010,7 @@ func (*TopicIdActorIdTimeStampedValue) ProtoMessage() {}
 
 // Deprecated: Use TopicIdActorIdTimeStampedValue.ProtoReflect.Descriptor instead.
 func (*TopicIdActorIdTimeStampedValue) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{27}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *TopicIdActorIdTimeStampedValue) GetTopicId() uint64 {
@@ -31425,7 +37048,7 @@ type TopicIdActorIdActorIdTimeStampedValue struct {
 func (x *TopicIdActorIdActorIdTimeStampedValue) Reset() {
 	*x = TopicIdActorIdActorIdTimeStampedValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[28]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -31439,7 +37062,7 @@ func (*TopicIdActorIdActorIdTimeStampedValue) ProtoMessage() {}
 
 // Deprecated: Use TopicIdActorIdActorIdTimeStampedValue.ProtoReflect.Descriptor instead.
 func (*TopicIdActorIdActorIdTimeStampedValue) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{28}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *TopicIdActorIdActorIdTimeStampedValue) GetTopicId() uint64 {
@@ -31482,7 +37105,7 @@ type TopicIdTimestampedActorNonce struct {
 func (x *TopicIdTimestampedActorNonce) Reset() {
 	*x = TopicIdTimestampedActorNonce{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[29]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -31496,7 +37119,7 @@ func (*TopicIdTimestampedActorNonce) ProtoMessage() {}
 
 // Deprecated: Use TopicIdTimestampedActorNonce.ProtoReflect.Descriptor instead.
 func (*TopicIdTimestampedActorNonce) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{29}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *TopicIdTimestampedActorNonce) GetTopicId() uint64 {
@@ -31525,7 +37148,7 @@ type BlockHeightTopicIds struct {
 func (x *BlockHeightTopicIds) Reset() {
 	*x = BlockHeightTopicIds{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[30]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -31539,7 +37162,7 @@ func (*BlockHeightTopicIds) ProtoMessage() {}
 
 // Deprecated: Use BlockHeightTopicIds.ProtoReflect.Descriptor instead.
 func (*BlockHeightTopicIds) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{30}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *BlockHeightTopicIds) GetBlockHeight() int64 {
@@ -31568,7 +37191,7 @@ type BlockHeightTopicIdWeightPair struct {
 func (x *BlockHeightTopicIdWeightPair) Reset() {
 	*x = BlockHeightTopicIdWeightPair{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[31]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -31582,7 +37205,7 @@ func (*BlockHeightTopicIdWeightPair) ProtoMessage() {}
 
 // Deprecated: Use BlockHeightTopicIdWeightPair.ProtoReflect.Descriptor instead.
 func (*BlockHeightTopicIdWeightPair) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{31}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *BlockHeightTopicIdWeightPair) GetBlockHeight() int64 {
@@ -31612,7 +37235,7 @@ type TopicIdReputerReputerValueBundle struct {
 func (x *TopicIdReputerReputerValueBundle) Reset() {
 	*x = TopicIdReputerReputerValueBundle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v7_genesis_proto_msgTypes[32]
+		mi := &file_emissions_v7_genesis_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -31626,7 +37249,7 @@ func (*TopicIdReputerReputerValueBundle) ProtoMessage() {}
 
 // Deprecated: Use TopicIdReputerReputerValueBundle.ProtoReflect.Descriptor instead.
 func (*TopicIdReputerReputerValueBundle) Descriptor() ([]byte, []int) {
-	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{32}
+	return file_emissions_v7_genesis_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *TopicIdReputerReputerValueBundle) GetTopicId() uint64 {
@@ -31674,7 +37297,7 @@ var file_emissions_v7_genesis_proto_rawDesc = []byte{
 	0x72, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x65, 0x6d, 0x69, 0x73,
 	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x37, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc9, 0x40, 0x0a, 0x0c,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba, 0x4e, 0x0a, 0x0c,
 	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x06,
 	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65,
 	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x37, 0x2e, 0x50, 0x61, 0x72, 0x61,
@@ -32184,315 +37807,450 @@ var file_emissions_v7_genesis_proto_rawDesc = []byte{
 	0x6c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x61,
 	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x5b,
 	0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x4a, 0x04, 0x08, 0x0d, 0x10, 0x0e,
-	0x4a, 0x04, 0x08, 0x0e, 0x10, 0x0f, 0x4a, 0x04, 0x08, 0x0f, 0x10, 0x10, 0x52, 0x1b, 0x6c, 0x61,
-	0x74, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x73, 0x42, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x1e, 0x6c, 0x61, 0x74, 0x65, 0x73,
-	0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x73, 0x42, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x1c, 0x6c, 0x61, 0x74, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x42, 0x79,
-	0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x22, 0x57, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x69, 0x63,
-	0x49, 0x64, 0x41, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
+	0x6e, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x15, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x5c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x37, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64,
+	0x41, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x5d,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65,
+	0x18, 0x5e, 0x20, 0x03, 0x28, 0x04, 0x52, 0x15, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x54, 0x6f, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x12, 0x40, 0x0a,
+	0x0c, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x5f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x37, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x41, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x12,
+	0x4f, 0x0a, 0x14, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x60, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x37, 0x2e, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x41, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x52, 0x12, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x61,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x37, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x54, 0x61,
+	0x67, 0x52, 0x09, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x61, 0x67, 0x73, 0x12, 0x4d, 0x0a, 0x15,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74,
+	0x72, 0x75, 0x74, 0x68, 0x73, 0x18, 0x62, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x54, 0x72, 0x75, 0x74, 0x68, 0x52, 0x13, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x75, 0x74, 0x68, 0x73, 0x12, 0x51, 0x0a, 0x17, 0x63,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x74, 0x72, 0x75, 0x74, 0x68, 0x73, 0x18, 0x63, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x54, 0x72, 0x75, 0x74, 0x68, 0x52, 0x15, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x75, 0x74, 0x68, 0x73, 0x12, 0x57,
+	0x0a, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x65, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x37, 0x2e,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x66, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x42, 0x6f,
+	0x6e, 0x64, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x42, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x55, 0x0a, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x67, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x42, 0x6f, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x42, 0x6f, 0x6e, 0x64, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x69, 0x0a, 0x1c, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x64, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x68, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x44, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1a, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x44, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x69, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x57, 0x0a, 0x18, 0x61, 0x75, 0x74, 0x6f, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x6a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x37, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x41, 0x6e,
+	0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x50, 0x0a, 0x13, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x6b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x12,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x6c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x6f, 0x63, 0x6b,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x41, 0x0a, 0x0e,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x6d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x41, 0x0a, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x73, 0x18, 0x6e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x73, 0x63,
+	0x72, 0x6f, 0x77, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x73, 0x12, 0x54, 0x0a, 0x15, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x6f, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33,
+	0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x52, 0x13, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x70, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x12, 0x7b, 0x0a, 0x27, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x71, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x37, 0x2e, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x49, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x72, 0x52, 0x23, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61,
+	0x6c, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x61, 0x0a, 0x1c, 0x64, 0x65, 0x61, 0x64,
+	0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x72, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x52, 0x19, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x0d, 0x10,
+	0x0e, 0x4a, 0x04, 0x08, 0x0e, 0x10, 0x0f, 0x4a, 0x04, 0x08, 0x0f, 0x10, 0x10, 0x52, 0x1b, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x42, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x1e, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x42, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x1c, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x42,
+	0x79, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x22, 0x57, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x22, 0x75, 0x0a, 0x1d, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x3c, 0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x54, 0x61, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x47, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x41,
+	0x6e, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22,
+	0x6c, 0x0a, 0x17, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
 	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x76, 0x33, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x22, 0x47, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x41, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x6f,
-	0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x19,
-	0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x15, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a,
-	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x22, 0x58, 0x0a, 0x16, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x41,
-	0x6e, 0x64, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04,
-	0x52, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x18, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x06, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x73, 0x22, 0x76, 0x0a, 0x13, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x63,
-	0x74, 0x6f, 0x72, 0x49, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x22, 0x61, 0x0a,
+	0x12, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x22, 0x55, 0x0a, 0x15, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x58, 0x0a, 0x16, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x41, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64,
+	0x73, 0x22, 0x86, 0x01, 0x0a, 0x18, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x06,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x76, 0x0a, 0x13, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x22, 0x64, 0x0a, 0x14, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
 	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
 	0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69,
 	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64,
-	0x12, 0x29, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x64, 0x0a, 0x14, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x55, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x19,
-	0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x22, 0xb3, 0x01, 0x0a, 0x22, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x63, 0x74,
-	0x6f, 0x72, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x65,
-	0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x57,
-	0x0a, 0x15, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x65, 0x66,
-	0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e,
-	0x74, 0x52, 0x14, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x65, 0x66,
-	0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x11, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x49, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x44, 0x65, 0x63, 0x12, 0x19, 0x0a,
-	0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x03, 0x64, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x03, 0x64, 0x65, 0x63, 0x22, 0x6e,
-	0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x12,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x22, 0xb3, 0x01, 0x0a, 0x22, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x49, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x12,
 	0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x03, 0x69, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x03, 0x69, 0x6e, 0x74, 0x22, 0x8d,
+	0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x15, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x65,
+	0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x14, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x94,
 	0x01, 0x0a, 0x11, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49,
-	0x64, 0x49, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64,
+	0x64, 0x44, 0x65, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12,
 	0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x03, 0x69, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x03, 0x69, 0x6e, 0x74, 0x22, 0xbd,
-	0x01, 0x0a, 0x24, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0e, 0x64, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
-	0x33, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x0d, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xd1,
-	0x01, 0x0a, 0x29, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x6f,
-	0x70, 0x69, 0x63, 0x49, 0x64, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x6b,
-	0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
-	0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70,
-	0x75, 0x74, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x72, 0x65,
-	0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x10, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x22, 0x74, 0x0a, 0x19, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x49, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x99, 0x02, 0x0a, 0x3a, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x44,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x44,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x65, 0x0a,
-	0x1b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f,
-	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
-	0x33, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x18, 0x64, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x9a, 0x01, 0x0a, 0x22, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x6f, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70,
-	0x75, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75,
-	0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21,
-	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
+	0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x03, 0x64, 0x65,
+	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x03, 0x64, 0x65, 0x63, 0x22, 0x6e, 0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64,
+	0x41, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49,
+	0x64, 0x12, 0x42, 0x0a, 0x03, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x03, 0x69, 0x6e, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49,
+	0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x49, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x42, 0x0a, 0x03, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x03, 0x69, 0x6e, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x24, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49,
+	0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65,
+	0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65,
+	0x72, 0x12, 0x42, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xd1, 0x01, 0x0a, 0x29, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x52, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x12, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x74, 0x0a, 0x19, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x99, 0x02, 0x0a, 0x3a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x22, 0x86, 0x01, 0x0a, 0x17, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x63, 0x74,
-	0x6f, 0x72, 0x49, 0x64, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x09, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x46, 0x6f, 0x72,
-	0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64,
-	0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x66,
-	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x46, 0x6f, 0x72,
-	0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x08, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x22,
-	0x7b, 0x0a, 0x18, 0x4c, 0x69, 0x62, 0x50, 0x32, 0x70, 0x4b, 0x65, 0x79, 0x41, 0x6e, 0x64, 0x4f,
-	0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x6c,
-	0x69, 0x62, 0x5f, 0x70, 0x32, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x6c, 0x69, 0x62, 0x50, 0x32, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x6f,
-	0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
-	0x33, 0x2e, 0x4f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0c,
-	0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x75, 0x0a, 0x0d,
-	0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x63, 0x12, 0x19, 0x0a,
-	0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x03, 0x64, 0x65, 0x63, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
-	0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x03,
-	0x64, 0x65, 0x63, 0x22, 0x96, 0x01, 0x0a, 0x1c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x1b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x18, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x9a, 0x01, 0x0a, 0x22,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x17, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x49, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x49, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
-	0x52, 0x0a, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x92, 0x01, 0x0a,
-	0x1b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
 	0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x66, 0x6f,
-	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x46, 0x6f, 0x72,
-	0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
-	0x73, 0x22, 0xbc, 0x01, 0x0a, 0x25, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x33, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x08, 0x66, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x22, 0x7b, 0x0a, 0x18, 0x4c, 0x69, 0x62, 0x50, 0x32, 0x70,
+	0x4b, 0x65, 0x79, 0x41, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x6c, 0x69, 0x62, 0x5f, 0x70, 0x32, 0x70, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x62, 0x50, 0x32, 0x70, 0x4b,
+	0x65, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x4f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e,
+	0x6f, 0x64, 0x65, 0x22, 0x75, 0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x6e,
+	0x64, 0x44, 0x65, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12,
+	0x49, 0x0a, 0x03, 0x64, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x03, 0x64, 0x65, 0x63, 0x22, 0x96, 0x01, 0x0a, 0x1c, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74,
 	0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
 	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
 	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x55, 0x0a, 0x15, 0x72, 0x65, 0x70,
-	0x75, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x13, 0x72, 0x65, 0x70,
-	0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73,
-	0x22, 0x9c, 0x01, 0x0a, 0x1e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64,
-	0x6c, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0a, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x1b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21,
 	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64,
-	0x6c, 0x65, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22,
-	0x5b, 0x0a, 0x10, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4e, 0x6f, 0x6e,
-	0x63, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2c,
-	0x0a, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x4e, 0x6f,
-	0x6e, 0x63, 0x65, 0x73, 0x52, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x95, 0x01, 0x0a,
-	0x1e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x75, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12,
-	0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x58, 0x0a, 0x16, 0x72, 0x65,
-	0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x14,
-	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f,
-	0x6e, 0x63, 0x65, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x1e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64,
-	0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70,
-	0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x4b, 0x0a,
-	0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc9, 0x01, 0x0a, 0x25, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x41, 0x63, 0x74,
-	0x6f, 0x72, 0x49, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12,
-	0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x31, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x31, 0x12, 0x1b, 0x0a, 0x09,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x32, 0x12, 0x4b, 0x0a, 0x11, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65,
-	0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x1c, 0x54, 0x6f, 0x70, 0x69, 0x63,
-	0x49, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x41, 0x63, 0x74,
-	0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x49, 0x64, 0x12, 0x5b, 0x0a, 0x17, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65,
-	0x64, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x76, 0x33, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x41, 0x63,
-	0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x65, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22,
-	0x6d, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x6f,
-	0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x74, 0x6f, 0x70,
-	0x69, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x49, 0x64, 0x73, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x22, 0x85,
-	0x01, 0x0a, 0x1c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x6f,
-	0x70, 0x69, 0x63, 0x49, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x69, 0x72, 0x12,
-	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x77, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x57,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x20, 0x54, 0x6f, 0x70, 0x69, 0x63,
-	0x49, 0x64, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x74, 0x12, 0x35, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x33, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x52, 0x09, 0x66,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x25, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x49, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x55, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x52,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x73, 0x52, 0x13, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x1e, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x49, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49,
+	0x64, 0x41, 0x6e, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x33, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x06, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x1e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41,
+	0x6e, 0x64, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49,
+	0x64, 0x12, 0x58, 0x0a, 0x16, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33,
+	0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x14, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x1e,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x10, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xc9, 0x01, 0x0a, 0x25, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74,
 	0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
-	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
-	0x12, 0x52, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65,
-	0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
-	0x52, 0x12, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75,
-	0x6e, 0x64, 0x6c, 0x65, 0x42, 0xc2, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x37, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x37, 0x3b, 0x65,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x37, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58,
-	0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x37, 0xca,
-	0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x37, 0xe2, 0x02,
-	0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x37, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x37, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x31, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x32,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x32,
+	0x12, 0x4b, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x96, 0x01,
+	0x0a, 0x1c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x65, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x5b, 0x0a, 0x17, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x65, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52,
+	0x15, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x41, 0x63, 0x74, 0x6f,
+	0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x6d, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x33, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x33, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x52, 0x08, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x49, 0x64, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x1c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x50, 0x61, 0x69, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x69, 0x72,
+	0x52, 0x0b, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xab, 0x01,
+	0x0a, 0x20, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x12, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x42, 0xc2, 0x01, 0x0a, 0x10,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x37,
+	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x76, 0x37, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76,
+	0x37, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x37, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x5c, 0x56, 0x37, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x5c, 0x56, 0x37, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x37,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -32507,164 +38265,201 @@ func file_emissions_v7_genesis_proto_rawDescGZIP() []byte {
 	return file_emissions_v7_genesis_proto_rawDescData
 }
 
-var file_emissions_v7_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_emissions_v7_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_emissions_v7_genesis_proto_goTypes = []interface{}{
 	(*GenesisState)(nil),                                               // 0: emissions.v7.GenesisState
 	(*TopicIdAndTopic)(nil),                                            // 1: emissions.v7.TopicIdAndTopic
-	(*TopicAndActorId)(nil),                                            // 2: emissions.v7.TopicAndActorId
-	(*TopicIdAndBlockHeight)(nil),                                      // 3: emissions.v7.TopicIdAndBlockHeight
-	(*BlockHeightAndTopicIds)(nil),                                     // 4: emissions.v7.BlockHeightAndTopicIds
-	(*TopicIdBlockHeightScores)(nil),                                   // 5: emissions.v7.TopicIdBlockHeightScores
-	(*TopicIdActorIdScore)(nil),                                        // 6: emissions.v7.TopicIdActorIdScore
-	(*TopicIdActorIdUint64)(nil),                                       // 7: emissions.v7.TopicIdActorIdUint64
-	(*TopicIdActorIdListeningCoefficient)(nil),                         // 8: emissions.v7.TopicIdActorIdListeningCoefficient
-	(*TopicIdActorIdDec)(nil),                                          // 9: emissions.v7.TopicIdActorIdDec
-	(*TopicIdAndInt)(nil),                                              // 10: emissions.v7.TopicIdAndInt
-	(*TopicIdActorIdInt)(nil),                                          // 11: emissions.v7.TopicIdActorIdInt
-	(*TopicIdDelegatorReputerDelegatorInfo)(nil),                       // 12: emissions.v7.TopicIdDelegatorReputerDelegatorInfo
-	(*BlockHeightTopicIdReputerStakeRemovalInfo)(nil),                  // 13: emissions.v7.BlockHeightTopicIdReputerStakeRemovalInfo
-	(*ActorIdTopicIdBlockHeight)(nil),                                  // 14: emissions.v7.ActorIdTopicIdBlockHeight
-	(*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo)(nil), // 15: emissions.v7.BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo
-	(*DelegatorReputerTopicIdBlockHeight)(nil),                         // 16: emissions.v7.DelegatorReputerTopicIdBlockHeight
-	(*TopicIdActorIdInference)(nil),                                    // 17: emissions.v7.TopicIdActorIdInference
-	(*TopicIdActorIdForecast)(nil),                                     // 18: emissions.v7.TopicIdActorIdForecast
-	(*LibP2PKeyAndOffchainNode)(nil),                                   // 19: emissions.v7.LibP2pKeyAndOffchainNode
-	(*TopicIdAndDec)(nil),                                              // 20: emissions.v7.TopicIdAndDec
-	(*TopicIdBlockHeightInferences)(nil),                               // 21: emissions.v7.TopicIdBlockHeightInferences
-	(*TopicIdBlockHeightForecasts)(nil),                                // 22: emissions.v7.TopicIdBlockHeightForecasts
-	(*TopicIdBlockHeightReputerValueBundles)(nil),                      // 23: emissions.v7.TopicIdBlockHeightReputerValueBundles
-	(*TopicIdBlockHeightValueBundles)(nil),                             // 24: emissions.v7.TopicIdBlockHeightValueBundles
-	(*TopicIdAndNonces)(nil),                                           // 25: emissions.v7.TopicIdAndNonces
-	(*TopicIdAndReputerRequestNonces)(nil),                             // 26: emissions.v7.TopicIdAndReputerRequestNonces
-	(*TopicIdActorIdTimeStampedValue)(nil),                             // 27: emissions.v7.TopicIdActorIdTimeStampedValue
-	(*TopicIdActorIdActorIdTimeStampedValue)(nil),                      // 28: emissions.v7.TopicIdActorIdActorIdTimeStampedValue
-	(*TopicIdTimestampedActorNonce)(nil),                               // 29: emissions.v7.TopicIdTimestampedActorNonce
-	(*BlockHeightTopicIds)(nil),                                        // 30: emissions.v7.BlockHeightTopicIds
-	(*BlockHeightTopicIdWeightPair)(nil),                               // 31: emissions.v7.BlockHeightTopicIdWeightPair
-	(*TopicIdReputerReputerValueBundle)(nil),                           // 32: emissions.v7.TopicIdReputerReputerValueBundle
-	(*Params)(nil),                                                     // 33: emissions.v7.Params
-	(*v3.Topic)(nil),                                                   // 34: emissions.v3.Topic
-	(*v3.Scores)(nil),                                                  // 35: emissions.v3.Scores
-	(*v3.Score)(nil),                                                   // 36: emissions.v3.Score
-	(*v3.ListeningCoefficient)(nil),                                    // 37: emissions.v3.ListeningCoefficient
-	(*v3.DelegatorInfo)(nil),                                           // 38: emissions.v3.DelegatorInfo
-	(*v3.StakeRemovalInfo)(nil),                                        // 39: emissions.v3.StakeRemovalInfo
-	(*v3.DelegateStakeRemovalInfo)(nil),                                // 40: emissions.v3.DelegateStakeRemovalInfo
-	(*v3.Inference)(nil),                                               // 41: emissions.v3.Inference
-	(*v3.Forecast)(nil),                                                // 42: emissions.v3.Forecast
-	(*v3.OffchainNode)(nil),                                            // 43: emissions.v3.OffchainNode
-	(*v3.Inferences)(nil),                                              // 44: emissions.v3.Inferences
-	(*v3.Forecasts)(nil),                                               // 45: emissions.v3.Forecasts
-	(*v3.ReputerValueBundles)(nil),                                     // 46: emissions.v3.ReputerValueBundles
-	(*v3.ValueBundle)(nil),                                             // 47: emissions.v3.ValueBundle
-	(*v3.Nonces)(nil),                                                  // 48: emissions.v3.Nonces
-	(*v3.ReputerRequestNonces)(nil),                                    // 49: emissions.v3.ReputerRequestNonces
-	(*v3.TimestampedValue)(nil),                                        // 50: emissions.v3.TimestampedValue
-	(*v3.TimestampedActorNonce)(nil),                                   // 51: emissions.v3.TimestampedActorNonce
-	(*v3.TopicIds)(nil),                                                // 52: emissions.v3.TopicIds
-	(*v3.TopicIdWeightPair)(nil),                                       // 53: emissions.v3.TopicIdWeightPair
-	(*v3.ReputerValueBundle)(nil),                                      // 54: emissions.v3.ReputerValueBundle
+	(*TopicIdAndOptionalTopicParams)(nil),                              // 2: emissions.v7.TopicIdAndOptionalTopicParams
+	(*TopicIdAndTag)(nil),                                              // 3: emissions.v7.TopicIdAndTag
+	(*TopicAndActorId)(nil),                                            // 4: emissions.v7.TopicAndActorId
+	(*TopicIdDelegatorReputer)(nil),                                    // 5: emissions.v7.TopicIdDelegatorReputer
+	(*TopicActorOperator)(nil),                                         // 6: emissions.v7.TopicActorOperator
+	(*TopicIdAndBlockHeight)(nil),                                      // 7: emissions.v7.TopicIdAndBlockHeight
+	(*BlockHeightAndTopicIds)(nil),                                     // 8: emissions.v7.BlockHeightAndTopicIds
+	(*TopicIdBlockHeightScores)(nil),                                   // 9: emissions.v7.TopicIdBlockHeightScores
+	(*TopicIdActorIdScore)(nil),                                        // 10: emissions.v7.TopicIdActorIdScore
+	(*TopicIdActorIdUint64)(nil),                                       // 11: emissions.v7.TopicIdActorIdUint64
+	(*TopicIdActorIdListeningCoefficient)(nil),                         // 12: emissions.v7.TopicIdActorIdListeningCoefficient
+	(*TopicIdActorIdDec)(nil),                                          // 13: emissions.v7.TopicIdActorIdDec
+	(*TopicIdAndInt)(nil),                                              // 14: emissions.v7.TopicIdAndInt
+	(*TopicIdActorIdInt)(nil),                                          // 15: emissions.v7.TopicIdActorIdInt
+	(*TopicIdDelegatorReputerDelegatorInfo)(nil),                       // 16: emissions.v7.TopicIdDelegatorReputerDelegatorInfo
+	(*BlockHeightTopicIdReputerStakeRemovalInfo)(nil),                  // 17: emissions.v7.BlockHeightTopicIdReputerStakeRemovalInfo
+	(*ActorIdTopicIdBlockHeight)(nil),                                  // 18: emissions.v7.ActorIdTopicIdBlockHeight
+	(*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo)(nil), // 19: emissions.v7.BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo
+	(*DelegatorReputerTopicIdBlockHeight)(nil),                         // 20: emissions.v7.DelegatorReputerTopicIdBlockHeight
+	(*TopicIdActorIdInference)(nil),                                    // 21: emissions.v7.TopicIdActorIdInference
+	(*TopicIdActorIdForecast)(nil),                                     // 22: emissions.v7.TopicIdActorIdForecast
+	(*LibP2PKeyAndOffchainNode)(nil),                                   // 23: emissions.v7.LibP2pKeyAndOffchainNode
+	(*TopicIdAndDec)(nil),                                              // 24: emissions.v7.TopicIdAndDec
+	(*TopicIdBlockHeightInferences)(nil),                               // 25: emissions.v7.TopicIdBlockHeightInferences
+	(*TopicIdBlockHeightForecasts)(nil),                                // 26: emissions.v7.TopicIdBlockHeightForecasts
+	(*TopicIdBlockHeightReputerValueBundles)(nil),                      // 27: emissions.v7.TopicIdBlockHeightReputerValueBundles
+	(*TopicIdBlockHeightValueBundles)(nil),                             // 28: emissions.v7.TopicIdBlockHeightValueBundles
+	(*TopicIdAndNonces)(nil),                                           // 29: emissions.v7.TopicIdAndNonces
+	(*TopicIdAndReputerRequestNonces)(nil),                             // 30: emissions.v7.TopicIdAndReputerRequestNonces
+	(*TopicIdActorIdTimeStampedValue)(nil),                             // 31: emissions.v7.TopicIdActorIdTimeStampedValue
+	(*TopicIdActorIdActorIdTimeStampedValue)(nil),                      // 32: emissions.v7.TopicIdActorIdActorIdTimeStampedValue
+	(*TopicIdTimestampedActorNonce)(nil),                               // 33: emissions.v7.TopicIdTimestampedActorNonce
+	(*BlockHeightTopicIds)(nil),                                        // 34: emissions.v7.BlockHeightTopicIds
+	(*BlockHeightTopicIdWeightPair)(nil),                               // 35: emissions.v7.BlockHeightTopicIdWeightPair
+	(*TopicIdReputerReputerValueBundle)(nil),                           // 36: emissions.v7.TopicIdReputerReputerValueBundle
+	(*Params)(nil),                                                     // 37: emissions.v7.Params
+	(*v3.GroundTruth)(nil),                                             // 38: emissions.v3.GroundTruth
+	(*v3.WorkerPayloadCommit)(nil),                                     // 39: emissions.v3.WorkerPayloadCommit
+	(*v3.WorkerBond)(nil),                                              // 40: emissions.v3.WorkerBond
+	(*v3.WorkerBondRemovalInfo)(nil),                                   // 41: emissions.v3.WorkerBondRemovalInfo
+	(*v3.ReputerConsensusDeviation)(nil),                               // 42: emissions.v3.ReputerConsensusDeviation
+	(*v3.RedelegationInfo)(nil),                                        // 43: emissions.v3.RedelegationInfo
+	(*v3.ReputerCommission)(nil),                                       // 44: emissions.v3.ReputerCommission
+	(*v3.StakeLock)(nil),                                               // 45: emissions.v3.StakeLock
+	(*v3.RewardRecord)(nil),                                            // 46: emissions.v3.RewardRecord
+	(*v3.RewardEscrow)(nil),                                            // 47: emissions.v3.RewardEscrow
+	(*v3.QueuedRewardPayout)(nil),                                      // 48: emissions.v3.QueuedRewardPayout
+	(*v3.Topic)(nil),                                                   // 49: emissions.v3.Topic
+	(*v3.OptionalTopicParams)(nil),                                     // 50: emissions.v3.OptionalTopicParams
+	(*v3.Scores)(nil),                                                  // 51: emissions.v3.Scores
+	(*v3.Score)(nil),                                                   // 52: emissions.v3.Score
+	(*v3.ListeningCoefficient)(nil),                                    // 53: emissions.v3.ListeningCoefficient
+	(*v3.DelegatorInfo)(nil),                                           // 54: emissions.v3.DelegatorInfo
+	(*v3.StakeRemovalInfo)(nil),                                        // 55: emissions.v3.StakeRemovalInfo
+	(*v3.DelegateStakeRemovalInfo)(nil),                                // 56: emissions.v3.DelegateStakeRemovalInfo
+	(*v3.Inference)(nil),                                               // 57: emissions.v3.Inference
+	(*v3.Forecast)(nil),                                                // 58: emissions.v3.Forecast
+	(*v3.OffchainNode)(nil),                                            // 59: emissions.v3.OffchainNode
+	(*v3.Inferences)(nil),                                              // 60: emissions.v3.Inferences
+	(*v3.Forecasts)(nil),                                               // 61: emissions.v3.Forecasts
+	(*v3.ReputerValueBundles)(nil),                                     // 62: emissions.v3.ReputerValueBundles
+	(*v3.ValueBundle)(nil),                                             // 63: emissions.v3.ValueBundle
+	(*v3.Nonces)(nil),                                                  // 64: emissions.v3.Nonces
+	(*v3.ReputerRequestNonces)(nil),                                    // 65: emissions.v3.ReputerRequestNonces
+	(*v3.TimestampedValue)(nil),                                        // 66: emissions.v3.TimestampedValue
+	(*v3.TimestampedActorNonce)(nil),                                   // 67: emissions.v3.TimestampedActorNonce
+	(*v3.TopicIds)(nil),                                                // 68: emissions.v3.TopicIds
+	(*v3.TopicIdWeightPair)(nil),                                       // 69: emissions.v3.TopicIdWeightPair
+	(*v3.ReputerValueBundle)(nil),                                      // 70: emissions.v3.ReputerValueBundle
 }
 var file_emissions_v7_genesis_proto_depIdxs = []int32{
-	33, // 0: emissions.v7.GenesisState.params:type_name -> emissions.v7.Params
-	1,  // 1: emissions.v7.GenesisState.topics:type_name -> emissions.v7.TopicIdAndTopic
-	2,  // 2: emissions.v7.GenesisState.topic_workers:type_name -> emissions.v7.TopicAndActorId
-	2,  // 3: emissions.v7.GenesisState.topic_reputers:type_name -> emissions.v7.TopicAndActorId
-	3,  // 4: emissions.v7.GenesisState.topic_reward_nonce:type_name -> emissions.v7.TopicIdAndBlockHeight
-	5,  // 5: emissions.v7.GenesisState.inferer_scores_by_block:type_name -> emissions.v7.TopicIdBlockHeightScores
-	5,  // 6: emissions.v7.GenesisState.forecaster_scores_by_block:type_name -> emissions.v7.TopicIdBlockHeightScores
-	5,  // 7: emissions.v7.GenesisState.reputer_scores_by_block:type_name -> emissions.v7.TopicIdBlockHeightScores
-	8,  // 8: emissions.v7.GenesisState.reputer_listening_coefficient:type_name -> emissions.v7.TopicIdActorIdListeningCoefficient
-	9,  // 9: emissions.v7.GenesisState.previous_reputer_reward_fraction:type_name -> emissions.v7.TopicIdActorIdDec
-	9,  // 10: emissions.v7.GenesisState.previous_inference_reward_fraction:type_name -> emissions.v7.TopicIdActorIdDec
-	9,  // 11: emissions.v7.GenesisState.previous_forecast_reward_fraction:type_name -> emissions.v7.TopicIdActorIdDec
-	20, // 12: emissions.v7.GenesisState.previous_forecaster_score_ratio:type_name -> emissions.v7.TopicIdAndDec
-	10, // 13: emissions.v7.GenesisState.topic_stake:type_name -> emissions.v7.TopicIdAndInt
-	11, // 14: emissions.v7.GenesisState.stake_reputer_authority:type_name -> emissions.v7.TopicIdActorIdInt
-	11, // 15: emissions.v7.GenesisState.stake_sum_from_delegator:type_name -> emissions.v7.TopicIdActorIdInt
-	12, // 16: emissions.v7.GenesisState.delegated_stakes:type_name -> emissions.v7.TopicIdDelegatorReputerDelegatorInfo
-	11, // 17: emissions.v7.GenesisState.stake_from_delegators_upon_reputer:type_name -> emissions.v7.TopicIdActorIdInt
-	9,  // 18: emissions.v7.GenesisState.delegate_reward_per_share:type_name -> emissions.v7.TopicIdActorIdDec
-	13, // 19: emissions.v7.GenesisState.stake_removals_by_block:type_name -> emissions.v7.BlockHeightTopicIdReputerStakeRemovalInfo
-	14, // 20: emissions.v7.GenesisState.stake_removals_by_actor:type_name -> emissions.v7.ActorIdTopicIdBlockHeight
-	15, // 21: emissions.v7.GenesisState.delegate_stake_removals_by_block:type_name -> emissions.v7.BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo
-	16, // 22: emissions.v7.GenesisState.delegate_stake_removals_by_actor:type_name -> emissions.v7.DelegatorReputerTopicIdBlockHeight
-	17, // 23: emissions.v7.GenesisState.inferences:type_name -> emissions.v7.TopicIdActorIdInference
-	18, // 24: emissions.v7.GenesisState.forecasts:type_name -> emissions.v7.TopicIdActorIdForecast
-	19, // 25: emissions.v7.GenesisState.workers:type_name -> emissions.v7.LibP2pKeyAndOffchainNode
-	19, // 26: emissions.v7.GenesisState.reputers:type_name -> emissions.v7.LibP2pKeyAndOffchainNode
-	10, // 27: emissions.v7.GenesisState.topic_fee_revenue:type_name -> emissions.v7.TopicIdAndInt
-	20, // 28: emissions.v7.GenesisState.previous_topic_weight:type_name -> emissions.v7.TopicIdAndDec
-	21, // 29: emissions.v7.GenesisState.all_inferences:type_name -> emissions.v7.TopicIdBlockHeightInferences
-	22, // 30: emissions.v7.GenesisState.all_forecasts:type_name -> emissions.v7.TopicIdBlockHeightForecasts
-	23, // 31: emissions.v7.GenesisState.all_loss_bundles:type_name -> emissions.v7.TopicIdBlockHeightReputerValueBundles
-	24, // 32: emissions.v7.GenesisState.network_loss_bundles:type_name -> emissions.v7.TopicIdBlockHeightValueBundles
-	25, // 33: emissions.v7.GenesisState.unfulfilled_worker_nonces:type_name -> emissions.v7.TopicIdAndNonces
-	26, // 34: emissions.v7.GenesisState.unfulfilled_reputer_nonces:type_name -> emissions.v7.TopicIdAndReputerRequestNonces
-	27, // 35: emissions.v7.GenesisState.latest_inferer_network_regrets:type_name -> emissions.v7.TopicIdActorIdTimeStampedValue
-	27, // 36: emissions.v7.GenesisState.latest_forecaster_network_regrets:type_name -> emissions.v7.TopicIdActorIdTimeStampedValue
-	28, // 37: emissions.v7.GenesisState.latest_one_in_forecaster_network_regrets:type_name -> emissions.v7.TopicIdActorIdActorIdTimeStampedValue
-	27, // 38: emissions.v7.GenesisState.latest_naive_inferer_network_regrets:type_name -> emissions.v7.TopicIdActorIdTimeStampedValue
-	28, // 39: emissions.v7.GenesisState.latest_one_out_inferer_inferer_network_regrets:type_name -> emissions.v7.TopicIdActorIdActorIdTimeStampedValue
-	28, // 40: emissions.v7.GenesisState.latest_one_out_inferer_forecaster_network_regrets:type_name -> emissions.v7.TopicIdActorIdActorIdTimeStampedValue
-	28, // 41: emissions.v7.GenesisState.latest_one_out_forecaster_inferer_network_regrets:type_name -> emissions.v7.TopicIdActorIdActorIdTimeStampedValue
-	28, // 42: emissions.v7.GenesisState.latest_one_out_forecaster_forecaster_network_regrets:type_name -> emissions.v7.TopicIdActorIdActorIdTimeStampedValue
-	29, // 43: emissions.v7.GenesisState.topic_last_worker_commit:type_name -> emissions.v7.TopicIdTimestampedActorNonce
-	29, // 44: emissions.v7.GenesisState.topic_last_reputer_commit:type_name -> emissions.v7.TopicIdTimestampedActorNonce
-	4,  // 45: emissions.v7.GenesisState.open_worker_windows:type_name -> emissions.v7.BlockHeightAndTopicIds
-	3,  // 46: emissions.v7.GenesisState.last_drip_block:type_name -> emissions.v7.TopicIdAndBlockHeight
-	3,  // 47: emissions.v7.GenesisState.topic_to_next_possible_churning_block:type_name -> emissions.v7.TopicIdAndBlockHeight
-	30, // 48: emissions.v7.GenesisState.block_to_active_topics:type_name -> emissions.v7.BlockHeightTopicIds
-	31, // 49: emissions.v7.GenesisState.block_to_lowest_active_topic_weight:type_name -> emissions.v7.BlockHeightTopicIdWeightPair
-	6,  // 50: emissions.v7.GenesisState.inferer_score_emas:type_name -> emissions.v7.TopicIdActorIdScore
-	6,  // 51: emissions.v7.GenesisState.forecaster_score_emas:type_name -> emissions.v7.TopicIdActorIdScore
-	6,  // 52: emissions.v7.GenesisState.reputer_score_emas:type_name -> emissions.v7.TopicIdActorIdScore
-	20, // 53: emissions.v7.GenesisState.previous_topic_quantile_inferer_score_ema:type_name -> emissions.v7.TopicIdAndDec
-	20, // 54: emissions.v7.GenesisState.previous_topic_quantile_forecaster_score_ema:type_name -> emissions.v7.TopicIdAndDec
-	20, // 55: emissions.v7.GenesisState.previous_topic_quantile_reputer_score_ema:type_name -> emissions.v7.TopicIdAndDec
-	7,  // 56: emissions.v7.GenesisState.count_inferer_inclusions_in_topic_active_set:type_name -> emissions.v7.TopicIdActorIdUint64
-	7,  // 57: emissions.v7.GenesisState.count_forecaster_inclusions_in_topic_active_set:type_name -> emissions.v7.TopicIdActorIdUint64
-	2,  // 58: emissions.v7.GenesisState.active_inferers:type_name -> emissions.v7.TopicAndActorId
-	2,  // 59: emissions.v7.GenesisState.active_forecasters:type_name -> emissions.v7.TopicAndActorId
-	6,  // 60: emissions.v7.GenesisState.lowest_inferer_score_ema:type_name -> emissions.v7.TopicIdActorIdScore
-	6,  // 61: emissions.v7.GenesisState.lowest_forecaster_score_ema:type_name -> emissions.v7.TopicIdActorIdScore
-	2,  // 62: emissions.v7.GenesisState.active_reputers:type_name -> emissions.v7.TopicAndActorId
-	6,  // 63: emissions.v7.GenesisState.lowest_reputer_score_ema:type_name -> emissions.v7.TopicIdActorIdScore
-	32, // 64: emissions.v7.GenesisState.loss_bundles:type_name -> emissions.v7.TopicIdReputerReputerValueBundle
-	2,  // 65: emissions.v7.GenesisState.topic_worker_whitelist:type_name -> emissions.v7.TopicAndActorId
-	2,  // 66: emissions.v7.GenesisState.topic_reputer_whitelist:type_name -> emissions.v7.TopicAndActorId
-	20, // 67: emissions.v7.GenesisState.last_median_inferences:type_name -> emissions.v7.TopicIdAndDec
-	20, // 68: emissions.v7.GenesisState.mad_inferences:type_name -> emissions.v7.TopicIdAndDec
-	20, // 69: emissions.v7.GenesisState.initial_inferer_ema_score:type_name -> emissions.v7.TopicIdAndDec
-	20, // 70: emissions.v7.GenesisState.initial_forecaster_ema_score:type_name -> emissions.v7.TopicIdAndDec
-	20, // 71: emissions.v7.GenesisState.initial_reputer_ema_score:type_name -> emissions.v7.TopicIdAndDec
-	34, // 72: emissions.v7.TopicIdAndTopic.topic:type_name -> emissions.v3.Topic
-	35, // 73: emissions.v7.TopicIdBlockHeightScores.scores:type_name -> emissions.v3.Scores
-	36, // 74: emissions.v7.TopicIdActorIdScore.score:type_name -> emissions.v3.Score
-	37, // 75: emissions.v7.TopicIdActorIdListeningCoefficient.listening_coefficient:type_name -> emissions.v3.ListeningCoefficient
-	38, // 76: emissions.v7.TopicIdDelegatorReputerDelegatorInfo.delegator_info:type_name -> emissions.v3.DelegatorInfo
-	39, // 77: emissions.v7.BlockHeightTopicIdReputerStakeRemovalInfo.stake_removal_info:type_name -> emissions.v3.StakeRemovalInfo
-	40, // 78: emissions.v7.BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo.delegate_stake_removal_info:type_name -> emissions.v3.DelegateStakeRemovalInfo
-	41, // 79: emissions.v7.TopicIdActorIdInference.inference:type_name -> emissions.v3.Inference
-	42, // 80: emissions.v7.TopicIdActorIdForecast.forecast:type_name -> emissions.v3.Forecast
-	43, // 81: emissions.v7.LibP2pKeyAndOffchainNode.offchain_node:type_name -> emissions.v3.OffchainNode
-	44, // 82: emissions.v7.TopicIdBlockHeightInferences.inferences:type_name -> emissions.v3.Inferences
-	45, // 83: emissions.v7.TopicIdBlockHeightForecasts.forecasts:type_name -> emissions.v3.Forecasts
-	46, // 84: emissions.v7.TopicIdBlockHeightReputerValueBundles.reputer_value_bundles:type_name -> emissions.v3.ReputerValueBundles
-	47, // 85: emissions.v7.TopicIdBlockHeightValueBundles.value_bundle:type_name -> emissions.v3.ValueBundle
-	48, // 86: emissions.v7.TopicIdAndNonces.nonces:type_name -> emissions.v3.Nonces
-	49, // 87: emissions.v7.TopicIdAndReputerRequestNonces.reputer_request_nonces:type_name -> emissions.v3.ReputerRequestNonces
-	50, // 88: emissions.v7.TopicIdActorIdTimeStampedValue.timestamped_value:type_name -> emissions.v3.TimestampedValue
-	50, // 89: emissions.v7.TopicIdActorIdActorIdTimeStampedValue.timestamped_value:type_name -> emissions.v3.TimestampedValue
-	51, // 90: emissions.v7.TopicIdTimestampedActorNonce.timestamped_actor_nonce:type_name -> emissions.v3.TimestampedActorNonce
-	52, // 91: emissions.v7.BlockHeightTopicIds.topic_ids:type_name -> emissions.v3.TopicIds
-	53, // 92: emissions.v7.BlockHeightTopicIdWeightPair.topic_weight:type_name -> emissions.v3.TopicIdWeightPair
-	54, // 93: emissions.v7.TopicIdReputerReputerValueBundle.reputer_value_bundle:type_name -> emissions.v3.ReputerValueBundle
-	94, // [94:94] is the sub-list for method output_type
-	94, // [94:94] is the sub-list for method input_type
-	94, // [94:94] is the sub-list for extension type_name
-	94, // [94:94] is the sub-list for extension extendee
-	0,  // [0:94] is the sub-list for field type_name
+	37,  // 0: emissions.v7.GenesisState.params:type_name -> emissions.v7.Params
+	1,   // 1: emissions.v7.GenesisState.topics:type_name -> emissions.v7.TopicIdAndTopic
+	4,   // 2: emissions.v7.GenesisState.topic_workers:type_name -> emissions.v7.TopicAndActorId
+	4,   // 3: emissions.v7.GenesisState.topic_reputers:type_name -> emissions.v7.TopicAndActorId
+	7,   // 4: emissions.v7.GenesisState.topic_reward_nonce:type_name -> emissions.v7.TopicIdAndBlockHeight
+	9,   // 5: emissions.v7.GenesisState.inferer_scores_by_block:type_name -> emissions.v7.TopicIdBlockHeightScores
+	9,   // 6: emissions.v7.GenesisState.forecaster_scores_by_block:type_name -> emissions.v7.TopicIdBlockHeightScores
+	9,   // 7: emissions.v7.GenesisState.reputer_scores_by_block:type_name -> emissions.v7.TopicIdBlockHeightScores
+	12,  // 8: emissions.v7.GenesisState.reputer_listening_coefficient:type_name -> emissions.v7.TopicIdActorIdListeningCoefficient
+	13,  // 9: emissions.v7.GenesisState.previous_reputer_reward_fraction:type_name -> emissions.v7.TopicIdActorIdDec
+	13,  // 10: emissions.v7.GenesisState.previous_inference_reward_fraction:type_name -> emissions.v7.TopicIdActorIdDec
+	13,  // 11: emissions.v7.GenesisState.previous_forecast_reward_fraction:type_name -> emissions.v7.TopicIdActorIdDec
+	24,  // 12: emissions.v7.GenesisState.previous_forecaster_score_ratio:type_name -> emissions.v7.TopicIdAndDec
+	14,  // 13: emissions.v7.GenesisState.topic_stake:type_name -> emissions.v7.TopicIdAndInt
+	15,  // 14: emissions.v7.GenesisState.stake_reputer_authority:type_name -> emissions.v7.TopicIdActorIdInt
+	15,  // 15: emissions.v7.GenesisState.stake_sum_from_delegator:type_name -> emissions.v7.TopicIdActorIdInt
+	16,  // 16: emissions.v7.GenesisState.delegated_stakes:type_name -> emissions.v7.TopicIdDelegatorReputerDelegatorInfo
+	15,  // 17: emissions.v7.GenesisState.stake_from_delegators_upon_reputer:type_name -> emissions.v7.TopicIdActorIdInt
+	13,  // 18: emissions.v7.GenesisState.delegate_reward_per_share:type_name -> emissions.v7.TopicIdActorIdDec
+	17,  // 19: emissions.v7.GenesisState.stake_removals_by_block:type_name -> emissions.v7.BlockHeightTopicIdReputerStakeRemovalInfo
+	18,  // 20: emissions.v7.GenesisState.stake_removals_by_actor:type_name -> emissions.v7.ActorIdTopicIdBlockHeight
+	19,  // 21: emissions.v7.GenesisState.delegate_stake_removals_by_block:type_name -> emissions.v7.BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo
+	20,  // 22: emissions.v7.GenesisState.delegate_stake_removals_by_actor:type_name -> emissions.v7.DelegatorReputerTopicIdBlockHeight
+	21,  // 23: emissions.v7.GenesisState.inferences:type_name -> emissions.v7.TopicIdActorIdInference
+	22,  // 24: emissions.v7.GenesisState.forecasts:type_name -> emissions.v7.TopicIdActorIdForecast
+	23,  // 25: emissions.v7.GenesisState.workers:type_name -> emissions.v7.LibP2pKeyAndOffchainNode
+	23,  // 26: emissions.v7.GenesisState.reputers:type_name -> emissions.v7.LibP2pKeyAndOffchainNode
+	14,  // 27: emissions.v7.GenesisState.topic_fee_revenue:type_name -> emissions.v7.TopicIdAndInt
+	24,  // 28: emissions.v7.GenesisState.previous_topic_weight:type_name -> emissions.v7.TopicIdAndDec
+	25,  // 29: emissions.v7.GenesisState.all_inferences:type_name -> emissions.v7.TopicIdBlockHeightInferences
+	26,  // 30: emissions.v7.GenesisState.all_forecasts:type_name -> emissions.v7.TopicIdBlockHeightForecasts
+	27,  // 31: emissions.v7.GenesisState.all_loss_bundles:type_name -> emissions.v7.TopicIdBlockHeightReputerValueBundles
+	28,  // 32: emissions.v7.GenesisState.network_loss_bundles:type_name -> emissions.v7.TopicIdBlockHeightValueBundles
+	29,  // 33: emissions.v7.GenesisState.unfulfilled_worker_nonces:type_name -> emissions.v7.TopicIdAndNonces
+	30,  // 34: emissions.v7.GenesisState.unfulfilled_reputer_nonces:type_name -> emissions.v7.TopicIdAndReputerRequestNonces
+	31,  // 35: emissions.v7.GenesisState.latest_inferer_network_regrets:type_name -> emissions.v7.TopicIdActorIdTimeStampedValue
+	31,  // 36: emissions.v7.GenesisState.latest_forecaster_network_regrets:type_name -> emissions.v7.TopicIdActorIdTimeStampedValue
+	32,  // 37: emissions.v7.GenesisState.latest_one_in_forecaster_network_regrets:type_name -> emissions.v7.TopicIdActorIdActorIdTimeStampedValue
+	31,  // 38: emissions.v7.GenesisState.latest_naive_inferer_network_regrets:type_name -> emissions.v7.TopicIdActorIdTimeStampedValue
+	32,  // 39: emissions.v7.GenesisState.latest_one_out_inferer_inferer_network_regrets:type_name -> emissions.v7.TopicIdActorIdActorIdTimeStampedValue
+	32,  // 40: emissions.v7.GenesisState.latest_one_out_inferer_forecaster_network_regrets:type_name -> emissions.v7.TopicIdActorIdActorIdTimeStampedValue
+	32,  // 41: emissions.v7.GenesisState.latest_one_out_forecaster_inferer_network_regrets:type_name -> emissions.v7.TopicIdActorIdActorIdTimeStampedValue
+	32,  // 42: emissions.v7.GenesisState.latest_one_out_forecaster_forecaster_network_regrets:type_name -> emissions.v7.TopicIdActorIdActorIdTimeStampedValue
+	33,  // 43: emissions.v7.GenesisState.topic_last_worker_commit:type_name -> emissions.v7.TopicIdTimestampedActorNonce
+	33,  // 44: emissions.v7.GenesisState.topic_last_reputer_commit:type_name -> emissions.v7.TopicIdTimestampedActorNonce
+	8,   // 45: emissions.v7.GenesisState.open_worker_windows:type_name -> emissions.v7.BlockHeightAndTopicIds
+	7,   // 46: emissions.v7.GenesisState.last_drip_block:type_name -> emissions.v7.TopicIdAndBlockHeight
+	7,   // 47: emissions.v7.GenesisState.topic_to_next_possible_churning_block:type_name -> emissions.v7.TopicIdAndBlockHeight
+	34,  // 48: emissions.v7.GenesisState.block_to_active_topics:type_name -> emissions.v7.BlockHeightTopicIds
+	35,  // 49: emissions.v7.GenesisState.block_to_lowest_active_topic_weight:type_name -> emissions.v7.BlockHeightTopicIdWeightPair
+	10,  // 50: emissions.v7.GenesisState.inferer_score_emas:type_name -> emissions.v7.TopicIdActorIdScore
+	10,  // 51: emissions.v7.GenesisState.forecaster_score_emas:type_name -> emissions.v7.TopicIdActorIdScore
+	10,  // 52: emissions.v7.GenesisState.reputer_score_emas:type_name -> emissions.v7.TopicIdActorIdScore
+	24,  // 53: emissions.v7.GenesisState.previous_topic_quantile_inferer_score_ema:type_name -> emissions.v7.TopicIdAndDec
+	24,  // 54: emissions.v7.GenesisState.previous_topic_quantile_forecaster_score_ema:type_name -> emissions.v7.TopicIdAndDec
+	24,  // 55: emissions.v7.GenesisState.previous_topic_quantile_reputer_score_ema:type_name -> emissions.v7.TopicIdAndDec
+	11,  // 56: emissions.v7.GenesisState.count_inferer_inclusions_in_topic_active_set:type_name -> emissions.v7.TopicIdActorIdUint64
+	11,  // 57: emissions.v7.GenesisState.count_forecaster_inclusions_in_topic_active_set:type_name -> emissions.v7.TopicIdActorIdUint64
+	4,   // 58: emissions.v7.GenesisState.active_inferers:type_name -> emissions.v7.TopicAndActorId
+	4,   // 59: emissions.v7.GenesisState.active_forecasters:type_name -> emissions.v7.TopicAndActorId
+	10,  // 60: emissions.v7.GenesisState.lowest_inferer_score_ema:type_name -> emissions.v7.TopicIdActorIdScore
+	10,  // 61: emissions.v7.GenesisState.lowest_forecaster_score_ema:type_name -> emissions.v7.TopicIdActorIdScore
+	4,   // 62: emissions.v7.GenesisState.active_reputers:type_name -> emissions.v7.TopicAndActorId
+	10,  // 63: emissions.v7.GenesisState.lowest_reputer_score_ema:type_name -> emissions.v7.TopicIdActorIdScore
+	36,  // 64: emissions.v7.GenesisState.loss_bundles:type_name -> emissions.v7.TopicIdReputerReputerValueBundle
+	4,   // 65: emissions.v7.GenesisState.topic_worker_whitelist:type_name -> emissions.v7.TopicAndActorId
+	4,   // 66: emissions.v7.GenesisState.topic_reputer_whitelist:type_name -> emissions.v7.TopicAndActorId
+	24,  // 67: emissions.v7.GenesisState.last_median_inferences:type_name -> emissions.v7.TopicIdAndDec
+	24,  // 68: emissions.v7.GenesisState.mad_inferences:type_name -> emissions.v7.TopicIdAndDec
+	24,  // 69: emissions.v7.GenesisState.initial_inferer_ema_score:type_name -> emissions.v7.TopicIdAndDec
+	24,  // 70: emissions.v7.GenesisState.initial_forecaster_ema_score:type_name -> emissions.v7.TopicIdAndDec
+	24,  // 71: emissions.v7.GenesisState.initial_reputer_ema_score:type_name -> emissions.v7.TopicIdAndDec
+	2,   // 72: emissions.v7.GenesisState.pending_topic_updates:type_name -> emissions.v7.TopicIdAndOptionalTopicParams
+	4,   // 73: emissions.v7.GenesisState.topic_owners:type_name -> emissions.v7.TopicAndActorId
+	4,   // 74: emissions.v7.GenesisState.pending_topic_owners:type_name -> emissions.v7.TopicAndActorId
+	3,   // 75: emissions.v7.GenesisState.topic_tags:type_name -> emissions.v7.TopicIdAndTag
+	38,  // 76: emissions.v7.GenesisState.reputer_ground_truths:type_name -> emissions.v3.GroundTruth
+	38,  // 77: emissions.v7.GenesisState.consensus_ground_truths:type_name -> emissions.v3.GroundTruth
+	39,  // 78: emissions.v7.GenesisState.worker_payload_commits:type_name -> emissions.v3.WorkerPayloadCommit
+	6,   // 79: emissions.v7.GenesisState.actor_operators:type_name -> emissions.v7.TopicActorOperator
+	40,  // 80: emissions.v7.GenesisState.worker_bonds:type_name -> emissions.v3.WorkerBond
+	41,  // 81: emissions.v7.GenesisState.worker_bond_removals:type_name -> emissions.v3.WorkerBondRemovalInfo
+	42,  // 82: emissions.v7.GenesisState.reputer_consensus_deviations:type_name -> emissions.v3.ReputerConsensusDeviation
+	43,  // 83: emissions.v7.GenesisState.redelegations:type_name -> emissions.v3.RedelegationInfo
+	4,   // 84: emissions.v7.GenesisState.auto_compound_delegators:type_name -> emissions.v7.TopicAndActorId
+	44,  // 85: emissions.v7.GenesisState.reputer_commissions:type_name -> emissions.v3.ReputerCommission
+	45,  // 86: emissions.v7.GenesisState.stake_locks:type_name -> emissions.v3.StakeLock
+	46,  // 87: emissions.v7.GenesisState.reward_history:type_name -> emissions.v3.RewardRecord
+	47,  // 88: emissions.v7.GenesisState.reward_escrows:type_name -> emissions.v3.RewardEscrow
+	48,  // 89: emissions.v7.GenesisState.queued_reward_payouts:type_name -> emissions.v3.QueuedRewardPayout
+	5,   // 90: emissions.v7.GenesisState.archived_topic_delegate_removal_cursors:type_name -> emissions.v7.TopicIdDelegatorReputer
+	48,  // 91: emissions.v7.GenesisState.dead_lettered_reward_payouts:type_name -> emissions.v3.QueuedRewardPayout
+	49,  // 92: emissions.v7.TopicIdAndTopic.topic:type_name -> emissions.v3.Topic
+	50,  // 93: emissions.v7.TopicIdAndOptionalTopicParams.params:type_name -> emissions.v3.OptionalTopicParams
+	51,  // 94: emissions.v7.TopicIdBlockHeightScores.scores:type_name -> emissions.v3.Scores
+	52,  // 95: emissions.v7.TopicIdActorIdScore.score:type_name -> emissions.v3.Score
+	53,  // 96: emissions.v7.TopicIdActorIdListeningCoefficient.listening_coefficient:type_name -> emissions.v3.ListeningCoefficient
+	54,  // 97: emissions.v7.TopicIdDelegatorReputerDelegatorInfo.delegator_info:type_name -> emissions.v3.DelegatorInfo
+	55,  // 98: emissions.v7.BlockHeightTopicIdReputerStakeRemovalInfo.stake_removal_info:type_name -> emissions.v3.StakeRemovalInfo
+	56,  // 99: emissions.v7.BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo.delegate_stake_removal_info:type_name -> emissions.v3.DelegateStakeRemovalInfo
+	57,  // 100: emissions.v7.TopicIdActorIdInference.inference:type_name -> emissions.v3.Inference
+	58,  // 101: emissions.v7.TopicIdActorIdForecast.forecast:type_name -> emissions.v3.Forecast
+	59,  // 102: emissions.v7.LibP2pKeyAndOffchainNode.offchain_node:type_name -> emissions.v3.OffchainNode
+	60,  // 103: emissions.v7.TopicIdBlockHeightInferences.inferences:type_name -> emissions.v3.Inferences
+	61,  // 104: emissions.v7.TopicIdBlockHeightForecasts.forecasts:type_name -> emissions.v3.Forecasts
+	62,  // 105: emissions.v7.TopicIdBlockHeightReputerValueBundles.reputer_value_bundles:type_name -> emissions.v3.ReputerValueBundles
+	63,  // 106: emissions.v7.TopicIdBlockHeightValueBundles.value_bundle:type_name -> emissions.v3.ValueBundle
+	64,  // 107: emissions.v7.TopicIdAndNonces.nonces:type_name -> emissions.v3.Nonces
+	65,  // 108: emissions.v7.TopicIdAndReputerRequestNonces.reputer_request_nonces:type_name -> emissions.v3.ReputerRequestNonces
+	66,  // 109: emissions.v7.TopicIdActorIdTimeStampedValue.timestamped_value:type_name -> emissions.v3.TimestampedValue
+	66,  // 110: emissions.v7.TopicIdActorIdActorIdTimeStampedValue.timestamped_value:type_name -> emissions.v3.TimestampedValue
+	67,  // 111: emissions.v7.TopicIdTimestampedActorNonce.timestamped_actor_nonce:type_name -> emissions.v3.TimestampedActorNonce
+	68,  // 112: emissions.v7.BlockHeightTopicIds.topic_ids:type_name -> emissions.v3.TopicIds
+	69,  // 113: emissions.v7.BlockHeightTopicIdWeightPair.topic_weight:type_name -> emissions.v3.TopicIdWeightPair
+	70,  // 114: emissions.v7.TopicIdReputerReputerValueBundle.reputer_value_bundle:type_name -> emissions.v3.ReputerValueBundle
+	115, // [115:115] is the sub-list for method output_type
+	115, // [115:115] is the sub-list for method input_type
+	115, // [115:115] is the sub-list for extension type_name
+	115, // [115:115] is the sub-list for extension extendee
+	0,   // [0:115] is the sub-list for field type_name
 }
 
 func init() { file_emissions_v7_genesis_proto_init() }
@@ -32699,7 +38494,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicAndActorId); i {
+			switch v := v.(*TopicIdAndOptionalTopicParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32711,7 +38506,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdAndBlockHeight); i {
+			switch v := v.(*TopicIdAndTag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32723,7 +38518,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeightAndTopicIds); i {
+			switch v := v.(*TopicAndActorId); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32735,7 +38530,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdBlockHeightScores); i {
+			switch v := v.(*TopicIdDelegatorReputer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32747,7 +38542,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdActorIdScore); i {
+			switch v := v.(*TopicActorOperator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32759,7 +38554,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdActorIdUint64); i {
+			switch v := v.(*TopicIdAndBlockHeight); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32771,7 +38566,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdActorIdListeningCoefficient); i {
+			switch v := v.(*BlockHeightAndTopicIds); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32783,7 +38578,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdActorIdDec); i {
+			switch v := v.(*TopicIdBlockHeightScores); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32795,7 +38590,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdAndInt); i {
+			switch v := v.(*TopicIdActorIdScore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32807,7 +38602,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdActorIdInt); i {
+			switch v := v.(*TopicIdActorIdUint64); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32819,7 +38614,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdDelegatorReputerDelegatorInfo); i {
+			switch v := v.(*TopicIdActorIdListeningCoefficient); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32831,7 +38626,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeightTopicIdReputerStakeRemovalInfo); i {
+			switch v := v.(*TopicIdActorIdDec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32843,7 +38638,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActorIdTopicIdBlockHeight); i {
+			switch v := v.(*TopicIdAndInt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32855,7 +38650,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo); i {
+			switch v := v.(*TopicIdActorIdInt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32867,7 +38662,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelegatorReputerTopicIdBlockHeight); i {
+			switch v := v.(*TopicIdDelegatorReputerDelegatorInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32879,7 +38674,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdActorIdInference); i {
+			switch v := v.(*BlockHeightTopicIdReputerStakeRemovalInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32891,7 +38686,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdActorIdForecast); i {
+			switch v := v.(*ActorIdTopicIdBlockHeight); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32903,7 +38698,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LibP2PKeyAndOffchainNode); i {
+			switch v := v.(*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32915,7 +38710,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdAndDec); i {
+			switch v := v.(*DelegatorReputerTopicIdBlockHeight); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32927,7 +38722,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdBlockHeightInferences); i {
+			switch v := v.(*TopicIdActorIdInference); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32939,7 +38734,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdBlockHeightForecasts); i {
+			switch v := v.(*TopicIdActorIdForecast); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32951,7 +38746,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdBlockHeightReputerValueBundles); i {
+			switch v := v.(*LibP2PKeyAndOffchainNode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32963,7 +38758,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdBlockHeightValueBundles); i {
+			switch v := v.(*TopicIdAndDec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32975,7 +38770,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdAndNonces); i {
+			switch v := v.(*TopicIdBlockHeightInferences); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32987,7 +38782,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdAndReputerRequestNonces); i {
+			switch v := v.(*TopicIdBlockHeightForecasts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -32999,7 +38794,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdActorIdTimeStampedValue); i {
+			switch v := v.(*TopicIdBlockHeightReputerValueBundles); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -33011,7 +38806,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdActorIdActorIdTimeStampedValue); i {
+			switch v := v.(*TopicIdBlockHeightValueBundles); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -33023,7 +38818,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIdTimestampedActorNonce); i {
+			switch v := v.(*TopicIdAndNonces); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -33035,7 +38830,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeightTopicIds); i {
+			switch v := v.(*TopicIdAndReputerRequestNonces); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -33047,7 +38842,7 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeightTopicIdWeightPair); i {
+			switch v := v.(*TopicIdActorIdTimeStampedValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -33059,6 +38854,54 @@ func file_emissions_v7_genesis_proto_init() {
 			}
 		}
 		file_emissions_v7_genesis_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicIdActorIdActorIdTimeStampedValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v7_genesis_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicIdTimestampedActorNonce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v7_genesis_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeightTopicIds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v7_genesis_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeightTopicIdWeightPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v7_genesis_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TopicIdReputerReputerValueBundle); i {
 			case 0:
 				return &v.state
@@ -33077,7 +38920,7 @@ func file_emissions_v7_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v7_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   33,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -15,6 +15,57 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_63_list)(nil)
+
+type _Params_63_list struct {
+	list *[]*StakeLockTier
+}
+
+func (x *_Params_63_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_63_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_63_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StakeLockTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_63_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StakeLockTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_63_list) AppendMutable() protoreflect.Value {
+	v := new(StakeLockTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_63_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_63_list) NewElement() protoreflect.Value {
+	v := new(StakeLockTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_63_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                                           protoreflect.MessageDescriptor
 	fd_Params_version                                   protoreflect.FieldDescriptor
@@ -70,6 +121,16 @@ var (
 	fd_Params_global_reputer_whitelist_enabled          protoreflect.FieldDescriptor
 	fd_Params_global_admin_whitelist_appended           protoreflect.FieldDescriptor
 	fd_Params_max_whitelist_input_array_length          protoreflect.FieldDescriptor
+	fd_Params_max_archived_topic_prunes_per_block       protoreflect.FieldDescriptor
+	fd_Params_max_reputer_stake_share                   protoreflect.FieldDescriptor
+	fd_Params_stake_above_cap_exponent                  protoreflect.FieldDescriptor
+	fd_Params_stake_lock_tiers                          protoreflect.FieldDescriptor
+	fd_Params_reward_history_retention_blocks           protoreflect.FieldDescriptor
+	fd_Params_max_reward_payouts_per_block              protoreflect.FieldDescriptor
+	fd_Params_max_reward_history_prunes_per_block       protoreflect.FieldDescriptor
+	fd_Params_max_reputer_commission_rate               protoreflect.FieldDescriptor
+	fd_Params_max_reputer_commission_change_rate        protoreflect.FieldDescriptor
+	fd_Params_max_reward_payout_attempts                protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -128,6 +189,16 @@ func init() {
 	fd_Params_global_reputer_whitelist_enabled = md_Params.Fields().ByName("global_reputer_whitelist_enabled")
 	fd_Params_global_admin_whitelist_appended = md_Params.Fields().ByName("global_admin_whitelist_appended")
 	fd_Params_max_whitelist_input_array_length = md_Params.Fields().ByName("max_whitelist_input_array_length")
+	fd_Params_max_archived_topic_prunes_per_block = md_Params.Fields().ByName("max_archived_topic_prunes_per_block")
+	fd_Params_max_reputer_stake_share = md_Params.Fields().ByName("max_reputer_stake_share")
+	fd_Params_stake_above_cap_exponent = md_Params.Fields().ByName("stake_above_cap_exponent")
+	fd_Params_stake_lock_tiers = md_Params.Fields().ByName("stake_lock_tiers")
+	fd_Params_reward_history_retention_blocks = md_Params.Fields().ByName("reward_history_retention_blocks")
+	fd_Params_max_reward_payouts_per_block = md_Params.Fields().ByName("max_reward_payouts_per_block")
+	fd_Params_max_reward_history_prunes_per_block = md_Params.Fields().ByName("max_reward_history_prunes_per_block")
+	fd_Params_max_reputer_commission_rate = md_Params.Fields().ByName("max_reputer_commission_rate")
+	fd_Params_max_reputer_commission_change_rate = md_Params.Fields().ByName("max_reputer_commission_change_rate")
+	fd_Params_max_reward_payout_attempts = md_Params.Fields().ByName("max_reward_payout_attempts")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -513,6 +584,66 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxArchivedTopicPrunesPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxArchivedTopicPrunesPerBlock)
+		if !f(fd_Params_max_archived_topic_prunes_per_block, value) {
+			return
+		}
+	}
+	if x.MaxReputerStakeShare != "" {
+		value := protoreflect.ValueOfString(x.MaxReputerStakeShare)
+		if !f(fd_Params_max_reputer_stake_share, value) {
+			return
+		}
+	}
+	if x.StakeAboveCapExponent != "" {
+		value := protoreflect.ValueOfString(x.StakeAboveCapExponent)
+		if !f(fd_Params_stake_above_cap_exponent, value) {
+			return
+		}
+	}
+	if len(x.StakeLockTiers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_63_list{list: &x.StakeLockTiers})
+		if !f(fd_Params_stake_lock_tiers, value) {
+			return
+		}
+	}
+	if x.RewardHistoryRetentionBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RewardHistoryRetentionBlocks)
+		if !f(fd_Params_reward_history_retention_blocks, value) {
+			return
+		}
+	}
+	if x.MaxRewardPayoutsPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRewardPayoutsPerBlock)
+		if !f(fd_Params_max_reward_payouts_per_block, value) {
+			return
+		}
+	}
+	if x.MaxRewardHistoryPrunesPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRewardHistoryPrunesPerBlock)
+		if !f(fd_Params_max_reward_history_prunes_per_block, value) {
+			return
+		}
+	}
+	if x.MaxReputerCommissionRate != "" {
+		value := protoreflect.ValueOfString(x.MaxReputerCommissionRate)
+		if !f(fd_Params_max_reputer_commission_rate, value) {
+			return
+		}
+	}
+	if x.MaxReputerCommissionChangeRate != "" {
+		value := protoreflect.ValueOfString(x.MaxReputerCommissionChangeRate)
+		if !f(fd_Params_max_reputer_commission_change_rate, value) {
+			return
+		}
+	}
+	if x.MaxRewardPayoutAttempts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRewardPayoutAttempts)
+		if !f(fd_Params_max_reward_payout_attempts, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -634,6 +765,26 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.GlobalAdminWhitelistAppended != false
 	case "emissions.v7.Params.max_whitelist_input_array_length":
 		return x.MaxWhitelistInputArrayLength != uint64(0)
+	case "emissions.v7.Params.max_archived_topic_prunes_per_block":
+		return x.MaxArchivedTopicPrunesPerBlock != uint64(0)
+	case "emissions.v7.Params.max_reputer_stake_share":
+		return x.MaxReputerStakeShare != ""
+	case "emissions.v7.Params.stake_above_cap_exponent":
+		return x.StakeAboveCapExponent != ""
+	case "emissions.v7.Params.stake_lock_tiers":
+		return len(x.StakeLockTiers) != 0
+	case "emissions.v7.Params.reward_history_retention_blocks":
+		return x.RewardHistoryRetentionBlocks != int64(0)
+	case "emissions.v7.Params.max_reward_payouts_per_block":
+		return x.MaxRewardPayoutsPerBlock != uint64(0)
+	case "emissions.v7.Params.max_reward_history_prunes_per_block":
+		return x.MaxRewardHistoryPrunesPerBlock != uint64(0)
+	case "emissions.v7.Params.max_reputer_commission_rate":
+		return x.MaxReputerCommissionRate != ""
+	case "emissions.v7.Params.max_reputer_commission_change_rate":
+		return x.MaxReputerCommissionChangeRate != ""
+	case "emissions.v7.Params.max_reward_payout_attempts":
+		return x.MaxRewardPayoutAttempts != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.Params"))
@@ -756,6 +907,26 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.GlobalAdminWhitelistAppended = false
 	case "emissions.v7.Params.max_whitelist_input_array_length":
 		x.MaxWhitelistInputArrayLength = uint64(0)
+	case "emissions.v7.Params.max_archived_topic_prunes_per_block":
+		x.MaxArchivedTopicPrunesPerBlock = uint64(0)
+	case "emissions.v7.Params.max_reputer_stake_share":
+		x.MaxReputerStakeShare = ""
+	case "emissions.v7.Params.stake_above_cap_exponent":
+		x.StakeAboveCapExponent = ""
+	case "emissions.v7.Params.stake_lock_tiers":
+		x.StakeLockTiers = nil
+	case "emissions.v7.Params.reward_history_retention_blocks":
+		x.RewardHistoryRetentionBlocks = int64(0)
+	case "emissions.v7.Params.max_reward_payouts_per_block":
+		x.MaxRewardPayoutsPerBlock = uint64(0)
+	case "emissions.v7.Params.max_reward_history_prunes_per_block":
+		x.MaxRewardHistoryPrunesPerBlock = uint64(0)
+	case "emissions.v7.Params.max_reputer_commission_rate":
+		x.MaxReputerCommissionRate = ""
+	case "emissions.v7.Params.max_reputer_commission_change_rate":
+		x.MaxReputerCommissionChangeRate = ""
+	case "emissions.v7.Params.max_reward_payout_attempts":
+		x.MaxRewardPayoutAttempts = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.Params"))
@@ -931,6 +1102,39 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "emissions.v7.Params.max_whitelist_input_array_length":
 		value := x.MaxWhitelistInputArrayLength
 		return protoreflect.ValueOfUint64(value)
+	case "emissions.v7.Params.max_archived_topic_prunes_per_block":
+		value := x.MaxArchivedTopicPrunesPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v7.Params.max_reputer_stake_share":
+		value := x.MaxReputerStakeShare
+		return protoreflect.ValueOfString(value)
+	case "emissions.v7.Params.stake_above_cap_exponent":
+		value := x.StakeAboveCapExponent
+		return protoreflect.ValueOfString(value)
+	case "emissions.v7.Params.stake_lock_tiers":
+		if len(x.StakeLockTiers) == 0 {
+			return protoreflect.ValueOfList(&_Params_63_list{})
+		}
+		listValue := &_Params_63_list{list: &x.StakeLockTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v7.Params.reward_history_retention_blocks":
+		value := x.RewardHistoryRetentionBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v7.Params.max_reward_payouts_per_block":
+		value := x.MaxRewardPayoutsPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v7.Params.max_reward_history_prunes_per_block":
+		value := x.MaxRewardHistoryPrunesPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v7.Params.max_reputer_commission_rate":
+		value := x.MaxReputerCommissionRate
+		return protoreflect.ValueOfString(value)
+	case "emissions.v7.Params.max_reputer_commission_change_rate":
+		value := x.MaxReputerCommissionChangeRate
+		return protoreflect.ValueOfString(value)
+	case "emissions.v7.Params.max_reward_payout_attempts":
+		value := x.MaxRewardPayoutAttempts
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.Params"))
@@ -1057,6 +1261,28 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.GlobalAdminWhitelistAppended = value.Bool()
 	case "emissions.v7.Params.max_whitelist_input_array_length":
 		x.MaxWhitelistInputArrayLength = value.Uint()
+	case "emissions.v7.Params.max_archived_topic_prunes_per_block":
+		x.MaxArchivedTopicPrunesPerBlock = value.Uint()
+	case "emissions.v7.Params.max_reputer_stake_share":
+		x.MaxReputerStakeShare = value.Interface().(string)
+	case "emissions.v7.Params.stake_above_cap_exponent":
+		x.StakeAboveCapExponent = value.Interface().(string)
+	case "emissions.v7.Params.stake_lock_tiers":
+		lv := value.List()
+		clv := lv.(*_Params_63_list)
+		x.StakeLockTiers = *clv.list
+	case "emissions.v7.Params.reward_history_retention_blocks":
+		x.RewardHistoryRetentionBlocks = value.Int()
+	case "emissions.v7.Params.max_reward_payouts_per_block":
+		x.MaxRewardPayoutsPerBlock = value.Uint()
+	case "emissions.v7.Params.max_reward_history_prunes_per_block":
+		x.MaxRewardHistoryPrunesPerBlock = value.Uint()
+	case "emissions.v7.Params.max_reputer_commission_rate":
+		x.MaxReputerCommissionRate = value.Interface().(string)
+	case "emissions.v7.Params.max_reputer_commission_change_rate":
+		x.MaxReputerCommissionChangeRate = value.Interface().(string)
+	case "emissions.v7.Params.max_reward_payout_attempts":
+		x.MaxRewardPayoutAttempts = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.Params"))
@@ -1077,6 +1303,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "emissions.v7.Params.stake_lock_tiers":
+		if x.StakeLockTiers == nil {
+			x.StakeLockTiers = []*StakeLockTier{}
+		}
+		value := &_Params_63_list{list: &x.StakeLockTiers}
+		return protoreflect.ValueOfList(value)
 	case "emissions.v7.Params.version":
 		panic(fmt.Errorf("field version of message emissions.v7.Params is not mutable"))
 	case "emissions.v7.Params.max_serialized_msg_length":
@@ -1183,6 +1415,24 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field global_admin_whitelist_appended of message emissions.v7.Params is not mutable"))
 	case "emissions.v7.Params.max_whitelist_input_array_length":
 		panic(fmt.Errorf("field max_whitelist_input_array_length of message emissions.v7.Params is not mutable"))
+	case "emissions.v7.Params.max_archived_topic_prunes_per_block":
+		panic(fmt.Errorf("field max_archived_topic_prunes_per_block of message emissions.v7.Params is not mutable"))
+	case "emissions.v7.Params.max_reputer_stake_share":
+		panic(fmt.Errorf("field max_reputer_stake_share of message emissions.v7.Params is not mutable"))
+	case "emissions.v7.Params.stake_above_cap_exponent":
+		panic(fmt.Errorf("field stake_above_cap_exponent of message emissions.v7.Params is not mutable"))
+	case "emissions.v7.Params.reward_history_retention_blocks":
+		panic(fmt.Errorf("field reward_history_retention_blocks of message emissions.v7.Params is not mutable"))
+	case "emissions.v7.Params.max_reward_payouts_per_block":
+		panic(fmt.Errorf("field max_reward_payouts_per_block of message emissions.v7.Params is not mutable"))
+	case "emissions.v7.Params.max_reward_history_prunes_per_block":
+		panic(fmt.Errorf("field max_reward_history_prunes_per_block of message emissions.v7.Params is not mutable"))
+	case "emissions.v7.Params.max_reputer_commission_rate":
+		panic(fmt.Errorf("field max_reputer_commission_rate of message emissions.v7.Params is not mutable"))
+	case "emissions.v7.Params.max_reputer_commission_change_rate":
+		panic(fmt.Errorf("field max_reputer_commission_change_rate of message emissions.v7.Params is not mutable"))
+	case "emissions.v7.Params.max_reward_payout_attempts":
+		panic(fmt.Errorf("field max_reward_payout_attempts of message emissions.v7.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.Params"))
@@ -1302,6 +1552,27 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBool(false)
 	case "emissions.v7.Params.max_whitelist_input_array_length":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v7.Params.max_archived_topic_prunes_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v7.Params.max_reputer_stake_share":
+		return protoreflect.ValueOfString("")
+	case "emissions.v7.Params.stake_above_cap_exponent":
+		return protoreflect.ValueOfString("")
+	case "emissions.v7.Params.stake_lock_tiers":
+		list := []*StakeLockTier{}
+		return protoreflect.ValueOfList(&_Params_63_list{list: &list})
+	case "emissions.v7.Params.reward_history_retention_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v7.Params.max_reward_payouts_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v7.Params.max_reward_history_prunes_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v7.Params.max_reputer_commission_rate":
+		return protoreflect.ValueOfString("")
+	case "emissions.v7.Params.max_reputer_commission_change_rate":
+		return protoreflect.ValueOfString("")
+	case "emissions.v7.Params.max_reward_payout_attempts":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.Params"))
@@ -1558,6 +1829,43 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.MaxWhitelistInputArrayLength != 0 {
 			n += 2 + runtime.Sov(uint64(x.MaxWhitelistInputArrayLength))
 		}
+		if x.MaxArchivedTopicPrunesPerBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxArchivedTopicPrunesPerBlock))
+		}
+		l = len(x.MaxReputerStakeShare)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StakeAboveCapExponent)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.StakeLockTiers) > 0 {
+			for _, e := range x.StakeLockTiers {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RewardHistoryRetentionBlocks != 0 {
+			n += 2 + runtime.Sov(uint64(x.RewardHistoryRetentionBlocks))
+		}
+		if x.MaxRewardPayoutsPerBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxRewardPayoutsPerBlock))
+		}
+		if x.MaxRewardHistoryPrunesPerBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxRewardHistoryPrunesPerBlock))
+		}
+		l = len(x.MaxReputerCommissionRate)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxReputerCommissionChangeRate)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxRewardPayoutAttempts != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxRewardPayoutAttempts))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1587,6 +1895,95 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxRewardPayoutAttempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRewardPayoutAttempts))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xa8
+		}
+		if len(x.MaxReputerCommissionChangeRate) > 0 {
+			i -= len(x.MaxReputerCommissionChangeRate)
+			copy(dAtA[i:], x.MaxReputerCommissionChangeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxReputerCommissionChangeRate)))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.MaxReputerCommissionRate) > 0 {
+			i -= len(x.MaxReputerCommissionRate)
+			copy(dAtA[i:], x.MaxReputerCommissionRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxReputerCommissionRate)))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x9a
+		}
+		if x.MaxRewardHistoryPrunesPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRewardHistoryPrunesPerBlock))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.MaxRewardPayoutsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRewardPayoutsPerBlock))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.RewardHistoryRetentionBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RewardHistoryRetentionBlocks))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.StakeLockTiers) > 0 {
+			for iNdEx := len(x.StakeLockTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StakeLockTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0xfa
+			}
+		}
+		if len(x.StakeAboveCapExponent) > 0 {
+			i -= len(x.StakeAboveCapExponent)
+			copy(dAtA[i:], x.StakeAboveCapExponent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StakeAboveCapExponent)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xf2
+		}
+		if len(x.MaxReputerStakeShare) > 0 {
+			i -= len(x.MaxReputerStakeShare)
+			copy(dAtA[i:], x.MaxReputerStakeShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxReputerStakeShare)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xea
+		}
+		if x.MaxArchivedTopicPrunesPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxArchivedTopicPrunesPerBlock))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xe0
+		}
 		if x.MaxWhitelistInputArrayLength != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxWhitelistInputArrayLength))
 			i--
@@ -3438,101 +3835,1320 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 60:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxArchivedTopicPrunesPerBlock", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.MaxArchivedTopicPrunesPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxArchivedTopicPrunesPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 61:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxReputerStakeShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.MaxReputerStakeShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 62:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeAboveCapExponent", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: emissions/v7/params.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Params defines the parameters of the module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"` // version of the protocol should be in lockstep with
-	// github release tag version
-	MaxSerializedMsgLength int64  `protobuf:"varint,2,opt,name=max_serialized_msg_length,json=maxSerializedMsgLength,proto3" json:"max_serialized_msg_length,omitempty"` // max length of input data for msg and query server calls
-	MinTopicWeight         string `protobuf:"bytes,3,opt,name=min_topic_weight,json=minTopicWeight,proto3" json:"min_topic_weight,omitempty"`                            // total unmet demand for a topic < this => don't run inference
-	// solicatation or weight-adjustment
-	RequiredMinimumStake   string `protobuf:"bytes,5,opt,name=required_minimum_stake,json=requiredMinimumStake,proto3" json:"required_minimum_stake,omitempty"`          // minimum amount of tokens to send to stake as a reputer or worker
-	RemoveStakeDelayWindow int64  `protobuf:"varint,6,opt,name=remove_stake_delay_window,json=removeStakeDelayWindow,proto3" json:"remove_stake_delay_window,omitempty"` // how long to wait (blocks) before allowed to remove stake
-	MinEpochLength         int64  `protobuf:"varint,7,opt,name=min_epoch_length,json=minEpochLength,proto3" json:"min_epoch_length,omitempty"`                           // fastest allowable topic epoch and cadence of a
-	// repeating inference request
-	BetaEntropy          string `protobuf:"bytes,8,opt,name=beta_entropy,json=betaEntropy,proto3" json:"beta_entropy,omitempty"`                               // controls resilience of reward payouts against copycat workers
-	LearningRate         string `protobuf:"bytes,9,opt,name=learning_rate,json=learningRate,proto3" json:"learning_rate,omitempty"`                            // speed of gradient descent
-	MaxGradientThreshold string `protobuf:"bytes,10,opt,name=max_gradient_threshold,json=maxGradientThreshold,proto3" json:"max_gradient_threshold,omitempty"` // gradient descent stops when gradient falls below this
-	MinStakeFraction     string `protobuf:"bytes,11,opt,name=min_stake_fraction,json=minStakeFraction,proto3" json:"min_stake_fraction,omitempty"`             // minimum fraction of stake to listen to when setting consensus listening
-	// coefficients
-	MaxUnfulfilledWorkerRequests  uint64 `protobuf:"varint,13,opt,name=max_unfulfilled_worker_requests,json=maxUnfulfilledWorkerRequests,proto3" json:"max_unfulfilled_worker_requests,omitempty"`    // max num worker request nonces to keep track of per topic
-	MaxUnfulfilledReputerRequests uint64 `protobuf:"varint,14,opt,name=max_unfulfilled_reputer_requests,json=maxUnfulfilledReputerRequests,proto3" json:"max_unfulfilled_reputer_requests,omitempty"` // max num reputer request nonces to keep track of per topic
-	TopicRewardStakeImportance    string `protobuf:"bytes,15,opt,name=topic_reward_stake_importance,json=topicRewardStakeImportance,proto3" json:"topic_reward_stake_importance,omitempty"`           // The exponent μ represents the importance of stake in the reward of a
-	// topic and has a fiducial value of 0.5
-	TopicRewardFeeRevenueImportance string `protobuf:"bytes,16,opt,name=topic_reward_fee_revenue_importance,json=topicRewardFeeRevenueImportance,proto3" json:"topic_reward_fee_revenue_importance,omitempty"` // The exponent ν represents the importance of fee revenue in the reward of
-	// a topic and has a fiducial value of 0.5
-	TopicRewardAlpha string `protobuf:"bytes,17,opt,name=topic_reward_alpha,json=topicRewardAlpha,proto3" json:"topic_reward_alpha,omitempty"` // global exponential moving average parameter. Fiducial value of 0.9375 on
-	// a monthly timescale, 0.5 for weekly updates
-	TaskRewardAlpha string `protobuf:"bytes,18,opt,name=task_reward_alpha,json=taskRewardAlpha,proto3" json:"task_reward_alpha,omitempty"` // global exponential moving average parameter. Fiducial value of 0.1 used
-	// to calculate ~U_ij, ~V_ik, ~W_im
-	ValidatorsVsAlloraPercentReward string `protobuf:"bytes,19,opt,name=validators_vs_allora_percent_reward,json=validatorsVsAlloraPercentReward,proto3" json:"validators_vs_allora_percent_reward,omitempty"` // percent of total supply rewarded to cosmos network validators, rest goes
-	// to allora reputers workers etc
-	MaxSamplesToScaleScores   uint64 `protobuf:"varint,20,opt,name=max_samples_to_scale_scores,json=maxSamplesToScaleScores,proto3" json:"max_samples_to_scale_scores,omitempty"`       // number of scores to use for standard deviation calculation
-	MaxTopInferersToReward    uint64 `protobuf:"varint,21,opt,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`          // max number of top inferers by score to reward
-	MaxTopForecastersToReward uint64 `protobuf:"varint,22,opt,name=max_top_forecasters_to_reward,json=maxTopForecastersToReward,proto3" json:"max_top_forecasters_to_reward,omitempty"` // max number of top forecasters by score to reward
-	MaxTopReputersToReward    uint64 `protobuf:"varint,23,opt,name=max_top_reputers_to_reward,json=maxTopReputersToReward,proto3" json:"max_top_reputers_to_reward,omitempty"`          // max number of top reputers by score to reward
-	CreateTopicFee            string `protobuf:"bytes,24,opt,name=create_topic_fee,json=createTopicFee,proto3" json:"create_topic_fee,omitempty"`                                       // topic registration fee
-	GradientDescentMaxIters   uint64 `protobuf:"varint,25,opt,name=gradient_descent_max_iters,json=gradientDescentMaxIters,proto3" json:"gradient_descent_max_iters,omitempty"`         // max number of gradient descent iterations
-	RegistrationFee           string `protobuf:"bytes,28,opt,name=registration_fee,json=registrationFee,proto3" json:"registration_fee,omitempty"`                                      // registration fee for reputer or worker
-	DefaultPageLimit          uint64 `protobuf:"varint,29,opt,name=default_page_limit,json=defaultPageLimit,proto3" json:"default_page_limit,omitempty"`                                // default limit for pagination
-	MaxPageLimit              uint64 `protobuf:"varint,30,opt,name=max_page_limit,json=maxPageLimit,proto3" json:"max_page_limit,omitempty"`                                            // max limit for pagination
-	// min number of epochs to keep network losses, reputer losses, inferences,
-	// forecasts
-	MinEpochLengthRecordLimit int64 `protobuf:"varint,31,opt,name=min_epoch_length_record_limit,json=minEpochLengthRecordLimit,proto3" json:"min_epoch_length_record_limit,omitempty"`
-	// block emission rate in number of blocks expected per month
-	BlocksPerMonth   uint64 `protobuf:"varint,32,opt,name=blocks_per_month,json=blocksPerMonth,proto3" json:"blocks_per_month,omitempty"`
-	PRewardInference string `protobuf:"bytes,33,opt,name=p_reward_inference,json=pRewardInference,proto3" json:"p_reward_inference,omitempty"`
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StakeAboveCapExponent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 63:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeLockTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StakeLockTiers = append(x.StakeLockTiers, &StakeLockTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StakeLockTiers[len(x.StakeLockTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 64:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardHistoryRetentionBlocks", wireType)
+				}
+				x.RewardHistoryRetentionBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RewardHistoryRetentionBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 65:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRewardPayoutsPerBlock", wireType)
+				}
+				x.MaxRewardPayoutsPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRewardPayoutsPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 66:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRewardHistoryPrunesPerBlock", wireType)
+				}
+				x.MaxRewardHistoryPrunesPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRewardHistoryPrunesPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 67:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxReputerCommissionRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxReputerCommissionRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 68:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxReputerCommissionChangeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxReputerCommissionChangeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 69:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRewardPayoutAttempts", wireType)
+				}
+				x.MaxRewardPayoutAttempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRewardPayoutAttempts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_StakeLockTier                   protoreflect.MessageDescriptor
+	fd_StakeLockTier_lock_months       protoreflect.FieldDescriptor
+	fd_StakeLockTier_reward_multiplier protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v7_params_proto_init()
+	md_StakeLockTier = File_emissions_v7_params_proto.Messages().ByName("StakeLockTier")
+	fd_StakeLockTier_lock_months = md_StakeLockTier.Fields().ByName("lock_months")
+	fd_StakeLockTier_reward_multiplier = md_StakeLockTier.Fields().ByName("reward_multiplier")
+}
+
+var _ protoreflect.Message = (*fastReflection_StakeLockTier)(nil)
+
+type fastReflection_StakeLockTier StakeLockTier
+
+func (x *StakeLockTier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StakeLockTier)(x)
+}
+
+func (x *StakeLockTier) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v7_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StakeLockTier_messageType fastReflection_StakeLockTier_messageType
+var _ protoreflect.MessageType = fastReflection_StakeLockTier_messageType{}
+
+type fastReflection_StakeLockTier_messageType struct{}
+
+func (x fastReflection_StakeLockTier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StakeLockTier)(nil)
+}
+func (x fastReflection_StakeLockTier_messageType) New() protoreflect.Message {
+	return new(fastReflection_StakeLockTier)
+}
+func (x fastReflection_StakeLockTier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StakeLockTier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StakeLockTier) Descriptor() protoreflect.MessageDescriptor {
+	return md_StakeLockTier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StakeLockTier) Type() protoreflect.MessageType {
+	return _fastReflection_StakeLockTier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StakeLockTier) New() protoreflect.Message {
+	return new(fastReflection_StakeLockTier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StakeLockTier) Interface() protoreflect.ProtoMessage {
+	return (*StakeLockTier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StakeLockTier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LockMonths != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LockMonths)
+		if !f(fd_StakeLockTier_lock_months, value) {
+			return
+		}
+	}
+	if x.RewardMultiplier != "" {
+		value := protoreflect.ValueOfString(x.RewardMultiplier)
+		if !f(fd_StakeLockTier_reward_multiplier, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StakeLockTier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v7.StakeLockTier.lock_months":
+		return x.LockMonths != uint64(0)
+	case "emissions.v7.StakeLockTier.reward_multiplier":
+		return x.RewardMultiplier != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.StakeLockTier"))
+		}
+		panic(fmt.Errorf("message emissions.v7.StakeLockTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StakeLockTier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v7.StakeLockTier.lock_months":
+		x.LockMonths = uint64(0)
+	case "emissions.v7.StakeLockTier.reward_multiplier":
+		x.RewardMultiplier = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.StakeLockTier"))
+		}
+		panic(fmt.Errorf("message emissions.v7.StakeLockTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StakeLockTier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v7.StakeLockTier.lock_months":
+		value := x.LockMonths
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v7.StakeLockTier.reward_multiplier":
+		value := x.RewardMultiplier
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.StakeLockTier"))
+		}
+		panic(fmt.Errorf("message emissions.v7.StakeLockTier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StakeLockTier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v7.StakeLockTier.lock_months":
+		x.LockMonths = value.Uint()
+	case "emissions.v7.StakeLockTier.reward_multiplier":
+		x.RewardMultiplier = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.StakeLockTier"))
+		}
+		panic(fmt.Errorf("message emissions.v7.StakeLockTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StakeLockTier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v7.StakeLockTier.lock_months":
+		panic(fmt.Errorf("field lock_months of message emissions.v7.StakeLockTier is not mutable"))
+	case "emissions.v7.StakeLockTier.reward_multiplier":
+		panic(fmt.Errorf("field reward_multiplier of message emissions.v7.StakeLockTier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.StakeLockTier"))
+		}
+		panic(fmt.Errorf("message emissions.v7.StakeLockTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StakeLockTier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v7.StakeLockTier.lock_months":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v7.StakeLockTier.reward_multiplier":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.StakeLockTier"))
+		}
+		panic(fmt.Errorf("message emissions.v7.StakeLockTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StakeLockTier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v7.StakeLockTier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StakeLockTier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StakeLockTier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StakeLockTier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StakeLockTier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StakeLockTier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.LockMonths != 0 {
+			n += 1 + runtime.Sov(uint64(x.LockMonths))
+		}
+		l = len(x.RewardMultiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StakeLockTier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RewardMultiplier) > 0 {
+			i -= len(x.RewardMultiplier)
+			copy(dAtA[i:], x.RewardMultiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardMultiplier)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.LockMonths != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LockMonths))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StakeLockTier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StakeLockTier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StakeLockTier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockMonths", wireType)
+				}
+				x.LockMonths = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LockMonths |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardMultiplier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardMultiplier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_StakeLockTiers_1_list)(nil)
+
+type _StakeLockTiers_1_list struct {
+	list *[]*StakeLockTier
+}
+
+func (x *_StakeLockTiers_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StakeLockTiers_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StakeLockTiers_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StakeLockTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StakeLockTiers_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StakeLockTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StakeLockTiers_1_list) AppendMutable() protoreflect.Value {
+	v := new(StakeLockTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StakeLockTiers_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StakeLockTiers_1_list) NewElement() protoreflect.Value {
+	v := new(StakeLockTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StakeLockTiers_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_StakeLockTiers       protoreflect.MessageDescriptor
+	fd_StakeLockTiers_tiers protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v7_params_proto_init()
+	md_StakeLockTiers = File_emissions_v7_params_proto.Messages().ByName("StakeLockTiers")
+	fd_StakeLockTiers_tiers = md_StakeLockTiers.Fields().ByName("tiers")
+}
+
+var _ protoreflect.Message = (*fastReflection_StakeLockTiers)(nil)
+
+type fastReflection_StakeLockTiers StakeLockTiers
+
+func (x *StakeLockTiers) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StakeLockTiers)(x)
+}
+
+func (x *StakeLockTiers) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v7_params_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StakeLockTiers_messageType fastReflection_StakeLockTiers_messageType
+var _ protoreflect.MessageType = fastReflection_StakeLockTiers_messageType{}
+
+type fastReflection_StakeLockTiers_messageType struct{}
+
+func (x fastReflection_StakeLockTiers_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StakeLockTiers)(nil)
+}
+func (x fastReflection_StakeLockTiers_messageType) New() protoreflect.Message {
+	return new(fastReflection_StakeLockTiers)
+}
+func (x fastReflection_StakeLockTiers_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StakeLockTiers
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StakeLockTiers) Descriptor() protoreflect.MessageDescriptor {
+	return md_StakeLockTiers
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StakeLockTiers) Type() protoreflect.MessageType {
+	return _fastReflection_StakeLockTiers_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StakeLockTiers) New() protoreflect.Message {
+	return new(fastReflection_StakeLockTiers)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StakeLockTiers) Interface() protoreflect.ProtoMessage {
+	return (*StakeLockTiers)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StakeLockTiers) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Tiers) != 0 {
+		value := protoreflect.ValueOfList(&_StakeLockTiers_1_list{list: &x.Tiers})
+		if !f(fd_StakeLockTiers_tiers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StakeLockTiers) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v7.StakeLockTiers.tiers":
+		return len(x.Tiers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.StakeLockTiers"))
+		}
+		panic(fmt.Errorf("message emissions.v7.StakeLockTiers does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StakeLockTiers) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v7.StakeLockTiers.tiers":
+		x.Tiers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.StakeLockTiers"))
+		}
+		panic(fmt.Errorf("message emissions.v7.StakeLockTiers does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StakeLockTiers) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v7.StakeLockTiers.tiers":
+		if len(x.Tiers) == 0 {
+			return protoreflect.ValueOfList(&_StakeLockTiers_1_list{})
+		}
+		listValue := &_StakeLockTiers_1_list{list: &x.Tiers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.StakeLockTiers"))
+		}
+		panic(fmt.Errorf("message emissions.v7.StakeLockTiers does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StakeLockTiers) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v7.StakeLockTiers.tiers":
+		lv := value.List()
+		clv := lv.(*_StakeLockTiers_1_list)
+		x.Tiers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.StakeLockTiers"))
+		}
+		panic(fmt.Errorf("message emissions.v7.StakeLockTiers does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StakeLockTiers) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v7.StakeLockTiers.tiers":
+		if x.Tiers == nil {
+			x.Tiers = []*StakeLockTier{}
+		}
+		value := &_StakeLockTiers_1_list{list: &x.Tiers}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.StakeLockTiers"))
+		}
+		panic(fmt.Errorf("message emissions.v7.StakeLockTiers does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StakeLockTiers) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v7.StakeLockTiers.tiers":
+		list := []*StakeLockTier{}
+		return protoreflect.ValueOfList(&_StakeLockTiers_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v7.StakeLockTiers"))
+		}
+		panic(fmt.Errorf("message emissions.v7.StakeLockTiers does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StakeLockTiers) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v7.StakeLockTiers", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StakeLockTiers) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StakeLockTiers) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StakeLockTiers) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StakeLockTiers) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StakeLockTiers)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Tiers) > 0 {
+			for _, e := range x.Tiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StakeLockTiers)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Tiers) > 0 {
+			for iNdEx := len(x.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Tiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StakeLockTiers)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StakeLockTiers: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StakeLockTiers: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tiers = append(x.Tiers, &StakeLockTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tiers[len(x.Tiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: emissions/v7/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the parameters of the module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"` // version of the protocol should be in lockstep with
+	// github release tag version
+	MaxSerializedMsgLength int64  `protobuf:"varint,2,opt,name=max_serialized_msg_length,json=maxSerializedMsgLength,proto3" json:"max_serialized_msg_length,omitempty"` // max length of input data for msg and query server calls
+	MinTopicWeight         string `protobuf:"bytes,3,opt,name=min_topic_weight,json=minTopicWeight,proto3" json:"min_topic_weight,omitempty"`                            // total unmet demand for a topic < this => don't run inference
+	// solicatation or weight-adjustment
+	RequiredMinimumStake   string `protobuf:"bytes,5,opt,name=required_minimum_stake,json=requiredMinimumStake,proto3" json:"required_minimum_stake,omitempty"`          // minimum amount of tokens to send to stake as a reputer or worker
+	RemoveStakeDelayWindow int64  `protobuf:"varint,6,opt,name=remove_stake_delay_window,json=removeStakeDelayWindow,proto3" json:"remove_stake_delay_window,omitempty"` // how long to wait (blocks) before allowed to remove stake
+	MinEpochLength         int64  `protobuf:"varint,7,opt,name=min_epoch_length,json=minEpochLength,proto3" json:"min_epoch_length,omitempty"`                           // fastest allowable topic epoch and cadence of a
+	// repeating inference request
+	BetaEntropy          string `protobuf:"bytes,8,opt,name=beta_entropy,json=betaEntropy,proto3" json:"beta_entropy,omitempty"`                               // controls resilience of reward payouts against copycat workers
+	LearningRate         string `protobuf:"bytes,9,opt,name=learning_rate,json=learningRate,proto3" json:"learning_rate,omitempty"`                            // speed of gradient descent
+	MaxGradientThreshold string `protobuf:"bytes,10,opt,name=max_gradient_threshold,json=maxGradientThreshold,proto3" json:"max_gradient_threshold,omitempty"` // gradient descent stops when gradient falls below this
+	MinStakeFraction     string `protobuf:"bytes,11,opt,name=min_stake_fraction,json=minStakeFraction,proto3" json:"min_stake_fraction,omitempty"`             // minimum fraction of stake to listen to when setting consensus listening
+	// coefficients
+	MaxUnfulfilledWorkerRequests  uint64 `protobuf:"varint,13,opt,name=max_unfulfilled_worker_requests,json=maxUnfulfilledWorkerRequests,proto3" json:"max_unfulfilled_worker_requests,omitempty"`    // max num worker request nonces to keep track of per topic
+	MaxUnfulfilledReputerRequests uint64 `protobuf:"varint,14,opt,name=max_unfulfilled_reputer_requests,json=maxUnfulfilledReputerRequests,proto3" json:"max_unfulfilled_reputer_requests,omitempty"` // max num reputer request nonces to keep track of per topic
+	TopicRewardStakeImportance    string `protobuf:"bytes,15,opt,name=topic_reward_stake_importance,json=topicRewardStakeImportance,proto3" json:"topic_reward_stake_importance,omitempty"`           // The exponent μ represents the importance of stake in the reward of a
+	// topic and has a fiducial value of 0.5
+	TopicRewardFeeRevenueImportance string `protobuf:"bytes,16,opt,name=topic_reward_fee_revenue_importance,json=topicRewardFeeRevenueImportance,proto3" json:"topic_reward_fee_revenue_importance,omitempty"` // The exponent ν represents the importance of fee revenue in the reward of
+	// a topic and has a fiducial value of 0.5
+	TopicRewardAlpha string `protobuf:"bytes,17,opt,name=topic_reward_alpha,json=topicRewardAlpha,proto3" json:"topic_reward_alpha,omitempty"` // global exponential moving average parameter. Fiducial value of 0.9375 on
+	// a monthly timescale, 0.5 for weekly updates
+	TaskRewardAlpha string `protobuf:"bytes,18,opt,name=task_reward_alpha,json=taskRewardAlpha,proto3" json:"task_reward_alpha,omitempty"` // global exponential moving average parameter. Fiducial value of 0.1 used
+	// to calculate ~U_ij, ~V_ik, ~W_im
+	ValidatorsVsAlloraPercentReward string `protobuf:"bytes,19,opt,name=validators_vs_allora_percent_reward,json=validatorsVsAlloraPercentReward,proto3" json:"validators_vs_allora_percent_reward,omitempty"` // percent of total supply rewarded to cosmos network validators, rest goes
+	// to allora reputers workers etc
+	MaxSamplesToScaleScores   uint64 `protobuf:"varint,20,opt,name=max_samples_to_scale_scores,json=maxSamplesToScaleScores,proto3" json:"max_samples_to_scale_scores,omitempty"`       // number of scores to use for standard deviation calculation
+	MaxTopInferersToReward    uint64 `protobuf:"varint,21,opt,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`          // max number of top inferers by score to reward
+	MaxTopForecastersToReward uint64 `protobuf:"varint,22,opt,name=max_top_forecasters_to_reward,json=maxTopForecastersToReward,proto3" json:"max_top_forecasters_to_reward,omitempty"` // max number of top forecasters by score to reward
+	MaxTopReputersToReward    uint64 `protobuf:"varint,23,opt,name=max_top_reputers_to_reward,json=maxTopReputersToReward,proto3" json:"max_top_reputers_to_reward,omitempty"`          // max number of top reputers by score to reward
+	CreateTopicFee            string `protobuf:"bytes,24,opt,name=create_topic_fee,json=createTopicFee,proto3" json:"create_topic_fee,omitempty"`                                       // topic registration fee
+	GradientDescentMaxIters   uint64 `protobuf:"varint,25,opt,name=gradient_descent_max_iters,json=gradientDescentMaxIters,proto3" json:"gradient_descent_max_iters,omitempty"`         // max number of gradient descent iterations
+	RegistrationFee           string `protobuf:"bytes,28,opt,name=registration_fee,json=registrationFee,proto3" json:"registration_fee,omitempty"`                                      // registration fee for reputer or worker
+	DefaultPageLimit          uint64 `protobuf:"varint,29,opt,name=default_page_limit,json=defaultPageLimit,proto3" json:"default_page_limit,omitempty"`                                // default limit for pagination
+	MaxPageLimit              uint64 `protobuf:"varint,30,opt,name=max_page_limit,json=maxPageLimit,proto3" json:"max_page_limit,omitempty"`                                            // max limit for pagination
+	// min number of epochs to keep network losses, reputer losses, inferences,
+	// forecasts
+	MinEpochLengthRecordLimit int64 `protobuf:"varint,31,opt,name=min_epoch_length_record_limit,json=minEpochLengthRecordLimit,proto3" json:"min_epoch_length_record_limit,omitempty"`
+	// block emission rate in number of blocks expected per month
+	BlocksPerMonth   uint64 `protobuf:"varint,32,opt,name=blocks_per_month,json=blocksPerMonth,proto3" json:"blocks_per_month,omitempty"`
+	PRewardInference string `protobuf:"bytes,33,opt,name=p_reward_inference,json=pRewardInference,proto3" json:"p_reward_inference,omitempty"`
 	PRewardForecast  string `protobuf:"bytes,34,opt,name=p_reward_forecast,json=pRewardForecast,proto3" json:"p_reward_forecast,omitempty"`
 	PRewardReputer   string `protobuf:"bytes,35,opt,name=p_reward_reputer,json=pRewardReputer,proto3" json:"p_reward_reputer,omitempty"`
 	CRewardInference string `protobuf:"bytes,36,opt,name=c_reward_inference,json=cRewardInference,proto3" json:"c_reward_inference,omitempty"`
@@ -3564,6 +5180,17 @@ type Params struct {
 	GlobalReputerWhitelistEnabled      bool   `protobuf:"varint,57,opt,name=global_reputer_whitelist_enabled,json=globalReputerWhitelistEnabled,proto3" json:"global_reputer_whitelist_enabled,omitempty"`
 	GlobalAdminWhitelistAppended       bool   `protobuf:"varint,58,opt,name=global_admin_whitelist_appended,json=globalAdminWhitelistAppended,proto3" json:"global_admin_whitelist_appended,omitempty"`
 	MaxWhitelistInputArrayLength       uint64 `protobuf:"varint,59,opt,name=max_whitelist_input_array_length,json=maxWhitelistInputArrayLength,proto3" json:"max_whitelist_input_array_length,omitempty"`
+	MaxArchivedTopicPrunesPerBlock     uint64 `protobuf:"varint,60,opt,name=max_archived_topic_prunes_per_block,json=maxArchivedTopicPrunesPerBlock,proto3" json:"max_archived_topic_prunes_per_block,omitempty"` // maximum number of state entries of archived topics removed per block
+	MaxReputerStakeShare               string `protobuf:"bytes,61,opt,name=max_reputer_stake_share,json=maxReputerStakeShare,proto3" json:"max_reputer_stake_share,omitempty"`
+	StakeAboveCapExponent              string `protobuf:"bytes,62,opt,name=stake_above_cap_exponent,json=stakeAboveCapExponent,proto3" json:"stake_above_cap_exponent,omitempty"`
+	// lockup periods stake can be locked for when added or delegated, with the reward multiplier of each
+	StakeLockTiers                 []*StakeLockTier `protobuf:"bytes,63,rep,name=stake_lock_tiers,json=stakeLockTiers,proto3" json:"stake_lock_tiers,omitempty"`
+	RewardHistoryRetentionBlocks   int64            `protobuf:"varint,64,opt,name=reward_history_retention_blocks,json=rewardHistoryRetentionBlocks,proto3" json:"reward_history_retention_blocks,omitempty"`           // number of blocks rewards are kept in the reward history of actors and topics, zero disables the history
+	MaxRewardPayoutsPerBlock       uint64           `protobuf:"varint,65,opt,name=max_reward_payouts_per_block,json=maxRewardPayoutsPerBlock,proto3" json:"max_reward_payouts_per_block,omitempty"`                     // maximum number of queued rewards paid out per block, zero pays out the whole queue
+	MaxRewardHistoryPrunesPerBlock uint64           `protobuf:"varint,66,opt,name=max_reward_history_prunes_per_block,json=maxRewardHistoryPrunesPerBlock,proto3" json:"max_reward_history_prunes_per_block,omitempty"` // maximum number of records that fell out of the reward history retention removed per block, zero pauses pruning
+	MaxReputerCommissionRate       string           `protobuf:"bytes,67,opt,name=max_reputer_commission_rate,json=maxReputerCommissionRate,proto3" json:"max_reputer_commission_rate,omitempty"`
+	MaxReputerCommissionChangeRate string           `protobuf:"bytes,68,opt,name=max_reputer_commission_change_rate,json=maxReputerCommissionChangeRate,proto3" json:"max_reputer_commission_change_rate,omitempty"`
+	MaxRewardPayoutAttempts        uint64           `protobuf:"varint,69,opt,name=max_reward_payout_attempts,json=maxRewardPayoutAttempts,proto3" json:"max_reward_payout_attempts,omitempty"` // number of times a queued reward fails to be paid out before it is dead-lettered and its coins return to the rewards pool, zero retries without limit
 }
 
 func (x *Params) Reset() {
@@ -3957,6 +5584,156 @@ func (x *Params) GetMaxWhitelistInputArrayLength() uint64 {
 	return 0
 }
 
+func (x *Params) GetMaxArchivedTopicPrunesPerBlock() uint64 {
+	if x != nil {
+		return x.MaxArchivedTopicPrunesPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetMaxReputerStakeShare() string {
+	if x != nil {
+		return x.MaxReputerStakeShare
+	}
+	return ""
+}
+
+func (x *Params) GetStakeAboveCapExponent() string {
+	if x != nil {
+		return x.StakeAboveCapExponent
+	}
+	return ""
+}
+
+func (x *Params) GetStakeLockTiers() []*StakeLockTier {
+	if x != nil {
+		return x.StakeLockTiers
+	}
+	return nil
+}
+
+func (x *Params) GetRewardHistoryRetentionBlocks() int64 {
+	if x != nil {
+		return x.RewardHistoryRetentionBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetMaxRewardPayoutsPerBlock() uint64 {
+	if x != nil {
+		return x.MaxRewardPayoutsPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetMaxRewardHistoryPrunesPerBlock() uint64 {
+	if x != nil {
+		return x.MaxRewardHistoryPrunesPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetMaxReputerCommissionRate() string {
+	if x != nil {
+		return x.MaxReputerCommissionRate
+	}
+	return ""
+}
+
+func (x *Params) GetMaxReputerCommissionChangeRate() string {
+	if x != nil {
+		return x.MaxReputerCommissionChangeRate
+	}
+	return ""
+}
+
+func (x *Params) GetMaxRewardPayoutAttempts() uint64 {
+	if x != nil {
+		return x.MaxRewardPayoutAttempts
+	}
+	return 0
+}
+
+// A lockup period stake can be locked for and the multiplier applied to locked stake when
+// computing reputer reward fractions
+type StakeLockTier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LockMonths       uint64 `protobuf:"varint,1,opt,name=lock_months,json=lockMonths,proto3" json:"lock_months,omitempty"`
+	RewardMultiplier string `protobuf:"bytes,2,opt,name=reward_multiplier,json=rewardMultiplier,proto3" json:"reward_multiplier,omitempty"`
+}
+
+func (x *StakeLockTier) Reset() {
+	*x = StakeLockTier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v7_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakeLockTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakeLockTier) ProtoMessage() {}
+
+// Deprecated: Use StakeLockTier.ProtoReflect.Descriptor instead.
+func (*StakeLockTier) Descriptor() ([]byte, []int) {
+	return file_emissions_v7_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StakeLockTier) GetLockMonths() uint64 {
+	if x != nil {
+		return x.LockMonths
+	}
+	return 0
+}
+
+func (x *StakeLockTier) GetRewardMultiplier() string {
+	if x != nil {
+		return x.RewardMultiplier
+	}
+	return ""
+}
+
+type StakeLockTiers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tiers []*StakeLockTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers,omitempty"`
+}
+
+func (x *StakeLockTiers) Reset() {
+	*x = StakeLockTiers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v7_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakeLockTiers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakeLockTiers) ProtoMessage() {}
+
+// Deprecated: Use StakeLockTiers.ProtoReflect.Descriptor instead.
+func (*StakeLockTiers) Descriptor() ([]byte, []int) {
+	return file_emissions_v7_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StakeLockTiers) GetTiers() []*StakeLockTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
 var File_emissions_v7_params_proto protoreflect.FileDescriptor
 
 var file_emissions_v7_params_proto_rawDesc = []byte{
@@ -3966,7 +5743,7 @@ var file_emissions_v7_params_proto_rawDesc = []byte{
 	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x24,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe1, 0x2b,
 	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
 	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
 	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
@@ -4246,31 +6023,105 @@ var file_emissions_v7_params_proto_rawDesc = []byte{
 	0x74, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x3b,
 	0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1c, 0x6d, 0x61, 0x78,
 	0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x72,
-	0x72, 0x61, 0x79, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a,
-	0x04, 0x08, 0x1a, 0x10, 0x1b, 0x4a, 0x04, 0x08, 0x1b, 0x10, 0x1c, 0x4a, 0x04, 0x08, 0x27, 0x10,
-	0x28, 0x4a, 0x04, 0x08, 0x29, 0x10, 0x2a, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70,
-	0x69, 0x63, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x1b, 0x6d,
-	0x69, 0x6e, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x70,
-	0x69, 0x63, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x23, 0x6d, 0x61, 0x78, 0x5f,
-	0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x75, 0x6c, 0x66, 0x69,
-	0x6c, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52,
-	0x1c, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e,
-	0x75, 0x65, 0x5f, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x52, 0x24, 0x6d,
-	0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x75,
-	0x6c, 0x66, 0x69, 0x6c, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x75,
-	0x74, 0x65, 0x72, 0x42, 0xc1, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x37, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x72, 0x61, 0x79, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x52, 0x0a, 0x23, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f,
+	0x70, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x3c, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1e, 0x6d,
+	0x61, 0x78, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50,
+	0x72, 0x75, 0x6e, 0x65, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x6e, 0x0a,
+	0x17, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x70, 0x0a,
+	0x18, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x61, 0x62, 0x6f, 0x76, 0x65, 0x5f, 0x63, 0x61, 0x70,
+	0x5f, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x15, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x41,
+	0x62, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12,
+	0x4b, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69,
+	0x65, 0x72, 0x73, 0x18, 0x3f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x37, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x6f,
+	0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x1f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x40, 0x20, 0x01, 0x28, 0x03, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1c, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x45, 0x0a, 0x1c, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x41, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x52, 0x0a, 0x23, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x42, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1e, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x76, 0x0a, 0x1b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x43, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x83, 0x01,
+	0x0a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x44, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x1e, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x45, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08,
+	0x1a, 0x10, 0x1b, 0x4a, 0x04, 0x08, 0x1b, 0x10, 0x1c, 0x4a, 0x04, 0x08, 0x27, 0x10, 0x28, 0x4a,
+	0x04, 0x08, 0x29, 0x10, 0x2a, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x1b, 0x6d, 0x69, 0x6e,
+	0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x23, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x5f,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x1c, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x5f, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x52, 0x24, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x75, 0x6c, 0x66,
+	0x69, 0x6c, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65,
+	0x72, 0x22, 0x96, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x54,
+	0x69, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x6f,
+	0x6e, 0x74, 0x68, 0x73, 0x12, 0x64, 0x0a, 0x11, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
 	0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x37, 0x3b, 0x65, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x37, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02,
-	0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x37, 0xca, 0x02, 0x0c,
-	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x37, 0xe2, 0x02, 0x18, 0x45,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x37, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x37, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x10, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0x49, 0x0a, 0x0e, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x37, 0x0a, 0x05,
+	0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x37, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05,
+	0x74, 0x69, 0x65, 0x72, 0x73, 0x42, 0xc1, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x37, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x37, 0x3b, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x37, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58,
+	0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x37, 0xca,
+	0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x37, 0xe2, 0x02,
+	0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x37, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x37, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -4285,16 +6136,20 @@ func file_emissions_v7_params_proto_rawDescGZIP() []byte {
 	return file_emissions_v7_params_proto_rawDescData
 }
 
-var file_emissions_v7_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_emissions_v7_params_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_emissions_v7_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: emissions.v7.Params
+	(*Params)(nil),         // 0: emissions.v7.Params
+	(*StakeLockTier)(nil),  // 1: emissions.v7.StakeLockTier
+	(*StakeLockTiers)(nil), // 2: emissions.v7.StakeLockTiers
 }
 var file_emissions_v7_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: emissions.v7.Params.stake_lock_tiers:type_name -> emissions.v7.StakeLockTier
+	1, // 1: emissions.v7.StakeLockTiers.tiers:type_name -> emissions.v7.StakeLockTier
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_emissions_v7_params_proto_init() }
@@ -4315,6 +6170,30 @@ func file_emissions_v7_params_proto_init() {
 				return nil
 			}
 		}
+		file_emissions_v7_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakeLockTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v7_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakeLockTiers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4322,7 +6201,7 @@ func file_emissions_v7_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v7_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
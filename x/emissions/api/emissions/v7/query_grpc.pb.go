@@ -23,10 +23,13 @@ const (
 	QueryService_GetNextTopicId_FullMethodName                                      = "/emissions.v7.QueryService/GetNextTopicId"
 	QueryService_GetTopic_FullMethodName                                            = "/emissions.v7.QueryService/GetTopic"
 	QueryService_GetWorkerLatestInferenceByTopicId_FullMethodName                   = "/emissions.v7.QueryService/GetWorkerLatestInferenceByTopicId"
+	QueryService_GetWorkerPayloadCommit_FullMethodName                              = "/emissions.v7.QueryService/GetWorkerPayloadCommit"
 	QueryService_GetInferencesAtBlock_FullMethodName                                = "/emissions.v7.QueryService/GetInferencesAtBlock"
 	QueryService_GetLatestTopicInferences_FullMethodName                            = "/emissions.v7.QueryService/GetLatestTopicInferences"
 	QueryService_GetForecastsAtBlock_FullMethodName                                 = "/emissions.v7.QueryService/GetForecastsAtBlock"
 	QueryService_GetNetworkLossBundleAtBlock_FullMethodName                         = "/emissions.v7.QueryService/GetNetworkLossBundleAtBlock"
+	QueryService_GetConsensusGroundTruthAtBlock_FullMethodName                      = "/emissions.v7.QueryService/GetConsensusGroundTruthAtBlock"
+	QueryService_GetReputerGroundTruth_FullMethodName                               = "/emissions.v7.QueryService/GetReputerGroundTruth"
 	QueryService_GetTotalStake_FullMethodName                                       = "/emissions.v7.QueryService/GetTotalStake"
 	QueryService_GetReputerStakeInTopic_FullMethodName                              = "/emissions.v7.QueryService/GetReputerStakeInTopic"
 	QueryService_GetMultiReputerStakeInTopic_FullMethodName                         = "/emissions.v7.QueryService/GetMultiReputerStakeInTopic"
@@ -39,6 +42,20 @@ const (
 	QueryService_GetDelegateStakeRemovalsUpUntilBlock_FullMethodName                = "/emissions.v7.QueryService/GetDelegateStakeRemovalsUpUntilBlock"
 	QueryService_GetStakeRemovalInfo_FullMethodName                                 = "/emissions.v7.QueryService/GetStakeRemovalInfo"
 	QueryService_GetDelegateStakeRemovalInfo_FullMethodName                         = "/emissions.v7.QueryService/GetDelegateStakeRemovalInfo"
+	QueryService_IsAutoCompoundEnabled_FullMethodName                               = "/emissions.v7.QueryService/IsAutoCompoundEnabled"
+	QueryService_GetReputerCommission_FullMethodName                                = "/emissions.v7.QueryService/GetReputerCommission"
+	QueryService_GetStakeLocks_FullMethodName                                       = "/emissions.v7.QueryService/GetStakeLocks"
+	QueryService_SimulateTopicRewards_FullMethodName                                = "/emissions.v7.QueryService/SimulateTopicRewards"
+	QueryService_GetActorRewardHistory_FullMethodName                               = "/emissions.v7.QueryService/GetActorRewardHistory"
+	QueryService_GetTopicRewardHistory_FullMethodName                               = "/emissions.v7.QueryService/GetTopicRewardHistory"
+	QueryService_GetRewardEscrows_FullMethodName                                    = "/emissions.v7.QueryService/GetRewardEscrows"
+	QueryService_GetQueuedRewardPayouts_FullMethodName                              = "/emissions.v7.QueryService/GetQueuedRewardPayouts"
+	QueryService_GetDeadLetteredRewardPayouts_FullMethodName                        = "/emissions.v7.QueryService/GetDeadLetteredRewardPayouts"
+	QueryService_GetTopicWeightBreakdown_FullMethodName                             = "/emissions.v7.QueryService/GetTopicWeightBreakdown"
+	QueryService_GetPendingDelegateRewards_FullMethodName                           = "/emissions.v7.QueryService/GetPendingDelegateRewards"
+	QueryService_GetDelegatorPositions_FullMethodName                               = "/emissions.v7.QueryService/GetDelegatorPositions"
+	QueryService_GetPendingStakeRemovalsForActor_FullMethodName                     = "/emissions.v7.QueryService/GetPendingStakeRemovalsForActor"
+	QueryService_GetActorOperators_FullMethodName                                   = "/emissions.v7.QueryService/GetActorOperators"
 	QueryService_GetWorkerNodeInfo_FullMethodName                                   = "/emissions.v7.QueryService/GetWorkerNodeInfo"
 	QueryService_GetReputerNodeInfo_FullMethodName                                  = "/emissions.v7.QueryService/GetReputerNodeInfo"
 	QueryService_IsWorkerRegisteredInTopicId_FullMethodName                         = "/emissions.v7.QueryService/IsWorkerRegisteredInTopicId"
@@ -67,10 +84,16 @@ const (
 	QueryService_GetDelegateRewardPerShare_FullMethodName                           = "/emissions.v7.QueryService/GetDelegateRewardPerShare"
 	QueryService_GetStakeRemovalForReputerAndTopicId_FullMethodName                 = "/emissions.v7.QueryService/GetStakeRemovalForReputerAndTopicId"
 	QueryService_GetDelegateStakeRemoval_FullMethodName                             = "/emissions.v7.QueryService/GetDelegateStakeRemoval"
+	QueryService_GetWorkerBond_FullMethodName                                       = "/emissions.v7.QueryService/GetWorkerBond"
+	QueryService_GetWorkerBondRemoval_FullMethodName                                = "/emissions.v7.QueryService/GetWorkerBondRemoval"
 	QueryService_GetPreviousTopicWeight_FullMethodName                              = "/emissions.v7.QueryService/GetPreviousTopicWeight"
 	QueryService_GetTotalSumPreviousTopicWeights_FullMethodName                     = "/emissions.v7.QueryService/GetTotalSumPreviousTopicWeights"
 	QueryService_TopicExists_FullMethodName                                         = "/emissions.v7.QueryService/TopicExists"
 	QueryService_IsTopicActive_FullMethodName                                       = "/emissions.v7.QueryService/IsTopicActive"
+	QueryService_IsTopicArchived_FullMethodName                                     = "/emissions.v7.QueryService/IsTopicArchived"
+	QueryService_GetTopicOwner_FullMethodName                                       = "/emissions.v7.QueryService/GetTopicOwner"
+	QueryService_GetPendingTopicOwner_FullMethodName                                = "/emissions.v7.QueryService/GetPendingTopicOwner"
+	QueryService_ListTopics_FullMethodName                                          = "/emissions.v7.QueryService/ListTopics"
 	QueryService_GetTopicFeeRevenue_FullMethodName                                  = "/emissions.v7.QueryService/GetTopicFeeRevenue"
 	QueryService_GetInfererScoreEma_FullMethodName                                  = "/emissions.v7.QueryService/GetInfererScoreEma"
 	QueryService_GetForecasterScoreEma_FullMethodName                               = "/emissions.v7.QueryService/GetForecasterScoreEma"
@@ -137,10 +160,13 @@ type QueryServiceClient interface {
 	GetNextTopicId(ctx context.Context, in *GetNextTopicIdRequest, opts ...grpc.CallOption) (*GetNextTopicIdResponse, error)
 	GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error)
 	GetWorkerLatestInferenceByTopicId(ctx context.Context, in *GetWorkerLatestInferenceByTopicIdRequest, opts ...grpc.CallOption) (*GetWorkerLatestInferenceByTopicIdResponse, error)
+	GetWorkerPayloadCommit(ctx context.Context, in *GetWorkerPayloadCommitRequest, opts ...grpc.CallOption) (*GetWorkerPayloadCommitResponse, error)
 	GetInferencesAtBlock(ctx context.Context, in *GetInferencesAtBlockRequest, opts ...grpc.CallOption) (*GetInferencesAtBlockResponse, error)
 	GetLatestTopicInferences(ctx context.Context, in *GetLatestTopicInferencesRequest, opts ...grpc.CallOption) (*GetLatestTopicInferencesResponse, error)
 	GetForecastsAtBlock(ctx context.Context, in *GetForecastsAtBlockRequest, opts ...grpc.CallOption) (*GetForecastsAtBlockResponse, error)
 	GetNetworkLossBundleAtBlock(ctx context.Context, in *GetNetworkLossBundleAtBlockRequest, opts ...grpc.CallOption) (*GetNetworkLossBundleAtBlockResponse, error)
+	GetConsensusGroundTruthAtBlock(ctx context.Context, in *GetConsensusGroundTruthAtBlockRequest, opts ...grpc.CallOption) (*GetConsensusGroundTruthAtBlockResponse, error)
+	GetReputerGroundTruth(ctx context.Context, in *GetReputerGroundTruthRequest, opts ...grpc.CallOption) (*GetReputerGroundTruthResponse, error)
 	GetTotalStake(ctx context.Context, in *GetTotalStakeRequest, opts ...grpc.CallOption) (*GetTotalStakeResponse, error)
 	GetReputerStakeInTopic(ctx context.Context, in *GetReputerStakeInTopicRequest, opts ...grpc.CallOption) (*GetReputerStakeInTopicResponse, error)
 	GetMultiReputerStakeInTopic(ctx context.Context, in *GetMultiReputerStakeInTopicRequest, opts ...grpc.CallOption) (*GetMultiReputerStakeInTopicResponse, error)
@@ -153,6 +179,23 @@ type QueryServiceClient interface {
 	GetDelegateStakeRemovalsUpUntilBlock(ctx context.Context, in *GetDelegateStakeRemovalsUpUntilBlockRequest, opts ...grpc.CallOption) (*GetDelegateStakeRemovalsUpUntilBlockResponse, error)
 	GetStakeRemovalInfo(ctx context.Context, in *GetStakeRemovalInfoRequest, opts ...grpc.CallOption) (*GetStakeRemovalInfoResponse, error)
 	GetDelegateStakeRemovalInfo(ctx context.Context, in *GetDelegateStakeRemovalInfoRequest, opts ...grpc.CallOption) (*GetDelegateStakeRemovalInfoResponse, error)
+	IsAutoCompoundEnabled(ctx context.Context, in *IsAutoCompoundEnabledRequest, opts ...grpc.CallOption) (*IsAutoCompoundEnabledResponse, error)
+	GetReputerCommission(ctx context.Context, in *GetReputerCommissionRequest, opts ...grpc.CallOption) (*GetReputerCommissionResponse, error)
+	GetStakeLocks(ctx context.Context, in *GetStakeLocksRequest, opts ...grpc.CallOption) (*GetStakeLocksResponse, error)
+	// Runs the reward pipeline of a topic epoch on a discarded branch of state, paying out a
+	// hypothetical topic reward, to inspect how it would be distributed
+	SimulateTopicRewards(ctx context.Context, in *SimulateTopicRewardsRequest, opts ...grpc.CallOption) (*SimulateTopicRewardsResponse, error)
+	GetActorRewardHistory(ctx context.Context, in *GetActorRewardHistoryRequest, opts ...grpc.CallOption) (*GetActorRewardHistoryResponse, error)
+	GetTopicRewardHistory(ctx context.Context, in *GetTopicRewardHistoryRequest, opts ...grpc.CallOption) (*GetTopicRewardHistoryResponse, error)
+	GetRewardEscrows(ctx context.Context, in *GetRewardEscrowsRequest, opts ...grpc.CallOption) (*GetRewardEscrowsResponse, error)
+	GetQueuedRewardPayouts(ctx context.Context, in *GetQueuedRewardPayoutsRequest, opts ...grpc.CallOption) (*GetQueuedRewardPayoutsResponse, error)
+	GetDeadLetteredRewardPayouts(ctx context.Context, in *GetDeadLetteredRewardPayoutsRequest, opts ...grpc.CallOption) (*GetDeadLetteredRewardPayoutsResponse, error)
+	// Explains the weight of a topic and whether it would stay active at its next churning block
+	GetTopicWeightBreakdown(ctx context.Context, in *GetTopicWeightBreakdownRequest, opts ...grpc.CallOption) (*GetTopicWeightBreakdownResponse, error)
+	GetPendingDelegateRewards(ctx context.Context, in *GetPendingDelegateRewardsRequest, opts ...grpc.CallOption) (*GetPendingDelegateRewardsResponse, error)
+	GetDelegatorPositions(ctx context.Context, in *GetDelegatorPositionsRequest, opts ...grpc.CallOption) (*GetDelegatorPositionsResponse, error)
+	GetPendingStakeRemovalsForActor(ctx context.Context, in *GetPendingStakeRemovalsForActorRequest, opts ...grpc.CallOption) (*GetPendingStakeRemovalsForActorResponse, error)
+	GetActorOperators(ctx context.Context, in *GetActorOperatorsRequest, opts ...grpc.CallOption) (*GetActorOperatorsResponse, error)
 	GetWorkerNodeInfo(ctx context.Context, in *GetWorkerNodeInfoRequest, opts ...grpc.CallOption) (*GetWorkerNodeInfoResponse, error)
 	GetReputerNodeInfo(ctx context.Context, in *GetReputerNodeInfoRequest, opts ...grpc.CallOption) (*GetReputerNodeInfoResponse, error)
 	IsWorkerRegisteredInTopicId(ctx context.Context, in *IsWorkerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*IsWorkerRegisteredInTopicIdResponse, error)
@@ -181,10 +224,16 @@ type QueryServiceClient interface {
 	GetDelegateRewardPerShare(ctx context.Context, in *GetDelegateRewardPerShareRequest, opts ...grpc.CallOption) (*GetDelegateRewardPerShareResponse, error)
 	GetStakeRemovalForReputerAndTopicId(ctx context.Context, in *GetStakeRemovalForReputerAndTopicIdRequest, opts ...grpc.CallOption) (*GetStakeRemovalForReputerAndTopicIdResponse, error)
 	GetDelegateStakeRemoval(ctx context.Context, in *GetDelegateStakeRemovalRequest, opts ...grpc.CallOption) (*GetDelegateStakeRemovalResponse, error)
+	GetWorkerBond(ctx context.Context, in *GetWorkerBondRequest, opts ...grpc.CallOption) (*GetWorkerBondResponse, error)
+	GetWorkerBondRemoval(ctx context.Context, in *GetWorkerBondRemovalRequest, opts ...grpc.CallOption) (*GetWorkerBondRemovalResponse, error)
 	GetPreviousTopicWeight(ctx context.Context, in *GetPreviousTopicWeightRequest, opts ...grpc.CallOption) (*GetPreviousTopicWeightResponse, error)
 	GetTotalSumPreviousTopicWeights(ctx context.Context, in *GetTotalSumPreviousTopicWeightsRequest, opts ...grpc.CallOption) (*GetTotalSumPreviousTopicWeightsResponse, error)
 	TopicExists(ctx context.Context, in *TopicExistsRequest, opts ...grpc.CallOption) (*TopicExistsResponse, error)
 	IsTopicActive(ctx context.Context, in *IsTopicActiveRequest, opts ...grpc.CallOption) (*IsTopicActiveResponse, error)
+	IsTopicArchived(ctx context.Context, in *IsTopicArchivedRequest, opts ...grpc.CallOption) (*IsTopicArchivedResponse, error)
+	GetTopicOwner(ctx context.Context, in *GetTopicOwnerRequest, opts ...grpc.CallOption) (*GetTopicOwnerResponse, error)
+	GetPendingTopicOwner(ctx context.Context, in *GetPendingTopicOwnerRequest, opts ...grpc.CallOption) (*GetPendingTopicOwnerResponse, error)
+	ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error)
 	GetTopicFeeRevenue(ctx context.Context, in *GetTopicFeeRevenueRequest, opts ...grpc.CallOption) (*GetTopicFeeRevenueResponse, error)
 	GetInfererScoreEma(ctx context.Context, in *GetInfererScoreEmaRequest, opts ...grpc.CallOption) (*GetInfererScoreEmaResponse, error)
 	GetForecasterScoreEma(ctx context.Context, in *GetForecasterScoreEmaRequest, opts ...grpc.CallOption) (*GetForecasterScoreEmaResponse, error)
@@ -291,6 +340,16 @@ func (c *queryServiceClient) GetWorkerLatestInferenceByTopicId(ctx context.Conte
 	return out, nil
 }
 
+func (c *queryServiceClient) GetWorkerPayloadCommit(ctx context.Context, in *GetWorkerPayloadCommitRequest, opts ...grpc.CallOption) (*GetWorkerPayloadCommitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkerPayloadCommitResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetWorkerPayloadCommit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) GetInferencesAtBlock(ctx context.Context, in *GetInferencesAtBlockRequest, opts ...grpc.CallOption) (*GetInferencesAtBlockResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetInferencesAtBlockResponse)
@@ -331,6 +390,26 @@ func (c *queryServiceClient) GetNetworkLossBundleAtBlock(ctx context.Context, in
 	return out, nil
 }
 
+func (c *queryServiceClient) GetConsensusGroundTruthAtBlock(ctx context.Context, in *GetConsensusGroundTruthAtBlockRequest, opts ...grpc.CallOption) (*GetConsensusGroundTruthAtBlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConsensusGroundTruthAtBlockResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetConsensusGroundTruthAtBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetReputerGroundTruth(ctx context.Context, in *GetReputerGroundTruthRequest, opts ...grpc.CallOption) (*GetReputerGroundTruthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReputerGroundTruthResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetReputerGroundTruth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) GetTotalStake(ctx context.Context, in *GetTotalStakeRequest, opts ...grpc.CallOption) (*GetTotalStakeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTotalStakeResponse)
@@ -451,6 +530,146 @@ func (c *queryServiceClient) GetDelegateStakeRemovalInfo(ctx context.Context, in
 	return out, nil
 }
 
+func (c *queryServiceClient) IsAutoCompoundEnabled(ctx context.Context, in *IsAutoCompoundEnabledRequest, opts ...grpc.CallOption) (*IsAutoCompoundEnabledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsAutoCompoundEnabledResponse)
+	err := c.cc.Invoke(ctx, QueryService_IsAutoCompoundEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetReputerCommission(ctx context.Context, in *GetReputerCommissionRequest, opts ...grpc.CallOption) (*GetReputerCommissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReputerCommissionResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetReputerCommission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetStakeLocks(ctx context.Context, in *GetStakeLocksRequest, opts ...grpc.CallOption) (*GetStakeLocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStakeLocksResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetStakeLocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) SimulateTopicRewards(ctx context.Context, in *SimulateTopicRewardsRequest, opts ...grpc.CallOption) (*SimulateTopicRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateTopicRewardsResponse)
+	err := c.cc.Invoke(ctx, QueryService_SimulateTopicRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetActorRewardHistory(ctx context.Context, in *GetActorRewardHistoryRequest, opts ...grpc.CallOption) (*GetActorRewardHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActorRewardHistoryResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetActorRewardHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetTopicRewardHistory(ctx context.Context, in *GetTopicRewardHistoryRequest, opts ...grpc.CallOption) (*GetTopicRewardHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicRewardHistoryResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetTopicRewardHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetRewardEscrows(ctx context.Context, in *GetRewardEscrowsRequest, opts ...grpc.CallOption) (*GetRewardEscrowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRewardEscrowsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetRewardEscrows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetQueuedRewardPayouts(ctx context.Context, in *GetQueuedRewardPayoutsRequest, opts ...grpc.CallOption) (*GetQueuedRewardPayoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQueuedRewardPayoutsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetQueuedRewardPayouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetDeadLetteredRewardPayouts(ctx context.Context, in *GetDeadLetteredRewardPayoutsRequest, opts ...grpc.CallOption) (*GetDeadLetteredRewardPayoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeadLetteredRewardPayoutsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetDeadLetteredRewardPayouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetTopicWeightBreakdown(ctx context.Context, in *GetTopicWeightBreakdownRequest, opts ...grpc.CallOption) (*GetTopicWeightBreakdownResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicWeightBreakdownResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetTopicWeightBreakdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetPendingDelegateRewards(ctx context.Context, in *GetPendingDelegateRewardsRequest, opts ...grpc.CallOption) (*GetPendingDelegateRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPendingDelegateRewardsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetPendingDelegateRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetDelegatorPositions(ctx context.Context, in *GetDelegatorPositionsRequest, opts ...grpc.CallOption) (*GetDelegatorPositionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDelegatorPositionsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetDelegatorPositions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetPendingStakeRemovalsForActor(ctx context.Context, in *GetPendingStakeRemovalsForActorRequest, opts ...grpc.CallOption) (*GetPendingStakeRemovalsForActorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPendingStakeRemovalsForActorResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetPendingStakeRemovalsForActor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetActorOperators(ctx context.Context, in *GetActorOperatorsRequest, opts ...grpc.CallOption) (*GetActorOperatorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActorOperatorsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetActorOperators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) GetWorkerNodeInfo(ctx context.Context, in *GetWorkerNodeInfoRequest, opts ...grpc.CallOption) (*GetWorkerNodeInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetWorkerNodeInfoResponse)
@@ -731,6 +950,26 @@ func (c *queryServiceClient) GetDelegateStakeRemoval(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *queryServiceClient) GetWorkerBond(ctx context.Context, in *GetWorkerBondRequest, opts ...grpc.CallOption) (*GetWorkerBondResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkerBondResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetWorkerBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetWorkerBondRemoval(ctx context.Context, in *GetWorkerBondRemovalRequest, opts ...grpc.CallOption) (*GetWorkerBondRemovalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkerBondRemovalResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetWorkerBondRemoval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) GetPreviousTopicWeight(ctx context.Context, in *GetPreviousTopicWeightRequest, opts ...grpc.CallOption) (*GetPreviousTopicWeightResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPreviousTopicWeightResponse)
@@ -771,6 +1010,46 @@ func (c *queryServiceClient) IsTopicActive(ctx context.Context, in *IsTopicActiv
 	return out, nil
 }
 
+func (c *queryServiceClient) IsTopicArchived(ctx context.Context, in *IsTopicArchivedRequest, opts ...grpc.CallOption) (*IsTopicArchivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsTopicArchivedResponse)
+	err := c.cc.Invoke(ctx, QueryService_IsTopicArchived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetTopicOwner(ctx context.Context, in *GetTopicOwnerRequest, opts ...grpc.CallOption) (*GetTopicOwnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicOwnerResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetTopicOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetPendingTopicOwner(ctx context.Context, in *GetPendingTopicOwnerRequest, opts ...grpc.CallOption) (*GetPendingTopicOwnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPendingTopicOwnerResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetPendingTopicOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTopicsResponse)
+	err := c.cc.Invoke(ctx, QueryService_ListTopics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) GetTopicFeeRevenue(ctx context.Context, in *GetTopicFeeRevenueRequest, opts ...grpc.CallOption) (*GetTopicFeeRevenueResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTopicFeeRevenueResponse)
@@ -1312,10 +1591,13 @@ type QueryServiceServer interface {
 	GetNextTopicId(context.Context, *GetNextTopicIdRequest) (*GetNextTopicIdResponse, error)
 	GetTopic(context.Context, *GetTopicRequest) (*GetTopicResponse, error)
 	GetWorkerLatestInferenceByTopicId(context.Context, *GetWorkerLatestInferenceByTopicIdRequest) (*GetWorkerLatestInferenceByTopicIdResponse, error)
+	GetWorkerPayloadCommit(context.Context, *GetWorkerPayloadCommitRequest) (*GetWorkerPayloadCommitResponse, error)
 	GetInferencesAtBlock(context.Context, *GetInferencesAtBlockRequest) (*GetInferencesAtBlockResponse, error)
 	GetLatestTopicInferences(context.Context, *GetLatestTopicInferencesRequest) (*GetLatestTopicInferencesResponse, error)
 	GetForecastsAtBlock(context.Context, *GetForecastsAtBlockRequest) (*GetForecastsAtBlockResponse, error)
 	GetNetworkLossBundleAtBlock(context.Context, *GetNetworkLossBundleAtBlockRequest) (*GetNetworkLossBundleAtBlockResponse, error)
+	GetConsensusGroundTruthAtBlock(context.Context, *GetConsensusGroundTruthAtBlockRequest) (*GetConsensusGroundTruthAtBlockResponse, error)
+	GetReputerGroundTruth(context.Context, *GetReputerGroundTruthRequest) (*GetReputerGroundTruthResponse, error)
 	GetTotalStake(context.Context, *GetTotalStakeRequest) (*GetTotalStakeResponse, error)
 	GetReputerStakeInTopic(context.Context, *GetReputerStakeInTopicRequest) (*GetReputerStakeInTopicResponse, error)
 	GetMultiReputerStakeInTopic(context.Context, *GetMultiReputerStakeInTopicRequest) (*GetMultiReputerStakeInTopicResponse, error)
@@ -1328,6 +1610,23 @@ type QueryServiceServer interface {
 	GetDelegateStakeRemovalsUpUntilBlock(context.Context, *GetDelegateStakeRemovalsUpUntilBlockRequest) (*GetDelegateStakeRemovalsUpUntilBlockResponse, error)
 	GetStakeRemovalInfo(context.Context, *GetStakeRemovalInfoRequest) (*GetStakeRemovalInfoResponse, error)
 	GetDelegateStakeRemovalInfo(context.Context, *GetDelegateStakeRemovalInfoRequest) (*GetDelegateStakeRemovalInfoResponse, error)
+	IsAutoCompoundEnabled(context.Context, *IsAutoCompoundEnabledRequest) (*IsAutoCompoundEnabledResponse, error)
+	GetReputerCommission(context.Context, *GetReputerCommissionRequest) (*GetReputerCommissionResponse, error)
+	GetStakeLocks(context.Context, *GetStakeLocksRequest) (*GetStakeLocksResponse, error)
+	// Runs the reward pipeline of a topic epoch on a discarded branch of state, paying out a
+	// hypothetical topic reward, to inspect how it would be distributed
+	SimulateTopicRewards(context.Context, *SimulateTopicRewardsRequest) (*SimulateTopicRewardsResponse, error)
+	GetActorRewardHistory(context.Context, *GetActorRewardHistoryRequest) (*GetActorRewardHistoryResponse, error)
+	GetTopicRewardHistory(context.Context, *GetTopicRewardHistoryRequest) (*GetTopicRewardHistoryResponse, error)
+	GetRewardEscrows(context.Context, *GetRewardEscrowsRequest) (*GetRewardEscrowsResponse, error)
+	GetQueuedRewardPayouts(context.Context, *GetQueuedRewardPayoutsRequest) (*GetQueuedRewardPayoutsResponse, error)
+	GetDeadLetteredRewardPayouts(context.Context, *GetDeadLetteredRewardPayoutsRequest) (*GetDeadLetteredRewardPayoutsResponse, error)
+	// Explains the weight of a topic and whether it would stay active at its next churning block
+	GetTopicWeightBreakdown(context.Context, *GetTopicWeightBreakdownRequest) (*GetTopicWeightBreakdownResponse, error)
+	GetPendingDelegateRewards(context.Context, *GetPendingDelegateRewardsRequest) (*GetPendingDelegateRewardsResponse, error)
+	GetDelegatorPositions(context.Context, *GetDelegatorPositionsRequest) (*GetDelegatorPositionsResponse, error)
+	GetPendingStakeRemovalsForActor(context.Context, *GetPendingStakeRemovalsForActorRequest) (*GetPendingStakeRemovalsForActorResponse, error)
+	GetActorOperators(context.Context, *GetActorOperatorsRequest) (*GetActorOperatorsResponse, error)
 	GetWorkerNodeInfo(context.Context, *GetWorkerNodeInfoRequest) (*GetWorkerNodeInfoResponse, error)
 	GetReputerNodeInfo(context.Context, *GetReputerNodeInfoRequest) (*GetReputerNodeInfoResponse, error)
 	IsWorkerRegisteredInTopicId(context.Context, *IsWorkerRegisteredInTopicIdRequest) (*IsWorkerRegisteredInTopicIdResponse, error)
@@ -1356,10 +1655,16 @@ type QueryServiceServer interface {
 	GetDelegateRewardPerShare(context.Context, *GetDelegateRewardPerShareRequest) (*GetDelegateRewardPerShareResponse, error)
 	GetStakeRemovalForReputerAndTopicId(context.Context, *GetStakeRemovalForReputerAndTopicIdRequest) (*GetStakeRemovalForReputerAndTopicIdResponse, error)
 	GetDelegateStakeRemoval(context.Context, *GetDelegateStakeRemovalRequest) (*GetDelegateStakeRemovalResponse, error)
+	GetWorkerBond(context.Context, *GetWorkerBondRequest) (*GetWorkerBondResponse, error)
+	GetWorkerBondRemoval(context.Context, *GetWorkerBondRemovalRequest) (*GetWorkerBondRemovalResponse, error)
 	GetPreviousTopicWeight(context.Context, *GetPreviousTopicWeightRequest) (*GetPreviousTopicWeightResponse, error)
 	GetTotalSumPreviousTopicWeights(context.Context, *GetTotalSumPreviousTopicWeightsRequest) (*GetTotalSumPreviousTopicWeightsResponse, error)
 	TopicExists(context.Context, *TopicExistsRequest) (*TopicExistsResponse, error)
 	IsTopicActive(context.Context, *IsTopicActiveRequest) (*IsTopicActiveResponse, error)
+	IsTopicArchived(context.Context, *IsTopicArchivedRequest) (*IsTopicArchivedResponse, error)
+	GetTopicOwner(context.Context, *GetTopicOwnerRequest) (*GetTopicOwnerResponse, error)
+	GetPendingTopicOwner(context.Context, *GetPendingTopicOwnerRequest) (*GetPendingTopicOwnerResponse, error)
+	ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error)
 	GetTopicFeeRevenue(context.Context, *GetTopicFeeRevenueRequest) (*GetTopicFeeRevenueResponse, error)
 	GetInfererScoreEma(context.Context, *GetInfererScoreEmaRequest) (*GetInfererScoreEmaResponse, error)
 	GetForecasterScoreEma(context.Context, *GetForecasterScoreEmaRequest) (*GetForecasterScoreEmaResponse, error)
@@ -1438,6 +1743,9 @@ func (UnimplementedQueryServiceServer) GetTopic(context.Context, *GetTopicReques
 func (UnimplementedQueryServiceServer) GetWorkerLatestInferenceByTopicId(context.Context, *GetWorkerLatestInferenceByTopicIdRequest) (*GetWorkerLatestInferenceByTopicIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerLatestInferenceByTopicId not implemented")
 }
+func (UnimplementedQueryServiceServer) GetWorkerPayloadCommit(context.Context, *GetWorkerPayloadCommitRequest) (*GetWorkerPayloadCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerPayloadCommit not implemented")
+}
 func (UnimplementedQueryServiceServer) GetInferencesAtBlock(context.Context, *GetInferencesAtBlockRequest) (*GetInferencesAtBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInferencesAtBlock not implemented")
 }
@@ -1450,6 +1758,12 @@ func (UnimplementedQueryServiceServer) GetForecastsAtBlock(context.Context, *Get
 func (UnimplementedQueryServiceServer) GetNetworkLossBundleAtBlock(context.Context, *GetNetworkLossBundleAtBlockRequest) (*GetNetworkLossBundleAtBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkLossBundleAtBlock not implemented")
 }
+func (UnimplementedQueryServiceServer) GetConsensusGroundTruthAtBlock(context.Context, *GetConsensusGroundTruthAtBlockRequest) (*GetConsensusGroundTruthAtBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsensusGroundTruthAtBlock not implemented")
+}
+func (UnimplementedQueryServiceServer) GetReputerGroundTruth(context.Context, *GetReputerGroundTruthRequest) (*GetReputerGroundTruthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerGroundTruth not implemented")
+}
 func (UnimplementedQueryServiceServer) GetTotalStake(context.Context, *GetTotalStakeRequest) (*GetTotalStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTotalStake not implemented")
 }
@@ -1486,6 +1800,48 @@ func (UnimplementedQueryServiceServer) GetStakeRemovalInfo(context.Context, *Get
 func (UnimplementedQueryServiceServer) GetDelegateStakeRemovalInfo(context.Context, *GetDelegateStakeRemovalInfoRequest) (*GetDelegateStakeRemovalInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateStakeRemovalInfo not implemented")
 }
+func (UnimplementedQueryServiceServer) IsAutoCompoundEnabled(context.Context, *IsAutoCompoundEnabledRequest) (*IsAutoCompoundEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAutoCompoundEnabled not implemented")
+}
+func (UnimplementedQueryServiceServer) GetReputerCommission(context.Context, *GetReputerCommissionRequest) (*GetReputerCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerCommission not implemented")
+}
+func (UnimplementedQueryServiceServer) GetStakeLocks(context.Context, *GetStakeLocksRequest) (*GetStakeLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStakeLocks not implemented")
+}
+func (UnimplementedQueryServiceServer) SimulateTopicRewards(context.Context, *SimulateTopicRewardsRequest) (*SimulateTopicRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTopicRewards not implemented")
+}
+func (UnimplementedQueryServiceServer) GetActorRewardHistory(context.Context, *GetActorRewardHistoryRequest) (*GetActorRewardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActorRewardHistory not implemented")
+}
+func (UnimplementedQueryServiceServer) GetTopicRewardHistory(context.Context, *GetTopicRewardHistoryRequest) (*GetTopicRewardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicRewardHistory not implemented")
+}
+func (UnimplementedQueryServiceServer) GetRewardEscrows(context.Context, *GetRewardEscrowsRequest) (*GetRewardEscrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRewardEscrows not implemented")
+}
+func (UnimplementedQueryServiceServer) GetQueuedRewardPayouts(context.Context, *GetQueuedRewardPayoutsRequest) (*GetQueuedRewardPayoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueuedRewardPayouts not implemented")
+}
+func (UnimplementedQueryServiceServer) GetDeadLetteredRewardPayouts(context.Context, *GetDeadLetteredRewardPayoutsRequest) (*GetDeadLetteredRewardPayoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetteredRewardPayouts not implemented")
+}
+func (UnimplementedQueryServiceServer) GetTopicWeightBreakdown(context.Context, *GetTopicWeightBreakdownRequest) (*GetTopicWeightBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicWeightBreakdown not implemented")
+}
+func (UnimplementedQueryServiceServer) GetPendingDelegateRewards(context.Context, *GetPendingDelegateRewardsRequest) (*GetPendingDelegateRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingDelegateRewards not implemented")
+}
+func (UnimplementedQueryServiceServer) GetDelegatorPositions(context.Context, *GetDelegatorPositionsRequest) (*GetDelegatorPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegatorPositions not implemented")
+}
+func (UnimplementedQueryServiceServer) GetPendingStakeRemovalsForActor(context.Context, *GetPendingStakeRemovalsForActorRequest) (*GetPendingStakeRemovalsForActorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingStakeRemovalsForActor not implemented")
+}
+func (UnimplementedQueryServiceServer) GetActorOperators(context.Context, *GetActorOperatorsRequest) (*GetActorOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActorOperators not implemented")
+}
 func (UnimplementedQueryServiceServer) GetWorkerNodeInfo(context.Context, *GetWorkerNodeInfoRequest) (*GetWorkerNodeInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerNodeInfo not implemented")
 }
@@ -1570,6 +1926,12 @@ func (UnimplementedQueryServiceServer) GetStakeRemovalForReputerAndTopicId(conte
 func (UnimplementedQueryServiceServer) GetDelegateStakeRemoval(context.Context, *GetDelegateStakeRemovalRequest) (*GetDelegateStakeRemovalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateStakeRemoval not implemented")
 }
+func (UnimplementedQueryServiceServer) GetWorkerBond(context.Context, *GetWorkerBondRequest) (*GetWorkerBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerBond not implemented")
+}
+func (UnimplementedQueryServiceServer) GetWorkerBondRemoval(context.Context, *GetWorkerBondRemovalRequest) (*GetWorkerBondRemovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerBondRemoval not implemented")
+}
 func (UnimplementedQueryServiceServer) GetPreviousTopicWeight(context.Context, *GetPreviousTopicWeightRequest) (*GetPreviousTopicWeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPreviousTopicWeight not implemented")
 }
@@ -1582,6 +1944,18 @@ func (UnimplementedQueryServiceServer) TopicExists(context.Context, *TopicExists
 func (UnimplementedQueryServiceServer) IsTopicActive(context.Context, *IsTopicActiveRequest) (*IsTopicActiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsTopicActive not implemented")
 }
+func (UnimplementedQueryServiceServer) IsTopicArchived(context.Context, *IsTopicArchivedRequest) (*IsTopicArchivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsTopicArchived not implemented")
+}
+func (UnimplementedQueryServiceServer) GetTopicOwner(context.Context, *GetTopicOwnerRequest) (*GetTopicOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicOwner not implemented")
+}
+func (UnimplementedQueryServiceServer) GetPendingTopicOwner(context.Context, *GetPendingTopicOwnerRequest) (*GetPendingTopicOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingTopicOwner not implemented")
+}
+func (UnimplementedQueryServiceServer) ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopics not implemented")
+}
 func (UnimplementedQueryServiceServer) GetTopicFeeRevenue(context.Context, *GetTopicFeeRevenueRequest) (*GetTopicFeeRevenueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopicFeeRevenue not implemented")
 }
@@ -1759,365 +2133,671 @@ func RegisterQueryServiceServer(s grpc.ServiceRegistrar, srv QueryServiceServer)
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&QueryService_ServiceDesc, srv)
+	s.RegisterService(&QueryService_ServiceDesc, srv)
+}
+
+func _QueryService_GetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetParams(ctx, req.(*GetParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetNextTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextTopicIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetNextTopicId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetNextTopicId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetNextTopicId(ctx, req.(*GetNextTopicIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetTopic(ctx, req.(*GetTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetWorkerLatestInferenceByTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerLatestInferenceByTopicIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetWorkerLatestInferenceByTopicId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetWorkerLatestInferenceByTopicId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetWorkerLatestInferenceByTopicId(ctx, req.(*GetWorkerLatestInferenceByTopicIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetWorkerPayloadCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerPayloadCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetWorkerPayloadCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetWorkerPayloadCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetWorkerPayloadCommit(ctx, req.(*GetWorkerPayloadCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetInferencesAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInferencesAtBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetInferencesAtBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetInferencesAtBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetInferencesAtBlock(ctx, req.(*GetInferencesAtBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetLatestTopicInferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestTopicInferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetLatestTopicInferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetLatestTopicInferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetLatestTopicInferences(ctx, req.(*GetLatestTopicInferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetForecastsAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForecastsAtBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetForecastsAtBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetForecastsAtBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetForecastsAtBlock(ctx, req.(*GetForecastsAtBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetNetworkLossBundleAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkLossBundleAtBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetNetworkLossBundleAtBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetNetworkLossBundleAtBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetNetworkLossBundleAtBlock(ctx, req.(*GetNetworkLossBundleAtBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetConsensusGroundTruthAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsensusGroundTruthAtBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetConsensusGroundTruthAtBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetConsensusGroundTruthAtBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetConsensusGroundTruthAtBlock(ctx, req.(*GetConsensusGroundTruthAtBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetReputerGroundTruth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReputerGroundTruthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetReputerGroundTruth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetReputerGroundTruth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetReputerGroundTruth(ctx, req.(*GetReputerGroundTruthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetTotalStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTotalStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetTotalStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetTotalStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetTotalStake(ctx, req.(*GetTotalStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetReputerStakeInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReputerStakeInTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetReputerStakeInTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetReputerStakeInTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetReputerStakeInTopic(ctx, req.(*GetReputerStakeInTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetMultiReputerStakeInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMultiReputerStakeInTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetMultiReputerStakeInTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetMultiReputerStakeInTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetMultiReputerStakeInTopic(ctx, req.(*GetMultiReputerStakeInTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetStakeFromReputerInTopicInSelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStakeFromReputerInTopicInSelfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetStakeFromReputerInTopicInSelf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetStakeFromReputerInTopicInSelf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetStakeFromReputerInTopicInSelf(ctx, req.(*GetStakeFromReputerInTopicInSelfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetDelegateStakeInTopicInReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDelegateStakeInTopicInReputerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetDelegateStakeInTopicInReputer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetDelegateStakeInTopicInReputer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetDelegateStakeInTopicInReputer(ctx, req.(*GetDelegateStakeInTopicInReputerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetStakeFromDelegatorInTopicInReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStakeFromDelegatorInTopicInReputerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetStakeFromDelegatorInTopicInReputer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetStakeFromDelegatorInTopicInReputer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetStakeFromDelegatorInTopicInReputer(ctx, req.(*GetStakeFromDelegatorInTopicInReputerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetParamsRequest)
+func _QueryService_GetStakeFromDelegatorInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStakeFromDelegatorInTopicRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetParams(ctx, in)
+		return srv.(QueryServiceServer).GetStakeFromDelegatorInTopic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetParams_FullMethodName,
+		FullMethod: QueryService_GetStakeFromDelegatorInTopic_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetParams(ctx, req.(*GetParamsRequest))
+		return srv.(QueryServiceServer).GetStakeFromDelegatorInTopic(ctx, req.(*GetStakeFromDelegatorInTopicRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetNextTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNextTopicIdRequest)
+func _QueryService_GetTopicStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicStakeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetNextTopicId(ctx, in)
+		return srv.(QueryServiceServer).GetTopicStake(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetNextTopicId_FullMethodName,
+		FullMethod: QueryService_GetTopicStake_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetNextTopicId(ctx, req.(*GetNextTopicIdRequest))
+		return srv.(QueryServiceServer).GetTopicStake(ctx, req.(*GetTopicStakeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTopicRequest)
+func _QueryService_GetStakeRemovalsUpUntilBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStakeRemovalsUpUntilBlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetTopic(ctx, in)
+		return srv.(QueryServiceServer).GetStakeRemovalsUpUntilBlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetTopic_FullMethodName,
+		FullMethod: QueryService_GetStakeRemovalsUpUntilBlock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetTopic(ctx, req.(*GetTopicRequest))
+		return srv.(QueryServiceServer).GetStakeRemovalsUpUntilBlock(ctx, req.(*GetStakeRemovalsUpUntilBlockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetWorkerLatestInferenceByTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkerLatestInferenceByTopicIdRequest)
+func _QueryService_GetDelegateStakeRemovalsUpUntilBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDelegateStakeRemovalsUpUntilBlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetWorkerLatestInferenceByTopicId(ctx, in)
+		return srv.(QueryServiceServer).GetDelegateStakeRemovalsUpUntilBlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetWorkerLatestInferenceByTopicId_FullMethodName,
+		FullMethod: QueryService_GetDelegateStakeRemovalsUpUntilBlock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetWorkerLatestInferenceByTopicId(ctx, req.(*GetWorkerLatestInferenceByTopicIdRequest))
+		return srv.(QueryServiceServer).GetDelegateStakeRemovalsUpUntilBlock(ctx, req.(*GetDelegateStakeRemovalsUpUntilBlockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetInferencesAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInferencesAtBlockRequest)
+func _QueryService_GetStakeRemovalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStakeRemovalInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetInferencesAtBlock(ctx, in)
+		return srv.(QueryServiceServer).GetStakeRemovalInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetInferencesAtBlock_FullMethodName,
+		FullMethod: QueryService_GetStakeRemovalInfo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetInferencesAtBlock(ctx, req.(*GetInferencesAtBlockRequest))
+		return srv.(QueryServiceServer).GetStakeRemovalInfo(ctx, req.(*GetStakeRemovalInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetLatestTopicInferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLatestTopicInferencesRequest)
+func _QueryService_GetDelegateStakeRemovalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDelegateStakeRemovalInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetLatestTopicInferences(ctx, in)
+		return srv.(QueryServiceServer).GetDelegateStakeRemovalInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetLatestTopicInferences_FullMethodName,
+		FullMethod: QueryService_GetDelegateStakeRemovalInfo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetLatestTopicInferences(ctx, req.(*GetLatestTopicInferencesRequest))
+		return srv.(QueryServiceServer).GetDelegateStakeRemovalInfo(ctx, req.(*GetDelegateStakeRemovalInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetForecastsAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForecastsAtBlockRequest)
+func _QueryService_IsAutoCompoundEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsAutoCompoundEnabledRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetForecastsAtBlock(ctx, in)
+		return srv.(QueryServiceServer).IsAutoCompoundEnabled(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetForecastsAtBlock_FullMethodName,
+		FullMethod: QueryService_IsAutoCompoundEnabled_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetForecastsAtBlock(ctx, req.(*GetForecastsAtBlockRequest))
+		return srv.(QueryServiceServer).IsAutoCompoundEnabled(ctx, req.(*IsAutoCompoundEnabledRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetNetworkLossBundleAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNetworkLossBundleAtBlockRequest)
+func _QueryService_GetReputerCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReputerCommissionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetNetworkLossBundleAtBlock(ctx, in)
+		return srv.(QueryServiceServer).GetReputerCommission(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetNetworkLossBundleAtBlock_FullMethodName,
+		FullMethod: QueryService_GetReputerCommission_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetNetworkLossBundleAtBlock(ctx, req.(*GetNetworkLossBundleAtBlockRequest))
+		return srv.(QueryServiceServer).GetReputerCommission(ctx, req.(*GetReputerCommissionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetTotalStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTotalStakeRequest)
+func _QueryService_GetStakeLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStakeLocksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetTotalStake(ctx, in)
+		return srv.(QueryServiceServer).GetStakeLocks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetTotalStake_FullMethodName,
+		FullMethod: QueryService_GetStakeLocks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetTotalStake(ctx, req.(*GetTotalStakeRequest))
+		return srv.(QueryServiceServer).GetStakeLocks(ctx, req.(*GetStakeLocksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetReputerStakeInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReputerStakeInTopicRequest)
+func _QueryService_SimulateTopicRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateTopicRewardsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetReputerStakeInTopic(ctx, in)
+		return srv.(QueryServiceServer).SimulateTopicRewards(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetReputerStakeInTopic_FullMethodName,
+		FullMethod: QueryService_SimulateTopicRewards_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetReputerStakeInTopic(ctx, req.(*GetReputerStakeInTopicRequest))
+		return srv.(QueryServiceServer).SimulateTopicRewards(ctx, req.(*SimulateTopicRewardsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetMultiReputerStakeInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMultiReputerStakeInTopicRequest)
+func _QueryService_GetActorRewardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActorRewardHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetMultiReputerStakeInTopic(ctx, in)
+		return srv.(QueryServiceServer).GetActorRewardHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetMultiReputerStakeInTopic_FullMethodName,
+		FullMethod: QueryService_GetActorRewardHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetMultiReputerStakeInTopic(ctx, req.(*GetMultiReputerStakeInTopicRequest))
+		return srv.(QueryServiceServer).GetActorRewardHistory(ctx, req.(*GetActorRewardHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetStakeFromReputerInTopicInSelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStakeFromReputerInTopicInSelfRequest)
+func _QueryService_GetTopicRewardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicRewardHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetStakeFromReputerInTopicInSelf(ctx, in)
+		return srv.(QueryServiceServer).GetTopicRewardHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetStakeFromReputerInTopicInSelf_FullMethodName,
+		FullMethod: QueryService_GetTopicRewardHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetStakeFromReputerInTopicInSelf(ctx, req.(*GetStakeFromReputerInTopicInSelfRequest))
+		return srv.(QueryServiceServer).GetTopicRewardHistory(ctx, req.(*GetTopicRewardHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetDelegateStakeInTopicInReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDelegateStakeInTopicInReputerRequest)
+func _QueryService_GetRewardEscrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRewardEscrowsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetDelegateStakeInTopicInReputer(ctx, in)
+		return srv.(QueryServiceServer).GetRewardEscrows(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetDelegateStakeInTopicInReputer_FullMethodName,
+		FullMethod: QueryService_GetRewardEscrows_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetDelegateStakeInTopicInReputer(ctx, req.(*GetDelegateStakeInTopicInReputerRequest))
+		return srv.(QueryServiceServer).GetRewardEscrows(ctx, req.(*GetRewardEscrowsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetStakeFromDelegatorInTopicInReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStakeFromDelegatorInTopicInReputerRequest)
+func _QueryService_GetQueuedRewardPayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueuedRewardPayoutsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetStakeFromDelegatorInTopicInReputer(ctx, in)
+		return srv.(QueryServiceServer).GetQueuedRewardPayouts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetStakeFromDelegatorInTopicInReputer_FullMethodName,
+		FullMethod: QueryService_GetQueuedRewardPayouts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetStakeFromDelegatorInTopicInReputer(ctx, req.(*GetStakeFromDelegatorInTopicInReputerRequest))
+		return srv.(QueryServiceServer).GetQueuedRewardPayouts(ctx, req.(*GetQueuedRewardPayoutsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetStakeFromDelegatorInTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStakeFromDelegatorInTopicRequest)
+func _QueryService_GetDeadLetteredRewardPayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLetteredRewardPayoutsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetStakeFromDelegatorInTopic(ctx, in)
+		return srv.(QueryServiceServer).GetDeadLetteredRewardPayouts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetStakeFromDelegatorInTopic_FullMethodName,
+		FullMethod: QueryService_GetDeadLetteredRewardPayouts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetStakeFromDelegatorInTopic(ctx, req.(*GetStakeFromDelegatorInTopicRequest))
+		return srv.(QueryServiceServer).GetDeadLetteredRewardPayouts(ctx, req.(*GetDeadLetteredRewardPayoutsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetTopicStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTopicStakeRequest)
+func _QueryService_GetTopicWeightBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicWeightBreakdownRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetTopicStake(ctx, in)
+		return srv.(QueryServiceServer).GetTopicWeightBreakdown(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetTopicStake_FullMethodName,
+		FullMethod: QueryService_GetTopicWeightBreakdown_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetTopicStake(ctx, req.(*GetTopicStakeRequest))
+		return srv.(QueryServiceServer).GetTopicWeightBreakdown(ctx, req.(*GetTopicWeightBreakdownRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetStakeRemovalsUpUntilBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStakeRemovalsUpUntilBlockRequest)
+func _QueryService_GetPendingDelegateRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingDelegateRewardsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetStakeRemovalsUpUntilBlock(ctx, in)
+		return srv.(QueryServiceServer).GetPendingDelegateRewards(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetStakeRemovalsUpUntilBlock_FullMethodName,
+		FullMethod: QueryService_GetPendingDelegateRewards_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetStakeRemovalsUpUntilBlock(ctx, req.(*GetStakeRemovalsUpUntilBlockRequest))
+		return srv.(QueryServiceServer).GetPendingDelegateRewards(ctx, req.(*GetPendingDelegateRewardsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetDelegateStakeRemovalsUpUntilBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDelegateStakeRemovalsUpUntilBlockRequest)
+func _QueryService_GetDelegatorPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDelegatorPositionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetDelegateStakeRemovalsUpUntilBlock(ctx, in)
+		return srv.(QueryServiceServer).GetDelegatorPositions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetDelegateStakeRemovalsUpUntilBlock_FullMethodName,
+		FullMethod: QueryService_GetDelegatorPositions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetDelegateStakeRemovalsUpUntilBlock(ctx, req.(*GetDelegateStakeRemovalsUpUntilBlockRequest))
+		return srv.(QueryServiceServer).GetDelegatorPositions(ctx, req.(*GetDelegatorPositionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetStakeRemovalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStakeRemovalInfoRequest)
+func _QueryService_GetPendingStakeRemovalsForActor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingStakeRemovalsForActorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetStakeRemovalInfo(ctx, in)
+		return srv.(QueryServiceServer).GetPendingStakeRemovalsForActor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetStakeRemovalInfo_FullMethodName,
+		FullMethod: QueryService_GetPendingStakeRemovalsForActor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetStakeRemovalInfo(ctx, req.(*GetStakeRemovalInfoRequest))
+		return srv.(QueryServiceServer).GetPendingStakeRemovalsForActor(ctx, req.(*GetPendingStakeRemovalsForActorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueryService_GetDelegateStakeRemovalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDelegateStakeRemovalInfoRequest)
+func _QueryService_GetActorOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActorOperatorsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServiceServer).GetDelegateStakeRemovalInfo(ctx, in)
+		return srv.(QueryServiceServer).GetActorOperators(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: QueryService_GetDelegateStakeRemovalInfo_FullMethodName,
+		FullMethod: QueryService_GetActorOperators_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).GetDelegateStakeRemovalInfo(ctx, req.(*GetDelegateStakeRemovalInfoRequest))
+		return srv.(QueryServiceServer).GetActorOperators(ctx, req.(*GetActorOperatorsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2626,6 +3306,42 @@ func _QueryService_GetDelegateStakeRemoval_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_GetWorkerBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetWorkerBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetWorkerBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetWorkerBond(ctx, req.(*GetWorkerBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetWorkerBondRemoval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerBondRemovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetWorkerBondRemoval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetWorkerBondRemoval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetWorkerBondRemoval(ctx, req.(*GetWorkerBondRemovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_GetPreviousTopicWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPreviousTopicWeightRequest)
 	if err := dec(in); err != nil {
@@ -2698,6 +3414,78 @@ func _QueryService_IsTopicActive_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_IsTopicArchived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsTopicArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).IsTopicArchived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_IsTopicArchived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).IsTopicArchived(ctx, req.(*IsTopicArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetTopicOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetTopicOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetTopicOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetTopicOwner(ctx, req.(*GetTopicOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetPendingTopicOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingTopicOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetPendingTopicOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetPendingTopicOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetPendingTopicOwner(ctx, req.(*GetPendingTopicOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ListTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_ListTopics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ListTopics(ctx, req.(*ListTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_GetTopicFeeRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTopicFeeRevenueRequest)
 	if err := dec(in); err != nil {
@@ -3675,6 +4463,10 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkerLatestInferenceByTopicId",
 			Handler:    _QueryService_GetWorkerLatestInferenceByTopicId_Handler,
 		},
+		{
+			MethodName: "GetWorkerPayloadCommit",
+			Handler:    _QueryService_GetWorkerPayloadCommit_Handler,
+		},
 		{
 			MethodName: "GetInferencesAtBlock",
 			Handler:    _QueryService_GetInferencesAtBlock_Handler,
@@ -3691,6 +4483,14 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNetworkLossBundleAtBlock",
 			Handler:    _QueryService_GetNetworkLossBundleAtBlock_Handler,
 		},
+		{
+			MethodName: "GetConsensusGroundTruthAtBlock",
+			Handler:    _QueryService_GetConsensusGroundTruthAtBlock_Handler,
+		},
+		{
+			MethodName: "GetReputerGroundTruth",
+			Handler:    _QueryService_GetReputerGroundTruth_Handler,
+		},
 		{
 			MethodName: "GetTotalStake",
 			Handler:    _QueryService_GetTotalStake_Handler,
@@ -3739,6 +4539,62 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDelegateStakeRemovalInfo",
 			Handler:    _QueryService_GetDelegateStakeRemovalInfo_Handler,
 		},
+		{
+			MethodName: "IsAutoCompoundEnabled",
+			Handler:    _QueryService_IsAutoCompoundEnabled_Handler,
+		},
+		{
+			MethodName: "GetReputerCommission",
+			Handler:    _QueryService_GetReputerCommission_Handler,
+		},
+		{
+			MethodName: "GetStakeLocks",
+			Handler:    _QueryService_GetStakeLocks_Handler,
+		},
+		{
+			MethodName: "SimulateTopicRewards",
+			Handler:    _QueryService_SimulateTopicRewards_Handler,
+		},
+		{
+			MethodName: "GetActorRewardHistory",
+			Handler:    _QueryService_GetActorRewardHistory_Handler,
+		},
+		{
+			MethodName: "GetTopicRewardHistory",
+			Handler:    _QueryService_GetTopicRewardHistory_Handler,
+		},
+		{
+			MethodName: "GetRewardEscrows",
+			Handler:    _QueryService_GetRewardEscrows_Handler,
+		},
+		{
+			MethodName: "GetQueuedRewardPayouts",
+			Handler:    _QueryService_GetQueuedRewardPayouts_Handler,
+		},
+		{
+			MethodName: "GetDeadLetteredRewardPayouts",
+			Handler:    _QueryService_GetDeadLetteredRewardPayouts_Handler,
+		},
+		{
+			MethodName: "GetTopicWeightBreakdown",
+			Handler:    _QueryService_GetTopicWeightBreakdown_Handler,
+		},
+		{
+			MethodName: "GetPendingDelegateRewards",
+			Handler:    _QueryService_GetPendingDelegateRewards_Handler,
+		},
+		{
+			MethodName: "GetDelegatorPositions",
+			Handler:    _QueryService_GetDelegatorPositions_Handler,
+		},
+		{
+			MethodName: "GetPendingStakeRemovalsForActor",
+			Handler:    _QueryService_GetPendingStakeRemovalsForActor_Handler,
+		},
+		{
+			MethodName: "GetActorOperators",
+			Handler:    _QueryService_GetActorOperators_Handler,
+		},
 		{
 			MethodName: "GetWorkerNodeInfo",
 			Handler:    _QueryService_GetWorkerNodeInfo_Handler,
@@ -3851,6 +4707,14 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDelegateStakeRemoval",
 			Handler:    _QueryService_GetDelegateStakeRemoval_Handler,
 		},
+		{
+			MethodName: "GetWorkerBond",
+			Handler:    _QueryService_GetWorkerBond_Handler,
+		},
+		{
+			MethodName: "GetWorkerBondRemoval",
+			Handler:    _QueryService_GetWorkerBondRemoval_Handler,
+		},
 		{
 			MethodName: "GetPreviousTopicWeight",
 			Handler:    _QueryService_GetPreviousTopicWeight_Handler,
@@ -3867,6 +4731,22 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsTopicActive",
 			Handler:    _QueryService_IsTopicActive_Handler,
 		},
+		{
+			MethodName: "IsTopicArchived",
+			Handler:    _QueryService_IsTopicArchived_Handler,
+		},
+		{
+			MethodName: "GetTopicOwner",
+			Handler:    _QueryService_GetTopicOwner_Handler,
+		},
+		{
+			MethodName: "GetPendingTopicOwner",
+			Handler:    _QueryService_GetPendingTopicOwner_Handler,
+		},
+		{
+			MethodName: "ListTopics",
+			Handler:    _QueryService_ListTopics_Handler,
+		},
 		{
 			MethodName: "GetTopicFeeRevenue",
 			Handler:    _QueryService_GetTopicFeeRevenue_Handler,
@@ -21,20 +21,42 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	MsgService_UpdateParams_FullMethodName                         = "/emissions.v7.MsgService/UpdateParams"
 	MsgService_CreateNewTopic_FullMethodName                       = "/emissions.v7.MsgService/CreateNewTopic"
+	MsgService_UpdateTopic_FullMethodName                          = "/emissions.v7.MsgService/UpdateTopic"
+	MsgService_ArchiveTopic_FullMethodName                         = "/emissions.v7.MsgService/ArchiveTopic"
+	MsgService_ProposeTopicOwner_FullMethodName                    = "/emissions.v7.MsgService/ProposeTopicOwner"
+	MsgService_AcceptTopicOwnership_FullMethodName                 = "/emissions.v7.MsgService/AcceptTopicOwnership"
 	MsgService_Register_FullMethodName                             = "/emissions.v7.MsgService/Register"
 	MsgService_RemoveRegistration_FullMethodName                   = "/emissions.v7.MsgService/RemoveRegistration"
+	MsgService_AuthorizeOperator_FullMethodName                    = "/emissions.v7.MsgService/AuthorizeOperator"
+	MsgService_RevokeOperator_FullMethodName                       = "/emissions.v7.MsgService/RevokeOperator"
+	MsgService_RotateOperator_FullMethodName                       = "/emissions.v7.MsgService/RotateOperator"
 	MsgService_AddStake_FullMethodName                             = "/emissions.v7.MsgService/AddStake"
 	MsgService_RemoveStake_FullMethodName                          = "/emissions.v7.MsgService/RemoveStake"
 	MsgService_CancelRemoveStake_FullMethodName                    = "/emissions.v7.MsgService/CancelRemoveStake"
+	MsgService_AmendRemoveStake_FullMethodName                     = "/emissions.v7.MsgService/AmendRemoveStake"
+	MsgService_MoveStake_FullMethodName                            = "/emissions.v7.MsgService/MoveStake"
 	MsgService_DelegateStake_FullMethodName                        = "/emissions.v7.MsgService/DelegateStake"
 	MsgService_RewardDelegateStake_FullMethodName                  = "/emissions.v7.MsgService/RewardDelegateStake"
+	MsgService_ClaimAllDelegateRewards_FullMethodName              = "/emissions.v7.MsgService/ClaimAllDelegateRewards"
 	MsgService_RemoveDelegateStake_FullMethodName                  = "/emissions.v7.MsgService/RemoveDelegateStake"
 	MsgService_CancelRemoveDelegateStake_FullMethodName            = "/emissions.v7.MsgService/CancelRemoveDelegateStake"
+	MsgService_AmendRemoveDelegateStake_FullMethodName             = "/emissions.v7.MsgService/AmendRemoveDelegateStake"
+	MsgService_RedelegateStake_FullMethodName                      = "/emissions.v7.MsgService/RedelegateStake"
+	MsgService_SetAutoCompound_FullMethodName                      = "/emissions.v7.MsgService/SetAutoCompound"
+	MsgService_SetReputerCommission_FullMethodName                 = "/emissions.v7.MsgService/SetReputerCommission"
+	MsgService_AddWorkerBond_FullMethodName                        = "/emissions.v7.MsgService/AddWorkerBond"
+	MsgService_RemoveWorkerBond_FullMethodName                     = "/emissions.v7.MsgService/RemoveWorkerBond"
+	MsgService_CancelRemoveWorkerBond_FullMethodName               = "/emissions.v7.MsgService/CancelRemoveWorkerBond"
+	MsgService_ClaimVestedRewards_FullMethodName                   = "/emissions.v7.MsgService/ClaimVestedRewards"
+	MsgService_ForfeitUnvestedRewards_FullMethodName               = "/emissions.v7.MsgService/ForfeitUnvestedRewards"
 	MsgService_FundTopic_FullMethodName                            = "/emissions.v7.MsgService/FundTopic"
 	MsgService_AddToWhitelistAdmin_FullMethodName                  = "/emissions.v7.MsgService/AddToWhitelistAdmin"
 	MsgService_RemoveFromWhitelistAdmin_FullMethodName             = "/emissions.v7.MsgService/RemoveFromWhitelistAdmin"
 	MsgService_InsertWorkerPayload_FullMethodName                  = "/emissions.v7.MsgService/InsertWorkerPayload"
+	MsgService_CommitWorkerPayload_FullMethodName                  = "/emissions.v7.MsgService/CommitWorkerPayload"
+	MsgService_RevealWorkerPayload_FullMethodName                  = "/emissions.v7.MsgService/RevealWorkerPayload"
 	MsgService_InsertReputerPayload_FullMethodName                 = "/emissions.v7.MsgService/InsertReputerPayload"
+	MsgService_InsertReputerGroundTruth_FullMethodName             = "/emissions.v7.MsgService/InsertReputerGroundTruth"
 	MsgService_AddToGlobalWhitelist_FullMethodName                 = "/emissions.v7.MsgService/AddToGlobalWhitelist"
 	MsgService_RemoveFromGlobalWhitelist_FullMethodName            = "/emissions.v7.MsgService/RemoveFromGlobalWhitelist"
 	MsgService_AddToGlobalWorkerWhitelist_FullMethodName           = "/emissions.v7.MsgService/AddToGlobalWorkerWhitelist"
@@ -71,20 +93,42 @@ const (
 type MsgServiceClient interface {
 	UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error)
 	CreateNewTopic(ctx context.Context, in *CreateNewTopicRequest, opts ...grpc.CallOption) (*CreateNewTopicResponse, error)
+	UpdateTopic(ctx context.Context, in *UpdateTopicRequest, opts ...grpc.CallOption) (*UpdateTopicResponse, error)
+	ArchiveTopic(ctx context.Context, in *ArchiveTopicRequest, opts ...grpc.CallOption) (*ArchiveTopicResponse, error)
+	ProposeTopicOwner(ctx context.Context, in *ProposeTopicOwnerRequest, opts ...grpc.CallOption) (*ProposeTopicOwnerResponse, error)
+	AcceptTopicOwnership(ctx context.Context, in *AcceptTopicOwnershipRequest, opts ...grpc.CallOption) (*AcceptTopicOwnershipResponse, error)
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 	RemoveRegistration(ctx context.Context, in *RemoveRegistrationRequest, opts ...grpc.CallOption) (*RemoveRegistrationResponse, error)
+	AuthorizeOperator(ctx context.Context, in *AuthorizeOperatorRequest, opts ...grpc.CallOption) (*AuthorizeOperatorResponse, error)
+	RevokeOperator(ctx context.Context, in *RevokeOperatorRequest, opts ...grpc.CallOption) (*RevokeOperatorResponse, error)
+	RotateOperator(ctx context.Context, in *RotateOperatorRequest, opts ...grpc.CallOption) (*RotateOperatorResponse, error)
 	AddStake(ctx context.Context, in *AddStakeRequest, opts ...grpc.CallOption) (*AddStakeResponse, error)
 	RemoveStake(ctx context.Context, in *RemoveStakeRequest, opts ...grpc.CallOption) (*RemoveStakeResponse, error)
 	CancelRemoveStake(ctx context.Context, in *CancelRemoveStakeRequest, opts ...grpc.CallOption) (*CancelRemoveStakeResponse, error)
+	AmendRemoveStake(ctx context.Context, in *AmendRemoveStakeRequest, opts ...grpc.CallOption) (*AmendRemoveStakeResponse, error)
+	MoveStake(ctx context.Context, in *MoveStakeRequest, opts ...grpc.CallOption) (*MoveStakeResponse, error)
 	DelegateStake(ctx context.Context, in *DelegateStakeRequest, opts ...grpc.CallOption) (*DelegateStakeResponse, error)
 	RewardDelegateStake(ctx context.Context, in *RewardDelegateStakeRequest, opts ...grpc.CallOption) (*RewardDelegateStakeResponse, error)
+	ClaimAllDelegateRewards(ctx context.Context, in *ClaimAllDelegateRewardsRequest, opts ...grpc.CallOption) (*ClaimAllDelegateRewardsResponse, error)
 	RemoveDelegateStake(ctx context.Context, in *RemoveDelegateStakeRequest, opts ...grpc.CallOption) (*RemoveDelegateStakeResponse, error)
 	CancelRemoveDelegateStake(ctx context.Context, in *CancelRemoveDelegateStakeRequest, opts ...grpc.CallOption) (*CancelRemoveDelegateStakeResponse, error)
+	AmendRemoveDelegateStake(ctx context.Context, in *AmendRemoveDelegateStakeRequest, opts ...grpc.CallOption) (*AmendRemoveDelegateStakeResponse, error)
+	RedelegateStake(ctx context.Context, in *RedelegateStakeRequest, opts ...grpc.CallOption) (*RedelegateStakeResponse, error)
+	SetAutoCompound(ctx context.Context, in *SetAutoCompoundRequest, opts ...grpc.CallOption) (*SetAutoCompoundResponse, error)
+	SetReputerCommission(ctx context.Context, in *SetReputerCommissionRequest, opts ...grpc.CallOption) (*SetReputerCommissionResponse, error)
+	AddWorkerBond(ctx context.Context, in *AddWorkerBondRequest, opts ...grpc.CallOption) (*AddWorkerBondResponse, error)
+	RemoveWorkerBond(ctx context.Context, in *RemoveWorkerBondRequest, opts ...grpc.CallOption) (*RemoveWorkerBondResponse, error)
+	CancelRemoveWorkerBond(ctx context.Context, in *CancelRemoveWorkerBondRequest, opts ...grpc.CallOption) (*CancelRemoveWorkerBondResponse, error)
+	ClaimVestedRewards(ctx context.Context, in *ClaimVestedRewardsRequest, opts ...grpc.CallOption) (*ClaimVestedRewardsResponse, error)
+	ForfeitUnvestedRewards(ctx context.Context, in *ForfeitUnvestedRewardsRequest, opts ...grpc.CallOption) (*ForfeitUnvestedRewardsResponse, error)
 	FundTopic(ctx context.Context, in *FundTopicRequest, opts ...grpc.CallOption) (*FundTopicResponse, error)
 	AddToWhitelistAdmin(ctx context.Context, in *AddToWhitelistAdminRequest, opts ...grpc.CallOption) (*AddToWhitelistAdminResponse, error)
 	RemoveFromWhitelistAdmin(ctx context.Context, in *RemoveFromWhitelistAdminRequest, opts ...grpc.CallOption) (*RemoveFromWhitelistAdminResponse, error)
 	InsertWorkerPayload(ctx context.Context, in *InsertWorkerPayloadRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadResponse, error)
+	CommitWorkerPayload(ctx context.Context, in *CommitWorkerPayloadRequest, opts ...grpc.CallOption) (*CommitWorkerPayloadResponse, error)
+	RevealWorkerPayload(ctx context.Context, in *RevealWorkerPayloadRequest, opts ...grpc.CallOption) (*RevealWorkerPayloadResponse, error)
 	InsertReputerPayload(ctx context.Context, in *InsertReputerPayloadRequest, opts ...grpc.CallOption) (*InsertReputerPayloadResponse, error)
+	InsertReputerGroundTruth(ctx context.Context, in *InsertReputerGroundTruthRequest, opts ...grpc.CallOption) (*InsertReputerGroundTruthResponse, error)
 	AddToGlobalWhitelist(ctx context.Context, in *AddToGlobalWhitelistRequest, opts ...grpc.CallOption) (*AddToGlobalWhitelistResponse, error)
 	RemoveFromGlobalWhitelist(ctx context.Context, in *RemoveFromGlobalWhitelistRequest, opts ...grpc.CallOption) (*RemoveFromGlobalWhitelistResponse, error)
 	AddToGlobalWorkerWhitelist(ctx context.Context, in *AddToGlobalWorkerWhitelistRequest, opts ...grpc.CallOption) (*AddToGlobalWorkerWhitelistResponse, error)
@@ -141,6 +185,46 @@ func (c *msgServiceClient) CreateNewTopic(ctx context.Context, in *CreateNewTopi
 	return out, nil
 }
 
+func (c *msgServiceClient) UpdateTopic(ctx context.Context, in *UpdateTopicRequest, opts ...grpc.CallOption) (*UpdateTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTopicResponse)
+	err := c.cc.Invoke(ctx, MsgService_UpdateTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) ArchiveTopic(ctx context.Context, in *ArchiveTopicRequest, opts ...grpc.CallOption) (*ArchiveTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveTopicResponse)
+	err := c.cc.Invoke(ctx, MsgService_ArchiveTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) ProposeTopicOwner(ctx context.Context, in *ProposeTopicOwnerRequest, opts ...grpc.CallOption) (*ProposeTopicOwnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProposeTopicOwnerResponse)
+	err := c.cc.Invoke(ctx, MsgService_ProposeTopicOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) AcceptTopicOwnership(ctx context.Context, in *AcceptTopicOwnershipRequest, opts ...grpc.CallOption) (*AcceptTopicOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptTopicOwnershipResponse)
+	err := c.cc.Invoke(ctx, MsgService_AcceptTopicOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RegisterResponse)
@@ -161,6 +245,36 @@ func (c *msgServiceClient) RemoveRegistration(ctx context.Context, in *RemoveReg
 	return out, nil
 }
 
+func (c *msgServiceClient) AuthorizeOperator(ctx context.Context, in *AuthorizeOperatorRequest, opts ...grpc.CallOption) (*AuthorizeOperatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorizeOperatorResponse)
+	err := c.cc.Invoke(ctx, MsgService_AuthorizeOperator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RevokeOperator(ctx context.Context, in *RevokeOperatorRequest, opts ...grpc.CallOption) (*RevokeOperatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeOperatorResponse)
+	err := c.cc.Invoke(ctx, MsgService_RevokeOperator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RotateOperator(ctx context.Context, in *RotateOperatorRequest, opts ...grpc.CallOption) (*RotateOperatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateOperatorResponse)
+	err := c.cc.Invoke(ctx, MsgService_RotateOperator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) AddStake(ctx context.Context, in *AddStakeRequest, opts ...grpc.CallOption) (*AddStakeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddStakeResponse)
@@ -191,6 +305,26 @@ func (c *msgServiceClient) CancelRemoveStake(ctx context.Context, in *CancelRemo
 	return out, nil
 }
 
+func (c *msgServiceClient) AmendRemoveStake(ctx context.Context, in *AmendRemoveStakeRequest, opts ...grpc.CallOption) (*AmendRemoveStakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AmendRemoveStakeResponse)
+	err := c.cc.Invoke(ctx, MsgService_AmendRemoveStake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) MoveStake(ctx context.Context, in *MoveStakeRequest, opts ...grpc.CallOption) (*MoveStakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveStakeResponse)
+	err := c.cc.Invoke(ctx, MsgService_MoveStake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) DelegateStake(ctx context.Context, in *DelegateStakeRequest, opts ...grpc.CallOption) (*DelegateStakeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DelegateStakeResponse)
@@ -211,6 +345,16 @@ func (c *msgServiceClient) RewardDelegateStake(ctx context.Context, in *RewardDe
 	return out, nil
 }
 
+func (c *msgServiceClient) ClaimAllDelegateRewards(ctx context.Context, in *ClaimAllDelegateRewardsRequest, opts ...grpc.CallOption) (*ClaimAllDelegateRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimAllDelegateRewardsResponse)
+	err := c.cc.Invoke(ctx, MsgService_ClaimAllDelegateRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) RemoveDelegateStake(ctx context.Context, in *RemoveDelegateStakeRequest, opts ...grpc.CallOption) (*RemoveDelegateStakeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RemoveDelegateStakeResponse)
@@ -231,6 +375,96 @@ func (c *msgServiceClient) CancelRemoveDelegateStake(ctx context.Context, in *Ca
 	return out, nil
 }
 
+func (c *msgServiceClient) AmendRemoveDelegateStake(ctx context.Context, in *AmendRemoveDelegateStakeRequest, opts ...grpc.CallOption) (*AmendRemoveDelegateStakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AmendRemoveDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, MsgService_AmendRemoveDelegateStake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RedelegateStake(ctx context.Context, in *RedelegateStakeRequest, opts ...grpc.CallOption) (*RedelegateStakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedelegateStakeResponse)
+	err := c.cc.Invoke(ctx, MsgService_RedelegateStake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) SetAutoCompound(ctx context.Context, in *SetAutoCompoundRequest, opts ...grpc.CallOption) (*SetAutoCompoundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, MsgService_SetAutoCompound_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) SetReputerCommission(ctx context.Context, in *SetReputerCommissionRequest, opts ...grpc.CallOption) (*SetReputerCommissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetReputerCommissionResponse)
+	err := c.cc.Invoke(ctx, MsgService_SetReputerCommission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) AddWorkerBond(ctx context.Context, in *AddWorkerBondRequest, opts ...grpc.CallOption) (*AddWorkerBondResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddWorkerBondResponse)
+	err := c.cc.Invoke(ctx, MsgService_AddWorkerBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RemoveWorkerBond(ctx context.Context, in *RemoveWorkerBondRequest, opts ...grpc.CallOption) (*RemoveWorkerBondResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveWorkerBondResponse)
+	err := c.cc.Invoke(ctx, MsgService_RemoveWorkerBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) CancelRemoveWorkerBond(ctx context.Context, in *CancelRemoveWorkerBondRequest, opts ...grpc.CallOption) (*CancelRemoveWorkerBondResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelRemoveWorkerBondResponse)
+	err := c.cc.Invoke(ctx, MsgService_CancelRemoveWorkerBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) ClaimVestedRewards(ctx context.Context, in *ClaimVestedRewardsRequest, opts ...grpc.CallOption) (*ClaimVestedRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimVestedRewardsResponse)
+	err := c.cc.Invoke(ctx, MsgService_ClaimVestedRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) ForfeitUnvestedRewards(ctx context.Context, in *ForfeitUnvestedRewardsRequest, opts ...grpc.CallOption) (*ForfeitUnvestedRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForfeitUnvestedRewardsResponse)
+	err := c.cc.Invoke(ctx, MsgService_ForfeitUnvestedRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) FundTopic(ctx context.Context, in *FundTopicRequest, opts ...grpc.CallOption) (*FundTopicResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FundTopicResponse)
@@ -271,6 +505,26 @@ func (c *msgServiceClient) InsertWorkerPayload(ctx context.Context, in *InsertWo
 	return out, nil
 }
 
+func (c *msgServiceClient) CommitWorkerPayload(ctx context.Context, in *CommitWorkerPayloadRequest, opts ...grpc.CallOption) (*CommitWorkerPayloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, MsgService_CommitWorkerPayload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RevealWorkerPayload(ctx context.Context, in *RevealWorkerPayloadRequest, opts ...grpc.CallOption) (*RevealWorkerPayloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevealWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, MsgService_RevealWorkerPayload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) InsertReputerPayload(ctx context.Context, in *InsertReputerPayloadRequest, opts ...grpc.CallOption) (*InsertReputerPayloadResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InsertReputerPayloadResponse)
@@ -281,6 +535,16 @@ func (c *msgServiceClient) InsertReputerPayload(ctx context.Context, in *InsertR
 	return out, nil
 }
 
+func (c *msgServiceClient) InsertReputerGroundTruth(ctx context.Context, in *InsertReputerGroundTruthRequest, opts ...grpc.CallOption) (*InsertReputerGroundTruthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InsertReputerGroundTruthResponse)
+	err := c.cc.Invoke(ctx, MsgService_InsertReputerGroundTruth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) AddToGlobalWhitelist(ctx context.Context, in *AddToGlobalWhitelistRequest, opts ...grpc.CallOption) (*AddToGlobalWhitelistResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddToGlobalWhitelistResponse)
@@ -549,20 +813,42 @@ func (c *msgServiceClient) RemoveFromTopicReputerWhitelist(ctx context.Context,
 type MsgServiceServer interface {
 	UpdateParams(context.Context, *UpdateParamsRequest) (*UpdateParamsResponse, error)
 	CreateNewTopic(context.Context, *CreateNewTopicRequest) (*CreateNewTopicResponse, error)
+	UpdateTopic(context.Context, *UpdateTopicRequest) (*UpdateTopicResponse, error)
+	ArchiveTopic(context.Context, *ArchiveTopicRequest) (*ArchiveTopicResponse, error)
+	ProposeTopicOwner(context.Context, *ProposeTopicOwnerRequest) (*ProposeTopicOwnerResponse, error)
+	AcceptTopicOwnership(context.Context, *AcceptTopicOwnershipRequest) (*AcceptTopicOwnershipResponse, error)
 	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
 	RemoveRegistration(context.Context, *RemoveRegistrationRequest) (*RemoveRegistrationResponse, error)
+	AuthorizeOperator(context.Context, *AuthorizeOperatorRequest) (*AuthorizeOperatorResponse, error)
+	RevokeOperator(context.Context, *RevokeOperatorRequest) (*RevokeOperatorResponse, error)
+	RotateOperator(context.Context, *RotateOperatorRequest) (*RotateOperatorResponse, error)
 	AddStake(context.Context, *AddStakeRequest) (*AddStakeResponse, error)
 	RemoveStake(context.Context, *RemoveStakeRequest) (*RemoveStakeResponse, error)
 	CancelRemoveStake(context.Context, *CancelRemoveStakeRequest) (*CancelRemoveStakeResponse, error)
+	AmendRemoveStake(context.Context, *AmendRemoveStakeRequest) (*AmendRemoveStakeResponse, error)
+	MoveStake(context.Context, *MoveStakeRequest) (*MoveStakeResponse, error)
 	DelegateStake(context.Context, *DelegateStakeRequest) (*DelegateStakeResponse, error)
 	RewardDelegateStake(context.Context, *RewardDelegateStakeRequest) (*RewardDelegateStakeResponse, error)
+	ClaimAllDelegateRewards(context.Context, *ClaimAllDelegateRewardsRequest) (*ClaimAllDelegateRewardsResponse, error)
 	RemoveDelegateStake(context.Context, *RemoveDelegateStakeRequest) (*RemoveDelegateStakeResponse, error)
 	CancelRemoveDelegateStake(context.Context, *CancelRemoveDelegateStakeRequest) (*CancelRemoveDelegateStakeResponse, error)
+	AmendRemoveDelegateStake(context.Context, *AmendRemoveDelegateStakeRequest) (*AmendRemoveDelegateStakeResponse, error)
+	RedelegateStake(context.Context, *RedelegateStakeRequest) (*RedelegateStakeResponse, error)
+	SetAutoCompound(context.Context, *SetAutoCompoundRequest) (*SetAutoCompoundResponse, error)
+	SetReputerCommission(context.Context, *SetReputerCommissionRequest) (*SetReputerCommissionResponse, error)
+	AddWorkerBond(context.Context, *AddWorkerBondRequest) (*AddWorkerBondResponse, error)
+	RemoveWorkerBond(context.Context, *RemoveWorkerBondRequest) (*RemoveWorkerBondResponse, error)
+	CancelRemoveWorkerBond(context.Context, *CancelRemoveWorkerBondRequest) (*CancelRemoveWorkerBondResponse, error)
+	ClaimVestedRewards(context.Context, *ClaimVestedRewardsRequest) (*ClaimVestedRewardsResponse, error)
+	ForfeitUnvestedRewards(context.Context, *ForfeitUnvestedRewardsRequest) (*ForfeitUnvestedRewardsResponse, error)
 	FundTopic(context.Context, *FundTopicRequest) (*FundTopicResponse, error)
 	AddToWhitelistAdmin(context.Context, *AddToWhitelistAdminRequest) (*AddToWhitelistAdminResponse, error)
 	RemoveFromWhitelistAdmin(context.Context, *RemoveFromWhitelistAdminRequest) (*RemoveFromWhitelistAdminResponse, error)
 	InsertWorkerPayload(context.Context, *InsertWorkerPayloadRequest) (*InsertWorkerPayloadResponse, error)
+	CommitWorkerPayload(context.Context, *CommitWorkerPayloadRequest) (*CommitWorkerPayloadResponse, error)
+	RevealWorkerPayload(context.Context, *RevealWorkerPayloadRequest) (*RevealWorkerPayloadResponse, error)
 	InsertReputerPayload(context.Context, *InsertReputerPayloadRequest) (*InsertReputerPayloadResponse, error)
+	InsertReputerGroundTruth(context.Context, *InsertReputerGroundTruthRequest) (*InsertReputerGroundTruthResponse, error)
 	AddToGlobalWhitelist(context.Context, *AddToGlobalWhitelistRequest) (*AddToGlobalWhitelistResponse, error)
 	RemoveFromGlobalWhitelist(context.Context, *RemoveFromGlobalWhitelistRequest) (*RemoveFromGlobalWhitelistResponse, error)
 	AddToGlobalWorkerWhitelist(context.Context, *AddToGlobalWorkerWhitelistRequest) (*AddToGlobalWorkerWhitelistResponse, error)
@@ -605,12 +891,33 @@ func (UnimplementedMsgServiceServer) UpdateParams(context.Context, *UpdateParams
 func (UnimplementedMsgServiceServer) CreateNewTopic(context.Context, *CreateNewTopicRequest) (*CreateNewTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateNewTopic not implemented")
 }
+func (UnimplementedMsgServiceServer) UpdateTopic(context.Context, *UpdateTopicRequest) (*UpdateTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopic not implemented")
+}
+func (UnimplementedMsgServiceServer) ArchiveTopic(context.Context, *ArchiveTopicRequest) (*ArchiveTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveTopic not implemented")
+}
+func (UnimplementedMsgServiceServer) ProposeTopicOwner(context.Context, *ProposeTopicOwnerRequest) (*ProposeTopicOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeTopicOwner not implemented")
+}
+func (UnimplementedMsgServiceServer) AcceptTopicOwnership(context.Context, *AcceptTopicOwnershipRequest) (*AcceptTopicOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTopicOwnership not implemented")
+}
 func (UnimplementedMsgServiceServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
 func (UnimplementedMsgServiceServer) RemoveRegistration(context.Context, *RemoveRegistrationRequest) (*RemoveRegistrationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveRegistration not implemented")
 }
+func (UnimplementedMsgServiceServer) AuthorizeOperator(context.Context, *AuthorizeOperatorRequest) (*AuthorizeOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeOperator not implemented")
+}
+func (UnimplementedMsgServiceServer) RevokeOperator(context.Context, *RevokeOperatorRequest) (*RevokeOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOperator not implemented")
+}
+func (UnimplementedMsgServiceServer) RotateOperator(context.Context, *RotateOperatorRequest) (*RotateOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateOperator not implemented")
+}
 func (UnimplementedMsgServiceServer) AddStake(context.Context, *AddStakeRequest) (*AddStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddStake not implemented")
 }
@@ -620,18 +927,54 @@ func (UnimplementedMsgServiceServer) RemoveStake(context.Context, *RemoveStakeRe
 func (UnimplementedMsgServiceServer) CancelRemoveStake(context.Context, *CancelRemoveStakeRequest) (*CancelRemoveStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveStake not implemented")
 }
+func (UnimplementedMsgServiceServer) AmendRemoveStake(context.Context, *AmendRemoveStakeRequest) (*AmendRemoveStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendRemoveStake not implemented")
+}
+func (UnimplementedMsgServiceServer) MoveStake(context.Context, *MoveStakeRequest) (*MoveStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveStake not implemented")
+}
 func (UnimplementedMsgServiceServer) DelegateStake(context.Context, *DelegateStakeRequest) (*DelegateStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegateStake not implemented")
 }
 func (UnimplementedMsgServiceServer) RewardDelegateStake(context.Context, *RewardDelegateStakeRequest) (*RewardDelegateStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RewardDelegateStake not implemented")
 }
+func (UnimplementedMsgServiceServer) ClaimAllDelegateRewards(context.Context, *ClaimAllDelegateRewardsRequest) (*ClaimAllDelegateRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAllDelegateRewards not implemented")
+}
 func (UnimplementedMsgServiceServer) RemoveDelegateStake(context.Context, *RemoveDelegateStakeRequest) (*RemoveDelegateStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveDelegateStake not implemented")
 }
 func (UnimplementedMsgServiceServer) CancelRemoveDelegateStake(context.Context, *CancelRemoveDelegateStakeRequest) (*CancelRemoveDelegateStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveDelegateStake not implemented")
 }
+func (UnimplementedMsgServiceServer) AmendRemoveDelegateStake(context.Context, *AmendRemoveDelegateStakeRequest) (*AmendRemoveDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendRemoveDelegateStake not implemented")
+}
+func (UnimplementedMsgServiceServer) RedelegateStake(context.Context, *RedelegateStakeRequest) (*RedelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateStake not implemented")
+}
+func (UnimplementedMsgServiceServer) SetAutoCompound(context.Context, *SetAutoCompoundRequest) (*SetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (UnimplementedMsgServiceServer) SetReputerCommission(context.Context, *SetReputerCommissionRequest) (*SetReputerCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReputerCommission not implemented")
+}
+func (UnimplementedMsgServiceServer) AddWorkerBond(context.Context, *AddWorkerBondRequest) (*AddWorkerBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWorkerBond not implemented")
+}
+func (UnimplementedMsgServiceServer) RemoveWorkerBond(context.Context, *RemoveWorkerBondRequest) (*RemoveWorkerBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWorkerBond not implemented")
+}
+func (UnimplementedMsgServiceServer) CancelRemoveWorkerBond(context.Context, *CancelRemoveWorkerBondRequest) (*CancelRemoveWorkerBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveWorkerBond not implemented")
+}
+func (UnimplementedMsgServiceServer) ClaimVestedRewards(context.Context, *ClaimVestedRewardsRequest) (*ClaimVestedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimVestedRewards not implemented")
+}
+func (UnimplementedMsgServiceServer) ForfeitUnvestedRewards(context.Context, *ForfeitUnvestedRewardsRequest) (*ForfeitUnvestedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForfeitUnvestedRewards not implemented")
+}
 func (UnimplementedMsgServiceServer) FundTopic(context.Context, *FundTopicRequest) (*FundTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundTopic not implemented")
 }
@@ -644,9 +987,18 @@ func (UnimplementedMsgServiceServer) RemoveFromWhitelistAdmin(context.Context, *
 func (UnimplementedMsgServiceServer) InsertWorkerPayload(context.Context, *InsertWorkerPayloadRequest) (*InsertWorkerPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertWorkerPayload not implemented")
 }
+func (UnimplementedMsgServiceServer) CommitWorkerPayload(context.Context, *CommitWorkerPayloadRequest) (*CommitWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitWorkerPayload not implemented")
+}
+func (UnimplementedMsgServiceServer) RevealWorkerPayload(context.Context, *RevealWorkerPayloadRequest) (*RevealWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealWorkerPayload not implemented")
+}
 func (UnimplementedMsgServiceServer) InsertReputerPayload(context.Context, *InsertReputerPayloadRequest) (*InsertReputerPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertReputerPayload not implemented")
 }
+func (UnimplementedMsgServiceServer) InsertReputerGroundTruth(context.Context, *InsertReputerGroundTruthRequest) (*InsertReputerGroundTruthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertReputerGroundTruth not implemented")
+}
 func (UnimplementedMsgServiceServer) AddToGlobalWhitelist(context.Context, *AddToGlobalWhitelistRequest) (*AddToGlobalWhitelistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddToGlobalWhitelist not implemented")
 }
@@ -782,6 +1134,78 @@ func _MsgService_CreateNewTopic_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_UpdateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_UpdateTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateTopic(ctx, req.(*UpdateTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ArchiveTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ArchiveTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_ArchiveTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ArchiveTopic(ctx, req.(*ArchiveTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ProposeTopicOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeTopicOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ProposeTopicOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_ProposeTopicOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ProposeTopicOwner(ctx, req.(*ProposeTopicOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_AcceptTopicOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTopicOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AcceptTopicOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_AcceptTopicOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AcceptTopicOwnership(ctx, req.(*AcceptTopicOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterRequest)
 	if err := dec(in); err != nil {
@@ -818,6 +1242,60 @@ func _MsgService_RemoveRegistration_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_AuthorizeOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AuthorizeOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_AuthorizeOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AuthorizeOperator(ctx, req.(*AuthorizeOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RevokeOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RevokeOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RevokeOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RevokeOperator(ctx, req.(*RevokeOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RotateOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RotateOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RotateOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RotateOperator(ctx, req.(*RotateOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_AddStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddStakeRequest)
 	if err := dec(in); err != nil {
@@ -872,6 +1350,42 @@ func _MsgService_CancelRemoveStake_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_AmendRemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmendRemoveStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AmendRemoveStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_AmendRemoveStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AmendRemoveStake(ctx, req.(*AmendRemoveStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_MoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).MoveStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_MoveStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).MoveStake(ctx, req.(*MoveStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_DelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DelegateStakeRequest)
 	if err := dec(in); err != nil {
@@ -908,6 +1422,24 @@ func _MsgService_RewardDelegateStake_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_ClaimAllDelegateRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimAllDelegateRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ClaimAllDelegateRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_ClaimAllDelegateRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ClaimAllDelegateRewards(ctx, req.(*ClaimAllDelegateRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_RemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveDelegateStakeRequest)
 	if err := dec(in); err != nil {
@@ -944,6 +1476,168 @@ func _MsgService_CancelRemoveDelegateStake_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_AmendRemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmendRemoveDelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AmendRemoveDelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_AmendRemoveDelegateStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AmendRemoveDelegateStake(ctx, req.(*AmendRemoveDelegateStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RedelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RedelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RedelegateStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RedelegateStake(ctx, req.(*RedelegateStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_SetAutoCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SetAutoCompound(ctx, req.(*SetAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_SetReputerCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReputerCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SetReputerCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_SetReputerCommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SetReputerCommission(ctx, req.(*SetReputerCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_AddWorkerBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWorkerBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddWorkerBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_AddWorkerBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddWorkerBond(ctx, req.(*AddWorkerBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemoveWorkerBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWorkerBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveWorkerBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RemoveWorkerBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveWorkerBond(ctx, req.(*RemoveWorkerBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CancelRemoveWorkerBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRemoveWorkerBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CancelRemoveWorkerBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_CancelRemoveWorkerBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CancelRemoveWorkerBond(ctx, req.(*CancelRemoveWorkerBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ClaimVestedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimVestedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ClaimVestedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_ClaimVestedRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ClaimVestedRewards(ctx, req.(*ClaimVestedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ForfeitUnvestedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForfeitUnvestedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ForfeitUnvestedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_ForfeitUnvestedRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ForfeitUnvestedRewards(ctx, req.(*ForfeitUnvestedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_FundTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FundTopicRequest)
 	if err := dec(in); err != nil {
@@ -1016,6 +1710,42 @@ func _MsgService_InsertWorkerPayload_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_CommitWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitWorkerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CommitWorkerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_CommitWorkerPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CommitWorkerPayload(ctx, req.(*CommitWorkerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RevealWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealWorkerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RevealWorkerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RevealWorkerPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RevealWorkerPayload(ctx, req.(*RevealWorkerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_InsertReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InsertReputerPayloadRequest)
 	if err := dec(in); err != nil {
@@ -1034,6 +1764,24 @@ func _MsgService_InsertReputerPayload_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_InsertReputerGroundTruth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertReputerGroundTruthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).InsertReputerGroundTruth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_InsertReputerGroundTruth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).InsertReputerGroundTruth(ctx, req.(*InsertReputerGroundTruthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_AddToGlobalWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddToGlobalWhitelistRequest)
 	if err := dec(in); err != nil {
@@ -1517,6 +2265,22 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateNewTopic",
 			Handler:    _MsgService_CreateNewTopic_Handler,
 		},
+		{
+			MethodName: "UpdateTopic",
+			Handler:    _MsgService_UpdateTopic_Handler,
+		},
+		{
+			MethodName: "ArchiveTopic",
+			Handler:    _MsgService_ArchiveTopic_Handler,
+		},
+		{
+			MethodName: "ProposeTopicOwner",
+			Handler:    _MsgService_ProposeTopicOwner_Handler,
+		},
+		{
+			MethodName: "AcceptTopicOwnership",
+			Handler:    _MsgService_AcceptTopicOwnership_Handler,
+		},
 		{
 			MethodName: "Register",
 			Handler:    _MsgService_Register_Handler,
@@ -1525,6 +2289,18 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRegistration",
 			Handler:    _MsgService_RemoveRegistration_Handler,
 		},
+		{
+			MethodName: "AuthorizeOperator",
+			Handler:    _MsgService_AuthorizeOperator_Handler,
+		},
+		{
+			MethodName: "RevokeOperator",
+			Handler:    _MsgService_RevokeOperator_Handler,
+		},
+		{
+			MethodName: "RotateOperator",
+			Handler:    _MsgService_RotateOperator_Handler,
+		},
 		{
 			MethodName: "AddStake",
 			Handler:    _MsgService_AddStake_Handler,
@@ -1537,6 +2313,14 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelRemoveStake",
 			Handler:    _MsgService_CancelRemoveStake_Handler,
 		},
+		{
+			MethodName: "AmendRemoveStake",
+			Handler:    _MsgService_AmendRemoveStake_Handler,
+		},
+		{
+			MethodName: "MoveStake",
+			Handler:    _MsgService_MoveStake_Handler,
+		},
 		{
 			MethodName: "DelegateStake",
 			Handler:    _MsgService_DelegateStake_Handler,
@@ -1545,6 +2329,10 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RewardDelegateStake",
 			Handler:    _MsgService_RewardDelegateStake_Handler,
 		},
+		{
+			MethodName: "ClaimAllDelegateRewards",
+			Handler:    _MsgService_ClaimAllDelegateRewards_Handler,
+		},
 		{
 			MethodName: "RemoveDelegateStake",
 			Handler:    _MsgService_RemoveDelegateStake_Handler,
@@ -1553,6 +2341,42 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelRemoveDelegateStake",
 			Handler:    _MsgService_CancelRemoveDelegateStake_Handler,
 		},
+		{
+			MethodName: "AmendRemoveDelegateStake",
+			Handler:    _MsgService_AmendRemoveDelegateStake_Handler,
+		},
+		{
+			MethodName: "RedelegateStake",
+			Handler:    _MsgService_RedelegateStake_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _MsgService_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "SetReputerCommission",
+			Handler:    _MsgService_SetReputerCommission_Handler,
+		},
+		{
+			MethodName: "AddWorkerBond",
+			Handler:    _MsgService_AddWorkerBond_Handler,
+		},
+		{
+			MethodName: "RemoveWorkerBond",
+			Handler:    _MsgService_RemoveWorkerBond_Handler,
+		},
+		{
+			MethodName: "CancelRemoveWorkerBond",
+			Handler:    _MsgService_CancelRemoveWorkerBond_Handler,
+		},
+		{
+			MethodName: "ClaimVestedRewards",
+			Handler:    _MsgService_ClaimVestedRewards_Handler,
+		},
+		{
+			MethodName: "ForfeitUnvestedRewards",
+			Handler:    _MsgService_ForfeitUnvestedRewards_Handler,
+		},
 		{
 			MethodName: "FundTopic",
 			Handler:    _MsgService_FundTopic_Handler,
@@ -1569,10 +2393,22 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InsertWorkerPayload",
 			Handler:    _MsgService_InsertWorkerPayload_Handler,
 		},
+		{
+			MethodName: "CommitWorkerPayload",
+			Handler:    _MsgService_CommitWorkerPayload_Handler,
+		},
+		{
+			MethodName: "RevealWorkerPayload",
+			Handler:    _MsgService_RevealWorkerPayload_Handler,
+		},
 		{
 			MethodName: "InsertReputerPayload",
 			Handler:    _MsgService_InsertReputerPayload_Handler,
 		},
+		{
+			MethodName: "InsertReputerGroundTruth",
+			Handler:    _MsgService_InsertReputerGroundTruth_Handler,
+		},
 		{
 			MethodName: "AddToGlobalWhitelist",
 			Handler:    _MsgService_AddToGlobalWhitelist_Handler,
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/// ACTOR POSITIONS
+
+// Pagination keys of pending stake removals of an actor start with the kind of removal,
+// followed by the key of the removal in its by actor index
+const (
+	stakeRemovalCursorKind         byte = 0
+	delegateStakeRemovalCursorKind byte = 1
+)
+
+// Returns a page of the delegator's stake placements across all topics and reputers, in
+// ascending (topic id, reputer) order, with their pending rewards and removals
+func (k *Keeper) GetDelegatorPositions(
+	ctx sdk.Context,
+	delegator ActorId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]*types.DelegatorPosition, *types.SimpleCursorPaginationResponse, error) {
+	placements, pageResponse, err := k.getDelegateStakePlacementsPage(ctx, delegator, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	positions := make([]*types.DelegatorPosition, 0, len(placements))
+	for _, placement := range placements {
+		topicId, reputer := placement.K2(), placement.K3()
+		delegateInfo, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+		if err != nil {
+			return nil, nil, err
+		}
+		amount, err := delegateInfo.Amount.SdkIntTrim()
+		if err != nil {
+			return nil, nil, errorsmod.Wrap(err, "error trimming delegate stake placement amount")
+		}
+		pendingReward, _, err := k.pendingDelegateReward(ctx, topicId, reputer, delegateInfo)
+		if err != nil {
+			return nil, nil, err
+		}
+		removal, _, err := k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(ctx, delegator, reputer, topicId)
+		if err != nil {
+			return nil, nil, err
+		}
+		positions = append(positions, &types.DelegatorPosition{
+			TopicId:               topicId,
+			Reputer:               reputer,
+			Amount:                amount,
+			PendingReward:         pendingReward,
+			RemovalAmount:         removal.Amount,
+			BlockRemovalCompleted: removal.BlockRemovalCompleted,
+		})
+	}
+	return positions, pageResponse, nil
+}
+
+// Returns a page of the pending removals of stake the address placed as a reputer, followed by
+// the pending removals of stake it delegated, across all topics
+func (k *Keeper) GetPendingStakeRemovalsForActor(
+	ctx sdk.Context,
+	address ActorId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]*types.StakeRemovalInfo, []*types.DelegateStakeRemovalInfo, *types.SimpleCursorPaginationResponse, error) {
+	limit, err := k.CalcAppropriatePaginationLimit(ctx, pagination)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	cursorKind := stakeRemovalCursorKind
+	var cursor []byte
+	if pagination != nil && len(pagination.Key) > 0 {
+		cursorKind, cursor = pagination.Key[0], pagination.Key[1:]
+	}
+
+	stakeRemovals := make([]*types.StakeRemovalInfo, 0)
+	delegateStakeRemovals := make([]*types.DelegateStakeRemovalInfo, 0)
+	var nextKey []byte
+
+	if cursorKind == stakeRemovalCursorKind {
+		keyCodec := k.stakeRemovalsByActor.KeyCodec()
+		rng := new(collections.Range[collections.Triple[ActorId, TopicId, BlockHeight]]).
+			Prefix(collections.TriplePrefix[ActorId, TopicId, BlockHeight](address))
+		if len(cursor) > 0 {
+			_, start, err := keyCodec.Decode(cursor)
+			if err != nil || start.K1() != address {
+				return nil, nil, nil, errorsmod.Wrap(types.ErrInvalidValue, "invalid pagination key")
+			}
+			rng = rng.StartInclusive(start)
+		}
+		err = k.stakeRemovalsByActor.Walk(ctx, rng, func(key collections.Triple[ActorId, TopicId, BlockHeight]) (bool, error) {
+			if uint64(len(stakeRemovals)) >= limit {
+				next, err := encodeRemovalCursor(stakeRemovalCursorKind, keyCodec, key)
+				nextKey = next
+				return true, err
+			}
+			removal, err := k.stakeRemovalsByBlock.Get(ctx, collections.Join3(key.K3(), key.K2(), key.K1()))
+			if err != nil {
+				return true, errorsmod.Wrap(err, "error getting stake removal")
+			}
+			stakeRemovals = append(stakeRemovals, &removal)
+			return false, nil
+		})
+		if err != nil {
+			return nil, nil, nil, errorsmod.Wrap(err, "error iterating stake removals of actor")
+		}
+		if nextKey != nil {
+			return stakeRemovals, delegateStakeRemovals, &types.SimpleCursorPaginationResponse{NextKey: nextKey}, nil
+		}
+		// delegate stake removals start a new cursor
+		cursor = nil
+	} else if cursorKind != delegateStakeRemovalCursorKind {
+		return nil, nil, nil, errorsmod.Wrap(types.ErrInvalidValue, "invalid pagination key")
+	}
+
+	keyCodec := k.delegateStakeRemovalsByActor.KeyCodec()
+	rng := new(collections.Range[Quadruple[ActorId, ActorId, TopicId, BlockHeight]]).
+		Prefix(QuadrupleSinglePrefix[ActorId, ActorId, TopicId, BlockHeight](address))
+	if len(cursor) > 0 {
+		_, start, err := keyCodec.Decode(cursor)
+		if err != nil || start.K1() != address {
+			return nil, nil, nil, errorsmod.Wrap(types.ErrInvalidValue, "invalid pagination key")
+		}
+		rng = rng.StartInclusive(start)
+	}
+	err = k.delegateStakeRemovalsByActor.Walk(ctx, rng, func(key Quadruple[ActorId, ActorId, TopicId, BlockHeight]) (bool, error) {
+		if uint64(len(stakeRemovals)+len(delegateStakeRemovals)) >= limit {
+			next, err := encodeRemovalCursor(delegateStakeRemovalCursorKind, keyCodec, key)
+			nextKey = next
+			return true, err
+		}
+		removal, err := k.delegateStakeRemovalsByBlock.Get(ctx, Join4(key.K4(), key.K3(), key.K1(), key.K2()))
+		if err != nil {
+			return true, errorsmod.Wrap(err, "error getting delegate stake removal")
+		}
+		delegateStakeRemovals = append(delegateStakeRemovals, &removal)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "error iterating delegate stake removals of actor")
+	}
+	return stakeRemovals, delegateStakeRemovals, &types.SimpleCursorPaginationResponse{NextKey: nextKey}, nil
+}
+
+// Encodes the pagination key of a pending stake removal of an actor
+func encodeRemovalCursor[K any](kind byte, keyCodec codec.KeyCodec[K], key K) ([]byte, error) {
+	cursor := make([]byte, 1+keyCodec.Size(key))
+	cursor[0] = kind
+	if _, err := keyCodec.Encode(cursor[1:], key); err != nil {
+		return nil, errorsmod.Wrap(err, "error encoding pagination key")
+	}
+	return cursor, nil
+}
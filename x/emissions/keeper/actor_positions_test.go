@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *KeeperTestSuite) TestGetDelegatorPositions() {
+	k := s.emissionsKeeper
+	delegator := s.addrsStr[0]
+	reputerA := s.addrsStr[1]
+	reputerB := s.addrsStr[2]
+	topicId := s.mockTopic().Id
+
+	s.mintTo(types.AlloraStakingAccountName, cosmosMath.NewInt(300))
+	s.Require().NoError(k.AddDelegateStake(s.ctx, topicId, delegator, reputerA, cosmosMath.NewInt(100)))
+	s.Require().NoError(k.AddDelegateStake(s.ctx, topicId, delegator, reputerB, cosmosMath.NewInt(200)))
+	s.Require().NoError(k.SetDelegateRewardPerShare(s.ctx, topicId, reputerA, alloraMath.MustNewDecFromString("0.5")))
+	removal := types.DelegateStakeRemovalInfo{
+		BlockRemovalStarted:   s.ctx.BlockHeight(),
+		BlockRemovalCompleted: s.ctx.BlockHeight() + 100,
+		TopicId:               topicId,
+		Delegator:             delegator,
+		Reputer:               reputerA,
+		Amount:                cosmosMath.NewInt(40),
+	}
+	s.Require().NoError(k.SetDelegateStakeRemoval(s.ctx, removal))
+
+	positions, pageResponse, err := k.GetDelegatorPositions(s.ctx, delegator, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(pageResponse.NextKey)
+	s.Require().Len(positions, 2)
+	for _, position := range positions {
+		s.Require().Equal(topicId, position.TopicId)
+		switch position.Reputer {
+		case reputerA:
+			s.Require().True(cosmosMath.NewInt(100).Equal(position.Amount))
+			s.Require().True(cosmosMath.NewInt(50).Equal(position.PendingReward))
+			s.Require().True(removal.Amount.Equal(position.RemovalAmount))
+			s.Require().Equal(removal.BlockRemovalCompleted, position.BlockRemovalCompleted)
+		case reputerB:
+			s.Require().True(cosmosMath.NewInt(200).Equal(position.Amount))
+			s.Require().True(position.PendingReward.IsZero())
+			s.Require().True(position.RemovalAmount.IsZero())
+			s.Require().Zero(position.BlockRemovalCompleted)
+		default:
+			s.Fail("unexpected reputer", position.Reputer)
+		}
+	}
+
+	firstPage, pageResponse, err := k.GetDelegatorPositions(s.ctx, delegator, &types.SimpleCursorPaginationRequest{Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Len(firstPage, 1)
+	secondPage, pageResponse, err := k.GetDelegatorPositions(s.ctx, delegator, &types.SimpleCursorPaginationRequest{
+		Key:   pageResponse.NextKey,
+		Limit: 1,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(secondPage, 1)
+	s.Require().Empty(pageResponse.NextKey)
+	s.Require().NotEqual(firstPage[0].Reputer, secondPage[0].Reputer)
+}
+
+func (s *KeeperTestSuite) TestGetPendingStakeRemovalsForActor() {
+	k := s.emissionsKeeper
+	actor := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+	otherActor := s.addrsStr[2]
+	start := s.ctx.BlockHeight()
+
+	for _, removal := range []types.StakeRemovalInfo{
+		{BlockRemovalStarted: start, BlockRemovalCompleted: start + 10, TopicId: 1, Reputer: actor, Amount: cosmosMath.NewInt(10)},
+		{BlockRemovalStarted: start, BlockRemovalCompleted: start + 20, TopicId: 2, Reputer: actor, Amount: cosmosMath.NewInt(20)},
+		{BlockRemovalStarted: start, BlockRemovalCompleted: start + 10, TopicId: 1, Reputer: otherActor, Amount: cosmosMath.NewInt(30)},
+	} {
+		s.Require().NoError(k.SetStakeRemoval(s.ctx, removal))
+	}
+	for _, removal := range []types.DelegateStakeRemovalInfo{
+		{BlockRemovalStarted: start, BlockRemovalCompleted: start + 10, TopicId: 1, Delegator: actor, Reputer: reputer, Amount: cosmosMath.NewInt(40)},
+		{BlockRemovalStarted: start, BlockRemovalCompleted: start + 30, TopicId: 3, Delegator: actor, Reputer: reputer, Amount: cosmosMath.NewInt(50)},
+		{BlockRemovalStarted: start, BlockRemovalCompleted: start + 10, TopicId: 1, Delegator: otherActor, Reputer: reputer, Amount: cosmosMath.NewInt(60)},
+	} {
+		s.Require().NoError(k.SetDelegateStakeRemoval(s.ctx, removal))
+	}
+
+	// A page continues from the removals of own stake into the removals of delegated stake
+	page := &types.SimpleCursorPaginationRequest{Limit: 3}
+	stakeRemovals, delegateStakeRemovals, pageResponse, err := k.GetPendingStakeRemovalsForActor(s.ctx, actor, page)
+	s.Require().NoError(err)
+	s.Require().Len(stakeRemovals, 2)
+	s.Require().Equal(uint64(1), stakeRemovals[0].TopicId)
+	s.Require().Equal(uint64(2), stakeRemovals[1].TopicId)
+	s.Require().Len(delegateStakeRemovals, 1)
+	s.Require().True(cosmosMath.NewInt(40).Equal(delegateStakeRemovals[0].Amount))
+	s.Require().NotEmpty(pageResponse.NextKey)
+
+	page = &types.SimpleCursorPaginationRequest{Key: pageResponse.NextKey, Limit: 3}
+	stakeRemovals, delegateStakeRemovals, pageResponse, err = k.GetPendingStakeRemovalsForActor(s.ctx, actor, page)
+	s.Require().NoError(err)
+	s.Require().Empty(stakeRemovals)
+	s.Require().Len(delegateStakeRemovals, 1)
+	s.Require().Equal(start+30, delegateStakeRemovals[0].BlockRemovalCompleted)
+	s.Require().Empty(pageResponse.NextKey)
+
+	// A page can end exactly at the last removal of own stake
+	page = &types.SimpleCursorPaginationRequest{Limit: 2}
+	stakeRemovals, delegateStakeRemovals, pageResponse, err = k.GetPendingStakeRemovalsForActor(s.ctx, actor, page)
+	s.Require().NoError(err)
+	s.Require().Len(stakeRemovals, 2)
+	s.Require().Empty(delegateStakeRemovals)
+	page = &types.SimpleCursorPaginationRequest{Key: pageResponse.NextKey, Limit: 2}
+	stakeRemovals, delegateStakeRemovals, _, err = k.GetPendingStakeRemovalsForActor(s.ctx, actor, page)
+	s.Require().NoError(err)
+	s.Require().Empty(stakeRemovals)
+	s.Require().Len(delegateStakeRemovals, 2)
+
+	_, _, _, err = k.GetPendingStakeRemovalsForActor(s.ctx, actor, &types.SimpleCursorPaginationRequest{Key: []byte{7}})
+	s.Require().ErrorIs(err, types.ErrInvalidValue)
+}
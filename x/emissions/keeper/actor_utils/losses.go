@@ -69,55 +69,30 @@ func CloseReputerNonce(
 		return err
 	}
 
-	lossBundlesByReputer := make([]*types.ReputerValueBundle, 0)
-	stakesByReputer := make(map[string]cosmosMath.Int)
-	for _, address := range activeReputerAddresses {
-		bundle, err := k.GetReputerLatestLossByTopicId(ctx, topic.Id, address)
+	var bundles types.ReputerValueBundles
+	var networkLossBundle types.ValueBundle
+	if topic.ComputesLossesOnChain() {
+		bundles, networkLossBundle, err = calcLossesFromGroundTruths(k, ctx, topic, nonce, params, activeReputerAddresses)
 		if err != nil {
-			return types.ErrNoValidBundles
+			return err
 		}
-
-		// Check that the reputer enough stake in the topic
-		stake, err := k.GetStakeReputerAuthority(ctx, topic.Id, bundle.ValueBundle.Reputer)
+	} else {
+		var stakesByReputer map[string]cosmosMath.Int
+		bundles, stakesByReputer, err = getActiveReputerLossBundles(k, ctx, topic, params, activeReputerAddresses)
 		if err != nil {
-			continue
+			return err
 		}
-		if stake.LT(params.RequiredMinimumStake) {
-			continue
-		}
-
-		// Examine forecast elements to verify that they're for registered inferers in the current set.
-		// A check of their registration and other filters have already been applied when their inferences were inserted.
-		// We keep what we can, ignoring the reputer and their contribution (losses) entirely
-		// if they're left with no valid losses.
-		filteredBundle, err := FilterUnacceptedWorkersFromReputerValueBundle(k, ctx, topic.Id, *bundle.ValueBundle.ReputerRequestNonce, &bundle)
+		networkLossBundle, err = synth.CalcTopicNetworkLosses(topic, nonce.BlockHeight, stakesByReputer, bundles)
 		if err != nil {
-			continue
+			return err
 		}
-
-		/// Filtering done now, now write what we must for inclusion
-		lossBundlesByReputer = append(lossBundlesByReputer, filteredBundle)
-		stakesByReputer[bundle.ValueBundle.Reputer] = stake
 	}
 
-	// sort by reputer score descending
-	sort.Slice(lossBundlesByReputer, func(i, j int) bool {
-		return lossBundlesByReputer[i].ValueBundle.Reputer < lossBundlesByReputer[j].ValueBundle.Reputer
-	})
-
-	bundles := types.ReputerValueBundles{
-		ReputerValueBundles: lossBundlesByReputer,
-	}
 	err = k.InsertActiveReputerLosses(ctx, topic.Id, nonce.BlockHeight, bundles)
 	if err != nil {
 		return err
 	}
 
-	networkLossBundle, err := synth.CalcNetworkLosses(topic.Id, nonce.BlockHeight, stakesByReputer, bundles)
-	if err != nil {
-		return err
-	}
-
 	ctx.Logger().Debug(fmt.Sprintf("Reputer Nonce %d Network Loss Bundle %v", &nonce.BlockHeight, networkLossBundle))
 
 	err = k.InsertNetworkLossBundleAtBlock(ctx, topic.Id, nonce.BlockHeight, networkLossBundle)
@@ -175,6 +150,134 @@ func CloseReputerNonce(
 	return nil
 }
 
+// Returns the loss bundles of the active reputers with enough stake in the topic,
+// along with the stake of each of these reputers
+func getActiveReputerLossBundles(
+	k *keeper.Keeper,
+	ctx sdk.Context,
+	topic types.Topic,
+	params types.Params,
+	activeReputerAddresses []string,
+) (types.ReputerValueBundles, map[string]cosmosMath.Int, error) {
+	lossBundlesByReputer := make([]*types.ReputerValueBundle, 0)
+	stakesByReputer := make(map[string]cosmosMath.Int)
+	for _, address := range activeReputerAddresses {
+		bundle, err := k.GetReputerLatestLossByTopicId(ctx, topic.Id, address)
+		if err != nil {
+			return types.ReputerValueBundles{}, nil, types.ErrNoValidBundles
+		}
+
+		// Check that the reputer enough stake in the topic
+		stake, err := k.GetStakeReputerAuthority(ctx, topic.Id, bundle.ValueBundle.Reputer)
+		if err != nil {
+			continue
+		}
+		if stake.LT(params.RequiredMinimumStake) {
+			continue
+		}
+
+		// Examine forecast elements to verify that they're for registered inferers in the current set.
+		// A check of their registration and other filters have already been applied when their inferences were inserted.
+		// We keep what we can, ignoring the reputer and their contribution (losses) entirely
+		// if they're left with no valid losses.
+		filteredBundle, err := FilterUnacceptedWorkersFromReputerValueBundle(k, ctx, topic.Id, *bundle.ValueBundle.ReputerRequestNonce, &bundle)
+		if err != nil {
+			continue
+		}
+
+		/// Filtering done now, now write what we must for inclusion
+		lossBundlesByReputer = append(lossBundlesByReputer, filteredBundle)
+		stakesByReputer[bundle.ValueBundle.Reputer] = stake
+	}
+
+	// sort by reputer score descending
+	sort.Slice(lossBundlesByReputer, func(i, j int) bool {
+		return lossBundlesByReputer[i].ValueBundle.Reputer < lossBundlesByReputer[j].ValueBundle.Reputer
+	})
+
+	return types.ReputerValueBundles{ReputerValueBundles: lossBundlesByReputer}, stakesByReputer, nil
+}
+
+// Computes the losses of the nonce from the ground truths submitted by the active reputers of a topic
+// whose losses are computed by the chain. Each reputer gets the bundle of losses implied by its own
+// ground truth, and network losses are computed from the stake-weighted consensus ground truth,
+// which is stored for auditing.
+func calcLossesFromGroundTruths(
+	k *keeper.Keeper,
+	ctx sdk.Context,
+	topic types.Topic,
+	nonce types.Nonce,
+	params types.Params,
+	activeReputerAddresses []string,
+) (types.ReputerValueBundles, types.ValueBundle, error) {
+	groundTruths := make([]types.GroundTruth, 0)
+	stakesByReputer := make(map[string]cosmosMath.Int)
+	for _, address := range activeReputerAddresses {
+		groundTruth, err := k.GetReputerLatestGroundTruthByTopicId(ctx, topic.Id, address)
+		if err != nil {
+			return types.ReputerValueBundles{}, types.ValueBundle{}, types.ErrNoValidBundles
+		}
+		if groundTruth.BlockHeight != nonce.BlockHeight {
+			continue
+		}
+
+		// Check that the reputer enough stake in the topic
+		stake, err := k.GetStakeReputerAuthority(ctx, topic.Id, groundTruth.Reputer)
+		if err != nil {
+			continue
+		}
+		if stake.LT(params.RequiredMinimumStake) {
+			continue
+		}
+
+		groundTruths = append(groundTruths, groundTruth)
+		stakesByReputer[groundTruth.Reputer] = stake
+	}
+	if len(groundTruths) == 0 {
+		return types.ReputerValueBundles{}, types.ValueBundle{}, types.ErrNoValidBundles
+	}
+
+	// Ensure deterministic ordering
+	sort.Slice(groundTruths, func(i, j int) bool {
+		return groundTruths[i].Reputer < groundTruths[j].Reputer
+	})
+
+	networkInferences, err := synth.GetNetworkInferences(ctx, *k, topic.Id, &nonce.BlockHeight, false)
+	if err != nil {
+		return types.ReputerValueBundles{}, types.ValueBundle{}, errorsmod.Wrap(err, "error getting network inferences of nonce")
+	}
+
+	lossBundlesByReputer := make([]*types.ReputerValueBundle, 0, len(groundTruths))
+	for _, groundTruth := range groundTruths {
+		lossBundle, err := synth.CalcLossBundleFromGroundTruth(
+			topic, nonce.BlockHeight, groundTruth.Reputer, networkInferences.NetworkInferences, groundTruth.Values)
+		if err != nil {
+			return types.ReputerValueBundles{}, types.ValueBundle{}, errorsmod.Wrapf(err, "error calculating losses of reputer %s", groundTruth.Reputer)
+		}
+		lossBundlesByReputer = append(lossBundlesByReputer, &types.ReputerValueBundle{
+			ValueBundle: lossBundle,
+			Signature:   nil,
+			Pubkey:      "",
+		})
+	}
+
+	consensus, err := synth.CalcConsensusGroundTruth(topic.Id, nonce.BlockHeight, stakesByReputer, groundTruths)
+	if err != nil {
+		return types.ReputerValueBundles{}, types.ValueBundle{}, err
+	}
+	err = k.SetConsensusGroundTruth(ctx, consensus)
+	if err != nil {
+		return types.ReputerValueBundles{}, types.ValueBundle{}, err
+	}
+
+	networkLossBundle, err := synth.CalcNetworkLossesFromGroundTruth(topic, nonce.BlockHeight, networkInferences.NetworkInferences, consensus)
+	if err != nil {
+		return types.ReputerValueBundles{}, types.ValueBundle{}, err
+	}
+
+	return types.ReputerValueBundles{ReputerValueBundles: lossBundlesByReputer}, networkLossBundle, nil
+}
+
 // Filter out values of unaccepted workers.
 // It is assumed that the work of inferers and forecasters stored at the nonce is already filtered for acceptance.
 // This also removes duplicate values of the same worker.
@@ -307,6 +410,8 @@ func FilterUnacceptedWorkersFromReputerValueBundle(
 			OneOutInfererForecasterValues: acceptedOneOutInfererForecasterValues,
 			NaiveValue:                    reputerValueBundle.ValueBundle.NaiveValue,
 			CombinedValue:                 reputerValueBundle.ValueBundle.CombinedValue,
+			NaiveValues:                   reputerValueBundle.ValueBundle.NaiveValues,
+			CombinedValues:                reputerValueBundle.ValueBundle.CombinedValues,
 		},
 		Signature: reputerValueBundle.Signature,
 	}
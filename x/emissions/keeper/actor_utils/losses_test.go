@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/core/header"
 	clog "cosmossdk.io/log"
+	cosmosMath "cosmossdk.io/math"
 	alloratestutil "github.com/allora-network/allora-chain/test/testutil"
 
 	storetypes "cosmossdk.io/store/types"
@@ -65,6 +66,7 @@ func (a *ActorUtilsTestSuite) SetupTest() {
 		emissionstypes.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		emissionstypes.AlloraRewardsAccountName: {"minter"},
 		emissionstypes.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		emissionstypes.AlloraRewardEscrowAccountName:              {"minter"},
 		"bonded_tokens_pool":     {"burner", "staking"},
 		"not_bonded_tokens_pool": {"burner", "staking"},
 		multiPerm:                {"burner", "minter", "staking"},
@@ -116,23 +118,35 @@ func (a *ActorUtilsTestSuite) SetupTest() {
 	}
 
 	err := a.emissionsKeeper.SetTopic(a.ctx, 1, emissionstypes.Topic{
-		Id:                       1,
-		Creator:                  a.addrsStr[49],
-		Metadata:                 "metadata",
-		LossMethod:               "mse",
-		EpochLastEnded:           0,
-		EpochLength:              100,
-		GroundTruthLag:           100,
-		WorkerSubmissionWindow:   100,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-		AllowNegative:            false,
-		InitialRegret:            alloraMath.MustNewDecFromString("0.0001"),
-		Epsilon:                  alloraMath.MustNewDecFromString("0.0001"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.0001"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.0001"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.0001"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.0001"),
+		Id:                            1,
+		Creator:                       a.addrsStr[49],
+		Metadata:                      "metadata",
+		LossMethod:                    "mse",
+		EpochLastEnded:                0,
+		EpochLength:                   100,
+		GroundTruthLag:                100,
+		WorkerSubmissionWindow:        100,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		AllowNegative:                 false,
+		InitialRegret:                 alloraMath.MustNewDecFromString("0.0001"),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.0001"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.0001"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.0001"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.0001"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.0001"),
+		OutputDimension:               0,
+		VectorSynthesisMode:           emissionstypes.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           emissionstypes.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	})
 	a.Require().NoError(err)
 }
@@ -204,10 +218,12 @@ func (a *ActorUtilsTestSuite) TestFilterUnacceptedWorkersFromReputerValueBundle(
 					{
 						Inferer: inferer1,
 						Value:   alloraMath.NewDecFromInt64(1),
+						Values:  nil,
 					},
 					{
 						Inferer: inferer2,
 						Value:   alloraMath.NewDecFromInt64(2),
+						Values:  nil,
 					},
 				},
 			},
@@ -219,6 +235,7 @@ func (a *ActorUtilsTestSuite) TestFilterUnacceptedWorkersFromReputerValueBundle(
 					{
 						Inferer: inferer1,
 						Value:   alloraMath.NewDecFromInt64(3),
+						Values:  nil,
 					},
 				},
 			},
@@ -237,42 +254,44 @@ func (a *ActorUtilsTestSuite) TestFilterUnacceptedWorkersFromReputerValueBundle(
 		ExtraData:     nil,
 		CombinedValue: alloraMath.NewDecFromInt64(1000),
 		InfererValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: inferer1, Value: alloraMath.NewDecFromInt64(100)},
-			{Worker: inferer2, Value: alloraMath.NewDecFromInt64(100)},
-			{Worker: inferer5, Value: alloraMath.NewDecFromInt64(200)}, // Should be filtered out
+			{Worker: inferer1, Value: alloraMath.NewDecFromInt64(100), Values: nil},
+			{Worker: inferer2, Value: alloraMath.NewDecFromInt64(100), Values: nil},
+			{Worker: inferer5, Value: alloraMath.NewDecFromInt64(200), Values: nil}, // Should be filtered out
 		},
 		ForecasterValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: forecaster1, Value: alloraMath.NewDecFromInt64(300)},
-			{Worker: forecaster3, Value: alloraMath.NewDecFromInt64(400)}, // Should be filtered out
+			{Worker: forecaster1, Value: alloraMath.NewDecFromInt64(300), Values: nil},
+			{Worker: forecaster3, Value: alloraMath.NewDecFromInt64(400), Values: nil}, // Should be filtered out
 		},
 		NaiveValue: alloraMath.NewDecFromInt64(1000),
 		OneOutInfererValues: []*emissionstypes.WithheldWorkerAttributedValue{
-			{Worker: inferer1, Value: alloraMath.NewDecFromInt64(500)},
-			{Worker: inferer5, Value: alloraMath.NewDecFromInt64(600)}, // Should be filtered out
+			{Worker: inferer1, Value: alloraMath.NewDecFromInt64(500), Values: nil},
+			{Worker: inferer5, Value: alloraMath.NewDecFromInt64(600), Values: nil}, // Should be filtered out
 		},
 		OneOutForecasterValues: []*emissionstypes.WithheldWorkerAttributedValue{
-			{Worker: forecaster1, Value: alloraMath.NewDecFromInt64(700)},
-			{Worker: forecaster4, Value: alloraMath.NewDecFromInt64(800)}, // Should be filtered out
+			{Worker: forecaster1, Value: alloraMath.NewDecFromInt64(700), Values: nil},
+			{Worker: forecaster4, Value: alloraMath.NewDecFromInt64(800), Values: nil}, // Should be filtered out
 		},
 		OneOutInfererForecasterValues: []*emissionstypes.OneOutInfererForecasterValues{
 			{
 				Forecaster: forecaster1,
 				OneOutInfererValues: []*emissionstypes.WithheldWorkerAttributedValue{
-					{Worker: inferer1, Value: alloraMath.NewDecFromInt64(900)},
-					{Worker: inferer5, Value: alloraMath.NewDecFromInt64(1000)}, // Should be filtered out
+					{Worker: inferer1, Value: alloraMath.NewDecFromInt64(900), Values: nil},
+					{Worker: inferer5, Value: alloraMath.NewDecFromInt64(1000), Values: nil}, // Should be filtered out
 				},
 			},
 			{
 				Forecaster: forecaster3, // Should be filtered out
 				OneOutInfererValues: []*emissionstypes.WithheldWorkerAttributedValue{
-					{Worker: inferer1, Value: alloraMath.NewDecFromInt64(1100)},
+					{Worker: inferer1, Value: alloraMath.NewDecFromInt64(1100), Values: nil},
 				},
 			},
 		},
 		OneInForecasterValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: forecaster1, Value: alloraMath.NewDecFromInt64(1200)},
-			{Worker: forecaster5, Value: alloraMath.NewDecFromInt64(1300)}, // Should be filtered out
+			{Worker: forecaster1, Value: alloraMath.NewDecFromInt64(1200), Values: nil},
+			{Worker: forecaster5, Value: alloraMath.NewDecFromInt64(1300), Values: nil}, // Should be filtered out
 		},
+		CombinedValues: nil,
+		NaiveValues:    nil,
 	}
 	signature := a.signValueBundle(valueBundle, a.privKeys[40])
 	reputerValueBundle := &emissionstypes.ReputerValueBundle{
@@ -293,3 +312,81 @@ func (a *ActorUtilsTestSuite) TestFilterUnacceptedWorkersFromReputerValueBundle(
 	a.Require().Len(acceptedBundle.ValueBundle.OneOutInfererForecasterValues[0].OneOutInfererValues, 1)
 	a.Require().Len(acceptedBundle.ValueBundle.OneInForecasterValues, 1)
 }
+
+func (a *ActorUtilsTestSuite) TestCloseReputerNonceComputesLossesFromGroundTruths() {
+	require := a.Require()
+	k := &a.emissionsKeeper
+	topicId := uint64(1)
+	nonce := emissionstypes.Nonce{BlockHeight: 1}
+
+	topic, err := k.GetTopic(a.ctx, topicId)
+	require.NoError(err)
+	topic.LossComputationMode = emissionstypes.LossComputationMode_LOSS_COMPUTATION_MODE_CHAIN
+	err = k.SetTopic(a.ctx, topicId, topic)
+	require.NoError(err)
+	params, err := k.GetParams(a.ctx)
+	require.NoError(err)
+
+	inferer := a.addrsStr[0]
+	err = k.InsertActiveInferences(a.ctx, topicId, nonce.BlockHeight, emissionstypes.Inferences{
+		Inferences: []*emissionstypes.Inference{
+			{TopicId: topicId, BlockHeight: nonce.BlockHeight, Inferer: inferer, Value: alloraMath.NewDecFromInt64(3), ExtraData: nil, Proof: "", Values: nil},
+		},
+	})
+	require.NoError(err)
+	err = k.InsertActiveForecasts(a.ctx, topicId, nonce.BlockHeight, emissionstypes.Forecasts{Forecasts: []*emissionstypes.Forecast{}})
+	require.NoError(err)
+	err = k.AddWorkerNonce(a.ctx, topicId, &nonce)
+	require.NoError(err)
+	_, err = k.FulfillWorkerNonce(a.ctx, topicId, &nonce)
+	require.NoError(err)
+	err = k.AddReputerNonce(a.ctx, topicId, &nonce)
+	require.NoError(err)
+
+	// The reputer with most of the stake drives the consensus ground truth
+	groundTruths := map[string]int64{a.addrsStr[40]: 1, a.addrsStr[41]: 2, a.addrsStr[42]: 100}
+	stakes := map[string]int64{a.addrsStr[40]: 800, a.addrsStr[41]: 100, a.addrsStr[42]: 100}
+	for reputer, groundTruth := range groundTruths {
+		stake := params.RequiredMinimumStake.Add(cosmosMath.NewInt(stakes[reputer]))
+		err = k.AddReputerStake(a.ctx, topicId, reputer, stake)
+		require.NoError(err)
+		err = k.AppendReputerGroundTruth(a.ctx, topic, params, emissionstypes.GroundTruth{
+			TopicId:     topicId,
+			BlockHeight: nonce.BlockHeight,
+			Reputer:     reputer,
+			Values:      []alloraMath.Dec{alloraMath.NewDecFromInt64(groundTruth)},
+		})
+		require.NoError(err)
+	}
+
+	a.ctx = a.ctx.WithBlockHeight(nonce.BlockHeight + topic.GroundTruthLag)
+	err = actorutils.CloseReputerNonce(k, a.ctx, topic, nonce)
+	require.NoError(err)
+
+	// Each reputer gets the losses implied by its own ground truth
+	lossBundles, err := k.GetReputerLossBundlesAtBlock(a.ctx, topicId, nonce.BlockHeight)
+	require.NoError(err)
+	require.Len(lossBundles.ReputerValueBundles, 3)
+	for _, bundle := range lossBundles.ReputerValueBundles {
+		require.True(bundle.IsChainComputed())
+		groundTruth := alloraMath.NewDecFromInt64(groundTruths[bundle.ValueBundle.Reputer])
+		expectedLoss, err := alloraMath.SquaredError(alloraMath.NewDecFromInt64(3), groundTruth)
+		require.NoError(err)
+		alloratestutil.InEpsilon5Dec(a.T(), bundle.ValueBundle.InfererValues[0].Value, expectedLoss)
+	}
+
+	consensus, err := k.GetConsensusGroundTruthAtBlock(a.ctx, topicId, nonce.BlockHeight)
+	require.NoError(err)
+	require.Len(consensus.Values, 1)
+	require.True(consensus.Values[0].Lt(alloraMath.NewDecFromInt64(2)))
+
+	networkLosses, err := k.GetNetworkLossBundleAtBlock(a.ctx, topicId, nonce.BlockHeight)
+	require.NoError(err)
+	expectedNetworkLoss, err := alloraMath.SquaredError(alloraMath.NewDecFromInt64(3), consensus.Values[0])
+	require.NoError(err)
+	alloratestutil.InEpsilon5Dec(a.T(), networkLosses.CombinedValue, expectedNetworkLoss)
+
+	// Submitted ground truths are cleared once the nonce is closed
+	_, err = k.GetReputerLatestGroundTruthByTopicId(a.ctx, topicId, a.addrsStr[40])
+	require.Error(err)
+}
@@ -97,10 +97,13 @@ func CloseWorkerNonce(k *keeper.Keeper, ctx sdk.Context, topic types.Topic, nonc
 		return err
 	}
 
-	// Once inferences are closed, update the network inferences outlier metrics
-	err = k.UpdateNetworkInferencesOutlierMetrics(ctx, topic.Id, nonce.BlockHeight)
-	if err != nil {
-		return err
+	// Once inferences are closed, update the network inferences outlier metrics.
+	// These are only defined for scalar inferences, so vector topics are never filtered.
+	if !topic.HasVectorOutput() {
+		err = k.UpdateNetworkInferencesOutlierMetrics(ctx, topic.Id, nonce.BlockHeight)
+		if err != nil {
+			return err
+		}
 	}
 
 	types.EmitNewWorkerLastCommitSetEvent(ctx, topic.Id, blockHeight, &nonce)
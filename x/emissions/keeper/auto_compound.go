@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/// AUTO-COMPOUNDING
+
+// Opts the delegator in or out of restaking its rewards on the topic as they are paid out
+func (k *Keeper) SetAutoCompound(ctx context.Context, topicId TopicId, delegator ActorId, enabled bool) error {
+	key := collections.Join(topicId, delegator)
+	if enabled {
+		return k.autoCompoundDelegators.Set(ctx, key)
+	}
+	return k.autoCompoundDelegators.Remove(ctx, key)
+}
+
+// Returns true if the delegator restakes its rewards on the topic as they are paid out
+func (k *Keeper) IsAutoCompoundEnabled(ctx context.Context, topicId TopicId, delegator ActorId) (bool, error) {
+	return k.autoCompoundDelegators.Has(ctx, collections.Join(topicId, delegator))
+}
+
+// Returns the delegators that restake their rewards on the topic as they are paid out
+func (k *Keeper) GetAutoCompoundDelegatorsOfTopic(ctx context.Context, topicId TopicId) ([]ActorId, error) {
+	rng := collections.NewPrefixedPairRange[TopicId, Delegator](topicId)
+	iter, err := k.autoCompoundDelegators.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error iterating auto compound delegators")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error getting auto compound delegators")
+	}
+	delegators := make([]ActorId, 0, len(keys))
+	for _, key := range keys {
+		delegators = append(delegators, key.K2())
+	}
+	return delegators, nil
+}
+
+// CompoundDelegateRewards restakes the pending rewards of every delegator that opted into
+// auto-compounding on the topic, as new delegate stake on the reputer that earned them.
+// Like any other delegation, compounding is held to the reputer stake share cap: rewards that
+// would take the reputer over it stay pending, to be claimed with RewardDelegateStake.
+// Nothing is compounded on an archived topic, whose delegate stake is being removed
+func (k *Keeper) CompoundDelegateRewards(ctx sdk.Context, topicId TopicId) error {
+	archived, err := k.IsTopicArchived(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	if archived {
+		return nil
+	}
+	delegators, err := k.GetAutoCompoundDelegatorsOfTopic(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	for _, delegator := range delegators {
+		rng := collections.NewSuperPrefixedTripleRange[TopicId, Delegator, Reputer](topicId, delegator)
+		iter, err := k.delegatedStakes.Iterate(ctx, rng)
+		if err != nil {
+			return errorsmod.Wrap(err, "error iterating delegated stakes")
+		}
+		kvs, err := iter.KeyValues()
+		if err != nil {
+			return errorsmod.Wrap(err, "error getting delegated stakes")
+		}
+		for _, kv := range kvs {
+			if err := k.compoundDelegateReward(ctx, topicId, delegator, kv.Key.K3(), kv.Value); err != nil {
+				return errorsmod.Wrapf(err, "error compounding reward of delegator %s on reputer %s", delegator, kv.Key.K3())
+			}
+		}
+	}
+	return nil
+}
+
+// Moves the pending reward of a delegation from the pending reward account to the staking
+// account and adds it to the delegation
+func (k *Keeper) compoundDelegateReward(
+	ctx sdk.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+	placement types.DelegatorInfo,
+) error {
+	share, err := k.GetDelegateRewardPerShare(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	}
+	pendingReward, err := placement.Amount.Mul(share)
+	if err != nil {
+		return err
+	}
+	pendingReward, err = pendingReward.Sub(placement.RewardDebt)
+	if err != nil {
+		return err
+	}
+	if !pendingReward.IsPositive() {
+		return nil
+	}
+	pendingRewardInt, err := pendingReward.SdkIntTrim()
+	if err != nil {
+		return err
+	}
+	if !pendingRewardInt.IsPositive() {
+		return nil
+	}
+	err = k.CheckReputerStakeShareCap(ctx, topicId, reputer, pendingRewardInt, pendingRewardInt)
+	if errorsmod.IsOf(err, types.ErrReputerStakeShareCapExceeded) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// settle the reward in the debt so that AddDelegateStake does not pay it out again
+	pendingRewardDec, err := alloraMath.NewDecFromSdkInt(pendingRewardInt)
+	if err != nil {
+		return err
+	}
+	placement.RewardDebt, err = placement.RewardDebt.Add(pendingRewardDec)
+	if err != nil {
+		return err
+	}
+	if err := k.SetDelegateStakePlacement(ctx, topicId, delegator, reputer, placement); err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, pendingRewardInt))
+	err = k.SendCoinsFromModuleToModule(ctx, types.AlloraPendingRewardForDelegatorAccountName, types.AlloraStakingAccountName, coins)
+	if err != nil {
+		return err
+	}
+	if err := k.AddDelegateStake(ctx, topicId, delegator, reputer, pendingRewardInt); err != nil {
+		return err
+	}
+	types.EmitNewDelegateRewardCompoundedEvent(ctx, topicId, delegator, reputer, pendingRewardInt)
+	ctx.Logger().Debug(fmt.Sprintf("Compounded reward %s of delegator %s on reputer %s in topic %d",
+		pendingRewardInt, delegator, reputer, topicId))
+	return nil
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestCompoundDelegateRewards() {
+	k := s.emissionsKeeper
+	topicId := s.mockTopic().Id
+	reputer := s.addrsStr[0]
+	compounder := s.addrsStr[1]
+	claimer := s.addrsStr[2]
+
+	s.mintTo(types.AlloraStakingAccountName, cosmosMath.NewInt(600))
+	s.Require().NoError(k.AddDelegateStake(s.ctx, topicId, compounder, reputer, cosmosMath.NewInt(400)))
+	s.Require().NoError(k.AddDelegateStake(s.ctx, topicId, claimer, reputer, cosmosMath.NewInt(200)))
+	// 300 of rewards are paid out to the delegators of the reputer
+	s.mintTo(types.AlloraPendingRewardForDelegatorAccountName, cosmosMath.NewInt(300))
+	s.Require().NoError(k.SetDelegateRewardPerShare(s.ctx, topicId, reputer, alloraMath.MustNewDecFromString("0.5")))
+
+	s.Require().NoError(k.SetAutoCompound(s.ctx, topicId, compounder, true))
+	enabled, err := k.IsAutoCompoundEnabled(s.ctx, topicId, compounder)
+	s.Require().NoError(err)
+	s.Require().True(enabled)
+	s.Require().NoError(k.CompoundDelegateRewards(s.ctx, topicId))
+
+	placement, err := k.GetDelegateStakePlacement(s.ctx, topicId, compounder, reputer)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.NewDecFromInt64(600).Equal(placement.Amount))
+	s.Require().True(alloraMath.NewDecFromInt64(300).Equal(placement.RewardDebt))
+	compounderAddr, err := sdk.AccAddressFromBech32(compounder)
+	s.Require().NoError(err)
+	s.Require().True(s.bankKeeper.GetBalance(s.ctx, compounderAddr, params.DefaultBondDenom).Amount.IsZero())
+	stakeUponReputer, err := k.GetDelegateStakeUponReputer(s.ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(800), stakeUponReputer)
+	totalStake, err := k.GetTotalStake(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(800), totalStake)
+
+	// Delegators that did not opt in keep their reward pending
+	placement, err = k.GetDelegateStakePlacement(s.ctx, topicId, claimer, reputer)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.NewDecFromInt64(200).Equal(placement.Amount))
+	s.Require().True(placement.RewardDebt.IsZero())
+
+	for _, invariant := range []sdk.Invariant{
+		keeper.StakingInvariantTotalStakeEqualAlloraStakingBankBalance(k),
+		keeper.StakingInvariantDelegatedStakes(k),
+		keeper.StakingInvariantPendingRewardForDelegatorsGreaterThanRewardPerShareMinusRewardDebt(k),
+	} {
+		msg, broken := invariant(s.ctx)
+		s.Require().False(broken, msg)
+	}
+
+	s.Require().NoError(k.SetAutoCompound(s.ctx, topicId, compounder, false))
+	delegators, err := k.GetAutoCompoundDelegatorsOfTopic(s.ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Empty(delegators)
+}
+
+func (s *KeeperTestSuite) TestCompoundDelegateRewardsRespectsStakeShareCap() {
+	k := s.emissionsKeeper
+	topicId := s.mockTopic().Id
+	reputer := s.addrsStr[0]
+	otherReputer := s.addrsStr[1]
+	compounder := s.addrsStr[2]
+
+	params, err := k.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.MaxReputerStakeShare = alloraMath.MustNewDecFromString("0.5")
+	params.RequiredMinimumStake = cosmosMath.NewInt(1)
+	s.Require().NoError(k.SetParams(s.ctx, params))
+
+	s.mintTo(types.AlloraStakingAccountName, cosmosMath.NewInt(800))
+	s.Require().NoError(k.AddReputerStake(s.ctx, topicId, otherReputer, cosmosMath.NewInt(400)))
+	s.Require().NoError(k.AddDelegateStake(s.ctx, topicId, compounder, reputer, cosmosMath.NewInt(400)))
+	// restaking 200 of rewards would give the reputer 600 of 1000, over its 50% cap
+	s.mintTo(types.AlloraPendingRewardForDelegatorAccountName, cosmosMath.NewInt(200))
+	s.Require().NoError(k.SetDelegateRewardPerShare(s.ctx, topicId, reputer, alloraMath.MustNewDecFromString("0.5")))
+	s.Require().NoError(k.SetAutoCompound(s.ctx, topicId, compounder, true))
+	s.Require().NoError(k.CompoundDelegateRewards(s.ctx, topicId))
+
+	// The reward stays pending
+	placement, err := k.GetDelegateStakePlacement(s.ctx, topicId, compounder, reputer)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.NewDecFromInt64(400).Equal(placement.Amount))
+	s.Require().True(placement.RewardDebt.IsZero())
+	stakeUponReputer, err := k.GetDelegateStakeUponReputer(s.ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(400), stakeUponReputer)
+}
+
+func (s *KeeperTestSuite) TestCompoundDelegateRewardsSkipsArchivedTopic() {
+	k := s.emissionsKeeper
+	topicId := s.CreateOneTopic(10800)
+	reputer := s.addrsStr[0]
+	compounder := s.addrsStr[1]
+
+	s.mintTo(types.AlloraStakingAccountName, cosmosMath.NewInt(400))
+	s.Require().NoError(k.AddDelegateStake(s.ctx, topicId, compounder, reputer, cosmosMath.NewInt(400)))
+	s.mintTo(types.AlloraPendingRewardForDelegatorAccountName, cosmosMath.NewInt(200))
+	s.Require().NoError(k.SetDelegateRewardPerShare(s.ctx, topicId, reputer, alloraMath.MustNewDecFromString("0.5")))
+	s.Require().NoError(k.SetAutoCompound(s.ctx, topicId, compounder, true))
+	s.Require().NoError(k.ArchiveTopic(s.ctx, topicId))
+	s.Require().NoError(k.CompoundDelegateRewards(s.ctx, topicId))
+
+	// The reward stays pending, to be claimed rather than staked on the archived topic
+	placement, err := k.GetDelegateStakePlacement(s.ctx, topicId, compounder, reputer)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.NewDecFromInt64(400).Equal(placement.Amount))
+	s.Require().True(placement.RewardDebt.IsZero())
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/// DELEGATE REWARD CLAIMS
+
+// Builds the indexes of delegate stake placements by delegator and by reputer from the placements already in state
+func (k *Keeper) IndexAllDelegateStakePlacements(ctx context.Context) error {
+	return k.delegatedStakes.Walk(ctx, nil, func(key collections.Triple[TopicId, Delegator, Reputer], _ types.DelegatorInfo) (bool, error) {
+		indexKey := collections.Join3(key.K2(), key.K1(), key.K3())
+		if err := k.delegateStakePlacementsByDelegator.Set(ctx, indexKey); err != nil {
+			return true, errorsmod.Wrap(err, "error indexing delegate stake placement")
+		}
+		reputerIndexKey := collections.Join3(key.K1(), key.K3(), key.K2())
+		if err := k.delegateStakePlacementsByReputer.Set(ctx, reputerIndexKey); err != nil {
+			return true, errorsmod.Wrap(err, "error indexing delegate stake placement by reputer")
+		}
+		return false, nil
+	})
+}
+
+// Returns the rewards pending on a page of the delegator's stake placements, in ascending
+// (topic id, reputer) order. Placements with nothing to claim are reported with a zero amount.
+func (k *Keeper) GetPendingDelegateRewards(
+	ctx context.Context,
+	delegator ActorId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]*types.PendingDelegateReward, *types.SimpleCursorPaginationResponse, error) {
+	placements, pageResponse, err := k.getDelegateStakePlacementsPage(ctx, delegator, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	rewards := make([]*types.PendingDelegateReward, 0, len(placements))
+	for _, placement := range placements {
+		topicId, reputer := placement.K2(), placement.K3()
+		delegateInfo, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+		if err != nil {
+			return nil, nil, err
+		}
+		pendingReward, _, err := k.pendingDelegateReward(ctx, topicId, reputer, delegateInfo)
+		if err != nil {
+			return nil, nil, err
+		}
+		rewards = append(rewards, &types.PendingDelegateReward{
+			TopicId: topicId,
+			Reputer: reputer,
+			Amount:  pendingReward,
+		})
+	}
+	return rewards, pageResponse, nil
+}
+
+// Settles the reward debt of a page of the delegator's stake placements, in ascending
+// (topic id, reputer) order, and pays out the rewards of all of them in a single transfer
+func (k *Keeper) ClaimAllDelegateRewards(
+	ctx context.Context,
+	delegator ActorId,
+	pagination *types.SimpleCursorPaginationRequest,
+) (cosmosMath.Int, *types.SimpleCursorPaginationResponse, error) {
+	placements, pageResponse, err := k.getDelegateStakePlacementsPage(ctx, delegator, pagination)
+	if err != nil {
+		return cosmosMath.Int{}, nil, err
+	}
+	claimed := cosmosMath.ZeroInt()
+	for _, placement := range placements {
+		topicId, reputer := placement.K2(), placement.K3()
+		delegateInfo, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+		if err != nil {
+			return cosmosMath.Int{}, nil, err
+		}
+		pendingReward, share, err := k.pendingDelegateReward(ctx, topicId, reputer, delegateInfo)
+		if err != nil {
+			return cosmosMath.Int{}, nil, err
+		}
+		if !pendingReward.IsPositive() {
+			continue
+		}
+		delegateInfo.RewardDebt, err = delegateInfo.Amount.Mul(share)
+		if err != nil {
+			return cosmosMath.Int{}, nil, err
+		}
+		if err := k.SetDelegateStakePlacement(ctx, topicId, delegator, reputer, delegateInfo); err != nil {
+			return cosmosMath.Int{}, nil, err
+		}
+		claimed = claimed.Add(pendingReward)
+	}
+	if claimed.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, claimed))
+		err = k.SendCoinsFromModuleToAccount(ctx, types.AlloraPendingRewardForDelegatorAccountName, delegator, coins)
+		if err != nil {
+			return cosmosMath.Int{}, nil, errorsmod.Wrap(err, "error paying out delegate rewards")
+		}
+	}
+	return claimed, pageResponse, nil
+}
+
+// Returns the reward accrued by a delegation since its reward debt was last set, trimmed to
+// the amount that would be paid out, and the reward per share it was computed from
+func (k *Keeper) pendingDelegateReward(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+	placement types.DelegatorInfo,
+) (cosmosMath.Int, alloraMath.Dec, error) {
+	share, err := k.GetDelegateRewardPerShare(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, alloraMath.Dec{}, err
+	}
+	pendingReward, err := placement.Amount.Mul(share)
+	if err != nil {
+		return cosmosMath.Int{}, alloraMath.Dec{}, err
+	}
+	pendingReward, err = pendingReward.Sub(placement.RewardDebt)
+	if err != nil {
+		return cosmosMath.Int{}, alloraMath.Dec{}, err
+	}
+	if !pendingReward.IsPositive() {
+		return cosmosMath.ZeroInt(), share, nil
+	}
+	pendingRewardInt, err := pendingReward.SdkIntTrim()
+	if err != nil {
+		return cosmosMath.Int{}, alloraMath.Dec{}, err
+	}
+	return pendingRewardInt, share, nil
+}
+
+// Returns a page of the (delegator, topic id, reputer) keys of the delegator's stake placements.
+// The pagination key of a page is the big endian topic id followed by the reputer address.
+func (k *Keeper) getDelegateStakePlacementsPage(
+	ctx context.Context,
+	delegator ActorId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]collections.Triple[Delegator, TopicId, Reputer], *types.SimpleCursorPaginationResponse, error) {
+	limit, err := k.CalcAppropriatePaginationLimit(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	rng := new(collections.Range[collections.Triple[Delegator, TopicId, Reputer]]).
+		Prefix(collections.TriplePrefix[Delegator, TopicId, Reputer](delegator))
+	if pagination != nil && len(pagination.Key) > 0 {
+		if len(pagination.Key) < 8 {
+			return nil, nil, errorsmod.Wrap(types.ErrInvalidValue, "pagination key is too short")
+		}
+		topicId := binary.BigEndian.Uint64(pagination.Key[:8])
+		reputer := string(pagination.Key[8:])
+		rng = rng.StartInclusive(collections.Join3(delegator, topicId, reputer))
+	}
+
+	placements := make([]collections.Triple[Delegator, TopicId, Reputer], 0)
+	var nextKey []byte
+	err = k.delegateStakePlacementsByDelegator.Walk(ctx, rng, func(key collections.Triple[Delegator, TopicId, Reputer]) (bool, error) {
+		if uint64(len(placements)) >= limit {
+			nextKey = binary.BigEndian.AppendUint64(nil, key.K2())
+			nextKey = append(nextKey, key.K3()...)
+			return true, nil
+		}
+		placements = append(placements, key)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error iterating delegate stake placements of delegator")
+	}
+	return placements, &types.SimpleCursorPaginationResponse{NextKey: nextKey}, nil
+}
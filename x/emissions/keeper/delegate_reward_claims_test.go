@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) requirePendingDelegateRewards(expected, actual []*types.PendingDelegateReward) {
+	s.Require().Len(actual, len(expected))
+	for i := range expected {
+		s.Require().Equal(expected[i].TopicId, actual[i].TopicId)
+		s.Require().Equal(expected[i].Reputer, actual[i].Reputer)
+		s.Require().True(expected[i].Amount.Equal(actual[i].Amount), "expected %s, got %s", expected[i].Amount, actual[i].Amount)
+	}
+}
+
+func (s *KeeperTestSuite) TestClaimAllDelegateRewards() {
+	k := s.emissionsKeeper
+	delegator := s.addrsStr[0]
+	reputerA := s.addrsStr[1]
+	reputerB := s.addrsStr[2]
+	otherDelegator := s.addrsStr[3]
+	// Placements of a topic are ordered by reputer address
+	if reputerB < reputerA {
+		reputerA, reputerB = reputerB, reputerA
+	}
+	topic1 := uint64(1)
+	topic2 := uint64(2)
+
+	s.mintTo(types.AlloraStakingAccountName, cosmosMath.NewInt(700))
+	s.Require().NoError(k.AddDelegateStake(s.ctx, topic1, delegator, reputerA, cosmosMath.NewInt(100)))
+	s.Require().NoError(k.AddDelegateStake(s.ctx, topic2, delegator, reputerA, cosmosMath.NewInt(200)))
+	s.Require().NoError(k.AddDelegateStake(s.ctx, topic2, delegator, reputerB, cosmosMath.NewInt(300)))
+	s.Require().NoError(k.AddDelegateStake(s.ctx, topic1, otherDelegator, reputerA, cosmosMath.NewInt(100)))
+	// 10 + 40 + 90 of rewards are pending for the delegator, 10 for the other delegator
+	s.mintTo(types.AlloraPendingRewardForDelegatorAccountName, cosmosMath.NewInt(150))
+	s.Require().NoError(k.SetDelegateRewardPerShare(s.ctx, topic1, reputerA, alloraMath.MustNewDecFromString("0.1")))
+	s.Require().NoError(k.SetDelegateRewardPerShare(s.ctx, topic2, reputerA, alloraMath.MustNewDecFromString("0.2")))
+	s.Require().NoError(k.SetDelegateRewardPerShare(s.ctx, topic2, reputerB, alloraMath.MustNewDecFromString("0.3")))
+
+	firstPage := &types.SimpleCursorPaginationRequest{Limit: 2}
+	rewards, pageResponse, err := k.GetPendingDelegateRewards(s.ctx, delegator, firstPage)
+	s.Require().NoError(err)
+	s.requirePendingDelegateRewards([]*types.PendingDelegateReward{
+		{TopicId: topic1, Reputer: reputerA, Amount: cosmosMath.NewInt(10)},
+		{TopicId: topic2, Reputer: reputerA, Amount: cosmosMath.NewInt(40)},
+	}, rewards)
+	s.Require().NotEmpty(pageResponse.NextKey)
+
+	claimed, claimPageResponse, err := k.ClaimAllDelegateRewards(s.ctx, delegator, firstPage)
+	s.Require().NoError(err)
+	s.Require().True(cosmosMath.NewInt(50).Equal(claimed))
+	s.Require().Equal(pageResponse.NextKey, claimPageResponse.NextKey)
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegator)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(50), s.bankKeeper.GetBalance(s.ctx, delegatorAddr, params.DefaultBondDenom).Amount)
+
+	// Claimed placements report nothing pending, the rest of the placements are untouched
+	rewards, _, err = k.GetPendingDelegateRewards(s.ctx, delegator, nil)
+	s.Require().NoError(err)
+	s.Require().Len(rewards, 3)
+	s.Require().True(rewards[0].Amount.IsZero())
+	s.Require().True(rewards[1].Amount.IsZero())
+	s.Require().True(cosmosMath.NewInt(90).Equal(rewards[2].Amount))
+
+	claimed, claimPageResponse, err = k.ClaimAllDelegateRewards(s.ctx, delegator, &types.SimpleCursorPaginationRequest{
+		Key:   pageResponse.NextKey,
+		Limit: 2,
+	})
+	s.Require().NoError(err)
+	s.Require().True(cosmosMath.NewInt(90).Equal(claimed))
+	s.Require().Empty(claimPageResponse.NextKey)
+	s.Require().Equal(cosmosMath.NewInt(140), s.bankKeeper.GetBalance(s.ctx, delegatorAddr, params.DefaultBondDenom).Amount)
+
+	// Claiming again pays nothing and the other delegator's reward is still pending
+	claimed, _, err = k.ClaimAllDelegateRewards(s.ctx, delegator, nil)
+	s.Require().NoError(err)
+	s.Require().True(claimed.IsZero())
+	rewards, _, err = k.GetPendingDelegateRewards(s.ctx, otherDelegator, nil)
+	s.Require().NoError(err)
+	s.requirePendingDelegateRewards([]*types.PendingDelegateReward{
+		{TopicId: topic1, Reputer: reputerA, Amount: cosmosMath.NewInt(10)},
+	}, rewards)
+
+	msg, broken := keeper.StakingInvariantPendingRewardForDelegatorsGreaterThanRewardPerShareMinusRewardDebt(k)(s.ctx)
+	s.Require().False(broken, msg)
+}
+
+func (s *KeeperTestSuite) TestGetPendingDelegateRewardsDropsRemovedPlacements() {
+	k := s.emissionsKeeper
+	delegator := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+	topicId := s.mockTopic().Id
+
+	s.mintTo(types.AlloraStakingAccountName, cosmosMath.NewInt(100))
+	s.Require().NoError(k.AddDelegateStake(s.ctx, topicId, delegator, reputer, cosmosMath.NewInt(100)))
+	rewards, _, err := k.GetPendingDelegateRewards(s.ctx, delegator, nil)
+	s.Require().NoError(err)
+	s.Require().Len(rewards, 1)
+
+	s.Require().NoError(k.SetDelegateStakePlacement(s.ctx, topicId, delegator, reputer, types.DelegatorInfo{
+		Amount:     alloraMath.ZeroDec(),
+		RewardDebt: alloraMath.ZeroDec(),
+	}))
+	rewards, _, err = k.GetPendingDelegateRewards(s.ctx, delegator, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(rewards)
+
+	_, _, err = k.GetPendingDelegateRewards(s.ctx, delegator, &types.SimpleCursorPaginationRequest{Key: []byte{1}})
+	s.Require().ErrorIs(err, types.ErrInvalidValue)
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 )
@@ -10,23 +11,35 @@ func (s *KeeperTestSuite) TestCalcAndSaveInfererScoreEmaIfNewUpdate() {
 	keeper := s.emissionsKeeper
 
 	topic := types.Topic{
-		Id:                       uint64(1),
-		WorkerSubmissionWindow:   10,
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.2"),
-		Creator:                  s.addrsStr[0],
-		Metadata:                 "",
-		LossMethod:               "",
-		EpochLastEnded:           0,
-		EpochLength:              10,
-		GroundTruthLag:           10,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.ZeroDec(),
-		AllowNegative:            false,
-		Epsilon:                  alloraMath.ZeroDec(),
-		InitialRegret:            alloraMath.ZeroDec(),
-		ActiveInfererQuantile:    alloraMath.ZeroDec(),
-		ActiveForecasterQuantile: alloraMath.ZeroDec(),
-		ActiveReputerQuantile:    alloraMath.ZeroDec(),
+		Id:                            uint64(1),
+		WorkerSubmissionWindow:        10,
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.2"),
+		Creator:                       s.addrsStr[0],
+		Metadata:                      "",
+		LossMethod:                    "",
+		EpochLastEnded:                0,
+		EpochLength:                   10,
+		GroundTruthLag:                10,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.ZeroDec(),
+		AllowNegative:                 false,
+		Epsilon:                       alloraMath.ZeroDec(),
+		InitialRegret:                 alloraMath.ZeroDec(),
+		ActiveInfererQuantile:         alloraMath.ZeroDec(),
+		ActiveForecasterQuantile:      alloraMath.ZeroDec(),
+		ActiveReputerQuantile:         alloraMath.ZeroDec(),
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	worker := s.addrsStr[1]
 	block := types.BlockHeight(100)
@@ -64,23 +77,35 @@ func (s *KeeperTestSuite) TestCalcAndSaveForecasterScoreEmaIfNewUpdate() {
 	keeper := s.emissionsKeeper
 
 	topic := types.Topic{
-		Id:                       uint64(1),
-		WorkerSubmissionWindow:   10,
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.2"),
-		Creator:                  s.addrsStr[0],
-		Metadata:                 "",
-		LossMethod:               "",
-		EpochLastEnded:           0,
-		EpochLength:              10,
-		GroundTruthLag:           10,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.ZeroDec(),
-		AllowNegative:            false,
-		Epsilon:                  alloraMath.ZeroDec(),
-		InitialRegret:            alloraMath.ZeroDec(),
-		ActiveInfererQuantile:    alloraMath.ZeroDec(),
-		ActiveForecasterQuantile: alloraMath.ZeroDec(),
-		ActiveReputerQuantile:    alloraMath.ZeroDec(),
+		Id:                            uint64(1),
+		WorkerSubmissionWindow:        10,
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.2"),
+		Creator:                       s.addrsStr[0],
+		Metadata:                      "",
+		LossMethod:                    "",
+		EpochLastEnded:                0,
+		EpochLength:                   10,
+		GroundTruthLag:                10,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.ZeroDec(),
+		AllowNegative:                 false,
+		Epsilon:                       alloraMath.ZeroDec(),
+		InitialRegret:                 alloraMath.ZeroDec(),
+		ActiveInfererQuantile:         alloraMath.ZeroDec(),
+		ActiveForecasterQuantile:      alloraMath.ZeroDec(),
+		ActiveReputerQuantile:         alloraMath.ZeroDec(),
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	worker := s.addrsStr[1]
 	block := types.BlockHeight(100)
@@ -118,23 +143,35 @@ func (s *KeeperTestSuite) TestCalcAndSaveReputerScoreEmaIfNewUpdate() {
 	keeper := s.emissionsKeeper
 
 	topic := types.Topic{
-		Creator:                  s.addrsStr[0],
-		Id:                       uint64(1),
-		EpochLength:              20,
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.2"),
-		Metadata:                 "",
-		LossMethod:               "",
-		EpochLastEnded:           0,
-		GroundTruthLag:           20,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.ZeroDec(),
-		AllowNegative:            false,
-		Epsilon:                  alloraMath.ZeroDec(),
-		InitialRegret:            alloraMath.ZeroDec(),
-		WorkerSubmissionWindow:   20,
-		ActiveInfererQuantile:    alloraMath.ZeroDec(),
-		ActiveForecasterQuantile: alloraMath.ZeroDec(),
-		ActiveReputerQuantile:    alloraMath.ZeroDec(),
+		Creator:                       s.addrsStr[0],
+		Id:                            uint64(1),
+		EpochLength:                   20,
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.2"),
+		Metadata:                      "",
+		LossMethod:                    "",
+		EpochLastEnded:                0,
+		GroundTruthLag:                20,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.ZeroDec(),
+		AllowNegative:                 false,
+		Epsilon:                       alloraMath.ZeroDec(),
+		InitialRegret:                 alloraMath.ZeroDec(),
+		WorkerSubmissionWindow:        20,
+		ActiveInfererQuantile:         alloraMath.ZeroDec(),
+		ActiveForecasterQuantile:      alloraMath.ZeroDec(),
+		ActiveReputerQuantile:         alloraMath.ZeroDec(),
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	reputer := s.addrsStr[2]
 	block := types.BlockHeight(100)
@@ -172,23 +209,35 @@ func (s *KeeperTestSuite) TestCalcAndSaveInfererScoreEmaWithLastSavedTopicQuanti
 	keeper := s.emissionsKeeper
 
 	topic := types.Topic{
-		Id:                       uint64(1),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.2"),
-		Creator:                  s.addrsStr[0],
-		Metadata:                 "",
-		LossMethod:               "",
-		EpochLastEnded:           0,
-		EpochLength:              100,
-		GroundTruthLag:           100,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.ZeroDec(),
-		AllowNegative:            false,
-		Epsilon:                  alloraMath.ZeroDec(),
-		InitialRegret:            alloraMath.ZeroDec(),
-		WorkerSubmissionWindow:   100,
-		ActiveInfererQuantile:    alloraMath.ZeroDec(),
-		ActiveForecasterQuantile: alloraMath.ZeroDec(),
-		ActiveReputerQuantile:    alloraMath.ZeroDec(),
+		Id:                            uint64(1),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.2"),
+		Creator:                       s.addrsStr[0],
+		Metadata:                      "",
+		LossMethod:                    "",
+		EpochLastEnded:                0,
+		EpochLength:                   100,
+		GroundTruthLag:                100,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.ZeroDec(),
+		AllowNegative:                 false,
+		Epsilon:                       alloraMath.ZeroDec(),
+		InitialRegret:                 alloraMath.ZeroDec(),
+		WorkerSubmissionWindow:        100,
+		ActiveInfererQuantile:         alloraMath.ZeroDec(),
+		ActiveForecasterQuantile:      alloraMath.ZeroDec(),
+		ActiveReputerQuantile:         alloraMath.ZeroDec(),
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	worker := s.addrsStr[1]
 	block := types.BlockHeight(100)
@@ -219,23 +268,35 @@ func (s *KeeperTestSuite) TestCalcAndSaveForecasterScoreEmaWithLastSavedTopicQua
 	keeper := s.emissionsKeeper
 
 	topic := types.Topic{
-		Id:                       uint64(1),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.2"),
-		Creator:                  s.addrsStr[0],
-		Metadata:                 "",
-		LossMethod:               "",
-		EpochLastEnded:           0,
-		EpochLength:              100,
-		GroundTruthLag:           100,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.ZeroDec(),
-		AllowNegative:            false,
-		Epsilon:                  alloraMath.ZeroDec(),
-		InitialRegret:            alloraMath.ZeroDec(),
-		WorkerSubmissionWindow:   100,
-		ActiveInfererQuantile:    alloraMath.ZeroDec(),
-		ActiveForecasterQuantile: alloraMath.ZeroDec(),
-		ActiveReputerQuantile:    alloraMath.ZeroDec(),
+		Id:                            uint64(1),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.2"),
+		Creator:                       s.addrsStr[0],
+		Metadata:                      "",
+		LossMethod:                    "",
+		EpochLastEnded:                0,
+		EpochLength:                   100,
+		GroundTruthLag:                100,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.ZeroDec(),
+		AllowNegative:                 false,
+		Epsilon:                       alloraMath.ZeroDec(),
+		InitialRegret:                 alloraMath.ZeroDec(),
+		WorkerSubmissionWindow:        100,
+		ActiveInfererQuantile:         alloraMath.ZeroDec(),
+		ActiveForecasterQuantile:      alloraMath.ZeroDec(),
+		ActiveReputerQuantile:         alloraMath.ZeroDec(),
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	worker := s.addrsStr[1]
 	block := types.BlockHeight(100)
@@ -266,23 +327,35 @@ func (s *KeeperTestSuite) TestCalcAndSaveReputerScoreEmaWithLastSavedTopicQuanti
 	keeper := s.emissionsKeeper
 
 	topic := types.Topic{
-		Id:                       uint64(1),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.2"),
-		Creator:                  s.addrsStr[0],
-		Metadata:                 "",
-		LossMethod:               "",
-		EpochLastEnded:           0,
-		EpochLength:              100,
-		GroundTruthLag:           100,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.ZeroDec(),
-		AllowNegative:            false,
-		Epsilon:                  alloraMath.ZeroDec(),
-		InitialRegret:            alloraMath.ZeroDec(),
-		WorkerSubmissionWindow:   100,
-		ActiveInfererQuantile:    alloraMath.ZeroDec(),
-		ActiveForecasterQuantile: alloraMath.ZeroDec(),
-		ActiveReputerQuantile:    alloraMath.ZeroDec(),
+		Id:                            uint64(1),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.2"),
+		Creator:                       s.addrsStr[0],
+		Metadata:                      "",
+		LossMethod:                    "",
+		EpochLastEnded:                0,
+		EpochLength:                   100,
+		GroundTruthLag:                100,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.ZeroDec(),
+		AllowNegative:                 false,
+		Epsilon:                       alloraMath.ZeroDec(),
+		InitialRegret:                 alloraMath.ZeroDec(),
+		WorkerSubmissionWindow:        100,
+		ActiveInfererQuantile:         alloraMath.ZeroDec(),
+		ActiveForecasterQuantile:      alloraMath.ZeroDec(),
+		ActiveReputerQuantile:         alloraMath.ZeroDec(),
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	reputer := s.addrsStr[2]
 	block := types.BlockHeight(100)
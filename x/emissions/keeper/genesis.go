@@ -963,6 +963,237 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 		}
 	}
 
+	// pendingTopicUpdates
+	for _, topicIdAndParams := range data.PendingTopicUpdates {
+		if topicIdAndParams != nil && topicIdAndParams.Params != nil {
+			if err := k.SetPendingTopicUpdate(ctx, topicIdAndParams.TopicId, *topicIdAndParams.Params); err != nil {
+				return errors.Wrap(err, "error setting pendingTopicUpdates")
+			}
+		}
+	}
+
+	// archivedTopics
+	for _, topicId := range data.ArchivedTopics {
+		if err := types.ValidateTopicId(topicId); err != nil {
+			return errors.Wrapf(err, "error setting archivedTopics %v", data.ArchivedTopics)
+		}
+		if err := k.archivedTopics.Set(ctx, topicId); err != nil {
+			return errors.Wrap(err, "error setting archivedTopics")
+		}
+	}
+	// archivedTopicsToPrune
+	for _, topicId := range data.ArchivedTopicsToPrune {
+		if err := types.ValidateTopicId(topicId); err != nil {
+			return errors.Wrapf(err, "error setting archivedTopicsToPrune %v", data.ArchivedTopicsToPrune)
+		}
+		if err := k.archivedTopicsToPrune.Set(ctx, topicId); err != nil {
+			return errors.Wrap(err, "error setting archivedTopicsToPrune")
+		}
+	}
+
+	// topicOwners
+	for _, topicAndOwner := range data.TopicOwners {
+		if topicAndOwner != nil {
+			if err := types.ValidateBech32(topicAndOwner.ActorId); err != nil {
+				return errors.Wrap(err, "error validating topic owner")
+			}
+			if err := k.SetTopicOwner(ctx, topicAndOwner.TopicId, topicAndOwner.ActorId); err != nil {
+				return errors.Wrap(err, "error setting topicOwners")
+			}
+		}
+	}
+	// pendingTopicOwners
+	for _, topicAndOwner := range data.PendingTopicOwners {
+		if topicAndOwner != nil {
+			if err := types.ValidateBech32(topicAndOwner.ActorId); err != nil {
+				return errors.Wrap(err, "error validating pending topic owner")
+			}
+			if err := k.pendingTopicOwners.Set(ctx, topicAndOwner.TopicId, topicAndOwner.ActorId); err != nil {
+				return errors.Wrap(err, "error setting pendingTopicOwners")
+			}
+		}
+	}
+
+	// topicTags
+	for _, topicIdAndTag := range data.TopicTags {
+		if topicIdAndTag != nil {
+			if err := k.SetTopicTags(ctx, topicIdAndTag.TopicId, []string{topicIdAndTag.Tag}); err != nil {
+				return errors.Wrap(err, "error setting topicTags")
+			}
+		}
+	}
+
+	// reputerGroundTruths
+	for _, groundTruth := range data.ReputerGroundTruths {
+		if groundTruth != nil {
+			if err := k.InsertReputerGroundTruth(ctx, groundTruth.TopicId, *groundTruth); err != nil {
+				return errors.Wrap(err, "error setting reputerGroundTruths")
+			}
+		}
+	}
+	// consensusGroundTruths
+	for _, groundTruth := range data.ConsensusGroundTruths {
+		if groundTruth != nil {
+			if err := k.SetConsensusGroundTruth(ctx, *groundTruth); err != nil {
+				return errors.Wrap(err, "error setting consensusGroundTruths")
+			}
+		}
+	}
+
+	// workerPayloadCommits
+	for _, commit := range data.WorkerPayloadCommits {
+		if commit != nil {
+			if err := k.InsertWorkerPayloadCommit(ctx, *commit); err != nil {
+				return errors.Wrap(err, "error setting workerPayloadCommits")
+			}
+		}
+	}
+
+	// actorOperators
+	for _, actorOperator := range data.ActorOperators {
+		if actorOperator != nil {
+			if err := actorOperator.Validate(); err != nil {
+				return errors.Wrap(err, "error validating actor operator")
+			}
+			key := collections.Join3(actorOperator.TopicId, actorOperator.Actor, actorOperator.Operator)
+			if err := k.actorOperators.Set(ctx, key); err != nil {
+				return errors.Wrap(err, "error setting actorOperators")
+			}
+		}
+	}
+
+	// workerBonds, the total is recomputed from the bonds
+	totalWorkerBonds := cosmosMath.ZeroInt()
+	for _, bond := range data.WorkerBonds {
+		if bond != nil {
+			if err := k.SetWorkerBond(ctx, *bond); err != nil {
+				return errors.Wrap(err, "error setting workerBonds")
+			}
+			totalWorkerBonds = totalWorkerBonds.Add(bond.Amount)
+		}
+	}
+	if err := k.SetTotalWorkerBonds(ctx, totalWorkerBonds); err != nil {
+		return errors.Wrap(err, "error setting totalWorkerBonds")
+	}
+
+	// workerBondRemovals
+	for _, removal := range data.WorkerBondRemovals {
+		if removal != nil {
+			if err := k.SetWorkerBondRemoval(ctx, *removal); err != nil {
+				return errors.Wrap(err, "error setting workerBondRemovals")
+			}
+		}
+	}
+
+	// reputerConsensusDeviations
+	for _, deviation := range data.ReputerConsensusDeviations {
+		if deviation != nil {
+			if err := k.SetReputerConsensusDeviation(ctx, *deviation); err != nil {
+				return errors.Wrap(err, "error setting reputerConsensusDeviations")
+			}
+		}
+	}
+
+	// redelegations
+	for _, redelegation := range data.Redelegations {
+		if redelegation != nil {
+			if err := k.SetRedelegation(ctx, *redelegation); err != nil {
+				return errors.Wrap(err, "error setting redelegations")
+			}
+		}
+	}
+
+	// autoCompoundDelegators
+	for _, entry := range data.AutoCompoundDelegators {
+		if entry != nil {
+			if err := k.SetAutoCompound(ctx, entry.TopicId, entry.ActorId, true); err != nil {
+				return errors.Wrap(err, "error setting autoCompoundDelegators")
+			}
+		}
+	}
+
+	// reputerCommissions
+	for _, commission := range data.ReputerCommissions {
+		if commission != nil {
+			if err := k.SetReputerCommission(ctx, *commission); err != nil {
+				return errors.Wrap(err, "error setting reputerCommissions")
+			}
+		}
+	}
+
+	// stakeLocks
+	for _, lock := range data.StakeLocks {
+		if lock != nil {
+			if err := k.SetStakeLock(ctx, *lock); err != nil {
+				return errors.Wrap(err, "error setting stakeLocks")
+			}
+		}
+	}
+
+	// rewardHistory
+	for _, record := range data.RewardHistory {
+		if record != nil {
+			if err := k.SetRewardRecord(ctx, *record); err != nil {
+				return errors.Wrap(err, "error setting rewardHistory")
+			}
+		}
+	}
+
+	// rewardEscrows, the total is recomputed from what is left unclaimed in the escrows
+	totalRewardEscrows := cosmosMath.ZeroInt()
+	for _, escrow := range data.RewardEscrows {
+		if escrow != nil {
+			if err := k.SetRewardEscrow(ctx, *escrow); err != nil {
+				return errors.Wrap(err, "error setting rewardEscrows")
+			}
+			totalRewardEscrows = totalRewardEscrows.Add(escrow.Amount.Sub(escrow.Claimed))
+		}
+	}
+	if err := k.SetTotalRewardEscrows(ctx, totalRewardEscrows); err != nil {
+		return errors.Wrap(err, "error setting totalRewardEscrows")
+	}
+
+	// queuedRewardPayouts, the total is recomputed from the payouts and the next id follows the last payout
+	totalQueuedRewardPayouts := cosmosMath.ZeroInt()
+	nextRewardPayoutId := data.NextRewardPayoutId
+	for _, payout := range data.QueuedRewardPayouts {
+		if payout != nil {
+			if err := k.SetQueuedRewardPayout(ctx, *payout); err != nil {
+				return errors.Wrap(err, "error setting queuedRewardPayouts")
+			}
+			totalQueuedRewardPayouts = totalQueuedRewardPayouts.Add(payout.Amount)
+			nextRewardPayoutId = max(nextRewardPayoutId, payout.Id+1)
+		}
+	}
+	if err := k.SetTotalQueuedRewardPayouts(ctx, totalQueuedRewardPayouts); err != nil {
+		return errors.Wrap(err, "error setting totalQueuedRewardPayouts")
+	}
+	if err := k.SetNextRewardPayoutId(ctx, nextRewardPayoutId); err != nil {
+		return errors.Wrap(err, "error setting nextRewardPayoutId")
+	}
+
+	// deadLetteredRewardPayouts
+	for _, payout := range data.DeadLetteredRewardPayouts {
+		if payout != nil {
+			if err := k.SetDeadLetteredRewardPayout(ctx, *payout); err != nil {
+				return errors.Wrap(err, "error setting deadLetteredRewardPayouts")
+			}
+		}
+	}
+
+	// archivedTopicDelegateRemovalCursors
+	for _, cursor := range data.ArchivedTopicDelegateRemovalCursors {
+		if cursor != nil {
+			if err := types.ValidateTopicId(cursor.TopicId); err != nil {
+				return errors.Wrapf(err, "error setting archivedTopicDelegateRemovalCursors %v", cursor)
+			}
+			err := k.archivedTopicDelegateRemovalCursors.Set(ctx, cursor.TopicId, collections.Join(cursor.Delegator, cursor.Reputer))
+			if err != nil {
+				return errors.Wrap(err, "error setting archivedTopicDelegateRemovalCursors")
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -2445,6 +2676,320 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		})
 	}
 
+	pendingTopicUpdates := make([]*types.TopicIdAndOptionalTopicParams, 0)
+	pendingTopicUpdatesIter, err := k.pendingTopicUpdates.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate pending topic updates")
+	}
+	for ; pendingTopicUpdatesIter.Valid(); pendingTopicUpdatesIter.Next() {
+		keyValue, err := pendingTopicUpdatesIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: pendingTopicUpdatesIter")
+		}
+		value := keyValue.Value
+		pendingTopicUpdates = append(pendingTopicUpdates, &types.TopicIdAndOptionalTopicParams{
+			TopicId: keyValue.Key,
+			Params:  &value,
+		})
+	}
+
+	archivedTopics := make([]uint64, 0)
+	archivedTopicsIter, err := k.archivedTopics.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate archived topics")
+	}
+	for ; archivedTopicsIter.Valid(); archivedTopicsIter.Next() {
+		key, err := archivedTopicsIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: archivedTopicsIter")
+		}
+		archivedTopics = append(archivedTopics, key)
+	}
+
+	archivedTopicsToPrune := make([]uint64, 0)
+	archivedTopicsToPruneIter, err := k.archivedTopicsToPrune.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate archived topics to prune")
+	}
+	for ; archivedTopicsToPruneIter.Valid(); archivedTopicsToPruneIter.Next() {
+		key, err := archivedTopicsToPruneIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: archivedTopicsToPruneIter")
+		}
+		archivedTopicsToPrune = append(archivedTopicsToPrune, key)
+	}
+
+	topicOwners := make([]*types.TopicAndActorId, 0)
+	topicOwnersIter, err := k.topicOwners.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic owners")
+	}
+	for ; topicOwnersIter.Valid(); topicOwnersIter.Next() {
+		keyValue, err := topicOwnersIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: topicOwnersIter")
+		}
+		topicOwners = append(topicOwners, &types.TopicAndActorId{
+			TopicId: keyValue.Key,
+			ActorId: keyValue.Value,
+		})
+	}
+
+	pendingTopicOwners := make([]*types.TopicAndActorId, 0)
+	pendingTopicOwnersIter, err := k.pendingTopicOwners.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate pending topic owners")
+	}
+	for ; pendingTopicOwnersIter.Valid(); pendingTopicOwnersIter.Next() {
+		keyValue, err := pendingTopicOwnersIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: pendingTopicOwnersIter")
+		}
+		pendingTopicOwners = append(pendingTopicOwners, &types.TopicAndActorId{
+			TopicId: keyValue.Key,
+			ActorId: keyValue.Value,
+		})
+	}
+
+	topicTags := make([]*types.TopicIdAndTag, 0)
+	topicTagsIter, err := k.topicTags.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic tags")
+	}
+	for ; topicTagsIter.Valid(); topicTagsIter.Next() {
+		key, err := topicTagsIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: topicTagsIter")
+		}
+		topicTags = append(topicTags, &types.TopicIdAndTag{
+			TopicId: key.K1(),
+			Tag:     key.K2(),
+		})
+	}
+
+	reputerGroundTruths := make([]*types.GroundTruth, 0)
+	reputerGroundTruthsIter, err := k.reputerGroundTruths.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reputer ground truths")
+	}
+	for ; reputerGroundTruthsIter.Valid(); reputerGroundTruthsIter.Next() {
+		value, err := reputerGroundTruthsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: reputerGroundTruthsIter")
+		}
+		reputerGroundTruths = append(reputerGroundTruths, &value)
+	}
+
+	consensusGroundTruths := make([]*types.GroundTruth, 0)
+	consensusGroundTruthsIter, err := k.consensusGroundTruths.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate consensus ground truths")
+	}
+	for ; consensusGroundTruthsIter.Valid(); consensusGroundTruthsIter.Next() {
+		value, err := consensusGroundTruthsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: consensusGroundTruthsIter")
+		}
+		consensusGroundTruths = append(consensusGroundTruths, &value)
+	}
+
+	workerPayloadCommits := make([]*types.WorkerPayloadCommit, 0)
+	workerPayloadCommitsIter, err := k.workerPayloadCommits.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate worker payload commits")
+	}
+	for ; workerPayloadCommitsIter.Valid(); workerPayloadCommitsIter.Next() {
+		value, err := workerPayloadCommitsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: workerPayloadCommitsIter")
+		}
+		workerPayloadCommits = append(workerPayloadCommits, &value)
+	}
+
+	actorOperators := make([]*types.TopicActorOperator, 0)
+	actorOperatorsIter, err := k.actorOperators.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate actor operators")
+	}
+	for ; actorOperatorsIter.Valid(); actorOperatorsIter.Next() {
+		key, err := actorOperatorsIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: actorOperatorsIter")
+		}
+		actorOperators = append(actorOperators, &types.TopicActorOperator{
+			TopicId:  key.K1(),
+			Actor:    key.K2(),
+			Operator: key.K3(),
+		})
+	}
+
+	workerBonds := make([]*types.WorkerBond, 0)
+	workerBondsIter, err := k.workerBonds.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate worker bonds")
+	}
+	for ; workerBondsIter.Valid(); workerBondsIter.Next() {
+		value, err := workerBondsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: workerBondsIter")
+		}
+		workerBonds = append(workerBonds, &value)
+	}
+
+	workerBondRemovals := make([]*types.WorkerBondRemovalInfo, 0)
+	workerBondRemovalsIter, err := k.workerBondRemovalsByBlock.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate worker bond removals")
+	}
+	for ; workerBondRemovalsIter.Valid(); workerBondRemovalsIter.Next() {
+		value, err := workerBondRemovalsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: workerBondRemovalsIter")
+		}
+		workerBondRemovals = append(workerBondRemovals, &value)
+	}
+
+	reputerConsensusDeviations := make([]*types.ReputerConsensusDeviation, 0)
+	reputerConsensusDeviationsIter, err := k.reputerConsensusDeviations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reputer consensus deviations")
+	}
+	for ; reputerConsensusDeviationsIter.Valid(); reputerConsensusDeviationsIter.Next() {
+		value, err := reputerConsensusDeviationsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: reputerConsensusDeviationsIter")
+		}
+		reputerConsensusDeviations = append(reputerConsensusDeviations, &value)
+	}
+
+	redelegations := make([]*types.RedelegationInfo, 0)
+	redelegationsIter, err := k.redelegations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate redelegations")
+	}
+	for ; redelegationsIter.Valid(); redelegationsIter.Next() {
+		value, err := redelegationsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: redelegationsIter")
+		}
+		redelegations = append(redelegations, &value)
+	}
+
+	autoCompoundDelegators := make([]*types.TopicAndActorId, 0)
+	autoCompoundDelegatorsIter, err := k.autoCompoundDelegators.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate auto compound delegators")
+	}
+	for ; autoCompoundDelegatorsIter.Valid(); autoCompoundDelegatorsIter.Next() {
+		key, err := autoCompoundDelegatorsIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: autoCompoundDelegatorsIter")
+		}
+		autoCompoundDelegators = append(autoCompoundDelegators, &types.TopicAndActorId{
+			TopicId: key.K1(),
+			ActorId: key.K2(),
+		})
+	}
+
+	reputerCommissions := make([]*types.ReputerCommission, 0)
+	reputerCommissionsIter, err := k.reputerCommissions.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reputer commissions")
+	}
+	for ; reputerCommissionsIter.Valid(); reputerCommissionsIter.Next() {
+		value, err := reputerCommissionsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: reputerCommissionsIter")
+		}
+		reputerCommissions = append(reputerCommissions, &value)
+	}
+
+	stakeLocks := make([]*types.StakeLock, 0)
+	stakeLocksIter, err := k.stakeLocks.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate stake locks")
+	}
+	for ; stakeLocksIter.Valid(); stakeLocksIter.Next() {
+		value, err := stakeLocksIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: stakeLocksIter")
+		}
+		stakeLocks = append(stakeLocks, &value)
+	}
+
+	rewardHistory := make([]*types.RewardRecord, 0)
+	rewardHistoryIter, err := k.rewardHistory.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reward history")
+	}
+	for ; rewardHistoryIter.Valid(); rewardHistoryIter.Next() {
+		value, err := rewardHistoryIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: rewardHistoryIter")
+		}
+		rewardHistory = append(rewardHistory, &value)
+	}
+
+	rewardEscrows := make([]*types.RewardEscrow, 0)
+	rewardEscrowsIter, err := k.rewardEscrows.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reward escrows")
+	}
+	for ; rewardEscrowsIter.Valid(); rewardEscrowsIter.Next() {
+		value, err := rewardEscrowsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: rewardEscrowsIter")
+		}
+		rewardEscrows = append(rewardEscrows, &value)
+	}
+
+	queuedRewardPayouts := make([]*types.QueuedRewardPayout, 0)
+	queuedRewardPayoutsIter, err := k.rewardPayoutQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reward payout queue")
+	}
+	for ; queuedRewardPayoutsIter.Valid(); queuedRewardPayoutsIter.Next() {
+		value, err := queuedRewardPayoutsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: queuedRewardPayoutsIter")
+		}
+		queuedRewardPayouts = append(queuedRewardPayouts, &value)
+	}
+	nextRewardPayoutId, err := k.GetNextRewardPayoutId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get next reward payout id")
+	}
+
+	deadLetteredRewardPayouts := make([]*types.QueuedRewardPayout, 0)
+	deadLetteredRewardPayoutsIter, err := k.deadLetteredRewardPayouts.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate dead-lettered reward payouts")
+	}
+	for ; deadLetteredRewardPayoutsIter.Valid(); deadLetteredRewardPayoutsIter.Next() {
+		value, err := deadLetteredRewardPayoutsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: deadLetteredRewardPayoutsIter")
+		}
+		deadLetteredRewardPayouts = append(deadLetteredRewardPayouts, &value)
+	}
+
+	archivedTopicDelegateRemovalCursors := make([]*types.TopicIdDelegatorReputer, 0)
+	archivedTopicDelegateRemovalCursorsIter, err := k.archivedTopicDelegateRemovalCursors.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate archived topic delegate removal cursors")
+	}
+	for ; archivedTopicDelegateRemovalCursorsIter.Valid(); archivedTopicDelegateRemovalCursorsIter.Next() {
+		keyValue, err := archivedTopicDelegateRemovalCursorsIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: archivedTopicDelegateRemovalCursorsIter")
+		}
+		archivedTopicDelegateRemovalCursors = append(archivedTopicDelegateRemovalCursors, &types.TopicIdDelegatorReputer{
+			TopicId:   keyValue.Key,
+			Delegator: keyValue.Value.K1(),
+			Reputer:   keyValue.Value.K2(),
+		})
+	}
+
 	return &types.GenesisState{
 		Params:                                         moduleParams,
 		NextTopicId:                                    nextTopicId,
@@ -2534,5 +3079,28 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		TopicReputerWhitelistEnabled:                   topicReputerWhitelistEnabled,
 		LastMedianInferences:                           lastMedianInferences,
 		MadInferences:                                  madInferences,
+		PendingTopicUpdates:                            pendingTopicUpdates,
+		ArchivedTopics:                                 archivedTopics,
+		ArchivedTopicsToPrune:                          archivedTopicsToPrune,
+		TopicOwners:                                    topicOwners,
+		PendingTopicOwners:                             pendingTopicOwners,
+		TopicTags:                                      topicTags,
+		ReputerGroundTruths:                            reputerGroundTruths,
+		ConsensusGroundTruths:                          consensusGroundTruths,
+		WorkerPayloadCommits:                           workerPayloadCommits,
+		ActorOperators:                                 actorOperators,
+		WorkerBonds:                                    workerBonds,
+		WorkerBondRemovals:                             workerBondRemovals,
+		ReputerConsensusDeviations:                     reputerConsensusDeviations,
+		Redelegations:                                  redelegations,
+		AutoCompoundDelegators:                         autoCompoundDelegators,
+		ReputerCommissions:                             reputerCommissions,
+		StakeLocks:                                     stakeLocks,
+		RewardHistory:                                  rewardHistory,
+		RewardEscrows:                                  rewardEscrows,
+		QueuedRewardPayouts:                            queuedRewardPayouts,
+		NextRewardPayoutId:                             nextRewardPayoutId,
+		ArchivedTopicDelegateRemovalCursors:            archivedTopicDelegateRemovalCursors,
+		DeadLetteredRewardPayouts:                      deadLetteredRewardPayouts,
 	}, nil
 }
@@ -94,6 +94,7 @@ func CalcForecastImpliedInferences(args CalcForecastImpliedInferencesArgs) (
 					Value:       medianValue,
 					ExtraData:   nil,
 					Proof:       "",
+					Values:      nil,
 				}
 				forecasterToForecastImpliedInference[forecaster] = &forecastImpliedInference
 			} else {
@@ -190,6 +191,7 @@ func CalcForecastImpliedInferences(args CalcForecastImpliedInferencesArgs) (
 						Value:       forecastValue,
 						ExtraData:   nil,
 						Proof:       "",
+						Values:      nil,
 					}
 					forecasterToForecastImpliedInference[forecaster] = &forecastImpliedInference
 				}
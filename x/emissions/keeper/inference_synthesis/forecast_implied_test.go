@@ -103,8 +103,8 @@ func (s *InferenceSynthesisTestSuite) TestCalcForecastImpliedInferencesTwoWorker
 			{
 				Forecaster: "forecaster0",
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: "worker0", Value: alloraMath.MustNewDecFromString("3")},
-					{Inferer: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("4")},
+					{Inferer: "worker0", Value: alloraMath.MustNewDecFromString("3"), Values: nil},
+					{Inferer: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("4"), Values: nil},
 				},
 			},
 		},
@@ -180,7 +180,7 @@ func (s *InferenceSynthesisTestSuite) TestCalcForecastImpliedInferencesTwoWorker
 			{
 				Forecaster: "worker0",
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("4")},
+					{Inferer: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("4"), Values: nil},
 				},
 			},
 		},
@@ -256,17 +256,17 @@ func (s *InferenceSynthesisTestSuite) TestCalcForecastImpliedInferencesThreeWork
 			{
 				Forecaster: "worker0",
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: "worker0", Value: alloraMath.MustNewDecFromString("1")},
-					{Inferer: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("2")},
-					{Inferer: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("3")},
+					{Inferer: "worker0", Value: alloraMath.MustNewDecFromString("1"), Values: nil},
+					{Inferer: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("2"), Values: nil},
+					{Inferer: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("3"), Values: nil},
 				},
 			},
 			{
 				Forecaster: s.addrsStr[1],
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: "worker0", Value: alloraMath.MustNewDecFromString("4")},
-					{Inferer: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("5")},
-					{Inferer: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("6")},
+					{Inferer: "worker0", Value: alloraMath.MustNewDecFromString("4"), Values: nil},
+					{Inferer: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("5"), Values: nil},
+					{Inferer: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("6"), Values: nil},
 				},
 			},
 		},
@@ -361,11 +361,11 @@ func (s *InferenceSynthesisTestSuite) TestCalcForcastImpliedInferencesEpoch2() {
 			{
 				Forecaster: forecaster0,
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: worker0, Value: epoch2Get("forecasted_loss_0_for_0")},
-					{Inferer: worker1, Value: epoch2Get("forecasted_loss_0_for_1")},
-					{Inferer: worker2, Value: epoch2Get("forecasted_loss_0_for_2")},
-					{Inferer: worker3, Value: epoch2Get("forecasted_loss_0_for_3")},
-					{Inferer: worker4, Value: epoch2Get("forecasted_loss_0_for_4")},
+					{Inferer: worker0, Value: epoch2Get("forecasted_loss_0_for_0"), Values: nil},
+					{Inferer: worker1, Value: epoch2Get("forecasted_loss_0_for_1"), Values: nil},
+					{Inferer: worker2, Value: epoch2Get("forecasted_loss_0_for_2"), Values: nil},
+					{Inferer: worker3, Value: epoch2Get("forecasted_loss_0_for_3"), Values: nil},
+					{Inferer: worker4, Value: epoch2Get("forecasted_loss_0_for_4"), Values: nil},
 				},
 			},
 		},
@@ -453,11 +453,11 @@ func (s *InferenceSynthesisTestSuite) TestCalcForcastImpliedInferencesEpoch3() {
 			{
 				Forecaster: forecaster0,
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: worker0, Value: epoch3Get("forecasted_loss_0_for_0")},
-					{Inferer: worker1, Value: epoch3Get("forecasted_loss_0_for_1")},
-					{Inferer: worker2, Value: epoch3Get("forecasted_loss_0_for_2")},
-					{Inferer: worker3, Value: epoch3Get("forecasted_loss_0_for_3")},
-					{Inferer: worker4, Value: epoch3Get("forecasted_loss_0_for_4")},
+					{Inferer: worker0, Value: epoch3Get("forecasted_loss_0_for_0"), Values: nil},
+					{Inferer: worker1, Value: epoch3Get("forecasted_loss_0_for_1"), Values: nil},
+					{Inferer: worker2, Value: epoch3Get("forecasted_loss_0_for_2"), Values: nil},
+					{Inferer: worker3, Value: epoch3Get("forecasted_loss_0_for_3"), Values: nil},
+					{Inferer: worker4, Value: epoch3Get("forecasted_loss_0_for_4"), Values: nil},
 				},
 			},
 		},
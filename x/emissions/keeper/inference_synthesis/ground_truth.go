@@ -0,0 +1,256 @@
+package inferencesynthesis
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissions "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// CalcConsensusGroundTruth returns the stake-weighted median of the ground truths
+// submitted by reputers, taken separately for each component
+func CalcConsensusGroundTruth(
+	topicId TopicId,
+	blockHeight BlockHeight,
+	stakesByReputer map[Worker]Stake,
+	groundTruths []emissions.GroundTruth,
+) (emissions.GroundTruth, error) {
+	if len(groundTruths) == 0 {
+		return emissions.GroundTruth{}, errorsmod.Wrap(emissions.ErrInvalidGroundTruth, "no ground truths to take the consensus of")
+	}
+	dimension := len(groundTruths[0].Values)
+	weights := make([]alloraMath.Dec, len(groundTruths))
+	for i, groundTruth := range groundTruths {
+		if len(groundTruth.Values) != dimension {
+			return emissions.GroundTruth{}, errorsmod.Wrapf(emissions.ErrInvalidGroundTruth,
+				"ground truth of reputer %s has %d values, expected %d", groundTruth.Reputer, len(groundTruth.Values), dimension)
+		}
+		weight, err := alloraMath.NewDecFromSdkInt(stakesByReputer[groundTruth.Reputer])
+		if err != nil {
+			return emissions.GroundTruth{}, err
+		}
+		weights[i] = weight
+	}
+
+	median := []alloraMath.Dec{alloraMath.NewDecFromInt64(50)}
+	consensus := make([]alloraMath.Dec, dimension)
+	for c := range consensus {
+		values := make([]alloraMath.Dec, len(groundTruths))
+		for i, groundTruth := range groundTruths {
+			values[i] = groundTruth.Values[c]
+		}
+		weightedMedian, err := alloraMath.WeightedPercentile(values, weights, median)
+		if err != nil {
+			return emissions.GroundTruth{}, errorsmod.Wrapf(err, "error calculating consensus of component %d", c)
+		}
+		consensus[c] = weightedMedian[0]
+	}
+
+	return emissions.GroundTruth{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Reputer:     "",
+		Values:      consensus,
+	}, nil
+}
+
+// Computes the losses of network inferences against a ground truth with the topic's built-in loss function
+type groundTruthLossCalculator struct {
+	topic          emissions.Topic
+	groundTruth    []alloraMath.Dec
+	pointLoss      emissions.PointLossFunction
+	quantileLoss   emissions.QuantileLossFunction
+	perComponent   bool
+	numComponents  int
+	numComponentsD alloraMath.Dec
+}
+
+func newGroundTruthLossCalculator(topic emissions.Topic, groundTruth []alloraMath.Dec) (*groundTruthLossCalculator, error) {
+	if err := topic.ValidateGroundTruth(groundTruth); err != nil {
+		return nil, err
+	}
+	calculator := &groundTruthLossCalculator{
+		topic:          topic,
+		groundTruth:    groundTruth,
+		pointLoss:      nil,
+		quantileLoss:   nil,
+		perComponent:   topic.SynthesizesPerComponent(),
+		numComponents:  1,
+		numComponentsD: alloraMath.OneDec(),
+	}
+	if topic.HasVectorOutput() {
+		calculator.numComponents = int(topic.OutputDimension)
+		calculator.numComponentsD = alloraMath.NewDecFromInt64(int64(topic.OutputDimension))
+	}
+	var ok bool
+	if topic.IsProbabilistic() {
+		calculator.quantileLoss, ok = emissions.GetQuantileLossFunction(topic.LossMethod)
+	} else {
+		calculator.pointLoss, ok = emissions.GetPointLossFunction(topic.LossMethod)
+	}
+	if !ok {
+		return nil, errorsmod.Wrapf(emissions.ErrInvalidGroundTruth, "no built-in loss function %s for topic %d", topic.LossMethod, topic.Id)
+	}
+	return calculator, nil
+}
+
+// Returns the loss of component c of an inference
+func (calc *groundTruthLossCalculator) componentLoss(value alloraMath.Dec, values []alloraMath.Dec, c int) (alloraMath.Dec, error) {
+	if calc.quantileLoss != nil {
+		return calc.quantileLoss(calc.topic.QuantileLevels[c], values[c], calc.groundTruth[0])
+	}
+	if len(values) == 0 {
+		return calc.pointLoss(value, calc.groundTruth[0])
+	}
+	return calc.pointLoss(values[c], calc.groundTruth[c])
+}
+
+// Returns the loss of an inference, averaged over its components, along with
+// the loss of each component if the topic is synthesized per component
+func (calc *groundTruthLossCalculator) loss(value alloraMath.Dec, values []alloraMath.Dec) (alloraMath.Dec, []alloraMath.Dec, error) {
+	if calc.numComponents > 1 && len(values) != calc.numComponents {
+		return alloraMath.Dec{}, nil, errorsmod.Wrapf(emissions.ErrInvalidOutputDimension,
+			"inference has %d values, expected %d", len(values), calc.numComponents)
+	}
+	sum := alloraMath.ZeroDec()
+	var componentLosses []alloraMath.Dec
+	if calc.perComponent {
+		componentLosses = make([]alloraMath.Dec, calc.numComponents)
+	}
+	for c := 0; c < calc.numComponents; c++ {
+		loss, err := calc.componentLoss(value, values, c)
+		if err != nil {
+			return alloraMath.Dec{}, nil, errorsmod.Wrapf(err, "error calculating loss of component %d", c)
+		}
+		if calc.perComponent {
+			componentLosses[c] = loss
+		}
+		sum, err = sum.Add(loss)
+		if err != nil {
+			return alloraMath.Dec{}, nil, err
+		}
+	}
+	mean, err := sum.Quo(calc.numComponentsD)
+	if err != nil {
+		return alloraMath.Dec{}, nil, err
+	}
+	return mean, componentLosses, nil
+}
+
+func (calc *groundTruthLossCalculator) attributedLosses(values []*emissions.WorkerAttributedValue) ([]*emissions.WorkerAttributedValue, error) {
+	losses := make([]*emissions.WorkerAttributedValue, len(values))
+	for i, v := range values {
+		loss, componentLosses, err := calc.loss(v.Value, v.Values)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "error calculating loss of worker %s", v.Worker)
+		}
+		losses[i] = &emissions.WorkerAttributedValue{
+			Worker: v.Worker,
+			Value:  loss,
+			Values: componentLosses,
+		}
+	}
+	return losses, nil
+}
+
+func (calc *groundTruthLossCalculator) withheldLosses(values []*emissions.WithheldWorkerAttributedValue) ([]*emissions.WithheldWorkerAttributedValue, error) {
+	losses := make([]*emissions.WithheldWorkerAttributedValue, len(values))
+	for i, v := range values {
+		loss, componentLosses, err := calc.loss(v.Value, v.Values)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "error calculating loss without worker %s", v.Worker)
+		}
+		losses[i] = &emissions.WithheldWorkerAttributedValue{
+			Worker: v.Worker,
+			Value:  loss,
+			Values: componentLosses,
+		}
+	}
+	return losses, nil
+}
+
+// CalcLossBundleFromGroundTruth computes the losses of every network inference of a nonce against
+// a ground truth, using the built-in loss function named by the topic loss method.
+// The returned bundle has the same shape as the value bundles reputers submit
+func CalcLossBundleFromGroundTruth(
+	topic emissions.Topic,
+	blockHeight BlockHeight,
+	reputer Worker,
+	networkInferences *emissions.ValueBundle,
+	groundTruth []alloraMath.Dec,
+) (*emissions.ValueBundle, error) {
+	calc, err := newGroundTruthLossCalculator(topic, groundTruth)
+	if err != nil {
+		return nil, err
+	}
+
+	combinedLoss, combinedLosses, err := calc.loss(networkInferences.CombinedValue, networkInferences.CombinedValues)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error calculating combined loss")
+	}
+	naiveLoss, naiveLosses, err := calc.loss(networkInferences.NaiveValue, networkInferences.NaiveValues)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error calculating naive loss")
+	}
+	infererLosses, err := calc.attributedLosses(networkInferences.InfererValues)
+	if err != nil {
+		return nil, err
+	}
+	forecasterLosses, err := calc.attributedLosses(networkInferences.ForecasterValues)
+	if err != nil {
+		return nil, err
+	}
+	oneInForecasterLosses, err := calc.attributedLosses(networkInferences.OneInForecasterValues)
+	if err != nil {
+		return nil, err
+	}
+	oneOutInfererLosses, err := calc.withheldLosses(networkInferences.OneOutInfererValues)
+	if err != nil {
+		return nil, err
+	}
+	oneOutForecasterLosses, err := calc.withheldLosses(networkInferences.OneOutForecasterValues)
+	if err != nil {
+		return nil, err
+	}
+	oneOutInfererForecasterLosses := make([]*emissions.OneOutInfererForecasterValues, len(networkInferences.OneOutInfererForecasterValues))
+	for i, v := range networkInferences.OneOutInfererForecasterValues {
+		losses, err := calc.withheldLosses(v.OneOutInfererValues)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "error calculating one-out losses of forecaster %s", v.Forecaster)
+		}
+		oneOutInfererForecasterLosses[i] = &emissions.OneOutInfererForecasterValues{
+			Forecaster:          v.Forecaster,
+			OneOutInfererValues: losses,
+		}
+	}
+
+	return &emissions.ValueBundle{
+		TopicId:                       topic.Id,
+		ReputerRequestNonce:           &emissions.ReputerRequestNonce{ReputerNonce: &emissions.Nonce{BlockHeight: blockHeight}},
+		Reputer:                       reputer,
+		ExtraData:                     nil,
+		CombinedValue:                 combinedLoss,
+		InfererValues:                 infererLosses,
+		ForecasterValues:              forecasterLosses,
+		NaiveValue:                    naiveLoss,
+		OneOutInfererValues:           oneOutInfererLosses,
+		OneOutForecasterValues:        oneOutForecasterLosses,
+		OneInForecasterValues:         oneInForecasterLosses,
+		OneOutInfererForecasterValues: oneOutInfererForecasterLosses,
+		CombinedValues:                combinedLosses,
+		NaiveValues:                   naiveLosses,
+	}, nil
+}
+
+// CalcNetworkLossesFromGroundTruth computes the network losses of a nonce from the consensus ground truth
+func CalcNetworkLossesFromGroundTruth(
+	topic emissions.Topic,
+	blockHeight BlockHeight,
+	networkInferences *emissions.ValueBundle,
+	consensus emissions.GroundTruth,
+) (emissions.ValueBundle, error) {
+	networkLosses, err := CalcLossBundleFromGroundTruth(topic, blockHeight, networkLossesReputer, networkInferences, consensus.Values)
+	if err != nil {
+		return emissions.ValueBundle{}, err
+	}
+	return *networkLosses, nil
+}
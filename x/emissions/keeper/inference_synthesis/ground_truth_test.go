@@ -0,0 +1,104 @@
+package inferencesynthesis_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/test/testutil"
+	inferencesynthesis "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	emissions "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *InferenceSynthesisTestSuite) TestCalcConsensusGroundTruth() {
+	require := s.Require()
+	groundTruths := []emissions.GroundTruth{
+		{TopicId: 1, BlockHeight: 100, Reputer: s.addrsStr[0], Values: []alloraMath.Dec{alloraMath.NewDecFromInt64(1), alloraMath.NewDecFromInt64(30)}},
+		{TopicId: 1, BlockHeight: 100, Reputer: s.addrsStr[1], Values: []alloraMath.Dec{alloraMath.NewDecFromInt64(2), alloraMath.NewDecFromInt64(20)}},
+		{TopicId: 1, BlockHeight: 100, Reputer: s.addrsStr[2], Values: []alloraMath.Dec{alloraMath.NewDecFromInt64(100), alloraMath.NewDecFromInt64(10)}},
+	}
+	// The first reputer holds most of the stake, so the consensus stays close to its
+	// ground truth and ignores the outlier of the third reputer
+	stakesByReputer := map[string]cosmosMath.Int{
+		s.addrsStr[0]: cosmosMath.NewInt(800),
+		s.addrsStr[1]: cosmosMath.NewInt(100),
+		s.addrsStr[2]: cosmosMath.NewInt(100),
+	}
+
+	consensus, err := inferencesynthesis.CalcConsensusGroundTruth(1, 100, stakesByReputer, groundTruths)
+	require.NoError(err)
+	require.Equal(uint64(1), consensus.TopicId)
+	require.Equal(int64(100), consensus.BlockHeight)
+	require.Empty(consensus.Reputer)
+	require.Len(consensus.Values, 2)
+	testutil.InEpsilon5(s.T(), consensus.Values[0], "1.2222222222222222222222222222222222")
+	testutil.InEpsilon5(s.T(), consensus.Values[1], "27.777777777777777777777777777777776")
+
+	_, err = inferencesynthesis.CalcConsensusGroundTruth(1, 100, stakesByReputer, nil)
+	require.ErrorIs(err, emissions.ErrInvalidGroundTruth)
+
+	groundTruths[1].Values = groundTruths[1].Values[:1]
+	_, err = inferencesynthesis.CalcConsensusGroundTruth(1, 100, stakesByReputer, groundTruths)
+	require.ErrorIs(err, emissions.ErrInvalidGroundTruth)
+}
+
+func (s *InferenceSynthesisTestSuite) TestCalcLossBundleFromGroundTruth() {
+	require := s.Require()
+	topic := s.mockTopic()
+	topic.LossComputationMode = emissions.LossComputationMode_LOSS_COMPUTATION_MODE_CHAIN
+
+	networkInferences := s.mockEmptyValueBundle(alloraMath.NewDecFromInt64(3))
+	networkInferences.NaiveValue = alloraMath.NewDecFromInt64(5)
+	networkInferences.InfererValues = []*emissions.WorkerAttributedValue{
+		{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(1), Values: nil},
+	}
+	networkInferences.OneOutInfererValues = []*emissions.WithheldWorkerAttributedValue{
+		{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(4), Values: nil},
+	}
+
+	lossBundle, err := inferencesynthesis.CalcLossBundleFromGroundTruth(
+		topic, 200, s.addrsStr[0], &networkInferences, []alloraMath.Dec{alloraMath.NewDecFromInt64(2)})
+	require.NoError(err)
+	require.Equal(s.addrsStr[0], lossBundle.Reputer)
+	require.Equal(int64(200), lossBundle.ReputerRequestNonce.ReputerNonce.BlockHeight)
+	testutil.InEpsilon5(s.T(), lossBundle.CombinedValue, "1")
+	testutil.InEpsilon5(s.T(), lossBundle.NaiveValue, "9")
+	require.Len(lossBundle.InfererValues, 1)
+	require.Equal(s.addrsStr[1], lossBundle.InfererValues[0].Worker)
+	testutil.InEpsilon5(s.T(), lossBundle.InfererValues[0].Value, "1")
+	require.Len(lossBundle.OneOutInfererValues, 1)
+	testutil.InEpsilon5(s.T(), lossBundle.OneOutInfererValues[0].Value, "4")
+	require.NoError(lossBundle.Validate())
+
+	_, err = inferencesynthesis.CalcLossBundleFromGroundTruth(
+		topic, 200, s.addrsStr[0], &networkInferences, []alloraMath.Dec{alloraMath.OneDec(), alloraMath.OneDec()})
+	require.ErrorIs(err, emissions.ErrInvalidOutputDimension)
+}
+
+func (s *InferenceSynthesisTestSuite) TestCalcLossBundleFromGroundTruthOfProbabilisticTopic() {
+	require := s.Require()
+	topic := s.mockTopic()
+	topic.LossMethod = emissions.LossMethodPinball
+	topic.OutputDimension = 3
+	topic.QuantileLevels = []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("0.1"),
+		alloraMath.MustNewDecFromString("0.5"),
+		alloraMath.MustNewDecFromString("0.9"),
+	}
+	topic.LossComputationMode = emissions.LossComputationMode_LOSS_COMPUTATION_MODE_CHAIN
+
+	quantiles := []alloraMath.Dec{
+		alloraMath.NewDecFromInt64(1),
+		alloraMath.NewDecFromInt64(2),
+		alloraMath.NewDecFromInt64(3),
+	}
+	networkInferences := s.mockEmptyValueBundle(alloraMath.NewDecFromInt64(2))
+	networkInferences.CombinedValues = quantiles
+	networkInferences.NaiveValues = quantiles
+
+	// Pinball losses are 0.1*1, 0.5*0 and 0.1*1 for an observed value of 2
+	lossBundle, err := inferencesynthesis.CalcLossBundleFromGroundTruth(
+		topic, 200, s.addrsStr[0], &networkInferences, []alloraMath.Dec{alloraMath.NewDecFromInt64(2)})
+	require.NoError(err)
+	testutil.InEpsilon5(s.T(), lossBundle.CombinedValue, "0.0666666666666666666666666666666667")
+	testutil.InEpsilon5(s.T(), lossBundle.NaiveValue, "0.0666666666666666666666666666666667")
+	require.Nil(lossBundle.CombinedValues)
+}
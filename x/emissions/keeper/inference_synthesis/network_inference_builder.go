@@ -80,6 +80,7 @@ func getInferences(
 		infererValues = append(infererValues, &emissions.WorkerAttributedValue{
 			Worker: inferer,
 			Value:  infererToInference[inferer].Value,
+			Values: nil,
 		})
 	}
 	return infererValues
@@ -100,6 +101,7 @@ func getForecastImpliedInferences(
 		forecastImpliedInferences = append(forecastImpliedInferences, &emissions.WorkerAttributedValue{
 			Worker: forecasterToForecastImpliedInference[forecaster].Inferer,
 			Value:  forecasterToForecastImpliedInference[forecaster].Value,
+			Values: nil,
 		})
 	}
 	return forecastImpliedInferences
@@ -110,6 +112,7 @@ type GetNaiveInferenceArgs struct {
 	Ctx                                  context.Context
 	Logger                               log.Logger
 	TopicId                              uint64
+	Component                            int
 	K                                    emissionskeeper.Keeper
 	Inferers                             []Worker
 	InfererToInference                   map[Worker]*emissions.Inference
@@ -134,7 +137,8 @@ func GetNaiveInference(args GetNaiveInferenceArgs) (naiveInference alloraMath.De
 		if err != nil {
 			return alloraMath.Dec{}, errorsmod.Wrapf(err, "GetNaiveInference() error getting naive regret for inferer %s", inferer)
 		}
-		infererToRegret[inferer] = &regret.Value
+		regretValue := regret.ComponentValue(args.Component)
+		infererToRegret[inferer] = &regretValue
 	}
 
 	weights, err := calcWeightsGivenWorkers(
@@ -179,6 +183,7 @@ type CalcOneOutInfererInferenceArgs struct {
 	K                    emissionskeeper.Keeper
 	Logger               log.Logger
 	TopicId              uint64
+	Component            int
 	Inferers             []Inferer
 	InfererToInference   map[Inferer]*emissions.Inference
 	InfererToRegret      map[Inferer]*Regret
@@ -223,7 +228,8 @@ func calcOneOutInfererInference(args CalcOneOutInfererInferenceArgs) (
 		if err != nil {
 			return alloraMath.Dec{}, errorsmod.Wrapf(err, "calcOneOutInfererInference() error getting one-out inferer regret")
 		}
-		remainingInfererRegrets[inferer] = &regret.Value
+		regretValue := regret.ComponentValue(args.Component)
+		remainingInfererRegrets[inferer] = &regretValue
 	}
 
 	// Recalculate the forecast-implied inferences without the worker's inference
@@ -256,7 +262,8 @@ func calcOneOutInfererInference(args CalcOneOutInfererInferenceArgs) (
 		if err != nil {
 			return alloraMath.Dec{}, errorsmod.Wrapf(err, "calcOneOutInfererInference() error getting one-out forecaster regret")
 		}
-		remainingForecasterRegrets[forecaster] = &regret.Value
+		regretValue := regret.ComponentValue(args.Component)
+		remainingForecasterRegrets[forecaster] = &regretValue
 	}
 
 	weights, err := calcWeightsGivenWorkers(
@@ -301,6 +308,7 @@ type GetOneOutInfererInferencesArgs struct {
 	K                    emissionskeeper.Keeper
 	Logger               log.Logger
 	TopicId              uint64
+	Component            int
 	Inferers             []Inferer
 	InfererToInference   map[Inferer]*emissions.Inference
 	InfererToRegret      map[Inferer]*Regret
@@ -337,6 +345,7 @@ func GetOneOutInfererInferences(args GetOneOutInfererInferencesArgs) (
 				K:                    args.K,
 				Logger:               args.Logger,
 				TopicId:              args.TopicId,
+				Component:            args.Component,
 				Inferers:             args.Inferers,
 				InfererToInference:   args.InfererToInference,
 				InfererToRegret:      args.InfererToRegret,
@@ -358,6 +367,7 @@ func GetOneOutInfererInferences(args GetOneOutInfererInferencesArgs) (
 		oneOutInferences = append(oneOutInferences, &emissions.WithheldWorkerAttributedValue{
 			Worker: worker,
 			Value:  oneOutInference,
+			Values: nil,
 		})
 	}
 
@@ -371,6 +381,7 @@ type CalcOneOutForecasterInferenceArgs struct {
 	K                                    emissionskeeper.Keeper
 	Logger                               log.Logger
 	TopicId                              uint64
+	Component                            int
 	Inferers                             []Inferer
 	InfererToInference                   map[Inferer]*emissions.Inference
 	InfererToRegret                      map[Inferer]*Regret
@@ -404,7 +415,8 @@ func calcOneOutForecasterInference(args CalcOneOutForecasterInferenceArgs) (
 			if err != nil {
 				return alloraMath.Dec{}, errorsmod.Wrapf(err, "calcOneOutForecasterInference() error getting one-out forecaster regret")
 			}
-			remainingForecasterRegrets[forecaster] = &regret.Value
+			regretValue := regret.ComponentValue(args.Component)
+			remainingForecasterRegrets[forecaster] = &regretValue
 
 			forecast, ok := args.ForecasterToForecast[forecaster]
 			if !ok {
@@ -421,7 +433,8 @@ func calcOneOutForecasterInference(args CalcOneOutForecasterInferenceArgs) (
 		if err != nil {
 			return alloraMath.Dec{}, errorsmod.Wrapf(err, "calcOneOutForecasterInference() error getting one-out inferer regret")
 		}
-		remainingInfererRegrets[inferer] = &regret.Value
+		regretValue := regret.ComponentValue(args.Component)
+		remainingInfererRegrets[inferer] = &regretValue
 	}
 
 	weights, err := calcWeightsGivenWorkers(
@@ -466,6 +479,7 @@ type GetOneOutForecasterInferencesArgs struct {
 	K                                    emissionskeeper.Keeper
 	Logger                               log.Logger
 	TopicId                              uint64
+	Component                            int
 	Inferers                             []Inferer
 	InfererToInference                   map[Inferer]*emissions.Inference
 	InfererToRegret                      map[Inferer]*Regret
@@ -499,6 +513,7 @@ func GetOneOutForecasterInferences(args GetOneOutForecasterInferencesArgs) (
 					K:                                    args.K,
 					Logger:                               args.Logger,
 					TopicId:                              args.TopicId,
+					Component:                            args.Component,
 					Inferers:                             args.Inferers,
 					InfererToInference:                   args.InfererToInference,
 					InfererToRegret:                      args.InfererToRegret,
@@ -518,6 +533,7 @@ func GetOneOutForecasterInferences(args GetOneOutForecasterInferencesArgs) (
 			oneOutForecasterInferences = append(oneOutForecasterInferences, &emissions.WithheldWorkerAttributedValue{
 				Worker: worker,
 				Value:  oneOutInference,
+				Values: nil,
 			})
 		}
 		args.Logger.Debug(fmt.Sprintf("One-out forecaster inferences calculated for topic %v", args.TopicId))
@@ -531,6 +547,7 @@ type calcOneInValueArgs struct {
 	K                                    emissionskeeper.Keeper
 	Logger                               log.Logger
 	TopicId                              uint64
+	Component                            int
 	AllInferersAreNew                    bool
 	Inferers                             []Inferer
 	InfererToInference                   map[Inferer]*emissions.Inference
@@ -560,7 +577,8 @@ func calcOneInValue(args calcOneInValueArgs) (
 	if err != nil {
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "CalcOneInValue() error getting one-in forecaster regret")
 	}
-	singleForecasterRegret[args.OneInForecaster] = &regret.Value
+	regretValue := regret.ComponentValue(args.Component)
+	singleForecasterRegret[args.OneInForecaster] = &regretValue
 
 	// get self forecast list
 	singleForecaster := []Worker{args.OneInForecaster}
@@ -577,7 +595,8 @@ func calcOneInValue(args calcOneInValueArgs) (
 		if err != nil {
 			return alloraMath.Dec{}, errorsmod.Wrapf(err, "CalcOneInValue() error getting one-in forecaster regret")
 		}
-		infererToRegretForSingleForecaster[inferer] = &regret.Value
+		regretValue := regret.ComponentValue(args.Component)
+		infererToRegretForSingleForecaster[inferer] = &regretValue
 
 		inference, ok := args.InfererToInference[inferer]
 		if !ok {
@@ -628,6 +647,7 @@ type GetOneInForecasterInferencesArgs struct {
 	K                                    emissionskeeper.Keeper
 	Logger                               log.Logger
 	TopicId                              uint64
+	Component                            int
 	Inferers                             []Inferer
 	InfererToInference                   map[Inferer]*emissions.Inference
 	AllInferersAreNew                    bool
@@ -659,6 +679,7 @@ func GetOneInForecasterInferences(args GetOneInForecasterInferencesArgs) (
 					K:                                    args.K,
 					Logger:                               args.Logger,
 					TopicId:                              args.TopicId,
+					Component:                            args.Component,
 					AllInferersAreNew:                    args.AllInferersAreNew,
 					Inferers:                             args.Inferers,
 					InfererToInference:                   args.InfererToInference,
@@ -676,6 +697,7 @@ func GetOneInForecasterInferences(args GetOneInForecasterInferencesArgs) (
 			oneInInferences = append(oneInInferences, &emissions.WorkerAttributedValue{
 				Worker: oneInForecaster,
 				Value:  oneInValue,
+				Values: nil,
 			})
 		}
 	}
@@ -684,10 +706,13 @@ func GetOneInForecasterInferences(args GetOneInForecasterInferencesArgs) (
 
 // Arguments to CalcNetworkInferences
 type CalcNetworkInferencesArgs struct {
-	Ctx                                  sdk.Context
-	K                                    emissionskeeper.Keeper
-	Logger                               log.Logger
-	TopicId                              uint64
+	Ctx     sdk.Context
+	K       emissionskeeper.Keeper
+	Logger  log.Logger
+	TopicId uint64
+	// Component of a vector output whose regrets are read. Regrets that are
+	// not tracked per component are used as is.
+	Component                            int
 	Inferers                             []Inferer
 	InfererToInference                   map[Inferer]*emissions.Inference
 	InfererToRegret                      map[Inferer]*Regret
@@ -752,6 +777,7 @@ func CalcNetworkInferences(
 			Ctx:                                  args.Ctx,
 			Logger:                               args.Logger,
 			TopicId:                              args.TopicId,
+			Component:                            args.Component,
 			K:                                    args.K,
 			Inferers:                             args.Inferers,
 			InfererToInference:                   args.InfererToInference,
@@ -781,6 +807,7 @@ func CalcNetworkInferences(
 			K:                    args.K,
 			Logger:               args.Logger,
 			TopicId:              args.TopicId,
+			Component:            args.Component,
 			Inferers:             args.Inferers,
 			InfererToInference:   args.InfererToInference,
 			InfererToRegret:      args.InfererToRegret,
@@ -810,6 +837,7 @@ func CalcNetworkInferences(
 			K:                                    args.K,
 			Logger:                               args.Logger,
 			TopicId:                              args.TopicId,
+			Component:                            args.Component,
 			Inferers:                             args.Inferers,
 			InfererToInference:                   args.InfererToInference,
 			InfererToRegret:                      args.InfererToRegret,
@@ -838,6 +866,7 @@ func CalcNetworkInferences(
 			K:                                    args.K,
 			Logger:                               args.Logger,
 			TopicId:                              args.TopicId,
+			Component:                            args.Component,
 			Inferers:                             args.Inferers,
 			InfererToInference:                   args.InfererToInference,
 			AllInferersAreNew:                    args.AllInferersAreNew,
@@ -870,5 +899,7 @@ func CalcNetworkInferences(
 		OneOutForecasterValues:        oneOutForecasterInferences,
 		OneInForecasterValues:         oneInForecasterInferences,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}, weights, err
 }
@@ -74,6 +74,7 @@ func (s *InferenceSynthesisTestSuite) SetupTest() {
 		emissionstypes.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		emissionstypes.AlloraRewardsAccountName: {"minter"},
 		emissionstypes.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		emissionstypes.AlloraRewardEscrowAccountName:              {"minter"},
 		"bonded_tokens_pool":     {"burner", "staking"},
 		"not_bonded_tokens_pool": {"burner", "staking"},
 		multiPerm:                {"burner", "minter", "staking"},
@@ -125,23 +126,35 @@ func (s *InferenceSynthesisTestSuite) SetupTest() {
 	}
 
 	err := s.emissionsKeeper.SetTopic(s.ctx, 1, emissionstypes.Topic{
-		Id:                       1,
-		Creator:                  s.addrsStr[0],
-		Metadata:                 "metadata",
-		LossMethod:               "mse",
-		EpochLastEnded:           0,
-		EpochLength:              100,
-		GroundTruthLag:           100,
-		WorkerSubmissionWindow:   100,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-		AllowNegative:            false,
-		InitialRegret:            alloraMath.MustNewDecFromString("0.0001"),
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.01"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.01"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.01"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.01"),
+		Id:                            1,
+		Creator:                       s.addrsStr[0],
+		Metadata:                      "metadata",
+		LossMethod:                    "mse",
+		EpochLastEnded:                0,
+		EpochLength:                   100,
+		GroundTruthLag:                100,
+		WorkerSubmissionWindow:        100,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		AllowNegative:                 false,
+		InitialRegret:                 alloraMath.MustNewDecFromString("0.0001"),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.01"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.01"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.01"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.01"),
+		OutputDimension:               0,
+		VectorSynthesisMode:           emissionstypes.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           emissionstypes.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	})
 	s.Require().NoError(err)
 }
@@ -178,11 +191,23 @@ func (s *InferenceSynthesisTestSuite) mockTopic() emissionstypes.Topic {
 		AllowNegative:          false,
 		Epsilon:                alloraMath.MustNewDecFromString("0.01"),
 		// Set Initial Regret
-		InitialRegret:            alloraMath.OneDec(),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.01"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.01"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.01"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.01"),
+		InitialRegret:                 alloraMath.OneDec(),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.01"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.01"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.01"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.01"),
+		OutputDimension:               0,
+		VectorSynthesisMode:           emissionstypes.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           emissionstypes.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 }
 
@@ -204,6 +229,8 @@ func (s *InferenceSynthesisTestSuite) mockEmptyValueBundle(
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 }
 
@@ -223,6 +250,8 @@ func (s *InferenceSynthesisTestSuite) getValueBundleForCombinedLoss(topicId uint
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	return valueBundle
 }
@@ -290,7 +319,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 				s.ctx,
 				topicId,
 				inferer,
-				emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret},
+				emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret, Values: nil},
 			)
 			s.Require().NoError(err)
 		}
@@ -306,7 +335,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 				s.ctx,
 				topicId,
 				forecaster,
-				emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret},
+				emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret, Values: nil},
 			)
 			s.Require().NoError(err)
 		}
@@ -325,7 +354,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 				s.ctx,
 				topicId,
 				inferer,
-				emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret},
+				emissionstypes.TimestampedValue{BlockHeight: blockHeight, Value: regret, Values: nil},
 			)
 			s.Require().NoError(err)
 		}
@@ -343,6 +372,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 				emissionstypes.TimestampedValue{
 					BlockHeight: blockHeight,
 					Value:       epochGetter(headerName),
+					Values:      nil,
 				},
 			)
 			s.Require().NoError(err)
@@ -366,6 +396,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 				emissionstypes.TimestampedValue{
 					BlockHeight: blockHeight,
 					Value:       epochGetter(headerName),
+					Values:      nil,
 				},
 			)
 			s.Require().NoError(err)
@@ -389,6 +420,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 				emissionstypes.TimestampedValue{
 					BlockHeight: blockHeight,
 					Value:       epochGetter(headerName),
+					Values:      nil,
 				},
 			)
 			s.Require().NoError(err)
@@ -412,6 +444,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 				emissionstypes.TimestampedValue{
 					BlockHeight: blockHeight,
 					Value:       epochGetter(headerName),
+					Values:      nil,
 				},
 			)
 			s.Require().NoError(err)
@@ -435,6 +468,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 				emissionstypes.TimestampedValue{
 					BlockHeight: blockHeight,
 					Value:       epochGetter(headerName),
+					Values:      nil,
 				},
 			)
 			s.Require().NoError(err)
@@ -450,6 +484,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 				emissionstypes.TimestampedValue{
 					BlockHeight: blockHeight,
 					Value:       epochGet(headerName),
+					Values:      nil,
 				},
 			)
 			s.Require().NoError(err)
@@ -494,6 +529,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 			Proof:       "",
 			BlockHeight: blockHeight,
 			TopicId:     topicId,
+			Values:      nil,
 		})
 	}
 
@@ -507,6 +543,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 			forecastElements = append(forecastElements, &emissionstypes.ForecastElement{
 				Inferer: s.addrsStr[infererIndex],
 				Value:   epochGet("forecasted_loss_" + strconv.Itoa(forecasterIndex) + "_for_" + strconv.Itoa(infererIndex)),
+				Values:  nil,
 			})
 		}
 		forecasts.Forecasts = append(forecasts.Forecasts, &emissionstypes.Forecast{
@@ -546,6 +583,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 		topic,
 		valueBundle,
 		moduleParams,
+		0,
 	)
 	s.Require().NoError(err)
 	return ctx, k, ctx.Logger(), topicId, calcArgs.AllInferersAreNew,
@@ -597,6 +635,7 @@ func (s *InferenceSynthesisTestSuite) getNetworkCalcArgs(
 		topic,
 		networkLosses,
 		moduleParams,
+		0,
 	)
 	s.Require().NoError(err)
 	return calcArgs.Inferers, calcArgs.InfererToInference, calcArgs.InfererToRegret, calcArgs.AllInferersAreNew,
@@ -668,6 +707,7 @@ func (s *InferenceSynthesisTestSuite) testCorrectNaiveValueForEpoch(epoch int) {
 			Ctx:                                  ctx,
 			Logger:                               logger,
 			TopicId:                              topicId,
+			Component:                            0,
 			K:                                    k,
 			Inferers:                             inferers,
 			InfererToInference:                   inferenceByWorker,
@@ -718,6 +758,7 @@ func (s *InferenceSynthesisTestSuite) testCorrectOneOutInfererValuesForEpoch(epo
 			K:                    k,
 			Logger:               logger,
 			TopicId:              topicId,
+			Component:            0,
 			Inferers:             inferers,
 			InfererToInference:   inferenceByWorker,
 			InfererToRegret:      infererRegrets,
@@ -764,6 +805,7 @@ func (s *InferenceSynthesisTestSuite) testCorrectOneOutForecasterValuesForEpoch(
 			K:                                    k,
 			Logger:                               logger,
 			TopicId:                              topicId,
+			Component:                            0,
 			Inferers:                             inferers,
 			InfererToInference:                   inferenceByWorker,
 			InfererToRegret:                      infererRegrets,
@@ -824,6 +866,7 @@ func (s *InferenceSynthesisTestSuite) testCorrectOneInForecasterValuesForEpoch(e
 			K:                                    k,
 			Logger:                               logger,
 			TopicId:                              topicId,
+			Component:                            0,
 			Inferers:                             inferers,
 			InfererToInference:                   inferenceByWorker,
 			AllInferersAreNew:                    allInferersAreNew,
@@ -896,8 +939,8 @@ func (s *InferenceSynthesisTestSuite) TestBuildNetworkInferencesIncompleteData()
 				BlockHeight: blockHeightForecaster,
 				Forecaster:  worker1,
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.5")},
-					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.8")},
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.5"), Values: nil},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.8"), Values: nil},
 				},
 			},
 			{
@@ -905,8 +948,8 @@ func (s *InferenceSynthesisTestSuite) TestBuildNetworkInferencesIncompleteData()
 				BlockHeight: blockHeightForecaster,
 				Forecaster:  worker2,
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.4")},
-					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.9")},
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.9"), Values: nil},
 				},
 			},
 		},
@@ -928,6 +971,7 @@ func (s *InferenceSynthesisTestSuite) TestBuildNetworkInferencesIncompleteData()
 		K:                                    k,
 		Logger:                               ctx.Logger(),
 		TopicId:                              topicId,
+		Component:                            0,
 		Inferers:                             inferers,
 		InfererToInference:                   inferenceByWorker,
 		InfererToRegret:                      infererRegrets,
@@ -981,8 +1025,8 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesTwoWorkerTwoForec
 				BlockHeight: blockHeight,
 				Forecaster:  worker3,
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.6")},
-					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.8")},
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.6"), Values: nil},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.8"), Values: nil},
 				},
 				ExtraData: nil,
 			},
@@ -991,8 +1035,8 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesTwoWorkerTwoForec
 				BlockHeight: blockHeight,
 				Forecaster:  worker4,
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.4")},
-					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.9")},
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.9"), Values: nil},
 				},
 				ExtraData: nil,
 			},
@@ -1000,25 +1044,25 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesTwoWorkerTwoForec
 	}
 
 	// Set inferer network regrets
-	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.2"), BlockHeight: blockHeight})
+	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.2"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetInfererNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.3"), BlockHeight: blockHeight})
+	err = k.SetInfererNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.3"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
 	// Set forecaster network regrets
-	err = k.SetForecasterNetworkRegret(ctx, topicId, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.4"), BlockHeight: blockHeight})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.4"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetForecasterNetworkRegret(ctx, topicId, worker4, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.5"), BlockHeight: blockHeight})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, worker4, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.5"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
 	// Set one-in forecaster network regrets
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker3, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.2"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker3, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.2"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker3, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.3"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker3, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.3"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker4, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.6"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker4, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.6"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker4, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.4"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker4, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.4"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
 	networkCombinedLoss := alloraMath.MustNewDecFromString("0.2")
@@ -1040,6 +1084,7 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesTwoWorkerTwoForec
 		K:                                    k,
 		Logger:                               ctx.Logger(),
 		TopicId:                              topicId,
+		Component:                            0,
 		Inferers:                             inferers,
 		InfererToInference:                   inferenceByWorker,
 		InfererToRegret:                      infererRegrets,
@@ -1096,9 +1141,9 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesThreeWorkerThreeF
 				BlockHeight: blockHeight,
 				Forecaster:  forecaster1,
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("400")},
-					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("500")},
-					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("600")},
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("400"), Values: nil},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("500"), Values: nil},
+					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("600"), Values: nil},
 				},
 				ExtraData: nil,
 			},
@@ -1107,9 +1152,9 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesThreeWorkerThreeF
 				BlockHeight: blockHeight,
 				Forecaster:  forecaster2,
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("700")},
-					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("800")},
-					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("900")},
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("700"), Values: nil},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("800"), Values: nil},
+					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("900"), Values: nil},
 				},
 				ExtraData: nil,
 			},
@@ -1118,9 +1163,9 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesThreeWorkerThreeF
 				BlockHeight: blockHeight,
 				Forecaster:  forecaster3,
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("100")},
-					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("200")},
-					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("300")},
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("100"), Values: nil},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
+					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("300"), Values: nil},
 				},
 				ExtraData: nil,
 			},
@@ -1128,41 +1173,41 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesThreeWorkerThreeF
 	}
 
 	// Set inferer network regrets
-	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001"), BlockHeight: blockHeight})
+	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetInfererNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.002"), BlockHeight: blockHeight})
+	err = k.SetInfererNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.002"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetInfererNetworkRegret(ctx, topicId, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.003"), BlockHeight: blockHeight})
+	err = k.SetInfererNetworkRegret(ctx, topicId, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.003"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
 	// Set forecaster network regrets
-	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004"), BlockHeight: blockHeight})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005"), BlockHeight: blockHeight})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.006"), BlockHeight: blockHeight})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.006"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
 	// Set one-in forecaster network regrets
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.007"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.007"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.008"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.008"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.009"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.009"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.002"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.002"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.003"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.003"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.006"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.006"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
 	networkCombinedLoss := alloraMath.MustNewDecFromString("10000")
@@ -1182,6 +1227,7 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesThreeWorkerThreeF
 		K:                                    k,
 		Logger:                               ctx.Logger(),
 		TopicId:                              topicId,
+		Component:                            0,
 		Inferers:                             inferers,
 		InfererToInference:                   inferenceByWorker,
 		InfererToRegret:                      infererRegrets,
@@ -1241,9 +1287,9 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesThreeWorkerTwoFor
 				BlockHeight: blockHeight,
 				Forecaster:  forecaster1,
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("400")},
-					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("500")},
-					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("600")},
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("400"), Values: nil},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("500"), Values: nil},
+					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("600"), Values: nil},
 				},
 				ExtraData: nil,
 			},
@@ -1252,9 +1298,9 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesThreeWorkerTwoFor
 				BlockHeight: blockHeight,
 				Forecaster:  forecaster2,
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("700")},
-					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("800")},
-					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("900")},
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("700"), Values: nil},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("800"), Values: nil},
+					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("900"), Values: nil},
 				},
 				ExtraData: nil,
 			},
@@ -1264,9 +1310,9 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesThreeWorkerTwoFor
 				BlockHeight: blockHeight,
 				Forecaster:  forecaster3,
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: "unexistent_worker1", Value: alloraMath.MustNewDecFromString("100")},
-					{Inferer: "unexistent_worker2", Value: alloraMath.MustNewDecFromString("200")},
-					{Inferer: "unexistent_worker3", Value: alloraMath.MustNewDecFromString("300")},
+					{Inferer: "unexistent_worker1", Value: alloraMath.MustNewDecFromString("100"), Values: nil},
+					{Inferer: "unexistent_worker2", Value: alloraMath.MustNewDecFromString("200"), Values: nil},
+					{Inferer: "unexistent_worker3", Value: alloraMath.MustNewDecFromString("300"), Values: nil},
 				},
 				ExtraData: nil,
 			},
@@ -1274,41 +1320,41 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesThreeWorkerTwoFor
 	}
 
 	// Set inferer network regrets
-	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001"), BlockHeight: blockHeight})
+	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetInfererNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.002"), BlockHeight: blockHeight})
+	err = k.SetInfererNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.002"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetInfererNetworkRegret(ctx, topicId, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.003"), BlockHeight: blockHeight})
+	err = k.SetInfererNetworkRegret(ctx, topicId, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.003"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
 	// Set forecaster network regrets
-	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004"), BlockHeight: blockHeight})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005"), BlockHeight: blockHeight})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.006"), BlockHeight: blockHeight})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, forecaster3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.006"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
 	// Set one-in forecaster network regrets
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.007"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.007"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.008"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.008"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.009"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster1, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.009"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.002"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.002"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.003"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster2, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.003"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.006"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster3, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.006"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
 	networkCombinedLoss := alloraMath.MustNewDecFromString("10000")
@@ -1328,6 +1374,7 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkInferencesThreeWorkerTwoFor
 		K:                                    k,
 		Logger:                               ctx.Logger(),
 		TopicId:                              topicId,
+		Component:                            0,
 		Inferers:                             inferers,
 		InfererToInference:                   inferenceByWorker,
 		InfererToRegret:                      infererRegrets,
@@ -1375,8 +1422,8 @@ func (s *InferenceSynthesisTestSuite) TestCalc0neInInferencesTwoForecastersOldTw
 	// Set up input data
 	inferences := &emissionstypes.Inferences{
 		Inferences: []*emissionstypes.Inference{
-			{Inferer: worker1, Value: alloraMath.MustNewDecFromString("100")},
-			{Inferer: worker2, Value: alloraMath.MustNewDecFromString("200")},
+			{Inferer: worker1, Value: alloraMath.MustNewDecFromString("100"), Values: nil},
+			{Inferer: worker2, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
 		},
 	}
 
@@ -1385,15 +1432,15 @@ func (s *InferenceSynthesisTestSuite) TestCalc0neInInferencesTwoForecastersOldTw
 			{
 				Forecaster: worker1,
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("100")},
-					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("500")},
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("100"), Values: nil},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("500"), Values: nil},
 				},
 			},
 			{
 				Forecaster: worker2,
 				ForecastElements: []*emissionstypes.ForecastElement{
-					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("700")},
-					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("200")},
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("700"), Values: nil},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
 				},
 			},
 		},
@@ -1401,21 +1448,21 @@ func (s *InferenceSynthesisTestSuite) TestCalc0neInInferencesTwoForecastersOldTw
 
 	blockHeight := int64(300)
 	// Set inferer network regrets - Just for worker1
-	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001"), BlockHeight: blockHeight})
+	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
 	// Set forecaster network regrets
-	err = k.SetForecasterNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004"), BlockHeight: blockHeight})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.004"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetForecasterNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005"), BlockHeight: blockHeight})
+	err = k.SetForecasterNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.005"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
 	// Set one-in forecaster network regrets
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker1, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker1, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker2, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker2, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.001"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
-	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker2, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.008"), BlockHeight: blockHeight})
+	err = k.SetOneInForecasterNetworkRegret(ctx, topicId, worker2, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.008"), BlockHeight: blockHeight, Values: nil})
 	s.Require().NoError(err)
 
 	networkCombinedLoss := alloraMath.MustNewDecFromString("10000")
@@ -1435,6 +1482,7 @@ func (s *InferenceSynthesisTestSuite) TestCalc0neInInferencesTwoForecastersOldTw
 		K:                                    k,
 		Logger:                               ctx.Logger(),
 		TopicId:                              topicId,
+		Component:                            0,
 		Inferers:                             inferers,
 		InfererToInference:                   inferenceByWorker,
 		InfererToRegret:                      infererRegrets,
@@ -37,6 +37,59 @@ func GetNetworkInferences(
 		return nil, errorsmod.Wrap(err, "while getting inferences")
 	}
 
+	topic, err := k.GetTopic(ctx, topicId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "while getting topic")
+	}
+	if topic.HasVectorOutput() {
+		return calcVectorNetworkInferences(ctx, k, topic, inferences, inferenceBlockHeight)
+	}
+	return calcNetworkInferencesOfComponent(ctx, k, topic, inferences, inferenceBlockHeight, 0)
+}
+
+// Calculates the network inferences of a vector topic one component at a time, then
+// combines them into a single bundle. Scalar values of the bundle hold the first
+// component, and the weights are those of the first component.
+func calcVectorNetworkInferences(
+	ctx sdk.Context,
+	k emissionskeeper.Keeper,
+	topic emissions.Topic,
+	inferences *emissions.Inferences,
+	inferenceBlockHeight BlockHeight,
+) (*GetNetworkInferencesResult, error) {
+	var result *GetNetworkInferencesResult
+	componentBundles := make([]*emissions.ValueBundle, topic.OutputDimension)
+	for c := range componentBundles {
+		componentResult, err := calcNetworkInferencesOfComponent(ctx, k, topic, inferences.Component(c), inferenceBlockHeight, c)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "while calculating network inferences of component %d", c)
+		}
+		if c == 0 {
+			result = componentResult
+		}
+		componentBundles[c] = componentResult.NetworkInferences
+	}
+	if err := result.NetworkInferences.SetComponents(componentBundles); err != nil {
+		return nil, errorsmod.Wrap(err, "while combining network inferences of components")
+	}
+	if topic.IsProbabilistic() {
+		result.NetworkInferences.RearrangeQuantiles()
+	}
+	return result, nil
+}
+
+// Calculates the network inferences from inferences holding a single value. For vector
+// topics, the inferences hold the values of the given component and the forecasts,
+// losses and regrets of that component are used.
+func calcNetworkInferencesOfComponent(
+	ctx sdk.Context,
+	k emissionskeeper.Keeper,
+	topic emissions.Topic,
+	inferences *emissions.Inferences,
+	inferenceBlockHeight BlockHeight,
+	component int,
+) (*GetNetworkInferencesResult, error) {
+	topicId := topic.Id
 	if len(inferences.Inferences) > 1 {
 		// If we have multiple inferences:
 		// 1. Try to get latest network loss
@@ -49,7 +102,7 @@ func GetNetworkInferences(
 		}
 
 		// 2b. Otherwise, calculate the normal way.
-		return calcNetworkInferencesMultiple(ctx, k, topicId, inferences, inferenceBlockHeight, networkLosses)
+		return calcNetworkInferencesMultiple(ctx, k, topic, inferences, inferenceBlockHeight, networkLosses, component)
 	} else if len(inferences.Inferences) == 1 {
 		// If we only have a single inference, simply return it as is.
 		return calcNetworkInferencesSingle(ctx, inferenceBlockHeight, topicId, inferences)
@@ -107,7 +160,7 @@ func calcNetworkInferencesMultipleByMedian(
 		Reputer:       "allo1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqas6usy",
 		CombinedValue: medianValue,
 		InfererValues: fn.Map(inferences.Inferences, func(inf *emissions.Inference) *emissions.WorkerAttributedValue {
-			return &emissions.WorkerAttributedValue{Worker: inf.Inferer, Value: inf.Value}
+			return &emissions.WorkerAttributedValue{Worker: inf.Inferer, Value: inf.Value, Values: nil}
 		}),
 		ForecasterValues:              nil,
 		NaiveValue:                    alloraMath.ZeroDec(),
@@ -115,6 +168,8 @@ func calcNetworkInferencesMultipleByMedian(
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	return &GetNetworkInferencesResult{
 		NetworkInferences:    networkInferences,
@@ -128,11 +183,14 @@ func calcNetworkInferencesMultipleByMedian(
 func calcNetworkInferencesMultiple(
 	ctx sdk.Context,
 	k emissionskeeper.Keeper,
-	topicId TopicId,
+	topic emissions.Topic,
 	inferences *emissions.Inferences,
 	inferenceBlockHeight BlockHeight,
 	networkLosses *emissions.ValueBundle,
+	component int,
 ) (*GetNetworkInferencesResult, error) {
+	topicId := topic.Id
+
 	// Retrieve forecasts
 	forecasts, err := k.GetForecastsAtBlock(ctx, topicId, inferenceBlockHeight)
 	if errors.Is(err, collections.ErrNotFound) {
@@ -141,18 +199,20 @@ func calcNetworkInferencesMultiple(
 		return nil, errorsmod.Wrap(err, "while getting forecasts")
 	}
 
+	// Use the forecasted and actual losses of the component if they are tracked per component
+	if topic.SynthesizesPerComponent() {
+		if forecasts != nil {
+			forecasts = forecasts.Component(component)
+		}
+		networkLosses = networkLosses.Component(component)
+	}
+
 	// Retrieve module params
 	moduleParams, err := k.GetParams(ctx)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "while getting params")
 	}
 
-	// Retrieve topic
-	topic, err := k.GetTopic(ctx, topicId)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "while getting topic")
-	}
-
 	// Otherwise, go ahead and calculate the inferences in the more complex way
 	calcArgs, err := GetCalcNetworkInferenceArgs(
 		ctx,
@@ -163,6 +223,7 @@ func calcNetworkInferencesMultiple(
 		topic,
 		*networkLosses,
 		moduleParams,
+		component,
 	)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "while getting network inference args")
@@ -205,6 +266,7 @@ func calcNetworkInferencesSingle(
 			{
 				Worker: singleInference.Inferer,
 				Value:  singleInference.Value,
+				Values: nil,
 			},
 		},
 		ForecasterValues:              nil,
@@ -213,6 +275,8 @@ func calcNetworkInferencesSingle(
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	return &GetNetworkInferencesResult{
 		NetworkInferences:    networkInferences,
@@ -235,6 +299,7 @@ func GetCalcNetworkInferenceArgs(
 	topic emissions.Topic,
 	networkLosses emissions.ValueBundle,
 	moduleParams emissions.Params,
+	component int,
 ) (
 	calcArgs CalcNetworkInferencesArgs,
 	err error,
@@ -253,8 +318,9 @@ func GetCalcNetworkInferenceArgs(
 			return CalcNetworkInferencesArgs{}, errorsmod.Wrapf(err, "GetCalcNetworkInferenceArgs: error getting inferer regret")
 		}
 
-		logger.Debug(fmt.Sprintf("Inferer %v has regret %v", inferer, regret.Value))
-		infererToRegret[inferer] = &regret.Value
+		regretValue := regret.ComponentValue(component)
+		logger.Debug(fmt.Sprintf("Inferer %v has regret %v", inferer, regretValue))
+		infererToRegret[inferer] = &regretValue
 	}
 
 	forecasterToRegret := make(map[string]*alloraMath.Dec)
@@ -264,8 +330,9 @@ func GetCalcNetworkInferenceArgs(
 			return CalcNetworkInferencesArgs{}, errorsmod.Wrapf(err, "GetCalcNetworkInferenceArgs: error getting forecaster regret")
 		}
 
-		logger.Debug(fmt.Sprintf("Forecaster %v has regret %v", forecaster, regret.Value))
-		forecasterToRegret[forecaster] = &regret.Value
+		regretValue := regret.ComponentValue(component)
+		logger.Debug(fmt.Sprintf("Forecaster %v has regret %v", forecaster, regretValue))
+		forecasterToRegret[forecaster] = &regretValue
 	}
 
 	forecastImpliedInferencesByWorker, _, _, err := CalcForecastImpliedInferences(
@@ -294,6 +361,7 @@ func GetCalcNetworkInferenceArgs(
 		K:                                    k,
 		Logger:                               logger,
 		TopicId:                              topicId,
+		Component:                            component,
 		Inferers:                             sortedInferers,
 		InfererToInference:                   infererToInference,
 		InfererToRegret:                      infererToRegret,
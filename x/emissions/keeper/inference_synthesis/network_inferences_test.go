@@ -838,3 +838,65 @@ func (s *InferenceSynthesisTestSuite) TestGetNetworkInferencesWithMedianCalculat
 		s.Require().True(found, "Inference not found in the result")
 	}
 }
+
+func (s *InferenceSynthesisTestSuite) TestGetNetworkInferencesOfVectorTopic() {
+	require := s.Require()
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	blockHeight := int64(300)
+
+	topic := s.mockTopic()
+	topic.OutputDimension = 2
+	topic.VectorSynthesisMode = emissionstypes.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_PER_COMPONENT
+	err := keeper.SetTopic(s.ctx, topicId, topic)
+	require.NoError(err)
+
+	newInference := func(inferer string, values ...string) *emissionstypes.Inference {
+		decs := make([]alloraMath.Dec, len(values))
+		for i, v := range values {
+			decs[i] = alloraMath.MustNewDecFromString(v)
+		}
+		return &emissionstypes.Inference{
+			TopicId:     topicId,
+			BlockHeight: blockHeight,
+			Inferer:     inferer,
+			Value:       alloraMath.ZeroDec(),
+			ExtraData:   nil,
+			Proof:       "",
+			Values:      decs,
+		}
+	}
+	inferences := emissionstypes.Inferences{
+		Inferences: []*emissionstypes.Inference{
+			newInference(s.addrsStr[1], "10", "-3"),
+			newInference(s.addrsStr[2], "30", "-1"),
+			newInference(s.addrsStr[3], "20", "-2"),
+		},
+	}
+	err = keeper.InsertActiveInferences(s.ctx, topicId, blockHeight, inferences)
+	require.NoError(err)
+
+	result, err := inferencesynthesis.GetNetworkInferences(s.ctx, keeper, topicId, &blockHeight, false)
+	require.NoError(err)
+	valueBundle := result.NetworkInferences
+
+	// Without network losses each component is the median of that component
+	require.Len(valueBundle.CombinedValues, 2)
+	require.True(alloraMath.MustNewDecFromString("20").Equal(valueBundle.CombinedValues[0]))
+	require.True(alloraMath.MustNewDecFromString("-2").Equal(valueBundle.CombinedValues[1]))
+	require.Len(valueBundle.NaiveValues, 2)
+
+	require.Len(valueBundle.InfererValues, len(inferences.Inferences))
+	for _, infererValue := range valueBundle.InfererValues {
+		found := false
+		for _, inference := range inferences.Inferences {
+			if inference.Inferer == infererValue.Worker {
+				found = true
+				require.Len(infererValue.Values, 2)
+				require.True(inference.Values[0].Equal(infererValue.Values[0]))
+				require.True(inference.Values[1].Equal(infererValue.Values[1]))
+			}
+		}
+		require.True(found, "Inference not found in the result")
+	}
+}
@@ -6,6 +6,11 @@ import (
 	emissions "github.com/allora-network/allora-chain/x/emissions/types"
 )
 
+// we re-use the value bundle for the network loss bundle
+// this is bad practice and we should refactor: PROTO-2369, PROTO-2464
+// for now set the Reputer address to a "zero" address
+const networkLossesReputer = "allo1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqas6usy"
+
 type RunningWeightedLoss struct {
 	UnnormalizedWeightedLoss Loss
 	SumWeight                Weight
@@ -249,12 +254,9 @@ func CalcNetworkLosses(
 	}
 
 	output := emissions.ValueBundle{
-		TopicId:             topicId,
-		ReputerRequestNonce: &emissions.ReputerRequestNonce{ReputerNonce: &emissions.Nonce{BlockHeight: blockHeight}},
-		// we re-use the value bundle for the network loss bundle
-		// this is bad practice and we should refactor: PROTO-2369, PROTO-2464
-		// for now set the Reputer address to a "zero" address
-		Reputer:                       "allo1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqas6usy",
+		TopicId:                       topicId,
+		ReputerRequestNonce:           &emissions.ReputerRequestNonce{ReputerNonce: &emissions.Nonce{BlockHeight: blockHeight}},
+		Reputer:                       networkLossesReputer,
 		ExtraData:                     nil,
 		CombinedValue:                 combinedValue,
 		InfererValues:                 infererLosses,
@@ -264,11 +266,41 @@ func CalcNetworkLosses(
 		OneOutForecasterValues:        oneOutForecasterLosses,
 		OneInForecasterValues:         oneInForecasterLosses,
 		OneOutInfererForecasterValues: oneOutInfererForecasterLosses,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 
 	return output, nil
 }
 
+// Calculates the network losses of a topic. For topics synthesized per component, the
+// per-component losses are stake-weighted averages of the reported per-component
+// losses, computed alongside the losses reported over the whole vector.
+func CalcTopicNetworkLosses(
+	topic emissions.Topic,
+	blockHeight int64,
+	stakesByReputer map[Worker]Stake,
+	reputerReportedLosses emissions.ReputerValueBundles,
+) (emissions.ValueBundle, error) {
+	networkLosses, err := CalcNetworkLosses(topic.Id, blockHeight, stakesByReputer, reputerReportedLosses)
+	if err != nil || !topic.SynthesizesPerComponent() {
+		return networkLosses, err
+	}
+
+	componentLosses := make([]*emissions.ValueBundle, topic.OutputDimension)
+	for c := range componentLosses {
+		losses, err := CalcNetworkLosses(topic.Id, blockHeight, stakesByReputer, reputerReportedLosses.Component(c))
+		if err != nil {
+			return emissions.ValueBundle{}, errorsmod.Wrapf(err, "Error calculating network losses of component %d", c)
+		}
+		componentLosses[c] = &losses
+	}
+	if err := networkLosses.SetComponents(componentLosses); err != nil {
+		return emissions.ValueBundle{}, errorsmod.Wrap(err, "Error combining network losses of components")
+	}
+	return networkLosses, nil
+}
+
 func normalizeWeightedLoss(
 	runningWeightedLossData *RunningWeightedLoss,
 ) (alloraMath.Dec, error) {
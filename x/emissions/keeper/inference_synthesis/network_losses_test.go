@@ -84,33 +84,40 @@ func (s *InferenceSynthesisTestSuite) getTestCasesOneWorker() []struct {
 			{
 				Worker: s.addrsStr[1],
 				Value:  alloraMath.MustNewDecFromString("0.1"),
+				Values: nil,
 			},
 		},
 		ForecasterValues: []*emissions.WorkerAttributedValue{
 			{
 				Worker: s.addrsStr[1],
 				Value:  alloraMath.MustNewDecFromString("0.1"),
+				Values: nil,
 			},
 		},
 		OneOutInfererValues: []*emissions.WithheldWorkerAttributedValue{
 			{
 				Worker: s.addrsStr[1],
 				Value:  alloraMath.MustNewDecFromString("0.1"),
+				Values: nil,
 			},
 		},
 		OneOutForecasterValues: []*emissions.WithheldWorkerAttributedValue{
 			{
 				Worker: s.addrsStr[1],
 				Value:  alloraMath.MustNewDecFromString("0.1"),
+				Values: nil,
 			},
 		},
 		OneInForecasterValues: []*emissions.WorkerAttributedValue{
 			{
 				Worker: s.addrsStr[1],
 				Value:  alloraMath.MustNewDecFromString("0.1"),
+				Values: nil,
 			},
 		},
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature := s.signValueBundle(valueBundle, s.privKeys[1])
 	return []struct {
@@ -149,33 +156,40 @@ func (s *InferenceSynthesisTestSuite) getTestCasesOneWorker() []struct {
 					{
 						Worker: s.addrsStr[1],
 						Value:  alloraMath.MustNewDecFromString("0.1587401051968199"),
+						Values: nil,
 					},
 				},
 				ForecasterValues: []*emissions.WorkerAttributedValue{
 					{
 						Worker: s.addrsStr[1],
 						Value:  alloraMath.MustNewDecFromString("0.1587401051968199"),
+						Values: nil,
 					},
 				},
 				OneOutInfererValues: []*emissions.WithheldWorkerAttributedValue{
 					{
 						Worker: s.addrsStr[1],
 						Value:  alloraMath.MustNewDecFromString("0.1587401051968199"),
+						Values: nil,
 					},
 				},
 				OneOutForecasterValues: []*emissions.WithheldWorkerAttributedValue{
 					{
 						Worker: s.addrsStr[1],
 						Value:  alloraMath.MustNewDecFromString("0.1587401051968199"),
+						Values: nil,
 					},
 				},
 				OneInForecasterValues: []*emissions.WorkerAttributedValue{
 					{
 						Worker: s.addrsStr[1],
 						Value:  alloraMath.MustNewDecFromString("0.1587401051968199"),
+						Values: nil,
 					},
 				},
 				OneOutInfererForecasterValues: nil,
+				CombinedValues:                nil,
+				NaiveValues:                   nil,
 			},
 			expectedError: nil,
 		},
@@ -203,53 +217,65 @@ func (s *InferenceSynthesisTestSuite) getTestCasesTwoWorkers() []struct {
 			{
 				Worker: s.addrsStr[1],
 				Value:  alloraMath.MustNewDecFromString("0.1"),
+				Values: nil,
 			},
 			{
 				Worker: s.addrsStr[2],
 				Value:  alloraMath.MustNewDecFromString("0.1"),
+				Values: nil,
 			},
 		},
 		ForecasterValues: []*emissions.WorkerAttributedValue{
 			{
 				Worker: s.addrsStr[1],
 				Value:  alloraMath.MustNewDecFromString("0.1"),
+				Values: nil,
 			},
 			{
 				Worker: s.addrsStr[2],
 				Value:  alloraMath.MustNewDecFromString("0.1"),
+				Values: nil,
 			},
 		},
 		OneOutInfererValues: []*emissions.WithheldWorkerAttributedValue{
 			{
 				Worker: s.addrsStr[1],
 				Value:  alloraMath.MustNewDecFromString("0.1"),
+				Values: nil,
 			},
 			{
 				Worker: s.addrsStr[2],
 				Value:  alloraMath.MustNewDecFromString("0.1"),
+				Values: nil,
 			},
 		},
 		OneOutForecasterValues: []*emissions.WithheldWorkerAttributedValue{
 			{
 				Worker: s.addrsStr[1],
 				Value:  alloraMath.MustNewDecFromString("0.1"),
+				Values: nil,
 			},
 			{
 				Worker: s.addrsStr[2],
 				Value:  alloraMath.MustNewDecFromString("0.1"),
+				Values: nil,
 			},
 		},
 		OneInForecasterValues: []*emissions.WorkerAttributedValue{
 			{
 				Worker: s.addrsStr[1],
 				Value:  alloraMath.MustNewDecFromString("0.1"),
+				Values: nil,
 			},
 			{
 				Worker: s.addrsStr[2],
 				Value:  alloraMath.MustNewDecFromString("0.1"),
+				Values: nil,
 			},
 		},
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature1 := s.signValueBundle(&valueBundle1, s.privKeys[1])
 
@@ -266,53 +292,65 @@ func (s *InferenceSynthesisTestSuite) getTestCasesTwoWorkers() []struct {
 			{
 				Worker: s.addrsStr[1],
 				Value:  alloraMath.MustNewDecFromString("0.2"),
+				Values: nil,
 			},
 			{
 				Worker: s.addrsStr[2],
 				Value:  alloraMath.MustNewDecFromString("0.2"),
+				Values: nil,
 			},
 		},
 		ForecasterValues: []*emissions.WorkerAttributedValue{
 			{
 				Worker: s.addrsStr[1],
 				Value:  alloraMath.MustNewDecFromString("0.2"),
+				Values: nil,
 			},
 			{
 				Worker: s.addrsStr[2],
 				Value:  alloraMath.MustNewDecFromString("0.2"),
+				Values: nil,
 			},
 		},
 		OneOutInfererValues: []*emissions.WithheldWorkerAttributedValue{
 			{
 				Worker: s.addrsStr[1],
 				Value:  alloraMath.MustNewDecFromString("0.2"),
+				Values: nil,
 			},
 			{
 				Worker: s.addrsStr[2],
 				Value:  alloraMath.MustNewDecFromString("0.2"),
+				Values: nil,
 			},
 		},
 		OneOutForecasterValues: []*emissions.WithheldWorkerAttributedValue{
 			{
 				Worker: s.addrsStr[1],
 				Value:  alloraMath.MustNewDecFromString("0.2"),
+				Values: nil,
 			},
 			{
 				Worker: s.addrsStr[2],
 				Value:  alloraMath.MustNewDecFromString("0.2"),
+				Values: nil,
 			},
 		},
 		OneInForecasterValues: []*emissions.WorkerAttributedValue{
 			{
 				Worker: s.addrsStr[1],
 				Value:  alloraMath.MustNewDecFromString("0.2"),
+				Values: nil,
 			},
 			{
 				Worker: s.addrsStr[2],
 				Value:  alloraMath.MustNewDecFromString("0.2"),
+				Values: nil,
 			},
 		},
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature2 := s.signValueBundle(&valueBundle2, s.privKeys[2])
 	return []struct {
@@ -357,53 +395,65 @@ func (s *InferenceSynthesisTestSuite) getTestCasesTwoWorkers() []struct {
 					{
 						Worker: s.addrsStr[1],
 						Value:  alloraMath.MustNewDecFromString("0.166666666"),
+						Values: nil,
 					},
 					{
 						Worker: s.addrsStr[2],
 						Value:  alloraMath.MustNewDecFromString("0.166666666"),
+						Values: nil,
 					},
 				},
 				ForecasterValues: []*emissions.WorkerAttributedValue{
 					{
 						Worker: s.addrsStr[1],
 						Value:  alloraMath.MustNewDecFromString("0.166666666"),
+						Values: nil,
 					},
 					{
 						Worker: s.addrsStr[2],
 						Value:  alloraMath.MustNewDecFromString("0.166666666"),
+						Values: nil,
 					},
 				},
 				OneOutInfererValues: []*emissions.WithheldWorkerAttributedValue{
 					{
 						Worker: s.addrsStr[1],
 						Value:  alloraMath.MustNewDecFromString("0.166666666"),
+						Values: nil,
 					},
 					{
 						Worker: s.addrsStr[2],
 						Value:  alloraMath.MustNewDecFromString("0.166666666"),
+						Values: nil,
 					},
 				},
 				OneOutForecasterValues: []*emissions.WithheldWorkerAttributedValue{
 					{
 						Worker: s.addrsStr[1],
 						Value:  alloraMath.MustNewDecFromString("0.166666666"),
+						Values: nil,
 					},
 					{
 						Worker: s.addrsStr[2],
 						Value:  alloraMath.MustNewDecFromString("0.166666666"),
+						Values: nil,
 					},
 				},
 				OneInForecasterValues: []*emissions.WorkerAttributedValue{
 					{
 						Worker: s.addrsStr[1],
 						Value:  alloraMath.MustNewDecFromString("0.166666666"),
+						Values: nil,
 					},
 					{
 						Worker: s.addrsStr[2],
 						Value:  alloraMath.MustNewDecFromString("0.166666666"),
+						Values: nil,
 					},
 				},
 				OneOutInfererForecasterValues: nil,
+				CombinedValues:                nil,
+				NaiveValues:                   nil,
 			},
 			expectedError: nil,
 		},
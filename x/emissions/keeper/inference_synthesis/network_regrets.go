@@ -91,9 +91,34 @@ func ComputeAndBuildEMRegret(
 	return emissions.TimestampedValue{
 		BlockHeight: blockHeight,
 		Value:       newRegret,
+		Values:      nil,
 	}, nil
 }
 
+// Sets the per-component regrets of a new regret from the pair of losses of each
+// component. Does nothing if losses are not tracked per component.
+func setComponentEMRegrets(
+	newRegret *emissions.TimestampedValue,
+	previousRegret emissions.TimestampedValue,
+	alpha alloraMath.Dec,
+	numComponents int,
+	componentLosses func(c int) (Loss, Loss),
+) error {
+	if numComponents == 0 {
+		return nil
+	}
+	newRegret.Values = make([]alloraMath.Dec, numComponents)
+	for c := 0; c < numComponents; c++ {
+		lossA, lossB := componentLosses(c)
+		regret, err := ComputeAndBuildEMRegret(lossA, lossB, previousRegret.ComponentValue(c), alpha, newRegret.BlockHeight)
+		if err != nil {
+			return err
+		}
+		newRegret.Values[c] = regret.Value
+	}
+	return nil
+}
+
 // args for GetCalcSetNetworkRegrets
 type GetCalcSetNetworkRegretsArgs struct {
 	Ctx                   sdk.Context
@@ -114,6 +139,11 @@ type GetCalcSetNetworkRegretsArgs struct {
 // NOTE: It is assumed the workers are uniquely represented in the network losses
 // NOTE: It is assumed network losses are sorted (done in synth.CalcNetworkLosses())
 func GetCalcSetNetworkRegrets(args GetCalcSetNetworkRegretsArgs) error {
+	// Per-component losses are only present for topics synthesized per component
+	componentLosses := make([]networkLossesByWorker, len(args.NetworkLosses.CombinedValues))
+	for c := range componentLosses {
+		componentLosses[c] = ConvertValueBundleToNetworkLossesByWorker(*args.NetworkLosses.Component(c))
+	}
 	// Convert the network losses to a networkLossesByWorker
 	networkLossesByWorker := ConvertValueBundleToNetworkLossesByWorker(args.NetworkLosses)
 	blockHeight := args.Nonce.BlockHeight
@@ -139,6 +169,12 @@ func GetCalcSetNetworkRegrets(args GetCalcSetNetworkRegretsArgs) error {
 		if err != nil {
 			return errorsmod.Wrapf(err, "Error computing and building inferer regret")
 		}
+		err = setComponentEMRegrets(&newInfererRegret, lastRegret, args.AlphaRegret, len(componentLosses), func(c int) (Loss, Loss) {
+			return componentLosses[c].CombinedLoss, componentLosses[c].InfererLosses[infererLoss.Worker]
+		})
+		if err != nil {
+			return errorsmod.Wrapf(err, "Error computing and building per-component inferer regret")
+		}
 		err = args.K.SetInfererNetworkRegret(args.Ctx, args.TopicId, infererLoss.Worker, newInfererRegret)
 		if err != nil {
 			return errorsmod.Wrapf(err, "Error setting inferer regret")
@@ -184,6 +220,12 @@ func GetCalcSetNetworkRegrets(args GetCalcSetNetworkRegretsArgs) error {
 		if err != nil {
 			return errorsmod.Wrapf(err, "Error computing and building forecaster regret")
 		}
+		err = setComponentEMRegrets(&newForecasterRegret, lastRegret, args.AlphaRegret, len(componentLosses), func(c int) (Loss, Loss) {
+			return componentLosses[c].CombinedLoss, componentLosses[c].ForecasterLosses[forecasterLoss.Worker]
+		})
+		if err != nil {
+			return errorsmod.Wrapf(err, "Error computing and building per-component forecaster regret")
+		}
 		err = args.K.SetForecasterNetworkRegret(args.Ctx, args.TopicId, forecasterLoss.Worker, newForecasterRegret)
 		if err != nil {
 			return errorsmod.Wrapf(err, "Error setting forecaster regret")
@@ -229,6 +271,12 @@ func GetCalcSetNetworkRegrets(args GetCalcSetNetworkRegretsArgs) error {
 		if err != nil {
 			return errorsmod.Wrapf(err, "Error computing and building inferer regret")
 		}
+		err = setComponentEMRegrets(&newInfererRegret, lastRegret, args.AlphaRegret, len(componentLosses), func(c int) (Loss, Loss) {
+			return componentLosses[c].NaiveLoss, componentLosses[c].InfererLosses[infererLoss.Worker]
+		})
+		if err != nil {
+			return errorsmod.Wrapf(err, "Error computing and building per-component inferer regret")
+		}
 		err = args.K.SetNaiveInfererNetworkRegret(args.Ctx, args.TopicId, infererLoss.Worker, newInfererRegret)
 		if err != nil {
 			return errorsmod.Wrapf(err, "Error setting inferer regret")
@@ -257,6 +305,12 @@ func GetCalcSetNetworkRegrets(args GetCalcSetNetworkRegretsArgs) error {
 			if err != nil {
 				return errorsmod.Wrapf(err, "Error computing and building one-out inferer regret")
 			}
+			err = setComponentEMRegrets(&newOneOutInfererInfererRegret, lastRegret, args.AlphaRegret, len(componentLosses), func(c int) (Loss, Loss) {
+				return componentLosses[c].OneOutInfererLosses[oneOutInfererLoss.Worker], componentLosses[c].InfererLosses[infererLoss.Worker]
+			})
+			if err != nil {
+				return errorsmod.Wrapf(err, "Error computing and building per-component one-out inferer regret")
+			}
 			err = args.K.SetOneOutInfererInfererNetworkRegret(args.Ctx, args.TopicId, oneOutInfererLoss.Worker, infererLoss.Worker, newOneOutInfererInfererRegret)
 			if err != nil {
 				return errorsmod.Wrapf(err, "Error setting one-out inferer inferer regret")
@@ -281,6 +335,12 @@ func GetCalcSetNetworkRegrets(args GetCalcSetNetworkRegretsArgs) error {
 			if err != nil {
 				return errorsmod.Wrapf(err, "Error computing and building one-out inferer forecaster regret")
 			}
+			err = setComponentEMRegrets(&newOneOutInfererForecasterRegret, lastRegret, args.AlphaRegret, len(componentLosses), func(c int) (Loss, Loss) {
+				return componentLosses[c].OneOutInfererLosses[oneOutInfererLoss.Worker], componentLosses[c].OneOutInfererForecasterLosses[oneOutInfererForecasterLoss.Forecaster][oneOutInfererLoss.Worker]
+			})
+			if err != nil {
+				return errorsmod.Wrapf(err, "Error computing and building per-component one-out inferer forecaster regret")
+			}
 			err = args.K.SetOneOutInfererForecasterNetworkRegret(args.Ctx, args.TopicId, oneOutInfererLoss.Worker, oneOutInfererForecasterLoss.Forecaster, newOneOutInfererForecasterRegret)
 			if err != nil {
 				return errorsmod.Wrapf(err, "Error setting one-out inferer forecaster regret")
@@ -305,6 +365,12 @@ func GetCalcSetNetworkRegrets(args GetCalcSetNetworkRegretsArgs) error {
 			if err != nil {
 				return errorsmod.Wrapf(err, "Error computing and building one-out forecaster inferer regret")
 			}
+			err = setComponentEMRegrets(&newOneOutForecasterInfererRegret, lastRegret, args.AlphaRegret, len(componentLosses), func(c int) (Loss, Loss) {
+				return componentLosses[c].OneOutForecasterLosses[oneOutForecasterLoss.Worker], componentLosses[c].InfererLosses[infererloss.Worker]
+			})
+			if err != nil {
+				return errorsmod.Wrapf(err, "Error computing and building per-component one-out forecaster inferer regret")
+			}
 			err = args.K.SetOneOutForecasterInfererNetworkRegret(args.Ctx, args.TopicId, oneOutForecasterLoss.Worker, infererloss.Worker, newOneOutForecasterInfererRegret)
 			if err != nil {
 				return errorsmod.Wrapf(err, "Error setting one-out forecaster inferer regret")
@@ -329,6 +395,12 @@ func GetCalcSetNetworkRegrets(args GetCalcSetNetworkRegretsArgs) error {
 			if err != nil {
 				return errorsmod.Wrapf(err, "Error computing and building one-out forecaster forecaster regret")
 			}
+			err = setComponentEMRegrets(&newOneOutForecasterForecasterRegret, lastRegret, args.AlphaRegret, len(componentLosses), func(c int) (Loss, Loss) {
+				return componentLosses[c].OneOutForecasterLosses[oneOutForecasterLoss.Worker], componentLosses[c].ForecasterLosses[forecasterLoss.Worker]
+			})
+			if err != nil {
+				return errorsmod.Wrapf(err, "Error computing and building per-component one-out forecaster forecaster regret")
+			}
 			err = args.K.SetOneOutForecasterForecasterNetworkRegret(args.Ctx, args.TopicId, oneOutForecasterLoss.Worker, forecasterLoss.Worker, newOneOutForecasterForecasterRegret)
 			if err != nil {
 				return errorsmod.Wrapf(err, "Error setting one-out forecaster forecaster regret")
@@ -354,6 +426,12 @@ func GetCalcSetNetworkRegrets(args GetCalcSetNetworkRegretsArgs) error {
 			if err != nil {
 				return errorsmod.Wrapf(err, "Error computing and building one-in forecaster regret")
 			}
+			err = setComponentEMRegrets(&newOneInForecasterRegret, lastRegret, args.AlphaRegret, len(componentLosses), func(c int) (Loss, Loss) {
+				return componentLosses[c].OneInForecasterLosses[oneInForecasterLoss.Worker], componentLosses[c].InfererLosses[infererLoss.Worker]
+			})
+			if err != nil {
+				return errorsmod.Wrapf(err, "Error computing and building per-component one-in forecaster regret")
+			}
 			err = args.K.SetOneInForecasterNetworkRegret(args.Ctx, args.TopicId, oneInForecasterLoss.Worker, infererLoss.Worker, newOneInForecasterRegret)
 			if err != nil {
 				return errorsmod.Wrapf(err, "Error setting one-in forecaster regret")
@@ -374,6 +452,12 @@ func GetCalcSetNetworkRegrets(args GetCalcSetNetworkRegretsArgs) error {
 		if err != nil {
 			return errorsmod.Wrapf(err, "Error computing and building one-in forecaster regret")
 		}
+		err = setComponentEMRegrets(&newOneInForecasterRegret, lastRegret, args.AlphaRegret, len(componentLosses), func(c int) (Loss, Loss) {
+			return componentLosses[c].OneInForecasterLosses[oneInForecasterLoss.Worker], componentLosses[c].ForecasterLosses[oneInForecasterLoss.Worker]
+		})
+		if err != nil {
+			return errorsmod.Wrapf(err, "Error computing and building per-component one-in forecaster regret")
+		}
 		err = args.K.SetOneInForecasterNetworkRegret(args.Ctx, args.TopicId, oneInForecasterLoss.Worker, oneInForecasterLoss.Worker, newOneInForecasterRegret)
 		if err != nil {
 			return errorsmod.Wrapf(err, "Error setting one-in forecaster regret")
@@ -23,26 +23,28 @@ func (s *InferenceSynthesisTestSuite) TestConvertValueBundleToNetworkLossesByWor
 		CombinedValue: alloraMath.MustNewDecFromString("0.1"),
 		NaiveValue:    alloraMath.MustNewDecFromString("0.1"),
 		InfererValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("0.1")},
-			{Worker: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("0.2")},
+			{Worker: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("0.1"), Values: nil},
+			{Worker: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
 		},
 		ForecasterValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("0.1")},
-			{Worker: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("0.2")},
+			{Worker: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("0.1"), Values: nil},
+			{Worker: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
 		},
 		OneOutInfererValues: []*emissionstypes.WithheldWorkerAttributedValue{
-			{Worker: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("0.1")},
-			{Worker: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("0.2")},
+			{Worker: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("0.1"), Values: nil},
+			{Worker: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
 		},
 		OneOutForecasterValues: []*emissionstypes.WithheldWorkerAttributedValue{
-			{Worker: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("0.1")},
-			{Worker: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("0.2")},
+			{Worker: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("0.1"), Values: nil},
+			{Worker: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
 		},
 		OneInForecasterValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("0.1")},
-			{Worker: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("0.2")},
+			{Worker: s.addrsStr[1], Value: alloraMath.MustNewDecFromString("0.1"), Values: nil},
+			{Worker: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
 		},
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 
 	result := inferencesynthesis.ConvertValueBundleToNetworkLossesByWorker(valueBundle)
@@ -142,26 +144,29 @@ func (s *InferenceSynthesisTestSuite) TestGetCalcSetNetworkRegretsTwoWorkers() {
 		ExtraData:           nil,
 		CombinedValue:       alloraMath.NewDecFromInt64(500),
 		InfererValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: worker1, Value: alloraMath.MustNewDecFromString("200")},
-			{Worker: worker2, Value: alloraMath.MustNewDecFromString("200")},
+			{Worker: worker1, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
+			{Worker: worker2, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
 		},
 		ForecasterValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: worker1, Value: alloraMath.MustNewDecFromString("200")},
-			{Worker: worker2, Value: alloraMath.MustNewDecFromString("200")},
+			{Worker: worker1, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
+			{Worker: worker2, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
 		},
 		NaiveValue:          alloraMath.NewDecFromInt64(123),
 		OneOutInfererValues: nil,
 		OneInForecasterValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: worker1, Value: alloraMath.MustNewDecFromString("200")},
-			{Worker: worker2, Value: alloraMath.MustNewDecFromString("200")},
+			{Worker: worker1, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
+			{Worker: worker2, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
 		},
 		OneOutForecasterValues:        nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 
 	regretVal := emissionstypes.TimestampedValue{
 		BlockHeight: blockHeight,
 		Value:       alloraMath.NewDecFromInt64(200),
+		Values:      nil,
 	}
 
 	// Need to more than 2 experienced actor
@@ -303,23 +308,25 @@ func (s *InferenceSynthesisTestSuite) TestGetCalcSetNetworkRegretsThreeWorkers()
 		CombinedValue: alloraMath.MustNewDecFromString("500"),
 		NaiveValue:    alloraMath.MustNewDecFromString("123"),
 		InfererValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: worker1, Value: alloraMath.MustNewDecFromString("200")},
-			{Worker: worker2, Value: alloraMath.MustNewDecFromString("200")},
-			{Worker: worker3, Value: alloraMath.MustNewDecFromString("200")},
+			{Worker: worker1, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
+			{Worker: worker2, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
+			{Worker: worker3, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
 		},
 		ForecasterValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: worker1, Value: alloraMath.MustNewDecFromString("200")},
-			{Worker: worker2, Value: alloraMath.MustNewDecFromString("200")},
-			{Worker: worker3, Value: alloraMath.MustNewDecFromString("200")},
+			{Worker: worker1, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
+			{Worker: worker2, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
+			{Worker: worker3, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
 		},
 		OneInForecasterValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: worker1, Value: alloraMath.MustNewDecFromString("200")},
-			{Worker: worker2, Value: alloraMath.MustNewDecFromString("200")},
-			{Worker: worker3, Value: alloraMath.MustNewDecFromString("200")},
+			{Worker: worker1, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
+			{Worker: worker2, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
+			{Worker: worker3, Value: alloraMath.MustNewDecFromString("200"), Values: nil},
 		},
 		OneOutInfererValues:           nil,
 		OneOutForecasterValues:        nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	blockHeight := int64(42)
 	nonce := emissionstypes.Nonce{BlockHeight: blockHeight}
@@ -329,6 +336,7 @@ func (s *InferenceSynthesisTestSuite) TestGetCalcSetNetworkRegretsThreeWorkers()
 	timestampedValue := emissionstypes.TimestampedValue{
 		BlockHeight: blockHeight,
 		Value:       alloraMath.MustNewDecFromString("200"),
+		Values:      nil,
 	}
 
 	err := k.SetInfererNetworkRegret(s.ctx, topicId, worker1, timestampedValue)
@@ -549,24 +557,26 @@ func (s *InferenceSynthesisTestSuite) TestHigherLossesLowerRegret() {
 		ExtraData:           nil,
 		CombinedValue:       alloraMath.MustNewDecFromString("0.1"),
 		InfererValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: worker0, Value: alloraMath.MustNewDecFromString("0.2")},
-			{Worker: worker1, Value: alloraMath.MustNewDecFromString("0.3")},
-			{Worker: worker2, Value: alloraMath.MustNewDecFromString("0.4")},
+			{Worker: worker0, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+			{Worker: worker1, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+			{Worker: worker2, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
 		},
 		ForecasterValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: worker0, Value: alloraMath.MustNewDecFromString("0.2")},
-			{Worker: worker1, Value: alloraMath.MustNewDecFromString("0.3")},
-			{Worker: worker2, Value: alloraMath.MustNewDecFromString("0.4")},
+			{Worker: worker0, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+			{Worker: worker1, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+			{Worker: worker2, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
 		},
 		NaiveValue:             alloraMath.MustNewDecFromString("0.1"),
 		OneOutInfererValues:    nil,
 		OneOutForecasterValues: nil,
 		OneInForecasterValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: worker0, Value: alloraMath.MustNewDecFromString("0.2")},
-			{Worker: worker1, Value: alloraMath.MustNewDecFromString("0.3")},
-			{Worker: worker2, Value: alloraMath.MustNewDecFromString("0.4")},
+			{Worker: worker0, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+			{Worker: worker1, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+			{Worker: worker2, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
 		},
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 
 	networkLossesValueBundle1 := emissionstypes.ValueBundle{
@@ -576,40 +586,45 @@ func (s *InferenceSynthesisTestSuite) TestHigherLossesLowerRegret() {
 		ExtraData:           nil,
 		CombinedValue:       alloraMath.MustNewDecFromString("0.1"),
 		InfererValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: worker0, Value: alloraMath.MustNewDecFromString("0.3")},
-			{Worker: worker1, Value: alloraMath.MustNewDecFromString("0.3")},
-			{Worker: worker2, Value: alloraMath.MustNewDecFromString("0.4")},
+			{Worker: worker0, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+			{Worker: worker1, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+			{Worker: worker2, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
 		},
 		ForecasterValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: worker0, Value: alloraMath.MustNewDecFromString("0.3")},
-			{Worker: worker1, Value: alloraMath.MustNewDecFromString("0.3")},
-			{Worker: worker2, Value: alloraMath.MustNewDecFromString("0.4")},
+			{Worker: worker0, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+			{Worker: worker1, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+			{Worker: worker2, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
 		},
 		NaiveValue:             alloraMath.MustNewDecFromString("0.1"),
 		OneOutInfererValues:    nil,
 		OneOutForecasterValues: nil,
 		OneInForecasterValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: worker0, Value: alloraMath.MustNewDecFromString("0.3")},
-			{Worker: worker1, Value: alloraMath.MustNewDecFromString("0.3")},
-			{Worker: worker2, Value: alloraMath.MustNewDecFromString("0.4")},
+			{Worker: worker0, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+			{Worker: worker1, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+			{Worker: worker2, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
 		},
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 
 	resetRegrets := func() {
 		timestampedValue0_1 := emissionstypes.TimestampedValue{
 			BlockHeight: blockHeight,
 			Value:       alloraMath.MustNewDecFromString("0.1"),
+			Values:      nil,
 		}
 
 		timestampedValue0_2 := emissionstypes.TimestampedValue{
 			BlockHeight: blockHeight,
 			Value:       alloraMath.MustNewDecFromString("0.2"),
+			Values:      nil,
 		}
 
 		timestampedValue0_3 := emissionstypes.TimestampedValue{
 			BlockHeight: blockHeight,
 			Value:       alloraMath.MustNewDecFromString("0.3"),
+			Values:      nil,
 		}
 
 		err := k.SetInfererNetworkRegret(s.ctx, topicId, worker0, timestampedValue0_1)
@@ -831,6 +846,7 @@ func (s *InferenceSynthesisTestSuite) TestUpdateTopicInitialRegret() {
 		timestampedValue := emissionstypes.TimestampedValue{
 			BlockHeight: blockHeight,
 			Value:       value,
+			Values:      nil,
 		}
 		err = k.SetInfererNetworkRegret(s.ctx, topicId, worker, timestampedValue)
 		require.NoError(err)
@@ -841,6 +857,7 @@ func (s *InferenceSynthesisTestSuite) TestUpdateTopicInitialRegret() {
 		timestampedValue := emissionstypes.TimestampedValue{
 			BlockHeight: blockHeight,
 			Value:       value,
+			Values:      nil,
 		}
 		err = k.SetForecasterNetworkRegret(s.ctx, topicId, worker, timestampedValue)
 		require.NoError(err)
@@ -852,6 +869,7 @@ func (s *InferenceSynthesisTestSuite) TestUpdateTopicInitialRegret() {
 		infererValues[i] = &emissionstypes.WorkerAttributedValue{
 			Worker: worker,
 			Value:  alloraMath.MustNewDecFromString(fmt.Sprintf("0.%d", i+2)),
+			Values: nil,
 		}
 	}
 
@@ -861,6 +879,7 @@ func (s *InferenceSynthesisTestSuite) TestUpdateTopicInitialRegret() {
 		forecasterValues[i] = &emissionstypes.WorkerAttributedValue{
 			Worker: worker,
 			Value:  alloraMath.MustNewDecFromString(fmt.Sprintf("0.%d", i+3)),
+			Values: nil,
 		}
 	}
 
@@ -878,6 +897,8 @@ func (s *InferenceSynthesisTestSuite) TestUpdateTopicInitialRegret() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 
 	// Calculate and set network regrets
@@ -981,10 +1002,12 @@ func (s *InferenceSynthesisTestSuite) TestCalcSetNetworkRegretsWithFallbackRegre
 	timestampedValue0_1 := emissionstypes.TimestampedValue{
 		BlockHeight: blockHeight,
 		Value:       alloraMath.MustNewDecFromString("0.1"),
+		Values:      nil,
 	}
 	timestampedValue0_2 := emissionstypes.TimestampedValue{
 		BlockHeight: blockHeight,
 		Value:       alloraMath.MustNewDecFromString("0.2"),
+		Values:      nil,
 	}
 
 	for i, inferer := range infererAddresses {
@@ -1009,26 +1032,28 @@ func (s *InferenceSynthesisTestSuite) TestCalcSetNetworkRegretsWithFallbackRegre
 		ExtraData:           nil,
 		CombinedValue:       alloraMath.MustNewDecFromString("0.1"),
 		InfererValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: inferer0, Value: alloraMath.MustNewDecFromString("0.2")},
-			{Worker: inferer1, Value: alloraMath.MustNewDecFromString("0.2")},
-			{Worker: inferer2, Value: alloraMath.MustNewDecFromString("0.2")},
-			{Worker: inferer3, Value: alloraMath.MustNewDecFromString("0.2")},
-			{Worker: inferer4, Value: alloraMath.MustNewDecFromString("0.2")},
-			{Worker: inferer5, Value: alloraMath.MustNewDecFromString("0.3")},
-			{Worker: inferer6, Value: alloraMath.MustNewDecFromString("0.3")},
-			{Worker: inferer7, Value: alloraMath.MustNewDecFromString("0.3")},
-			{Worker: inferer8, Value: alloraMath.MustNewDecFromString("0.3")},
-			{Worker: inferer9, Value: alloraMath.MustNewDecFromString("0.3")},
+			{Worker: inferer0, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+			{Worker: inferer1, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+			{Worker: inferer2, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+			{Worker: inferer3, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+			{Worker: inferer4, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+			{Worker: inferer5, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+			{Worker: inferer6, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+			{Worker: inferer7, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+			{Worker: inferer8, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+			{Worker: inferer9, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
 		},
 		ForecasterValues: []*emissionstypes.WorkerAttributedValue{
-			{Worker: forecaster0, Value: alloraMath.MustNewDecFromString("0.3")},
-			{Worker: forecaster1, Value: alloraMath.MustNewDecFromString("0.3")},
+			{Worker: forecaster0, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+			{Worker: forecaster1, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
 		},
 		NaiveValue:                    alloraMath.MustNewDecFromString("0.1"),
 		OneOutInfererValues:           nil,
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 
 	// Call GetCalcSetNetworkRegrets
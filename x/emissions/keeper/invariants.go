@@ -18,6 +18,9 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 	ir.RegisterRoute(emissionstypes.ModuleName, "stake-removals-length-same", StakingInvariantLenStakeRemovalsSame(*k))
 	ir.RegisterRoute(emissionstypes.ModuleName, "stake-sum-delegated-stakes", StakingInvariantDelegatedStakes(*k))
 	ir.RegisterRoute(emissionstypes.ModuleName, "pending-reward-for-delegators-equal-reward-per-share-minus-reward-debt", StakingInvariantPendingRewardForDelegatorsGreaterThanRewardPerShareMinusRewardDebt(*k))
+	ir.RegisterRoute(emissionstypes.ModuleName, "redelegations-length-same", StakingInvariantLenRedelegationsSame(*k))
+	ir.RegisterRoute(emissionstypes.ModuleName, "redelegations-indexed", StakingInvariantRedelegationsIndexed(*k))
+	ir.RegisterRoute(emissionstypes.ModuleName, "allora-reward-escrow-total-balance", RewardEscrowInvariantTotalRewardEscrowsEqualAlloraRewardEscrowBankBalance(*k))
 }
 
 // AllInvariants is a convience function to run all invariants in the emissions module.
@@ -38,6 +41,15 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if res, stop := StakingInvariantPendingRewardForDelegatorsGreaterThanRewardPerShareMinusRewardDebt(k)(ctx); stop {
 			return res, stop
 		}
+		if res, stop := StakingInvariantLenRedelegationsSame(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := StakingInvariantRedelegationsIndexed(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := RewardEscrowInvariantTotalRewardEscrowsEqualAlloraRewardEscrowBankBalance(k)(ctx); stop {
+			return res, stop
+		}
 		return "", false
 	}
 }
@@ -51,23 +63,55 @@ func StakingInvariantTotalStakeEqualAlloraStakingBankBalance(k Keeper) sdk.Invar
 		if err != nil {
 			panic(fmt.Sprintf("failed to get total stake: %v", err))
 		}
+		// worker bonds are held in the staking account alongside reputer stake
+		totalWorkerBonds, err := k.GetTotalWorkerBonds(ctx)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get total worker bonds: %v", err))
+		}
 		alloraStakingAddr := k.authKeeper.GetModuleAccount(ctx, emissionstypes.AlloraStakingAccountName).GetAddress()
 		alloraStakingBalance := k.bankKeeper.GetBalance(
 			ctx,
 			alloraStakingAddr,
 			params.DefaultBondDenom).Amount
-		broken := !totalStake.Equal(alloraStakingBalance)
+		broken := !totalStake.Add(totalWorkerBonds).Equal(alloraStakingBalance)
 		return sdk.FormatInvariant(
 			emissionstypes.ModuleName,
 			"emissions module total stake equal allora staking bank balance",
-			fmt.Sprintf("TotalStake: %s | Allora Module Account Staking Balance: %s",
+			fmt.Sprintf("TotalStake: %s | TotalWorkerBonds: %s | Allora Module Account Staking Balance: %s",
 				totalStake.String(),
+				totalWorkerBonds.String(),
 				alloraStakingBalance.String(),
 			),
 		), broken
 	}
 }
 
+// RewardEscrowInvariantTotalRewardEscrowsEqualAlloraRewardEscrowBankBalance checks that
+// the rewards held in escrow in the emissions module are equal to the balance
+// of the Allora reward escrow bank account.
+func RewardEscrowInvariantTotalRewardEscrowsEqualAlloraRewardEscrowBankBalance(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalRewardEscrows, err := k.GetTotalRewardEscrows(ctx)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get total reward escrows: %v", err))
+		}
+		alloraRewardEscrowAddr := k.authKeeper.GetModuleAccount(ctx, emissionstypes.AlloraRewardEscrowAccountName).GetAddress()
+		alloraRewardEscrowBalance := k.bankKeeper.GetBalance(
+			ctx,
+			alloraRewardEscrowAddr,
+			params.DefaultBondDenom).Amount
+		broken := !totalRewardEscrows.Equal(alloraRewardEscrowBalance)
+		return sdk.FormatInvariant(
+			emissionstypes.ModuleName,
+			"emissions module total reward escrows equal allora reward escrow bank balance",
+			fmt.Sprintf("TotalRewardEscrows: %s | Allora Module Account Reward Escrow Balance: %s",
+				totalRewardEscrows.String(),
+				alloraRewardEscrowBalance.String(),
+			),
+		), broken
+	}
+}
+
 // the number of values in the stakeRemovalsByBlock map
 // should always equal the number of values in the stakeRemovalsByActor map
 func StakingInvariantLenStakeRemovalsSame(k Keeper) sdk.Invariant {
@@ -376,3 +420,94 @@ func StakingInvariantPendingRewardForDelegatorsGreaterThanRewardPerShareMinusRew
 		return "", false
 	}
 }
+
+// the number of values in the redelegations map should always equal
+// the number of keys in the redelegationsByDestination and redelegationsByBlock key sets
+func StakingInvariantLenRedelegationsSame(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		iter, err := k.redelegations.Iterate(ctx, nil)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get redelegations iterator: %v", err))
+		}
+		defer iter.Close()
+		keys, err := iter.Keys()
+		if err != nil {
+			panic(fmt.Sprintf("failed to get redelegations keys: %v", err))
+		}
+		iterByDestination, err := k.redelegationsByDestination.Iterate(ctx, nil)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get redelegations by destination iterator: %v", err))
+		}
+		defer iterByDestination.Close()
+		keysByDestination, err := iterByDestination.Keys()
+		if err != nil {
+			panic(fmt.Sprintf("failed to get redelegations by destination keys: %v", err))
+		}
+		iterByBlock, err := k.redelegationsByBlock.Iterate(ctx, nil)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get redelegations by block iterator: %v", err))
+		}
+		defer iterByBlock.Close()
+		keysByBlock, err := iterByBlock.Keys()
+		if err != nil {
+			panic(fmt.Sprintf("failed to get redelegations by block keys: %v", err))
+		}
+
+		broken := len(keys) != len(keysByDestination) || len(keys) != len(keysByBlock)
+		return sdk.FormatInvariant(
+			emissionstypes.ModuleName,
+			"emissions module length of redelegations same",
+			fmt.Sprintf("Length of redelegations: %d | by destination: %d | by block: %d",
+				len(keys),
+				len(keysByDestination),
+				len(keysByBlock),
+			),
+		), broken
+	}
+}
+
+// every redelegation is stored under its own topic and reputers, moves a positive amount
+// between two different reputers and can be found through the destination and block indexes.
+// Without the destination index a delegator could hop stake across reputers to escape slashing
+func StakingInvariantRedelegationsIndexed(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		iter, err := k.redelegations.Iterate(ctx, nil)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get redelegations iterator: %v", err))
+		}
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			kv, err := iter.KeyValue()
+			if err != nil {
+				panic(fmt.Sprintf("failed to get redelegation: %v", err))
+			}
+			redelegation := kv.Value
+			broken := kv.Key.K1() != redelegation.TopicId ||
+				kv.Key.K2() != redelegation.SrcReputer ||
+				kv.Key.K3() != redelegation.Delegator ||
+				kv.Key.K4() != redelegation.DstReputer ||
+				redelegation.SrcReputer == redelegation.DstReputer ||
+				!redelegation.Amount.IsPositive()
+			if !broken {
+				hasByDestination, err := k.redelegationsByDestination.Has(ctx,
+					Join4(redelegation.TopicId, redelegation.Delegator, redelegation.DstReputer, redelegation.SrcReputer))
+				if err != nil {
+					panic(fmt.Sprintf("failed to check redelegation by destination: %v", err))
+				}
+				hasByBlock, err := k.redelegationsByBlock.Has(ctx, collections.Join(redelegation.BlockRedelegationCompleted, kv.Key))
+				if err != nil {
+					panic(fmt.Sprintf("failed to check redelegation by block: %v", err))
+				}
+				broken = !hasByDestination || !hasByBlock
+			}
+			if broken {
+				return sdk.FormatInvariant(
+					emissionstypes.ModuleName,
+					"emissions module redelegations consistently indexed",
+					fmt.Sprintf("Key: %v | Redelegation: %v", kv.Key, redelegation),
+				), broken
+			}
+		}
+		return "", false
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/allora-network/allora-chain/utils/fn"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/address"
 
 	coreStore "cosmossdk.io/core/store"
@@ -70,6 +71,26 @@ type Keeper struct {
 	activeInferers collections.KeySet[collections.Pair[TopicId, ActorId]]
 	// active topic forecasters for a topic
 	activeForecasters collections.KeySet[collections.Pair[TopicId, ActorId]]
+	// topic parameter changes to apply at the topic's next epoch boundary
+	pendingTopicUpdates collections.Map[TopicId, types.OptionalTopicParams]
+	// topics that have been archived, they are kept in topics but can no longer be used
+	archivedTopics collections.KeySet[TopicId]
+	// archived topics whose per-topic state is still being pruned
+	archivedTopicsToPrune collections.KeySet[TopicId]
+	// archived topic id -> last (delegator, reputer) whose delegated stake was scheduled for removal
+	archivedTopicDelegateRemovalCursors collections.Map[TopicId, collections.Pair[Delegator, Reputer]]
+	// topic id -> current owner, only set once ownership moved away from the topic creator
+	topicOwners collections.Map[TopicId, ActorId]
+	// topic id -> proposed owner that has yet to accept the transfer
+	pendingTopicOwners collections.Map[TopicId, ActorId]
+	// topics indexed by their current owner, for listing topics
+	topicsByOwner collections.KeySet[collections.Pair[ActorId, TopicId]]
+	// topics indexed by their loss method, for listing topics
+	topicsByLossMethod collections.KeySet[collections.Pair[string, TopicId]]
+	// free-form tags attached to a topic at creation
+	topicTags collections.KeySet[collections.Pair[TopicId, string]]
+	// topics indexed by their tags, for listing topics
+	topicsByTag collections.KeySet[collections.Pair[string, TopicId]]
 	// active reputers for a topic
 	activeReputers collections.KeySet[collections.Pair[TopicId, ActorId]]
 	// lowest topic inferer score ema for a topic
@@ -124,6 +145,10 @@ type Keeper struct {
 	stakeSumFromDelegator collections.Map[collections.Pair[TopicId, Delegator], cosmosMath.Int]
 	// map of (topic id, delegator, reputer) -> amount of stake that has been placed by that delegator on that target
 	delegatedStakes collections.Map[collections.Triple[TopicId, Delegator, Reputer], types.DelegatorInfo]
+	// set of (delegator, topic id, reputer) indexing delegatedStakes by delegator
+	delegateStakePlacementsByDelegator collections.KeySet[collections.Triple[Delegator, TopicId, Reputer]]
+	// set of (topic id, reputer, delegator) indexing delegatedStakes by reputer
+	delegateStakePlacementsByReputer collections.KeySet[collections.Triple[TopicId, Reputer, Delegator]]
 	// map of (topic id, reputer) -> total amount of stake that has been placed on that reputer by delegators
 	stakeFromDelegatorsUponReputer collections.Map[collections.Pair[TopicId, Reputer], cosmosMath.Int]
 	// map of (topicId, reputer) -> share of delegate reward
@@ -177,6 +202,91 @@ type Keeper struct {
 	// map of (topic, block_height) -> ValueBundle (1 network wide bundle per timestep)
 	networkLossBundles collections.Map[collections.Pair[TopicId, BlockHeight], types.ValueBundle]
 
+	// map of (topic, reputer) -> ground truth, for topics whose losses are computed by the chain
+	reputerGroundTruths collections.Map[collections.Pair[TopicId, Reputer], types.GroundTruth]
+
+	// map of (topic, block_height) -> stake-weighted consensus ground truth of the reputers
+	consensusGroundTruths collections.Map[collections.Pair[TopicId, BlockHeight], types.GroundTruth]
+
+	// map of (topic, worker) -> payload commit, for topics using commit-reveal
+	workerPayloadCommits collections.Map[collections.Pair[TopicId, ActorId], types.WorkerPayloadCommit]
+
+	// set of (topic, actor, operator) of operator keys authorized to submit payloads on behalf of actors
+	actorOperators collections.KeySet[collections.Triple[TopicId, ActorId, ActorId]]
+
+	/// WORKER BONDS
+
+	// map of (topic, worker) -> amount bonded by the worker on topics requiring a worker bond
+	workerBonds collections.Map[collections.Pair[TopicId, ActorId], types.WorkerBond]
+	// total sum of all worker bonds, held in the allora staking account alongside reputer stake
+	totalWorkerBonds collections.Item[cosmosMath.Int]
+	// worker bond removals are double indexed like stake removals
+	// map of (blockHeight, topic, worker) -> removal information for that worker bond
+	workerBondRemovalsByBlock collections.Map[collections.Triple[BlockHeight, TopicId, ActorId], types.WorkerBondRemovalInfo]
+	// key set of (worker, topic, blockHeight) to existence of a removal in the forwards map
+	workerBondRemovalsByActor collections.KeySet[collections.Triple[ActorId, TopicId, BlockHeight]]
+
+	/// REPUTER SLASHING
+
+	// map of (topic, reputer) -> run of consecutive reports far from the loss consensus
+	reputerConsensusDeviations collections.Map[collections.Pair[TopicId, Reputer], types.ReputerConsensusDeviation]
+
+	/// REDELEGATIONS
+
+	// redelegations are triple indexed: by source reputer for slashing, by destination reputer
+	// to limit transitive redelegation and by completion block to expire them
+	// map of (topic, source reputer, delegator, destination reputer) -> redelegation that has not completed
+	redelegations collections.Map[Quadruple[TopicId, Reputer, Delegator, Reputer], types.RedelegationInfo]
+	// key set of (topic, delegator, destination reputer, source reputer) to existence of a redelegation in the forwards map
+	redelegationsByDestination collections.KeySet[Quadruple[TopicId, Delegator, Reputer, Reputer]]
+	// key set of (blockHeight, (topic, source reputer, delegator, destination reputer)) of redelegations completing at that block
+	redelegationsByBlock collections.KeySet[collections.Pair[BlockHeight, Quadruple[TopicId, Reputer, Delegator, Reputer]]]
+
+	/// AUTO-COMPOUNDING
+
+	// set of (topic, delegator) of delegators that restake their rewards on the topic as they are paid out
+	autoCompoundDelegators collections.KeySet[collections.Pair[TopicId, Delegator]]
+
+	/// REPUTER COMMISSIONS
+
+	// map of (topic, reputer) -> cut of the rewards of its delegators the reputer keeps
+	reputerCommissions collections.Map[collections.Pair[TopicId, Reputer], types.ReputerCommission]
+
+	/// STAKE LOCKS
+
+	// map of (topic, reputer, delegator, unlock block) -> stake locked until that block, delegator is empty for stake of the reputer itself
+	stakeLocks collections.Map[Quadruple[TopicId, Reputer, Delegator, BlockHeight], types.StakeLock]
+	// key set of (unlock block, (topic, reputer, delegator, unlock block)) of stake locks expiring at that block
+	stakeLocksByBlock collections.KeySet[collections.Pair[BlockHeight, Quadruple[TopicId, Reputer, Delegator, BlockHeight]]]
+
+	/// REWARD HISTORY
+
+	// map of (topic, block, actor, reward type) -> reward paid out to the actor at that block
+	rewardHistory collections.Map[Quadruple[TopicId, BlockHeight, ActorId, string], types.RewardRecord]
+	// key set of (actor, topic, block, reward type) indexing the reward history by actor
+	rewardHistoryByActor collections.KeySet[Quadruple[ActorId, TopicId, BlockHeight, string]]
+
+	/// REWARD ESCROWS
+
+	// map of (actor, topic, block escrowed) -> rewards of the actor vesting in escrow
+	rewardEscrows collections.Map[collections.Triple[ActorId, TopicId, BlockHeight], types.RewardEscrow]
+	// total amount of rewards held in escrow, in the allora staking account
+	totalRewardEscrows collections.Item[cosmosMath.Int]
+
+	/// REWARD PAYOUT QUEUE
+
+	// map of payout id -> reward waiting to be paid out, paid out in ascending id order
+	rewardPayoutQueue collections.Map[uint64, types.QueuedRewardPayout]
+	// key set of (actor, payout id) indexing the reward payout queue by actor
+	rewardPayoutQueueByActor collections.KeySet[collections.Pair[ActorId, uint64]]
+	// id of the next reward payout queued
+	nextRewardPayoutId collections.Sequence
+	// total amount of queued reward payouts, still held in the allora rewards account
+	totalQueuedRewardPayouts collections.Item[cosmosMath.Int]
+	// map of (actor, payout id) -> reward that failed to be paid out max reward payout attempts times.
+	// Its coins are left to the allora rewards account and distributed again as rewards
+	deadLetteredRewardPayouts collections.Map[collections.Pair[ActorId, uint64], types.QueuedRewardPayout]
+
 	// Percentage of all rewards, paid out to staked reputers, during the previous reward cadence. Used by mint module
 	previousPercentageRewardToStakedReputers collections.Item[alloraMath.Dec]
 
@@ -284,6 +394,16 @@ func NewKeeper(
 		topicStake:                               collections.NewMap(sb, types.TopicStakeKey, "topic_stake", collections.Uint64Key, sdk.IntValue),
 		nextTopicId:                              collections.NewSequence(sb, types.NextTopicIdKey, "next_TopicId"),
 		topics:                                   collections.NewMap(sb, types.TopicsKey, "topics", collections.Uint64Key, codec.CollValue[types.Topic](cdc)),
+		pendingTopicUpdates:                      collections.NewMap(sb, types.PendingTopicUpdatesKey, "pending_topic_updates", collections.Uint64Key, codec.CollValue[types.OptionalTopicParams](cdc)),
+		archivedTopics:                           collections.NewKeySet(sb, types.ArchivedTopicsKey, "archived_topics", collections.Uint64Key),
+		archivedTopicsToPrune:                    collections.NewKeySet(sb, types.ArchivedTopicsToPruneKey, "archived_topics_to_prune", collections.Uint64Key),
+		archivedTopicDelegateRemovalCursors:      collections.NewMap(sb, types.ArchivedTopicDelegateRemovalCursorsKey, "archived_topic_delegate_removal_cursors", collections.Uint64Key, collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.StringKey, collections.StringKey))),
+		topicOwners:                              collections.NewMap(sb, types.TopicOwnersKey, "topic_owners", collections.Uint64Key, collections.StringValue),
+		pendingTopicOwners:                       collections.NewMap(sb, types.PendingTopicOwnersKey, "pending_topic_owners", collections.Uint64Key, collections.StringValue),
+		topicsByOwner:                            collections.NewKeySet(sb, types.TopicsByOwnerKey, "topics_by_owner", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		topicsByLossMethod:                       collections.NewKeySet(sb, types.TopicsByLossMethodKey, "topics_by_loss_method", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		topicTags:                                collections.NewKeySet(sb, types.TopicTagsKey, "topic_tags", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		topicsByTag:                              collections.NewKeySet(sb, types.TopicsByTagKey, "topics_by_tag", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
 		activeTopics:                             collections.NewKeySet(sb, types.ActiveTopicsKey, "active_topics", collections.Uint64Key),
 		topicToNextPossibleChurningBlock:         collections.NewMap(sb, types.TopicToNextPossibleChurningBlockKey, "topic_to_next_possible_churning_block", collections.Uint64Key, collections.Int64Value),
 		blockToActiveTopics:                      collections.NewMap(sb, types.BlockToActiveTopicsKey, "block_to_active_topics", collections.Int64Key, codec.CollValue[types.TopicIds](cdc)),
@@ -298,6 +418,8 @@ func NewKeeper(
 		delegateStakeRemovalsByActor:             collections.NewKeySet(sb, types.DelegateStakeRemovalsByActorKey, "delegate_stake_removals_by_actor", QuadrupleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key, collections.Int64Key)),
 		stakeSumFromDelegator:                    collections.NewMap(sb, types.DelegatorStakeKey, "stake_from_delegator", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
 		delegatedStakes:                          collections.NewMap(sb, types.DelegateStakePlacementKey, "delegate_stake_placement", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.DelegatorInfo](cdc)),
+		delegateStakePlacementsByDelegator:       collections.NewKeySet(sb, types.DelegateStakePlacementsByDelegatorKey, "delegate_stake_placements_by_delegator", collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.StringKey)),
+		delegateStakePlacementsByReputer:         collections.NewKeySet(sb, types.DelegateStakePlacementsByReputerKey, "delegate_stake_placements_by_reputer", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey)),
 		stakeFromDelegatorsUponReputer:           collections.NewMap(sb, types.TargetStakeKey, "stake_upon_reputer", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
 		delegateRewardPerShare:                   collections.NewMap(sb, types.DelegateRewardPerShare, "delegate_reward_per_share", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), alloraMath.DecValue),
 		topicFeeRevenue:                          collections.NewMap(sb, types.TopicFeeRevenueKey, "topic_fee_revenue", collections.Uint64Key, sdk.IntValue),
@@ -311,6 +433,31 @@ func NewKeeper(
 		allForecasts:                             collections.NewMap(sb, types.AllForecastsKey, "forecasts_all", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Forecasts](cdc)),
 		allLossBundles:                           collections.NewMap(sb, types.AllLossBundlesKey, "value_bundles_all", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.ReputerValueBundles](cdc)),
 		networkLossBundles:                       collections.NewMap(sb, types.NetworkLossBundlesKey, "value_bundles_network", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.ValueBundle](cdc)),
+		reputerGroundTruths:                      collections.NewMap(sb, types.ReputerGroundTruthsKey, "reputer_ground_truths", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.GroundTruth](cdc)),
+		consensusGroundTruths:                    collections.NewMap(sb, types.ConsensusGroundTruthsKey, "consensus_ground_truths", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.GroundTruth](cdc)),
+		workerPayloadCommits:                     collections.NewMap(sb, types.WorkerPayloadCommitsKey, "worker_payload_commits", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.WorkerPayloadCommit](cdc)),
+		actorOperators:                           collections.NewKeySet(sb, types.ActorOperatorsKey, "actor_operators", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey)),
+		workerBonds:                              collections.NewMap(sb, types.WorkerBondsKey, "worker_bonds", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.WorkerBond](cdc)),
+		totalWorkerBonds:                         collections.NewItem(sb, types.TotalWorkerBondsKey, "total_worker_bonds", sdk.IntValue),
+		workerBondRemovalsByBlock:                collections.NewMap(sb, types.WorkerBondRemovalsByBlockKey, "worker_bond_removals_by_block", collections.TripleKeyCodec(collections.Int64Key, collections.Uint64Key, collections.StringKey), codec.CollValue[types.WorkerBondRemovalInfo](cdc)),
+		workerBondRemovalsByActor:                collections.NewKeySet(sb, types.WorkerBondRemovalsByActorKey, "worker_bond_removals_by_actor", collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Int64Key)),
+		reputerConsensusDeviations:               collections.NewMap(sb, types.ReputerConsensusDeviationsKey, "reputer_consensus_deviations", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ReputerConsensusDeviation](cdc)),
+		redelegations:                            collections.NewMap(sb, types.RedelegationsKey, "redelegations", QuadrupleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey, collections.StringKey), codec.CollValue[types.RedelegationInfo](cdc)),
+		redelegationsByDestination:               collections.NewKeySet(sb, types.RedelegationsByDestinationKey, "redelegations_by_destination", QuadrupleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey, collections.StringKey)),
+		redelegationsByBlock:                     collections.NewKeySet(sb, types.RedelegationsByBlockKey, "redelegations_by_block", collections.PairKeyCodec(collections.Int64Key, QuadrupleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey, collections.StringKey))),
+		autoCompoundDelegators:                   collections.NewKeySet(sb, types.AutoCompoundDelegatorsKey, "auto_compound_delegators", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		reputerCommissions:                       collections.NewMap(sb, types.ReputerCommissionsKey, "reputer_commissions", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ReputerCommission](cdc)),
+		stakeLocks:                               collections.NewMap(sb, types.StakeLocksKey, "stake_locks", QuadrupleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey, collections.Int64Key), codec.CollValue[types.StakeLock](cdc)),
+		stakeLocksByBlock:                        collections.NewKeySet(sb, types.StakeLocksByBlockKey, "stake_locks_by_block", collections.PairKeyCodec(collections.Int64Key, QuadrupleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey, collections.Int64Key))),
+		rewardHistory:                            collections.NewMap(sb, types.RewardHistoryKey, "reward_history", QuadrupleKeyCodec(collections.Uint64Key, collections.Int64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.RewardRecord](cdc)),
+		rewardHistoryByActor:                     collections.NewKeySet(sb, types.RewardHistoryByActorKey, "reward_history_by_actor", QuadrupleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Int64Key, collections.StringKey)),
+		rewardEscrows:                            collections.NewMap(sb, types.RewardEscrowsKey, "reward_escrows", collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Int64Key), codec.CollValue[types.RewardEscrow](cdc)),
+		totalRewardEscrows:                       collections.NewItem(sb, types.TotalRewardEscrowsKey, "total_reward_escrows", sdk.IntValue),
+		rewardPayoutQueue:                        collections.NewMap(sb, types.RewardPayoutQueueKey, "reward_payout_queue", collections.Uint64Key, codec.CollValue[types.QueuedRewardPayout](cdc)),
+		rewardPayoutQueueByActor:                 collections.NewKeySet(sb, types.RewardPayoutQueueByActorKey, "reward_payout_queue_by_actor", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		nextRewardPayoutId:                       collections.NewSequence(sb, types.NextRewardPayoutIdKey, "next_reward_payout_id"),
+		totalQueuedRewardPayouts:                 collections.NewItem(sb, types.TotalQueuedRewardPayoutsKey, "total_queued_reward_payouts", sdk.IntValue),
+		deadLetteredRewardPayouts:                collections.NewMap(sb, types.DeadLetteredRewardPayoutsKey, "dead_lettered_reward_payouts", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.QueuedRewardPayout](cdc)),
 		previousPercentageRewardToStakedReputers: collections.NewItem(sb, types.PreviousPercentageRewardToStakedReputersKey, "previous_percentage_reward_to_staked_reputers", alloraMath.DecValue),
 		latestInfererNetworkRegrets:              collections.NewMap(sb, types.InfererNetworkRegretsKey, "inferer_network_regrets", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
 		latestForecasterNetworkRegrets:           collections.NewMap(sb, types.ForecasterNetworkRegretsKey, "forecaster_network_regrets", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
@@ -731,16 +878,19 @@ func (k *Keeper) GetInfererNetworkRegret(
 			return types.TimestampedValue{
 				BlockHeight: 0,
 				Value:       alloraMath.ZeroDec(),
+				Values:      nil,
 			}, false, errorsmod.Wrap(err, "error getting topic")
 		}
 		return types.TimestampedValue{
 			BlockHeight: 0,
 			Value:       topic.InitialRegret,
+			Values:      nil,
 		}, true, nil
 	} else if err != nil {
 		return types.TimestampedValue{
 			BlockHeight: 0,
 			Value:       alloraMath.ZeroDec(),
+			Values:      nil,
 		}, false, errorsmod.Wrap(err, "error getting inferer network regret")
 	}
 	return regret, false, nil
@@ -781,6 +931,7 @@ func (k *Keeper) GetForecasterNetworkRegret(
 		return types.TimestampedValue{
 			BlockHeight: 0,
 			Value:       topic.InitialRegret,
+			Values:      nil,
 		}, true, nil
 	} else if err != nil {
 		return types.TimestampedValue{}, false, errorsmod.Wrap(err, "error getting forecaster network regret")
@@ -827,6 +978,7 @@ func (k *Keeper) GetOneInForecasterNetworkRegret(
 		return types.TimestampedValue{
 			BlockHeight: 0,
 			Value:       topic.InitialRegret,
+			Values:      nil,
 		}, true, nil
 	} else if err != nil {
 		return types.TimestampedValue{}, false, errorsmod.Wrap(err, "error getting one in forecaster network regret")
@@ -866,6 +1018,7 @@ func (k *Keeper) GetNaiveInfererNetworkRegret(ctx context.Context, topicId Topic
 		return types.TimestampedValue{
 			BlockHeight: 0,
 			Value:       topic.InitialRegret,
+			Values:      nil,
 		}, true, nil
 	} else if err != nil {
 		return types.TimestampedValue{}, false, errorsmod.Wrap(err, "error getting naive inferer network regret")
@@ -912,6 +1065,7 @@ func (k *Keeper) GetOneOutInfererInfererNetworkRegret(
 		return types.TimestampedValue{
 			BlockHeight: 0,
 			Value:       topic.InitialRegret,
+			Values:      nil,
 		}, true, nil
 	} else if err != nil {
 		return types.TimestampedValue{}, false, errorsmod.Wrap(err, "error getting one out inferer inferer network regret")
@@ -958,6 +1112,7 @@ func (k *Keeper) GetOneOutInfererForecasterNetworkRegret(
 		return types.TimestampedValue{
 			BlockHeight: 0,
 			Value:       topic.InitialRegret,
+			Values:      nil,
 		}, true, nil
 	} else if err != nil {
 		return types.TimestampedValue{}, false, errorsmod.Wrap(err, "error getting one out inferer forecaster network regret")
@@ -1004,6 +1159,7 @@ func (k *Keeper) GetOneOutForecasterInfererNetworkRegret(
 		return types.TimestampedValue{
 			BlockHeight: 0,
 			Value:       topic.InitialRegret,
+			Values:      nil,
 		}, true, nil
 	} else if err != nil {
 		return types.TimestampedValue{}, false, errorsmod.Wrap(err, "error getting one out forecaster inferer network regret")
@@ -1050,6 +1206,7 @@ func (k *Keeper) GetOneOutForecasterForecasterNetworkRegret(
 		return types.TimestampedValue{
 			BlockHeight: 0,
 			Value:       topic.InitialRegret,
+			Values:      nil,
 		}, true, nil
 	} else if err != nil {
 		return types.TimestampedValue{}, false, errorsmod.Wrap(err, "error getting one out forecaster forecaster network regret")
@@ -1635,6 +1792,31 @@ func (k *Keeper) GetWorkerLatestInferenceByTopicId(
 	return k.inferences.Get(ctx, key)
 }
 
+// InsertWorkerPayloadCommit stores the payload commitment of a worker for the open nonce of a topic
+func (k *Keeper) InsertWorkerPayloadCommit(ctx context.Context, commit types.WorkerPayloadCommit) error {
+	if err := commit.Validate(); err != nil {
+		return errorsmod.Wrap(err, "worker payload commit is invalid")
+	}
+	key := collections.Join(commit.TopicId, commit.Worker)
+	return k.workerPayloadCommits.Set(ctx, key, commit)
+}
+
+// GetWorkerPayloadCommit returns the payload commitment of a worker for the open nonce of a topic
+func (k *Keeper) GetWorkerPayloadCommit(
+	ctx context.Context,
+	topicId TopicId,
+	worker ActorId,
+) (types.WorkerPayloadCommit, error) {
+	key := collections.Join(topicId, worker)
+	return k.workerPayloadCommits.Get(ctx, key)
+}
+
+// RemoveWorkerPayloadCommit removes the payload commitment of a worker once it has been revealed
+func (k *Keeper) RemoveWorkerPayloadCommit(ctx context.Context, topicId TopicId, worker ActorId) error {
+	key := collections.Join(topicId, worker)
+	return k.workerPayloadCommits.Remove(ctx, key)
+}
+
 func (k *Keeper) GetWorkerLatestForecastByTopicId(
 	ctx context.Context,
 	topicId TopicId,
@@ -1687,15 +1869,30 @@ func (k *Keeper) AppendReputerLoss(
 		return errors.New("invalid reputerLoss bundle: reputer is empty or nil")
 	}
 
+	return k.appendReputerSubmission(ctx, topic, moduleParams, nonceBlockHeight, reputerLoss.ValueBundle.Reputer, func() error {
+		return k.InsertReputerLoss(ctx, topic.Id, *reputerLoss)
+	})
+}
+
+// Adds a reputer to the active reputers of the topic if it ranks among the top reputers by score EMA,
+// storing its submission with insertSubmission, and evicts the lowest scoring reputer if needed
+func (k *Keeper) appendReputerSubmission(
+	ctx sdk.Context,
+	topic types.Topic,
+	moduleParams types.Params,
+	nonceBlockHeight BlockHeight,
+	reputer ActorId,
+	insertSubmission func() error,
+) error {
 	// Check if the reputer already submitted the loss
-	isActive, err := k.IsActiveReputer(ctx, topic.Id, reputerLoss.ValueBundle.Reputer)
+	isActive, err := k.IsActiveReputer(ctx, topic.Id, reputer)
 	if err != nil {
 		return errorsmod.Wrap(err, "error checking if reputer already submitted loss")
 	} else if isActive {
 		return errors.New("reputer loss already submitted")
 	}
 
-	previousEmaScore, err := k.GetReputerScoreEma(ctx, topic.Id, reputerLoss.ValueBundle.Reputer)
+	previousEmaScore, err := k.GetReputerScoreEma(ctx, topic.Id, reputer)
 	if err != nil {
 		return errorsmod.Wrapf(err, "Error getting reputer score ema")
 	}
@@ -1712,9 +1909,9 @@ func (k *Keeper) AppendReputerLoss(
 		if err != nil {
 			return errorsmod.Wrap(err, "error getting topic initial ema score")
 		}
-		err = k.SetReputerScoreEma(ctx, topic.Id, reputerLoss.ValueBundle.Reputer, types.Score{
+		err = k.SetReputerScoreEma(ctx, topic.Id, reputer, types.Score{
 			TopicId:     topic.Id,
-			Address:     reputerLoss.ValueBundle.Reputer,
+			Address:     reputer,
 			BlockHeight: nonceBlockHeight,
 			Score:       initialEmaScore,
 		})
@@ -1754,11 +1951,11 @@ func (k *Keeper) AppendReputerLoss(
 			}
 		}
 
-		err = k.AddActiveReputer(ctx, topic.Id, reputerLoss.ValueBundle.Reputer)
+		err = k.AddActiveReputer(ctx, topic.Id, reputer)
 		if err != nil {
 			return errorsmod.Wrap(err, "error adding active reputer")
 		}
-		return k.InsertReputerLoss(ctx, topic.Id, *reputerLoss)
+		return insertSubmission()
 	}
 
 	if previousEmaScore.Score.Gt(lowestEmaScore.Score) {
@@ -1792,17 +1989,21 @@ func (k *Keeper) AppendReputerLoss(
 		if err != nil {
 			return errorsmod.Wrap(err, "error removing reputer loss from reputer")
 		}
+		err = k.RemoveReputerGroundTruth(ctx, topic.Id, lowestEmaScore.Address)
+		if err != nil {
+			return errorsmod.Wrap(err, "error removing reputer ground truth from reputer")
+		}
 		// Add new active reputer
-		err = k.AddActiveReputer(ctx, topic.Id, reputerLoss.ValueBundle.Reputer)
+		err = k.AddActiveReputer(ctx, topic.Id, reputer)
 		if err != nil {
 			return errorsmod.Wrap(err, "error adding active reputer")
 		}
 		// Calculate new lowest score with updated reputerAddresses
-		err = UpdateLowestScoreFromReputerAddresses(ctx, k, topic.Id, reputerAddresses, reputerLoss.ValueBundle.Reputer, lowestEmaScore.Address)
+		err = UpdateLowestScoreFromReputerAddresses(ctx, k, topic.Id, reputerAddresses, reputer, lowestEmaScore.Address)
 		if err != nil {
 			return errorsmod.Wrap(err, "error getting low score from all reputer losses")
 		}
-		return k.InsertReputerLoss(ctx, topic.Id, *reputerLoss)
+		return insertSubmission()
 	} else {
 		// Update EMA score for the current reputer, who is the lowest score reputer
 		if !firstSubmission {
@@ -1852,6 +2053,75 @@ func (k *Keeper) RemoveReputerLoss(
 	return k.lossBundles.Remove(ctx, key)
 }
 
+/// GROUND TRUTHS
+
+// Append the ground truth submitted by a reputer of a topic whose losses are computed by the chain
+func (k *Keeper) AppendReputerGroundTruth(
+	ctx sdk.Context,
+	topic types.Topic,
+	moduleParams types.Params,
+	groundTruth types.GroundTruth,
+) error {
+	return k.appendReputerSubmission(ctx, topic, moduleParams, groundTruth.BlockHeight, groundTruth.Reputer, func() error {
+		return k.InsertReputerGroundTruth(ctx, topic.Id, groundTruth)
+	})
+}
+
+// GetReputerLatestGroundTruthByTopicId returns the ground truth submitted by a reputer for the open nonce of a topic
+func (k *Keeper) GetReputerLatestGroundTruthByTopicId(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+) (types.GroundTruth, error) {
+	key := collections.Join(topicId, reputer)
+	return k.reputerGroundTruths.Get(ctx, key)
+}
+
+// InsertReputerGroundTruth inserts the ground truth of a reputer for a specific topic
+func (k *Keeper) InsertReputerGroundTruth(
+	ctx context.Context,
+	topicId TopicId,
+	groundTruth types.GroundTruth,
+) error {
+	if err := types.ValidateTopicId(topicId); err != nil {
+		return errorsmod.Wrap(err, "topic id validation failed")
+	}
+	if err := groundTruth.Validate(); err != nil {
+		return errorsmod.Wrap(err, "reputer ground truth is invalid")
+	}
+	key := collections.Join(topicId, groundTruth.Reputer)
+	return k.reputerGroundTruths.Set(ctx, key, groundTruth)
+}
+
+// RemoveReputerGroundTruth removes the ground truth of a reputer for a specific topic
+func (k *Keeper) RemoveReputerGroundTruth(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+) error {
+	key := collections.Join(topicId, reputer)
+	return k.reputerGroundTruths.Remove(ctx, key)
+}
+
+// SetConsensusGroundTruth stores the consensus ground truth of a topic at the block of its nonce
+func (k *Keeper) SetConsensusGroundTruth(ctx context.Context, groundTruth types.GroundTruth) error {
+	if err := groundTruth.Validate(); err != nil {
+		return errorsmod.Wrap(err, "consensus ground truth is invalid")
+	}
+	key := collections.Join(groundTruth.TopicId, groundTruth.BlockHeight)
+	return k.consensusGroundTruths.Set(ctx, key, groundTruth)
+}
+
+// GetConsensusGroundTruthAtBlock returns the consensus ground truth of a topic at the block of a nonce
+func (k *Keeper) GetConsensusGroundTruthAtBlock(
+	ctx context.Context,
+	topicId TopicId,
+	block BlockHeight,
+) (types.GroundTruth, error) {
+	key := collections.Join(topicId, block)
+	return k.consensusGroundTruths.Get(ctx, key)
+}
+
 // Insert a loss bundle for a topic and timestamp but do it in the CloseReputerNonce, so reputer loss bundles are known to be validated
 // and not validated again (this is due to poor data type design choices, see PROTO-2369)
 func (k *Keeper) InsertActiveReputerLosses(
@@ -1935,6 +2205,8 @@ func (k *Keeper) GetNetworkLossBundleAtBlock(ctx context.Context, topicId TopicI
 			OneOutForecasterValues:        nil,
 			OneInForecasterValues:         nil,
 			OneOutInfererForecasterValues: nil,
+			CombinedValues:                nil,
+			NaiveValues:                   nil,
 		}, nil
 	} else if err != nil {
 		return nil, errorsmod.Wrap(err, "error getting network loss bundle at block")
@@ -2192,6 +2464,69 @@ func (k *Keeper) RemoveReputerStake(
 	return nil
 }
 
+// Moves stake of a reputer from one topic to another without it leaving the staking account
+// subtracts from: topicStake, stakeReputerAuthority of the source topic
+// adds to: topicStake, stakeReputerAuthority of the destination topic
+// totalStake is left as is, as the stake never leaves the system
+func (k *Keeper) MoveReputerStake(
+	ctx context.Context,
+	fromTopicId TopicId,
+	toTopicId TopicId,
+	reputer ActorId,
+	stakeToMove cosmosMath.Int,
+) error {
+	// CHECKS
+	if stakeToMove.IsZero() {
+		return errorsmod.Wrapf(types.ErrInvalidValue, "reputer stake to move must be greater than zero")
+	}
+	if fromTopicId == toTopicId {
+		return errorsmod.Wrapf(types.ErrInvalidValue, "cannot move reputer stake to the same topic")
+	}
+
+	// GET CURRENT VALUES
+	fromReputerAuthority, err := k.GetStakeReputerAuthority(ctx, fromTopicId, reputer)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting reputer authority")
+	}
+	delegateStakeUponReputerInTopic, err := k.GetDelegateStakeUponReputer(ctx, fromTopicId, reputer)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting delegate stake upon reputer in topic")
+	}
+	if stakeToMove.GT(fromReputerAuthority.Sub(delegateStakeUponReputerInTopic)) {
+		return types.ErrIntegerUnderflowTopicReputerStake
+	}
+	fromTopicStake, err := k.GetTopicStake(ctx, fromTopicId)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting topic stake")
+	}
+	if stakeToMove.GT(fromTopicStake) {
+		return types.ErrIntegerUnderflowTopicStake
+	}
+	toReputerAuthority, err := k.GetStakeReputerAuthority(ctx, toTopicId, reputer)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting reputer authority")
+	}
+	toTopicStake, err := k.GetTopicStake(ctx, toTopicId)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting topic stake")
+	}
+
+	// SET NEW VALUES
+	if err := k.SetStakeReputerAuthority(ctx, fromTopicId, reputer, fromReputerAuthority.Sub(stakeToMove)); err != nil {
+		return errorsmod.Wrapf(err, "Setting moved reputer stake in source topic failed")
+	}
+	if err := k.SetTopicStake(ctx, fromTopicId, fromTopicStake.Sub(stakeToMove)); err != nil {
+		return errorsmod.Wrapf(err, "Setting moved topic stake in source topic failed")
+	}
+	if err := k.SetStakeReputerAuthority(ctx, toTopicId, reputer, toReputerAuthority.Add(stakeToMove)); err != nil {
+		return errorsmod.Wrapf(err, "Setting moved reputer stake in destination topic failed")
+	}
+	if err := k.SetTopicStake(ctx, toTopicId, toTopicStake.Add(stakeToMove)); err != nil {
+		return errorsmod.Wrapf(err, "Setting moved topic stake in destination topic failed")
+	}
+	return nil
+}
+
 // Removes delegate stake from the system for a given topic, delegator, and reputer
 // subtracts from: totalStake, topicStake, stakeReputerAuthority
 //
@@ -2478,9 +2813,23 @@ func (k *Keeper) SetDelegateStakePlacement(ctx context.Context, topicId TopicId,
 		return errorsmod.Wrap(err, "stake information is not valid")
 	}
 	key := collections.Join3(topicId, delegator, target)
+	indexKey := collections.Join3(delegator, topicId, target)
+	reputerIndexKey := collections.Join3(topicId, target, delegator)
 	if stake.Amount.IsZero() {
+		if err := k.delegateStakePlacementsByDelegator.Remove(ctx, indexKey); err != nil {
+			return errorsmod.Wrap(err, "error removing delegate stake placement index")
+		}
+		if err := k.delegateStakePlacementsByReputer.Remove(ctx, reputerIndexKey); err != nil {
+			return errorsmod.Wrap(err, "error removing delegate stake placement index by reputer")
+		}
 		return k.delegatedStakes.Remove(ctx, key)
 	}
+	if err := k.delegateStakePlacementsByDelegator.Set(ctx, indexKey); err != nil {
+		return errorsmod.Wrap(err, "error indexing delegate stake placement")
+	}
+	if err := k.delegateStakePlacementsByReputer.Set(ctx, reputerIndexKey); err != nil {
+		return errorsmod.Wrap(err, "error indexing delegate stake placement by reputer")
+	}
 	return k.delegatedStakes.Set(ctx, key, stake)
 }
 
@@ -3023,6 +3372,9 @@ func (k *Keeper) SetTopic(ctx context.Context, topicId TopicId, topic types.Topi
 	if err := topic.Validate(params); err != nil {
 		return errorsmod.Wrap(err, "set topic validation failure")
 	}
+	if err := k.reindexTopic(ctx, topicId, topic); err != nil {
+		return errorsmod.Wrap(err, "error indexing topic")
+	}
 	return k.topics.Set(ctx, topicId, topic)
 }
 
@@ -3066,6 +3418,60 @@ func (k *Keeper) UpdateTopicEpochLastEnded(ctx context.Context, topicId TopicId,
 	return k.SetTopic(ctx, topicId, topic)
 }
 
+// Gets the topic parameter changes waiting for the topic's next epoch boundary.
+// Returns (update, true) if there is a pending update, else (empty, false)
+func (k *Keeper) GetPendingTopicUpdate(ctx context.Context, topicId TopicId) (types.OptionalTopicParams, bool, error) {
+	update, err := k.pendingTopicUpdates.Get(ctx, topicId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.OptionalTopicParams{}, false, nil
+	} else if err != nil {
+		return types.OptionalTopicParams{}, false, err
+	}
+	return update, true, nil
+}
+
+// Queues topic parameter changes to be applied at the topic's next epoch boundary
+func (k *Keeper) SetPendingTopicUpdate(ctx context.Context, topicId TopicId, update types.OptionalTopicParams) error {
+	if err := types.ValidateTopicId(topicId); err != nil {
+		return errorsmod.Wrap(err, "topic id validation failed")
+	}
+	if err := update.Validate(); err != nil {
+		return errorsmod.Wrap(err, "pending topic update validation failed")
+	}
+	return k.pendingTopicUpdates.Set(ctx, topicId, update)
+}
+
+// Applies the pending parameter changes of a topic, if any, and emits an event with
+// the old and new topic. The pending update is always consumed; if it no longer
+// passes topic validation (e.g. module params changed since it was queued) it is dropped
+// and an event with the rejected update and the reason is emitted instead.
+func (k *Keeper) ApplyPendingTopicUpdate(ctx context.Context, topicId TopicId) error {
+	update, found, err := k.GetPendingTopicUpdate(ctx, topicId)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting pending topic update")
+	}
+	if !found {
+		return nil
+	}
+	if err := k.pendingTopicUpdates.Remove(ctx, topicId); err != nil {
+		return errorsmod.Wrap(err, "error removing pending topic update")
+	}
+
+	oldTopic, err := k.GetTopic(ctx, topicId)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting topic")
+	}
+	newTopic := update.ApplyToTopic(oldTopic)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.SetTopic(ctx, topicId, newTopic); err != nil {
+		sdkCtx.Logger().Warn(fmt.Sprintf("Dropping pending update for topic %d: %s", topicId, err.Error()))
+		types.EmitNewTopicUpdateRejectedEvent(sdkCtx, topicId, sdkCtx.BlockHeight(), update, err.Error())
+		return nil
+	}
+	types.EmitNewTopicUpdatedEvent(sdkCtx, topicId, sdkCtx.BlockHeight(), oldTopic, newTopic)
+	return nil
+}
+
 // True if worker is registered in topic, else False
 func (k *Keeper) IsWorkerRegisteredInTopic(ctx context.Context, topicId TopicId, worker ActorId) (bool, error) {
 	topicKey := collections.Join(topicId, worker)
@@ -3841,6 +4247,12 @@ func (k *Keeper) GetTotalRewardToDistribute(ctx context.Context) (alloraMath.Dec
 		ctx,
 		alloraRewardsAccountAddr,
 		params.DefaultBondDenom).Amount
+	// rewards waiting in the payout queue are already distributed
+	totalQueued, err := k.GetTotalQueuedRewardPayouts(ctx)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	totalReward = cosmosMath.MaxInt(totalReward.Sub(totalQueued), cosmosMath.ZeroInt())
 	totalRewardDec, err := alloraMath.NewDecFromSdkInt(totalReward)
 	if err != nil {
 		return alloraMath.Dec{}, errorsmod.Wrap(err, "error getting total reward to distribute")
@@ -3853,17 +4265,27 @@ func (k *Keeper) GetTotalRewardToDistribute(ctx context.Context) (alloraMath.Dec
 // Convert pagination.key from []bytes to uint64, if pagination is nil or [], len = 0
 // Get the limit from the pagination request, within acceptable bounds and defaulting as necessary
 func (k Keeper) CalcAppropriatePaginationForUint64Cursor(ctx context.Context, pagination *types.SimpleCursorPaginationRequest) (uint64, uint64, error) {
+	limit, err := k.CalcAppropriatePaginationLimit(ctx, pagination)
+	if err != nil {
+		return uint64(0), uint64(0), err
+	}
+	cursor := uint64(0)
+	if pagination != nil && len(pagination.Key) > 0 {
+		cursor = binary.BigEndian.Uint64(pagination.Key)
+	}
+
+	return limit, cursor, nil
+}
+
+// Get the limit from the pagination request, within acceptable bounds and defaulting as necessary
+func (k Keeper) CalcAppropriatePaginationLimit(ctx context.Context, pagination *types.SimpleCursorPaginationRequest) (uint64, error) {
 	moduleParams, err := k.GetParams(ctx)
 	if err != nil {
-		return uint64(0), uint64(0), errorsmod.Wrap(err, "error getting params")
+		return uint64(0), errorsmod.Wrap(err, "error getting params")
 	}
 	limit := moduleParams.DefaultPageLimit
-	cursor := uint64(0)
 
 	if pagination != nil {
-		if len(pagination.Key) > 0 {
-			cursor = binary.BigEndian.Uint64(pagination.Key)
-		}
 		if pagination.Limit > 0 {
 			limit = pagination.Limit
 		}
@@ -3872,7 +4294,7 @@ func (k Keeper) CalcAppropriatePaginationForUint64Cursor(ctx context.Context, pa
 		}
 	}
 
-	return limit, cursor, nil
+	return limit, nil
 }
 
 /// STATE MANAGEMENT
@@ -3900,6 +4322,10 @@ func (k *Keeper) PruneRecordsAfterRewards(ctx context.Context, topicId TopicId,
 	if err != nil {
 		return errorsmod.Wrap(err, "error pruning network losses")
 	}
+	err = k.pruneConsensusGroundTruths(ctx, blockRange)
+	if err != nil {
+		return errorsmod.Wrap(err, "error pruning consensus ground truths")
+	}
 
 	return nil
 }
@@ -3920,6 +4346,10 @@ func (k *Keeper) pruneNetworkLosses(ctx context.Context, blockRange *collections
 	return k.networkLossBundles.Clear(ctx, blockRange)
 }
 
+func (k *Keeper) pruneConsensusGroundTruths(ctx context.Context, blockRange *collections.PairRange[uint64, int64]) error {
+	return k.consensusGroundTruths.Clear(ctx, blockRange)
+}
+
 func (k *Keeper) PruneWorkerNonces(ctx context.Context, topicId uint64, blockHeightThreshold int64) error {
 	if err := types.ValidateTopicId(topicId); err != nil {
 		return errorsmod.Wrap(err, "error validating topic id")
@@ -3990,6 +4420,13 @@ func (k *Keeper) BlockWithinWorkerSubmissionWindowOfNonce(topic types.Topic, non
 	return nonce.BlockHeight <= blockHeight && blockHeight < topic.WorkerSubmissionWindow+nonce.BlockHeight
 }
 
+// Return true if the nonce is within the worker reveal window for the topic, which follows its worker submission window
+// Inclusive of the start block height and exclusive of the end block height. Always false if the topic does not use commit-reveal
+func (k *Keeper) BlockWithinWorkerRevealWindowOfNonce(topic types.Topic, nonce types.Nonce, blockHeight int64) bool {
+	revealWindowStart := nonce.BlockHeight + topic.WorkerSubmissionWindow
+	return revealWindowStart <= blockHeight && blockHeight < revealWindowStart+topic.WorkerRevealWindow
+}
+
 // Return true if the nonce is within the worker submission window for the topic
 // Inclusive of the start block height and of the end block height
 func (k *Keeper) BlockWithinReputerSubmissionWindowOfNonce(topic types.Topic, nonce types.ReputerRequestNonce, blockHeight int64) bool {
@@ -4286,6 +4723,9 @@ func (k *Keeper) ResetReputersIndividualSubmissionsForTopic(ctx context.Context,
 	if err := k.lossBundles.Clear(ctx, reputerRange); err != nil {
 		return errorsmod.Wrap(err, "error clearing reputer losses")
 	}
+	if err := k.reputerGroundTruths.Clear(ctx, reputerRange); err != nil {
+		return errorsmod.Wrap(err, "error clearing reputer ground truths")
+	}
 	return nil
 }
 
@@ -4299,6 +4739,11 @@ func (k *Keeper) ResetWorkersIndividualSubmissionsForTopic(ctx context.Context,
 	if err := k.forecasts.Clear(ctx, forecasterRange); err != nil {
 		return errorsmod.Wrap(err, "error clearing forecasts")
 	}
+	// payloads committed but never revealed
+	commitRange := collections.NewPrefixedPairRange[TopicId, ActorId](topicId)
+	if err := k.workerPayloadCommits.Clear(ctx, commitRange); err != nil {
+		return errorsmod.Wrap(err, "error clearing worker payload commits")
+	}
 
 	return nil
 }
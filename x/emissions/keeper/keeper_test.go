@@ -89,6 +89,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraRewardEscrowAccountName:              {"minter"},
 		minttypes.EcosystemModuleName:                    nil,
 		"bonded_tokens_pool":                             {"burner", "staking"},
 		"not_bonded_tokens_pool":                         {"burner", "staking"},
@@ -163,23 +164,37 @@ func (s *KeeperTestSuite) MintTokensToAddress(address sdk.AccAddress, amount cos
 
 func (s *KeeperTestSuite) mockTopic() types.Topic {
 	return types.Topic{
-		Id:                       123,
-		Creator:                  s.addrsStr[9],
-		Metadata:                 "metadata",
-		LossMethod:               "method",
-		EpochLastEnded:           123,
-		EpochLength:              135,
-		GroundTruthLag:           135,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.NewDecFromInt64(1),
-		AllowNegative:            false,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		InitialRegret:            alloraMath.MustNewDecFromString("0.5"),
-		WorkerSubmissionWindow:   135,
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		Id:                            123,
+		Creator:                       s.addrsStr[9],
+		Metadata:                      "metadata",
+		LossMethod:                    "method",
+		EpochLastEnded:                123,
+		EpochLength:                   135,
+		GroundTruthLag:                135,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		AllowNegative:                 false,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		InitialRegret:                 alloraMath.MustNewDecFromString("0.5"),
+		WorkerSubmissionWindow:        135,
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
+		RewardEscrowFraction:          alloraMath.ZeroDec(),
+		RewardEscrowVestingBlocks:     0,
 	}
 }
 
@@ -204,22 +219,35 @@ func (s *KeeperTestSuite) CreateOneTopic(epochLen int64) uint64 {
 	// Create a CreateNewTopicRequest message
 
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  s.addrsStr[0],
-		Metadata:                 metadata,
-		LossMethod:               "method",
-		EpochLength:              epochLen,
-		GroundTruthLag:           epochLen,
-		WorkerSubmissionWindow:   epochLen,
-		AllowNegative:            false,
-		AlphaRegret:              alloraMath.NewDecFromInt64(1),
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       s.addrsStr[0],
+		Metadata:                      metadata,
+		LossMethod:                    "method",
+		EpochLength:                   epochLen,
+		GroundTruthLag:                epochLen,
+		WorkerSubmissionWindow:        epochLen,
+		AllowNegative:                 false,
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 
 	s.MintTokensToAddress(s.addrs[0], types.DefaultParams().CreateTopicFee)
@@ -552,7 +580,7 @@ func (s *KeeperTestSuite) TestSetAndGetInfererNetworkRegret() {
 	keeper := s.emissionsKeeper
 	topicId := uint64(1)
 	worker := s.addrsStr[1]
-	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10)}
+	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10), Values: nil}
 
 	// Set Inferer Network Regret
 	err := keeper.SetInfererNetworkRegret(ctx, topicId, worker, regret)
@@ -570,7 +598,7 @@ func (s *KeeperTestSuite) TestSetAndGetForecasterNetworkRegret() {
 	topicId := uint64(1)
 	worker := s.addrsStr[3] // Assuming sdk.AccAddress is initialized with a string representing the address
 
-	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(20)}
+	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(20), Values: nil}
 
 	// Set Forecaster Network Regret
 	err := keeper.SetForecasterNetworkRegret(ctx, topicId, worker, regret)
@@ -590,7 +618,7 @@ func (s *KeeperTestSuite) TestSetAndGetOneInForecasterNetworkRegret() {
 	forecaster := s.addrsStr[3]
 	inferer := s.addrsStr[1]
 
-	regret := types.TimestampedValue{BlockHeight: 200, Value: alloraMath.NewDecFromInt64(30)}
+	regret := types.TimestampedValue{BlockHeight: 200, Value: alloraMath.NewDecFromInt64(30), Values: nil}
 
 	// Set One-In Forecaster Network Regret
 	err := keeper.SetOneInForecasterNetworkRegret(ctx, topicId, forecaster, inferer, regret)
@@ -613,7 +641,7 @@ func (s *KeeperTestSuite) TestDifferentTopicIdsYieldDifferentInfererRegrets() {
 	topicId2 := s.CreateOneTopic(10800)
 
 	// Zero regret for initial check
-	noRegret := types.TimestampedValue{BlockHeight: 0, Value: alloraMath.NewDecFromInt64(0)}
+	noRegret := types.TimestampedValue{BlockHeight: 0, Value: alloraMath.NewDecFromInt64(0), Values: nil}
 
 	// Initial regrets should be zero
 	gotRegret1, _, err := keeper.GetInfererNetworkRegret(ctx, topicId1, worker)
@@ -625,8 +653,8 @@ func (s *KeeperTestSuite) TestDifferentTopicIdsYieldDifferentInfererRegrets() {
 	s.Require().Equal(noRegret, gotRegret2, "Initial regret should be zero for Topic ID 2")
 
 	// Regrets to be set
-	regret1 := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10)}
-	regret2 := types.TimestampedValue{BlockHeight: 200, Value: alloraMath.NewDecFromInt64(20)}
+	regret1 := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10), Values: nil}
+	regret2 := types.TimestampedValue{BlockHeight: 200, Value: alloraMath.NewDecFromInt64(20), Values: nil}
 
 	// Set regrets for the same worker under different topic IDs
 	err = keeper.SetInfererNetworkRegret(ctx, topicId1, worker, regret1)
@@ -658,9 +686,9 @@ func (s *KeeperTestSuite) TestDifferentTopicIdsYieldDifferentForecasterRegrets()
 	topicId2 := s.CreateOneTopic(10800)
 
 	// Regrets
-	noRagret := types.TimestampedValue{BlockHeight: 0, Value: alloraMath.NewDecFromInt64(0)}
-	regret1 := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10)}
-	regret2 := types.TimestampedValue{BlockHeight: 200, Value: alloraMath.NewDecFromInt64(20)}
+	noRagret := types.TimestampedValue{BlockHeight: 0, Value: alloraMath.NewDecFromInt64(0), Values: nil}
+	regret1 := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10), Values: nil}
+	regret2 := types.TimestampedValue{BlockHeight: 200, Value: alloraMath.NewDecFromInt64(20), Values: nil}
 
 	gotRegret1, _, err := keeper.GetForecasterNetworkRegret(ctx, topicId1, worker)
 	s.Require().NoError(err)
@@ -699,7 +727,7 @@ func (s *KeeperTestSuite) TestDifferentTopicIdsYieldDifferentOneInForecasterNetw
 	topicId2 := uint64(2)
 
 	// Zero regret for initial checks
-	noRegret := types.TimestampedValue{BlockHeight: 0, Value: alloraMath.NewDecFromInt64(0)}
+	noRegret := types.TimestampedValue{BlockHeight: 0, Value: alloraMath.NewDecFromInt64(0), Values: nil}
 
 	// Initial regrets should be zero
 	gotRegret1, _, err := keeper.GetOneInForecasterNetworkRegret(ctx, topicId1, forecaster, inferer)
@@ -711,8 +739,8 @@ func (s *KeeperTestSuite) TestDifferentTopicIdsYieldDifferentOneInForecasterNetw
 	s.Require().Equal(noRegret, gotRegret2, "Initial regret should be zero for Topic ID 2")
 
 	// Regrets to be set
-	regret1 := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10)}
-	regret2 := types.TimestampedValue{BlockHeight: 200, Value: alloraMath.NewDecFromInt64(20)}
+	regret1 := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10), Values: nil}
+	regret2 := types.TimestampedValue{BlockHeight: 200, Value: alloraMath.NewDecFromInt64(20), Values: nil}
 
 	// Set regrets for the same forecaster-inferer pair under different topic IDs
 	err = keeper.SetOneInForecasterNetworkRegret(ctx, topicId1, forecaster, inferer, regret1)
@@ -740,7 +768,7 @@ func (s *KeeperTestSuite) TestSetAndGetNaiveInfererNetworkRegret() {
 	topicId := uint64(1)
 	inferer := s.addrsStr[1]
 
-	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10)}
+	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10), Values: nil}
 
 	err := keeper.SetNaiveInfererNetworkRegret(ctx, topicId, inferer, regret)
 	s.Require().NoError(err)
@@ -757,7 +785,7 @@ func (s *KeeperTestSuite) TestSetAndGetLatestOneOutInfererInfererNetworkRegret()
 	inferer1 := s.addrsStr[1]
 	inferer2 := s.addrsStr[2]
 
-	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(15)}
+	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(15), Values: nil}
 
 	err := keeper.SetOneOutInfererInfererNetworkRegret(ctx, topicId, inferer1, inferer2, regret)
 	s.Require().NoError(err)
@@ -774,7 +802,7 @@ func (s *KeeperTestSuite) TestSetAndGetLatestOneOutInfererForecasterNetworkRegre
 	inferer := s.addrsStr[1]
 	forecaster := s.addrsStr[3]
 
-	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(20)}
+	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(20), Values: nil}
 
 	err := keeper.SetOneOutInfererForecasterNetworkRegret(ctx, topicId, inferer, forecaster, regret)
 	s.Require().NoError(err)
@@ -791,7 +819,7 @@ func (s *KeeperTestSuite) TestSetAndGetLatestOneOutForecasterInfererNetworkRegre
 	forecaster := s.addrsStr[3]
 	inferer := s.addrsStr[1]
 
-	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(25)}
+	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(25), Values: nil}
 
 	err := keeper.SetOneOutForecasterInfererNetworkRegret(ctx, topicId, forecaster, inferer, regret)
 	s.Require().NoError(err)
@@ -808,7 +836,7 @@ func (s *KeeperTestSuite) TestSetAndGetLatestOneOutForecasterForecasterNetworkRe
 	forecaster1 := s.addrsStr[3]
 	forecaster2 := s.addrsStr[4]
 
-	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(30)}
+	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(30), Values: nil}
 
 	err := keeper.SetOneOutForecasterForecasterNetworkRegret(ctx, topicId, forecaster1, forecaster2, regret)
 	s.Require().NoError(err)
@@ -1201,6 +1229,7 @@ func (s *KeeperTestSuite) TestGetLatestTopicInferences() {
 		Value:       alloraMath.MustNewDecFromString("10"),
 		ExtraData:   []byte("data1"),
 		Proof:       "proof1",
+		Values:      nil,
 	}
 	inferences1 := types.Inferences{
 		Inferences: []*types.Inference{&newInference1},
@@ -1218,6 +1247,7 @@ func (s *KeeperTestSuite) TestGetLatestTopicInferences() {
 		Value:       alloraMath.MustNewDecFromString("20"),
 		ExtraData:   []byte("data2"),
 		Proof:       "proof2",
+		Values:      nil,
 	}
 	inferences2 := types.Inferences{
 		Inferences: []*types.Inference{&newInference2},
@@ -1251,6 +1281,7 @@ func (s *KeeperTestSuite) TestGetWorkerLatestInferenceByTopicId() {
 		Value:       alloraMath.MustNewDecFromString("10"),
 		ExtraData:   []byte("data"),
 		Proof:       "proof123",
+		Values:      nil,
 	}
 	err = keeper.InsertInference(ctx, topicId, newInference1)
 	s.Require().NoError(err, "Inserting inferences should not fail")
@@ -1263,6 +1294,7 @@ func (s *KeeperTestSuite) TestGetWorkerLatestInferenceByTopicId() {
 		Value:       alloraMath.MustNewDecFromString("10"),
 		ExtraData:   []byte("data"),
 		Proof:       "proof123",
+		Values:      nil,
 	}
 	err = keeper.InsertInference(ctx, topicId, newInference2)
 	s.Require().NoError(err, "Inserting inferences should not fail")
@@ -1287,10 +1319,12 @@ func (s *KeeperTestSuite) TestGetForecastsAtBlock() {
 					{
 						Inferer: "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve",
 						Value:   alloraMath.MustNewDecFromString("1"),
+						Values:  nil,
 					},
 					{
 						Inferer: "allo1snm6pxg7p9jetmkhz0jz9ku3vdzmszegy9q5lh",
 						Value:   alloraMath.MustNewDecFromString("2"),
+						Values:  nil,
 					},
 				},
 			},
@@ -1302,10 +1336,12 @@ func (s *KeeperTestSuite) TestGetForecastsAtBlock() {
 					{
 						Inferer: "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve",
 						Value:   alloraMath.MustNewDecFromString("3"),
+						Values:  nil,
 					},
 					{
 						Inferer: "allo1snm6pxg7p9jetmkhz0jz9ku3vdzmszegy9q5lh",
 						Value:   alloraMath.MustNewDecFromString("4"),
+						Values:  nil,
 					},
 				},
 			},
@@ -1344,6 +1380,8 @@ func (s *KeeperTestSuite) TestInsertActiveReputerLosses() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature := s.signValueBundle(valueBundle, s.privKeys[0])
 	reputerLossBundles := types.ReputerValueBundles{
@@ -1399,6 +1437,8 @@ func (s *KeeperTestSuite) TestInsertNetworkLossBundleAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 
 	err := s.emissionsKeeper.InsertNetworkLossBundleAtBlock(ctx, topicId, block, lossBundle)
@@ -1452,6 +1492,8 @@ func (s *KeeperTestSuite) TestGetLatestNetworkLossBundle() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	err = keeper.InsertNetworkLossBundleAtBlock(ctx, topicId, blockHeight1, lossBundle1)
 	s.Require().NoError(err, "Inserting first network loss bundle should not fail")
@@ -1473,6 +1515,8 @@ func (s *KeeperTestSuite) TestGetLatestNetworkLossBundle() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	err = keeper.InsertNetworkLossBundleAtBlock(ctx, topicId, blockHeight2, lossBundle2)
 	s.Require().NoError(err, "Inserting second network loss bundle should not fail")
@@ -1533,6 +1577,43 @@ func (s *KeeperTestSuite) TestAddReputerStake() {
 	s.Require().Equal(initialTotalStake.Add(stakeAmount), totalStake, "Total stake should be incremented by stake amount after addition")
 }
 
+func (s *KeeperTestSuite) TestMoveReputerStake() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	fromTopicId := uint64(1)
+	toTopicId := uint64(2)
+	reputerAddr := s.addrsStr[0]
+	delegatorAddr := s.addrsStr[1]
+
+	err := keeper.AddReputerStake(ctx, fromTopicId, reputerAddr, cosmosMath.NewInt(500))
+	s.Require().NoError(err)
+	err = keeper.AddDelegateStake(ctx, fromTopicId, delegatorAddr, reputerAddr, cosmosMath.NewInt(200))
+	s.Require().NoError(err)
+
+	// Only the reputer's own stake can be moved, not the stake delegated to it
+	err = keeper.MoveReputerStake(ctx, fromTopicId, toTopicId, reputerAddr, cosmosMath.NewInt(501))
+	s.Require().ErrorIs(err, types.ErrIntegerUnderflowTopicReputerStake)
+
+	err = keeper.MoveReputerStake(ctx, fromTopicId, toTopicId, reputerAddr, cosmosMath.NewInt(300))
+	s.Require().NoError(err)
+
+	fromStake, err := keeper.GetStakeReputerAuthority(ctx, fromTopicId, reputerAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(400), fromStake)
+	toStake, err := keeper.GetStakeReputerAuthority(ctx, toTopicId, reputerAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(300), toStake)
+	fromTopicStake, err := keeper.GetTopicStake(ctx, fromTopicId)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(400), fromTopicStake)
+	toTopicStake, err := keeper.GetTopicStake(ctx, toTopicId)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(300), toTopicStake)
+	totalStake, err := keeper.GetTotalStake(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(700), totalStake, "Total stake should not change when stake is moved")
+}
+
 func (s *KeeperTestSuite) TestAddDelegateStake() {
 	ctx := s.ctx
 	keeper := s.emissionsKeeper
@@ -2585,23 +2666,35 @@ func (s *KeeperTestSuite) TestAddTopicFeeRevenue() {
 	block := int64(100)
 
 	newTopic := types.Topic{
-		Creator:                  s.addrs[0].String(),
-		Id:                       topicId,
-		Metadata:                 "metadata",
-		LossMethod:               "LOSS",
-		EpochLastEnded:           10,
-		EpochLength:              20,
-		GroundTruthLag:           20,
-		PNorm:                    alloraMath.MustNewDecFromString("3.5"),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.5"),
-		AllowNegative:            false,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.5"),
-		InitialRegret:            alloraMath.MustNewDecFromString("0.5"),
-		WorkerSubmissionWindow:   20,
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.5"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.5"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.5"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.5"),
+		Creator:                       s.addrs[0].String(),
+		Id:                            topicId,
+		Metadata:                      "metadata",
+		LossMethod:                    "LOSS",
+		EpochLastEnded:                10,
+		EpochLength:                   20,
+		GroundTruthLag:                20,
+		PNorm:                         alloraMath.MustNewDecFromString("3.5"),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.5"),
+		AllowNegative:                 false,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.5"),
+		InitialRegret:                 alloraMath.MustNewDecFromString("0.5"),
+		WorkerSubmissionWindow:        20,
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.5"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.5"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.5"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.5"),
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	err := keeper.SetTopic(ctx, topicId, newTopic)
 	s.Require().NoError(err, "Setting a new topic should not fail")
@@ -3237,10 +3330,12 @@ func (s *KeeperTestSuite) TestPruneRecordsAfterRewards() {
 					{
 						Inferer: s.addrsStr[0],
 						Value:   alloraMath.MustNewDecFromString("1"),
+						Values:  nil,
 					},
 					{
 						Inferer: s.addrsStr[1],
 						Value:   alloraMath.MustNewDecFromString("2"),
+						Values:  nil,
 					},
 				},
 			},
@@ -3252,10 +3347,12 @@ func (s *KeeperTestSuite) TestPruneRecordsAfterRewards() {
 					{
 						Inferer: s.addrsStr[0],
 						Value:   alloraMath.MustNewDecFromString("3"),
+						Values:  nil,
 					},
 					{
 						Inferer: s.addrsStr[1],
 						Value:   alloraMath.MustNewDecFromString("4"),
+						Values:  nil,
 					},
 				},
 			},
@@ -3286,6 +3383,8 @@ func (s *KeeperTestSuite) TestPruneRecordsAfterRewards() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	err = s.emissionsKeeper.InsertNetworkLossBundleAtBlock(s.ctx, topicId, block, networkLosses)
 	s.Require().NoError(err, "InsertNetworkLossBundleAtBlock should not return an error")
@@ -3755,6 +3854,7 @@ func (s *KeeperTestSuite) TestAppendInference() {
 		Value:       alloraMath.MustNewDecFromString("0.52"),
 		ExtraData:   nil,
 		Proof:       "",
+		Values:      nil,
 	}
 	err = k.AppendInference(ctx, topic, nonce.BlockHeight, &newInference, params.MaxTopInferersToReward)
 	s.Require().NoError(err)
@@ -3770,6 +3870,7 @@ func (s *KeeperTestSuite) TestAppendInference() {
 		Value:       alloraMath.MustNewDecFromString("0.52"),
 		ExtraData:   nil,
 		Proof:       "",
+		Values:      nil,
 	}
 
 	err = k.AppendInference(ctx, topic, nonce.BlockHeight, &newInference2, params.MaxTopInferersToReward)
@@ -3822,6 +3923,7 @@ func (s *KeeperTestSuite) TestAppendInference() {
 		Value:       alloraMath.MustNewDecFromString("0.52"),
 		ExtraData:   nil,
 		Proof:       "",
+		Values:      nil,
 	}
 	err = k.AppendInference(ctx, topic, nonce.BlockHeight, &newInference2, params.MaxTopInferersToReward)
 	s.Require().Error(err, types.ErrCantUpdateEmaMoreThanOncePerWindow.Error())
@@ -4002,6 +4104,18 @@ func mockUninitializedParams() types.Params {
 		GlobalReputerWhitelistEnabled:       true,
 		GlobalAdminWhitelistAppended:        true,
 		MaxWhitelistInputArrayLength:        uint64(10),
+		MaxArchivedTopicPrunesPerBlock:      uint64(100),
+		MaxReputerStakeShare:                alloraMath.MustNewDecFromString("0.5"),
+		StakeAboveCapExponent:               alloraMath.MustNewDecFromString("0.5"),
+		StakeLockTiers: []types.StakeLockTier{
+			{LockMonths: 12, RewardMultiplier: alloraMath.MustNewDecFromString("2")},
+		},
+		RewardHistoryRetentionBlocks:   int64(1000),
+		MaxRewardPayoutsPerBlock:       uint64(100),
+		MaxRewardHistoryPrunesPerBlock: uint64(100),
+		MaxReputerCommissionRate:       alloraMath.MustNewDecFromString("0.2"),
+		MaxReputerCommissionChangeRate: alloraMath.MustNewDecFromString("0.01"),
+		MaxRewardPayoutAttempts:        uint64(5),
 	}
 }
 
@@ -4049,10 +4163,12 @@ func (s *KeeperTestSuite) TestAppendForecast() {
 					{
 						Inferer: worker1,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 					{
 						Inferer: worker2,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 				},
 			},
@@ -4064,10 +4180,12 @@ func (s *KeeperTestSuite) TestAppendForecast() {
 					{
 						Inferer: worker1,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 					{
 						Inferer: worker2,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 				},
 			},
@@ -4079,10 +4197,12 @@ func (s *KeeperTestSuite) TestAppendForecast() {
 					{
 						Inferer: worker1,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 					{
 						Inferer: worker2,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 				},
 			},
@@ -4094,10 +4214,12 @@ func (s *KeeperTestSuite) TestAppendForecast() {
 					{
 						Inferer: worker1,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 					{
 						Inferer: worker2,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 				},
 			},
@@ -4124,10 +4246,12 @@ func (s *KeeperTestSuite) TestAppendForecast() {
 			{
 				Inferer: worker1,
 				Value:   alloraMath.MustNewDecFromString("0.52"),
+				Values:  nil,
 			},
 			{
 				Inferer: worker2,
 				Value:   alloraMath.MustNewDecFromString("0.52"),
+				Values:  nil,
 			},
 		},
 		ExtraData: nil,
@@ -4186,10 +4310,12 @@ func (s *KeeperTestSuite) TestAppendForecastWithResetActiveForecasters() {
 					{
 						Inferer: worker1,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 					{
 						Inferer: worker2,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 				},
 			},
@@ -4201,10 +4327,12 @@ func (s *KeeperTestSuite) TestAppendForecastWithResetActiveForecasters() {
 					{
 						Inferer: worker1,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 					{
 						Inferer: worker2,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 				},
 			},
@@ -4216,10 +4344,12 @@ func (s *KeeperTestSuite) TestAppendForecastWithResetActiveForecasters() {
 					{
 						Inferer: worker1,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 					{
 						Inferer: worker2,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 				},
 			},
@@ -4231,10 +4361,12 @@ func (s *KeeperTestSuite) TestAppendForecastWithResetActiveForecasters() {
 					{
 						Inferer: worker1,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 					{
 						Inferer: worker2,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 				},
 			},
@@ -4246,10 +4378,12 @@ func (s *KeeperTestSuite) TestAppendForecastWithResetActiveForecasters() {
 					{
 						Inferer: worker1,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 					{
 						Inferer: worker2,
 						Value:   alloraMath.MustNewDecFromString("0.52"),
+						Values:  nil,
 					},
 				},
 			},
@@ -4339,6 +4473,8 @@ func (s *KeeperTestSuite) TestAppendReputerLoss() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature := s.signValueBundle(&valueBundleReputer1, s.privKeys[0])
 	reputerValueBundle1 := types.ReputerValueBundle{
@@ -4359,6 +4495,8 @@ func (s *KeeperTestSuite) TestAppendReputerLoss() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature = s.signValueBundle(&valueBundleReputer2, s.privKeys[1])
 	reputerValueBundle2 := types.ReputerValueBundle{
@@ -4379,6 +4517,8 @@ func (s *KeeperTestSuite) TestAppendReputerLoss() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature = s.signValueBundle(&valueBundleReputer3, s.privKeys[2])
 	reputerValueBundle3 := types.ReputerValueBundle{
@@ -4415,6 +4555,8 @@ func (s *KeeperTestSuite) TestAppendReputerLoss() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature = s.signValueBundle(&valueBundleReputer4, s.privKeys[3])
 	reputerValueBundle4 := types.ReputerValueBundle{
@@ -4442,6 +4584,8 @@ func (s *KeeperTestSuite) TestAppendReputerLoss() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature = s.signValueBundle(&valueBundleReputer5, s.privKeys[4])
 	reputerValueBundle5 := types.ReputerValueBundle{
@@ -4506,6 +4650,8 @@ func (s *KeeperTestSuite) TestAppendReputerLossWithResetActiveReputers() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature := s.signValueBundle(&valueBundleReputer1, s.privKeys[0])
 	reputerValueBundle1 := types.ReputerValueBundle{
@@ -4526,6 +4672,8 @@ func (s *KeeperTestSuite) TestAppendReputerLossWithResetActiveReputers() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature = s.signValueBundle(&valueBundleReputer2, s.privKeys[1])
 	reputerValueBundle2 := types.ReputerValueBundle{
@@ -4546,6 +4694,8 @@ func (s *KeeperTestSuite) TestAppendReputerLossWithResetActiveReputers() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature = s.signValueBundle(&valueBundleReputer3, s.privKeys[2])
 	reputerValueBundle3 := types.ReputerValueBundle{
@@ -4566,6 +4716,8 @@ func (s *KeeperTestSuite) TestAppendReputerLossWithResetActiveReputers() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature = s.signValueBundle(&valueBundleReputer4, s.privKeys[3])
 	reputerValueBundle4 := types.ReputerValueBundle{
@@ -4586,6 +4738,8 @@ func (s *KeeperTestSuite) TestAppendReputerLossWithResetActiveReputers() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature = s.signValueBundle(&valueBundleReputer5, s.privKeys[4])
 	reputerValueBundle5 := types.ReputerValueBundle{
@@ -4866,6 +5020,8 @@ func (s *KeeperTestSuite) TestRemoveReputerLoss() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature := s.signValueBundle(valueBundle, s.privKeys[0])
 	reputerLossBundle := types.ReputerValueBundle{
@@ -4907,10 +5063,12 @@ func (s *KeeperTestSuite) TestRemoveForecast() {
 			{
 				Inferer: "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve",
 				Value:   alloraMath.MustNewDecFromString("1"),
+				Values:  nil,
 			},
 			{
 				Inferer: "allo1snm6pxg7p9jetmkhz0jz9ku3vdzmszegy9q5lh",
 				Value:   alloraMath.MustNewDecFromString("2"),
+				Values:  nil,
 			},
 		},
 		ExtraData: []byte("data"),
@@ -4948,6 +5106,7 @@ func (s *KeeperTestSuite) TestRemoveInference() {
 		Inferer:     inferer,
 		ExtraData:   []byte("data"),
 		Proof:       "",
+		Values:      nil,
 	}
 
 	// Insert the inference
@@ -5260,6 +5419,7 @@ func (s *KeeperTestSuite) TestInitialEmaScoreSettingInAppendInference() {
 		Inferer:     worker,
 		ExtraData:   nil,
 		Proof:       "",
+		Values:      nil,
 	}
 
 	topic, err := k.GetTopic(ctx, topicId)
@@ -5299,6 +5459,7 @@ func (s *KeeperTestSuite) TestInitialEmaScoreSettingInAppendForecast() {
 			{
 				Inferer: s.addrsStr[1],
 				Value:   alloraMath.MustNewDecFromString("0.52"),
+				Values:  nil,
 			},
 		},
 		ExtraData: nil,
@@ -5348,6 +5509,8 @@ func (s *KeeperTestSuite) TestInitialEmaScoreSettingInAppendReputer() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature := s.signValueBundle(valueBundle, s.privKeys[0])
 	reputerValueBundle := &types.ReputerValueBundle{
@@ -5416,6 +5579,7 @@ func (s *KeeperTestSuite) TestFirstSubmissionDoesNotUpdateEMAUsingQuantile() {
 		Value:       alloraMath.NewDecFromInt64(100),
 		ExtraData:   nil,
 		Proof:       "",
+		Values:      nil,
 	}
 
 	topic, err := k.GetTopic(ctx, topicId)
@@ -5462,6 +5626,7 @@ func (s *KeeperTestSuite) TestLivenessPenaltyAppliedInAppendInference() {
 		Inferer:     worker,
 		ExtraData:   nil,
 		Proof:       "",
+		Values:      nil,
 	}
 
 	topic, err := k.GetTopic(ctx, topic.Id)
@@ -5514,6 +5679,7 @@ func (s *KeeperTestSuite) TestLivenessPenaltyAppliedInAppendForecast() {
 			{
 				Inferer: worker,
 				Value:   alloraMath.MustNewDecFromString("0.52"),
+				Values:  nil,
 			},
 		},
 		ExtraData: nil,
@@ -5578,6 +5744,8 @@ func (s *KeeperTestSuite) TestLivenessPenaltyAppliedInAppendReputerLoss() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature := s.signValueBundle(&valueBundleReputer, s.privKeys[0])
 	reputerValueBundle := types.ReputerValueBundle{
@@ -1,6 +1,10 @@
 package msgserver
 
 import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/x/emissions/keeper"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	"github.com/gogo/protobuf/proto"
@@ -27,3 +31,35 @@ func checkInputLength(maxSerializedMsgLength int64, msg proto.Message) error {
 
 	return nil
 }
+
+// Archived topics are retired, so no new activity may be started on them
+func checkTopicIsNotArchived(ctx context.Context, ms msgServer, topicId uint64) error {
+	isArchived, err := ms.k.IsTopicArchived(ctx, topicId)
+	if err != nil {
+		return err
+	} else if isArchived {
+		return types.ErrTopicArchived
+	}
+	return nil
+}
+
+// Locked stake cannot leave its position until its lock ends, so taking the amount out of the
+// available stake of the position must leave the stake that is still locked in place
+func checkStakeIsNotLocked(
+	ctx context.Context,
+	ms msgServer,
+	topicId uint64,
+	reputer string,
+	delegator string,
+	available cosmosMath.Int,
+	amount cosmosMath.Int,
+) error {
+	locked, err := ms.k.GetLockedStake(ctx, topicId, reputer, delegator)
+	if err != nil {
+		return err
+	}
+	if amount.GT(available.Sub(locked)) {
+		return errorsmod.Wrapf(types.ErrStakeLocked, "%s of the stake is locked", locked)
+	}
+	return nil
+}
@@ -30,6 +30,10 @@ func (ms msgServer) FundTopic(ctx context.Context, msg *types.FundTopicRequest)
 		return nil, err
 	}
 
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
 	err = sendEffectiveRevenueActivateTopicIfWeightSufficient(ctx, ms, msg.Sender, msg.TopicId, msg.Amount)
 	return &types.FundTopicResponse{}, err
 }
@@ -0,0 +1,107 @@
+package msgserver
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Authorizes an operator key to submit payloads on behalf of the sender in a topic.
+// The sender must be registered in the topic as a worker or a reputer.
+func (ms msgServer) AuthorizeOperator(ctx context.Context, msg *types.AuthorizeOperatorRequest) (_ *types.AuthorizeOperatorResponse, err error) {
+	defer metrics.RecordMetrics("AuthorizeOperator", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := checkOperatorCanBeAuthorized(ctx, ms, msg.TopicId, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &types.AuthorizeOperatorResponse{}, ms.k.AuthorizeOperator(ctx, msg.TopicId, msg.Sender, msg.Operator)
+}
+
+// Revokes an operator key of the sender in a topic. Revoking is allowed
+// even after the sender has removed its registration or the topic is archived.
+func (ms msgServer) RevokeOperator(ctx context.Context, msg *types.RevokeOperatorRequest) (_ *types.RevokeOperatorResponse, err error) {
+	defer metrics.RecordMetrics("RevokeOperator", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &types.RevokeOperatorResponse{}, ms.k.RevokeOperator(ctx, msg.TopicId, msg.Sender, msg.Operator)
+}
+
+// Replaces an operator key of the sender in a topic with a new one, so that
+// there is no block in which neither key is authorized
+func (ms msgServer) RotateOperator(ctx context.Context, msg *types.RotateOperatorRequest) (_ *types.RotateOperatorResponse, err error) {
+	defer metrics.RecordMetrics("RotateOperator", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := checkOperatorCanBeAuthorized(ctx, ms, msg.TopicId, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	err = ms.k.RevokeOperator(ctx, msg.TopicId, msg.Sender, msg.OldOperator)
+	if err != nil {
+		return nil, err
+	}
+	err = ms.k.AuthorizeOperator(ctx, msg.TopicId, msg.Sender, msg.NewOperator)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RotateOperatorResponse{}, nil
+}
+
+// Operators can only be authorized by actors registered in a topic that is not archived
+func checkOperatorCanBeAuthorized(ctx context.Context, ms msgServer, topicId uint64, actor string) error {
+	topicExists, err := ms.k.TopicExists(ctx, topicId)
+	if err != nil {
+		return err
+	} else if !topicExists {
+		return types.ErrInvalidTopicId
+	}
+	if err := checkTopicIsNotArchived(ctx, ms, topicId); err != nil {
+		return err
+	}
+
+	isWorker, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, actor)
+	if err != nil {
+		return err
+	}
+	isReputer, err := ms.k.IsReputerRegisteredInTopic(ctx, topicId, actor)
+	if err != nil {
+		return err
+	}
+	if !isWorker && !isReputer {
+		return errorsmod.Wrap(types.ErrAddressNotRegistered, "only actors registered in the topic can authorize operators")
+	}
+	return nil
+}
+
+// Payloads of an actor must be signed by the actor itself or by one of its operators in the topic
+func checkSignerIsAuthorized(ctx context.Context, ms msgServer, topicId uint64, actor string, signer string) error {
+	authorized, err := ms.k.IsAuthorizedSigner(ctx, topicId, actor, signer)
+	if err != nil {
+		return err
+	} else if !authorized {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither %s nor one of its operators", signer, actor)
+	}
+	return nil
+}
+
+// The bundle of a worker must be signed by the worker itself or by one of its operators in the topic
+func checkWorkerDataBundleSigner(ctx context.Context, ms msgServer, bundle *types.WorkerDataBundle) error {
+	signer, err := bundle.Signer()
+	if err != nil {
+		return err
+	}
+	return checkSignerIsAuthorized(ctx, ms, bundle.TopicId, bundle.Worker, signer)
+}
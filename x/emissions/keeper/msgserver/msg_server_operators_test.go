@@ -0,0 +1,142 @@
+package msgserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadSignedByOperator() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	operatorPrivateKey := secp256k1.GenPrivKey()
+	operator := sdk.AccAddress(operatorPrivateKey.PubKey().Address()).String()
+
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	worker := workerMsg.WorkerDataBundle.Worker
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, operatorPrivateKey)
+	ctx = ctx.WithBlockHeight(workerMsg.WorkerDataBundle.Nonce.BlockHeight)
+
+	err := s.emissionsKeeper.AddToTopicWorkerWhitelist(ctx, topicId, worker)
+	require.NoError(err)
+
+	// The operator is not authorized yet
+	_, err = msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.AuthorizeOperator(ctx, &types.AuthorizeOperatorRequest{
+		Sender:   worker,
+		TopicId:  topicId,
+		Operator: operator,
+	})
+	require.NoError(err)
+
+	_, err = msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+
+	// The payload counts as coming from the worker
+	inference, err := s.emissionsKeeper.GetWorkerLatestInferenceByTopicId(ctx, topicId, worker)
+	require.NoError(err)
+	require.Equal(worker, inference.Inferer)
+
+	_, err = msgServer.RevokeOperator(ctx, &types.RevokeOperatorRequest{
+		Sender:   worker,
+		TopicId:  topicId,
+		Operator: operator,
+	})
+	require.NoError(err)
+
+	_, err = msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertReputerGroundTruthSubmittedByOperator() {
+	require := s.Require()
+	reputerAddr := s.addrs[0]
+	reputer := reputerAddr.String()
+	operatorAddr, operator := getNewAddress()
+
+	_, topic := s.setUpChainComputedLossesTopic(reputerAddr, s.addrs[1])
+	s.MintTokensToAddress(operatorAddr, cosmosMath.NewInt(1000000000000000000))
+
+	groundTruthMsg := &types.InsertReputerGroundTruthRequest{
+		Sender:              operator,
+		TopicId:             topic.Id,
+		ReputerRequestNonce: &types.ReputerRequestNonce{ReputerNonce: &types.Nonce{BlockHeight: block}},
+		Values:              []alloraMath.Dec{alloraMath.MustNewDecFromString("1.5")},
+		Reputer:             reputer,
+	}
+	_, err := s.msgServer.InsertReputerGroundTruth(s.ctx, groundTruthMsg)
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.msgServer.AuthorizeOperator(s.ctx, &types.AuthorizeOperatorRequest{
+		Sender:   reputer,
+		TopicId:  topic.Id,
+		Operator: operator,
+	})
+	require.NoError(err)
+
+	_, err = s.msgServer.InsertReputerGroundTruth(s.ctx, groundTruthMsg)
+	require.NoError(err)
+
+	stored, err := s.emissionsKeeper.GetReputerLatestGroundTruthByTopicId(s.ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.Equal(reputer, stored.Reputer)
+}
+
+func (s *MsgServerTestSuite) TestMsgAuthorizeOperatorRequiresRegistration() {
+	topic := s.CreateOneTopic()
+
+	_, err := s.msgServer.AuthorizeOperator(s.ctx, &types.AuthorizeOperatorRequest{
+		Sender:   s.addrsStr[0],
+		TopicId:  topic.Id,
+		Operator: s.addrsStr[1],
+	})
+	s.Require().ErrorIs(err, types.ErrAddressNotRegistered)
+
+	// An actor cannot authorize itself
+	_, err = s.msgServer.AuthorizeOperator(s.ctx, &types.AuthorizeOperatorRequest{
+		Sender:   s.addrsStr[0],
+		TopicId:  topic.Id,
+		Operator: s.addrsStr[0],
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (s *MsgServerTestSuite) TestMsgRotateOperator() {
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	worker := workerMsg.WorkerDataBundle.Worker
+	_, oldOperator := getNewAddress()
+	_, newOperator := getNewAddress()
+
+	rotateMsg := &types.RotateOperatorRequest{
+		Sender:      worker,
+		TopicId:     topicId,
+		OldOperator: oldOperator,
+		NewOperator: newOperator,
+	}
+	_, err := s.msgServer.RotateOperator(s.ctx, rotateMsg)
+	require.ErrorIs(err, types.ErrOperatorNotAuthorized)
+
+	_, err = s.msgServer.AuthorizeOperator(s.ctx, &types.AuthorizeOperatorRequest{
+		Sender:   worker,
+		TopicId:  topicId,
+		Operator: oldOperator,
+	})
+	require.NoError(err)
+
+	_, err = s.msgServer.RotateOperator(s.ctx, rotateMsg)
+	require.NoError(err)
+
+	operators, err := s.emissionsKeeper.GetOperatorsOfActor(s.ctx, topicId, worker)
+	require.NoError(err)
+	require.Equal([]string{newOperator}, operators)
+}
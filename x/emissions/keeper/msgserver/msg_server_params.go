@@ -186,6 +186,36 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.UpdateParamsReq
 	if len(newParams.MaxWhitelistInputArrayLength) == 1 {
 		existingParams.MaxWhitelistInputArrayLength = newParams.MaxWhitelistInputArrayLength[0]
 	}
+	if len(newParams.MaxArchivedTopicPrunesPerBlock) == 1 {
+		existingParams.MaxArchivedTopicPrunesPerBlock = newParams.MaxArchivedTopicPrunesPerBlock[0]
+	}
+	if len(newParams.MaxReputerStakeShare) == 1 {
+		existingParams.MaxReputerStakeShare = newParams.MaxReputerStakeShare[0]
+	}
+	if len(newParams.StakeAboveCapExponent) == 1 {
+		existingParams.StakeAboveCapExponent = newParams.StakeAboveCapExponent[0]
+	}
+	if len(newParams.StakeLockTiers) == 1 {
+		existingParams.StakeLockTiers = newParams.StakeLockTiers[0].Tiers
+	}
+	if len(newParams.RewardHistoryRetentionBlocks) == 1 {
+		existingParams.RewardHistoryRetentionBlocks = newParams.RewardHistoryRetentionBlocks[0]
+	}
+	if len(newParams.MaxRewardPayoutsPerBlock) == 1 {
+		existingParams.MaxRewardPayoutsPerBlock = newParams.MaxRewardPayoutsPerBlock[0]
+	}
+	if len(newParams.MaxRewardHistoryPrunesPerBlock) == 1 {
+		existingParams.MaxRewardHistoryPrunesPerBlock = newParams.MaxRewardHistoryPrunesPerBlock[0]
+	}
+	if len(newParams.MaxReputerCommissionRate) == 1 {
+		existingParams.MaxReputerCommissionRate = newParams.MaxReputerCommissionRate[0]
+	}
+	if len(newParams.MaxReputerCommissionChangeRate) == 1 {
+		existingParams.MaxReputerCommissionChangeRate = newParams.MaxReputerCommissionChangeRate[0]
+	}
+	if len(newParams.MaxRewardPayoutAttempts) == 1 {
+		existingParams.MaxRewardPayoutAttempts = newParams.MaxRewardPayoutAttempts[0]
+	}
 	err = existingParams.Validate()
 	if err != nil {
 		return nil, err
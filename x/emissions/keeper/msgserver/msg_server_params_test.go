@@ -73,6 +73,18 @@ func (s *MsgServerTestSuite) TestUpdateAllParams() {
 		GlobalReputerWhitelistEnabled:       []bool{true},
 		GlobalAdminWhitelistAppended:        []bool{true},
 		MaxWhitelistInputArrayLength:        []uint64{10},
+		MaxArchivedTopicPrunesPerBlock:      []uint64{100},
+		MaxReputerStakeShare:                []alloraMath.Dec{alloraMath.MustNewDecFromString("0.3")},
+		StakeAboveCapExponent:               []alloraMath.Dec{alloraMath.MustNewDecFromString("0.5")},
+		StakeLockTiers: []*types.StakeLockTiers{{Tiers: []types.StakeLockTier{
+			{LockMonths: 12, RewardMultiplier: alloraMath.MustNewDecFromString("2")},
+		}}},
+		RewardHistoryRetentionBlocks:   []int64{1000},
+		MaxRewardPayoutsPerBlock:       []uint64{100},
+		MaxRewardHistoryPrunesPerBlock: []uint64{100},
+		MaxReputerCommissionRate:       []alloraMath.Dec{alloraMath.MustNewDecFromString("0.2")},
+		MaxReputerCommissionChangeRate: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.01")},
+		MaxRewardPayoutAttempts:        []uint64{5},
 	}
 
 	updateMsg := &types.UpdateParamsRequest{
@@ -140,6 +152,16 @@ func (s *MsgServerTestSuite) TestUpdateAllParams() {
 	require.Equal(newParams.GlobalReputerWhitelistEnabled[0], updatedParams.GlobalReputerWhitelistEnabled)
 	require.Equal(newParams.GlobalAdminWhitelistAppended[0], updatedParams.GlobalAdminWhitelistAppended)
 	require.Equal(newParams.MaxWhitelistInputArrayLength[0], updatedParams.MaxWhitelistInputArrayLength)
+	require.Equal(newParams.MaxArchivedTopicPrunesPerBlock[0], updatedParams.MaxArchivedTopicPrunesPerBlock)
+	require.Equal(newParams.MaxReputerStakeShare[0], updatedParams.MaxReputerStakeShare)
+	require.Equal(newParams.StakeAboveCapExponent[0], updatedParams.StakeAboveCapExponent)
+	require.Equal(newParams.StakeLockTiers[0].Tiers, updatedParams.StakeLockTiers)
+	require.Equal(newParams.RewardHistoryRetentionBlocks[0], updatedParams.RewardHistoryRetentionBlocks)
+	require.Equal(newParams.MaxRewardPayoutsPerBlock[0], updatedParams.MaxRewardPayoutsPerBlock)
+	require.Equal(newParams.MaxRewardHistoryPrunesPerBlock[0], updatedParams.MaxRewardHistoryPrunesPerBlock)
+	require.Equal(newParams.MaxReputerCommissionRate[0], updatedParams.MaxReputerCommissionRate)
+	require.Equal(newParams.MaxReputerCommissionChangeRate[0], updatedParams.MaxReputerCommissionChangeRate)
+	require.Equal(newParams.MaxRewardPayoutAttempts[0], updatedParams.MaxRewardPayoutAttempts)
 }
 
 func (s *MsgServerTestSuite) TestUpdateParamsNonWhitelistedUser() {
@@ -206,6 +228,16 @@ func (s *MsgServerTestSuite) TestUpdateParamsNonWhitelistedUser() {
 		GlobalReputerWhitelistEnabled:       nil,
 		GlobalAdminWhitelistAppended:        nil,
 		MaxWhitelistInputArrayLength:        nil,
+		MaxArchivedTopicPrunesPerBlock:      nil,
+		MaxReputerStakeShare:                nil,
+		StakeAboveCapExponent:               nil,
+		StakeLockTiers:                      nil,
+		RewardHistoryRetentionBlocks:        nil,
+		MaxRewardPayoutsPerBlock:            nil,
+		MaxRewardHistoryPrunesPerBlock:      nil,
+		MaxReputerCommissionRate:            nil,
+		MaxReputerCommissionChangeRate:      nil,
+		MaxRewardPayoutAttempts:             nil,
 	}
 
 	// Creating the UpdateParamsRequest message with a non-whitelisted user
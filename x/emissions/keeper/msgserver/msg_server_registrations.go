@@ -29,6 +29,10 @@ func (ms msgServer) Register(ctx context.Context, msg *types.RegisterRequest) (_
 		return nil, types.ErrTopicDoesNotExist
 	}
 
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
 	if msg.IsReputer {
 		isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
 		if err != nil {
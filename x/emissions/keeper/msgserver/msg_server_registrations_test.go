@@ -306,22 +306,35 @@ func (s *MsgServerTestSuite) TestBlocklistedAddressUnableToRegister() {
 	s.MintTokensToAddress(worker, cosmosMath.NewInt(10).Mul(cosmosOneE18))
 	// Create topic
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  worker.String(),
-		Metadata:                 "test",
-		LossMethod:               "mse",
-		EpochLength:              epochLength,
-		GroundTruthLag:           epochLength,
-		WorkerSubmissionWindow:   10,
-		AllowNegative:            false,
-		AlphaRegret:              alloraMath.NewDecFromInt64(1),
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       worker.String(),
+		Metadata:                      "test",
+		LossMethod:                    "mse",
+		EpochLength:                   epochLength,
+		GroundTruthLag:                epochLength,
+		WorkerSubmissionWindow:        10,
+		AllowNegative:                 false,
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
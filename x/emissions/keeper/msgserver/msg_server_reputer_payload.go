@@ -23,6 +23,14 @@ func (ms msgServer) InsertReputerPayload(ctx context.Context, msg *types.InsertR
 		return nil, errorsmod.Wrapf(err,
 			"Reputer invalid data for block: %d", blockHeight)
 	}
+	signer, err := msg.ReputerValueBundle.Signer()
+	if err != nil {
+		return nil, err
+	}
+	err = checkSignerIsAuthorized(ctx, ms, msg.ReputerValueBundle.ValueBundle.TopicId, msg.ReputerValueBundle.ValueBundle.Reputer, signer)
+	if err != nil {
+		return nil, err
+	}
 
 	canSubmit, err := ms.k.CanSubmitReputerPayload(ctx, msg.ReputerValueBundle.ValueBundle.TopicId, msg.ReputerValueBundle.ValueBundle.Reputer)
 	if err != nil {
@@ -48,6 +56,16 @@ func (ms msgServer) InsertReputerPayload(ctx context.Context, msg *types.InsertR
 		return nil, types.ErrInvalidTopicId
 	}
 
+	if err := checkTopicIsNotArchived(ctx, ms, topicId); err != nil {
+		return nil, err
+	}
+	if topic.ComputesLossesOnChain() {
+		return nil, errorsmod.Wrap(types.ErrLossComputationModeMismatch, "topic losses are computed by the chain, submit the ground truth instead")
+	}
+	if err := topic.ValidateValueBundleOutput(msg.ReputerValueBundle.ValueBundle); err != nil {
+		return nil, err
+	}
+
 	workerNonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, topicId, nonce.ReputerNonce)
 	if err != nil {
 		return nil, err
@@ -99,3 +117,111 @@ func (ms msgServer) InsertReputerPayload(ctx context.Context, msg *types.InsertR
 
 	return &types.InsertReputerPayloadResponse{}, err
 }
+
+// A tx function that accepts the ground truth of a reputer for a topic whose losses are computed by the chain
+func (ms msgServer) InsertReputerGroundTruth(ctx context.Context, msg *types.InsertReputerGroundTruthRequest) (_ *types.InsertReputerGroundTruthResponse, err error) {
+	defer metrics.RecordMetrics("InsertReputerGroundTruth", time.Now(), &err)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	blockHeight := sdkCtx.BlockHeight()
+	err = msg.Validate()
+	if err != nil {
+		return nil, errorsmod.Wrapf(err,
+			"Reputer invalid ground truth for block: %d", blockHeight)
+	}
+	reputer := msg.SubmittingReputer()
+	if err := checkSignerIsAuthorized(ctx, ms, msg.TopicId, reputer, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	canSubmit, err := ms.k.CanSubmitReputerPayload(ctx, msg.TopicId, reputer)
+	if err != nil {
+		return nil, err
+	} else if !canSubmit {
+		return nil, types.ErrNotPermittedToSubmitReputerPayload
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "Error getting params for reputer: %v", reputer)
+	}
+	err = checkInputLength(moduleParams.MaxSerializedMsgLength, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := msg.ReputerRequestNonce
+	topicId := msg.TopicId
+
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return nil, types.ErrInvalidTopicId
+	}
+
+	if err := checkTopicIsNotArchived(ctx, ms, topicId); err != nil {
+		return nil, err
+	}
+	if !topic.ComputesLossesOnChain() {
+		return nil, errorsmod.Wrap(types.ErrLossComputationModeMismatch, "topic losses are computed by reputers, submit a value bundle instead")
+	}
+	if err := topic.ValidateGroundTruth(msg.Values); err != nil {
+		return nil, err
+	}
+
+	workerNonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, topicId, nonce.ReputerNonce)
+	if err != nil {
+		return nil, err
+	} else if workerNonceUnfulfilled {
+		return nil, errorsmod.Wrapf(types.ErrNonceStillUnfulfilled, "worker nonce")
+	}
+
+	reputerNonceUnfulfilled, err := ms.k.IsReputerNonceUnfulfilled(ctx, topicId, nonce.ReputerNonce)
+	if err != nil {
+		return nil, err
+	} else if !reputerNonceUnfulfilled {
+		return nil, errorsmod.Wrapf(types.ErrUnfulfilledNonceNotFound, "reputer nonce")
+	}
+
+	if !ms.k.BlockWithinReputerSubmissionWindowOfNonce(topic, *nonce, blockHeight) {
+		return nil, errorsmod.Wrapf(
+			types.ErrReputerNonceWindowNotAvailable,
+			"Reputer window not open for topic: %d, current block %d, start window: %d, end window: %d",
+			topicId, blockHeight, nonce.ReputerNonce.BlockHeight+topic.GroundTruthLag, nonce.ReputerNonce.BlockHeight+topic.GroundTruthLag+topic.EpochLength*2,
+		)
+	}
+
+	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, topicId, reputer)
+	if err != nil {
+		return nil, err
+	} else if !isRegistered {
+		return nil, errorsmod.Wrapf(types.ErrAddressNotRegistered, "reputer is not registered in this topic")
+	}
+
+	// Check that the reputer enough stake in the topic
+	stake, err := ms.k.GetStakeReputerAuthority(ctx, topicId, reputer)
+	if err != nil {
+		return nil, err
+	}
+	if stake.LT(moduleParams.RequiredMinimumStake) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientStake, "reputer does not have sufficient stake in the topic")
+	}
+
+	// Before accepting data, transfer fee amount from sender to ecosystem bucket
+	err = sendEffectiveRevenueActivateTopicIfWeightSufficient(ctx, ms, msg.Sender, topicId, moduleParams.DataSendingFee)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.AppendReputerGroundTruth(sdkCtx, topic, moduleParams, types.GroundTruth{
+		TopicId:     topicId,
+		BlockHeight: nonce.ReputerNonce.BlockHeight,
+		Reputer:     reputer,
+		Values:      msg.Values,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.InsertReputerGroundTruthResponse{}, err
+}
@@ -76,6 +76,7 @@ func (s *MsgServerTestSuite) setUpMsgReputerPayload(
 					{
 						Inferer: workerAddr.String(),
 						Value:   alloraMath.NewDecFromInt64(1),
+						Values:  nil,
 					},
 				},
 			},
@@ -92,12 +93,14 @@ func (s *MsgServerTestSuite) setUpMsgReputerPayload(
 			{
 				Worker: workerAddr.String(),
 				Value:  alloraMath.NewDecFromInt64(100),
+				Values: nil,
 			},
 		},
 		ForecasterValues: []*types.WorkerAttributedValue{
 			{
 				Worker: workerAddr.String(),
 				Value:  alloraMath.NewDecFromInt64(100),
+				Values: nil,
 			},
 		},
 		NaiveValue:          alloraMath.NewDecFromInt64(100),
@@ -106,15 +109,19 @@ func (s *MsgServerTestSuite) setUpMsgReputerPayload(
 			{
 				Worker: workerAddr.String(),
 				Value:  alloraMath.NewDecFromInt64(100),
+				Values: nil,
 			},
 		},
 		OneInForecasterValues: []*types.WorkerAttributedValue{
 			{
 				Worker: workerAddr.String(),
 				Value:  alloraMath.NewDecFromInt64(100),
+				Values: nil,
 			},
 		},
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 
 	return reputerValueBundle, expectedInferences, expectedForecasts, topicId
@@ -255,3 +262,88 @@ func (s *MsgServerTestSuite) TestMsgInsertReputerPayloadReputerNotMatchSignature
 	_, err = s.msgServer.InsertReputerPayload(ctx, lossesMsg)
 	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
 }
+
+func (s *MsgServerTestSuite) setUpChainComputedLossesTopic(reputerAddr, workerAddr sdk.AccAddress) (types.ValueBundle, types.Topic) {
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerValueBundle, expectedInferences, expectedForecasts, topicId := s.setUpMsgReputerPayload(
+		reputerAddr.String(), reputerAddr, workerAddr.String(), workerAddr)
+
+	err := keeper.InsertActiveForecasts(s.ctx, topicId, block, expectedForecasts)
+	require.NoError(err)
+	err = keeper.InsertActiveInferences(s.ctx, topicId, block, expectedInferences)
+	require.NoError(err)
+
+	topic, err := keeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	topic.LossMethod = types.LossMethodMSE
+	topic.LossComputationMode = types.LossComputationMode_LOSS_COMPUTATION_MODE_CHAIN
+	err = keeper.SetTopic(s.ctx, topicId, topic)
+	require.NoError(err)
+
+	s.ctx = sdk.UnwrapSDKContext(s.ctx).WithBlockHeight(block + topic.GroundTruthLag)
+	return reputerValueBundle, topic
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertReputerGroundTruth() {
+	require := s.Require()
+	reputerAddr := s.addrs[0]
+
+	_, topic := s.setUpChainComputedLossesTopic(reputerAddr, s.addrs[1])
+
+	groundTruth := []alloraMath.Dec{alloraMath.MustNewDecFromString("1.5")}
+	_, err := s.msgServer.InsertReputerGroundTruth(s.ctx, &types.InsertReputerGroundTruthRequest{
+		Sender:              reputerAddr.String(),
+		TopicId:             topic.Id,
+		ReputerRequestNonce: &types.ReputerRequestNonce{ReputerNonce: &types.Nonce{BlockHeight: block}},
+		Values:              groundTruth,
+		Reputer:             "",
+	})
+	require.NoError(err)
+
+	stored, err := s.emissionsKeeper.GetReputerLatestGroundTruthByTopicId(s.ctx, topic.Id, reputerAddr.String())
+	require.NoError(err)
+	require.Equal(types.GroundTruth{
+		TopicId:     topic.Id,
+		BlockHeight: block,
+		Reputer:     reputerAddr.String(),
+		Values:      groundTruth,
+	}, stored)
+
+	// A scalar topic expects a single ground truth value
+	_, err = s.msgServer.InsertReputerGroundTruth(s.ctx, &types.InsertReputerGroundTruthRequest{
+		Sender:              reputerAddr.String(),
+		TopicId:             topic.Id,
+		ReputerRequestNonce: &types.ReputerRequestNonce{ReputerNonce: &types.Nonce{BlockHeight: block}},
+		Values:              []alloraMath.Dec{alloraMath.OneDec(), alloraMath.OneDec()},
+		Reputer:             "",
+	})
+	require.ErrorIs(err, types.ErrInvalidOutputDimension)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertReputerPayloadChainComputedLossesTopicFails() {
+	require := s.Require()
+	reputerAddr := s.addrs[0]
+
+	reputerValueBundle, _ := s.setUpChainComputedLossesTopic(reputerAddr, s.addrs[1])
+
+	err := s.constructAndInsertReputerPayload(reputerAddr, s.privKeys[0], s.pubKeyHexStr[0], &reputerValueBundle)
+	require.ErrorIs(err, types.ErrLossComputationModeMismatch)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertReputerGroundTruthReputerComputedLossesTopicFails() {
+	require := s.Require()
+	reputerAddr := s.addrs[0]
+
+	_, _, _, topicId := s.setUpMsgReputerPayload(s.addrsStr[0], reputerAddr, s.addrsStr[1], s.addrs[1])
+
+	_, err := s.msgServer.InsertReputerGroundTruth(s.ctx, &types.InsertReputerGroundTruthRequest{
+		Sender:              reputerAddr.String(),
+		TopicId:             topicId,
+		ReputerRequestNonce: &types.ReputerRequestNonce{ReputerNonce: &types.Nonce{BlockHeight: block}},
+		Values:              []alloraMath.Dec{alloraMath.OneDec()},
+		Reputer:             "",
+	})
+	require.ErrorIs(err, types.ErrLossComputationModeMismatch)
+}
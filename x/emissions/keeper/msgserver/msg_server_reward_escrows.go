@@ -0,0 +1,63 @@
+package msgserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ClaimVestedRewards pays out the rewards of the sender held in escrow on a topic that have vested so far.
+// Vested rewards can still be claimed once the topic is archived
+func (ms msgServer) ClaimVestedRewards(ctx context.Context, msg *types.ClaimVestedRewardsRequest) (_ *types.ClaimVestedRewardsResponse, err error) {
+	defer metrics.RecordMetrics("ClaimVestedRewards", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	amount, err := ms.k.ClaimVestedRewards(sdk.UnwrapSDKContext(ctx), msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ClaimVestedRewardsResponse{Amount: amount}, nil
+}
+
+// ForfeitUnvestedRewards returns the unvested rewards of an actor held in escrow on a topic to the
+// topic reward pool. Only whitelist admins can forfeit rewards
+func (ms msgServer) ForfeitUnvestedRewards(ctx context.Context, msg *types.ForfeitUnvestedRewardsRequest) (_ *types.ForfeitUnvestedRewardsResponse, err error) {
+	defer metrics.RecordMetrics("ForfeitUnvestedRewards", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	canForfeit, err := ms.k.CanForfeitRewards(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if !canForfeit {
+		return nil, types.ErrNotPermittedToForfeitRewards
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	amount, err := ms.k.ForfeitUnvestedRewards(sdk.UnwrapSDKContext(ctx), msg.TopicId, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ForfeitUnvestedRewardsResponse{Amount: amount}, nil
+}
@@ -0,0 +1,68 @@
+package msgserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// escrowRewards makes the topic escrow half of each reward and escrows amount for the actor
+func (s *MsgServerTestSuite) escrowRewards(topicId uint64, actor string, amount cosmosMath.Int) {
+	topic, err := s.emissionsKeeper.GetTopic(s.ctx, topicId)
+	s.Require().NoError(err)
+	topic.RewardEscrowFraction = alloraMath.MustNewDecFromString("0.5")
+	topic.RewardEscrowVestingBlocks = 100
+	s.Require().NoError(s.emissionsKeeper.SetTopic(s.ctx, topicId, topic))
+
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+	s.Require().NoError(s.bankKeeper.MintCoins(s.ctx, types.AlloraRewardEscrowAccountName, coins))
+	s.Require().NoError(s.emissionsKeeper.EscrowReward(s.ctx, topic, actor, amount))
+}
+
+func (s *MsgServerTestSuite) TestMsgClaimVestedRewards() {
+	require := s.Require()
+	topic := s.CreateOneTopic()
+	actorAddr, actor := getNewAddress()
+	s.escrowRewards(topic.Id, actor, cosmosMath.NewInt(100))
+
+	ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 25)
+	response, err := s.msgServer.ClaimVestedRewards(ctx, &types.ClaimVestedRewardsRequest{
+		Sender:  actor,
+		TopicId: topic.Id,
+	})
+	require.NoError(err)
+	require.True(cosmosMath.NewInt(25).Equal(response.Amount))
+	require.Equal(cosmosMath.NewInt(25), s.bankKeeper.GetBalance(ctx, actorAddr, params.DefaultBondDenom).Amount)
+
+	_, err = s.msgServer.ClaimVestedRewards(ctx, &types.ClaimVestedRewardsRequest{
+		Sender:  actor,
+		TopicId: topic.Id + 1,
+	})
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+}
+
+func (s *MsgServerTestSuite) TestMsgForfeitUnvestedRewards() {
+	require := s.Require()
+	topic := s.CreateOneTopic()
+	_, actor := getNewAddress()
+	s.escrowRewards(topic.Id, actor, cosmosMath.NewInt(100))
+
+	forfeitMsg := &types.ForfeitUnvestedRewardsRequest{
+		Sender:  actor,
+		TopicId: topic.Id,
+		Address: actor,
+	}
+	_, err := s.msgServer.ForfeitUnvestedRewards(s.ctx, forfeitMsg)
+	require.ErrorIs(err, types.ErrNotPermittedToForfeitRewards)
+
+	forfeitMsg.Sender = s.addrsStr[0]
+	ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 25)
+	response, err := s.msgServer.ForfeitUnvestedRewards(ctx, forfeitMsg)
+	require.NoError(err)
+	require.True(cosmosMath.NewInt(75).Equal(response.Amount))
+	feeRevenue, err := s.emissionsKeeper.GetTopicFeeRevenue(ctx, topic.Id)
+	require.NoError(err)
+	require.True(feeRevenue.GTE(cosmosMath.NewInt(75)))
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 
 	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/app/params"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
@@ -37,6 +38,10 @@ func (ms msgServer) AddStake(ctx context.Context, msg *types.AddStakeRequest) (_
 		return nil, types.ErrTopicDoesNotExist
 	}
 
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
 	// Check sender is registered in topic
 	isReputerRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
 	if err != nil {
@@ -45,6 +50,17 @@ func (ms msgServer) AddStake(ctx context.Context, msg *types.AddStakeRequest) (_
 		return nil, types.ErrAddressIsNotRegisteredInThisTopic
 	}
 
+	err = ms.k.CheckReputerStakeShareCap(ctx, msg.TopicId, msg.Sender, msg.Amount, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.LockMonths > 0 {
+		if _, err := ms.k.GetStakeLockTier(ctx, msg.LockMonths); err != nil {
+			return nil, err
+		}
+	}
+
 	// Check the sender has enough funds to add the stake
 	// bank module does this for us in module SendCoins / subUnlockedCoins so we don't need to check
 	// Send the funds
@@ -60,6 +76,13 @@ func (ms msgServer) AddStake(ctx context.Context, msg *types.AddStakeRequest) (_
 		return nil, err
 	}
 
+	if msg.LockMonths > 0 {
+		err = ms.k.LockStake(sdk.UnwrapSDKContext(ctx), msg.TopicId, msg.Sender, "", msg.Amount, msg.LockMonths)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = activateTopicIfWeightAtLeastGlobalMin(ctx, ms, msg.TopicId)
 	return &types.AddStakeResponse{}, err
 }
@@ -81,6 +104,10 @@ func (ms msgServer) RemoveStake(ctx context.Context, msg *types.RemoveStakeReque
 		return nil, types.ErrTopicDoesNotExist
 	}
 
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
 	// Check the sender has enough stake already placed on the topic to remove the stake
 	stakePlaced, err := ms.k.GetStakeReputerAuthority(ctx, msg.TopicId, msg.Sender)
 	if err != nil {
@@ -95,6 +122,10 @@ func (ms msgServer) RemoveStake(ctx context.Context, msg *types.RemoveStakeReque
 	if msg.Amount.GT(reputerStakeInTopicWithoutDelegateStake) {
 		return nil, types.ErrInsufficientStakeToRemove
 	}
+	err = checkStakeIsNotLocked(ctx, ms, msg.TopicId, msg.Sender, "", reputerStakeInTopicWithoutDelegateStake, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
 
 	moduleParams, err := ms.k.GetParams(ctx)
 	if err != nil {
@@ -126,6 +157,94 @@ func (ms msgServer) RemoveStake(ctx context.Context, msg *types.RemoveStakeReque
 	return &types.RemoveStakeResponse{}, err
 }
 
+// MoveStake moves stake of a reputer from one topic to another straight away. The stake stays in the
+// staking account, so unlike RemoveStake followed by AddStake there is no delay during which it earns nothing.
+// Stake that is pending removal from the source topic cannot be moved.
+func (ms msgServer) MoveStake(ctx context.Context, msg *types.MoveStakeRequest) (_ *types.MoveStakeResponse, err error) {
+	defer metrics.RecordMetrics("MoveStake", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	canAddStake, err := ms.k.CanAddReputerStake(ctx, msg.ToTopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if !canAddStake {
+		return nil, types.ErrNotPermittedToAddStake
+	}
+
+	// Check both topics exist
+	for _, topicId := range []uint64{msg.FromTopicId, msg.ToTopicId} {
+		topicExists, err := ms.k.TopicExists(ctx, topicId)
+		if err != nil {
+			return nil, err
+		} else if !topicExists {
+			return nil, types.ErrTopicDoesNotExist
+		}
+
+		if err := checkTopicIsNotArchived(ctx, ms, topicId); err != nil {
+			return nil, err
+		}
+	}
+
+	// Check sender is registered in the destination topic
+	isReputerRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.ToTopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if !isReputerRegistered {
+		return nil, types.ErrAddressIsNotRegisteredInThisTopic
+	}
+
+	// Check the sender has enough stake placed on the source topic that is not already being removed
+	stakePlaced, err := ms.k.GetStakeReputerAuthority(ctx, msg.FromTopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	delegateStakeUponReputerInTopic, err := ms.k.GetDelegateStakeUponReputer(ctx, msg.FromTopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	movableStake := stakePlaced.Sub(delegateStakeUponReputerInTopic)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	removal, found, err := ms.k.GetStakeRemovalForReputerAndTopicId(sdkCtx, msg.Sender, msg.FromTopicId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error while searching previous stake removal")
+	}
+	if found {
+		movableStake = movableStake.Sub(removal.Amount)
+	}
+	if msg.Amount.GT(movableStake) {
+		return nil, types.ErrInsufficientStakeToMove
+	}
+	err = checkStakeIsNotLocked(ctx, ms, msg.FromTopicId, msg.Sender, "", movableStake, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// A reputer on its way to being slashed in the source topic cannot take its stake out of reach
+	deviation, err := ms.k.GetReputerConsensusDeviation(ctx, msg.FromTopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if deviation.ConsecutiveEpochs > 0 {
+		return nil, types.ErrReputerDeviatingFromConsensus
+	}
+
+	err = ms.k.CheckReputerStakeShareCap(ctx, msg.ToTopicId, msg.Sender, msg.Amount, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.MoveReputerStake(ctx, msg.FromTopicId, msg.ToTopicId, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = activateTopicIfWeightAtLeastGlobalMin(ctx, ms, msg.ToTopicId)
+	return &types.MoveStakeResponse{}, err
+}
+
 // cancel a request to remove your stake, during the delay window
 func (ms msgServer) CancelRemoveStake(ctx context.Context, msg *types.CancelRemoveStakeRequest) (_ *types.CancelRemoveStakeResponse, err error) {
 	defer metrics.RecordMetrics("CancelRemoveStake", time.Now(), &err)
@@ -141,6 +260,10 @@ func (ms msgServer) CancelRemoveStake(ctx context.Context, msg *types.CancelRemo
 		return nil, types.ErrTopicDoesNotExist
 	}
 
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	removal, found, err := ms.k.GetStakeRemovalForReputerAndTopicId(sdkCtx, msg.Sender, msg.TopicId)
 	// if the specific error is that we somehow got into a buggy invariant state
@@ -159,6 +282,65 @@ func (ms msgServer) CancelRemoveStake(ctx context.Context, msg *types.CancelRemo
 	return &types.CancelRemoveStakeResponse{}, err
 }
 
+// change the amount of an ongoing stake removal during the delay period. Shrinking the removal
+// keeps its completion block, growing it restarts the removal delay.
+func (ms msgServer) AmendRemoveStake(ctx context.Context, msg *types.AmendRemoveStakeRequest) (_ *types.AmendRemoveStakeResponse, err error) {
+	defer metrics.RecordMetrics("AmendRemoveStake", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	removal, found, err := ms.k.GetStakeRemovalForReputerAndTopicId(sdkCtx, msg.Sender, msg.TopicId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error while searching previous stake removal")
+	}
+	if !found {
+		return nil, types.ErrStakeRemovalNotFound
+	}
+
+	// Check the sender has enough stake placed on the topic to remove the new total
+	stakePlaced, err := ms.k.GetStakeReputerAuthority(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	delegateStakeUponReputerInTopic, err := ms.k.GetDelegateStakeUponReputer(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Amount.GT(stakePlaced.Sub(delegateStakeUponReputerInTopic)) {
+		return nil, types.ErrInsufficientStakeToRemove
+	}
+	err = checkStakeIsNotLocked(
+		ctx, ms, msg.TopicId, msg.Sender, "", stakePlaced.Sub(delegateStakeUponReputerInTopic), msg.Amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	amended, err := ms.k.AmendStakeRemoval(sdkCtx, removal, msg.Amount, moduleParams.RemoveStakeDelayWindow)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AmendRemoveStakeResponse{BlockRemovalCompleted: amended.BlockRemovalCompleted}, nil
+}
+
 // Delegates a stake to a reputer. Sender does not have to be registered to delegate stake.
 func (ms msgServer) DelegateStake(ctx context.Context, msg *types.DelegateStakeRequest) (_ *types.DelegateStakeResponse, err error) {
 	defer metrics.RecordMetrics("DelegateStake", time.Now(), &err)
@@ -174,6 +356,10 @@ func (ms msgServer) DelegateStake(ctx context.Context, msg *types.DelegateStakeR
 		return nil, types.ErrTopicDoesNotExist
 	}
 
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
 	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Reputer)
 	if err != nil {
 		return nil, err
@@ -181,6 +367,17 @@ func (ms msgServer) DelegateStake(ctx context.Context, msg *types.DelegateStakeR
 		return nil, errorsmod.Wrap(types.ErrAddressIsNotRegisteredInThisTopic, "reputer address")
 	}
 
+	err = ms.k.CheckReputerStakeShareCap(ctx, msg.TopicId, msg.Reputer, msg.Amount, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.LockMonths > 0 {
+		if _, err := ms.k.GetStakeLockTier(ctx, msg.LockMonths); err != nil {
+			return nil, err
+		}
+	}
+
 	// Check the sender has enough funds to delegate the stake
 	// bank module does this for us in module SendCoins / subUnlockedCoins so we don't need to check here
 	// Send the funds
@@ -196,6 +393,13 @@ func (ms msgServer) DelegateStake(ctx context.Context, msg *types.DelegateStakeR
 		return nil, err
 	}
 
+	if msg.LockMonths > 0 {
+		err = ms.k.LockStake(sdk.UnwrapSDKContext(ctx), msg.TopicId, msg.Reputer, msg.Sender, msg.Amount, msg.LockMonths)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = activateTopicIfWeightAtLeastGlobalMin(ctx, ms, msg.TopicId)
 	return &types.DelegateStakeResponse{}, err
 }
@@ -217,6 +421,10 @@ func (ms msgServer) RemoveDelegateStake(ctx context.Context, msg *types.RemoveDe
 		return nil, types.ErrTopicDoesNotExist
 	}
 
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
 	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Reputer)
 	if err != nil {
 		return nil, err
@@ -236,6 +444,14 @@ func (ms msgServer) RemoveDelegateStake(ctx context.Context, msg *types.RemoveDe
 	if delegateStakePlaced.Amount.Lt(amountDec) {
 		return nil, types.ErrInsufficientDelegateStakeToRemove
 	}
+	delegateStakeAvailable, err := delegateStakePlaced.Amount.SdkIntTrim()
+	if err != nil {
+		return nil, err
+	}
+	err = checkStakeIsNotLocked(ctx, ms, msg.TopicId, msg.Reputer, msg.Sender, delegateStakeAvailable, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check the reputer has enough stake already placed on the topic to remove the stake
 	totalStakeOnReputer, err := ms.k.GetStakeReputerAuthority(ctx, msg.TopicId, msg.Reputer)
@@ -299,6 +515,10 @@ func (ms msgServer) CancelRemoveDelegateStake(ctx context.Context, msg *types.Ca
 		return nil, types.ErrTopicDoesNotExist
 	}
 
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
 	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Reputer)
 	if err != nil {
 		return nil, err
@@ -332,6 +552,204 @@ func (ms msgServer) CancelRemoveDelegateStake(ctx context.Context, msg *types.Ca
 	return &types.CancelRemoveDelegateStakeResponse{}, err
 }
 
+// change the amount of an ongoing delegate stake removal during the delay period. Shrinking the
+// removal keeps its completion block, growing it restarts the removal delay.
+func (ms msgServer) AmendRemoveDelegateStake(
+	ctx context.Context,
+	msg *types.AmendRemoveDelegateStakeRequest,
+) (_ *types.AmendRemoveDelegateStakeResponse, err error) {
+	defer metrics.RecordMetrics("AmendRemoveDelegateStake", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	removal, found, err := ms.k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(
+		sdkCtx, msg.Sender, msg.Reputer, msg.TopicId,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error while searching previous delegate stake removal")
+	}
+	if !found {
+		return nil, types.ErrStakeRemovalNotFound
+	}
+
+	// Check the delegator and the reputer have enough stake placed on the topic to remove the new total
+	delegateStakePlaced, err := ms.k.GetDelegateStakePlacement(ctx, msg.TopicId, msg.Sender, msg.Reputer)
+	if err != nil {
+		return nil, err
+	}
+	amountDec, err := alloraMath.NewDecFromSdkInt(msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if delegateStakePlaced.Amount.Lt(amountDec) {
+		return nil, types.ErrInsufficientDelegateStakeToRemove
+	}
+	delegateStakeAvailable, err := delegateStakePlaced.Amount.SdkIntTrim()
+	if err != nil {
+		return nil, err
+	}
+	err = checkStakeIsNotLocked(ctx, ms, msg.TopicId, msg.Reputer, msg.Sender, delegateStakeAvailable, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	totalStakeOnReputer, err := ms.k.GetStakeReputerAuthority(ctx, msg.TopicId, msg.Reputer)
+	if err != nil {
+		return nil, err
+	}
+	if totalStakeOnReputer.LT(msg.Amount) {
+		return nil, types.ErrInsufficientStakeToRemove
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	amended, err := ms.k.AmendDelegateStakeRemoval(sdkCtx, removal, msg.Amount, moduleParams.RemoveStakeDelayWindow)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AmendRemoveDelegateStakeResponse{BlockRemovalCompleted: amended.BlockRemovalCompleted}, nil
+}
+
+// move delegated stake from one reputer of a topic to another without waiting out the removal delay
+func (ms msgServer) RedelegateStake(ctx context.Context, msg *types.RedelegateStakeRequest) (_ *types.RedelegateStakeResponse, err error) {
+	defer metrics.RecordMetrics("RedelegateStake", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
+	// the source reputer may have been unregistered since, the destination must still take stake
+	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.DstReputer)
+	if err != nil {
+		return nil, err
+	} else if !isRegistered {
+		return nil, errorsmod.Wrap(types.ErrAddressIsNotRegisteredInThisTopic, "destination reputer address")
+	}
+
+	// Check the delegator has enough stake upon the source reputer that is not already being removed
+	delegateStakePlaced, err := ms.k.GetDelegateStakePlacement(ctx, msg.TopicId, msg.Sender, msg.SrcReputer)
+	if err != nil {
+		return nil, err
+	}
+	available, err := delegateStakePlaced.Amount.SdkIntTrim()
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	removal, found, err := ms.k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(
+		sdkCtx, msg.Sender, msg.SrcReputer, msg.TopicId,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error during finding delegate stake removal")
+	}
+	if found {
+		available = available.Sub(removal.Amount)
+	}
+	if available.LT(msg.Amount) {
+		return nil, types.ErrInsufficientDelegateStakeToRedelegate
+	}
+	err = checkStakeIsNotLocked(ctx, ms, msg.TopicId, msg.SrcReputer, msg.Sender, available, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// the stake only moves between reputers of the topic, so the topic's stake does not grow
+	err = ms.k.CheckReputerStakeShareCap(ctx, msg.TopicId, msg.DstReputer, msg.Amount, cosmosMath.ZeroInt())
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.RedelegateStake(sdkCtx, msg.TopicId, msg.Sender, msg.SrcReputer, msg.DstReputer, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RedelegateStakeResponse{}, nil
+}
+
+// opt in or out of restaking delegator rewards on a topic as they are paid out, instead of
+// leaving them pending until claimed with RewardDelegateStake
+func (ms msgServer) SetAutoCompound(ctx context.Context, msg *types.SetAutoCompoundRequest) (_ *types.SetAutoCompoundResponse, err error) {
+	defer metrics.RecordMetrics("SetAutoCompound", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	// opting out is always possible, so that no stake is added to a topic being wound down
+	if msg.Enabled {
+		if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+			return nil, err
+		}
+	}
+
+	err = ms.k.SetAutoCompound(ctx, msg.TopicId, msg.Sender, msg.Enabled)
+	return &types.SetAutoCompoundResponse{}, err
+}
+
+// Sets the commission the sender keeps, as a reputer of the topic, on the rewards of its delegators
+func (ms msgServer) SetReputerCommission(ctx context.Context, msg *types.SetReputerCommissionRequest) (_ *types.SetReputerCommissionResponse, err error) {
+	defer metrics.RecordMetrics("SetReputerCommission", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, types.ErrTopicDoesNotExist
+	}
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
+	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if !isRegistered {
+		return nil, types.ErrAddressIsNotRegisteredInThisTopic
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = ms.k.UpdateReputerCommission(sdkCtx, topic, msg.Sender, msg.Rate)
+	if err != nil {
+		return nil, err
+	}
+	return &types.SetReputerCommissionResponse{}, nil
+}
+
 func (ms msgServer) RewardDelegateStake(ctx context.Context, msg *types.RewardDelegateStakeRequest) (_ *types.RewardDelegateStakeResponse, err error) {
 	defer metrics.RecordMetrics("RewardDelegateStake", time.Now(), &err)
 
@@ -346,11 +764,18 @@ func (ms msgServer) RewardDelegateStake(ctx context.Context, msg *types.RewardDe
 		return nil, types.ErrTopicDoesNotExist
 	}
 
-	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Reputer)
+	// the reputers of an archived topic are pruned, yet the rewards delegators accrued there stay claimable
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
 	if err != nil {
 		return nil, err
-	} else if !isRegistered {
-		return nil, errorsmod.Wrap(types.ErrAddressIsNotRegisteredInThisTopic, "reputer address")
+	}
+	if !isArchived {
+		isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Reputer)
+		if err != nil {
+			return nil, err
+		} else if !isRegistered {
+			return nil, errorsmod.Wrap(types.ErrAddressIsNotRegisteredInThisTopic, "reputer address")
+		}
 	}
 
 	delegateInfo, err := ms.k.GetDelegateStakePlacement(ctx, msg.TopicId, msg.Sender, msg.Reputer)
@@ -390,3 +815,18 @@ func (ms msgServer) RewardDelegateStake(ctx context.Context, msg *types.RewardDe
 	}
 	return &types.RewardDelegateStakeResponse{}, err
 }
+
+// Claims the rewards of a page of the sender's delegate stake placements across all topics and reputers
+func (ms msgServer) ClaimAllDelegateRewards(ctx context.Context, msg *types.ClaimAllDelegateRewardsRequest) (_ *types.ClaimAllDelegateRewardsResponse, err error) {
+	defer metrics.RecordMetrics("ClaimAllDelegateRewards", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	claimed, pagination, err := ms.k.ClaimAllDelegateRewards(ctx, msg.Sender, msg.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ClaimAllDelegateRewardsResponse{Amount: claimed, Pagination: pagination}, nil
+}
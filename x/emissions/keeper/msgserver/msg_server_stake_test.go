@@ -29,22 +29,35 @@ func (s *MsgServerTestSuite) commonStakingSetup(
 
 	// Create Topic
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  reputer,
-		Metadata:                 "Some metadata for the new topic",
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		AllowNegative:            false,
-		AlphaRegret:              alloraMath.NewDecFromInt64(1),
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       reputer,
+		Metadata:                      "Some metadata for the new topic",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		AllowNegative:                 false,
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 
 	reputerInitialBalance := types.DefaultParams().CreateTopicFee.Add(reputerInitialBalanceUint)
@@ -576,6 +589,61 @@ func (s *MsgServerTestSuite) TestDelegateStake() {
 	require.Equal(stakeAmount, amountInt)
 }
 
+func (s *MsgServerTestSuite) TestDelegateStakeOverReputerStakeShareCap() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	reputers := []string{s.addrsStr[1], s.addrsStr[2], s.addrsStr[3]}
+	topic := s.CreateOneTopic()
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(100000))
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.MaxReputerStakeShare = alloraMath.MustNewDecFromString("0.5")
+	err = keeper.SetParams(ctx, params)
+	require.NoError(err)
+
+	for _, reputer := range reputers {
+		err = keeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{
+			Owner:       s.addrsStr[7],
+			NodeAddress: reputer,
+		})
+		require.NoError(err)
+		err = keeper.AddReputerStake(ctx, topic.Id, reputer, cosmosMath.NewInt(20000))
+		require.NoError(err)
+	}
+
+	// 30000 of 70000 stays within half of the topic's stake
+	msg := &types.DelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: reputers[0],
+		Amount:  cosmosMath.NewInt(10000),
+	}
+	_, err = s.msgServer.DelegateStake(ctx, msg)
+	require.NoError(err)
+
+	// 60000 of 100000 would not
+	msg.Amount = cosmosMath.NewInt(30000)
+	_, err = s.msgServer.DelegateStake(ctx, msg)
+	require.ErrorIs(err, types.ErrReputerStakeShareCapExceeded)
+
+	// the reputer cannot add the stake itself either
+	_, err = s.msgServer.AddStake(ctx, &types.AddStakeRequest{
+		Sender:  reputers[0],
+		TopicId: topic.Id,
+		Amount:  cosmosMath.NewInt(30000),
+	})
+	require.ErrorIs(err, types.ErrReputerStakeShareCapExceeded)
+
+	reputerStake, err := keeper.GetStakeReputerAuthority(ctx, topic.Id, reputers[0])
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(30000), reputerStake)
+}
+
 func (s *MsgServerTestSuite) TestDelegateStakeNil() {
 	ctx := s.ctx
 	require := s.Require()
@@ -1516,6 +1584,8 @@ func (s *MsgServerTestSuite) TestRewardDelegateStake() {
 			OneOutForecasterValues:        nil,
 			OneInForecasterValues:         nil,
 			OneOutInfererForecasterValues: nil,
+			CombinedValues:                nil,
+			NaiveValues:                   nil,
 		},
 		Signature: []byte{},
 	}
@@ -1582,6 +1652,8 @@ func (s *MsgServerTestSuite) TestRewardDelegateStake() {
 			OneOutForecasterValues:        nil,
 			OneInForecasterValues:         nil,
 			OneOutInfererForecasterValues: nil,
+			CombinedValues:                nil,
+			NaiveValues:                   nil,
 		},
 		Signature: []byte{},
 		Pubkey:    s.pubKeyHexStr[1],
@@ -1668,6 +1740,8 @@ func (s *MsgServerTestSuite) insertValueBundlesAndGetRewards(
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature := s.signValueBundle(valueBundle, reputerPrivKey)
 	reputerValueBundle := &types.ReputerValueBundle{
@@ -2187,3 +2261,605 @@ func (s *MsgServerTestSuite) TestCancelRemoveDelegateStakeNotExist() {
 	require.Error(err)
 	require.True(errors.Is(err, types.ErrStakeRemovalNotFound), "Expected delegate stake removal not found error")
 }
+
+func (s *MsgServerTestSuite) TestAmendRemoveStake() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputer := s.addrsStr[0]
+	topicId := s.CreateOneTopic().Id
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+
+	msg := &types.AmendRemoveStakeRequest{
+		Sender:  reputer,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(20),
+	}
+	_, err = s.msgServer.AmendRemoveStake(ctx, msg)
+	require.ErrorIs(err, types.ErrStakeRemovalNotFound)
+
+	err = keeper.AddReputerStake(ctx, topicId, reputer, cosmosMath.NewInt(100))
+	require.NoError(err)
+	_, err = s.msgServer.RemoveStake(ctx, &types.RemoveStakeRequest{
+		Sender:  reputer,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(50),
+	})
+	require.NoError(err)
+	endBlock := ctx.BlockHeight() + params.RemoveStakeDelayWindow
+
+	// Shrinking the removal keeps its completion block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	response, err := s.msgServer.AmendRemoveStake(ctx, msg)
+	require.NoError(err)
+	require.Equal(endBlock, response.BlockRemovalCompleted)
+	removal, found, err := keeper.GetStakeRemovalForReputerAndTopicId(ctx, reputer, topicId)
+	require.NoError(err)
+	require.True(found)
+	require.True(cosmosMath.NewInt(20).Equal(removal.Amount))
+	require.Equal(endBlock, removal.BlockRemovalCompleted)
+
+	// Growing the removal restarts the delay and moves it in the removal queue
+	msg.Amount = cosmosMath.NewInt(80)
+	response, err = s.msgServer.AmendRemoveStake(ctx, msg)
+	require.NoError(err)
+	newEndBlock := ctx.BlockHeight() + params.RemoveStakeDelayWindow
+	require.Equal(newEndBlock, response.BlockRemovalCompleted)
+	removals, _, err := keeper.GetStakeRemovalsUpUntilBlock(ctx, endBlock, 100)
+	require.NoError(err)
+	require.Empty(removals)
+	removals, _, err = keeper.GetStakeRemovalsUpUntilBlock(ctx, newEndBlock, 100)
+	require.NoError(err)
+	require.Len(removals, 1)
+	require.True(cosmosMath.NewInt(80).Equal(removals[0].Amount))
+	require.Equal(ctx.BlockHeight(), removals[0].BlockRemovalStarted)
+	_, found, err = keeper.GetStakeRemovalForReputerAndTopicId(ctx, reputer, topicId)
+	require.NoError(err)
+	require.True(found)
+
+	// The removal cannot grow beyond the stake placed
+	msg.Amount = cosmosMath.NewInt(101)
+	_, err = s.msgServer.AmendRemoveStake(ctx, msg)
+	require.ErrorIs(err, types.ErrInsufficientStakeToRemove)
+}
+
+func (s *MsgServerTestSuite) TestAmendRemoveDelegateStake() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+	topicId := s.CreateOneTopic().Id
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	err = keeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{
+		Owner:       s.addrsStr[7],
+		NodeAddress: reputer,
+	})
+	require.NoError(err)
+	_, err = s.msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topicId,
+		Reputer: reputer,
+		Amount:  cosmosMath.NewInt(100),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.RemoveDelegateStake(ctx, &types.RemoveDelegateStakeRequest{
+		Sender:  delegator,
+		Reputer: reputer,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(50),
+	})
+	require.NoError(err)
+	endBlock := ctx.BlockHeight() + params.RemoveStakeDelayWindow
+
+	// Shrinking the removal keeps its completion block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	msg := &types.AmendRemoveDelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topicId,
+		Reputer: reputer,
+		Amount:  cosmosMath.NewInt(20),
+	}
+	response, err := s.msgServer.AmendRemoveDelegateStake(ctx, msg)
+	require.NoError(err)
+	require.Equal(endBlock, response.BlockRemovalCompleted)
+	removal, found, err := keeper.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(ctx, delegator, reputer, topicId)
+	require.NoError(err)
+	require.True(found)
+	require.True(cosmosMath.NewInt(20).Equal(removal.Amount))
+
+	// Growing the removal restarts the delay and moves it in the removal queue
+	msg.Amount = cosmosMath.NewInt(100)
+	response, err = s.msgServer.AmendRemoveDelegateStake(ctx, msg)
+	require.NoError(err)
+	newEndBlock := ctx.BlockHeight() + params.RemoveStakeDelayWindow
+	require.Equal(newEndBlock, response.BlockRemovalCompleted)
+	removals, _, err := keeper.GetDelegateStakeRemovalsUpUntilBlock(ctx, endBlock, 100)
+	require.NoError(err)
+	require.Empty(removals)
+	removals, _, err = keeper.GetDelegateStakeRemovalsUpUntilBlock(ctx, newEndBlock, 100)
+	require.NoError(err)
+	require.Len(removals, 1)
+	require.True(cosmosMath.NewInt(100).Equal(removals[0].Amount))
+	_, found, err = keeper.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(ctx, delegator, reputer, topicId)
+	require.NoError(err)
+	require.True(found)
+
+	// The removal cannot grow beyond the stake delegated
+	msg.Amount = cosmosMath.NewInt(101)
+	_, err = s.msgServer.AmendRemoveDelegateStake(ctx, msg)
+	require.ErrorIs(err, types.ErrInsufficientDelegateStakeToRemove)
+}
+
+func (s *MsgServerTestSuite) TestRedelegateStake() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	srcReputer := s.addrsStr[1]
+	dstReputer := s.addrsStr[2]
+	topic := s.CreateOneTopic()
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	endBlock := ctx.BlockHeight() + params.RemoveStakeDelayWindow
+
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	for _, reputer := range []string{srcReputer, dstReputer} {
+		err = keeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{
+			Owner:       s.addrsStr[7],
+			NodeAddress: reputer,
+		})
+		require.NoError(err)
+	}
+	_, err = s.msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: srcReputer,
+		Amount:  cosmosMath.NewInt(50),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.RemoveDelegateStake(ctx, &types.RemoveDelegateStakeRequest{
+		Sender:  delegator,
+		Reputer: srcReputer,
+		TopicId: topic.Id,
+		Amount:  cosmosMath.NewInt(30),
+	})
+	require.NoError(err)
+
+	// Stake already being removed cannot be redelegated
+	msg := &types.RedelegateStakeRequest{
+		Sender:     delegator,
+		TopicId:    topic.Id,
+		SrcReputer: srcReputer,
+		DstReputer: dstReputer,
+		Amount:     cosmosMath.NewInt(30),
+	}
+	_, err = s.msgServer.RedelegateStake(ctx, msg)
+	require.ErrorIs(err, types.ErrInsufficientDelegateStakeToRedelegate)
+
+	msg.Amount = cosmosMath.NewInt(20)
+	_, err = s.msgServer.RedelegateStake(ctx, msg)
+	require.NoError(err)
+	dstStake, err := keeper.GetStakeReputerAuthority(ctx, topic.Id, dstReputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(20), dstStake)
+
+	msg.SrcReputer = dstReputer
+	msg.DstReputer = srcReputer
+	_, err = s.msgServer.RedelegateStake(ctx, msg)
+	require.ErrorIs(err, types.ErrTransitiveRedelegation)
+
+	// The redelegation completes with the pending removal
+	ctx = ctx.WithBlockHeight(endBlock)
+	err = s.appModule.EndBlock(ctx)
+	require.NoError(err)
+	_, found, err := keeper.GetRedelegation(ctx, topic.Id, srcReputer, delegator, dstReputer)
+	require.NoError(err)
+	require.False(found)
+	srcPlacement, err := keeper.GetDelegateStakePlacement(ctx, topic.Id, delegator, srcReputer)
+	require.NoError(err)
+	require.True(srcPlacement.Amount.IsZero())
+	dstPlacement, err := keeper.GetDelegateStakePlacement(ctx, topic.Id, delegator, dstReputer)
+	require.NoError(err)
+	require.True(alloraMath.NewDecFromInt64(20).Equal(dstPlacement.Amount))
+}
+
+func (s *MsgServerTestSuite) TestMoveStake() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerAddr := s.addrs[1]
+	reputer := s.addrsStr[1]
+	fromTopic := s.CreateOneTopic()
+	toTopic := s.CreateOneTopic()
+	for _, topicId := range []uint64{fromTopic.Id, toTopic.Id} {
+		err := keeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{
+			Owner:       reputer,
+			NodeAddress: reputer,
+		})
+		require.NoError(err)
+	}
+	s.MintTokensToAddress(reputerAddr, cosmosMath.NewInt(1000))
+	_, err := s.msgServer.AddStake(ctx, &types.AddStakeRequest{
+		Sender:  reputer,
+		TopicId: fromTopic.Id,
+		Amount:  cosmosMath.NewInt(100),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.RemoveStake(ctx, &types.RemoveStakeRequest{
+		Sender:  reputer,
+		TopicId: fromTopic.Id,
+		Amount:  cosmosMath.NewInt(60),
+	})
+	require.NoError(err)
+	totalStakeBefore, err := keeper.GetTotalStake(ctx)
+	require.NoError(err)
+
+	// Stake already being removed cannot be moved
+	msg := &types.MoveStakeRequest{
+		Sender:      reputer,
+		FromTopicId: fromTopic.Id,
+		ToTopicId:   toTopic.Id,
+		Amount:      cosmosMath.NewInt(50),
+	}
+	_, err = s.msgServer.MoveStake(ctx, msg)
+	require.ErrorIs(err, types.ErrInsufficientStakeToMove)
+
+	msg.Amount = cosmosMath.NewInt(40)
+	_, err = s.msgServer.MoveStake(ctx, msg)
+	require.NoError(err)
+
+	fromStake, err := keeper.GetStakeReputerAuthority(ctx, fromTopic.Id, reputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(60), fromStake)
+	toStake, err := keeper.GetStakeReputerAuthority(ctx, toTopic.Id, reputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(40), toStake)
+	toTopicStake, err := keeper.GetTopicStake(ctx, toTopic.Id)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(40), toTopicStake)
+	totalStake, err := keeper.GetTotalStake(ctx)
+	require.NoError(err)
+	require.Equal(totalStakeBefore, totalStake)
+
+	// The destination topic whitelist still applies
+	msg.FromTopicId = toTopic.Id
+	msg.ToTopicId = fromTopic.Id
+	msg.Amount = cosmosMath.NewInt(10)
+
+	// A reputer on its way to being slashed cannot move its stake away
+	err = keeper.SetReputerConsensusDeviation(ctx, types.ReputerConsensusDeviation{
+		TopicId:           toTopic.Id,
+		Reputer:           reputer,
+		ConsecutiveEpochs: 1,
+		Distance:          alloraMath.OneDec(),
+		BlockHeight:       ctx.BlockHeight(),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.MoveStake(ctx, msg)
+	require.ErrorIs(err, types.ErrReputerDeviatingFromConsensus)
+	err = keeper.DeleteReputerConsensusDeviation(ctx, toTopic.Id, reputer)
+	require.NoError(err)
+
+	err = keeper.EnableTopicReputerWhitelist(ctx, fromTopic.Id)
+	require.NoError(err)
+	err = keeper.RemoveFromTopicReputerWhitelist(ctx, fromTopic.Id, reputer)
+	require.NoError(err)
+	err = keeper.RemoveFromGlobalWhitelist(ctx, reputer)
+	require.NoError(err)
+	_, err = s.msgServer.MoveStake(ctx, msg)
+	require.ErrorIs(err, types.ErrNotPermittedToAddStake)
+}
+
+func (s *MsgServerTestSuite) TestRewardDelegateStakeOfPrunedArchivedTopic() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+	topic := s.CreateOneTopic()
+
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	err := keeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{
+		Owner:       s.addrsStr[7],
+		NodeAddress: reputer,
+	})
+	require.NoError(err)
+	_, err = s.msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: reputer,
+		Amount:  cosmosMath.NewInt(100),
+	})
+	require.NoError(err)
+	s.MintTokensToModule(types.AlloraPendingRewardForDelegatorAccountName, cosmosMath.NewInt(50))
+	err = keeper.SetDelegateRewardPerShare(ctx, topic.Id, reputer, alloraMath.MustNewDecFromString("0.5"))
+	require.NoError(err)
+
+	// The topic is archived and pruned, removing its reputers
+	require.NoError(keeper.ArchiveTopic(ctx, topic.Id))
+	require.NoError(keeper.PruneArchivedTopics(ctx, 1000))
+	pending, err := keeper.IsArchivedTopicPendingPrune(ctx, topic.Id)
+	require.NoError(err)
+	require.False(pending)
+	isRegistered, err := keeper.IsReputerRegisteredInTopic(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.False(isRegistered)
+
+	// The delegator still claims the rewards accrued before the topic was archived
+	_, err = s.msgServer.RewardDelegateStake(ctx, &types.RewardDelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: reputer,
+	})
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(950), s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom).Amount)
+	placement, err := keeper.GetDelegateStakePlacement(ctx, topic.Id, delegator, reputer)
+	require.NoError(err)
+	require.True(alloraMath.NewDecFromInt64(50).Equal(placement.RewardDebt))
+}
+
+func (s *MsgServerTestSuite) TestClaimAllDelegateRewards() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+	topic := s.CreateOneTopic()
+
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	err := keeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{
+		Owner:       s.addrsStr[7],
+		NodeAddress: reputer,
+	})
+	require.NoError(err)
+	_, err = s.msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: reputer,
+		Amount:  cosmosMath.NewInt(100),
+	})
+	require.NoError(err)
+	s.MintTokensToModule(types.AlloraPendingRewardForDelegatorAccountName, cosmosMath.NewInt(50))
+	err = keeper.SetDelegateRewardPerShare(ctx, topic.Id, reputer, alloraMath.MustNewDecFromString("0.5"))
+	require.NoError(err)
+
+	response, err := s.msgServer.ClaimAllDelegateRewards(ctx, &types.ClaimAllDelegateRewardsRequest{
+		Sender:     delegator,
+		Pagination: nil,
+	})
+	require.NoError(err)
+	require.True(cosmosMath.NewInt(50).Equal(response.Amount))
+	require.Empty(response.Pagination.NextKey)
+	require.Equal(cosmosMath.NewInt(950), s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom).Amount)
+
+	placement, err := keeper.GetDelegateStakePlacement(ctx, topic.Id, delegator, reputer)
+	require.NoError(err)
+	require.True(alloraMath.NewDecFromInt64(50).Equal(placement.RewardDebt))
+
+	_, err = s.msgServer.ClaimAllDelegateRewards(ctx, &types.ClaimAllDelegateRewardsRequest{
+		Sender:     "invalid",
+		Pagination: nil,
+	})
+	require.Error(err)
+}
+
+func (s *MsgServerTestSuite) TestSetReputerCommission() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputer := s.addrsStr[1]
+	topic := s.CreateOneTopic()
+	msg := &types.SetReputerCommissionRequest{
+		Sender:  reputer,
+		TopicId: topic.Id,
+		Rate:    alloraMath.MustNewDecFromString("0.01"),
+	}
+
+	_, err := s.msgServer.SetReputerCommission(ctx, msg)
+	require.ErrorIs(err, types.ErrAddressIsNotRegisteredInThisTopic)
+
+	err = keeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{
+		Owner:       s.addrsStr[7],
+		NodeAddress: reputer,
+	})
+	require.NoError(err)
+	_, err = s.msgServer.SetReputerCommission(ctx, msg)
+	require.NoError(err)
+	commission, found, err := keeper.GetReputerCommission(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.True(found)
+	require.True(msg.Rate.Equal(commission.Rate))
+
+	invalid := *msg
+	invalid.Rate = alloraMath.MustNewDecFromString("1.5")
+	_, err = s.msgServer.SetReputerCommission(ctx, &invalid)
+	require.ErrorIs(err, types.ErrInvalidReputerCommission)
+}
+
+func (s *MsgServerTestSuite) TestSetReputerCommissionCannotJumpOverDelegators() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputer := s.addrsStr[1]
+	delegatorAddr := s.addrs[2]
+	topic := s.CreateOneTopic()
+	err := keeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{
+		Owner:       reputer,
+		NodeAddress: reputer,
+	})
+	require.NoError(err)
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	_, err = s.msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:  delegatorAddr.String(),
+		TopicId: topic.Id,
+		Reputer: reputer,
+		Amount:  cosmosMath.NewInt(1000),
+	})
+	require.NoError(err)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+
+	// The reputer cannot take all of its delegators' rewards at once
+	_, err = s.msgServer.SetReputerCommission(ctx, &types.SetReputerCommissionRequest{
+		Sender:  reputer,
+		TopicId: topic.Id,
+		Rate:    alloraMath.OneDec(),
+	})
+	require.ErrorIs(err, types.ErrReputerCommissionAboveMaxRate)
+	// nor jump to the max rate, the max change rate applies from zero
+	_, err = s.msgServer.SetReputerCommission(ctx, &types.SetReputerCommissionRequest{
+		Sender:  reputer,
+		TopicId: topic.Id,
+		Rate:    params.MaxReputerCommissionRate,
+	})
+	require.ErrorIs(err, types.ErrReputerCommissionChangeTooLarge)
+	commission, found, err := keeper.GetReputerCommission(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.False(found)
+	require.True(commission.Rate.IsZero())
+}
+
+func (s *MsgServerTestSuite) TestAddStakeWithLock() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerAddr := s.addrs[0]
+	reputer := s.addrsStr[0]
+	topicId := s.CreateOneTopic().Id
+	otherTopicId := s.CreateOneTopic().Id
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+
+	s.MintTokensToAddress(reputerAddr, cosmosMath.NewInt(1000))
+	for _, id := range []uint64{topicId, otherTopicId} {
+		err = keeper.InsertReputer(ctx, id, reputer, types.OffchainNode{
+			Owner:       s.addrsStr[7],
+			NodeAddress: reputer,
+		})
+		require.NoError(err)
+	}
+
+	msg := &types.AddStakeRequest{
+		Sender:     reputer,
+		TopicId:    topicId,
+		Amount:     cosmosMath.NewInt(100),
+		LockMonths: 2,
+	}
+	_, err = s.msgServer.AddStake(ctx, msg)
+	require.ErrorIs(err, types.ErrInvalidStakeLockTier)
+
+	msg.LockMonths = 1
+	_, err = s.msgServer.AddStake(ctx, msg)
+	require.NoError(err)
+	msg.LockMonths = 0
+	_, err = s.msgServer.AddStake(ctx, msg)
+	require.NoError(err)
+
+	// Only the stake that was not locked can be removed or moved
+	removeMsg := &types.RemoveStakeRequest{
+		Sender:  reputer,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(101),
+	}
+	_, err = s.msgServer.RemoveStake(ctx, removeMsg)
+	require.ErrorIs(err, types.ErrStakeLocked)
+	_, err = s.msgServer.MoveStake(ctx, &types.MoveStakeRequest{
+		Sender:      reputer,
+		FromTopicId: topicId,
+		ToTopicId:   otherTopicId,
+		Amount:      cosmosMath.NewInt(101),
+	})
+	require.ErrorIs(err, types.ErrStakeLocked)
+	removeMsg.Amount = cosmosMath.NewInt(100)
+	_, err = s.msgServer.RemoveStake(ctx, removeMsg)
+	require.NoError(err)
+	_, err = s.msgServer.AmendRemoveStake(ctx, &types.AmendRemoveStakeRequest{
+		Sender:  reputer,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(200),
+	})
+	require.ErrorIs(err, types.ErrStakeLocked)
+
+	// The lock ends after its months
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.BlocksPerMonth))
+	removeMsg.Amount = cosmosMath.NewInt(200)
+	_, err = s.msgServer.RemoveStake(ctx, removeMsg)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestDelegateStakeWithLock() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+	otherReputer := s.addrsStr[2]
+	topicId := s.CreateOneTopic().Id
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	for _, addr := range []string{reputer, otherReputer} {
+		err = keeper.InsertReputer(ctx, topicId, addr, types.OffchainNode{
+			Owner:       s.addrsStr[7],
+			NodeAddress: addr,
+		})
+		require.NoError(err)
+	}
+	_, err = s.msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:     delegator,
+		TopicId:    topicId,
+		Reputer:    reputer,
+		Amount:     cosmosMath.NewInt(100),
+		LockMonths: 3,
+	})
+	require.NoError(err)
+
+	locks, err := keeper.GetStakeLocks(ctx, topicId, reputer)
+	require.NoError(err)
+	require.Len(locks, 1)
+	require.Equal(delegator, locks[0].Delegator)
+	require.Equal(ctx.BlockHeight()+int64(3*params.BlocksPerMonth), locks[0].BlockUnlocked)
+
+	_, err = s.msgServer.RemoveDelegateStake(ctx, &types.RemoveDelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topicId,
+		Reputer: reputer,
+		Amount:  cosmosMath.NewInt(1),
+	})
+	require.ErrorIs(err, types.ErrStakeLocked)
+	redelegateMsg := &types.RedelegateStakeRequest{
+		Sender:     delegator,
+		TopicId:    topicId,
+		SrcReputer: reputer,
+		DstReputer: otherReputer,
+		Amount:     cosmosMath.NewInt(1),
+	}
+	_, err = s.msgServer.RedelegateStake(ctx, redelegateMsg)
+	require.ErrorIs(err, types.ErrStakeLocked)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(3*params.BlocksPerMonth))
+	_, err = s.msgServer.RedelegateStake(ctx, redelegateMsg)
+	require.NoError(err)
+}
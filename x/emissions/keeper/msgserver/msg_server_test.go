@@ -89,6 +89,7 @@ func (s *MsgServerTestSuite) SetupTest() {
 		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraRewardEscrowAccountName:              {"minter"},
 		minttypes.EcosystemModuleName:                    nil,
 		"bonded_tokens_pool":                             {"burner", "staking"},
 		"not_bonded_tokens_pool":                         {"burner", "staking"},
@@ -190,22 +191,35 @@ func (s *MsgServerTestSuite) CreateCustomEpochTopic(epochLen int64) types.Topic
 	creator := 9
 	// Create a CreateNewTopicRequest message
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  s.addrsStr[creator],
-		Metadata:                 metadata,
-		LossMethod:               "mse",
-		EpochLength:              epochLen,
-		GroundTruthLag:           epochLen,
-		AllowNegative:            false,
-		WorkerSubmissionWindow:   10,
-		AlphaRegret:              alloraMath.NewDecFromInt64(1),
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       s.addrsStr[creator],
+		Metadata:                      metadata,
+		LossMethod:                    "mse",
+		EpochLength:                   epochLen,
+		GroundTruthLag:                epochLen,
+		AllowNegative:                 false,
+		WorkerSubmissionWindow:        10,
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 
 	s.MintTokensToAddress(s.addrs[creator], types.DefaultParams().CreateTopicFee)
@@ -214,23 +228,35 @@ func (s *MsgServerTestSuite) CreateCustomEpochTopic(epochLen int64) types.Topic
 	require.NoError(err, "CreateTopic fails on first creation")
 
 	return types.Topic{
-		Id:                       result.TopicId,
-		Creator:                  newTopicMsg.Creator,
-		Metadata:                 newTopicMsg.Metadata,
-		LossMethod:               newTopicMsg.LossMethod,
-		EpochLength:              newTopicMsg.EpochLength,
-		EpochLastEnded:           0,
-		InitialRegret:            alloraMath.ZeroDec(),
-		GroundTruthLag:           newTopicMsg.GroundTruthLag,
-		WorkerSubmissionWindow:   newTopicMsg.WorkerSubmissionWindow,
-		AlphaRegret:              newTopicMsg.AlphaRegret,
-		AllowNegative:            newTopicMsg.AllowNegative,
-		PNorm:                    newTopicMsg.PNorm,
-		Epsilon:                  newTopicMsg.Epsilon,
-		MeritSortitionAlpha:      newTopicMsg.MeritSortitionAlpha,
-		ActiveInfererQuantile:    newTopicMsg.ActiveInfererQuantile,
-		ActiveForecasterQuantile: newTopicMsg.ActiveForecasterQuantile,
-		ActiveReputerQuantile:    newTopicMsg.ActiveReputerQuantile,
+		Id:                            result.TopicId,
+		Creator:                       newTopicMsg.Creator,
+		Metadata:                      newTopicMsg.Metadata,
+		LossMethod:                    newTopicMsg.LossMethod,
+		EpochLength:                   newTopicMsg.EpochLength,
+		EpochLastEnded:                0,
+		InitialRegret:                 alloraMath.ZeroDec(),
+		GroundTruthLag:                newTopicMsg.GroundTruthLag,
+		WorkerSubmissionWindow:        newTopicMsg.WorkerSubmissionWindow,
+		AlphaRegret:                   newTopicMsg.AlphaRegret,
+		AllowNegative:                 newTopicMsg.AllowNegative,
+		PNorm:                         newTopicMsg.PNorm,
+		Epsilon:                       newTopicMsg.Epsilon,
+		MeritSortitionAlpha:           newTopicMsg.MeritSortitionAlpha,
+		ActiveInfererQuantile:         newTopicMsg.ActiveInfererQuantile,
+		ActiveForecasterQuantile:      newTopicMsg.ActiveForecasterQuantile,
+		ActiveReputerQuantile:         newTopicMsg.ActiveReputerQuantile,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 }
 
@@ -243,22 +269,35 @@ func (s *MsgServerTestSuite) TestCreateSeveralTopics() {
 	creator := s.addrs[0]
 
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  creator.String(),
-		Metadata:                 metadata,
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		AllowNegative:            false,
-		AlphaRegret:              alloraMath.NewDecFromInt64(1),
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       creator.String(),
+		Metadata:                      metadata,
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		AllowNegative:                 false,
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 
 	creatorInitialBalance := types.DefaultParams().CreateTopicFee.Mul(cosmosMath.NewInt(3))
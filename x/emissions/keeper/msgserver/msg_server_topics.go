@@ -51,23 +51,37 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.CreateNewTopi
 	}
 
 	topic := types.Topic{
-		Id:                       topicId,
-		Creator:                  msg.Creator,
-		Metadata:                 msg.Metadata,
-		LossMethod:               msg.LossMethod,
-		EpochLastEnded:           0,
-		EpochLength:              msg.EpochLength,
-		GroundTruthLag:           msg.GroundTruthLag,
-		WorkerSubmissionWindow:   msg.WorkerSubmissionWindow,
-		PNorm:                    msg.PNorm,
-		AlphaRegret:              msg.AlphaRegret,
-		AllowNegative:            msg.AllowNegative,
-		Epsilon:                  msg.Epsilon,
-		InitialRegret:            alloraMath.ZeroDec(),
-		MeritSortitionAlpha:      msg.MeritSortitionAlpha,
-		ActiveInfererQuantile:    msg.ActiveInfererQuantile,
-		ActiveForecasterQuantile: msg.ActiveForecasterQuantile,
-		ActiveReputerQuantile:    msg.ActiveReputerQuantile,
+		Id:                            topicId,
+		Creator:                       msg.Creator,
+		Metadata:                      msg.Metadata,
+		LossMethod:                    msg.LossMethod,
+		EpochLastEnded:                0,
+		EpochLength:                   msg.EpochLength,
+		GroundTruthLag:                msg.GroundTruthLag,
+		WorkerSubmissionWindow:        msg.WorkerSubmissionWindow,
+		PNorm:                         msg.PNorm,
+		AlphaRegret:                   msg.AlphaRegret,
+		AllowNegative:                 msg.AllowNegative,
+		Epsilon:                       msg.Epsilon,
+		InitialRegret:                 alloraMath.ZeroDec(),
+		MeritSortitionAlpha:           msg.MeritSortitionAlpha,
+		ActiveInfererQuantile:         msg.ActiveInfererQuantile,
+		ActiveForecasterQuantile:      msg.ActiveForecasterQuantile,
+		ActiveReputerQuantile:         msg.ActiveReputerQuantile,
+		OutputDimension:               msg.OutputDimension,
+		VectorSynthesisMode:           msg.VectorSynthesisMode,
+		QuantileLevels:                msg.QuantileLevels,
+		LossComputationMode:           msg.LossComputationMode,
+		WorkerRevealWindow:            msg.WorkerRevealWindow,
+		WorkerBond:                    msg.WorkerBond,
+		WorkerBondSlashMissedEpochs:   msg.WorkerBondSlashMissedEpochs,
+		WorkerBondSlashFraction:       msg.WorkerBondSlashFraction,
+		ReputerSlashDistanceThreshold: msg.ReputerSlashDistanceThreshold,
+		ReputerSlashEpochs:            msg.ReputerSlashEpochs,
+		ReputerSelfSlashFraction:      msg.ReputerSelfSlashFraction,
+		ReputerDelegateSlashFraction:  msg.ReputerDelegateSlashFraction,
+		RewardEscrowFraction:          msg.RewardEscrowFraction,
+		RewardEscrowVestingBlocks:     msg.RewardEscrowVestingBlocks,
 	}
 	_, err = ms.k.IncrementTopicId(ctx)
 	if err != nil {
@@ -76,6 +90,9 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.CreateNewTopi
 	if err := ms.k.SetTopic(ctx, topicId, topic); err != nil {
 		return nil, err
 	}
+	if err := ms.k.SetTopicTags(ctx, topicId, msg.Tags); err != nil {
+		return nil, err
+	}
 
 	// Turn topic whitelist on by default so no one can squeeze in payloads before an admin notices or can act
 	if msg.EnableWorkerWhitelist {
@@ -94,3 +111,104 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.CreateNewTopi
 	err = ms.k.AddTopicFeeRevenue(ctx, topicId, params.CreateTopicFee)
 	return &types.CreateNewTopicResponse{TopicId: topicId}, err
 }
+
+// UpdateTopic queues changes to a topic's parameters. The changes are validated now against
+// the current topic, but only applied at the topic's next epoch boundary.
+func (ms msgServer) UpdateTopic(ctx context.Context, msg *types.UpdateTopicRequest) (_ *types.UpdateTopicResponse, err error) {
+	defer metrics.RecordMetrics("UpdateTopic", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	canUpdate, err := ms.k.CanUpdateTopic(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if !canUpdate {
+		return nil, types.ErrNotPermittedToUpdateTopic
+	}
+
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "Error getting params for sender: %v", &msg.Sender)
+	}
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Later updates within the same epoch overwrite earlier ones field by field
+	pending, _, err := ms.k.GetPendingTopicUpdate(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	pending = msg.Params.MergeInto(pending)
+
+	updatedTopic := pending.ApplyToTopic(topic)
+	if updatedTopic.EpochLength < params.MinEpochLength {
+		return nil, types.ErrTopicCadenceBelowMinimum
+	}
+	if uint64(updatedTopic.GroundTruthLag) > params.MaxUnfulfilledReputerRequests*uint64(updatedTopic.EpochLength) {
+		return nil, types.ErrGroundTruthLagTooBig
+	}
+	if err := updatedTopic.Validate(params); err != nil {
+		return nil, err
+	}
+
+	return &types.UpdateTopicResponse{}, ms.k.SetPendingTopicUpdate(ctx, msg.TopicId, pending)
+}
+
+// Retires a topic for good: it is inactivated, its unspent fee revenue is refunded to the owner,
+// all stake on it starts unbonding and its per-topic state is pruned over the following blocks
+func (ms msgServer) ArchiveTopic(ctx context.Context, msg *types.ArchiveTopicRequest) (_ *types.ArchiveTopicResponse, err error) {
+	defer metrics.RecordMetrics("ArchiveTopic", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	canArchive, err := ms.k.CanArchiveTopic(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if !canArchive {
+		return nil, types.ErrNotPermittedToArchiveTopic
+	}
+
+	return &types.ArchiveTopicResponse{}, ms.k.ArchiveTopic(ctx, msg.TopicId)
+}
+
+// Proposes a new owner for a topic. Ownership only moves once the proposed owner accepts it
+func (ms msgServer) ProposeTopicOwner(ctx context.Context, msg *types.ProposeTopicOwnerRequest) (_ *types.ProposeTopicOwnerResponse, err error) {
+	defer metrics.RecordMetrics("ProposeTopicOwner", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	canTransfer, err := ms.k.CanTransferTopicOwnership(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if !canTransfer {
+		return nil, types.ErrNotPermittedToTransferTopicOwnership
+	}
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
+	return &types.ProposeTopicOwnerResponse{}, ms.k.ProposeTopicOwner(ctx, msg.TopicId, msg.NewOwner)
+}
+
+// Completes a topic ownership transfer. Only the proposed owner can accept it
+func (ms msgServer) AcceptTopicOwnership(ctx context.Context, msg *types.AcceptTopicOwnershipRequest) (_ *types.AcceptTopicOwnershipResponse, err error) {
+	defer metrics.RecordMetrics("AcceptTopicOwnership", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
+	return &types.AcceptTopicOwnershipResponse{}, ms.k.AcceptTopicOwnership(ctx, msg.TopicId, msg.Sender)
+}
@@ -3,8 +3,11 @@ package msgserver_test
 import (
 	"strings"
 
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 /// Topics tests
@@ -18,22 +21,35 @@ func (s *MsgServerTestSuite) TestMsgCreateNewTopicAndWhitelistCheck() {
 
 	// Create a CreateNewTopicRequest message
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  sender,
-		Metadata:                 "Some metadata for the new topic",
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		AllowNegative:            false,
-		AlphaRegret:              alloraMath.NewDecFromInt64(1),
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       sender,
+		Metadata:                      "Some metadata for the new topic",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		AllowNegative:                 false,
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 
 	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
@@ -86,22 +102,35 @@ func (s *MsgServerTestSuite) TestMsgCreateNewTopicWithEpsilonZeroFails() {
 
 	// Create a CreateNewTopicRequest message
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  sender,
-		Metadata:                 "Some metadata for the new topic",
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		AllowNegative:            false,
-		AlphaRegret:              alloraMath.NewDecFromInt64(1),
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		Epsilon:                  alloraMath.MustNewDecFromString("0"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       sender,
+		Metadata:                      "Some metadata for the new topic",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		AllowNegative:                 false,
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		Epsilon:                       alloraMath.MustNewDecFromString("0"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 
 	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
@@ -112,6 +141,130 @@ func (s *MsgServerTestSuite) TestMsgCreateNewTopicWithEpsilonZeroFails() {
 	s.Require().Nil(result)
 }
 
+func (s *MsgServerTestSuite) TestMsgCreateNewTopicWithTags() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	senderAddr := s.addrs[0]
+	sender := s.addrsStr[0]
+
+	newTopicMsg := &types.CreateNewTopicRequest{
+		Creator:                       sender,
+		Metadata:                      "Some metadata for the new topic",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		AllowNegative:                 false,
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          []string{"price", "eth", "price"},
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
+	}
+
+	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
+
+	// Duplicate tags are rejected
+	_, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	newTopicMsg.Tags = []string{"price", "eth"}
+	result, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	tags, err := s.emissionsKeeper.GetTopicTags(ctx, result.TopicId)
+	require.NoError(err)
+	require.Equal([]string{"eth", "price"}, tags)
+}
+
+func (s *MsgServerTestSuite) TestMsgCreateNewProbabilisticTopic() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	senderAddr := s.addrs[0]
+	sender := s.addrsStr[0]
+
+	levels := []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("0.1"),
+		alloraMath.MustNewDecFromString("0.5"),
+		alloraMath.MustNewDecFromString("0.9"),
+	}
+	newTopicMsg := &types.CreateNewTopicRequest{
+		Creator:                       sender,
+		Metadata:                      "Some metadata for the new topic",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		AllowNegative:                 false,
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               3,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                levels,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
+	}
+
+	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
+
+	// Quantiles must be scored with a proper scoring rule
+	_, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidQuantiles)
+
+	newTopicMsg.LossMethod = types.LossMethodPinball
+	newTopicMsg.OutputDimension = 2
+	_, err = msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidQuantiles)
+
+	newTopicMsg.OutputDimension = 3
+	newTopicMsg.QuantileLevels = []alloraMath.Dec{levels[0], levels[2], levels[1]}
+	_, err = msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidQuantiles)
+
+	newTopicMsg.QuantileLevels = levels
+	result, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.True(topic.IsProbabilistic())
+	require.Equal(levels, topic.QuantileLevels)
+}
+
 func (s *MsgServerTestSuite) TestUpdateTopicEpochLastEnded() {
 	ctx := s.ctx
 	require := s.Require()
@@ -137,22 +290,35 @@ func (s *MsgServerTestSuite) TestMsgCreateNewTopicTooLongMetadataFails() {
 	sender := s.addrsStr[0]
 
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  sender,
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		AllowNegative:            false,
-		AlphaRegret:              alloraMath.NewDecFromInt64(1),
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		Metadata:                 strings.Repeat("a", 257),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       sender,
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		AllowNegative:                 false,
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		Metadata:                      strings.Repeat("a", 257),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 
 	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
@@ -171,22 +337,35 @@ func (s *MsgServerTestSuite) TestMsgCreateNewTopicTooLongLossMethodFails() {
 	sender := s.addrsStr[0]
 
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  sender,
-		Metadata:                 "Some metadata for the new topic",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		AllowNegative:            false,
-		AlphaRegret:              alloraMath.NewDecFromInt64(1),
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		LossMethod:               strings.Repeat("a", 257),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       sender,
+		Metadata:                      "Some metadata for the new topic",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		AllowNegative:                 false,
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		LossMethod:                    strings.Repeat("a", 257),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 
 	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
@@ -196,3 +375,388 @@ func (s *MsgServerTestSuite) TestMsgCreateNewTopicTooLongLossMethodFails() {
 	require.Nil(result)
 	require.ErrorContains(err, "loss method invalid")
 }
+
+func (s *MsgServerTestSuite) TestMsgUpdateTopicPermissions() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	outsider := s.addrsStr[1]
+	err := s.emissionsKeeper.RemoveWhitelistAdmin(ctx, outsider)
+	require.NoError(err)
+
+	updateMsg := &types.UpdateTopicRequest{
+		Sender:  outsider,
+		TopicId: topic.Id,
+		Params: &types.OptionalTopicParams{
+			EpochLength: []int64{topic.EpochLength / 2},
+		},
+	}
+	_, err = msgServer.UpdateTopic(ctx, updateMsg)
+	require.ErrorIs(err, types.ErrNotPermittedToUpdateTopic)
+
+	// The topic creator can update the topic
+	updateMsg.Sender = topic.Creator
+	_, err = msgServer.UpdateTopic(ctx, updateMsg)
+	require.NoError(err)
+
+	// A whitelist admin can update the topic
+	err = s.emissionsKeeper.AddWhitelistAdmin(ctx, outsider)
+	require.NoError(err)
+	updateMsg.Sender = outsider
+	_, err = msgServer.UpdateTopic(ctx, updateMsg)
+	require.NoError(err)
+
+	// Unknown topics cannot be updated
+	updateMsg.TopicId = topic.Id + 1
+	_, err = msgServer.UpdateTopic(ctx, updateMsg)
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateTopicValidation() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	params, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+
+	testCases := []struct {
+		name        string
+		params      *types.OptionalTopicParams
+		expectedErr error
+	}{
+		{
+			name:        "no params",
+			params:      &types.OptionalTopicParams{},
+			expectedErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:        "more than one value",
+			params:      &types.OptionalTopicParams{EpochLength: []int64{topic.EpochLength, topic.EpochLength}},
+			expectedErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:        "epoch length below minimum",
+			params:      &types.OptionalTopicParams{EpochLength: []int64{params.MinEpochLength - 1}},
+			expectedErr: types.ErrTopicCadenceBelowMinimum,
+		},
+		{
+			name:        "ground truth lag too big for epoch length",
+			params:      &types.OptionalTopicParams{EpochLength: []int64{topic.GroundTruthLag / int64(params.MaxUnfulfilledReputerRequests+1)}},
+			expectedErr: types.ErrGroundTruthLagTooBig,
+		},
+		{
+			name:        "worker submission window above epoch length",
+			params:      &types.OptionalTopicParams{WorkerSubmissionWindow: []int64{topic.EpochLength + 1}},
+			expectedErr: sdkerrors.ErrInvalidType,
+		},
+		{
+			name:        "p-norm out of range",
+			params:      &types.OptionalTopicParams{PNorm: []alloraMath.Dec{alloraMath.NewDecFromInt64(5)}},
+			expectedErr: sdkerrors.ErrInvalidType,
+		},
+		{
+			name:        "alpha regret out of range",
+			params:      &types.OptionalTopicParams{AlphaRegret: []alloraMath.Dec{alloraMath.ZeroDec()}},
+			expectedErr: sdkerrors.ErrInvalidType,
+		},
+		{
+			name:        "quantile out of range",
+			params:      &types.OptionalTopicParams{ActiveReputerQuantile: []alloraMath.Dec{alloraMath.NewDecFromInt64(2)}},
+			expectedErr: sdkerrors.ErrInvalidType,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{
+				Sender:  topic.Creator,
+				TopicId: topic.Id,
+				Params:  tc.params,
+			})
+			require.ErrorIs(err, tc.expectedErr)
+		})
+	}
+
+	_, found, err := s.emissionsKeeper.GetPendingTopicUpdate(ctx, topic.Id)
+	require.NoError(err)
+	require.False(found, "rejected updates must not be queued")
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateTopicAppliedAtActivation() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	newEpochLength := topic.EpochLength / 2
+	newPNorm := alloraMath.MustNewDecFromString("2.5")
+
+	_, err := msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{
+		Sender:  topic.Creator,
+		TopicId: topic.Id,
+		Params:  &types.OptionalTopicParams{EpochLength: []int64{newEpochLength}},
+	})
+	require.NoError(err)
+	// A later update in the same epoch is merged field by field
+	_, err = msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{
+		Sender:  topic.Creator,
+		TopicId: topic.Id,
+		Params:  &types.OptionalTopicParams{PNorm: []alloraMath.Dec{newPNorm}},
+	})
+	require.NoError(err)
+
+	// Nothing changes until the topic reaches an epoch boundary
+	storedTopic, err := s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(topic.EpochLength, storedTopic.EpochLength)
+	require.True(topic.PNorm.Equal(storedTopic.PNorm))
+
+	err = s.emissionsKeeper.ActivateTopic(ctx, topic.Id)
+	require.NoError(err)
+
+	storedTopic, err = s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(newEpochLength, storedTopic.EpochLength)
+	require.True(newPNorm.Equal(storedTopic.PNorm))
+	require.True(topic.AlphaRegret.Equal(storedTopic.AlphaRegret))
+
+	// The next epoch is scheduled with the new epoch length
+	churningBlock, active, err := s.emissionsKeeper.GetNextPossibleChurningBlockByTopicId(ctx, topic.Id)
+	require.NoError(err)
+	require.True(active)
+	require.Equal(ctx.BlockHeight()+newEpochLength, churningBlock)
+
+	_, found, err := s.emissionsKeeper.GetPendingTopicUpdate(ctx, topic.Id)
+	require.NoError(err)
+	require.False(found)
+
+	events := ctx.EventManager().Events()
+	found = false
+	for _, event := range events {
+		if event.Type == "emissions.v7.EventTopicUpdated" {
+			found = true
+		}
+	}
+	require.True(found, "EventTopicUpdated should be emitted")
+}
+
+func (s *MsgServerTestSuite) TestMsgArchiveTopicPermissions() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	outsider := s.addrsStr[1]
+	err := s.emissionsKeeper.RemoveWhitelistAdmin(ctx, outsider)
+	require.NoError(err)
+
+	archiveMsg := &types.ArchiveTopicRequest{
+		Sender:  outsider,
+		TopicId: topic.Id,
+	}
+	_, err = msgServer.ArchiveTopic(ctx, archiveMsg)
+	require.ErrorIs(err, types.ErrNotPermittedToArchiveTopic)
+
+	// The topic creator can archive the topic
+	archiveMsg.Sender = topic.Creator
+	_, err = msgServer.ArchiveTopic(ctx, archiveMsg)
+	require.NoError(err)
+
+	isArchived, err := s.emissionsKeeper.IsTopicArchived(ctx, topic.Id)
+	require.NoError(err)
+	require.True(isArchived)
+
+	// A topic can only be archived once
+	_, err = msgServer.ArchiveTopic(ctx, archiveMsg)
+	require.ErrorIs(err, types.ErrTopicArchived)
+
+	// A whitelist admin can archive another topic
+	otherTopic := s.CreateOneTopic()
+	err = s.emissionsKeeper.AddWhitelistAdmin(ctx, outsider)
+	require.NoError(err)
+	_, err = msgServer.ArchiveTopic(ctx, &types.ArchiveTopicRequest{Sender: outsider, TopicId: otherTopic.Id})
+	require.NoError(err)
+
+	// Unknown topics cannot be archived
+	archiveMsg.TopicId = otherTopic.Id + 1
+	_, err = msgServer.ArchiveTopic(ctx, archiveMsg)
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+}
+
+func (s *MsgServerTestSuite) TestMsgTopicOwnershipTransfer() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	topic := s.CreateOneTopic()
+	creator := topic.Creator
+	newOwner := s.addrsStr[1]
+	outsider := s.addrsStr[2]
+	for _, addr := range []string{creator, newOwner, outsider} {
+		err := keeper.RemoveWhitelistAdmin(ctx, addr)
+		require.NoError(err)
+	}
+
+	// Only the current owner can propose a new owner
+	_, err := msgServer.ProposeTopicOwner(ctx, &types.ProposeTopicOwnerRequest{
+		Sender:   outsider,
+		TopicId:  topic.Id,
+		NewOwner: outsider,
+	})
+	require.ErrorIs(err, types.ErrNotPermittedToTransferTopicOwnership)
+
+	// Nothing to accept before a proposal is made
+	acceptMsg := &types.AcceptTopicOwnershipRequest{
+		Sender:  newOwner,
+		TopicId: topic.Id,
+	}
+	_, err = msgServer.AcceptTopicOwnership(ctx, acceptMsg)
+	require.ErrorIs(err, types.ErrNoPendingTopicOwnershipTransfer)
+
+	_, err = msgServer.ProposeTopicOwner(ctx, &types.ProposeTopicOwnerRequest{
+		Sender:   creator,
+		TopicId:  topic.Id,
+		NewOwner: newOwner,
+	})
+	require.NoError(err)
+
+	// Ownership does not move until the proposal is accepted
+	owner, err := keeper.GetTopicOwner(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(creator, owner)
+	pendingOwner, found, err := keeper.GetPendingTopicOwner(ctx, topic.Id)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(newOwner, pendingOwner)
+
+	_, err = msgServer.AcceptTopicOwnership(ctx, &types.AcceptTopicOwnershipRequest{
+		Sender:  outsider,
+		TopicId: topic.Id,
+	})
+	require.ErrorIs(err, types.ErrNotPendingTopicOwner)
+
+	_, err = msgServer.AcceptTopicOwnership(ctx, acceptMsg)
+	require.NoError(err)
+
+	owner, err = keeper.GetTopicOwner(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(newOwner, owner)
+	_, found, err = keeper.GetPendingTopicOwner(ctx, topic.Id)
+	require.NoError(err)
+	require.False(found)
+
+	// The creator field itself is left untouched
+	storedTopic, err := keeper.GetTopic(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(creator, storedTopic.Creator)
+
+	// Creator-gated permissions now follow the new owner
+	_, err = msgServer.AddToTopicWorkerWhitelist(ctx, &types.AddToTopicWorkerWhitelistRequest{
+		Sender:  creator,
+		Address: outsider,
+		TopicId: topic.Id,
+	})
+	require.ErrorIs(err, types.ErrNotPermittedToUpdateTopicWorkerWhitelist)
+	_, err = msgServer.AddToTopicWorkerWhitelist(ctx, &types.AddToTopicWorkerWhitelistRequest{
+		Sender:  newOwner,
+		Address: outsider,
+		TopicId: topic.Id,
+	})
+	require.NoError(err)
+
+	_, err = msgServer.ProposeTopicOwner(ctx, &types.ProposeTopicOwnerRequest{
+		Sender:   creator,
+		TopicId:  topic.Id,
+		NewOwner: creator,
+	})
+	require.ErrorIs(err, types.ErrNotPermittedToTransferTopicOwnership)
+
+	_, err = msgServer.ArchiveTopic(ctx, &types.ArchiveTopicRequest{Sender: creator, TopicId: topic.Id})
+	require.ErrorIs(err, types.ErrNotPermittedToArchiveTopic)
+	_, err = msgServer.ArchiveTopic(ctx, &types.ArchiveTopicRequest{Sender: newOwner, TopicId: topic.Id})
+	require.NoError(err)
+
+	// Archived topics cannot change hands
+	_, err = msgServer.ProposeTopicOwner(ctx, &types.ProposeTopicOwnerRequest{
+		Sender:   newOwner,
+		TopicId:  topic.Id,
+		NewOwner: outsider,
+	})
+	require.ErrorIs(err, types.ErrTopicArchived)
+}
+
+func (s *MsgServerTestSuite) TestMsgArchiveTopicRefundsAndUnstakes() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	topic := s.CreateOneTopic()
+	creatorAddr := s.addrs[9]
+	reputer := s.addrsStr[1]
+	delegator := s.addrsStr[2]
+	funder := s.addrsStr[3]
+
+	reputerStake := cosmosMath.NewInt(1000)
+	delegateStake := cosmosMath.NewInt(300)
+	err := keeper.AddReputerStake(ctx, topic.Id, reputer, reputerStake)
+	require.NoError(err)
+	err = keeper.AddDelegateStake(ctx, topic.Id, delegator, reputer, delegateStake)
+	require.NoError(err)
+
+	fundAmount := cosmosMath.NewInt(500)
+	s.MintTokensToAddress(s.addrs[3], fundAmount)
+	_, err = msgServer.FundTopic(ctx, &types.FundTopicRequest{Sender: funder, TopicId: topic.Id, Amount: fundAmount})
+	require.NoError(err)
+	feeRevenue, err := keeper.GetTopicFeeRevenue(ctx, topic.Id)
+	require.NoError(err)
+	require.True(feeRevenue.IsPositive())
+
+	// An earlier partial removal is replaced by the removal of the full stake
+	_, err = msgServer.RemoveStake(ctx, &types.RemoveStakeRequest{Sender: reputer, TopicId: topic.Id, Amount: cosmosMath.NewInt(10)})
+	require.NoError(err)
+
+	creatorBalanceBefore := s.bankKeeper.GetBalance(ctx, creatorAddr, params.DefaultBondDenom)
+	_, err = msgServer.ArchiveTopic(ctx, &types.ArchiveTopicRequest{Sender: topic.Creator, TopicId: topic.Id})
+	require.NoError(err)
+
+	// Leftover fee revenue goes back to the creator
+	creatorBalanceAfter := s.bankKeeper.GetBalance(ctx, creatorAddr, params.DefaultBondDenom)
+	require.Equal(creatorBalanceBefore.Amount.Add(feeRevenue), creatorBalanceAfter.Amount)
+	feeRevenue, err = keeper.GetTopicFeeRevenue(ctx, topic.Id)
+	require.NoError(err)
+	require.True(feeRevenue.IsZero())
+
+	isActive, err := keeper.IsTopicActive(ctx, topic.Id)
+	require.NoError(err)
+	require.False(isActive)
+
+	// All stake on the topic is on its way out
+	removal, found, err := keeper.GetStakeRemovalForReputerAndTopicId(ctx, reputer, topic.Id)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(reputerStake, removal.Amount)
+	// delegated stake is scheduled for removal by the end blocker
+	err = keeper.PruneArchivedTopics(ctx, 100)
+	require.NoError(err)
+	delegateRemoval, found, err := keeper.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(ctx, delegator, reputer, topic.Id)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(delegateStake, delegateRemoval.Amount)
+
+	// Archived topics are still found, but reject new activity
+	_, err = keeper.GetTopic(ctx, topic.Id)
+	require.NoError(err)
+	_, err = msgServer.AddStake(ctx, &types.AddStakeRequest{Sender: reputer, TopicId: topic.Id, Amount: reputerStake})
+	require.ErrorIs(err, types.ErrTopicArchived)
+	_, err = msgServer.CancelRemoveStake(ctx, &types.CancelRemoveStakeRequest{Sender: reputer, TopicId: topic.Id})
+	require.ErrorIs(err, types.ErrTopicArchived)
+	_, err = msgServer.FundTopic(ctx, &types.FundTopicRequest{Sender: funder, TopicId: topic.Id, Amount: fundAmount})
+	require.ErrorIs(err, types.ErrTopicArchived)
+
+	// and can never be activated again
+	err = keeper.ActivateTopic(ctx, topic.Id)
+	require.NoError(err)
+	isActive, err = keeper.IsTopicActive(ctx, topic.Id)
+	require.NoError(err)
+	require.False(isActive)
+}
@@ -0,0 +1,149 @@
+package msgserver
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddWorkerBond bonds funds of a registered worker on a topic that requires workers to bond
+func (ms msgServer) AddWorkerBond(ctx context.Context, msg *types.AddWorkerBondRequest) (_ *types.AddWorkerBondResponse, err error) {
+	defer metrics.RecordMetrics("AddWorkerBond", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, types.ErrTopicDoesNotExist
+	}
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+	if !topic.RequiresWorkerBond() {
+		return nil, types.ErrWorkerBondNotRequired
+	}
+
+	isWorkerRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if !isWorkerRegistered {
+		return nil, types.ErrAddressIsNotRegisteredInThisTopic
+	}
+
+	// Bonds are held in the staking account, the bank module checks the sender has enough funds
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, msg.Amount))
+	err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Sender, types.AlloraStakingAccountName, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.AddWorkerBond(ctx, msg.TopicId, msg.Sender, msg.Amount)
+	return &types.AddWorkerBondResponse{}, err
+}
+
+// RemoveWorkerBond starts the removal of part of a worker bond. Like stake removals, the removal is
+// delayed and paid out by the ABCI endBlocker, and calling it again overwrites the pending removal.
+// The bond can still be slashed during the delay.
+func (ms msgServer) RemoveWorkerBond(ctx context.Context, msg *types.RemoveWorkerBondRequest) (_ *types.RemoveWorkerBondResponse, err error) {
+	defer metrics.RecordMetrics("RemoveWorkerBond", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
+	bond, err := ms.k.GetWorkerBond(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Amount.GT(bond.Amount) {
+		return nil, types.ErrInsufficientWorkerBond
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	removal, found, err := ms.k.GetWorkerBondRemovalForWorkerAndTopicId(ctx, msg.Sender, msg.TopicId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error while searching previous worker bond removal")
+	}
+	if found {
+		err = ms.k.DeleteWorkerBondRemoval(ctx, removal.BlockRemovalCompleted, removal.TopicId, removal.Worker)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to delete previous worker bond removal")
+		}
+	}
+	err = ms.k.SetWorkerBondRemoval(ctx, types.WorkerBondRemovalInfo{
+		BlockRemovalStarted:   sdkCtx.BlockHeight(),
+		BlockRemovalCompleted: sdkCtx.BlockHeight() + moduleParams.RemoveStakeDelayWindow,
+		TopicId:               msg.TopicId,
+		Worker:                msg.Sender,
+		Amount:                msg.Amount,
+	})
+	return &types.RemoveWorkerBondResponse{}, err
+}
+
+// CancelRemoveWorkerBond cancels a pending worker bond removal during the delay window
+func (ms msgServer) CancelRemoveWorkerBond(ctx context.Context, msg *types.CancelRemoveWorkerBondRequest) (_ *types.CancelRemoveWorkerBondResponse, err error) {
+	defer metrics.RecordMetrics("CancelRemoveWorkerBond", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+
+	removal, found, err := ms.k.GetWorkerBondRemovalForWorkerAndTopicId(ctx, msg.Sender, msg.TopicId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error while searching previous worker bond removal")
+	}
+	if !found {
+		return nil, types.ErrStakeRemovalNotFound
+	}
+	err = ms.k.DeleteWorkerBondRemoval(ctx, removal.BlockRemovalCompleted, removal.TopicId, removal.Worker)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to delete previous worker bond removal")
+	}
+	return &types.CancelRemoveWorkerBondResponse{}, nil
+}
+
+// checkWorkerHasSufficientBond returns an error if the topic requires a worker bond
+// and the worker has not bonded enough
+func checkWorkerHasSufficientBond(ctx context.Context, ms msgServer, topic types.Topic, worker string) error {
+	hasBond, err := ms.k.HasSufficientWorkerBond(ctx, topic, worker)
+	if err != nil {
+		return err
+	}
+	if !hasBond {
+		return errorsmod.Wrapf(types.ErrInsufficientWorkerBond,
+			"worker %s must bond at least %s on topic %d", worker, topic.WorkerBond, topic.Id)
+	}
+	return nil
+}
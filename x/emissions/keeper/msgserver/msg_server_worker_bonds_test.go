@@ -0,0 +1,137 @@
+package msgserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// requireWorkerBond makes the topic require workers to bond the given amount
+func (s *MsgServerTestSuite) requireWorkerBond(topicId uint64, amount cosmosMath.Int) {
+	topic, err := s.emissionsKeeper.GetTopic(s.ctx, topicId)
+	s.Require().NoError(err)
+	topic.WorkerBond = amount
+	topic.WorkerBondSlashMissedEpochs = 3
+	topic.WorkerBondSlashFraction = alloraMath.MustNewDecFromString("0.1")
+	s.Require().NoError(s.emissionsKeeper.SetTopic(s.ctx, topicId, topic))
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadRequiresWorkerBond() {
+	require := s.Require()
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	worker := workerMsg.WorkerDataBundle.Worker
+	ctx := s.ctx.WithBlockHeight(workerMsg.WorkerDataBundle.Nonce.BlockHeight)
+	require.NoError(s.emissionsKeeper.AddToTopicWorkerWhitelist(ctx, topicId, worker))
+
+	bondAmount := cosmosMath.NewInt(1000)
+	s.requireWorkerBond(topicId, bondAmount)
+
+	_, err := s.msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrInsufficientWorkerBond)
+
+	s.MintTokensToAddress(sdk.AccAddress(workerPrivateKey.PubKey().Address()), bondAmount)
+	_, err = s.msgServer.AddWorkerBond(ctx, &types.AddWorkerBondRequest{
+		Sender:  worker,
+		TopicId: topicId,
+		Amount:  bondAmount,
+	})
+	require.NoError(err)
+
+	_, err = s.msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestMsgAddWorkerBond() {
+	require := s.Require()
+	topic := s.CreateOneTopic()
+	workerAddr, worker := getNewAddress()
+	s.MintTokensToAddress(workerAddr, cosmosMath.NewInt(1000))
+
+	addMsg := &types.AddWorkerBondRequest{
+		Sender:  worker,
+		TopicId: topic.Id,
+		Amount:  cosmosMath.NewInt(600),
+	}
+	_, err := s.msgServer.AddWorkerBond(s.ctx, addMsg)
+	require.ErrorIs(err, types.ErrWorkerBondNotRequired)
+
+	s.requireWorkerBond(topic.Id, cosmosMath.NewInt(500))
+	_, err = s.msgServer.AddWorkerBond(s.ctx, addMsg)
+	require.ErrorIs(err, types.ErrAddressIsNotRegisteredInThisTopic)
+
+	require.NoError(s.emissionsKeeper.InsertWorker(s.ctx, topic.Id, worker, types.OffchainNode{
+		Owner:       worker,
+		NodeAddress: worker,
+	}))
+	_, err = s.msgServer.AddWorkerBond(s.ctx, addMsg)
+	require.NoError(err)
+
+	bond, err := s.emissionsKeeper.GetWorkerBond(s.ctx, topic.Id, worker)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(600), bond.Amount)
+	total, err := s.emissionsKeeper.GetTotalWorkerBonds(s.ctx)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(600), total)
+	require.Equal(cosmosMath.NewInt(400), s.bankKeeper.GetBalance(s.ctx, workerAddr, params.DefaultBondDenom).Amount)
+}
+
+func (s *MsgServerTestSuite) TestMsgRemoveAndCancelWorkerBond() {
+	require := s.Require()
+	topic := s.CreateOneTopic()
+	workerAddr, worker := getNewAddress()
+	s.MintTokensToAddress(workerAddr, cosmosMath.NewInt(1000))
+	s.requireWorkerBond(topic.Id, cosmosMath.NewInt(500))
+	require.NoError(s.emissionsKeeper.InsertWorker(s.ctx, topic.Id, worker, types.OffchainNode{
+		Owner:       worker,
+		NodeAddress: worker,
+	}))
+	_, err := s.msgServer.AddWorkerBond(s.ctx, &types.AddWorkerBondRequest{
+		Sender:  worker,
+		TopicId: topic.Id,
+		Amount:  cosmosMath.NewInt(500),
+	})
+	require.NoError(err)
+
+	removeMsg := &types.RemoveWorkerBondRequest{
+		Sender:  worker,
+		TopicId: topic.Id,
+		Amount:  cosmosMath.NewInt(501),
+	}
+	_, err = s.msgServer.RemoveWorkerBond(s.ctx, removeMsg)
+	require.ErrorIs(err, types.ErrInsufficientWorkerBond)
+
+	removeMsg.Amount = cosmosMath.NewInt(200)
+	_, err = s.msgServer.RemoveWorkerBond(s.ctx, removeMsg)
+	require.NoError(err)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	removal, found, err := s.emissionsKeeper.GetWorkerBondRemovalForWorkerAndTopicId(s.ctx, worker, topic.Id)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(200), removal.Amount)
+	require.Equal(s.ctx.BlockHeight()+moduleParams.RemoveStakeDelayWindow, removal.BlockRemovalCompleted)
+
+	// The bond still counts until the removal completes
+	bond, err := s.emissionsKeeper.GetWorkerBond(s.ctx, topic.Id, worker)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(500), bond.Amount)
+
+	cancelMsg := &types.CancelRemoveWorkerBondRequest{
+		Sender:  worker,
+		TopicId: topic.Id,
+	}
+	_, err = s.msgServer.CancelRemoveWorkerBond(s.ctx, cancelMsg)
+	require.NoError(err)
+	_, found, err = s.emissionsKeeper.GetWorkerBondRemovalForWorkerAndTopicId(s.ctx, worker, topic.Id)
+	require.NoError(err)
+	require.False(found)
+
+	_, err = s.msgServer.CancelRemoveWorkerBond(s.ctx, cancelMsg)
+	require.ErrorIs(err, types.ErrStakeRemovalNotFound)
+}
@@ -1,9 +1,12 @@
 package msgserver
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"time"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	actorutils "github.com/allora-network/allora-chain/x/emissions/keeper/actor_utils"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
@@ -40,6 +43,9 @@ func (ms msgServer) InsertWorkerPayload(ctx context.Context, msg *types.InsertWo
 		return nil, errorsmod.Wrapf(err,
 			"Worker invalid data for block: %d", blockHeight)
 	}
+	if err := checkWorkerDataBundleSigner(ctx, ms, msg.WorkerDataBundle); err != nil {
+		return nil, err
+	}
 
 	moduleParams, err := ms.k.GetParams(ctx)
 	if err != nil {
@@ -57,6 +63,16 @@ func (ms msgServer) InsertWorkerPayload(ctx context.Context, msg *types.InsertWo
 	if err != nil {
 		return nil, types.ErrInvalidTopicId
 	}
+
+	if err := checkTopicIsNotArchived(ctx, ms, topicId); err != nil {
+		return nil, err
+	}
+	if topic.UsesCommitReveal() {
+		return nil, errorsmod.Wrap(types.ErrCommitRevealModeMismatch, "workers of this topic commit to their payload and reveal it later")
+	}
+	if err := checkWorkerHasSufficientBond(ctx, ms, topic, msg.WorkerDataBundle.Worker); err != nil {
+		return nil, err
+	}
 	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, topicId, nonce)
 	if err != nil {
 		return nil, err
@@ -85,31 +101,54 @@ func (ms msgServer) InsertWorkerPayload(ctx context.Context, msg *types.InsertWo
 		return nil, err
 	}
 
+	err = ms.appendWorkerDataBundle(sdkCtx, topic, moduleParams, msg.WorkerDataBundle)
+	if err != nil {
+		return nil, err
+	}
+	return &types.InsertWorkerPayloadResponse{}, nil
+}
+
+// Appends the inference and forecast of a worker data bundle to the submissions of the open nonce
+func (ms msgServer) appendWorkerDataBundle(
+	ctx sdk.Context,
+	topic types.Topic,
+	moduleParams types.Params,
+	bundle *types.WorkerDataBundle,
+) error {
+	nonce := bundle.Nonce
+	topicId := bundle.TopicId
+
 	// Process Inferences
-	if msg.WorkerDataBundle.InferenceForecastsBundle.Inference != nil {
-		inference := msg.WorkerDataBundle.InferenceForecastsBundle.Inference
+	if bundle.InferenceForecastsBundle.Inference != nil {
+		inference := bundle.InferenceForecastsBundle.Inference
 		if inference == nil {
-			return nil, errorsmod.Wrapf(types.ErrNoValidInferences, "Inference not found")
+			return errorsmod.Wrapf(types.ErrNoValidInferences, "Inference not found")
 		}
-		if inference.TopicId != msg.WorkerDataBundle.TopicId {
-			return nil, errorsmod.Wrapf(types.ErrInvalidTopicId,
+		if inference.TopicId != bundle.TopicId {
+			return errorsmod.Wrapf(types.ErrInvalidTopicId,
 				"inferer not using the same topic as bundle")
 		}
+		if err := topic.ValidateInferenceOutput(inference); err != nil {
+			return err
+		}
 
-		err = ms.k.AppendInference(sdkCtx, topic, nonce.BlockHeight, inference, moduleParams.MaxTopInferersToReward)
+		err := ms.k.AppendInference(ctx, topic, nonce.BlockHeight, inference, moduleParams.MaxTopInferersToReward)
 		if err != nil {
-			return nil, errorsmod.Wrapf(err, "Error appending inference")
+			return errorsmod.Wrapf(err, "Error appending inference")
 		}
 	}
 
 	// Process Forecasts
-	if msg.WorkerDataBundle.InferenceForecastsBundle.Forecast != nil {
-		forecast := msg.WorkerDataBundle.InferenceForecastsBundle.Forecast
+	if bundle.InferenceForecastsBundle.Forecast != nil {
+		forecast := bundle.InferenceForecastsBundle.Forecast
 		if len(forecast.ForecastElements) == 0 {
-			return nil, errorsmod.Wrapf(types.ErrNoValidForecastElements, "No valid forecast elements found in Forecast")
+			return errorsmod.Wrapf(types.ErrNoValidForecastElements, "No valid forecast elements found in Forecast")
+		}
+		if forecast.TopicId != bundle.TopicId {
+			return errorsmod.Wrapf(types.ErrInvalidTopicId, "forecaster not using the same topic as bundle")
 		}
-		if forecast.TopicId != msg.WorkerDataBundle.TopicId {
-			return nil, errorsmod.Wrapf(types.ErrInvalidTopicId, "forecaster not using the same topic as bundle")
+		if err := topic.ValidateForecastOutput(forecast); err != nil {
+			return err
 		}
 
 		// Limit forecast elements to top inferers
@@ -123,7 +162,7 @@ func (ms msgServer) InsertWorkerPayload(ctx context.Context, msg *types.InsertWo
 		}
 
 		_, _, topNInferer := actorutils.FindTopNByScoreDesc(
-			sdkCtx,
+			ctx,
 			moduleParams.MaxElementsPerForecast,
 			latestScoresForForecastedInferers,
 			forecast.BlockHeight,
@@ -136,10 +175,10 @@ func (ms msgServer) InsertWorkerPayload(ctx context.Context, msg *types.InsertWo
 			// Check if the forecasted inferer is registered in the topic
 			isInfererRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, el.Inferer)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			if !isInfererRegistered {
-				return nil, errorsmod.Wrapf(err,
+				return errorsmod.Wrapf(err,
 					"Error forecasted inferer address is not registered in this topic")
 			}
 
@@ -153,12 +192,197 @@ func (ms msgServer) InsertWorkerPayload(ctx context.Context, msg *types.InsertWo
 
 		if len(acceptedForecastElements) > 0 {
 			forecast.ForecastElements = acceptedForecastElements
-			err = ms.k.AppendForecast(sdkCtx, topic, nonce.BlockHeight, forecast, moduleParams.MaxTopForecastersToReward)
+			err := ms.k.AppendForecast(ctx, topic, nonce.BlockHeight, forecast, moduleParams.MaxTopForecastersToReward)
 			if err != nil {
-				return nil, errorsmod.Wrapf(err,
+				return errorsmod.Wrapf(err,
 					"Error appending forecast")
 			}
 		}
 	}
-	return &types.InsertWorkerPayloadResponse{}, nil
+	return nil
+}
+
+// CommitWorkerPayload stores the commitment of a worker to its payload for the open nonce of a topic
+// using commit-reveal. Workers commit for themselves, so the sender is the worker. The payload itself is only submitted once the worker submission window is over,
+// so workers cannot copy the inferences of others. The data sending fee is paid on commit.
+func (ms msgServer) CommitWorkerPayload(ctx context.Context, msg *types.CommitWorkerPayloadRequest) (_ *types.CommitWorkerPayloadResponse, err error) {
+	defer metrics.RecordMetrics("CommitWorkerPayload", time.Now(), &err)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	blockHeight := sdkCtx.BlockHeight()
+	err = msg.Validate()
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "Worker invalid commit for block: %d", blockHeight)
+	}
+	worker := msg.CommittingWorker()
+	if err := checkSignerIsAuthorized(ctx, ms, msg.TopicId, worker, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	canSubmit, err := ms.k.CanSubmitWorkerPayload(ctx, msg.TopicId, worker)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "Error checking if worker can submit payload")
+	} else if !canSubmit {
+		return nil, errorsmod.Wrapf(types.ErrNotPermittedToSubmitWorkerPayload, "Worker is not permitted to submit payload")
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "Error getting params")
+	}
+	err = checkInputLength(moduleParams.MaxSerializedMsgLength, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, types.ErrInvalidTopicId
+	}
+
+	if err := checkTopicIsNotArchived(ctx, ms, msg.TopicId); err != nil {
+		return nil, err
+	}
+	if !topic.UsesCommitReveal() {
+		return nil, errorsmod.Wrap(types.ErrCommitRevealModeMismatch, "workers of this topic submit their payload in the clear")
+	}
+	if err := checkWorkerHasSufficientBond(ctx, ms, topic, worker); err != nil {
+		return nil, err
+	}
+	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, msg.TopicId, msg.Nonce)
+	if err != nil {
+		return nil, err
+	} else if !nonceUnfulfilled {
+		return nil, types.ErrUnfulfilledNonceNotFound
+	}
+
+	// Note: this is exclusive of the end block height
+	if !ms.k.BlockWithinWorkerSubmissionWindowOfNonce(topic, *msg.Nonce, blockHeight) {
+		return nil, errorsmod.Wrapf(
+			types.ErrWorkerNonceWindowNotAvailable,
+			"Worker window not open for topic: %d, current block %d, start window: %d, end window: %d",
+			msg.TopicId, blockHeight, msg.Nonce.BlockHeight, msg.Nonce.BlockHeight+topic.WorkerSubmissionWindow,
+		)
+	}
+
+	isWorkerRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, msg.TopicId, worker)
+	if err != nil {
+		return nil, err
+	} else if !isWorkerRegistered {
+		return nil, errorsmod.Wrapf(types.ErrAddressNotRegistered, "worker is not registered in this topic")
+	}
+
+	err = sendEffectiveRevenueActivateTopicIfWeightSufficient(ctx, ms, msg.Sender, msg.TopicId, moduleParams.DataSendingFee)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.InsertWorkerPayloadCommit(ctx, types.WorkerPayloadCommit{
+		TopicId:     msg.TopicId,
+		BlockHeight: msg.Nonce.BlockHeight,
+		Worker:      worker,
+		Commitment:  msg.Commitment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.CommitWorkerPayloadResponse{}, nil
+}
+
+// RevealWorkerPayload submits the payload a worker committed to during the worker submission window.
+// Reveals are only accepted during the worker reveal window and must match the commitment of the worker.
+// Workers that commit without revealing are treated as having missed the epoch.
+func (ms msgServer) RevealWorkerPayload(ctx context.Context, msg *types.RevealWorkerPayloadRequest) (_ *types.RevealWorkerPayloadResponse, err error) {
+	defer metrics.RecordMetrics("RevealWorkerPayload", time.Now(), &err)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	blockHeight := sdkCtx.BlockHeight()
+	err = msg.Validate()
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "Worker invalid reveal for block: %d", blockHeight)
+	}
+
+	bundle := msg.WorkerDataBundle
+	if err := checkWorkerDataBundleSigner(ctx, ms, bundle); err != nil {
+		return nil, err
+	}
+	canSubmit, err := ms.k.CanSubmitWorkerPayload(ctx, bundle.TopicId, bundle.Worker)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "Error checking if worker can submit payload")
+	} else if !canSubmit {
+		return nil, errorsmod.Wrapf(types.ErrNotPermittedToSubmitWorkerPayload, "Worker is not permitted to submit payload")
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "Error getting params")
+	}
+	err = checkInputLength(moduleParams.MaxSerializedMsgLength, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := bundle.Nonce
+	topicId := bundle.TopicId
+
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return nil, types.ErrInvalidTopicId
+	}
+
+	if err := checkTopicIsNotArchived(ctx, ms, topicId); err != nil {
+		return nil, err
+	}
+	if !topic.UsesCommitReveal() {
+		return nil, errorsmod.Wrap(types.ErrCommitRevealModeMismatch, "workers of this topic submit their payload in the clear")
+	}
+	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, topicId, nonce)
+	if err != nil {
+		return nil, err
+	} else if !nonceUnfulfilled {
+		return nil, types.ErrUnfulfilledNonceNotFound
+	}
+
+	// Note: this is exclusive of the end block height
+	if !ms.k.BlockWithinWorkerRevealWindowOfNonce(topic, *nonce, blockHeight) {
+		return nil, errorsmod.Wrapf(
+			types.ErrWorkerNonceWindowNotAvailable,
+			"Worker reveal window not open for topic: %d, current block %d, start window: %d, end window: %d",
+			topicId, blockHeight, nonce.BlockHeight+topic.WorkerSubmissionWindow,
+			nonce.BlockHeight+topic.WorkerSubmissionWindow+topic.WorkerRevealWindow,
+		)
+	}
+
+	isWorkerRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, bundle.Worker)
+	if err != nil {
+		return nil, err
+	} else if !isWorkerRegistered {
+		return nil, errorsmod.Wrapf(types.ErrAddressNotRegistered, "worker is not registered in this topic")
+	}
+
+	commit, err := ms.k.GetWorkerPayloadCommit(ctx, topicId, bundle.Worker)
+	if errors.Is(err, collections.ErrNotFound) || (err == nil && commit.BlockHeight != nonce.BlockHeight) {
+		return nil, errorsmod.Wrapf(types.ErrWorkerPayloadCommitNotFound, "no commit of worker %s for nonce %d", bundle.Worker, nonce.BlockHeight)
+	} else if err != nil {
+		return nil, err
+	}
+	commitment, err := types.WorkerPayloadCommitment(bundle.InferenceForecastsBundle, msg.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(commitment, commit.Commitment) {
+		return nil, types.ErrRevealDoesNotMatchCommit
+	}
+
+	// A commit can only be revealed once
+	err = ms.k.RemoveWorkerPayloadCommit(ctx, topicId, bundle.Worker)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.appendWorkerDataBundle(sdkCtx, topic, moduleParams, bundle)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RevealWorkerPayloadResponse{}, nil
 }
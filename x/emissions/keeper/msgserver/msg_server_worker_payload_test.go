@@ -74,6 +74,7 @@ func (s *MsgServerTestSuite) setUpMsgInsertWorkerPayloadWithBlockHeight(
 					Value:       alloraMath.NewDecFromInt64(100),
 					ExtraData:   nil,
 					Proof:       "",
+					Values:      nil,
 				},
 				Forecast: &types.Forecast{
 					TopicId:     topic.Id,
@@ -83,18 +84,22 @@ func (s *MsgServerTestSuite) setUpMsgInsertWorkerPayloadWithBlockHeight(
 						{
 							Inferer: worker,
 							Value:   alloraMath.NewDecFromInt64(100),
+							Values:  nil,
 						},
 						{
 							Inferer: Inferer2,
 							Value:   alloraMath.NewDecFromInt64(101),
+							Values:  nil,
 						},
 						{
 							Inferer: Inferer3,
 							Value:   alloraMath.NewDecFromInt64(102),
+							Values:  nil,
 						},
 						{
 							Inferer: Inferer4,
 							Value:   alloraMath.NewDecFromInt64(103),
+							Values:  nil,
 						},
 					},
 					ExtraData: nil,
@@ -371,6 +376,16 @@ func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadWithFewTopElementsPerFore
 		GlobalReputerWhitelistEnabled:       nil,
 		GlobalAdminWhitelistAppended:        nil,
 		MaxWhitelistInputArrayLength:        nil,
+		MaxArchivedTopicPrunesPerBlock:      nil,
+		MaxReputerStakeShare:                nil,
+		StakeAboveCapExponent:               nil,
+		StakeLockTiers:                      nil,
+		RewardHistoryRetentionBlocks:        nil,
+		MaxRewardPayoutsPerBlock:            nil,
+		MaxRewardHistoryPrunesPerBlock:      nil,
+		MaxReputerCommissionRate:            nil,
+		MaxReputerCommissionChangeRate:      nil,
+		MaxRewardPayoutAttempts:             nil,
 	}
 
 	updateMsg := &types.UpdateParamsRequest{
@@ -525,6 +540,7 @@ func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadFiltersDuplicateForecastE
 	duplicateElement := &types.ForecastElement{
 		Inferer: originalElement.Inferer,
 		Value:   originalElement.Value,
+		Values:  nil,
 	}
 	forecast.ForecastElements = append(forecast.ForecastElements, duplicateElement)
 	// END MODIFICATION
@@ -592,6 +608,7 @@ func (s *MsgServerTestSuite) TestInsertingHugeBundleWorkerPayloadFails() {
 		forecastElements = append(forecastElements, &types.ForecastElement{
 			Inferer: InfererAddr,
 			Value:   alloraMath.NewDecFromInt64(100),
+			Values:  nil,
 		})
 	}
 
@@ -610,6 +627,7 @@ func (s *MsgServerTestSuite) TestInsertingHugeBundleWorkerPayloadFails() {
 					Value:       alloraMath.NewDecFromInt64(100),
 					ExtraData:   nil,
 					Proof:       "",
+					Values:      nil,
 				},
 				Forecast: &types.Forecast{
 					TopicId:          topicId,
@@ -686,6 +704,7 @@ func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadVerifyFailed() {
 					Value:       alloraMath.NewDecFromInt64(100),
 					ExtraData:   nil,
 					Proof:       "",
+					Values:      nil,
 				},
 				Forecast: &types.Forecast{
 					TopicId:     topicId,
@@ -695,10 +714,12 @@ func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadVerifyFailed() {
 						{
 							Inferer: Inferer,
 							Value:   alloraMath.NewDecFromInt64(100),
+							Values:  nil,
 						},
 						{
 							Inferer: Inferer2,
 							Value:   alloraMath.NewDecFromInt64(100),
+							Values:  nil,
 						},
 					},
 					ExtraData: nil,
@@ -778,6 +799,16 @@ func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadWithLowScoreForecastsAreR
 		GlobalReputerWhitelistEnabled:       nil,
 		GlobalAdminWhitelistAppended:        nil,
 		MaxWhitelistInputArrayLength:        nil,
+		MaxArchivedTopicPrunesPerBlock:      nil,
+		MaxReputerStakeShare:                nil,
+		StakeAboveCapExponent:               nil,
+		StakeLockTiers:                      nil,
+		RewardHistoryRetentionBlocks:        nil,
+		MaxRewardPayoutsPerBlock:            nil,
+		MaxRewardHistoryPrunesPerBlock:      nil,
+		MaxReputerCommissionRate:            nil,
+		MaxReputerCommissionChangeRate:      nil,
+		MaxRewardPayoutAttempts:             nil,
 	}
 
 	updateMsg := &types.UpdateParamsRequest{
@@ -889,3 +920,175 @@ func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadWorkerNotMatchSignature()
 	_, err = msgServer.InsertWorkerPayload(ctx, &workerMsg)
 	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
 }
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadVectorOutputDimension() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	topic.OutputDimension = 3
+	err = s.emissionsKeeper.SetTopic(ctx, topicId, topic)
+	require.NoError(err)
+
+	blockHeight := workerMsg.WorkerDataBundle.InferenceForecastsBundle.Forecast.BlockHeight
+	ctx = ctx.WithBlockHeight(blockHeight)
+	err = s.emissionsKeeper.AddToTopicWorkerWhitelist(ctx, topicId, workerMsg.WorkerDataBundle.Worker)
+	require.NoError(err)
+
+	// A scalar inference is rejected on a vector topic
+	scalarMsg := s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = msgServer.InsertWorkerPayload(ctx, &scalarMsg)
+	require.ErrorIs(err, types.ErrInvalidOutputDimension)
+
+	workerMsg.WorkerDataBundle.InferenceForecastsBundle.Inference.Values = []alloraMath.Dec{
+		alloraMath.NewDecFromInt64(1),
+		alloraMath.NewDecFromInt64(2),
+		alloraMath.NewDecFromInt64(3),
+	}
+	vectorMsg := s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = msgServer.InsertWorkerPayload(ctx, &vectorMsg)
+	require.NoError(err)
+
+	inference, err := s.emissionsKeeper.GetWorkerLatestInferenceByTopicId(ctx, topicId, workerMsg.WorkerDataBundle.Worker)
+	require.NoError(err)
+	require.Len(inference.Values, 3)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadUnorderedQuantilesFails() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	topic.OutputDimension = 2
+	topic.LossMethod = types.LossMethodCRPS
+	topic.QuantileLevels = []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("0.25"),
+		alloraMath.MustNewDecFromString("0.75"),
+	}
+	err = s.emissionsKeeper.SetTopic(ctx, topicId, topic)
+	require.NoError(err)
+
+	blockHeight := workerMsg.WorkerDataBundle.InferenceForecastsBundle.Forecast.BlockHeight
+	ctx = ctx.WithBlockHeight(blockHeight)
+	err = s.emissionsKeeper.AddToTopicWorkerWhitelist(ctx, topicId, workerMsg.WorkerDataBundle.Worker)
+	require.NoError(err)
+
+	workerMsg.WorkerDataBundle.InferenceForecastsBundle.Inference.Values = []alloraMath.Dec{
+		alloraMath.NewDecFromInt64(5),
+		alloraMath.NewDecFromInt64(4),
+	}
+	unorderedMsg := s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = msgServer.InsertWorkerPayload(ctx, &unorderedMsg)
+	require.ErrorIs(err, types.ErrInvalidQuantiles)
+
+	workerMsg.WorkerDataBundle.InferenceForecastsBundle.Inference.Values = []alloraMath.Dec{
+		alloraMath.NewDecFromInt64(4),
+		alloraMath.NewDecFromInt64(5),
+	}
+	orderedMsg := s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = msgServer.InsertWorkerPayload(ctx, &orderedMsg)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestMsgCommitRevealWorkerPayload() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	worker := workerMsg.WorkerDataBundle.Worker
+	nonce := workerMsg.WorkerDataBundle.Nonce
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	topic.WorkerSubmissionWindow = 5
+	topic.WorkerRevealWindow = 5
+	err = s.emissionsKeeper.SetTopic(ctx, topicId, topic)
+	require.NoError(err)
+
+	ctx = ctx.WithBlockHeight(nonce.BlockHeight)
+	err = s.emissionsKeeper.AddToTopicWorkerWhitelist(ctx, topicId, worker)
+	require.NoError(err)
+
+	// Payloads cannot be submitted in the clear
+	_, err = msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrCommitRevealModeMismatch)
+
+	salt := []byte("salt")
+	commitment, err := types.WorkerPayloadCommitment(workerMsg.WorkerDataBundle.InferenceForecastsBundle, salt)
+	require.NoError(err)
+	_, err = msgServer.CommitWorkerPayload(ctx, &types.CommitWorkerPayloadRequest{
+		Sender:     worker,
+		TopicId:    topicId,
+		Nonce:      nonce,
+		Commitment: commitment,
+		Worker:     "",
+	})
+	require.NoError(err)
+
+	// Nothing is readable before the reveal
+	_, err = s.emissionsKeeper.GetWorkerLatestInferenceByTopicId(ctx, topicId, worker)
+	require.Error(err)
+
+	revealMsg := &types.RevealWorkerPayloadRequest{
+		Sender:           worker,
+		WorkerDataBundle: workerMsg.WorkerDataBundle,
+		Salt:             salt,
+	}
+	_, err = msgServer.RevealWorkerPayload(ctx, revealMsg)
+	require.ErrorIs(err, types.ErrWorkerNonceWindowNotAvailable)
+
+	ctx = ctx.WithBlockHeight(nonce.BlockHeight + topic.WorkerSubmissionWindow)
+	_, err = msgServer.RevealWorkerPayload(ctx, &types.RevealWorkerPayloadRequest{
+		Sender:           worker,
+		WorkerDataBundle: workerMsg.WorkerDataBundle,
+		Salt:             []byte("other salt"),
+	})
+	require.ErrorIs(err, types.ErrRevealDoesNotMatchCommit)
+
+	_, err = msgServer.RevealWorkerPayload(ctx, revealMsg)
+	require.NoError(err)
+
+	inference, err := s.emissionsKeeper.GetWorkerLatestInferenceByTopicId(ctx, topicId, worker)
+	require.NoError(err)
+	require.Equal(*workerMsg.WorkerDataBundle.InferenceForecastsBundle.Inference, inference)
+
+	// A commit is only revealed once
+	_, err = msgServer.RevealWorkerPayload(ctx, revealMsg)
+	require.ErrorIs(err, types.ErrWorkerPayloadCommitNotFound)
+
+	ctx = ctx.WithBlockHeight(nonce.BlockHeight + topic.WorkerSubmissionWindow + topic.WorkerRevealWindow)
+	_, err = msgServer.RevealWorkerPayload(ctx, revealMsg)
+	require.ErrorIs(err, types.ErrWorkerNonceWindowNotAvailable)
+}
+
+func (s *MsgServerTestSuite) TestMsgCommitWorkerPayloadWithoutCommitRevealFails() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	ctx = ctx.WithBlockHeight(workerMsg.WorkerDataBundle.Nonce.BlockHeight)
+	err := s.emissionsKeeper.AddToTopicWorkerWhitelist(ctx, topicId, workerMsg.WorkerDataBundle.Worker)
+	require.NoError(err)
+
+	commitment, err := types.WorkerPayloadCommitment(workerMsg.WorkerDataBundle.InferenceForecastsBundle, nil)
+	require.NoError(err)
+	_, err = msgServer.CommitWorkerPayload(ctx, &types.CommitWorkerPayloadRequest{
+		Sender:     workerMsg.WorkerDataBundle.Worker,
+		TopicId:    topicId,
+		Nonce:      workerMsg.WorkerDataBundle.Nonce,
+		Commitment: commitment,
+		Worker:     "",
+	})
+	require.ErrorIs(err, types.ErrCommitRevealModeMismatch)
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Returns true if the operator is authorized to submit payloads on behalf of the actor in the topic
+func (k *Keeper) IsOperatorOf(ctx context.Context, topicId TopicId, actor ActorId, operator ActorId) (bool, error) {
+	return k.actorOperators.Has(ctx, collections.Join3(topicId, actor, operator))
+}
+
+// Returns true if payloads of the actor in the topic may be signed by the signer,
+// that is if the signer is the actor itself or one of its operators
+func (k *Keeper) IsAuthorizedSigner(ctx context.Context, topicId TopicId, actor ActorId, signer ActorId) (bool, error) {
+	if signer == actor {
+		return true, nil
+	}
+	return k.IsOperatorOf(ctx, topicId, actor, signer)
+}
+
+// Returns the operators authorized by the actor in the topic, in ascending address order
+func (k *Keeper) GetOperatorsOfActor(ctx context.Context, topicId TopicId, actor ActorId) ([]ActorId, error) {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, ActorId, ActorId](topicId, actor)
+	iter, err := k.actorOperators.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error iterating actor operators")
+	}
+	defer iter.Close()
+
+	operators := make([]ActorId, 0)
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "error getting actor operator key")
+		}
+		operators = append(operators, key.K3())
+	}
+	return operators, nil
+}
+
+// Authorizes the operator to submit payloads on behalf of the actor in the topic
+func (k *Keeper) AuthorizeOperator(ctx context.Context, topicId TopicId, actor ActorId, operator ActorId) error {
+	if err := types.ValidateBech32(operator); err != nil {
+		return errorsmod.Wrap(err, "operator is not valid")
+	}
+	if operator == actor {
+		return errorsmod.Wrap(types.ErrOperatorAlreadyAuthorized, "an actor can always sign its own payloads")
+	}
+	operators, err := k.GetOperatorsOfActor(ctx, topicId, actor)
+	if err != nil {
+		return err
+	}
+	for _, authorized := range operators {
+		if authorized == operator {
+			return types.ErrOperatorAlreadyAuthorized
+		}
+	}
+	if len(operators) >= types.MaxOperatorsPerActor {
+		return errorsmod.Wrapf(types.ErrTooManyOperators, "an actor can authorize at most %d operators per topic", types.MaxOperatorsPerActor)
+	}
+	err = k.actorOperators.Set(ctx, collections.Join3(topicId, actor, operator))
+	if err != nil {
+		return errorsmod.Wrap(err, "error setting actor operator")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	types.EmitNewOperatorAuthorizedEvent(sdkCtx, topicId, sdkCtx.BlockHeight(), actor, operator)
+	return nil
+}
+
+// Revokes the authorization of the operator to submit payloads on behalf of the actor in the topic
+func (k *Keeper) RevokeOperator(ctx context.Context, topicId TopicId, actor ActorId, operator ActorId) error {
+	key := collections.Join3(topicId, actor, operator)
+	isOperator, err := k.actorOperators.Has(ctx, key)
+	if err != nil {
+		return errorsmod.Wrap(err, "error checking actor operator")
+	} else if !isOperator {
+		return types.ErrOperatorNotAuthorized
+	}
+	err = k.actorOperators.Remove(ctx, key)
+	if err != nil {
+		return errorsmod.Wrap(err, "error removing actor operator")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	types.EmitNewOperatorRevokedEvent(sdkCtx, topicId, sdkCtx.BlockHeight(), actor, operator)
+	return nil
+}
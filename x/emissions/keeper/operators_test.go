@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	alloratestutil "github.com/allora-network/allora-chain/test/testutil"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *KeeperTestSuite) TestAuthorizeAndRevokeOperator() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	actor := s.addrsStr[0]
+	operator := s.addrsStr[1]
+
+	isSigner, err := keeper.IsAuthorizedSigner(ctx, topicId, actor, actor)
+	s.Require().NoError(err)
+	s.Require().True(isSigner, "an actor always signs for itself")
+	isSigner, err = keeper.IsAuthorizedSigner(ctx, topicId, actor, operator)
+	s.Require().NoError(err)
+	s.Require().False(isSigner)
+
+	err = keeper.AuthorizeOperator(ctx, topicId, actor, operator)
+	s.Require().NoError(err)
+	err = keeper.AuthorizeOperator(ctx, topicId, actor, operator)
+	s.Require().ErrorIs(err, types.ErrOperatorAlreadyAuthorized)
+
+	isSigner, err = keeper.IsAuthorizedSigner(ctx, topicId, actor, operator)
+	s.Require().NoError(err)
+	s.Require().True(isSigner)
+	isSigner, err = keeper.IsAuthorizedSigner(ctx, topicId+1, actor, operator)
+	s.Require().NoError(err)
+	s.Require().False(isSigner, "operators are authorized per topic")
+	isSigner, err = keeper.IsAuthorizedSigner(ctx, topicId, operator, actor)
+	s.Require().NoError(err)
+	s.Require().False(isSigner, "authorization is not symmetric")
+
+	operators, err := keeper.GetOperatorsOfActor(ctx, topicId, actor)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{operator}, operators)
+
+	err = keeper.RevokeOperator(ctx, topicId, actor, operator)
+	s.Require().NoError(err)
+	err = keeper.RevokeOperator(ctx, topicId, actor, operator)
+	s.Require().ErrorIs(err, types.ErrOperatorNotAuthorized)
+
+	isSigner, err = keeper.IsAuthorizedSigner(ctx, topicId, actor, operator)
+	s.Require().NoError(err)
+	s.Require().False(isSigner)
+	operators, err = keeper.GetOperatorsOfActor(ctx, topicId, actor)
+	s.Require().NoError(err)
+	s.Require().Empty(operators)
+}
+
+func (s *KeeperTestSuite) TestAuthorizeOperatorLimit() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	_, _, _, addrs := alloratestutil.GenerateTestAccounts(types.MaxOperatorsPerActor + 2)
+	actor := addrs[0]
+
+	for i := 1; i <= types.MaxOperatorsPerActor; i++ {
+		err := keeper.AuthorizeOperator(ctx, topicId, actor, addrs[i])
+		s.Require().NoError(err)
+	}
+	err := keeper.AuthorizeOperator(ctx, topicId, actor, addrs[types.MaxOperatorsPerActor+1])
+	s.Require().ErrorIs(err, types.ErrTooManyOperators)
+
+	// Another actor is not limited by the operators of the first one
+	err = keeper.AuthorizeOperator(ctx, topicId, addrs[1], addrs[types.MaxOperatorsPerActor+1])
+	s.Require().NoError(err)
+}
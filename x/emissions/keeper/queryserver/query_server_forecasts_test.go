@@ -22,6 +22,7 @@ func (s *QueryServerTestSuite) TestGetForecastsAtBlock() {
 					{
 						Inferer: s.addrsStr[4],
 						Value:   alloraMath.MustNewDecFromString("0.5"),
+						Values:  nil,
 					},
 				},
 				ExtraData: nil,
@@ -34,6 +35,7 @@ func (s *QueryServerTestSuite) TestGetForecastsAtBlock() {
 					{
 						Inferer: s.addrsStr[4],
 						Value:   alloraMath.MustNewDecFromString("0.5"),
+						Values:  nil,
 					},
 				},
 				ExtraData: nil,
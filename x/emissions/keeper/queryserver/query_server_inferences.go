@@ -37,6 +37,27 @@ func (qs queryServer) GetWorkerLatestInferenceByTopicId(ctx context.Context, req
 	return &emissionstypes.GetWorkerLatestInferenceByTopicIdResponse{LatestInference: &inference}, nil
 }
 
+func (qs queryServer) GetWorkerPayloadCommit(ctx context.Context, req *emissionstypes.GetWorkerPayloadCommitRequest) (_ *emissionstypes.GetWorkerPayloadCommitResponse, err error) {
+	defer metrics.RecordMetrics("GetWorkerPayloadCommit", time.Now(), &err)
+
+	if err = qs.k.ValidateStringIsBech32(req.WorkerAddress); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	commit, err := qs.k.GetWorkerPayloadCommit(ctx, req.TopicId, req.WorkerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emissionstypes.GetWorkerPayloadCommitResponse{Commit: &commit}, nil
+}
+
 func (qs queryServer) GetInferencesAtBlock(ctx context.Context, req *emissionstypes.GetInferencesAtBlockRequest) (_ *emissionstypes.GetInferencesAtBlockResponse, err error) {
 	defer metrics.RecordMetrics("GetInferencesAtBlock", time.Now(), &err)
 
@@ -162,24 +183,11 @@ func (qs queryServer) getLatestNetworkInferencesBase(
 		return nil, err
 	}
 
-	ciRawPercentiles, ciValues, err :=
-		qs.GetConfidenceIntervalsForInferenceData(
-			result.NetworkInferences,
-			result.InfererToWeight,
-			result.ForecasterToWeight,
-		)
+	ciRawPercentiles, ciValues, err := qs.getConfidenceIntervals(params.ctx, params.topicId, result)
 	if err != nil {
 		return nil, err
 	}
 
-	if ciRawPercentiles == nil {
-		ciRawPercentiles = []alloraMath.Dec{}
-	}
-
-	if ciValues == nil {
-		ciValues = []alloraMath.Dec{}
-	}
-
 	inferers := alloraMath.GetSortedKeys(result.InfererToWeight)
 	forecasters := alloraMath.GetSortedKeys(result.ForecasterToWeight)
 
@@ -295,24 +303,11 @@ func (qs queryServer) getLatestAvailableNetworkInferencesBase(
 		return nil, err
 	}
 
-	ciRawPercentiles, ciValues, err :=
-		qs.GetConfidenceIntervalsForInferenceData(
-			result.NetworkInferences,
-			result.InfererToWeight,
-			result.ForecasterToWeight,
-		)
+	ciRawPercentiles, ciValues, err := qs.getConfidenceIntervals(params.ctx, params.topicId, result)
 	if err != nil {
 		return nil, err
 	}
 
-	if ciRawPercentiles == nil {
-		ciRawPercentiles = []alloraMath.Dec{}
-	}
-
-	if ciValues == nil {
-		ciValues = []alloraMath.Dec{}
-	}
-
 	inferers := alloraMath.GetSortedKeys(result.InfererToWeight)
 	forecasters := alloraMath.GetSortedKeys(result.ForecasterToWeight)
 
@@ -394,6 +389,62 @@ func (qs queryServer) GetLatestAvailableNetworkInferencesOutlierResistant(
 	}, nil
 }
 
+// Returns the confidence intervals of network inferences. Probabilistic topics return the
+// network quantiles at their levels, other topics the weighted spread of worker inferences
+func (qs queryServer) getConfidenceIntervals(
+	ctx context.Context,
+	topicId uint64,
+	result *synth.GetNetworkInferencesResult,
+) ([]alloraMath.Dec, []alloraMath.Dec, error) {
+	topic, err := qs.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if topic.IsProbabilistic() {
+		return GetQuantileConfidenceIntervals(topic, result.NetworkInferences)
+	}
+
+	ciRawPercentiles, ciValues, err :=
+		qs.GetConfidenceIntervalsForInferenceData(
+			result.NetworkInferences,
+			result.InfererToWeight,
+			result.ForecasterToWeight,
+		)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if ciRawPercentiles == nil {
+		ciRawPercentiles = []alloraMath.Dec{}
+	}
+
+	if ciValues == nil {
+		ciValues = []alloraMath.Dec{}
+	}
+	return ciRawPercentiles, ciValues, nil
+}
+
+// GetQuantileConfidenceIntervals returns the levels of a probabilistic topic as percentiles
+// along with the network quantiles at those levels
+func GetQuantileConfidenceIntervals(
+	topic emissionstypes.Topic,
+	networkInferences *emissionstypes.ValueBundle,
+) ([]alloraMath.Dec, []alloraMath.Dec, error) {
+	if len(networkInferences.CombinedValues) != len(topic.QuantileLevels) {
+		return []alloraMath.Dec{}, []alloraMath.Dec{}, nil
+	}
+	hundred := alloraMath.NewDecFromInt64(100)
+	ciRawPercentiles := make([]alloraMath.Dec, len(topic.QuantileLevels))
+	for i, level := range topic.QuantileLevels {
+		percentile, err := level.Mul(hundred)
+		if err != nil {
+			return nil, nil, err
+		}
+		ciRawPercentiles[i] = percentile
+	}
+	return ciRawPercentiles, networkInferences.CombinedValues, nil
+}
+
 func (qs queryServer) GetConfidenceIntervalsForInferenceData(
 	networkInferences *emissionstypes.ValueBundle,
 	infererWeights map[string]alloraMath.Dec,
@@ -88,6 +88,7 @@ func (s *QueryServerTestSuite) TestGetWorkerLatestInferenceByTopicId() {
 		Value:       alloraMath.MustNewDecFromString("123.456"),
 		ExtraData:   nil,
 		Proof:       "",
+		Values:      nil,
 	}
 	err = keeper.InsertInference(ctx, topicId, inference)
 	s.Require().NoError(err, "Inserting inferences should succeed")
@@ -140,6 +141,8 @@ func (s *QueryServerTestSuite) TestGetNetworkInferencesAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature1 := s.signValueBundle(&valueBundle1, s.privKeys[5])
 
@@ -156,6 +159,8 @@ func (s *QueryServerTestSuite) TestGetNetworkInferencesAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature2 := s.signValueBundle(&valueBundle2, s.privKeys[6])
 	valueBundle3 := types.ValueBundle{
@@ -171,6 +176,8 @@ func (s *QueryServerTestSuite) TestGetNetworkInferencesAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature3 := s.signValueBundle(&valueBundle3, s.privKeys[7])
 	valueBundle4 := types.ValueBundle{
@@ -186,6 +193,8 @@ func (s *QueryServerTestSuite) TestGetNetworkInferencesAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature4 := s.signValueBundle(&valueBundle4, s.privKeys[8])
 	valueBundle5 := types.ValueBundle{
@@ -201,6 +210,8 @@ func (s *QueryServerTestSuite) TestGetNetworkInferencesAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature5 := s.signValueBundle(&valueBundle5, s.privKeys[9])
 	reputerLossBundles := types.ReputerValueBundles{
@@ -331,6 +342,8 @@ func (s *QueryServerTestSuite) TestGetLatestNetworkInferences() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	})
 	require.NoError(err)
 
@@ -341,6 +354,7 @@ func (s *QueryServerTestSuite) TestGetLatestNetworkInferences() {
 		return types.TimestampedValue{
 			BlockHeight: inferenceBlockHeight,
 			Value:       alloraMath.MustNewDecFromString(value),
+			Values:      nil,
 		}
 	}
 
@@ -416,11 +430,11 @@ func (s *QueryServerTestSuite) TestGetLatestNetworkInferences() {
 			{
 				Forecaster: forecaster0,
 				ForecastElements: []*types.ForecastElement{
-					{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.1")},
-					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.2")},
-					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.3")},
-					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.4")},
-					{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.5")},
+					{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.1"), Values: nil},
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
+					{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.5"), Values: nil},
 				},
 				TopicId:     topicId,
 				BlockHeight: inferenceBlockHeight,
@@ -428,11 +442,11 @@ func (s *QueryServerTestSuite) TestGetLatestNetworkInferences() {
 			{
 				Forecaster: forecaster1,
 				ForecastElements: []*types.ForecastElement{
-					{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.5")},
-					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.4")},
-					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.3")},
-					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.2")},
-					{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.1")},
+					{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.5"), Values: nil},
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+					{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.1"), Values: nil},
 				},
 				TopicId:     topicId,
 				BlockHeight: inferenceBlockHeight,
@@ -440,11 +454,11 @@ func (s *QueryServerTestSuite) TestGetLatestNetworkInferences() {
 			{
 				Forecaster: forecaster2,
 				ForecastElements: []*types.ForecastElement{
-					{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.2")},
-					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.3")},
-					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.4")},
-					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.3")},
-					{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.2")},
+					{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
+					{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+					{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
 				},
 				TopicId:     topicId,
 				BlockHeight: inferenceBlockHeight,
@@ -472,6 +486,78 @@ func (s *QueryServerTestSuite) TestGetLatestNetworkInferences() {
 	require.Equal(len(response.NetworkInferences.ForecasterValues), 3)
 }
 
+func (s *QueryServerTestSuite) TestGetLatestNetworkInferencesOfProbabilisticTopic() {
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+	topic, err := keeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	topic.OutputDimension = 3
+	topic.LossMethod = types.LossMethodPinball
+	topic.QuantileLevels = []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("0.1"),
+		alloraMath.MustNewDecFromString("0.5"),
+		alloraMath.MustNewDecFromString("0.9"),
+	}
+	err = keeper.SetTopic(s.ctx, topicId, topic)
+	require.NoError(err)
+
+	inferenceBlockHeight := topic.EpochLastEnded + topic.EpochLength
+	s.ctx = s.ctx.WithBlockHeight(inferenceBlockHeight)
+
+	newInference := func(inferer string, quantiles ...int64) *types.Inference {
+		values := make([]alloraMath.Dec, len(quantiles))
+		for i, q := range quantiles {
+			values[i] = alloraMath.NewDecFromInt64(q)
+		}
+		return &types.Inference{
+			TopicId:     topicId,
+			BlockHeight: inferenceBlockHeight,
+			Inferer:     inferer,
+			Value:       alloraMath.ZeroDec(),
+			ExtraData:   nil,
+			Proof:       "",
+			Values:      values,
+		}
+	}
+	err = keeper.InsertActiveInferences(s.ctx, topicId, inferenceBlockHeight, types.Inferences{
+		Inferences: []*types.Inference{
+			newInference(s.addrsStr[1], 1, 5, 9),
+			newInference(s.addrsStr[2], 3, 6, 8),
+			newInference(s.addrsStr[3], 2, 4, 12),
+		},
+	})
+	require.NoError(err)
+
+	response, err := queryServer.GetLatestNetworkInferences(
+		s.ctx,
+		&types.GetLatestNetworkInferencesRequest{TopicId: topicId},
+	)
+	require.NoError(err)
+
+	// Without network losses every quantile is the median of the submitted quantiles,
+	// and confidence intervals are the network quantiles at the topic levels
+	expectedPercentiles := []alloraMath.Dec{
+		alloraMath.NewDecFromInt64(10),
+		alloraMath.NewDecFromInt64(50),
+		alloraMath.NewDecFromInt64(90),
+	}
+	expectedQuantiles := []alloraMath.Dec{
+		alloraMath.NewDecFromInt64(2),
+		alloraMath.NewDecFromInt64(5),
+		alloraMath.NewDecFromInt64(9),
+	}
+	require.Len(response.ConfidenceIntervalRawPercentiles, 3)
+	require.Len(response.ConfidenceIntervalValues, 3)
+	for i := range expectedQuantiles {
+		require.True(expectedPercentiles[i].Equal(response.ConfidenceIntervalRawPercentiles[i]))
+		require.True(expectedQuantiles[i].Equal(response.ConfidenceIntervalValues[i]))
+		require.True(expectedQuantiles[i].Equal(response.NetworkInferences.CombinedValues[i]))
+	}
+}
+
 func (s *QueryServerTestSuite) TestIsWorkerNonceUnfulfilled() {
 	ctx := s.ctx
 	keeper := s.emissionsKeeper
@@ -534,10 +620,11 @@ func (s *QueryServerTestSuite) TestGetInfererNetworkRegret() {
 	keeper := s.emissionsKeeper
 	topicId := s.CreateOneTopic()
 	worker := s.addrsStr[1]
-	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10)}
+	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10), Values: nil}
 	emptyRegret := types.TimestampedValue{
 		BlockHeight: 0,
 		Value:       alloraMath.NewDecFromInt64(0),
+		Values:      nil,
 	}
 
 	req := &types.GetInfererNetworkRegretRequest{
@@ -563,10 +650,11 @@ func (s *QueryServerTestSuite) TestGetForecasterNetworkRegret() {
 	keeper := s.emissionsKeeper
 	topicId := s.CreateOneTopic()
 	worker := s.addrsStr[1]
-	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10)}
+	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10), Values: nil}
 	emptyRegret := types.TimestampedValue{
 		BlockHeight: 0,
 		Value:       alloraMath.NewDecFromInt64(0),
+		Values:      nil,
 	}
 
 	req := &types.GetForecasterNetworkRegretRequest{
@@ -593,10 +681,11 @@ func (s *QueryServerTestSuite) TestGetOneInForecasterNetworkRegret() {
 	topicId := s.CreateOneTopic()
 	forecaster := s.addrsStr[3]
 	inferer := s.addrsStr[1]
-	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10)}
+	regret := types.TimestampedValue{BlockHeight: 100, Value: alloraMath.NewDecFromInt64(10), Values: nil}
 	emptyRegret := types.TimestampedValue{
 		BlockHeight: 0,
 		Value:       alloraMath.NewDecFromInt64(0),
+		Values:      nil,
 	}
 
 	req := &types.GetOneInForecasterNetworkRegretRequest{
@@ -646,6 +735,7 @@ func (s *QueryServerTestSuite) TestGetLatestTopicInferences() {
 		Value:       alloraMath.MustNewDecFromString("10"),
 		ExtraData:   []byte("data1"),
 		Proof:       "proof1",
+		Values:      nil,
 	}
 	inferences1 := types.Inferences{
 		Inferences: []*types.Inference{&newInference1},
@@ -663,6 +753,7 @@ func (s *QueryServerTestSuite) TestGetLatestTopicInferences() {
 		Value:       alloraMath.MustNewDecFromString("20"),
 		ExtraData:   []byte("data2"),
 		Proof:       "proof2",
+		Values:      nil,
 	}
 	inferences2 := types.Inferences{
 		Inferences: []*types.Inference{&newInference2},
@@ -718,6 +809,8 @@ func (s *QueryServerTestSuite) TestGetLatestAvailableNetworkInference() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	// Set Loss bundles
 	err = keeper.InsertNetworkLossBundleAtBlock(s.ctx, topicId, lossBlockHeight, lossBundle)
@@ -733,6 +826,7 @@ func (s *QueryServerTestSuite) TestGetLatestAvailableNetworkInference() {
 		return types.TimestampedValue{
 			BlockHeight: inferenceBlockHeight,
 			Value:       alloraMath.MustNewDecFromString(value),
+			Values:      nil,
 		}
 	}
 
@@ -807,11 +901,11 @@ func (s *QueryServerTestSuite) TestGetLatestAvailableNetworkInference() {
 				{
 					Forecaster: forecaster0,
 					ForecastElements: []*types.ForecastElement{
-						{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.1")},
-						{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.2")},
-						{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.3")},
-						{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.4")},
-						{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.5")},
+						{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.1"), Values: nil},
+						{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+						{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+						{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
+						{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.5"), Values: nil},
 					},
 					TopicId:     topicId,
 					BlockHeight: blockHeight,
@@ -819,11 +913,11 @@ func (s *QueryServerTestSuite) TestGetLatestAvailableNetworkInference() {
 				{
 					Forecaster: forecaster1,
 					ForecastElements: []*types.ForecastElement{
-						{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.5")},
-						{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.4")},
-						{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.3")},
-						{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.2")},
-						{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.1")},
+						{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.5"), Values: nil},
+						{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
+						{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+						{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+						{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.1"), Values: nil},
 					},
 					TopicId:     topicId,
 					BlockHeight: blockHeight,
@@ -831,11 +925,11 @@ func (s *QueryServerTestSuite) TestGetLatestAvailableNetworkInference() {
 				{
 					Forecaster: forecaster2,
 					ForecastElements: []*types.ForecastElement{
-						{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.2")},
-						{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.3")},
-						{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.4")},
-						{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.3")},
-						{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.2")},
+						{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+						{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+						{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
+						{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+						{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
 					},
 					TopicId:     topicId,
 					BlockHeight: blockHeight,
@@ -925,6 +1019,8 @@ func (s *QueryServerTestSuite) TestTestGetLatestAvailableNetworkInferenceWithMis
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	err = keeper.InsertNetworkLossBundleAtBlock(s.ctx, topicId, lossBlockHeight, lossBundle)
 	require.NoError(err)
@@ -936,6 +1032,7 @@ func (s *QueryServerTestSuite) TestTestGetLatestAvailableNetworkInferenceWithMis
 		return types.TimestampedValue{
 			BlockHeight: inferenceBlockHeight,
 			Value:       alloraMath.MustNewDecFromString(value),
+			Values:      nil,
 		}
 	}
 
@@ -1010,11 +1107,11 @@ func (s *QueryServerTestSuite) TestTestGetLatestAvailableNetworkInferenceWithMis
 				{
 					Forecaster: forecaster0,
 					ForecastElements: []*types.ForecastElement{
-						{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.1")},
-						{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.2")},
-						{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.3")},
-						{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.4")},
-						{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.5")},
+						{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.1"), Values: nil},
+						{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+						{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+						{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
+						{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.5"), Values: nil},
 					},
 					TopicId:     topicId,
 					BlockHeight: blockHeight,
@@ -1022,11 +1119,11 @@ func (s *QueryServerTestSuite) TestTestGetLatestAvailableNetworkInferenceWithMis
 				{
 					Forecaster: forecaster1,
 					ForecastElements: []*types.ForecastElement{
-						{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.5")},
-						{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.4")},
-						{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.3")},
-						{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.2")},
-						{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.1")},
+						{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.5"), Values: nil},
+						{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
+						{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+						{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+						{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.1"), Values: nil},
 					},
 					TopicId:     topicId,
 					BlockHeight: blockHeight,
@@ -1034,11 +1131,11 @@ func (s *QueryServerTestSuite) TestTestGetLatestAvailableNetworkInferenceWithMis
 				{
 					Forecaster: forecaster2,
 					ForecastElements: []*types.ForecastElement{
-						{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.2")},
-						{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.3")},
-						{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.4")},
-						{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.3")},
-						{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.2")},
+						{Inferer: worker0, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
+						{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+						{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.4"), Values: nil},
+						{Inferer: worker3, Value: alloraMath.MustNewDecFromString("0.3"), Values: nil},
+						{Inferer: worker4, Value: alloraMath.MustNewDecFromString("0.2"), Values: nil},
 					},
 					TopicId:     topicId,
 					BlockHeight: blockHeight,
@@ -1126,3 +1223,40 @@ func (s *QueryServerTestSuite) TestGetActiveInferersForTopic() {
 	s.Require().NotNil(emptyResponse)
 	s.Require().Empty(emptyResponse.Inferers)
 }
+
+func (s *QueryServerTestSuite) TestGetWorkerPayloadCommit() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	queryServer := s.queryServer
+	topicId := s.CreateOneTopic()
+	worker := s.addrsStr[0]
+
+	_, err := queryServer.GetWorkerPayloadCommit(ctx, &types.GetWorkerPayloadCommitRequest{
+		TopicId:       topicId,
+		WorkerAddress: worker,
+	})
+	s.Require().Error(err, "no commit should be found before one is inserted")
+
+	commit := types.WorkerPayloadCommit{
+		TopicId:     topicId,
+		BlockHeight: 10,
+		Worker:      worker,
+		Commitment:  make([]byte, 32),
+	}
+	err = keeper.InsertWorkerPayloadCommit(ctx, commit)
+	s.Require().NoError(err)
+
+	response, err := queryServer.GetWorkerPayloadCommit(ctx, &types.GetWorkerPayloadCommitRequest{
+		TopicId:       topicId,
+		WorkerAddress: worker,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(&commit, response.Commit)
+
+	_, err = queryServer.GetWorkerPayloadCommit(ctx, &types.GetWorkerPayloadCommitRequest{
+		TopicId:       uint64(999),
+		WorkerAddress: worker,
+	})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "not found")
+}
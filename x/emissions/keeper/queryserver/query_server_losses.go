@@ -29,6 +29,42 @@ func (qs queryServer) GetNetworkLossBundleAtBlock(ctx context.Context, req *type
 	return &types.GetNetworkLossBundleAtBlockResponse{LossBundle: networkLoss}, nil
 }
 
+func (qs queryServer) GetConsensusGroundTruthAtBlock(ctx context.Context, req *types.GetConsensusGroundTruthAtBlockRequest) (_ *types.GetConsensusGroundTruthAtBlockResponse, err error) {
+	defer metrics.RecordMetrics("GetConsensusGroundTruthAtBlock", time.Now(), &err)
+
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	groundTruth, err := qs.k.GetConsensusGroundTruthAtBlock(ctx, req.TopicId, req.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetConsensusGroundTruthAtBlockResponse{GroundTruth: &groundTruth}, nil
+}
+
+func (qs queryServer) GetReputerGroundTruth(ctx context.Context, req *types.GetReputerGroundTruthRequest) (_ *types.GetReputerGroundTruthResponse, err error) {
+	defer metrics.RecordMetrics("GetReputerGroundTruth", time.Now(), &err)
+
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	groundTruth, err := qs.k.GetReputerLatestGroundTruthByTopicId(ctx, req.TopicId, req.Reputer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetReputerGroundTruthResponse{GroundTruth: &groundTruth}, nil
+}
+
 func (qs queryServer) IsReputerNonceUnfulfilled(ctx context.Context, req *types.IsReputerNonceUnfulfilledRequest) (_ *types.IsReputerNonceUnfulfilledResponse, err error) {
 	defer metrics.RecordMetrics("IsReputerNonceUnfulfilled", time.Now(), &err)
 
@@ -32,6 +32,8 @@ func (s *QueryServerTestSuite) TestGetNetworkLossBundleAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 
 	err := keeper.InsertNetworkLossBundleAtBlock(ctx, topicId, blockHeight, *expectedBundle)
@@ -50,6 +52,63 @@ func (s *QueryServerTestSuite) TestGetNetworkLossBundleAtBlock() {
 	s.Require().Equal(expectedBundle, response.LossBundle, "Retrieved loss bundle should match the expected bundle")
 }
 
+func (s *QueryServerTestSuite) TestGetConsensusGroundTruthAtBlock() {
+	topicId := s.CreateOneTopic()
+	blockHeight := types.BlockHeight(100)
+
+	expected := types.GroundTruth{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Reputer:     "",
+		Values:      []alloraMath.Dec{alloraMath.MustNewDecFromString("1.5")},
+	}
+	err := s.emissionsKeeper.SetConsensusGroundTruth(s.ctx, expected)
+	s.Require().NoError(err)
+
+	response, err := s.queryServer.GetConsensusGroundTruthAtBlock(
+		s.ctx,
+		&types.GetConsensusGroundTruthAtBlockRequest{
+			TopicId:     topicId,
+			BlockHeight: blockHeight,
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(&expected, response.GroundTruth)
+
+	_, err = s.queryServer.GetConsensusGroundTruthAtBlock(
+		s.ctx,
+		&types.GetConsensusGroundTruthAtBlockRequest{
+			TopicId:     topicId,
+			BlockHeight: blockHeight + 1,
+		},
+	)
+	s.Require().Error(err)
+}
+
+func (s *QueryServerTestSuite) TestGetReputerGroundTruth() {
+	topicId := s.CreateOneTopic()
+	reputer := s.addrsStr[0]
+
+	expected := types.GroundTruth{
+		TopicId:     topicId,
+		BlockHeight: 100,
+		Reputer:     reputer,
+		Values:      []alloraMath.Dec{alloraMath.NewDecFromInt64(2)},
+	}
+	err := s.emissionsKeeper.InsertReputerGroundTruth(s.ctx, topicId, expected)
+	s.Require().NoError(err)
+
+	response, err := s.queryServer.GetReputerGroundTruth(
+		s.ctx,
+		&types.GetReputerGroundTruthRequest{
+			TopicId: topicId,
+			Reputer: reputer,
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(&expected, response.GroundTruth)
+}
+
 func (s *QueryServerTestSuite) TestIsReputerNonceUnfulfilled() {
 	ctx := s.ctx
 	keeper := s.emissionsKeeper
@@ -125,6 +184,8 @@ func (s *QueryServerTestSuite) TestGetReputerLossBundlesAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 	signature := s.signValueBundle(&valueBundle, s.privKeys[0])
 	reputerLossBundles := types.ReputerValueBundles{
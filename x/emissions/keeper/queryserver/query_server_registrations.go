@@ -70,3 +70,24 @@ func (qs queryServer) IsReputerRegisteredInTopicId(ctx context.Context, req *typ
 
 	return &types.IsReputerRegisteredInTopicIdResponse{IsRegistered: isRegistered}, nil
 }
+
+func (qs queryServer) GetActorOperators(ctx context.Context, req *types.GetActorOperatorsRequest) (_ *types.GetActorOperatorsResponse, err error) {
+	defer metrics.RecordMetrics("GetActorOperators", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Actor); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	operators, err := qs.k.GetOperatorsOfActor(ctx, req.TopicId, req.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetActorOperatorsResponse{Operators: operators}, nil
+}
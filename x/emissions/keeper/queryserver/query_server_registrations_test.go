@@ -188,3 +188,36 @@ func (s *QueryServerTestSuite) TestRegisteredReputerIsRegisteredInTopicId() {
 	require.NoError(err, "IsReputerRegisteredInTopicId should not return an error")
 	require.True(queryResp.IsRegistered, "Query response should confirm reputer is registered")
 }
+
+func (s *QueryServerTestSuite) TestGetActorOperators() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	queryServer := s.queryServer
+	topicId := s.CreateOneTopic()
+	actor := s.addrsStr[0]
+
+	response, err := queryServer.GetActorOperators(ctx, &types.GetActorOperatorsRequest{
+		TopicId: topicId,
+		Actor:   actor,
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(response.Operators)
+
+	err = keeper.AuthorizeOperator(ctx, topicId, actor, s.addrsStr[1])
+	s.Require().NoError(err)
+	err = keeper.AuthorizeOperator(ctx, topicId, actor, s.addrsStr[2])
+	s.Require().NoError(err)
+
+	response, err = queryServer.GetActorOperators(ctx, &types.GetActorOperatorsRequest{
+		TopicId: topicId,
+		Actor:   actor,
+	})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]string{s.addrsStr[1], s.addrsStr[2]}, response.Operators)
+
+	_, err = queryServer.GetActorOperators(ctx, &types.GetActorOperatorsRequest{
+		TopicId: uint64(999),
+		Actor:   actor,
+	})
+	s.Require().Error(err)
+}
@@ -4,8 +4,14 @@ import (
 	"context"
 	"time"
 
+	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (qs queryServer) GetPreviousReputerRewardFraction(ctx context.Context, req *types.GetPreviousReputerRewardFractionRequest) (_ *types.GetPreviousReputerRewardFractionResponse, err error) {
@@ -62,3 +68,178 @@ func (qs queryServer) GetTotalRewardToDistribute(ctx context.Context, req *types
 
 	return &types.GetTotalRewardToDistributeResponse{TotalReward: totalReward}, nil
 }
+
+func (qs queryServer) GetActorRewardHistory(ctx context.Context, req *types.GetActorRewardHistoryRequest) (_ *types.GetActorRewardHistoryResponse, err error) {
+	defer metrics.RecordMetrics("GetActorRewardHistory", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+	if req.FromBlock < 0 || req.ToBlock < 0 {
+		return nil, status.Error(codes.InvalidArgument, "block range cannot be negative")
+	}
+	if req.ToBlock != 0 && req.FromBlock > req.ToBlock {
+		return nil, status.Errorf(codes.InvalidArgument, "from block %d is after to block %d", req.FromBlock, req.ToBlock)
+	}
+
+	records, pagination, err := qs.k.GetActorRewardHistory(ctx, req.Address, req.TopicId, req.FromBlock, req.ToBlock, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetActorRewardHistoryResponse{Rewards: records, Pagination: pagination}, nil
+}
+
+func (qs queryServer) GetTopicRewardHistory(ctx context.Context, req *types.GetTopicRewardHistoryRequest) (_ *types.GetTopicRewardHistoryResponse, err error) {
+	defer metrics.RecordMetrics("GetTopicRewardHistory", time.Now(), &err)
+
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	}
+
+	records, pagination, err := qs.k.GetTopicRewardHistory(ctx, req.TopicId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetTopicRewardHistoryResponse{Rewards: records, Pagination: pagination}, nil
+}
+
+func (qs queryServer) GetQueuedRewardPayouts(ctx context.Context, req *types.GetQueuedRewardPayoutsRequest) (_ *types.GetQueuedRewardPayoutsResponse, err error) {
+	defer metrics.RecordMetrics("GetQueuedRewardPayouts", time.Now(), &err)
+
+	if req.Address != "" {
+		if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+		}
+	}
+
+	payouts, pagination, err := qs.k.GetQueuedRewardPayoutsPage(ctx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	totalQueued, err := qs.k.GetTotalQueuedRewardPayouts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetQueuedRewardPayoutsResponse{Payouts: payouts, TotalQueued: totalQueued, Pagination: pagination}, nil
+}
+
+func (qs queryServer) GetDeadLetteredRewardPayouts(ctx context.Context, req *types.GetDeadLetteredRewardPayoutsRequest) (_ *types.GetDeadLetteredRewardPayoutsResponse, err error) {
+	defer metrics.RecordMetrics("GetDeadLetteredRewardPayouts", time.Now(), &err)
+
+	if req.Address != "" {
+		if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+		}
+	}
+
+	payouts, pagination, err := qs.k.GetDeadLetteredRewardPayoutsPage(ctx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetDeadLetteredRewardPayoutsResponse{Payouts: payouts, Pagination: pagination}, nil
+}
+
+func (qs queryServer) GetRewardEscrows(ctx context.Context, req *types.GetRewardEscrowsRequest) (_ *types.GetRewardEscrowsResponse, err error) {
+	defer metrics.RecordMetrics("GetRewardEscrows", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	escrows, err := qs.k.GetRewardEscrows(ctx, req.Address, req.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	vested, unvested, err := qs.k.GetRewardEscrowBalances(ctx, escrows)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*types.RewardEscrow, len(escrows))
+	for i := range escrows {
+		ret[i] = &escrows[i]
+	}
+	return &types.GetRewardEscrowsResponse{Escrows: ret, Vested: vested, Unvested: unvested}, nil
+}
+
+func (qs queryServer) SimulateTopicRewards(ctx context.Context, req *types.SimulateTopicRewardsRequest) (_ *types.SimulateTopicRewardsResponse, err error) {
+	defer metrics.RecordMetrics("SimulateTopicRewards", time.Now(), &err)
+
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	}
+	if err := types.ValidateDec(req.HypotheticalTopicReward); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hypothetical topic reward: %s", err)
+	}
+	if req.HypotheticalTopicReward.IsNegative() {
+		return nil, status.Error(codes.InvalidArgument, "hypothetical topic reward cannot be negative")
+	}
+
+	breakdown, err := rewards.SimulateTopicRewards(
+		sdk.UnwrapSDKContext(ctx), qs.k, req.TopicId, req.BlockHeight, req.HypotheticalTopicReward,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.SimulateTopicRewardsResponse{
+		Inferers: simulatedActorRewards(
+			breakdown.Inferers, breakdown.InfererScores, breakdown.InfererRewardFractions, breakdown.InferenceRewards,
+		),
+		Forecasters: simulatedActorRewards(
+			breakdown.Forecasters, breakdown.ForecasterScores, breakdown.ForecasterRewardFractions, breakdown.ForecastRewards,
+		),
+		Reputers: simulatedActorRewards(
+			breakdown.Reputers, breakdown.ReputerScores, breakdown.ReputerRewardFractions, breakdown.ReputerRewards,
+		),
+		InferenceEntropy:      breakdown.InferenceEntropy,
+		ForecastingEntropy:    breakdown.ForecastingEntropy,
+		ReputerEntropy:        breakdown.ReputerEntropy,
+		Chi:                   breakdown.Chi,
+		Gamma:                 breakdown.Gamma,
+		InferenceTaskReward:   breakdown.TaskInferenceReward,
+		ForecastingTaskReward: breakdown.TaskForecastingReward,
+		ReputerTaskReward:     breakdown.TaskReputerReward,
+	}, nil
+}
+
+// Joins the score, reward fraction and reward of each actor of a task, which are all keyed by address
+func simulatedActorRewards(
+	addresses []string,
+	scores []types.Score,
+	fractions []alloraMath.Dec,
+	taskRewards []types.TaskReward,
+) []*types.SimulatedActorReward {
+	scoreByAddress := make(map[string]alloraMath.Dec, len(scores))
+	for _, score := range scores {
+		scoreByAddress[score.Address] = score.Score
+	}
+	rewardByAddress := make(map[string]alloraMath.Dec, len(taskRewards))
+	for _, reward := range taskRewards {
+		rewardByAddress[reward.Address] = reward.Reward
+	}
+	actorRewards := make([]*types.SimulatedActorReward, len(addresses))
+	for i, address := range addresses {
+		actorReward := &types.SimulatedActorReward{
+			Address:        address,
+			Score:          alloraMath.ZeroDec(),
+			RewardFraction: alloraMath.ZeroDec(),
+			Reward:         alloraMath.ZeroDec(),
+		}
+		if score, ok := scoreByAddress[address]; ok {
+			actorReward.Score = score
+		}
+		if i < len(fractions) {
+			actorReward.RewardFraction = fractions[i]
+		}
+		if reward, ok := rewardByAddress[address]; ok {
+			actorReward.Reward = reward
+		}
+		actorRewards[i] = actorReward
+	}
+	return actorRewards
+}
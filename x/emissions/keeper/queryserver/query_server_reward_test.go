@@ -1,6 +1,7 @@
 package queryserver_test
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 )
@@ -136,3 +137,174 @@ func (s *QueryServerTestSuite) TestGetPreviousPercentageRewardToStakedReputers()
 		previousPercentageReward.String(),
 	)
 }
+
+func (s *QueryServerTestSuite) TestSimulateTopicRewardsInvalidRequest() {
+	ctx := s.ctx
+	topicId := s.CreateOneTopic()
+
+	_, err := s.queryServer.SimulateTopicRewards(ctx, &types.SimulateTopicRewardsRequest{
+		TopicId:                 topicId + 1,
+		BlockHeight:             ctx.BlockHeight(),
+		HypotheticalTopicReward: alloraMath.NewDecFromInt64(100),
+	})
+	s.Require().Error(err)
+
+	_, err = s.queryServer.SimulateTopicRewards(ctx, &types.SimulateTopicRewardsRequest{
+		TopicId:                 topicId,
+		BlockHeight:             ctx.BlockHeight(),
+		HypotheticalTopicReward: alloraMath.NewDecFromInt64(-100),
+	})
+	s.Require().Error(err)
+
+	// there are no loss bundles to reward at the block
+	_, err = s.queryServer.SimulateTopicRewards(ctx, &types.SimulateTopicRewardsRequest{
+		TopicId:                 topicId,
+		BlockHeight:             ctx.BlockHeight(),
+		HypotheticalTopicReward: alloraMath.NewDecFromInt64(100),
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidReward)
+}
+
+func (s *QueryServerTestSuite) TestGetActorRewardHistory() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+	actor := s.addrsStr[0]
+
+	err := keeper.RecordRewards(ctx, []types.TaskReward{
+		{Address: actor, Reward: alloraMath.NewDecFromInt64(10), TopicId: topicId, Type: types.WorkerInferenceRewardType},
+		{Address: actor, Reward: alloraMath.NewDecFromInt64(20), TopicId: topicId, Type: types.WorkerForecastRewardType},
+	})
+	s.Require().NoError(err)
+
+	response, err := s.queryServer.GetActorRewardHistory(ctx, &types.GetActorRewardHistoryRequest{
+		Address:    actor,
+		TopicId:    topicId,
+		Pagination: &types.SimpleCursorPaginationRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Rewards, 1)
+	s.Require().NotEmpty(response.Pagination.NextKey)
+
+	response, err = s.queryServer.GetActorRewardHistory(ctx, &types.GetActorRewardHistoryRequest{
+		Address:    actor,
+		TopicId:    topicId,
+		Pagination: &types.SimpleCursorPaginationRequest{Key: response.Pagination.NextKey, Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Rewards, 1)
+	s.Require().Empty(response.Pagination.NextKey)
+
+	_, err = s.queryServer.GetActorRewardHistory(ctx, &types.GetActorRewardHistoryRequest{Address: "invalid"})
+	s.Require().Error(err)
+	_, err = s.queryServer.GetActorRewardHistory(ctx, &types.GetActorRewardHistoryRequest{
+		Address:   actor,
+		FromBlock: 20,
+		ToBlock:   10,
+	})
+	s.Require().Error(err)
+}
+
+func (s *QueryServerTestSuite) TestGetTopicRewardHistory() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+
+	err := keeper.RecordRewards(ctx, []types.TaskReward{
+		{Address: s.addrsStr[0], Reward: alloraMath.NewDecFromInt64(10), TopicId: topicId, Type: types.ReputerAndDelegatorRewardType},
+	})
+	s.Require().NoError(err)
+
+	response, err := s.queryServer.GetTopicRewardHistory(ctx, &types.GetTopicRewardHistoryRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().Len(response.Rewards, 1)
+	s.Require().Equal(s.addrsStr[0], response.Rewards[0].Address)
+	s.Require().Equal(ctx.BlockHeight(), response.Rewards[0].BlockHeight)
+
+	_, err = s.queryServer.GetTopicRewardHistory(ctx, &types.GetTopicRewardHistoryRequest{TopicId: topicId + 1})
+	s.Require().Error(err)
+}
+
+func (s *QueryServerTestSuite) TestGetRewardEscrows() {
+	ctx := s.ctx.WithBlockHeight(100)
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+	actor := s.addrsStr[0]
+
+	topic, err := keeper.GetTopic(ctx, topicId)
+	s.Require().NoError(err)
+	topic.RewardEscrowFraction = alloraMath.MustNewDecFromString("0.5")
+	topic.RewardEscrowVestingBlocks = 100
+	s.Require().NoError(keeper.SetTopic(ctx, topicId, topic))
+	s.Require().NoError(keeper.EscrowReward(ctx, topic, actor, cosmosMath.NewInt(100)))
+
+	response, err := s.queryServer.GetRewardEscrows(ctx.WithBlockHeight(140), &types.GetRewardEscrowsRequest{
+		Address: actor,
+		TopicId: topicId,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Escrows, 1)
+	s.Require().Equal(int64(200), response.Escrows[0].BlockVested)
+	s.Require().True(cosmosMath.NewInt(40).Equal(response.Vested))
+	s.Require().True(cosmosMath.NewInt(60).Equal(response.Unvested))
+
+	response, err = s.queryServer.GetRewardEscrows(ctx, &types.GetRewardEscrowsRequest{Address: s.addrsStr[1]})
+	s.Require().NoError(err)
+	s.Require().Empty(response.Escrows)
+	s.Require().True(response.Vested.IsZero())
+
+	_, err = s.queryServer.GetRewardEscrows(ctx, &types.GetRewardEscrowsRequest{Address: "invalid"})
+	s.Require().Error(err)
+}
+
+func (s *QueryServerTestSuite) TestGetQueuedRewardPayouts() {
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+	actor := s.addrsStr[0]
+	s.Require().NoError(keeper.QueueRewardPayout(s.ctx, topicId, actor, types.WorkerInferenceRewardType, cosmosMath.NewInt(10)))
+	s.Require().NoError(keeper.QueueRewardPayout(s.ctx, topicId, s.addrsStr[1], types.ReputerAndDelegatorRewardType, cosmosMath.NewInt(20)))
+
+	response, err := s.queryServer.GetQueuedRewardPayouts(s.ctx, &types.GetQueuedRewardPayoutsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(response.Payouts, 2)
+	s.Require().Equal(actor, response.Payouts[0].Address)
+	s.Require().True(cosmosMath.NewInt(30).Equal(response.TotalQueued))
+
+	response, err = s.queryServer.GetQueuedRewardPayouts(s.ctx, &types.GetQueuedRewardPayoutsRequest{Address: actor})
+	s.Require().NoError(err)
+	s.Require().Len(response.Payouts, 1)
+	s.Require().Equal(string(types.WorkerInferenceRewardType), response.Payouts[0].Type)
+
+	_, err = s.queryServer.GetQueuedRewardPayouts(s.ctx, &types.GetQueuedRewardPayoutsRequest{Address: "invalid"})
+	s.Require().Error(err)
+}
+
+func (s *QueryServerTestSuite) TestGetDeadLetteredRewardPayouts() {
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+	actor := s.addrsStr[0]
+	for i, address := range []string{actor, s.addrsStr[1]} {
+		s.Require().NoError(keeper.SetDeadLetteredRewardPayout(s.ctx, types.QueuedRewardPayout{
+			Id:             uint64(i),
+			TopicId:        topicId,
+			Address:        address,
+			Type:           string(types.WorkerInferenceRewardType),
+			Amount:         cosmosMath.NewInt(10),
+			BlockQueued:    s.ctx.BlockHeight(),
+			FailedAttempts: 5,
+		}))
+	}
+
+	response, err := s.queryServer.GetDeadLetteredRewardPayouts(s.ctx, &types.GetDeadLetteredRewardPayoutsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(response.Payouts, 2)
+
+	response, err = s.queryServer.GetDeadLetteredRewardPayouts(s.ctx, &types.GetDeadLetteredRewardPayoutsRequest{Address: actor})
+	s.Require().NoError(err)
+	s.Require().Len(response.Payouts, 1)
+	s.Require().Equal(actor, response.Payouts[0].Address)
+	s.Require().Equal(uint64(5), response.Payouts[0].FailedAttempts)
+
+	_, err = s.queryServer.GetDeadLetteredRewardPayouts(s.ctx, &types.GetDeadLetteredRewardPayoutsRequest{Address: "invalid"})
+	s.Require().Error(err)
+}
@@ -305,6 +305,104 @@ func (qs queryServer) GetDelegateStakeRemovalInfo(ctx context.Context, req *type
 	return &types.GetDelegateStakeRemovalInfoResponse{Removal: &removal}, nil
 }
 
+func (qs queryServer) IsAutoCompoundEnabled(ctx context.Context, req *types.IsAutoCompoundEnabledRequest) (_ *types.IsAutoCompoundEnabledResponse, err error) {
+	defer metrics.RecordMetrics("IsAutoCompoundEnabled", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	isEnabled, err := qs.k.IsAutoCompoundEnabled(ctx, req.TopicId, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &types.IsAutoCompoundEnabledResponse{IsEnabled: isEnabled}, nil
+}
+
+func (qs queryServer) GetReputerCommission(ctx context.Context, req *types.GetReputerCommissionRequest) (_ *types.GetReputerCommissionResponse, err error) {
+	defer metrics.RecordMetrics("GetReputerCommission", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Reputer); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid reputer address: %s", err)
+	}
+
+	commission, found, err := qs.k.GetReputerCommission(ctx, req.TopicId, req.Reputer)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetReputerCommissionResponse{Commission: &commission, Found: found}, nil
+}
+
+func (qs queryServer) GetStakeLocks(ctx context.Context, req *types.GetStakeLocksRequest) (_ *types.GetStakeLocksResponse, err error) {
+	defer metrics.RecordMetrics("GetStakeLocks", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Reputer); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid reputer address: %s", err)
+	}
+
+	locks, err := qs.k.GetStakeLocks(ctx, req.TopicId, req.Reputer)
+	if err != nil {
+		return nil, err
+	}
+	stakeLocks := make([]*types.StakeLock, len(locks))
+	for i := range locks {
+		stakeLocks[i] = &locks[i]
+	}
+	return &types.GetStakeLocksResponse{StakeLocks: stakeLocks}, nil
+}
+
+func (qs queryServer) GetPendingDelegateRewards(ctx context.Context, req *types.GetPendingDelegateRewardsRequest) (_ *types.GetPendingDelegateRewardsResponse, err error) {
+	defer metrics.RecordMetrics("GetPendingDelegateRewards", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Delegator); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	rewards, pagination, err := qs.k.GetPendingDelegateRewards(ctx, req.Delegator, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	total := cosmosMath.ZeroInt()
+	for _, reward := range rewards {
+		total = total.Add(reward.Amount)
+	}
+	return &types.GetPendingDelegateRewardsResponse{Rewards: rewards, Total: total, Pagination: pagination}, nil
+}
+
+func (qs queryServer) GetDelegatorPositions(ctx context.Context, req *types.GetDelegatorPositionsRequest) (_ *types.GetDelegatorPositionsResponse, err error) {
+	defer metrics.RecordMetrics("GetDelegatorPositions", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Delegator); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	positions, pagination, err := qs.k.GetDelegatorPositions(sdkCtx, req.Delegator, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetDelegatorPositionsResponse{Positions: positions, Pagination: pagination}, nil
+}
+
+func (qs queryServer) GetPendingStakeRemovalsForActor(ctx context.Context, req *types.GetPendingStakeRemovalsForActorRequest) (_ *types.GetPendingStakeRemovalsForActorResponse, err error) {
+	defer metrics.RecordMetrics("GetPendingStakeRemovalsForActor", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	stakeRemovals, delegateStakeRemovals, pagination, err := qs.k.GetPendingStakeRemovalsForActor(sdkCtx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetPendingStakeRemovalsForActorResponse{
+		StakeRemovals:         stakeRemovals,
+		DelegateStakeRemovals: delegateStakeRemovals,
+		Pagination:            pagination,
+	}, nil
+}
+
 func (qs queryServer) GetStakeReputerAuthority(ctx context.Context, req *types.GetStakeReputerAuthorityRequest) (_ *types.GetStakeReputerAuthorityResponse, err error) {
 	defer metrics.RecordMetrics("GetStakeReputerAuthority", time.Now(), &err)
 	stakeReputerAuthority, err := qs.k.GetStakeReputerAuthority(ctx, req.TopicId, req.Reputer)
@@ -369,3 +467,48 @@ func (qs queryServer) GetDelegateStakeRemoval(ctx context.Context, req *types.Ge
 
 	return &types.GetDelegateStakeRemovalResponse{StakeRemovalInfo: &delegateStakeRemoval}, nil
 }
+
+func (qs queryServer) GetWorkerBond(ctx context.Context, req *types.GetWorkerBondRequest) (_ *types.GetWorkerBondResponse, err error) {
+	defer metrics.RecordMetrics("GetWorkerBond", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Worker); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid worker address: %s", err)
+	}
+
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	}
+
+	bond, err := qs.k.GetWorkerBond(ctx, req.TopicId, req.Worker)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetWorkerBondResponse{Bond: &bond}, nil
+}
+
+func (qs queryServer) GetWorkerBondRemoval(ctx context.Context, req *types.GetWorkerBondRemovalRequest) (_ *types.GetWorkerBondRemovalResponse, err error) {
+	defer metrics.RecordMetrics("GetWorkerBondRemoval", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Worker); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid worker address: %s", err)
+	}
+
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	}
+
+	removal, found, err := qs.k.GetWorkerBondRemovalForWorkerAndTopicId(ctx, req.Worker, req.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "no worker bond removal found")
+	}
+	return &types.GetWorkerBondRemovalResponse{RemovalInfo: &removal}, nil
+}
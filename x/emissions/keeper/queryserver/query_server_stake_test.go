@@ -489,3 +489,261 @@ func (s *QueryServerTestSuite) TestGetStakeRemovalForReputerAndTopicId() {
 	s.Require().NoError(err)
 	s.Require().Equal(&stakeRemovalInfo, response.StakeRemovalInfo)
 }
+
+func (s *QueryServerTestSuite) TestGetWorkerBondAndRemoval() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+	worker := s.addrsStr[0]
+
+	bondResponse, err := queryServer.GetWorkerBond(ctx, &types.GetWorkerBondRequest{
+		TopicId: topicId,
+		Worker:  worker,
+	})
+	s.Require().NoError(err)
+	s.Require().True(bondResponse.Bond.Amount.IsZero())
+
+	_, err = queryServer.GetWorkerBondRemoval(ctx, &types.GetWorkerBondRemovalRequest{
+		TopicId: topicId,
+		Worker:  worker,
+	})
+	s.Require().Error(err, "no removal is pending")
+
+	err = keeper.AddWorkerBond(ctx, topicId, worker, cosmosMath.NewInt(100))
+	s.Require().NoError(err)
+	removal := types.WorkerBondRemovalInfo{
+		BlockRemovalStarted:   0,
+		TopicId:               topicId,
+		Worker:                worker,
+		Amount:                cosmosMath.NewInt(40),
+		BlockRemovalCompleted: 1234,
+	}
+	err = keeper.SetWorkerBondRemoval(ctx, removal)
+	s.Require().NoError(err)
+
+	bondResponse, err = queryServer.GetWorkerBond(ctx, &types.GetWorkerBondRequest{
+		TopicId: topicId,
+		Worker:  worker,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(100), bondResponse.Bond.Amount)
+
+	removalResponse, err := queryServer.GetWorkerBondRemoval(ctx, &types.GetWorkerBondRemovalRequest{
+		TopicId: topicId,
+		Worker:  worker,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(&removal, removalResponse.RemovalInfo)
+}
+
+func (s *QueryServerTestSuite) TestIsAutoCompoundEnabled() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+	delegator := s.addrsStr[0]
+
+	response, err := queryServer.IsAutoCompoundEnabled(ctx, &types.IsAutoCompoundEnabledRequest{
+		TopicId: topicId,
+		Address: delegator,
+	})
+	s.Require().NoError(err)
+	s.Require().False(response.IsEnabled)
+
+	err = keeper.SetAutoCompound(ctx, topicId, delegator, true)
+	s.Require().NoError(err)
+	response, err = queryServer.IsAutoCompoundEnabled(ctx, &types.IsAutoCompoundEnabledRequest{
+		TopicId: topicId,
+		Address: delegator,
+	})
+	s.Require().NoError(err)
+	s.Require().True(response.IsEnabled)
+
+	_, err = queryServer.IsAutoCompoundEnabled(ctx, &types.IsAutoCompoundEnabledRequest{
+		TopicId: topicId,
+		Address: "invalid",
+	})
+	s.Require().Error(err)
+}
+
+func (s *QueryServerTestSuite) TestGetPendingDelegateRewards() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+	delegator := s.addrsStr[0]
+	reputerA := s.addrsStr[1]
+	reputerB := s.addrsStr[2]
+
+	err := keeper.AddDelegateStake(ctx, topicId, delegator, reputerA, cosmosMath.NewInt(100))
+	s.Require().NoError(err)
+	err = keeper.AddDelegateStake(ctx, topicId, delegator, reputerB, cosmosMath.NewInt(200))
+	s.Require().NoError(err)
+	err = keeper.SetDelegateRewardPerShare(ctx, topicId, reputerA, alloraMath.MustNewDecFromString("0.5"))
+	s.Require().NoError(err)
+
+	response, err := queryServer.GetPendingDelegateRewards(ctx, &types.GetPendingDelegateRewardsRequest{
+		Delegator:  delegator,
+		Pagination: &types.SimpleCursorPaginationRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Rewards, 1)
+	s.Require().NotEmpty(response.Pagination.NextKey)
+	total := response.Total
+
+	response, err = queryServer.GetPendingDelegateRewards(ctx, &types.GetPendingDelegateRewardsRequest{
+		Delegator:  delegator,
+		Pagination: &types.SimpleCursorPaginationRequest{Key: response.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Rewards, 1)
+	s.Require().Equal(topicId, response.Rewards[0].TopicId)
+	s.Require().Empty(response.Pagination.NextKey)
+	s.Require().True(cosmosMath.NewInt(50).Equal(total.Add(response.Total)), "only the placement on reputer A has a reward pending")
+
+	_, err = queryServer.GetPendingDelegateRewards(ctx, &types.GetPendingDelegateRewardsRequest{
+		Delegator:  "invalid",
+		Pagination: nil,
+	})
+	s.Require().Error(err)
+}
+
+func (s *QueryServerTestSuite) TestGetReputerCommission() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+	reputer := s.addrsStr[0]
+
+	response, err := queryServer.GetReputerCommission(ctx, &types.GetReputerCommissionRequest{
+		TopicId: topicId,
+		Reputer: reputer,
+	})
+	s.Require().NoError(err)
+	s.Require().False(response.Found)
+	s.Require().True(response.Commission.Rate.IsZero())
+
+	commission := types.ReputerCommission{
+		TopicId:           topicId,
+		Reputer:           reputer,
+		Rate:              alloraMath.MustNewDecFromString("0.1"),
+		MaxRate:           alloraMath.MustNewDecFromString("0.2"),
+		MaxChangeRate:     alloraMath.MustNewDecFromString("0.01"),
+		UpdateBlockHeight: ctx.BlockHeight(),
+	}
+	err = keeper.SetReputerCommission(ctx, commission)
+	s.Require().NoError(err)
+	response, err = queryServer.GetReputerCommission(ctx, &types.GetReputerCommissionRequest{
+		TopicId: topicId,
+		Reputer: reputer,
+	})
+	s.Require().NoError(err)
+	s.Require().True(response.Found)
+	s.Require().True(commission.MaxRate.Equal(response.Commission.MaxRate))
+
+	_, err = queryServer.GetReputerCommission(ctx, &types.GetReputerCommissionRequest{
+		TopicId: topicId,
+		Reputer: "invalid",
+	})
+	s.Require().Error(err)
+}
+
+func (s *QueryServerTestSuite) TestGetStakeLocks() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+	reputer := s.addrsStr[0]
+	delegator := s.addrsStr[1]
+
+	response, err := queryServer.GetStakeLocks(ctx, &types.GetStakeLocksRequest{
+		TopicId: topicId,
+		Reputer: reputer,
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(response.StakeLocks)
+
+	err = keeper.LockStake(ctx, topicId, reputer, "", cosmosMath.NewInt(100), 1)
+	s.Require().NoError(err)
+	err = keeper.LockStake(ctx, topicId, reputer, delegator, cosmosMath.NewInt(50), 6)
+	s.Require().NoError(err)
+	response, err = queryServer.GetStakeLocks(ctx, &types.GetStakeLocksRequest{
+		TopicId: topicId,
+		Reputer: reputer,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.StakeLocks, 2)
+	total := cosmosMath.ZeroInt()
+	for _, lock := range response.StakeLocks {
+		s.Require().Equal(reputer, lock.Reputer)
+		total = total.Add(lock.Amount)
+	}
+	s.Require().True(cosmosMath.NewInt(150).Equal(total))
+
+	_, err = queryServer.GetStakeLocks(ctx, &types.GetStakeLocksRequest{
+		TopicId: topicId,
+		Reputer: "invalid",
+	})
+	s.Require().Error(err)
+}
+
+func (s *QueryServerTestSuite) TestGetDelegatorPositions() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+	delegator := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+
+	err := keeper.AddDelegateStake(ctx, topicId, delegator, reputer, cosmosMath.NewInt(100))
+	s.Require().NoError(err)
+
+	response, err := queryServer.GetDelegatorPositions(ctx, &types.GetDelegatorPositionsRequest{
+		Delegator:  delegator,
+		Pagination: nil,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Positions, 1)
+	s.Require().Equal(reputer, response.Positions[0].Reputer)
+	s.Require().True(cosmosMath.NewInt(100).Equal(response.Positions[0].Amount))
+
+	_, err = queryServer.GetDelegatorPositions(ctx, &types.GetDelegatorPositionsRequest{
+		Delegator:  "invalid",
+		Pagination: nil,
+	})
+	s.Require().Error(err)
+}
+
+func (s *QueryServerTestSuite) TestGetPendingStakeRemovalsForActor() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+	reputer := s.addrsStr[0]
+
+	removal := types.StakeRemovalInfo{
+		BlockRemovalStarted:   ctx.BlockHeight(),
+		BlockRemovalCompleted: ctx.BlockHeight() + 10,
+		TopicId:               topicId,
+		Reputer:               reputer,
+		Amount:                cosmosMath.NewInt(10),
+	}
+	err := keeper.SetStakeRemoval(ctx, removal)
+	s.Require().NoError(err)
+
+	response, err := queryServer.GetPendingStakeRemovalsForActor(ctx, &types.GetPendingStakeRemovalsForActorRequest{
+		Address:    reputer,
+		Pagination: nil,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.StakeRemovals, 1)
+	s.Require().Equal(removal.BlockRemovalCompleted, response.StakeRemovals[0].BlockRemovalCompleted)
+	s.Require().Empty(response.DelegateStakeRemovals)
+
+	_, err = queryServer.GetPendingStakeRemovalsForActor(ctx, &types.GetPendingStakeRemovalsForActorRequest{
+		Address:    "invalid",
+		Pagination: nil,
+	})
+	s.Require().Error(err)
+}
@@ -81,6 +81,7 @@ func (s *QueryServerTestSuite) SetupTest() {
 		mintTypes.EcosystemModuleName:  {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraRewardEscrowAccountName:              {"minter"},
 		"bonded_tokens_pool":                             {"burner", "staking"},
 		"not_bonded_tokens_pool":                         {"burner", "staking"},
 		multiPerm:                                        {"burner", "minter", "staking"},
@@ -187,22 +188,35 @@ func (s *QueryServerTestSuite) CreateOneTopic() uint64 {
 	creator := s.addrs[0]
 
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  creator.String(),
-		Metadata:                 metadata,
-		LossMethod:               "method",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		AllowNegative:            false,
-		AlphaRegret:              alloraMath.NewDecFromInt64(1),
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       creator.String(),
+		Metadata:                      metadata,
+		LossMethod:                    "method",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		AllowNegative:                 false,
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 
 	s.MintTokensToAddress(creator, types.DefaultParams().CreateTopicFee)
@@ -223,22 +237,35 @@ func (s *QueryServerTestSuite) TestCreateSeveralTopics() {
 	creator := s.addrs[0]
 
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  creator.String(),
-		Metadata:                 metadata,
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		AllowNegative:            false,
-		AlphaRegret:              alloraMath.NewDecFromInt64(1),
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       creator.String(),
+		Metadata:                      metadata,
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		AllowNegative:                 false,
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 
 	creatorInitialBalance := types.DefaultParams().CreateTopicFee.Mul(cosmosMath.NewInt(3))
@@ -273,23 +300,35 @@ func (s *QueryServerTestSuite) TestCreateSeveralTopics() {
 
 func (s *QueryServerTestSuite) mockTopic() types.Topic {
 	return types.Topic{
-		Id:                       uint64(1),
-		Creator:                  s.addrs[0].String(),
-		Metadata:                 "whatever",
-		LossMethod:               "loss",
-		EpochLastEnded:           2,
-		EpochLength:              100,
-		GroundTruthLag:           100,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-		WorkerSubmissionWindow:   100,
-		AllowNegative:            true,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.1"),
-		InitialRegret:            alloraMath.MustNewDecFromString("0.1"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.1"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.1"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.1"),
+		Id:                            uint64(1),
+		Creator:                       s.addrs[0].String(),
+		Metadata:                      "whatever",
+		LossMethod:                    "loss",
+		EpochLastEnded:                2,
+		EpochLength:                   100,
+		GroundTruthLag:                100,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		WorkerSubmissionWindow:        100,
+		AllowNegative:                 true,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.1"),
+		InitialRegret:                 alloraMath.MustNewDecFromString("0.1"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.1"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.1"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.1"),
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 }
 
@@ -6,6 +6,8 @@ import (
 
 	"cosmossdk.io/errors"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -47,10 +49,16 @@ func (qs queryServer) GetTopic(ctx context.Context, req *types.GetTopicRequest)
 		return nil, errors.Wrapf(err, "error getting current topic weight")
 	}
 
+	isArchived, err := qs.k.IsTopicArchived(ctx, req.TopicId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error checking if topic is archived")
+	}
+
 	return &types.GetTopicResponse{
 		Topic:            &topic,
 		Weight:           currentTopicWeight.String(),
 		EffectiveRevenue: currentTopicRevenue.String(),
+		Archived:         isArchived,
 	}, nil
 }
 
@@ -106,6 +114,40 @@ func (qs queryServer) GetTotalSumPreviousTopicWeights(ctx context.Context, req *
 	return &types.GetTotalSumPreviousTopicWeightsResponse{Weight: previousTopicWeight}, nil
 }
 
+func (qs queryServer) GetTopicWeightBreakdown(ctx context.Context, req *types.GetTopicWeightBreakdownRequest) (_ *types.GetTopicWeightBreakdownResponse, err error) {
+	defer metrics.RecordMetrics("GetTopicWeightBreakdown", time.Now(), &err)
+
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	}
+
+	breakdown, err := rewards.GetTopicWeightBreakdown(sdk.UnwrapSDKContext(ctx), qs.k, req.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetTopicWeightBreakdownResponse{
+		TopicStake:                breakdown.TopicStake,
+		TopicFeeRevenue:           breakdown.TopicFeeRevenue,
+		StakeTerm:                 breakdown.StakeTerm,
+		FeeRevenueTerm:            breakdown.FeeRevenueTerm,
+		TargetWeight:              breakdown.TargetWeight,
+		PreviousWeight:            breakdown.PreviousWeight,
+		NoPriorWeight:             breakdown.NoPriorWeight,
+		Weight:                    breakdown.Weight,
+		MinTopicWeight:            breakdown.MinTopicWeight,
+		AboveMinTopicWeight:       breakdown.AboveMinTopicWeight,
+		IsActive:                  breakdown.IsActive,
+		NextChurningBlock:         breakdown.NextChurningBlock,
+		LowestActiveWeightTopicId: breakdown.LowestActiveWeight.TopicId,
+		LowestActiveWeight:        breakdown.LowestActiveWeight.Weight,
+		MaxActiveTopicsPerBlock:   breakdown.MaxActiveTopicsPerBlock,
+		KeptAfterSkimming:         breakdown.KeptAfterSkimming,
+	}, nil
+}
+
 func (qs queryServer) TopicExists(ctx context.Context, req *types.TopicExistsRequest) (_ *types.TopicExistsResponse, err error) {
 	defer metrics.RecordMetrics("TopicExists", time.Now(), &err)
 	exists, err := qs.k.TopicExists(ctx, req.TopicId)
@@ -126,6 +168,46 @@ func (qs queryServer) IsTopicActive(ctx context.Context, req *types.IsTopicActiv
 	return &types.IsTopicActiveResponse{IsActive: isActive}, nil
 }
 
+func (qs queryServer) IsTopicArchived(ctx context.Context, req *types.IsTopicArchivedRequest) (_ *types.IsTopicArchivedResponse, err error) {
+	defer metrics.RecordMetrics("IsTopicArchived", time.Now(), &err)
+	isArchived, err := qs.k.IsTopicArchived(ctx, req.TopicId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.IsTopicArchivedResponse{IsArchived: isArchived}, nil
+}
+
+func (qs queryServer) GetTopicOwner(ctx context.Context, req *types.GetTopicOwnerRequest) (_ *types.GetTopicOwnerResponse, err error) {
+	defer metrics.RecordMetrics("GetTopicOwner", time.Now(), &err)
+	owner, err := qs.k.GetTopicOwner(ctx, req.TopicId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetTopicOwnerResponse{Owner: owner}, nil
+}
+
+func (qs queryServer) GetPendingTopicOwner(ctx context.Context, req *types.GetPendingTopicOwnerRequest) (_ *types.GetPendingTopicOwnerResponse, err error) {
+	defer metrics.RecordMetrics("GetPendingTopicOwner", time.Now(), &err)
+	pendingOwner, found, err := qs.k.GetPendingTopicOwner(ctx, req.TopicId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetPendingTopicOwnerResponse{PendingOwner: pendingOwner, Found: found}, nil
+}
+
+func (qs queryServer) ListTopics(ctx context.Context, req *types.ListTopicsRequest) (_ *types.ListTopicsResponse, err error) {
+	defer metrics.RecordMetrics("ListTopics", time.Now(), &err)
+	topics, pagination, err := qs.k.ListTopics(ctx, req)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.ListTopicsResponse{Topics: topics, Pagination: pagination}, nil
+}
+
 func (qs queryServer) GetTopicFeeRevenue(ctx context.Context, req *types.GetTopicFeeRevenueRequest) (_ *types.GetTopicFeeRevenueResponse, err error) {
 	defer metrics.RecordMetrics("GetTopicFeeRevenue", time.Now(), &err)
 	feeRevenue, err := qs.k.GetTopicFeeRevenue(ctx, req.TopicId)
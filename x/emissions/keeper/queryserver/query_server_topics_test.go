@@ -168,6 +168,31 @@ func (s *QueryServerTestSuite) TestGetPreviousTopicWeight() {
 	s.Require().Equal(weightToSet, retrievedWeight, "Retrieved weight should match the set weight")
 }
 
+func (s *QueryServerTestSuite) TestGetTopicWeightBreakdown() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+	s.Require().NoError(keeper.AddTopicFeeRevenue(ctx, topicId, cosmosMath.NewInt(100)))
+	s.Require().NoError(keeper.SetTopicStake(ctx, topicId, cosmosMath.NewInt(1000)))
+
+	response, err := s.queryServer.GetTopicWeightBreakdown(ctx, &types.GetTopicWeightBreakdownRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().True(cosmosMath.NewInt(1000).Equal(response.TopicStake))
+	feeRevenue, err := keeper.GetTopicFeeRevenue(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().True(feeRevenue.Equal(response.TopicFeeRevenue))
+	weight, err := keeper.GetTopicWeightFromTopicId(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().True(weight.Equal(response.Weight), "expected %s, got %s", weight, response.Weight)
+	target, err := response.StakeTerm.Mul(response.FeeRevenueTerm)
+	s.Require().NoError(err)
+	s.Require().True(target.Equal(response.TargetWeight))
+	s.Require().Equal(response.AboveMinTopicWeight, !response.Weight.Lt(response.MinTopicWeight))
+
+	_, err = s.queryServer.GetTopicWeightBreakdown(ctx, &types.GetTopicWeightBreakdownRequest{TopicId: topicId + 1})
+	s.Require().Error(err)
+}
+
 func (s *QueryServerTestSuite) TestTopicExists() {
 	ctx := s.ctx
 	keeper := s.emissionsKeeper
@@ -243,6 +268,73 @@ func (s *QueryServerTestSuite) TestIsTopicActive() {
 	s.Require().True(topicActive, "Topic should be active again")
 }
 
+func (s *QueryServerTestSuite) TestIsTopicArchived() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(3)
+
+	topic := s.mockTopic()
+	topic.Id = topicId
+	err := keeper.SetTopic(ctx, topicId, topic)
+	s.Require().NoError(err)
+
+	response, err := s.queryServer.IsTopicArchived(ctx, &types.IsTopicArchivedRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().False(response.IsArchived, "Topic should not be archived")
+
+	err = keeper.ArchiveTopic(ctx, topicId)
+	s.Require().NoError(err, "Archiving topic should not fail")
+
+	response, err = s.queryServer.IsTopicArchived(ctx, &types.IsTopicArchivedRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().True(response.IsArchived, "Topic should be archived")
+
+	// An archived topic is still returned, flagged as archived
+	topicResponse, err := s.queryServer.GetTopic(ctx, &types.GetTopicRequest{TopicId: topicId})
+	s.Require().NoError(err, "Retrieving an archived topic should not fail")
+	s.Require().Equal(topic, *topicResponse.Topic)
+	s.Require().True(topicResponse.Archived)
+}
+
+func (s *QueryServerTestSuite) TestGetTopicOwner() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+
+	topic := s.mockTopic()
+	topic.Id = topicId
+	err := keeper.SetTopic(ctx, topicId, topic)
+	s.Require().NoError(err)
+
+	// The creator owns the topic until ownership is transferred
+	ownerResponse, err := s.queryServer.GetTopicOwner(ctx, &types.GetTopicOwnerRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().Equal(topic.Creator, ownerResponse.Owner)
+
+	pendingResponse, err := s.queryServer.GetPendingTopicOwner(ctx, &types.GetPendingTopicOwnerRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().False(pendingResponse.Found)
+
+	newOwner := s.addrsStr[1]
+	err = keeper.ProposeTopicOwner(ctx, topicId, newOwner)
+	s.Require().NoError(err)
+
+	pendingResponse, err = s.queryServer.GetPendingTopicOwner(ctx, &types.GetPendingTopicOwnerRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().True(pendingResponse.Found)
+	s.Require().Equal(newOwner, pendingResponse.PendingOwner)
+
+	err = keeper.AcceptTopicOwnership(ctx, topicId, newOwner)
+	s.Require().NoError(err)
+
+	ownerResponse, err = s.queryServer.GetTopicOwner(ctx, &types.GetTopicOwnerRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().Equal(newOwner, ownerResponse.Owner)
+
+	_, err = s.queryServer.GetTopicOwner(ctx, &types.GetTopicOwnerRequest{TopicId: topicId + 1})
+	s.Require().ErrorIs(err, types.ErrTopicDoesNotExist)
+}
+
 func (s *QueryServerTestSuite) TestGetTopicFeeRevenue() {
 	ctx := s.ctx
 	keeper := s.emissionsKeeper
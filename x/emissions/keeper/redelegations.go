@@ -0,0 +1,291 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/// REDELEGATIONS
+
+// Records a redelegation that has not completed yet in all of its indexes.
+// This completely overrides the existing redelegation between the same reputers
+func (k *Keeper) SetRedelegation(ctx context.Context, redelegation types.RedelegationInfo) error {
+	if err := redelegation.Validate(); err != nil {
+		return errorsmod.Wrap(err, "redelegation is not valid")
+	}
+	key := Join4(redelegation.TopicId, redelegation.SrcReputer, redelegation.Delegator, redelegation.DstReputer)
+	if err := k.redelegations.Set(ctx, key, redelegation); err != nil {
+		return errorsmod.Wrap(err, "error setting redelegation")
+	}
+	byDestinationKey := Join4(redelegation.TopicId, redelegation.Delegator, redelegation.DstReputer, redelegation.SrcReputer)
+	if err := k.redelegationsByDestination.Set(ctx, byDestinationKey); err != nil {
+		return errorsmod.Wrap(err, "error setting redelegation by destination")
+	}
+	return k.redelegationsByBlock.Set(ctx, collections.Join(redelegation.BlockRedelegationCompleted, key))
+}
+
+// Removes a redelegation from all of its indexes
+func (k *Keeper) DeleteRedelegation(
+	ctx context.Context,
+	topicId TopicId,
+	srcReputer ActorId,
+	delegator ActorId,
+	dstReputer ActorId,
+) error {
+	key := Join4(topicId, srcReputer, delegator, dstReputer)
+	redelegation, err := k.redelegations.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ErrRedelegationNotFound
+	} else if err != nil {
+		return errorsmod.Wrap(err, "error getting redelegation")
+	}
+	if err := k.redelegations.Remove(ctx, key); err != nil {
+		return errorsmod.Wrap(err, "error removing redelegation")
+	}
+	if err := k.redelegationsByDestination.Remove(ctx, Join4(topicId, delegator, dstReputer, srcReputer)); err != nil {
+		return errorsmod.Wrap(err, "error removing redelegation by destination")
+	}
+	return k.redelegationsByBlock.Remove(ctx, collections.Join(redelegation.BlockRedelegationCompleted, key))
+}
+
+// Returns the redelegation of the delegator between two reputers of a topic, if it has not completed yet
+func (k *Keeper) GetRedelegation(
+	ctx context.Context,
+	topicId TopicId,
+	srcReputer ActorId,
+	delegator ActorId,
+	dstReputer ActorId,
+) (redelegation types.RedelegationInfo, found bool, err error) {
+	redelegation, err = k.redelegations.Get(ctx, Join4(topicId, srcReputer, delegator, dstReputer))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RedelegationInfo{}, false, nil
+	} else if err != nil {
+		return types.RedelegationInfo{}, false, errorsmod.Wrap(err, "error getting redelegation")
+	}
+	return redelegation, true, nil
+}
+
+// Returns the redelegations away from the reputer in the topic that have not completed yet
+func (k *Keeper) GetRedelegationsFromReputer(
+	ctx context.Context,
+	topicId TopicId,
+	srcReputer ActorId,
+) ([]types.RedelegationInfo, error) {
+	rng := NewDoublePrefixedQuadrupleRange[TopicId, Reputer, Delegator, Reputer](topicId, srcReputer)
+	iter, err := k.redelegations.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error iterating redelegations")
+	}
+	redelegations, err := iter.Values()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error getting redelegations")
+	}
+	return redelegations, nil
+}
+
+// Returns true if stake of the delegator was redelegated to the reputer in the topic
+// and that redelegation has not completed yet
+func (k *Keeper) HasIncomingRedelegation(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	dstReputer ActorId,
+) (bool, error) {
+	rng := NewTriplePrefixedQuadrupleRange[TopicId, Delegator, Reputer, Reputer](topicId, delegator, dstReputer)
+	iter, err := k.redelegationsByDestination.Iterate(ctx, rng)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "error iterating redelegations by destination")
+	}
+	defer iter.Close()
+	return iter.Valid(), nil
+}
+
+// get a list of redelegations that complete before and including this block
+func (k *Keeper) GetRedelegationsUpUntilBlock(
+	ctx context.Context,
+	blockHeight BlockHeight,
+	limit uint64,
+) (ret []types.RedelegationInfo, limitHit bool, err error) {
+	ret = make([]types.RedelegationInfo, 0)
+
+	// make a range that has everything less than the block height, inclusive
+	rng := &collections.Range[collections.Pair[BlockHeight, Quadruple[TopicId, Reputer, Delegator, Reputer]]]{}
+	rng = rng.StartInclusive(collections.PairPrefix[BlockHeight, Quadruple[TopicId, Reputer, Delegator, Reputer]](0))
+	rng = rng.EndExclusive(collections.PairPrefix[BlockHeight, Quadruple[TopicId, Reputer, Delegator, Reputer]](blockHeight + 1))
+
+	iter, err := k.redelegationsByBlock.Iterate(ctx, rng)
+	if err != nil {
+		return ret, false, errorsmod.Wrap(err, "error iterating over redelegations by block")
+	}
+	defer iter.Close()
+	count := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		if count >= limit {
+			return ret, true, nil
+		}
+		key, err := iter.Key()
+		if err != nil {
+			return ret, true, errorsmod.Wrap(err, "error getting redelegation by block")
+		}
+		val, err := k.redelegations.Get(ctx, key.K2())
+		if err != nil {
+			return ret, true, errorsmod.Wrap(err, "error getting redelegation")
+		}
+		ret = append(ret, val)
+		count += 1
+	}
+	return ret, false, nil
+}
+
+// RedelegateStake moves stake the delegator placed upon one reputer of the topic to another reputer of
+// the same topic. Rewards pending on both delegations are paid out first so that their reward debts can
+// be reset against the new amounts. The stake remains in the topic and remains slashable for faults of
+// the source reputer until the redelegation completes after the stake removal delay. Stake that arrived
+// at a reputer through a redelegation that has not completed cannot be redelegated again
+func (k *Keeper) RedelegateStake(
+	ctx sdk.Context,
+	topicId TopicId,
+	delegator ActorId,
+	srcReputer ActorId,
+	dstReputer ActorId,
+	amount cosmosMath.Int,
+) error {
+	// CHECKS
+	if !amount.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidValue, "stake to redelegate must be greater than zero")
+	}
+	if srcReputer == dstReputer {
+		return types.ErrSelfRedelegation
+	}
+	transitive, err := k.HasIncomingRedelegation(ctx, topicId, delegator, srcReputer)
+	if err != nil {
+		return err
+	}
+	if transitive {
+		return types.ErrTransitiveRedelegation
+	}
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting params")
+	}
+
+	// GET CURRENT VALUES
+	amountDec, err := alloraMath.NewDecFromSdkInt(amount)
+	if err != nil {
+		return errorsmod.Wrap(err, "error creating dec from stake to redelegate")
+	}
+	srcPlacement, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, srcReputer)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting source delegate stake placement")
+	}
+	if srcPlacement.Amount.Lt(amountDec) {
+		return types.ErrIntegerUnderflowDelegateStakePlacement
+	}
+	srcStakeUponReputer, err := k.GetDelegateStakeUponReputer(ctx, topicId, srcReputer)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting delegate stake upon source reputer")
+	}
+	if amount.GT(srcStakeUponReputer) {
+		return types.ErrIntegerUnderflowDelegateStakeUponReputer
+	}
+	srcReputerAuthority, err := k.GetStakeReputerAuthority(ctx, topicId, srcReputer)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting source reputer authority")
+	}
+	if amount.GT(srcReputerAuthority) {
+		return types.ErrIntegerUnderflowReputerStakeAuthority
+	}
+	dstPlacement, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, dstReputer)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting destination delegate stake placement")
+	}
+	dstStakeUponReputer, err := k.GetDelegateStakeUponReputer(ctx, topicId, dstReputer)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting delegate stake upon destination reputer")
+	}
+	dstReputerAuthority, err := k.GetStakeReputerAuthority(ctx, topicId, dstReputer)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting destination reputer authority")
+	}
+	srcShare, err := k.GetDelegateRewardPerShare(ctx, topicId, srcReputer)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting source delegate reward per share")
+	}
+	dstShare, err := k.GetDelegateRewardPerShare(ctx, topicId, dstReputer)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting destination delegate reward per share")
+	}
+
+	// SETTLE PENDING REWARDS
+	if err := k.payoutPendingDelegateReward(ctx, delegator, srcPlacement, srcShare); err != nil {
+		return errorsmod.Wrap(err, "error paying out pending reward on source reputer")
+	}
+	if err := k.payoutPendingDelegateReward(ctx, delegator, dstPlacement, dstShare); err != nil {
+		return errorsmod.Wrap(err, "error paying out pending reward on destination reputer")
+	}
+
+	// UPDATE STATE AFTER CHECKS
+	srcPlacement.Amount, err = srcPlacement.Amount.Sub(amountDec)
+	if err != nil {
+		return errorsmod.Wrap(err, "error subtracting redelegated stake from source placement")
+	}
+	srcPlacement.RewardDebt, err = srcPlacement.Amount.Mul(srcShare)
+	if err != nil {
+		return errorsmod.Wrap(err, "error multiplying source amount by share")
+	}
+	dstPlacement.Amount, err = dstPlacement.Amount.Add(amountDec)
+	if err != nil {
+		return errorsmod.Wrap(err, "error adding redelegated stake to destination placement")
+	}
+	dstPlacement.RewardDebt, err = dstPlacement.Amount.Mul(dstShare)
+	if err != nil {
+		return errorsmod.Wrap(err, "error multiplying destination amount by share")
+	}
+	if err := k.SetDelegateStakePlacement(ctx, topicId, delegator, srcReputer, srcPlacement); err != nil {
+		return errorsmod.Wrap(err, "RedelegateStake Setting source delegate stake placement failed")
+	}
+	if err := k.SetDelegateStakePlacement(ctx, topicId, delegator, dstReputer, dstPlacement); err != nil {
+		return errorsmod.Wrap(err, "RedelegateStake Setting destination delegate stake placement failed")
+	}
+	if err := k.SetDelegateStakeUponReputer(ctx, topicId, srcReputer, srcStakeUponReputer.Sub(amount)); err != nil {
+		return errorsmod.Wrap(err, "RedelegateStake Setting stake from delegators upon source reputer failed")
+	}
+	if err := k.SetDelegateStakeUponReputer(ctx, topicId, dstReputer, dstStakeUponReputer.Add(amount)); err != nil {
+		return errorsmod.Wrap(err, "RedelegateStake Setting stake from delegators upon destination reputer failed")
+	}
+	if err := k.SetStakeReputerAuthority(ctx, topicId, srcReputer, srcReputerAuthority.Sub(amount)); err != nil {
+		return errorsmod.Wrap(err, "RedelegateStake Setting source reputer stake authority failed")
+	}
+	if err := k.SetStakeReputerAuthority(ctx, topicId, dstReputer, dstReputerAuthority.Add(amount)); err != nil {
+		return errorsmod.Wrap(err, "RedelegateStake Setting destination reputer stake authority failed")
+	}
+
+	// a repeated redelegation between the same reputers restarts the wait for all of the redelegated stake
+	redelegated := amount
+	existing, found, err := k.GetRedelegation(ctx, topicId, srcReputer, delegator, dstReputer)
+	if err != nil {
+		return err
+	}
+	if found {
+		redelegated = redelegated.Add(existing.Amount)
+		if err := k.DeleteRedelegation(ctx, topicId, srcReputer, delegator, dstReputer); err != nil {
+			return err
+		}
+	}
+	return k.SetRedelegation(ctx, types.RedelegationInfo{
+		TopicId:                    topicId,
+		Delegator:                  delegator,
+		SrcReputer:                 srcReputer,
+		DstReputer:                 dstReputer,
+		Amount:                     redelegated,
+		BlockRedelegationStarted:   ctx.BlockHeight(),
+		BlockRedelegationCompleted: ctx.BlockHeight() + moduleParams.RemoveStakeDelayWindow,
+	})
+}
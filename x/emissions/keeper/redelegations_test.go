@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) requireRedelegationInvariants() {
+	for _, invariant := range []sdk.Invariant{
+		keeper.StakingInvariantTotalStakeEqualAlloraStakingBankBalance(s.emissionsKeeper),
+		keeper.StakingInvariantDelegatedStakes(s.emissionsKeeper),
+		keeper.StakingInvariantPendingRewardForDelegatorsGreaterThanRewardPerShareMinusRewardDebt(s.emissionsKeeper),
+		keeper.StakingInvariantLenRedelegationsSame(s.emissionsKeeper),
+		keeper.StakingInvariantRedelegationsIndexed(s.emissionsKeeper),
+	} {
+		msg, broken := invariant(s.ctx)
+		s.Require().False(broken, msg)
+	}
+}
+
+func (s *KeeperTestSuite) TestRedelegateStake() {
+	k := s.emissionsKeeper
+	topicId := s.mockTopic().Id
+	delegator := s.addrsStr[0]
+	srcReputer := s.addrsStr[1]
+	dstReputer := s.addrsStr[2]
+	otherReputer := s.addrsStr[3]
+	moduleParams, err := k.GetParams(s.ctx)
+	s.Require().NoError(err)
+
+	s.mintTo(types.AlloraStakingAccountName, cosmosMath.NewInt(600))
+	s.Require().NoError(k.AddReputerStake(s.ctx, topicId, srcReputer, cosmosMath.NewInt(100)))
+	s.Require().NoError(k.AddReputerStake(s.ctx, topicId, dstReputer, cosmosMath.NewInt(100)))
+	s.Require().NoError(k.AddDelegateStake(s.ctx, topicId, delegator, srcReputer, cosmosMath.NewInt(400)))
+	// The delegator has 200 of rewards pending on the source reputer
+	s.mintTo(types.AlloraPendingRewardForDelegatorAccountName, cosmosMath.NewInt(200))
+	s.Require().NoError(k.SetDelegateRewardPerShare(s.ctx, topicId, srcReputer, alloraMath.MustNewDecFromString("0.5")))
+
+	s.Require().NoError(k.RedelegateStake(s.ctx, topicId, delegator, srcReputer, dstReputer, cosmosMath.NewInt(300)))
+
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegator)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(200), s.bankKeeper.GetBalance(s.ctx, delegatorAddr, params.DefaultBondDenom).Amount)
+	srcPlacement, err := k.GetDelegateStakePlacement(s.ctx, topicId, delegator, srcReputer)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.NewDecFromInt64(100).Equal(srcPlacement.Amount))
+	s.Require().True(alloraMath.NewDecFromInt64(50).Equal(srcPlacement.RewardDebt))
+	dstPlacement, err := k.GetDelegateStakePlacement(s.ctx, topicId, delegator, dstReputer)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.NewDecFromInt64(300).Equal(dstPlacement.Amount))
+	s.Require().True(alloraMath.ZeroDec().Equal(dstPlacement.RewardDebt))
+	srcAuthority, err := k.GetStakeReputerAuthority(s.ctx, topicId, srcReputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(200), srcAuthority)
+	dstAuthority, err := k.GetStakeReputerAuthority(s.ctx, topicId, dstReputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(400), dstAuthority)
+	topicStake, err := k.GetTopicStake(s.ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(600), topicStake, "redelegated stake stays in the topic")
+
+	redelegation, found, err := k.GetRedelegation(s.ctx, topicId, srcReputer, delegator, dstReputer)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(cosmosMath.NewInt(300), redelegation.Amount)
+	s.Require().Equal(s.ctx.BlockHeight()+moduleParams.RemoveStakeDelayWindow, redelegation.BlockRedelegationCompleted)
+
+	// Stake that has just arrived at a reputer cannot hop on to another one
+	err = k.RedelegateStake(s.ctx, topicId, delegator, dstReputer, otherReputer, cosmosMath.NewInt(100))
+	s.Require().ErrorIs(err, types.ErrTransitiveRedelegation)
+	err = k.RedelegateStake(s.ctx, topicId, delegator, srcReputer, dstReputer, cosmosMath.NewInt(101))
+	s.Require().ErrorIs(err, types.ErrIntegerUnderflowDelegateStakePlacement)
+
+	// A repeated redelegation between the same reputers restarts the wait for all of it
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.Require().NoError(k.RedelegateStake(s.ctx, topicId, delegator, srcReputer, dstReputer, cosmosMath.NewInt(50)))
+	completion := s.ctx.BlockHeight() + moduleParams.RemoveStakeDelayWindow
+	redelegations, limitHit, err := k.GetRedelegationsUpUntilBlock(s.ctx, completion-1, 10)
+	s.Require().NoError(err)
+	s.Require().False(limitHit)
+	s.Require().Empty(redelegations)
+	redelegations, _, err = k.GetRedelegationsUpUntilBlock(s.ctx, completion, 10)
+	s.Require().NoError(err)
+	s.Require().Len(redelegations, 1)
+	s.Require().Equal(cosmosMath.NewInt(350), redelegations[0].Amount)
+	s.requireRedelegationInvariants()
+
+	// Once completed the stake can be redelegated again
+	s.Require().NoError(k.DeleteRedelegation(s.ctx, topicId, srcReputer, delegator, dstReputer))
+	s.Require().NoError(k.RedelegateStake(s.ctx, topicId, delegator, dstReputer, otherReputer, cosmosMath.NewInt(100)))
+	s.requireRedelegationInvariants()
+}
+
+func (s *KeeperTestSuite) TestSlashReputerSlashesRedelegatedStake() {
+	k := s.emissionsKeeper
+	topic := s.mockSlashingTopic()
+	reputer := s.addrsStr[0]
+	delegator := s.addrsStr[1]
+	dstReputer := s.addrsStr[2]
+	far := alloraMath.MustNewDecFromString("0.8")
+
+	s.mintTo(types.AlloraStakingAccountName, cosmosMath.NewInt(1900))
+	s.Require().NoError(k.AddReputerStake(s.ctx, topic.Id, reputer, cosmosMath.NewInt(1000)))
+	s.Require().NoError(k.AddReputerStake(s.ctx, topic.Id, dstReputer, cosmosMath.NewInt(500)))
+	s.Require().NoError(k.AddDelegateStake(s.ctx, topic.Id, delegator, reputer, cosmosMath.NewInt(400)))
+	s.Require().NoError(k.RedelegateStake(s.ctx, topic.Id, delegator, reputer, dstReputer, cosmosMath.NewInt(400)))
+
+	s.Require().NoError(k.UpdateReputerConsensusDeviation(s.ctx, topic, reputer, 10, far))
+	s.Require().NoError(k.UpdateReputerConsensusDeviation(s.ctx, topic, reputer, 20, far))
+	s.Require().NoError(k.SlashReputersFarFromConsensus(s.ctx, topic))
+
+	// The redelegated stake is slashed at its destination as if it had not moved
+	placement, err := k.GetDelegateStakePlacement(s.ctx, topic.Id, delegator, dstReputer)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.NewDecFromInt64(380).Equal(placement.Amount))
+	dstAuthority, err := k.GetStakeReputerAuthority(s.ctx, topic.Id, dstReputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(880), dstAuthority)
+	authority, err := k.GetStakeReputerAuthority(s.ctx, topic.Id, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(900), authority)
+	topicStake, err := k.GetTopicStake(s.ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(1780), topicStake)
+
+	redelegation, found, err := k.GetRedelegation(s.ctx, topic.Id, reputer, delegator, dstReputer)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(cosmosMath.NewInt(380), redelegation.Amount)
+	s.requireRedelegationInvariants()
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/// REPUTER COMMISSIONS
+
+// Returns the commission the reputer takes on the rewards of its delegators in the topic,
+// and whether the reputer has set one
+func (k *Keeper) GetReputerCommission(ctx context.Context, topicId TopicId, reputer ActorId) (types.ReputerCommission, bool, error) {
+	commission, err := k.reputerCommissions.Get(ctx, collections.Join(topicId, reputer))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ReputerCommission{
+			TopicId:           topicId,
+			Reputer:           reputer,
+			Rate:              alloraMath.ZeroDec(),
+			MaxRate:           alloraMath.ZeroDec(),
+			MaxChangeRate:     alloraMath.ZeroDec(),
+			UpdateBlockHeight: 0,
+		}, false, nil
+	} else if err != nil {
+		return types.ReputerCommission{}, false, errorsmod.Wrap(err, "error getting reputer commission")
+	}
+	return commission, true, nil
+}
+
+// Sets the commission the reputer takes on the rewards of its delegators in the topic
+func (k *Keeper) SetReputerCommission(ctx context.Context, commission types.ReputerCommission) error {
+	if err := commission.Validate(); err != nil {
+		return errorsmod.Wrap(err, "reputer commission is not valid")
+	}
+	return k.reputerCommissions.Set(ctx, collections.Join(commission.TopicId, commission.Reputer), commission)
+}
+
+// Changes the commission rate of the reputer in the topic. The max rate and max change rate are
+// taken from the module params the first time a commission is set, and are fixed for the reputer
+// after that. The rate can only change once per topic epoch. A reputer without a commission is at
+// a rate of zero, and every change, including the first one, is capped at the max change rate.
+func (k *Keeper) UpdateReputerCommission(
+	ctx sdk.Context,
+	topic types.Topic,
+	reputer ActorId,
+	rate alloraMath.Dec,
+) error {
+	commission, found, err := k.GetReputerCommission(ctx, topic.Id, reputer)
+	if err != nil {
+		return err
+	}
+	if found {
+		if ctx.BlockHeight() < commission.UpdateBlockHeight+topic.EpochLength {
+			return types.ErrReputerCommissionUpdateTooSoon
+		}
+	} else {
+		moduleParams, err := k.GetParams(ctx)
+		if err != nil {
+			return err
+		}
+		commission.MaxRate = moduleParams.MaxReputerCommissionRate
+		commission.MaxChangeRate = moduleParams.MaxReputerCommissionChangeRate
+	}
+	if rate.Gt(commission.MaxRate) {
+		return types.ErrReputerCommissionAboveMaxRate
+	}
+	change, err := rate.Sub(commission.Rate)
+	if err != nil {
+		return err
+	}
+	change, err = change.Abs()
+	if err != nil {
+		return err
+	}
+	if change.Gt(commission.MaxChangeRate) {
+		return types.ErrReputerCommissionChangeTooLarge
+	}
+
+	commission.Rate = rate
+	commission.UpdateBlockHeight = ctx.BlockHeight()
+	if err := k.SetReputerCommission(ctx, commission); err != nil {
+		return err
+	}
+	types.EmitNewReputerCommissionSetEvent(ctx, commission)
+	return nil
+}
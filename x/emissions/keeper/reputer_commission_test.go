@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateReputerCommission() {
+	k := s.emissionsKeeper
+	topic := s.mockTopic()
+	reputer := s.addrsStr[0]
+	params, err := k.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.MaxReputerCommissionRate = alloraMath.MustNewDecFromString("0.2")
+	params.MaxReputerCommissionChangeRate = alloraMath.MustNewDecFromString("0.01")
+	s.Require().NoError(k.SetParams(s.ctx, params))
+
+	commission, found, err := k.GetReputerCommission(s.ctx, topic.Id, reputer)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	s.Require().True(commission.Rate.IsZero())
+
+	// A reputer without a commission is at zero, so the first rate is capped at the max change rate
+	err = k.UpdateReputerCommission(s.ctx, topic, reputer, alloraMath.MustNewDecFromString("0.1"))
+	s.Require().ErrorIs(err, types.ErrReputerCommissionChangeTooLarge)
+	_, found, err = k.GetReputerCommission(s.ctx, topic.Id, reputer)
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	rate := alloraMath.MustNewDecFromString("0.01")
+	s.Require().NoError(k.UpdateReputerCommission(s.ctx, topic, reputer, rate))
+	commission, found, err = k.GetReputerCommission(s.ctx, topic.Id, reputer)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().True(rate.Equal(commission.Rate))
+	s.Require().True(params.MaxReputerCommissionRate.Equal(commission.MaxRate))
+	s.Require().True(params.MaxReputerCommissionChangeRate.Equal(commission.MaxChangeRate))
+	s.Require().Equal(s.ctx.BlockHeight(), commission.UpdateBlockHeight)
+
+	nextRate := alloraMath.MustNewDecFromString("0.02")
+	err = k.UpdateReputerCommission(s.ctx, topic, reputer, nextRate)
+	s.Require().ErrorIs(err, types.ErrReputerCommissionUpdateTooSoon)
+
+	// The bounds the reputer started with stay in force when governance loosens the params
+	params.MaxReputerCommissionRate = alloraMath.OneDec()
+	params.MaxReputerCommissionChangeRate = alloraMath.OneDec()
+	s.Require().NoError(k.SetParams(s.ctx, params))
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + topic.EpochLength)
+	err = k.UpdateReputerCommission(s.ctx, topic, reputer, alloraMath.MustNewDecFromString("0.03"))
+	s.Require().ErrorIs(err, types.ErrReputerCommissionChangeTooLarge)
+
+	s.Require().NoError(k.UpdateReputerCommission(s.ctx, topic, reputer, nextRate))
+	commission, _, err = k.GetReputerCommission(s.ctx, topic.Id, reputer)
+	s.Require().NoError(err)
+	s.Require().True(nextRate.Equal(commission.Rate))
+	s.Require().True(alloraMath.MustNewDecFromString("0.2").Equal(commission.MaxRate))
+}
+
+func (s *KeeperTestSuite) TestUpdateReputerCommissionAboveMaxRate() {
+	k := s.emissionsKeeper
+	topic := s.mockTopic()
+	reputer := s.addrsStr[0]
+	params, err := k.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.MaxReputerCommissionRate = alloraMath.MustNewDecFromString("0.01")
+	params.MaxReputerCommissionChangeRate = alloraMath.MustNewDecFromString("0.01")
+	s.Require().NoError(k.SetParams(s.ctx, params))
+
+	s.Require().NoError(k.UpdateReputerCommission(s.ctx, topic, reputer, alloraMath.MustNewDecFromString("0.01")))
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + topic.EpochLength)
+	err = k.UpdateReputerCommission(s.ctx, topic, reputer, alloraMath.MustNewDecFromString("0.02"))
+	s.Require().ErrorIs(err, types.ErrReputerCommissionAboveMaxRate)
+}
@@ -0,0 +1,414 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/// REPUTER CONSENSUS DEVIATIONS
+
+// Returns the run of consecutive deviating reports of the reputer on the topic,
+// with zero consecutive epochs if the reputer's last report was close to consensus
+func (k *Keeper) GetReputerConsensusDeviation(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+) (types.ReputerConsensusDeviation, error) {
+	deviation, err := k.reputerConsensusDeviations.Get(ctx, collections.Join(topicId, reputer))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ReputerConsensusDeviation{
+			TopicId:           topicId,
+			Reputer:           reputer,
+			ConsecutiveEpochs: 0,
+			Distance:          alloraMath.ZeroDec(),
+			BlockHeight:       0,
+		}, nil
+	} else if err != nil {
+		return types.ReputerConsensusDeviation{}, errorsmod.Wrap(err, "error getting reputer consensus deviation")
+	}
+	return deviation, nil
+}
+
+// Sets the run of consecutive deviating reports of a reputer on a topic
+func (k *Keeper) SetReputerConsensusDeviation(ctx context.Context, deviation types.ReputerConsensusDeviation) error {
+	if err := deviation.Validate(); err != nil {
+		return errorsmod.Wrap(err, "reputer consensus deviation is not valid")
+	}
+	return k.reputerConsensusDeviations.Set(ctx, collections.Join(deviation.TopicId, deviation.Reputer), deviation)
+}
+
+// Ends the run of consecutive deviating reports of a reputer on a topic
+func (k *Keeper) DeleteReputerConsensusDeviation(ctx context.Context, topicId TopicId, reputer ActorId) error {
+	return k.reputerConsensusDeviations.Remove(ctx, collections.Join(topicId, reputer))
+}
+
+// Returns the runs of consecutive deviating reports of all reputers of the topic
+func (k *Keeper) GetReputerConsensusDeviationsOfTopic(ctx context.Context, topicId TopicId) ([]types.ReputerConsensusDeviation, error) {
+	rng := collections.NewPrefixedPairRange[TopicId, Reputer](topicId)
+	iter, err := k.reputerConsensusDeviations.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error iterating reputer consensus deviations")
+	}
+	deviations, err := iter.Values()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error getting reputer consensus deviations")
+	}
+	return deviations, nil
+}
+
+// Records how far the report of the reputer at the given nonce was from the loss consensus.
+// A report further than the topic threshold extends the reputer's run of deviating reports,
+// any other report ends it. A run does not carry over epochs in which the reputer did not report,
+// a deviating report more than an epoch after the last one starts a new run
+func (k *Keeper) UpdateReputerConsensusDeviation(
+	ctx context.Context,
+	topic types.Topic,
+	reputer ActorId,
+	blockHeight BlockHeight,
+	distance alloraMath.Dec,
+) error {
+	if !topic.SlashesReputers() {
+		return nil
+	}
+	if !topic.IsDeviatingFromConsensus(distance) {
+		return k.DeleteReputerConsensusDeviation(ctx, topic.Id, reputer)
+	}
+	deviation, err := k.GetReputerConsensusDeviation(ctx, topic.Id, reputer)
+	if err != nil {
+		return err
+	}
+	if deviation.ConsecutiveEpochs > 0 && blockHeight-deviation.BlockHeight > topic.EpochLength {
+		deviation.ConsecutiveEpochs = 0
+	}
+	deviation.ConsecutiveEpochs++
+	deviation.Distance = distance
+	deviation.BlockHeight = blockHeight
+	return k.SetReputerConsensusDeviation(ctx, deviation)
+}
+
+/// SLASHING
+
+// SlashReputersFarFromConsensus slashes every reputer of the topic whose last ReputerSlashEpochs
+// reports were all further from the loss consensus than the topic threshold. The reputer's own
+// stake and the stake delegated to it are slashed by their respective fractions, the slashed
+// funds go to the ecosystem account and the reputer's run of deviating reports starts afresh.
+// Its unvested rewards held in escrow on the topic are forfeited to the topic reward pool.
+func (k *Keeper) SlashReputersFarFromConsensus(ctx sdk.Context, topic types.Topic) error {
+	if !topic.SlashesReputers() {
+		return nil
+	}
+	deviations, err := k.GetReputerConsensusDeviationsOfTopic(ctx, topic.Id)
+	if err != nil {
+		return err
+	}
+	for _, deviation := range deviations {
+		if deviation.ConsecutiveEpochs < topic.ReputerSlashEpochs {
+			continue
+		}
+		selfSlashed, err := k.slashReputerSelfStake(ctx, topic.Id, deviation.Reputer, topic.ReputerSelfSlashFraction)
+		if err != nil {
+			return errorsmod.Wrapf(err, "error slashing stake of reputer %s", deviation.Reputer)
+		}
+		delegateSlashed, err := k.slashReputerDelegateStake(ctx, topic.Id, deviation.Reputer, topic.ReputerDelegateSlashFraction)
+		if err != nil {
+			return errorsmod.Wrapf(err, "error slashing stake delegated to reputer %s", deviation.Reputer)
+		}
+		slashed := selfSlashed.Add(delegateSlashed)
+		if slashed.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, slashed))
+			err = k.SendCoinsFromModuleToModule(ctx, types.AlloraStakingAccountName, minttypes.EcosystemModuleName, coins)
+			if err != nil {
+				return errorsmod.Wrapf(err, "error sending slashed stake of reputer %s", deviation.Reputer)
+			}
+		}
+		forfeited, err := k.ForfeitUnvestedRewards(ctx, topic.Id, deviation.Reputer)
+		if err != nil {
+			return errorsmod.Wrapf(err, "error forfeiting unvested rewards of reputer %s", deviation.Reputer)
+		}
+		if err := k.DeleteReputerConsensusDeviation(ctx, topic.Id, deviation.Reputer); err != nil {
+			return err
+		}
+		types.EmitNewReputerSlashedEvent(ctx, deviation, topic.ReputerSlashDistanceThreshold, selfSlashed, delegateSlashed)
+		ctx.Logger().Debug(fmt.Sprintf("Slashed %s from reputer %s and %s from its delegators in topic %d after %d deviating reports, forfeiting %s of unvested rewards",
+			selfSlashed, deviation.Reputer, delegateSlashed, topic.Id, deviation.ConsecutiveEpochs, forfeited))
+	}
+	return nil
+}
+
+// Slashes the given fraction of the reputer's own stake in the topic and returns the slashed amount.
+// A pending removal is reduced so that it does not exceed what is left of the stake
+func (k *Keeper) slashReputerSelfStake(
+	ctx sdk.Context,
+	topicId TopicId,
+	reputer ActorId,
+	fraction alloraMath.Dec,
+) (cosmosMath.Int, error) {
+	reputerAuthority, err := k.GetStakeReputerAuthority(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	delegateStakeUponReputer, err := k.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	selfStake := reputerAuthority.Sub(delegateStakeUponReputer)
+	slashed, err := types.SlashAmount(selfStake, fraction)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	if !slashed.IsPositive() {
+		return cosmosMath.ZeroInt(), nil
+	}
+	if err := k.subtractSlashedReputerStake(ctx, topicId, reputer, slashed); err != nil {
+		return cosmosMath.Int{}, err
+	}
+
+	remaining := selfStake.Sub(slashed)
+	removal, found, err := k.GetStakeRemovalForReputerAndTopicId(ctx, reputer, topicId)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	if found && removal.Amount.GT(remaining) {
+		err = k.DeleteStakeRemoval(ctx, removal.BlockRemovalCompleted, removal.TopicId, removal.Reputer)
+		if err != nil {
+			return cosmosMath.Int{}, err
+		}
+		if remaining.IsPositive() {
+			removal.Amount = remaining
+			if err := k.SetStakeRemoval(ctx, removal); err != nil {
+				return cosmosMath.Int{}, err
+			}
+		}
+	}
+	return slashed, nil
+}
+
+// Slashes the given fraction of every delegation to the reputer in the topic and returns the total
+// slashed amount. Stake redelegated away from the reputer that has not completed its redelegation is
+// slashed at its destination as well, so that redelegating cannot be used to escape a pending slash
+func (k *Keeper) slashReputerDelegateStake(
+	ctx sdk.Context,
+	topicId TopicId,
+	reputer ActorId,
+	fraction alloraMath.Dec,
+) (cosmosMath.Int, error) {
+	share, err := k.GetDelegateRewardPerShare(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, Reputer, Delegator](topicId, reputer)
+	delegators, err := collectKeySetKeys(ctx, k.delegateStakePlacementsByReputer, rng, 0)
+	if err != nil {
+		return cosmosMath.Int{}, errorsmod.Wrap(err, "error getting delegations to reputer")
+	}
+
+	totalSlashed := cosmosMath.ZeroInt()
+	for _, key := range delegators {
+		delegator := key.K3()
+		placement, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+		if err != nil {
+			return cosmosMath.Int{}, err
+		}
+		amount, err := placement.Amount.SdkIntTrim()
+		if err != nil {
+			return cosmosMath.Int{}, err
+		}
+		slashed, err := types.SlashAmount(amount, fraction)
+		if err != nil {
+			return cosmosMath.Int{}, err
+		}
+		if !slashed.IsPositive() {
+			continue
+		}
+		if err := k.slashDelegation(ctx, topicId, delegator, reputer, placement, share, slashed); err != nil {
+			return cosmosMath.Int{}, err
+		}
+		totalSlashed = totalSlashed.Add(slashed)
+	}
+
+	redelegations, err := k.GetRedelegationsFromReputer(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	for _, redelegation := range redelegations {
+		slashed, err := types.SlashAmount(redelegation.Amount, fraction)
+		if err != nil {
+			return cosmosMath.Int{}, err
+		}
+		placement, err := k.GetDelegateStakePlacement(ctx, topicId, redelegation.Delegator, redelegation.DstReputer)
+		if err != nil {
+			return cosmosMath.Int{}, err
+		}
+		// stake removed from the destination since the redelegation is out of reach
+		available, err := placement.Amount.SdkIntTrim()
+		if err != nil {
+			return cosmosMath.Int{}, err
+		}
+		slashed = cosmosMath.MinInt(slashed, available)
+		if !slashed.IsPositive() {
+			continue
+		}
+		dstShare, err := k.GetDelegateRewardPerShare(ctx, topicId, redelegation.DstReputer)
+		if err != nil {
+			return cosmosMath.Int{}, err
+		}
+		err = k.slashDelegation(ctx, topicId, redelegation.Delegator, redelegation.DstReputer, placement, dstShare, slashed)
+		if err != nil {
+			return cosmosMath.Int{}, err
+		}
+		redelegation.Amount = redelegation.Amount.Sub(slashed)
+		if redelegation.Amount.IsPositive() {
+			err = k.SetRedelegation(ctx, redelegation)
+		} else {
+			err = k.DeleteRedelegation(ctx, topicId, reputer, redelegation.Delegator, redelegation.DstReputer)
+		}
+		if err != nil {
+			return cosmosMath.Int{}, err
+		}
+		totalSlashed = totalSlashed.Add(slashed)
+	}
+	return totalSlashed, nil
+}
+
+// Slashes the given amount from a delegation. Pending rewards are paid out first so that the reward
+// debt can be reset against the reduced delegation, and a pending removal is reduced to what is left
+func (k *Keeper) slashDelegation(
+	ctx sdk.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+	placement types.DelegatorInfo,
+	share alloraMath.Dec,
+	slashed cosmosMath.Int,
+) error {
+	if err := k.payoutPendingDelegateReward(ctx, delegator, placement, share); err != nil {
+		return err
+	}
+	slashedDec, err := alloraMath.NewDecFromSdkInt(slashed)
+	if err != nil {
+		return err
+	}
+	placement.Amount, err = placement.Amount.Sub(slashedDec)
+	if err != nil {
+		return err
+	}
+	placement.RewardDebt, err = placement.Amount.Mul(share)
+	if err != nil {
+		return err
+	}
+	if err := k.SetDelegateStakePlacement(ctx, topicId, delegator, reputer, placement); err != nil {
+		return err
+	}
+	stakeFromDelegator, err := k.GetStakeFromDelegatorInTopic(ctx, topicId, delegator)
+	if err != nil {
+		return err
+	}
+	if err := k.SetStakeFromDelegator(ctx, topicId, delegator, stakeFromDelegator.Sub(slashed)); err != nil {
+		return err
+	}
+
+	remaining, err := placement.Amount.SdkIntTrim()
+	if err != nil {
+		return err
+	}
+	removal, found, err := k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(ctx, delegator, reputer, topicId)
+	if err != nil {
+		return err
+	}
+	if found && removal.Amount.GT(remaining) {
+		err = k.DeleteDelegateStakeRemoval(ctx, removal.BlockRemovalCompleted, removal.TopicId, removal.Reputer, removal.Delegator)
+		if err != nil {
+			return err
+		}
+		if remaining.IsPositive() {
+			removal.Amount = remaining
+			if err := k.SetDelegateStakeRemoval(ctx, removal); err != nil {
+				return err
+			}
+		}
+	}
+
+	stakeUponReputer, err := k.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	}
+	if err := k.SetDelegateStakeUponReputer(ctx, topicId, reputer, stakeUponReputer.Sub(slashed)); err != nil {
+		return err
+	}
+	return k.subtractSlashedReputerStake(ctx, topicId, reputer, slashed)
+}
+
+// Pays the delegator the rewards accrued by a delegation since its reward debt was last set
+func (k *Keeper) payoutPendingDelegateReward(
+	ctx context.Context,
+	delegator ActorId,
+	placement types.DelegatorInfo,
+	share alloraMath.Dec,
+) error {
+	pendingReward, err := placement.Amount.Mul(share)
+	if err != nil {
+		return err
+	}
+	pendingReward, err = pendingReward.Sub(placement.RewardDebt)
+	if err != nil {
+		return err
+	}
+	if !pendingReward.IsPositive() {
+		return nil
+	}
+	pendingRewardInt, err := pendingReward.SdkIntTrim()
+	if err != nil {
+		return err
+	}
+	if !pendingRewardInt.IsPositive() {
+		return nil
+	}
+	return k.SendCoinsFromModuleToAccount(
+		ctx,
+		types.AlloraPendingRewardForDelegatorAccountName,
+		delegator,
+		sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, pendingRewardInt)),
+	)
+}
+
+// Subtracts slashed stake from the reputer's authority, the topic stake and the total stake
+func (k *Keeper) subtractSlashedReputerStake(ctx context.Context, topicId TopicId, reputer ActorId, amount cosmosMath.Int) error {
+	reputerAuthority, err := k.GetStakeReputerAuthority(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	}
+	if amount.GT(reputerAuthority) {
+		return types.ErrIntegerUnderflowReputerStakeAuthority
+	}
+	topicStake, err := k.GetTopicStake(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	if amount.GT(topicStake) {
+		return types.ErrIntegerUnderflowTopicStake
+	}
+	totalStake, err := k.GetTotalStake(ctx)
+	if err != nil {
+		return err
+	}
+	if amount.GT(totalStake) {
+		return types.ErrIntegerUnderflowTotalStake
+	}
+	if err := k.SetStakeReputerAuthority(ctx, topicId, reputer, reputerAuthority.Sub(amount)); err != nil {
+		return err
+	}
+	if err := k.SetTopicStake(ctx, topicId, topicStake.Sub(amount)); err != nil {
+		return err
+	}
+	return k.SetTotalStake(ctx, totalStake.Sub(amount))
+}
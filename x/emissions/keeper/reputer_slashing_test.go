@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) mockSlashingTopic() types.Topic {
+	topic := s.mockTopic()
+	topic.ReputerSlashDistanceThreshold = alloraMath.MustNewDecFromString("0.5")
+	topic.ReputerSlashEpochs = 2
+	topic.ReputerSelfSlashFraction = alloraMath.MustNewDecFromString("0.1")
+	topic.ReputerDelegateSlashFraction = alloraMath.MustNewDecFromString("0.05")
+	return topic
+}
+
+// mintTo funds a module account as the msg server would when stake is added
+func (s *KeeperTestSuite) mintTo(moduleName string, amount cosmosMath.Int) {
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+	s.Require().NoError(s.bankKeeper.MintCoins(s.ctx, moduleName, coins))
+}
+
+func (s *KeeperTestSuite) TestUpdateReputerConsensusDeviation() {
+	k := s.emissionsKeeper
+	topic := s.mockSlashingTopic()
+	reputer := s.addrsStr[0]
+
+	s.Require().NoError(k.UpdateReputerConsensusDeviation(s.ctx, topic, reputer, 10, alloraMath.MustNewDecFromString("0.6")))
+	s.Require().NoError(k.UpdateReputerConsensusDeviation(s.ctx, topic, reputer, 20, alloraMath.MustNewDecFromString("0.7")))
+	deviation, err := k.GetReputerConsensusDeviation(s.ctx, topic.Id, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(2), deviation.ConsecutiveEpochs)
+	s.Require().Equal(int64(20), deviation.BlockHeight)
+	s.Require().True(alloraMath.MustNewDecFromString("0.7").Equal(deviation.Distance))
+
+	// A report at the threshold ends the run
+	s.Require().NoError(k.UpdateReputerConsensusDeviation(s.ctx, topic, reputer, 30, alloraMath.MustNewDecFromString("0.5")))
+	deviation, err = k.GetReputerConsensusDeviation(s.ctx, topic.Id, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), deviation.ConsecutiveEpochs)
+
+	// Nothing is tracked on topics that do not slash reputers
+	topic.ReputerSlashEpochs = 0
+	s.Require().NoError(k.UpdateReputerConsensusDeviation(s.ctx, topic, reputer, 40, alloraMath.MustNewDecFromString("0.9")))
+	deviations, err := k.GetReputerConsensusDeviationsOfTopic(s.ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().Empty(deviations)
+}
+
+func (s *KeeperTestSuite) TestReputerConsensusDeviationResetsAfterMissedEpoch() {
+	k := s.emissionsKeeper
+	topic := s.mockSlashingTopic()
+	reputer := s.addrsStr[0]
+	far := alloraMath.MustNewDecFromString("0.8")
+
+	s.Require().NoError(k.UpdateReputerConsensusDeviation(s.ctx, topic, reputer, 100, far))
+	// The reputer does not report in the next epoch
+	nextReport := 100 + 2*topic.EpochLength
+	s.Require().NoError(k.UpdateReputerConsensusDeviation(s.ctx, topic, reputer, nextReport, far))
+	deviation, err := k.GetReputerConsensusDeviation(s.ctx, topic.Id, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), deviation.ConsecutiveEpochs)
+	s.Require().Equal(nextReport, deviation.BlockHeight)
+
+	// Two deviating reports split by a missed epoch are not enough to be slashed
+	s.mintTo(types.AlloraStakingAccountName, cosmosMath.NewInt(1000))
+	s.Require().NoError(k.AddReputerStake(s.ctx, topic.Id, reputer, cosmosMath.NewInt(1000)))
+	s.Require().NoError(k.SlashReputersFarFromConsensus(s.ctx, topic))
+	stake, err := k.GetStakeReputerAuthority(s.ctx, topic.Id, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(1000), stake)
+
+	// The next consecutive deviating report completes the run
+	s.Require().NoError(k.UpdateReputerConsensusDeviation(s.ctx, topic, reputer, nextReport+topic.EpochLength, far))
+	deviation, err = k.GetReputerConsensusDeviation(s.ctx, topic.Id, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(2), deviation.ConsecutiveEpochs)
+}
+
+func (s *KeeperTestSuite) TestSlashReputersFarFromConsensus() {
+	k := s.emissionsKeeper
+	topic := s.mockSlashingTopic()
+	reputer := s.addrsStr[0]
+	delegator := s.addrsStr[1]
+	honestReputer := s.addrsStr[2]
+	far := alloraMath.MustNewDecFromString("0.8")
+
+	s.mintTo(types.AlloraStakingAccountName, cosmosMath.NewInt(1900))
+	s.Require().NoError(k.AddReputerStake(s.ctx, topic.Id, reputer, cosmosMath.NewInt(1000)))
+	s.Require().NoError(k.AddReputerStake(s.ctx, topic.Id, honestReputer, cosmosMath.NewInt(500)))
+	s.Require().NoError(k.AddDelegateStake(s.ctx, topic.Id, delegator, reputer, cosmosMath.NewInt(400)))
+	// The delegator has 200 of rewards pending when the reputer is slashed
+	s.mintTo(types.AlloraPendingRewardForDelegatorAccountName, cosmosMath.NewInt(200))
+	s.Require().NoError(k.SetDelegateRewardPerShare(s.ctx, topic.Id, reputer, alloraMath.MustNewDecFromString("0.5")))
+
+	s.Require().NoError(k.SetStakeRemoval(s.ctx, types.StakeRemovalInfo{
+		BlockRemovalStarted:   s.ctx.BlockHeight(),
+		BlockRemovalCompleted: s.ctx.BlockHeight() + 100,
+		TopicId:               topic.Id,
+		Reputer:               reputer,
+		Amount:                cosmosMath.NewInt(950),
+	}))
+	s.Require().NoError(k.SetDelegateStakeRemoval(s.ctx, types.DelegateStakeRemovalInfo{
+		BlockRemovalStarted:   s.ctx.BlockHeight(),
+		BlockRemovalCompleted: s.ctx.BlockHeight() + 100,
+		TopicId:               topic.Id,
+		Delegator:             delegator,
+		Reputer:               reputer,
+		Amount:                cosmosMath.NewInt(400),
+	}))
+
+	// One deviating report is tolerated
+	s.Require().NoError(k.UpdateReputerConsensusDeviation(s.ctx, topic, reputer, 10, far))
+	s.Require().NoError(k.UpdateReputerConsensusDeviation(s.ctx, topic, honestReputer, 10, far))
+	s.Require().NoError(k.SlashReputersFarFromConsensus(s.ctx, topic))
+	authority, err := k.GetStakeReputerAuthority(s.ctx, topic.Id, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(1400), authority)
+
+	s.Require().NoError(k.UpdateReputerConsensusDeviation(s.ctx, topic, reputer, 20, far))
+	s.Require().NoError(k.UpdateReputerConsensusDeviation(s.ctx, topic, honestReputer, 20, alloraMath.ZeroDec()))
+	s.Require().NoError(k.SlashReputersFarFromConsensus(s.ctx, topic))
+
+	authority, err = k.GetStakeReputerAuthority(s.ctx, topic.Id, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(1280), authority, "100 of self stake and 20 of delegated stake are slashed")
+	stakeUponReputer, err := k.GetDelegateStakeUponReputer(s.ctx, topic.Id, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(380), stakeUponReputer)
+	authority, err = k.GetStakeReputerAuthority(s.ctx, topic.Id, honestReputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(500), authority)
+	topicStake, err := k.GetTopicStake(s.ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(1780), topicStake)
+
+	placement, err := k.GetDelegateStakePlacement(s.ctx, topic.Id, delegator, reputer)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.NewDecFromInt64(380).Equal(placement.Amount))
+	s.Require().True(alloraMath.NewDecFromInt64(190).Equal(placement.RewardDebt), "no reward is pending after the slash")
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegator)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(200), s.bankKeeper.GetBalance(s.ctx, delegatorAddr, params.DefaultBondDenom).Amount)
+	ecosystemAddr := s.accountKeeper.GetModuleAddress(minttypes.EcosystemModuleName)
+	s.Require().Equal(cosmosMath.NewInt(120), s.bankKeeper.GetBalance(s.ctx, ecosystemAddr, params.DefaultBondDenom).Amount)
+
+	removal, found, err := k.GetStakeRemovalForReputerAndTopicId(s.ctx, reputer, topic.Id)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(cosmosMath.NewInt(900), removal.Amount, "pending removal is capped to the remaining self stake")
+	delegateRemoval, found, err := k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(s.ctx, delegator, reputer, topic.Id)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(cosmosMath.NewInt(380), delegateRemoval.Amount)
+
+	// Deviating reports are counted afresh after a slash
+	deviations, err := k.GetReputerConsensusDeviationsOfTopic(s.ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().Empty(deviations)
+
+	for _, invariant := range []sdk.Invariant{
+		keeper.StakingInvariantTotalStakeEqualAlloraStakingBankBalance(k),
+		keeper.StakingInvariantDelegatedStakes(k),
+		keeper.StakingInvariantPendingRewardForDelegatorsGreaterThanRewardPerShareMinusRewardDebt(k),
+	} {
+		msg, broken := invariant(s.ctx)
+		s.Require().False(broken, msg)
+	}
+}
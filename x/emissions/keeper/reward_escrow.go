@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/// REWARD ESCROWS
+
+// Sets a reward held in escrow, removing it once it has fully vested and been claimed
+func (k *Keeper) SetRewardEscrow(ctx context.Context, escrow types.RewardEscrow) error {
+	if err := escrow.Validate(); err != nil {
+		return errorsmod.Wrap(err, "reward escrow is not valid")
+	}
+	key := collections.Join3(escrow.Address, escrow.TopicId, escrow.BlockEscrowed)
+	if escrow.Claimed.Equal(escrow.Amount) && sdk.UnwrapSDKContext(ctx).BlockHeight() >= escrow.BlockVested {
+		return k.rewardEscrows.Remove(ctx, key)
+	}
+	return k.rewardEscrows.Set(ctx, key, escrow)
+}
+
+// Returns the rewards of the actor held in escrow on the topic, or on all topics if the topic id is 0,
+// in ascending (topic id, block escrowed) order
+func (k *Keeper) GetRewardEscrows(ctx context.Context, address ActorId, topicId TopicId) ([]types.RewardEscrow, error) {
+	var rng collections.Ranger[collections.Triple[ActorId, TopicId, BlockHeight]]
+	if topicId == 0 {
+		rng = collections.NewPrefixedTripleRange[ActorId, TopicId, BlockHeight](address)
+	} else {
+		rng = collections.NewSuperPrefixedTripleRange[ActorId, TopicId, BlockHeight](address, topicId)
+	}
+	iter, err := k.rewardEscrows.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error iterating reward escrows")
+	}
+	defer iter.Close()
+	return iter.Values()
+}
+
+// Returns the total amount of rewards held in escrow across all topics
+func (k *Keeper) GetTotalRewardEscrows(ctx context.Context) (cosmosMath.Int, error) {
+	ret, err := k.totalRewardEscrows.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return cosmosMath.ZeroInt(), nil
+	} else if err != nil {
+		return cosmosMath.Int{}, errorsmod.Wrap(err, "error getting total reward escrows")
+	}
+	return ret, nil
+}
+
+// Sets the total amount of rewards held in escrow across all topics
+func (k *Keeper) SetTotalRewardEscrows(ctx context.Context, total cosmosMath.Int) error {
+	if err := types.ValidateSdkIntRepresentingMonetaryValue(total); err != nil {
+		return errorsmod.Wrap(err, "total reward escrows cosmos Int is not valid")
+	}
+	return k.totalRewardEscrows.Set(ctx, total)
+}
+
+// Holds part of a reward of the actor in escrow, vesting over the reward escrow vesting blocks of the topic.
+// The coins must already have been sent to the allora reward escrow account.
+// Escrowing more rewards at the same block adds to that escrow
+func (k *Keeper) EscrowReward(ctx sdk.Context, topic types.Topic, address ActorId, amount cosmosMath.Int) error {
+	blockHeight := ctx.BlockHeight()
+	escrow, err := k.rewardEscrows.Get(ctx, collections.Join3(address, topic.Id, blockHeight))
+	if errors.Is(err, collections.ErrNotFound) {
+		escrow = types.RewardEscrow{
+			TopicId:       topic.Id,
+			Address:       address,
+			Amount:        cosmosMath.ZeroInt(),
+			Claimed:       cosmosMath.ZeroInt(),
+			BlockEscrowed: blockHeight,
+			BlockVested:   blockHeight + topic.RewardEscrowVestingBlocks,
+		}
+	} else if err != nil {
+		return errorsmod.Wrap(err, "error getting reward escrow")
+	}
+	escrow.Amount = escrow.Amount.Add(amount)
+	if err := k.SetRewardEscrow(ctx, escrow); err != nil {
+		return err
+	}
+	total, err := k.GetTotalRewardEscrows(ctx)
+	if err != nil {
+		return err
+	}
+	return k.SetTotalRewardEscrows(ctx, total.Add(amount))
+}
+
+// Returns the vested and not yet claimed, and the unvested amounts of the escrows at the current block
+func (k *Keeper) GetRewardEscrowBalances(ctx context.Context, escrows []types.RewardEscrow) (vested, unvested cosmosMath.Int, err error) {
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	vested, unvested = cosmosMath.ZeroInt(), cosmosMath.ZeroInt()
+	for _, escrow := range escrows {
+		escrowVested, err := escrow.VestedAmount(blockHeight)
+		if err != nil {
+			return cosmosMath.Int{}, cosmosMath.Int{}, errorsmod.Wrap(err, "error computing vested reward")
+		}
+		vested = vested.Add(escrowVested.Sub(escrow.Claimed))
+		unvested = unvested.Add(escrow.Amount.Sub(escrowVested))
+	}
+	return vested, unvested, nil
+}
+
+// Pays out the vested and not yet claimed rewards of the actor held in escrow on the topic
+// and returns the amount paid out
+func (k *Keeper) ClaimVestedRewards(ctx sdk.Context, topicId TopicId, address ActorId) (cosmosMath.Int, error) {
+	escrows, err := k.GetRewardEscrows(ctx, address, topicId)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	claimed := cosmosMath.ZeroInt()
+	for _, escrow := range escrows {
+		vested, err := escrow.VestedAmount(ctx.BlockHeight())
+		if err != nil {
+			return cosmosMath.Int{}, errorsmod.Wrap(err, "error computing vested reward")
+		}
+		claimable := vested.Sub(escrow.Claimed)
+		if !claimable.IsPositive() {
+			continue
+		}
+		escrow.Claimed = vested
+		if err := k.SetRewardEscrow(ctx, escrow); err != nil {
+			return cosmosMath.Int{}, err
+		}
+		claimed = claimed.Add(claimable)
+	}
+	if claimed.IsZero() {
+		return claimed, nil
+	}
+	if err := k.subtractTotalRewardEscrows(ctx, claimed); err != nil {
+		return cosmosMath.Int{}, err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, claimed))
+	if err := k.SendCoinsFromModuleToAccount(ctx, types.AlloraRewardEscrowAccountName, address, coins); err != nil {
+		return cosmosMath.Int{}, errorsmod.Wrap(err, "error paying out vested rewards")
+	}
+	return claimed, nil
+}
+
+// Returns the unvested rewards of the actor held in escrow on the topic to the topic reward pool
+// and returns the amount forfeited. What has vested can still be claimed
+func (k *Keeper) ForfeitUnvestedRewards(ctx sdk.Context, topicId TopicId, address ActorId) (cosmosMath.Int, error) {
+	escrows, err := k.GetRewardEscrows(ctx, address, topicId)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	forfeited := cosmosMath.ZeroInt()
+	for _, escrow := range escrows {
+		vested, err := escrow.VestedAmount(ctx.BlockHeight())
+		if err != nil {
+			return cosmosMath.Int{}, errorsmod.Wrap(err, "error computing vested reward")
+		}
+		unvested := escrow.Amount.Sub(vested)
+		if !unvested.IsPositive() {
+			continue
+		}
+		// what is left has vested in full
+		escrow.Amount = vested
+		escrow.BlockVested = ctx.BlockHeight()
+		if err := k.SetRewardEscrow(ctx, escrow); err != nil {
+			return cosmosMath.Int{}, err
+		}
+		forfeited = forfeited.Add(unvested)
+	}
+	if forfeited.IsZero() {
+		return forfeited, nil
+	}
+	if err := k.subtractTotalRewardEscrows(ctx, forfeited); err != nil {
+		return cosmosMath.Int{}, err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, forfeited))
+	err = k.SendCoinsFromModuleToModule(ctx, types.AlloraRewardEscrowAccountName, minttypes.EcosystemModuleName, coins)
+	if err != nil {
+		return cosmosMath.Int{}, errorsmod.Wrap(err, "error sending forfeited rewards")
+	}
+	if err := k.AddTopicFeeRevenue(ctx, topicId, forfeited); err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return forfeited, nil
+}
+
+// Subtracts from the total amount of rewards held in escrow, without moving any coins
+func (k *Keeper) subtractTotalRewardEscrows(ctx context.Context, amount cosmosMath.Int) error {
+	total, err := k.GetTotalRewardEscrows(ctx)
+	if err != nil {
+		return err
+	}
+	if amount.GT(total) {
+		return errorsmod.Wrapf(types.ErrInvariantFailure, "cannot remove %s from total reward escrows of %s", amount, total)
+	}
+	return k.SetTotalRewardEscrows(ctx, total.Sub(amount))
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *KeeperTestSuite) mockEscrowTopic() types.Topic {
+	topic := s.mockTopic()
+	topic.RewardEscrowFraction = alloraMath.MustNewDecFromString("0.5")
+	topic.RewardEscrowVestingBlocks = 100
+	return topic
+}
+
+// escrowReward escrows amount for the actor as the reward payout would, funding the reward escrow account
+func (s *KeeperTestSuite) escrowReward(blockHeight int64, topic types.Topic, address string, amount cosmosMath.Int) {
+	s.mintTo(types.AlloraRewardEscrowAccountName, amount)
+	s.Require().NoError(s.emissionsKeeper.EscrowReward(s.ctx.WithBlockHeight(blockHeight), topic, address, amount))
+}
+
+func (s *KeeperTestSuite) requireRewardEscrowBalances(blockHeight int64, address string, vested, unvested int64) {
+	ctx := s.ctx.WithBlockHeight(blockHeight)
+	escrows, err := s.emissionsKeeper.GetRewardEscrows(ctx, address, 0)
+	s.Require().NoError(err)
+	vestedAmount, unvestedAmount, err := s.emissionsKeeper.GetRewardEscrowBalances(ctx, escrows)
+	s.Require().NoError(err)
+	s.Require().True(cosmosMath.NewInt(vested).Equal(vestedAmount), "vested %s", vestedAmount)
+	s.Require().True(cosmosMath.NewInt(unvested).Equal(unvestedAmount), "unvested %s", unvestedAmount)
+}
+
+func (s *KeeperTestSuite) TestRewardEscrowAmount() {
+	topic := s.mockEscrowTopic()
+	escrowed, err := topic.RewardEscrowAmount(cosmosMath.NewInt(101))
+	s.Require().NoError(err)
+	s.Require().True(cosmosMath.NewInt(50).Equal(escrowed), "the escrowed part is rounded in favour of the actor")
+
+	topic.RewardEscrowVestingBlocks = 0
+	escrowed, err = topic.RewardEscrowAmount(cosmosMath.NewInt(101))
+	s.Require().NoError(err)
+	s.Require().True(escrowed.IsZero())
+}
+
+func (s *KeeperTestSuite) TestEscrowClaimAndForfeitRewards() {
+	k := s.emissionsKeeper
+	topic := s.mockEscrowTopic()
+	s.Require().NoError(k.SetTopic(s.ctx, topic.Id, topic))
+	actor := s.addrsStr[0]
+
+	s.escrowReward(100, topic, actor, cosmosMath.NewInt(100))
+	s.requireRewardEscrowBalances(100, actor, 0, 100)
+	s.requireRewardEscrowBalances(150, actor, 50, 50)
+
+	// Claiming pays out what vested so far
+	claimed, err := k.ClaimVestedRewards(s.ctx.WithBlockHeight(150), topic.Id, actor)
+	s.Require().NoError(err)
+	s.Require().True(cosmosMath.NewInt(50).Equal(claimed))
+	s.Require().Equal(cosmosMath.NewInt(50), s.bankKeeper.GetBalance(s.ctx, s.addrs[0], params.DefaultBondDenom).Amount)
+	s.requireRewardEscrowBalances(150, actor, 0, 50)
+
+	// Nothing more can be claimed in the same block
+	claimed, err = k.ClaimVestedRewards(s.ctx.WithBlockHeight(150), topic.Id, actor)
+	s.Require().NoError(err)
+	s.Require().True(claimed.IsZero())
+
+	// Rewards escrowed later vest on their own schedule
+	s.escrowReward(150, topic, actor, cosmosMath.NewInt(40))
+	s.requireRewardEscrowBalances(200, actor, 70, 20)
+
+	// Forfeiting only takes what has not vested yet
+	forfeited, err := k.ForfeitUnvestedRewards(s.ctx.WithBlockHeight(200), topic.Id, actor)
+	s.Require().NoError(err)
+	s.Require().True(cosmosMath.NewInt(20).Equal(forfeited))
+	feeRevenue, err := k.GetTopicFeeRevenue(s.ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(20), feeRevenue)
+	s.requireRewardEscrowBalances(300, actor, 70, 0)
+	total, err := k.GetTotalRewardEscrows(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(70), total)
+
+	claimed, err = k.ClaimVestedRewards(s.ctx.WithBlockHeight(300), topic.Id, actor)
+	s.Require().NoError(err)
+	s.Require().True(cosmosMath.NewInt(70).Equal(claimed))
+	escrows, err := k.GetRewardEscrows(s.ctx, actor, topic.Id)
+	s.Require().NoError(err)
+	s.Require().Empty(escrows, "escrows are removed once fully vested and claimed")
+	total, err = k.GetTotalRewardEscrows(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(total.IsZero())
+
+	_, broken := keeper.RewardEscrowInvariantTotalRewardEscrowsEqualAlloraRewardEscrowBankBalance(k)(s.ctx)
+	s.Require().False(broken)
+}
+
+func (s *KeeperTestSuite) TestSlashIdleWorkerBondsForfeitsUnvestedRewards() {
+	s.ctx = s.ctx.WithBlockHeight(5)
+	k := s.emissionsKeeper
+	topic := s.mockBondedTopic()
+	topic.RewardEscrowFraction = alloraMath.MustNewDecFromString("0.5")
+	topic.RewardEscrowVestingBlocks = 1000
+	s.Require().NoError(k.SetTopic(s.ctx, topic.Id, topic))
+	idleWorker := s.addrsStr[0]
+	s.bondWorker(topic.Id, idleWorker, cosmosMath.NewInt(100))
+	s.escrowReward(5, topic, idleWorker, cosmosMath.NewInt(100))
+
+	topic.EpochLastEnded = 305
+	s.Require().NoError(k.SlashIdleWorkerBonds(s.ctx.WithBlockHeight(505), topic))
+
+	// The bond is slashed by half and the unvested 50 of the escrow are forfeited
+	feeRevenue, err := k.GetTopicFeeRevenue(s.ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(100), feeRevenue)
+	s.requireRewardEscrowBalances(505, idleWorker, 50, 0)
+
+	_, broken := keeper.RewardEscrowInvariantTotalRewardEscrowsEqualAlloraRewardEscrowBankBalance(k)(s.ctx)
+	s.Require().False(broken)
+}
@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/// REWARD HISTORY
+
+// Records a paid out reward in the reward history of its topic and of its actor.
+// This completely overrides an existing record of the same reward type at the same block
+func (k *Keeper) SetRewardRecord(ctx context.Context, record types.RewardRecord) error {
+	if err := record.Validate(); err != nil {
+		return errorsmod.Wrap(err, "reward record is not valid")
+	}
+	key := Join4(record.TopicId, record.BlockHeight, record.Address, record.Type)
+	if err := k.rewardHistory.Set(ctx, key, record); err != nil {
+		return errorsmod.Wrap(err, "error setting reward record")
+	}
+	return k.rewardHistoryByActor.Set(ctx, Join4(record.Address, record.TopicId, record.BlockHeight, record.Type))
+}
+
+// Removes a reward record from the reward history of its topic and of its actor
+func (k *Keeper) DeleteRewardRecord(
+	ctx context.Context,
+	topicId TopicId,
+	blockHeight BlockHeight,
+	address ActorId,
+	rewardType string,
+) error {
+	if err := k.rewardHistory.Remove(ctx, Join4(topicId, blockHeight, address, rewardType)); err != nil {
+		return errorsmod.Wrap(err, "error removing reward record")
+	}
+	return k.rewardHistoryByActor.Remove(ctx, Join4(address, topicId, blockHeight, rewardType))
+}
+
+// Records rewards paid out at the current block in the reward history.
+// Nothing is recorded while the reward history retention is zero
+func (k *Keeper) RecordRewards(ctx sdk.Context, rewards []types.TaskReward) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting params")
+	}
+	if moduleParams.RewardHistoryRetentionBlocks == 0 {
+		return nil
+	}
+	for _, reward := range rewards {
+		err := k.SetRewardRecord(ctx, types.RewardRecord{
+			TopicId:     reward.TopicId,
+			BlockHeight: ctx.BlockHeight(),
+			Address:     reward.Address,
+			Type:        string(reward.Type),
+			Reward:      reward.Reward,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns a page of the rewards paid out to the actor, in ascending (topic id, block) order.
+// A zero topic id returns the rewards of the actor across all topics, and a zero from or to block
+// leaves that end of the block range open.
+// The page limit bounds the records scanned rather than the records returned, so with a block range
+// across topics a page may hold fewer records than the limit, or none, while still returning a key to resume from.
+// The pagination key of a page is the encoded key of its first record in the reward history by actor
+func (k *Keeper) GetActorRewardHistory(
+	ctx context.Context,
+	address ActorId,
+	topicId TopicId,
+	fromBlock BlockHeight,
+	toBlock BlockHeight,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]*types.RewardRecord, *types.SimpleCursorPaginationResponse, error) {
+	limit, err := k.CalcAppropriatePaginationLimit(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyCodec := k.rewardHistoryByActor.KeyCodec()
+	rng := new(collections.Range[Quadruple[ActorId, TopicId, BlockHeight, string]]).
+		Prefix(QuadrupleSinglePrefix[ActorId, TopicId, BlockHeight, string](address))
+	if topicId != 0 {
+		rng = rng.Prefix(QuadrupleDoublePrefix[ActorId, TopicId, BlockHeight, string](address, topicId))
+		if fromBlock != 0 {
+			rng = rng.StartInclusive(QuadrupleTriplePrefix[ActorId, TopicId, BlockHeight, string](address, topicId, fromBlock))
+		}
+		if toBlock != 0 {
+			rng = rng.EndExclusive(QuadrupleTriplePrefix[ActorId, TopicId, BlockHeight, string](address, topicId, toBlock+1))
+		}
+	}
+	if pagination != nil && len(pagination.Key) > 0 {
+		_, start, err := keyCodec.Decode(pagination.Key)
+		if err != nil || start.K1() != address || (topicId != 0 && start.K2() != topicId) {
+			return nil, nil, errorsmod.Wrap(types.ErrInvalidValue, "invalid pagination key")
+		}
+		rng = rng.StartInclusive(start)
+	}
+
+	records := make([]*types.RewardRecord, 0)
+	var nextKey []byte
+	scanned := uint64(0)
+	err = k.rewardHistoryByActor.Walk(ctx, rng, func(key Quadruple[ActorId, TopicId, BlockHeight, string]) (bool, error) {
+		if scanned >= limit {
+			next, err := encodeKeyCursor(keyCodec, key)
+			nextKey = next
+			return true, err
+		}
+		scanned++
+		// blocks of other topics can only be filtered while walking
+		if key.K3() < fromBlock || (toBlock != 0 && key.K3() > toBlock) {
+			return false, nil
+		}
+		record, err := k.rewardHistory.Get(ctx, Join4(key.K2(), key.K3(), key.K1(), key.K4()))
+		if err != nil {
+			return true, errorsmod.Wrap(err, "error getting reward record")
+		}
+		records = append(records, &record)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error iterating reward history of actor")
+	}
+	return records, &types.SimpleCursorPaginationResponse{NextKey: nextKey}, nil
+}
+
+// Returns a page of the rewards paid out in the topic, in ascending block order.
+// The pagination key of a page is the encoded key of its first record in the reward history
+func (k *Keeper) GetTopicRewardHistory(
+	ctx context.Context,
+	topicId TopicId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]*types.RewardRecord, *types.SimpleCursorPaginationResponse, error) {
+	limit, err := k.CalcAppropriatePaginationLimit(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyCodec := k.rewardHistory.KeyCodec()
+	rng := new(collections.Range[Quadruple[TopicId, BlockHeight, ActorId, string]]).
+		Prefix(QuadrupleSinglePrefix[TopicId, BlockHeight, ActorId, string](topicId))
+	if pagination != nil && len(pagination.Key) > 0 {
+		_, start, err := keyCodec.Decode(pagination.Key)
+		if err != nil || start.K1() != topicId {
+			return nil, nil, errorsmod.Wrap(types.ErrInvalidValue, "invalid pagination key")
+		}
+		rng = rng.StartInclusive(start)
+	}
+
+	records := make([]*types.RewardRecord, 0)
+	var nextKey []byte
+	err = k.rewardHistory.Walk(ctx, rng, func(key Quadruple[TopicId, BlockHeight, ActorId, string], record types.RewardRecord) (bool, error) {
+		if uint64(len(records)) >= limit {
+			next, err := encodeKeyCursor(keyCodec, key)
+			nextKey = next
+			return true, err
+		}
+		records = append(records, &record)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error iterating reward history of topic")
+	}
+	return records, &types.SimpleCursorPaginationResponse{NextKey: nextKey}, nil
+}
+
+// Removes up to limit rewards of the topic that fell out of the reward history retention at the current
+// block, oldest first. All rewards of the topic are out of the retention while it is zero.
+// Returns how many were removed, what is left over is removed the next time the topic is pruned
+func (k *Keeper) PruneRewardHistory(ctx context.Context, topicId TopicId, limit uint64) (uint64, error) {
+	if limit == 0 {
+		return 0, nil
+	}
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "error getting params")
+	}
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	rng := new(collections.Range[Quadruple[TopicId, BlockHeight, ActorId, string]]).
+		Prefix(QuadrupleSinglePrefix[TopicId, BlockHeight, ActorId, string](topicId))
+	if moduleParams.RewardHistoryRetentionBlocks > 0 {
+		// keep the rewards of the last retention blocks, including the current block
+		rng = rng.EndExclusive(QuadrupleDoublePrefix[TopicId, BlockHeight, ActorId, string](
+			topicId, blockHeight-moduleParams.RewardHistoryRetentionBlocks+1))
+	}
+	return k.pruneRewardHistoryRange(ctx, rng, limit)
+}
+
+// Removes up to limit rewards of the reward history within the range, all of them if limit is 0.
+// Returns how many were removed
+func (k *Keeper) pruneRewardHistoryRange(
+	ctx context.Context,
+	rng collections.Ranger[Quadruple[TopicId, BlockHeight, ActorId, string]],
+	limit uint64,
+) (uint64, error) {
+	keys, err := collectMapKeys(ctx, k.rewardHistory, rng, limit)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "error iterating reward history")
+	}
+	for _, key := range keys {
+		if err := k.DeleteRewardRecord(ctx, key.K1(), key.K2(), key.K3(), key.K4()); err != nil {
+			return 0, err
+		}
+	}
+	return uint64(len(keys)), nil
+}
+
+// Encodes a key of a collection as a pagination key
+func encodeKeyCursor[K any](keyCodec codec.KeyCodec[K], key K) ([]byte, error) {
+	cursor := make([]byte, keyCodec.Size(key))
+	if _, err := keyCodec.Encode(cursor, key); err != nil {
+		return nil, errorsmod.Wrap(err, "error encoding pagination key")
+	}
+	return cursor, nil
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *KeeperTestSuite) TestGetActorRewardHistory() {
+	k := s.emissionsKeeper
+	actor := s.addrsStr[0]
+	otherActor := s.addrsStr[1]
+
+	for _, block := range []int64{100, 200, 300} {
+		ctx := s.ctx.WithBlockHeight(block)
+		s.Require().NoError(k.RecordRewards(ctx, []types.TaskReward{
+			{Address: actor, Reward: alloraMath.NewDecFromInt64(block), TopicId: 1, Type: types.WorkerInferenceRewardType},
+			{Address: actor, Reward: alloraMath.NewDecFromInt64(block + 1), TopicId: 2, Type: types.ReputerAndDelegatorRewardType},
+			{Address: otherActor, Reward: alloraMath.NewDecFromInt64(block), TopicId: 1, Type: types.WorkerForecastRewardType},
+		}))
+	}
+
+	records, pageResponse, err := k.GetActorRewardHistory(s.ctx, actor, 0, 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Len(records, 6)
+	s.Require().Empty(pageResponse.NextKey)
+	for _, record := range records {
+		s.Require().Equal(actor, record.Address)
+	}
+
+	// The rewards of one topic within a block range
+	records, _, err = k.GetActorRewardHistory(s.ctx, actor, 1, 150, 300, nil)
+	s.Require().NoError(err)
+	s.Require().Len(records, 2)
+	s.Require().Equal(int64(200), records[0].BlockHeight)
+	s.Require().Equal(int64(300), records[1].BlockHeight)
+	s.Require().Equal(string(types.WorkerInferenceRewardType), records[0].Type)
+	s.Require().True(alloraMath.NewDecFromInt64(200).Equal(records[0].Reward))
+
+	// A block range across topics, one page at a time. Pages bound the records scanned,
+	// so the page scanning the reward of topic 1 at block 300 comes back empty
+	page := &types.SimpleCursorPaginationRequest{Limit: 1}
+	records, pageResponse, err = k.GetActorRewardHistory(s.ctx, actor, 0, 0, 200, page)
+	s.Require().NoError(err)
+	s.Require().Len(records, 1)
+	s.Require().Equal(uint64(1), records[0].TopicId)
+	s.Require().Equal(int64(100), records[0].BlockHeight)
+	seen := len(records)
+	pages := 1
+	for len(pageResponse.NextKey) > 0 {
+		page = &types.SimpleCursorPaginationRequest{Key: pageResponse.NextKey, Limit: 1}
+		records, pageResponse, err = k.GetActorRewardHistory(s.ctx, actor, 0, 0, 200, page)
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(records), 1)
+		for _, record := range records {
+			s.Require().LessOrEqual(record.BlockHeight, int64(200))
+		}
+		seen += len(records)
+		pages++
+	}
+	s.Require().Equal(4, seen)
+	s.Require().Equal(6, pages)
+
+	// A pagination key of another actor is rejected
+	_, pageResponse, err = k.GetActorRewardHistory(s.ctx, otherActor, 0, 0, 0, &types.SimpleCursorPaginationRequest{Limit: 1})
+	s.Require().NoError(err)
+	_, _, err = k.GetActorRewardHistory(s.ctx, actor, 0, 0, 0, &types.SimpleCursorPaginationRequest{Key: pageResponse.NextKey})
+	s.Require().ErrorIs(err, types.ErrInvalidValue)
+}
+
+func (s *KeeperTestSuite) TestGetTopicRewardHistory() {
+	k := s.emissionsKeeper
+	topicId := s.mockTopic().Id
+
+	for _, block := range []int64{100, 200} {
+		ctx := s.ctx.WithBlockHeight(block)
+		s.Require().NoError(k.RecordRewards(ctx, []types.TaskReward{
+			{Address: s.addrsStr[0], Reward: alloraMath.NewDecFromInt64(10), TopicId: topicId, Type: types.WorkerInferenceRewardType},
+			{Address: s.addrsStr[1], Reward: alloraMath.NewDecFromInt64(20), TopicId: topicId, Type: types.ReputerAndDelegatorRewardType},
+		}))
+	}
+
+	page := &types.SimpleCursorPaginationRequest{Limit: 3}
+	records, pageResponse, err := k.GetTopicRewardHistory(s.ctx, topicId, page)
+	s.Require().NoError(err)
+	s.Require().Len(records, 3)
+	s.Require().Equal(int64(100), records[0].BlockHeight)
+	s.Require().Equal(int64(100), records[1].BlockHeight)
+	s.Require().Equal(int64(200), records[2].BlockHeight)
+
+	page = &types.SimpleCursorPaginationRequest{Key: pageResponse.NextKey, Limit: 3}
+	records, pageResponse, err = k.GetTopicRewardHistory(s.ctx, topicId, page)
+	s.Require().NoError(err)
+	s.Require().Len(records, 1)
+	s.Require().Equal(int64(200), records[0].BlockHeight)
+	s.Require().Empty(pageResponse.NextKey)
+}
+
+func (s *KeeperTestSuite) TestRewardHistoryIsPrunedAfterRetention() {
+	k := s.emissionsKeeper
+	topicId := s.mockTopic().Id
+	actor := s.addrsStr[0]
+
+	params, err := k.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.RewardHistoryRetentionBlocks = 100
+	s.Require().NoError(k.SetParams(s.ctx, params))
+
+	for _, block := range []int64{100, 150, 200} {
+		ctx := s.ctx.WithBlockHeight(block)
+		s.Require().NoError(k.RecordRewards(ctx, []types.TaskReward{
+			{Address: actor, Reward: alloraMath.NewDecFromInt64(10), TopicId: topicId, Type: types.WorkerInferenceRewardType},
+		}))
+	}
+
+	// At block 200 the last 100 blocks are kept, the reward at block 100 is not
+	ctx := s.ctx.WithBlockHeight(200)
+	pruned, err := k.PruneRewardHistory(ctx, topicId, 10)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), pruned)
+	records, _, err := k.GetTopicRewardHistory(ctx, topicId, nil)
+	s.Require().NoError(err)
+	s.Require().Len(records, 2)
+	s.Require().Equal(int64(150), records[0].BlockHeight)
+	records, _, err = k.GetActorRewardHistory(ctx, actor, topicId, 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Len(records, 2)
+
+	// Disabling the history stops recording and prunes what is left
+	params.RewardHistoryRetentionBlocks = 0
+	s.Require().NoError(k.SetParams(s.ctx, params))
+	s.Require().NoError(k.RecordRewards(ctx, []types.TaskReward{
+		{Address: actor, Reward: alloraMath.NewDecFromInt64(10), TopicId: topicId, Type: types.WorkerForecastRewardType},
+	}))
+	records, _, err = k.GetTopicRewardHistory(ctx, topicId, nil)
+	s.Require().NoError(err)
+	s.Require().Len(records, 2)
+
+	// Pruning is bounded by the limit, the rest is pruned later
+	pruned, err = k.PruneRewardHistory(ctx, topicId, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), pruned)
+	records, _, err = k.GetActorRewardHistory(ctx, actor, 0, 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Len(records, 1)
+	s.Require().Equal(int64(200), records[0].BlockHeight)
+	pruned, err = k.PruneRewardHistory(ctx, topicId, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), pruned)
+	records, _, err = k.GetActorRewardHistory(ctx, actor, 0, 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(records)
+}
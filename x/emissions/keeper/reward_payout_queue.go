@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/// REWARD PAYOUT QUEUE
+
+// Queues a reward of the actor to be paid out by the ABCI endBlocker after every reward queued before it.
+// The coins stay in the allora rewards account until the reward is paid out
+func (k *Keeper) QueueRewardPayout(
+	ctx sdk.Context,
+	topicId TopicId,
+	address ActorId,
+	rewardType types.TaskRewardType,
+	amount cosmosMath.Int,
+) error {
+	return k.queueRewardPayout(ctx, types.QueuedRewardPayout{
+		TopicId: topicId,
+		Address: address,
+		Type:    string(rewardType),
+		Amount:  amount,
+	})
+}
+
+// Queues a reward that failed to be paid out again at the tail of the reward payout queue,
+// counting the failed attempt. The payout must have been dequeued first
+func (k *Keeper) RequeueFailedRewardPayout(ctx sdk.Context, payout types.QueuedRewardPayout) error {
+	payout.FailedAttempts++
+	return k.queueRewardPayout(ctx, payout)
+}
+
+// Queues the payout under the next payout id at the current block
+func (k *Keeper) queueRewardPayout(ctx sdk.Context, payout types.QueuedRewardPayout) error {
+	id, err := k.nextRewardPayoutId.Next(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting next reward payout id")
+	}
+	payout.Id = id
+	payout.BlockQueued = ctx.BlockHeight()
+	if err := k.SetQueuedRewardPayout(ctx, payout); err != nil {
+		return err
+	}
+	total, err := k.GetTotalQueuedRewardPayouts(ctx)
+	if err != nil {
+		return err
+	}
+	return k.SetTotalQueuedRewardPayouts(ctx, total.Add(payout.Amount))
+}
+
+// Sets a reward in the reward payout queue, without updating the total of queued payouts
+func (k *Keeper) SetQueuedRewardPayout(ctx context.Context, payout types.QueuedRewardPayout) error {
+	if err := payout.Validate(); err != nil {
+		return errorsmod.Wrap(err, "queued reward payout is not valid")
+	}
+	if err := k.rewardPayoutQueue.Set(ctx, payout.Id, payout); err != nil {
+		return errorsmod.Wrap(err, "error setting queued reward payout")
+	}
+	return k.rewardPayoutQueueByActor.Set(ctx, collections.Join(payout.Address, payout.Id))
+}
+
+// Returns up to limit rewards at the head of the reward payout queue, all of them if limit is 0
+func (k *Keeper) GetQueuedRewardPayouts(ctx context.Context, limit uint64) ([]types.QueuedRewardPayout, error) {
+	iter, err := k.rewardPayoutQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error iterating reward payout queue")
+	}
+	defer iter.Close()
+	payouts := make([]types.QueuedRewardPayout, 0)
+	for ; iter.Valid(); iter.Next() {
+		if limit > 0 && uint64(len(payouts)) >= limit {
+			break
+		}
+		payout, err := iter.Value()
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "error getting queued reward payout")
+		}
+		payouts = append(payouts, payout)
+	}
+	return payouts, nil
+}
+
+// Removes a reward from the reward payout queue once it is paid out, or failed to be paid out
+// and is left to the rewards account
+func (k *Keeper) DequeueRewardPayout(ctx context.Context, payout types.QueuedRewardPayout) error {
+	if err := k.rewardPayoutQueue.Remove(ctx, payout.Id); err != nil {
+		return errorsmod.Wrap(err, "error removing queued reward payout")
+	}
+	if err := k.rewardPayoutQueueByActor.Remove(ctx, collections.Join(payout.Address, payout.Id)); err != nil {
+		return errorsmod.Wrap(err, "error removing queued reward payout of actor")
+	}
+	total, err := k.GetTotalQueuedRewardPayouts(ctx)
+	if err != nil {
+		return err
+	}
+	if payout.Amount.GT(total) {
+		return errorsmod.Wrapf(types.ErrInvariantFailure, "cannot remove %s from total queued reward payouts of %s", payout.Amount, total)
+	}
+	return k.SetTotalQueuedRewardPayouts(ctx, total.Sub(payout.Amount))
+}
+
+// Returns a page of the reward payout queue in payout order, of the actor or of all actors if the
+// address is empty. The pagination key of a page is the encoded key of its first payout
+func (k *Keeper) GetQueuedRewardPayoutsPage(
+	ctx context.Context,
+	address ActorId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]*types.QueuedRewardPayout, *types.SimpleCursorPaginationResponse, error) {
+	limit, err := k.CalcAppropriatePaginationLimit(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	payouts := make([]*types.QueuedRewardPayout, 0)
+	var nextKey []byte
+
+	if address == "" {
+		keyCodec := k.rewardPayoutQueue.KeyCodec()
+		rng := new(collections.Range[uint64])
+		if pagination != nil && len(pagination.Key) > 0 {
+			_, start, err := keyCodec.Decode(pagination.Key)
+			if err != nil {
+				return nil, nil, errorsmod.Wrap(types.ErrInvalidValue, "invalid pagination key")
+			}
+			rng = rng.StartInclusive(start)
+		}
+		err = k.rewardPayoutQueue.Walk(ctx, rng, func(id uint64, payout types.QueuedRewardPayout) (bool, error) {
+			if uint64(len(payouts)) >= limit {
+				next, err := encodeKeyCursor(keyCodec, id)
+				nextKey = next
+				return true, err
+			}
+			payouts = append(payouts, &payout)
+			return false, nil
+		})
+		if err != nil {
+			return nil, nil, errorsmod.Wrap(err, "error iterating reward payout queue")
+		}
+		return payouts, &types.SimpleCursorPaginationResponse{NextKey: nextKey}, nil
+	}
+
+	keyCodec := k.rewardPayoutQueueByActor.KeyCodec()
+	rng := collections.NewPrefixedPairRange[ActorId, uint64](address)
+	if pagination != nil && len(pagination.Key) > 0 {
+		_, start, err := keyCodec.Decode(pagination.Key)
+		if err != nil || start.K1() != address {
+			return nil, nil, errorsmod.Wrap(types.ErrInvalidValue, "invalid pagination key")
+		}
+		rng = rng.StartInclusive(start.K2())
+	}
+	err = k.rewardPayoutQueueByActor.Walk(ctx, rng, func(key collections.Pair[ActorId, uint64]) (bool, error) {
+		if uint64(len(payouts)) >= limit {
+			next, err := encodeKeyCursor(keyCodec, key)
+			nextKey = next
+			return true, err
+		}
+		payout, err := k.rewardPayoutQueue.Get(ctx, key.K2())
+		if err != nil {
+			return true, errorsmod.Wrap(err, "error getting queued reward payout")
+		}
+		payouts = append(payouts, &payout)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error iterating reward payout queue of actor")
+	}
+	return payouts, &types.SimpleCursorPaginationResponse{NextKey: nextKey}, nil
+}
+
+// Returns the total amount of the rewards in the reward payout queue
+func (k *Keeper) GetTotalQueuedRewardPayouts(ctx context.Context) (cosmosMath.Int, error) {
+	ret, err := k.totalQueuedRewardPayouts.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return cosmosMath.ZeroInt(), nil
+	} else if err != nil {
+		return cosmosMath.Int{}, errorsmod.Wrap(err, "error getting total queued reward payouts")
+	}
+	return ret, nil
+}
+
+// Sets the total amount of the rewards in the reward payout queue
+func (k *Keeper) SetTotalQueuedRewardPayouts(ctx context.Context, total cosmosMath.Int) error {
+	if err := types.ValidateSdkIntRepresentingMonetaryValue(total); err != nil {
+		return errorsmod.Wrap(err, "total queued reward payouts cosmos Int is not valid")
+	}
+	return k.totalQueuedRewardPayouts.Set(ctx, total)
+}
+
+// Returns the id the next queued reward payout will get
+func (k *Keeper) GetNextRewardPayoutId(ctx context.Context) (uint64, error) {
+	return k.nextRewardPayoutId.Peek(ctx)
+}
+
+// Sets the id the next queued reward payout will get
+func (k *Keeper) SetNextRewardPayoutId(ctx context.Context, id uint64) error {
+	return k.nextRewardPayoutId.Set(ctx, id)
+}
+
+/// DEAD-LETTERED REWARD PAYOUTS
+
+// Keeps a dequeued reward that failed to be paid out too many times out of the queue for good.
+// Its amount is no longer counted in the total queued, so its coins are distributed again as rewards
+func (k *Keeper) SetDeadLetteredRewardPayout(ctx context.Context, payout types.QueuedRewardPayout) error {
+	if err := payout.Validate(); err != nil {
+		return errorsmod.Wrap(err, "dead-lettered reward payout is not valid")
+	}
+	if err := k.deadLetteredRewardPayouts.Set(ctx, collections.Join(payout.Address, payout.Id), payout); err != nil {
+		return errorsmod.Wrap(err, "error setting dead-lettered reward payout")
+	}
+	return nil
+}
+
+// Returns a page of the dead-lettered reward payouts, of the actor or of all actors if the
+// address is empty. The pagination key of a page is the encoded key of its first payout
+func (k *Keeper) GetDeadLetteredRewardPayoutsPage(
+	ctx context.Context,
+	address ActorId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]*types.QueuedRewardPayout, *types.SimpleCursorPaginationResponse, error) {
+	limit, err := k.CalcAppropriatePaginationLimit(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyCodec := k.deadLetteredRewardPayouts.KeyCodec()
+	var rng collections.Ranger[collections.Pair[ActorId, uint64]]
+	if address == "" {
+		allRng := new(collections.Range[collections.Pair[ActorId, uint64]])
+		if pagination != nil && len(pagination.Key) > 0 {
+			_, start, err := keyCodec.Decode(pagination.Key)
+			if err != nil {
+				return nil, nil, errorsmod.Wrap(types.ErrInvalidValue, "invalid pagination key")
+			}
+			allRng = allRng.StartInclusive(start)
+		}
+		rng = allRng
+	} else {
+		actorRng := collections.NewPrefixedPairRange[ActorId, uint64](address)
+		if pagination != nil && len(pagination.Key) > 0 {
+			_, start, err := keyCodec.Decode(pagination.Key)
+			if err != nil || start.K1() != address {
+				return nil, nil, errorsmod.Wrap(types.ErrInvalidValue, "invalid pagination key")
+			}
+			actorRng = actorRng.StartInclusive(start.K2())
+		}
+		rng = actorRng
+	}
+
+	payouts := make([]*types.QueuedRewardPayout, 0)
+	var nextKey []byte
+	err = k.deadLetteredRewardPayouts.Walk(ctx, rng, func(key collections.Pair[ActorId, uint64], payout types.QueuedRewardPayout) (bool, error) {
+		if uint64(len(payouts)) >= limit {
+			next, err := encodeKeyCursor(keyCodec, key)
+			nextKey = next
+			return true, err
+		}
+		payouts = append(payouts, &payout)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error iterating dead-lettered reward payouts")
+	}
+	return payouts, &types.SimpleCursorPaginationResponse{NextKey: nextKey}, nil
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *KeeperTestSuite) TestQueueAndDequeueRewardPayouts() {
+	k := s.emissionsKeeper
+	ctx := s.ctx.WithBlockHeight(10)
+	actor := s.addrsStr[0]
+	otherActor := s.addrsStr[1]
+
+	s.Require().NoError(k.QueueRewardPayout(ctx, 1, actor, types.WorkerInferenceRewardType, cosmosMath.NewInt(10)))
+	s.Require().NoError(k.QueueRewardPayout(ctx, 1, otherActor, types.WorkerForecastRewardType, cosmosMath.NewInt(20)))
+	s.Require().NoError(k.QueueRewardPayout(ctx, 2, actor, types.ReputerAndDelegatorRewardType, cosmosMath.NewInt(30)))
+
+	total, err := k.GetTotalQueuedRewardPayouts(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(60), total)
+
+	// Payouts come out in the order they were queued
+	payouts, err := k.GetQueuedRewardPayouts(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Len(payouts, 2)
+	s.Require().Equal(actor, payouts[0].Address)
+	s.Require().Equal(otherActor, payouts[1].Address)
+	s.Require().Equal(int64(10), payouts[0].BlockQueued)
+	s.Require().Less(payouts[0].Id, payouts[1].Id)
+
+	s.Require().NoError(k.DequeueRewardPayout(ctx, payouts[0]))
+	payouts, err = k.GetQueuedRewardPayouts(ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Len(payouts, 2)
+	s.Require().Equal(otherActor, payouts[0].Address)
+	total, err = k.GetTotalQueuedRewardPayouts(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(50), total)
+
+	// Only the remaining payout of the actor is left in its index
+	actorPayouts, pageResponse, err := k.GetQueuedRewardPayoutsPage(ctx, actor, nil)
+	s.Require().NoError(err)
+	s.Require().Len(actorPayouts, 1)
+	s.Require().Equal(uint64(2), actorPayouts[0].TopicId)
+	s.Require().Empty(pageResponse.NextKey)
+}
+
+func (s *KeeperTestSuite) TestGetQueuedRewardPayoutsPage() {
+	k := s.emissionsKeeper
+	actor := s.addrsStr[0]
+	for i := int64(1); i <= 3; i++ {
+		s.Require().NoError(k.QueueRewardPayout(s.ctx, 1, actor, types.WorkerInferenceRewardType, cosmosMath.NewInt(i)))
+		s.Require().NoError(k.QueueRewardPayout(s.ctx, 1, s.addrsStr[1], types.WorkerInferenceRewardType, cosmosMath.NewInt(i)))
+	}
+
+	page := &types.SimpleCursorPaginationRequest{Limit: 4}
+	payouts, pageResponse, err := k.GetQueuedRewardPayoutsPage(s.ctx, "", page)
+	s.Require().NoError(err)
+	s.Require().Len(payouts, 4)
+	page = &types.SimpleCursorPaginationRequest{Key: pageResponse.NextKey, Limit: 4}
+	payouts, pageResponse, err = k.GetQueuedRewardPayoutsPage(s.ctx, "", page)
+	s.Require().NoError(err)
+	s.Require().Len(payouts, 2)
+	s.Require().Empty(pageResponse.NextKey)
+
+	page = &types.SimpleCursorPaginationRequest{Limit: 2}
+	payouts, pageResponse, err = k.GetQueuedRewardPayoutsPage(s.ctx, actor, page)
+	s.Require().NoError(err)
+	s.Require().Len(payouts, 2)
+	s.Require().True(cosmosMath.NewInt(1).Equal(payouts[0].Amount))
+	page = &types.SimpleCursorPaginationRequest{Key: pageResponse.NextKey, Limit: 2}
+	payouts, pageResponse, err = k.GetQueuedRewardPayoutsPage(s.ctx, actor, page)
+	s.Require().NoError(err)
+	s.Require().Len(payouts, 1)
+	s.Require().True(cosmosMath.NewInt(3).Equal(payouts[0].Amount))
+	s.Require().Empty(pageResponse.NextKey)
+
+	// A pagination key of another actor is rejected
+	_, pageResponse, err = k.GetQueuedRewardPayoutsPage(s.ctx, s.addrsStr[1], &types.SimpleCursorPaginationRequest{Limit: 1})
+	s.Require().NoError(err)
+	_, _, err = k.GetQueuedRewardPayoutsPage(s.ctx, actor, &types.SimpleCursorPaginationRequest{Key: pageResponse.NextKey})
+	s.Require().ErrorIs(err, types.ErrInvalidValue)
+}
+
+func (s *KeeperTestSuite) TestQueuedRewardPayoutsAreNotDistributedAgain() {
+	k := s.emissionsKeeper
+	s.mintTo(types.AlloraRewardsAccountName, cosmosMath.NewInt(100))
+	before, err := k.GetTotalRewardToDistribute(s.ctx)
+	s.Require().NoError(err)
+
+	s.Require().NoError(k.QueueRewardPayout(s.ctx, 1, s.addrsStr[0], types.WorkerInferenceRewardType, cosmosMath.NewInt(40)))
+	after, err := k.GetTotalRewardToDistribute(s.ctx)
+	s.Require().NoError(err)
+	expected, err := before.Sub(alloraMath.NewDecFromInt64(40))
+	s.Require().NoError(err)
+	s.Require().True(expected.Equal(after), "expected %s, got %s", expected, after)
+}
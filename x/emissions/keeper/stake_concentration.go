@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+/// STAKE CONCENTRATION
+
+// Checks that adding amount to the stake upon the reputer in the topic keeps the reputer within the
+// largest share of topic stake one reputer may hold, counting its own and its delegated stake.
+// topicStakeAdded is how much the deposit grows the topic's stake, which is zero when stake only moves
+// between reputers of the topic. A reputer may always hold up to the required minimum stake, so the
+// cap does not stop the first reputers of a topic from staking.
+func (k *Keeper) CheckReputerStakeShareCap(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+	amount cosmosMath.Int,
+	topicStakeAdded cosmosMath.Int,
+) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if moduleParams.MaxReputerStakeShare.IsZero() {
+		return nil
+	}
+	reputerStake, err := k.GetStakeReputerAuthority(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	}
+	topicStake, err := k.GetTopicStake(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	newReputerStake := reputerStake.Add(amount)
+	newTopicStake, err := alloraMath.NewDecFromSdkInt(topicStake.Add(topicStakeAdded))
+	if err != nil {
+		return err
+	}
+	stakeCapDec, err := newTopicStake.Mul(moduleParams.MaxReputerStakeShare)
+	if err != nil {
+		return err
+	}
+	stakeCap, err := stakeCapDec.SdkIntTrim()
+	if err != nil {
+		return err
+	}
+	stakeCap = cosmosMath.MaxInt(stakeCap, moduleParams.RequiredMinimumStake)
+	if newReputerStake.GT(stakeCap) {
+		return errorsmod.Wrapf(types.ErrReputerStakeShareCapExceeded,
+			"reputer stake %s would exceed cap %s", newReputerStake, stakeCap)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *KeeperTestSuite) TestCheckReputerStakeShareCap() {
+	k := s.emissionsKeeper
+	topicId := s.mockTopic().Id
+	reputerA := s.addrsStr[0]
+	reputerB := s.addrsStr[1]
+
+	params, err := k.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.MaxReputerStakeShare = alloraMath.ZeroDec()
+	s.Require().NoError(k.SetParams(s.ctx, params))
+
+	// No cap by default
+	err = k.CheckReputerStakeShareCap(s.ctx, topicId, reputerA, cosmosMath.NewInt(1000000), cosmosMath.NewInt(1000000))
+	s.Require().NoError(err)
+
+	params.MaxReputerStakeShare = alloraMath.MustNewDecFromString("0.4")
+	params.RequiredMinimumStake = cosmosMath.NewInt(100)
+	s.Require().NoError(k.SetParams(s.ctx, params))
+
+	// The first reputer of a topic may always reach the required minimum stake
+	err = k.CheckReputerStakeShareCap(s.ctx, topicId, reputerA, cosmosMath.NewInt(100), cosmosMath.NewInt(100))
+	s.Require().NoError(err)
+	err = k.CheckReputerStakeShareCap(s.ctx, topicId, reputerA, cosmosMath.NewInt(101), cosmosMath.NewInt(101))
+	s.Require().ErrorIs(err, types.ErrReputerStakeShareCapExceeded)
+
+	s.Require().NoError(k.AddReputerStake(s.ctx, topicId, reputerA, cosmosMath.NewInt(300)))
+	s.Require().NoError(k.AddReputerStake(s.ctx, topicId, reputerB, cosmosMath.NewInt(300)))
+
+	// 400 of 1000 is exactly the cap, 401 of 1001 is over it
+	err = k.CheckReputerStakeShareCap(s.ctx, topicId, reputerA, cosmosMath.NewInt(100), cosmosMath.NewInt(400))
+	s.Require().NoError(err)
+	err = k.CheckReputerStakeShareCap(s.ctx, topicId, reputerA, cosmosMath.NewInt(101), cosmosMath.NewInt(401))
+	s.Require().ErrorIs(err, types.ErrReputerStakeShareCapExceeded)
+
+	// Stake moving between reputers of the topic does not grow the topic's stake
+	err = k.CheckReputerStakeShareCap(s.ctx, topicId, reputerA, cosmosMath.NewInt(1), cosmosMath.ZeroInt())
+	s.Require().ErrorIs(err, types.ErrReputerStakeShareCapExceeded)
+}
@@ -0,0 +1,273 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/// STAKE LOCKS
+
+// Records stake locked until a block in both of its indexes.
+// This completely overrides an existing lock of the same stake until the same block
+func (k *Keeper) SetStakeLock(ctx context.Context, lock types.StakeLock) error {
+	if err := lock.Validate(); err != nil {
+		return errorsmod.Wrap(err, "stake lock is not valid")
+	}
+	key := Join4(lock.TopicId, lock.Reputer, lock.Delegator, lock.BlockUnlocked)
+	if err := k.stakeLocks.Set(ctx, key, lock); err != nil {
+		return errorsmod.Wrap(err, "error setting stake lock")
+	}
+	return k.stakeLocksByBlock.Set(ctx, collections.Join(lock.BlockUnlocked, key))
+}
+
+// Removes a stake lock from all of its indexes, turning the stake back into stake that can be removed
+func (k *Keeper) DeleteStakeLock(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+	delegator ActorId,
+	blockUnlocked BlockHeight,
+) error {
+	key := Join4(topicId, reputer, delegator, blockUnlocked)
+	if err := k.stakeLocks.Remove(ctx, key); err != nil {
+		return errorsmod.Wrap(err, "error removing stake lock")
+	}
+	return k.stakeLocksByBlock.Remove(ctx, collections.Join(blockUnlocked, key))
+}
+
+// Returns the stake lock tier locking stake for the given number of months
+func (k *Keeper) GetStakeLockTier(ctx context.Context, lockMonths uint64) (types.StakeLockTier, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return types.StakeLockTier{}, errorsmod.Wrap(err, "error getting params")
+	}
+	for _, tier := range moduleParams.StakeLockTiers {
+		if tier.LockMonths == lockMonths {
+			return tier, nil
+		}
+	}
+	return types.StakeLockTier{}, errorsmod.Wrapf(types.ErrInvalidStakeLockTier, "no stake lock tier of %d months", lockMonths)
+}
+
+// Locks stake the delegator placed upon the reputer, or the reputer placed itself when the delegator
+// is empty, for the months of a stake lock tier. Locking more stake until the same block adds to that lock
+func (k *Keeper) LockStake(
+	ctx sdk.Context,
+	topicId TopicId,
+	reputer ActorId,
+	delegator ActorId,
+	amount cosmosMath.Int,
+	lockMonths uint64,
+) error {
+	tier, err := k.GetStakeLockTier(ctx, lockMonths)
+	if err != nil {
+		return err
+	}
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting params")
+	}
+	blockUnlocked := ctx.BlockHeight() + int64(lockMonths*moduleParams.BlocksPerMonth) //nolint:gosec // G115: integer overflow conversion uint64 -> int64 (gosec)
+	lock := types.StakeLock{
+		TopicId:          topicId,
+		Reputer:          reputer,
+		Delegator:        delegator,
+		Amount:           amount,
+		RewardMultiplier: tier.RewardMultiplier,
+		BlockLocked:      ctx.BlockHeight(),
+		BlockUnlocked:    blockUnlocked,
+	}
+	existing, err := k.stakeLocks.Get(ctx, Join4(topicId, reputer, delegator, blockUnlocked))
+	if err == nil {
+		lock.Amount = lock.Amount.Add(existing.Amount)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrap(err, "error getting stake lock")
+	}
+	return k.SetStakeLock(ctx, lock)
+}
+
+// Returns the locks of stake placed upon the reputer in the topic, by the reputer itself and by its delegators
+func (k *Keeper) GetStakeLocks(ctx context.Context, topicId TopicId, reputer ActorId) ([]types.StakeLock, error) {
+	rng := NewDoublePrefixedQuadrupleRange[TopicId, Reputer, Delegator, BlockHeight](topicId, reputer)
+	iter, err := k.stakeLocks.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error iterating stake locks")
+	}
+	locks, err := iter.Values()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error getting stake locks")
+	}
+	return locks, nil
+}
+
+// Returns the stake the delegator placed upon the reputer, or the reputer placed itself when the
+// delegator is empty, that is still locked at the current block
+func (k *Keeper) GetLockedStake(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+	delegator ActorId,
+) (cosmosMath.Int, error) {
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	rng := NewTriplePrefixedQuadrupleRange[TopicId, Reputer, Delegator, BlockHeight](topicId, reputer, delegator)
+	locked := cosmosMath.ZeroInt()
+	err := k.stakeLocks.Walk(ctx, rng, func(_ Quadruple[TopicId, Reputer, Delegator, BlockHeight], lock types.StakeLock) (bool, error) {
+		// locks expiring this block may not have been unlocked by the end blocker yet
+		if lock.BlockUnlocked > blockHeight {
+			locked = locked.Add(lock.Amount)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return cosmosMath.Int{}, errorsmod.Wrap(err, "error walking stake locks")
+	}
+	return locked, nil
+}
+
+// Returns the weight the stake locked upon the reputer in the topic adds to each position, keyed by
+// delegator with the reputer's own position under the empty delegator. Each locked amount adds
+// (multiplier - 1) times itself. Should slashing leave a position less stake than it locked, the added
+// weight is scaled down in proportion, so only stake the position still holds is weighted
+func (k *Keeper) GetStakeLockBonuses(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+) (map[ActorId]alloraMath.Dec, error) {
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	locks, err := k.GetStakeLocks(ctx, topicId, reputer)
+	if err != nil {
+		return nil, err
+	}
+	locked := make(map[ActorId]alloraMath.Dec)
+	bonuses := make(map[ActorId]alloraMath.Dec)
+	for _, lock := range locks {
+		if lock.BlockUnlocked <= blockHeight {
+			continue
+		}
+		amount, err := alloraMath.NewDecFromSdkInt(lock.Amount)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "error creating dec from locked stake")
+		}
+		extra, err := lock.RewardMultiplier.Sub(alloraMath.OneDec())
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "error subtracting one from reward multiplier")
+		}
+		extra, err = extra.Mul(amount)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "error multiplying locked stake by reward multiplier")
+		}
+		bonus, ok := bonuses[lock.Delegator]
+		if !ok {
+			bonus = alloraMath.ZeroDec()
+			locked[lock.Delegator] = alloraMath.ZeroDec()
+		}
+		if bonuses[lock.Delegator], err = bonus.Add(extra); err != nil {
+			return nil, errorsmod.Wrap(err, "error adding locked stake weight")
+		}
+		if locked[lock.Delegator], err = locked[lock.Delegator].Add(amount); err != nil {
+			return nil, errorsmod.Wrap(err, "error adding locked stake")
+		}
+	}
+	for _, delegator := range alloraMath.GetSortedKeys(bonuses) {
+		stake, err := k.getPositionStake(ctx, topicId, reputer, delegator)
+		if err != nil {
+			return nil, err
+		}
+		if !locked[delegator].Gt(stake) {
+			continue
+		}
+		bonus, err := bonuses[delegator].Mul(stake)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "error scaling locked stake weight")
+		}
+		if bonuses[delegator], err = bonus.Quo(locked[delegator]); err != nil {
+			return nil, errorsmod.Wrap(err, "error scaling locked stake weight")
+		}
+	}
+	return bonuses, nil
+}
+
+// Returns the stake the delegator placed upon the reputer, or the reputer placed itself when the delegator is empty
+func (k *Keeper) getPositionStake(ctx context.Context, topicId TopicId, reputer ActorId, delegator ActorId) (alloraMath.Dec, error) {
+	if delegator != "" {
+		placement, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+		return placement.Amount, nil
+	}
+	reputerAuthority, err := k.GetStakeReputerAuthority(ctx, topicId, reputer)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	delegateStakeUponReputer, err := k.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	selfStake, err := alloraMath.NewDecFromSdkInt(reputerAuthority.Sub(delegateStakeUponReputer))
+	if err != nil {
+		return alloraMath.Dec{}, errorsmod.Wrap(err, "error creating dec from reputer self stake")
+	}
+	return selfStake, nil
+}
+
+// Returns the stake upon the reputer weighted by the reward multipliers of the stake locked upon it,
+// adding the weight of the locks of every position to the stake. The reputer and each delegator earn
+// the weight of their own locks when the reward of the reputer is split between them
+func (k *Keeper) GetLockWeightedReputerStake(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+	stake cosmosMath.Int,
+) (alloraMath.Dec, error) {
+	weighted, err := alloraMath.NewDecFromSdkInt(stake)
+	if err != nil {
+		return alloraMath.Dec{}, errorsmod.Wrap(err, "error creating dec from reputer stake")
+	}
+	bonuses, err := k.GetStakeLockBonuses(ctx, topicId, reputer)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	for _, delegator := range alloraMath.GetSortedKeys(bonuses) {
+		if weighted, err = weighted.Add(bonuses[delegator]); err != nil {
+			return alloraMath.Dec{}, errorsmod.Wrap(err, "error adding locked stake weight")
+		}
+	}
+	return weighted, nil
+}
+
+// Removes up to limit stake locks that expire before and including this block.
+// Returns true if there were more locks to remove than the limit
+func (k *Keeper) UnlockStakeLocksUpUntilBlock(
+	ctx context.Context,
+	blockHeight BlockHeight,
+	limit uint64,
+) (limitHit bool, err error) {
+	// make a range that has everything less than the block height, inclusive
+	rng := &collections.Range[collections.Pair[BlockHeight, Quadruple[TopicId, Reputer, Delegator, BlockHeight]]]{}
+	rng = rng.StartInclusive(collections.PairPrefix[BlockHeight, Quadruple[TopicId, Reputer, Delegator, BlockHeight]](0))
+	rng = rng.EndExclusive(collections.PairPrefix[BlockHeight, Quadruple[TopicId, Reputer, Delegator, BlockHeight]](blockHeight + 1))
+
+	keys, err := collectKeySetKeys(ctx, k.stakeLocksByBlock, rng, limit+1)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "error iterating over stake locks by block")
+	}
+	if uint64(len(keys)) > limit {
+		keys = keys[:limit]
+		limitHit = true
+	}
+	for _, key := range keys {
+		lockKey := key.K2()
+		if err := k.DeleteStakeLock(ctx, lockKey.K1(), lockKey.K2(), lockKey.K3(), lockKey.K4()); err != nil {
+			return limitHit, err
+		}
+	}
+	return limitHit, nil
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *KeeperTestSuite) TestLockStakeUntilTierEnds() {
+	k := s.emissionsKeeper
+	topicId := s.mockTopic().Id
+	reputer := s.addrsStr[0]
+	delegator := s.addrsStr[1]
+
+	params, err := k.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.BlocksPerMonth = 100
+	s.Require().NoError(k.SetParams(s.ctx, params))
+
+	err = k.LockStake(s.ctx, topicId, reputer, "", cosmosMath.NewInt(100), 2)
+	s.Require().ErrorIs(err, types.ErrInvalidStakeLockTier)
+
+	// Locking more stake until the same block adds to that lock
+	s.Require().NoError(k.LockStake(s.ctx, topicId, reputer, "", cosmosMath.NewInt(100), 1))
+	s.Require().NoError(k.LockStake(s.ctx, topicId, reputer, "", cosmosMath.NewInt(50), 1))
+	s.Require().NoError(k.LockStake(s.ctx, topicId, reputer, delegator, cosmosMath.NewInt(70), 3))
+
+	locks, err := k.GetStakeLocks(s.ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().Len(locks, 2)
+
+	locked, err := k.GetLockedStake(s.ctx, topicId, reputer, "")
+	s.Require().NoError(err)
+	s.Require().True(cosmosMath.NewInt(150).Equal(locked))
+	locked, err = k.GetLockedStake(s.ctx, topicId, reputer, delegator)
+	s.Require().NoError(err)
+	s.Require().True(cosmosMath.NewInt(70).Equal(locked))
+
+	// The stake of the reputer unlocks after a month, even before the end blocker removes its lock
+	unlockBlock := s.ctx.BlockHeight() + 100
+	ctx := s.ctx.WithBlockHeight(unlockBlock)
+	locked, err = k.GetLockedStake(ctx, topicId, reputer, "")
+	s.Require().NoError(err)
+	s.Require().True(locked.IsZero())
+
+	limitHit, err := k.UnlockStakeLocksUpUntilBlock(ctx, unlockBlock, 10)
+	s.Require().NoError(err)
+	s.Require().False(limitHit)
+	locks, err = k.GetStakeLocks(ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().Len(locks, 1)
+	s.Require().Equal(delegator, locks[0].Delegator)
+}
+
+func (s *KeeperTestSuite) TestGetLockWeightedReputerStake() {
+	k := s.emissionsKeeper
+	topicId := s.mockTopic().Id
+	reputer := s.addrsStr[0]
+	delegator := s.addrsStr[1]
+	s.Require().NoError(k.AddReputerStake(s.ctx, topicId, reputer, cosmosMath.NewInt(600)))
+	s.Require().NoError(k.AddDelegateStake(s.ctx, topicId, delegator, reputer, cosmosMath.NewInt(400)))
+
+	weighted, err := k.GetLockWeightedReputerStake(s.ctx, topicId, reputer, cosmosMath.NewInt(1000))
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.NewDecFromInt64(1000).Equal(weighted))
+
+	// 400 locked for 6 months and 100 for a month weigh 400 * 0.5 + 100 * 0.1 more
+	s.Require().NoError(k.LockStake(s.ctx, topicId, reputer, "", cosmosMath.NewInt(400), 6))
+	s.Require().NoError(k.LockStake(s.ctx, topicId, reputer, delegator, cosmosMath.NewInt(100), 1))
+	weighted, err = k.GetLockWeightedReputerStake(s.ctx, topicId, reputer, cosmosMath.NewInt(1000))
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.NewDecFromInt64(1210).Equal(weighted), weighted.String())
+
+	// Each position earns the weight of its own locks
+	bonuses, err := k.GetStakeLockBonuses(s.ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().Len(bonuses, 2)
+	s.Require().True(alloraMath.NewDecFromInt64(200).Equal(bonuses[""]), bonuses[""].String())
+	s.Require().True(alloraMath.NewDecFromInt64(10).Equal(bonuses[delegator]), bonuses[delegator].String())
+
+	// With 1000 locked but 600 held, as after slashing, only 3/5 of the weight the reputer locks add remains
+	s.Require().NoError(k.LockStake(s.ctx, topicId, reputer, "", cosmosMath.NewInt(600), 6))
+	weighted, err = k.GetLockWeightedReputerStake(s.ctx, topicId, reputer, cosmosMath.NewInt(1000))
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.NewDecFromInt64(1310).Equal(weighted), weighted.String())
+}
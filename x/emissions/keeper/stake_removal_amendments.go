@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/// STAKE REMOVAL AMENDMENTS
+
+// Returns the removal with its amount changed to the new total. Shrinking a removal keeps its
+// completion block. Growing it restarts the removal delay at the current block, so the added
+// stake waits the full delay, and never brings the completion block forward.
+func amendedRemovalSchedule(
+	ctx sdk.Context,
+	amount cosmosMath.Int,
+	newAmount cosmosMath.Int,
+	blockRemovalStarted BlockHeight,
+	blockRemovalCompleted BlockHeight,
+	removeStakeDelayWindow BlockHeight,
+) (BlockHeight, BlockHeight) {
+	if newAmount.LTE(amount) {
+		return blockRemovalStarted, blockRemovalCompleted
+	}
+	restartedCompletion := ctx.BlockHeight() + removeStakeDelayWindow
+	if restartedCompletion < blockRemovalCompleted {
+		restartedCompletion = blockRemovalCompleted
+	}
+	return ctx.BlockHeight(), restartedCompletion
+}
+
+// Changes the amount of a pending stake removal to the new total, keeping the by block and by
+// actor indexes of stake removals consistent. Returns the amended removal.
+func (k *Keeper) AmendStakeRemoval(
+	ctx sdk.Context,
+	removal types.StakeRemovalInfo,
+	newAmount cosmosMath.Int,
+	removeStakeDelayWindow BlockHeight,
+) (types.StakeRemovalInfo, error) {
+	amended := removal
+	amended.Amount = newAmount
+	amended.BlockRemovalStarted, amended.BlockRemovalCompleted = amendedRemovalSchedule(
+		ctx, removal.Amount, newAmount, removal.BlockRemovalStarted, removal.BlockRemovalCompleted, removeStakeDelayWindow,
+	)
+	if amended.BlockRemovalCompleted != removal.BlockRemovalCompleted {
+		err := k.DeleteStakeRemoval(ctx, removal.BlockRemovalCompleted, removal.TopicId, removal.Reputer)
+		if err != nil {
+			return types.StakeRemovalInfo{}, err
+		}
+	}
+	if err := k.SetStakeRemoval(ctx, amended); err != nil {
+		return types.StakeRemovalInfo{}, err
+	}
+	return amended, nil
+}
+
+// Changes the amount of a pending delegate stake removal to the new total, keeping the by block
+// and by actor indexes of delegate stake removals consistent. Returns the amended removal.
+func (k *Keeper) AmendDelegateStakeRemoval(
+	ctx sdk.Context,
+	removal types.DelegateStakeRemovalInfo,
+	newAmount cosmosMath.Int,
+	removeStakeDelayWindow BlockHeight,
+) (types.DelegateStakeRemovalInfo, error) {
+	amended := removal
+	amended.Amount = newAmount
+	amended.BlockRemovalStarted, amended.BlockRemovalCompleted = amendedRemovalSchedule(
+		ctx, removal.Amount, newAmount, removal.BlockRemovalStarted, removal.BlockRemovalCompleted, removeStakeDelayWindow,
+	)
+	if amended.BlockRemovalCompleted != removal.BlockRemovalCompleted {
+		err := k.DeleteDelegateStakeRemoval(
+			ctx, removal.BlockRemovalCompleted, removal.TopicId, removal.Reputer, removal.Delegator,
+		)
+		if err != nil {
+			return types.DelegateStakeRemovalInfo{}, err
+		}
+	}
+	if err := k.SetDelegateStakeRemoval(ctx, amended); err != nil {
+		return types.DelegateStakeRemovalInfo{}, err
+	}
+	return amended, nil
+}
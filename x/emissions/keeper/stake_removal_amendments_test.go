@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *KeeperTestSuite) TestAmendStakeRemovalNeverBringsCompletionForward() {
+	k := s.emissionsKeeper
+	reputer := s.addrsStr[0]
+	start := s.ctx.BlockHeight()
+	removal := types.StakeRemovalInfo{
+		BlockRemovalStarted:   start,
+		BlockRemovalCompleted: start + 100,
+		TopicId:               1,
+		Reputer:               reputer,
+		Amount:                cosmosMath.NewInt(10),
+	}
+	s.Require().NoError(k.SetStakeRemoval(s.ctx, removal))
+
+	// Growing the removal with a shorter delay window than it was queued with keeps its completion block
+	ctx := s.ctx.WithBlockHeight(start + 10)
+	amended, err := k.AmendStakeRemoval(ctx, removal, cosmosMath.NewInt(20), 50)
+	s.Require().NoError(err)
+	s.Require().Equal(removal.BlockRemovalCompleted, amended.BlockRemovalCompleted)
+	s.Require().Equal(ctx.BlockHeight(), amended.BlockRemovalStarted)
+
+	stored, err := k.GetStakeRemoval(ctx, removal.BlockRemovalCompleted, removal.TopicId, reputer)
+	s.Require().NoError(err)
+	s.Require().True(cosmosMath.NewInt(20).Equal(stored.Amount))
+}
+
+func (s *KeeperTestSuite) TestAmendDelegateStakeRemovalMovesIndexes() {
+	k := s.emissionsKeeper
+	delegator := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+	start := s.ctx.BlockHeight()
+	removal := types.DelegateStakeRemovalInfo{
+		BlockRemovalStarted:   start,
+		BlockRemovalCompleted: start + 10,
+		TopicId:               1,
+		Delegator:             delegator,
+		Reputer:               reputer,
+		Amount:                cosmosMath.NewInt(10),
+	}
+	s.Require().NoError(k.SetDelegateStakeRemoval(s.ctx, removal))
+
+	ctx := s.ctx.WithBlockHeight(start + 5)
+	amended, err := k.AmendDelegateStakeRemoval(ctx, removal, cosmosMath.NewInt(30), 20)
+	s.Require().NoError(err)
+	s.Require().Equal(start+25, amended.BlockRemovalCompleted)
+
+	_, err = k.GetDelegateStakeRemoval(ctx, removal.BlockRemovalCompleted, removal.TopicId, delegator, reputer)
+	s.Require().Error(err)
+	stakeRemovals, delegateStakeRemovals, _, err := k.GetPendingStakeRemovalsForActor(ctx, delegator, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(stakeRemovals)
+	s.Require().Len(delegateStakeRemovals, 1)
+	s.Require().Equal(amended.BlockRemovalCompleted, delegateStakeRemovals[0].BlockRemovalCompleted)
+	s.Require().True(cosmosMath.NewInt(30).Equal(delegateStakeRemovals[0].Amount))
+}
@@ -261,6 +261,15 @@ func (k *Keeper) ActivateTopic(ctx context.Context, topicId TopicId) error {
 		return nil
 	}
 
+	// Archived topics are retired for good and must never be reactivated
+	topicIsArchived, err := k.IsTopicArchived(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to check if topic is archived")
+	}
+	if topicIsArchived {
+		return nil
+	}
+
 	// Check topic activation with next possible churning block
 	_, topicIsActive, err := k.GetNextPossibleChurningBlockByTopicId(ctx, topicId)
 	if err != nil {
@@ -270,6 +279,12 @@ func (k *Keeper) ActivateTopic(ctx context.Context, topicId TopicId) error {
 		return nil
 	}
 
+	// An inactive topic has no running epoch, so queued parameter changes apply now
+	err = k.ApplyPendingTopicUpdate(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to apply pending topic update")
+	}
+
 	topic, err := k.GetTopic(ctx, topicId)
 	if err != nil {
 		return errors.Wrap(err, "failed to get topic")
@@ -0,0 +1,539 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Returns true if the topic has been archived
+func (k *Keeper) IsTopicArchived(ctx context.Context, topicId TopicId) (bool, error) {
+	return k.archivedTopics.Has(ctx, topicId)
+}
+
+// Returns true if the per-topic state of an archived topic is still being pruned
+func (k *Keeper) IsArchivedTopicPendingPrune(ctx context.Context, topicId TopicId) (bool, error) {
+	return k.archivedTopicsToPrune.Has(ctx, topicId)
+}
+
+// Archives a topic. The topic is inactivated and can never be reactivated,
+// its leftover fee revenue is refunded to the topic owner,
+// all reputer stake and worker bonds on it are scheduled for removal,
+// and its per-topic state is queued for pruning in the end blocker.
+// Delegated stake is scheduled for removal by the end blocker too, in bounded batches.
+// The topic record itself is kept so that queries can report it as archived.
+func (k *Keeper) ArchiveTopic(ctx context.Context, topicId TopicId) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	exists, err := k.TopicExists(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to check if topic exists")
+	} else if !exists {
+		return types.ErrTopicDoesNotExist
+	}
+	isArchived, err := k.IsTopicArchived(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to check if topic is archived")
+	}
+	if isArchived {
+		return types.ErrTopicArchived
+	}
+
+	err = k.InactivateTopic(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to inactivate topic")
+	}
+
+	owner, err := k.GetTopicOwner(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to get topic owner")
+	}
+	refunded, err := k.refundTopicFeeRevenue(ctx, topicId, owner)
+	if err != nil {
+		return errors.Wrap(err, "failed to refund topic fee revenue")
+	}
+
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get params")
+	}
+	blockRemovalStarted := sdkCtx.BlockHeight()
+	blockRemovalCompleted := blockRemovalStarted + moduleParams.RemoveStakeDelayWindow
+	err = k.forceReputerStakeRemovals(sdkCtx, topicId, blockRemovalStarted, blockRemovalCompleted)
+	if err != nil {
+		return errors.Wrap(err, "failed to schedule reputer stake removals")
+	}
+	err = k.forceWorkerBondRemovals(sdkCtx, topicId, blockRemovalStarted, blockRemovalCompleted)
+	if err != nil {
+		return errors.Wrap(err, "failed to schedule worker bond removals")
+	}
+
+	// Stop the topic from taking part in the current reward round and close its open nonces
+	err = k.rewardableTopics.Remove(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove rewardable topic")
+	}
+	err = k.unfulfilledWorkerNonces.Remove(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove unfulfilled worker nonces")
+	}
+	err = k.unfulfilledReputerNonces.Remove(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove unfulfilled reputer nonces")
+	}
+
+	err = k.archivedTopics.Set(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to set archived topic")
+	}
+	err = k.archivedTopicsToPrune.Set(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to set archived topic to prune")
+	}
+
+	types.EmitNewTopicArchivedEvent(sdkCtx, topicId, blockRemovalStarted, owner, refunded)
+	return nil
+}
+
+// Sends the fee revenue a topic has not yet dripped back to the recipient and clears it.
+// Fees are held by the ecosystem bucket, so the refund is capped by its balance.
+func (k *Keeper) refundTopicFeeRevenue(ctx context.Context, topicId TopicId, recipient ActorId) (cosmosMath.Int, error) {
+	feeRevenue, err := k.GetTopicFeeRevenue(ctx, topicId)
+	if err != nil {
+		return cosmosMath.ZeroInt(), errors.Wrap(err, "failed to get topic fee revenue")
+	}
+	ecosystemAddr := k.authKeeper.GetModuleAddress(minttypes.EcosystemModuleName)
+	ecosystemBalance := k.GetBankBalance(ctx, ecosystemAddr, params.DefaultBondDenom)
+	refund := cosmosMath.MinInt(feeRevenue, ecosystemBalance.Amount)
+	if refund.IsPositive() {
+		err = k.SendCoinsFromModuleToAccount(
+			ctx,
+			minttypes.EcosystemModuleName,
+			recipient,
+			sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, refund)),
+		)
+		if err != nil {
+			return cosmosMath.ZeroInt(), errors.Wrap(err, "failed to send fee revenue refund")
+		}
+	}
+	err = k.topicFeeRevenue.Remove(ctx, topicId)
+	if err != nil {
+		return cosmosMath.ZeroInt(), errors.Wrap(err, "failed to remove topic fee revenue")
+	}
+	return refund, nil
+}
+
+// Schedules the removal of the full personal stake of every reputer in the topic,
+// replacing any removal the reputer already started
+func (k *Keeper) forceReputerStakeRemovals(
+	ctx sdk.Context,
+	topicId TopicId,
+	blockRemovalStarted BlockHeight,
+	blockRemovalCompleted BlockHeight,
+) error {
+	rng := collections.NewPrefixedPairRange[TopicId, Reputer](topicId)
+	reputers, err := collectMapKeys(ctx, k.stakeReputerAuthority, rng, 0)
+	if err != nil {
+		return errors.Wrap(err, "failed to iterate reputer stakes")
+	}
+	for _, key := range reputers {
+		reputer := key.K2()
+		reputerAuthority, err := k.GetStakeReputerAuthority(ctx, topicId, reputer)
+		if err != nil {
+			return errors.Wrap(err, "failed to get reputer authority")
+		}
+		delegateStakeUponReputer, err := k.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+		if err != nil {
+			return errors.Wrap(err, "failed to get delegate stake upon reputer")
+		}
+		amount := reputerAuthority.Sub(delegateStakeUponReputer)
+
+		removal, found, err := k.GetStakeRemovalForReputerAndTopicId(ctx, reputer, topicId)
+		if err != nil {
+			return errors.Wrap(err, "failed to get stake removal")
+		}
+		if found {
+			err = k.DeleteStakeRemoval(ctx, removal.BlockRemovalCompleted, removal.TopicId, removal.Reputer)
+			if err != nil {
+				return errors.Wrap(err, "failed to delete previous stake removal")
+			}
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+		err = k.SetStakeRemoval(ctx, types.StakeRemovalInfo{
+			BlockRemovalStarted:   blockRemovalStarted,
+			BlockRemovalCompleted: blockRemovalCompleted,
+			TopicId:               topicId,
+			Reputer:               reputer,
+			Amount:                amount,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to set stake removal")
+		}
+	}
+	return nil
+}
+
+// Schedules the removal of the full bond of every worker in the topic,
+// replacing any removal the worker already started
+func (k *Keeper) forceWorkerBondRemovals(
+	ctx sdk.Context,
+	topicId TopicId,
+	blockRemovalStarted BlockHeight,
+	blockRemovalCompleted BlockHeight,
+) error {
+	bonds, err := k.GetWorkerBondsOfTopic(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to get worker bonds")
+	}
+	for _, bond := range bonds {
+		removal, found, err := k.GetWorkerBondRemovalForWorkerAndTopicId(ctx, bond.Worker, topicId)
+		if err != nil {
+			return errors.Wrap(err, "failed to get worker bond removal")
+		}
+		if found {
+			err = k.DeleteWorkerBondRemoval(ctx, removal.BlockRemovalCompleted, removal.TopicId, removal.Worker)
+			if err != nil {
+				return errors.Wrap(err, "failed to delete previous worker bond removal")
+			}
+		}
+		err = k.SetWorkerBondRemoval(ctx, types.WorkerBondRemovalInfo{
+			BlockRemovalStarted:   blockRemovalStarted,
+			BlockRemovalCompleted: blockRemovalCompleted,
+			TopicId:               topicId,
+			Worker:                bond.Worker,
+			Amount:                bond.Amount,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to set worker bond removal")
+		}
+	}
+	return nil
+}
+
+// Schedules the removal of the full stake of up to limit delegations of the archived topic,
+// replacing any removal the delegator already started.
+// Delegations are walked in key order from where the previous call stopped.
+// Returns the number of delegations whose removal was scheduled
+func (k *Keeper) forceDelegateStakeRemovals(ctx context.Context, topicId TopicId, limit uint64) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get params")
+	}
+	blockRemovalStarted := sdkCtx.BlockHeight()
+	blockRemovalCompleted := blockRemovalStarted + moduleParams.RemoveStakeDelayWindow
+
+	rng := new(collections.Range[collections.Triple[TopicId, Delegator, Reputer]]).
+		Prefix(collections.TriplePrefix[TopicId, Delegator, Reputer](topicId))
+	cursor, err := k.archivedTopicDelegateRemovalCursors.Get(ctx, topicId)
+	if err == nil {
+		rng = rng.StartExclusive(collections.Join3(topicId, cursor.K1(), cursor.K2()))
+	} else if !errors.IsOf(err, collections.ErrNotFound) {
+		return 0, errors.Wrap(err, "failed to get delegate stake removal cursor")
+	}
+	keys, err := collectMapKeys(ctx, k.delegatedStakes, rng, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to iterate delegated stakes")
+	}
+	for _, key := range keys {
+		delegator := key.K2()
+		reputer := key.K3()
+		delegateStake, err := k.delegatedStakes.Get(ctx, key)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to get delegated stake")
+		}
+		amount, err := delegateStake.Amount.SdkIntTrim()
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to trim delegated stake amount")
+		}
+
+		removal, found, err := k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(sdkCtx, delegator, reputer, topicId)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to get delegate stake removal")
+		}
+		if found {
+			err = k.DeleteDelegateStakeRemoval(ctx, removal.BlockRemovalCompleted, removal.TopicId, removal.Reputer, removal.Delegator)
+			if err != nil {
+				return 0, errors.Wrap(err, "failed to delete previous delegate stake removal")
+			}
+		}
+		if amount.IsPositive() {
+			err = k.SetDelegateStakeRemoval(ctx, types.DelegateStakeRemovalInfo{
+				BlockRemovalStarted:   blockRemovalStarted,
+				BlockRemovalCompleted: blockRemovalCompleted,
+				TopicId:               topicId,
+				Delegator:             delegator,
+				Reputer:               reputer,
+				Amount:                amount,
+			})
+			if err != nil {
+				return 0, errors.Wrap(err, "failed to set delegate stake removal")
+			}
+		}
+		err = k.archivedTopicDelegateRemovalCursors.Set(ctx, topicId, collections.Join(delegator, reputer))
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to set delegate stake removal cursor")
+		}
+	}
+	return uint64(len(keys)), nil
+}
+
+// Removes at most maxToPrune per-topic state entries belonging to archived topics.
+// Topics are pruned in id order and dropped from the prune queue once nothing is left.
+// Scheduling the removal of a delegation counts against the same budget.
+// Stake state is left untouched, it is emptied by the scheduled stake removals.
+func (k *Keeper) PruneArchivedTopics(ctx context.Context, maxToPrune uint64) error {
+	topicIds, err := collectKeySetKeys(ctx, k.archivedTopicsToPrune, nil, 0)
+	if err != nil {
+		return errors.Wrap(err, "failed to get archived topics to prune")
+	}
+	budget := maxToPrune
+	for _, topicId := range topicIds {
+		if budget == 0 {
+			break
+		}
+		pruned, done, err := k.pruneArchivedTopicState(ctx, topicId, budget)
+		if err != nil {
+			return errors.Wrapf(err, "failed to prune archived topic %d", topicId)
+		}
+		budget -= pruned
+		if done {
+			err = k.archivedTopicsToPrune.Remove(ctx, topicId)
+			if err != nil {
+				return errors.Wrap(err, "failed to remove archived topic from prune queue")
+			}
+			err = k.archivedTopicDelegateRemovalCursors.Remove(ctx, topicId)
+			if err != nil {
+				return errors.Wrap(err, "failed to remove delegate stake removal cursor")
+			}
+			sdk.UnwrapSDKContext(ctx).Logger().Info(fmt.Sprintf("Finished pruning archived topic %d", topicId))
+		}
+	}
+	return nil
+}
+
+// Removes up to limit entries of the topic's state.
+// Returns the number of entries removed and whether the topic has nothing left to prune.
+func (k *Keeper) pruneArchivedTopicState(ctx context.Context, topicId TopicId, limit uint64) (uint64, bool, error) {
+	actorRange := collections.NewPrefixedPairRange[TopicId, ActorId](topicId)
+	blockRange := collections.NewPrefixedPairRange[TopicId, BlockHeight](topicId)
+	actorPairRange := collections.NewPrefixedTripleRange[TopicId, ActorId, ActorId](topicId)
+	topic, err := k.topics.Get(ctx, topicId)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "failed to get topic")
+	}
+	owner, err := k.indexedTopicOwner(ctx, topicId, topic)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "failed to get topic owner")
+	}
+
+	steps := []pruneStep{
+		// delegated stake is scheduled for removal before the state it may rely on is pruned
+		func(limit uint64) (uint64, error) {
+			return k.forceDelegateStakeRemovals(ctx, topicId, limit)
+		},
+		// actor sets
+		keySetRangeStep(ctx, k.topicWorkers, actorRange),
+		keySetRangeStep(ctx, k.topicReputers, actorRange),
+		keySetRangeStep(ctx, k.activeInferers, actorRange),
+		keySetRangeStep(ctx, k.activeForecasters, actorRange),
+		keySetRangeStep(ctx, k.activeReputers, actorRange),
+		keySetRangeStep(ctx, k.topicWorkerWhitelist, actorRange),
+		keySetRangeStep(ctx, k.topicReputerWhitelist, actorRange),
+		keySetRangeStep(ctx, k.actorOperators, actorPairRange),
+		// scores
+		mapRangeStep(ctx, k.infererScoresByBlock, blockRange),
+		mapRangeStep(ctx, k.forecasterScoresByBlock, blockRange),
+		mapRangeStep(ctx, k.reputerScoresByBlock, blockRange),
+		mapRangeStep(ctx, k.infererScoreEmas, actorRange),
+		mapRangeStep(ctx, k.forecasterScoreEmas, actorRange),
+		mapRangeStep(ctx, k.reputerScoreEmas, actorRange),
+		mapRangeStep(ctx, k.reputerListeningCoefficient, actorRange),
+		mapRangeStep(ctx, k.previousReputerRewardFraction, actorRange),
+		mapRangeStep(ctx, k.previousInferenceRewardFraction, actorRange),
+		mapRangeStep(ctx, k.previousForecastRewardFraction, actorRange),
+		mapRangeStep(ctx, k.countInfererInclusionsInTopicActiveSet, actorRange),
+		mapRangeStep(ctx, k.countForecasterInclusionsInTopicActiveSet, actorRange),
+		mapRangeStep(ctx, k.reputerConsensusDeviations, actorRange),
+		keySetRangeStep(ctx, k.autoCompoundDelegators, actorRange),
+		mapRangeStep(ctx, k.reputerCommissions, actorRange),
+		// the by block index of stake locks tolerates the locks being gone once they expire
+		mapRangeStep(ctx, k.stakeLocks, NewSinglePrefixedQuadrupleRange[TopicId, Reputer, Delegator, BlockHeight](topicId)),
+		// the reward history is removed from both of its indexes
+		func(limit uint64) (uint64, error) {
+			return k.pruneRewardHistoryRange(ctx, NewSinglePrefixedQuadrupleRange[TopicId, BlockHeight, ActorId, string](topicId), limit)
+		},
+		// payloads
+		mapRangeStep(ctx, k.inferences, actorRange),
+		mapRangeStep(ctx, k.forecasts, actorRange),
+		mapRangeStep(ctx, k.workerPayloadCommits, actorRange),
+		mapRangeStep(ctx, k.lossBundles, actorRange),
+		mapRangeStep(ctx, k.allInferences, blockRange),
+		mapRangeStep(ctx, k.allForecasts, blockRange),
+		mapRangeStep(ctx, k.allLossBundles, blockRange),
+		mapRangeStep(ctx, k.networkLossBundles, blockRange),
+		mapRangeStep(ctx, k.reputerGroundTruths, actorRange),
+		mapRangeStep(ctx, k.consensusGroundTruths, blockRange),
+		// regrets
+		mapRangeStep(ctx, k.latestInfererNetworkRegrets, actorRange),
+		mapRangeStep(ctx, k.latestForecasterNetworkRegrets, actorRange),
+		mapRangeStep(ctx, k.latestNaiveInfererNetworkRegrets, actorRange),
+		mapRangeStep(ctx, k.latestOneInForecasterNetworkRegrets, actorPairRange),
+		mapRangeStep(ctx, k.latestOneOutInfererInfererNetworkRegrets, actorPairRange),
+		mapRangeStep(ctx, k.latestOneOutInfererForecasterNetworkRegrets, actorPairRange),
+		mapRangeStep(ctx, k.latestOneOutForecasterInfererNetworkRegrets, actorPairRange),
+		mapRangeStep(ctx, k.latestOneOutForecasterForecasterNetworkRegrets, actorPairRange),
+		// single entries keyed by topic id
+		mapKeyStep(ctx, k.lowestInfererScoreEma, topicId),
+		mapKeyStep(ctx, k.lowestForecasterScoreEma, topicId),
+		mapKeyStep(ctx, k.lowestReputerScoreEma, topicId),
+		mapKeyStep(ctx, k.previousForecasterScoreRatio, topicId),
+		mapKeyStep(ctx, k.previousTopicQuantileInfererScoreEma, topicId),
+		mapKeyStep(ctx, k.previousTopicQuantileForecasterScoreEma, topicId),
+		mapKeyStep(ctx, k.previousTopicQuantileReputerScoreEma, topicId),
+		mapKeyStep(ctx, k.initialInfererEmaScore, topicId),
+		mapKeyStep(ctx, k.initialForecasterEmaScore, topicId),
+		mapKeyStep(ctx, k.initialReputerEmaScore, topicId),
+		mapKeyStep(ctx, k.topicFeeRevenue, topicId),
+		mapKeyStep(ctx, k.previousTopicWeight, topicId),
+		mapKeyStep(ctx, k.lastDripBlock, topicId),
+		mapKeyStep(ctx, k.lastMedianInferences, topicId),
+		mapKeyStep(ctx, k.madInferences, topicId),
+		mapKeyStep(ctx, k.unfulfilledWorkerNonces, topicId),
+		mapKeyStep(ctx, k.unfulfilledReputerNonces, topicId),
+		mapKeyStep(ctx, k.topicLastWorkerCommit, topicId),
+		mapKeyStep(ctx, k.topicLastReputerCommit, topicId),
+		mapKeyStep(ctx, k.topicRewardNonce, topicId),
+		mapKeyStep(ctx, k.pendingTopicUpdates, topicId),
+		mapKeyStep(ctx, k.pendingTopicOwners, topicId),
+		keySetKeyStep(ctx, k.topicWorkerWhitelistEnabled, topicId),
+		keySetKeyStep(ctx, k.topicReputerWhitelistEnabled, topicId),
+		keySetKeyStep(ctx, k.rewardableTopics, topicId),
+		// topic listing indexes, the topic itself stays listable by id
+		func(limit uint64) (uint64, error) {
+			return k.pruneTopicTags(ctx, topicId, limit)
+		},
+		keySetKeyStep(ctx, k.topicsByOwner, collections.Join(owner, topicId)),
+		keySetKeyStep(ctx, k.topicsByLossMethod, collections.Join(topic.LossMethod, topicId)),
+	}
+
+	pruned := uint64(0)
+	for _, step := range steps {
+		if pruned >= limit {
+			return pruned, false, nil
+		}
+		removed, err := step(limit - pruned)
+		if err != nil {
+			return pruned, false, err
+		}
+		pruned += removed
+	}
+	return pruned, pruned < limit, nil
+}
+
+// Removes up to limit entries and returns how many were removed
+type pruneStep func(limit uint64) (uint64, error)
+
+// Returns up to limit keys of the map within the range, all of them if limit is 0
+func collectMapKeys[K, V any](ctx context.Context, m collections.Map[K, V], rng collections.Ranger[K], limit uint64) ([]K, error) {
+	iter, err := m.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	keys := make([]K, 0)
+	for ; iter.Valid(); iter.Next() {
+		if limit > 0 && uint64(len(keys)) >= limit {
+			break
+		}
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Returns up to limit keys of the key set within the range, all of them if limit is 0
+func collectKeySetKeys[K any](ctx context.Context, ks collections.KeySet[K], rng collections.Ranger[K], limit uint64) ([]K, error) {
+	iter, err := ks.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	keys := make([]K, 0)
+	for ; iter.Valid(); iter.Next() {
+		if limit > 0 && uint64(len(keys)) >= limit {
+			break
+		}
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Prunes the entries of the map within the range
+func mapRangeStep[K, V any](ctx context.Context, m collections.Map[K, V], rng collections.Ranger[K]) pruneStep {
+	return func(limit uint64) (uint64, error) {
+		keys, err := collectMapKeys(ctx, m, rng, limit)
+		if err != nil {
+			return 0, err
+		}
+		for _, key := range keys {
+			if err := m.Remove(ctx, key); err != nil {
+				return 0, err
+			}
+		}
+		return uint64(len(keys)), nil
+	}
+}
+
+// Prunes the keys of the key set within the range
+func keySetRangeStep[K any](ctx context.Context, ks collections.KeySet[K], rng collections.Ranger[K]) pruneStep {
+	return func(limit uint64) (uint64, error) {
+		keys, err := collectKeySetKeys(ctx, ks, rng, limit)
+		if err != nil {
+			return 0, err
+		}
+		for _, key := range keys {
+			if err := ks.Remove(ctx, key); err != nil {
+				return 0, err
+			}
+		}
+		return uint64(len(keys)), nil
+	}
+}
+
+// Prunes the entry of the map at key
+func mapKeyStep[K, V any](ctx context.Context, m collections.Map[K, V], key K) pruneStep {
+	return func(uint64) (uint64, error) {
+		has, err := m.Has(ctx, key)
+		if err != nil || !has {
+			return 0, err
+		}
+		return 1, m.Remove(ctx, key)
+	}
+}
+
+// Prunes the key from the key set
+func keySetKeyStep[K any](ctx context.Context, ks collections.KeySet[K], key K) pruneStep {
+	return func(uint64) (uint64, error) {
+		has, err := ks.Has(ctx, key)
+		if err != nil || !has {
+			return 0, err
+		}
+		return 1, ks.Remove(ctx, key)
+	}
+}
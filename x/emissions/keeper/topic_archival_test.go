@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *KeeperTestSuite) TestPruneArchivedTopicsIsBounded() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	topicId := s.CreateOneTopic(10800)
+	otherTopicId := s.CreateOneTopic(10800)
+	workers := s.addrsStr[1:4]
+	for _, id := range []uint64{topicId, otherTopicId} {
+		for _, worker := range workers {
+			err := keeper.InsertWorker(ctx, id, worker, types.OffchainNode{Owner: worker, NodeAddress: worker})
+			require.NoError(err)
+			err = keeper.AddToTopicWorkerWhitelist(ctx, id, worker)
+			require.NoError(err)
+			err = keeper.SetInfererScoreEma(ctx, id, worker, types.Score{
+				TopicId:     id,
+				BlockHeight: 5,
+				Address:     worker,
+				Score:       alloraMath.OneDec(),
+			})
+			require.NoError(err)
+		}
+	}
+
+	err := keeper.ArchiveTopic(ctx, topicId)
+	require.NoError(err)
+
+	// Each call removes at most the allowed number of entries
+	maxToPrune := uint64(2)
+	err = keeper.PruneArchivedTopics(ctx, maxToPrune)
+	require.NoError(err)
+	pending, err := keeper.IsArchivedTopicPendingPrune(ctx, topicId)
+	require.NoError(err)
+	require.True(pending)
+	stillRegistered := 0
+	for _, worker := range workers {
+		registered, err := keeper.IsWorkerRegisteredInTopic(ctx, topicId, worker)
+		require.NoError(err)
+		if registered {
+			stillRegistered++
+		}
+	}
+	require.Equal(len(workers)-int(maxToPrune), stillRegistered, "only the first entries should be pruned in one call")
+
+	calls := 1
+	for pending {
+		err = keeper.PruneArchivedTopics(ctx, maxToPrune)
+		require.NoError(err)
+		pending, err = keeper.IsArchivedTopicPendingPrune(ctx, topicId)
+		require.NoError(err)
+		calls++
+		require.Less(calls, 100, "pruning should finish")
+	}
+	require.Greater(calls, 3)
+
+	for _, worker := range workers {
+		registered, err := keeper.IsWorkerRegisteredInTopic(ctx, topicId, worker)
+		require.NoError(err)
+		require.False(registered)
+		whitelisted, err := keeper.IsWhitelistedTopicWorker(ctx, topicId, worker)
+		require.NoError(err)
+		require.False(whitelisted)
+		score, err := keeper.GetInfererScoreEma(ctx, topicId, worker)
+		require.NoError(err)
+		require.Equal(int64(0), score.BlockHeight)
+	}
+	whitelistEnabled, err := keeper.IsTopicWorkerWhitelistEnabled(ctx, topicId)
+	require.NoError(err)
+	require.False(whitelistEnabled)
+
+	// The archived topic itself is kept
+	_, err = keeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	isArchived, err := keeper.IsTopicArchived(ctx, topicId)
+	require.NoError(err)
+	require.True(isArchived)
+
+	// Other topics are untouched
+	for _, worker := range workers {
+		registered, err := keeper.IsWorkerRegisteredInTopic(ctx, otherTopicId, worker)
+		require.NoError(err)
+		require.True(registered)
+		score, err := keeper.GetInfererScoreEma(ctx, otherTopicId, worker)
+		require.NoError(err)
+		require.Equal(int64(5), score.BlockHeight)
+	}
+	whitelistEnabled, err = keeper.IsTopicWorkerWhitelistEnabled(ctx, otherTopicId)
+	require.NoError(err)
+	require.True(whitelistEnabled)
+}
+
+func (s *KeeperTestSuite) TestArchivedTopicDelegateStakeRemovalsAreBatched() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	topicId := s.CreateOneTopic(10800)
+	reputer := s.addrsStr[0]
+	delegators := s.addrsStr[1:6]
+	err := keeper.AddReputerStake(ctx, topicId, reputer, cosmosMath.NewInt(1000))
+	require.NoError(err)
+	for _, delegator := range delegators {
+		err = keeper.AddDelegateStake(ctx, topicId, delegator, reputer, cosmosMath.NewInt(100))
+		require.NoError(err)
+	}
+
+	err = keeper.ArchiveTopic(ctx, topicId)
+	require.NoError(err)
+	countScheduled := func() int {
+		scheduled := 0
+		for _, delegator := range delegators {
+			_, found, err := keeper.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(ctx, delegator, reputer, topicId)
+			require.NoError(err)
+			if found {
+				scheduled++
+			}
+		}
+		return scheduled
+	}
+	require.Equal(0, countScheduled(), "delegated stake is not scheduled for removal at archival")
+
+	// Each call schedules at most the allowed number of removals, picking up where the last call stopped
+	maxToPrune := uint64(2)
+	err = keeper.PruneArchivedTopics(ctx, maxToPrune)
+	require.NoError(err)
+	require.Equal(2, countScheduled())
+	err = keeper.PruneArchivedTopics(ctx, maxToPrune)
+	require.NoError(err)
+	require.Equal(4, countScheduled())
+
+	pending := true
+	for calls := 0; pending; calls++ {
+		require.Less(calls, 100, "pruning should finish")
+		err = keeper.PruneArchivedTopics(ctx, maxToPrune)
+		require.NoError(err)
+		pending, err = keeper.IsArchivedTopicPendingPrune(ctx, topicId)
+		require.NoError(err)
+	}
+	require.Equal(len(delegators), countScheduled())
+	for _, delegator := range delegators {
+		removal, found, err := keeper.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(ctx, delegator, reputer, topicId)
+		require.NoError(err)
+		require.True(found)
+		require.Equal(cosmosMath.NewInt(100), removal.Amount)
+	}
+}
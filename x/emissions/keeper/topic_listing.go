@@ -0,0 +1,252 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Returns the owner of a topic as indexed for the given version of the topic.
+// The creator of that version owns the topic unless ownership was transferred.
+func (k *Keeper) indexedTopicOwner(ctx context.Context, topicId TopicId, topic types.Topic) (ActorId, error) {
+	owner, err := k.topicOwners.Get(ctx, topicId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return topic.Creator, nil
+	} else if err != nil {
+		return "", err
+	}
+	return owner, nil
+}
+
+// Updates the secondary topic indexes for a topic about to be written,
+// dropping the entries of the stored version of the topic if they changed
+func (k *Keeper) reindexTopic(ctx context.Context, topicId TopicId, topic types.Topic) error {
+	owner, err := k.indexedTopicOwner(ctx, topicId, topic)
+	if err != nil {
+		return err
+	}
+	oldTopic, err := k.topics.Get(ctx, topicId)
+	if err == nil {
+		oldOwner, err := k.indexedTopicOwner(ctx, topicId, oldTopic)
+		if err != nil {
+			return err
+		}
+		if oldOwner != owner {
+			if err := k.topicsByOwner.Remove(ctx, collections.Join(oldOwner, topicId)); err != nil {
+				return err
+			}
+		}
+		if oldTopic.LossMethod != topic.LossMethod {
+			if err := k.topicsByLossMethod.Remove(ctx, collections.Join(oldTopic.LossMethod, topicId)); err != nil {
+				return err
+			}
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if err := k.topicsByOwner.Set(ctx, collections.Join(owner, topicId)); err != nil {
+		return err
+	}
+	return k.topicsByLossMethod.Set(ctx, collections.Join(topic.LossMethod, topicId))
+}
+
+// Builds the secondary topic indexes for every stored topic.
+// Used to index the topics created before the indexes existed.
+func (k *Keeper) IndexAllTopics(ctx context.Context) error {
+	return k.topics.Walk(ctx, nil, func(topicId TopicId, topic types.Topic) (bool, error) {
+		if err := k.reindexTopic(ctx, topicId, topic); err != nil {
+			return true, err
+		}
+		return false, nil
+	})
+}
+
+// Attaches tags to a topic, so that it can be found by them in topic listings
+func (k *Keeper) SetTopicTags(ctx context.Context, topicId TopicId, tags []string) error {
+	if err := types.ValidateTopicId(topicId); err != nil {
+		return errorsmod.Wrap(err, "topic id validation failed")
+	}
+	for _, tag := range tags {
+		if len(tag) == 0 {
+			return errorsmod.Wrap(types.ErrInvalidValue, "tag cannot be empty")
+		}
+		if err := k.topicTags.Set(ctx, collections.Join(topicId, tag)); err != nil {
+			return errorsmod.Wrap(err, "error setting topic tag")
+		}
+		if err := k.topicsByTag.Set(ctx, collections.Join(tag, topicId)); err != nil {
+			return errorsmod.Wrap(err, "error indexing topic tag")
+		}
+	}
+	return nil
+}
+
+// Returns the tags of a topic in lexicographic order
+func (k *Keeper) GetTopicTags(ctx context.Context, topicId TopicId) ([]string, error) {
+	tags := make([]string, 0)
+	rng := collections.NewPrefixedPairRange[TopicId, string](topicId)
+	err := k.topicTags.Walk(ctx, rng, func(key collections.Pair[TopicId, string]) (bool, error) {
+		tags = append(tags, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error getting topic tags")
+	}
+	return tags, nil
+}
+
+// Moves a topic to its new owner in the owner index
+func (k *Keeper) reindexTopicOwner(ctx context.Context, topicId TopicId, oldOwner, newOwner ActorId) error {
+	if err := k.topicsByOwner.Remove(ctx, collections.Join(oldOwner, topicId)); err != nil {
+		return errorsmod.Wrap(err, "error removing topic from owner index")
+	}
+	if err := k.topicsByOwner.Set(ctx, collections.Join(newOwner, topicId)); err != nil {
+		return errorsmod.Wrap(err, "error adding topic to owner index")
+	}
+	return nil
+}
+
+// Removes up to limit tags of an archived topic together with their index entries.
+// Returns how many tags were removed
+func (k *Keeper) pruneTopicTags(ctx context.Context, topicId TopicId, limit uint64) (uint64, error) {
+	keys, err := collectKeySetKeys(ctx, k.topicTags, collections.NewPrefixedPairRange[TopicId, string](topicId), limit)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "error iterating topic tags")
+	}
+	for _, key := range keys {
+		if err := k.topicsByTag.Remove(ctx, collections.Join(key.K2(), topicId)); err != nil {
+			return 0, errorsmod.Wrap(err, "error removing topic from tag index")
+		}
+		if err := k.topicTags.Remove(ctx, key); err != nil {
+			return 0, errorsmod.Wrap(err, "error removing topic tag")
+		}
+	}
+	return uint64(len(keys)), nil
+}
+
+// Returns a page of topics matching all the filters of the request, in ascending id order.
+// The most selective index available is walked and the remaining filters are checked on each topic.
+// The page limit bounds the index entries scanned rather than the topics returned, so a page
+// may hold fewer topics than the limit, or none, while still returning a key to resume from.
+// Archived topics drop out of the owner, loss method and tag indexes once they are pruned.
+func (k *Keeper) ListTopics(ctx context.Context, req *types.ListTopicsRequest) ([]*types.ListedTopic, *types.SimpleCursorPaginationResponse, error) {
+	limit, cursor, err := k.CalcAppropriatePaginationForUint64Cursor(ctx, req.Pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	topics := make([]*types.ListedTopic, 0)
+	var nextKey []byte
+	scanned := uint64(0)
+	visit := func(topicId TopicId) (bool, error) {
+		if scanned >= limit {
+			nextKey = make([]byte, 8)
+			binary.BigEndian.PutUint64(nextKey, topicId)
+			return true, nil
+		}
+		scanned++
+		listed, matches, err := k.matchTopicListing(ctx, req, topicId)
+		if err != nil {
+			return true, err
+		}
+		if matches {
+			topics = append(topics, listed)
+		}
+		return false, nil
+	}
+
+	switch {
+	case len(req.Tags) > 0:
+		rng := collections.NewPrefixedPairRange[string, TopicId](req.Tags[0]).StartInclusive(cursor)
+		err = k.topicsByTag.Walk(ctx, rng, func(key collections.Pair[string, TopicId]) (bool, error) {
+			return visit(key.K2())
+		})
+	case req.Owner != "":
+		rng := collections.NewPrefixedPairRange[ActorId, TopicId](req.Owner).StartInclusive(cursor)
+		err = k.topicsByOwner.Walk(ctx, rng, func(key collections.Pair[ActorId, TopicId]) (bool, error) {
+			return visit(key.K2())
+		})
+	case req.LossMethod != "":
+		rng := collections.NewPrefixedPairRange[string, TopicId](req.LossMethod).StartInclusive(cursor)
+		err = k.topicsByLossMethod.Walk(ctx, rng, func(key collections.Pair[string, TopicId]) (bool, error) {
+			return visit(key.K2())
+		})
+	case req.Activity == types.TopicActivity_TOPIC_ACTIVITY_ACTIVE:
+		rng := new(collections.Range[TopicId]).StartInclusive(cursor)
+		err = k.activeTopics.Walk(ctx, rng, visit)
+	default:
+		rng := new(collections.Range[TopicId]).StartInclusive(cursor)
+		err = k.topics.Walk(ctx, rng, func(topicId TopicId, _ types.Topic) (bool, error) {
+			return visit(topicId)
+		})
+	}
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error listing topics")
+	}
+
+	return topics, &types.SimpleCursorPaginationResponse{NextKey: nextKey}, nil
+}
+
+// Checks a topic against every filter of a listing request
+func (k *Keeper) matchTopicListing(ctx context.Context, req *types.ListTopicsRequest, topicId TopicId) (*types.ListedTopic, bool, error) {
+	topic, err := k.topics.Get(ctx, topicId)
+	if err != nil {
+		return nil, false, errorsmod.Wrap(err, "error getting topic")
+	}
+	if req.Owner != "" {
+		owner, err := k.indexedTopicOwner(ctx, topicId, topic)
+		if err != nil {
+			return nil, false, errorsmod.Wrap(err, "error getting topic owner")
+		}
+		if owner != req.Owner {
+			return nil, false, nil
+		}
+	}
+	if req.LossMethod != "" && topic.LossMethod != req.LossMethod {
+		return nil, false, nil
+	}
+	if req.MinEpochLength > 0 && topic.EpochLength < req.MinEpochLength {
+		return nil, false, nil
+	}
+	if req.MaxEpochLength > 0 && topic.EpochLength > req.MaxEpochLength {
+		return nil, false, nil
+	}
+
+	isActive, err := k.activeTopics.Has(ctx, topicId)
+	if err != nil {
+		return nil, false, errorsmod.Wrap(err, "error checking if topic is active")
+	}
+	if (req.Activity == types.TopicActivity_TOPIC_ACTIVITY_ACTIVE && !isActive) ||
+		(req.Activity == types.TopicActivity_TOPIC_ACTIVITY_INACTIVE && isActive) {
+		return nil, false, nil
+	}
+
+	for _, tag := range req.Tags {
+		hasTag, err := k.topicTags.Has(ctx, collections.Join(topicId, tag))
+		if err != nil {
+			return nil, false, errorsmod.Wrap(err, "error checking topic tag")
+		}
+		if !hasTag {
+			return nil, false, nil
+		}
+	}
+
+	tags, err := k.GetTopicTags(ctx, topicId)
+	if err != nil {
+		return nil, false, err
+	}
+	isArchived, err := k.IsTopicArchived(ctx, topicId)
+	if err != nil {
+		return nil, false, err
+	}
+	return &types.ListedTopic{
+		Topic:    &topic,
+		IsActive: isActive,
+		Archived: isArchived,
+		Tags:     tags,
+	}, true, nil
+}
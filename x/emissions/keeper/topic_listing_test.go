@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Lists topics with the filters set by setFilters and returns their ids and the next page key
+func (s *KeeperTestSuite) listedTopicIds(setFilters func(req *types.ListTopicsRequest)) ([]uint64, []byte) {
+	req := &types.ListTopicsRequest{
+		Pagination:     nil,
+		Owner:          "",
+		Activity:       types.TopicActivity_TOPIC_ACTIVITY_ANY_UNSPECIFIED,
+		LossMethod:     "",
+		MinEpochLength: 0,
+		MaxEpochLength: 0,
+		Tags:           nil,
+	}
+	setFilters(req)
+	topics, pagination, err := s.emissionsKeeper.ListTopics(s.ctx, req)
+	s.Require().NoError(err)
+	ids := make([]uint64, 0, len(topics))
+	for _, listed := range topics {
+		ids = append(ids, listed.Topic.Id)
+	}
+	return ids, pagination.NextKey
+}
+
+func (s *KeeperTestSuite) TestListTopicsFilters() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	topicId1 := s.CreateOneTopic(100)
+	topicId2 := s.CreateOneTopic(200)
+	topicId3 := s.CreateOneTopic(300)
+
+	// Changing an indexed field or the owner moves the topic in the index
+	topic3, err := keeper.GetTopic(ctx, topicId3)
+	require.NoError(err)
+	topic3.LossMethod = "huber"
+	err = keeper.SetTopic(ctx, topicId3, topic3)
+	require.NoError(err)
+	err = keeper.ProposeTopicOwner(ctx, topicId3, s.addrsStr[1])
+	require.NoError(err)
+	err = keeper.AcceptTopicOwnership(ctx, topicId3, s.addrsStr[1])
+	require.NoError(err)
+
+	err = keeper.SetTopicTags(ctx, topicId1, []string{"eth", "price"})
+	require.NoError(err)
+	err = keeper.SetTopicTags(ctx, topicId2, []string{"eth"})
+	require.NoError(err)
+	err = keeper.ActivateTopic(ctx, topicId2)
+	require.NoError(err)
+
+	ids, _ := s.listedTopicIds(func(req *types.ListTopicsRequest) {})
+	require.Equal([]uint64{topicId1, topicId2, topicId3}, ids)
+
+	ids, _ = s.listedTopicIds(func(req *types.ListTopicsRequest) { req.Owner = s.addrsStr[0] })
+	require.Equal([]uint64{topicId1, topicId2}, ids)
+	ids, _ = s.listedTopicIds(func(req *types.ListTopicsRequest) { req.Owner = s.addrsStr[1] })
+	require.Equal([]uint64{topicId3}, ids)
+
+	ids, _ = s.listedTopicIds(func(req *types.ListTopicsRequest) { req.LossMethod = "method" })
+	require.Equal([]uint64{topicId1, topicId2}, ids)
+	ids, _ = s.listedTopicIds(func(req *types.ListTopicsRequest) { req.LossMethod = "huber" })
+	require.Equal([]uint64{topicId3}, ids)
+
+	ids, _ = s.listedTopicIds(func(req *types.ListTopicsRequest) {
+		req.MinEpochLength = 150
+		req.MaxEpochLength = 300
+	})
+	require.Equal([]uint64{topicId2, topicId3}, ids)
+
+	ids, _ = s.listedTopicIds(func(req *types.ListTopicsRequest) { req.Tags = []string{"eth"} })
+	require.Equal([]uint64{topicId1, topicId2}, ids)
+	ids, _ = s.listedTopicIds(func(req *types.ListTopicsRequest) { req.Tags = []string{"eth", "price"} })
+	require.Equal([]uint64{topicId1}, ids)
+
+	ids, _ = s.listedTopicIds(func(req *types.ListTopicsRequest) { req.Activity = types.TopicActivity_TOPIC_ACTIVITY_ACTIVE })
+	require.Equal([]uint64{topicId2}, ids)
+	ids, _ = s.listedTopicIds(func(req *types.ListTopicsRequest) { req.Activity = types.TopicActivity_TOPIC_ACTIVITY_INACTIVE })
+	require.Equal([]uint64{topicId1, topicId3}, ids)
+
+	// Filters combine
+	ids, _ = s.listedTopicIds(func(req *types.ListTopicsRequest) {
+		req.Owner = s.addrsStr[0]
+		req.Activity = types.TopicActivity_TOPIC_ACTIVITY_INACTIVE
+		req.LossMethod = "method"
+		req.MinEpochLength = 50
+		req.MaxEpochLength = 150
+		req.Tags = []string{"price"}
+	})
+	require.Equal([]uint64{topicId1}, ids)
+
+	// Listed topics carry their tags and state
+	topics, _, err := keeper.ListTopics(ctx, &types.ListTopicsRequest{
+		Pagination:     nil,
+		Owner:          "",
+		Activity:       types.TopicActivity_TOPIC_ACTIVITY_ANY_UNSPECIFIED,
+		LossMethod:     "",
+		MinEpochLength: 0,
+		MaxEpochLength: 0,
+		Tags:           []string{"eth"},
+	})
+	require.NoError(err)
+	require.Len(topics, 2)
+	require.Equal([]string{"eth", "price"}, topics[0].Tags)
+	require.False(topics[0].IsActive)
+	require.True(topics[1].IsActive)
+}
+
+func (s *KeeperTestSuite) TestListTopicsPagination() {
+	topicIds := []uint64{s.CreateOneTopic(100), s.CreateOneTopic(100), s.CreateOneTopic(100)}
+
+	ids, nextKey := s.listedTopicIds(func(req *types.ListTopicsRequest) {
+		req.Pagination = &types.SimpleCursorPaginationRequest{Key: nil, Limit: 2}
+	})
+	s.Require().Equal(topicIds[:2], ids)
+	s.Require().Equal(topicIds[2], binary.BigEndian.Uint64(nextKey))
+
+	ids, nextKey = s.listedTopicIds(func(req *types.ListTopicsRequest) {
+		req.Pagination = &types.SimpleCursorPaginationRequest{Key: nextKey, Limit: 2}
+	})
+	s.Require().Equal(topicIds[2:], ids)
+	s.Require().Nil(nextKey)
+}
+
+func (s *KeeperTestSuite) TestListTopicsPageLimitBoundsScannedTopics() {
+	topicIds := []uint64{s.CreateOneTopic(100), s.CreateOneTopic(200), s.CreateOneTopic(200), s.CreateOneTopic(100)}
+
+	// The first page scans two topics and only one of them matches
+	ids, nextKey := s.listedTopicIds(func(req *types.ListTopicsRequest) {
+		req.MinEpochLength = 200
+		req.Pagination = &types.SimpleCursorPaginationRequest{Key: nil, Limit: 1}
+	})
+	s.Require().Empty(ids)
+	s.Require().Equal(topicIds[1], binary.BigEndian.Uint64(nextKey))
+
+	ids, nextKey = s.listedTopicIds(func(req *types.ListTopicsRequest) {
+		req.MinEpochLength = 200
+		req.Pagination = &types.SimpleCursorPaginationRequest{Key: nextKey, Limit: 3}
+	})
+	s.Require().Equal(topicIds[1:3], ids)
+	s.Require().Nil(nextKey)
+}
+
+func (s *KeeperTestSuite) TestPruneArchivedTopicRemovesListingIndexes() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	topicId := s.CreateOneTopic(100)
+	err := keeper.SetTopicTags(ctx, topicId, []string{"eth", "price"})
+	require.NoError(err)
+	err = keeper.ArchiveTopic(ctx, topicId)
+	require.NoError(err)
+	err = keeper.PruneArchivedTopics(ctx, 100)
+	require.NoError(err)
+
+	ids, _ := s.listedTopicIds(func(req *types.ListTopicsRequest) { req.Tags = []string{"eth"} })
+	require.Empty(ids)
+	ids, _ = s.listedTopicIds(func(req *types.ListTopicsRequest) { req.Owner = s.addrsStr[0] })
+	require.Empty(ids)
+	ids, _ = s.listedTopicIds(func(req *types.ListTopicsRequest) { req.LossMethod = "method" })
+	require.Empty(ids)
+	tags, err := keeper.GetTopicTags(ctx, topicId)
+	require.NoError(err)
+	require.Empty(tags)
+
+	// The topic itself is still listed as archived
+	topics, _, err := keeper.ListTopics(ctx, &types.ListTopicsRequest{
+		Pagination:     nil,
+		Owner:          "",
+		Activity:       types.TopicActivity_TOPIC_ACTIVITY_ANY_UNSPECIFIED,
+		LossMethod:     "",
+		MinEpochLength: 0,
+		MaxEpochLength: 0,
+		Tags:           nil,
+	})
+	require.NoError(err)
+	require.Len(topics, 1)
+	require.True(topics[0].Archived)
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Returns the current owner of a topic. This is the topic creator
+// unless ownership has been transferred since.
+func (k *Keeper) GetTopicOwner(ctx context.Context, topicId TopicId) (ActorId, error) {
+	owner, err := k.topicOwners.Get(ctx, topicId)
+	if err == nil {
+		return owner, nil
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return "", errorsmod.Wrap(err, "error getting topic owner")
+	}
+	topic, err := k.GetTopic(ctx, topicId)
+	if err != nil {
+		return "", err
+	}
+	return topic.Creator, nil
+}
+
+// Returns true if the actor is the current owner of the topic
+func (k *Keeper) IsTopicOwner(ctx context.Context, topicId TopicId, actor ActorId) (bool, error) {
+	owner, err := k.GetTopicOwner(ctx, topicId)
+	if err != nil {
+		return false, err
+	}
+	return owner == actor, nil
+}
+
+// Returns the proposed owner of a topic and whether there is one
+func (k *Keeper) GetPendingTopicOwner(ctx context.Context, topicId TopicId) (ActorId, bool, error) {
+	pendingOwner, err := k.pendingTopicOwners.Get(ctx, topicId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, errorsmod.Wrap(err, "error getting pending topic owner")
+	}
+	return pendingOwner, true, nil
+}
+
+// Records a proposed owner for the topic, replacing any earlier proposal
+func (k *Keeper) ProposeTopicOwner(ctx context.Context, topicId TopicId, newOwner ActorId) error {
+	if err := types.ValidateBech32(newOwner); err != nil {
+		return errorsmod.Wrap(err, "new owner is not valid")
+	}
+	owner, err := k.GetTopicOwner(ctx, topicId)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting topic owner")
+	}
+	err = k.pendingTopicOwners.Set(ctx, topicId, newOwner)
+	if err != nil {
+		return errorsmod.Wrap(err, "error setting pending topic owner")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	types.EmitNewTopicOwnershipTransferProposedEvent(sdkCtx, topicId, sdkCtx.BlockHeight(), owner, newOwner)
+	return nil
+}
+
+// Makes the proposed owner the owner of the topic
+func (k *Keeper) AcceptTopicOwnership(ctx context.Context, topicId TopicId, newOwner ActorId) error {
+	pendingOwner, found, err := k.GetPendingTopicOwner(ctx, topicId)
+	if err != nil {
+		return err
+	} else if !found {
+		return types.ErrNoPendingTopicOwnershipTransfer
+	} else if pendingOwner != newOwner {
+		return types.ErrNotPendingTopicOwner
+	}
+	previousOwner, err := k.GetTopicOwner(ctx, topicId)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting topic owner")
+	}
+	err = k.pendingTopicOwners.Remove(ctx, topicId)
+	if err != nil {
+		return errorsmod.Wrap(err, "error removing pending topic owner")
+	}
+	err = k.SetTopicOwner(ctx, topicId, newOwner)
+	if err != nil {
+		return err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	types.EmitNewTopicOwnershipTransferredEvent(sdkCtx, topicId, sdkCtx.BlockHeight(), previousOwner, newOwner)
+	return nil
+}
+
+// Makes the actor the owner of the topic, keeping the owner index of topic listings in sync
+func (k *Keeper) SetTopicOwner(ctx context.Context, topicId TopicId, owner ActorId) error {
+	previousOwner, err := k.GetTopicOwner(ctx, topicId)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting topic owner")
+	}
+	err = k.topicOwners.Set(ctx, topicId, owner)
+	if err != nil {
+		return errorsmod.Wrap(err, "error setting topic owner")
+	}
+	return k.reindexTopicOwner(ctx, topicId, previousOwner, owner)
+}
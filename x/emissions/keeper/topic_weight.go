@@ -24,20 +24,35 @@ func (k *Keeper) GetTargetWeight(
 	stakeImportance alloraMath.Dec,
 	feeImportance alloraMath.Dec,
 ) (alloraMath.Dec, error) {
-	s, err := alloraMath.Pow(topicStake, stakeImportance)
+	s, p, err := k.GetTargetWeightTerms(topicStake, topicEpochLength, topicFeeRevenue, stakeImportance, feeImportance)
 	if err != nil {
 		return alloraMath.Dec{}, err
 	}
+	return s.Mul(p)
+}
+
+// Return the stake term S^{μ}_{t,i} and the fee revenue term (P/C)^{ν}_{t,i} of the target weight of a topic
+func (k *Keeper) GetTargetWeightTerms(
+	topicStake alloraMath.Dec,
+	topicEpochLength int64,
+	topicFeeRevenue alloraMath.Dec,
+	stakeImportance alloraMath.Dec,
+	feeImportance alloraMath.Dec,
+) (stakeTerm alloraMath.Dec, feeRevenueTerm alloraMath.Dec, err error) {
+	s, err := alloraMath.Pow(topicStake, stakeImportance)
+	if err != nil {
+		return alloraMath.Dec{}, alloraMath.Dec{}, err
+	}
 	c := alloraMath.NewDecFromInt64(topicEpochLength)
 	feePerEpoch, err := topicFeeRevenue.Quo(c)
 	if err != nil {
-		return alloraMath.Dec{}, err
+		return alloraMath.Dec{}, alloraMath.Dec{}, err
 	}
 	p, err := alloraMath.Pow(feePerEpoch, feeImportance)
 	if err != nil {
-		return alloraMath.Dec{}, err
+		return alloraMath.Dec{}, alloraMath.Dec{}, err
 	}
-	return s.Mul(p)
+	return s, p, nil
 }
 
 func (k *Keeper) GetCurrentTopicWeight(
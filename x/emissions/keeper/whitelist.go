@@ -320,14 +320,19 @@ func (k *Keeper) CanUpdateParams(ctx context.Context, actor ActorId) (bool, erro
 	return k.IsWhitelistAdmin(ctx, actor)
 }
 
-// Whitelist admins and topic creators and global admins can update topic whitelists
+// Whitelist admins can forfeit the unvested rewards of an actor held in escrow
+func (k *Keeper) CanForfeitRewards(ctx context.Context, actor ActorId) (bool, error) {
+	return k.IsWhitelistAdmin(ctx, actor)
+}
+
+// Whitelist admins and topic owners and global admins can update topic whitelists
 // Updating the whitelist includes adding/removing from the whitelist and enabling/disabling the whitelist
 func (k *Keeper) CanUpdateTopicWhitelist(ctx context.Context, topicId TopicId, actor ActorId) (bool, error) {
-	topic, err := k.GetTopic(ctx, topicId)
+	isOwner, err := k.IsTopicOwner(ctx, topicId, actor)
 	if err != nil {
 		return false, err
 	}
-	if topic.Creator == actor {
+	if isOwner {
 		return true, nil
 	}
 	isWhitelistAdmin, err := k.IsEnabledGlobalAdmin(ctx, actor)
@@ -340,6 +345,42 @@ func (k *Keeper) CanUpdateTopicWhitelist(ctx context.Context, topicId TopicId, a
 	return k.IsWhitelistAdmin(ctx, actor)
 }
 
+// Topic owners and whitelist admins can update topic parameters
+func (k *Keeper) CanUpdateTopic(ctx context.Context, topicId TopicId, actor ActorId) (bool, error) {
+	isOwner, err := k.IsTopicOwner(ctx, topicId, actor)
+	if err != nil {
+		return false, err
+	}
+	if isOwner {
+		return true, nil
+	}
+	return k.IsWhitelistAdmin(ctx, actor)
+}
+
+// Topic owners and whitelist admins can archive a topic
+func (k *Keeper) CanArchiveTopic(ctx context.Context, topicId TopicId, actor ActorId) (bool, error) {
+	isOwner, err := k.IsTopicOwner(ctx, topicId, actor)
+	if err != nil {
+		return false, err
+	}
+	if isOwner {
+		return true, nil
+	}
+	return k.IsWhitelistAdmin(ctx, actor)
+}
+
+// Topic owners and whitelist admins can propose a new owner for a topic
+func (k *Keeper) CanTransferTopicOwnership(ctx context.Context, topicId TopicId, actor ActorId) (bool, error) {
+	isOwner, err := k.IsTopicOwner(ctx, topicId, actor)
+	if err != nil {
+		return false, err
+	}
+	if isOwner {
+		return true, nil
+	}
+	return k.IsWhitelistAdmin(ctx, actor)
+}
+
 // An actor can create a topic if they are topic creator whitelisted
 // or if they are globally whitelisted
 func (k *Keeper) CanCreateTopic(ctx context.Context, actor ActorId) (bool, error) {
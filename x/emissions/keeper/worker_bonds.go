@@ -0,0 +1,332 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/// WORKER BONDS
+
+// Returns the bond of the worker on the topic, with a zero amount if the worker has not bonded
+func (k *Keeper) GetWorkerBond(ctx context.Context, topicId TopicId, worker ActorId) (types.WorkerBond, error) {
+	bond, err := k.workerBonds.Get(ctx, collections.Join(topicId, worker))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.WorkerBond{
+			TopicId:             topicId,
+			Worker:              worker,
+			Amount:              cosmosMath.ZeroInt(),
+			LivenessBlockHeight: 0,
+		}, nil
+	} else if err != nil {
+		return types.WorkerBond{}, errorsmod.Wrap(err, "error getting worker bond")
+	}
+	return bond, nil
+}
+
+// Sets the bond of a worker on a topic, removing it if its amount is zero
+func (k *Keeper) SetWorkerBond(ctx context.Context, bond types.WorkerBond) error {
+	if err := bond.Validate(); err != nil {
+		return errorsmod.Wrap(err, "worker bond is not valid")
+	}
+	key := collections.Join(bond.TopicId, bond.Worker)
+	if bond.Amount.IsZero() {
+		return k.workerBonds.Remove(ctx, key)
+	}
+	return k.workerBonds.Set(ctx, key, bond)
+}
+
+// Returns the bonds of all workers of the topic, in ascending worker address order
+func (k *Keeper) GetWorkerBondsOfTopic(ctx context.Context, topicId TopicId) ([]types.WorkerBond, error) {
+	rng := collections.NewPrefixedPairRange[TopicId, ActorId](topicId)
+	iter, err := k.workerBonds.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error iterating worker bonds")
+	}
+	defer iter.Close()
+	return iter.Values()
+}
+
+// Returns the total sum of all worker bonds across all topics
+func (k *Keeper) GetTotalWorkerBonds(ctx context.Context) (cosmosMath.Int, error) {
+	ret, err := k.totalWorkerBonds.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return cosmosMath.ZeroInt(), nil
+	} else if err != nil {
+		return cosmosMath.Int{}, errorsmod.Wrap(err, "error getting total worker bonds")
+	}
+	return ret, nil
+}
+
+// Sets the total sum of all worker bonds across all topics
+func (k *Keeper) SetTotalWorkerBonds(ctx context.Context, total cosmosMath.Int) error {
+	if err := types.ValidateSdkIntRepresentingMonetaryValue(total); err != nil {
+		return errorsmod.Wrap(err, "total worker bonds cosmos Int is not valid")
+	}
+	return k.totalWorkerBonds.Set(ctx, total)
+}
+
+// Adds to the bond of a worker on a topic. The coins must already have been sent to the allora staking account.
+// Missed epochs of a new bond are counted from the current block
+func (k *Keeper) AddWorkerBond(ctx context.Context, topicId TopicId, worker ActorId, amount cosmosMath.Int) error {
+	bond, err := k.GetWorkerBond(ctx, topicId, worker)
+	if err != nil {
+		return err
+	}
+	if bond.Amount.IsZero() {
+		bond.LivenessBlockHeight = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	}
+	bond.Amount = bond.Amount.Add(amount)
+	if err := k.SetWorkerBond(ctx, bond); err != nil {
+		return err
+	}
+	total, err := k.GetTotalWorkerBonds(ctx)
+	if err != nil {
+		return err
+	}
+	return k.SetTotalWorkerBonds(ctx, total.Add(amount))
+}
+
+// Subtracts from the bond of a worker on a topic, without moving any coins
+func (k *Keeper) subtractWorkerBond(ctx context.Context, topicId TopicId, worker ActorId, amount cosmosMath.Int) error {
+	bond, err := k.GetWorkerBond(ctx, topicId, worker)
+	if err != nil {
+		return err
+	}
+	if amount.GT(bond.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientWorkerBond, "cannot remove %s from bond of %s", amount, bond.Amount)
+	}
+	bond.Amount = bond.Amount.Sub(amount)
+	if err := k.SetWorkerBond(ctx, bond); err != nil {
+		return err
+	}
+	total, err := k.GetTotalWorkerBonds(ctx)
+	if err != nil {
+		return err
+	}
+	if amount.GT(total) {
+		return errorsmod.Wrapf(types.ErrInvariantFailure, "cannot remove %s from total worker bonds of %s", amount, total)
+	}
+	return k.SetTotalWorkerBonds(ctx, total.Sub(amount))
+}
+
+// Completes a worker bond removal: the bond is reduced and the removal is dequeued.
+// The coins are sent back to the worker by the caller
+func (k *Keeper) RemoveWorkerBond(
+	ctx context.Context,
+	blockRemovalCompleted BlockHeight,
+	topicId TopicId,
+	worker ActorId,
+	amount cosmosMath.Int,
+) error {
+	if err := k.subtractWorkerBond(ctx, topicId, worker, amount); err != nil {
+		return err
+	}
+	return k.DeleteWorkerBondRemoval(ctx, blockRemovalCompleted, topicId, worker)
+}
+
+/// WORKER BOND REMOVALS
+
+// Adds a worker bond removal to the delayed queue
+func (k *Keeper) SetWorkerBondRemoval(ctx context.Context, removalInfo types.WorkerBondRemovalInfo) error {
+	if err := removalInfo.Validate(); err != nil {
+		return errorsmod.Wrap(err, "removalInfo is not valid")
+	}
+	byBlockKey := collections.Join3(removalInfo.BlockRemovalCompleted, removalInfo.TopicId, removalInfo.Worker)
+	err := k.workerBondRemovalsByBlock.Set(ctx, byBlockKey, removalInfo)
+	if err != nil {
+		return errorsmod.Wrap(err, "error setting worker bond removal by block")
+	}
+	byActorKey := collections.Join3(removalInfo.Worker, removalInfo.TopicId, removalInfo.BlockRemovalCompleted)
+	return k.workerBondRemovalsByActor.Set(ctx, byActorKey)
+}
+
+// Removes a worker bond removal from the delayed queue
+func (k *Keeper) DeleteWorkerBondRemoval(
+	ctx context.Context,
+	blockHeight BlockHeight,
+	topicId TopicId,
+	worker ActorId,
+) error {
+	byBlockKey := collections.Join3(blockHeight, topicId, worker)
+	has, err := k.workerBondRemovalsByBlock.Has(ctx, byBlockKey)
+	if err != nil {
+		return errorsmod.Wrap(err, "error checking if worker bond removal by block exists")
+	}
+	if !has {
+		return types.ErrStakeRemovalNotFound
+	}
+	err = k.workerBondRemovalsByBlock.Remove(ctx, byBlockKey)
+	if err != nil {
+		return errorsmod.Wrap(err, "error removing worker bond removal by block")
+	}
+	return k.workerBondRemovalsByActor.Remove(ctx, collections.Join3(worker, topicId, blockHeight))
+}
+
+// Returns the worker bond removals due before and including this block, up to limit removals
+func (k *Keeper) GetWorkerBondRemovalsUpUntilBlock(
+	ctx context.Context,
+	blockHeight BlockHeight,
+	limit uint64,
+) (ret []types.WorkerBondRemovalInfo, anyLeft bool, err error) {
+	ret = make([]types.WorkerBondRemovalInfo, 0)
+	rng := &collections.Range[collections.Triple[BlockHeight, TopicId, ActorId]]{}
+	rng = rng.Prefix(collections.TriplePrefix[BlockHeight, TopicId, ActorId](0))
+	rng = rng.EndExclusive(collections.TriplePrefix[BlockHeight, TopicId, ActorId](blockHeight + 1))
+
+	iter, err := k.workerBondRemovalsByBlock.Iterate(ctx, rng)
+	if err != nil {
+		return ret, false, errorsmod.Wrap(err, "error iterating over worker bond removals by block")
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(ret)) >= limit {
+			return ret, true, nil
+		}
+		val, err := iter.Value()
+		if err != nil {
+			return ret, true, errorsmod.Wrap(err, "error getting worker bond removal by block")
+		}
+		ret = append(ret, val)
+	}
+	return ret, false, nil
+}
+
+// Returns the pending bond removal of a worker on a topic, if any
+func (k *Keeper) GetWorkerBondRemovalForWorkerAndTopicId(
+	ctx context.Context,
+	worker ActorId,
+	topicId TopicId,
+) (removal types.WorkerBondRemovalInfo, found bool, err error) {
+	rng := collections.NewSuperPrefixedTripleRange[ActorId, TopicId, BlockHeight](worker, topicId)
+	keys, err := collectKeySetKeys(ctx, k.workerBondRemovalsByActor, rng, 1)
+	if err != nil {
+		return types.WorkerBondRemovalInfo{}, false, errorsmod.Wrap(err, "error iterating over worker bond removals by actor")
+	}
+	if len(keys) == 0 {
+		return types.WorkerBondRemovalInfo{}, false, nil
+	}
+	removal, err = k.workerBondRemovalsByBlock.Get(ctx, collections.Join3(keys[0].K3(), topicId, worker))
+	if err != nil {
+		return types.WorkerBondRemovalInfo{}, false, errorsmod.Wrap(err, "error getting worker bond removal by block")
+	}
+	return removal, true, nil
+}
+
+// Returns true if the worker has bonded enough to submit payloads on the topic
+func (k *Keeper) HasSufficientWorkerBond(ctx context.Context, topic types.Topic, worker ActorId) (bool, error) {
+	if !topic.RequiresWorkerBond() {
+		return true, nil
+	}
+	bond, err := k.GetWorkerBond(ctx, topic.Id, worker)
+	if err != nil {
+		return false, err
+	}
+	return bond.Amount.GTE(topic.WorkerBond), nil
+}
+
+/// SLASHING
+
+// Returns the height of the last nonce the worker submitted to, or of the last time
+// its bond was added or slashed if that is more recent
+func (k *Keeper) getWorkerBondLastLiveness(ctx context.Context, bond types.WorkerBond) (BlockHeight, error) {
+	last := bond.LivenessBlockHeight
+	infererScore, err := k.GetInfererScoreEma(ctx, bond.TopicId, bond.Worker)
+	if err != nil {
+		return 0, err
+	}
+	forecasterScore, err := k.GetForecasterScoreEma(ctx, bond.TopicId, bond.Worker)
+	if err != nil {
+		return 0, err
+	}
+	return max(last, infererScore.BlockHeight, forecasterScore.BlockHeight), nil
+}
+
+// SlashIdleWorkerBonds slashes the bond of every worker of the topic that missed at least
+// WorkerBondSlashMissedEpochs contiguous epochs. Slashed funds and the unvested rewards of the worker
+// held in escrow on the topic are added to the topic reward pool, and missed epochs are counted
+// afresh from the current epoch.
+func (k *Keeper) SlashIdleWorkerBonds(ctx sdk.Context, topic types.Topic) error {
+	if !topic.RequiresWorkerBond() {
+		return nil
+	}
+	bonds, err := k.GetWorkerBondsOfTopic(ctx, topic.Id)
+	if err != nil {
+		return err
+	}
+	for _, bond := range bonds {
+		last, err := k.getWorkerBondLastLiveness(ctx, bond)
+		if err != nil {
+			return err
+		}
+		missedEpochs := CountWorkerContiguousMissedEpochs(topic, last)
+		if missedEpochs < topic.WorkerBondSlashMissedEpochs {
+			continue
+		}
+		slashed, err := topic.WorkerBondSlashAmount(bond.Amount)
+		if err != nil {
+			return errorsmod.Wrapf(err, "error computing slash of worker %s", bond.Worker)
+		}
+		if err := k.slashWorkerBond(ctx, bond, slashed, topic.EpochLastEnded); err != nil {
+			return errorsmod.Wrapf(err, "error slashing worker %s", bond.Worker)
+		}
+		forfeited, err := k.ForfeitUnvestedRewards(ctx, topic.Id, bond.Worker)
+		if err != nil {
+			return errorsmod.Wrapf(err, "error forfeiting unvested rewards of worker %s", bond.Worker)
+		}
+		types.EmitNewWorkerBondSlashedEvent(ctx, topic.Id, ctx.BlockHeight(), bond.Worker, slashed, missedEpochs)
+		ctx.Logger().Debug(fmt.Sprintf("Slashed %s from bond of worker %s in topic %d after %d missed epochs, forfeiting %s of unvested rewards",
+			slashed, bond.Worker, topic.Id, missedEpochs, forfeited))
+	}
+	return nil
+}
+
+// Moves the slashed part of a bond to the topic reward pool and restarts counting missed epochs.
+// A pending removal is reduced so that it does not exceed what is left of the bond
+func (k *Keeper) slashWorkerBond(ctx sdk.Context, bond types.WorkerBond, slashed cosmosMath.Int, livenessBlockHeight BlockHeight) error {
+	remaining := bond.Amount.Sub(slashed)
+	if slashed.IsPositive() {
+		if err := k.subtractWorkerBond(ctx, bond.TopicId, bond.Worker, slashed); err != nil {
+			return err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, slashed))
+		err := k.SendCoinsFromModuleToModule(ctx, types.AlloraStakingAccountName, minttypes.EcosystemModuleName, coins)
+		if err != nil {
+			return err
+		}
+		if err := k.AddTopicFeeRevenue(ctx, bond.TopicId, slashed); err != nil {
+			return err
+		}
+
+		removal, found, err := k.GetWorkerBondRemovalForWorkerAndTopicId(ctx, bond.Worker, bond.TopicId)
+		if err != nil {
+			return err
+		}
+		if found && removal.Amount.GT(remaining) {
+			err = k.DeleteWorkerBondRemoval(ctx, removal.BlockRemovalCompleted, removal.TopicId, removal.Worker)
+			if err != nil {
+				return err
+			}
+			if remaining.IsPositive() {
+				removal.Amount = remaining
+				if err := k.SetWorkerBondRemoval(ctx, removal); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if remaining.IsZero() {
+		return nil
+	}
+	bond.Amount = remaining
+	bond.LivenessBlockHeight = livenessBlockHeight
+	return k.SetWorkerBond(ctx, bond)
+}
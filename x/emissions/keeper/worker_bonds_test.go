@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) mockBondedTopic() types.Topic {
+	topic := s.mockTopic()
+	topic.EpochLength = 100
+	topic.GroundTruthLag = 100
+	topic.WorkerSubmissionWindow = 100
+	topic.WorkerBond = cosmosMath.NewInt(100)
+	topic.WorkerBondSlashMissedEpochs = 2
+	topic.WorkerBondSlashFraction = alloraMath.MustNewDecFromString("0.5")
+	return topic
+}
+
+// bondWorker bonds amount for the worker as the msg server would, funding the staking account
+func (s *KeeperTestSuite) bondWorker(topicId uint64, worker string, amount cosmosMath.Int) {
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+	s.Require().NoError(s.bankKeeper.MintCoins(s.ctx, types.AlloraStakingAccountName, coins))
+	s.Require().NoError(s.emissionsKeeper.AddWorkerBond(s.ctx, topicId, worker, amount))
+}
+
+func (s *KeeperTestSuite) TestAddAndRemoveWorkerBond() {
+	ctx := s.ctx.WithBlockHeight(5)
+	k := s.emissionsKeeper
+	topicId := uint64(1)
+	worker := s.addrsStr[0]
+
+	bond, err := k.GetWorkerBond(ctx, topicId, worker)
+	s.Require().NoError(err)
+	s.Require().True(bond.Amount.IsZero())
+
+	s.Require().NoError(k.AddWorkerBond(ctx, topicId, worker, cosmosMath.NewInt(60)))
+	s.Require().NoError(k.AddWorkerBond(ctx.WithBlockHeight(9), topicId, worker, cosmosMath.NewInt(40)))
+	s.Require().NoError(k.AddWorkerBond(ctx, topicId, s.addrsStr[1], cosmosMath.NewInt(10)))
+
+	bond, err = k.GetWorkerBond(ctx, topicId, worker)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(100), bond.Amount)
+	s.Require().Equal(int64(5), bond.LivenessBlockHeight, "adding to a bond does not reset missed epochs")
+	total, err := k.GetTotalWorkerBonds(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(110), total)
+
+	removal := types.WorkerBondRemovalInfo{
+		BlockRemovalStarted:   5,
+		TopicId:               topicId,
+		Worker:                worker,
+		Amount:                cosmosMath.NewInt(100),
+		BlockRemovalCompleted: 15,
+	}
+	s.Require().NoError(k.SetWorkerBondRemoval(ctx, removal))
+	removals, anyLeft, err := k.GetWorkerBondRemovalsUpUntilBlock(ctx, 14, 10)
+	s.Require().NoError(err)
+	s.Require().False(anyLeft)
+	s.Require().Empty(removals)
+	removals, _, err = k.GetWorkerBondRemovalsUpUntilBlock(ctx, 15, 10)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.WorkerBondRemovalInfo{removal}, removals)
+
+	s.Require().NoError(k.RemoveWorkerBond(ctx, 15, topicId, worker, cosmosMath.NewInt(100)))
+	bond, err = k.GetWorkerBond(ctx, topicId, worker)
+	s.Require().NoError(err)
+	s.Require().True(bond.Amount.IsZero())
+	total, err = k.GetTotalWorkerBonds(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(10), total)
+	_, found, err := k.GetWorkerBondRemovalForWorkerAndTopicId(ctx, worker, topicId)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestSlashIdleWorkerBonds() {
+	s.ctx = s.ctx.WithBlockHeight(5)
+	k := s.emissionsKeeper
+	topic := s.mockBondedTopic()
+	s.Require().NoError(k.SetTopic(s.ctx, topic.Id, topic))
+	idleWorker := s.addrsStr[0]
+	liveWorker := s.addrsStr[1]
+	s.bondWorker(topic.Id, idleWorker, cosmosMath.NewInt(101))
+	s.bondWorker(topic.Id, liveWorker, cosmosMath.NewInt(100))
+	s.Require().NoError(k.SetWorkerBondRemoval(s.ctx, types.WorkerBondRemovalInfo{
+		BlockRemovalStarted:   5,
+		TopicId:               topic.Id,
+		Worker:                idleWorker,
+		Amount:                cosmosMath.NewInt(80),
+		BlockRemovalCompleted: 1000,
+	}))
+
+	// One missed epoch is tolerated
+	topic.EpochLastEnded = 205
+	s.Require().NoError(k.SlashIdleWorkerBonds(s.ctx, topic))
+	bond, err := k.GetWorkerBond(s.ctx, topic.Id, idleWorker)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(101), bond.Amount)
+
+	// The live worker submitted an inference for the last epoch
+	s.Require().NoError(k.SetInfererScoreEma(s.ctx, topic.Id, liveWorker, types.Score{
+		TopicId:     topic.Id,
+		BlockHeight: 205,
+		Address:     liveWorker,
+		Score:       alloraMath.OneDec(),
+	}))
+	topic.EpochLastEnded = 305
+	s.Require().NoError(k.SlashIdleWorkerBonds(s.ctx, topic))
+
+	bond, err = k.GetWorkerBond(s.ctx, topic.Id, idleWorker)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(51), bond.Amount, "half the bond is slashed, rounded in favour of the worker")
+	s.Require().Equal(int64(305), bond.LivenessBlockHeight)
+	bond, err = k.GetWorkerBond(s.ctx, topic.Id, liveWorker)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(100), bond.Amount)
+
+	feeRevenue, err := k.GetTopicFeeRevenue(s.ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(50), feeRevenue)
+	removal, found, err := k.GetWorkerBondRemovalForWorkerAndTopicId(s.ctx, idleWorker, topic.Id)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(cosmosMath.NewInt(51), removal.Amount, "pending removal is capped to the remaining bond")
+
+	// Missed epochs are counted afresh after a slash
+	topic.EpochLastEnded = 405
+	s.Require().NoError(k.SlashIdleWorkerBonds(s.ctx, topic))
+	bond, err = k.GetWorkerBond(s.ctx, topic.Id, idleWorker)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(51), bond.Amount)
+
+	_, broken := keeper.StakingInvariantTotalStakeEqualAlloraStakingBankBalance(k)(s.ctx)
+	s.Require().False(broken)
+}
@@ -19,4 +19,18 @@ const (
 	NAIVE_INFERER_NETWORK_REGRET_EVENT = "naive_inferer_network_regret_event"
 	TOPIC_INITIAL_REGRET_EVENT         = "topic_initial_regret_event"
 	TOPIC_INITIAL_EMA_SCORE_EVENT      = "topic_initial_ema_score_event"
+	TOPIC_UPDATED_EVENT                = "topic_updated_event"
+	TOPIC_ARCHIVED_EVENT               = "topic_archived_event"
+	TOPIC_OWNER_PROPOSED_EVENT         = "topic_owner_proposed_event"
+	TOPIC_OWNER_TRANSFERRED_EVENT      = "topic_owner_transferred_event"
+	OPERATOR_AUTHORIZED_EVENT          = "operator_authorized_event"
+	OPERATOR_REVOKED_EVENT             = "operator_revoked_event"
+	WORKER_BOND_SLASHED_EVENT          = "worker_bond_slashed_event"
+	REPUTER_SLASHED_EVENT              = "reputer_slashed_event"
+	DELEGATE_REWARD_COMPOUNDED_EVENT   = "delegate_reward_compounded_event"
+	REPUTER_COMMISSION_SET_EVENT       = "reputer_commission_set_event"
+	STAKE_REMOVAL_REQUEUED_EVENT       = "stake_removal_requeued_event"
+	WORKER_BOND_REMOVAL_REQUEUED_EVENT = "worker_bond_removal_requeued_event"
+	REWARD_PAYOUT_DEAD_LETTERED_EVENT  = "reward_payout_dead_lettered_event"
+	TOPIC_UPDATE_REJECTED_EVENT        = "topic_update_rejected_event"
 )
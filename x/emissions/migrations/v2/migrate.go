@@ -187,36 +187,43 @@ func MigrateNetworkLossBundles(store storetypes.KVStore, cdc codec.BinaryCodec)
 			OneOutInfererValues:           newOneOutInfererValues,
 			OneOutForecasterValues:        newOneOutForecasterValues,
 			OneInForecasterValues:         newOneInForecastValues,
+			CombinedValues:                nil,
+			NaiveValues:                   nil,
 		}
 
 		for _, inference := range oldMsg.InfererValues {
 			newInfererValues = append(newInfererValues, &types.WorkerAttributedValue{
 				Worker: inference.Worker,
 				Value:  inference.Value,
+				Values: nil,
 			})
 		}
 		for _, forecast := range oldMsg.ForecasterValues {
 			newForecastValues = append(newForecastValues, &types.WorkerAttributedValue{
 				Worker: forecast.Worker,
 				Value:  forecast.Value,
+				Values: nil,
 			})
 		}
 		for _, inference := range oldMsg.OneOutInfererValues {
 			newOneOutInfererValues = append(newOneOutInfererValues, &types.WithheldWorkerAttributedValue{
 				Worker: inference.Worker,
 				Value:  inference.Value,
+				Values: nil,
 			})
 		}
 		for _, forecast := range oldMsg.OneOutForecasterValues {
 			newOneOutForecasterValues = append(newOneOutForecasterValues, &types.WithheldWorkerAttributedValue{
 				Worker: forecast.Worker,
 				Value:  forecast.Value,
+				Values: nil,
 			})
 		}
 		for _, forecast := range oldMsg.OneInForecasterValues {
 			newOneInForecastValues = append(newOneInForecastValues, &types.WorkerAttributedValue{
 				Worker: forecast.Worker,
 				Value:  forecast.Value,
+				Values: nil,
 			})
 		}
 
@@ -270,6 +277,8 @@ func MigrateAllLossBundles(store storetypes.KVStore, cdc codec.BinaryCodec) erro
 						OneOutInfererValues:           valueBundle.ValueBundle.OneOutInfererValues,
 						OneOutForecasterValues:        valueBundle.ValueBundle.OneOutForecasterValues,
 						OneInForecasterValues:         valueBundle.ValueBundle.OneInForecasterValues,
+						CombinedValues:                nil,
+						NaiveValues:                   nil,
 					},
 					Pubkey:    valueBundle.Pubkey,
 					Signature: valueBundle.Signature,
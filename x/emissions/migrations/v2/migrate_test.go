@@ -61,6 +61,7 @@ func (s *EmissionsV2MigrationsTestSuite) SetupTest() {
 		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraRewardEscrowAccountName:              {"minter"},
 		minttypes.EcosystemModuleName:                    nil,
 		"bonded_tokens_pool":                             {"burner", "staking"},
 		"not_bonded_tokens_pool":                         {"burner", "staking"},
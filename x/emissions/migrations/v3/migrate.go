@@ -290,10 +290,22 @@ func getNewTopic(oldMsg oldtypes.Topic) types.Topic {
 		InitialRegret:          alloraMath.MustNewDecFromString("0"),
 		WorkerSubmissionWindow: oldMsg.WorkerSubmissionWindow,
 		// These are new fields
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.25"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.25"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.25"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.25"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.25"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.25"),
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 }
 
@@ -3,6 +3,7 @@ package v4
 import (
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -228,22 +229,34 @@ func ResetMapsWithNonNumericValues(ctx sdk.Context, store storetypes.KVStore, cd
 // copyTopic duplicates a topic into a new struct
 func copyTopic(original emissionstypes.Topic) emissionstypes.Topic {
 	return emissionstypes.Topic{
-		Id:                       original.Id,
-		Creator:                  original.Creator,
-		Metadata:                 original.Metadata,
-		LossMethod:               original.LossMethod,
-		EpochLastEnded:           original.EpochLastEnded,
-		EpochLength:              original.EpochLength,
-		GroundTruthLag:           original.GroundTruthLag,
-		PNorm:                    original.PNorm,
-		AlphaRegret:              original.AlphaRegret,
-		AllowNegative:            original.AllowNegative,
-		Epsilon:                  original.Epsilon,
-		InitialRegret:            original.InitialRegret,
-		WorkerSubmissionWindow:   original.WorkerSubmissionWindow,
-		MeritSortitionAlpha:      original.MeritSortitionAlpha,
-		ActiveInfererQuantile:    original.ActiveInfererQuantile,
-		ActiveForecasterQuantile: original.ActiveForecasterQuantile,
-		ActiveReputerQuantile:    original.ActiveReputerQuantile,
+		Id:                            original.Id,
+		Creator:                       original.Creator,
+		Metadata:                      original.Metadata,
+		LossMethod:                    original.LossMethod,
+		EpochLastEnded:                original.EpochLastEnded,
+		EpochLength:                   original.EpochLength,
+		GroundTruthLag:                original.GroundTruthLag,
+		PNorm:                         original.PNorm,
+		AlphaRegret:                   original.AlphaRegret,
+		AllowNegative:                 original.AllowNegative,
+		Epsilon:                       original.Epsilon,
+		InitialRegret:                 original.InitialRegret,
+		WorkerSubmissionWindow:        original.WorkerSubmissionWindow,
+		MeritSortitionAlpha:           original.MeritSortitionAlpha,
+		ActiveInfererQuantile:         original.ActiveInfererQuantile,
+		ActiveForecasterQuantile:      original.ActiveForecasterQuantile,
+		ActiveReputerQuantile:         original.ActiveReputerQuantile,
+		OutputDimension:               0,
+		VectorSynthesisMode:           emissionstypes.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           emissionstypes.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 }
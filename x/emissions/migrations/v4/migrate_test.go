@@ -1,6 +1,7 @@
 package v4_test
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	"testing"
 
 	alloraMath "github.com/allora-network/allora-chain/math"
@@ -185,23 +186,35 @@ func (s *EmissionsV4MigrationTestSuite) TestMigratedTopicWithNoProblems() {
 	cdc := s.emissionsKeeper.GetBinaryCodec()
 
 	migratedOldTopic := emissionstypes.Topic{
-		Id:                       1,
-		Creator:                  "creator",
-		Metadata:                 "metadata",
-		LossMethod:               "lossMethod",
-		EpochLastEnded:           0,
-		EpochLength:              100,
-		GroundTruthLag:           10,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-		AllowNegative:            false,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		InitialRegret:            alloraMath.MustNewDecFromString("11"),
-		WorkerSubmissionWindow:   120,
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.1337"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.1337"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.1337"),
+		Id:                            1,
+		Creator:                       "creator",
+		Metadata:                      "metadata",
+		LossMethod:                    "lossMethod",
+		EpochLastEnded:                0,
+		EpochLength:                   100,
+		GroundTruthLag:                10,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		AllowNegative:                 false,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		InitialRegret:                 alloraMath.MustNewDecFromString("11"),
+		WorkerSubmissionWindow:        120,
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.1337"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.1337"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.1337"),
+		OutputDimension:               0,
+		VectorSynthesisMode:           emissionstypes.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           emissionstypes.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 
 	bz, err := proto.Marshal(&migratedOldTopic)
@@ -259,23 +272,35 @@ func (s *EmissionsV4MigrationTestSuite) TestMigratedTopicWithNaNInitialRegret()
 	cdc := s.emissionsKeeper.GetBinaryCodec()
 
 	migratedOldTopicWithNaNInitialRegret := emissionstypes.Topic{
-		Id:                       1,
-		Creator:                  "creator",
-		Metadata:                 "metadata",
-		LossMethod:               "lossMethod",
-		EpochLastEnded:           0,
-		EpochLength:              100,
-		GroundTruthLag:           10,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-		AllowNegative:            false,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		InitialRegret:            alloraMath.NewNaN(), // OBSERVE: NAN FOR INITIAL REGRET
-		WorkerSubmissionWindow:   120,
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.1337"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.1337"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.1337"),
+		Id:                            1,
+		Creator:                       "creator",
+		Metadata:                      "metadata",
+		LossMethod:                    "lossMethod",
+		EpochLastEnded:                0,
+		EpochLength:                   100,
+		GroundTruthLag:                10,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		AllowNegative:                 false,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		InitialRegret:                 alloraMath.NewNaN(), // OBSERVE: NAN FOR INITIAL REGRET
+		WorkerSubmissionWindow:        120,
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.1337"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.1337"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.1337"),
+		OutputDimension:               0,
+		VectorSynthesisMode:           emissionstypes.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           emissionstypes.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 
 	bz, err := proto.Marshal(&migratedOldTopicWithNaNInitialRegret)
@@ -589,12 +614,14 @@ func getBundle() emissionstypes.ValueBundle {
 			{
 				Worker: "inferer",
 				Value:  alloraMath.NewDecFromInt64(10),
+				Values: nil,
 			},
 		},
 		ForecasterValues: []*emissionstypes.WorkerAttributedValue{
 			{
 				Worker: "forecaster",
 				Value:  alloraMath.NewDecFromInt64(10),
+				Values: nil,
 			},
 		},
 		NaiveValue: alloraMath.NewDecFromInt64(10),
@@ -602,12 +629,14 @@ func getBundle() emissionstypes.ValueBundle {
 			{
 				Worker: "oneOutInferer",
 				Value:  alloraMath.NewDecFromInt64(10),
+				Values: nil,
 			},
 		},
 		OneOutForecasterValues: []*emissionstypes.WithheldWorkerAttributedValue{
 			{
 				Worker: "oneOutForecaster",
 				Value:  alloraMath.NewDecFromInt64(10),
+				Values: nil,
 			},
 		},
 		OneInForecasterValues: nil,
@@ -618,10 +647,13 @@ func getBundle() emissionstypes.ValueBundle {
 					{
 						Worker: "oneOutInferer",
 						Value:  alloraMath.NewDecFromInt64(10),
+						Values: nil,
 					},
 				},
 			},
 		},
+		CombinedValues: nil,
+		NaiveValues:    nil,
 	}
 }
 
@@ -710,6 +742,7 @@ func testTimeStampedValueMapDeletion(
 	timeStampedValue := emissionstypes.TimestampedValue{
 		Value:       alloraMath.NewDecFromInt64(10),
 		BlockHeight: 1,
+		Values:      nil,
 	}
 
 	bz, err := proto.Marshal(&timeStampedValue)
@@ -1,6 +1,7 @@
 package v5_test
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	"testing"
 
 	alloraMath "github.com/allora-network/allora-chain/math"
@@ -191,23 +192,35 @@ func (s *EmissionsV5MigrationTestSuite) TestMigratedTopicWithNaNInitialRegret()
 	_, err := s.emissionsKeeper.IncrementTopicId(s.ctx)
 	s.Require().NoError(err)
 	migratedOldTopicWithNaNInitialRegret := emissionstypes.Topic{
-		Id:                       1,
-		Creator:                  "creator",
-		Metadata:                 "metadata",
-		LossMethod:               "lossMethod",
-		EpochLastEnded:           0,
-		EpochLength:              100,
-		GroundTruthLag:           10,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-		AllowNegative:            true,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		InitialRegret:            alloraMath.NewNaN(), // OBSERVE: NAN FOR INITIAL REGRET
-		WorkerSubmissionWindow:   120,
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.1337"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.1337"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.1337"),
+		Id:                            1,
+		Creator:                       "creator",
+		Metadata:                      "metadata",
+		LossMethod:                    "lossMethod",
+		EpochLastEnded:                0,
+		EpochLength:                   100,
+		GroundTruthLag:                10,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		AllowNegative:                 true,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		InitialRegret:                 alloraMath.NewNaN(), // OBSERVE: NAN FOR INITIAL REGRET
+		WorkerSubmissionWindow:        120,
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.1337"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.1337"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.1337"),
+		OutputDimension:               0,
+		VectorSynthesisMode:           emissionstypes.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           emissionstypes.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 
 	_, err = s.emissionsKeeper.IncrementTopicId(s.ctx)
@@ -266,23 +279,35 @@ func (s *EmissionsV5MigrationTestSuite) TestMigratedSumTotalPreviousTopicWeights
 	_, err := s.emissionsKeeper.IncrementTopicId(s.ctx)
 	s.Require().NoError(err)
 	migratedOldTopic1 := emissionstypes.Topic{
-		Id:                       1,
-		Creator:                  "creator",
-		Metadata:                 "metadata",
-		LossMethod:               "lossMethod",
-		EpochLastEnded:           0,
-		EpochLength:              100,
-		GroundTruthLag:           10,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-		AllowNegative:            true,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		InitialRegret:            alloraMath.MustNewDecFromString("0"),
-		WorkerSubmissionWindow:   120,
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.1337"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.1337"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.1337"),
+		Id:                            1,
+		Creator:                       "creator",
+		Metadata:                      "metadata",
+		LossMethod:                    "lossMethod",
+		EpochLastEnded:                0,
+		EpochLength:                   100,
+		GroundTruthLag:                10,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		AllowNegative:                 true,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		InitialRegret:                 alloraMath.MustNewDecFromString("0"),
+		WorkerSubmissionWindow:        120,
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.1337"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.1337"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.1337"),
+		OutputDimension:               0,
+		VectorSynthesisMode:           emissionstypes.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           emissionstypes.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	migratedOldTopic2 := migratedOldTopic1
 	migratedOldTopic2.Id = 2
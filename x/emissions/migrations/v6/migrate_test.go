@@ -1,6 +1,7 @@
 package v6_test
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	"testing"
 
 	alloraMath "github.com/allora-network/allora-chain/math"
@@ -193,23 +194,35 @@ func (s *EmissionsV6MigrationTestSuite) TestFlipOnTopicWhitelists() {
 		s.Require().NoError(err)
 
 		topic := emissionstypes.Topic{
-			Id:                       i,
-			Creator:                  "allo1qgqeu0twe5t6gr30k3e3sumaqs5a29ug5um8lr",
-			Metadata:                 "metadata",
-			LossMethod:               "mse",
-			EpochLastEnded:           0,
-			EpochLength:              1000,
-			GroundTruthLag:           1000,
-			PNorm:                    alloraMath.NewDecFromInt64(3),
-			AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-			AllowNegative:            false,
-			Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-			InitialRegret:            alloraMath.ZeroDec(),
-			WorkerSubmissionWindow:   120,
-			MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-			ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.1337"),
-			ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.1337"),
-			ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.1337"),
+			Id:                            i,
+			Creator:                       "allo1qgqeu0twe5t6gr30k3e3sumaqs5a29ug5um8lr",
+			Metadata:                      "metadata",
+			LossMethod:                    "mse",
+			EpochLastEnded:                0,
+			EpochLength:                   1000,
+			GroundTruthLag:                1000,
+			PNorm:                         alloraMath.NewDecFromInt64(3),
+			AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+			AllowNegative:                 false,
+			Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+			InitialRegret:                 alloraMath.ZeroDec(),
+			WorkerSubmissionWindow:        120,
+			MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+			ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.1337"),
+			ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.1337"),
+			ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.1337"),
+			OutputDimension:               0,
+			VectorSynthesisMode:           emissionstypes.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+			QuantileLevels:                nil,
+			LossComputationMode:           emissionstypes.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+			WorkerRevealWindow:            0,
+			WorkerBond:                    cosmosMath.ZeroInt(),
+			WorkerBondSlashMissedEpochs:   0,
+			WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+			ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+			ReputerSlashEpochs:            0,
+			ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+			ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 		}
 
 		err = s.emissionsKeeper.SetTopic(s.ctx, i, topic)
@@ -18,6 +18,8 @@ import (
 // It does the following:
 // - Migrate params to add and set GlobalWhitelistEnabled, TopicCreatorWhitelistEnabled
 // - Iterates through all topics to turn on their respective worker and reputer whitelists
+// - Builds the secondary topic indexes used to list topics
+// - Builds the index of delegate stake placements by delegator
 func MigrateStore(ctx sdk.Context, emissionsKeeper keeper.Keeper) error {
 	ctx.Logger().Info("STARTING EMISSIONS MODULE MIGRATION FROM VERSION 6 TO VERSION 7")
 	ctx.Logger().Info("MIGRATING STORE FROM VERSION 6 TO VERSION 7")
@@ -32,6 +34,18 @@ func MigrateStore(ctx sdk.Context, emissionsKeeper keeper.Keeper) error {
 		return err
 	}
 
+	ctx.Logger().Info("INDEXING TOPICS FOR VERSION 7")
+	if err := emissionsKeeper.IndexAllTopics(ctx); err != nil {
+		ctx.Logger().Error("ERROR INVOKING MIGRATION HANDLER IndexAllTopics() FROM VERSION 6 TO VERSION 7")
+		return err
+	}
+
+	ctx.Logger().Info("INDEXING DELEGATE STAKE PLACEMENTS FOR VERSION 7")
+	if err := emissionsKeeper.IndexAllDelegateStakePlacements(ctx); err != nil {
+		ctx.Logger().Error("ERROR INVOKING MIGRATION HANDLER IndexAllDelegateStakePlacements() FROM VERSION 6 TO VERSION 7")
+		return err
+	}
+
 	ctx.Logger().Info("MIGRATING EMISSIONS MODULE FROM VERSION 6 TO VERSION 7 COMPLETE")
 	return nil
 }
@@ -115,6 +129,16 @@ func MigrateParams(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCo
 		GlobalReputerWhitelistEnabled:      defaultParams.GlobalReputerWhitelistEnabled,
 		GlobalAdminWhitelistAppended:       defaultParams.GlobalAdminWhitelistAppended,
 		MaxWhitelistInputArrayLength:       defaultParams.MaxWhitelistInputArrayLength,
+		MaxArchivedTopicPrunesPerBlock:     defaultParams.MaxArchivedTopicPrunesPerBlock,
+		MaxReputerStakeShare:               defaultParams.MaxReputerStakeShare,
+		StakeAboveCapExponent:              defaultParams.StakeAboveCapExponent,
+		StakeLockTiers:                     defaultParams.StakeLockTiers,
+		RewardHistoryRetentionBlocks:       defaultParams.RewardHistoryRetentionBlocks,
+		MaxRewardPayoutsPerBlock:           defaultParams.MaxRewardPayoutsPerBlock,
+		MaxRewardHistoryPrunesPerBlock:     defaultParams.MaxRewardHistoryPrunesPerBlock,
+		MaxReputerCommissionRate:           defaultParams.MaxReputerCommissionRate,
+		MaxReputerCommissionChangeRate:     defaultParams.MaxReputerCommissionChangeRate,
+		MaxRewardPayoutAttempts:            defaultParams.MaxRewardPayoutAttempts,
 	}
 
 	ctx.Logger().Info(fmt.Sprintf("MIGRATED PARAMS: %+v", newParams))
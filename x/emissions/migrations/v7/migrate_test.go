@@ -189,4 +189,18 @@ func (s *EmissionsV6MigrationTestSuite) TestMigrateParams() {
 	s.Require().True(paramsExpected.GlobalReputerWhitelistEnabled)
 	s.Require().True(paramsExpected.GlobalAdminWhitelistAppended)
 	s.Require().Equal(paramsExpected.MaxWhitelistInputArrayLength, params.MaxWhitelistInputArrayLength)
+	s.Require().Equal(paramsExpected.MaxArchivedTopicPrunesPerBlock, params.MaxArchivedTopicPrunesPerBlock)
+	s.Require().True(paramsExpected.MaxReputerStakeShare.Equal(params.MaxReputerStakeShare))
+	s.Require().True(paramsExpected.StakeAboveCapExponent.Equal(params.StakeAboveCapExponent))
+	s.Require().Len(params.StakeLockTiers, len(paramsExpected.StakeLockTiers))
+	for i, tier := range paramsExpected.StakeLockTiers {
+		s.Require().Equal(tier.LockMonths, params.StakeLockTiers[i].LockMonths)
+		s.Require().True(tier.RewardMultiplier.Equal(params.StakeLockTiers[i].RewardMultiplier))
+	}
+	s.Require().Equal(paramsExpected.RewardHistoryRetentionBlocks, params.RewardHistoryRetentionBlocks)
+	s.Require().Equal(paramsExpected.MaxRewardPayoutsPerBlock, params.MaxRewardPayoutsPerBlock)
+	s.Require().Equal(paramsExpected.MaxRewardHistoryPrunesPerBlock, params.MaxRewardHistoryPrunesPerBlock)
+	s.Require().Equal(paramsExpected.MaxReputerCommissionRate, params.MaxReputerCommissionRate)
+	s.Require().Equal(paramsExpected.MaxReputerCommissionChangeRate, params.MaxReputerCommissionChangeRate)
+	s.Require().Equal(paramsExpected.MaxRewardPayoutAttempts, params.MaxRewardPayoutAttempts)
 }
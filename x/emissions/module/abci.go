@@ -35,6 +35,18 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 	if err != nil {
 		sdkCtx.Logger().Error("Error removing delegate stakes: ", err)
 	}
+	err = RemoveWorkerBonds(sdkCtx, blockHeight, am.keeper, moduleParams.HalfMaxProcessStakeRemovalsEndBlock)
+	if err != nil {
+		sdkCtx.Logger().Error("Error removing worker bonds: ", err)
+	}
+	err = CompleteRedelegations(sdkCtx, blockHeight, am.keeper, moduleParams.HalfMaxProcessStakeRemovalsEndBlock)
+	if err != nil {
+		sdkCtx.Logger().Error("Error completing redelegations: ", err)
+	}
+	err = UnlockStakeLocks(sdkCtx, blockHeight, am.keeper, moduleParams.HalfMaxProcessStakeRemovalsEndBlock)
+	if err != nil {
+		sdkCtx.Logger().Error("Error unlocking stake locks: ", err)
+	}
 
 	// Get unnormalized weights of active topics and the sum weight and revenue they have generated
 	weights, sumWeight, totalRevenue, err := rewards.GetAndUpdateActiveTopicWeights(sdkCtx, am.keeper, blockHeight)
@@ -69,6 +81,14 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 		sdkCtx.Logger().Error("Error calculating global emission per topic: ", err)
 		return errors.Wrapf(err, "Rewards error")
 	}
+	// Pay out a bounded number of queued rewards, oldest first
+	cacheSdkCtx, write := sdkCtx.CacheContext()
+	err = rewards.PayOutQueuedRewards(cacheSdkCtx, am.keeper, moduleParams.MaxRewardPayoutsPerBlock)
+	if err != nil {
+		sdkCtx.Logger().Error("Error paying out queued rewards: ", err)
+	} else {
+		write()
+	}
 	// Close any open windows due this blockHeight
 	workerWindowsToClose := am.keeper.GetWorkerWindowTopicIds(sdkCtx, blockHeight)
 	if len(workerWindowsToClose.TopicIds) > 0 {
@@ -89,9 +109,10 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 					continue
 				}
 				for _, nonce := range nonces.Nonces {
-					// Skip rest of logic if the worker submission window is still open (i.e. don't close the window yet)
-					// Note: worker window exclusive of the end block height so we ensure the window is closed at this point.
-					if am.keeper.BlockWithinWorkerSubmissionWindowOfNonce(topic, *nonce, blockHeight) {
+					// Skip rest of logic if the worker submission or reveal window is still open (i.e. don't close the window yet)
+					// Note: worker windows exclusive of the end block height so we ensure the window is closed at this point.
+					if am.keeper.BlockWithinWorkerSubmissionWindowOfNonce(topic, *nonce, blockHeight) ||
+						am.keeper.BlockWithinWorkerRevealWindowOfNonce(topic, *nonce, blockHeight) {
 						sdkCtx.Logger().Debug(fmt.Sprintf("ABCI EndBlocker %d: Worker window still open for topic: %d, nonce: %v", blockHeight, topicId, nonce))
 						continue
 					}
@@ -107,6 +128,14 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 							sdkCtx.Logger().Debug(fmt.Sprintf("Fulfilled: %t, worker nonce: %v", fulfilledNonce, nonce))
 						}
 					}
+					// Slash idle workers even when no payload could be accepted for the nonce
+					cacheSdkCtx, write := sdkCtx.CacheContext()
+					err = am.keeper.SlashIdleWorkerBonds(cacheSdkCtx, topic)
+					if err != nil {
+						sdkCtx.Logger().Warn(fmt.Sprintf("Error slashing idle worker bonds: %s", err.Error()))
+					} else {
+						write()
+					}
 				}
 			}
 		}
@@ -115,5 +144,11 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 			sdkCtx.Logger().Warn(fmt.Sprintf("Error deleting worker window blockheight: %s", err.Error()))
 		}
 	}
+
+	// Remove a bounded amount of the state left behind by archived topics
+	err = am.keeper.PruneArchivedTopics(sdkCtx, moduleParams.MaxArchivedTopicPrunesPerBlock)
+	if err != nil {
+		sdkCtx.Logger().Warn(fmt.Sprintf("Error pruning archived topics: %s", err.Error()))
+	}
 	return nil
 }
@@ -45,6 +45,35 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "topic_id"},
 					},
 				},
+				{
+					RpcMethod: "IsTopicArchived",
+					Use:       "is-topic-archived [topic_id]",
+					Short:     "True if topic is archived, else false",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicOwner",
+					Use:       "topic-owner [topic_id]",
+					Short:     "Get the current owner of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetPendingTopicOwner",
+					Use:       "pending-topic-owner [topic_id]",
+					Short:     "Get the proposed new owner of a topic, if any",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "ListTopics",
+					Use:       "list-topics",
+					Short:     "List topics, optionally filtered by owner, activity, loss method, epoch length range and tags",
+				},
 				{
 					RpcMethod: "GetReputerStakeInTopic",
 					Use:       "stake-in-topic-reputer [address] [topic_id]",
@@ -93,6 +122,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "reputer"},
 					},
 				},
+				{
+					RpcMethod: "GetWorkerBond",
+					Use:       "worker-bond [topic_id] [worker]",
+					Short:     "Get the amount bonded by a worker on a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "worker"},
+					},
+				},
+				{
+					RpcMethod: "GetWorkerBondRemoval",
+					Use:       "worker-bond-removal [topic_id] [worker]",
+					Short:     "Get current state of a pending worker bond removal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "worker"},
+					},
+				},
 				{
 					RpcMethod: "GetDelegateStakeUponReputer",
 					Use:       "delegate-stake-on-reputer [topic_id] [target]",
@@ -372,6 +419,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "topic_id"},
 					},
 				},
+				{
+					RpcMethod: "GetTopicWeightBreakdown",
+					Use:       "topic-weight-breakdown [topic_id]",
+					Short:     "Explain the weight of a topic from its stake and fee revenue terms, and whether it would be inactivated or skimmed out at its next churning block",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
 				{
 					RpcMethod:      "GetTotalSumPreviousTopicWeights",
 					Use:            "previous-total-topic-weights",
@@ -514,6 +569,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "worker_address"},
 					},
 				},
+				{
+					RpcMethod: "GetWorkerPayloadCommit",
+					Use:       "worker-payload-commit [topic_id] [worker_address]",
+					Short:     "Get the payload commitment of a worker for the open nonce of a topic using commit-reveal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "worker_address"},
+					},
+				},
 				{
 					RpcMethod: "GetInferencesAtBlock",
 					Use:       "inferences-at-block [topic_id] [block_height]",
@@ -523,6 +587,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "block_height"},
 					},
 				},
+				{
+					RpcMethod: "GetActorOperators",
+					Use:       "actor-operators [topic_id] [actor]",
+					Short:     "Get the operator keys an actor has authorized to submit payloads on its behalf in a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "actor"},
+					},
+				},
 				{
 					RpcMethod: "GetWorkerNodeInfo",
 					Use:       "worker-info [address]",
@@ -614,6 +687,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "block_height"},
 					},
 				},
+				{
+					RpcMethod: "GetConsensusGroundTruthAtBlock",
+					Use:       "consensus-ground-truth-at-block [topic_id] [block]",
+					Short:     "Get the consensus ground truth that the chain computed the losses of a topic from at given block height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "block_height"},
+					},
+				},
+				{
+					RpcMethod: "GetReputerGroundTruth",
+					Use:       "reputer-ground-truth [topic_id] [reputer]",
+					Short:     "Get the ground truth submitted by a reputer for the open nonce of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "reputer"},
+					},
+				},
 				{
 					RpcMethod: "IsWhitelistAdmin",
 					Use:       "is-whitelist-admin [address]",
@@ -657,6 +748,101 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "topic_id"},
 					},
 				},
+				{
+					RpcMethod: "IsAutoCompoundEnabled",
+					Use:       "is-auto-compound-enabled [topic_id] [address]",
+					Short:     "True if [address] restakes its delegator rewards on a topic as they are paid out",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "GetReputerCommission",
+					Use:       "reputer-commission [topic_id] [reputer]",
+					Short:     "Get the commission [reputer] takes on the rewards of its delegators in a topic, with its bounds",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "reputer"},
+					},
+				},
+				{
+					RpcMethod: "GetStakeLocks",
+					Use:       "stake-locks [topic_id] [reputer]",
+					Short:     "Get the stake locked upon [reputer] in a topic by the reputer and its delegators, with the block each lock ends",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "reputer"},
+					},
+				},
+				{
+					RpcMethod: "SimulateTopicRewards",
+					Use:       "simulate-topic-rewards [topic_id] [block_height] [hypothetical_topic_reward]",
+					Short:     "Simulate the rewards of a topic epoch for the reputer nonce at [block_height] paying out [hypothetical_topic_reward], without keeping any state",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "block_height"},
+						{ProtoField: "hypothetical_topic_reward"},
+					},
+				},
+				{
+					RpcMethod: "GetActorRewardHistory",
+					Use:       "actor-reward-history [address]",
+					Short:     "Get the rewards paid out to [address] still in the reward history, optionally of one topic and within a block range, one page at a time",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicRewardHistory",
+					Use:       "topic-reward-history [topic_id]",
+					Short:     "Get the rewards paid out in a topic still in the reward history, one page at a time",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetRewardEscrows",
+					Use:       "reward-escrows [address]",
+					Short:     "Get the rewards of [address] held in escrow, optionally of one topic, with how much has vested and can be claimed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "GetQueuedRewardPayouts",
+					Use:       "queued-reward-payouts",
+					Short:     "Get the rewards waiting to be paid out, in payout order, optionally of one address, one page at a time",
+				},
+				{
+					RpcMethod: "GetDeadLetteredRewardPayouts",
+					Use:       "dead-lettered-reward-payouts",
+					Short:     "Get the rewards dropped from the payout queue after failing to be paid out max-reward-payout-attempts times, optionally of one address, one page at a time",
+				},
+				{
+					RpcMethod: "GetPendingDelegateRewards",
+					Use:       "pending-delegate-rewards [delegator]",
+					Short:     "Get the rewards [delegator] can claim from each of its delegate stake placements, one page at a time",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator"},
+					},
+				},
+				{
+					RpcMethod: "GetDelegatorPositions",
+					Use:       "delegator-positions [delegator]",
+					Short:     "Get the delegate stake placements of [delegator] across all topics, with their pending rewards and removals, one page at a time",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator"},
+					},
+				},
+				{
+					RpcMethod: "GetPendingStakeRemovalsForActor",
+					Use:       "pending-stake-removals [address]",
+					Short:     "Get the pending removals of stake [address] placed as a reputer and delegated, across all topics, one page at a time",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
 				{
 					RpcMethod: "GetTopicLastWorkerCommitInfo",
 					Use:       "topic-last-worker-commit [topic_id]",
@@ -924,7 +1110,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod: "CreateNewTopic",
-					Use:       "create-topic [creator] [metadata] [loss_method] [epoch_length] [ground_truth_lag] [worker_submission_window] [p_norm] [alpha_regret] [allow_negative] [epsilon] [merit_sortition_alpha] [active_inferer_quantile] [active_forecaster_quantile] [active_reputer_quantile] [enable_worker_whitelist] [enable_reputer_whitelist]",
+					Use:       "create-topic [creator] [metadata] [loss_method] [epoch_length] [ground_truth_lag] [worker_submission_window] [p_norm] [alpha_regret] [allow_negative] [epsilon] [merit_sortition_alpha] [active_inferer_quantile] [active_forecaster_quantile] [active_reputer_quantile] [enable_worker_whitelist] [enable_reputer_whitelist] [tags...]",
 					Short:     "Add a new topic to the network",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "creator"},
@@ -943,6 +1129,45 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "active_reputer_quantile"},
 						{ProtoField: "enable_worker_whitelist"},
 						{ProtoField: "enable_reputer_whitelist"},
+						{ProtoField: "tags", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "UpdateTopic",
+					Use:       "update-topic [sender] [topic_id] [params]",
+					Short:     "Update params of a topic, applied at the topic's next epoch boundary",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "params"},
+					},
+				},
+				{
+					RpcMethod: "ArchiveTopic",
+					Use:       "archive-topic [sender] [topic_id]",
+					Short:     "Archive a topic, refunding its fee revenue, unstaking all stake on it and pruning its state",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "ProposeTopicOwner",
+					Use:       "propose-topic-owner [sender] [topic_id] [new_owner]",
+					Short:     "Propose a new owner for a topic. The new owner must accept before ownership moves",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "new_owner"},
+					},
+				},
+				{
+					RpcMethod: "AcceptTopicOwnership",
+					Use:       "accept-topic-ownership [sender] [topic_id]",
+					Short:     "Accept a pending ownership transfer of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
 					},
 				},
 				{
@@ -966,10 +1191,41 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "is_reputer"},
 					},
 				},
+				{
+					RpcMethod: "AuthorizeOperator",
+					Use:       "authorize-operator [sender] [topic_id] [operator]",
+					Short:     "Authorize an operator key to submit payloads on behalf of the sender in a topic. Rewards still go to the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "operator"},
+					},
+				},
+				{
+					RpcMethod: "RevokeOperator",
+					Use:       "revoke-operator [sender] [topic_id] [operator]",
+					Short:     "Revoke an operator key of the sender in a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "operator"},
+					},
+				},
+				{
+					RpcMethod: "RotateOperator",
+					Use:       "rotate-operator [sender] [topic_id] [old_operator] [new_operator]",
+					Short:     "Replace an operator key of the sender in a topic with a new one",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "old_operator"},
+						{ProtoField: "new_operator"},
+					},
+				},
 				{
 					RpcMethod: "AddStake",
 					Use:       "add-stake [sender] [topic_id] [amount]",
-					Short:     "Add stake [amount] to ones self sender [reputer or worker] for a topic",
+					Short:     "Add stake [amount] to ones self sender [reputer or worker] for a topic, optionally locked for the months of a stake lock tier with --lock-months",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "sender"},
 						{ProtoField: "topic_id"},
@@ -995,10 +1251,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "topic_id"},
 					},
 				},
+				{
+					RpcMethod: "AmendRemoveStake",
+					Use:       "amend-remove-stake [sender] [topic_id] [amount]",
+					Short:     "Set a new total [amount] of the pending stake removal of a reputer in a topic. Growing the removal restarts the removal delay",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "MoveStake",
+					Use:       "move-stake [sender] [from_topic_id] [to_topic_id] [amount]",
+					Short:     "Move [amount] of stake of reputer [sender] from one topic to another without waiting out the removal delay",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "from_topic_id"},
+						{ProtoField: "to_topic_id"},
+						{ProtoField: "amount"},
+					},
+				},
 				{
 					RpcMethod: "DelegateStake",
 					Use:       "delegate-stake [sender] [topic_id] [reputer] [amount]",
-					Short:     "Delegate stake [amount] to a reputer for a topic",
+					Short:     "Delegate stake [amount] to a reputer for a topic, optionally locked for the months of a stake lock tier with --lock-months",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "sender"},
 						{ProtoField: "topic_id"},
@@ -1027,6 +1304,97 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "reputer"},
 					},
 				},
+				{
+					RpcMethod: "AmendRemoveDelegateStake",
+					Use:       "amend-remove-delegate-stake [sender] [topic_id] [reputer] [amount]",
+					Short:     "Set a new total [amount] of the pending removal of stake delegated upon a reputer in a topic. Growing the removal restarts the removal delay",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "reputer"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "RedelegateStake",
+					Use:       "redelegate-stake [sender] [topic_id] [src_reputer] [dst_reputer] [amount]",
+					Short:     "Move [amount] of stake delegated by [sender] from one reputer of a topic to another without waiting out the removal delay",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "src_reputer"},
+						{ProtoField: "dst_reputer"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound [sender] [topic_id] [enabled]",
+					Short:     "Opt in or out of restaking the delegator rewards of [sender] on a topic as they are paid out",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "enabled"},
+					},
+				},
+				{
+					RpcMethod: "SetReputerCommission",
+					Use:       "set-reputer-commission [sender] [topic_id] [rate]",
+					Short:     "Set the commission reputer [sender] takes on the rewards of its delegators in a topic, changing at most once per epoch by the max reputer commission change rate",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "rate"},
+					},
+				},
+				{
+					RpcMethod: "ClaimVestedRewards",
+					Use:       "claim-vested-rewards [sender] [topic_id]",
+					Short:     "Claim the rewards of [sender] held in escrow on a topic that have vested so far",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "ForfeitUnvestedRewards",
+					Use:       "forfeit-unvested-rewards [sender] [topic_id] [address]",
+					Short:     "Forfeit the unvested rewards of [address] held in escrow on a topic to the topic reward pool. Only whitelist admins can forfeit rewards",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "AddWorkerBond",
+					Use:       "add-worker-bond [sender] [topic_id] [amount]",
+					Short:     "Bond [amount] for worker [sender] on a topic that requires workers to bond",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "RemoveWorkerBond",
+					Use:       "remove-worker-bond [sender] [topic_id] [amount]",
+					Short:     "Start removing [amount] from the bond of worker [sender] on a topic, after a delay",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "CancelRemoveWorkerBond",
+					Use:       "cancel-remove-worker-bond [sender] [topic_id]",
+					Short:     "Cancel the pending removal of a worker bond on a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
 				{
 					RpcMethod: "RewardDelegateStake",
 					Use:       "reward-delegate-stake [sender] [topic_id] [reputer]",
@@ -1037,6 +1405,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "reputer"},
 					},
 				},
+				{
+					RpcMethod: "ClaimAllDelegateRewards",
+					Use:       "claim-all-delegate-rewards [sender]",
+					Short:     "Claim the rewards of a page of the delegate stake placements of [sender] across all topics and reputers",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+					},
+				},
 				{
 					RpcMethod: "FundTopic",
 					Use:       "fund-topic [sender] [topic_id] [amount] [extra_data]",
@@ -1074,6 +1450,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "worker_data_bundle"},
 					},
 				},
+				{
+					RpcMethod: "CommitWorkerPayload",
+					Use:       "commit-worker-payload [sender] [topic_id] [nonce] [commitment]",
+					Short:     "Commit to a worker payload for a topic using commit-reveal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "nonce"},
+						{ProtoField: "commitment"},
+					},
+				},
+				{
+					RpcMethod: "RevealWorkerPayload",
+					Use:       "reveal-worker-payload [sender] [worker_data] [salt]",
+					Short:     "Reveal a worker payload committed to during the worker submission window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "worker_data_bundle"},
+						{ProtoField: "salt"},
+					},
+				},
 				{
 					RpcMethod: "InsertReputerPayload",
 					Use:       "insert-reputer-payload [sender] [reputer_data]",
@@ -1083,6 +1480,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "reputer_value_bundle"},
 					},
 				},
+				{
+					RpcMethod: "InsertReputerGroundTruth",
+					Use:       "insert-reputer-ground-truth [sender] [topic_id] [reputer_request_nonce] [values...]",
+					Short:     "Insert the ground truth of a nonce for a topic whose losses are computed by the chain",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "reputer_request_nonce"},
+						{ProtoField: "values", Varargs: true},
+					},
+				},
 				{
 					RpcMethod: "AddToGlobalWhitelist",
 					Use:       "add-to-global-whitelist [sender] [address]",
@@ -0,0 +1,556 @@
+package rewards_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/log"
+	cosmosMath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	inferencesynthesis "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	"github.com/allora-network/allora-chain/x/emissions/keeper/msgserver"
+	"github.com/allora-network/allora-chain/x/emissions/module"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	codecAddress "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	auth "github.com/cosmos/cosmos-sdk/x/auth"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (s *RewardsTestSuite) TestPayOutQueuedRewardsWithinBudget() {
+	k := s.emissionsKeeper
+	workerIndexes := s.returnIndexes(5, 3)
+	topicId := s.setUpTopic(1, workerIndexes, s.returnIndexes(0, 2), cosmosMath.NewInt(1000), alloraMath.MustNewDecFromString("0.1"))
+
+	balances := make([]cosmosMath.Int, len(workerIndexes))
+	for i, index := range workerIndexes {
+		balances[i] = s.bankKeeper.GetBalance(s.ctx, s.addrs[index], params.DefaultBondDenom).Amount
+		amount := cosmosMath.NewInt(int64(10 * (i + 1)))
+		s.Require().NoError(k.QueueRewardPayout(s.ctx, topicId, s.addrsStr[index], types.WorkerInferenceRewardType, amount))
+	}
+
+	// Only the first two rewards queued are paid out
+	s.Require().NoError(rewards.PayOutQueuedRewards(s.ctx, k, 2))
+	for i, index := range workerIndexes {
+		paid := s.bankKeeper.GetBalance(s.ctx, s.addrs[index], params.DefaultBondDenom).Amount.Sub(balances[i])
+		if i < 2 {
+			s.Require().Equal(cosmosMath.NewInt(int64(10*(i+1))), paid)
+		} else {
+			s.Require().True(paid.IsZero())
+		}
+	}
+	total, err := k.GetTotalQueuedRewardPayouts(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(30), total)
+
+	// The rest is paid out in a later block
+	s.Require().NoError(rewards.PayOutQueuedRewards(s.ctx, k, 2))
+	paid := s.bankKeeper.GetBalance(s.ctx, s.addrs[workerIndexes[2]], params.DefaultBondDenom).Amount.Sub(balances[2])
+	s.Require().Equal(cosmosMath.NewInt(30), paid)
+	payouts, err := k.GetQueuedRewardPayouts(s.ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Empty(payouts)
+	total, err = k.GetTotalQueuedRewardPayouts(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(total.IsZero())
+
+	// Paid out rewards are recorded when they are paid out
+	records, _, err := k.GetTopicRewardHistory(s.ctx, topicId, nil)
+	s.Require().NoError(err)
+	s.Require().Len(records, len(workerIndexes))
+}
+
+func (s *RewardsTestSuite) TestPayOutQueuedReputerRewardsOverStakeShareCap() {
+	k := s.emissionsKeeper
+	reputerIndexes := s.returnIndexes(0, 2)
+	topicId := s.setUpTopic(1, s.returnIndexes(5, 1), reputerIndexes, cosmosMath.NewInt(1000), alloraMath.MustNewDecFromString("0.1"))
+	moduleParams, err := k.GetParams(s.ctx)
+	s.Require().NoError(err)
+	moduleParams.MaxReputerStakeShare = alloraMath.MustNewDecFromString("0.52")
+	moduleParams.RequiredMinimumStake = cosmosMath.NewInt(1)
+	s.Require().NoError(k.SetParams(s.ctx, moduleParams))
+
+	balances := make([]cosmosMath.Int, len(reputerIndexes))
+	for i, index := range reputerIndexes {
+		balances[i] = s.bankKeeper.GetBalance(s.ctx, s.addrs[index], params.DefaultBondDenom).Amount
+	}
+	// Restaking 100 would give the first reputer 1100 of 2100, over its cap of 1092
+	s.Require().NoError(k.QueueRewardPayout(s.ctx, topicId, s.addrsStr[reputerIndexes[0]], types.ReputerAndDelegatorRewardType, cosmosMath.NewInt(100)))
+	// Restaking 40 gives the second reputer 1040 of 2040, within its cap of 1060
+	s.Require().NoError(k.QueueRewardPayout(s.ctx, topicId, s.addrsStr[reputerIndexes[1]], types.ReputerAndDelegatorRewardType, cosmosMath.NewInt(40)))
+	s.Require().NoError(rewards.PayOutQueuedRewards(s.ctx, k, 0))
+
+	stake, err := k.GetStakeReputerAuthority(s.ctx, topicId, s.addrsStr[reputerIndexes[0]])
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(1000), stake)
+	paid := s.bankKeeper.GetBalance(s.ctx, s.addrs[reputerIndexes[0]], params.DefaultBondDenom).Amount.Sub(balances[0])
+	s.Require().Equal(cosmosMath.NewInt(100), paid)
+
+	stake, err = k.GetStakeReputerAuthority(s.ctx, topicId, s.addrsStr[reputerIndexes[1]])
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(1040), stake)
+	paid = s.bankKeeper.GetBalance(s.ctx, s.addrs[reputerIndexes[1]], params.DefaultBondDenom).Amount.Sub(balances[1])
+	s.Require().True(paid.IsZero())
+}
+
+func (s *RewardsTestSuite) TestPayOutQueuedRewardRollsBackFailedPayout() {
+	k := s.emissionsKeeper
+	workerIndex := 5
+	topicId := s.setUpTopic(1, []int{workerIndex}, s.returnIndexes(0, 1), cosmosMath.NewInt(1000), alloraMath.MustNewDecFromString("0.1"))
+	topic, err := k.GetTopic(s.ctx, topicId)
+	s.Require().NoError(err)
+	topic.RewardEscrowFraction = alloraMath.MustNewDecFromString("0.5")
+	topic.RewardEscrowVestingBlocks = 100
+	s.Require().NoError(k.SetTopic(s.ctx, topicId, topic))
+
+	// The rewards account can fund the escrowed half of the reward but not the rest
+	rewardsAddr := s.accountKeeper.GetModuleAddress(types.AlloraRewardsAccountName)
+	rewardsBalance := s.bankKeeper.GetAllBalances(s.ctx, rewardsAddr)
+	s.Require().NoError(s.bankKeeper.SendCoinsFromModuleToModule(s.ctx, types.AlloraRewardsAccountName, minttypes.EcosystemModuleName, rewardsBalance))
+	s.MintTokensToModule(types.AlloraRewardsAccountName, cosmosMath.NewInt(60))
+	balance := s.bankKeeper.GetBalance(s.ctx, s.addrs[workerIndex], params.DefaultBondDenom).Amount
+
+	s.Require().NoError(k.QueueRewardPayout(s.ctx, topicId, s.addrsStr[workerIndex], types.WorkerInferenceRewardType, cosmosMath.NewInt(100)))
+	s.Require().NoError(rewards.PayOutQueuedRewards(s.ctx, k, 0))
+
+	// Nothing of the failed payout is kept, not even the escrow, and the reward is queued again
+	s.Require().Equal(cosmosMath.NewInt(60), s.bankKeeper.GetBalance(s.ctx, rewardsAddr, params.DefaultBondDenom).Amount)
+	s.Require().Equal(balance, s.bankKeeper.GetBalance(s.ctx, s.addrs[workerIndex], params.DefaultBondDenom).Amount)
+	escrows, err := k.GetRewardEscrows(s.ctx, s.addrsStr[workerIndex], topicId)
+	s.Require().NoError(err)
+	s.Require().Empty(escrows)
+	total, err := k.GetTotalRewardEscrows(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(total.IsZero())
+	records, _, err := k.GetTopicRewardHistory(s.ctx, topicId, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(records)
+	payouts, err := k.GetQueuedRewardPayouts(s.ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Len(payouts, 1)
+	s.Require().Equal(s.addrsStr[workerIndex], payouts[0].Address)
+	s.Require().Equal(cosmosMath.NewInt(100), payouts[0].Amount)
+	queued, err := k.GetTotalQueuedRewardPayouts(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(100), queued)
+
+	// It is paid out once the rewards account can fund it
+	s.MintTokensToModule(types.AlloraRewardsAccountName, cosmosMath.NewInt(40))
+	s.Require().NoError(rewards.PayOutQueuedRewards(s.ctx, k, 0))
+	paid := s.bankKeeper.GetBalance(s.ctx, s.addrs[workerIndex], params.DefaultBondDenom).Amount.Sub(balance)
+	s.Require().Equal(cosmosMath.NewInt(50), paid)
+	total, err = k.GetTotalRewardEscrows(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(50), total)
+	queued, err = k.GetTotalQueuedRewardPayouts(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(queued.IsZero())
+}
+
+func (s *RewardsTestSuite) TestPayOutQueuedRewardDeadLettersAfterMaxAttempts() {
+	k := s.emissionsKeeper
+	workerIndex := 5
+	topicId := s.setUpTopic(1, []int{workerIndex}, s.returnIndexes(0, 1), cosmosMath.NewInt(1000), alloraMath.MustNewDecFromString("0.1"))
+	moduleParams, err := k.GetParams(s.ctx)
+	s.Require().NoError(err)
+	moduleParams.MaxRewardPayoutAttempts = 2
+	s.Require().NoError(k.SetParams(s.ctx, moduleParams))
+
+	// The rewards account cannot fund the reward
+	rewardsAddr := s.accountKeeper.GetModuleAddress(types.AlloraRewardsAccountName)
+	rewardsBalance := s.bankKeeper.GetAllBalances(s.ctx, rewardsAddr)
+	s.Require().NoError(s.bankKeeper.SendCoinsFromModuleToModule(s.ctx, types.AlloraRewardsAccountName, minttypes.EcosystemModuleName, rewardsBalance))
+	s.MintTokensToModule(types.AlloraRewardsAccountName, cosmosMath.NewInt(60))
+	s.Require().NoError(k.QueueRewardPayout(s.ctx, topicId, s.addrsStr[workerIndex], types.WorkerInferenceRewardType, cosmosMath.NewInt(100)))
+
+	// The first failure queues the reward again, counting the attempt
+	s.Require().NoError(rewards.PayOutQueuedRewards(s.ctx, k, 0))
+	payouts, err := k.GetQueuedRewardPayouts(s.ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Len(payouts, 1)
+	s.Require().Equal(uint64(1), payouts[0].FailedAttempts)
+	toDistribute, err := k.GetTotalRewardToDistribute(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(toDistribute.IsZero())
+
+	// The last allowed attempt dead-letters it, leaving its coins to the rewards pool
+	s.Require().NoError(rewards.PayOutQueuedRewards(s.ctx, k, 0))
+	payouts, err = k.GetQueuedRewardPayouts(s.ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Empty(payouts)
+	queued, err := k.GetTotalQueuedRewardPayouts(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(queued.IsZero())
+	toDistribute, err = k.GetTotalRewardToDistribute(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(alloraMath.NewDecFromInt64(60), toDistribute)
+	s.Require().True(hasEventType(s.ctx.EventManager().Events(), "emissions.v7.EventRewardPayoutDeadLettered"))
+
+	deadLettered, _, err := k.GetDeadLetteredRewardPayoutsPage(s.ctx, s.addrsStr[workerIndex], nil)
+	s.Require().NoError(err)
+	s.Require().Len(deadLettered, 1)
+	s.Require().Equal(cosmosMath.NewInt(100), deadLettered[0].Amount)
+	s.Require().Equal(uint64(2), deadLettered[0].FailedAttempts)
+	deadLettered, _, err = k.GetDeadLetteredRewardPayoutsPage(s.ctx, s.addrsStr[workerIndex+1], nil)
+	s.Require().NoError(err)
+	s.Require().Empty(deadLettered)
+}
+
+func (s *RewardsTestSuite) TestPayOutQueuedReputerRewardOfArchivedTopic() {
+	k := s.emissionsKeeper
+	reputerIndex := 0
+	topicId := s.setUpTopic(1, s.returnIndexes(5, 1), []int{reputerIndex}, cosmosMath.NewInt(1000), alloraMath.MustNewDecFromString("0.1"))
+	s.Require().NoError(k.QueueRewardPayout(s.ctx, topicId, s.addrsStr[reputerIndex], types.ReputerAndDelegatorRewardType, cosmosMath.NewInt(100)))
+
+	// The topic is archived after the reward was queued
+	s.Require().NoError(k.ArchiveTopic(s.ctx, topicId))
+	balance := s.bankKeeper.GetBalance(s.ctx, s.addrs[reputerIndex], params.DefaultBondDenom).Amount
+	s.Require().NoError(rewards.PayOutQueuedRewards(s.ctx, k, 0))
+
+	// The reward is sent to the reputer rather than restaked on the archived topic
+	stake, err := k.GetStakeReputerAuthority(s.ctx, topicId, s.addrsStr[reputerIndex])
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(1000), stake)
+	paid := s.bankKeeper.GetBalance(s.ctx, s.addrs[reputerIndex], params.DefaultBondDenom).Amount.Sub(balance)
+	s.Require().Equal(cosmosMath.NewInt(100), paid)
+	queued, err := k.GetTotalQueuedRewardPayouts(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(queued.IsZero())
+}
+
+// newBenchmarkEmissionsModule returns the emissions module on a fresh store, with the keepers it is built on
+func newBenchmarkEmissionsModule(b *testing.B) (sdk.Context, keeper.Keeper, bankkeeper.BaseKeeper, module.AppModule) {
+	b.Helper()
+	key := storetypes.NewKVStoreKey("emissions")
+	storeService := runtime.NewKVStoreService(key)
+	ctx := testutil.DefaultContextWithDB(b, key, storetypes.NewTransientStoreKey("transient_test")).Ctx.
+		WithLogger(log.NewNopLogger())
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, module.AppModule{})
+	maccPerms := map[string][]string{
+		types.AlloraStakingAccountName:                   {"burner", "minter", "staking"},
+		types.AlloraRewardsAccountName:                   {"minter"},
+		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraRewardEscrowAccountName:              {"minter"},
+		"ecosystem":                                      {"minter"},
+	}
+	accountKeeper := authkeeper.NewAccountKeeper(
+		encCfg.Codec,
+		storeService,
+		authtypes.ProtoBaseAccount,
+		maccPerms,
+		authcodec.NewBech32Codec(params.Bech32PrefixAccAddr),
+		params.Bech32PrefixAccAddr,
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		map[string]bool{},
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		log.NewNopLogger(),
+	)
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		codecAddress.NewBech32Codec(params.Bech32PrefixAccAddr),
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		authtypes.FeeCollectorName)
+	appModule := module.NewAppModule(encCfg.Codec, k)
+	appModule.InitGenesis(ctx, encCfg.Codec, appModule.DefaultGenesis(encCfg.Codec))
+	return ctx, k, bankKeeper, appModule
+}
+
+// setUpPayoutBenchmark returns the emissions module paying out at most maxPayouts rewards per block,
+// with a funded rewards account and a reward queued for each of the actors on one topic
+func setUpPayoutBenchmark(b *testing.B, maxPayouts uint64, actors int) (sdk.Context, module.AppModule) {
+	b.Helper()
+	ctx, k, bankKeeper, appModule := newBenchmarkEmissionsModule(b)
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	moduleParams.MaxRewardPayoutsPerBlock = maxPayouts
+	if err := k.SetParams(ctx, moduleParams); err != nil {
+		b.Fatal(err)
+	}
+
+	creator := sdk.AccAddress("creator_____________").String()
+	topic := types.Topic{
+		Id:                            1,
+		Creator:                       creator,
+		Metadata:                      "benchmark",
+		LossMethod:                    "mse",
+		EpochLength:                   100,
+		GroundTruthLag:                100,
+		WorkerSubmissionWindow:        10,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		InitialRegret:                 alloraMath.ZeroDec(),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
+		RewardEscrowFraction:          alloraMath.ZeroDec(),
+	}
+	if err := k.SetTopic(ctx, topic.Id, topic); err != nil {
+		b.Fatal(err)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(int64(actors))))
+	if err := bankKeeper.MintCoins(ctx, types.AlloraRewardsAccountName, coins); err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < actors; i++ {
+		actor := sdk.AccAddress(fmt.Sprintf("actor%015d", i)).String()
+		err := k.QueueRewardPayout(ctx, topic.Id, actor, types.WorkerInferenceRewardType, cosmosMath.OneInt())
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	return ctx, appModule
+}
+
+// BenchmarkPayOutQueuedRewards measures the end blocker paying out queued rewards as the number of
+// rewarded actors grows. Within the max reward payouts per block the cost stays flat, while paying out
+// the whole queue at once grows with the number of actors
+func BenchmarkPayOutQueuedRewards(b *testing.B) {
+	for _, maxPayouts := range []uint64{100, 0} {
+		for _, actors := range []int{100, 1000, 5000} {
+			b.Run(fmt.Sprintf("max_payouts=%d/actors=%d", maxPayouts, actors), func(b *testing.B) {
+				ctx, appModule := setUpPayoutBenchmark(b, maxPayouts, actors)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					// every iteration pays out from the same queue
+					cacheCtx, _ := ctx.CacheContext()
+					if err := appModule.EndBlock(cacheCtx); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// setUpEndBlockerBenchmark returns the emissions module at the block the epochs of rewardable
+// topics end together, sharing the rewarded actors out between the topics. Each topic has a
+// fulfilled reputer nonce, so the end blocker generates and queues the rewards of every actor
+// before paying out at most maxPayouts of them
+func setUpEndBlockerBenchmark(b *testing.B, maxPayouts uint64, topics int, actors int) (sdk.Context, module.AppModule) {
+	b.Helper()
+	ctx, k, bankKeeper, appModule := newBenchmarkEmissionsModule(b)
+	msgServer := msgserver.NewMsgServerImpl(k)
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	moduleParams.MaxRewardPayoutsPerBlock = maxPayouts
+	moduleParams.MaxActiveTopicsPerBlock = uint64(topics)
+	if err := k.SetParams(ctx, moduleParams); err != nil {
+		b.Fatal(err)
+	}
+	fund := func(address sdk.AccAddress, amount cosmosMath.Int) {
+		coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+		if err := bankKeeper.MintCoins(ctx, minttypes.EcosystemModuleName, coins); err != nil {
+			b.Fatal(err)
+		}
+		if err := bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.EcosystemModuleName, address, coins); err != nil {
+			b.Fatal(err)
+		}
+	}
+	rewardsFund := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(1_000_000_000_000)))
+	if err := bankKeeper.MintCoins(ctx, types.AlloraRewardsAccountName, rewardsFund); err != nil {
+		b.Fatal(err)
+	}
+
+	block := int64(100)
+	epochLength := int64(100)
+	ctx = ctx.WithBlockHeight(block)
+	creator := sdk.AccAddress("creator_____________")
+	fund(creator, cosmosMath.NewInt(1_000_000_000))
+	if err := k.AddToTopicCreatorWhitelist(ctx, creator.String()); err != nil {
+		b.Fatal(err)
+	}
+	stake := cosmosMath.NewInt(1_000_000)
+	// Scoring reputers against each other grows with the square of their number, so the
+	// actors of a topic are a few reputers and as many workers as it takes
+	reputersPerTopic := 3
+	workersPerTopic := max(actors/topics-reputersPerTopic, 1)
+	topicIds := make([]uint64, 0, topics)
+	for t := 0; t < topics; t++ {
+		res, err := msgServer.CreateNewTopic(ctx, &types.CreateNewTopicRequest{
+			Creator:                       creator.String(),
+			Metadata:                      "benchmark",
+			LossMethod:                    "mse",
+			EpochLength:                   epochLength,
+			GroundTruthLag:                epochLength,
+			WorkerSubmissionWindow:        10,
+			AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+			PNorm:                         alloraMath.NewDecFromInt64(3),
+			Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+			MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+			ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+			ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+			ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+			VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+			LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+			WorkerBond:                    cosmosMath.ZeroInt(),
+			WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+			ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+			ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+			ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+		topicId := res.TopicId
+		topicIds = append(topicIds, topicId)
+
+		register := func(i int, isReputer bool) string {
+			role := 'w'
+			if isReputer {
+				role = 'r'
+			}
+			address := sdk.AccAddress(fmt.Sprintf("t%02d%c%016d", topicId, role, i))
+			fund(address, stake.MulRaw(2))
+			_, err := msgServer.Register(ctx, &types.RegisterRequest{
+				Sender:    address.String(),
+				TopicId:   topicId,
+				IsReputer: isReputer,
+				Owner:     address.String(),
+			})
+			if err != nil {
+				b.Fatal(err)
+			}
+			return address.String()
+		}
+		workers := make([]string, workersPerTopic)
+		for i := range workers {
+			workers[i] = register(i, false)
+		}
+		reputers := make([]string, reputersPerTopic)
+		for i := range reputers {
+			reputers[i] = register(i, true)
+			_, err = msgServer.AddStake(ctx, &types.AddStakeRequest{Sender: reputers[i], TopicId: topicId, Amount: stake})
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+		_, err = msgServer.FundTopic(ctx, &types.FundTopicRequest{
+			Sender:  creator.String(),
+			TopicId: topicId,
+			Amount:  cosmosMath.NewInt(1_000_000),
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		// Every reputer reports a loss for every worker, as if the reputer nonce of the epoch was closed
+		bundles := types.ReputerValueBundles{ReputerValueBundles: make([]*types.ReputerValueBundle, 0, len(reputers))}
+		stakesByReputer := make(map[string]cosmosMath.Int, len(reputers))
+		for r, reputer := range reputers {
+			valueBundle := &types.ValueBundle{
+				TopicId:             topicId,
+				ReputerRequestNonce: &types.ReputerRequestNonce{ReputerNonce: &types.Nonce{BlockHeight: block}},
+				Reputer:             reputer,
+				CombinedValue:       alloraMath.NewDecFromInt64(int64(100 + r)),
+				NaiveValue:          alloraMath.NewDecFromInt64(int64(110 + r)),
+			}
+			for w, worker := range workers {
+				loss := alloraMath.NewDecFromInt64(int64(100 + r + w))
+				valueBundle.InfererValues = append(valueBundle.InfererValues, &types.WorkerAttributedValue{Worker: worker, Value: loss})
+				valueBundle.OneOutInfererValues = append(valueBundle.OneOutInfererValues, &types.WithheldWorkerAttributedValue{Worker: worker, Value: loss})
+			}
+			bundles.ReputerValueBundles = append(bundles.ReputerValueBundles, &types.ReputerValueBundle{ValueBundle: valueBundle})
+			stakesByReputer[reputer] = stake
+		}
+		topic, err := k.GetTopic(ctx, topicId)
+		if err != nil {
+			b.Fatal(err)
+		}
+		networkLosses, err := inferencesynthesis.CalcTopicNetworkLosses(topic, block, stakesByReputer, bundles)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := k.InsertActiveReputerLosses(ctx, topicId, block, bundles); err != nil {
+			b.Fatal(err)
+		}
+		if err := k.InsertNetworkLossBundleAtBlock(ctx, topicId, block, networkLosses); err != nil {
+			b.Fatal(err)
+		}
+		if err := k.SetTopicRewardNonce(ctx, topicId, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	// The epochs of all topics end at the same block
+	churningBlock, _, err := k.GetNextPossibleChurningBlockByTopicId(ctx, topicIds[0])
+	if err != nil {
+		b.Fatal(err)
+	}
+	ctx = ctx.WithBlockHeight(churningBlock)
+	if err := k.SetRewardCurrentBlockEmission(ctx, cosmosMath.NewInt(1_000_000)); err != nil {
+		b.Fatal(err)
+	}
+	if err := k.SetTotalSumPreviousTopicWeights(ctx, alloraMath.OneDec()); err != nil {
+		b.Fatal(err)
+	}
+
+	// Make sure the end blocker settles the rewards of the topics
+	cacheCtx, _ := ctx.CacheContext()
+	if err := appModule.EndBlock(cacheCtx); err != nil {
+		b.Fatal(err)
+	}
+	settled := 0
+	for _, event := range cacheCtx.EventManager().Events() {
+		if event.Type == "emissions.v7.EventRewardsSettled" {
+			settled++
+		}
+	}
+	// inferer and reputer rewards
+	if settled != 2 {
+		b.Fatalf("expected the end blocker to settle inferer and reputer rewards, got %d settlements", settled)
+	}
+	return ctx, appModule
+}
+
+// BenchmarkEndBlockerWithRewards measures the whole end blocker of a block at which the epochs of
+// rewardable topics end together, generating, queueing and paying out the rewards of their actors
+func BenchmarkEndBlockerWithRewards(b *testing.B) {
+	topics := 2
+	for _, maxPayouts := range []uint64{100, 0} {
+		for _, actors := range []int{10, 100, 1000} {
+			b.Run(fmt.Sprintf("max_payouts=%d/actors=%d", maxPayouts, actors), func(b *testing.B) {
+				ctx, appModule := setUpEndBlockerBenchmark(b, maxPayouts, topics, actors)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					// every iteration rewards the same epochs
+					cacheCtx, _ := ctx.CacheContext()
+					if err := appModule.EndBlock(cacheCtx); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
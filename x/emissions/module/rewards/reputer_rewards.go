@@ -28,9 +28,10 @@ func GetReputersRewardFractions(
 		if err != nil {
 			return []string{}, []alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer stake on topic %d", topicId)
 		}
-		stakes[i], err = alloraMath.NewDecFromSdkInt(stake)
+		// locked stake weighs more in the reward fractions by the multiplier of its lock tier
+		stakes[i], err = k.GetLockWeightedReputerStake(ctx, topicId, scorePtr.Address, stake)
 		if err != nil {
-			return []string{}, []alloraMath.Dec{}, errors.Wrapf(err, "failed to convert reputer stake %d", stake)
+			return []string{}, []alloraMath.Dec{}, errors.Wrapf(err, "failed to weight reputer stake %d by its locks", stake)
 		}
 	}
 
@@ -149,7 +150,10 @@ func GetRewardForReputerTaskInTopic(
 }
 
 // Send total reward for delegator to PENDING_ACCOUNT
-// and return remain reward for reputer
+// and return remain reward for reputer.
+// The reward is split by lock-weighted stake: delegators share the reward of their plain stake through
+// the reward per share, while the weight of the stake a delegator locked is credited to that delegator
+// alone by lowering the reward debt of its placement. The reputer keeps the rest
 func GetRewardForReputerFromTotalReward(
 	ctx sdk.Context,
 	keeper keeper.Keeper,
@@ -164,9 +168,13 @@ func GetRewardForReputerFromTotalReward(
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to get reputer stake")
 		}
-		totalStakeAmountDec, err := alloraMath.NewDecFromSdkInt(totalStakeAmountInt)
+		totalStakeAmountDec, err := keeper.GetLockWeightedReputerStake(ctx, topicId, reputer, totalStakeAmountInt)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to convert reputer total stake to dec")
+			return nil, errors.Wrapf(err, "failed to weight reputer stake %s by its locks", totalStakeAmountInt)
+		}
+		stakeLockBonuses, err := keeper.GetStakeLockBonuses(ctx, topicId, reputer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get stake lock bonuses")
 		}
 		// calculate reward for delegator total staked amount and send it to AlloraPendingRewardForDelegatorAccountName
 		totalDelegatorStakeAmountInt, err := keeper.GetDelegateStakeUponReputer(ctx, topicId, reputer)
@@ -178,11 +186,24 @@ func GetRewardForReputerFromTotalReward(
 			return nil, errors.Wrapf(err, "failed to convert delegator stake upon reputer to dec")
 		}
 
-		fractionDec, err := totalDelegatorStakeAmountDec.Quo(totalStakeAmountDec)
+		rewardPerWeight, err := reward.Quo(totalStakeAmountDec)
 		if err != nil {
 			return nil, err
 		}
-		delegatorRewardDec, err := reward.Mul(fractionDec)
+		// the reputer keeps its commission out of the reward of its delegators
+		commission, _, err := keeper.GetReputerCommission(ctx, topicId, reputer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get reputer commission")
+		}
+		delegatorFractionAfterCommission, err := alloraMath.OneDec().Sub(commission.Rate)
+		if err != nil {
+			return nil, err
+		}
+		delegatorRewardPerWeight, err := rewardPerWeight.Mul(delegatorFractionAfterCommission)
+		if err != nil {
+			return nil, err
+		}
+		delegatorRewardDec, err := delegatorRewardPerWeight.Mul(totalDelegatorStakeAmountDec)
 		if err != nil {
 			return nil, err
 		}
@@ -213,6 +234,50 @@ func GetRewardForReputerFromTotalReward(
 			if err != nil {
 				return nil, err
 			}
+		}
+		// credit each delegator with the reward of the weight its own locks add
+		delegatorBonusInt := cosmosMath.ZeroInt()
+		for _, delegator := range alloraMath.GetSortedKeys(stakeLockBonuses) {
+			if delegator == "" {
+				continue
+			}
+			bonusRewardDec, err := delegatorRewardPerWeight.Mul(stakeLockBonuses[delegator])
+			if err != nil {
+				return nil, err
+			}
+			bonusRewardInt, err := bonusRewardDec.SdkIntTrim()
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to sdk int trim delegator lock reward")
+			}
+			if !bonusRewardInt.IsPositive() {
+				continue
+			}
+			bonusRewardDec, err = alloraMath.NewDecFromSdkInt(bonusRewardInt)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to reconvert delegator lock reward from int to dec")
+			}
+			placement, err := keeper.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+			if err != nil {
+				return nil, err
+			}
+			placement.RewardDebt, err = placement.RewardDebt.Sub(bonusRewardDec)
+			if err != nil {
+				return nil, err
+			}
+			err = keeper.SetDelegateStakePlacement(ctx, topicId, delegator, reputer, placement)
+			if err != nil {
+				return nil, err
+			}
+			delegatorBonusInt = delegatorBonusInt.Add(bonusRewardInt)
+		}
+		if delegatorBonusInt.IsPositive() {
+			delegatorRewardInt = delegatorRewardInt.Add(delegatorBonusInt)
+			delegatorRewardDec, err = alloraMath.NewDecFromSdkInt(delegatorRewardInt)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to reconvert delegator reward from int to dec")
+			}
+		}
+		if delegatorRewardInt.GT(cosmosMath.ZeroInt()) {
 			err = keeper.SendCoinsFromModuleToModule(
 				ctx,
 				types.AlloraRewardsAccountName,
@@ -382,6 +382,48 @@ func (s *RewardsTestSuite) TestGetReputersRewardFractionsShouldIncreaseFractionO
 	)
 }
 
+func (s *RewardsTestSuite) TestGetReputersRewardFractionsShouldIncreaseFractionOfRewardsForLockedStake() {
+	reputerIndexes := []int{0, 1, 2, 3, 4}
+
+	topicId, err := CreateTopic(s.ctx, s.msgServer, s.addrs[0].String())
+	s.Require().NoError(err)
+	block := int64(1003)
+
+	reputerValueBundles, err := mockReputersData(s, topicId, block, reputerIndexes)
+	s.Require().NoError(err)
+	scores, err := rewards.GenerateReputerScores(s.ctx, s.emissionsKeeper, topicId, block, reputerValueBundles)
+	s.Require().NoError(err)
+
+	_, reputersRewardFractions, err := rewards.GetReputersRewardFractions(
+		s.ctx,
+		s.emissionsKeeper,
+		topicId,
+		alloraMath.OneDec(),
+		scores,
+	)
+	s.Require().NoError(err)
+
+	// Lock all of the stake of the first reputer, which weighs it by the multiplier of the tier
+	stake, err := s.emissionsKeeper.GetStakeReputerAuthority(s.ctx, topicId, s.addrsStr[0])
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.LockStake(s.ctx, topicId, s.addrsStr[0], "", stake, 6)
+	s.Require().NoError(err)
+
+	_, newReputersRewardFractions, err := rewards.GetReputersRewardFractions(
+		s.ctx,
+		s.emissionsKeeper,
+		topicId,
+		alloraMath.OneDec(),
+		scores,
+	)
+	s.Require().NoError(err)
+
+	s.Require().True(newReputersRewardFractions[0].Gt(reputersRewardFractions[0]))
+	for i := 1; i < len(reputerIndexes); i++ {
+		s.Require().True(newReputersRewardFractions[i].Lt(reputersRewardFractions[i]))
+	}
+}
+
 func (s *RewardsTestSuite) TestGetReputersRewardFractionsShouldOutputZeroForReputerWithZeroStake() {
 	block := int64(1003)
 	reputerIndexes := []int{0, 1, 2, 3, 4}
@@ -631,6 +673,8 @@ func mockReputersData(s *RewardsTestSuite, topicId uint64, block int64, reputerI
 			OneOutForecasterValues:        nil,
 			OneInForecasterValues:         nil,
 			OneOutInfererForecasterValues: nil,
+			CombinedValues:                nil,
+			NaiveValues:                   nil,
 		}
 		signature := s.signValueBundle(valueBundle, s.privKeys[reputerIndex])
 		reputerValueBundle := &types.ReputerValueBundle{
@@ -652,22 +696,35 @@ func mockReputersData(s *RewardsTestSuite, topicId uint64, block int64, reputerI
 func CreateTopic(ctx context.Context, msgServer types.MsgServiceServer, creator string) (uint64, error) {
 	// Create topic
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  creator,
-		Metadata:                 "test",
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		AllowNegative:            false,
-		WorkerSubmissionWindow:   10,
-		AlphaRegret:              alloraMath.NewDecFromInt64(1),
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       creator,
+		Metadata:                      "test",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		AllowNegative:                 false,
+		WorkerSubmissionWindow:        10,
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	res, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
 	if err != nil {
@@ -675,3 +732,84 @@ func CreateTopic(ctx context.Context, msgServer types.MsgServiceServer, creator
 	}
 	return res.TopicId, nil
 }
+
+func (s *RewardsTestSuite) TestGetRewardForReputerFromTotalRewardKeepsReputerCommission() {
+	topicId := uint64(1)
+	reputer := s.addrs[0].String()
+	reputerWithoutCommission := s.addrs[1].String()
+	delegator := s.addrs[5].String()
+
+	for _, reputerAddr := range []string{reputer, reputerWithoutCommission} {
+		err := s.emissionsKeeper.AddReputerStake(s.ctx, topicId, reputerAddr, cosmosMath.NewInt(100))
+		s.Require().NoError(err)
+		err = s.emissionsKeeper.AddDelegateStake(s.ctx, topicId, delegator, reputerAddr, cosmosMath.NewInt(100))
+		s.Require().NoError(err)
+	}
+	err := s.emissionsKeeper.SetReputerCommission(s.ctx, types.ReputerCommission{
+		TopicId:           topicId,
+		Reputer:           reputer,
+		Rate:              alloraMath.MustNewDecFromString("0.1"),
+		MaxRate:           alloraMath.MustNewDecFromString("0.2"),
+		MaxChangeRate:     alloraMath.MustNewDecFromString("0.01"),
+		UpdateBlockHeight: s.ctx.BlockHeight(),
+	})
+	s.Require().NoError(err)
+	s.MintTokensToModule(types.AlloraRewardsAccountName, cosmosMath.NewInt(2000))
+
+	reputerRewards, err := rewards.GetRewardForReputerFromTotalReward(s.ctx, s.emissionsKeeper, topicId, []types.TaskReward{
+		{Address: reputer, Reward: alloraMath.NewDecFromInt64(1000), TopicId: topicId, Type: types.ReputerAndDelegatorRewardType},
+		{Address: reputerWithoutCommission, Reward: alloraMath.NewDecFromInt64(1000), TopicId: topicId, Type: types.ReputerAndDelegatorRewardType},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(reputerRewards, 2)
+
+	// Half of the reward is earned by delegated stake, of which the reputer keeps 10%
+	s.Require().True(alloraMath.NewDecFromInt64(550).Equal(reputerRewards[0].Reward))
+	share, err := s.emissionsKeeper.GetDelegateRewardPerShare(s.ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.MustNewDecFromString("4.5").Equal(share))
+
+	s.Require().True(alloraMath.NewDecFromInt64(500).Equal(reputerRewards[1].Reward))
+	share, err = s.emissionsKeeper.GetDelegateRewardPerShare(s.ctx, topicId, reputerWithoutCommission)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.NewDecFromInt64(5).Equal(share))
+
+	pendingRewardAddr := s.accountKeeper.GetModuleAddress(types.AlloraPendingRewardForDelegatorAccountName)
+	s.Require().Equal(cosmosMath.NewInt(950), s.bankKeeper.GetBalance(s.ctx, pendingRewardAddr, params.DefaultBondDenom).Amount)
+}
+
+func (s *RewardsTestSuite) TestGetRewardForReputerFromTotalRewardWeighsDelegatorsByTheirOwnLocks() {
+	topicId := uint64(1)
+	reputer := s.addrs[0].String()
+	lockingDelegator := s.addrs[5].String()
+	delegator := s.addrs[6].String()
+
+	err := s.emissionsKeeper.AddReputerStake(s.ctx, topicId, reputer, cosmosMath.NewInt(100))
+	s.Require().NoError(err)
+	for _, delegatorAddr := range []string{lockingDelegator, delegator} {
+		err = s.emissionsKeeper.AddDelegateStake(s.ctx, topicId, delegatorAddr, reputer, cosmosMath.NewInt(100))
+		s.Require().NoError(err)
+	}
+	// Locked for 6 months, the stake of one delegator weighs 1.5 times as much
+	err = s.emissionsKeeper.LockStake(s.ctx, topicId, reputer, lockingDelegator, cosmosMath.NewInt(100), 6)
+	s.Require().NoError(err)
+	s.MintTokensToModule(types.AlloraRewardsAccountName, cosmosMath.NewInt(1000))
+
+	reputerRewards, err := rewards.GetRewardForReputerFromTotalReward(s.ctx, s.emissionsKeeper, topicId, []types.TaskReward{
+		{Address: reputer, Reward: alloraMath.NewDecFromInt64(700), TopicId: topicId, Type: types.ReputerAndDelegatorRewardType},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(reputerRewards, 1)
+
+	// The reward of 700 is 2 per unit of the 350 lock-weighted stake: the reputer earns 200 on
+	// its unlocked stake, the unlocked delegator 200 and the locking delegator 300
+	s.Require().True(alloraMath.NewDecFromInt64(200).Equal(reputerRewards[0].Reward), reputerRewards[0].Reward.String())
+	for delegatorAddr, expected := range map[string]int64{lockingDelegator: 300, delegator: 200} {
+		pending, _, err := s.emissionsKeeper.GetPendingDelegateRewards(s.ctx, delegatorAddr, nil)
+		s.Require().NoError(err)
+		s.Require().Len(pending, 1)
+		s.Require().Equal(cosmosMath.NewInt(expected), pending[0].Amount, delegatorAddr)
+	}
+	pendingRewardAddr := s.accountKeeper.GetModuleAddress(types.AlloraPendingRewardForDelegatorAccountName)
+	s.Require().Equal(cosmosMath.NewInt(500), s.bankKeeper.GetBalance(s.ctx, pendingRewardAddr, params.DefaultBondDenom).Amount)
+}
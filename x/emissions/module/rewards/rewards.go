@@ -32,6 +32,31 @@ type GenerateRewardsDistributionByTopicParticipantArgs struct {
 	ModuleParams types.Params
 }
 
+// Everything the rewards of a topic epoch are computed from, with the resulting rewards of each task.
+// Addresses, reward fractions and rewards of a task are in the same order
+type TopicRewardsBreakdown struct {
+	ReputerScores             []types.Score
+	InfererScores             []types.Score
+	ForecasterScores          []types.Score
+	Reputers                  []string
+	Inferers                  []string
+	Forecasters               []string
+	ReputerRewardFractions    []alloraMath.Dec
+	InfererRewardFractions    []alloraMath.Dec
+	ForecasterRewardFractions []alloraMath.Dec
+	ReputerEntropy            alloraMath.Dec
+	InferenceEntropy          alloraMath.Dec
+	ForecastingEntropy        alloraMath.Dec
+	Chi                       alloraMath.Dec
+	Gamma                     alloraMath.Dec
+	TaskReputerReward         alloraMath.Dec
+	TaskInferenceReward       alloraMath.Dec
+	TaskForecastingReward     alloraMath.Dec
+	ReputerRewards            []types.TaskReward
+	InferenceRewards          []types.TaskReward
+	ForecastRewards           []types.TaskReward
+}
+
 type GetDistributionAndPayoutRewardsToTopicActorsArgs struct {
 	Ctx              sdk.Context
 	K                keeper.Keeper
@@ -126,6 +151,9 @@ func EmitRewards(args EmitRewardsArgs) error {
 	// Initialize totalRewardToStakedReputers
 	totalRewardToStakedReputers := alloraMath.ZeroDec()
 
+	// Reward history pruning is shared by all topics rewarded in the block
+	rewardHistoryPruneBudget := args.ModuleParams.MaxRewardHistoryPrunesPerBlock
+
 	// Process rewards for each topic, pruning at the end of epoch
 	for _, topicId := range sortedRewardableTopics {
 		topicRewardNonce, err := args.K.GetTopicRewardNonce(args.Ctx, topicId)
@@ -135,7 +163,7 @@ func EmitRewards(args EmitRewardsArgs) error {
 		}
 		// Defer pruning records after rewards payout
 		defer func(topicId uint64, topicRewardNonce int64) {
-			if err := pruneRecordsAfterRewards(args.Ctx, args.K, args.ModuleParams.MinEpochLengthRecordLimit, topicId, topicRewardNonce); err != nil {
+			if err := pruneRecordsAfterRewards(args.Ctx, args.K, args.ModuleParams.MinEpochLengthRecordLimit, topicId, topicRewardNonce, &rewardHistoryPruneBudget); err != nil {
 				Logger(args.Ctx).Error(fmt.Sprintf("Failed to prune records after rewards for Topic %d, nonce: %d, err: %s", topicId, topicRewardNonce, err.Error()))
 			}
 		}(topicId, topicRewardNonce)
@@ -153,6 +181,8 @@ func EmitRewards(args EmitRewardsArgs) error {
 			Logger(args.Ctx).Error(fmt.Sprintf("Failed to process rewards for topic %d: %s", topicId, err.Error()))
 			continue
 		}
+		compoundDelegateRewards(args.Ctx, args.K, topicId)
+		slashReputersFarFromConsensus(args.Ctx, args.K, topicId)
 
 		// Add rewardInTopicToReputers to totalRewardToStakedReputers
 		totalRewardToStakedReputers, err = totalRewardToStakedReputers.Add(rewardInTopicToReputers)
@@ -206,11 +236,11 @@ func getDistributionAndPayoutRewardsToTopicActors(args GetDistributionAndPayoutR
 		return alloraMath.ZeroDec(), errors.Wrapf(err, "Failed to Generate Rewards for Topic %d", args.TopicId)
 	}
 
-	// Pay out rewards to topic participants
-	payoutErrors := payoutRewards(args.Ctx, args.K, totalRewardsDistribution)
-	if len(payoutErrors) > 0 {
-		for _, payoutErr := range payoutErrors {
-			Logger(args.Ctx).Warn(fmt.Sprintf("Failed to pay out rewards to participant in Topic %d: %s", args.TopicId, payoutErr.Error()))
+	// Queue rewards of topic participants, paid out over the next blocks by PayOutQueuedRewards
+	queueErrors := queueRewardPayouts(args.Ctx, args.K, totalRewardsDistribution)
+	if len(queueErrors) > 0 {
+		for _, queueErr := range queueErrors {
+			Logger(args.Ctx).Warn(fmt.Sprintf("Failed to queue rewards of participant in Topic %d: %s", args.TopicId, queueErr.Error()))
 		}
 		return alloraMath.ZeroDec(), nil // continue to next topic
 	}
@@ -219,6 +249,33 @@ func getDistributionAndPayoutRewardsToTopicActors(args GetDistributionAndPayoutR
 	return rewardInTopicToActors, nil
 }
 
+// Restakes the rewards just paid out to delegators of the topic that opted into auto-compounding.
+// Errors are logged and leave the rewards pending, to be claimed with RewardDelegateStake
+func compoundDelegateRewards(ctx sdk.Context, k keeper.Keeper, topicId uint64) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.CompoundDelegateRewards(cacheCtx, topicId); err != nil {
+		Logger(ctx).Error(fmt.Sprintf("Failed to compound delegate rewards of topic %d: %s", topicId, err.Error()))
+		return
+	}
+	write()
+}
+
+// Slashes the reputers of the topic that kept reporting losses far from consensus, once the
+// topic rewards were paid out against their stake. Errors are logged and leave stake untouched
+func slashReputersFarFromConsensus(ctx sdk.Context, k keeper.Keeper, topicId uint64) {
+	topic, err := k.GetTopic(ctx, topicId)
+	if err != nil {
+		Logger(ctx).Error(fmt.Sprintf("Failed to get topic %d to slash reputers: %s", topicId, err.Error()))
+		return
+	}
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.SlashReputersFarFromConsensus(cacheCtx, topic); err != nil {
+		Logger(ctx).Error(fmt.Sprintf("Failed to slash reputers of topic %d: %s", topicId, err.Error()))
+		return
+	}
+	write()
+}
+
 // Calculates the rewards for each topic.
 //
 // Calculates rewards per-block based on their weights vs all active topics.
@@ -341,48 +398,86 @@ func GenerateRewardsDistributionByTopicParticipant(
 	taskReputerReward alloraMath.Dec,
 	err error,
 ) {
+	breakdown, err := GenerateTopicRewardsBreakdown(args)
+	if err != nil {
+		return []types.TaskReward{}, alloraMath.Dec{}, err
+	}
+	totalRewardsDistribution = make([]types.TaskReward, 0)
+	totalRewardsDistribution = append(totalRewardsDistribution, breakdown.ReputerRewards...)
+	totalRewardsDistribution = append(totalRewardsDistribution, breakdown.InferenceRewards...)
+	totalRewardsDistribution = append(totalRewardsDistribution, breakdown.ForecastRewards...)
+	return totalRewardsDistribution, breakdown.TaskReputerReward, nil
+}
+
+// Runs the reward pipeline of a topic epoch paying out a hypothetical topic reward on a branch of
+// state that is discarded afterwards, so none of the scores it sets or events it emits are kept
+func SimulateTopicRewards(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId uint64,
+	blockHeight int64,
+	topicReward alloraMath.Dec,
+) (TopicRewardsBreakdown, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get module params")
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	return GenerateTopicRewardsBreakdown(GenerateRewardsDistributionByTopicParticipantArgs{
+		Ctx:          cacheCtx,
+		K:            k,
+		TopicId:      topicId,
+		TopicReward:  &topicReward,
+		BlockHeight:  blockHeight,
+		ModuleParams: moduleParams,
+	})
+}
+
+// Calculates the scores, reward fractions, entropies and task rewards of a topic epoch that
+// the distribution of rewards to topic participants is made of. Sets the scores as it goes
+func GenerateTopicRewardsBreakdown(args GenerateRewardsDistributionByTopicParticipantArgs) (TopicRewardsBreakdown, error) {
 	if args.TopicReward == nil {
-		return nil, alloraMath.Dec{}, types.ErrInvalidReward
+		return TopicRewardsBreakdown{}, types.ErrInvalidReward
 	}
 	args.Ctx.Logger().Debug(fmt.Sprintf("Generating rewards distribution for topic: %d, block: %d, topicReward: %s", args.TopicId, args.BlockHeight, args.TopicReward.String()))
 	bundles, err := args.K.GetReputerLossBundlesAtBlock(args.Ctx, args.TopicId, args.BlockHeight)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer loss bundle at block %d", args.BlockHeight)
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get reputer loss bundle at block %d", args.BlockHeight)
 	}
 	if bundles != nil && len(bundles.ReputerValueBundles) == 0 {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(types.ErrInvalidReward, "empty reputer loss bundles")
+		return TopicRewardsBreakdown{}, errors.Wrapf(types.ErrInvalidReward, "empty reputer loss bundles")
 	}
 
 	lossBundles, err := args.K.GetNetworkLossBundleAtBlock(args.Ctx, args.TopicId, args.BlockHeight)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get network loss bundle at block %d", args.BlockHeight)
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get network loss bundle at block %d", args.BlockHeight)
 	}
 
 	// Calculate and Set the reputer scores
 	reputerScores, err := GenerateReputerScores(args.Ctx, args.K, args.TopicId, args.BlockHeight, *bundles)
 	if err != nil {
-		return nil, alloraMath.Dec{}, err
+		return TopicRewardsBreakdown{}, err
 	}
 	if len(reputerScores) == 0 {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(types.ErrInvalidReward, "empty reputer scores")
+		return TopicRewardsBreakdown{}, errors.Wrapf(types.ErrInvalidReward, "empty reputer scores")
 	}
 
 	// Calculate and Set the worker scores for their inference work
 	infererScores, err := GenerateInferenceScores(args.Ctx, args.K, args.TopicId, args.BlockHeight, *lossBundles)
 	if err != nil {
-		return nil, alloraMath.Dec{}, err
+		return TopicRewardsBreakdown{}, err
 	}
 
 	// Calculate and Set the worker scores for their forecast work
 	forecasterScores, err := GenerateForecastScores(args.Ctx, args.K, args.TopicId, args.BlockHeight, *lossBundles)
 	if err != nil {
-		return nil, alloraMath.Dec{}, err
+		return TopicRewardsBreakdown{}, err
 	}
 
 	// Get reputer participants' addresses and reward fractions to be used in the reward round for topic
 	reputers, reputersRewardFractions, err := GetReputersRewardFractions(args.Ctx, args.K, args.TopicId, args.ModuleParams.PRewardReputer, reputerScores)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer reward round data")
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get reputer reward round data")
 	}
 
 	// Get reputer task entropy
@@ -396,7 +491,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		reputersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer task entropy")
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get reputer task entropy")
 	}
 
 	// Get inferer reward fractions
@@ -410,7 +505,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		infererScores,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get inferer reward fractions")
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get inferer reward fractions")
 	}
 
 	// Get inference entropy
@@ -424,7 +519,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		inferersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get inference task entropy")
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get inference task entropy")
 	}
 
 	// Get forecaster reward fractions
@@ -438,7 +533,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		forecasterScores,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get forecaster reward fractions")
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get forecaster reward fractions")
 	}
 
 	var forecastingEntropy alloraMath.Dec
@@ -454,7 +549,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 			forecastersRewardFractions,
 		)
 		if err != nil {
-			return []types.TaskReward{}, alloraMath.Dec{}, err
+			return TopicRewardsBreakdown{}, err
 		}
 	} else {
 		// If there are no forecasters, set forecasting entropy to zero
@@ -462,20 +557,20 @@ func GenerateRewardsDistributionByTopicParticipant(
 	}
 
 	// Get Total Rewards for Reputation task
-	taskReputerReward, err = GetRewardForReputerTaskInTopic(
+	taskReputerReward, err := GetRewardForReputerTaskInTopic(
 		inferenceEntropy,
 		forecastingEntropy,
 		reputerEntropy,
 		args.TopicReward,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reward for reputer task in topic")
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get reward for reputer task in topic")
 	}
 
 	// Get previous forecaster score ratio for topic
 	previousForecasterScoreRatio, err := args.K.GetPreviousForecasterScoreRatio(args.Ctx, args.TopicId)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get previous forecast score ratio")
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get previous forecast score ratio")
 	}
 
 	// Get chi (Forecasting Utility) and gamma (Normalization Factor)
@@ -489,14 +584,14 @@ func GenerateRewardsDistributionByTopicParticipant(
 		args.ModuleParams.TaskRewardAlpha,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get chi and gamma")
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get chi and gamma")
 	}
 	types.EmitNewForecastTaskUtilityScoreSetEvent(args.Ctx, args.TopicId, forecastingTaskUtilityScore)
 
 	// Set updated forecaster score ratio
 	err = args.K.SetPreviousForecasterScoreRatio(args.Ctx, args.TopicId, updatedForecasterScoreRatio)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to set previous forecast score ratio")
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to set previous forecast score ratio")
 	}
 
 	// Get Total Rewards for Inference task
@@ -509,7 +604,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		gamma,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reward for inference task in topic")
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get reward for inference task in topic")
 	}
 
 	// Get Total Rewards for Forecasting task
@@ -522,11 +617,9 @@ func GenerateRewardsDistributionByTopicParticipant(
 		gamma,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reward for forecasting task in topic")
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get reward for forecasting task in topic")
 	}
 
-	totalRewardsDistribution = make([]types.TaskReward, 0)
-
 	// Get Distribution of Rewards per Reputer
 	reputerRewards, err := GetRewardPerReputer(
 		args.Ctx,
@@ -537,10 +630,8 @@ func GenerateRewardsDistributionByTopicParticipant(
 		reputersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer rewards")
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get reputer rewards")
 	}
-	totalRewardsDistribution = append(totalRewardsDistribution, reputerRewards...)
-
 	// Get Distribution of Rewards per Worker - Inference Task
 	inferenceRewards, err := GetRewardPerWorker(
 		args.TopicId,
@@ -550,10 +641,8 @@ func GenerateRewardsDistributionByTopicParticipant(
 		inferersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get inference rewards")
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get inference rewards")
 	}
-	totalRewardsDistribution = append(totalRewardsDistribution, inferenceRewards...)
-
 	// Get Distribution of Rewards per Worker - Forecast Task
 	forecastRewards, err := GetRewardPerWorker(
 		args.TopicId,
@@ -563,31 +652,153 @@ func GenerateRewardsDistributionByTopicParticipant(
 		forecastersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get forecast rewards")
+		return TopicRewardsBreakdown{}, errors.Wrapf(err, "failed to get forecast rewards")
 	}
-	totalRewardsDistribution = append(totalRewardsDistribution, forecastRewards...)
-
-	return totalRewardsDistribution, taskReputerReward, nil
+	return TopicRewardsBreakdown{
+		ReputerScores:             reputerScores,
+		InfererScores:             infererScores,
+		ForecasterScores:          forecasterScores,
+		Reputers:                  reputers,
+		Inferers:                  inferers,
+		Forecasters:               forecasters,
+		ReputerRewardFractions:    reputersRewardFractions,
+		InfererRewardFractions:    inferersRewardFractions,
+		ForecasterRewardFractions: forecastersRewardFractions,
+		ReputerEntropy:            reputerEntropy,
+		InferenceEntropy:          inferenceEntropy,
+		ForecastingEntropy:        forecastingEntropy,
+		Chi:                       chi,
+		Gamma:                     gamma,
+		TaskReputerReward:         taskReputerReward,
+		TaskInferenceReward:       taskInferenceReward,
+		TaskForecastingReward:     taskForecastingReward,
+		ReputerRewards:            reputerRewards,
+		InferenceRewards:          inferenceRewards,
+		ForecastRewards:           forecastRewards,
+	}, nil
 }
 
 // pay out the rewards to the participants
 // this function moves tokens from the rewards module to the participants
 // if it fails to pay a particular participant, it will continue to the next participant
-func payoutRewards(
+// Queues the rewards to be paid out in the order given, skipping rewards that round down to zero
+func queueRewardPayouts(
 	ctx sdk.Context,
 	k keeper.Keeper,
 	rewards []types.TaskReward,
 ) []error {
-	ret := make([]error, 0) // errors to return from paying individual actors
+	ret := make([]error, 0) // errors to return from queueing individual actors
+	for _, reward := range rewards {
+		if reward.Reward.IsNegative() {
+			ret = append(ret, errors.Wrapf(
+				types.ErrInvalidReward,
+				"reward cannot be negative: { address: %s, amount: %s, topicId: %d, type: %s }",
+				reward.Address,
+				reward.Reward.String(),
+				reward.TopicId,
+				reward.Type,
+			))
+			continue
+		}
+		rewardInt, err := reward.Reward.SdkIntTrim()
+		if err != nil {
+			ret = append(ret, errors.Wrapf(err, "failed to convert reward to sdk.Int: %s", reward.Reward.String()))
+			continue
+		}
+		if rewardInt.IsZero() {
+			continue
+		}
+		if err := k.QueueRewardPayout(ctx, reward.TopicId, reward.Address, reward.Type, rewardInt); err != nil {
+			ret = append(ret, errors.Wrapf(err, "failed to queue reward of %s: %s", reward.Address, rewardInt.String()))
+		}
+	}
+	return ret
+}
+
+// PayOutQueuedRewards pays out up to maxPayouts rewards from the head of the reward payout queue,
+// the whole queue if maxPayouts is 0. Rewards that fail to be paid out are logged and queued again
+// at the tail of the queue, keeping their coins in the rewards account until they are paid out.
+// A reward failing max reward payout attempts times is dead-lettered instead, leaving its coins
+// to be distributed again as rewards
+func PayOutQueuedRewards(ctx sdk.Context, k keeper.Keeper, maxPayouts uint64) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get module params")
+	}
+	payouts, err := k.GetQueuedRewardPayouts(ctx, maxPayouts)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get queued reward payouts")
+	}
+	if len(payouts) == 0 {
+		return nil
+	}
+	rewards := make([]types.TaskReward, 0, len(payouts))
+	for _, payout := range payouts {
+		if err := k.DequeueRewardPayout(ctx, payout); err != nil {
+			return errors.Wrapf(err, "failed to dequeue reward payout %d", payout.Id)
+		}
+		reward, err := alloraMath.NewDecFromSdkInt(payout.Amount)
+		if err != nil {
+			return errors.Wrapf(err, "failed to convert queued reward to decimal: %s", payout.Amount.String())
+		}
+		rewards = append(rewards, types.TaskReward{
+			Address: payout.Address,
+			Reward:  reward,
+			TopicId: payout.TopicId,
+			Type:    types.TaskRewardType(payout.Type),
+		})
+	}
+	failed, payoutErrs := payoutRewards(ctx, k, rewards)
+	for _, payoutErr := range payoutErrs {
+		Logger(ctx).Warn(fmt.Sprintf("Failed to pay out queued reward: %s", payoutErr.Error()))
+	}
+	deadLettered := 0
+	for j, i := range failed {
+		payout := payouts[i]
+		if moduleParams.MaxRewardPayoutAttempts > 0 && payout.FailedAttempts+1 >= moduleParams.MaxRewardPayoutAttempts {
+			payout.FailedAttempts++
+			if err := k.SetDeadLetteredRewardPayout(ctx, payout); err != nil {
+				return errors.Wrapf(err, "failed to dead-letter reward payout %d", payout.Id)
+			}
+			// errors of a failed payout are listed in the order of the failed payouts
+			types.EmitNewRewardPayoutDeadLetteredEvent(ctx, payout, payoutErrs[j].Error())
+			deadLettered++
+			continue
+		}
+		if err := k.RequeueFailedRewardPayout(ctx, payout); err != nil {
+			return errors.Wrapf(err, "failed to queue again reward payout %d", payout.Id)
+		}
+	}
+	Logger(ctx).Debug(fmt.Sprintf(
+		"Paid out %d queued rewards, %d queued again, %d dead-lettered",
+		len(payouts)-len(failed),
+		len(failed)-deadLettered,
+		deadLettered,
+	))
+	return nil
+}
+
+// Pays out the rewards, each actor's payout committed only if all of its steps succeed.
+// Returns the indexes of the rewards that were not paid out along with the errors met
+func payoutRewards(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	rewards []types.TaskReward,
+) (failed []int, ret []error) {
+	failed = make([]int, 0) // indexes of the rewards that were not paid out
+	ret = make([]error, 0)  // errors to return from paying individual actors
 	reputerAndDelegatorRewards := make([]types.TaskReward, 0)
 	infererRewards := make([]types.TaskReward, 0)
 	forecasterRewards := make([]types.TaskReward, 0)
+	topics := make(map[uint64]types.Topic)
+	archivedTopics := make(map[uint64]bool)
 	blockHeight := ctx.BlockHeight()
-	for _, reward := range rewards {
+	for i, reward := range rewards {
 		if reward.Reward.IsZero() {
 			continue
 		}
 		if reward.Reward.IsNegative() {
+			failed = append(failed, i)
 			ret = append(ret, errors.Wrapf(
 				types.ErrInvalidReward,
 				"reward cannot be negative: { address: %s, amount: %s, topicId: %d, type: %s }",
@@ -601,77 +812,199 @@ func payoutRewards(
 
 		rewardInt, err := reward.Reward.SdkIntTrim()
 		if err != nil {
+			failed = append(failed, i)
 			ret = append(ret, errors.Wrapf(err, "failed to convert reward to sdk.Int: %s", reward.Reward.String()))
 			continue
 		}
 		if rewardInt.IsZero() {
 			continue
 		}
-		coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, rewardInt))
+		topic, ok := topics[reward.TopicId]
+		if !ok {
+			topic, err = k.GetTopic(ctx, reward.TopicId)
+			if err != nil {
+				failed = append(failed, i)
+				ret = append(ret, errors.Wrapf(err, "failed to get topic %d", reward.TopicId))
+				continue
+			}
+			archived, err := k.IsTopicArchived(ctx, reward.TopicId)
+			if err != nil {
+				failed = append(failed, i)
+				ret = append(ret, errors.Wrapf(err, "failed to check if topic %d is archived", reward.TopicId))
+				continue
+			}
+			topics[reward.TopicId] = topic
+			archivedTopics[reward.TopicId] = archived
+		}
 
-		if reward.Type == types.ReputerAndDelegatorRewardType {
+		// each payout is committed only if all of its steps succeed,
+		// so a failure part way never leaves coins moved without the matching state
+		cacheCtx, write := ctx.CacheContext()
+		if err := payoutReward(cacheCtx, k, topic, archivedTopics[reward.TopicId], reward, rewardInt); err != nil {
+			failed = append(failed, i)
+			ret = append(ret, err)
+			continue
+		}
+		write()
+
+		switch reward.Type {
+		case types.ReputerAndDelegatorRewardType:
+			reputerAndDelegatorRewards = append(reputerAndDelegatorRewards, reward)
+		case types.WorkerInferenceRewardType:
+			infererRewards = append(infererRewards, reward)
+		case types.WorkerForecastRewardType:
+			forecasterRewards = append(forecasterRewards, reward)
+		}
+	}
+
+	for _, paid := range [][]types.TaskReward{infererRewards, forecasterRewards, reputerAndDelegatorRewards} {
+		if err := k.RecordRewards(ctx, paid); err != nil {
+			ret = append(ret, errors.Wrapf(err, "failed to record rewards in reward history"))
+		}
+	}
+
+	types.EmitNewInfererRewardsSettledEvent(ctx, blockHeight, infererRewards)
+	types.EmitNewForecasterRewardsSettledEvent(ctx, blockHeight, forecasterRewards)
+	types.EmitNewReputerAndDelegatorRewardsSettledEvent(ctx, blockHeight, reputerAndDelegatorRewards)
+	return failed, ret
+}
+
+// Pays out the reward of one actor: escrows the part the topic holds back, then restakes
+// the rest of a reputer reward or sends it to the actor's account. Nothing is restaked on
+// or recorded against a topic archived since the reward was queued, its state being pruned
+func payoutReward(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topic types.Topic,
+	archived bool,
+	reward types.TaskReward,
+	rewardInt cosmosMath.Int,
+) error {
+	escrowed, err := escrowReward(ctx, k, topic, reward.Address, rewardInt)
+	if err != nil {
+		return errors.Wrapf(err, "failed to escrow reward of %s: %s", reward.Address, rewardInt.String())
+	}
+	// the rest of the reward is paid out right away
+	rewardInt = rewardInt.Sub(escrowed)
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, rewardInt))
+
+	if reward.Type == types.ReputerAndDelegatorRewardType {
+		restake := false
+		if rewardInt.IsPositive() && !archived {
+			restake, err = restakesReputerReward(ctx, k, reward.TopicId, reward.Address, rewardInt)
+			if err != nil {
+				return errors.Wrapf(err, "failed to check stake share cap of %s", reward.Address)
+			}
+		}
+		if restake {
 			err := k.SendCoinsFromModuleToModule(ctx, types.AlloraRewardsAccountName, types.AlloraStakingAccountName, coins)
 			if err != nil {
-				ret = append(ret, errors.Wrapf(
+				return errors.Wrapf(
 					err,
 					"failed to send coins from rewards module to staking module: %s",
 					coins.String(),
-				))
-				continue
+				)
 			}
 			err = k.AddReputerStake(ctx, reward.TopicId, reward.Address, rewardInt)
 			if err != nil {
-				ret = append(ret, errors.Wrapf(err, "failed to add stake %s: %s", reward.Address, rewardInt.String()))
-				continue
+				return errors.Wrapf(err, "failed to add stake %s: %s", reward.Address, rewardInt.String())
 			}
-
-			reputerAndDelegatorRewards = append(reputerAndDelegatorRewards, reward)
-		} else {
-			_, err := sdk.AccAddressFromBech32(reward.Address)
+		} else if rewardInt.IsPositive() {
+			err := k.SendCoinsFromModuleToAccount(ctx, types.AlloraRewardsAccountName, reward.Address, coins)
 			if err != nil {
-				ret = append(ret, errors.Wrapf(err, "failed to decode payout address: %s", reward.Address))
-				continue
+				return errors.Wrapf(
+					err,
+					"failed to send coins from rewards module to reputer %s: %s",
+					reward.Address,
+					coins.String(),
+				)
 			}
-			err = k.SendCoinsFromModuleToAccount(
-				ctx,
+		}
+		return nil
+	}
+
+	_, err = sdk.AccAddressFromBech32(reward.Address)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode payout address: %s", reward.Address)
+	}
+	if rewardInt.IsPositive() {
+		err = k.SendCoinsFromModuleToAccount(
+			ctx,
+			types.AlloraRewardsAccountName,
+			reward.Address,
+			coins,
+		)
+		if err != nil {
+			return errors.Wrapf(
+				err,
+				"failed to send coins from rewards module to payout address %s, %s",
 				types.AlloraRewardsAccountName,
 				reward.Address,
-				coins,
 			)
-			if err != nil {
-				ret = append(ret, errors.Wrapf(
-					err,
-					"failed to send coins from rewards module to payout address %s, %s",
-					types.AlloraRewardsAccountName,
-					reward.Address,
-				))
-				continue
-			}
+		}
+	}
 
-			if reward.Type == types.WorkerInferenceRewardType {
-				infererRewards = append(infererRewards, reward)
-
-				err := k.IncrementCountInfererInclusionsInTopic(ctx, reward.TopicId, reward.Address)
-				if err != nil {
-					ret = append(ret, errors.Wrapf(err, "failed to increment count inferer inclusions in topic"))
-					continue
-				}
-			} else if reward.Type == types.WorkerForecastRewardType {
-				forecasterRewards = append(forecasterRewards, reward)
-
-				err := k.IncrementCountForecasterInclusionsInTopic(ctx, reward.TopicId, reward.Address)
-				if err != nil {
-					ret = append(ret, errors.Wrapf(err, "failed to increment count forecaster inclusions in topic"))
-					continue
-				}
-			}
+	if archived {
+		return nil
+	}
+	if reward.Type == types.WorkerInferenceRewardType {
+		err := k.IncrementCountInfererInclusionsInTopic(ctx, reward.TopicId, reward.Address)
+		if err != nil {
+			return errors.Wrapf(err, "failed to increment count inferer inclusions in topic")
+		}
+	} else if reward.Type == types.WorkerForecastRewardType {
+		err := k.IncrementCountForecasterInclusionsInTopic(ctx, reward.TopicId, reward.Address)
+		if err != nil {
+			return errors.Wrapf(err, "failed to increment count forecaster inclusions in topic")
 		}
 	}
+	return nil
+}
 
-	types.EmitNewInfererRewardsSettledEvent(ctx, blockHeight, infererRewards)
-	types.EmitNewForecasterRewardsSettledEvent(ctx, blockHeight, forecasterRewards)
-	types.EmitNewReputerAndDelegatorRewardsSettledEvent(ctx, blockHeight, reputerAndDelegatorRewards)
-	return ret
+// Returns whether the reward of a reputer is added to its stake. Restaked rewards are held to the
+// reputer stake share cap like any other stake, so rewards that would take the reputer over the cap
+// are sent to its account instead
+func restakesReputerReward(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId uint64,
+	reputer string,
+	reward cosmosMath.Int,
+) (bool, error) {
+	err := k.CheckReputerStakeShareCap(ctx, topicId, reputer, reward, reward)
+	if errors.IsOf(err, types.ErrReputerStakeShareCapExceeded) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Holds the part of a reward the topic escrows in the allora reward escrow account, vesting there
+// until claimed. Returns the amount escrowed
+func escrowReward(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topic types.Topic,
+	address string,
+	reward cosmosMath.Int,
+) (cosmosMath.Int, error) {
+	escrowed, err := topic.RewardEscrowAmount(reward)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	if !escrowed.IsPositive() {
+		return cosmosMath.ZeroInt(), nil
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, escrowed))
+	err = k.SendCoinsFromModuleToModule(ctx, types.AlloraRewardsAccountName, types.AlloraRewardEscrowAccountName, coins)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrapf(err, "failed to send coins from rewards module to reward escrow module: %s", coins.String())
+	}
+	if err := k.EscrowReward(ctx, topic, address, escrowed); err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return escrowed, nil
 }
 
 func pruneRecordsAfterRewards(
@@ -680,6 +1013,7 @@ func pruneRecordsAfterRewards(
 	minEpochLengthRecordLimit int64,
 	topicId uint64,
 	topicRewardNonce int64,
+	rewardHistoryPruneBudget *uint64,
 ) error {
 	// Delete topic reward nonce
 	err := k.DeleteTopicRewardNonce(ctx, topicId)
@@ -722,6 +1056,12 @@ func pruneRecordsAfterRewards(
 	if err != nil {
 		return errors.Wrapf(err, "failed to prune records after rewards")
 	}
+	// The reward history is kept for its own retention rather than until the oldest nonce
+	pruned, err := k.PruneRewardHistory(ctx, topicId, *rewardHistoryPruneBudget)
+	if err != nil {
+		return errors.Wrapf(err, "failed to prune reward history")
+	}
+	*rewardHistoryPruneBudget -= pruned
 
 	return nil
 }
@@ -303,13 +303,12 @@ func GetStakeWeightedLossMatrix(
 	return stakeWeightedLoss, mostDistantValues, nil
 }
 
-// GetConsensusScore calculates the proximity to consensus score for a reputer.
-// T_im
-func GetConsensusScore(
+// GetConsensusDistance calculates the distance of a reputer's losses to the consensus losses,
+// normalized by the norm of the consensus losses
+func GetConsensusDistance(
 	reputerLosses,
 	consensusLosses,
 	mostDistantValues []alloraMath.Dec,
-	epsilonReputer alloraMath.Dec,
 	epsilon alloraMath.Dec,
 ) (alloraMath.Dec, error) {
 	if len(reputerLosses) != len(consensusLosses) {
@@ -361,7 +360,19 @@ func GetConsensusScore(
 	if err != nil {
 		return alloraMath.ZeroDec(), err
 	}
-	distanceOverConsensusNorm, err := distance.Quo(consensusNorm)
+	return distance.Quo(consensusNorm)
+}
+
+// GetConsensusScore calculates the proximity to consensus score for a reputer.
+// T_im
+func GetConsensusScore(
+	reputerLosses,
+	consensusLosses,
+	mostDistantValues []alloraMath.Dec,
+	epsilonReputer alloraMath.Dec,
+	epsilon alloraMath.Dec,
+) (alloraMath.Dec, error) {
+	distanceOverConsensusNorm, err := GetConsensusDistance(reputerLosses, consensusLosses, mostDistantValues, epsilon)
 	if err != nil {
 		return alloraMath.ZeroDec(), err
 	}
@@ -376,19 +387,14 @@ func GetConsensusScore(
 	return score, nil
 }
 
-// GetAllConsensusScores calculates the proximity to consensus score for all reputers.
-// calculates:
-// T_i - stake weighted total consensus
-// returns:
-// T_im - reputer score (proximity to consensus)
-func GetAllConsensusScores(
+// getStakeWeightedConsensus calculates the consensus losses of all reputers, weighting their losses
+// by their stake adjusted with their listening coefficients, and the most distant value of each loss
+func getStakeWeightedConsensus(
 	allLosses [][]alloraMath.Dec,
 	stakes []alloraMath.Dec,
 	allListeningCoefficients []alloraMath.Dec,
 	numReputers int64,
-	epsilonReputer alloraMath.Dec,
-	epsilon alloraMath.Dec,
-) ([]alloraMath.Dec, error) {
+) ([]alloraMath.Dec, []alloraMath.Dec, error) {
 	// Get adjusted stakes
 	var adjustedStakes []alloraMath.Dec
 	for i, reputerStake := range stakes {
@@ -400,7 +406,7 @@ func GetAllConsensusScores(
 			alloraMath.NewDecFromInt64(numReputers),
 		)
 		if err != nil {
-			return nil, errors.Wrapf(err, "error in GetAdjustedStake")
+			return nil, nil, errors.Wrapf(err, "error in GetAdjustedStake")
 		}
 		adjustedStakes = append(adjustedStakes, adjustedStake)
 	}
@@ -408,7 +414,27 @@ func GetAllConsensusScores(
 	// Get consensus loss vector and retrieve most distant values from
 	consensus, mostDistantValues, err := GetStakeWeightedLossMatrix(adjustedStakes, allLosses)
 	if err != nil {
-		return nil, errors.Wrapf(err, "error in GetStakeWeightedLossMatrix")
+		return nil, nil, errors.Wrapf(err, "error in GetStakeWeightedLossMatrix")
+	}
+	return consensus, mostDistantValues, nil
+}
+
+// GetAllConsensusScores calculates the proximity to consensus score for all reputers.
+// calculates:
+// T_i - stake weighted total consensus
+// returns:
+// T_im - reputer score (proximity to consensus)
+func GetAllConsensusScores(
+	allLosses [][]alloraMath.Dec,
+	stakes []alloraMath.Dec,
+	allListeningCoefficients []alloraMath.Dec,
+	numReputers int64,
+	epsilonReputer alloraMath.Dec,
+	epsilon alloraMath.Dec,
+) ([]alloraMath.Dec, error) {
+	consensus, mostDistantValues, err := getStakeWeightedConsensus(allLosses, stakes, allListeningCoefficients, numReputers)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get reputers scores
@@ -424,6 +450,31 @@ func GetAllConsensusScores(
 	return scores, nil
 }
 
+// GetAllConsensusDistances calculates the normalized distance of the losses of every reputer
+// to the stake weighted consensus losses.
+func GetAllConsensusDistances(
+	allLosses [][]alloraMath.Dec,
+	stakes []alloraMath.Dec,
+	allListeningCoefficients []alloraMath.Dec,
+	numReputers int64,
+	epsilon alloraMath.Dec,
+) ([]alloraMath.Dec, error) {
+	consensus, mostDistantValues, err := getStakeWeightedConsensus(allLosses, stakes, allListeningCoefficients, numReputers)
+	if err != nil {
+		return nil, err
+	}
+
+	distances := make([]alloraMath.Dec, numReputers)
+	for i := int64(0); i < numReputers; i++ {
+		distances[i], err = GetConsensusDistance(allLosses[i], consensus, mostDistantValues, epsilon)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error in GetConsensusDistance")
+		}
+	}
+
+	return distances, nil
+}
+
 // GetAllReputersOutput calculates the final scores and adjusted listening coefficients for all reputers.
 // This function iteratively adjusts the listening coefficients based on a gradient descent method to minimize
 // the difference between each reputer's losses and the consensus losses, taking into account each reputer's stake.
@@ -670,6 +721,51 @@ func GetAdjustedStake(
 	return ret, nil
 }
 
+// GetStakesWeightedAboveCap returns the stakes of reputers with each stake above the largest share of the
+// topic's stake one reputer may hold weighted as cap * (stake / cap)^stakeAboveCapExponent. With an exponent
+// below one, a reputer holding most of the topic's stake weighs sub-linearly in the adjusted stakes used for
+// consensus, and with a zero exponent stake above the cap is ignored. The stakes are returned unchanged when
+// the cap is disabled or the exponent is one.
+func GetStakesWeightedAboveCap(
+	stakes []alloraMath.Dec,
+	topicStake alloraMath.Dec,
+	maxReputerStakeShare alloraMath.Dec,
+	stakeAboveCapExponent alloraMath.Dec,
+) ([]alloraMath.Dec, error) {
+	if maxReputerStakeShare.IsZero() || stakeAboveCapExponent.Equal(alloraMath.OneDec()) {
+		return stakes, nil
+	}
+	stakeCap, err := topicStake.Mul(maxReputerStakeShare)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetStakesWeightedAboveCap, err calculating stake cap")
+	}
+	weightedStakes := make([]alloraMath.Dec, len(stakes))
+	for i, stake := range stakes {
+		if stake.Lte(stakeCap) {
+			weightedStakes[i] = stake
+			continue
+		}
+		// stake above the cap carries no weight
+		if stakeAboveCapExponent.IsZero() {
+			weightedStakes[i] = stakeCap
+			continue
+		}
+		ratio, err := stake.Quo(stakeCap)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetStakesWeightedAboveCap, err calculating stake to cap ratio")
+		}
+		weightedRatio, err := alloraMath.Pow(ratio, stakeAboveCapExponent)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetStakesWeightedAboveCap, err weighting stake to cap ratio")
+		}
+		weightedStakes[i], err = stakeCap.Mul(weightedRatio)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetStakesWeightedAboveCap, err calculating weighted stake")
+		}
+	}
+	return weightedStakes, nil
+}
+
 // f_ij, f_ik, and f_im are all reward fractions
 // that require computing the ratio of one participant to all participants
 // yes this is extremely simple math
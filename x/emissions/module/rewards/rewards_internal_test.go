@@ -61,6 +61,54 @@ func (s *RewardsMathTestSuite) TestAdjustedStakeSimple() {
 	s.Require().True(alloraMath.InDelta(expected, result, alloraMath.MustNewDecFromString("0.0001")))
 }
 
+func (s *RewardsMathTestSuite) TestGetStakesWeightedAboveCap() {
+	stakes := []alloraMath.Dec{
+		alloraMath.NewDecFromInt64(100),
+		alloraMath.NewDecFromInt64(500),
+		alloraMath.NewDecFromInt64(1300),
+	}
+	topicStake := alloraMath.NewDecFromInt64(2000)
+
+	// cap of 0.2 * 2000 = 400, stakes above it weigh 400 * sqrt(stake / 400)
+	result, err := rewards.GetStakesWeightedAboveCap(
+		stakes,
+		topicStake,
+		alloraMath.MustNewDecFromString("0.2"),
+		alloraMath.MustNewDecFromString("0.5"),
+	)
+	s.Require().NoError(err)
+	expected := []alloraMath.Dec{
+		alloraMath.NewDecFromInt64(100),
+		alloraMath.MustNewDecFromString("447.2136"),
+		alloraMath.MustNewDecFromString("721.1103"),
+	}
+	s.Require().Len(result, len(expected))
+	for i := range expected {
+		s.Require().True(alloraMath.InDelta(expected[i], result[i], alloraMath.MustNewDecFromString("0.0001")))
+	}
+
+	// a zero exponent ignores stake above the cap
+	result, err = rewards.GetStakesWeightedAboveCap(stakes, topicStake, alloraMath.MustNewDecFromString("0.2"), alloraMath.ZeroDec())
+	s.Require().NoError(err)
+	expected = []alloraMath.Dec{
+		alloraMath.NewDecFromInt64(100),
+		alloraMath.NewDecFromInt64(400),
+		alloraMath.NewDecFromInt64(400),
+	}
+	s.Require().Len(result, len(expected))
+	for i := range expected {
+		s.Require().True(expected[i].Equal(result[i]), "got %v, want %v", result[i], expected[i])
+	}
+
+	// a disabled cap or a linear exponent leaves the stakes unchanged
+	result, err = rewards.GetStakesWeightedAboveCap(stakes, topicStake, alloraMath.ZeroDec(), alloraMath.MustNewDecFromString("0.5"))
+	s.Require().NoError(err)
+	s.Require().Equal(stakes, result)
+	result, err = rewards.GetStakesWeightedAboveCap(stakes, topicStake, alloraMath.MustNewDecFromString("0.2"), alloraMath.OneDec())
+	s.Require().NoError(err)
+	s.Require().Equal(stakes, result)
+}
+
 func (s *RewardsMathTestSuite) TestNormalizeAgainstSlice() {
 	a := []alloraMath.Dec{
 		alloraMath.MustNewDecFromString("2.0"),
@@ -689,6 +737,37 @@ func TestGetAllConsensusScores(t *testing.T) {
 	}
 }
 
+func TestGetAllConsensusDistances(t *testing.T) {
+	allLosses := [][]alloraMath.Dec{
+		{alloraMath.MustNewDecFromString("0.0112"), alloraMath.MustNewDecFromString("0.00231"), alloraMath.MustNewDecFromString("0.02274")},
+		{alloraMath.MustNewDecFromString("0.01635"), alloraMath.MustNewDecFromString("0.00179"), alloraMath.MustNewDecFromString("0.03396")},
+		{alloraMath.MustNewDecFromString("0.01345"), alloraMath.MustNewDecFromString("0.00209"), alloraMath.MustNewDecFromString("0.03249")},
+		{alloraMath.MustNewDecFromString("0.2"), alloraMath.MustNewDecFromString("0.3"), alloraMath.MustNewDecFromString("0.4")},
+	}
+	stakes := []alloraMath.Dec{alloraMath.MustNewDecFromString("1000"), alloraMath.MustNewDecFromString("1000"), alloraMath.MustNewDecFromString("1000"), alloraMath.MustNewDecFromString("100")}
+	allListeningCoefficients := []alloraMath.Dec{alloraMath.OneDec(), alloraMath.OneDec(), alloraMath.OneDec(), alloraMath.OneDec()}
+	var numReputers int64 = 4
+	reputerEpsilon := alloraMath.MustNewDecFromString("1e-2")
+	epsilon := alloraMath.MustNewDecFromString("1e-4")
+
+	distances, err := rewards.GetAllConsensusDistances(allLosses, stakes, allListeningCoefficients, numReputers, epsilon)
+	require.NoError(t, err)
+	scores, err := rewards.GetAllConsensusScores(allLosses, stakes, allListeningCoefficients, numReputers, reputerEpsilon, epsilon)
+	require.NoError(t, err)
+
+	// Scores are the inverse of the distances offset by epsilon
+	for i := range distances {
+		distancePlusEpsilon, err := distances[i].Add(reputerEpsilon)
+		require.NoError(t, err)
+		score, err := alloraMath.OneDec().Quo(distancePlusEpsilon)
+		require.NoError(t, err)
+		require.True(t, score.Equal(scores[i]), "score %d: got %s, want %s", i, scores[i], score)
+	}
+	for i := 0; i < 3; i++ {
+		require.True(t, distances[3].Gt(distances[i]), "the outlier is the furthest from consensus")
+	}
+}
+
 func (s *RewardsTestSuite) TestGetAllReputersOutput() {
 	require := s.Require()
 
@@ -108,10 +108,30 @@ func GenerateReputerScores(
 		}
 	}
 
+	// Weigh stake above the largest share of topic stake one reputer may hold sub-linearly,
+	// so a reputer holding most of the topic's stake cannot dominate consensus
+	topicStake, err := keeper.GetTopicStake(ctx, topicId)
+	if err != nil {
+		return []types.Score{}, errors.Wrapf(err, "Error getting topic stake")
+	}
+	topicStakeDec, err := alloraMath.NewDecFromSdkInt(topicStake)
+	if err != nil {
+		return []types.Score{}, errors.Wrapf(err, "Error converting topic stake to Dec")
+	}
+	consensusStakes, err := GetStakesWeightedAboveCap(
+		reputerStakes,
+		topicStakeDec,
+		params.MaxReputerStakeShare,
+		params.StakeAboveCapExponent,
+	)
+	if err != nil {
+		return []types.Score{}, errors.Wrapf(err, "Error getting GetStakesWeightedAboveCap")
+	}
+
 	// Get reputer output
 	scores, newCoefficients, err := GetAllReputersOutput(
 		losses,
-		reputerStakes,
+		consensusStakes,
 		reputerListeningCoefficients,
 		int64(len(reputerStakes)),
 		params,
@@ -120,6 +140,22 @@ func GenerateReputerScores(
 		return []types.Score{}, errors.Wrapf(err, "Error getting GetAllReputersOutput")
 	}
 
+	// Measure how far each reputer is from the consensus reached with this epoch's listening coefficients,
+	// to slash reputers that keep reporting losses far from it
+	var distances []alloraMath.Dec
+	if topic.SlashesReputers() {
+		distances, err = GetAllConsensusDistances(
+			losses,
+			consensusStakes,
+			reputerListeningCoefficients,
+			int64(len(reputerStakes)),
+			params.EpsilonSafeDiv,
+		)
+		if err != nil {
+			return []types.Score{}, errors.Wrapf(err, "Error getting GetAllConsensusDistances")
+		}
+	}
+
 	// Insert new coeffients and scores
 	var instantScores []types.Score
 	var emaScores []types.Score
@@ -151,6 +187,13 @@ func GenerateReputerScores(
 			return []types.Score{}, errors.Wrapf(err, "Error calculating and saving reputer score ema")
 		}
 
+		if distances != nil {
+			err = keeper.UpdateReputerConsensusDeviation(ctx, topic, reputer, block, distances[i])
+			if err != nil {
+				return []types.Score{}, errors.Wrapf(err, "Error updating reputer consensus deviation")
+			}
+		}
+
 		activeArr[reputer] = true
 		instantScores = append(instantScores, instantScore)
 		emaScores = append(emaScores, emaScore)
@@ -481,6 +524,7 @@ func createNaNWithheldValues(workers map[string]struct{}) []*types.WithheldWorke
 		values = append(values, &types.WithheldWorkerAttributedValue{
 			Worker: worker,
 			Value:  alloraMath.NewNaN(),
+			Values: nil,
 		})
 	}
 	return values
@@ -505,6 +549,7 @@ func EnsureAllWorkersPresent(
 			values = append(values, &types.WorkerAttributedValue{
 				Worker: worker,
 				Value:  alloraMath.NewNaN(),
+				Values: nil,
 			})
 		}
 	}
@@ -531,6 +576,7 @@ func EnsureAllWorkersPresentWithheld(
 			values = append(values, &types.WithheldWorkerAttributedValue{
 				Worker: worker,
 				Value:  alloraMath.NewNaN(),
+				Values: nil,
 			})
 		}
 	}
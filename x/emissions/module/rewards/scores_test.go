@@ -21,22 +21,35 @@ func (s *RewardsTestSuite) TestGetReputersScoresFromCsv() {
 	block := int64(1003)
 
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  s.addrs[0].String(),
-		Metadata:                 "test",
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-		AllowNegative:            true,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       s.addrs[0].String(),
+		Metadata:                      "test",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		AllowNegative:                 true,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -180,22 +193,35 @@ func (s *RewardsTestSuite) TestGetReputersScoresFromCsv() {
 
 func (s *RewardsTestSuite) TestGetInferenceScores() {
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  s.addrs[0].String(),
-		Metadata:                 "test",
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-		AllowNegative:            true,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       s.addrs[0].String(),
+		Metadata:                      "test",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		AllowNegative:                 true,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -240,22 +266,35 @@ func (s *RewardsTestSuite) TestGetInferenceScoresFromCsv() {
 	epochGet := testutil.GetSimulatedValuesGetterForEpochs()
 	epoch3Get := epochGet[300]
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  s.addrs[0].String(),
-		Metadata:                 "test",
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-		AllowNegative:            true,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       s.addrs[0].String(),
+		Metadata:                      "test",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		AllowNegative:                 true,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -312,22 +351,35 @@ func (s *RewardsTestSuite) TestGetInferenceScoresFromCsv() {
 // We then compare the resulting scores and check that the higher one out losses result in higher scores.
 func (s *RewardsTestSuite) TestHigherOneOutLossesHigherInferenceScore() {
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  s.addrs[0].String(),
-		Metadata:                 "test",
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-		AllowNegative:            true,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       s.addrs[0].String(),
+		Metadata:                      "test",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		AllowNegative:                 true,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -367,22 +419,35 @@ func (s *RewardsTestSuite) TestHigherOneOutLossesHigherInferenceScore() {
 func (s *RewardsTestSuite) TestGetForecastScores() {
 	block := int64(1003)
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  s.addrs[0].String(),
-		Metadata:                 "test",
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-		AllowNegative:            true,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       s.addrs[0].String(),
+		Metadata:                      "test",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		AllowNegative:                 true,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -424,22 +489,35 @@ func (s *RewardsTestSuite) TestGetForecasterScoresFromCsv() {
 	epochGet := testutil.GetSimulatedValuesGetterForEpochs()
 	epoch3Get := epochGet[300]
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  s.addrs[0].String(),
-		Metadata:                 "test",
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-		AllowNegative:            true,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       s.addrs[0].String(),
+		Metadata:                      "test",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		AllowNegative:                 true,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -495,22 +573,35 @@ func (s *RewardsTestSuite) TestGetForecasterScoresFromCsv() {
 // in higher scores.
 func (s *RewardsTestSuite) TestHigherOneOutLossesHigherForecastScore() {
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  s.addrs[0].String(),
-		Metadata:                 "test",
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-		AllowNegative:            true,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       s.addrs[0].String(),
+		Metadata:                      "test",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		AllowNegative:                 true,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -558,8 +649,8 @@ func (s *RewardsTestSuite) TestEnsureAllWorkersPresent() {
 	}
 
 	values := []*types.WorkerAttributedValue{
-		{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(100)},
-		{Worker: "worker3", Value: alloraMath.NewDecFromInt64(300)},
+		{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(100), Values: nil},
+		{Worker: "worker3", Value: alloraMath.NewDecFromInt64(300), Values: nil},
 	}
 
 	expectedValues := map[string]string{
@@ -603,8 +694,8 @@ func (s *RewardsTestSuite) TestEnsureAllWorkersPresentWithheld() {
 	}
 
 	values := []*types.WithheldWorkerAttributedValue{
-		{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(100)},
-		{Worker: "worker3", Value: alloraMath.NewDecFromInt64(300)},
+		{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(100), Values: nil},
+		{Worker: "worker3", Value: alloraMath.NewDecFromInt64(300), Values: nil},
 	}
 
 	expectedValues := map[string]string{
@@ -654,30 +745,32 @@ func (s *RewardsTestSuite) TestEnsureWorkerPresenceConsistency() {
 					CombinedValue: alloraMath.NewDecFromInt64(100),
 					NaiveValue:    alloraMath.NewDecFromInt64(100),
 					InfererValues: []*types.WorkerAttributedValue{
-						{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(100)},
-						{Worker: s.addrsStr[2], Value: alloraMath.NewDecFromInt64(200)},
+						{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(100), Values: nil},
+						{Worker: s.addrsStr[2], Value: alloraMath.NewDecFromInt64(200), Values: nil},
 					},
 					ForecasterValues: []*types.WorkerAttributedValue{
-						{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(300)},
+						{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(300), Values: nil},
 					},
 					OneOutInfererValues: []*types.WithheldWorkerAttributedValue{
-						{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(100)},
-						{Worker: s.addrsStr[2], Value: alloraMath.NewDecFromInt64(200)},
+						{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(100), Values: nil},
+						{Worker: s.addrsStr[2], Value: alloraMath.NewDecFromInt64(200), Values: nil},
 					},
 					OneOutForecasterValues: []*types.WithheldWorkerAttributedValue{
-						{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(300)},
+						{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(300), Values: nil},
 					},
 					OneInForecasterValues: []*types.WorkerAttributedValue{
-						{Worker: s.addrsStr[2], Value: alloraMath.NewDecFromInt64(400)},
+						{Worker: s.addrsStr[2], Value: alloraMath.NewDecFromInt64(400), Values: nil},
 					},
 					OneOutInfererForecasterValues: []*types.OneOutInfererForecasterValues{
 						{
 							Forecaster: "allo13kenskkx7e0v253m3kcgwfc67cmx00fgwpgj6h",
 							OneOutInfererValues: []*types.WithheldWorkerAttributedValue{
-								{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(500)},
+								{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(500), Values: nil},
 							},
 						},
 					},
+					CombinedValues: nil,
+					NaiveValues:    nil,
 				},
 			},
 			{
@@ -692,38 +785,40 @@ func (s *RewardsTestSuite) TestEnsureWorkerPresenceConsistency() {
 					CombinedValue: alloraMath.NewDecFromInt64(100),
 					NaiveValue:    alloraMath.NewDecFromInt64(100),
 					InfererValues: []*types.WorkerAttributedValue{
-						{Worker: "worker5", Value: alloraMath.NewDecFromInt64(100)},
+						{Worker: "worker5", Value: alloraMath.NewDecFromInt64(100), Values: nil},
 					},
 					ForecasterValues: nil,
 					OneOutInfererValues: []*types.WithheldWorkerAttributedValue{
-						{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(100)},
-						{Worker: s.addrsStr[2], Value: alloraMath.NewDecFromInt64(200)},
-						{Worker: "worker3", Value: alloraMath.NewDecFromInt64(300)},
-						{Worker: "worker4", Value: alloraMath.NewDecFromInt64(400)},
+						{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(100), Values: nil},
+						{Worker: s.addrsStr[2], Value: alloraMath.NewDecFromInt64(200), Values: nil},
+						{Worker: "worker3", Value: alloraMath.NewDecFromInt64(300), Values: nil},
+						{Worker: "worker4", Value: alloraMath.NewDecFromInt64(400), Values: nil},
 					},
 					OneOutForecasterValues: []*types.WithheldWorkerAttributedValue{
-						{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(500)},
-						{Worker: "worker3", Value: alloraMath.NewDecFromInt64(600)},
+						{Worker: s.addrsStr[1], Value: alloraMath.NewDecFromInt64(500), Values: nil},
+						{Worker: "worker3", Value: alloraMath.NewDecFromInt64(600), Values: nil},
 					},
 					OneInForecasterValues: []*types.WorkerAttributedValue{
-						{Worker: s.addrsStr[2], Value: alloraMath.NewDecFromInt64(700)},
-						{Worker: "worker4", Value: alloraMath.NewDecFromInt64(800)},
+						{Worker: s.addrsStr[2], Value: alloraMath.NewDecFromInt64(700), Values: nil},
+						{Worker: "worker4", Value: alloraMath.NewDecFromInt64(800), Values: nil},
 					},
 					OneOutInfererForecasterValues: []*types.OneOutInfererForecasterValues{
 						{
 							Forecaster: "allo13kenskkx7e0v253m3kcgwfc67cmx00fgwpgj6h",
 							OneOutInfererValues: []*types.WithheldWorkerAttributedValue{
-								{Worker: "worker6", Value: alloraMath.NewDecFromInt64(1000)},
+								{Worker: "worker6", Value: alloraMath.NewDecFromInt64(1000), Values: nil},
 							},
 						},
 						{
 							Forecaster: "forecaster2",
 							OneOutInfererValues: []*types.WithheldWorkerAttributedValue{
-								{Worker: "worker3", Value: alloraMath.NewDecFromInt64(900)},
-								{Worker: "worker4", Value: alloraMath.NewDecFromInt64(1000)},
+								{Worker: "worker3", Value: alloraMath.NewDecFromInt64(900), Values: nil},
+								{Worker: "worker4", Value: alloraMath.NewDecFromInt64(1000), Values: nil},
 							},
 						},
 					},
+					CombinedValues: nil,
+					NaiveValues:    nil,
 				},
 			},
 		},
@@ -1011,14 +1106,16 @@ func generateLossBundles(s *RewardsTestSuite, blockHeight int64, topicId uint64,
 			OneOutForecasterValues:        make([]*types.WithheldWorkerAttributedValue, len(workers)),
 			OneInForecasterValues:         make([]*types.WorkerAttributedValue, len(workers)),
 			OneOutInfererForecasterValues: nil,
+			CombinedValues:                nil,
+			NaiveValues:                   nil,
 		}
 
 		for j, worker := range workers {
-			valueBundle.InfererValues[j] = &types.WorkerAttributedValue{Worker: worker.String(), Value: reputersInfererLosses[i][j]}
-			valueBundle.ForecasterValues[j] = &types.WorkerAttributedValue{Worker: worker.String(), Value: reputersForecasterLosses[i][j]}
-			valueBundle.OneOutInfererValues[j] = &types.WithheldWorkerAttributedValue{Worker: worker.String(), Value: reputersInfererOneOutLosses[i][j]}
-			valueBundle.OneOutForecasterValues[j] = &types.WithheldWorkerAttributedValue{Worker: worker.String(), Value: reputersForecasterOneOutLosses[i][j]}
-			valueBundle.OneInForecasterValues[j] = &types.WorkerAttributedValue{Worker: worker.String(), Value: reputersOneInNaiveLosses[i][j]}
+			valueBundle.InfererValues[j] = &types.WorkerAttributedValue{Worker: worker.String(), Value: reputersInfererLosses[i][j], Values: nil}
+			valueBundle.ForecasterValues[j] = &types.WorkerAttributedValue{Worker: worker.String(), Value: reputersForecasterLosses[i][j], Values: nil}
+			valueBundle.OneOutInfererValues[j] = &types.WithheldWorkerAttributedValue{Worker: worker.String(), Value: reputersInfererOneOutLosses[i][j], Values: nil}
+			valueBundle.OneOutForecasterValues[j] = &types.WithheldWorkerAttributedValue{Worker: worker.String(), Value: reputersForecasterOneOutLosses[i][j], Values: nil}
+			valueBundle.OneInForecasterValues[j] = &types.WorkerAttributedValue{Worker: worker.String(), Value: reputersOneInNaiveLosses[i][j], Values: nil}
 		}
 
 		sig, err := signValueBundle(valueBundle, s.privKeys[reputerIndex])
@@ -1071,14 +1168,16 @@ func generateHugeLossBundles(
 			OneOutForecasterValues:        make([]*types.WithheldWorkerAttributedValue, len(workerIndexes)),
 			OneInForecasterValues:         make([]*types.WorkerAttributedValue, len(workerIndexes)),
 			OneOutInfererForecasterValues: nil,
+			CombinedValues:                nil,
+			NaiveValues:                   nil,
 		}
 
 		for j, workerIndex := range workerIndexes {
-			valueBundle.InfererValues[j] = &types.WorkerAttributedValue{Worker: s.addrsStr[workerIndex], Value: reputersInfererLosses[i][j]}
-			valueBundle.ForecasterValues[j] = &types.WorkerAttributedValue{Worker: s.addrsStr[workerIndex], Value: reputersForecasterLosses[i][j]}
-			valueBundle.OneOutInfererValues[j] = &types.WithheldWorkerAttributedValue{Worker: s.addrsStr[workerIndex], Value: reputersInfererOneOutLosses[i][j]}
-			valueBundle.OneOutForecasterValues[j] = &types.WithheldWorkerAttributedValue{Worker: s.addrsStr[workerIndex], Value: reputersForecasterOneOutLosses[i][j]}
-			valueBundle.OneInForecasterValues[j] = &types.WorkerAttributedValue{Worker: s.addrsStr[workerIndex], Value: reputersOneInNaiveLosses[i][j]}
+			valueBundle.InfererValues[j] = &types.WorkerAttributedValue{Worker: s.addrsStr[workerIndex], Value: reputersInfererLosses[i][j], Values: nil}
+			valueBundle.ForecasterValues[j] = &types.WorkerAttributedValue{Worker: s.addrsStr[workerIndex], Value: reputersForecasterLosses[i][j], Values: nil}
+			valueBundle.OneOutInfererValues[j] = &types.WithheldWorkerAttributedValue{Worker: s.addrsStr[workerIndex], Value: reputersInfererOneOutLosses[i][j], Values: nil}
+			valueBundle.OneOutForecasterValues[j] = &types.WithheldWorkerAttributedValue{Worker: s.addrsStr[workerIndex], Value: reputersForecasterOneOutLosses[i][j], Values: nil}
+			valueBundle.OneInForecasterValues[j] = &types.WorkerAttributedValue{Worker: s.addrsStr[workerIndex], Value: reputersOneInNaiveLosses[i][j], Values: nil}
 		}
 
 		sig, err := signValueBundle(valueBundle, s.privKeys[reputerIndex])
@@ -1111,6 +1210,7 @@ func generateHugeWorkerDataBundles(
 				Value:       alloraMath.MustNewDecFromString(strconv.FormatInt(int64(rand.Intn(1000)+1), 10)),
 				ExtraData:   nil,
 				Proof:       "",
+				Values:      nil,
 			},
 			Forecast: &types.Forecast{
 				TopicId:     topicId,
@@ -1120,10 +1220,12 @@ func generateHugeWorkerDataBundles(
 					{
 						Inferer: s.addrs[26].String(),
 						Value:   alloraMath.MustNewDecFromString(strconv.FormatInt(int64(rand.Intn(1000)+1), 10)),
+						Values:  nil,
 					},
 					{
 						Inferer: s.addrs[27].String(),
 						Value:   alloraMath.MustNewDecFromString(strconv.FormatInt(int64(rand.Intn(1000)+1), 10)),
+						Values:  nil,
 					},
 				},
 				ExtraData: nil,
@@ -1194,6 +1296,7 @@ func generateWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topicId u
 			Value:       alloraMath.MustNewDecFromString("0.01127"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values:      nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1203,10 +1306,12 @@ func generateWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topicId u
 				{
 					Inferer: s.addrs[6].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01127"),
+					Values:  nil,
 				},
 				{
 					Inferer: s.addrs[7].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01127"),
+					Values:  nil,
 				},
 			},
 			ExtraData: nil,
@@ -1232,6 +1337,7 @@ func generateWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topicId u
 			Value:       alloraMath.MustNewDecFromString("0.01791"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values:      nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1241,10 +1347,12 @@ func generateWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topicId u
 				{
 					Inferer: s.addrs[7].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01791"),
+					Values:  nil,
 				},
 				{
 					Inferer: s.addrs[8].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01791"),
+					Values:  nil,
 				},
 			},
 			ExtraData: nil,
@@ -1270,6 +1378,7 @@ func generateWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topicId u
 			Value:       alloraMath.MustNewDecFromString("0.01404"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values:      nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1279,10 +1388,12 @@ func generateWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topicId u
 				{
 					Inferer: s.addrs[8].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01404"),
+					Values:  nil,
 				},
 				{
 					Inferer: s.addrs[9].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01404"),
+					Values:  nil,
 				},
 			},
 			ExtraData: nil,
@@ -1308,6 +1419,7 @@ func generateWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topicId u
 			Value:       alloraMath.MustNewDecFromString("0.02318"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values:      nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1317,10 +1429,12 @@ func generateWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topicId u
 				{
 					Inferer: s.addrs[9].String(),
 					Value:   alloraMath.MustNewDecFromString("0.02318"),
+					Values:  nil,
 				},
 				{
 					Inferer: s.addrs[0].String(),
 					Value:   alloraMath.MustNewDecFromString("0.02318"),
+					Values:  nil,
 				},
 			},
 			ExtraData: nil,
@@ -1346,6 +1460,7 @@ func generateWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topicId u
 			Value:       alloraMath.MustNewDecFromString("0.01251"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values:      nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1355,10 +1470,12 @@ func generateWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topicId u
 				{
 					Inferer: s.addrs[0].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01251"),
+					Values:  nil,
 				},
 				{
 					Inferer: s.addrs[1].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01251"),
+					Values:  nil,
 				},
 			},
 			ExtraData: nil,
@@ -1393,6 +1510,7 @@ func generateMoreInferencesDataBundles(s *RewardsTestSuite, blockHeight int64, t
 			Value:       alloraMath.MustNewDecFromString("0.01251"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values: nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1402,10 +1520,12 @@ func generateMoreInferencesDataBundles(s *RewardsTestSuite, blockHeight int64, t
 				{
 					Inferer: s.addrs[7].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01251"),
+					Values: nil,
 				},
 				{
 					Inferer: s.addrs[8].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01251"),
+					Values: nil,
 				},
 			},
 			ExtraData: nil,
@@ -1431,6 +1551,7 @@ func generateMoreInferencesDataBundles(s *RewardsTestSuite, blockHeight int64, t
 			Value:       alloraMath.MustNewDecFromString("10000"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values: nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1440,10 +1561,12 @@ func generateMoreInferencesDataBundles(s *RewardsTestSuite, blockHeight int64, t
 				{
 					Inferer: s.addrs[5].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01251"),
+					Values: nil,
 				},
 				{
 					Inferer: s.addrs[6].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01251"),
+					Values: nil,
 				},
 			},
 			ExtraData: nil,
@@ -1479,6 +1602,7 @@ func generateMoreForecastersDataBundles(s *RewardsTestSuite, blockHeight int64,
 			Value:       alloraMath.MustNewDecFromString("0.01251"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values: nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1488,10 +1612,12 @@ func generateMoreForecastersDataBundles(s *RewardsTestSuite, blockHeight int64,
 				{
 					Inferer: s.addrs[7].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01251"),
+					Values: nil,
 				},
 				{
 					Inferer: s.addrs[8].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01251"),
+					Values: nil,
 				},
 			},
 			ExtraData: nil,
@@ -1517,6 +1643,7 @@ func generateMoreForecastersDataBundles(s *RewardsTestSuite, blockHeight int64,
 			Value:       alloraMath.MustNewDecFromString("0.01251"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values: nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1526,10 +1653,12 @@ func generateMoreForecastersDataBundles(s *RewardsTestSuite, blockHeight int64,
 				{
 					Inferer: s.addrs[5].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01251"),
+					Values: nil,
 				},
 				{
 					Inferer: s.addrs[6].String(),
 					Value:   alloraMath.MustNewDecFromString("0.01251"),
+					Values: nil,
 				},
 			},
 			ExtraData: nil,
@@ -1594,6 +1723,7 @@ func generateSimpleWorkerDataBundles(
 				Value:       alloraMath.MustNewDecFromString(workerValue.Value),
 				ExtraData:   nil,
 				Proof:       "",
+				Values:      nil,
 			},
 			Forecast: &types.Forecast{
 				TopicId:     topicId,
@@ -1603,10 +1733,12 @@ func generateSimpleWorkerDataBundles(
 					{
 						Inferer: s.addrsStr[infererIndexes[getInfererIndex()]],
 						Value:   alloraMath.MustNewDecFromString(workerValue.Value),
+						Values:  nil,
 					},
 					{
 						Inferer: s.addrsStr[infererIndexes[getInfererIndex()]],
 						Value:   alloraMath.MustNewDecFromString(workerValue.Value),
+						Values:  nil,
 					},
 				},
 				ExtraData: nil,
@@ -1664,23 +1796,25 @@ func generateSimpleLossBundles(
 			OneOutForecasterValues:        make([]*types.WithheldWorkerAttributedValue, countValues),
 			OneInForecasterValues:         make([]*types.WorkerAttributedValue, countValues),
 			OneOutInfererForecasterValues: nil,
+			CombinedValues:                nil,
+			NaiveValues:                   nil,
 		}
 
 		for j, worker := range workerValues {
 			if j < len(reputerValues) {
 				if s.addrs[worker.Index].Equals(workerZeroAddress) {
-					valueBundle.InfererValues[j] = &types.WorkerAttributedValue{Worker: s.addrsStr[worker.Index], Value: alloraMath.MustNewDecFromString(workerZeroInfererValue)}
+					valueBundle.InfererValues[j] = &types.WorkerAttributedValue{Worker: s.addrsStr[worker.Index], Value: alloraMath.MustNewDecFromString(workerZeroInfererValue), Values: nil}
 				} else {
-					valueBundle.InfererValues[j] = &types.WorkerAttributedValue{Worker: s.addrsStr[worker.Index], Value: alloraMath.MustNewDecFromString(reputerValues[j].Value)}
+					valueBundle.InfererValues[j] = &types.WorkerAttributedValue{Worker: s.addrsStr[worker.Index], Value: alloraMath.MustNewDecFromString(reputerValues[j].Value), Values: nil}
 				}
-				valueBundle.ForecasterValues[j] = &types.WorkerAttributedValue{Worker: s.addrsStr[worker.Index], Value: alloraMath.MustNewDecFromString(reputerValues[j].Value)}
+				valueBundle.ForecasterValues[j] = &types.WorkerAttributedValue{Worker: s.addrsStr[worker.Index], Value: alloraMath.MustNewDecFromString(reputerValues[j].Value), Values: nil}
 				if s.addrs[worker.Index].Equals(workerZeroAddress) {
-					valueBundle.OneOutInfererValues[j] = &types.WithheldWorkerAttributedValue{Worker: s.addrsStr[worker.Index], Value: alloraMath.MustNewDecFromString(workerZeroOneOutInfererValue)}
+					valueBundle.OneOutInfererValues[j] = &types.WithheldWorkerAttributedValue{Worker: s.addrsStr[worker.Index], Value: alloraMath.MustNewDecFromString(workerZeroOneOutInfererValue), Values: nil}
 				} else {
-					valueBundle.OneOutInfererValues[j] = &types.WithheldWorkerAttributedValue{Worker: s.addrsStr[worker.Index], Value: alloraMath.MustNewDecFromString(reputerValues[j].Value)}
+					valueBundle.OneOutInfererValues[j] = &types.WithheldWorkerAttributedValue{Worker: s.addrsStr[worker.Index], Value: alloraMath.MustNewDecFromString(reputerValues[j].Value), Values: nil}
 				}
-				valueBundle.OneOutForecasterValues[j] = &types.WithheldWorkerAttributedValue{Worker: s.addrsStr[worker.Index], Value: alloraMath.MustNewDecFromString(reputerValues[j].Value)}
-				valueBundle.OneInForecasterValues[j] = &types.WorkerAttributedValue{Worker: s.addrsStr[worker.Index], Value: alloraMath.MustNewDecFromString(reputerValues[j].Value)}
+				valueBundle.OneOutForecasterValues[j] = &types.WithheldWorkerAttributedValue{Worker: s.addrsStr[worker.Index], Value: alloraMath.MustNewDecFromString(reputerValues[j].Value), Values: nil}
+				valueBundle.OneInForecasterValues[j] = &types.WorkerAttributedValue{Worker: s.addrsStr[worker.Index], Value: alloraMath.MustNewDecFromString(reputerValues[j].Value), Values: nil}
 			}
 		}
 
@@ -1701,22 +1835,35 @@ func generateSimpleLossBundles(
 func (s *RewardsTestSuite) TestGenerateReputerScoresWithZeroListeningCoefficients() {
 	// Create a new topic
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  s.addrs[0].String(),
-		Metadata:                 "test",
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
-		AllowNegative:            true,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       s.addrs[0].String(),
+		Metadata:                      "test",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		AlphaRegret:                   alloraMath.MustNewDecFromString("0.1"),
+		AllowNegative:                 true,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -1760,10 +1907,12 @@ func (s *RewardsTestSuite) TestGenerateReputerScoresWithZeroListeningCoefficient
 						{
 							Worker: s.addrsStr[5],
 							Value:  alloraMath.MustNewDecFromString("3.81"),
+							Values: nil,
 						},
 						{
 							Worker: s.addrsStr[6],
 							Value:  alloraMath.MustNewDecFromString("3.82"),
+							Values: nil,
 						},
 					},
 					ForecasterValues:              nil,
@@ -1772,6 +1921,8 @@ func (s *RewardsTestSuite) TestGenerateReputerScoresWithZeroListeningCoefficient
 					OneInForecasterValues:         nil,
 					OneOutInfererForecasterValues: nil,
 					ExtraData:                     nil,
+					CombinedValues:                nil,
+					NaiveValues:                   nil,
 				},
 			},
 		},
@@ -0,0 +1,239 @@
+package rewards_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/module"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *RewardsTestSuite) TestRemoveStakesKeepsProcessingWhenLimitHit() {
+	require := s.Require()
+	block := int64(600)
+	reputerIndexes := []int{0, 1, 2}
+	stake := cosmosMath.NewInt(1000)
+	topicId := s.setUpTopic(block, []int{3}, reputerIndexes, stake, alloraMath.OneDec())
+
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	for _, index := range reputerIndexes {
+		_, err := s.msgServer.RemoveStake(s.ctx, &types.RemoveStakeRequest{
+			Sender:  s.addrsStr[index],
+			TopicId: topicId,
+			Amount:  stake,
+		})
+		require.NoError(err)
+	}
+	removalBlock := block + moduleParams.RemoveStakeDelayWindow
+
+	// Only two of the three removals fit in this block
+	err = module.RemoveStakes(s.ctx, removalBlock, s.emissionsKeeper, 2)
+	require.NoError(err)
+	removals, limitHit, err := s.emissionsKeeper.GetStakeRemovalsUpUntilBlock(s.ctx, removalBlock, 10)
+	require.NoError(err)
+	require.False(limitHit)
+	require.Len(removals, 1)
+
+	// The last one is picked up in the next block
+	err = module.RemoveStakes(s.ctx, removalBlock+1, s.emissionsKeeper, 2)
+	require.NoError(err)
+	removals, _, err = s.emissionsKeeper.GetStakeRemovalsUpUntilBlock(s.ctx, removalBlock+1, 10)
+	require.NoError(err)
+	require.Empty(removals)
+	topicStake, err := s.emissionsKeeper.GetTopicStake(s.ctx, topicId)
+	require.NoError(err)
+	require.True(topicStake.IsZero())
+}
+
+func (s *RewardsTestSuite) TestRemoveDelegateStakesKeepsProcessingWhenLimitHit() {
+	require := s.Require()
+	block := int64(600)
+	reputer := s.addrsStr[0]
+	delegatorIndexes := []int{1, 2, 3}
+	stake := cosmosMath.NewInt(1000)
+	topicId := s.setUpTopic(block, []int{4}, []int{0}, stake, alloraMath.OneDec())
+
+	for _, index := range delegatorIndexes {
+		_, err := s.msgServer.DelegateStake(s.ctx, &types.DelegateStakeRequest{
+			Sender:  s.addrsStr[index],
+			TopicId: topicId,
+			Reputer: reputer,
+			Amount:  stake,
+		})
+		require.NoError(err)
+	}
+
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	for _, index := range delegatorIndexes {
+		_, err := s.msgServer.RemoveDelegateStake(s.ctx, &types.RemoveDelegateStakeRequest{
+			Sender:  s.addrsStr[index],
+			Reputer: reputer,
+			TopicId: topicId,
+			Amount:  stake,
+		})
+		require.NoError(err)
+	}
+	removalBlock := block + moduleParams.RemoveStakeDelayWindow
+
+	// Only two of the three removals fit in this block
+	err = module.RemoveDelegateStakes(s.ctx, removalBlock, s.emissionsKeeper, 2)
+	require.NoError(err)
+	removals, limitHit, err := s.emissionsKeeper.GetDelegateStakeRemovalsUpUntilBlock(s.ctx, removalBlock, 10)
+	require.NoError(err)
+	require.False(limitHit)
+	require.Len(removals, 1)
+
+	// The last one is picked up in the next block
+	err = module.RemoveDelegateStakes(s.ctx, removalBlock+1, s.emissionsKeeper, 2)
+	require.NoError(err)
+	removals, _, err = s.emissionsKeeper.GetDelegateStakeRemovalsUpUntilBlock(s.ctx, removalBlock+1, 10)
+	require.NoError(err)
+	require.Empty(removals)
+	delegateStake, err := s.emissionsKeeper.GetDelegateStakeUponReputer(s.ctx, topicId, reputer)
+	require.NoError(err)
+	require.True(delegateStake.IsZero())
+}
+
+func (s *RewardsTestSuite) TestRemoveStakesRequeuesFailingRemovalPastTheHead() {
+	require := s.Require()
+	block := int64(600)
+	stake := cosmosMath.NewInt(1000)
+	topicId := s.setUpTopic(block, []int{2}, []int{0, 1}, stake, alloraMath.OneDec())
+
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	_, err = s.msgServer.RemoveStake(s.ctx, &types.RemoveStakeRequest{
+		Sender:  s.addrsStr[0],
+		TopicId: topicId,
+		Amount:  stake,
+	})
+	require.NoError(err)
+	removalBlock := block + moduleParams.RemoveStakeDelayWindow
+
+	// A removal of more than the reputer has staked fails, and sits ahead of the valid one
+	failing := types.StakeRemovalInfo{
+		BlockRemovalStarted:   block,
+		BlockRemovalCompleted: removalBlock - 1,
+		TopicId:               topicId,
+		Reputer:               s.addrsStr[1],
+		Amount:                stake.MulRaw(2),
+	}
+	err = s.emissionsKeeper.SetStakeRemoval(s.ctx, failing)
+	require.NoError(err)
+
+	err = module.RemoveStakes(s.ctx, removalBlock, s.emissionsKeeper, 1)
+	require.NoError(err)
+	requeued, err := s.emissionsKeeper.GetStakeRemoval(s.ctx, removalBlock+1, topicId, failing.Reputer)
+	require.NoError(err)
+	require.Equal(failing.Amount, requeued.Amount)
+	require.True(hasEventType(s.ctx.EventManager().Events(), "emissions.v7.EventStakeRemovalRequeued"))
+
+	// The failing removal no longer holds back the one behind it
+	err = module.RemoveStakes(s.ctx, removalBlock+1, s.emissionsKeeper, 1)
+	require.NoError(err)
+	reputerStake, err := s.emissionsKeeper.GetStakeReputerAuthority(s.ctx, topicId, s.addrsStr[0])
+	require.NoError(err)
+	require.True(reputerStake.IsZero())
+}
+
+func (s *RewardsTestSuite) TestRemoveDelegateStakesRequeuesFailingRemovalPastTheHead() {
+	require := s.Require()
+	block := int64(600)
+	reputer := s.addrsStr[0]
+	stake := cosmosMath.NewInt(1000)
+	topicId := s.setUpTopic(block, []int{3}, []int{0}, stake, alloraMath.OneDec())
+
+	for _, index := range []int{1, 2} {
+		_, err := s.msgServer.DelegateStake(s.ctx, &types.DelegateStakeRequest{
+			Sender:  s.addrsStr[index],
+			TopicId: topicId,
+			Reputer: reputer,
+			Amount:  stake,
+		})
+		require.NoError(err)
+	}
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	_, err = s.msgServer.RemoveDelegateStake(s.ctx, &types.RemoveDelegateStakeRequest{
+		Sender:  s.addrsStr[1],
+		Reputer: reputer,
+		TopicId: topicId,
+		Amount:  stake,
+	})
+	require.NoError(err)
+	removalBlock := block + moduleParams.RemoveStakeDelayWindow
+
+	// A removal of more than the delegator has staked fails, and sits ahead of the valid one
+	failing := types.DelegateStakeRemovalInfo{
+		BlockRemovalStarted:   block,
+		BlockRemovalCompleted: removalBlock - 1,
+		TopicId:               topicId,
+		Reputer:               reputer,
+		Delegator:             s.addrsStr[2],
+		Amount:                stake.MulRaw(2),
+	}
+	err = s.emissionsKeeper.SetDelegateStakeRemoval(s.ctx, failing)
+	require.NoError(err)
+
+	err = module.RemoveDelegateStakes(s.ctx, removalBlock, s.emissionsKeeper, 1)
+	require.NoError(err)
+	requeued, err := s.emissionsKeeper.GetDelegateStakeRemoval(
+		s.ctx, removalBlock+1, topicId, failing.Delegator, reputer)
+	require.NoError(err)
+	require.Equal(failing.Amount, requeued.Amount)
+	require.True(hasEventType(s.ctx.EventManager().Events(), "emissions.v7.EventStakeRemovalRequeued"))
+
+	// The failing removal no longer holds back the one behind it
+	err = module.RemoveDelegateStakes(s.ctx, removalBlock+1, s.emissionsKeeper, 1)
+	require.NoError(err)
+	delegateStake, err := s.emissionsKeeper.GetDelegateStakeUponReputer(s.ctx, topicId, reputer)
+	require.NoError(err)
+	require.Equal(stake, delegateStake)
+}
+
+func (s *RewardsTestSuite) TestRemoveStakesProcessedLateIsRemovedOnce() {
+	require := s.Require()
+	block := int64(600)
+	reputer := s.addrsStr[0]
+	stake := cosmosMath.NewInt(1000)
+	topicId := s.setUpTopic(block, []int{1}, []int{0}, stake, alloraMath.OneDec())
+
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	_, err = s.msgServer.RemoveStake(s.ctx, &types.RemoveStakeRequest{
+		Sender:  reputer,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(400),
+	})
+	require.NoError(err)
+	removalBlock := block + moduleParams.RemoveStakeDelayWindow
+
+	// A backlog of removals means this one is only processed some blocks after it matured
+	lateBlock := removalBlock + 5
+	err = module.RemoveStakes(s.ctx, lateBlock, s.emissionsKeeper, 10)
+	require.NoError(err)
+	removals, _, err := s.emissionsKeeper.GetStakeRemovalsUpUntilBlock(s.ctx, lateBlock, 10)
+	require.NoError(err)
+	require.Empty(removals)
+	_, found, err := s.emissionsKeeper.GetStakeRemovalForReputerAndTopicId(s.ctx, reputer, topicId)
+	require.NoError(err)
+	require.False(found)
+
+	// Running again in a later block must not remove the stake a second time
+	err = module.RemoveStakes(s.ctx, lateBlock+1, s.emissionsKeeper, 10)
+	require.NoError(err)
+	reputerStake, err := s.emissionsKeeper.GetStakeReputerAuthority(s.ctx, topicId, reputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(600), reputerStake)
+}
+
+func hasEventType(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -98,7 +98,8 @@ func UpdateNoncesOfActiveTopics(
 		}
 		ctx.Logger().Debug(fmt.Sprintf("Added worker nonce for topic %d: %v \n", topic.Id, nextNonce.BlockHeight))
 
-		err = k.AddWorkerWindowTopicId(ctx, block+topic.WorkerSubmissionWindow, topic.Id)
+		// Workers of topics using commit-reveal reveal their payloads after the submission window
+		err = k.AddWorkerWindowTopicId(ctx, block+topic.WorkerSubmissionWindow+topic.WorkerRevealWindow, topic.Id)
 		if err != nil {
 			ctx.Logger().Warn(fmt.Sprintf("Error adding worker window topic id: %s", err.Error()))
 			continue
@@ -179,6 +180,13 @@ func GetAndUpdateActiveTopicWeights(
 			return nil, alloraMath.Dec{}, cosmosMath.Int{}, errors.Wrapf(err, "failed to reset topic fee revenue")
 		}
 
+		// The epoch of this topic ends here, so queued parameter changes take effect
+		// before the topic is rescheduled for its next epoch
+		err = k.ApplyPendingTopicUpdate(ctx, topic.Id)
+		if err != nil {
+			return nil, alloraMath.Dec{}, cosmosMath.Int{}, errors.Wrapf(err, "failed to apply pending topic update")
+		}
+
 		// If the topic is inactive, inactivate it
 		if weight.Lt(moduleParams.MinTopicWeight) {
 			err := k.InactivateTopic(ctx, topic.Id)
@@ -210,3 +218,139 @@ func GetAndUpdateActiveTopicWeights(
 
 	return weights, sumWeight, totalRevenue, nil
 }
+
+// The terms of the weight of a topic and how the topic fares at its next churning block
+type TopicWeightBreakdown struct {
+	TopicStake              cosmosMath.Int
+	TopicFeeRevenue         cosmosMath.Int
+	StakeTerm               alloraMath.Dec
+	FeeRevenueTerm          alloraMath.Dec
+	TargetWeight            alloraMath.Dec
+	PreviousWeight          alloraMath.Dec
+	NoPriorWeight           bool
+	Weight                  alloraMath.Dec
+	MinTopicWeight          alloraMath.Dec
+	AboveMinTopicWeight     bool
+	IsActive                bool
+	NextChurningBlock       BlockHeight
+	LowestActiveWeight      types.TopicIdWeightPair
+	MaxActiveTopicsPerBlock uint64
+	KeptAfterSkimming       bool
+}
+
+// Computes the weight of a topic from the current state as GetAndUpdateActiveTopicWeights would at the end of
+// its epoch, then whether it would stay active at its next churning block: topics below the min topic weight
+// are inactivated, and of the rest only the top topics by weight are kept by SkimTopTopicsByWeightDesc.
+// An inactive topic is evaluated at the block it would be activated at. Nothing is written to state
+func GetTopicWeightBreakdown(ctx sdk.Context, k keeper.Keeper, topicId TopicId) (TopicWeightBreakdown, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return TopicWeightBreakdown{}, errors.Wrapf(err, "failed to get module params")
+	}
+	topic, err := k.GetTopic(ctx, topicId)
+	if err != nil {
+		return TopicWeightBreakdown{}, errors.Wrapf(err, "failed to get topic")
+	}
+	breakdown := TopicWeightBreakdown{
+		StakeTerm:               alloraMath.ZeroDec(),
+		FeeRevenueTerm:          alloraMath.ZeroDec(),
+		TargetWeight:            alloraMath.ZeroDec(),
+		Weight:                  alloraMath.ZeroDec(),
+		MinTopicWeight:          moduleParams.MinTopicWeight,
+		MaxActiveTopicsPerBlock: moduleParams.MaxActiveTopicsPerBlock,
+	}
+
+	breakdown.TopicStake, err = k.GetTopicStake(ctx, topicId)
+	if err != nil {
+		return TopicWeightBreakdown{}, errors.Wrapf(err, "failed to get topic stake")
+	}
+	breakdown.TopicFeeRevenue, err = k.GetTopicFeeRevenue(ctx, topicId)
+	if err != nil {
+		return TopicWeightBreakdown{}, errors.Wrapf(err, "failed to get topic fee revenue")
+	}
+	breakdown.PreviousWeight, breakdown.NoPriorWeight, err = k.GetPreviousTopicWeight(ctx, topicId)
+	if err != nil {
+		return TopicWeightBreakdown{}, errors.Wrapf(err, "failed to get previous topic weight")
+	}
+
+	// As in GetCurrentTopicWeight, a topic without fee revenue has zero weight
+	if !breakdown.TopicFeeRevenue.IsZero() {
+		topicStakeDec, err := alloraMath.NewDecFromSdkInt(breakdown.TopicStake)
+		if err != nil {
+			return TopicWeightBreakdown{}, errors.Wrapf(err, "failed to convert topic stake to dec")
+		}
+		topicFeeRevenueDec, err := alloraMath.NewDecFromSdkInt(breakdown.TopicFeeRevenue)
+		if err != nil {
+			return TopicWeightBreakdown{}, errors.Wrapf(err, "failed to convert topic fee revenue to dec")
+		}
+		breakdown.StakeTerm, breakdown.FeeRevenueTerm, err = k.GetTargetWeightTerms(
+			topicStakeDec,
+			topic.EpochLength,
+			topicFeeRevenueDec,
+			moduleParams.TopicRewardStakeImportance,
+			moduleParams.TopicRewardFeeRevenueImportance,
+		)
+		if err != nil {
+			return TopicWeightBreakdown{}, errors.Wrapf(err, "failed to get target weight terms")
+		}
+		breakdown.TargetWeight, err = breakdown.StakeTerm.Mul(breakdown.FeeRevenueTerm)
+		if err != nil {
+			return TopicWeightBreakdown{}, errors.Wrapf(err, "failed to get target weight")
+		}
+		breakdown.Weight, err = alloraMath.CalcEma(
+			moduleParams.TopicRewardAlpha, breakdown.TargetWeight, breakdown.PreviousWeight, breakdown.NoPriorWeight,
+		)
+		if err != nil {
+			return TopicWeightBreakdown{}, errors.Wrapf(err, "failed to calculate EMA")
+		}
+	}
+	breakdown.AboveMinTopicWeight = !breakdown.Weight.Lt(moduleParams.MinTopicWeight)
+
+	breakdown.NextChurningBlock, breakdown.IsActive, err = k.GetNextPossibleChurningBlockByTopicId(ctx, topicId)
+	if err != nil {
+		return TopicWeightBreakdown{}, errors.Wrapf(err, "failed to get next possible churning block")
+	}
+	if !breakdown.IsActive {
+		breakdown.NextChurningBlock = ctx.BlockHeight() + topic.EpochLength
+	}
+	breakdown.LowestActiveWeight, _, err = k.GetLowestActiveTopicWeightAtBlock(ctx, breakdown.NextChurningBlock)
+	if err != nil {
+		return TopicWeightBreakdown{}, errors.Wrapf(err, "failed to get lowest active topic weight")
+	}
+
+	// A topic below the min topic weight is inactivated before the top topics are skimmed
+	if !breakdown.AboveMinTopicWeight {
+		return breakdown, nil
+	}
+	activeTopicIds, err := k.GetActiveTopicIdsAtBlock(ctx, breakdown.NextChurningBlock)
+	if err != nil {
+		return TopicWeightBreakdown{}, errors.Wrapf(err, "failed to get active topics")
+	}
+	weights := map[TopicId]*alloraMath.Dec{topicId: &breakdown.Weight}
+	for _, activeTopicId := range activeTopicIds.TopicIds {
+		if activeTopicId == topicId {
+			continue
+		}
+		weight, err := k.GetTopicWeightFromTopicId(ctx, activeTopicId)
+		if errors.IsOf(err, types.ErrTopicDoesNotExist) {
+			continue
+		} else if err != nil {
+			return TopicWeightBreakdown{}, errors.Wrapf(err, "failed to get weight of active topic %d", activeTopicId)
+		}
+		if weight.Lt(moduleParams.MinTopicWeight) {
+			continue
+		}
+		weights[activeTopicId] = &weight
+	}
+	weightsOfTopActiveTopics, _, err := SkimTopTopicsByWeightDesc(
+		ctx,
+		weights,
+		moduleParams.MaxActiveTopicsPerBlock,
+		breakdown.NextChurningBlock,
+	)
+	if err != nil {
+		return TopicWeightBreakdown{}, errors.Wrapf(err, "failed to skim top topics by weight")
+	}
+	_, breakdown.KeptAfterSkimming = weightsOfTopActiveTopics[topicId]
+	return breakdown, nil
+}
@@ -1,6 +1,8 @@
 package rewards_test
 
 import (
+	"fmt"
+
 	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	inferencesynthesis "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
@@ -11,23 +13,35 @@ import (
 
 func mockTopic(s *RewardsTestSuite) types.Topic {
 	return types.Topic{
-		Id:                       1,
-		Creator:                  s.addrs[5].String(),
-		Metadata:                 "test",
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		EpochLastEnded:           0,
-		GroundTruthLag:           10800,
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		InitialRegret:            alloraMath.ZeroDec(),
-		AlphaRegret:              alloraMath.NewDecFromInt64(1),
-		AllowNegative:            false,
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		WorkerSubmissionWindow:   10,
+		Id:                            1,
+		Creator:                       s.addrs[5].String(),
+		Metadata:                      "test",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		EpochLastEnded:                0,
+		GroundTruthLag:                10800,
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		InitialRegret:                 alloraMath.ZeroDec(),
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		AllowNegative:                 false,
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		WorkerSubmissionWindow:        10,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 }
 
@@ -121,6 +135,123 @@ func (s *RewardsTestSuite) TestGetAndUpdateActiveTopicWeights() {
 	s.Require().Equal(1, len(activeTopics.TopicIds), "Should retrieve exactly one active topics")
 }
 
+func (s *RewardsTestSuite) TestGetAndUpdateActiveTopicWeightsAppliesPendingTopicUpdate() {
+	ctx := s.ctx.WithBlockHeight(1)
+	params := types.DefaultParams()
+	params.MinTopicWeight = alloraMath.ZeroDec()
+	err := s.emissionsKeeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	topic := mockTopic(s)
+	topic.EpochLength = 15
+	topic.GroundTruthLag = 30
+	topic.WorkerSubmissionWindow = 10
+	err = s.emissionsKeeper.SetTopic(ctx, topic.Id, topic)
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.AddTopicFeeRevenue(ctx, topic.Id, cosmosMath.NewInt(150))
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.SetTopicStake(ctx, topic.Id, cosmosMath.NewInt(10))
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.ActivateTopic(ctx, topic.Id)
+	s.Require().NoError(err)
+
+	err = s.emissionsKeeper.SetPendingTopicUpdate(ctx, topic.Id, types.OptionalTopicParams{
+		EpochLength: []int64{30},
+	})
+	s.Require().NoError(err)
+
+	// Before the epoch boundary the topic is untouched
+	block := int64(10)
+	ctx = s.ctx.WithBlockHeight(block)
+	_, _, _, err = rewards.GetAndUpdateActiveTopicWeights(ctx, s.emissionsKeeper, block)
+	s.Require().NoError(err)
+	storedTopic, err := s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(15), storedTopic.EpochLength)
+
+	// At the epoch boundary the update is applied and the next epoch uses the new length
+	block = 16
+	ctx = s.ctx.WithBlockHeight(block)
+	_, _, _, err = rewards.GetAndUpdateActiveTopicWeights(ctx, s.emissionsKeeper, block)
+	s.Require().NoError(err)
+	storedTopic, err = s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(30), storedTopic.EpochLength)
+
+	activeTopics, err := s.emissionsKeeper.GetActiveTopicIdsAtBlock(ctx, block+30)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{topic.Id}, activeTopics.TopicIds)
+
+	_, found, err := s.emissionsKeeper.GetPendingTopicUpdate(ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
+func (s *RewardsTestSuite) TestGetAndUpdateActiveTopicWeightsRejectsPendingTopicUpdateInvalidatedByParams() {
+	ctx := s.ctx.WithBlockHeight(1)
+	params := types.DefaultParams()
+	params.MinTopicWeight = alloraMath.ZeroDec()
+	err := s.emissionsKeeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	topic := mockTopic(s)
+	topic.EpochLength = 30
+	topic.GroundTruthLag = 30
+	topic.WorkerSubmissionWindow = 10
+	err = s.emissionsKeeper.SetTopic(ctx, topic.Id, topic)
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.AddTopicFeeRevenue(ctx, topic.Id, cosmosMath.NewInt(150))
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.SetTopicStake(ctx, topic.Id, cosmosMath.NewInt(10))
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.ActivateTopic(ctx, topic.Id)
+	s.Require().NoError(err)
+
+	update := types.OptionalTopicParams{EpochLength: []int64{15}}
+	err = s.emissionsKeeper.SetPendingTopicUpdate(ctx, topic.Id, update)
+	s.Require().NoError(err)
+
+	// Governance raises the min epoch length above the queued one before the epoch ends
+	params.MinEpochLength = 20
+	err = s.emissionsKeeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	block := int64(31)
+	ctx = s.ctx.WithBlockHeight(block).WithEventManager(sdk.NewEventManager())
+	_, _, _, err = rewards.GetAndUpdateActiveTopicWeights(ctx, s.emissionsKeeper, block)
+	s.Require().NoError(err)
+
+	// The topic keeps its params and the rejection is reported with the dropped update
+	storedTopic, err := s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(30), storedTopic.EpochLength)
+	_, found, err := s.emissionsKeeper.GetPendingTopicUpdate(ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	var rejected *sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "emissions.v7.EventTopicUpdateRejected" {
+			rejected = &event
+		}
+	}
+	s.Require().NotNil(rejected, "expected a topic update rejected event")
+	val, exists := rejected.GetAttribute("topic_id")
+	s.Require().True(exists)
+	s.Require().Equal(fmt.Sprintf("\"%d\"", topic.Id), val.GetValue())
+	val, exists = rejected.GetAttribute("update")
+	s.Require().True(exists)
+	s.Require().Contains(val.GetValue(), "\"epoch_length\":[\"15\"]")
+	val, exists = rejected.GetAttribute("reason")
+	s.Require().True(exists)
+	s.Require().Contains(val.GetValue(), "minimum epoch length")
+
+	// The topic is rescheduled with its unchanged epoch length
+	activeTopics, err := s.emissionsKeeper.GetActiveTopicIdsAtBlock(ctx, block+30)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{topic.Id}, activeTopics.TopicIds)
+}
+
 func (s *RewardsTestSuite) TestGetRewardAndRemovedRewardableTopics() {
 	block := int64(1)
 	s.ctx = s.ctx.WithBlockHeight(block)
@@ -386,3 +517,73 @@ func (s *RewardsTestSuite) TestPreviousTopicWeightsAfterInactivation() {
 	s.Require().NoError(err)
 	s.Require().True(previousTopicWeight.Equal(reactiveTotalSumPreviousTopicWeights), "Total sum of previous topic weights should be equal to previous topic weight after reactivation")
 }
+
+func (s *RewardsTestSuite) TestGetTopicWeightBreakdown() {
+	ctx := s.ctx.WithBlockHeight(1)
+	k := s.emissionsKeeper
+	params := types.DefaultParams()
+	params.MaxActiveTopicsPerBlock = 2
+	params.MinTopicWeight = alloraMath.NewDecFromInt64(50)
+	params.TopicRewardAlpha = alloraMath.MustNewDecFromString("0.5")
+	params.TopicRewardStakeImportance = alloraMath.OneDec()
+	params.TopicRewardFeeRevenueImportance = alloraMath.OneDec()
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	setUpTopic := func(topicId uint64, revenue, stake int64, activate bool) {
+		topic := mockTopic(s)
+		topic.Id = topicId
+		topic.EpochLength = 15
+		topic.GroundTruthLag = topic.EpochLength
+		topic.WorkerSubmissionWindow = topic.EpochLength
+		s.Require().NoError(k.SetTopic(ctx, topicId, topic))
+		s.Require().NoError(k.AddTopicFeeRevenue(ctx, topicId, cosmosMath.NewInt(revenue)))
+		s.Require().NoError(k.SetTopicStake(ctx, topicId, cosmosMath.NewInt(stake)))
+		if activate {
+			s.Require().NoError(k.ActivateTopic(ctx, topicId))
+		}
+	}
+	// Weight of topic 1 is the EMA of its target weight 10 * 150/15 = 100 with its previous weight 60
+	s.Require().NoError(k.SetPreviousTopicWeight(ctx, 1, alloraMath.NewDecFromInt64(60)))
+	setUpTopic(1, 150, 10, true)
+	// Weight of topic 2 is its target weight 10 * 300/15 = 200, having no previous weight
+	setUpTopic(2, 300, 10, true)
+	// Topic 3 has no fee revenue so it has no weight
+	setUpTopic(3, 0, 10, false)
+
+	breakdown, err := rewards.GetTopicWeightBreakdown(ctx, k, 1)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.NewDecFromInt64(10).Equal(breakdown.StakeTerm), "stake term %s", breakdown.StakeTerm)
+	s.Require().True(alloraMath.NewDecFromInt64(10).Equal(breakdown.FeeRevenueTerm), "fee revenue term %s", breakdown.FeeRevenueTerm)
+	s.Require().True(alloraMath.NewDecFromInt64(100).Equal(breakdown.TargetWeight))
+	s.Require().False(breakdown.NoPriorWeight)
+	s.Require().True(alloraMath.NewDecFromInt64(80).Equal(breakdown.Weight), "weight %s", breakdown.Weight)
+	weight, err := k.GetTopicWeightFromTopicId(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().True(weight.Equal(breakdown.Weight), "the breakdown adds up to the weight used by the end blocker")
+	s.Require().True(breakdown.AboveMinTopicWeight)
+	s.Require().True(breakdown.IsActive)
+	s.Require().Equal(int64(16), breakdown.NextChurningBlock)
+	s.Require().Equal(uint64(1), breakdown.LowestActiveWeight.TopicId)
+	s.Require().True(breakdown.KeptAfterSkimming)
+
+	// Only the heavier topic is kept once fewer topics can be active per block
+	params.MaxActiveTopicsPerBlock = 1
+	s.Require().NoError(k.SetParams(ctx, params))
+	breakdown, err = rewards.GetTopicWeightBreakdown(ctx, k, 1)
+	s.Require().NoError(err)
+	s.Require().False(breakdown.KeptAfterSkimming)
+	breakdown, err = rewards.GetTopicWeightBreakdown(ctx, k, 2)
+	s.Require().NoError(err)
+	s.Require().True(breakdown.NoPriorWeight)
+	s.Require().True(alloraMath.NewDecFromInt64(200).Equal(breakdown.Weight))
+	s.Require().True(breakdown.KeptAfterSkimming)
+
+	// An inactive topic is evaluated at the block it would be activated at
+	breakdown, err = rewards.GetTopicWeightBreakdown(ctx, k, 3)
+	s.Require().NoError(err)
+	s.Require().True(breakdown.Weight.IsZero())
+	s.Require().False(breakdown.AboveMinTopicWeight)
+	s.Require().False(breakdown.IsActive)
+	s.Require().Equal(int64(16), breakdown.NextChurningBlock)
+	s.Require().False(breakdown.KeptAfterSkimming)
+}
@@ -1,6 +1,7 @@
 package rewards_test
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	alloraMath "github.com/allora-network/allora-chain/math"
@@ -11,22 +12,35 @@ import (
 
 func createNewTopic(s *RewardsTestSuite) uint64 {
 	newTopicMsg := &types.CreateNewTopicRequest{
-		Creator:                  s.addrs[5].String(),
-		Metadata:                 "test",
-		LossMethod:               "mse",
-		EpochLength:              10800,
-		GroundTruthLag:           10800,
-		WorkerSubmissionWindow:   10,
-		AlphaRegret:              alloraMath.NewDecFromInt64(1),
-		PNorm:                    alloraMath.NewDecFromInt64(3),
-		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
-		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
-		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
-		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
-		AllowNegative:            false,
-		EnableWorkerWhitelist:    true,
-		EnableReputerWhitelist:   true,
+		Creator:                       s.addrs[5].String(),
+		Metadata:                      "test",
+		LossMethod:                    "mse",
+		EpochLength:                   10800,
+		GroundTruthLag:                10800,
+		WorkerSubmissionWindow:        10,
+		AlphaRegret:                   alloraMath.NewDecFromInt64(1),
+		PNorm:                         alloraMath.NewDecFromInt64(3),
+		Epsilon:                       alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:           alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile:      alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:         alloraMath.MustNewDecFromString("0.2"),
+		AllowNegative:                 false,
+		EnableWorkerWhitelist:         true,
+		EnableReputerWhitelist:        true,
+		Tags:                          nil,
+		OutputDimension:               0,
+		VectorSynthesisMode:           types.VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED,
+		QuantileLevels:                nil,
+		LossComputationMode:           types.LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED,
+		WorkerRevealWindow:            0,
+		WorkerBond:                    cosmosMath.ZeroInt(),
+		WorkerBondSlashMissedEpochs:   0,
+		WorkerBondSlashFraction:       alloraMath.ZeroDec(),
+		ReputerSlashDistanceThreshold: alloraMath.ZeroDec(),
+		ReputerSlashEpochs:            0,
+		ReputerSelfSlashFraction:      alloraMath.ZeroDec(),
+		ReputerDelegateSlashFraction:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -575,22 +589,27 @@ func mockNetworkLosses(s *RewardsTestSuite, topicId uint64, block int64) (types.
 		{
 			Worker: s.addrs[0].String(),
 			Value:  alloraMath.MustNewDecFromString("0.01327"),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[1].String(),
 			Value:  alloraMath.MustNewDecFromString("0.01302"),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[2].String(),
 			Value:  alloraMath.MustNewDecFromString("0.0136"),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[3].String(),
 			Value:  alloraMath.MustNewDecFromString("0.01491"),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[4].String(),
 			Value:  alloraMath.MustNewDecFromString("0.01686"),
+			Values: nil,
 		},
 	}
 
@@ -598,22 +617,27 @@ func mockNetworkLosses(s *RewardsTestSuite, topicId uint64, block int64) (types.
 		{
 			Worker: s.addrs[0].String(),
 			Value:  alloraMath.MustNewDecFromString("0.01402"),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[1].String(),
 			Value:  alloraMath.MustNewDecFromString("0.01316"),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[2].String(),
 			Value:  alloraMath.MustNewDecFromString("0.01657"),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[3].String(),
 			Value:  alloraMath.MustNewDecFromString("0.0124"),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[4].String(),
 			Value:  alloraMath.MustNewDecFromString("0.01341"),
+			Values: nil,
 		},
 	}
 
@@ -621,22 +645,27 @@ func mockNetworkLosses(s *RewardsTestSuite, topicId uint64, block int64) (types.
 		{
 			Worker: s.addrs[0].String(),
 			Value:  alloraMath.MustNewDecFromString("0.01529"),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[1].String(),
 			Value:  alloraMath.MustNewDecFromString("0.01141"),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[2].String(),
 			Value:  alloraMath.MustNewDecFromString("0.01562"),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[3].String(),
 			Value:  alloraMath.MustNewDecFromString("0.01444"),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[4].String(),
 			Value:  alloraMath.MustNewDecFromString("0.01396"),
+			Values: nil,
 		},
 	}
 
@@ -653,6 +682,8 @@ func mockNetworkLosses(s *RewardsTestSuite, topicId uint64, block int64) (types.
 		OneOutForecasterValues:        oneOutForecasterLosses,
 		OneInForecasterValues:         oneInNaiveLosses,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 
 	// Persist network losses
@@ -674,14 +705,17 @@ func mockSimpleNetworkLosses(
 		{
 			Worker: s.addrs[0].String(),
 			Value:  alloraMath.MustNewDecFromString(worker0Value),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[1].String(),
 			Value:  alloraMath.MustNewDecFromString("0.3"),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[2].String(),
 			Value:  alloraMath.MustNewDecFromString("0.4"),
+			Values: nil,
 		},
 	}
 
@@ -689,14 +723,17 @@ func mockSimpleNetworkLosses(
 		{
 			Worker: s.addrs[0].String(),
 			Value:  alloraMath.MustNewDecFromString(worker0Value),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[1].String(),
 			Value:  alloraMath.MustNewDecFromString("0.3"),
+			Values: nil,
 		},
 		{
 			Worker: s.addrs[2].String(),
 			Value:  alloraMath.MustNewDecFromString("0.4"),
+			Values: nil,
 		},
 	}
 
@@ -713,6 +750,8 @@ func mockSimpleNetworkLosses(
 		OneOutForecasterValues:        genericLossesWithheld,
 		OneInForecasterValues:         genericLosses,
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 
 	err := s.emissionsKeeper.InsertNetworkLossBundleAtBlock(s.ctx, topicId, block, networkLosses)
@@ -22,12 +22,12 @@ func RemoveStakes(
 		return errors.Wrapf(err, "Unable to get stake removals for block %d", currentBlock)
 	}
 	if limitHit {
-		return errors.Wrapf(
-			err,
+		// the rest of the queue is picked up in the following blocks
+		sdkCtx.Logger().Info(fmt.Sprintf(
 			"Hit limit of number of stake removals we can process up until block %d, only removing %d",
 			currentBlock,
 			limitToProcess,
-		)
+		))
 	}
 	for _, stakeRemoval := range removals {
 		// attempt writes in a cache context, only write finally if there are no errors
@@ -36,7 +36,7 @@ func RemoveStakes(
 		// Update the stake data structures
 		err = k.RemoveReputerStake(
 			cacheSdkCtx,
-			currentBlock,
+			stakeRemoval.BlockRemovalCompleted,
 			stakeRemoval.TopicId,
 			stakeRemoval.Reputer,
 			stakeRemoval.Amount,
@@ -47,6 +47,7 @@ func RemoveStakes(
 				stakeRemoval,
 				err,
 			))
+			requeueStakeRemoval(sdkCtx, currentBlock, k, stakeRemoval, err)
 			continue
 		}
 
@@ -70,6 +71,7 @@ func RemoveStakes(
 				stakeRemoval,
 				err,
 			))
+			requeueStakeRemoval(sdkCtx, currentBlock, k, stakeRemoval, err)
 			continue
 		}
 
@@ -93,12 +95,12 @@ func RemoveDelegateStakes(
 		return errors.Wrapf(err, "Unable to get stake removals for block %d", currentBlock)
 	}
 	if limitHit {
-		return errors.Wrapf(
-			err,
+		// the rest of the queue is picked up in the following blocks
+		sdkCtx.Logger().Info(fmt.Sprintf(
 			"Hit limit of number of stake removals we can process up until block %d, only removing %d",
 			currentBlock,
 			limitToProcess,
-		)
+		))
 	}
 	for _, stakeRemoval := range removals {
 		// attempt writes in a cache context, only write finally if there are no errors
@@ -119,6 +121,7 @@ func RemoveDelegateStakes(
 				stakeRemoval,
 				err,
 			))
+			requeueDelegateStakeRemoval(sdkCtx, currentBlock, k, stakeRemoval, err)
 			continue
 		}
 
@@ -140,6 +143,69 @@ func RemoveDelegateStakes(
 				stakeRemoval,
 				err,
 			))
+			requeueDelegateStakeRemoval(sdkCtx, currentBlock, k, stakeRemoval, err)
+			continue
+		}
+
+		write()
+	}
+
+	return nil
+}
+
+// remove all worker bonds that have been marked for removal this block
+func RemoveWorkerBonds(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+	limitToProcess uint64,
+) error {
+	removals, limitHit, err := k.GetWorkerBondRemovalsUpUntilBlock(sdkCtx, currentBlock, limitToProcess)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get worker bond removals for block %d", currentBlock)
+	}
+	if limitHit {
+		sdkCtx.Logger().Info(fmt.Sprintf(
+			"Hit limit of number of worker bond removals we can process up until block %d, only removing %d",
+			currentBlock,
+			limitToProcess,
+		))
+	}
+	for _, bondRemoval := range removals {
+		// attempt writes in a cache context, only write finally if there are no errors
+		cacheSdkCtx, write := sdkCtx.CacheContext()
+
+		err = k.RemoveWorkerBond(
+			cacheSdkCtx,
+			bondRemoval.BlockRemovalCompleted,
+			bondRemoval.TopicId,
+			bondRemoval.Worker,
+			bondRemoval.Amount,
+		)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf(
+				"Error removing worker bond data structures: %v | %v",
+				bondRemoval,
+				err,
+			))
+			requeueWorkerBondRemoval(sdkCtx, currentBlock, k, bondRemoval, err)
+			continue
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(chainParams.DefaultBondDenom, bondRemoval.Amount))
+		err = k.SendCoinsFromModuleToAccount(
+			cacheSdkCtx,
+			emissionstypes.AlloraStakingAccountName,
+			bondRemoval.Worker,
+			coins,
+		)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf(
+				"Error removing worker bond funds: %v | %v",
+				bondRemoval,
+				err,
+			))
+			requeueWorkerBondRemoval(sdkCtx, currentBlock, k, bondRemoval, err)
 			continue
 		}
 
@@ -148,3 +214,161 @@ func RemoveDelegateStakes(
 
 	return nil
 }
+
+// Moves a stake removal that failed to the next block. The queue is read from its oldest
+// entry, so a removal left in place would be read again every block and a full page of
+// failing removals would hold back every removal behind it
+func requeueStakeRemoval(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+	stakeRemoval emissionstypes.StakeRemovalInfo,
+	reason error,
+) {
+	err := k.DeleteStakeRemoval(sdkCtx, stakeRemoval.BlockRemovalCompleted, stakeRemoval.TopicId, stakeRemoval.Reputer)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Error dequeuing failed stake removal: %v | %v", stakeRemoval, err))
+		return
+	}
+	stakeRemoval.BlockRemovalCompleted = currentBlock + 1
+	err = k.SetStakeRemoval(sdkCtx, stakeRemoval)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Error requeuing failed stake removal: %v | %v", stakeRemoval, err))
+		return
+	}
+	emissionstypes.EmitNewStakeRemovalRequeuedEvent(
+		sdkCtx,
+		stakeRemoval.TopicId,
+		stakeRemoval.Reputer,
+		"",
+		stakeRemoval.Amount,
+		stakeRemoval.BlockRemovalCompleted,
+		reason.Error(),
+	)
+}
+
+// Moves a delegate stake removal that failed to the next block, see requeueStakeRemoval
+func requeueDelegateStakeRemoval(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+	stakeRemoval emissionstypes.DelegateStakeRemovalInfo,
+	reason error,
+) {
+	err := k.DeleteDelegateStakeRemoval(
+		sdkCtx,
+		stakeRemoval.BlockRemovalCompleted,
+		stakeRemoval.TopicId,
+		stakeRemoval.Reputer,
+		stakeRemoval.Delegator,
+	)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Error dequeuing failed delegate stake removal: %v | %v", stakeRemoval, err))
+		return
+	}
+	stakeRemoval.BlockRemovalCompleted = currentBlock + 1
+	err = k.SetDelegateStakeRemoval(sdkCtx, stakeRemoval)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Error requeuing failed delegate stake removal: %v | %v", stakeRemoval, err))
+		return
+	}
+	emissionstypes.EmitNewStakeRemovalRequeuedEvent(
+		sdkCtx,
+		stakeRemoval.TopicId,
+		stakeRemoval.Reputer,
+		stakeRemoval.Delegator,
+		stakeRemoval.Amount,
+		stakeRemoval.BlockRemovalCompleted,
+		reason.Error(),
+	)
+}
+
+// Moves a worker bond removal that failed to the next block, see requeueStakeRemoval
+func requeueWorkerBondRemoval(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+	bondRemoval emissionstypes.WorkerBondRemovalInfo,
+	reason error,
+) {
+	err := k.DeleteWorkerBondRemoval(sdkCtx, bondRemoval.BlockRemovalCompleted, bondRemoval.TopicId, bondRemoval.Worker)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Error dequeuing failed worker bond removal: %v | %v", bondRemoval, err))
+		return
+	}
+	bondRemoval.BlockRemovalCompleted = currentBlock + 1
+	err = k.SetWorkerBondRemoval(sdkCtx, bondRemoval)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Error requeuing failed worker bond removal: %v | %v", bondRemoval, err))
+		return
+	}
+	emissionstypes.EmitNewWorkerBondRemovalRequeuedEvent(
+		sdkCtx,
+		bondRemoval.TopicId,
+		bondRemoval.Worker,
+		bondRemoval.Amount,
+		bondRemoval.BlockRemovalCompleted,
+		reason.Error(),
+	)
+}
+
+// Complete all redelegations that have waited out the stake removal delay this block.
+// The stake already sits with the destination reputer, only the record kept for slashing is dropped
+func CompleteRedelegations(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+	limitToProcess uint64,
+) error {
+	redelegations, limitHit, err := k.GetRedelegationsUpUntilBlock(sdkCtx, currentBlock, limitToProcess)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get redelegations for block %d", currentBlock)
+	}
+	if limitHit {
+		sdkCtx.Logger().Info(fmt.Sprintf(
+			"Hit limit of number of redelegations we can process up until block %d, only completing %d",
+			currentBlock,
+			limitToProcess,
+		))
+	}
+	for _, redelegation := range redelegations {
+		err = k.DeleteRedelegation(
+			sdkCtx,
+			redelegation.TopicId,
+			redelegation.SrcReputer,
+			redelegation.Delegator,
+			redelegation.DstReputer,
+		)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf(
+				"Error completing redelegation: %v | %v",
+				redelegation,
+				err,
+			))
+		}
+	}
+
+	return nil
+}
+
+// Ends the stake locks that expire up until the current block, turning their stake back into stake
+// that can be removed
+func UnlockStakeLocks(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+	limitToProcess uint64,
+) error {
+	limitHit, err := k.UnlockStakeLocksUpUntilBlock(sdkCtx, currentBlock, limitToProcess)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to unlock stake locks for block %d", currentBlock)
+	}
+	if limitHit {
+		sdkCtx.Logger().Info(fmt.Sprintf(
+			"Hit limit of number of stake locks we can unlock up until block %d, only unlocking %d",
+			currentBlock,
+			limitToProcess,
+		))
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	"cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxWorkerPayloadSaltLength is the maximum length of the salt hashed with a committed payload
+const MaxWorkerPayloadSaltLength = 64
+
+// UsesCommitReveal returns true if workers of the topic commit to their payload during
+// the worker submission window and reveal it during the worker reveal window
+func (topic Topic) UsesCommitReveal() bool {
+	return topic.WorkerRevealWindow > 0
+}
+
+// WorkerPayloadCommitment returns the commitment to an inference forecast bundle, which is
+// the sha256 hash of the marshaled bundle followed by the salt
+func WorkerPayloadCommitment(bundle *InferenceForecastBundle, salt []byte) ([]byte, error) {
+	if bundle == nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "inference forecasts bundle cannot be nil")
+	}
+	marshaled, err := bundle.Marshal()
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to marshal inference forecasts bundle: %s", err)
+	}
+	hash := sha256.New()
+	hash.Write(marshaled)
+	hash.Write(salt)
+	return hash.Sum(nil), nil
+}
+
+func validateCommitment(commitment []byte) error {
+	if len(commitment) != sha256.Size {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "commitment must be a sha256 hash of %d bytes", sha256.Size)
+	}
+	return nil
+}
+
+// Validate checks that a stored worker payload commit is well formed
+func (commit *WorkerPayloadCommit) Validate() error {
+	if err := ValidateTopicId(commit.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if err := ValidateBlockHeight(commit.BlockHeight); err != nil {
+		return errors.Wrap(err, "invalid block height")
+	}
+	if err := ValidateBech32(commit.Worker); err != nil {
+		return errors.Wrap(err, "invalid worker address")
+	}
+	return validateCommitment(commit.Commitment)
+}
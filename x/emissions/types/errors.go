@@ -95,4 +95,37 @@ var (
 	ErrNotPermittedToAddStake                    = errors.Register(ModuleName, 90, "not permitted to add stake")
 	ErrNotPermittedToUpdateWhitelistAdmins       = errors.Register(ModuleName, 91, "not permitted to update whitelist admins")
 	ErrMaxWhitelistInputArrayLengthExceeded      = errors.Register(ModuleName, 92, "max whitelist input array length exceeded")
+	ErrNotPermittedToUpdateTopic                 = errors.Register(ModuleName, 93, "not permitted to update topic")
+	ErrNotPermittedToArchiveTopic                = errors.Register(ModuleName, 94, "not permitted to archive topic")
+	ErrTopicArchived                             = errors.Register(ModuleName, 95, "topic is archived")
+	ErrNotPermittedToTransferTopicOwnership      = errors.Register(ModuleName, 96, "not permitted to transfer topic ownership")
+	ErrNoPendingTopicOwnershipTransfer           = errors.Register(ModuleName, 97, "no pending topic ownership transfer")
+	ErrNotPendingTopicOwner                      = errors.Register(ModuleName, 98, "sender is not the pending topic owner")
+	ErrInvalidOutputDimension                    = errors.Register(ModuleName, 99, "number of values does not match topic output dimension")
+	ErrInvalidQuantiles                          = errors.Register(ModuleName, 100, "invalid quantiles")
+	ErrLossComputationModeMismatch               = errors.Register(ModuleName, 101, "submission does not match topic loss computation mode")
+	ErrInvalidGroundTruth                        = errors.Register(ModuleName, 102, "invalid ground truth")
+	ErrCommitRevealModeMismatch                  = errors.Register(ModuleName, 103, "submission does not match topic commit-reveal mode")
+	ErrWorkerPayloadCommitNotFound               = errors.Register(ModuleName, 104, "worker payload commit not found")
+	ErrRevealDoesNotMatchCommit                  = errors.Register(ModuleName, 105, "revealed payload does not match commit")
+	ErrOperatorAlreadyAuthorized                 = errors.Register(ModuleName, 106, "operator already authorized")
+	ErrOperatorNotAuthorized                     = errors.Register(ModuleName, 107, "operator not authorized")
+	ErrTooManyOperators                          = errors.Register(ModuleName, 108, "too many operators")
+	ErrInsufficientWorkerBond                    = errors.Register(ModuleName, 109, "insufficient worker bond")
+	ErrWorkerBondNotFound                        = errors.Register(ModuleName, 110, "worker bond not found")
+	ErrWorkerBondNotRequired                     = errors.Register(ModuleName, 111, "topic does not require a worker bond")
+	ErrSelfRedelegation                          = errors.Register(ModuleName, 112, "cannot redelegate to the same reputer")
+	ErrTransitiveRedelegation                    = errors.Register(ModuleName, 113, "cannot redelegate stake that is still being redelegated")
+	ErrInsufficientDelegateStakeToRedelegate     = errors.Register(ModuleName, 114, "insufficient delegate stake to redelegate")
+	ErrRedelegationNotFound                      = errors.Register(ModuleName, 115, "redelegation not found")
+	ErrInsufficientStakeToMove                   = errors.Register(ModuleName, 116, "insufficient stake to move")
+	ErrReputerDeviatingFromConsensus             = errors.Register(ModuleName, 117, "reputer is deviating from the loss consensus")
+	ErrInvalidReputerCommission                  = errors.Register(ModuleName, 118, "invalid reputer commission")
+	ErrReputerCommissionUpdateTooSoon            = errors.Register(ModuleName, 119, "reputer commission can only be changed once per topic epoch")
+	ErrReputerCommissionChangeTooLarge           = errors.Register(ModuleName, 120, "reputer commission change exceeds its max change rate")
+	ErrReputerCommissionAboveMaxRate             = errors.Register(ModuleName, 121, "reputer commission exceeds its max rate")
+	ErrReputerStakeShareCapExceeded              = errors.Register(ModuleName, 122, "stake would exceed the largest share of topic stake one reputer may hold")
+	ErrInvalidStakeLockTier                      = errors.Register(ModuleName, 123, "stake lock period is not one of the stake lock tiers")
+	ErrStakeLocked                               = errors.Register(ModuleName, 124, "stake is locked")
+	ErrNotPermittedToForfeitRewards              = errors.Register(ModuleName, 125, "not permitted to forfeit rewards held in escrow")
 )
@@ -4,8 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_allora_network_allora_chain_math "github.com/allora-network/allora-chain/math"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -903,678 +905,1154 @@ func (m *EventTopicInitialEmaScoreSet) GetBlockHeight() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("emissions.v7.ActorType", ActorType_name, ActorType_value)
-	proto.RegisterType((*EventScoresSet)(nil), "emissions.v7.EventScoresSet")
-	proto.RegisterType((*EventRewardsSettled)(nil), "emissions.v7.EventRewardsSettled")
-	proto.RegisterType((*EventNetworkLossSet)(nil), "emissions.v7.EventNetworkLossSet")
-	proto.RegisterType((*EventForecastTaskScoreSet)(nil), "emissions.v7.EventForecastTaskScoreSet")
-	proto.RegisterType((*EventWorkerLastCommitSet)(nil), "emissions.v7.EventWorkerLastCommitSet")
-	proto.RegisterType((*EventReputerLastCommitSet)(nil), "emissions.v7.EventReputerLastCommitSet")
-	proto.RegisterType((*EventTopicRewardsSet)(nil), "emissions.v7.EventTopicRewardsSet")
-	proto.RegisterType((*EventEMAScoresSet)(nil), "emissions.v7.EventEMAScoresSet")
-	proto.RegisterType((*EventListeningCoefficientsSet)(nil), "emissions.v7.EventListeningCoefficientsSet")
-	proto.RegisterType((*EventInfererNetworkRegretSet)(nil), "emissions.v7.EventInfererNetworkRegretSet")
-	proto.RegisterType((*EventForecasterNetworkRegretSet)(nil), "emissions.v7.EventForecasterNetworkRegretSet")
-	proto.RegisterType((*EventNaiveInfererNetworkRegretSet)(nil), "emissions.v7.EventNaiveInfererNetworkRegretSet")
-	proto.RegisterType((*EventTopicInitialRegretSet)(nil), "emissions.v7.EventTopicInitialRegretSet")
-	proto.RegisterType((*EventTopicInitialEmaScoreSet)(nil), "emissions.v7.EventTopicInitialEmaScoreSet")
+type EventTopicUpdated struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	OldTopic    *Topic `protobuf:"bytes,3,opt,name=old_topic,json=oldTopic,proto3" json:"old_topic,omitempty"`
+	NewTopic    *Topic `protobuf:"bytes,4,opt,name=new_topic,json=newTopic,proto3" json:"new_topic,omitempty"`
 }
 
-func init() { proto.RegisterFile("emissions/v7/events.proto", fileDescriptor_8a10150f55db931c) }
-
-var fileDescriptor_8a10150f55db931c = []byte{
-	// 789 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4f, 0x6f, 0x22, 0x37,
-	0x14, 0xc7, 0x40, 0x08, 0x38, 0x28, 0x4a, 0x27, 0x69, 0x3b, 0xd0, 0x94, 0x90, 0x39, 0xd1, 0x4a,
-	0x65, 0xa4, 0x44, 0x6a, 0x7a, 0xe8, 0x85, 0x90, 0x41, 0x45, 0x4a, 0x20, 0x35, 0xa4, 0x55, 0xdb,
-	0xc3, 0xc8, 0x0c, 0x0e, 0x58, 0xc0, 0x18, 0xd9, 0x86, 0x34, 0xe7, 0xfe, 0x51, 0x8f, 0xe9, 0xe7,
-	0xe8, 0x17, 0xc9, 0xa5, 0x52, 0xb4, 0xa7, 0xd5, 0x1e, 0xa2, 0x55, 0xf8, 0x0c, 0x7b, 0xdb, 0xc3,
-	0x6a, 0x3c, 0xfc, 0x19, 0xb4, 0xab, 0xdd, 0x95, 0x60, 0x37, 0xd9, 0xbd, 0xd9, 0xef, 0x3d, 0x3d,
-	0xff, 0x7e, 0x3f, 0xfb, 0xbd, 0x67, 0x98, 0x22, 0x3d, 0x2a, 0x04, 0x65, 0xae, 0x30, 0x87, 0x07,
-	0x26, 0x19, 0x12, 0x57, 0x8a, 0x7c, 0x9f, 0x33, 0xc9, 0xb4, 0xe4, 0xd4, 0x95, 0x1f, 0x1e, 0xa4,
-	0xf5, 0x40, 0xe0, 0xbe, 0xe9, 0x32, 0xd7, 0x21, 0x7e, 0x5c, 0x3a, 0x3d, 0xe7, 0xe1, 0xa4, 0x3f,
-	0x90, 0x84, 0x8f, 0x7d, 0x5b, 0x2d, 0xd6, 0x62, 0x6a, 0x69, 0x7a, 0x2b, 0xdf, 0x6a, 0x3c, 0x03,
-	0x70, 0xdd, 0xf2, 0x8e, 0xaa, 0x39, 0x8c, 0x13, 0x51, 0x23, 0x52, 0xfb, 0x16, 0x42, 0xec, 0x48,
-	0xc6, 0x6d, 0x79, 0xd9, 0x27, 0x3a, 0xc8, 0x82, 0xdc, 0xfa, 0xde, 0xe7, 0xf9, 0x20, 0x82, 0x7c,
-	0xc1, 0xf3, 0xd7, 0x2f, 0xfb, 0x04, 0x25, 0xf0, 0x64, 0xa9, 0xa5, 0x60, 0x5c, 0xb2, 0x3e, 0x75,
-	0x6c, 0xda, 0xd4, 0xc3, 0x59, 0x90, 0x8b, 0xa2, 0x55, 0xb5, 0x2f, 0x37, 0xb5, 0x5d, 0x98, 0x6c,
-	0x74, 0x99, 0xd3, 0xb1, 0xdb, 0x84, 0xb6, 0xda, 0x52, 0x8f, 0x64, 0x41, 0x2e, 0x82, 0xd6, 0x94,
-	0xed, 0x07, 0x65, 0xd2, 0xb6, 0x61, 0x02, 0x37, 0x9b, 0x9c, 0x08, 0x41, 0x84, 0x1e, 0xcd, 0x46,
-	0x72, 0x09, 0x34, 0x33, 0x68, 0x55, 0x18, 0x13, 0x0a, 0xa0, 0xbe, 0xe2, 0xb9, 0x0e, 0x0f, 0xae,
-	0x6f, 0x77, 0x42, 0x4f, 0x6e, 0x77, 0xcc, 0x16, 0x95, 0xed, 0x41, 0x23, 0xef, 0xb0, 0x9e, 0x89,
-	0xbb, 0x5d, 0xc6, 0xf1, 0x37, 0x2e, 0x91, 0x17, 0x8c, 0x77, 0x26, 0x5b, 0xa7, 0x8d, 0xa9, 0x6b,
-	0xf6, 0xb0, 0x6c, 0xe7, 0x8f, 0x88, 0x83, 0xc6, 0x69, 0x8c, 0xe7, 0x00, 0x6e, 0x2a, 0xde, 0x88,
-	0x5c, 0x60, 0xde, 0xf4, 0x88, 0xcb, 0x2e, 0x69, 0x3e, 0x48, 0xf2, 0x3f, 0xc2, 0x55, 0xee, 0xa3,
-	0x5c, 0x94, 0xfd, 0x24, 0x8f, 0xf1, 0xef, 0x84, 0x7e, 0xc5, 0x8f, 0x3f, 0x66, 0x42, 0xdd, 0x7d,
-	0x90, 0x06, 0x78, 0x3d, 0x8d, 0xf0, 0xcb, 0x34, 0xbe, 0x87, 0xc9, 0x21, 0xee, 0x0e, 0x88, 0xdd,
-	0x18, 0xb8, 0xcd, 0x2e, 0x51, 0x4c, 0xd7, 0xf6, 0x52, 0x41, 0xf9, 0xf6, 0xf3, 0x3f, 0x79, 0x11,
-	0x87, 0x2a, 0x00, 0xad, 0x0d, 0x67, 0x1b, 0xe3, 0x2f, 0x00, 0x53, 0x0a, 0x53, 0x89, 0x71, 0xe2,
-	0x60, 0x21, 0xeb, 0x58, 0x74, 0xd4, 0xb3, 0x7c, 0x03, 0xb2, 0x13, 0xb8, 0xa2, 0x6e, 0x55, 0x41,
-	0x5a, 0x40, 0x1d, 0x3f, 0x8b, 0xf1, 0x07, 0x80, 0xba, 0xc2, 0xf1, 0x33, 0xe3, 0x1d, 0xc2, 0x8f,
-	0xb1, 0x90, 0x45, 0xd6, 0xeb, 0x51, 0xb9, 0xb8, 0x40, 0x5f, 0xc1, 0x15, 0x55, 0xae, 0x63, 0x65,
-	0x36, 0xe7, 0x95, 0xa9, 0x78, 0x2e, 0xe4, 0x47, 0x18, 0x7f, 0x4e, 0xd4, 0x40, 0x7e, 0x15, 0xdf,
-	0x13, 0x8c, 0xbf, 0x01, 0xdc, 0x52, 0x30, 0xea, 0x5e, 0xfa, 0x59, 0xb1, 0x68, 0x5f, 0xc0, 0xc4,
-	0x04, 0x81, 0xd0, 0x41, 0x36, 0x92, 0x8b, 0xa2, 0xf8, 0x18, 0xc2, 0xdc, 0x8b, 0x0d, 0x2f, 0xe9,
-	0xc5, 0xfe, 0x13, 0x86, 0x9f, 0x28, 0x20, 0xd6, 0x49, 0xe1, 0x9d, 0xf6, 0xaa, 0xad, 0xa0, 0x38,
-	0x91, 0xb1, 0x0e, 0xef, 0xb9, 0x3d, 0x79, 0xea, 0x52, 0x61, 0x63, 0x47, 0xd2, 0x21, 0xd1, 0x63,
-	0xd9, 0x48, 0x2e, 0x8e, 0xe2, 0x54, 0x14, 0xd4, 0xde, 0xb8, 0x0a, 0xc3, 0x2f, 0x95, 0x14, 0xc7,
-	0x54, 0x48, 0xe2, 0x52, 0xb7, 0x55, 0x64, 0xe4, 0xfc, 0x9c, 0x3a, 0xd4, 0x1b, 0x19, 0x0f, 0xb5,
-	0x85, 0xff, 0x06, 0x93, 0x4e, 0x00, 0xe6, 0xa2, 0x4a, 0xcd, 0x25, 0x33, 0xfe, 0x07, 0x70, 0x5b,
-	0x49, 0x52, 0x76, 0xcf, 0x09, 0x27, 0x7c, 0xdc, 0xd6, 0x10, 0x69, 0x71, 0xb2, 0x84, 0x82, 0x99,
-	0x63, 0x16, 0x79, 0x65, 0x7f, 0xf6, 0x0e, 0x1a, 0xb3, 0x5e, 0xe8, 0xb5, 0xab, 0x3c, 0xc6, 0x0d,
-	0x80, 0x3b, 0x73, 0xbd, 0xf0, 0xc3, 0xa7, 0xf4, 0x08, 0xc0, 0x5d, 0x7f, 0xe4, 0x60, 0x3a, 0x24,
-	0x1f, 0xc9, 0x3d, 0xfd, 0x07, 0x60, 0x7a, 0xd6, 0x1e, 0xcb, 0x2e, 0x95, 0x14, 0x77, 0x97, 0xc5,
-	0xa6, 0x0a, 0x63, 0xfe, 0x39, 0xaa, 0xd8, 0x16, 0xe9, 0x2a, 0x7e, 0x1a, 0x63, 0x34, 0xa9, 0x92,
-	0x20, 0x5a, 0xab, 0x87, 0xa7, 0x43, 0xf6, 0x7e, 0xfa, 0xc6, 0x74, 0x7e, 0x47, 0x97, 0x31, 0xbf,
-	0xbf, 0x6e, 0xc0, 0xc4, 0x14, 0xa4, 0x66, 0xc0, 0x4c, 0xa1, 0x58, 0xaf, 0x22, 0xbb, 0xfe, 0xcb,
-	0xa9, 0x65, 0x97, 0x2b, 0x25, 0x0b, 0x59, 0xc8, 0x3e, 0xab, 0xd4, 0x4e, 0xad, 0x62, 0xb9, 0x54,
-	0xb6, 0x8e, 0x36, 0x42, 0x5a, 0x0a, 0x7e, 0x1a, 0x88, 0x29, 0x55, 0x91, 0x55, 0x2c, 0xd4, 0xea,
-	0x16, 0xda, 0x00, 0xda, 0x67, 0x50, 0x0b, 0xb8, 0x90, 0x75, 0x7a, 0xe6, 0xd9, 0xc3, 0x87, 0xe8,
-	0xfa, 0x2e, 0x03, 0x6e, 0xee, 0x32, 0xe0, 0xe9, 0x5d, 0x06, 0x5c, 0x8d, 0x32, 0xa1, 0x9b, 0x51,
-	0x26, 0xf4, 0x78, 0x94, 0x09, 0xfd, 0xfa, 0xdd, 0x5b, 0xa2, 0xfe, 0xdd, 0x9c, 0xfd, 0xd5, 0x3d,
-	0xb5, 0x45, 0x23, 0xa6, 0x7e, 0xe4, 0xfb, 0x2f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xee, 0xe5, 0xe2,
-	0xca, 0x08, 0x0c, 0x00, 0x00,
+func (m *EventTopicUpdated) Reset()         { *m = EventTopicUpdated{} }
+func (m *EventTopicUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventTopicUpdated) ProtoMessage()    {}
+func (*EventTopicUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a10150f55db931c, []int{14}
 }
-
-func (m *EventScoresSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTopicUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTopicUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTopicUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventScoresSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventTopicUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTopicUpdated.Merge(m, src)
+}
+func (m *EventTopicUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTopicUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTopicUpdated.DiscardUnknown(m)
 }
 
-func (m *EventScoresSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Scores) > 0 {
-		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Scores[iNdEx].Size()
-				i -= size
-				if _, err := m.Scores[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
+var xxx_messageInfo_EventTopicUpdated proto.InternalMessageInfo
+
+func (m *EventTopicUpdated) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *EventTopicUpdated) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if m.ActorType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventTopicUpdated) GetOldTopic() *Topic {
+	if m != nil {
+		return m.OldTopic
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventRewardsSettled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTopicUpdated) GetNewTopic() *Topic {
+	if m != nil {
+		return m.NewTopic
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventRewardsSettled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// a pending topic update dropped at the topic's epoch boundary because the
+// updated topic no longer passes validation, e.g. after module params changed
+type EventTopicUpdateRejected struct {
+	TopicId     uint64               `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64                `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Update      *OptionalTopicParams `protobuf:"bytes,3,opt,name=update,proto3" json:"update,omitempty"`
+	Reason      string               `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *EventRewardsSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Rewards[iNdEx].Size()
-				i -= size
-				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *EventTopicUpdateRejected) Reset()         { *m = EventTopicUpdateRejected{} }
+func (m *EventTopicUpdateRejected) String() string { return proto.CompactTextString(m) }
+func (*EventTopicUpdateRejected) ProtoMessage()    {}
+func (*EventTopicUpdateRejected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a10150f55db931c, []int{15}
+}
+func (m *EventTopicUpdateRejected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTopicUpdateRejected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTopicUpdateRejected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *EventTopicUpdateRejected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTopicUpdateRejected.Merge(m, src)
+}
+func (m *EventTopicUpdateRejected) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTopicUpdateRejected) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTopicUpdateRejected.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTopicUpdateRejected proto.InternalMessageInfo
+
+func (m *EventTopicUpdateRejected) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *EventTopicUpdateRejected) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if m.ActorType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventTopicUpdateRejected) GetUpdate() *OptionalTopicParams {
+	if m != nil {
+		return m.Update
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventNetworkLossSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTopicUpdateRejected) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventNetworkLossSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventTopicArchived struct {
+	TopicId            uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight        int64                 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	RefundRecipient    string                `protobuf:"bytes,3,opt,name=refund_recipient,json=refundRecipient,proto3" json:"refund_recipient,omitempty"`
+	RefundedFeeRevenue cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=refunded_fee_revenue,json=refundedFeeRevenue,proto3,customtype=cosmossdk.io/math.Int" json:"refunded_fee_revenue"`
 }
 
-func (m *EventNetworkLossSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValueBundle != nil {
-		{
-			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventTopicArchived) Reset()         { *m = EventTopicArchived{} }
+func (m *EventTopicArchived) String() string { return proto.CompactTextString(m) }
+func (*EventTopicArchived) ProtoMessage()    {}
+func (*EventTopicArchived) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a10150f55db931c, []int{16}
+}
+func (m *EventTopicArchived) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTopicArchived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTopicArchived.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *EventTopicArchived) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTopicArchived.Merge(m, src)
+}
+func (m *EventTopicArchived) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTopicArchived) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTopicArchived.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTopicArchived proto.InternalMessageInfo
+
+func (m *EventTopicArchived) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventTopicArchived) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventForecastTaskScoreSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTopicArchived) GetRefundRecipient() string {
+	if m != nil {
+		return m.RefundRecipient
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventForecastTaskScoreSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventTopicOwnershipTransferProposed struct {
+	TopicId      uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight  int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Owner        string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	PendingOwner string `protobuf:"bytes,4,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
 }
 
-func (m *EventForecastTaskScoreSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Score.Size()
-		i -= size
-		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventTopicOwnershipTransferProposed) Reset()         { *m = EventTopicOwnershipTransferProposed{} }
+func (m *EventTopicOwnershipTransferProposed) String() string { return proto.CompactTextString(m) }
+func (*EventTopicOwnershipTransferProposed) ProtoMessage()    {}
+func (*EventTopicOwnershipTransferProposed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a10150f55db931c, []int{17}
+}
+func (m *EventTopicOwnershipTransferProposed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTopicOwnershipTransferProposed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTopicOwnershipTransferProposed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventTopicOwnershipTransferProposed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTopicOwnershipTransferProposed.Merge(m, src)
+}
+func (m *EventTopicOwnershipTransferProposed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTopicOwnershipTransferProposed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTopicOwnershipTransferProposed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTopicOwnershipTransferProposed proto.InternalMessageInfo
+
+func (m *EventTopicOwnershipTransferProposed) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventWorkerLastCommitSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTopicOwnershipTransferProposed) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventWorkerLastCommitSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventTopicOwnershipTransferProposed) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *EventWorkerLastCommitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != nil {
-		{
-			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventTopicOwnershipTransferProposed) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return ""
+}
+
+type EventTopicOwnershipTransferred struct {
+	TopicId       uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight   int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	PreviousOwner string `protobuf:"bytes,3,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	NewOwner      string `protobuf:"bytes,4,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *EventTopicOwnershipTransferred) Reset()         { *m = EventTopicOwnershipTransferred{} }
+func (m *EventTopicOwnershipTransferred) String() string { return proto.CompactTextString(m) }
+func (*EventTopicOwnershipTransferred) ProtoMessage()    {}
+func (*EventTopicOwnershipTransferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a10150f55db931c, []int{18}
+}
+func (m *EventTopicOwnershipTransferred) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTopicOwnershipTransferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTopicOwnershipTransferred.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *EventTopicOwnershipTransferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTopicOwnershipTransferred.Merge(m, src)
+}
+func (m *EventTopicOwnershipTransferred) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTopicOwnershipTransferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTopicOwnershipTransferred.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTopicOwnershipTransferred proto.InternalMessageInfo
+
+func (m *EventTopicOwnershipTransferred) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventTopicOwnershipTransferred) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventReputerLastCommitSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTopicOwnershipTransferred) GetPreviousOwner() string {
+	if m != nil {
+		return m.PreviousOwner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventReputerLastCommitSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventTopicOwnershipTransferred) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
 }
 
-func (m *EventReputerLastCommitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != nil {
-		{
-			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+type EventOperatorAuthorized struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Actor       string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Operator    string `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *EventOperatorAuthorized) Reset()         { *m = EventOperatorAuthorized{} }
+func (m *EventOperatorAuthorized) String() string { return proto.CompactTextString(m) }
+func (*EventOperatorAuthorized) ProtoMessage()    {}
+func (*EventOperatorAuthorized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a10150f55db931c, []int{19}
+}
+func (m *EventOperatorAuthorized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOperatorAuthorized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOperatorAuthorized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *EventOperatorAuthorized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOperatorAuthorized.Merge(m, src)
+}
+func (m *EventOperatorAuthorized) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOperatorAuthorized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOperatorAuthorized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOperatorAuthorized proto.InternalMessageInfo
+
+func (m *EventOperatorAuthorized) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventOperatorAuthorized) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventTopicRewardsSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventOperatorAuthorized) GetActor() string {
+	if m != nil {
+		return m.Actor
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventTopicRewardsSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventOperatorAuthorized) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-func (m *EventTopicRewardsSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Rewards[iNdEx].Size()
-				i -= size
-				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+type EventOperatorRevoked struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Actor       string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Operator    string `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *EventOperatorRevoked) Reset()         { *m = EventOperatorRevoked{} }
+func (m *EventOperatorRevoked) String() string { return proto.CompactTextString(m) }
+func (*EventOperatorRevoked) ProtoMessage()    {}
+func (*EventOperatorRevoked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a10150f55db931c, []int{20}
+}
+func (m *EventOperatorRevoked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOperatorRevoked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOperatorRevoked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TopicIds) > 0 {
-		dAtA5 := make([]byte, len(m.TopicIds)*10)
-		var j4 int
-		for _, num := range m.TopicIds {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintEvents(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventOperatorRevoked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOperatorRevoked.Merge(m, src)
+}
+func (m *EventOperatorRevoked) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOperatorRevoked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOperatorRevoked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOperatorRevoked proto.InternalMessageInfo
+
+func (m *EventOperatorRevoked) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventEMAScoresSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventOperatorRevoked) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventEMAScoresSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventOperatorRevoked) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
 }
 
-func (m *EventEMAScoresSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IsActive) > 0 {
-		for iNdEx := len(m.IsActive) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.IsActive[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.IsActive)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Scores) > 0 {
-		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Scores[iNdEx].Size()
-				i -= size
-				if _, err := m.Scores[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+func (m *EventOperatorRevoked) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	return ""
+}
+
+type EventWorkerBondSlashed struct {
+	TopicId       uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight   int64                 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Worker        string                `protobuf:"bytes,3,opt,name=worker,proto3" json:"worker,omitempty"`
+	SlashedAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=slashed_amount,json=slashedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"slashed_amount"`
+	MissedEpochs  int64                 `protobuf:"varint,5,opt,name=missed_epochs,json=missedEpochs,proto3" json:"missed_epochs,omitempty"`
+}
+
+func (m *EventWorkerBondSlashed) Reset()         { *m = EventWorkerBondSlashed{} }
+func (m *EventWorkerBondSlashed) String() string { return proto.CompactTextString(m) }
+func (*EventWorkerBondSlashed) ProtoMessage()    {}
+func (*EventWorkerBondSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a10150f55db931c, []int{21}
+}
+func (m *EventWorkerBondSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWorkerBondSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWorkerBondSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *EventWorkerBondSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWorkerBondSlashed.Merge(m, src)
+}
+func (m *EventWorkerBondSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWorkerBondSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWorkerBondSlashed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWorkerBondSlashed proto.InternalMessageInfo
+
+func (m *EventWorkerBondSlashed) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.ActorType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventWorkerBondSlashed) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventListeningCoefficientsSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventWorkerBondSlashed) GetWorker() string {
+	if m != nil {
+		return m.Worker
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventListeningCoefficientsSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventWorkerBondSlashed) GetMissedEpochs() int64 {
+	if m != nil {
+		return m.MissedEpochs
+	}
+	return 0
 }
 
-func (m *EventListeningCoefficientsSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coefficients) > 0 {
-		for iNdEx := len(m.Coefficients) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Coefficients[iNdEx].Size()
-				i -= size
-				if _, err := m.Coefficients[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+type EventReputerSlashed struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Reputer     string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	// distance to consensus of the last deviating report and the threshold it exceeded
+	Distance          github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=distance,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"distance"`
+	DistanceThreshold github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,opt,name=distance_threshold,json=distanceThreshold,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"distance_threshold"`
+	ConsecutiveEpochs int64                                           `protobuf:"varint,6,opt,name=consecutive_epochs,json=consecutiveEpochs,proto3" json:"consecutive_epochs,omitempty"`
+	// reputer nonce of the last deviating report
+	ReputerNonceBlockHeight int64                 `protobuf:"varint,7,opt,name=reputer_nonce_block_height,json=reputerNonceBlockHeight,proto3" json:"reputer_nonce_block_height,omitempty"`
+	SelfSlashedAmount       cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=self_slashed_amount,json=selfSlashedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"self_slashed_amount"`
+	DelegateSlashedAmount   cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=delegate_slashed_amount,json=delegateSlashedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"delegate_slashed_amount"`
+}
+
+func (m *EventReputerSlashed) Reset()         { *m = EventReputerSlashed{} }
+func (m *EventReputerSlashed) String() string { return proto.CompactTextString(m) }
+func (*EventReputerSlashed) ProtoMessage()    {}
+func (*EventReputerSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a10150f55db931c, []int{22}
+}
+func (m *EventReputerSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReputerSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReputerSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+}
+func (m *EventReputerSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReputerSlashed.Merge(m, src)
+}
+func (m *EventReputerSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReputerSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReputerSlashed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReputerSlashed proto.InternalMessageInfo
+
+func (m *EventReputerSlashed) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *EventReputerSlashed) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *EventReputerSlashed) GetReputer() string {
+	if m != nil {
+		return m.Reputer
 	}
-	if m.ActorType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *EventReputerSlashed) GetConsecutiveEpochs() int64 {
+	if m != nil {
+		return m.ConsecutiveEpochs
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventInfererNetworkRegretSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventReputerSlashed) GetReputerNonceBlockHeight() int64 {
+	if m != nil {
+		return m.ReputerNonceBlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventInfererNetworkRegretSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// a delegator's pending reward restaked on the reputer that earned it
+type EventDelegateRewardCompounded struct {
+	TopicId     uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64                 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Delegator   string                `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Reputer     string                `protobuf:"bytes,4,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Amount      cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *EventInfererNetworkRegretSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Regrets) > 0 {
-		for iNdEx := len(m.Regrets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Regrets[iNdEx].Size()
-				i -= size
-				if _, err := m.Regrets[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *EventDelegateRewardCompounded) Reset()         { *m = EventDelegateRewardCompounded{} }
+func (m *EventDelegateRewardCompounded) String() string { return proto.CompactTextString(m) }
+func (*EventDelegateRewardCompounded) ProtoMessage()    {}
+func (*EventDelegateRewardCompounded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a10150f55db931c, []int{23}
+}
+func (m *EventDelegateRewardCompounded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelegateRewardCompounded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelegateRewardCompounded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+}
+func (m *EventDelegateRewardCompounded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelegateRewardCompounded.Merge(m, src)
+}
+func (m *EventDelegateRewardCompounded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelegateRewardCompounded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelegateRewardCompounded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDelegateRewardCompounded proto.InternalMessageInfo
+
+func (m *EventDelegateRewardCompounded) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *EventDelegateRewardCompounded) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventDelegateRewardCompounded) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventForecasterNetworkRegretSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDelegateRewardCompounded) GetReputer() string {
+	if m != nil {
+		return m.Reputer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventForecasterNetworkRegretSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventReputerCommissionSet struct {
+	TopicId       uint64                                          `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight   int64                                           `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Reputer       string                                          `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Rate          github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=rate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"rate"`
+	MaxRate       github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,opt,name=max_rate,json=maxRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_rate"`
+	MaxChangeRate github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,6,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_change_rate"`
 }
 
-func (m *EventForecasterNetworkRegretSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Regrets) > 0 {
-		for iNdEx := len(m.Regrets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Regrets[iNdEx].Size()
-				i -= size
-				if _, err := m.Regrets[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *EventReputerCommissionSet) Reset()         { *m = EventReputerCommissionSet{} }
+func (m *EventReputerCommissionSet) String() string { return proto.CompactTextString(m) }
+func (*EventReputerCommissionSet) ProtoMessage()    {}
+func (*EventReputerCommissionSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a10150f55db931c, []int{24}
+}
+func (m *EventReputerCommissionSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReputerCommissionSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReputerCommissionSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+}
+func (m *EventReputerCommissionSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReputerCommissionSet.Merge(m, src)
+}
+func (m *EventReputerCommissionSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReputerCommissionSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReputerCommissionSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReputerCommissionSet proto.InternalMessageInfo
+
+func (m *EventReputerCommissionSet) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *EventReputerCommissionSet) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EventReputerCommissionSet) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
+// a stake removal that failed at the head of the queue, moved to a later
+// block so the removals behind it still complete
+type EventStakeRemovalRequeued struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Reputer     string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	// empty for the removal of a reputer's own stake
+	Delegator             string                `protobuf:"bytes,4,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Amount                cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	BlockRemovalCompleted int64                 `protobuf:"varint,6,opt,name=block_removal_completed,json=blockRemovalCompleted,proto3" json:"block_removal_completed,omitempty"`
+	Reason                string                `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventStakeRemovalRequeued) Reset()         { *m = EventStakeRemovalRequeued{} }
+func (m *EventStakeRemovalRequeued) String() string { return proto.CompactTextString(m) }
+func (*EventStakeRemovalRequeued) ProtoMessage()    {}
+func (*EventStakeRemovalRequeued) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a10150f55db931c, []int{25}
+}
+func (m *EventStakeRemovalRequeued) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStakeRemovalRequeued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStakeRemovalRequeued.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *EventStakeRemovalRequeued) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStakeRemovalRequeued.Merge(m, src)
+}
+func (m *EventStakeRemovalRequeued) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStakeRemovalRequeued) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStakeRemovalRequeued.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStakeRemovalRequeued proto.InternalMessageInfo
+
+func (m *EventStakeRemovalRequeued) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventStakeRemovalRequeued) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventNaiveInfererNetworkRegretSet) Marshal() (dAtA []byte, err error) {
+func (m *EventStakeRemovalRequeued) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
+func (m *EventStakeRemovalRequeued) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *EventStakeRemovalRequeued) GetBlockRemovalCompleted() int64 {
+	if m != nil {
+		return m.BlockRemovalCompleted
+	}
+	return 0
+}
+
+func (m *EventStakeRemovalRequeued) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// a worker bond removal that failed at the head of the queue, moved to a
+// later block so the removals behind it still complete
+type EventWorkerBondRemovalRequeued struct {
+	TopicId               uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight           int64                 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Worker                string                `protobuf:"bytes,3,opt,name=worker,proto3" json:"worker,omitempty"`
+	Amount                cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	BlockRemovalCompleted int64                 `protobuf:"varint,5,opt,name=block_removal_completed,json=blockRemovalCompleted,proto3" json:"block_removal_completed,omitempty"`
+	Reason                string                `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventWorkerBondRemovalRequeued) Reset()         { *m = EventWorkerBondRemovalRequeued{} }
+func (m *EventWorkerBondRemovalRequeued) String() string { return proto.CompactTextString(m) }
+func (*EventWorkerBondRemovalRequeued) ProtoMessage()    {}
+func (*EventWorkerBondRemovalRequeued) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a10150f55db931c, []int{26}
+}
+func (m *EventWorkerBondRemovalRequeued) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWorkerBondRemovalRequeued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWorkerBondRemovalRequeued.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventWorkerBondRemovalRequeued) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWorkerBondRemovalRequeued.Merge(m, src)
+}
+func (m *EventWorkerBondRemovalRequeued) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWorkerBondRemovalRequeued) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWorkerBondRemovalRequeued.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWorkerBondRemovalRequeued proto.InternalMessageInfo
+
+func (m *EventWorkerBondRemovalRequeued) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *EventWorkerBondRemovalRequeued) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EventWorkerBondRemovalRequeued) GetWorker() string {
+	if m != nil {
+		return m.Worker
+	}
+	return ""
+}
+
+func (m *EventWorkerBondRemovalRequeued) GetBlockRemovalCompleted() int64 {
+	if m != nil {
+		return m.BlockRemovalCompleted
+	}
+	return 0
+}
+
+func (m *EventWorkerBondRemovalRequeued) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// a queued reward that failed to be paid out max_reward_payout_attempts times,
+// its coins left to the rewards pool
+type EventRewardPayoutDeadLettered struct {
+	TopicId        uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight    int64                 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Address        string                `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Type           string                `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Amount         cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	FailedAttempts uint64                `protobuf:"varint,6,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	Reason         string                `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventRewardPayoutDeadLettered) Reset()         { *m = EventRewardPayoutDeadLettered{} }
+func (m *EventRewardPayoutDeadLettered) String() string { return proto.CompactTextString(m) }
+func (*EventRewardPayoutDeadLettered) ProtoMessage()    {}
+func (*EventRewardPayoutDeadLettered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a10150f55db931c, []int{27}
+}
+func (m *EventRewardPayoutDeadLettered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRewardPayoutDeadLettered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRewardPayoutDeadLettered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRewardPayoutDeadLettered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRewardPayoutDeadLettered.Merge(m, src)
+}
+func (m *EventRewardPayoutDeadLettered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRewardPayoutDeadLettered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRewardPayoutDeadLettered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRewardPayoutDeadLettered proto.InternalMessageInfo
+
+func (m *EventRewardPayoutDeadLettered) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *EventRewardPayoutDeadLettered) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EventRewardPayoutDeadLettered) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventRewardPayoutDeadLettered) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *EventRewardPayoutDeadLettered) GetFailedAttempts() uint64 {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return 0
+}
+
+func (m *EventRewardPayoutDeadLettered) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("emissions.v7.ActorType", ActorType_name, ActorType_value)
+	proto.RegisterType((*EventScoresSet)(nil), "emissions.v7.EventScoresSet")
+	proto.RegisterType((*EventRewardsSettled)(nil), "emissions.v7.EventRewardsSettled")
+	proto.RegisterType((*EventNetworkLossSet)(nil), "emissions.v7.EventNetworkLossSet")
+	proto.RegisterType((*EventForecastTaskScoreSet)(nil), "emissions.v7.EventForecastTaskScoreSet")
+	proto.RegisterType((*EventWorkerLastCommitSet)(nil), "emissions.v7.EventWorkerLastCommitSet")
+	proto.RegisterType((*EventReputerLastCommitSet)(nil), "emissions.v7.EventReputerLastCommitSet")
+	proto.RegisterType((*EventTopicRewardsSet)(nil), "emissions.v7.EventTopicRewardsSet")
+	proto.RegisterType((*EventEMAScoresSet)(nil), "emissions.v7.EventEMAScoresSet")
+	proto.RegisterType((*EventListeningCoefficientsSet)(nil), "emissions.v7.EventListeningCoefficientsSet")
+	proto.RegisterType((*EventInfererNetworkRegretSet)(nil), "emissions.v7.EventInfererNetworkRegretSet")
+	proto.RegisterType((*EventForecasterNetworkRegretSet)(nil), "emissions.v7.EventForecasterNetworkRegretSet")
+	proto.RegisterType((*EventNaiveInfererNetworkRegretSet)(nil), "emissions.v7.EventNaiveInfererNetworkRegretSet")
+	proto.RegisterType((*EventTopicInitialRegretSet)(nil), "emissions.v7.EventTopicInitialRegretSet")
+	proto.RegisterType((*EventTopicInitialEmaScoreSet)(nil), "emissions.v7.EventTopicInitialEmaScoreSet")
+	proto.RegisterType((*EventTopicUpdated)(nil), "emissions.v7.EventTopicUpdated")
+	proto.RegisterType((*EventTopicUpdateRejected)(nil), "emissions.v7.EventTopicUpdateRejected")
+	proto.RegisterType((*EventTopicArchived)(nil), "emissions.v7.EventTopicArchived")
+	proto.RegisterType((*EventTopicOwnershipTransferProposed)(nil), "emissions.v7.EventTopicOwnershipTransferProposed")
+	proto.RegisterType((*EventTopicOwnershipTransferred)(nil), "emissions.v7.EventTopicOwnershipTransferred")
+	proto.RegisterType((*EventOperatorAuthorized)(nil), "emissions.v7.EventOperatorAuthorized")
+	proto.RegisterType((*EventOperatorRevoked)(nil), "emissions.v7.EventOperatorRevoked")
+	proto.RegisterType((*EventWorkerBondSlashed)(nil), "emissions.v7.EventWorkerBondSlashed")
+	proto.RegisterType((*EventReputerSlashed)(nil), "emissions.v7.EventReputerSlashed")
+	proto.RegisterType((*EventDelegateRewardCompounded)(nil), "emissions.v7.EventDelegateRewardCompounded")
+	proto.RegisterType((*EventReputerCommissionSet)(nil), "emissions.v7.EventReputerCommissionSet")
+	proto.RegisterType((*EventStakeRemovalRequeued)(nil), "emissions.v7.EventStakeRemovalRequeued")
+	proto.RegisterType((*EventWorkerBondRemovalRequeued)(nil), "emissions.v7.EventWorkerBondRemovalRequeued")
+	proto.RegisterType((*EventRewardPayoutDeadLettered)(nil), "emissions.v7.EventRewardPayoutDeadLettered")
+}
+
+func init() { proto.RegisterFile("emissions/v7/events.proto", fileDescriptor_8a10150f55db931c) }
+
+var fileDescriptor_8a10150f55db931c = []byte{
+	// 1589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4f, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0x8f, 0xc7, 0xe3, 0x71, 0xc5, 0x76, 0xe2, 0xf6, 0xbf, 0xb1, 0x77, 0x99, 0x38, 0xbd,
+	0x42, 0x78, 0x41, 0x99, 0x41, 0x1b, 0x69, 0x03, 0x82, 0xcb, 0x78, 0x3c, 0x16, 0x16, 0x5e, 0xdb,
+	0xd4, 0x4c, 0x40, 0xb0, 0x42, 0xad, 0x72, 0xf7, 0x9b, 0x99, 0xc6, 0xdd, 0x5d, 0x4d, 0x55, 0xcd,
+	0xd8, 0xe1, 0xca, 0x1f, 0x81, 0xb8, 0xec, 0x7e, 0x01, 0x0e, 0x1c, 0xb8, 0xec, 0x15, 0xbe, 0xc3,
+	0x5e, 0x90, 0xa2, 0x3d, 0x45, 0x11, 0x8a, 0x50, 0x7c, 0xe0, 0x13, 0xe4, 0xc6, 0x01, 0xd5, 0x9f,
+	0xf6, 0xf4, 0x98, 0x24, 0x24, 0xf4, 0x84, 0x84, 0xbd, 0x75, 0xbd, 0xd7, 0xf5, 0xab, 0xdf, 0xfb,
+	0xf5, 0xab, 0x57, 0xf5, 0x66, 0xd0, 0x06, 0x44, 0x01, 0xe7, 0x01, 0x8d, 0x79, 0x7d, 0x78, 0xb7,
+	0x0e, 0x43, 0x88, 0x05, 0xaf, 0x25, 0x8c, 0x0a, 0x6a, 0xcf, 0x5f, 0xba, 0x6a, 0xc3, 0xbb, 0x9b,
+	0x1b, 0x1e, 0xe5, 0x11, 0xe5, 0xae, 0xf2, 0xd5, 0xf5, 0x40, 0xbf, 0xb8, 0x59, 0xc9, 0x60, 0xdc,
+	0xa9, 0xc7, 0x34, 0xf6, 0xc0, 0x78, 0x36, 0xc7, 0x3c, 0x0c, 0x92, 0x81, 0x00, 0xf6, 0xcc, 0x59,
+	0x82, 0x26, 0x81, 0x67, 0x3c, 0x2b, 0x3d, 0xda, 0xa3, 0x7a, 0x1d, 0xf9, 0xa4, 0xad, 0xce, 0x53,
+	0x0b, 0x2d, 0xb6, 0x24, 0xbf, 0xb6, 0x47, 0x19, 0xf0, 0x36, 0x08, 0xfb, 0x43, 0x84, 0x88, 0x27,
+	0x28, 0x73, 0xc5, 0xfd, 0x04, 0x2a, 0xd6, 0x96, 0xb5, 0xbd, 0xf8, 0xc1, 0x7a, 0x2d, 0x4b, 0xbb,
+	0xd6, 0x90, 0xfe, 0xce, 0xfd, 0x04, 0xf0, 0x1c, 0x49, 0x1f, 0xed, 0x0d, 0x54, 0x56, 0xeb, 0xb9,
+	0x81, 0x5f, 0x29, 0x6c, 0x59, 0xdb, 0x45, 0x3c, 0xab, 0xc6, 0xfb, 0xbe, 0x7d, 0x0b, 0xcd, 0x9f,
+	0x84, 0xd4, 0x3b, 0x75, 0xfb, 0x10, 0xf4, 0xfa, 0xa2, 0x32, 0xbd, 0x65, 0x6d, 0x4f, 0xe3, 0x6b,
+	0xca, 0xf6, 0x3d, 0x65, 0xb2, 0xdf, 0x45, 0x73, 0xc4, 0xf7, 0x19, 0x70, 0x0e, 0xbc, 0x52, 0xdc,
+	0x9a, 0xde, 0x9e, 0xc3, 0x23, 0x83, 0x7d, 0x84, 0x4a, 0x5c, 0x11, 0xac, 0xcc, 0x48, 0xd7, 0xce,
+	0xdd, 0xcf, 0x1f, 0xdf, 0x9c, 0x7a, 0xf4, 0xf8, 0x66, 0xbd, 0x17, 0x88, 0xfe, 0xe0, 0xa4, 0xe6,
+	0xd1, 0xa8, 0x4e, 0xc2, 0x90, 0x32, 0x72, 0x3b, 0x06, 0x71, 0x46, 0xd9, 0x69, 0x3a, 0xf4, 0xfa,
+	0x24, 0x88, 0xeb, 0x11, 0x11, 0xfd, 0xda, 0x2e, 0x78, 0xd8, 0xc0, 0x38, 0xff, 0xb4, 0xd0, 0xb2,
+	0x8a, 0x1b, 0xc3, 0x19, 0x61, 0xbe, 0x0c, 0x5c, 0x84, 0xe0, 0xbf, 0x95, 0xc1, 0xff, 0x00, 0xcd,
+	0x32, 0xcd, 0x32, 0x6f, 0xf4, 0x29, 0x8e, 0xf3, 0x69, 0x1a, 0xfe, 0xa1, 0x7e, 0xff, 0x80, 0x72,
+	0xf5, 0xed, 0xb3, 0x61, 0x58, 0x2f, 0x0e, 0xa3, 0xf0, 0xef, 0x61, 0x7c, 0x17, 0xcd, 0x0f, 0x49,
+	0x38, 0x00, 0xf7, 0x64, 0x10, 0xfb, 0x21, 0xa8, 0x48, 0xaf, 0x7d, 0xb0, 0x91, 0x95, 0xef, 0x4e,
+	0xed, 0x87, 0xf2, 0x8d, 0x1d, 0xf5, 0x02, 0xbe, 0x36, 0x1c, 0x0d, 0x9c, 0x5f, 0x5b, 0x68, 0x43,
+	0x71, 0xda, 0xa3, 0x0c, 0x3c, 0xc2, 0x45, 0x87, 0xf0, 0x53, 0x95, 0x96, 0xff, 0x81, 0xd9, 0x47,
+	0x68, 0x46, 0x7d, 0x55, 0x45, 0x29, 0x87, 0x3a, 0x1a, 0xc5, 0xf9, 0xa5, 0x85, 0x2a, 0x8a, 0xc7,
+	0x8f, 0x28, 0x3b, 0x05, 0x76, 0x40, 0xb8, 0x68, 0xd2, 0x28, 0x0a, 0x44, 0x7e, 0x81, 0xde, 0x47,
+	0x33, 0x6a, 0x23, 0x1b, 0x65, 0x96, 0xc7, 0x95, 0x39, 0x94, 0x2e, 0xac, 0xdf, 0x70, 0x7e, 0x95,
+	0xaa, 0x81, 0xf5, 0xfe, 0x7e, 0x43, 0x34, 0x7e, 0x63, 0xa1, 0x15, 0x45, 0xa3, 0x23, 0xe1, 0x47,
+	0x9b, 0xc5, 0x7e, 0x07, 0xcd, 0xa5, 0x0c, 0x78, 0xc5, 0xda, 0x9a, 0xde, 0x2e, 0xe2, 0xb2, 0xa1,
+	0x30, 0x96, 0xb1, 0x85, 0x09, 0x65, 0xec, 0x6f, 0x0b, 0x68, 0x49, 0x11, 0x69, 0x7d, 0xd4, 0x78,
+	0xad, 0xb5, 0x6a, 0x25, 0x2b, 0xce, 0xb4, 0xd1, 0xe1, 0x7f, 0x5c, 0x9e, 0xa4, 0xba, 0x01, 0x77,
+	0x89, 0x27, 0x82, 0x21, 0x54, 0x4a, 0x5b, 0xd3, 0xdb, 0x65, 0x5c, 0x0e, 0x78, 0x43, 0x8d, 0x9d,
+	0x4f, 0x0a, 0xe8, 0x2b, 0x4a, 0x8a, 0x83, 0x80, 0x0b, 0x88, 0x83, 0xb8, 0xd7, 0xa4, 0xd0, 0xed,
+	0x06, 0x5e, 0x20, 0xcf, 0x99, 0xb7, 0xb5, 0x84, 0x7f, 0x8c, 0xe6, 0xbd, 0x0c, 0xcd, 0xbc, 0x4a,
+	0x8d, 0x81, 0x39, 0x7f, 0xb5, 0xd0, 0xbb, 0x4a, 0x92, 0xfd, 0xb8, 0x0b, 0x0c, 0x98, 0x29, 0x6b,
+	0x18, 0x7a, 0x0c, 0x26, 0xb0, 0x61, 0xc6, 0x22, 0x9b, 0x7e, 0x66, 0x7d, 0x96, 0x0b, 0x99, 0xa8,
+	0x73, 0x65, 0xbb, 0xc2, 0x71, 0x1e, 0x58, 0xe8, 0xe6, 0x58, 0x2d, 0xfc, 0xff, 0x0f, 0xe9, 0x0b,
+	0x0b, 0xdd, 0xd2, 0x47, 0x0e, 0x09, 0x86, 0xf0, 0x25, 0xf9, 0x4e, 0x9f, 0x59, 0x68, 0x73, 0x54,
+	0x1e, 0xf7, 0xe3, 0x40, 0x04, 0x24, 0x9c, 0x54, 0x34, 0x47, 0xa8, 0xa4, 0xd7, 0x51, 0x9b, 0x2d,
+	0x4f, 0x55, 0xd1, 0x30, 0xce, 0x45, 0xba, 0x4b, 0xb2, 0x6c, 0x5b, 0x11, 0xb9, 0x3c, 0x64, 0xdf,
+	0x4c, 0xdd, 0xb8, 0x3c, 0xbf, 0x8b, 0x13, 0x39, 0xbf, 0xff, 0x62, 0xa1, 0xa5, 0x51, 0x94, 0xf7,
+	0x12, 0x9f, 0x08, 0xf0, 0x73, 0x7e, 0x8a, 0x6f, 0xa2, 0x39, 0x1a, 0xfa, 0xae, 0x9a, 0xf1, 0xec,
+	0x53, 0x53, 0x9f, 0x8f, 0x65, 0x1a, 0xfa, 0xea, 0x49, 0xce, 0x88, 0xe1, 0xcc, 0xcc, 0x28, 0xbe,
+	0x60, 0x46, 0x0c, 0x67, 0xea, 0xc9, 0xf9, 0x2c, 0xbd, 0x77, 0x64, 0x78, 0x63, 0xf8, 0x19, 0x78,
+	0xf9, 0xe9, 0x7f, 0x1b, 0x95, 0x06, 0x0a, 0xcf, 0x70, 0xbf, 0x35, 0xce, 0xe4, 0x28, 0x11, 0x01,
+	0x8d, 0x49, 0xa8, 0x16, 0x3e, 0x26, 0x8c, 0x44, 0x1c, 0x9b, 0x09, 0xf6, 0x9a, 0x4c, 0x42, 0xc2,
+	0x69, 0xac, 0xbf, 0x0e, 0x36, 0x23, 0xe7, 0x6f, 0x16, 0xb2, 0x47, 0x6c, 0x1b, 0xcc, 0xeb, 0x07,
+	0xc3, 0xdc, 0x3c, 0xdf, 0x47, 0x37, 0x18, 0x74, 0x07, 0xb1, 0xef, 0x32, 0xf0, 0x82, 0x44, 0xd6,
+	0x76, 0x9d, 0xfb, 0xf8, 0xba, 0xb6, 0xe3, 0xd4, 0x6c, 0xff, 0x14, 0xad, 0x68, 0x13, 0xf8, 0x6e,
+	0x17, 0xc0, 0x65, 0xb2, 0xcb, 0x1a, 0xa4, 0x39, 0xf4, 0x0d, 0x93, 0x43, 0xab, 0xba, 0xa5, 0xe2,
+	0xfe, 0x69, 0x2d, 0xa0, 0x3a, 0x53, 0xf6, 0x63, 0xf1, 0xc5, 0x9f, 0x6f, 0x23, 0xed, 0x90, 0x23,
+	0x6c, 0xa7, 0x40, 0x7b, 0x00, 0x58, 0xc3, 0x38, 0x7f, 0xb0, 0xd0, 0x7b, 0xa3, 0xf0, 0x8e, 0xce,
+	0x62, 0x60, 0xbc, 0x1f, 0x24, 0x1d, 0x46, 0x62, 0xde, 0x05, 0x76, 0xcc, 0x68, 0x42, 0x79, 0xee,
+	0x78, 0x57, 0xd0, 0x0c, 0x95, 0xd0, 0x26, 0x48, 0x3d, 0xb0, 0xdf, 0x43, 0x0b, 0x09, 0xc4, 0x7e,
+	0x10, 0xf7, 0x5c, 0xed, 0xd5, 0xca, 0xcf, 0x1b, 0xa3, 0x22, 0xe3, 0xfc, 0xd1, 0x42, 0xd5, 0x17,
+	0x10, 0x64, 0xb9, 0xb9, 0x7d, 0x15, 0x2d, 0x26, 0x0c, 0x86, 0x01, 0x1d, 0x70, 0x37, 0x4b, 0x72,
+	0x21, 0xb5, 0xaa, 0x35, 0xed, 0x77, 0x74, 0x9e, 0x67, 0x89, 0xca, 0x94, 0xd6, 0x24, 0x7f, 0x67,
+	0xa1, 0x75, 0x45, 0xf2, 0x28, 0x01, 0x46, 0x04, 0x65, 0x8d, 0x81, 0xe8, 0x53, 0x16, 0xfc, 0x62,
+	0x12, 0xca, 0xa9, 0xf2, 0x93, 0x2a, 0xa7, 0x06, 0xf6, 0x26, 0x2a, 0x53, 0xb3, 0x52, 0xca, 0x25,
+	0x1d, 0x8f, 0x6e, 0xb2, 0x29, 0x17, 0x0c, 0x43, 0x7a, 0xfa, 0x06, 0x88, 0xfc, 0xc3, 0x42, 0x6b,
+	0x99, 0xfe, 0x62, 0x87, 0xc6, 0x7e, 0x3b, 0x24, 0xbc, 0x9f, 0x9b, 0xca, 0x1a, 0x2a, 0x9d, 0x29,
+	0x48, 0xc3, 0xc5, 0x8c, 0x6c, 0x8c, 0x16, 0xb9, 0x5e, 0xc0, 0x25, 0x11, 0x1d, 0xc4, 0xe2, 0xbf,
+	0xd9, 0x24, 0x0b, 0x06, 0xa2, 0xa1, 0x10, 0x64, 0x8e, 0xca, 0x0a, 0x02, 0xbe, 0x0b, 0x09, 0xf5,
+	0xfa, 0xf2, 0x3a, 0x27, 0xf9, 0xcc, 0x6b, 0x63, 0x4b, 0xd9, 0x9c, 0x87, 0x45, 0xb4, 0x9c, 0xed,
+	0x61, 0x26, 0x13, 0x66, 0x45, 0x1e, 0xe3, 0x0a, 0xcf, 0xc4, 0x99, 0x0e, 0xed, 0x36, 0x2a, 0xfb,
+	0x01, 0x17, 0x44, 0xde, 0xde, 0x73, 0x9e, 0x25, 0x97, 0x40, 0x76, 0x17, 0xd9, 0xe9, 0xb3, 0x2b,
+	0xfa, 0x0c, 0x78, 0x9f, 0x86, 0x7e, 0x65, 0x26, 0x1f, 0xfc, 0x52, 0x0a, 0xd9, 0x49, 0x11, 0xed,
+	0xdb, 0xc8, 0xf6, 0x68, 0xcc, 0xc1, 0x1b, 0xc8, 0x4b, 0x7e, 0x2a, 0x6b, 0x49, 0xc5, 0xbf, 0x94,
+	0xf1, 0x68, 0x6d, 0xed, 0xef, 0xa0, 0x4d, 0x13, 0xb6, 0xab, 0x3a, 0x14, 0x77, 0x4c, 0xb6, 0x59,
+	0x35, 0x6d, 0xdd, 0xbc, 0xa1, 0x3a, 0xba, 0x9d, 0x8c, 0x84, 0x1f, 0xa3, 0x65, 0x0e, 0x61, 0xd7,
+	0xbd, 0x92, 0x16, 0xe5, 0x57, 0x4f, 0x8b, 0x25, 0x89, 0xd3, 0x1e, 0x4b, 0x0d, 0x0f, 0xad, 0xfb,
+	0x10, 0x42, 0x8f, 0x08, 0xb8, 0xba, 0xc0, 0xdc, 0xab, 0x2f, 0xb0, 0x9a, 0x62, 0x8d, 0x2d, 0xe2,
+	0x3c, 0xb2, 0x4c, 0x0f, 0xb4, 0x6b, 0xdc, 0xba, 0x35, 0x6d, 0xd2, 0x28, 0xa1, 0xaa, 0x96, 0xe7,
+	0xbf, 0x49, 0x9a, 0x85, 0x2f, 0xb7, 0xf6, 0xc8, 0x90, 0x4d, 0xc1, 0xe2, 0x78, 0x0a, 0x36, 0x51,
+	0xc9, 0xc4, 0x3a, 0xf3, 0xea, 0xb1, 0x9a, 0xa9, 0xce, 0xd3, 0xc2, 0x78, 0xef, 0xaf, 0xfa, 0x7e,
+	0x75, 0x5c, 0xe7, 0xbf, 0x54, 0x3e, 0x7f, 0xf7, 0x7c, 0x1f, 0x15, 0x99, 0xbc, 0x22, 0xe4, 0xdc,
+	0x39, 0x0a, 0xc4, 0xc6, 0xa8, 0x1c, 0x91, 0x73, 0x57, 0x01, 0xe6, 0xdc, 0x2b, 0xb3, 0x11, 0x39,
+	0xc7, 0x12, 0xd3, 0x45, 0xd7, 0x25, 0xa6, 0xd7, 0x27, 0x71, 0x0f, 0x34, 0x74, 0x29, 0x1f, 0xf4,
+	0x42, 0x44, 0xce, 0x9b, 0x0a, 0x4e, 0x2e, 0xe0, 0xfc, 0x29, 0xd5, 0xbd, 0x2d, 0xc8, 0x29, 0x60,
+	0x88, 0xe8, 0x50, 0xde, 0xe6, 0x7f, 0x3e, 0x80, 0xc1, 0x6b, 0xac, 0x5a, 0x63, 0xa9, 0x56, 0xbc,
+	0x9a, 0x6a, 0x93, 0x48, 0x28, 0xfb, 0x43, 0xb4, 0xae, 0xf9, 0x31, 0x1d, 0x93, 0xeb, 0xd1, 0x28,
+	0x09, 0x41, 0x80, 0x6f, 0x0a, 0xcc, 0xaa, 0x72, 0x9b, 0x88, 0x9b, 0xa9, 0x33, 0x73, 0xf9, 0x9b,
+	0x1d, 0xbb, 0xfc, 0xfd, 0xbe, 0x80, 0xaa, 0x57, 0x8e, 0xb0, 0xc9, 0xaa, 0xf5, 0xbc, 0xa3, 0x6c,
+	0xa4, 0x46, 0xf1, 0xb5, 0xa8, 0x31, 0xf3, 0x72, 0x6a, 0x94, 0xc6, 0xd4, 0xf8, 0x34, 0xfd, 0x3d,
+	0x46, 0xd7, 0xa0, 0x63, 0x72, 0x9f, 0x0e, 0xc4, 0x2e, 0x10, 0xff, 0x00, 0x84, 0x00, 0x36, 0x89,
+	0xd4, 0x31, 0x4d, 0x6c, 0x9a, 0x3a, 0x66, 0x68, 0xdb, 0xa8, 0xa8, 0x3a, 0x35, 0x9d, 0x35, 0xea,
+	0x79, 0x32, 0x09, 0xf3, 0x35, 0x74, 0xbd, 0x4b, 0x82, 0x50, 0x56, 0x6e, 0x21, 0x20, 0x4a, 0x84,
+	0x3e, 0x89, 0x8a, 0x78, 0x51, 0x9b, 0x1b, 0xc6, 0xfa, 0xbc, 0x0c, 0xf9, 0xfa, 0x09, 0x9a, 0xbb,
+	0xec, 0x14, 0x6d, 0x07, 0x55, 0x1b, 0xcd, 0xce, 0x11, 0x76, 0x3b, 0x3f, 0x3e, 0x6e, 0xb9, 0xfb,
+	0x87, 0x7b, 0x2d, 0xdc, 0xc2, 0xee, 0xbd, 0xc3, 0xf6, 0x71, 0xab, 0xb9, 0xbf, 0xb7, 0xdf, 0xda,
+	0xbd, 0x31, 0x65, 0x6f, 0xa0, 0xd5, 0xcc, 0x3b, 0x7b, 0x47, 0xb8, 0xd5, 0x6c, 0xb4, 0x3b, 0x2d,
+	0x7c, 0xc3, 0xb2, 0xd7, 0x90, 0x9d, 0x71, 0xe1, 0xd6, 0xf1, 0x3d, 0x69, 0x2f, 0xec, 0xe0, 0xcf,
+	0x9f, 0x54, 0xad, 0x07, 0x4f, 0xaa, 0xd6, 0xdf, 0x9f, 0x54, 0xad, 0x4f, 0x2e, 0xaa, 0x53, 0x0f,
+	0x2e, 0xaa, 0x53, 0x0f, 0x2f, 0xaa, 0x53, 0x3f, 0xf9, 0xd6, 0x4b, 0x16, 0x82, 0xf3, 0xfa, 0xe8,
+	0xef, 0x12, 0x29, 0x1e, 0x3f, 0x29, 0xa9, 0xbf, 0x45, 0xee, 0xfc, 0x6b, 0x00, 0x33, 0xe9, 0x6a,
+	0xa5, 0xc2, 0x19, 0x00, 0x00,
+}
+
+func (m *EventScoresSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1584,28 +2062,28 @@ func (m *EventNaiveInfererNetworkRegretSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventNaiveInfererNetworkRegretSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScoresSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventNaiveInfererNetworkRegretSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScoresSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Regrets) > 0 {
-		for iNdEx := len(m.Regrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size := m.Regrets[iNdEx].Size()
+				size := m.Scores[iNdEx].Size()
 				i -= size
-				if _, err := m.Regrets[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+				if _, err := m.Scores[iNdEx].MarshalTo(dAtA[i:]); err != nil {
 					return 0, err
 				}
 				i = encodeVarintEvents(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x2a
 		}
 	}
 	if len(m.Addresses) > 0 {
@@ -1614,23 +2092,28 @@ func (m *EventNaiveInfererNetworkRegretSet) MarshalToSizedBuffer(dAtA []byte) (i
 			copy(dAtA[i:], m.Addresses[iNdEx])
 			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x22
 		}
 	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.ActorType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventTopicInitialRegretSet) Marshal() (dAtA []byte, err error) {
+func (m *EventRewardsSettled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1640,40 +2123,58 @@ func (m *EventTopicInitialRegretSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventTopicInitialRegretSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRewardsSettled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTopicInitialRegretSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRewardsSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Regret.Size()
-		i -= size
-		if _, err := m.Regret.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Rewards[iNdEx].Size()
+				i -= size
+				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
 	if m.BlockHeight != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.ActorType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventTopicInitialEmaScoreSet) Marshal() (dAtA []byte, err error) {
+func (m *EventNetworkLossSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1683,396 +2184,4601 @@ func (m *EventTopicInitialEmaScoreSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventTopicInitialEmaScoreSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventNetworkLossSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTopicInitialEmaScoreSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventNetworkLossSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Score.Size()
-		i -= size
-		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.ValueBundle != nil {
+		{
+			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x22
 	if m.BlockHeight != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x10
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.ActorType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
-		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventForecastTaskScoreSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventScoresSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventForecastTaskScoreSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForecastTaskScoreSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ActorType != 0 {
-		n += 1 + sovEvents(uint64(m.ActorType))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
-	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if len(m.Scores) > 0 {
-		for _, e := range m.Scores {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x12
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRewardsSettled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventWorkerLastCommitSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWorkerLastCommitSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWorkerLastCommitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ActorType != 0 {
-		n += 1 + sovEvents(uint64(m.ActorType))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
+	if m.Nonce != nil {
+		{
+			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
-	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
-		}
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventNetworkLossSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventReputerLastCommitSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReputerLastCommitSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReputerLastCommitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
+	if m.Nonce != nil {
+		{
+			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ValueBundle != nil {
-		l = m.ValueBundle.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventForecastTaskScoreSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
+func (m *EventTopicRewardsSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Score.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventWorkerLastCommitSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
-	}
-	if m.Nonce != nil {
-		l = m.Nonce.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
-}
-
-func (m *EventReputerLastCommitSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
-	}
-	if m.Nonce != nil {
-		l = m.Nonce.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventTopicRewardsSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTopicRewardsSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventTopicRewardsSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TopicIds) > 0 {
-		l = 0
-		for _, e := range m.TopicIds {
-			l += sovEvents(uint64(e))
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Rewards[iNdEx].Size()
+				i -= size
+				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		n += 1 + sovEvents(uint64(l)) + l
 	}
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.TopicIds) > 0 {
+		dAtA5 := make([]byte, len(m.TopicIds)*10)
+		var j4 int
+		for _, num := range m.TopicIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintEvents(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventEMAScoresSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventEMAScoresSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEMAScoresSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEMAScoresSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ActorType != 0 {
-		n += 1 + sovEvents(uint64(m.ActorType))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
+	if len(m.IsActive) > 0 {
+		for iNdEx := len(m.IsActive) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.IsActive[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.IsActive)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovEvents(uint64(m.Nonce))
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Scores[iNdEx].Size()
+				i -= size
+				if _, err := m.Scores[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
 	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.Scores) > 0 {
-		for _, e := range m.Scores {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if m.Nonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.IsActive) > 0 {
-		n += 1 + sovEvents(uint64(len(m.IsActive))) + len(m.IsActive)*1
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.ActorType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventListeningCoefficientsSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventListeningCoefficientsSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventListeningCoefficientsSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventListeningCoefficientsSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ActorType != 0 {
-		n += 1 + sovEvents(uint64(m.ActorType))
+	if len(m.Coefficients) > 0 {
+		for iNdEx := len(m.Coefficients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Coefficients[iNdEx].Size()
+				i -= size
+				if _, err := m.Coefficients[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Coefficients) > 0 {
-		for _, e := range m.Coefficients {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if m.ActorType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventInfererNetworkRegretSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventInfererNetworkRegretSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventInfererNetworkRegretSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventInfererNetworkRegretSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	if len(m.Regrets) > 0 {
+		for iNdEx := len(m.Regrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Regrets[iNdEx].Size()
+				i -= size
+				if _, err := m.Regrets[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Regrets) > 0 {
-		for _, e := range m.Regrets {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventForecasterNetworkRegretSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventForecasterNetworkRegretSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventForecasterNetworkRegretSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForecasterNetworkRegretSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	if len(m.Regrets) > 0 {
+		for iNdEx := len(m.Regrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Regrets[iNdEx].Size()
+				i -= size
+				if _, err := m.Regrets[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNaiveInfererNetworkRegretSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNaiveInfererNetworkRegretSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNaiveInfererNetworkRegretSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.Regrets) > 0 {
-		for _, e := range m.Regrets {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+		for iNdEx := len(m.Regrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Regrets[iNdEx].Size()
+				i -= size
+				if _, err := m.Regrets[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return n
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventNaiveInfererNetworkRegretSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventTopicInitialRegretSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTopicInitialRegretSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopicInitialRegretSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
+	{
+		size := m.Regret.Size()
+		i -= size
+		if _, err := m.Regret.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTopicInitialEmaScoreSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTopicInitialEmaScoreSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopicInitialEmaScoreSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if len(m.Regrets) > 0 {
-		for _, e := range m.Regrets {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ActorType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTopicUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTopicUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopicUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewTopic != nil {
+		{
+			size, err := m.NewTopic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OldTopic != nil {
+		{
+			size, err := m.OldTopic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTopicUpdateRejected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTopicUpdateRejected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopicUpdateRejected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Update != nil {
+		{
+			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTopicArchived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTopicArchived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopicArchived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RefundedFeeRevenue.Size()
+		i -= size
+		if _, err := m.RefundedFeeRevenue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.RefundRecipient) > 0 {
+		i -= len(m.RefundRecipient)
+		copy(dAtA[i:], m.RefundRecipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RefundRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTopicOwnershipTransferProposed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTopicOwnershipTransferProposed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopicOwnershipTransferProposed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTopicOwnershipTransferred) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTopicOwnershipTransferred) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopicOwnershipTransferred) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviousOwner) > 0 {
+		i -= len(m.PreviousOwner)
+		copy(dAtA[i:], m.PreviousOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOperatorAuthorized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOperatorAuthorized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOperatorAuthorized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOperatorRevoked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOperatorRevoked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOperatorRevoked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWorkerBondSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWorkerBondSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWorkerBondSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedEpochs != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MissedEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.SlashedAmount.Size()
+		i -= size
+		if _, err := m.SlashedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReputerSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReputerSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReputerSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DelegateSlashedAmount.Size()
+		i -= size
+		if _, err := m.DelegateSlashedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.SelfSlashedAmount.Size()
+		i -= size
+		if _, err := m.SelfSlashedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.ReputerNonceBlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReputerNonceBlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ConsecutiveEpochs != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ConsecutiveEpochs))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.DistanceThreshold.Size()
+		i -= size
+		if _, err := m.DistanceThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Distance.Size()
+		i -= size
+		if _, err := m.Distance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDelegateRewardCompounded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelegateRewardCompounded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelegateRewardCompounded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReputerCommissionSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReputerCommissionSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReputerCommissionSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventStakeRemovalRequeued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStakeRemovalRequeued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStakeRemovalRequeued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BlockRemovalCompleted != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockRemovalCompleted))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWorkerBondRemovalRequeued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWorkerBondRemovalRequeued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWorkerBondRemovalRequeued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockRemovalCompleted != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockRemovalCompleted))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRewardPayoutDeadLettered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRewardPayoutDeadLettered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRewardPayoutDeadLettered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.FailedAttempts != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FailedAttempts))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventScoresSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActorType != 0 {
+		n += 1 + sovEvents(uint64(m.ActorType))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRewardsSettled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActorType != 0 {
+		n += 1 + sovEvents(uint64(m.ActorType))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventNetworkLossSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if m.ValueBundle != nil {
+		l = m.ValueBundle.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventForecastTaskScoreSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventWorkerLastCommitSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if m.Nonce != nil {
+		l = m.Nonce.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventReputerLastCommitSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if m.Nonce != nil {
+		l = m.Nonce.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTopicRewardsSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TopicIds) > 0 {
+		l = 0
+		for _, e := range m.TopicIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventEMAScoresSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActorType != 0 {
+		n += 1 + sovEvents(uint64(m.ActorType))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvents(uint64(m.Nonce))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.IsActive) > 0 {
+		n += 1 + sovEvents(uint64(len(m.IsActive))) + len(m.IsActive)*1
+	}
+	return n
+}
+
+func (m *EventListeningCoefficientsSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActorType != 0 {
+		n += 1 + sovEvents(uint64(m.ActorType))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Coefficients) > 0 {
+		for _, e := range m.Coefficients {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventInfererNetworkRegretSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Regrets) > 0 {
+		for _, e := range m.Regrets {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventForecasterNetworkRegretSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Regrets) > 0 {
+		for _, e := range m.Regrets {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventNaiveInfererNetworkRegretSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Regrets) > 0 {
+		for _, e := range m.Regrets {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventTopicInitialRegretSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = m.Regret.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventTopicInitialEmaScoreSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActorType != 0 {
+		n += 1 + sovEvents(uint64(m.ActorType))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventTopicUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if m.OldTopic != nil {
+		l = m.OldTopic.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NewTopic != nil {
+		l = m.NewTopic.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTopicUpdateRejected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTopicArchived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.RefundRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.RefundedFeeRevenue.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventTopicOwnershipTransferProposed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTopicOwnershipTransferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.PreviousOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOperatorAuthorized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOperatorRevoked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventWorkerBondSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.MissedEpochs != 0 {
+		n += 1 + sovEvents(uint64(m.MissedEpochs))
+	}
+	return n
+}
+
+func (m *EventReputerSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Distance.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.DistanceThreshold.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.ConsecutiveEpochs != 0 {
+		n += 1 + sovEvents(uint64(m.ConsecutiveEpochs))
+	}
+	if m.ReputerNonceBlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ReputerNonceBlockHeight))
+	}
+	l = m.SelfSlashedAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.DelegateSlashedAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventDelegateRewardCompounded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventReputerCommissionSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventStakeRemovalRequeued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.BlockRemovalCompleted != 0 {
+		n += 1 + sovEvents(uint64(m.BlockRemovalCompleted))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventWorkerBondRemovalRequeued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.BlockRemovalCompleted != 0 {
+		n += 1 + sovEvents(uint64(m.BlockRemovalCompleted))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRewardPayoutDeadLettered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.FailedAttempts != 0 {
+		n += 1 + sovEvents(uint64(m.FailedAttempts))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScoresSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScoresSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			}
+			m.ActorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorType |= ActorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Scores = append(m.Scores, v)
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRewardsSettled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRewardsSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			}
+			m.ActorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorType |= ActorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Rewards = append(m.Rewards, v)
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNetworkLossSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNetworkLossSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueBundle == nil {
+				m.ValueBundle = &ValueBundle{}
+			}
+			if err := m.ValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventForecastTaskScoreSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForecastTaskScoreSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForecastTaskScoreSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWorkerLastCommitSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWorkerLastCommitSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWorkerLastCommitSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nonce == nil {
+				m.Nonce = &Nonce{}
+			}
+			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventReputerLastCommitSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventReputerLastCommitSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventReputerLastCommitSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nonce == nil {
+				m.Nonce = &Nonce{}
+			}
+			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTopicRewardsSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTopicRewardsSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTopicRewardsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TopicIds = append(m.TopicIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TopicIds) == 0 {
+					m.TopicIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TopicIds = append(m.TopicIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicIds", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Rewards = append(m.Rewards, v)
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEMAScoresSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEMAScoresSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			}
+			m.ActorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorType |= ActorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Scores = append(m.Scores, v)
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.IsActive = append(m.IsActive, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.IsActive) == 0 {
+					m.IsActive = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.IsActive = append(m.IsActive, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventListeningCoefficientsSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventListeningCoefficientsSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventListeningCoefficientsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			}
+			m.ActorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorType |= ActorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coefficients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Coefficients = append(m.Coefficients, v)
+			if err := m.Coefficients[len(m.Coefficients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventInfererNetworkRegretSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventInfererNetworkRegretSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventInfererNetworkRegretSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regrets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Regrets = append(m.Regrets, v)
+			if err := m.Regrets[len(m.Regrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventForecasterNetworkRegretSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForecasterNetworkRegretSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForecasterNetworkRegretSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regrets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Regrets = append(m.Regrets, v)
+			if err := m.Regrets[len(m.Regrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNaiveInfererNetworkRegretSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNaiveInfererNetworkRegretSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNaiveInfererNetworkRegretSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regrets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Regrets = append(m.Regrets, v)
+			if err := m.Regrets[len(m.Regrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTopicInitialRegretSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTopicInitialRegretSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTopicInitialRegretSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Regret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTopicInitialEmaScoreSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTopicInitialEmaScoreSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTopicInitialEmaScoreSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			}
+			m.ActorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorType |= ActorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTopicUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTopicUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTopicUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldTopic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldTopic == nil {
+				m.OldTopic = &Topic{}
+			}
+			if err := m.OldTopic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTopic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewTopic == nil {
+				m.NewTopic = &Topic{}
+			}
+			if err := m.NewTopic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTopicUpdateRejected) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTopicUpdateRejected: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTopicUpdateRejected: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Update == nil {
+				m.Update = &OptionalTopicParams{}
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *EventTopicInitialRegretSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
-	}
-	l = m.Regret.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
 
-func (m *EventTopicInitialEmaScoreSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ActorType != 0 {
-		n += 1 + sovEvents(uint64(m.ActorType))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Score.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
+func (m *EventTopicArchived) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2095,32 +6801,13 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScoresSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTopicArchived: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScoresSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTopicArchived: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
-			}
-			m.ActorType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ActorType |= ActorType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -2139,7 +6826,7 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -2158,9 +6845,9 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundRecipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2188,11 +6875,11 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.RefundRecipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedFeeRevenue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2220,9 +6907,7 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.Scores = append(m.Scores, v)
-			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RefundedFeeRevenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2247,7 +6932,7 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
+func (m *EventTopicOwnershipTransferProposed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2270,32 +6955,13 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRewardsSettled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTopicOwnershipTransferProposed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRewardsSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTopicOwnershipTransferProposed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
-			}
-			m.ActorType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ActorType |= ActorType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -2314,7 +6980,7 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -2333,9 +6999,9 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2363,11 +7029,11 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2395,11 +7061,7 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.Rewards = append(m.Rewards, v)
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2422,7 +7084,7 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
+func (m *EventTopicOwnershipTransferred) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2445,10 +7107,10 @@ func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNetworkLossSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTopicOwnershipTransferred: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNetworkLossSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTopicOwnershipTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2491,9 +7153,9 @@ func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2503,27 +7165,55 @@ func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueBundle == nil {
-				m.ValueBundle = &ValueBundle{}
+			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
-			if err := m.ValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2546,7 +7236,7 @@ func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventForecastTaskScoreSet) Unmarshal(dAtA []byte) error {
+func (m *EventOperatorAuthorized) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2569,10 +7259,10 @@ func (m *EventForecastTaskScoreSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventForecastTaskScoreSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOperatorAuthorized: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventForecastTaskScoreSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOperatorAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2595,8 +7285,59 @@ func (m *EventForecastTaskScoreSet) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2624,9 +7365,7 @@ func (m *EventForecastTaskScoreSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2649,7 +7388,7 @@ func (m *EventForecastTaskScoreSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWorkerLastCommitSet) Unmarshal(dAtA []byte) error {
+func (m *EventOperatorRevoked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2672,10 +7411,10 @@ func (m *EventWorkerLastCommitSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWorkerLastCommitSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOperatorRevoked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWorkerLastCommitSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOperatorRevoked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2718,9 +7457,9 @@ func (m *EventWorkerLastCommitSet) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2730,27 +7469,55 @@ func (m *EventWorkerLastCommitSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nonce == nil {
-				m.Nonce = &Nonce{}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2773,7 +7540,7 @@ func (m *EventWorkerLastCommitSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventReputerLastCommitSet) Unmarshal(dAtA []byte) error {
+func (m *EventWorkerBondSlashed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2796,10 +7563,10 @@ func (m *EventReputerLastCommitSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventReputerLastCommitSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventWorkerBondSlashed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventReputerLastCommitSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventWorkerBondSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2842,9 +7609,9 @@ func (m *EventReputerLastCommitSet) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2854,28 +7621,77 @@ func (m *EventReputerLastCommitSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nonce == nil {
-				m.Nonce = &Nonce{}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
 			}
-			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedEpochs", wireType)
+			}
+			m.MissedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2897,7 +7713,7 @@ func (m *EventReputerLastCommitSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTopicRewardsSet) Unmarshal(dAtA []byte) error {
+func (m *EventReputerSlashed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2920,91 +7736,53 @@ func (m *EventTopicRewardsSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTopicRewardsSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventReputerSlashed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTopicRewardsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventReputerSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
 				}
-				m.TopicIds = append(m.TopicIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.TopicIds) == 0 {
-					m.TopicIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.TopicIds = append(m.TopicIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicIds", wireType)
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3032,67 +7810,47 @@ func (m *EventTopicRewardsSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.Rewards = append(m.Rewards, v)
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distance", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Distance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventEMAScoresSet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventEMAScoresSet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistanceThreshold", wireType)
 			}
-			m.ActorType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3102,16 +7860,31 @@ func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActorType |= ActorType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistanceThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveEpochs", wireType)
 			}
-			m.TopicId = 0
+			m.ConsecutiveEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3121,16 +7894,16 @@ func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				m.ConsecutiveEpochs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerNonceBlockHeight", wireType)
 			}
-			m.Nonce = 0
+			m.ReputerNonceBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3140,14 +7913,14 @@ func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
+				m.ReputerNonceBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfSlashedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3175,11 +7948,13 @@ func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			if err := m.SelfSlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateSlashedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3207,82 +7982,10 @@ func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.Scores = append(m.Scores, v)
-			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelegateSlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.IsActive = append(m.IsActive, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.IsActive) == 0 {
-					m.IsActive = make([]bool, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.IsActive = append(m.IsActive, bool(v != 0))
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3304,7 +8007,7 @@ func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventListeningCoefficientsSet) Unmarshal(dAtA []byte) error {
+func (m *EventDelegateRewardCompounded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3327,17 +8030,17 @@ func (m *EventListeningCoefficientsSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventListeningCoefficientsSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDelegateRewardCompounded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventListeningCoefficientsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDelegateRewardCompounded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.ActorType = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3347,16 +8050,16 @@ func (m *EventListeningCoefficientsSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActorType |= ActorType(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.TopicId = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3366,16 +8069,16 @@ func (m *EventListeningCoefficientsSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3385,14 +8088,27 @@ func (m *EventListeningCoefficientsSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3420,11 +8136,11 @@ func (m *EventListeningCoefficientsSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coefficients", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3452,9 +8168,7 @@ func (m *EventListeningCoefficientsSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.Coefficients = append(m.Coefficients, v)
-			if err := m.Coefficients[len(m.Coefficients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3479,7 +8193,7 @@ func (m *EventListeningCoefficientsSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventInfererNetworkRegretSet) Unmarshal(dAtA []byte) error {
+func (m *EventReputerCommissionSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3502,10 +8216,10 @@ func (m *EventInfererNetworkRegretSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventInfererNetworkRegretSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventReputerCommissionSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventInfererNetworkRegretSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventReputerCommissionSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3548,7 +8262,7 @@ func (m *EventInfererNetworkRegretSet) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3576,11 +8290,79 @@ func (m *EventInfererNetworkRegretSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3608,9 +8390,7 @@ func (m *EventInfererNetworkRegretSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.Regrets = append(m.Regrets, v)
-			if err := m.Regrets[len(m.Regrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3635,7 +8415,7 @@ func (m *EventInfererNetworkRegretSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventForecasterNetworkRegretSet) Unmarshal(dAtA []byte) error {
+func (m *EventStakeRemovalRequeued) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3658,10 +8438,10 @@ func (m *EventForecasterNetworkRegretSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventForecasterNetworkRegretSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventStakeRemovalRequeued: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventForecasterNetworkRegretSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventStakeRemovalRequeued: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3704,7 +8484,7 @@ func (m *EventForecasterNetworkRegretSet) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3732,11 +8512,11 @@ func (m *EventForecasterNetworkRegretSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3764,12 +8544,93 @@ func (m *EventForecasterNetworkRegretSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.Regrets = append(m.Regrets, v)
-			if err := m.Regrets[len(m.Regrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+			}
+			m.BlockRemovalCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3791,7 +8652,7 @@ func (m *EventForecasterNetworkRegretSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNaiveInfererNetworkRegretSet) Unmarshal(dAtA []byte) error {
+func (m *EventWorkerBondRemovalRequeued) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3814,10 +8675,10 @@ func (m *EventNaiveInfererNetworkRegretSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNaiveInfererNetworkRegretSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventWorkerBondRemovalRequeued: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNaiveInfererNetworkRegretSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventWorkerBondRemovalRequeued: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3860,7 +8721,7 @@ func (m *EventNaiveInfererNetworkRegretSet) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3888,11 +8749,11 @@ func (m *EventNaiveInfererNetworkRegretSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3920,12 +8781,61 @@ func (m *EventNaiveInfererNetworkRegretSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.Regrets = append(m.Regrets, v)
-			if err := m.Regrets[len(m.Regrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+			}
+			m.BlockRemovalCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3947,7 +8857,7 @@ func (m *EventNaiveInfererNetworkRegretSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTopicInitialRegretSet) Unmarshal(dAtA []byte) error {
+func (m *EventRewardPayoutDeadLettered) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3970,10 +8880,10 @@ func (m *EventTopicInitialRegretSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTopicInitialRegretSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRewardPayoutDeadLettered: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTopicInitialRegretSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRewardPayoutDeadLettered: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4016,7 +8926,7 @@ func (m *EventTopicInitialRegretSet) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4044,65 +8954,13 @@ func (m *EventTopicInitialRegretSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Regret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventTopicInitialEmaScoreSet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventTopicInitialEmaScoreSet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTopicInitialEmaScoreSet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.ActorType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4112,16 +8970,29 @@ func (m *EventTopicInitialEmaScoreSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActorType |= ActorType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.TopicId = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4131,16 +9002,31 @@ func (m *EventTopicInitialEmaScoreSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
 			}
-			m.BlockHeight = 0
+			m.FailedAttempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4150,14 +9036,14 @@ func (m *EventTopicInitialEmaScoreSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				m.FailedAttempts |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4185,9 +9071,7 @@ func (m *EventTopicInitialEmaScoreSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1,6 +1,7 @@
 package types
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -192,3 +193,145 @@ func EmitNewTopicInitialEmaScoreSetEvent(ctx sdk.Context, actorType ActorType, t
 		ctx.Logger().Warn("Error emitting NewTopicInitialEmaScoreSetEvent: ", err.Error())
 	}
 }
+
+func EmitNewTopicUpdatedEvent(ctx sdk.Context, topicId uint64, blockHeight int64, oldTopic, newTopic Topic) {
+	metrics.IncrProducerEventCount(metrics.TOPIC_UPDATED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewTopicUpdatedEventBase(topicId, blockHeight, oldTopic, newTopic))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewTopicUpdatedEvent: ", err.Error())
+	}
+}
+
+func EmitNewTopicUpdateRejectedEvent(ctx sdk.Context, topicId uint64, blockHeight int64, update OptionalTopicParams, reason string) {
+	metrics.IncrProducerEventCount(metrics.TOPIC_UPDATE_REJECTED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewTopicUpdateRejectedEventBase(topicId, blockHeight, update, reason))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewTopicUpdateRejectedEvent: ", err.Error())
+	}
+}
+
+func EmitNewTopicArchivedEvent(ctx sdk.Context, topicId uint64, blockHeight int64, refundRecipient string, refundedFeeRevenue cosmosMath.Int) {
+	metrics.IncrProducerEventCount(metrics.TOPIC_ARCHIVED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewTopicArchivedEventBase(topicId, blockHeight, refundRecipient, refundedFeeRevenue))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewTopicArchivedEvent: ", err.Error())
+	}
+}
+
+func EmitNewTopicOwnershipTransferProposedEvent(ctx sdk.Context, topicId uint64, blockHeight int64, owner, pendingOwner string) {
+	metrics.IncrProducerEventCount(metrics.TOPIC_OWNER_PROPOSED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewTopicOwnershipTransferProposedEventBase(topicId, blockHeight, owner, pendingOwner))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewTopicOwnershipTransferProposedEvent: ", err.Error())
+	}
+}
+
+func EmitNewTopicOwnershipTransferredEvent(ctx sdk.Context, topicId uint64, blockHeight int64, previousOwner, newOwner string) {
+	metrics.IncrProducerEventCount(metrics.TOPIC_OWNER_TRANSFERRED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewTopicOwnershipTransferredEventBase(topicId, blockHeight, previousOwner, newOwner))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewTopicOwnershipTransferredEvent: ", err.Error())
+	}
+}
+
+func EmitNewOperatorAuthorizedEvent(ctx sdk.Context, topicId uint64, blockHeight int64, actor, operator string) {
+	metrics.IncrProducerEventCount(metrics.OPERATOR_AUTHORIZED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewOperatorAuthorizedEventBase(topicId, blockHeight, actor, operator))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewOperatorAuthorizedEvent: ", err.Error())
+	}
+}
+
+func EmitNewOperatorRevokedEvent(ctx sdk.Context, topicId uint64, blockHeight int64, actor, operator string) {
+	metrics.IncrProducerEventCount(metrics.OPERATOR_REVOKED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewOperatorRevokedEventBase(topicId, blockHeight, actor, operator))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewOperatorRevokedEvent: ", err.Error())
+	}
+}
+
+func EmitNewWorkerBondSlashedEvent(ctx sdk.Context, topicId uint64, blockHeight int64, worker string, slashedAmount cosmosMath.Int, missedEpochs int64) {
+	metrics.IncrProducerEventCount(metrics.WORKER_BOND_SLASHED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewWorkerBondSlashedEventBase(topicId, blockHeight, worker, slashedAmount, missedEpochs))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewWorkerBondSlashedEvent: ", err.Error())
+	}
+}
+
+func EmitNewReputerSlashedEvent(
+	ctx sdk.Context,
+	deviation ReputerConsensusDeviation,
+	distanceThreshold alloraMath.Dec,
+	selfSlashedAmount cosmosMath.Int,
+	delegateSlashedAmount cosmosMath.Int,
+) {
+	metrics.IncrProducerEventCount(metrics.REPUTER_SLASHED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(
+		NewReputerSlashedEventBase(ctx.BlockHeight(), deviation, distanceThreshold, selfSlashedAmount, delegateSlashedAmount))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewReputerSlashedEvent: ", err.Error())
+	}
+}
+
+func EmitNewDelegateRewardCompoundedEvent(
+	ctx sdk.Context,
+	topicId uint64,
+	delegator string,
+	reputer string,
+	amount cosmosMath.Int,
+) {
+	metrics.IncrProducerEventCount(metrics.DELEGATE_REWARD_COMPOUNDED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(
+		NewDelegateRewardCompoundedEventBase(ctx.BlockHeight(), topicId, delegator, reputer, amount))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewDelegateRewardCompoundedEvent: ", err.Error())
+	}
+}
+
+func EmitNewReputerCommissionSetEvent(ctx sdk.Context, commission ReputerCommission) {
+	metrics.IncrProducerEventCount(metrics.REPUTER_COMMISSION_SET_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewReputerCommissionSetEventBase(ctx.BlockHeight(), commission))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewReputerCommissionSetEvent: ", err.Error())
+	}
+}
+
+func EmitNewStakeRemovalRequeuedEvent(
+	ctx sdk.Context,
+	topicId uint64,
+	reputer, delegator string,
+	amount cosmosMath.Int,
+	blockRemovalCompleted int64,
+	reason string,
+) {
+	metrics.IncrProducerEventCount(metrics.STAKE_REMOVAL_REQUEUED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewStakeRemovalRequeuedEventBase(
+		ctx.BlockHeight(), topicId, reputer, delegator, amount, blockRemovalCompleted, reason))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewStakeRemovalRequeuedEvent: ", err.Error())
+	}
+}
+
+func EmitNewWorkerBondRemovalRequeuedEvent(
+	ctx sdk.Context,
+	topicId uint64,
+	worker string,
+	amount cosmosMath.Int,
+	blockRemovalCompleted int64,
+	reason string,
+) {
+	metrics.IncrProducerEventCount(metrics.WORKER_BOND_REMOVAL_REQUEUED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewWorkerBondRemovalRequeuedEventBase(
+		ctx.BlockHeight(), topicId, worker, amount, blockRemovalCompleted, reason))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewWorkerBondRemovalRequeuedEvent: ", err.Error())
+	}
+}
+
+func EmitNewRewardPayoutDeadLetteredEvent(ctx sdk.Context, payout QueuedRewardPayout, reason string) {
+	metrics.IncrProducerEventCount(metrics.REWARD_PAYOUT_DEAD_LETTERED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewRewardPayoutDeadLetteredEventBase(ctx.BlockHeight(), payout, reason))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewRewardPayoutDeadLetteredEvent: ", err.Error())
+	}
+}
@@ -390,12 +390,14 @@ func TestEmitNewNetworkLossSetEvent(t *testing.T) {
 		ExtraData:                     nil,
 		CombinedValue:                 alloraMath.MustNewDecFromString("10"),
 		NaiveValue:                    alloraMath.MustNewDecFromString("20"),
-		InfererValues:                 []*types.WorkerAttributedValue{{Worker: "TestInferer", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestInferer1", Value: alloraMath.MustNewDecFromString("0.0112")}},
-		ForecasterValues:              []*types.WorkerAttributedValue{{Worker: "TestForecaster", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestForecaster1", Value: alloraMath.MustNewDecFromString("0.0112")}},
-		OneOutInfererValues:           []*types.WithheldWorkerAttributedValue{{Worker: "TestInferer2", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestInferer3", Value: alloraMath.MustNewDecFromString("0.0112")}},
-		OneOutForecasterValues:        []*types.WithheldWorkerAttributedValue{{Worker: "TestForecaster3", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestForecaster4", Value: alloraMath.MustNewDecFromString("0.0112")}},
-		OneInForecasterValues:         []*types.WorkerAttributedValue{{Worker: "TestForecaster5", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestForecaster6", Value: alloraMath.MustNewDecFromString("0.0112")}},
+		InfererValues:                 []*types.WorkerAttributedValue{{Worker: "TestInferer", Value: alloraMath.MustNewDecFromString("0.0112"), Values: []alloraMath.Dec{}}, {Worker: "TestInferer1", Value: alloraMath.MustNewDecFromString("0.0112"), Values: []alloraMath.Dec{}}},
+		ForecasterValues:              []*types.WorkerAttributedValue{{Worker: "TestForecaster", Value: alloraMath.MustNewDecFromString("0.0112"), Values: []alloraMath.Dec{}}, {Worker: "TestForecaster1", Value: alloraMath.MustNewDecFromString("0.0112"), Values: []alloraMath.Dec{}}},
+		OneOutInfererValues:           []*types.WithheldWorkerAttributedValue{{Worker: "TestInferer2", Value: alloraMath.MustNewDecFromString("0.0112"), Values: []alloraMath.Dec{}}, {Worker: "TestInferer3", Value: alloraMath.MustNewDecFromString("0.0112"), Values: []alloraMath.Dec{}}},
+		OneOutForecasterValues:        []*types.WithheldWorkerAttributedValue{{Worker: "TestForecaster3", Value: alloraMath.MustNewDecFromString("0.0112"), Values: []alloraMath.Dec{}}, {Worker: "TestForecaster4", Value: alloraMath.MustNewDecFromString("0.0112"), Values: []alloraMath.Dec{}}},
+		OneInForecasterValues:         []*types.WorkerAttributedValue{{Worker: "TestForecaster5", Value: alloraMath.MustNewDecFromString("0.0112"), Values: []alloraMath.Dec{}}, {Worker: "TestForecaster6", Value: alloraMath.MustNewDecFromString("0.0112"), Values: []alloraMath.Dec{}}},
 		OneOutInfererForecasterValues: nil,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
 	}
 
 	types.EmitNewNetworkLossSetEvent(ctx, topicId, blockHeight, loss)
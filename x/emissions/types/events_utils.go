@@ -1,6 +1,7 @@
 package types
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	proto "github.com/cosmos/gogoproto/proto"
 )
@@ -170,3 +171,172 @@ func NewTopicInitialEmaScoreSetEventBase(actorType ActorType, topicId uint64, bl
 		Score:       score,
 	}
 }
+
+func NewTopicUpdatedEventBase(topicId uint64, blockHeight int64, oldTopic, newTopic Topic) proto.Message {
+	return &EventTopicUpdated{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		OldTopic:    &oldTopic,
+		NewTopic:    &newTopic,
+	}
+}
+
+func NewTopicUpdateRejectedEventBase(topicId uint64, blockHeight int64, update OptionalTopicParams, reason string) proto.Message {
+	return &EventTopicUpdateRejected{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Update:      &update,
+		Reason:      reason,
+	}
+}
+
+func NewTopicArchivedEventBase(topicId uint64, blockHeight int64, refundRecipient string, refundedFeeRevenue cosmosMath.Int) proto.Message {
+	return &EventTopicArchived{
+		TopicId:            topicId,
+		BlockHeight:        blockHeight,
+		RefundRecipient:    refundRecipient,
+		RefundedFeeRevenue: refundedFeeRevenue,
+	}
+}
+
+func NewTopicOwnershipTransferProposedEventBase(topicId uint64, blockHeight int64, owner, pendingOwner string) proto.Message {
+	return &EventTopicOwnershipTransferProposed{
+		TopicId:      topicId,
+		BlockHeight:  blockHeight,
+		Owner:        owner,
+		PendingOwner: pendingOwner,
+	}
+}
+
+func NewTopicOwnershipTransferredEventBase(topicId uint64, blockHeight int64, previousOwner, newOwner string) proto.Message {
+	return &EventTopicOwnershipTransferred{
+		TopicId:       topicId,
+		BlockHeight:   blockHeight,
+		PreviousOwner: previousOwner,
+		NewOwner:      newOwner,
+	}
+}
+
+func NewOperatorAuthorizedEventBase(topicId uint64, blockHeight int64, actor, operator string) proto.Message {
+	return &EventOperatorAuthorized{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Actor:       actor,
+		Operator:    operator,
+	}
+}
+
+func NewOperatorRevokedEventBase(topicId uint64, blockHeight int64, actor, operator string) proto.Message {
+	return &EventOperatorRevoked{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Actor:       actor,
+		Operator:    operator,
+	}
+}
+
+func NewWorkerBondSlashedEventBase(topicId uint64, blockHeight int64, worker string, slashedAmount cosmosMath.Int, missedEpochs int64) proto.Message {
+	return &EventWorkerBondSlashed{
+		TopicId:       topicId,
+		BlockHeight:   blockHeight,
+		Worker:        worker,
+		SlashedAmount: slashedAmount,
+		MissedEpochs:  missedEpochs,
+	}
+}
+
+func NewReputerSlashedEventBase(
+	blockHeight int64,
+	deviation ReputerConsensusDeviation,
+	distanceThreshold alloraMath.Dec,
+	selfSlashedAmount cosmosMath.Int,
+	delegateSlashedAmount cosmosMath.Int,
+) proto.Message {
+	return &EventReputerSlashed{
+		TopicId:                 deviation.TopicId,
+		BlockHeight:             blockHeight,
+		Reputer:                 deviation.Reputer,
+		Distance:                deviation.Distance,
+		DistanceThreshold:       distanceThreshold,
+		ConsecutiveEpochs:       deviation.ConsecutiveEpochs,
+		ReputerNonceBlockHeight: deviation.BlockHeight,
+		SelfSlashedAmount:       selfSlashedAmount,
+		DelegateSlashedAmount:   delegateSlashedAmount,
+	}
+}
+
+func NewDelegateRewardCompoundedEventBase(
+	blockHeight int64,
+	topicId uint64,
+	delegator string,
+	reputer string,
+	amount cosmosMath.Int,
+) proto.Message {
+	return &EventDelegateRewardCompounded{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Delegator:   delegator,
+		Reputer:     reputer,
+		Amount:      amount,
+	}
+}
+
+func NewReputerCommissionSetEventBase(blockHeight int64, commission ReputerCommission) proto.Message {
+	return &EventReputerCommissionSet{
+		TopicId:       commission.TopicId,
+		BlockHeight:   blockHeight,
+		Reputer:       commission.Reputer,
+		Rate:          commission.Rate,
+		MaxRate:       commission.MaxRate,
+		MaxChangeRate: commission.MaxChangeRate,
+	}
+}
+
+func NewStakeRemovalRequeuedEventBase(
+	blockHeight int64,
+	topicId uint64,
+	reputer, delegator string,
+	amount cosmosMath.Int,
+	blockRemovalCompleted int64,
+	reason string,
+) proto.Message {
+	return &EventStakeRemovalRequeued{
+		TopicId:               topicId,
+		BlockHeight:           blockHeight,
+		Reputer:               reputer,
+		Delegator:             delegator,
+		Amount:                amount,
+		BlockRemovalCompleted: blockRemovalCompleted,
+		Reason:                reason,
+	}
+}
+
+func NewWorkerBondRemovalRequeuedEventBase(
+	blockHeight int64,
+	topicId uint64,
+	worker string,
+	amount cosmosMath.Int,
+	blockRemovalCompleted int64,
+	reason string,
+) proto.Message {
+	return &EventWorkerBondRemovalRequeued{
+		TopicId:               topicId,
+		BlockHeight:           blockHeight,
+		Worker:                worker,
+		Amount:                amount,
+		BlockRemovalCompleted: blockRemovalCompleted,
+		Reason:                reason,
+	}
+}
+
+func NewRewardPayoutDeadLetteredEventBase(blockHeight int64, payout QueuedRewardPayout, reason string) proto.Message {
+	return &EventRewardPayoutDeadLettered{
+		TopicId:        payout.TopicId,
+		BlockHeight:    blockHeight,
+		Address:        payout.Address,
+		Type:           payout.Type,
+		Amount:         payout.Amount,
+		FailedAttempts: payout.FailedAttempts,
+		Reason:         reason,
+	}
+}
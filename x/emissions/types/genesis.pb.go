@@ -219,6 +219,51 @@ type GenesisState struct {
 	GlobalWorkerWhitelist  []string         `protobuf:"bytes,89,rep,name=global_worker_whitelist,json=globalWorkerWhitelist,proto3" json:"global_worker_whitelist,omitempty"`
 	GlobalReputerWhitelist []string         `protobuf:"bytes,90,rep,name=global_reputer_whitelist,json=globalReputerWhitelist,proto3" json:"global_reputer_whitelist,omitempty"`
 	GlobalAdminWhitelist   []string         `protobuf:"bytes,91,rep,name=global_admin_whitelist,json=globalAdminWhitelist,proto3" json:"global_admin_whitelist,omitempty"`
+	// topic parameter changes waiting for the topic's next epoch boundary
+	PendingTopicUpdates []*TopicIdAndOptionalTopicParams `protobuf:"bytes,92,rep,name=pending_topic_updates,json=pendingTopicUpdates,proto3" json:"pending_topic_updates,omitempty"`
+	// topics that have been archived
+	ArchivedTopics []uint64 `protobuf:"varint,93,rep,packed,name=archived_topics,json=archivedTopics,proto3" json:"archived_topics,omitempty"`
+	// archived topics whose per-topic state has not been fully pruned yet
+	ArchivedTopicsToPrune []uint64 `protobuf:"varint,94,rep,packed,name=archived_topics_to_prune,json=archivedTopicsToPrune,proto3" json:"archived_topics_to_prune,omitempty"`
+	// topics whose ownership has been transferred away from their creator
+	TopicOwners []*TopicAndActorId `protobuf:"bytes,95,rep,name=topic_owners,json=topicOwners,proto3" json:"topic_owners,omitempty"`
+	// proposed owners that have not accepted the transfer yet
+	PendingTopicOwners []*TopicAndActorId `protobuf:"bytes,96,rep,name=pending_topic_owners,json=pendingTopicOwners,proto3" json:"pending_topic_owners,omitempty"`
+	/// TOPIC TAGS
+	TopicTags []*TopicIdAndTag `protobuf:"bytes,97,rep,name=topic_tags,json=topicTags,proto3" json:"topic_tags,omitempty"`
+	// latest ground truth submitted by each reputer of topics whose losses are computed by the chain
+	ReputerGroundTruths []*GroundTruth `protobuf:"bytes,98,rep,name=reputer_ground_truths,json=reputerGroundTruths,proto3" json:"reputer_ground_truths,omitempty"`
+	// stake-weighted consensus ground truth of each closed reputer nonce
+	ConsensusGroundTruths []*GroundTruth `protobuf:"bytes,99,rep,name=consensus_ground_truths,json=consensusGroundTruths,proto3" json:"consensus_ground_truths,omitempty"`
+	// payload commitments of workers of topics using commit-reveal, for the open worker nonce
+	WorkerPayloadCommits []*WorkerPayloadCommit `protobuf:"bytes,100,rep,name=worker_payload_commits,json=workerPayloadCommits,proto3" json:"worker_payload_commits,omitempty"`
+	// operator keys authorized to submit payloads on behalf of actors of a topic
+	ActorOperators []*TopicActorOperator `protobuf:"bytes,101,rep,name=actor_operators,json=actorOperators,proto3" json:"actor_operators,omitempty"`
+	// amounts bonded by workers on topics requiring a worker bond
+	WorkerBonds []*WorkerBond `protobuf:"bytes,102,rep,name=worker_bonds,json=workerBonds,proto3" json:"worker_bonds,omitempty"`
+	// pending worker bond removals
+	WorkerBondRemovals []*WorkerBondRemovalInfo `protobuf:"bytes,103,rep,name=worker_bond_removals,json=workerBondRemovals,proto3" json:"worker_bond_removals,omitempty"`
+	// reputers currently deviating from the loss consensus
+	ReputerConsensusDeviations []*ReputerConsensusDeviation `protobuf:"bytes,104,rep,name=reputer_consensus_deviations,json=reputerConsensusDeviations,proto3" json:"reputer_consensus_deviations,omitempty"`
+	// redelegations that have not completed yet
+	Redelegations []*RedelegationInfo `protobuf:"bytes,105,rep,name=redelegations,proto3" json:"redelegations,omitempty"`
+	// delegators that restake their rewards on a topic as they are paid out
+	AutoCompoundDelegators []*TopicAndActorId `protobuf:"bytes,106,rep,name=auto_compound_delegators,json=autoCompoundDelegators,proto3" json:"auto_compound_delegators,omitempty"`
+	// commissions reputers take on the rewards of their delegators
+	ReputerCommissions []*ReputerCommission `protobuf:"bytes,107,rep,name=reputer_commissions,json=reputerCommissions,proto3" json:"reputer_commissions,omitempty"`
+	// stake locked for a lockup period, with their reward multiplier
+	StakeLocks []*StakeLock `protobuf:"bytes,108,rep,name=stake_locks,json=stakeLocks,proto3" json:"stake_locks,omitempty"`
+	// rewards paid out within the reward history retention
+	RewardHistory []*RewardRecord `protobuf:"bytes,109,rep,name=reward_history,json=rewardHistory,proto3" json:"reward_history,omitempty"`
+	// shares of rewards held in escrow until they vest and are claimed
+	RewardEscrows []*RewardEscrow `protobuf:"bytes,110,rep,name=reward_escrows,json=rewardEscrows,proto3" json:"reward_escrows,omitempty"`
+	// rewards waiting to be paid out, and the id of the next reward queued
+	QueuedRewardPayouts []*QueuedRewardPayout `protobuf:"bytes,111,rep,name=queued_reward_payouts,json=queuedRewardPayouts,proto3" json:"queued_reward_payouts,omitempty"`
+	NextRewardPayoutId  uint64                `protobuf:"varint,112,opt,name=next_reward_payout_id,json=nextRewardPayoutId,proto3" json:"next_reward_payout_id,omitempty"`
+	// last delegation of each archived topic whose stake was scheduled for removal
+	ArchivedTopicDelegateRemovalCursors []*TopicIdDelegatorReputer `protobuf:"bytes,113,rep,name=archived_topic_delegate_removal_cursors,json=archivedTopicDelegateRemovalCursors,proto3" json:"archived_topic_delegate_removal_cursors,omitempty"`
+	// rewards that failed to be paid out max_reward_payout_attempts times
+	DeadLetteredRewardPayouts []*QueuedRewardPayout `protobuf:"bytes,114,rep,name=dead_lettered_reward_payouts,json=deadLetteredRewardPayouts,proto3" json:"dead_lettered_reward_payouts,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -842,6 +887,167 @@ func (m *GenesisState) GetGlobalAdminWhitelist() []string {
 	return nil
 }
 
+func (m *GenesisState) GetPendingTopicUpdates() []*TopicIdAndOptionalTopicParams {
+	if m != nil {
+		return m.PendingTopicUpdates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedTopics() []uint64 {
+	if m != nil {
+		return m.ArchivedTopics
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedTopicsToPrune() []uint64 {
+	if m != nil {
+		return m.ArchivedTopicsToPrune
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicOwners() []*TopicAndActorId {
+	if m != nil {
+		return m.TopicOwners
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingTopicOwners() []*TopicAndActorId {
+	if m != nil {
+		return m.PendingTopicOwners
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicTags() []*TopicIdAndTag {
+	if m != nil {
+		return m.TopicTags
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputerGroundTruths() []*GroundTruth {
+	if m != nil {
+		return m.ReputerGroundTruths
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsensusGroundTruths() []*GroundTruth {
+	if m != nil {
+		return m.ConsensusGroundTruths
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWorkerPayloadCommits() []*WorkerPayloadCommit {
+	if m != nil {
+		return m.WorkerPayloadCommits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetActorOperators() []*TopicActorOperator {
+	if m != nil {
+		return m.ActorOperators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWorkerBonds() []*WorkerBond {
+	if m != nil {
+		return m.WorkerBonds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWorkerBondRemovals() []*WorkerBondRemovalInfo {
+	if m != nil {
+		return m.WorkerBondRemovals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputerConsensusDeviations() []*ReputerConsensusDeviation {
+	if m != nil {
+		return m.ReputerConsensusDeviations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedelegations() []*RedelegationInfo {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundDelegators() []*TopicAndActorId {
+	if m != nil {
+		return m.AutoCompoundDelegators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputerCommissions() []*ReputerCommission {
+	if m != nil {
+		return m.ReputerCommissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStakeLocks() []*StakeLock {
+	if m != nil {
+		return m.StakeLocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardHistory() []*RewardRecord {
+	if m != nil {
+		return m.RewardHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardEscrows() []*RewardEscrow {
+	if m != nil {
+		return m.RewardEscrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedRewardPayouts() []*QueuedRewardPayout {
+	if m != nil {
+		return m.QueuedRewardPayouts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextRewardPayoutId() uint64 {
+	if m != nil {
+		return m.NextRewardPayoutId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetArchivedTopicDelegateRemovalCursors() []*TopicIdDelegatorReputer {
+	if m != nil {
+		return m.ArchivedTopicDelegateRemovalCursors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeadLetteredRewardPayouts() []*QueuedRewardPayout {
+	if m != nil {
+		return m.DeadLetteredRewardPayouts
+	}
+	return nil
+}
+
 type TopicIdAndTopic struct {
 	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 	Topic   *Topic `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
@@ -894,23 +1100,23 @@ func (m *TopicIdAndTopic) GetTopic() *Topic {
 	return nil
 }
 
-type TopicAndActorId struct {
-	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+type TopicIdAndOptionalTopicParams struct {
+	TopicId uint64               `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Params  *OptionalTopicParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
 }
 
-func (m *TopicAndActorId) Reset()         { *m = TopicAndActorId{} }
-func (m *TopicAndActorId) String() string { return proto.CompactTextString(m) }
-func (*TopicAndActorId) ProtoMessage()    {}
-func (*TopicAndActorId) Descriptor() ([]byte, []int) {
+func (m *TopicIdAndOptionalTopicParams) Reset()         { *m = TopicIdAndOptionalTopicParams{} }
+func (m *TopicIdAndOptionalTopicParams) String() string { return proto.CompactTextString(m) }
+func (*TopicIdAndOptionalTopicParams) ProtoMessage()    {}
+func (*TopicIdAndOptionalTopicParams) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3a31f894a338870b, []int{2}
 }
-func (m *TopicAndActorId) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdAndOptionalTopicParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicAndActorId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdAndOptionalTopicParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicAndActorId.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdAndOptionalTopicParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -920,49 +1126,49 @@ func (m *TopicAndActorId) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *TopicAndActorId) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicAndActorId.Merge(m, src)
+func (m *TopicIdAndOptionalTopicParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdAndOptionalTopicParams.Merge(m, src)
 }
-func (m *TopicAndActorId) XXX_Size() int {
+func (m *TopicIdAndOptionalTopicParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicAndActorId) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicAndActorId.DiscardUnknown(m)
+func (m *TopicIdAndOptionalTopicParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdAndOptionalTopicParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicAndActorId proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdAndOptionalTopicParams proto.InternalMessageInfo
 
-func (m *TopicAndActorId) GetTopicId() uint64 {
+func (m *TopicIdAndOptionalTopicParams) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicAndActorId) GetActorId() string {
+func (m *TopicIdAndOptionalTopicParams) GetParams() *OptionalTopicParams {
 	if m != nil {
-		return m.ActorId
+		return m.Params
 	}
-	return ""
+	return nil
 }
 
-type TopicIdAndBlockHeight struct {
-	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+type TopicIdAndTag struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Tag     string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
 }
 
-func (m *TopicIdAndBlockHeight) Reset()         { *m = TopicIdAndBlockHeight{} }
-func (m *TopicIdAndBlockHeight) String() string { return proto.CompactTextString(m) }
-func (*TopicIdAndBlockHeight) ProtoMessage()    {}
-func (*TopicIdAndBlockHeight) Descriptor() ([]byte, []int) {
+func (m *TopicIdAndTag) Reset()         { *m = TopicIdAndTag{} }
+func (m *TopicIdAndTag) String() string { return proto.CompactTextString(m) }
+func (*TopicIdAndTag) ProtoMessage()    {}
+func (*TopicIdAndTag) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3a31f894a338870b, []int{3}
 }
-func (m *TopicIdAndBlockHeight) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdAndTag) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdAndBlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdAndTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdAndBlockHeight.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdAndTag.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -972,49 +1178,49 @@ func (m *TopicIdAndBlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *TopicIdAndBlockHeight) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdAndBlockHeight.Merge(m, src)
+func (m *TopicIdAndTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdAndTag.Merge(m, src)
 }
-func (m *TopicIdAndBlockHeight) XXX_Size() int {
+func (m *TopicIdAndTag) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdAndBlockHeight) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdAndBlockHeight.DiscardUnknown(m)
+func (m *TopicIdAndTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdAndTag.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdAndBlockHeight proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdAndTag proto.InternalMessageInfo
 
-func (m *TopicIdAndBlockHeight) GetTopicId() uint64 {
+func (m *TopicIdAndTag) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdAndBlockHeight) GetBlockHeight() int64 {
+func (m *TopicIdAndTag) GetTag() string {
 	if m != nil {
-		return m.BlockHeight
+		return m.Tag
 	}
-	return 0
+	return ""
 }
 
-type BlockHeightAndTopicIds struct {
-	BlockHeight int64    `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	TopicIds    []uint64 `protobuf:"varint,2,rep,packed,name=topic_ids,json=topicIds,proto3" json:"topic_ids,omitempty"`
+type TopicAndActorId struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
 }
 
-func (m *BlockHeightAndTopicIds) Reset()         { *m = BlockHeightAndTopicIds{} }
-func (m *BlockHeightAndTopicIds) String() string { return proto.CompactTextString(m) }
-func (*BlockHeightAndTopicIds) ProtoMessage()    {}
-func (*BlockHeightAndTopicIds) Descriptor() ([]byte, []int) {
+func (m *TopicAndActorId) Reset()         { *m = TopicAndActorId{} }
+func (m *TopicAndActorId) String() string { return proto.CompactTextString(m) }
+func (*TopicAndActorId) ProtoMessage()    {}
+func (*TopicAndActorId) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3a31f894a338870b, []int{4}
 }
-func (m *BlockHeightAndTopicIds) XXX_Unmarshal(b []byte) error {
+func (m *TopicAndActorId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockHeightAndTopicIds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicAndActorId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockHeightAndTopicIds.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicAndActorId.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1024,50 +1230,50 @@ func (m *BlockHeightAndTopicIds) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *BlockHeightAndTopicIds) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockHeightAndTopicIds.Merge(m, src)
+func (m *TopicAndActorId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicAndActorId.Merge(m, src)
 }
-func (m *BlockHeightAndTopicIds) XXX_Size() int {
+func (m *TopicAndActorId) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockHeightAndTopicIds) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockHeightAndTopicIds.DiscardUnknown(m)
+func (m *TopicAndActorId) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicAndActorId.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockHeightAndTopicIds proto.InternalMessageInfo
+var xxx_messageInfo_TopicAndActorId proto.InternalMessageInfo
 
-func (m *BlockHeightAndTopicIds) GetBlockHeight() int64 {
+func (m *TopicAndActorId) GetTopicId() uint64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.TopicId
 	}
 	return 0
 }
 
-func (m *BlockHeightAndTopicIds) GetTopicIds() []uint64 {
+func (m *TopicAndActorId) GetActorId() string {
 	if m != nil {
-		return m.TopicIds
+		return m.ActorId
 	}
-	return nil
+	return ""
 }
 
-type TopicIdBlockHeightScores struct {
-	TopicId     uint64  `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight int64   `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Scores      *Scores `protobuf:"bytes,3,opt,name=scores,proto3" json:"scores,omitempty"`
+type TopicIdDelegatorReputer struct {
+	TopicId   uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Reputer   string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
 }
 
-func (m *TopicIdBlockHeightScores) Reset()         { *m = TopicIdBlockHeightScores{} }
-func (m *TopicIdBlockHeightScores) String() string { return proto.CompactTextString(m) }
-func (*TopicIdBlockHeightScores) ProtoMessage()    {}
-func (*TopicIdBlockHeightScores) Descriptor() ([]byte, []int) {
+func (m *TopicIdDelegatorReputer) Reset()         { *m = TopicIdDelegatorReputer{} }
+func (m *TopicIdDelegatorReputer) String() string { return proto.CompactTextString(m) }
+func (*TopicIdDelegatorReputer) ProtoMessage()    {}
+func (*TopicIdDelegatorReputer) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3a31f894a338870b, []int{5}
 }
-func (m *TopicIdBlockHeightScores) XXX_Unmarshal(b []byte) error {
+func (m *TopicIdDelegatorReputer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdBlockHeightScores) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicIdDelegatorReputer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdBlockHeightScores.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicIdDelegatorReputer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1077,57 +1283,57 @@ func (m *TopicIdBlockHeightScores) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *TopicIdBlockHeightScores) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdBlockHeightScores.Merge(m, src)
+func (m *TopicIdDelegatorReputer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdDelegatorReputer.Merge(m, src)
 }
-func (m *TopicIdBlockHeightScores) XXX_Size() int {
+func (m *TopicIdDelegatorReputer) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdBlockHeightScores) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdBlockHeightScores.DiscardUnknown(m)
+func (m *TopicIdDelegatorReputer) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdDelegatorReputer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdBlockHeightScores proto.InternalMessageInfo
+var xxx_messageInfo_TopicIdDelegatorReputer proto.InternalMessageInfo
 
-func (m *TopicIdBlockHeightScores) GetTopicId() uint64 {
+func (m *TopicIdDelegatorReputer) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdBlockHeightScores) GetBlockHeight() int64 {
+func (m *TopicIdDelegatorReputer) GetDelegator() string {
 	if m != nil {
-		return m.BlockHeight
+		return m.Delegator
 	}
-	return 0
+	return ""
 }
 
-func (m *TopicIdBlockHeightScores) GetScores() *Scores {
+func (m *TopicIdDelegatorReputer) GetReputer() string {
 	if m != nil {
-		return m.Scores
+		return m.Reputer
 	}
-	return nil
+	return ""
 }
 
-type TopicIdActorIdScore struct {
-	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	Score   *Score `protobuf:"bytes,3,opt,name=score,proto3" json:"score,omitempty"`
+type TopicActorOperator struct {
+	TopicId  uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Actor    string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
 }
 
-func (m *TopicIdActorIdScore) Reset()         { *m = TopicIdActorIdScore{} }
-func (m *TopicIdActorIdScore) String() string { return proto.CompactTextString(m) }
-func (*TopicIdActorIdScore) ProtoMessage()    {}
-func (*TopicIdActorIdScore) Descriptor() ([]byte, []int) {
+func (m *TopicActorOperator) Reset()         { *m = TopicActorOperator{} }
+func (m *TopicActorOperator) String() string { return proto.CompactTextString(m) }
+func (*TopicActorOperator) ProtoMessage()    {}
+func (*TopicActorOperator) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3a31f894a338870b, []int{6}
 }
-func (m *TopicIdActorIdScore) XXX_Unmarshal(b []byte) error {
+func (m *TopicActorOperator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicIdActorIdScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicActorOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicIdActorIdScore.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicActorOperator.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1137,42 +1343,266 @@ func (m *TopicIdActorIdScore) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *TopicIdActorIdScore) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicIdActorIdScore.Merge(m, src)
+func (m *TopicActorOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicActorOperator.Merge(m, src)
 }
-func (m *TopicIdActorIdScore) XXX_Size() int {
+func (m *TopicActorOperator) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicIdActorIdScore) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicIdActorIdScore.DiscardUnknown(m)
+func (m *TopicActorOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicActorOperator.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicIdActorIdScore proto.InternalMessageInfo
+var xxx_messageInfo_TopicActorOperator proto.InternalMessageInfo
 
-func (m *TopicIdActorIdScore) GetTopicId() uint64 {
+func (m *TopicActorOperator) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *TopicIdActorIdScore) GetActorId() string {
+func (m *TopicActorOperator) GetActor() string {
 	if m != nil {
-		return m.ActorId
+		return m.Actor
 	}
 	return ""
 }
 
-func (m *TopicIdActorIdScore) GetScore() *Score {
+func (m *TopicActorOperator) GetOperator() string {
 	if m != nil {
-		return m.Score
+		return m.Operator
 	}
-	return nil
+	return ""
 }
 
-type TopicIdActorIdUint64 struct {
-	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+type TopicIdAndBlockHeight struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *TopicIdAndBlockHeight) Reset()         { *m = TopicIdAndBlockHeight{} }
+func (m *TopicIdAndBlockHeight) String() string { return proto.CompactTextString(m) }
+func (*TopicIdAndBlockHeight) ProtoMessage()    {}
+func (*TopicIdAndBlockHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a31f894a338870b, []int{7}
+}
+func (m *TopicIdAndBlockHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdAndBlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdAndBlockHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdAndBlockHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdAndBlockHeight.Merge(m, src)
+}
+func (m *TopicIdAndBlockHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdAndBlockHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdAndBlockHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdAndBlockHeight proto.InternalMessageInfo
+
+func (m *TopicIdAndBlockHeight) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdAndBlockHeight) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+type BlockHeightAndTopicIds struct {
+	BlockHeight int64    `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TopicIds    []uint64 `protobuf:"varint,2,rep,packed,name=topic_ids,json=topicIds,proto3" json:"topic_ids,omitempty"`
+}
+
+func (m *BlockHeightAndTopicIds) Reset()         { *m = BlockHeightAndTopicIds{} }
+func (m *BlockHeightAndTopicIds) String() string { return proto.CompactTextString(m) }
+func (*BlockHeightAndTopicIds) ProtoMessage()    {}
+func (*BlockHeightAndTopicIds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a31f894a338870b, []int{8}
+}
+func (m *BlockHeightAndTopicIds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockHeightAndTopicIds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockHeightAndTopicIds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockHeightAndTopicIds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeightAndTopicIds.Merge(m, src)
+}
+func (m *BlockHeightAndTopicIds) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockHeightAndTopicIds) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeightAndTopicIds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockHeightAndTopicIds proto.InternalMessageInfo
+
+func (m *BlockHeightAndTopicIds) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *BlockHeightAndTopicIds) GetTopicIds() []uint64 {
+	if m != nil {
+		return m.TopicIds
+	}
+	return nil
+}
+
+type TopicIdBlockHeightScores struct {
+	TopicId     uint64  `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64   `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Scores      *Scores `protobuf:"bytes,3,opt,name=scores,proto3" json:"scores,omitempty"`
+}
+
+func (m *TopicIdBlockHeightScores) Reset()         { *m = TopicIdBlockHeightScores{} }
+func (m *TopicIdBlockHeightScores) String() string { return proto.CompactTextString(m) }
+func (*TopicIdBlockHeightScores) ProtoMessage()    {}
+func (*TopicIdBlockHeightScores) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a31f894a338870b, []int{9}
+}
+func (m *TopicIdBlockHeightScores) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdBlockHeightScores) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdBlockHeightScores.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdBlockHeightScores) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdBlockHeightScores.Merge(m, src)
+}
+func (m *TopicIdBlockHeightScores) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdBlockHeightScores) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdBlockHeightScores.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdBlockHeightScores proto.InternalMessageInfo
+
+func (m *TopicIdBlockHeightScores) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdBlockHeightScores) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TopicIdBlockHeightScores) GetScores() *Scores {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+type TopicIdActorIdScore struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Score   *Score `protobuf:"bytes,3,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (m *TopicIdActorIdScore) Reset()         { *m = TopicIdActorIdScore{} }
+func (m *TopicIdActorIdScore) String() string { return proto.CompactTextString(m) }
+func (*TopicIdActorIdScore) ProtoMessage()    {}
+func (*TopicIdActorIdScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a31f894a338870b, []int{10}
+}
+func (m *TopicIdActorIdScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdActorIdScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdActorIdScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdActorIdScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdActorIdScore.Merge(m, src)
+}
+func (m *TopicIdActorIdScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdActorIdScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdActorIdScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdActorIdScore proto.InternalMessageInfo
+
+func (m *TopicIdActorIdScore) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdActorIdScore) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *TopicIdActorIdScore) GetScore() *Score {
+	if m != nil {
+		return m.Score
+	}
+	return nil
+}
+
+type TopicIdActorIdUint64 struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
 	Uint64  uint64 `protobuf:"varint,3,opt,name=uint64,proto3" json:"uint64,omitempty"`
 }
 
@@ -1180,7 +1610,7 @@ func (m *TopicIdActorIdUint64) Reset()         { *m = TopicIdActorIdUint64{} }
 func (m *TopicIdActorIdUint64) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdUint64) ProtoMessage()    {}
 func (*TopicIdActorIdUint64) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{7}
+	return fileDescriptor_3a31f894a338870b, []int{11}
 }
 func (m *TopicIdActorIdUint64) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1240,7 +1670,7 @@ func (m *TopicIdActorIdListeningCoefficient) Reset()         { *m = TopicIdActor
 func (m *TopicIdActorIdListeningCoefficient) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdListeningCoefficient) ProtoMessage()    {}
 func (*TopicIdActorIdListeningCoefficient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{8}
+	return fileDescriptor_3a31f894a338870b, []int{12}
 }
 func (m *TopicIdActorIdListeningCoefficient) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1300,7 +1730,7 @@ func (m *TopicIdActorIdDec) Reset()         { *m = TopicIdActorIdDec{} }
 func (m *TopicIdActorIdDec) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdDec) ProtoMessage()    {}
 func (*TopicIdActorIdDec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{9}
+	return fileDescriptor_3a31f894a338870b, []int{13}
 }
 func (m *TopicIdActorIdDec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1352,7 +1782,7 @@ func (m *TopicIdAndInt) Reset()         { *m = TopicIdAndInt{} }
 func (m *TopicIdAndInt) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndInt) ProtoMessage()    {}
 func (*TopicIdAndInt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{10}
+	return fileDescriptor_3a31f894a338870b, []int{14}
 }
 func (m *TopicIdAndInt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1398,7 +1828,7 @@ func (m *TopicIdActorIdInt) Reset()         { *m = TopicIdActorIdInt{} }
 func (m *TopicIdActorIdInt) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdInt) ProtoMessage()    {}
 func (*TopicIdActorIdInt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{11}
+	return fileDescriptor_3a31f894a338870b, []int{15}
 }
 func (m *TopicIdActorIdInt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1452,7 +1882,7 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Reset()         { *m = TopicIdDel
 func (m *TopicIdDelegatorReputerDelegatorInfo) String() string { return proto.CompactTextString(m) }
 func (*TopicIdDelegatorReputerDelegatorInfo) ProtoMessage()    {}
 func (*TopicIdDelegatorReputerDelegatorInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{12}
+	return fileDescriptor_3a31f894a338870b, []int{16}
 }
 func (m *TopicIdDelegatorReputerDelegatorInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1524,7 +1954,7 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) String() string {
 }
 func (*BlockHeightTopicIdReputerStakeRemovalInfo) ProtoMessage() {}
 func (*BlockHeightTopicIdReputerStakeRemovalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{13}
+	return fileDescriptor_3a31f894a338870b, []int{17}
 }
 func (m *BlockHeightTopicIdReputerStakeRemovalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1591,7 +2021,7 @@ func (m *ActorIdTopicIdBlockHeight) Reset()         { *m = ActorIdTopicIdBlockHe
 func (m *ActorIdTopicIdBlockHeight) String() string { return proto.CompactTextString(m) }
 func (*ActorIdTopicIdBlockHeight) ProtoMessage()    {}
 func (*ActorIdTopicIdBlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{14}
+	return fileDescriptor_3a31f894a338870b, []int{18}
 }
 func (m *ActorIdTopicIdBlockHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1657,7 +2087,7 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) String() st
 }
 func (*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) ProtoMessage() {}
 func (*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{15}
+	return fileDescriptor_3a31f894a338870b, []int{19}
 }
 func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1732,7 +2162,7 @@ func (m *DelegatorReputerTopicIdBlockHeight) Reset()         { *m = DelegatorRep
 func (m *DelegatorReputerTopicIdBlockHeight) String() string { return proto.CompactTextString(m) }
 func (*DelegatorReputerTopicIdBlockHeight) ProtoMessage()    {}
 func (*DelegatorReputerTopicIdBlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{16}
+	return fileDescriptor_3a31f894a338870b, []int{20}
 }
 func (m *DelegatorReputerTopicIdBlockHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1799,7 +2229,7 @@ func (m *TopicIdActorIdInference) Reset()         { *m = TopicIdActorIdInference
 func (m *TopicIdActorIdInference) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdInference) ProtoMessage()    {}
 func (*TopicIdActorIdInference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{17}
+	return fileDescriptor_3a31f894a338870b, []int{21}
 }
 func (m *TopicIdActorIdInference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +2289,7 @@ func (m *TopicIdActorIdForecast) Reset()         { *m = TopicIdActorIdForecast{}
 func (m *TopicIdActorIdForecast) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdForecast) ProtoMessage()    {}
 func (*TopicIdActorIdForecast) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{18}
+	return fileDescriptor_3a31f894a338870b, []int{22}
 }
 func (m *TopicIdActorIdForecast) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1918,7 +2348,7 @@ func (m *LibP2PKeyAndOffchainNode) Reset()         { *m = LibP2PKeyAndOffchainNo
 func (m *LibP2PKeyAndOffchainNode) String() string { return proto.CompactTextString(m) }
 func (*LibP2PKeyAndOffchainNode) ProtoMessage()    {}
 func (*LibP2PKeyAndOffchainNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{19}
+	return fileDescriptor_3a31f894a338870b, []int{23}
 }
 func (m *LibP2PKeyAndOffchainNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1970,7 +2400,7 @@ func (m *TopicIdAndDec) Reset()         { *m = TopicIdAndDec{} }
 func (m *TopicIdAndDec) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndDec) ProtoMessage()    {}
 func (*TopicIdAndDec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{20}
+	return fileDescriptor_3a31f894a338870b, []int{24}
 }
 func (m *TopicIdAndDec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2016,7 +2446,7 @@ func (m *TopicIdBlockHeightInferences) Reset()         { *m = TopicIdBlockHeight
 func (m *TopicIdBlockHeightInferences) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightInferences) ProtoMessage()    {}
 func (*TopicIdBlockHeightInferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{21}
+	return fileDescriptor_3a31f894a338870b, []int{25}
 }
 func (m *TopicIdBlockHeightInferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2076,7 +2506,7 @@ func (m *TopicIdBlockHeightForecasts) Reset()         { *m = TopicIdBlockHeightF
 func (m *TopicIdBlockHeightForecasts) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightForecasts) ProtoMessage()    {}
 func (*TopicIdBlockHeightForecasts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{22}
+	return fileDescriptor_3a31f894a338870b, []int{26}
 }
 func (m *TopicIdBlockHeightForecasts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2136,7 +2566,7 @@ func (m *TopicIdBlockHeightReputerValueBundles) Reset()         { *m = TopicIdBl
 func (m *TopicIdBlockHeightReputerValueBundles) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightReputerValueBundles) ProtoMessage()    {}
 func (*TopicIdBlockHeightReputerValueBundles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{23}
+	return fileDescriptor_3a31f894a338870b, []int{27}
 }
 func (m *TopicIdBlockHeightReputerValueBundles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2196,7 +2626,7 @@ func (m *TopicIdBlockHeightValueBundles) Reset()         { *m = TopicIdBlockHeig
 func (m *TopicIdBlockHeightValueBundles) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightValueBundles) ProtoMessage()    {}
 func (*TopicIdBlockHeightValueBundles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{24}
+	return fileDescriptor_3a31f894a338870b, []int{28}
 }
 func (m *TopicIdBlockHeightValueBundles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2255,7 +2685,7 @@ func (m *TopicIdAndNonces) Reset()         { *m = TopicIdAndNonces{} }
 func (m *TopicIdAndNonces) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndNonces) ProtoMessage()    {}
 func (*TopicIdAndNonces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{25}
+	return fileDescriptor_3a31f894a338870b, []int{29}
 }
 func (m *TopicIdAndNonces) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +2737,7 @@ func (m *TopicIdAndReputerRequestNonces) Reset()         { *m = TopicIdAndRepute
 func (m *TopicIdAndReputerRequestNonces) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndReputerRequestNonces) ProtoMessage()    {}
 func (*TopicIdAndReputerRequestNonces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{26}
+	return fileDescriptor_3a31f894a338870b, []int{30}
 }
 func (m *TopicIdAndReputerRequestNonces) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2360,7 +2790,7 @@ func (m *TopicIdActorIdTimeStampedValue) Reset()         { *m = TopicIdActorIdTi
 func (m *TopicIdActorIdTimeStampedValue) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdTimeStampedValue) ProtoMessage()    {}
 func (*TopicIdActorIdTimeStampedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{27}
+	return fileDescriptor_3a31f894a338870b, []int{31}
 }
 func (m *TopicIdActorIdTimeStampedValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2421,7 +2851,7 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Reset()         { *m = TopicIdAc
 func (m *TopicIdActorIdActorIdTimeStampedValue) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdActorIdTimeStampedValue) ProtoMessage()    {}
 func (*TopicIdActorIdActorIdTimeStampedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{28}
+	return fileDescriptor_3a31f894a338870b, []int{32}
 }
 func (m *TopicIdActorIdActorIdTimeStampedValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2487,7 +2917,7 @@ func (m *TopicIdTimestampedActorNonce) Reset()         { *m = TopicIdTimestamped
 func (m *TopicIdTimestampedActorNonce) String() string { return proto.CompactTextString(m) }
 func (*TopicIdTimestampedActorNonce) ProtoMessage()    {}
 func (*TopicIdTimestampedActorNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{29}
+	return fileDescriptor_3a31f894a338870b, []int{33}
 }
 func (m *TopicIdTimestampedActorNonce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2539,7 +2969,7 @@ func (m *BlockHeightTopicIds) Reset()         { *m = BlockHeightTopicIds{} }
 func (m *BlockHeightTopicIds) String() string { return proto.CompactTextString(m) }
 func (*BlockHeightTopicIds) ProtoMessage()    {}
 func (*BlockHeightTopicIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{30}
+	return fileDescriptor_3a31f894a338870b, []int{34}
 }
 func (m *BlockHeightTopicIds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2591,7 +3021,7 @@ func (m *BlockHeightTopicIdWeightPair) Reset()         { *m = BlockHeightTopicId
 func (m *BlockHeightTopicIdWeightPair) String() string { return proto.CompactTextString(m) }
 func (*BlockHeightTopicIdWeightPair) ProtoMessage()    {}
 func (*BlockHeightTopicIdWeightPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{31}
+	return fileDescriptor_3a31f894a338870b, []int{35}
 }
 func (m *BlockHeightTopicIdWeightPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2644,7 +3074,7 @@ func (m *TopicIdReputerReputerValueBundle) Reset()         { *m = TopicIdReputer
 func (m *TopicIdReputerReputerValueBundle) String() string { return proto.CompactTextString(m) }
 func (*TopicIdReputerReputerValueBundle) ProtoMessage()    {}
 func (*TopicIdReputerReputerValueBundle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a31f894a338870b, []int{32}
+	return fileDescriptor_3a31f894a338870b, []int{36}
 }
 func (m *TopicIdReputerReputerValueBundle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2697,7 +3127,11 @@ func (m *TopicIdReputerReputerValueBundle) GetReputerValueBundle() *ReputerValue
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "emissions.v7.GenesisState")
 	proto.RegisterType((*TopicIdAndTopic)(nil), "emissions.v7.TopicIdAndTopic")
+	proto.RegisterType((*TopicIdAndOptionalTopicParams)(nil), "emissions.v7.TopicIdAndOptionalTopicParams")
+	proto.RegisterType((*TopicIdAndTag)(nil), "emissions.v7.TopicIdAndTag")
 	proto.RegisterType((*TopicAndActorId)(nil), "emissions.v7.TopicAndActorId")
+	proto.RegisterType((*TopicIdDelegatorReputer)(nil), "emissions.v7.TopicIdDelegatorReputer")
+	proto.RegisterType((*TopicActorOperator)(nil), "emissions.v7.TopicActorOperator")
 	proto.RegisterType((*TopicIdAndBlockHeight)(nil), "emissions.v7.TopicIdAndBlockHeight")
 	proto.RegisterType((*BlockHeightAndTopicIds)(nil), "emissions.v7.BlockHeightAndTopicIds")
 	proto.RegisterType((*TopicIdBlockHeightScores)(nil), "emissions.v7.TopicIdBlockHeightScores")
@@ -2733,218 +3167,260 @@ func init() {
 func init() { proto.RegisterFile("emissions/v7/genesis.proto", fileDescriptor_3a31f894a338870b) }
 
 var fileDescriptor_3a31f894a338870b = []byte{
-	// 3375 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5b, 0x4b, 0x6f, 0xdc, 0xd6,
-	0x15, 0x36, 0x2d, 0xc5, 0x96, 0x8e, 0x24, 0x4b, 0xba, 0x7a, 0x51, 0x0f, 0x8f, 0xc6, 0xf4, 0x23,
-	0x52, 0x62, 0x4b, 0xb1, 0xe4, 0x57, 0xd3, 0xb4, 0x89, 0x24, 0x4b, 0xce, 0xd8, 0x8a, 0x1e, 0x94,
-	0x64, 0xb9, 0x4e, 0x13, 0x96, 0x1a, 0x5e, 0x49, 0x8c, 0xf9, 0x98, 0xf0, 0x72, 0x24, 0xbb, 0x2d,
-	0xd0, 0xa2, 0x40, 0xb3, 0x28, 0x50, 0x20, 0x28, 0x9a, 0xa2, 0xaf, 0x4d, 0xd1, 0x4d, 0x81, 0x6e,
-	0x0a, 0xb4, 0xcb, 0x76, 0x9f, 0xec, 0xd2, 0xae, 0x8a, 0x2e, 0x82, 0x22, 0x59, 0xf4, 0x6f, 0x14,
-	0xbc, 0x0f, 0x3e, 0x66, 0x48, 0xce, 0x68, 0x94, 0x8d, 0x20, 0xf2, 0xde, 0xf3, 0x9d, 0xef, 0x9e,
-	0x73, 0x78, 0xee, 0xe3, 0xdc, 0x81, 0x31, 0x6c, 0x9b, 0x84, 0x98, 0xae, 0x43, 0x66, 0x8f, 0xee,
-	0xce, 0x1e, 0x60, 0x07, 0x13, 0x93, 0xcc, 0x54, 0x3c, 0xd7, 0x77, 0x51, 0x77, 0xd8, 0x36, 0x73,
-	0x74, 0x77, 0xac, 0x5f, 0xb7, 0x4d, 0xc7, 0x9d, 0xa5, 0x7f, 0x59, 0x87, 0xb1, 0xd1, 0xb2, 0x4b,
-	0x6c, 0x97, 0x68, 0xf4, 0x69, 0x96, 0x3d, 0xf0, 0xa6, 0x91, 0x18, 0xee, 0xfc, 0xac, 0xe3, 0x1a,
-	0x98, 0x37, 0xc8, 0x35, 0x0d, 0x4e, 0x59, 0xb4, 0x8c, 0x25, 0x5a, 0x3c, 0x5c, 0xa9, 0xfa, 0xd8,
-	0x4b, 0x95, 0x22, 0x65, 0xd7, 0x4b, 0xc7, 0x23, 0xbe, 0xfe, 0x2c, 0xbd, 0xc5, 0x77, 0x2b, 0x66,
-	0x39, 0xbd, 0xe5, 0x45, 0x05, 0x0b, 0xda, 0xa3, 0x89, 0x96, 0x63, 0xd7, 0x7b, 0x16, 0x52, 0x18,
-	0x4d, 0x58, 0xaa, 0xa2, 0x7b, 0xba, 0x2d, 0xa4, 0x06, 0x0f, 0xdc, 0x03, 0x97, 0x19, 0x21, 0xf8,
-	0x8f, 0xbd, 0x55, 0x3e, 0x7b, 0x0b, 0xba, 0x1f, 0x30, 0x83, 0x6e, 0xf9, 0xba, 0x8f, 0xd1, 0x1c,
-	0x9c, 0x63, 0x62, 0xb2, 0x54, 0x94, 0xa6, 0xba, 0xe6, 0x06, 0x67, 0xe2, 0x06, 0x9e, 0xd9, 0xa0,
-	0x6d, 0x8b, 0xed, 0x9f, 0x7e, 0x31, 0x79, 0x46, 0xe5, 0x3d, 0xd1, 0x0c, 0x0c, 0x04, 0x83, 0xd5,
-	0x7c, 0xac, 0xdb, 0x9a, 0x6e, 0x18, 0x1e, 0x26, 0x04, 0x13, 0xf9, 0x6c, 0xb1, 0x6d, 0xaa, 0x53,
-	0xed, 0x0f, 0x9a, 0xb6, 0xb1, 0x6e, 0x2f, 0x88, 0x06, 0xa4, 0x40, 0x8f, 0x83, 0x9f, 0xfb, 0x1a,
-	0x1d, 0xae, 0x66, 0x1a, 0x72, 0x5b, 0x51, 0x9a, 0x6a, 0x57, 0xbb, 0x82, 0x97, 0xdb, 0xc1, 0xbb,
-	0x92, 0x81, 0x6e, 0xc3, 0x39, 0xda, 0x4c, 0xe4, 0xf6, 0x62, 0xdb, 0x54, 0xd7, 0xdc, 0xc5, 0x24,
-	0x0f, 0xde, 0x6d, 0xc1, 0x31, 0xe8, 0x7f, 0x2a, 0xef, 0x8c, 0x2e, 0x43, 0x8f, 0x5e, 0xf6, 0xcd,
-	0x23, 0xac, 0x71, 0xe9, 0x97, 0x8a, 0x6d, 0x53, 0xed, 0x6a, 0x37, 0x7b, 0xb9, 0xcd, 0x3a, 0xbd,
-	0x0a, 0xfd, 0x1e, 0x3e, 0xd6, 0x3d, 0x43, 0xdf, 0xb3, 0xc2, 0x8e, 0xe7, 0x68, 0xc7, 0xbe, 0xa8,
-	0x81, 0x77, 0x5e, 0x84, 0x1e, 0xc6, 0x93, 0x19, 0x9a, 0xc8, 0xe7, 0x33, 0xf9, 0x2c, 0x38, 0xc6,
-	0x42, 0xd9, 0x77, 0xbd, 0x92, 0xa1, 0x76, 0x53, 0x99, 0x5d, 0x26, 0x82, 0xee, 0xc3, 0x05, 0x86,
-	0xc1, 0x03, 0x86, 0xc8, 0x1d, 0xcd, 0x80, 0x30, 0xc5, 0x2a, 0x97, 0x41, 0x9b, 0x80, 0x04, 0x4a,
-	0xc0, 0x51, 0xa3, 0x71, 0x29, 0x77, 0x52, 0xa4, 0xcb, 0x59, 0xe6, 0x59, 0xb4, 0xdc, 0xf2, 0xb3,
-	0xb7, 0xb1, 0x79, 0x70, 0xe8, 0xab, 0x7d, 0x1c, 0x2f, 0x90, 0x5e, 0x0b, 0x84, 0xd1, 0x7b, 0x30,
-	0x62, 0x3a, 0xfb, 0xd8, 0xc3, 0x9e, 0x46, 0xe3, 0x95, 0x68, 0x7b, 0x2f, 0xb4, 0xbd, 0x40, 0x44,
-	0x06, 0x8a, 0x7b, 0x2d, 0x15, 0x37, 0x06, 0xba, 0x45, 0xc5, 0xd4, 0x41, 0x0e, 0xc3, 0x1e, 0x17,
-	0x5f, 0xd0, 0x1e, 0xa8, 0x0c, 0x63, 0xfb, 0xae, 0x87, 0xcb, 0x3a, 0xf1, 0x53, 0x34, 0x74, 0x9d,
-	0x48, 0xc3, 0x48, 0x84, 0x94, 0x54, 0xf2, 0x1e, 0x8c, 0x70, 0xb3, 0xd6, 0x69, 0xe8, 0x3e, 0xd9,
-	0x18, 0x38, 0x4c, 0x12, 0xde, 0x87, 0x8b, 0x02, 0xde, 0x32, 0x89, 0x8f, 0x1d, 0xd3, 0x39, 0xd0,
-	0xca, 0x2e, 0xde, 0xdf, 0x37, 0xcb, 0x26, 0x76, 0x7c, 0xb9, 0x8f, 0x2a, 0x79, 0x2d, 0xdd, 0x01,
-	0xcc, 0x93, 0xab, 0x42, 0x70, 0x29, 0x92, 0x53, 0xc7, 0x39, 0x6c, 0x5a, 0x23, 0x3a, 0x84, 0x62,
-	0xc5, 0xc3, 0x47, 0xa6, 0x5b, 0x25, 0x22, 0x68, 0x84, 0xdb, 0xf7, 0xbd, 0x20, 0x98, 0x5d, 0x47,
-	0xee, 0xa7, 0x8a, 0x27, 0xf3, 0x14, 0xdf, 0xc7, 0x65, 0xf5, 0xa2, 0x00, 0xe2, 0x81, 0xc4, 0xfc,
-	0xbf, 0xc2, 0x51, 0x90, 0x05, 0x4a, 0xa8, 0x89, 0x39, 0xd1, 0x29, 0xe3, 0x3a, 0x5d, 0xa8, 0x39,
-	0x5d, 0x93, 0x02, 0xaa, 0x24, 0x90, 0x6a, 0xb4, 0x7d, 0x00, 0x97, 0x42, 0x6d, 0xc2, 0xa1, 0x75,
-	0xca, 0x06, 0x9a, 0x53, 0x56, 0x10, 0x48, 0x2b, 0x1c, 0xa8, 0x46, 0xd7, 0x1e, 0x4c, 0xd6, 0xe9,
-	0x12, 0x41, 0xa2, 0x79, 0xba, 0x6f, 0xba, 0xf2, 0x20, 0xd5, 0x34, 0x9e, 0xf5, 0xf1, 0x04, 0x5a,
-	0x26, 0x6a, 0xb5, 0xf0, 0x00, 0x51, 0x03, 0x00, 0xb4, 0x09, 0x5d, 0xbe, 0xeb, 0xeb, 0x96, 0x46,
-	0x93, 0xba, 0x3c, 0x54, 0x94, 0xa6, 0x3a, 0x17, 0x5f, 0x0b, 0xb2, 0xe3, 0x7f, 0xbe, 0x98, 0x1c,
-	0x62, 0xb3, 0x0d, 0x31, 0x9e, 0xcd, 0x98, 0xee, 0xac, 0xad, 0xfb, 0x87, 0x33, 0x25, 0xc7, 0xff,
-	0xd7, 0xdf, 0x6e, 0x00, 0x9f, 0x86, 0x4a, 0x8e, 0xff, 0xa7, 0xff, 0xfd, 0xe5, 0x15, 0x49, 0x05,
-	0x0a, 0xb2, 0x15, 0x60, 0xa0, 0x37, 0x02, 0xc8, 0xe0, 0x33, 0x67, 0x90, 0xc3, 0xf9, 0x14, 0x4b,
-	0x8e, 0x1f, 0x48, 0x57, 0xcc, 0x32, 0x93, 0xde, 0x85, 0x11, 0x2a, 0x17, 0x46, 0x8d, 0x5e, 0xf5,
-	0x0f, 0x5d, 0xcf, 0xf4, 0x5f, 0xc8, 0x23, 0x8d, 0xcd, 0x1a, 0xa0, 0x0d, 0x51, 0x79, 0x1e, 0x2c,
-	0x0b, 0x42, 0x1a, 0x3d, 0x01, 0x99, 0x01, 0x93, 0xaa, 0xad, 0xed, 0x7b, 0xae, 0xad, 0x19, 0xd8,
-	0xc2, 0x07, 0xba, 0xef, 0x7a, 0xb2, 0x7c, 0x12, 0xe4, 0xad, 0xaa, 0xbd, 0xe2, 0xb9, 0xf6, 0x7d,
-	0x21, 0x8d, 0xde, 0x83, 0x3e, 0x0e, 0x85, 0x0d, 0x36, 0x68, 0x22, 0x8f, 0x52, 0xc4, 0xb9, 0x54,
-	0xc4, 0x50, 0x92, 0x73, 0x0c, 0x9f, 0x4b, 0xce, 0xbe, 0xab, 0xf6, 0x86, 0x58, 0xd4, 0x20, 0x04,
-	0x3d, 0x03, 0x85, 0x11, 0x4f, 0x92, 0x26, 0x5a, 0xb5, 0xe2, 0x3a, 0xc2, 0x4c, 0xf2, 0x58, 0x73,
-	0x43, 0x28, 0x50, 0xa8, 0x04, 0x7f, 0xb2, 0x53, 0x71, 0x1d, 0x4e, 0x05, 0x3d, 0x85, 0x51, 0xa1,
-	0x5f, 0x84, 0x75, 0x25, 0x88, 0xb9, 0x43, 0xdd, 0xc3, 0xf2, 0x78, 0x73, 0x71, 0x3d, 0x2c, 0x10,
-	0x58, 0x3c, 0x6f, 0x60, 0x6f, 0x2b, 0x10, 0x47, 0x4e, 0xe4, 0x5a, 0xdb, 0x3d, 0xd2, 0xad, 0x58,
-	0xa2, 0x9b, 0xa0, 0xc8, 0x77, 0x93, 0xc8, 0xb1, 0x0c, 0xc7, 0x95, 0x70, 0x96, 0x5b, 0xcc, 0xc1,
-	0x14, 0x84, 0xda, 0x6c, 0x90, 0xc4, 0xde, 0x84, 0x99, 0xef, 0xfd, 0x34, 0x7d, 0x7a, 0xc0, 0x53,
-	0xbe, 0x48, 0xf5, 0xbd, 0x9c, 0xd4, 0xc7, 0x87, 0x50, 0x9f, 0x5f, 0xeb, 0xf0, 0x69, 0x4f, 0xf4,
-	0xb1, 0x04, 0xc5, 0xd0, 0x58, 0x59, 0x23, 0x2b, 0x50, 0x4d, 0x6f, 0x37, 0x1a, 0x59, 0x46, 0x4c,
-	0xe0, 0xba, 0xa1, 0x4e, 0x18, 0x29, 0x2d, 0xe1, 0x90, 0x9f, 0xe7, 0x32, 0x62, 0x63, 0x9f, 0x4c,
-	0xcb, 0xf7, 0xb5, 0xfa, 0x53, 0x8c, 0x90, 0xa5, 0x99, 0x19, 0x63, 0x19, 0x20, 0xcc, 0xbe, 0x44,
-	0x2e, 0x52, 0x1d, 0x57, 0xf3, 0xa3, 0x51, 0x64, 0xd8, 0x98, 0x20, 0x5a, 0x84, 0x4e, 0x91, 0xea,
-	0x88, 0x7c, 0x89, 0xa2, 0x5c, 0xc9, 0x43, 0x09, 0x53, 0x67, 0x24, 0x86, 0xde, 0x82, 0xf3, 0x62,
-	0xad, 0xa3, 0xa4, 0x4d, 0xa0, 0xab, 0xe6, 0xde, 0xc6, 0x5c, 0xe5, 0x11, 0x7e, 0xb1, 0xe0, 0x18,
-	0xeb, 0xfb, 0xfb, 0xe5, 0x43, 0xdd, 0x74, 0xd6, 0x5c, 0x03, 0xab, 0x42, 0x0c, 0x2d, 0x42, 0x47,
-	0xb8, 0xd2, 0xb9, 0x7c, 0x22, 0x88, 0x50, 0x0e, 0x3d, 0x80, 0x7e, 0x96, 0x06, 0xf7, 0x71, 0xe0,
-	0x85, 0x23, 0xec, 0x54, 0xb1, 0x7c, 0xa5, 0x71, 0x32, 0xec, 0xa5, 0x52, 0x2b, 0x18, 0xab, 0x4c,
-	0x06, 0xad, 0xc3, 0x50, 0x38, 0x0d, 0xf0, 0x95, 0x1c, 0x75, 0x88, 0x7c, 0xb5, 0x71, 0xf2, 0x1f,
-	0x10, 0x92, 0xf4, 0xf5, 0x2e, 0x95, 0x43, 0x9b, 0x70, 0x41, 0xb7, 0x2c, 0x2d, 0xe6, 0xae, 0x6b,
-	0x14, 0xe9, 0x95, 0x46, 0xeb, 0x8c, 0xd0, 0x65, 0x44, 0xed, 0xd1, 0x2d, 0x2b, 0x7a, 0x44, 0x6b,
-	0x10, 0xbc, 0xd0, 0x22, 0xd7, 0xbd, 0x4c, 0x11, 0xa7, 0x1b, 0x21, 0x0a, 0xf7, 0x11, 0xb5, 0x5b,
-	0xb7, 0xac, 0xf0, 0x29, 0x48, 0xa9, 0x01, 0x9e, 0xe5, 0x12, 0xa2, 0xed, 0x55, 0x1d, 0xc3, 0xc2,
-	0x44, 0x9e, 0xa2, 0x90, 0xf3, 0x8d, 0x20, 0x79, 0x00, 0x3f, 0xd6, 0xad, 0x2a, 0x5e, 0x64, 0xa2,
-	0x6a, 0x30, 0xde, 0x55, 0x97, 0x10, 0xfe, 0x8c, 0xde, 0x87, 0x41, 0x07, 0xfb, 0x81, 0xb7, 0x93,
-	0x2a, 0xa6, 0xa9, 0x8a, 0xeb, 0x8d, 0x54, 0x24, 0xb0, 0x11, 0x47, 0x8a, 0xe3, 0xff, 0x5a, 0x82,
-	0x1b, 0xa1, 0xcf, 0x2a, 0xd8, 0x2b, 0x63, 0xc7, 0xd7, 0x0f, 0xc2, 0x8c, 0xea, 0xbb, 0xec, 0xe3,
-	0x34, 0xa2, 0xf5, 0xf4, 0x2b, 0x74, 0xe2, 0xbd, 0xcb, 0x27, 0xde, 0xd9, 0x03, 0xd3, 0x3f, 0xac,
-	0xee, 0xcd, 0x94, 0x5d, 0x7b, 0x56, 0xb7, 0x2c, 0xd7, 0xd3, 0x6f, 0x70, 0x2d, 0xe2, 0x91, 0x46,
-	0x1c, 0x9b, 0x92, 0x03, 0x3f, 0x4f, 0x09, 0x6d, 0x1b, 0xa1, 0x32, 0x96, 0x7c, 0xb7, 0x5d, 0xfa,
-	0xb5, 0x1a, 0xe1, 0x22, 0xfc, 0x29, 0x8c, 0x56, 0x9d, 0xfd, 0xaa, 0xb5, 0x6f, 0x5a, 0x16, 0x36,
-	0xf8, 0xa6, 0x80, 0x2d, 0xc5, 0x89, 0xfc, 0x2a, 0x1d, 0x7f, 0x21, 0x2b, 0xa2, 0xe8, 0x9a, 0x9b,
-	0xa8, 0x23, 0x31, 0x00, 0xb6, 0x43, 0x60, 0x0d, 0xe8, 0x03, 0x18, 0x8b, 0x63, 0x8b, 0x19, 0x9c,
-	0x83, 0x5f, 0xcf, 0x31, 0xee, 0x82, 0x63, 0x84, 0xab, 0xbb, 0x0f, 0xab, 0x98, 0xf8, 0x5c, 0x95,
-	0x1c, 0xc3, 0xe3, 0x1d, 0xb8, 0xae, 0x0f, 0xa1, 0x60, 0xe9, 0x3e, 0x26, 0xbe, 0x26, 0x36, 0x00,
-	0xc2, 0xa3, 0x1e, 0x3e, 0xf0, 0xb0, 0x4f, 0xe4, 0x1b, 0x79, 0xfa, 0x78, 0xaa, 0x37, 0xed, 0x20,
-	0x9b, 0xd9, 0x15, 0x6c, 0x50, 0x9f, 0xaa, 0xe3, 0x0c, 0x93, 0x45, 0xb6, 0xb7, 0xc6, 0x10, 0x55,
-	0x06, 0x88, 0x8e, 0xe1, 0x12, 0x57, 0x19, 0x5b, 0x8d, 0xd5, 0x6a, 0x9d, 0x69, 0x41, 0x2b, 0x1f,
-	0x49, 0xb4, 0x40, 0xab, 0x51, 0xfc, 0x33, 0x09, 0xa6, 0xb8, 0x66, 0xd7, 0xc1, 0x9a, 0xe9, 0xe4,
-	0x11, 0x98, 0xcd, 0xf9, 0x4c, 0x38, 0x81, 0x2c, 0x1e, 0x97, 0x99, 0x92, 0x75, 0x07, 0x97, 0x9c,
-	0x4c, 0x32, 0x3f, 0x84, 0x2b, 0x9c, 0x8b, 0xa3, 0x07, 0xfb, 0xd4, 0x2c, 0xf3, 0xbf, 0xd6, 0x82,
-	0x21, 0x8a, 0x0c, 0x79, 0x2d, 0x00, 0x4e, 0xf7, 0xc1, 0x27, 0x12, 0xcc, 0xc4, 0x4c, 0xe1, 0x56,
-	0x23, 0xff, 0x67, 0x11, 0xb9, 0xd9, 0xba, 0x41, 0xa6, 0x42, 0x83, 0xac, 0x57, 0x45, 0x50, 0xa4,
-	0xf3, 0xfa, 0x9d, 0x04, 0x37, 0x33, 0x78, 0xe5, 0xf8, 0x6a, 0xae, 0x75, 0x6a, 0xaf, 0xa6, 0x50,
-	0xcb, 0xf4, 0x59, 0x0a, 0xbb, 0x18, 0xab, 0x2c, 0xc3, 0xcd, 0x7f, 0x4d, 0xec, 0x22, 0x5a, 0xe9,
-	0xb6, 0xfb, 0x83, 0x04, 0xb7, 0xb2, 0xd9, 0xe5, 0x98, 0xef, 0x56, 0xeb, 0x04, 0x67, 0xd2, 0x09,
-	0x66, 0x5a, 0xb0, 0x0c, 0x32, 0x9b, 0x7b, 0xad, 0x60, 0xc3, 0xc7, 0xb3, 0x66, 0xd9, 0xb5, 0x6d,
-	0xd3, 0x97, 0x6f, 0xe7, 0xcc, 0x9e, 0x81, 0x62, 0xc2, 0x14, 0x53, 0x2a, 0x34, 0x7b, 0xa9, 0x43,
-	0x14, 0x6b, 0x55, 0x27, 0x3e, 0xcb, 0x9f, 0x4b, 0x14, 0x08, 0x61, 0x18, 0x8d, 0x29, 0x11, 0xe9,
-	0x93, 0x6b, 0xb9, 0x73, 0x62, 0x2d, 0xc3, 0xa1, 0x16, 0x9e, 0x3a, 0xb9, 0x9a, 0x6d, 0x18, 0x70,
-	0x2b, 0xd8, 0x11, 0xa3, 0x38, 0x36, 0x1d, 0xc3, 0x3d, 0x26, 0xf2, 0xdd, 0xb4, 0xd5, 0x56, 0x6c,
-	0xd6, 0x13, 0x67, 0x55, 0x25, 0x83, 0xa8, 0xfd, 0x01, 0x00, 0xe3, 0xbe, 0xcb, 0xc4, 0xd1, 0x23,
-	0xe8, 0xa5, 0xb4, 0x0d, 0xcf, 0xac, 0xf0, 0xb5, 0xef, 0xbd, 0xe6, 0x8f, 0x76, 0x7a, 0x02, 0xd9,
-	0xfb, 0x9e, 0x59, 0x11, 0x87, 0x16, 0x57, 0x99, 0x25, 0x7c, 0x57, 0xa3, 0x47, 0x6d, 0x15, 0x97,
-	0x10, 0x73, 0xcf, 0xc2, 0x5a, 0xf9, 0xb0, 0xea, 0xd1, 0x23, 0x0c, 0xa6, 0xe2, 0x1b, 0xcd, 0xab,
-	0x28, 0x52, 0xc4, 0x6d, 0x77, 0x0d, 0x3f, 0xf7, 0x37, 0x38, 0xdc, 0x12, 0x47, 0x63, 0x5a, 0x1f,
-	0xc3, 0x30, 0x45, 0x0d, 0xb4, 0x26, 0x4f, 0xe1, 0x5e, 0xa7, 0x6a, 0x2e, 0x35, 0x5a, 0xc5, 0x13,
-	0x75, 0x80, 0x02, 0x6c, 0xbb, 0x0b, 0xf1, 0xf3, 0xba, 0x63, 0xb8, 0x1c, 0xe2, 0x5a, 0xee, 0x71,
-	0x10, 0xe8, 0x71, 0x78, 0xb1, 0x9e, 0xfb, 0x66, 0x9a, 0x87, 0xeb, 0x95, 0xb0, 0x55, 0xdc, 0x86,
-	0x6e, 0x7a, 0x6a, 0x81, 0x6b, 0x5b, 0xa5, 0xa0, 0x31, 0x9d, 0x7c, 0xa5, 0xb7, 0x0e, 0x28, 0x71,
-	0x3c, 0xa6, 0x61, 0x5b, 0x27, 0xf2, 0x1b, 0x69, 0x83, 0x49, 0x7e, 0x36, 0xec, 0x84, 0xa0, 0x2f,
-	0x7e, 0x28, 0xb6, 0x6c, 0xeb, 0x04, 0xed, 0xc0, 0x50, 0xdd, 0x49, 0x04, 0xc5, 0xfc, 0x56, 0xb3,
-	0x98, 0x03, 0x35, 0xc7, 0x60, 0x14, 0x76, 0x1d, 0x50, 0xe2, 0x08, 0x8c, 0x61, 0x7e, 0xbb, 0x69,
-	0x9e, 0xf1, 0x83, 0x2f, 0x0a, 0xe8, 0xc3, 0x74, 0xcd, 0x9a, 0xf9, 0xc3, 0xaa, 0xee, 0xf8, 0xa6,
-	0x15, 0xcd, 0x57, 0xa1, 0x22, 0xf9, 0xcd, 0xc6, 0xeb, 0xe8, 0x2b, 0x89, 0x75, 0xf4, 0x26, 0xc7,
-	0x2a, 0x25, 0xcd, 0x83, 0xbe, 0x0f, 0xd7, 0xb3, 0xb4, 0xa6, 0x59, 0x4d, 0x7e, 0xab, 0xb1, 0xe2,
-	0x97, 0x53, 0x15, 0xaf, 0xd4, 0xd9, 0x30, 0x6f, 0xc4, 0x75, 0xa6, 0x95, 0x17, 0x5a, 0x1d, 0xb1,
-	0x9a, 0x34, 0x34, 0xfa, 0xb1, 0x04, 0xd7, 0xcb, 0x6e, 0xd5, 0x89, 0xcf, 0xc2, 0x65, 0xab, 0x4a,
-	0x31, 0x83, 0x75, 0x0a, 0x63, 0xc2, 0x83, 0x9d, 0x60, 0x5f, 0x5e, 0xa4, 0x9a, 0x95, 0x3c, 0x9f,
-	0xee, 0x98, 0x8e, 0x7f, 0xe7, 0x96, 0x7a, 0x8d, 0xe2, 0x86, 0x93, 0xae, 0x40, 0x2d, 0x39, 0xec,
-	0x94, 0x99, 0x42, 0x6e, 0x61, 0x1f, 0x7d, 0x24, 0xc1, 0x2c, 0xa3, 0x90, 0x98, 0xd2, 0x72, 0x59,
-	0x2c, 0x35, 0xcd, 0x62, 0x9a, 0x42, 0xc7, 0x67, 0xb0, 0x4c, 0x22, 0x2b, 0xd0, 0xcb, 0x55, 0x70,
-	0x5b, 0x10, 0xf9, 0x7e, 0x33, 0xa7, 0xe4, 0x17, 0x98, 0x14, 0x1f, 0x29, 0x41, 0xab, 0x80, 0x38,
-	0x4e, 0x34, 0x20, 0x22, 0x2f, 0x37, 0x03, 0xd5, 0xcf, 0x04, 0x23, 0xba, 0xc1, 0x7a, 0x5f, 0xe6,
-	0x29, 0xa7, 0x3e, 0xf0, 0x57, 0x9a, 0xfd, 0xc0, 0x86, 0x18, 0x44, 0x6d, 0xbc, 0x7f, 0x0f, 0xc6,
-	0x39, 0x76, 0x6a, 0x78, 0x3f, 0x68, 0x16, 0x9e, 0x33, 0x4c, 0x89, 0xea, 0xc8, 0xa6, 0xe1, 0x4e,
-	0xe9, 0xed, 0x13, 0xd8, 0x34, 0xb6, 0xeb, 0x11, 0x56, 0xa8, 0xff, 0x18, 0x4a, 0x27, 0xb4, 0x42,
-	0xed, 0x37, 0xb0, 0x09, 0xdd, 0x89, 0x4d, 0xe4, 0x43, 0x8a, 0x37, 0x93, 0x8a, 0x17, 0x6e, 0x72,
-	0x6a, 0xf7, 0xa8, 0x6a, 0x97, 0x15, 0xdb, 0x3f, 0xfe, 0x08, 0x8a, 0xfc, 0x54, 0xb6, 0x6a, 0x6b,
-	0xa9, 0x9b, 0x7f, 0x22, 0x3f, 0x3a, 0xdd, 0x8e, 0x71, 0x82, 0x9d, 0xd8, 0x56, 0xed, 0x8d, 0xfa,
-	0x13, 0x02, 0x82, 0x08, 0x5c, 0xe4, 0x7b, 0xd5, 0x72, 0xd5, 0xf3, 0xb0, 0xe3, 0xb3, 0xe9, 0x56,
-	0x13, 0x63, 0x92, 0x57, 0x5b, 0x3c, 0x28, 0x1e, 0x63, 0xb0, 0x4b, 0x0c, 0x95, 0x4e, 0x6e, 0xcb,
-	0x1c, 0x13, 0x4d, 0x43, 0xdf, 0xf1, 0xa1, 0xe9, 0x63, 0xcb, 0x0c, 0x26, 0x48, 0xc3, 0x36, 0x1d,
-	0x22, 0xbf, 0x43, 0x6b, 0x70, 0xbd, 0xe1, 0xfb, 0x05, 0xfa, 0x3a, 0xe8, 0x7a, 0x60, 0xb9, 0x7b,
-	0xba, 0xa5, 0x85, 0x2d, 0xf2, 0x1a, 0xeb, 0xca, 0xde, 0xef, 0x8a, 0xd7, 0xe8, 0x0e, 0x8c, 0x30,
-	0xc3, 0x95, 0x3d, 0xac, 0xfb, 0xae, 0x17, 0x93, 0x58, 0xa7, 0x12, 0x6c, 0x31, 0xb6, 0xc4, 0x5a,
-	0x23, 0xb9, 0x2d, 0x18, 0x8e, 0xd7, 0xcd, 0x62, 0x62, 0x1b, 0xcd, 0x44, 0xe0, 0x60, 0xac, 0x80,
-	0x16, 0x81, 0xee, 0x08, 0x32, 0x22, 0x0c, 0x23, 0xd4, 0xcd, 0x66, 0x50, 0x87, 0xe2, 0x15, 0xb5,
-	0x08, 0x76, 0x09, 0x0a, 0xe9, 0x5c, 0x35, 0xec, 0xe8, 0x7b, 0x16, 0x36, 0x64, 0x95, 0x56, 0x07,
-	0xc7, 0xd3, 0x48, 0x2d, 0xb3, 0x2e, 0x68, 0x19, 0x26, 0x33, 0xb8, 0x85, 0x28, 0x5b, 0x14, 0x65,
-	0x22, 0x95, 0x84, 0x80, 0xd9, 0x84, 0x61, 0xba, 0x0e, 0xb4, 0xb1, 0x61, 0xea, 0x4e, 0xfc, 0x94,
-	0x69, 0xbb, 0xf1, 0xac, 0x33, 0x18, 0x88, 0xbe, 0x43, 0x25, 0x4b, 0xf1, 0x43, 0xc1, 0x0b, 0xb6,
-	0x6e, 0xc4, 0xa1, 0x76, 0x1a, 0x43, 0xf5, 0xd8, 0xba, 0x11, 0xc3, 0x78, 0x0c, 0xa3, 0xa6, 0x63,
-	0xfa, 0xa6, 0x6e, 0x85, 0x89, 0x10, 0xdb, 0x3a, 0x4b, 0x03, 0xf2, 0xe3, 0xc6, 0x70, 0xc3, 0x5c,
-	0x9a, 0xe7, 0xc0, 0x65, 0x5b, 0xa7, 0x09, 0x00, 0x7d, 0x17, 0x26, 0x04, 0x6e, 0x2c, 0x09, 0x46,
-	0xd0, 0xbb, 0x8d, 0xa1, 0x05, 0xb1, 0x28, 0xff, 0x85, 0xe8, 0x31, 0xd6, 0xc2, 0x2b, 0x11, 0xf4,
-	0x93, 0xe6, 0x59, 0x73, 0x67, 0x85, 0xb8, 0x77, 0x60, 0x44, 0x7c, 0x3f, 0xb5, 0xd1, 0xfd, 0x1d,
-	0xf6, 0x51, 0xf0, 0xcf, 0xa8, 0x26, 0x7e, 0xef, 0x81, 0xcc, 0xe5, 0xea, 0x03, 0xf8, 0x29, 0x15,
-	0x1c, 0x66, 0xed, 0x75, 0x21, 0x7a, 0x0b, 0x78, 0x0b, 0xfb, 0xb2, 0x63, 0x72, 0xef, 0x52, 0xb9,
-	0x41, 0xd6, 0x4a, 0xbf, 0xef, 0x50, 0xea, 0x61, 0x7b, 0x47, 0x4f, 0xdf, 0x85, 0x87, 0xed, 0x1d,
-	0x17, 0xfa, 0x7a, 0x1f, 0xb6, 0x77, 0xf4, 0xf6, 0xf5, 0xd5, 0x9c, 0xd0, 0x88, 0x52, 0x27, 0xe3,
-	0x59, 0x7f, 0x90, 0x52, 0xd3, 0x3e, 0xc1, 0xda, 0xd5, 0x64, 0x9d, 0x94, 0x3f, 0x2a, 0xbb, 0xd0,
-	0x5b, 0x53, 0x96, 0x47, 0xa3, 0xd0, 0x11, 0x16, 0xf9, 0x25, 0x5a, 0xe4, 0x3f, 0xef, 0xf3, 0x02,
-	0xff, 0x34, 0xbc, 0x44, 0xff, 0x95, 0xcf, 0xd2, 0x7b, 0x06, 0x03, 0x71, 0x37, 0xcc, 0x33, 0x37,
-	0xa8, 0xac, 0x87, 0xf2, 0x80, 0x03, 0x47, 0x1f, 0x72, 0x1e, 0xf0, 0x28, 0x74, 0xd0, 0x83, 0xfa,
-	0xa0, 0x29, 0xc0, 0xee, 0x54, 0xcf, 0xeb, 0x4c, 0x4a, 0xd9, 0x81, 0xa1, 0xd4, 0xbd, 0x4d, 0x1e,
-	0xdc, 0x25, 0xe8, 0x66, 0xb9, 0xfb, 0x90, 0xed, 0x32, 0x02, 0xc8, 0x36, 0xb5, 0x6b, 0x2f, 0x92,
-	0x56, 0x9e, 0xc0, 0x70, 0xfa, 0x3e, 0xaf, 0x4e, 0x58, 0xaa, 0x13, 0x46, 0xe3, 0xd0, 0x29, 0x54,
-	0xb3, 0x2b, 0x13, 0xed, 0x6a, 0x07, 0xd7, 0x4d, 0x94, 0x8f, 0x24, 0x90, 0xb3, 0xea, 0xd5, 0xa7,
-	0x23, 0x8d, 0xae, 0xc3, 0x39, 0x56, 0x2e, 0xa7, 0xb7, 0x2f, 0x6a, 0x2e, 0x7a, 0xcc, 0xcf, 0xf0,
-	0x9a, 0x38, 0xef, 0xa3, 0x1c, 0xc1, 0x40, 0xca, 0x94, 0xde, 0x9a, 0x1b, 0x02, 0xd7, 0xb3, 0x2f,
-	0xb0, 0x2d, 0xcd, 0xf5, 0x6c, 0xb1, 0xc0, 0x7a, 0x28, 0x06, 0x0c, 0xa6, 0xad, 0x2b, 0x5b, 0x54,
-	0x3c, 0x0c, 0xe7, 0xaa, 0x54, 0x9e, 0xdf, 0x38, 0xe1, 0x4f, 0xca, 0x5f, 0x25, 0x50, 0x1a, 0x57,
-	0xec, 0x5b, 0x54, 0xba, 0x0b, 0x43, 0xe9, 0x17, 0x07, 0xd8, 0xe8, 0x95, 0xe4, 0xe8, 0x53, 0xaf,
-	0x0a, 0x0c, 0x5a, 0x29, 0x6f, 0x95, 0x5f, 0x4a, 0xd0, 0x5f, 0x57, 0x3d, 0x6c, 0x91, 0x64, 0x09,
-	0xda, 0x0c, 0x5c, 0xa6, 0x94, 0x4e, 0xb1, 0x28, 0x0a, 0x30, 0x14, 0x07, 0x7a, 0x12, 0x15, 0x99,
-	0x3c, 0x46, 0x8b, 0xd0, 0x66, 0x3a, 0x2c, 0x3c, 0x5b, 0x59, 0x0d, 0x05, 0xc2, 0xca, 0xcf, 0xeb,
-	0xcc, 0x50, 0x6a, 0xd9, 0x57, 0x9c, 0x4f, 0xdb, 0x69, 0xf8, 0xfc, 0x43, 0x82, 0x2b, 0xcd, 0x54,
-	0xaa, 0xf3, 0x28, 0x4e, 0x40, 0x67, 0x54, 0x5d, 0x67, 0x1c, 0xa3, 0x17, 0x48, 0x86, 0xf3, 0xa2,
-	0x6c, 0xdd, 0xc6, 0xf8, 0xf3, 0xc7, 0x60, 0xa6, 0x0f, 0xbb, 0x05, 0xf3, 0xb4, 0x2b, 0xb7, 0xd3,
-	0x20, 0x1b, 0x4f, 0x06, 0x59, 0xb2, 0x62, 0xde, 0x63, 0xc4, 0x1f, 0x95, 0x7f, 0x4a, 0x30, 0xdd,
-	0x74, 0xe9, 0xb8, 0x99, 0x0c, 0x17, 0x1f, 0xe7, 0xd9, 0xe4, 0x38, 0xb3, 0x47, 0xb2, 0x0a, 0x28,
-	0x51, 0x80, 0x8d, 0x8f, 0xa6, 0x50, 0x93, 0x30, 0x6a, 0x6b, 0xbc, 0x7d, 0xa4, 0xe6, 0x8d, 0xe2,
-	0xc3, 0x68, 0x66, 0x75, 0x3a, 0x11, 0x0f, 0x52, 0x32, 0x1e, 0x72, 0xa8, 0xd7, 0x0e, 0xbc, 0xad,
-	0x7e, 0x5e, 0xf8, 0xcd, 0x59, 0x78, 0xbd, 0xf5, 0x52, 0xf5, 0x29, 0x4d, 0x9b, 0x08, 0xa1, 0xb6,
-	0x9c, 0x10, 0x6a, 0x4f, 0x1a, 0x1e, 0xc3, 0x78, 0x7a, 0x09, 0x9c, 0x79, 0xe0, 0x25, 0xea, 0x81,
-	0x6b, 0xa9, 0xf1, 0x54, 0x5f, 0x6d, 0x97, 0x8d, 0x8c, 0x16, 0xe5, 0xb7, 0x12, 0x28, 0x8d, 0x8b,
-	0xe6, 0xc9, 0x51, 0x48, 0x39, 0xa3, 0x38, 0x9b, 0x1c, 0x45, 0xdc, 0x30, 0x6d, 0xf9, 0x8e, 0x6b,
-	0xaf, 0x77, 0xdc, 0x47, 0x12, 0x8c, 0x64, 0x54, 0xdb, 0x5b, 0x4c, 0x2c, 0xb7, 0xa1, 0x33, 0x5c,
-	0x7e, 0xf3, 0xc4, 0x3f, 0x92, 0xb4, 0x61, 0x54, 0xcf, 0x8f, 0x7a, 0x2a, 0x3f, 0x91, 0x60, 0x38,
-	0xbd, 0x60, 0xdf, 0x22, 0x8f, 0x39, 0xe8, 0x10, 0xcb, 0x6c, 0x4e, 0x63, 0x38, 0x49, 0x23, 0xbc,
-	0x10, 0x10, 0xf6, 0x53, 0x7e, 0x00, 0x72, 0x56, 0xbd, 0x1e, 0x15, 0xa0, 0xcb, 0x32, 0xf7, 0xb4,
-	0xca, 0x5c, 0x45, 0x7b, 0x86, 0x5f, 0x08, 0x0f, 0x59, 0xa2, 0x3b, 0x7a, 0x13, 0x7a, 0x5c, 0xde,
-	0x5f, 0x73, 0x5c, 0x03, 0xf3, 0xd5, 0xde, 0x58, 0x52, 0x69, 0xe2, 0x0a, 0x40, 0xb7, 0x1b, 0x7b,
-	0x52, 0xaa, 0xf1, 0xd9, 0xa4, 0xc1, 0xfc, 0xc6, 0x27, 0xb1, 0xb3, 0x5f, 0xc3, 0x24, 0xf6, 0x2b,
-	0x09, 0x26, 0xf2, 0x0a, 0xf8, 0xa7, 0x5c, 0x7c, 0xdd, 0x4b, 0xdc, 0xf6, 0x60, 0x8e, 0x90, 0x33,
-	0xe2, 0x81, 0xc4, 0x2f, 0x78, 0x28, 0xbf, 0x90, 0x60, 0x3c, 0xe7, 0x1e, 0xc0, 0x29, 0x79, 0xdd,
-	0x8e, 0x5f, 0x1f, 0x49, 0x0d, 0xd3, 0xe8, 0xc6, 0x41, 0xd4, 0x53, 0xf9, 0xbb, 0x04, 0x57, 0x9b,
-	0xba, 0x49, 0x70, 0x4a, 0x7a, 0x3b, 0x30, 0x24, 0xf6, 0x4d, 0x47, 0x01, 0x6a, 0x78, 0x66, 0xc4,
-	0xa8, 0x5e, 0x4a, 0x52, 0x4d, 0xbb, 0xc9, 0x30, 0xe0, 0xd5, 0xbf, 0x54, 0x7e, 0x2f, 0x41, 0x21,
-	0xff, 0x96, 0xc2, 0x29, 0x79, 0xbf, 0x01, 0xdd, 0x71, 0xbe, 0x9c, 0xee, 0x68, 0x92, 0x6e, 0xe2,
-	0x34, 0xeb, 0x28, 0x7a, 0x50, 0xde, 0x85, 0xbe, 0xda, 0x3b, 0x04, 0x79, 0x7c, 0xae, 0xc3, 0x39,
-	0x7e, 0x63, 0xe0, 0x6c, 0xda, 0xc2, 0x9e, 0xdf, 0x0c, 0xe0, 0x7d, 0x94, 0x4f, 0xa2, 0xb1, 0x67,
-	0x5c, 0x22, 0xc8, 0xd3, 0xf5, 0x04, 0x86, 0xa3, 0xdb, 0xa9, 0x54, 0x46, 0x4b, 0xe8, 0x56, 0x52,
-	0x3d, 0x92, 0xbc, 0xa3, 0x20, 0xae, 0xdd, 0x26, 0xde, 0x2a, 0x7f, 0x8c, 0xf1, 0x4a, 0xaf, 0x41,
-	0xb6, 0x98, 0x01, 0x1f, 0x41, 0xbf, 0x1f, 0x95, 0xfb, 0x58, 0x1c, 0x71, 0x87, 0xd4, 0xac, 0x2b,
-	0x62, 0x55, 0x41, 0x56, 0xf4, 0xec, 0xf3, 0x6b, 0xde, 0x28, 0x9f, 0x45, 0x81, 0x9f, 0x5f, 0x30,
-	0xcd, 0x23, 0x3b, 0x0e, 0x9d, 0x82, 0xec, 0x4d, 0xce, 0xb6, 0x83, 0xb3, 0xbd, 0x19, 0x6f, 0x9c,
-	0xe3, 0xd3, 0xb8, 0x68, 0x9c, 0x4b, 0x1f, 0x4b, 0x7b, 0x8b, 0x63, 0x89, 0xa5, 0xbc, 0xd4, 0x7a,
-	0x68, 0xde, 0x10, 0xde, 0x85, 0x91, 0x38, 0x11, 0xc6, 0x98, 0xdd, 0x4f, 0x67, 0x81, 0x70, 0x39,
-	0x93, 0x4e, 0xa2, 0xac, 0x9b, 0xf6, 0x5a, 0xb1, 0x61, 0x20, 0xa5, 0x54, 0xd8, 0xcc, 0xf2, 0x68,
-	0x3e, 0xb9, 0xb7, 0x4e, 0x99, 0xee, 0xc2, 0xc2, 0x63, 0xb4, 0xe7, 0xfe, 0xa9, 0x04, 0x13, 0x79,
-	0x55, 0xc3, 0x66, 0x14, 0x2f, 0x42, 0x77, 0xa2, 0x34, 0xc9, 0x74, 0x4f, 0xa6, 0xea, 0x8e, 0xd5,
-	0x23, 0xd9, 0xc5, 0x5f, 0xf6, 0x42, 0xf9, 0xb3, 0x04, 0xc5, 0x46, 0xc7, 0xde, 0x79, 0x3e, 0xc9,
-	0x5e, 0x1c, 0xa9, 0x30, 0x98, 0x96, 0x46, 0xf9, 0x57, 0x50, 0x6c, 0x94, 0x45, 0x55, 0x54, 0x9f,
-	0x44, 0x17, 0xd5, 0x4f, 0xbf, 0x2c, 0x48, 0x9f, 0x7f, 0x59, 0x90, 0xfe, 0xfb, 0x65, 0x41, 0xfa,
-	0xf8, 0xab, 0xc2, 0x99, 0xcf, 0xbf, 0x2a, 0x9c, 0xf9, 0xf7, 0x57, 0x85, 0x33, 0x4f, 0xef, 0x35,
-	0x39, 0x01, 0x3f, 0x9f, 0x8d, 0x7e, 0xbc, 0x42, 0x7f, 0xee, 0xb2, 0x77, 0x8e, 0xfe, 0x46, 0x65,
-	0xfe, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe0, 0xc1, 0x7c, 0x35, 0x00, 0x34, 0x00, 0x00,
+	// 4033 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0xcd, 0x6f, 0x1c, 0x47,
+	0x76, 0x77, 0x6b, 0x68, 0x89, 0x7c, 0x24, 0x45, 0xb2, 0xf8, 0x55, 0xfc, 0x10, 0x45, 0xb5, 0x64,
+	0x8b, 0xb2, 0xe5, 0xa1, 0x45, 0xda, 0x96, 0xd6, 0xeb, 0x64, 0x4d, 0x52, 0x1f, 0xa6, 0x4d, 0x8b,
+	0x54, 0x93, 0x32, 0x1d, 0x7b, 0xed, 0xde, 0xe6, 0x74, 0x91, 0x6c, 0xab, 0xa7, 0x6b, 0xd4, 0xd5,
+	0x43, 0x8a, 0xd9, 0x00, 0x09, 0x02, 0xc4, 0x87, 0x00, 0x01, 0x16, 0x41, 0x36, 0xc8, 0xd7, 0x25,
+	0xc8, 0x25, 0x40, 0x2e, 0x01, 0x92, 0x53, 0x90, 0xdc, 0x37, 0xb7, 0x4d, 0x4e, 0x41, 0x0e, 0x8b,
+	0xc0, 0x3e, 0xe4, 0xdf, 0x08, 0xea, 0xa3, 0xbb, 0xab, 0x7b, 0x7a, 0x7a, 0x46, 0x43, 0xe7, 0x42,
+	0xb0, 0xab, 0xea, 0xfd, 0x7e, 0xaf, 0x5e, 0xbd, 0xfa, 0x7a, 0xf5, 0x06, 0x66, 0x49, 0xdd, 0x63,
+	0xcc, 0xa3, 0x01, 0x5b, 0x3e, 0xb9, 0xbb, 0x7c, 0x44, 0x02, 0xc2, 0x3c, 0x56, 0x6d, 0x84, 0x34,
+	0xa2, 0x68, 0x28, 0xa9, 0xab, 0x9e, 0xdc, 0x9d, 0x1d, 0x73, 0xea, 0x5e, 0x40, 0x97, 0xc5, 0x5f,
+	0xd9, 0x60, 0x76, 0xa6, 0x46, 0x59, 0x9d, 0x32, 0x5b, 0x7c, 0x2d, 0xcb, 0x0f, 0x55, 0x35, 0xad,
+	0xe1, 0xae, 0x2e, 0x07, 0xd4, 0x25, 0xaa, 0x02, 0xe7, 0x2a, 0x82, 0x5a, 0x5c, 0x33, 0x9b, 0xa9,
+	0x09, 0x49, 0xa3, 0x19, 0x91, 0xb0, 0x50, 0x8a, 0xd5, 0x68, 0x58, 0x8c, 0xc7, 0x22, 0xe7, 0x59,
+	0x71, 0x4d, 0x44, 0x1b, 0x5e, 0xad, 0xb8, 0xe6, 0xac, 0x41, 0x62, 0xb5, 0x67, 0x32, 0x35, 0xa7,
+	0x34, 0x7c, 0x46, 0xc2, 0x82, 0xaa, 0xbb, 0xcb, 0x0d, 0x27, 0x74, 0xea, 0xb1, 0xd4, 0xc4, 0x11,
+	0x3d, 0xa2, 0xd2, 0x08, 0xfc, 0x3f, 0x59, 0x6a, 0xfe, 0xcb, 0x63, 0x18, 0x7a, 0x24, 0x0d, 0xba,
+	0x1b, 0x39, 0x11, 0x41, 0x2b, 0x70, 0x51, 0x8a, 0x61, 0x63, 0xd1, 0x58, 0x1a, 0x5c, 0x99, 0xa8,
+	0xea, 0x06, 0xae, 0xee, 0x88, 0xba, 0xf5, 0xbe, 0x5f, 0xfd, 0xe6, 0xea, 0x2b, 0x96, 0x6a, 0x89,
+	0xaa, 0x30, 0xce, 0x3b, 0x6b, 0x47, 0xc4, 0xa9, 0xdb, 0x8e, 0xeb, 0x86, 0x84, 0x31, 0xc2, 0xf0,
+	0x85, 0xc5, 0xca, 0xd2, 0x80, 0x35, 0xc6, 0xab, 0xf6, 0x88, 0x53, 0x5f, 0x8b, 0x2b, 0x90, 0x09,
+	0xc3, 0x01, 0x79, 0x11, 0xd9, 0xa2, 0xbb, 0xb6, 0xe7, 0xe2, 0xca, 0xa2, 0xb1, 0xd4, 0x67, 0x0d,
+	0xf2, 0xc2, 0x3d, 0x5e, 0xb6, 0xe9, 0xa2, 0x77, 0xe1, 0xa2, 0xa8, 0x66, 0xb8, 0x6f, 0xb1, 0xb2,
+	0x34, 0xb8, 0x72, 0x25, 0xab, 0x87, 0x6a, 0xb6, 0x16, 0xb8, 0xe2, 0x3f, 0x4b, 0x35, 0x46, 0xd7,
+	0x61, 0xd8, 0xa9, 0x45, 0xde, 0x09, 0xb1, 0x95, 0xf4, 0xab, 0x8b, 0x95, 0xa5, 0x3e, 0x6b, 0x48,
+	0x16, 0xee, 0xc9, 0x46, 0x6f, 0xc2, 0x58, 0x48, 0x4e, 0x9d, 0xd0, 0x75, 0x0e, 0xfc, 0xa4, 0xe1,
+	0x45, 0xd1, 0x70, 0x34, 0xad, 0x50, 0x8d, 0xd7, 0x61, 0x58, 0xea, 0x29, 0x0d, 0xcd, 0xf0, 0xa5,
+	0xb6, 0xfa, 0xac, 0x05, 0xee, 0x5a, 0x2d, 0xa2, 0xe1, 0xa6, 0x6b, 0x0d, 0x09, 0x99, 0x7d, 0x29,
+	0x82, 0xee, 0xc3, 0x65, 0x89, 0xa1, 0x1c, 0x86, 0xe1, 0xfe, 0x6e, 0x40, 0x24, 0xb1, 0xa5, 0x64,
+	0xd0, 0x13, 0x40, 0x31, 0x0a, 0xd7, 0xd1, 0x16, 0x7e, 0x89, 0x07, 0x04, 0xd2, 0xf5, 0x76, 0xe6,
+	0x59, 0xf7, 0x69, 0xed, 0xd9, 0x47, 0xc4, 0x3b, 0x3a, 0x8e, 0xac, 0x51, 0x85, 0xc7, 0xa5, 0x1f,
+	0x73, 0x61, 0xf4, 0x15, 0x4c, 0x7b, 0xc1, 0x21, 0x09, 0x49, 0x68, 0x0b, 0x7f, 0x65, 0xf6, 0xc1,
+	0x99, 0x7d, 0xc0, 0x45, 0x30, 0x08, 0xdc, 0xd7, 0x0b, 0x71, 0x35, 0xd0, 0x5d, 0x21, 0x66, 0x4d,
+	0x28, 0x18, 0xf9, 0xb9, 0x7e, 0x26, 0x5a, 0xa0, 0x1a, 0xcc, 0x1e, 0xd2, 0x90, 0xd4, 0x1c, 0x16,
+	0x15, 0x30, 0x0c, 0xbe, 0x14, 0xc3, 0x74, 0x8a, 0x94, 0x25, 0xf9, 0x0a, 0xa6, 0x95, 0x59, 0x5b,
+	0x18, 0x86, 0x5e, 0xae, 0x0f, 0x0a, 0x26, 0x0b, 0x1f, 0xc1, 0x95, 0x18, 0xde, 0xf7, 0x58, 0x44,
+	0x02, 0x2f, 0x38, 0xb2, 0x6b, 0x94, 0x1c, 0x1e, 0x7a, 0x35, 0x8f, 0x04, 0x11, 0x1e, 0x15, 0x24,
+	0x6f, 0x17, 0x0f, 0x80, 0x1c, 0xc9, 0xad, 0x58, 0x70, 0x23, 0x95, 0xb3, 0xe6, 0x14, 0x6c, 0x51,
+	0x25, 0x3a, 0x86, 0xc5, 0x46, 0x48, 0x4e, 0x3c, 0xda, 0x64, 0xb1, 0xd3, 0xc4, 0xc3, 0x7e, 0x18,
+	0x72, 0x67, 0xa6, 0x01, 0x1e, 0x13, 0xc4, 0x57, 0xcb, 0x88, 0xef, 0x93, 0x9a, 0x75, 0x25, 0x06,
+	0x52, 0x8e, 0x24, 0xc7, 0xff, 0xa1, 0x42, 0x41, 0x3e, 0x98, 0x09, 0x93, 0x1c, 0xc4, 0xa0, 0x46,
+	0x5a, 0xb8, 0x50, 0x77, 0x5c, 0x57, 0x63, 0xa8, 0xcd, 0x18, 0x29, 0xc7, 0xf6, 0x0d, 0x5c, 0x4b,
+	0xd8, 0xe2, 0x01, 0x6d, 0x21, 0x1b, 0xef, 0x8e, 0x6c, 0x21, 0x46, 0x7a, 0xa8, 0x80, 0x72, 0x5c,
+	0x07, 0x70, 0xb5, 0x85, 0x2b, 0x76, 0x12, 0x3b, 0x74, 0x22, 0x8f, 0xe2, 0x09, 0xc1, 0x34, 0xd7,
+	0x6e, 0xf2, 0x70, 0x96, 0xf9, 0x3c, 0x8b, 0x72, 0x10, 0x8b, 0x03, 0xa0, 0x27, 0x30, 0x18, 0xd1,
+	0xc8, 0xf1, 0x6d, 0xb1, 0xa8, 0xe3, 0xc9, 0x45, 0x63, 0x69, 0x60, 0xfd, 0x6d, 0xbe, 0x3a, 0xfe,
+	0xf7, 0x6f, 0xae, 0x4e, 0xca, 0xdd, 0x86, 0xb9, 0xcf, 0xaa, 0x1e, 0x5d, 0xae, 0x3b, 0xd1, 0x71,
+	0x75, 0x33, 0x88, 0xfe, 0xf3, 0x9f, 0xdf, 0x02, 0x59, 0xc1, 0xbf, 0xfe, 0xfe, 0x7f, 0xff, 0xf1,
+	0x0d, 0xc3, 0x02, 0x01, 0xb2, 0xcb, 0x31, 0xd0, 0x07, 0x30, 0x28, 0xa7, 0xb9, 0x84, 0x9c, 0x2a,
+	0x57, 0x71, 0x33, 0x88, 0xb8, 0x74, 0xc3, 0xab, 0x49, 0xe9, 0x7d, 0x98, 0x16, 0x72, 0x89, 0xd7,
+	0x38, 0xcd, 0xe8, 0x98, 0x86, 0x5e, 0x74, 0x86, 0xa7, 0x3b, 0x9b, 0x95, 0xa3, 0x4d, 0x0a, 0x79,
+	0xe5, 0x2c, 0x6b, 0xb1, 0x34, 0xfa, 0x1c, 0xb0, 0x04, 0x66, 0xcd, 0xba, 0x7d, 0x18, 0xd2, 0xba,
+	0xed, 0x12, 0x9f, 0x1c, 0x39, 0x11, 0x0d, 0x31, 0x7e, 0x19, 0xe4, 0xdd, 0x66, 0xfd, 0x61, 0x48,
+	0xeb, 0xf7, 0x63, 0x69, 0xf4, 0x15, 0x8c, 0x2a, 0x28, 0xe2, 0xca, 0x4e, 0x33, 0x3c, 0x23, 0x10,
+	0x57, 0x0a, 0x11, 0x13, 0x49, 0xa5, 0x63, 0xf2, 0xbd, 0x19, 0x1c, 0x52, 0x6b, 0x24, 0xc1, 0x12,
+	0x06, 0x61, 0xe8, 0x19, 0x98, 0x52, 0xf1, 0xac, 0xd2, 0xcc, 0x6e, 0x36, 0x68, 0x10, 0x9b, 0x09,
+	0xcf, 0x76, 0xd7, 0x85, 0x05, 0x01, 0x95, 0xd1, 0x9f, 0x3d, 0x6d, 0xd0, 0x40, 0xa9, 0x82, 0xbe,
+	0x80, 0x99, 0x98, 0x3f, 0x76, 0xeb, 0x06, 0xf7, 0xb9, 0x63, 0x27, 0x24, 0x78, 0xae, 0x3b, 0xbf,
+	0x9e, 0x8a, 0x11, 0xa4, 0x3f, 0xef, 0x90, 0x70, 0x97, 0x8b, 0xa3, 0x20, 0x1d, 0xda, 0x3a, 0x3d,
+	0x71, 0x7c, 0x6d, 0xa1, 0x9b, 0x17, 0xc8, 0x77, 0xb3, 0xc8, 0xda, 0x0a, 0xa7, 0x48, 0x94, 0x96,
+	0xbb, 0x72, 0x80, 0x05, 0x88, 0xb0, 0xd9, 0x04, 0xd3, 0x4a, 0x92, 0x95, 0xef, 0xeb, 0x22, 0x3e,
+	0x87, 0xeb, 0x89, 0xaf, 0x08, 0xbe, 0x9b, 0x59, 0x3e, 0xd5, 0x85, 0xd6, 0xf5, 0xb5, 0x05, 0x5f,
+	0xb4, 0x44, 0xbf, 0x30, 0x60, 0x31, 0x31, 0x56, 0xbb, 0x9e, 0x2d, 0x08, 0xa6, 0x8f, 0x3a, 0xf5,
+	0xac, 0x8d, 0x4f, 0x90, 0x96, 0xae, 0xce, 0xbb, 0x05, 0x35, 0x49, 0x97, 0x5f, 0x94, 0x6a, 0x24,
+	0xfb, 0x7e, 0xb5, 0x68, 0xbd, 0xcf, 0xf3, 0x17, 0x18, 0xa1, 0x1d, 0xb3, 0x34, 0xc6, 0x03, 0x80,
+	0x64, 0xf5, 0x65, 0x78, 0x51, 0x70, 0xbc, 0x56, 0xee, 0x8d, 0xf1, 0x0a, 0xab, 0x09, 0xa2, 0x75,
+	0x18, 0x88, 0x97, 0x3a, 0x86, 0xaf, 0x09, 0x94, 0x1b, 0x65, 0x28, 0xc9, 0xd2, 0x99, 0x8a, 0xa1,
+	0x0f, 0xe1, 0x52, 0x7c, 0xd6, 0x31, 0x8b, 0x36, 0xd0, 0x2d, 0xef, 0x60, 0x67, 0xa5, 0xf1, 0x09,
+	0x39, 0x5b, 0x0b, 0xdc, 0xed, 0xc3, 0xc3, 0xda, 0xb1, 0xe3, 0x05, 0x8f, 0xa9, 0x4b, 0xac, 0x58,
+	0x0c, 0xad, 0x43, 0x7f, 0x72, 0xd2, 0xb9, 0xfe, 0x52, 0x10, 0x89, 0x1c, 0x7a, 0x04, 0x63, 0x72,
+	0x19, 0x3c, 0x24, 0x7c, 0x14, 0x4e, 0x48, 0xd0, 0x24, 0xf8, 0x46, 0xe7, 0xc5, 0x70, 0x44, 0x48,
+	0x3d, 0x24, 0xc4, 0x92, 0x32, 0x68, 0x1b, 0x26, 0x93, 0x6d, 0x40, 0x9d, 0xe4, 0xc4, 0x80, 0xe0,
+	0xd7, 0x3a, 0x2f, 0xfe, 0xe3, 0xb1, 0xa4, 0x28, 0xde, 0x17, 0x72, 0xe8, 0x09, 0x5c, 0x76, 0x7c,
+	0xdf, 0xd6, 0x86, 0xeb, 0x75, 0x81, 0xf4, 0x46, 0xa7, 0x73, 0x46, 0x32, 0x64, 0xcc, 0x1a, 0x76,
+	0x7c, 0x3f, 0xfd, 0x44, 0x8f, 0x81, 0x17, 0xd8, 0xe9, 0xd0, 0xdd, 0x14, 0x88, 0xb7, 0x3a, 0x21,
+	0xc6, 0xc3, 0xc7, 0xac, 0x21, 0xc7, 0xf7, 0x93, 0x2f, 0xbe, 0xa4, 0x72, 0x3c, 0x9f, 0x32, 0x66,
+	0x1f, 0x34, 0x03, 0xd7, 0x27, 0x0c, 0x2f, 0x09, 0xc8, 0xd5, 0x4e, 0x90, 0xca, 0x81, 0x3f, 0x73,
+	0xfc, 0x26, 0x59, 0x97, 0xa2, 0x16, 0xef, 0xef, 0x16, 0x65, 0x4c, 0x7d, 0xa3, 0xaf, 0x61, 0x22,
+	0x20, 0x11, 0x1f, 0xed, 0x2c, 0xc5, 0x2d, 0x41, 0x71, 0xbb, 0x13, 0x45, 0x06, 0x1b, 0x29, 0x24,
+	0x1d, 0xff, 0x2f, 0x0c, 0x78, 0x2b, 0x19, 0xb3, 0x06, 0x09, 0x6b, 0x24, 0x88, 0x9c, 0xa3, 0x64,
+	0x45, 0x8d, 0xa8, 0x9c, 0x9c, 0x6e, 0x7a, 0x9e, 0x7e, 0x43, 0x6c, 0xbc, 0x77, 0xd5, 0xc6, 0xbb,
+	0x7c, 0xe4, 0x45, 0xc7, 0xcd, 0x83, 0x6a, 0x8d, 0xd6, 0x97, 0x1d, 0xdf, 0xa7, 0xa1, 0xf3, 0x96,
+	0x62, 0x89, 0x3f, 0x85, 0xc7, 0xc9, 0x2d, 0x99, 0x8f, 0xf3, 0x52, 0xcc, 0xb6, 0x93, 0x90, 0xc9,
+	0xc5, 0x77, 0x8f, 0x8a, 0xd9, 0xea, 0x26, 0x87, 0xf0, 0x2f, 0x60, 0xa6, 0x19, 0x1c, 0x36, 0xfd,
+	0x43, 0xcf, 0xf7, 0x89, 0xab, 0x2e, 0x05, 0xf2, 0x28, 0xce, 0xf0, 0x9b, 0xa2, 0xff, 0x0b, 0xed,
+	0x3c, 0x4a, 0x9c, 0xb9, 0x99, 0x35, 0xad, 0x01, 0xc8, 0x1b, 0x82, 0xac, 0x40, 0xdf, 0xc0, 0xac,
+	0x8e, 0x1d, 0xef, 0xe0, 0x0a, 0xfc, 0x76, 0x89, 0x71, 0xd7, 0x02, 0x37, 0x39, 0xdd, 0x3d, 0x6f,
+	0x12, 0x16, 0x29, 0x2a, 0xac, 0xe1, 0xa9, 0x06, 0x8a, 0xeb, 0x39, 0x2c, 0xf8, 0x4e, 0x44, 0x58,
+	0x64, 0xc7, 0x17, 0x80, 0x78, 0x44, 0x43, 0x72, 0x14, 0x92, 0x88, 0xe1, 0xb7, 0xca, 0xf8, 0xd4,
+	0x52, 0xef, 0xd5, 0xf9, 0x6a, 0x56, 0x6f, 0x10, 0x57, 0x8c, 0xa9, 0x35, 0x27, 0x31, 0xa5, 0x67,
+	0x87, 0x8f, 0x25, 0xa2, 0x25, 0x01, 0xd1, 0x29, 0x5c, 0x53, 0x94, 0xda, 0x69, 0x2c, 0xcf, 0x5a,
+	0xed, 0x81, 0x55, 0xf5, 0x24, 0x3d, 0xa0, 0xe5, 0x88, 0xff, 0xd8, 0x80, 0x25, 0xc5, 0x4c, 0x03,
+	0x62, 0x7b, 0x41, 0x99, 0x02, 0xcb, 0x25, 0xd3, 0x44, 0x29, 0xd0, 0x4e, 0x8f, 0xeb, 0x92, 0x64,
+	0x3b, 0x20, 0x9b, 0x41, 0x5b, 0x65, 0x7e, 0x0f, 0x6e, 0x28, 0x5d, 0x02, 0x87, 0xdf, 0x53, 0xdb,
+	0x99, 0xff, 0xed, 0x1e, 0x0c, 0xb1, 0x28, 0x91, 0x1f, 0x73, 0xe0, 0xe2, 0x31, 0xf8, 0xa5, 0x01,
+	0x55, 0xcd, 0x14, 0xb4, 0x99, 0x8e, 0x7f, 0x3b, 0x45, 0xee, 0xf4, 0x6e, 0x90, 0xa5, 0xc4, 0x20,
+	0xdb, 0xcd, 0xd8, 0x29, 0x8a, 0xf5, 0xfa, 0x6b, 0x03, 0xee, 0xb4, 0xd1, 0xab, 0x64, 0xac, 0x56,
+	0x7a, 0x57, 0xed, 0xcd, 0x02, 0xd5, 0xda, 0x8e, 0x59, 0x81, 0x76, 0x9a, 0x56, 0xed, 0x0c, 0xb7,
+	0xfa, 0x03, 0x69, 0x97, 0xaa, 0x55, 0x6c, 0xbb, 0xbf, 0x35, 0xe0, 0x9d, 0xf6, 0xda, 0x95, 0x98,
+	0xef, 0x9d, 0xde, 0x15, 0xac, 0x16, 0x2b, 0xd8, 0xd6, 0x82, 0x35, 0xc0, 0x72, 0xef, 0xf5, 0xf9,
+	0x85, 0x4f, 0xad, 0x9a, 0x35, 0x5a, 0xaf, 0x7b, 0x11, 0x7e, 0xb7, 0x64, 0xf7, 0xe4, 0xc4, 0x4c,
+	0x12, 0x0b, 0x55, 0xc4, 0xea, 0x65, 0x4d, 0x0a, 0xac, 0x2d, 0x87, 0x45, 0x72, 0xfd, 0xdc, 0x10,
+	0x40, 0x88, 0xc0, 0x8c, 0x46, 0x12, 0x2f, 0x9f, 0x8a, 0xe5, 0xbd, 0x97, 0x66, 0x99, 0x4a, 0x58,
+	0xd4, 0xd2, 0xa9, 0x68, 0xf6, 0x60, 0x9c, 0x36, 0x48, 0x10, 0xf7, 0xe2, 0xd4, 0x0b, 0x5c, 0x7a,
+	0xca, 0xf0, 0xdd, 0xa2, 0xd3, 0x96, 0xb6, 0xeb, 0xc5, 0xb1, 0xaa, 0x4d, 0x97, 0x59, 0x63, 0x1c,
+	0x40, 0xea, 0xbe, 0x2f, 0xc5, 0xd1, 0x27, 0x30, 0x22, 0xd4, 0x76, 0x43, 0xaf, 0xa1, 0xce, 0xbe,
+	0xf7, 0xba, 0x0f, 0xed, 0x0c, 0x73, 0xd9, 0xfb, 0xa1, 0xd7, 0x88, 0x83, 0x16, 0xaf, 0x49, 0x4b,
+	0x44, 0xd4, 0x16, 0xa1, 0xb6, 0x06, 0x65, 0xcc, 0xe3, 0xd1, 0xae, 0xda, 0x71, 0x33, 0x14, 0x21,
+	0x0c, 0x49, 0xf1, 0xa3, 0xee, 0x29, 0x16, 0x05, 0xe2, 0x1e, 0x7d, 0x4c, 0x5e, 0x44, 0x3b, 0x0a,
+	0x6e, 0x43, 0xa1, 0x49, 0xd6, 0xcf, 0x60, 0x4a, 0xa0, 0x72, 0xd6, 0x6c, 0x14, 0xee, 0x7d, 0x41,
+	0x73, 0xad, 0xd3, 0x29, 0x9e, 0x59, 0xe3, 0x02, 0x60, 0x8f, 0xae, 0xe9, 0xf1, 0xba, 0x53, 0xb8,
+	0x9e, 0xe0, 0xfa, 0xf4, 0x94, 0x3b, 0xba, 0x0e, 0x1f, 0x9f, 0xe7, 0x7e, 0x5c, 0x34, 0xc2, 0xad,
+	0x24, 0xf2, 0x14, 0xb7, 0xe3, 0x78, 0xa1, 0xb5, 0xa0, 0xd8, 0xb6, 0x04, 0xa8, 0xc6, 0x29, 0xdb,
+	0xa0, 0x6d, 0x40, 0x99, 0xf0, 0x98, 0x4d, 0xea, 0x0e, 0xc3, 0x1f, 0x14, 0x75, 0x26, 0x3b, 0x6d,
+	0x64, 0x84, 0x60, 0x54, 0x0f, 0x8a, 0x3d, 0xa8, 0x3b, 0x0c, 0x3d, 0x85, 0xc9, 0x96, 0x48, 0x84,
+	0xc0, 0xfc, 0xad, 0x6e, 0x31, 0xc7, 0x73, 0x61, 0x30, 0x01, 0xbb, 0x0d, 0x28, 0x13, 0x02, 0x93,
+	0x98, 0xbf, 0xdd, 0xb5, 0x9e, 0x7a, 0xe0, 0x4b, 0x00, 0x46, 0x70, 0x2b, 0x77, 0x66, 0x7e, 0xde,
+	0x74, 0x82, 0xc8, 0xf3, 0xd3, 0xfd, 0x2a, 0x21, 0xc2, 0x3f, 0xe9, 0x7c, 0x8e, 0xbe, 0x91, 0x39,
+	0x47, 0x3f, 0x51, 0x58, 0x9b, 0x59, 0xf3, 0xa0, 0xdf, 0x85, 0xdb, 0xed, 0x58, 0x8b, 0xac, 0x86,
+	0x3f, 0xec, 0x4c, 0x7c, 0xb3, 0x90, 0xf8, 0x61, 0x8b, 0x0d, 0xcb, 0x7a, 0xdc, 0x62, 0x5a, 0xbc,
+	0xd6, 0x6b, 0x8f, 0xad, 0xac, 0xa1, 0xd1, 0x1f, 0x18, 0x70, 0xbb, 0x46, 0x9b, 0x81, 0xbe, 0x0b,
+	0xd7, 0xfc, 0xa6, 0xc0, 0xe4, 0xe7, 0x14, 0xa9, 0x89, 0x72, 0x76, 0x46, 0x22, 0xbc, 0x2e, 0x98,
+	0xcd, 0xb2, 0x31, 0x7d, 0xea, 0x05, 0xd1, 0x7b, 0xef, 0x58, 0xaf, 0x0b, 0xdc, 0x64, 0xd3, 0x8d,
+	0x51, 0x37, 0x03, 0xd1, 0x5a, 0xfa, 0xfa, 0x2e, 0x89, 0xd0, 0xb7, 0x06, 0x2c, 0x4b, 0x15, 0x32,
+	0x5b, 0x5a, 0xa9, 0x16, 0x1b, 0x5d, 0x6b, 0x71, 0x4b, 0x40, 0xeb, 0x3b, 0x58, 0x5b, 0x45, 0x1e,
+	0xc2, 0x88, 0xa2, 0x50, 0xb6, 0x60, 0xf8, 0x7e, 0x37, 0x51, 0xf2, 0xcb, 0x52, 0x4a, 0xf5, 0x94,
+	0xa1, 0x2d, 0x40, 0x0a, 0x27, 0xed, 0x10, 0xc3, 0x0f, 0xba, 0x81, 0x1a, 0x93, 0x82, 0xa9, 0xba,
+	0xfc, 0xbc, 0x8f, 0xd5, 0x92, 0xd3, 0xea, 0xf8, 0x0f, 0xbb, 0x9d, 0x60, 0x93, 0x12, 0x22, 0xef,
+	0xef, 0x3f, 0x83, 0x39, 0x85, 0x5d, 0xe8, 0xde, 0x8f, 0xba, 0x85, 0x57, 0x1a, 0x16, 0x78, 0x75,
+	0x6a, 0xd3, 0xe4, 0xa6, 0xf4, 0xd1, 0x4b, 0xd8, 0x54, 0xbb, 0xf5, 0xc4, 0x56, 0x68, 0x9d, 0x0c,
+	0x9b, 0x2f, 0x69, 0x85, 0xfc, 0x1c, 0x78, 0x02, 0x43, 0x99, 0x4b, 0xe4, 0xc7, 0x02, 0xaf, 0x5a,
+	0x88, 0x97, 0x5c, 0x72, 0xf2, 0x77, 0x54, 0x6b, 0xd0, 0xd7, 0xee, 0x8f, 0xbf, 0x0f, 0x8b, 0x2a,
+	0x2a, 0xdb, 0xac, 0xdb, 0x85, 0x97, 0x7f, 0x86, 0x3f, 0x39, 0xdf, 0x8d, 0x71, 0x5e, 0x46, 0x6c,
+	0x9b, 0xf5, 0x9d, 0xd6, 0x08, 0x01, 0x43, 0x8c, 0x3f, 0x1a, 0x88, 0xbb, 0x6a, 0xad, 0x19, 0x86,
+	0x24, 0x88, 0xe4, 0x76, 0x6b, 0xc7, 0x7d, 0xc2, 0x5b, 0x3d, 0x06, 0x8a, 0x67, 0x25, 0xec, 0x86,
+	0x44, 0x15, 0x9b, 0xdb, 0x03, 0x85, 0x89, 0x6e, 0xc1, 0xe8, 0xe9, 0xb1, 0x17, 0x11, 0xdf, 0xe3,
+	0x1b, 0xa4, 0x5b, 0xf7, 0x02, 0x86, 0x3f, 0x15, 0x6f, 0x70, 0x23, 0x49, 0xf9, 0x9a, 0x28, 0xe6,
+	0x4d, 0x8f, 0x7c, 0x7a, 0xe0, 0xf8, 0x76, 0x52, 0x83, 0x1f, 0xcb, 0xa6, 0xb2, 0x7c, 0x3f, 0x2e,
+	0x46, 0xef, 0xc1, 0xb4, 0x34, 0x5c, 0x2d, 0x24, 0x4e, 0x44, 0x43, 0x4d, 0x62, 0x5b, 0x48, 0xc8,
+	0xc3, 0xd8, 0x86, 0xac, 0x4d, 0xe5, 0x76, 0x61, 0x4a, 0x7f, 0x37, 0xd3, 0xc4, 0x76, 0xba, 0xf1,
+	0xc0, 0x09, 0xed, 0x01, 0x2d, 0x05, 0x7d, 0x1a, 0x2b, 0x13, 0xbb, 0x61, 0x8a, 0xfa, 0xa4, 0x1b,
+	0xd4, 0x49, 0xfd, 0x45, 0x2d, 0x85, 0xdd, 0x80, 0x85, 0x62, 0x5d, 0x6d, 0x12, 0xf0, 0xa7, 0x40,
+	0x17, 0x5b, 0xe2, 0x75, 0x70, 0xae, 0x48, 0xa9, 0x07, 0xb2, 0x09, 0x7a, 0x00, 0x57, 0xdb, 0xe8,
+	0x96, 0xa0, 0xec, 0x0a, 0x94, 0xf9, 0x42, 0x25, 0x62, 0x98, 0x27, 0x30, 0x25, 0xce, 0x81, 0x75,
+	0xe2, 0x7a, 0x4e, 0xa0, 0x47, 0x99, 0xf6, 0x3a, 0xef, 0x3a, 0x13, 0x5c, 0xf4, 0x53, 0x21, 0xb9,
+	0xa9, 0x07, 0x05, 0x2f, 0xd7, 0x1d, 0x57, 0x87, 0x7a, 0xda, 0x19, 0x6a, 0xb8, 0xee, 0xb8, 0x1a,
+	0xc6, 0x67, 0x30, 0xe3, 0x05, 0x5e, 0xe4, 0x39, 0x7e, 0xb2, 0x10, 0x92, 0xba, 0x23, 0x97, 0x01,
+	0xfc, 0x59, 0x67, 0xb8, 0x29, 0x25, 0x2d, 0x21, 0xc3, 0x07, 0x75, 0x47, 0x2c, 0x00, 0xe8, 0xa7,
+	0x30, 0x1f, 0xe3, 0x6a, 0x8b, 0x60, 0x0a, 0xbd, 0xdf, 0x19, 0x3a, 0x56, 0x2c, 0x5d, 0xff, 0x12,
+	0x74, 0x4d, 0xeb, 0x78, 0x54, 0x52, 0xe8, 0xcf, 0xbb, 0xd7, 0x5a, 0x0d, 0x56, 0x82, 0xfb, 0x1e,
+	0x4c, 0xc7, 0xf3, 0x27, 0xef, 0xdd, 0xbf, 0x23, 0x27, 0x85, 0x9a, 0x46, 0x39, 0xff, 0xbd, 0x07,
+	0x58, 0xc9, 0xb5, 0x3a, 0xf0, 0x17, 0x42, 0x70, 0x4a, 0xd6, 0xb7, 0xb8, 0xe8, 0x3b, 0xa0, 0x6a,
+	0xe4, 0xcc, 0xd6, 0xe4, 0xbe, 0x14, 0x72, 0x13, 0xb2, 0x56, 0xcc, 0xef, 0x54, 0xca, 0x86, 0xc9,
+	0x06, 0x09, 0x5c, 0x7e, 0xde, 0x97, 0xbe, 0xd9, 0x6c, 0xb8, 0x4e, 0x44, 0x18, 0xfe, 0xa9, 0xe8,
+	0xfb, 0x9b, 0xed, 0xfa, 0xbe, 0xdd, 0x88, 0x3c, 0x1a, 0x38, 0xbe, 0x28, 0x91, 0x6f, 0xfe, 0xd6,
+	0xb8, 0x42, 0x12, 0x65, 0x4f, 0x25, 0x0e, 0xba, 0x09, 0x23, 0x4e, 0x58, 0x3b, 0xf6, 0x4e, 0x88,
+	0x1b, 0x9f, 0xf5, 0xbf, 0x12, 0x4e, 0x7e, 0x39, 0x2e, 0x56, 0x67, 0xf8, 0xbb, 0x80, 0x73, 0x0d,
+	0xf9, 0x69, 0xbe, 0x11, 0x36, 0x03, 0x82, 0xbf, 0x16, 0x12, 0x93, 0x59, 0x89, 0x3d, 0xba, 0xc3,
+	0x2b, 0xd1, 0x87, 0x20, 0xdf, 0xd2, 0x6d, 0x7a, 0x1a, 0xf0, 0xfd, 0xcb, 0xee, 0x66, 0x9e, 0xcb,
+	0x17, 0xb4, 0x6d, 0x21, 0x81, 0xb6, 0x61, 0x22, 0x6b, 0x04, 0x85, 0xf4, 0xb3, 0x6e, 0x90, 0x90,
+	0xde, 0x6b, 0x05, 0xf8, 0x3e, 0xc8, 0x17, 0x37, 0x3b, 0x72, 0x8e, 0x18, 0x76, 0xca, 0xdd, 0x68,
+	0xcf, 0x39, 0xb2, 0x06, 0xe4, 0xd5, 0xc9, 0x39, 0x62, 0xe8, 0x53, 0x98, 0x8c, 0x87, 0xfe, 0x28,
+	0xa4, 0xcd, 0xc0, 0xb5, 0xa3, 0xb0, 0x19, 0x1d, 0x33, 0x7c, 0x20, 0x60, 0x66, 0x74, 0x98, 0xd5,
+	0xea, 0x23, 0xd1, 0x64, 0x8f, 0xb7, 0xb0, 0xc6, 0x95, 0x9c, 0x56, 0xc6, 0x73, 0x02, 0xa6, 0x6b,
+	0x34, 0x60, 0x24, 0x60, 0x4d, 0x96, 0x03, 0xac, 0x75, 0x02, 0x9c, 0x4c, 0x24, 0x33, 0x90, 0xfb,
+	0x30, 0xa5, 0x9c, 0xba, 0xe1, 0x9c, 0xf9, 0xd4, 0x71, 0xd5, 0x0d, 0x9a, 0x61, 0xb7, 0x75, 0xa7,
+	0x5f, 0xad, 0x4a, 0x17, 0xdf, 0x91, 0x4d, 0xe5, 0x0d, 0xd9, 0x9a, 0x38, 0x6d, 0x2d, 0x64, 0x68,
+	0x53, 0x1c, 0x46, 0x68, 0x68, 0xd3, 0x06, 0x09, 0xc5, 0xcb, 0x19, 0x26, 0x02, 0x71, 0xb1, 0x68,
+	0x08, 0x78, 0xcb, 0x6d, 0xd5, 0x50, 0x9c, 0x47, 0xd2, 0x4f, 0x86, 0x7e, 0x0c, 0x43, 0x4a, 0xc7,
+	0x03, 0x1a, 0xb8, 0x0c, 0x1f, 0x0a, 0x1c, 0x5c, 0xa4, 0xd9, 0x3a, 0x0d, 0x5c, 0x6b, 0xf0, 0x34,
+	0xf9, 0x9f, 0x5f, 0xc2, 0x26, 0x34, 0xe1, 0xe4, 0x85, 0x07, 0x1f, 0xb5, 0xde, 0x85, 0x33, 0x20,
+	0xda, 0x2b, 0x12, 0x3a, 0xcd, 0x17, 0x33, 0xe4, 0xc1, 0x7c, 0x1a, 0x72, 0x88, 0x87, 0xc4, 0x25,
+	0x27, 0x9e, 0xc3, 0xe7, 0x12, 0xc3, 0xc7, 0xad, 0x6f, 0x66, 0xab, 0xd5, 0x24, 0xb2, 0xa0, 0x04,
+	0xee, 0xc7, 0xed, 0xf9, 0x4e, 0xdf, 0xa6, 0x8a, 0xe7, 0x93, 0x0c, 0x87, 0x44, 0x3d, 0x27, 0x09,
+	0x6c, 0xaf, 0x35, 0xf0, 0xcc, 0xb1, 0xd3, 0x26, 0x42, 0xeb, 0xac, 0x10, 0xda, 0x07, 0xec, 0x34,
+	0x23, 0xca, 0x87, 0xb7, 0x21, 0x1c, 0x27, 0x7d, 0x1b, 0xc5, 0xdf, 0x74, 0x33, 0x37, 0xa6, 0xb8,
+	0xf8, 0x86, 0x92, 0x4e, 0xdf, 0x43, 0xd1, 0x0e, 0x8c, 0x67, 0x82, 0x2f, 0x12, 0x01, 0x3f, 0x6b,
+	0x7d, 0xfe, 0xd4, 0x0c, 0x10, 0xb7, 0xb3, 0x50, 0x98, 0x2f, 0x62, 0xe8, 0x1e, 0x0c, 0xca, 0xe7,
+	0x38, 0x7e, 0xe0, 0x61, 0xd8, 0x17, 0x48, 0xd3, 0x59, 0x24, 0x11, 0xa8, 0xdf, 0xa2, 0xb5, 0x67,
+	0x16, 0xb0, 0xf8, 0x5f, 0x86, 0xd6, 0xe0, 0xb2, 0x3a, 0x89, 0x1d, 0x7b, 0x2c, 0xa2, 0xe1, 0x19,
+	0xae, 0x0b, 0xe1, 0xd9, 0xbc, 0x1a, 0xbc, 0x8d, 0x45, 0x6a, 0x34, 0x74, 0xb9, 0x9d, 0xf8, 0xd7,
+	0x47, 0x52, 0x40, 0x83, 0x20, 0xac, 0x16, 0xf2, 0x50, 0x4f, 0xd0, 0x1e, 0xe2, 0x81, 0x68, 0x12,
+	0x43, 0xc8, 0x2f, 0x86, 0xf6, 0x60, 0xf2, 0x79, 0x93, 0x34, 0x45, 0x50, 0x5f, 0x20, 0x35, 0x9c,
+	0x33, 0xda, 0x8c, 0x18, 0xa6, 0xad, 0x13, 0x60, 0xb5, 0xfa, 0x44, 0x34, 0x55, 0xaf, 0xbf, 0xa2,
+	0xa1, 0x35, 0xfe, 0xbc, 0xa5, 0x8c, 0xa1, 0x3b, 0x30, 0x29, 0x82, 0x3b, 0x19, 0x4c, 0x9e, 0x4f,
+	0xd5, 0x10, 0xf9, 0x54, 0x88, 0x57, 0xea, 0x12, 0x9b, 0x2e, 0xfa, 0x39, 0xdc, 0xcc, 0x2e, 0xc3,
+	0xb6, 0xf6, 0x5c, 0x2d, 0x1c, 0x99, 0x9f, 0x58, 0x19, 0x77, 0x81, 0xe7, 0x25, 0x6f, 0x90, 0xf9,
+	0xe7, 0x4e, 0xeb, 0x7a, 0x66, 0xf1, 0xbe, 0x9f, 0x3c, 0x60, 0x0b, 0xc8, 0x0d, 0x89, 0x88, 0x1c,
+	0x98, 0x77, 0x89, 0xe3, 0xda, 0x3e, 0x89, 0x22, 0x12, 0xb6, 0x1a, 0x23, 0xec, 0xd2, 0x18, 0x33,
+	0x1c, 0x65, 0x4b, 0x81, 0xe8, 0x35, 0xec, 0xe3, 0xbe, 0xfe, 0xe1, 0xd1, 0xcb, 0x1f, 0xf7, 0xf5,
+	0x5f, 0x1e, 0x1d, 0xf9, 0xb8, 0xaf, 0x7f, 0x64, 0x74, 0x34, 0xf7, 0x24, 0x11, 0xe7, 0xf6, 0xc8,
+	0x69, 0xdd, 0xfa, 0x72, 0x90, 0xab, 0x9f, 0x97, 0xf5, 0x56, 0x36, 0x31, 0x48, 0x7d, 0x9a, 0xfb,
+	0x30, 0x92, 0xcb, 0x43, 0x43, 0x33, 0xd0, 0x9f, 0x64, 0xb5, 0x19, 0x62, 0x14, 0x2e, 0x45, 0xb2,
+	0x09, 0xba, 0x05, 0xaf, 0x8a, 0x7f, 0xf1, 0x05, 0x91, 0x58, 0x37, 0x9e, 0xed, 0xa6, 0x10, 0xb7,
+	0x64, 0x0b, 0xb3, 0x09, 0x57, 0x4a, 0xf7, 0xe2, 0x32, 0x9a, 0x1f, 0x25, 0x09, 0x7c, 0x92, 0x27,
+	0xb7, 0x5c, 0x17, 0xed, 0xec, 0x4a, 0xc0, 0xfc, 0x00, 0x86, 0x33, 0xfb, 0x56, 0x19, 0xcd, 0x28,
+	0x54, 0x22, 0xe7, 0x48, 0x70, 0x0c, 0x58, 0xfc, 0x5f, 0xf3, 0x11, 0x8c, 0xe4, 0x16, 0x88, 0x32,
+	0xf9, 0x19, 0xe8, 0x97, 0x9b, 0x81, 0xe7, 0x2a, 0x90, 0x4b, 0x8e, 0x94, 0x32, 0x7d, 0x98, 0x6e,
+	0xe3, 0x66, 0x65, 0x80, 0xf3, 0x30, 0x90, 0x26, 0xa4, 0x48, 0xc4, 0xb4, 0x00, 0x61, 0xb8, 0x14,
+	0x67, 0x7a, 0x54, 0x24, 0x9b, 0xfa, 0x34, 0x1d, 0x40, 0xad, 0x1b, 0x4e, 0x19, 0xd1, 0x04, 0xbc,
+	0xea, 0xd4, 0x52, 0x12, 0xf9, 0x81, 0x66, 0xa1, 0x3f, 0xde, 0xd6, 0x14, 0x43, 0xf2, 0x6d, 0x3e,
+	0x85, 0xc9, 0xc2, 0x90, 0x6a, 0x19, 0xcb, 0x35, 0x18, 0x92, 0x57, 0xc6, 0x63, 0xd1, 0x54, 0x90,
+	0x55, 0xac, 0xc1, 0x83, 0x54, 0xda, 0xfc, 0x1c, 0xa6, 0x8a, 0xc3, 0xcb, 0x2d, 0xc2, 0x46, 0x8b,
+	0x30, 0x9a, 0x83, 0x81, 0x98, 0x5a, 0x66, 0x6a, 0xf6, 0x59, 0xfd, 0x8a, 0x9b, 0x99, 0xdf, 0x1a,
+	0x80, 0xdb, 0xa5, 0xc9, 0x9d, 0x4f, 0x69, 0x74, 0x1b, 0x2e, 0xca, 0x2c, 0x3d, 0x5c, 0x69, 0xcd,
+	0x2f, 0x5d, 0xad, 0x4a, 0x0e, 0x4b, 0xb5, 0x31, 0x4f, 0x60, 0xbc, 0x20, 0x92, 0xd0, 0x9b, 0x5f,
+	0xf1, 0x09, 0x28, 0x0f, 0xfe, 0x95, 0xa2, 0x09, 0x28, 0x90, 0x2d, 0xd9, 0xc2, 0x74, 0x61, 0xa2,
+	0x28, 0x9c, 0xd5, 0x23, 0xf1, 0x14, 0x5c, 0x6c, 0x0a, 0x79, 0x95, 0xe8, 0xaa, 0xbe, 0xcc, 0x7f,
+	0x32, 0xc0, 0xec, 0x9c, 0x28, 0xd8, 0x23, 0xe9, 0x3e, 0x4c, 0x16, 0xe7, 0x2b, 0xca, 0xde, 0x9b,
+	0xd9, 0xde, 0x17, 0x11, 0x5b, 0x13, 0x7e, 0x41, 0xa9, 0xf9, 0x67, 0x06, 0x8c, 0xb5, 0x24, 0x2d,
+	0xf5, 0xa8, 0xe4, 0x26, 0x54, 0x5c, 0x52, 0xc3, 0x95, 0xf3, 0xc5, 0x62, 0x38, 0x86, 0x19, 0xe8,
+	0x8b, 0xd7, 0x66, 0xb9, 0xd9, 0xd6, 0xa1, 0xe2, 0x05, 0xd2, 0x3d, 0x7b, 0x09, 0xc2, 0x70, 0x61,
+	0xf3, 0x4f, 0x5a, 0xcc, 0xb0, 0xd9, 0xf3, 0x58, 0x29, 0x7d, 0x2a, 0xe7, 0xd1, 0xe7, 0xdf, 0x0c,
+	0xb8, 0xd1, 0x4d, 0x82, 0xdc, 0xff, 0xc3, 0x1a, 0xca, 0x03, 0x0c, 0x49, 0x33, 0x1e, 0x1e, 0xa0,
+	0xb8, 0x6f, 0xd1, 0xc8, 0x5f, 0x8a, 0x56, 0xab, 0x19, 0x3d, 0xac, 0x61, 0x57, 0xff, 0x34, 0xff,
+	0xc3, 0x80, 0x5b, 0x5d, 0x67, 0xac, 0x75, 0xb3, 0xc2, 0xe9, 0xfd, 0xbc, 0x90, 0xed, 0x67, 0xfb,
+	0x9e, 0x6c, 0x01, 0xca, 0xe4, 0x7d, 0xe9, 0xbd, 0x59, 0x28, 0x38, 0x6f, 0xea, 0x97, 0x82, 0x51,
+	0x96, 0x2b, 0x31, 0x23, 0x98, 0x69, 0x9b, 0x14, 0x97, 0xf1, 0x07, 0x23, 0xeb, 0x0f, 0x25, 0xaa,
+	0xe7, 0x3b, 0x5e, 0x69, 0xdd, 0x17, 0xfe, 0xf2, 0x02, 0xbc, 0xdf, 0x7b, 0x86, 0xdc, 0x39, 0x4d,
+	0x9b, 0x71, 0xa1, 0x4a, 0x89, 0x0b, 0xf5, 0x65, 0x0d, 0x4f, 0x60, 0xae, 0x38, 0xf3, 0x4e, 0x8e,
+	0xc0, 0xab, 0x8b, 0x46, 0x3e, 0x8b, 0x6c, 0xb5, 0xda, 0xae, 0x0b, 0x16, 0x76, 0xdb, 0xd4, 0x98,
+	0x7f, 0x65, 0x80, 0xd9, 0x39, 0x57, 0x2f, 0xdb, 0x0b, 0xa3, 0xa4, 0x17, 0x17, 0xb2, 0xbd, 0xd0,
+	0x0d, 0x53, 0x29, 0x1f, 0xb8, 0xbe, 0xd6, 0x81, 0xfb, 0xd6, 0x48, 0x4e, 0x3e, 0xf9, 0x24, 0xbf,
+	0x1e, 0x17, 0x96, 0x77, 0x61, 0x20, 0x89, 0xfa, 0xa9, 0x85, 0x3f, 0x77, 0x6b, 0x4a, 0x18, 0xac,
+	0xb4, 0xa5, 0xf9, 0x87, 0x06, 0x4c, 0x15, 0xe7, 0x09, 0xf6, 0xa8, 0xc7, 0x0a, 0xf4, 0xc7, 0xd1,
+	0x3d, 0xa5, 0xc6, 0x54, 0x56, 0x8d, 0x18, 0xdf, 0x4a, 0xda, 0x99, 0x3f, 0x07, 0xdc, 0x2e, 0x4d,
+	0x10, 0x2d, 0xc0, 0xa0, 0xef, 0x1d, 0xd8, 0x8d, 0x95, 0x86, 0xfd, 0x8c, 0x9c, 0xc5, 0x23, 0xe4,
+	0xc7, 0xcd, 0xd1, 0x4f, 0x60, 0x98, 0xaa, 0xf6, 0x76, 0x40, 0x5d, 0xa2, 0xce, 0xc2, 0xb9, 0x1b,
+	0x9b, 0x0e, 0x69, 0x0d, 0x51, 0xed, 0xcb, 0x6c, 0xea, 0xbb, 0x49, 0x87, 0xfd, 0x4d, 0x6d, 0x62,
+	0x17, 0x7e, 0x80, 0x4d, 0xec, 0xcf, 0x0d, 0x98, 0x2f, 0xcb, 0x1b, 0x3c, 0xe7, 0xe1, 0xeb, 0x5e,
+	0x26, 0xc9, 0x54, 0x0e, 0x04, 0x6e, 0xe3, 0x0f, 0x4c, 0xcf, 0x2b, 0x35, 0xff, 0xd4, 0x80, 0xb9,
+	0x92, 0xf4, 0xc3, 0x73, 0xea, 0xf5, 0xae, 0x9e, 0xb5, 0x5a, 0xe8, 0xa6, 0x09, 0x93, 0x96, 0xa8,
+	0x6a, 0xfe, 0xab, 0x01, 0xaf, 0x75, 0x95, 0xc0, 0x78, 0x4e, 0xf5, 0x9e, 0xa6, 0x31, 0xbb, 0x13,
+	0x8e, 0x9a, 0x3c, 0x55, 0x55, 0x8a, 0x6e, 0x58, 0x05, 0xfc, 0x49, 0xec, 0x4e, 0x2f, 0x34, 0xff,
+	0xc6, 0x80, 0x85, 0xf2, 0xe4, 0xc8, 0x73, 0xea, 0xfd, 0x01, 0x0c, 0xe9, 0xfa, 0x2a, 0x75, 0x73,
+	0x11, 0xc1, 0xcc, 0x23, 0xda, 0x49, 0xfa, 0x61, 0x7e, 0x09, 0xa3, 0xf9, 0xd4, 0xc5, 0x32, 0x7d,
+	0x6e, 0xc3, 0x45, 0x95, 0xa8, 0x78, 0xa1, 0xe8, 0x60, 0x2f, 0x01, 0x2c, 0xd5, 0xc6, 0xfc, 0x65,
+	0xda, 0xf7, 0x36, 0xb9, 0x8b, 0x65, 0x5c, 0x9f, 0xc3, 0x54, 0xfa, 0xa3, 0x18, 0x21, 0x63, 0x67,
+	0xb8, 0xcd, 0xc2, 0x11, 0xc9, 0xc0, 0x27, 0xbf, 0xf6, 0xc9, 0x94, 0x9a, 0x7f, 0xa7, 0xe9, 0x55,
+	0x9c, 0xfa, 0xd4, 0xe3, 0x0a, 0xf8, 0x09, 0x8c, 0x45, 0x69, 0x96, 0x91, 0xf4, 0x23, 0x5c, 0x29,
+	0x3a, 0x57, 0x68, 0xc9, 0x48, 0x82, 0xd0, 0x1a, 0x8d, 0x72, 0x25, 0xe6, 0xbf, 0xa7, 0x8e, 0x5f,
+	0x9e, 0xa7, 0x55, 0xa6, 0xec, 0x1c, 0x0c, 0xc4, 0xca, 0xde, 0x51, 0xda, 0xf6, 0x2b, 0x6d, 0xef,
+	0xe8, 0x95, 0x2b, 0xf1, 0x7d, 0x56, 0x55, 0xae, 0x14, 0xf7, 0xa5, 0xaf, 0xc7, 0xbe, 0x68, 0x4b,
+	0x5e, 0x61, 0x1a, 0x56, 0x59, 0x17, 0xbe, 0x84, 0x69, 0x5d, 0x11, 0xa9, 0xb1, 0xfc, 0x59, 0x9c,
+	0x74, 0x84, 0xeb, 0x6d, 0xd5, 0xc9, 0x64, 0x93, 0x15, 0x15, 0x9b, 0x75, 0x18, 0x2f, 0xc8, 0x50,
+	0xea, 0xe6, 0x78, 0xb4, 0x9a, 0xbd, 0x5b, 0x17, 0x6c, 0x77, 0x31, 0x9a, 0x76, 0xe7, 0xfe, 0x23,
+	0x03, 0xe6, 0xcb, 0x92, 0x95, 0xba, 0x21, 0x5e, 0x87, 0x21, 0xfd, 0x91, 0x5b, 0x71, 0x5f, 0x2d,
+	0xe4, 0x4e, 0x91, 0xd5, 0x6b, 0x89, 0x2c, 0x30, 0xff, 0xc1, 0x80, 0xc5, 0x4e, 0xaf, 0xed, 0x65,
+	0x63, 0xd2, 0xfe, 0x70, 0x64, 0xc1, 0x44, 0xd1, 0x32, 0xaa, 0x66, 0xc1, 0x62, 0xa7, 0x55, 0x34,
+	0x09, 0x0c, 0x6b, 0x65, 0xeb, 0xd6, 0xaf, 0xbe, 0x5b, 0x30, 0x7e, 0xfd, 0xdd, 0x82, 0xf1, 0x3f,
+	0xdf, 0x2d, 0x18, 0xbf, 0xf8, 0x7e, 0xe1, 0x95, 0x5f, 0x7f, 0xbf, 0xf0, 0xca, 0x7f, 0x7d, 0xbf,
+	0xf0, 0xca, 0x17, 0xf7, 0xba, 0xdc, 0x80, 0x5f, 0x2c, 0xa7, 0xbf, 0x99, 0x15, 0xbf, 0xb2, 0x3d,
+	0xb8, 0x28, 0x7e, 0x1a, 0xbb, 0xfa, 0x7f, 0x03, 0x00, 0x0c, 0x32, 0xfc, 0xda, 0x77, 0x3c, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -2967,43 +3443,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.GlobalAdminWhitelist) > 0 {
-		for iNdEx := len(m.GlobalAdminWhitelist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GlobalAdminWhitelist[iNdEx])
-			copy(dAtA[i:], m.GlobalAdminWhitelist[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.GlobalAdminWhitelist[iNdEx])))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xda
-		}
-	}
-	if len(m.GlobalReputerWhitelist) > 0 {
-		for iNdEx := len(m.GlobalReputerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GlobalReputerWhitelist[iNdEx])
-			copy(dAtA[i:], m.GlobalReputerWhitelist[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.GlobalReputerWhitelist[iNdEx])))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xd2
-		}
-	}
-	if len(m.GlobalWorkerWhitelist) > 0 {
-		for iNdEx := len(m.GlobalWorkerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GlobalWorkerWhitelist[iNdEx])
-			copy(dAtA[i:], m.GlobalWorkerWhitelist[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.GlobalWorkerWhitelist[iNdEx])))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xca
-		}
-	}
-	if len(m.InitialReputerEmaScore) > 0 {
-		for iNdEx := len(m.InitialReputerEmaScore) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.DeadLetteredRewardPayouts) > 0 {
+		for iNdEx := len(m.DeadLetteredRewardPayouts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.InitialReputerEmaScore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.DeadLetteredRewardPayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3011,15 +3454,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x7
 			i--
-			dAtA[i] = 0xc2
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.InitialForecasterEmaScore) > 0 {
-		for iNdEx := len(m.InitialForecasterEmaScore) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ArchivedTopicDelegateRemovalCursors) > 0 {
+		for iNdEx := len(m.ArchivedTopicDelegateRemovalCursors) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.InitialForecasterEmaScore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ArchivedTopicDelegateRemovalCursors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3027,15 +3470,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x7
 			i--
-			dAtA[i] = 0xba
+			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.InitialInfererEmaScore) > 0 {
-		for iNdEx := len(m.InitialInfererEmaScore) - 1; iNdEx >= 0; iNdEx-- {
+	if m.NextRewardPayoutId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRewardPayoutId))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.QueuedRewardPayouts) > 0 {
+		for iNdEx := len(m.QueuedRewardPayouts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.InitialInfererEmaScore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.QueuedRewardPayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3043,15 +3493,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x6
 			i--
-			dAtA[i] = 0xb2
+			dAtA[i] = 0xfa
 		}
 	}
-	if len(m.MadInferences) > 0 {
-		for iNdEx := len(m.MadInferences) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RewardEscrows) > 0 {
+		for iNdEx := len(m.RewardEscrows) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MadInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RewardEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3059,15 +3509,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x6
 			i--
-			dAtA[i] = 0xaa
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.LastMedianInferences) > 0 {
-		for iNdEx := len(m.LastMedianInferences) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RewardHistory) > 0 {
+		for iNdEx := len(m.RewardHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LastMedianInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RewardHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3075,55 +3525,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x6
 			i--
-			dAtA[i] = 0xa2
-		}
-	}
-	if len(m.TopicReputerWhitelistEnabled) > 0 {
-		dAtA2 := make([]byte, len(m.TopicReputerWhitelistEnabled)*10)
-		var j1 int
-		for _, num := range m.TopicReputerWhitelistEnabled {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintGenesis(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.TopicWorkerWhitelistEnabled) > 0 {
-		dAtA4 := make([]byte, len(m.TopicWorkerWhitelistEnabled)*10)
-		var j3 int
-		for _, num := range m.TopicWorkerWhitelistEnabled {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA[i] = 0xea
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintGenesis(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0x92
 	}
-	if len(m.TopicReputerWhitelist) > 0 {
-		for iNdEx := len(m.TopicReputerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.StakeLocks) > 0 {
+		for iNdEx := len(m.StakeLocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicReputerWhitelist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.StakeLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3131,15 +3541,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5
+			dAtA[i] = 0x6
 			i--
-			dAtA[i] = 0x8a
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.TopicWorkerWhitelist) > 0 {
-		for iNdEx := len(m.TopicWorkerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerCommissions) > 0 {
+		for iNdEx := len(m.ReputerCommissions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicWorkerWhitelist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerCommissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3147,72 +3557,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0x82
-		}
-	}
-	if len(m.TopicCreatorWhitelist) > 0 {
-		for iNdEx := len(m.TopicCreatorWhitelist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TopicCreatorWhitelist[iNdEx])
-			copy(dAtA[i:], m.TopicCreatorWhitelist[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.TopicCreatorWhitelist[iNdEx])))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xfa
-		}
-	}
-	if len(m.GlobalWhitelist) > 0 {
-		for iNdEx := len(m.GlobalWhitelist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GlobalWhitelist[iNdEx])
-			copy(dAtA[i:], m.GlobalWhitelist[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.GlobalWhitelist[iNdEx])))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xf2
-		}
-	}
-	if len(m.WhitelistAdmins) > 0 {
-		for iNdEx := len(m.WhitelistAdmins) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.WhitelistAdmins[iNdEx])
-			copy(dAtA[i:], m.WhitelistAdmins[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.WhitelistAdmins[iNdEx])))
-			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x6
 			i--
-			dAtA[i] = 0xea
-		}
-	}
-	{
-		size := m.RewardCurrentBlockEmission.Size()
-		i -= size
-		if _, err := m.RewardCurrentBlockEmission.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4
-	i--
-	dAtA[i] = 0xe2
-	{
-		size := m.TotalSumPreviousTopicWeights.Size()
-		i -= size
-		if _, err := m.TotalSumPreviousTopicWeights.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			dAtA[i] = 0xda
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x4
-	i--
-	dAtA[i] = 0xda
-	if len(m.LossBundles) > 0 {
-		for iNdEx := len(m.LossBundles) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AutoCompoundDelegators) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegators) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AutoCompoundDelegators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3220,15 +3573,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x6
 			i--
 			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.LowestReputerScoreEma) > 0 {
-		for iNdEx := len(m.LowestReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LowestReputerScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3236,15 +3589,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x6
 			i--
 			dAtA[i] = 0xca
 		}
 	}
-	if len(m.ActiveReputers) > 0 {
-		for iNdEx := len(m.ActiveReputers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerConsensusDeviations) > 0 {
+		for iNdEx := len(m.ReputerConsensusDeviations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ActiveReputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerConsensusDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3252,15 +3605,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x6
 			i--
 			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.LowestForecasterScoreEma) > 0 {
-		for iNdEx := len(m.LowestForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.WorkerBondRemovals) > 0 {
+		for iNdEx := len(m.WorkerBondRemovals) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LowestForecasterScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.WorkerBondRemovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3268,15 +3621,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x6
 			i--
 			dAtA[i] = 0xba
 		}
 	}
-	if len(m.LowestInfererScoreEma) > 0 {
-		for iNdEx := len(m.LowestInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.WorkerBonds) > 0 {
+		for iNdEx := len(m.WorkerBonds) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LowestInfererScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.WorkerBonds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3284,15 +3637,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x6
 			i--
 			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.ActiveForecasters) > 0 {
-		for iNdEx := len(m.ActiveForecasters) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ActorOperators) > 0 {
+		for iNdEx := len(m.ActorOperators) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ActiveForecasters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ActorOperators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3300,15 +3653,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x6
 			i--
 			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.ActiveInferers) > 0 {
-		for iNdEx := len(m.ActiveInferers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.WorkerPayloadCommits) > 0 {
+		for iNdEx := len(m.WorkerPayloadCommits) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ActiveInferers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.WorkerPayloadCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3316,15 +3669,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x6
 			i--
 			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.CountForecasterInclusionsInTopicActiveSet) > 0 {
-		for iNdEx := len(m.CountForecasterInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ConsensusGroundTruths) > 0 {
+		for iNdEx := len(m.ConsensusGroundTruths) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CountForecasterInclusionsInTopicActiveSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ConsensusGroundTruths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3332,15 +3685,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x6
 			i--
 			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.CountInfererInclusionsInTopicActiveSet) > 0 {
-		for iNdEx := len(m.CountInfererInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerGroundTruths) > 0 {
+		for iNdEx := len(m.ReputerGroundTruths) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CountInfererInclusionsInTopicActiveSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerGroundTruths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3348,15 +3701,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x6
 			i--
 			dAtA[i] = 0x92
 		}
 	}
-	if len(m.PreviousTopicQuantileReputerScoreEma) > 0 {
-		for iNdEx := len(m.PreviousTopicQuantileReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicTags) > 0 {
+		for iNdEx := len(m.TopicTags) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousTopicQuantileReputerScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicTags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3364,15 +3717,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x6
 			i--
 			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.PreviousTopicQuantileForecasterScoreEma) > 0 {
-		for iNdEx := len(m.PreviousTopicQuantileForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingTopicOwners) > 0 {
+		for iNdEx := len(m.PendingTopicOwners) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousTopicQuantileForecasterScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingTopicOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3380,15 +3733,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x6
 			i--
 			dAtA[i] = 0x82
 		}
 	}
-	if len(m.PreviousTopicQuantileInfererScoreEma) > 0 {
-		for iNdEx := len(m.PreviousTopicQuantileInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicOwners) > 0 {
+		for iNdEx := len(m.TopicOwners) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousTopicQuantileInfererScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3396,47 +3749,55 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
 			dAtA[i] = 0xfa
 		}
 	}
-	if len(m.ReputerScoreEmas) > 0 {
-		for iNdEx := len(m.ReputerScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReputerScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if len(m.ArchivedTopicsToPrune) > 0 {
+		dAtA2 := make([]byte, len(m.ArchivedTopicsToPrune)*10)
+		var j1 int
+		for _, num := range m.ArchivedTopicsToPrune {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
 			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xf2
+			dAtA2[j1] = uint8(num)
+			j1++
 		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xf2
 	}
-	if len(m.ForecasterScoreEmas) > 0 {
-		for iNdEx := len(m.ForecasterScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ForecasterScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if len(m.ArchivedTopics) > 0 {
+		dAtA4 := make([]byte, len(m.ArchivedTopics)*10)
+		var j3 int
+		for _, num := range m.ArchivedTopics {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
 			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xea
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGenesis(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xea
 	}
-	if len(m.InfererScoreEmas) > 0 {
-		for iNdEx := len(m.InfererScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingTopicUpdates) > 0 {
+		for iNdEx := len(m.PendingTopicUpdates) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.InfererScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingTopicUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3444,63 +3805,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
 			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.BlockToLowestActiveTopicWeight) > 0 {
-		for iNdEx := len(m.BlockToLowestActiveTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlockToLowestActiveTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
+	if len(m.GlobalAdminWhitelist) > 0 {
+		for iNdEx := len(m.GlobalAdminWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GlobalAdminWhitelist[iNdEx])
+			copy(dAtA[i:], m.GlobalAdminWhitelist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.GlobalAdminWhitelist[iNdEx])))
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
 			dAtA[i] = 0xda
 		}
 	}
-	if len(m.BlockToActiveTopics) > 0 {
-		for iNdEx := len(m.BlockToActiveTopics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlockToActiveTopics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
+	if len(m.GlobalReputerWhitelist) > 0 {
+		for iNdEx := len(m.GlobalReputerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GlobalReputerWhitelist[iNdEx])
+			copy(dAtA[i:], m.GlobalReputerWhitelist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.GlobalReputerWhitelist[iNdEx])))
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
 			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.TopicToNextPossibleChurningBlock) > 0 {
-		for iNdEx := len(m.TopicToNextPossibleChurningBlock) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TopicToNextPossibleChurningBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
+	if len(m.GlobalWorkerWhitelist) > 0 {
+		for iNdEx := len(m.GlobalWorkerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GlobalWorkerWhitelist[iNdEx])
+			copy(dAtA[i:], m.GlobalWorkerWhitelist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.GlobalWorkerWhitelist[iNdEx])))
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
 			dAtA[i] = 0xca
 		}
 	}
-	if len(m.LastDripBlock) > 0 {
-		for iNdEx := len(m.LastDripBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.InitialReputerEmaScore) > 0 {
+		for iNdEx := len(m.InitialReputerEmaScore) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LastDripBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.InitialReputerEmaScore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3508,15 +3854,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
 			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.OpenWorkerWindows) > 0 {
-		for iNdEx := len(m.OpenWorkerWindows) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.InitialForecasterEmaScore) > 0 {
+		for iNdEx := len(m.InitialForecasterEmaScore) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.OpenWorkerWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.InitialForecasterEmaScore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3524,15 +3870,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
 			dAtA[i] = 0xba
 		}
 	}
-	if len(m.TopicLastReputerCommit) > 0 {
-		for iNdEx := len(m.TopicLastReputerCommit) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.InitialInfererEmaScore) > 0 {
+		for iNdEx := len(m.InitialInfererEmaScore) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicLastReputerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.InitialInfererEmaScore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3540,15 +3886,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
 			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.TopicLastWorkerCommit) > 0 {
-		for iNdEx := len(m.TopicLastWorkerCommit) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.MadInferences) > 0 {
+		for iNdEx := len(m.MadInferences) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicLastWorkerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.MadInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3556,15 +3902,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
 			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.LatestOneOutForecasterForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneOutForecasterForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LastMedianInferences) > 0 {
+		for iNdEx := len(m.LastMedianInferences) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestOneOutForecasterForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LastMedianInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3572,47 +3918,55 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
 			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.LatestOneOutForecasterInfererNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneOutForecasterInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LatestOneOutForecasterInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if len(m.TopicReputerWhitelistEnabled) > 0 {
+		dAtA6 := make([]byte, len(m.TopicReputerWhitelistEnabled)*10)
+		var j5 int
+		for _, num := range m.TopicReputerWhitelistEnabled {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
 			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x9a
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGenesis(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x9a
 	}
-	if len(m.LatestOneOutInfererForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneOutInfererForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LatestOneOutInfererForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if len(m.TopicWorkerWhitelistEnabled) > 0 {
+		dAtA8 := make([]byte, len(m.TopicWorkerWhitelistEnabled)*10)
+		var j7 int
+		for _, num := range m.TopicWorkerWhitelistEnabled {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
 			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x92
+			dAtA8[j7] = uint8(num)
+			j7++
 		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintGenesis(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x92
 	}
-	if len(m.LatestOneOutInfererInfererNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneOutInfererInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicReputerWhitelist) > 0 {
+		for iNdEx := len(m.TopicReputerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestOneOutInfererInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicReputerWhitelist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3620,15 +3974,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
 			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.LatestNaiveInfererNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestNaiveInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicWorkerWhitelist) > 0 {
+		for iNdEx := len(m.TopicWorkerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestNaiveInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicWorkerWhitelist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3636,79 +3990,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
 			dAtA[i] = 0x82
 		}
 	}
-	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneInForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LatestOneInForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
+	if len(m.TopicCreatorWhitelist) > 0 {
+		for iNdEx := len(m.TopicCreatorWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TopicCreatorWhitelist[iNdEx])
+			copy(dAtA[i:], m.TopicCreatorWhitelist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.TopicCreatorWhitelist[iNdEx])))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xfa
 		}
 	}
-	if len(m.LatestForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LatestForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
+	if len(m.GlobalWhitelist) > 0 {
+		for iNdEx := len(m.GlobalWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GlobalWhitelist[iNdEx])
+			copy(dAtA[i:], m.GlobalWhitelist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.GlobalWhitelist[iNdEx])))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.LatestInfererNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LatestInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
+	if len(m.WhitelistAdmins) > 0 {
+		for iNdEx := len(m.WhitelistAdmins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WhitelistAdmins[iNdEx])
+			copy(dAtA[i:], m.WhitelistAdmins[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.WhitelistAdmins[iNdEx])))
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xea
 		}
 	}
-	if len(m.UnfulfilledReputerNonces) > 0 {
-		for iNdEx := len(m.UnfulfilledReputerNonces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnfulfilledReputerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xe2
+	{
+		size := m.RewardCurrentBlockEmission.Size()
+		i -= size
+		if _, err := m.RewardCurrentBlockEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.UnfulfilledWorkerNonces) > 0 {
-		for iNdEx := len(m.UnfulfilledWorkerNonces) - 1; iNdEx >= 0; iNdEx-- {
+	i--
+	dAtA[i] = 0x4
+	i--
+	dAtA[i] = 0xe2
+	{
+		size := m.TotalSumPreviousTopicWeights.Size()
+		i -= size
+		if _, err := m.TotalSumPreviousTopicWeights.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4
+	i--
+	dAtA[i] = 0xda
+	if len(m.LossBundles) > 0 {
+		for iNdEx := len(m.LossBundles) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UnfulfilledWorkerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3716,27 +4063,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xda
-		}
-	}
-	{
-		size := m.PreviousPercentageRewardToStakedReputers.Size()
-		i -= size
-		if _, err := m.PreviousPercentageRewardToStakedReputers.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			dAtA[i] = 0xd2
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x2
-	i--
-	dAtA[i] = 0xd2
-	if len(m.NetworkLossBundles) > 0 {
-		for iNdEx := len(m.NetworkLossBundles) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LowestReputerScoreEma) > 0 {
+		for iNdEx := len(m.LowestReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.NetworkLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LowestReputerScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3744,15 +4079,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xca
 		}
 	}
-	if len(m.AllLossBundles) > 0 {
-		for iNdEx := len(m.AllLossBundles) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ActiveReputers) > 0 {
+		for iNdEx := len(m.ActiveReputers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ActiveReputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3760,15 +4095,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.AllForecasts) > 0 {
-		for iNdEx := len(m.AllForecasts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LowestForecasterScoreEma) > 0 {
+		for iNdEx := len(m.LowestForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllForecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LowestForecasterScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3776,15 +4111,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xba
 		}
 	}
-	if len(m.AllInferences) > 0 {
-		for iNdEx := len(m.AllInferences) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LowestInfererScoreEma) > 0 {
+		for iNdEx := len(m.LowestInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LowestInfererScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3792,15 +4127,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.PreviousTopicWeight) > 0 {
-		for iNdEx := len(m.PreviousTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ActiveForecasters) > 0 {
+		for iNdEx := len(m.ActiveForecasters) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ActiveForecasters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3808,15 +4143,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.TopicFeeRevenue) > 0 {
-		for iNdEx := len(m.TopicFeeRevenue) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ActiveInferers) > 0 {
+		for iNdEx := len(m.ActiveInferers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicFeeRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ActiveInferers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3824,15 +4159,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.Reputers) > 0 {
-		for iNdEx := len(m.Reputers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.CountForecasterInclusionsInTopicActiveSet) > 0 {
+		for iNdEx := len(m.CountForecasterInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Reputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CountForecasterInclusionsInTopicActiveSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3840,15 +4175,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.Workers) > 0 {
-		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.CountInfererInclusionsInTopicActiveSet) > 0 {
+		for iNdEx := len(m.CountInfererInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CountInfererInclusionsInTopicActiveSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3856,15 +4191,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0x92
 		}
 	}
-	if len(m.Forecasts) > 0 {
-		for iNdEx := len(m.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PreviousTopicQuantileReputerScoreEma) > 0 {
+		for iNdEx := len(m.PreviousTopicQuantileReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Forecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PreviousTopicQuantileReputerScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3872,15 +4207,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.Inferences) > 0 {
-		for iNdEx := len(m.Inferences) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PreviousTopicQuantileForecasterScoreEma) > 0 {
+		for iNdEx := len(m.PreviousTopicQuantileForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Inferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PreviousTopicQuantileForecasterScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3888,15 +4223,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0x82
 		}
 	}
-	if len(m.DelegateStakeRemovalsByActor) > 0 {
-		for iNdEx := len(m.DelegateStakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PreviousTopicQuantileInfererScoreEma) > 0 {
+		for iNdEx := len(m.PreviousTopicQuantileInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateStakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PreviousTopicQuantileInfererScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3904,15 +4239,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xfa
 		}
 	}
-	if len(m.DelegateStakeRemovalsByBlock) > 0 {
-		for iNdEx := len(m.DelegateStakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerScoreEmas) > 0 {
+		for iNdEx := len(m.ReputerScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateStakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3920,15 +4255,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.StakeRemovalsByActor) > 0 {
-		for iNdEx := len(m.StakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ForecasterScoreEmas) > 0 {
+		for iNdEx := len(m.ForecasterScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ForecasterScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3936,15 +4271,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xea
 		}
 	}
-	if len(m.StakeRemovalsByBlock) > 0 {
-		for iNdEx := len(m.StakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.InfererScoreEmas) > 0 {
+		for iNdEx := len(m.InfererScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.InfererScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3952,15 +4287,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.DelegateRewardPerShare) > 0 {
-		for iNdEx := len(m.DelegateRewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BlockToLowestActiveTopicWeight) > 0 {
+		for iNdEx := len(m.BlockToLowestActiveTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateRewardPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BlockToLowestActiveTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3968,15 +4303,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xda
 		}
 	}
-	if len(m.StakeFromDelegatorsUponReputer) > 0 {
-		for iNdEx := len(m.StakeFromDelegatorsUponReputer) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BlockToActiveTopics) > 0 {
+		for iNdEx := len(m.BlockToActiveTopics) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeFromDelegatorsUponReputer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BlockToActiveTopics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3984,15 +4319,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.DelegatedStakes) > 0 {
-		for iNdEx := len(m.DelegatedStakes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicToNextPossibleChurningBlock) > 0 {
+		for iNdEx := len(m.TopicToNextPossibleChurningBlock) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegatedStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicToNextPossibleChurningBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4000,15 +4335,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xca
 		}
 	}
-	if len(m.StakeSumFromDelegator) > 0 {
-		for iNdEx := len(m.StakeSumFromDelegator) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LastDripBlock) > 0 {
+		for iNdEx := len(m.LastDripBlock) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeSumFromDelegator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LastDripBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4016,15 +4351,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.StakeReputerAuthority) > 0 {
-		for iNdEx := len(m.StakeReputerAuthority) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.OpenWorkerWindows) > 0 {
+		for iNdEx := len(m.OpenWorkerWindows) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeReputerAuthority[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OpenWorkerWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4032,15 +4367,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xba
 		}
 	}
-	if len(m.TopicStake) > 0 {
-		for iNdEx := len(m.TopicStake) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicLastReputerCommit) > 0 {
+		for iNdEx := len(m.TopicLastReputerCommit) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicStake[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicLastReputerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4048,27 +4383,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xb2
 		}
 	}
-	{
-		size := m.TotalStake.Size()
-		i -= size
-		if _, err := m.TotalStake.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xaa
-	if len(m.PreviousForecasterScoreRatio) > 0 {
-		for iNdEx := len(m.PreviousForecasterScoreRatio) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicLastWorkerCommit) > 0 {
+		for iNdEx := len(m.TopicLastWorkerCommit) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousForecasterScoreRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicLastWorkerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4076,15 +4399,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xa2
+			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.PreviousForecastRewardFraction) > 0 {
-		for iNdEx := len(m.PreviousForecastRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneOutForecasterForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneOutForecasterForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousForecastRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneOutForecasterForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4092,15 +4415,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0x9a
+			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.PreviousInferenceRewardFraction) > 0 {
-		for iNdEx := len(m.PreviousInferenceRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneOutForecasterInfererNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneOutForecasterInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousInferenceRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneOutForecasterInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4108,15 +4431,31 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.LatestOneOutInfererForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneOutInfererForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatestOneOutInfererForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x92
 		}
 	}
-	if len(m.PreviousReputerRewardFraction) > 0 {
-		for iNdEx := len(m.PreviousReputerRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneOutInfererInfererNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneOutInfererInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousReputerRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneOutInfererInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4124,15 +4463,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.ReputerListeningCoefficient) > 0 {
-		for iNdEx := len(m.ReputerListeningCoefficient) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestNaiveInfererNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestNaiveInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ReputerListeningCoefficient[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestNaiveInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4140,15 +4479,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x82
 		}
 	}
-	if len(m.ReputerScoresByBlock) > 0 {
-		for iNdEx := len(m.ReputerScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneInForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ReputerScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneInForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4156,13 +4495,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x62
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xfa
 		}
 	}
-	if len(m.ForecasterScoresByBlock) > 0 {
-		for iNdEx := len(m.ForecasterScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ForecasterScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4170,13 +4511,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.InfererScoresByBlock) > 0 {
-		for iNdEx := len(m.InfererScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestInfererNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.InfererScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4184,13 +4527,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
 		}
 	}
-	if len(m.TopicRewardNonce) > 0 {
-		for iNdEx := len(m.TopicRewardNonce) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.UnfulfilledReputerNonces) > 0 {
+		for iNdEx := len(m.UnfulfilledReputerNonces) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicRewardNonce[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UnfulfilledReputerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4198,13 +4543,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.TopicReputers) > 0 {
-		for iNdEx := len(m.TopicReputers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.UnfulfilledWorkerNonces) > 0 {
+		for iNdEx := len(m.UnfulfilledWorkerNonces) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicReputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UnfulfilledWorkerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4212,13 +4559,27 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.TopicWorkers) > 0 {
-		for iNdEx := len(m.TopicWorkers) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size := m.PreviousPercentageRewardToStakedReputers.Size()
+		i -= size
+		if _, err := m.PreviousPercentageRewardToStakedReputers.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xd2
+	if len(m.NetworkLossBundles) > 0 {
+		for iNdEx := len(m.NetworkLossBundles) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicWorkers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.NetworkLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4226,49 +4587,47 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.RewardableTopics) > 0 {
-		dAtA6 := make([]byte, len(m.RewardableTopics)*10)
-		var j5 int
-		for _, num := range m.RewardableTopics {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
+	if len(m.AllLossBundles) > 0 {
+		for iNdEx := len(m.AllLossBundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			dAtA6[j5] = uint8(num)
-			j5++
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintGenesis(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x32
 	}
-	if len(m.ActiveTopics) > 0 {
-		dAtA8 := make([]byte, len(m.ActiveTopics)*10)
-		var j7 int
-		for _, num := range m.ActiveTopics {
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
+	if len(m.AllForecasts) > 0 {
+		for iNdEx := len(m.AllForecasts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllForecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintGenesis(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0x2a
 	}
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AllInferences) > 0 {
+		for iNdEx := len(m.AllInferences) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AllInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4276,415 +4635,521 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
 		}
 	}
-	if m.NextTopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTopicId))
-		i--
-		dAtA[i] = 0x18
+	if len(m.PreviousTopicWeight) > 0 {
+		for iNdEx := len(m.PreviousTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
 	}
-	if len(m.CoreTeamAddresses) > 0 {
-		for iNdEx := len(m.CoreTeamAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CoreTeamAddresses[iNdEx])
-			copy(dAtA[i:], m.CoreTeamAddresses[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CoreTeamAddresses[iNdEx])))
+	if len(m.TopicFeeRevenue) > 0 {
+		for iNdEx := len(m.TopicFeeRevenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicFeeRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
 		}
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Reputers) > 0 {
+		for iNdEx := len(m.Reputers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdAndTopic) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdAndTopic) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdAndTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Topic != nil {
-		{
-			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Forecasts) > 0 {
+		for iNdEx := len(m.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Forecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicAndActorId) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Inferences) > 0 {
+		for iNdEx := len(m.Inferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicAndActorId) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicAndActorId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.DelegateStakeRemovalsByActor) > 0 {
+		for iNdEx := len(m.DelegateStakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateStakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.DelegateStakeRemovalsByBlock) > 0 {
+		for iNdEx := len(m.DelegateStakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateStakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdAndBlockHeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.StakeRemovalsByActor) > 0 {
+		for iNdEx := len(m.StakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdAndBlockHeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdAndBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+	if len(m.StakeRemovalsByBlock) > 0 {
+		for iNdEx := len(m.StakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.DelegateRewardPerShare) > 0 {
+		for iNdEx := len(m.DelegateRewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateRewardPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BlockHeightAndTopicIds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.StakeFromDelegatorsUponReputer) > 0 {
+		for iNdEx := len(m.StakeFromDelegatorsUponReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeFromDelegatorsUponReputer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BlockHeightAndTopicIds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BlockHeightAndTopicIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TopicIds) > 0 {
-		dAtA12 := make([]byte, len(m.TopicIds)*10)
-		var j11 int
-		for _, num := range m.TopicIds {
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
+	if len(m.DelegatedStakes) > 0 {
+		for iNdEx := len(m.DelegatedStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			dAtA12[j11] = uint8(num)
-			j11++
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
 		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintGenesis(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdBlockHeightScores) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdBlockHeightScores) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdBlockHeightScores) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Scores != nil {
-		{
-			size, err := m.Scores.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.StakeSumFromDelegator) > 0 {
+		for iNdEx := len(m.StakeSumFromDelegator) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeSumFromDelegator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdActorIdScore) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdActorIdScore) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdActorIdScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Score != nil {
-		{
-			size, err := m.Score.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.StakeReputerAuthority) > 0 {
+		for iNdEx := len(m.StakeReputerAuthority) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeReputerAuthority[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdActorIdUint64) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdActorIdUint64) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdActorIdUint64) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Uint64 != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Uint64))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdActorIdListeningCoefficient) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdActorIdListeningCoefficient) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdActorIdListeningCoefficient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ListeningCoefficient != nil {
-		{
-			size, err := m.ListeningCoefficient.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.TopicStake) > 0 {
+		for iNdEx := len(m.TopicStake) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicStake[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdActorIdDec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdActorIdDec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdActorIdDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	{
-		size := m.Dec.Size()
+		size := m.TotalStake.Size()
 		i -= size
-		if _, err := m.Dec.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TotalStake.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if len(m.PreviousForecasterScoreRatio) > 0 {
+		for iNdEx := len(m.PreviousForecasterScoreRatio) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousForecasterScoreRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.PreviousForecastRewardFraction) > 0 {
+		for iNdEx := len(m.PreviousForecastRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousForecastRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.PreviousInferenceRewardFraction) > 0 {
+		for iNdEx := len(m.PreviousInferenceRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousInferenceRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.PreviousReputerRewardFraction) > 0 {
+		for iNdEx := len(m.PreviousReputerRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousReputerRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ReputerListeningCoefficient) > 0 {
+		for iNdEx := len(m.ReputerListeningCoefficient) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReputerListeningCoefficient[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ReputerScoresByBlock) > 0 {
+		for iNdEx := len(m.ReputerScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReputerScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ForecasterScoresByBlock) > 0 {
+		for iNdEx := len(m.ForecasterScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForecasterScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.InfererScoresByBlock) > 0 {
+		for iNdEx := len(m.InfererScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InfererScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TopicRewardNonce) > 0 {
+		for iNdEx := len(m.TopicRewardNonce) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicRewardNonce[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.TopicReputers) > 0 {
+		for iNdEx := len(m.TopicReputers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicReputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TopicWorkers) > 0 {
+		for iNdEx := len(m.TopicWorkers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicWorkers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RewardableTopics) > 0 {
+		dAtA10 := make([]byte, len(m.RewardableTopics)*10)
+		var j9 int
+		for _, num := range m.RewardableTopics {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintGenesis(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ActiveTopics) > 0 {
+		dAtA12 := make([]byte, len(m.ActiveTopics)*10)
+		var j11 int
+		for _, num := range m.ActiveTopics {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintGenesis(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NextTopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CoreTeamAddresses) > 0 {
+		for iNdEx := len(m.CoreTeamAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CoreTeamAddresses[iNdEx])
+			copy(dAtA[i:], m.CoreTeamAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CoreTeamAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
 
-func (m *TopicIdAndInt) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdAndTopic) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4694,26 +5159,28 @@ func (m *TopicIdAndInt) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdAndInt) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdAndTopic) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdAndInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdAndTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Int.Size()
-		i -= size
-		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Topic != nil {
+		{
+			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
@@ -4722,7 +5189,7 @@ func (m *TopicIdAndInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdInt) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdAndOptionalTopicParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4732,26 +5199,91 @@ func (m *TopicIdActorIdInt) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdInt) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdAndOptionalTopicParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdAndOptionalTopicParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Int.Size()
-		i -= size
-		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdAndTag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndTag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicAndActorId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicAndActorId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicAndActorId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.ActorId) > 0 {
 		i -= len(m.ActorId)
 		copy(dAtA[i:], m.ActorId)
@@ -4767,7 +5299,7 @@ func (m *TopicIdActorIdInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdDelegatorReputer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4777,28 +5309,16 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdDelegatorReputer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdDelegatorReputer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DelegatorInfo != nil {
-		{
-			size, err := m.DelegatorInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
 	if len(m.Reputer) > 0 {
 		i -= len(m.Reputer)
 		copy(dAtA[i:], m.Reputer)
@@ -4821,7 +5341,7 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
+func (m *TopicActorOperator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4831,49 +5351,39 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicActorOperator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicActorOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.StakeRemovalInfo != nil {
-		{
-			size, err := m.StakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Operator)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Actor)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ActorIdTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdAndBlockHeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4883,12 +5393,12 @@ func (m *ActorIdTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActorIdTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdAndBlockHeight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActorIdTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdAndBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4896,24 +5406,17 @@ func (m *ActorIdTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	if m.BlockHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x10
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
+func (m *BlockHeightAndTopicIds) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4923,46 +5426,33 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Marshal() (
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockHeightAndTopicIds) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockHeightAndTopicIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DelegateStakeRemovalInfo != nil {
-		{
-			size, err := m.DelegateStakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.TopicIds) > 0 {
+		dAtA17 := make([]byte, len(m.TopicIds)*10)
+		var j16 int
+		for _, num := range m.TopicIds {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			dAtA17[j16] = uint8(num)
+			j16++
 		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintGenesis(dAtA, i, uint64(j16))
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
@@ -4972,7 +5462,7 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalToSi
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdBlockHeightScores) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4982,44 +5472,42 @@ func (m *DelegatorReputerTopicIdBlockHeight) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightScores) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightScores) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Scores != nil {
+		{
+			size, err := m.Scores.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x10
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdInference) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdScore) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5029,19 +5517,19 @@ func (m *TopicIdActorIdInference) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdInference) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdScore) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Inference != nil {
+	if m.Score != nil {
 		{
-			size, err := m.Inference.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Score.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5066,7 +5554,7 @@ func (m *TopicIdActorIdInference) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdForecast) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdUint64) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5076,27 +5564,20 @@ func (m *TopicIdActorIdForecast) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdForecast) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdUint64) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdForecast) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdUint64) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Forecast != nil {
-		{
-			size, err := m.Forecast.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
+	if m.Uint64 != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Uint64))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
 	if len(m.ActorId) > 0 {
 		i -= len(m.ActorId)
@@ -5113,7 +5594,7 @@ func (m *TopicIdActorIdForecast) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *LibP2PKeyAndOffchainNode) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdListeningCoefficient) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5123,19 +5604,19 @@ func (m *LibP2PKeyAndOffchainNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LibP2PKeyAndOffchainNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdListeningCoefficient) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LibP2PKeyAndOffchainNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdListeningCoefficient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OffchainNode != nil {
+	if m.ListeningCoefficient != nil {
 		{
-			size, err := m.OffchainNode.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ListeningCoefficient.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5143,19 +5624,24 @@ func (m *LibP2PKeyAndOffchainNode) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if len(m.LibP2PKey) > 0 {
-		i -= len(m.LibP2PKey)
-		copy(dAtA[i:], m.LibP2PKey)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LibP2PKey)))
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndDec) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdDec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5165,12 +5651,12 @@ func (m *TopicIdAndDec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdAndDec) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdDec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdAndDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5184,7 +5670,14 @@ func (m *TopicIdAndDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1a
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
@@ -5193,7 +5686,7 @@ func (m *TopicIdAndDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightInferences) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdAndInt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5203,33 +5696,26 @@ func (m *TopicIdBlockHeightInferences) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightInferences) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdAndInt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightInferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdAndInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Inferences != nil {
-		{
-			size, err := m.Inferences.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	{
+		size := m.Int.Size()
+		i -= size
+		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
@@ -5238,7 +5724,7 @@ func (m *TopicIdBlockHeightInferences) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightForecasts) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdInt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5248,32 +5734,32 @@ func (m *TopicIdBlockHeightForecasts) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightForecasts) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdInt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightForecasts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Forecasts != nil {
-		{
-			size, err := m.Forecasts.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	{
+		size := m.Int.Size()
+		i -= size
+		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
@@ -5283,7 +5769,7 @@ func (m *TopicIdBlockHeightForecasts) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdDelegatorReputerDelegatorInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5293,19 +5779,19 @@ func (m *TopicIdBlockHeightReputerValueBundles) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReputerValueBundles != nil {
+	if m.DelegatorInfo != nil {
 		{
-			size, err := m.ReputerValueBundles.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DelegatorInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5313,12 +5799,21 @@ func (m *TopicIdBlockHeightReputerValueBundles) MarshalToSizedBuffer(dAtA []byte
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+		i--
 		dAtA[i] = 0x1a
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
@@ -5328,7 +5823,7 @@ func (m *TopicIdBlockHeightReputerValueBundles) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightValueBundles) Marshal() (dAtA []byte, err error) {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5338,19 +5833,19 @@ func (m *TopicIdBlockHeightValueBundles) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightValueBundles) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ValueBundle != nil {
+	if m.StakeRemovalInfo != nil {
 		{
-			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5358,22 +5853,69 @@ func (m *TopicIdBlockHeightValueBundles) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+		i--
 		dAtA[i] = 0x1a
 	}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActorIdTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActorIdTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActorIdTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndNonces) Marshal() (dAtA []byte, err error) {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5383,19 +5925,19 @@ func (m *TopicIdAndNonces) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdAndNonces) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdAndNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonces != nil {
+	if m.DelegateStakeRemovalInfo != nil {
 		{
-			size, err := m.Nonces.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DelegateStakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5403,17 +5945,36 @@ func (m *TopicIdAndNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndReputerRequestNonces) Marshal() (dAtA []byte, err error) {
+func (m *DelegatorReputerTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5423,37 +5984,44 @@ func (m *TopicIdAndReputerRequestNonces) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdAndReputerRequestNonces) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegatorReputerTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdAndReputerRequestNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegatorReputerTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReputerRequestNonces != nil {
-		{
-			size, err := m.ReputerRequestNonces.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x18
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdInference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5463,19 +6031,19 @@ func (m *TopicIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdInference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TimestampedValue != nil {
+	if m.Inference != nil {
 		{
-			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Inference.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5500,7 +6068,7 @@ func (m *TopicIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdForecast) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5510,19 +6078,19 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdForecast) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdForecast) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TimestampedValue != nil {
+	if m.Forecast != nil {
 		{
-			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Forecast.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5530,19 +6098,12 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ActorId2) > 0 {
-		i -= len(m.ActorId2)
-		copy(dAtA[i:], m.ActorId2)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId2)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.ActorId1) > 0 {
-		i -= len(m.ActorId1)
-		copy(dAtA[i:], m.ActorId1)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId1)))
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5554,7 +6115,7 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdTimestampedActorNonce) Marshal() (dAtA []byte, err error) {
+func (m *LibP2PKeyAndOffchainNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5564,19 +6125,19 @@ func (m *TopicIdTimestampedActorNonce) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdTimestampedActorNonce) MarshalTo(dAtA []byte) (int, error) {
+func (m *LibP2PKeyAndOffchainNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdTimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LibP2PKeyAndOffchainNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TimestampedActorNonce != nil {
+	if m.OffchainNode != nil {
 		{
-			size, err := m.TimestampedActorNonce.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.OffchainNode.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5586,6 +6147,46 @@ func (m *TopicIdTimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x12
 	}
+	if len(m.LibP2PKey) > 0 {
+		i -= len(m.LibP2PKey)
+		copy(dAtA[i:], m.LibP2PKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LibP2PKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdAndDec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndDec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Dec.Size()
+		i -= size
+		if _, err := m.Dec.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
@@ -5594,7 +6195,7 @@ func (m *TopicIdTimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeightTopicIds) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdBlockHeightInferences) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5604,19 +6205,19 @@ func (m *BlockHeightTopicIds) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeightTopicIds) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightInferences) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeightTopicIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightInferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TopicIds != nil {
+	if m.Inferences != nil {
 		{
-			size, err := m.TopicIds.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Inferences.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5624,17 +6225,22 @@ func (m *BlockHeightTopicIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeightTopicIdWeightPair) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdBlockHeightForecasts) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5644,19 +6250,19 @@ func (m *BlockHeightTopicIdWeightPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeightTopicIdWeightPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightForecasts) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeightTopicIdWeightPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightForecasts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TopicWeight != nil {
+	if m.Forecasts != nil {
 		{
-			size, err := m.TopicWeight.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Forecasts.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5664,17 +6270,22 @@ func (m *BlockHeightTopicIdWeightPair) MarshalToSizedBuffer(dAtA []byte) (int, e
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdReputerReputerValueBundle) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdBlockHeightReputerValueBundles) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5684,19 +6295,19 @@ func (m *TopicIdReputerReputerValueBundle) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdReputerReputerValueBundle) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightReputerValueBundles) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdReputerReputerValueBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightReputerValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReputerValueBundle != nil {
+	if m.ReputerValueBundles != nil {
 		{
-			size, err := m.ReputerValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ReputerValueBundles.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5706,12 +6317,10 @@ func (m *TopicIdReputerReputerValueBundle) MarshalToSizedBuffer(dAtA []byte) (in
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
@@ -5721,325 +6330,718 @@ func (m *TopicIdReputerReputerValueBundle) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TopicIdBlockHeightValueBundles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TopicIdBlockHeightValueBundles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdBlockHeightValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.CoreTeamAddresses) > 0 {
-		for _, s := range m.CoreTeamAddresses {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.NextTopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.NextTopicId))
-	}
-	if len(m.Topics) > 0 {
-		for _, e := range m.Topics {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.ValueBundle != nil {
+		{
+			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.ActiveTopics) > 0 {
-		l = 0
-		for _, e := range m.ActiveTopics {
-			l += sovGenesis(uint64(e))
-		}
-		n += 1 + sovGenesis(uint64(l)) + l
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.RewardableTopics) > 0 {
-		l = 0
-		for _, e := range m.RewardableTopics {
-			l += sovGenesis(uint64(e))
-		}
-		n += 1 + sovGenesis(uint64(l)) + l
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.TopicWorkers) > 0 {
-		for _, e := range m.TopicWorkers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdAndNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.TopicReputers) > 0 {
-		for _, e := range m.TopicReputers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonces != nil {
+		{
+			size, err := m.Nonces.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.TopicRewardNonce) > 0 {
-		for _, e := range m.TopicRewardNonce {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.InfererScoresByBlock) > 0 {
-		for _, e := range m.InfererScoresByBlock {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdAndReputerRequestNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ForecasterScoresByBlock) > 0 {
-		for _, e := range m.ForecasterScoresByBlock {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndReputerRequestNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndReputerRequestNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReputerRequestNonces != nil {
+		{
+			size, err := m.ReputerRequestNonces.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ReputerScoresByBlock) > 0 {
-		for _, e := range m.ReputerScoresByBlock {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.ReputerListeningCoefficient) > 0 {
-		for _, e := range m.ReputerListeningCoefficient {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PreviousReputerRewardFraction) > 0 {
-		for _, e := range m.PreviousReputerRewardFraction {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampedValue != nil {
+		{
+			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.PreviousInferenceRewardFraction) > 0 {
-		for _, e := range m.PreviousInferenceRewardFraction {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.PreviousForecastRewardFraction) > 0 {
-		for _, e := range m.PreviousForecastRewardFraction {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.PreviousForecasterScoreRatio) > 0 {
-		for _, e := range m.PreviousForecasterScoreRatio {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.TotalStake.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	if len(m.TopicStake) > 0 {
-		for _, e := range m.TopicStake {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampedValue != nil {
+		{
+			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.StakeReputerAuthority) > 0 {
-		for _, e := range m.StakeReputerAuthority {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ActorId2) > 0 {
+		i -= len(m.ActorId2)
+		copy(dAtA[i:], m.ActorId2)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId2)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.StakeSumFromDelegator) > 0 {
-		for _, e := range m.StakeSumFromDelegator {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ActorId1) > 0 {
+		i -= len(m.ActorId1)
+		copy(dAtA[i:], m.ActorId1)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId1)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.DelegatedStakes) > 0 {
-		for _, e := range m.DelegatedStakes {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.StakeFromDelegatorsUponReputer) > 0 {
-		for _, e := range m.StakeFromDelegatorsUponReputer {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdTimestampedActorNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DelegateRewardPerShare) > 0 {
-		for _, e := range m.DelegateRewardPerShare {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdTimestampedActorNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdTimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampedActorNonce != nil {
+		{
+			size, err := m.TimestampedActorNonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.StakeRemovalsByBlock) > 0 {
-		for _, e := range m.StakeRemovalsByBlock {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.StakeRemovalsByActor) > 0 {
-		for _, e := range m.StakeRemovalsByActor {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockHeightTopicIds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeightTopicIds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHeightTopicIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicIds != nil {
+		{
+			size, err := m.TopicIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.DelegateStakeRemovalsByBlock) > 0 {
-		for _, e := range m.DelegateStakeRemovalsByBlock {
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockHeightTopicIdWeightPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeightTopicIdWeightPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHeightTopicIdWeightPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicWeight != nil {
+		{
+			size, err := m.TopicWeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdReputerReputerValueBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdReputerReputerValueBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdReputerReputerValueBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReputerValueBundle != nil {
+		{
+			size, err := m.ReputerValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CoreTeamAddresses) > 0 {
+		for _, s := range m.CoreTeamAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextTopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextTopicId))
+	}
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
 			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.DelegateStakeRemovalsByActor) > 0 {
-		for _, e := range m.DelegateStakeRemovalsByActor {
+	if len(m.ActiveTopics) > 0 {
+		l = 0
+		for _, e := range m.ActiveTopics {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.RewardableTopics) > 0 {
+		l = 0
+		for _, e := range m.RewardableTopics {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.TopicWorkers) > 0 {
+		for _, e := range m.TopicWorkers {
 			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.Inferences) > 0 {
-		for _, e := range m.Inferences {
+	if len(m.TopicReputers) > 0 {
+		for _, e := range m.TopicReputers {
 			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.Forecasts) > 0 {
-		for _, e := range m.Forecasts {
+	if len(m.TopicRewardNonce) > 0 {
+		for _, e := range m.TopicRewardNonce {
 			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.Workers) > 0 {
-		for _, e := range m.Workers {
+	if len(m.InfererScoresByBlock) > 0 {
+		for _, e := range m.InfererScoresByBlock {
 			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.Reputers) > 0 {
-		for _, e := range m.Reputers {
+	if len(m.ForecasterScoresByBlock) > 0 {
+		for _, e := range m.ForecasterScoresByBlock {
 			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.TopicFeeRevenue) > 0 {
-		for _, e := range m.TopicFeeRevenue {
+	if len(m.ReputerScoresByBlock) > 0 {
+		for _, e := range m.ReputerScoresByBlock {
 			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.PreviousTopicWeight) > 0 {
-		for _, e := range m.PreviousTopicWeight {
+	if len(m.ReputerListeningCoefficient) > 0 {
+		for _, e := range m.ReputerListeningCoefficient {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.AllInferences) > 0 {
-		for _, e := range m.AllInferences {
+	if len(m.PreviousReputerRewardFraction) > 0 {
+		for _, e := range m.PreviousReputerRewardFraction {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.AllForecasts) > 0 {
-		for _, e := range m.AllForecasts {
+	if len(m.PreviousInferenceRewardFraction) > 0 {
+		for _, e := range m.PreviousInferenceRewardFraction {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.AllLossBundles) > 0 {
-		for _, e := range m.AllLossBundles {
+	if len(m.PreviousForecastRewardFraction) > 0 {
+		for _, e := range m.PreviousForecastRewardFraction {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.NetworkLossBundles) > 0 {
-		for _, e := range m.NetworkLossBundles {
+	if len(m.PreviousForecasterScoreRatio) > 0 {
+		for _, e := range m.PreviousForecasterScoreRatio {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	l = m.PreviousPercentageRewardToStakedReputers.Size()
+	l = m.TotalStake.Size()
 	n += 2 + l + sovGenesis(uint64(l))
-	if len(m.UnfulfilledWorkerNonces) > 0 {
-		for _, e := range m.UnfulfilledWorkerNonces {
+	if len(m.TopicStake) > 0 {
+		for _, e := range m.TopicStake {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.UnfulfilledReputerNonces) > 0 {
-		for _, e := range m.UnfulfilledReputerNonces {
+	if len(m.StakeReputerAuthority) > 0 {
+		for _, e := range m.StakeReputerAuthority {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.LatestInfererNetworkRegrets) > 0 {
-		for _, e := range m.LatestInfererNetworkRegrets {
+	if len(m.StakeSumFromDelegator) > 0 {
+		for _, e := range m.StakeSumFromDelegator {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.LatestForecasterNetworkRegrets) > 0 {
-		for _, e := range m.LatestForecasterNetworkRegrets {
+	if len(m.DelegatedStakes) > 0 {
+		for _, e := range m.DelegatedStakes {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
-		for _, e := range m.LatestOneInForecasterNetworkRegrets {
+	if len(m.StakeFromDelegatorsUponReputer) > 0 {
+		for _, e := range m.StakeFromDelegatorsUponReputer {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.LatestNaiveInfererNetworkRegrets) > 0 {
-		for _, e := range m.LatestNaiveInfererNetworkRegrets {
+	if len(m.DelegateRewardPerShare) > 0 {
+		for _, e := range m.DelegateRewardPerShare {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.LatestOneOutInfererInfererNetworkRegrets) > 0 {
-		for _, e := range m.LatestOneOutInfererInfererNetworkRegrets {
+	if len(m.StakeRemovalsByBlock) > 0 {
+		for _, e := range m.StakeRemovalsByBlock {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.LatestOneOutInfererForecasterNetworkRegrets) > 0 {
-		for _, e := range m.LatestOneOutInfererForecasterNetworkRegrets {
+	if len(m.StakeRemovalsByActor) > 0 {
+		for _, e := range m.StakeRemovalsByActor {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.LatestOneOutForecasterInfererNetworkRegrets) > 0 {
-		for _, e := range m.LatestOneOutForecasterInfererNetworkRegrets {
+	if len(m.DelegateStakeRemovalsByBlock) > 0 {
+		for _, e := range m.DelegateStakeRemovalsByBlock {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.LatestOneOutForecasterForecasterNetworkRegrets) > 0 {
-		for _, e := range m.LatestOneOutForecasterForecasterNetworkRegrets {
+	if len(m.DelegateStakeRemovalsByActor) > 0 {
+		for _, e := range m.DelegateStakeRemovalsByActor {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.TopicLastWorkerCommit) > 0 {
-		for _, e := range m.TopicLastWorkerCommit {
+	if len(m.Inferences) > 0 {
+		for _, e := range m.Inferences {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.TopicLastReputerCommit) > 0 {
-		for _, e := range m.TopicLastReputerCommit {
+	if len(m.Forecasts) > 0 {
+		for _, e := range m.Forecasts {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.OpenWorkerWindows) > 0 {
-		for _, e := range m.OpenWorkerWindows {
+	if len(m.Workers) > 0 {
+		for _, e := range m.Workers {
 			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.LastDripBlock) > 0 {
-		for _, e := range m.LastDripBlock {
-			l = e.Size()
+	if len(m.Reputers) > 0 {
+		for _, e := range m.Reputers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicFeeRevenue) > 0 {
+		for _, e := range m.TopicFeeRevenue {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PreviousTopicWeight) > 0 {
+		for _, e := range m.PreviousTopicWeight {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllInferences) > 0 {
+		for _, e := range m.AllInferences {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllForecasts) > 0 {
+		for _, e := range m.AllForecasts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllLossBundles) > 0 {
+		for _, e := range m.AllLossBundles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NetworkLossBundles) > 0 {
+		for _, e := range m.NetworkLossBundles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.PreviousPercentageRewardToStakedReputers.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.UnfulfilledWorkerNonces) > 0 {
+		for _, e := range m.UnfulfilledWorkerNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnfulfilledReputerNonces) > 0 {
+		for _, e := range m.UnfulfilledReputerNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestInfererNetworkRegrets) > 0 {
+		for _, e := range m.LatestInfererNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestForecasterNetworkRegrets) > 0 {
+		for _, e := range m.LatestForecasterNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
+		for _, e := range m.LatestOneInForecasterNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestNaiveInfererNetworkRegrets) > 0 {
+		for _, e := range m.LatestNaiveInfererNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestOneOutInfererInfererNetworkRegrets) > 0 {
+		for _, e := range m.LatestOneOutInfererInfererNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestOneOutInfererForecasterNetworkRegrets) > 0 {
+		for _, e := range m.LatestOneOutInfererForecasterNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestOneOutForecasterInfererNetworkRegrets) > 0 {
+		for _, e := range m.LatestOneOutForecasterInfererNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestOneOutForecasterForecasterNetworkRegrets) > 0 {
+		for _, e := range m.LatestOneOutForecasterForecasterNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicLastWorkerCommit) > 0 {
+		for _, e := range m.TopicLastWorkerCommit {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicLastReputerCommit) > 0 {
+		for _, e := range m.TopicLastReputerCommit {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OpenWorkerWindows) > 0 {
+		for _, e := range m.OpenWorkerWindows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LastDripBlock) > 0 {
+		for _, e := range m.LastDripBlock {
+			l = e.Size()
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
@@ -6247,6 +7249,143 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingTopicUpdates) > 0 {
+		for _, e := range m.PendingTopicUpdates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedTopics) > 0 {
+		l = 0
+		for _, e := range m.ArchivedTopics {
+			l += sovGenesis(uint64(e))
+		}
+		n += 2 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.ArchivedTopicsToPrune) > 0 {
+		l = 0
+		for _, e := range m.ArchivedTopicsToPrune {
+			l += sovGenesis(uint64(e))
+		}
+		n += 2 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.TopicOwners) > 0 {
+		for _, e := range m.TopicOwners {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingTopicOwners) > 0 {
+		for _, e := range m.PendingTopicOwners {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicTags) > 0 {
+		for _, e := range m.TopicTags {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerGroundTruths) > 0 {
+		for _, e := range m.ReputerGroundTruths {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsensusGroundTruths) > 0 {
+		for _, e := range m.ConsensusGroundTruths {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WorkerPayloadCommits) > 0 {
+		for _, e := range m.WorkerPayloadCommits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ActorOperators) > 0 {
+		for _, e := range m.ActorOperators {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WorkerBonds) > 0 {
+		for _, e := range m.WorkerBonds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WorkerBondRemovals) > 0 {
+		for _, e := range m.WorkerBondRemovals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerConsensusDeviations) > 0 {
+		for _, e := range m.ReputerConsensusDeviations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundDelegators) > 0 {
+		for _, e := range m.AutoCompoundDelegators {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerCommissions) > 0 {
+		for _, e := range m.ReputerCommissions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StakeLocks) > 0 {
+		for _, e := range m.StakeLocks {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardHistory) > 0 {
+		for _, e := range m.RewardHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardEscrows) > 0 {
+		for _, e := range m.RewardEscrows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedRewardPayouts) > 0 {
+		for _, e := range m.QueuedRewardPayouts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRewardPayoutId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextRewardPayoutId))
+	}
+	if len(m.ArchivedTopicDelegateRemovalCursors) > 0 {
+		for _, e := range m.ArchivedTopicDelegateRemovalCursors {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeadLetteredRewardPayouts) > 0 {
+		for _, e := range m.DeadLetteredRewardPayouts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6266,7 +7405,7 @@ func (m *TopicIdAndTopic) Size() (n int) {
 	return n
 }
 
-func (m *TopicAndActorId) Size() (n int) {
+func (m *TopicIdAndOptionalTopicParams) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6275,14 +7414,14 @@ func (m *TopicAndActorId) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	l = len(m.ActorId)
-	if l > 0 {
+	if m.Params != nil {
+		l = m.Params.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdAndBlockHeight) Size() (n int) {
+func (m *TopicIdAndTag) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6291,32 +7430,30 @@ func (m *TopicIdAndBlockHeight) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *BlockHeightAndTopicIds) Size() (n int) {
+func (m *TopicAndActorId) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if len(m.TopicIds) > 0 {
-		l = 0
-		for _, e := range m.TopicIds {
-			l += sovGenesis(uint64(e))
-		}
-		n += 1 + sovGenesis(uint64(l)) + l
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdBlockHeightScores) Size() (n int) {
+func (m *TopicIdDelegatorReputer) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6325,17 +7462,18 @@ func (m *TopicIdBlockHeightScores) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.Scores != nil {
-		l = m.Scores.Size()
+	l = len(m.Reputer)
+	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdActorIdScore) Size() (n int) {
+func (m *TopicActorOperator) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6344,18 +7482,91 @@ func (m *TopicIdActorIdScore) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	l = len(m.ActorId)
+	l = len(m.Actor)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.Score != nil {
-		l = m.Score.Size()
+	l = len(m.Operator)
+	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdActorIdUint64) Size() (n int) {
+func (m *TopicIdAndBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *BlockHeightAndTopicIds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if len(m.TopicIds) > 0 {
+		l = 0
+		for _, e := range m.TopicIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *TopicIdBlockHeightScores) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.Scores != nil {
+		l = m.Scores.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdActorIdScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Score != nil {
+		l = m.Score.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdActorIdUint64) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6867,28 +8078,876 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreTeamAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoreTeamAddresses = append(m.CoreTeamAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTopicId", wireType)
+			}
+			m.NextTopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, &TopicIdAndTopic{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActiveTopics = append(m.ActiveTopics, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ActiveTopics) == 0 {
+					m.ActiveTopics = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActiveTopics = append(m.ActiveTopics, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveTopics", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RewardableTopics = append(m.RewardableTopics, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RewardableTopics) == 0 {
+					m.RewardableTopics = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RewardableTopics = append(m.RewardableTopics, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardableTopics", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicWorkers = append(m.TopicWorkers, &TopicAndActorId{})
+			if err := m.TopicWorkers[len(m.TopicWorkers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicReputers = append(m.TopicReputers, &TopicAndActorId{})
+			if err := m.TopicReputers[len(m.TopicReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardNonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicRewardNonce = append(m.TopicRewardNonce, &TopicIdAndBlockHeight{})
+			if err := m.TopicRewardNonce[len(m.TopicRewardNonce)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoresByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfererScoresByBlock = append(m.InfererScoresByBlock, &TopicIdBlockHeightScores{})
+			if err := m.InfererScoresByBlock[len(m.InfererScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoresByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForecasterScoresByBlock = append(m.ForecasterScoresByBlock, &TopicIdBlockHeightScores{})
+			if err := m.ForecasterScoresByBlock[len(m.ForecasterScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoresByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerScoresByBlock = append(m.ReputerScoresByBlock, &TopicIdBlockHeightScores{})
+			if err := m.ReputerScoresByBlock[len(m.ReputerScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerListeningCoefficient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerListeningCoefficient = append(m.ReputerListeningCoefficient, &TopicIdActorIdListeningCoefficient{})
+			if err := m.ReputerListeningCoefficient[len(m.ReputerListeningCoefficient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousReputerRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousReputerRewardFraction = append(m.PreviousReputerRewardFraction, &TopicIdActorIdDec{})
+			if err := m.PreviousReputerRewardFraction[len(m.PreviousReputerRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousInferenceRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousInferenceRewardFraction = append(m.PreviousInferenceRewardFraction, &TopicIdActorIdDec{})
+			if err := m.PreviousInferenceRewardFraction[len(m.PreviousInferenceRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecastRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousForecastRewardFraction = append(m.PreviousForecastRewardFraction, &TopicIdActorIdDec{})
+			if err := m.PreviousForecastRewardFraction[len(m.PreviousForecastRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecasterScoreRatio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousForecasterScoreRatio = append(m.PreviousForecasterScoreRatio, &TopicIdAndDec{})
+			if err := m.PreviousForecasterScoreRatio[len(m.PreviousForecasterScoreRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicStake = append(m.TopicStake, &TopicIdAndInt{})
+			if err := m.TopicStake[len(m.TopicStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeReputerAuthority", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeReputerAuthority = append(m.StakeReputerAuthority, &TopicIdActorIdInt{})
+			if err := m.StakeReputerAuthority[len(m.StakeReputerAuthority)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeSumFromDelegator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeSumFromDelegator = append(m.StakeSumFromDelegator, &TopicIdActorIdInt{})
+			if err := m.StakeSumFromDelegator[len(m.StakeSumFromDelegator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedStakes = append(m.DelegatedStakes, &TopicIdDelegatorReputerDelegatorInfo{})
+			if err := m.DelegatedStakes[len(m.DelegatedStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeFromDelegatorsUponReputer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.StakeFromDelegatorsUponReputer = append(m.StakeFromDelegatorsUponReputer, &TopicIdActorIdInt{})
+			if err := m.StakeFromDelegatorsUponReputer[len(m.StakeFromDelegatorsUponReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateRewardPerShare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6915,15 +8974,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegateRewardPerShare = append(m.DelegateRewardPerShare, &TopicIdActorIdDec{})
+			if err := m.DelegateRewardPerShare[len(m.DelegateRewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoreTeamAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByBlock", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6933,29 +8993,235 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeRemovalsByBlock = append(m.StakeRemovalsByBlock, &BlockHeightTopicIdReputerStakeRemovalInfo{})
+			if err := m.StakeRemovalsByBlock[len(m.StakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeRemovalsByActor = append(m.StakeRemovalsByActor, &ActorIdTopicIdBlockHeight{})
+			if err := m.StakeRemovalsByActor[len(m.StakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateStakeRemovalsByBlock = append(m.DelegateStakeRemovalsByBlock, &BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{})
+			if err := m.DelegateStakeRemovalsByBlock[len(m.DelegateStakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateStakeRemovalsByActor = append(m.DelegateStakeRemovalsByActor, &DelegatorReputerTopicIdBlockHeight{})
+			if err := m.DelegateStakeRemovalsByActor[len(m.DelegateStakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inferences = append(m.Inferences, &TopicIdActorIdInference{})
+			if err := m.Inferences[len(m.Inferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Forecasts = append(m.Forecasts, &TopicIdActorIdForecast{})
+			if err := m.Forecasts[len(m.Forecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CoreTeamAddresses = append(m.CoreTeamAddresses, string(dAtA[iNdEx:postIndex]))
+			m.Workers = append(m.Workers, &LibP2PKeyAndOffchainNode{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextTopicId", wireType)
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputers", wireType)
 			}
-			m.NextTopicId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6965,14 +9231,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextTopicId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputers = append(m.Reputers, &LibP2PKeyAndOffchainNode{})
+			if err := m.Reputers[len(m.Reputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6999,166 +9280,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, &TopicIdAndTopic{})
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicFeeRevenue = append(m.TopicFeeRevenue, &TopicIdAndInt{})
+			if err := m.TopicFeeRevenue[len(m.TopicFeeRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ActiveTopics = append(m.ActiveTopics, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenesis
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenesis
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ActiveTopics) == 0 {
-					m.ActiveTopics = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ActiveTopics = append(m.ActiveTopics, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveTopics", wireType)
-			}
-		case 6:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RewardableTopics = append(m.RewardableTopics, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenesis
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenesis
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RewardableTopics) == 0 {
-					m.RewardableTopics = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RewardableTopics = append(m.RewardableTopics, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardableTopics", wireType)
-			}
-		case 7:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicWeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7185,14 +9314,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicWorkers = append(m.TopicWorkers, &TopicAndActorId{})
-			if err := m.TopicWorkers[len(m.TopicWorkers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PreviousTopicWeight = append(m.PreviousTopicWeight, &TopicIdAndDec{})
+			if err := m.PreviousTopicWeight[len(m.PreviousTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllInferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7219,14 +9348,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicReputers = append(m.TopicReputers, &TopicAndActorId{})
-			if err := m.TopicReputers[len(m.TopicReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllInferences = append(m.AllInferences, &TopicIdBlockHeightInferences{})
+			if err := m.AllInferences[len(m.AllInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllForecasts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7253,14 +9382,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicRewardNonce = append(m.TopicRewardNonce, &TopicIdAndBlockHeight{})
-			if err := m.TopicRewardNonce[len(m.TopicRewardNonce)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllForecasts = append(m.AllForecasts, &TopicIdBlockHeightForecasts{})
+			if err := m.AllForecasts[len(m.AllForecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoresByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllLossBundles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7287,14 +9416,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InfererScoresByBlock = append(m.InfererScoresByBlock, &TopicIdBlockHeightScores{})
-			if err := m.InfererScoresByBlock[len(m.InfererScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllLossBundles = append(m.AllLossBundles, &TopicIdBlockHeightReputerValueBundles{})
+			if err := m.AllLossBundles[len(m.AllLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoresByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkLossBundles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7321,16 +9450,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForecasterScoresByBlock = append(m.ForecasterScoresByBlock, &TopicIdBlockHeightScores{})
-			if err := m.ForecasterScoresByBlock[len(m.ForecasterScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NetworkLossBundles = append(m.NetworkLossBundles, &TopicIdBlockHeightValueBundles{})
+			if err := m.NetworkLossBundles[len(m.NetworkLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoresByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPercentageRewardToStakedReputers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7340,29 +9469,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerScoresByBlock = append(m.ReputerScoresByBlock, &TopicIdBlockHeightScores{})
-			if err := m.ReputerScoresByBlock[len(m.ReputerScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousPercentageRewardToStakedReputers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerListeningCoefficient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledWorkerNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7389,14 +9518,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerListeningCoefficient = append(m.ReputerListeningCoefficient, &TopicIdActorIdListeningCoefficient{})
-			if err := m.ReputerListeningCoefficient[len(m.ReputerListeningCoefficient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnfulfilledWorkerNonces = append(m.UnfulfilledWorkerNonces, &TopicIdAndNonces{})
+			if err := m.UnfulfilledWorkerNonces[len(m.UnfulfilledWorkerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousReputerRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledReputerNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7423,14 +9552,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousReputerRewardFraction = append(m.PreviousReputerRewardFraction, &TopicIdActorIdDec{})
-			if err := m.PreviousReputerRewardFraction[len(m.PreviousReputerRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnfulfilledReputerNonces = append(m.UnfulfilledReputerNonces, &TopicIdAndReputerRequestNonces{})
+			if err := m.UnfulfilledReputerNonces[len(m.UnfulfilledReputerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousInferenceRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestInfererNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7457,14 +9586,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousInferenceRewardFraction = append(m.PreviousInferenceRewardFraction, &TopicIdActorIdDec{})
-			if err := m.PreviousInferenceRewardFraction[len(m.PreviousInferenceRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestInfererNetworkRegrets = append(m.LatestInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+			if err := m.LatestInfererNetworkRegrets[len(m.LatestInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 46:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecastRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7491,14 +9620,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousForecastRewardFraction = append(m.PreviousForecastRewardFraction, &TopicIdActorIdDec{})
-			if err := m.PreviousForecastRewardFraction[len(m.PreviousForecastRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestForecasterNetworkRegrets = append(m.LatestForecasterNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+			if err := m.LatestForecasterNetworkRegrets[len(m.LatestForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 47:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecasterScoreRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7525,16 +9654,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousForecasterScoreRatio = append(m.PreviousForecasterScoreRatio, &TopicIdAndDec{})
-			if err := m.PreviousForecasterScoreRatio[len(m.PreviousForecasterScoreRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneInForecasterNetworkRegrets = append(m.LatestOneInForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneInForecasterNetworkRegrets[len(m.LatestOneInForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestNaiveInfererNetworkRegrets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7544,29 +9673,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestNaiveInfererNetworkRegrets = append(m.LatestNaiveInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+			if err := m.LatestNaiveInfererNetworkRegrets[len(m.LatestNaiveInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 49:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererInfererNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7593,14 +9722,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicStake = append(m.TopicStake, &TopicIdAndInt{})
-			if err := m.TopicStake[len(m.TopicStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneOutInfererInfererNetworkRegrets = append(m.LatestOneOutInfererInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneOutInfererInfererNetworkRegrets[len(m.LatestOneOutInfererInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeReputerAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererForecasterNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7627,14 +9756,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeReputerAuthority = append(m.StakeReputerAuthority, &TopicIdActorIdInt{})
-			if err := m.StakeReputerAuthority[len(m.StakeReputerAuthority)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneOutInfererForecasterNetworkRegrets = append(m.LatestOneOutInfererForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneOutInfererForecasterNetworkRegrets[len(m.LatestOneOutInfererForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeSumFromDelegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterInfererNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7661,14 +9790,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeSumFromDelegator = append(m.StakeSumFromDelegator, &TopicIdActorIdInt{})
-			if err := m.StakeSumFromDelegator[len(m.StakeSumFromDelegator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneOutForecasterInfererNetworkRegrets = append(m.LatestOneOutForecasterInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneOutForecasterInfererNetworkRegrets[len(m.LatestOneOutForecasterInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 52:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedStakes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterForecasterNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7695,14 +9824,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatedStakes = append(m.DelegatedStakes, &TopicIdDelegatorReputerDelegatorInfo{})
-			if err := m.DelegatedStakes[len(m.DelegatedStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneOutForecasterForecasterNetworkRegrets = append(m.LatestOneOutForecasterForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneOutForecasterForecasterNetworkRegrets[len(m.LatestOneOutForecasterForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 53:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeFromDelegatorsUponReputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7729,14 +9858,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeFromDelegatorsUponReputer = append(m.StakeFromDelegatorsUponReputer, &TopicIdActorIdInt{})
-			if err := m.StakeFromDelegatorsUponReputer[len(m.StakeFromDelegatorsUponReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicLastWorkerCommit = append(m.TopicLastWorkerCommit, &TopicIdTimestampedActorNonce{})
+			if err := m.TopicLastWorkerCommit[len(m.TopicLastWorkerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 27:
+		case 54:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateRewardPerShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7763,14 +9892,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateRewardPerShare = append(m.DelegateRewardPerShare, &TopicIdActorIdDec{})
-			if err := m.DelegateRewardPerShare[len(m.DelegateRewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicLastReputerCommit = append(m.TopicLastReputerCommit, &TopicIdTimestampedActorNonce{})
+			if err := m.TopicLastReputerCommit[len(m.TopicLastReputerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 28:
+		case 55:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenWorkerWindows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7797,14 +9926,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeRemovalsByBlock = append(m.StakeRemovalsByBlock, &BlockHeightTopicIdReputerStakeRemovalInfo{})
-			if err := m.StakeRemovalsByBlock[len(m.StakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OpenWorkerWindows = append(m.OpenWorkerWindows, &BlockHeightAndTopicIds{})
+			if err := m.OpenWorkerWindows[len(m.OpenWorkerWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 29:
+		case 56:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByActor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDripBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7831,14 +9960,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeRemovalsByActor = append(m.StakeRemovalsByActor, &ActorIdTopicIdBlockHeight{})
-			if err := m.StakeRemovalsByActor[len(m.StakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LastDripBlock = append(m.LastDripBlock, &TopicIdAndBlockHeight{})
+			if err := m.LastDripBlock[len(m.LastDripBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 30:
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicToNextPossibleChurningBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7865,14 +9994,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateStakeRemovalsByBlock = append(m.DelegateStakeRemovalsByBlock, &BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{})
-			if err := m.DelegateStakeRemovalsByBlock[len(m.DelegateStakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicToNextPossibleChurningBlock = append(m.TopicToNextPossibleChurningBlock, &TopicIdAndBlockHeight{})
+			if err := m.TopicToNextPossibleChurningBlock[len(m.TopicToNextPossibleChurningBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 31:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByActor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockToActiveTopics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7899,14 +10028,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateStakeRemovalsByActor = append(m.DelegateStakeRemovalsByActor, &DelegatorReputerTopicIdBlockHeight{})
-			if err := m.DelegateStakeRemovalsByActor[len(m.DelegateStakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlockToActiveTopics = append(m.BlockToActiveTopics, &BlockHeightTopicIds{})
+			if err := m.BlockToActiveTopics[len(m.BlockToActiveTopics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 32:
+		case 59:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockToLowestActiveTopicWeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7933,14 +10062,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inferences = append(m.Inferences, &TopicIdActorIdInference{})
-			if err := m.Inferences[len(m.Inferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlockToLowestActiveTopicWeight = append(m.BlockToLowestActiveTopicWeight, &BlockHeightTopicIdWeightPair{})
+			if err := m.BlockToLowestActiveTopicWeight[len(m.BlockToLowestActiveTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 33:
+		case 60:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoreEmas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7967,14 +10096,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Forecasts = append(m.Forecasts, &TopicIdActorIdForecast{})
-			if err := m.Forecasts[len(m.Forecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InfererScoreEmas = append(m.InfererScoreEmas, &TopicIdActorIdScore{})
+			if err := m.InfererScoreEmas[len(m.InfererScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 34:
+		case 61:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoreEmas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8001,14 +10130,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Workers = append(m.Workers, &LibP2PKeyAndOffchainNode{})
-			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ForecasterScoreEmas = append(m.ForecasterScoreEmas, &TopicIdActorIdScore{})
+			if err := m.ForecasterScoreEmas[len(m.ForecasterScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 35:
+		case 62:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoreEmas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8035,14 +10164,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputers = append(m.Reputers, &LibP2PKeyAndOffchainNode{})
-			if err := m.Reputers[len(m.Reputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerScoreEmas = append(m.ReputerScoreEmas, &TopicIdActorIdScore{})
+			if err := m.ReputerScoreEmas[len(m.ReputerScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 36:
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileInfererScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8069,14 +10198,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicFeeRevenue = append(m.TopicFeeRevenue, &TopicIdAndInt{})
-			if err := m.TopicFeeRevenue[len(m.TopicFeeRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PreviousTopicQuantileInfererScoreEma = append(m.PreviousTopicQuantileInfererScoreEma, &TopicIdAndDec{})
+			if err := m.PreviousTopicQuantileInfererScoreEma[len(m.PreviousTopicQuantileInfererScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 37:
+		case 64:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileForecasterScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8103,14 +10232,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousTopicWeight = append(m.PreviousTopicWeight, &TopicIdAndDec{})
-			if err := m.PreviousTopicWeight[len(m.PreviousTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PreviousTopicQuantileForecasterScoreEma = append(m.PreviousTopicQuantileForecasterScoreEma, &TopicIdAndDec{})
+			if err := m.PreviousTopicQuantileForecasterScoreEma[len(m.PreviousTopicQuantileForecasterScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 38:
+		case 65:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllInferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileReputerScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8137,14 +10266,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllInferences = append(m.AllInferences, &TopicIdBlockHeightInferences{})
-			if err := m.AllInferences[len(m.AllInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PreviousTopicQuantileReputerScoreEma = append(m.PreviousTopicQuantileReputerScoreEma, &TopicIdAndDec{})
+			if err := m.PreviousTopicQuantileReputerScoreEma[len(m.PreviousTopicQuantileReputerScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 39:
+		case 66:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllForecasts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CountInfererInclusionsInTopicActiveSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8171,14 +10300,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllForecasts = append(m.AllForecasts, &TopicIdBlockHeightForecasts{})
-			if err := m.AllForecasts[len(m.AllForecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CountInfererInclusionsInTopicActiveSet = append(m.CountInfererInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
+			if err := m.CountInfererInclusionsInTopicActiveSet[len(m.CountInfererInclusionsInTopicActiveSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 40:
+		case 67:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllLossBundles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CountForecasterInclusionsInTopicActiveSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8205,14 +10334,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllLossBundles = append(m.AllLossBundles, &TopicIdBlockHeightReputerValueBundles{})
-			if err := m.AllLossBundles[len(m.AllLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CountForecasterInclusionsInTopicActiveSet = append(m.CountForecasterInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
+			if err := m.CountForecasterInclusionsInTopicActiveSet[len(m.CountForecasterInclusionsInTopicActiveSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 41:
+		case 68:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkLossBundles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveInferers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8239,16 +10368,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkLossBundles = append(m.NetworkLossBundles, &TopicIdBlockHeightValueBundles{})
-			if err := m.NetworkLossBundles[len(m.NetworkLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActiveInferers = append(m.ActiveInferers, &TopicAndActorId{})
+			if err := m.ActiveInferers[len(m.ActiveInferers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 42:
+		case 69:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPercentageRewardToStakedReputers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8258,29 +10387,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PreviousPercentageRewardToStakedReputers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActiveForecasters = append(m.ActiveForecasters, &TopicAndActorId{})
+			if err := m.ActiveForecasters[len(m.ActiveForecasters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 43:
+		case 70:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledWorkerNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestInfererScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8307,14 +10436,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnfulfilledWorkerNonces = append(m.UnfulfilledWorkerNonces, &TopicIdAndNonces{})
-			if err := m.UnfulfilledWorkerNonces[len(m.UnfulfilledWorkerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LowestInfererScoreEma = append(m.LowestInfererScoreEma, &TopicIdActorIdScore{})
+			if err := m.LowestInfererScoreEma[len(m.LowestInfererScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 44:
+		case 71:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledReputerNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestForecasterScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8341,14 +10470,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnfulfilledReputerNonces = append(m.UnfulfilledReputerNonces, &TopicIdAndReputerRequestNonces{})
-			if err := m.UnfulfilledReputerNonces[len(m.UnfulfilledReputerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LowestForecasterScoreEma = append(m.LowestForecasterScoreEma, &TopicIdActorIdScore{})
+			if err := m.LowestForecasterScoreEma[len(m.LowestForecasterScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 45:
+		case 72:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestInfererNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveReputers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8375,14 +10504,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestInfererNetworkRegrets = append(m.LatestInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-			if err := m.LatestInfererNetworkRegrets[len(m.LatestInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActiveReputers = append(m.ActiveReputers, &TopicAndActorId{})
+			if err := m.ActiveReputers[len(m.ActiveReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 46:
+		case 73:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestReputerScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8409,14 +10538,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestForecasterNetworkRegrets = append(m.LatestForecasterNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-			if err := m.LatestForecasterNetworkRegrets[len(m.LatestForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LowestReputerScoreEma = append(m.LowestReputerScoreEma, &TopicIdActorIdScore{})
+			if err := m.LowestReputerScoreEma[len(m.LowestReputerScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 47:
+		case 74:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LossBundles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8443,16 +10572,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestOneInForecasterNetworkRegrets = append(m.LatestOneInForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneInForecasterNetworkRegrets[len(m.LatestOneInForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LossBundles = append(m.LossBundles, &TopicIdReputerReputerValueBundle{})
+			if err := m.LossBundles[len(m.LossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 48:
+		case 75:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestNaiveInfererNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSumPreviousTopicWeights", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8462,31 +10591,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestNaiveInfererNetworkRegrets = append(m.LatestNaiveInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-			if err := m.LatestNaiveInfererNetworkRegrets[len(m.LatestNaiveInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalSumPreviousTopicWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 49:
+		case 76:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererInfererNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardCurrentBlockEmission", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8496,31 +10625,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestOneOutInfererInfererNetworkRegrets = append(m.LatestOneOutInfererInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneOutInfererInfererNetworkRegrets[len(m.LatestOneOutInfererInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardCurrentBlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 50:
+		case 77:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererForecasterNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistAdmins", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8530,31 +10659,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestOneOutInfererForecasterNetworkRegrets = append(m.LatestOneOutInfererForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneOutInfererForecasterNetworkRegrets[len(m.LatestOneOutInfererForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WhitelistAdmins = append(m.WhitelistAdmins, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 51:
+		case 78:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterInfererNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalWhitelist", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8564,31 +10691,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestOneOutForecasterInfererNetworkRegrets = append(m.LatestOneOutForecasterInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneOutForecasterInfererNetworkRegrets[len(m.LatestOneOutForecasterInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GlobalWhitelist = append(m.GlobalWhitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 52:
+		case 79:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterForecasterNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicCreatorWhitelist", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8598,29 +10723,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestOneOutForecasterForecasterNetworkRegrets = append(m.LatestOneOutForecasterForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneOutForecasterForecasterNetworkRegrets[len(m.LatestOneOutForecasterForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TopicCreatorWhitelist = append(m.TopicCreatorWhitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 53:
+		case 80:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkerWhitelist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8647,14 +10770,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicLastWorkerCommit = append(m.TopicLastWorkerCommit, &TopicIdTimestampedActorNonce{})
-			if err := m.TopicLastWorkerCommit[len(m.TopicLastWorkerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicWorkerWhitelist = append(m.TopicWorkerWhitelist, &TopicAndActorId{})
+			if err := m.TopicWorkerWhitelist[len(m.TopicWorkerWhitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 54:
+		case 81:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputerWhitelist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8681,14 +10804,166 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicLastReputerCommit = append(m.TopicLastReputerCommit, &TopicIdTimestampedActorNonce{})
-			if err := m.TopicLastReputerCommit[len(m.TopicLastReputerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicReputerWhitelist = append(m.TopicReputerWhitelist, &TopicAndActorId{})
+			if err := m.TopicReputerWhitelist[len(m.TopicReputerWhitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 55:
+			iNdEx = postIndex
+		case 82:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TopicWorkerWhitelistEnabled = append(m.TopicWorkerWhitelistEnabled, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TopicWorkerWhitelistEnabled) == 0 {
+					m.TopicWorkerWhitelistEnabled = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TopicWorkerWhitelistEnabled = append(m.TopicWorkerWhitelistEnabled, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkerWhitelistEnabled", wireType)
+			}
+		case 83:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TopicReputerWhitelistEnabled = append(m.TopicReputerWhitelistEnabled, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TopicReputerWhitelistEnabled) == 0 {
+					m.TopicReputerWhitelistEnabled = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TopicReputerWhitelistEnabled = append(m.TopicReputerWhitelistEnabled, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputerWhitelistEnabled", wireType)
+			}
+		case 84:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenWorkerWindows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMedianInferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8715,14 +10990,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OpenWorkerWindows = append(m.OpenWorkerWindows, &BlockHeightAndTopicIds{})
-			if err := m.OpenWorkerWindows[len(m.OpenWorkerWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LastMedianInferences = append(m.LastMedianInferences, &TopicIdAndDec{})
+			if err := m.LastMedianInferences[len(m.LastMedianInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 56:
+		case 85:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDripBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MadInferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8749,14 +11024,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastDripBlock = append(m.LastDripBlock, &TopicIdAndBlockHeight{})
-			if err := m.LastDripBlock[len(m.LastDripBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MadInferences = append(m.MadInferences, &TopicIdAndDec{})
+			if err := m.MadInferences[len(m.MadInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 57:
+		case 86:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicToNextPossibleChurningBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialInfererEmaScore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8783,14 +11058,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicToNextPossibleChurningBlock = append(m.TopicToNextPossibleChurningBlock, &TopicIdAndBlockHeight{})
-			if err := m.TopicToNextPossibleChurningBlock[len(m.TopicToNextPossibleChurningBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InitialInfererEmaScore = append(m.InitialInfererEmaScore, &TopicIdAndDec{})
+			if err := m.InitialInfererEmaScore[len(m.InitialInfererEmaScore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 58:
+		case 87:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockToActiveTopics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialForecasterEmaScore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8817,14 +11092,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockToActiveTopics = append(m.BlockToActiveTopics, &BlockHeightTopicIds{})
-			if err := m.BlockToActiveTopics[len(m.BlockToActiveTopics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InitialForecasterEmaScore = append(m.InitialForecasterEmaScore, &TopicIdAndDec{})
+			if err := m.InitialForecasterEmaScore[len(m.InitialForecasterEmaScore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 59:
+		case 88:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockToLowestActiveTopicWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialReputerEmaScore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8851,16 +11126,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockToLowestActiveTopicWeight = append(m.BlockToLowestActiveTopicWeight, &BlockHeightTopicIdWeightPair{})
-			if err := m.BlockToLowestActiveTopicWeight[len(m.BlockToLowestActiveTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InitialReputerEmaScore = append(m.InitialReputerEmaScore, &TopicIdAndDec{})
+			if err := m.InitialReputerEmaScore[len(m.InitialReputerEmaScore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 60:
+		case 89:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoreEmas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalWorkerWhitelist", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8870,31 +11145,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InfererScoreEmas = append(m.InfererScoreEmas, &TopicIdActorIdScore{})
-			if err := m.InfererScoreEmas[len(m.InfererScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GlobalWorkerWhitelist = append(m.GlobalWorkerWhitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 61:
+		case 90:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoreEmas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalReputerWhitelist", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8904,31 +11177,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForecasterScoreEmas = append(m.ForecasterScoreEmas, &TopicIdActorIdScore{})
-			if err := m.ForecasterScoreEmas[len(m.ForecasterScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GlobalReputerWhitelist = append(m.GlobalReputerWhitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 62:
+		case 91:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoreEmas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalAdminWhitelist", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8938,29 +11209,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerScoreEmas = append(m.ReputerScoreEmas, &TopicIdActorIdScore{})
-			if err := m.ReputerScoreEmas[len(m.ReputerScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GlobalAdminWhitelist = append(m.GlobalAdminWhitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 63:
+		case 92:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileInfererScoreEma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTopicUpdates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8987,48 +11256,166 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousTopicQuantileInfererScoreEma = append(m.PreviousTopicQuantileInfererScoreEma, &TopicIdAndDec{})
-			if err := m.PreviousTopicQuantileInfererScoreEma[len(m.PreviousTopicQuantileInfererScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingTopicUpdates = append(m.PendingTopicUpdates, &TopicIdAndOptionalTopicParams{})
+			if err := m.PendingTopicUpdates[len(m.PendingTopicUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 64:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileForecasterScoreEma", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
+		case 93:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.ArchivedTopics = append(m.ArchivedTopics, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.ArchivedTopics) == 0 {
+					m.ArchivedTopics = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ArchivedTopics = append(m.ArchivedTopics, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedTopics", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreviousTopicQuantileForecasterScoreEma = append(m.PreviousTopicQuantileForecasterScoreEma, &TopicIdAndDec{})
-			if err := m.PreviousTopicQuantileForecasterScoreEma[len(m.PreviousTopicQuantileForecasterScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 94:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ArchivedTopicsToPrune = append(m.ArchivedTopicsToPrune, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ArchivedTopicsToPrune) == 0 {
+					m.ArchivedTopicsToPrune = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ArchivedTopicsToPrune = append(m.ArchivedTopicsToPrune, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedTopicsToPrune", wireType)
 			}
-			iNdEx = postIndex
-		case 65:
+		case 95:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileReputerScoreEma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicOwners", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9055,14 +11442,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousTopicQuantileReputerScoreEma = append(m.PreviousTopicQuantileReputerScoreEma, &TopicIdAndDec{})
-			if err := m.PreviousTopicQuantileReputerScoreEma[len(m.PreviousTopicQuantileReputerScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicOwners = append(m.TopicOwners, &TopicAndActorId{})
+			if err := m.TopicOwners[len(m.TopicOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 66:
+		case 96:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CountInfererInclusionsInTopicActiveSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTopicOwners", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9089,14 +11476,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CountInfererInclusionsInTopicActiveSet = append(m.CountInfererInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
-			if err := m.CountInfererInclusionsInTopicActiveSet[len(m.CountInfererInclusionsInTopicActiveSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingTopicOwners = append(m.PendingTopicOwners, &TopicAndActorId{})
+			if err := m.PendingTopicOwners[len(m.PendingTopicOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 67:
+		case 97:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CountForecasterInclusionsInTopicActiveSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicTags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9123,14 +11510,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CountForecasterInclusionsInTopicActiveSet = append(m.CountForecasterInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
-			if err := m.CountForecasterInclusionsInTopicActiveSet[len(m.CountForecasterInclusionsInTopicActiveSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicTags = append(m.TopicTags, &TopicIdAndTag{})
+			if err := m.TopicTags[len(m.TopicTags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 68:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveInferers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerGroundTruths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9157,14 +11544,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveInferers = append(m.ActiveInferers, &TopicAndActorId{})
-			if err := m.ActiveInferers[len(m.ActiveInferers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerGroundTruths = append(m.ReputerGroundTruths, &GroundTruth{})
+			if err := m.ReputerGroundTruths[len(m.ReputerGroundTruths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 69:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusGroundTruths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9191,14 +11578,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveForecasters = append(m.ActiveForecasters, &TopicAndActorId{})
-			if err := m.ActiveForecasters[len(m.ActiveForecasters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConsensusGroundTruths = append(m.ConsensusGroundTruths, &GroundTruth{})
+			if err := m.ConsensusGroundTruths[len(m.ConsensusGroundTruths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 70:
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowestInfererScoreEma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPayloadCommits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9225,14 +11612,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LowestInfererScoreEma = append(m.LowestInfererScoreEma, &TopicIdActorIdScore{})
-			if err := m.LowestInfererScoreEma[len(m.LowestInfererScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerPayloadCommits = append(m.WorkerPayloadCommits, &WorkerPayloadCommit{})
+			if err := m.WorkerPayloadCommits[len(m.WorkerPayloadCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 71:
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowestForecasterScoreEma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorOperators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9259,14 +11646,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LowestForecasterScoreEma = append(m.LowestForecasterScoreEma, &TopicIdActorIdScore{})
-			if err := m.LowestForecasterScoreEma[len(m.LowestForecasterScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActorOperators = append(m.ActorOperators, &TopicActorOperator{})
+			if err := m.ActorOperators[len(m.ActorOperators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 72:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveReputers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerBonds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9293,14 +11680,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveReputers = append(m.ActiveReputers, &TopicAndActorId{})
-			if err := m.ActiveReputers[len(m.ActiveReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerBonds = append(m.WorkerBonds, &WorkerBond{})
+			if err := m.WorkerBonds[len(m.WorkerBonds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 73:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowestReputerScoreEma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerBondRemovals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9327,14 +11714,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LowestReputerScoreEma = append(m.LowestReputerScoreEma, &TopicIdActorIdScore{})
-			if err := m.LowestReputerScoreEma[len(m.LowestReputerScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerBondRemovals = append(m.WorkerBondRemovals, &WorkerBondRemovalInfo{})
+			if err := m.WorkerBondRemovals[len(m.WorkerBondRemovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 74:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossBundles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerConsensusDeviations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9361,16 +11748,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LossBundles = append(m.LossBundles, &TopicIdReputerReputerValueBundle{})
-			if err := m.LossBundles[len(m.LossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerConsensusDeviations = append(m.ReputerConsensusDeviations, &ReputerConsensusDeviation{})
+			if err := m.ReputerConsensusDeviations[len(m.ReputerConsensusDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 75:
+		case 105:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSumPreviousTopicWeights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9380,31 +11767,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalSumPreviousTopicWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Redelegations = append(m.Redelegations, &RedelegationInfo{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 76:
+		case 106:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardCurrentBlockEmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9414,63 +11801,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardCurrentBlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AutoCompoundDelegators = append(m.AutoCompoundDelegators, &TopicAndActorId{})
+			if err := m.AutoCompoundDelegators[len(m.AutoCompoundDelegators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 77:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistAdmins", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WhitelistAdmins = append(m.WhitelistAdmins, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 78:
+		case 107:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalWhitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerCommissions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9480,59 +11835,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalWhitelist = append(m.GlobalWhitelist, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 79:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicCreatorWhitelist", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ReputerCommissions = append(m.ReputerCommissions, &ReputerCommission{})
+			if err := m.ReputerCommissions[len(m.ReputerCommissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TopicCreatorWhitelist = append(m.TopicCreatorWhitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 80:
+		case 108:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkerWhitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeLocks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9559,14 +11884,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicWorkerWhitelist = append(m.TopicWorkerWhitelist, &TopicAndActorId{})
-			if err := m.TopicWorkerWhitelist[len(m.TopicWorkerWhitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StakeLocks = append(m.StakeLocks, &StakeLock{})
+			if err := m.StakeLocks[len(m.StakeLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 81:
+		case 109:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputerWhitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9593,166 +11918,101 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicReputerWhitelist = append(m.TopicReputerWhitelist, &TopicAndActorId{})
-			if err := m.TopicReputerWhitelist[len(m.TopicReputerWhitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardHistory = append(m.RewardHistory, &RewardRecord{})
+			if err := m.RewardHistory[len(m.RewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 82:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.TopicWorkerWhitelistEnabled = append(m.TopicWorkerWhitelistEnabled, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenesis
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenesis
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.TopicWorkerWhitelistEnabled) == 0 {
-					m.TopicWorkerWhitelistEnabled = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.TopicWorkerWhitelistEnabled = append(m.TopicWorkerWhitelistEnabled, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkerWhitelistEnabled", wireType)
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEscrows", wireType)
 			}
-		case 83:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				m.TopicReputerWhitelistEnabled = append(m.TopicReputerWhitelistEnabled, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenesis
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenesis
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardEscrows = append(m.RewardEscrows, &RewardEscrow{})
+			if err := m.RewardEscrows[len(m.RewardEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 111:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedRewardPayouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.TopicReputerWhitelistEnabled) == 0 {
-					m.TopicReputerWhitelistEnabled = make([]uint64, 0, elementCount)
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedRewardPayouts = append(m.QueuedRewardPayouts, &QueuedRewardPayout{})
+			if err := m.QueuedRewardPayouts[len(m.QueuedRewardPayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 112:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRewardPayoutId", wireType)
+			}
+			m.NextRewardPayoutId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.TopicReputerWhitelistEnabled = append(m.TopicReputerWhitelistEnabled, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRewardPayoutId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputerWhitelistEnabled", wireType)
 			}
-		case 84:
+		case 113:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastMedianInferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedTopicDelegateRemovalCursors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9779,14 +12039,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastMedianInferences = append(m.LastMedianInferences, &TopicIdAndDec{})
-			if err := m.LastMedianInferences[len(m.LastMedianInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ArchivedTopicDelegateRemovalCursors = append(m.ArchivedTopicDelegateRemovalCursors, &TopicIdDelegatorReputer{})
+			if err := m.ArchivedTopicDelegateRemovalCursors[len(m.ArchivedTopicDelegateRemovalCursors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 85:
+		case 114:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MadInferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetteredRewardPayouts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9813,14 +12073,83 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MadInferences = append(m.MadInferences, &TopicIdAndDec{})
-			if err := m.MadInferences[len(m.MadInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DeadLetteredRewardPayouts = append(m.DeadLetteredRewardPayouts, &QueuedRewardPayout{})
+			if err := m.DeadLetteredRewardPayouts[len(m.DeadLetteredRewardPayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 86:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdAndTopic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdAndTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialInfererEmaScore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9847,14 +12176,85 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialInfererEmaScore = append(m.InitialInfererEmaScore, &TopicIdAndDec{})
-			if err := m.InitialInfererEmaScore[len(m.InitialInfererEmaScore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Topic == nil {
+				m.Topic = &Topic{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 87:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdAndOptionalTopicParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdAndOptionalTopicParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdAndOptionalTopicParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialForecasterEmaScore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9881,16 +12281,68 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialForecasterEmaScore = append(m.InitialForecasterEmaScore, &TopicIdAndDec{})
-			if err := m.InitialForecasterEmaScore[len(m.InitialForecasterEmaScore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Params == nil {
+				m.Params = &OptionalTopicParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 88:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialReputerEmaScore", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdAndTag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdAndTag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdAndTag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9900,29 +12352,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InitialReputerEmaScore = append(m.InitialReputerEmaScore, &TopicIdAndDec{})
-			if err := m.InitialReputerEmaScore[len(m.InitialReputerEmaScore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 89:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalWorkerWhitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9950,13 +12387,63 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalWorkerWhitelist = append(m.GlobalWorkerWhitelist, string(dAtA[iNdEx:postIndex]))
+			m.Tag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 90:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalReputerWhitelist", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicAndActorId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicAndActorId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9966,27 +12453,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GlobalReputerWhitelist = append(m.GlobalReputerWhitelist, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 91:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalAdminWhitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10014,7 +12488,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalAdminWhitelist = append(m.GlobalAdminWhitelist, string(dAtA[iNdEx:postIndex]))
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10037,7 +12511,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
+func (m *TopicIdDelegatorReputer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10060,10 +12534,10 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndTopic: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdDelegatorReputer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdDelegatorReputer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10087,9 +12561,9 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -10099,27 +12573,55 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Topic == nil {
-				m.Topic = &Topic{}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10142,7 +12644,7 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
+func (m *TopicActorOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10165,10 +12667,10 @@ func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicAndActorId: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicActorOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicAndActorId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicActorOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10192,7 +12694,7 @@ func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10220,7 +12722,39 @@ func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
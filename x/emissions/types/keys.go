@@ -8,6 +8,7 @@ const (
 	AlloraStakingAccountName                   = "allorastaking"
 	AlloraRewardsAccountName                   = "allorarewards"
 	AlloraPendingRewardForDelegatorAccountName = "allorapendingrewards"
+	AlloraRewardEscrowAccountName              = "allorarewardescrow"
 )
 
 var (
@@ -111,4 +112,41 @@ var (
 	GlobalWorkerWhitelistKey                          = collections.NewPrefix(96)
 	GlobalReputerWhitelistKey                         = collections.NewPrefix(97)
 	GlobalAdminWhitelistKey                           = collections.NewPrefix(98)
+	PendingTopicUpdatesKey                            = collections.NewPrefix(99)
+	ArchivedTopicsKey                                 = collections.NewPrefix(100)
+	ArchivedTopicsToPruneKey                          = collections.NewPrefix(101)
+	TopicOwnersKey                                    = collections.NewPrefix(102)
+	PendingTopicOwnersKey                             = collections.NewPrefix(103)
+	TopicsByOwnerKey                                  = collections.NewPrefix(104)
+	TopicsByLossMethodKey                             = collections.NewPrefix(105)
+	TopicTagsKey                                      = collections.NewPrefix(106)
+	TopicsByTagKey                                    = collections.NewPrefix(107)
+	ReputerGroundTruthsKey                            = collections.NewPrefix(108)
+	ConsensusGroundTruthsKey                          = collections.NewPrefix(109)
+	WorkerPayloadCommitsKey                           = collections.NewPrefix(110)
+	ActorOperatorsKey                                 = collections.NewPrefix(111)
+	WorkerBondsKey                                    = collections.NewPrefix(112)
+	TotalWorkerBondsKey                               = collections.NewPrefix(113)
+	WorkerBondRemovalsByBlockKey                      = collections.NewPrefix(114)
+	WorkerBondRemovalsByActorKey                      = collections.NewPrefix(115)
+	ReputerConsensusDeviationsKey                     = collections.NewPrefix(116)
+	RedelegationsKey                                  = collections.NewPrefix(117)
+	RedelegationsByDestinationKey                     = collections.NewPrefix(118)
+	RedelegationsByBlockKey                           = collections.NewPrefix(119)
+	AutoCompoundDelegatorsKey                         = collections.NewPrefix(120)
+	DelegateStakePlacementsByDelegatorKey             = collections.NewPrefix(121)
+	ReputerCommissionsKey                             = collections.NewPrefix(122)
+	StakeLocksKey                                     = collections.NewPrefix(123)
+	StakeLocksByBlockKey                              = collections.NewPrefix(124)
+	RewardHistoryKey                                  = collections.NewPrefix(125)
+	RewardHistoryByActorKey                           = collections.NewPrefix(126)
+	RewardEscrowsKey                                  = collections.NewPrefix(127)
+	TotalRewardEscrowsKey                             = collections.NewPrefix(128)
+	RewardPayoutQueueKey                              = collections.NewPrefix(129)
+	RewardPayoutQueueByActorKey                       = collections.NewPrefix(130)
+	NextRewardPayoutIdKey                             = collections.NewPrefix(131)
+	TotalQueuedRewardPayoutsKey                       = collections.NewPrefix(132)
+	ArchivedTopicDelegateRemovalCursorsKey            = collections.NewPrefix(133)
+	DelegateStakePlacementsByReputerKey               = collections.NewPrefix(134)
+	DeadLetteredRewardPayoutsKey                      = collections.NewPrefix(135)
 )
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Loss methods of point estimate topics whose losses can be computed by the chain
+const (
+	LossMethodMSE     = "mse"
+	LossMethodMAE     = "mae"
+	LossMethodLogLoss = "logloss"
+	LossMethodHuber   = "huber"
+)
+
+var (
+	// Threshold between the quadratic and linear parts of the huber loss
+	huberLossDelta = alloraMath.OneDec()
+	// Probabilities are clamped this far away from 0 and 1 when computing the log loss
+	logLossEpsilon = alloraMath.MustNewDecFromString("0.000000000000001")
+)
+
+// PointLossFunction returns the loss of a point prediction once the ground truth is known
+type PointLossFunction func(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error)
+
+// QuantileLossFunction returns the loss of the quantile predicted at a level once the ground truth is known
+type QuantileLossFunction func(level, quantile, groundTruth alloraMath.Dec) (alloraMath.Dec, error)
+
+var pointLossFunctions = map[string]PointLossFunction{
+	LossMethodMSE: alloraMath.SquaredError,
+	LossMethodMAE: alloraMath.AbsoluteError,
+	LossMethodLogLoss: func(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+		return alloraMath.LogLoss(prediction, groundTruth, logLossEpsilon)
+	},
+	LossMethodHuber: func(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+		return alloraMath.HuberLoss(prediction, groundTruth, huberLossDelta)
+	},
+}
+
+// The average of these over the topic levels gives the mean pinball loss and the CRPS respectively.
+// The CRPS of a distribution given by its quantiles is approximated as twice their mean pinball loss
+var quantileLossFunctions = map[string]QuantileLossFunction{
+	LossMethodPinball: alloraMath.PinballLoss,
+	LossMethodCRPS: func(level, quantile, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+		loss, err := alloraMath.PinballLoss(level, quantile, groundTruth)
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+		return loss.Mul(alloraMath.NewDecFromInt64(2))
+	},
+}
+
+// GetPointLossFunction returns the built-in loss function of a point estimate loss method
+func GetPointLossFunction(lossMethod string) (PointLossFunction, bool) {
+	lossFunction, ok := pointLossFunctions[lossMethod]
+	return lossFunction, ok
+}
+
+// GetQuantileLossFunction returns the built-in loss function of a quantile loss method
+func GetQuantileLossFunction(lossMethod string) (QuantileLossFunction, bool) {
+	lossFunction, ok := quantileLossFunctions[lossMethod]
+	return lossFunction, ok
+}
+
+// ComputesLossesOnChain returns true if reputers of the topic submit ground truths
+// and the chain computes the losses
+func (topic Topic) ComputesLossesOnChain() bool {
+	return topic.LossComputationMode == LossComputationMode_LOSS_COMPUTATION_MODE_CHAIN
+}
+
+// ValidateLossComputation checks that topics whose losses are computed by the chain
+// name a built-in loss function that fits the kind of inferences they hold
+func ValidateLossComputation(mode LossComputationMode, lossMethod string, quantileLevels []alloraMath.Dec) error {
+	if _, ok := LossComputationMode_name[int32(mode)]; !ok {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "topic loss computation mode is unknown")
+	}
+	if mode != LossComputationMode_LOSS_COMPUTATION_MODE_CHAIN {
+		return nil
+	}
+	if len(quantileLevels) > 0 {
+		if _, ok := GetQuantileLossFunction(lossMethod); !ok {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "no built-in loss function %s for probabilistic topics", lossMethod)
+		}
+		return nil
+	}
+	if _, ok := GetPointLossFunction(lossMethod); !ok {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "no built-in loss function %s for point estimate topics", lossMethod)
+	}
+	return nil
+}
+
+// Returns the number of values of a ground truth of the topic. Probabilistic
+// topics predict the distribution of a single value
+func (topic Topic) groundTruthDimension() int {
+	if topic.HasVectorOutput() && !topic.IsProbabilistic() {
+		return int(topic.OutputDimension)
+	}
+	return 1
+}
+
+// ValidateGroundTruth checks that a ground truth holds a value per predicted component
+// and that its values are valid outcomes for the topic loss function
+func (topic Topic) ValidateGroundTruth(values []alloraMath.Dec) error {
+	if err := checkDimension(values, topic.groundTruthDimension(), "ground truth"); err != nil {
+		return err
+	}
+	for _, value := range values {
+		if err := ValidateDec(value); err != nil {
+			return errors.Wrap(ErrInvalidGroundTruth, err.Error())
+		}
+		if topic.LossMethod == LossMethodLogLoss && (value.IsNegative() || value.Gt(alloraMath.OneDec())) {
+			return errors.Wrapf(ErrInvalidGroundTruth, "log loss outcome %s is not between 0 and 1", value)
+		}
+	}
+	return nil
+}
+
+// IsChainComputed returns true if the bundle holds losses computed by the chain from the ground
+// truth submitted by its reputer, rather than losses signed and submitted by the reputer
+func (bundle *ReputerValueBundle) IsChainComputed() bool {
+	return bundle.ValueBundle != nil && bundle.Pubkey == "" && len(bundle.Signature) == 0
+}
@@ -0,0 +1,22 @@
+package types
+
+// MaxOperatorsPerActor is the maximum number of operators an actor can authorize in a topic
+const MaxOperatorsPerActor = 10
+
+// CommittingWorker returns the worker committing to the payload. This is the
+// sender unless the sender commits as an operator of the worker
+func (msg *CommitWorkerPayloadRequest) CommittingWorker() string {
+	if msg.Worker != "" {
+		return msg.Worker
+	}
+	return msg.Sender
+}
+
+// SubmittingReputer returns the reputer submitting the ground truth. This is the
+// sender unless the sender submits as an operator of the reputer
+func (msg *InsertReputerGroundTruthRequest) SubmittingReputer() string {
+	if msg.Reputer != "" {
+		return msg.Reputer
+	}
+	return msg.Sender
+}
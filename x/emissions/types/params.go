@@ -65,6 +65,20 @@ func DefaultParams() Params {
 		GlobalReputerWhitelistEnabled:       true,                                         // global reputer whitelist enabled => the global reputer whitelist determines which reputers can participate in all topics
 		GlobalAdminWhitelistAppended:        true,                                         // global admins enabled => the global admins whitelist determines which admins can create topics and participate in all topics as workers and reputers
 		MaxWhitelistInputArrayLength:        uint64(2000),                                 // maximum length of input arrays for whitelist operations
+		MaxArchivedTopicPrunesPerBlock:      uint64(500),                                  // maximum number of state entries of archived topics removed per block
+		MaxReputerStakeShare:                alloraMath.ZeroDec(),                         // largest share of a topic's stake one reputer may hold, zero disables the cap
+		StakeAboveCapExponent:               alloraMath.OneDec(),                          // exponent of the stake of a reputer above the max reputer stake share in consensus, one weights it linearly
+		StakeLockTiers: []StakeLockTier{ // months stake can be locked for and the multiplier of its weight in reputer rewards
+			{LockMonths: 1, RewardMultiplier: alloraMath.MustNewDecFromString("1.1")},
+			{LockMonths: 3, RewardMultiplier: alloraMath.MustNewDecFromString("1.25")},
+			{LockMonths: 6, RewardMultiplier: alloraMath.MustNewDecFromString("1.5")},
+		},
+		RewardHistoryRetentionBlocks:   int64((60 * 60 * 24 * 30) / 3),          // ~approx 1 month assuming 3 second block time, number of blocks rewards are kept in the reward history
+		MaxRewardPayoutsPerBlock:       uint64(500),                             // maximum number of queued rewards paid out per block
+		MaxRewardHistoryPrunesPerBlock: uint64(500),                             // maximum number of reward history records removed per block
+		MaxReputerCommissionRate:       alloraMath.MustNewDecFromString("0.2"),  // largest commission a reputer can take on the rewards of its delegators
+		MaxReputerCommissionChangeRate: alloraMath.MustNewDecFromString("0.01"), // largest change of a reputer commission per topic epoch
+		MaxRewardPayoutAttempts:        uint64(5),                               // number of failed payouts of a queued reward before it is dead-lettered
 	}
 }
 
@@ -223,6 +237,39 @@ func (p Params) Validate() error {
 	if err := validateMaxWhitelistInputArrayLength(p.MaxWhitelistInputArrayLength); err != nil {
 		return errorsmod.Wrap(err, "params validation failure: max whitelist input array length")
 	}
+	if err := validateMaxArchivedTopicPrunesPerBlock(p.MaxArchivedTopicPrunesPerBlock); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max archived topic prunes per block")
+	}
+	if err := validateMaxReputerStakeShare(p.MaxReputerStakeShare); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max reputer stake share")
+	}
+	if err := validateStakeAboveCapExponent(p.StakeAboveCapExponent); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: stake above cap exponent")
+	}
+	if err := validateStakeLockTiers(p.StakeLockTiers); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: stake lock tiers")
+	}
+	if err := validateRewardHistoryRetentionBlocks(p.RewardHistoryRetentionBlocks); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: reward history retention blocks")
+	}
+	if err := validateMaxRewardPayoutsPerBlock(p.MaxRewardPayoutsPerBlock); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max reward payouts per block")
+	}
+	if err := validateMaxRewardHistoryPrunesPerBlock(p.MaxRewardHistoryPrunesPerBlock); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max reward history prunes per block")
+	}
+	if err := validateMaxReputerCommissionRate(p.MaxReputerCommissionRate); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max reputer commission rate")
+	}
+	if err := validateMaxReputerCommissionChangeRate(p.MaxReputerCommissionChangeRate); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max reputer commission change rate")
+	}
+	if p.MaxReputerCommissionChangeRate.Gt(p.MaxReputerCommissionRate) {
+		return errorsmod.Wrap(ErrInvalidReputerCommission, "params validation failure: max reputer commission change rate cannot exceed max reputer commission rate")
+	}
+	if err := validateMaxRewardPayoutAttempts(p.MaxRewardPayoutAttempts); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max reward payout attempts")
+	}
 	return nil
 }
 
@@ -682,3 +729,102 @@ func validateGlobalAdminWhitelistAppended(_ bool) error {
 func validateMaxWhitelistInputArrayLength(_ uint64) error {
 	return nil
 }
+
+// Zero pauses the pruning of archived topics
+func validateMaxArchivedTopicPrunesPerBlock(_ uint64) error {
+	return nil
+}
+
+// Largest share of a topic's stake one reputer may hold with self and delegated stake.
+// Should be between 0 and 1 inclusive, zero disables the cap
+func validateMaxReputerStakeShare(i alloraMath.Dec) error {
+	if err := ValidateDec(i); err != nil {
+		return err
+	} else if !isAlloraDecBetweenZeroAndOneInclusive(i) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	return nil
+}
+
+// Exponent of the stake of a reputer above the max reputer stake share when weighting reputers for consensus.
+// Should be between 0 and 1 inclusive, so stake above the cap weighs sub-linearly. Zero ignores it and one weighs it linearly
+func validateStakeAboveCapExponent(i alloraMath.Dec) error {
+	if err := ValidateDec(i); err != nil {
+		return err
+	} else if !isAlloraDecBetweenZeroAndOneInclusive(i) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	return nil
+}
+
+// Lockup periods stake can be locked for, with the multiplier of locked stake in reputer reward fractions.
+// Each period should be a distinct positive number of months and each multiplier at least 1.
+// No tiers disables stake locking
+func validateStakeLockTiers(tiers []StakeLockTier) error {
+	seen := make(map[uint64]bool, len(tiers))
+	for _, tier := range tiers {
+		if tier.LockMonths == 0 {
+			return ErrValidationMustBeGreaterthanZero
+		}
+		if seen[tier.LockMonths] {
+			return fmt.Errorf("duplicate stake lock tier of %d months", tier.LockMonths)
+		}
+		seen[tier.LockMonths] = true
+		if err := ValidateDec(tier.RewardMultiplier); err != nil {
+			return err
+		}
+		if tier.RewardMultiplier.Lt(alloraMath.OneDec()) {
+			return fmt.Errorf("stake lock tier reward multiplier cannot be less than 1: %s", tier.RewardMultiplier)
+		}
+	}
+	return nil
+}
+
+// Number of blocks paid out rewards are kept in the reward history of actors and topics.
+// Should be >= 0, zero disables the reward history
+func validateRewardHistoryRetentionBlocks(i int64) error {
+	if i < 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Maximum number of queued rewards paid out per block, zero pays out the whole queue every block.
+// Any value is valid
+func validateMaxRewardPayoutsPerBlock(_ uint64) error {
+	return nil
+}
+
+// Maximum number of records that fell out of the reward history retention removed per block,
+// zero pauses pruning the reward history. Any value is valid
+func validateMaxRewardHistoryPrunesPerBlock(_ uint64) error {
+	return nil
+}
+
+// Largest commission a reputer can take on the rewards of its delegators.
+// Should be between 0 and 1 inclusive
+func validateMaxReputerCommissionRate(i alloraMath.Dec) error {
+	if err := ValidateDec(i); err != nil {
+		return err
+	} else if !isAlloraDecBetweenZeroAndOneInclusive(i) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	return nil
+}
+
+// Largest change of a reputer commission per topic epoch.
+// Should be between 0 and 1 inclusive
+func validateMaxReputerCommissionChangeRate(i alloraMath.Dec) error {
+	if err := ValidateDec(i); err != nil {
+		return err
+	} else if !isAlloraDecBetweenZeroAndOneInclusive(i) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	return nil
+}
+
+// Number of times a queued reward fails to be paid out before it is dead-lettered.
+// Any value is valid, zero retries without limit
+func validateMaxRewardPayoutAttempts(_ uint64) error {
+	return nil
+}
@@ -101,6 +101,17 @@ type Params struct {
 	GlobalReputerWhitelistEnabled      bool                                            `protobuf:"varint,57,opt,name=global_reputer_whitelist_enabled,json=globalReputerWhitelistEnabled,proto3" json:"global_reputer_whitelist_enabled,omitempty"`
 	GlobalAdminWhitelistAppended       bool                                            `protobuf:"varint,58,opt,name=global_admin_whitelist_appended,json=globalAdminWhitelistAppended,proto3" json:"global_admin_whitelist_appended,omitempty"`
 	MaxWhitelistInputArrayLength       uint64                                          `protobuf:"varint,59,opt,name=max_whitelist_input_array_length,json=maxWhitelistInputArrayLength,proto3" json:"max_whitelist_input_array_length,omitempty"`
+	MaxArchivedTopicPrunesPerBlock     uint64                                          `protobuf:"varint,60,opt,name=max_archived_topic_prunes_per_block,json=maxArchivedTopicPrunesPerBlock,proto3" json:"max_archived_topic_prunes_per_block,omitempty"`
+	MaxReputerStakeShare               github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,61,opt,name=max_reputer_stake_share,json=maxReputerStakeShare,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_reputer_stake_share"`
+	StakeAboveCapExponent              github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,62,opt,name=stake_above_cap_exponent,json=stakeAboveCapExponent,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"stake_above_cap_exponent"`
+	// lockup periods stake can be locked for when added or delegated, with the reward multiplier of each
+	StakeLockTiers                 []StakeLockTier                                 `protobuf:"bytes,63,rep,name=stake_lock_tiers,json=stakeLockTiers,proto3" json:"stake_lock_tiers"`
+	RewardHistoryRetentionBlocks   int64                                           `protobuf:"varint,64,opt,name=reward_history_retention_blocks,json=rewardHistoryRetentionBlocks,proto3" json:"reward_history_retention_blocks,omitempty"`
+	MaxRewardPayoutsPerBlock       uint64                                          `protobuf:"varint,65,opt,name=max_reward_payouts_per_block,json=maxRewardPayoutsPerBlock,proto3" json:"max_reward_payouts_per_block,omitempty"`
+	MaxRewardHistoryPrunesPerBlock uint64                                          `protobuf:"varint,66,opt,name=max_reward_history_prunes_per_block,json=maxRewardHistoryPrunesPerBlock,proto3" json:"max_reward_history_prunes_per_block,omitempty"`
+	MaxReputerCommissionRate       github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,67,opt,name=max_reputer_commission_rate,json=maxReputerCommissionRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_reputer_commission_rate"`
+	MaxReputerCommissionChangeRate github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,68,opt,name=max_reputer_commission_change_rate,json=maxReputerCommissionChangeRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_reputer_commission_change_rate"`
+	MaxRewardPayoutAttempts        uint64                                          `protobuf:"varint,69,opt,name=max_reward_payout_attempts,json=maxRewardPayoutAttempts,proto3" json:"max_reward_payout_attempts,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -318,118 +329,274 @@ func (m *Params) GetMaxWhitelistInputArrayLength() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxArchivedTopicPrunesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxArchivedTopicPrunesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetStakeLockTiers() []StakeLockTier {
+	if m != nil {
+		return m.StakeLockTiers
+	}
+	return nil
+}
+
+func (m *Params) GetRewardHistoryRetentionBlocks() int64 {
+	if m != nil {
+		return m.RewardHistoryRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRewardPayoutsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRewardPayoutsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRewardHistoryPrunesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRewardHistoryPrunesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRewardPayoutAttempts() uint64 {
+	if m != nil {
+		return m.MaxRewardPayoutAttempts
+	}
+	return 0
+}
+
+// A lockup period stake can be locked for and the multiplier applied to locked stake when
+// computing reputer reward fractions
+type StakeLockTier struct {
+	LockMonths       uint64                                          `protobuf:"varint,1,opt,name=lock_months,json=lockMonths,proto3" json:"lock_months,omitempty"`
+	RewardMultiplier github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=reward_multiplier,json=rewardMultiplier,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reward_multiplier"`
+}
+
+func (m *StakeLockTier) Reset()         { *m = StakeLockTier{} }
+func (m *StakeLockTier) String() string { return proto.CompactTextString(m) }
+func (*StakeLockTier) ProtoMessage()    {}
+func (*StakeLockTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c07ddd983414a3f, []int{1}
+}
+func (m *StakeLockTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakeLockTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakeLockTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakeLockTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeLockTier.Merge(m, src)
+}
+func (m *StakeLockTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakeLockTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeLockTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeLockTier proto.InternalMessageInfo
+
+func (m *StakeLockTier) GetLockMonths() uint64 {
+	if m != nil {
+		return m.LockMonths
+	}
+	return 0
+}
+
+type StakeLockTiers struct {
+	Tiers []StakeLockTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers"`
+}
+
+func (m *StakeLockTiers) Reset()         { *m = StakeLockTiers{} }
+func (m *StakeLockTiers) String() string { return proto.CompactTextString(m) }
+func (*StakeLockTiers) ProtoMessage()    {}
+func (*StakeLockTiers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c07ddd983414a3f, []int{2}
+}
+func (m *StakeLockTiers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakeLockTiers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakeLockTiers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakeLockTiers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeLockTiers.Merge(m, src)
+}
+func (m *StakeLockTiers) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakeLockTiers) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeLockTiers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeLockTiers proto.InternalMessageInfo
+
+func (m *StakeLockTiers) GetTiers() []StakeLockTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "emissions.v7.Params")
+	proto.RegisterType((*StakeLockTier)(nil), "emissions.v7.StakeLockTier")
+	proto.RegisterType((*StakeLockTiers)(nil), "emissions.v7.StakeLockTiers")
 }
 
 func init() { proto.RegisterFile("emissions/v7/params.proto", fileDescriptor_8c07ddd983414a3f) }
 
 var fileDescriptor_8c07ddd983414a3f = []byte{
-	// 1652 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0x24, 0x47,
-	0x15, 0xdf, 0x21, 0xde, 0x8d, 0x53, 0xd9, 0xd8, 0xb3, 0x8d, 0xd7, 0xdb, 0xfe, 0x37, 0x76, 0xd6,
-	0x0e, 0x4c, 0x4c, 0xd6, 0x13, 0x08, 0xe0, 0xfc, 0x41, 0x02, 0x3b, 0xb6, 0xa3, 0xb1, 0xd6, 0x8b,
-	0x69, 0x9b, 0x58, 0x5a, 0x10, 0x45, 0xb9, 0xfb, 0xcd, 0x4c, 0xc9, 0xdd, 0x55, 0x9d, 0xaa, 0x9a,
-	0xf1, 0x38, 0x47, 0x24, 0x2e, 0x9c, 0xf8, 0x18, 0x1c, 0x91, 0xe0, 0x43, 0xe4, 0x18, 0x71, 0x42,
-	0x1c, 0x22, 0xb4, 0x7b, 0xe0, 0x6b, 0xa0, 0x57, 0x55, 0xdd, 0x33, 0xb3, 0x36, 0x2b, 0xb4, 0x9d,
-	0x8b, 0xe5, 0xee, 0xfa, 0xbd, 0xdf, 0x7b, 0xfd, 0xea, 0x57, 0xef, 0xbd, 0x1a, 0xb2, 0x00, 0x19,
-	0xd7, 0x9a, 0x4b, 0xa1, 0x5b, 0x83, 0xed, 0x56, 0xce, 0x14, 0xcb, 0xf4, 0x56, 0xae, 0xa4, 0x91,
-	0xc1, 0xdd, 0x72, 0x69, 0x6b, 0xb0, 0xbd, 0x78, 0x8f, 0x65, 0x5c, 0xc8, 0x96, 0xfd, 0xeb, 0x00,
-	0x8b, 0x0b, 0xb1, 0xd4, 0x99, 0xd4, 0xd4, 0x3e, 0xb5, 0xdc, 0x83, 0x5f, 0x9a, 0xeb, 0xca, 0xae,
-	0x74, 0xef, 0xf1, 0x3f, 0xf7, 0xf6, 0xe1, 0xdf, 0x36, 0xc8, 0x9d, 0x63, 0xeb, 0x22, 0x08, 0xc9,
-	0xeb, 0x03, 0x50, 0xc8, 0x1e, 0xd6, 0xd6, 0x6a, 0xcd, 0x37, 0xa2, 0xe2, 0x31, 0xf8, 0x88, 0x2c,
-	0x64, 0x6c, 0x48, 0x35, 0x28, 0xce, 0x52, 0xfe, 0x25, 0x24, 0x34, 0xd3, 0x5d, 0x9a, 0x82, 0xe8,
-	0x9a, 0x5e, 0xf8, 0x9d, 0xb5, 0x5a, 0xf3, 0xb5, 0x68, 0x3e, 0x63, 0xc3, 0x93, 0x72, 0xfd, 0x48,
-	0x77, 0x1f, 0xdb, 0xd5, 0x80, 0x91, 0x7a, 0xc6, 0x05, 0x35, 0x32, 0xe7, 0x31, 0xbd, 0x04, 0xde,
-	0xed, 0x99, 0xf0, 0x35, 0x64, 0xdf, 0xdd, 0xfe, 0xea, 0x9b, 0xd5, 0x5b, 0xff, 0xfa, 0x66, 0xb5,
-	0xd5, 0xe5, 0xa6, 0xd7, 0x3f, 0xdf, 0x8a, 0x65, 0xd6, 0x62, 0x69, 0x2a, 0x15, 0x7b, 0x24, 0xc0,
-	0x5c, 0x4a, 0x75, 0x51, 0x3c, 0xc6, 0x3d, 0xc6, 0x45, 0x2b, 0x63, 0xa6, 0xb7, 0xb5, 0x07, 0x71,
-	0x34, 0x93, 0x71, 0x71, 0x8a, 0x7c, 0x67, 0x96, 0x2e, 0xe8, 0x90, 0x79, 0x05, 0x5f, 0xf4, 0xb9,
-	0xc2, 0xb8, 0xb8, 0xe0, 0x59, 0x3f, 0xa3, 0xda, 0xb0, 0x0b, 0x08, 0x6f, 0x5b, 0x47, 0xef, 0x7b,
-	0x47, 0xf7, 0x5d, 0x3a, 0x74, 0x72, 0xb1, 0xc5, 0xa5, 0xa3, 0x6b, 0x0b, 0xf3, 0x8f, 0xbf, 0x3f,
-	0x22, 0x3e, 0x4f, 0x6d, 0x61, 0xfe, 0xf2, 0x9f, 0xbf, 0x6e, 0xd6, 0xa2, 0xb9, 0x82, 0xef, 0xc8,
-	0xd1, 0x9d, 0x20, 0x1b, 0x66, 0x41, 0x41, 0x26, 0x07, 0xe0, 0xd8, 0x69, 0x02, 0x29, 0xbb, 0xa2,
-	0x97, 0x5c, 0x24, 0xf2, 0x32, 0xbc, 0xe3, 0xb2, 0xe0, 0x00, 0x16, 0xbf, 0x87, 0xcb, 0x67, 0x76,
-	0x35, 0x68, 0xba, 0x2c, 0x40, 0x2e, 0xe3, 0x5e, 0x91, 0xb7, 0xd7, 0xad, 0x05, 0x7e, 0xcc, 0x3e,
-	0xbe, 0xf6, 0xf9, 0x7a, 0x4a, 0xee, 0x9e, 0x83, 0x61, 0x14, 0x84, 0x51, 0x32, 0xbf, 0x0a, 0xa7,
-	0xab, 0xe5, 0xea, 0x4d, 0x24, 0xdb, 0x77, 0x5c, 0xc1, 0x6f, 0xc9, 0x5b, 0x29, 0x30, 0x25, 0xb8,
-	0xe8, 0x52, 0xc5, 0x0c, 0x84, 0x6f, 0x54, 0x23, 0xbf, 0x5b, 0xb0, 0x45, 0xcc, 0x40, 0x90, 0x11,
-	0xd4, 0x00, 0xed, 0x2a, 0x96, 0x70, 0x10, 0x86, 0x9a, 0x9e, 0x02, 0xdd, 0x93, 0x69, 0x12, 0x92,
-	0x6a, 0x6e, 0xe6, 0x32, 0x36, 0xfc, 0xcc, 0xb3, 0x9e, 0x16, 0xa4, 0x01, 0x90, 0x00, 0x53, 0xea,
-	0xb6, 0xa2, 0xa3, 0x58, 0x6c, 0x50, 0xb8, 0x6f, 0x56, 0x73, 0x85, 0xbb, 0x64, 0x37, 0xef, 0xc0,
-	0x13, 0x06, 0xfb, 0x64, 0x15, 0xbf, 0xaa, 0x2f, 0x3a, 0xfd, 0xb4, 0xc3, 0xd3, 0x14, 0x12, 0x8a,
-	0xf6, 0xa0, 0x28, 0x6a, 0x04, 0xb4, 0xd1, 0xe1, 0x5b, 0x6b, 0xb5, 0xe6, 0x54, 0xb4, 0x9c, 0xb1,
-	0xe1, 0xaf, 0x47, 0xa8, 0x33, 0x0b, 0x8a, 0x3c, 0x26, 0xf8, 0x8c, 0xac, 0xbd, 0x48, 0xa3, 0x20,
-	0xef, 0x9b, 0x71, 0x9e, 0x19, 0xcb, 0xb3, 0x32, 0xc9, 0x13, 0x39, 0x54, 0x49, 0xf4, 0x25, 0x59,
-	0x71, 0x67, 0x49, 0xc1, 0x25, 0x53, 0x89, 0xff, 0x7e, 0x9e, 0xe5, 0x52, 0x19, 0x26, 0x62, 0x08,
-	0x67, 0xab, 0x65, 0x60, 0xd1, 0xb2, 0x47, 0x96, 0xdc, 0x66, 0xa2, 0x5d, 0x52, 0x07, 0x7f, 0xac,
-	0x91, 0xf5, 0x09, 0xe7, 0x1d, 0x00, 0xaa, 0x60, 0x00, 0xa2, 0x3f, 0x11, 0x42, 0xbd, 0x5a, 0x08,
-	0xab, 0x63, 0x21, 0x1c, 0x00, 0x44, 0xce, 0xc1, 0x58, 0x1c, 0x40, 0x82, 0x89, 0x30, 0x58, 0x9a,
-	0xf7, 0x58, 0x78, 0xaf, 0xe2, 0xd6, 0x8f, 0x79, 0xdd, 0x41, 0xc2, 0x20, 0x26, 0xf7, 0x0c, 0xd3,
-	0x17, 0x93, 0x5e, 0x82, 0x6a, 0x5e, 0x66, 0x91, 0x71, 0xdc, 0x09, 0xe6, 0x74, 0xc0, 0x52, 0x9e,
-	0x30, 0x23, 0x95, 0xa6, 0x03, 0x4d, 0x9d, 0x21, 0xcd, 0x41, 0xc5, 0x78, 0x8c, 0x9c, 0xf7, 0xf0,
-	0xbb, 0x15, 0x73, 0x3a, 0xf2, 0xf1, 0xb9, 0xde, 0xb1, 0x90, 0x63, 0xe7, 0xc0, 0x05, 0x13, 0xfc,
-	0x8c, 0x2c, 0xd9, 0x12, 0xcf, 0xb2, 0x3c, 0x05, 0x4d, 0x8d, 0xa4, 0x3a, 0x66, 0x29, 0x50, 0x1d,
-	0x4b, 0x05, 0x3a, 0x9c, 0xb3, 0xda, 0x7c, 0x80, 0x45, 0xde, 0x21, 0x4e, 0xe5, 0x09, 0xae, 0x9f,
-	0xd8, 0xe5, 0xe0, 0x63, 0xb2, 0x88, 0xd6, 0x46, 0xe6, 0x94, 0x8b, 0x0e, 0x28, 0x50, 0x96, 0xc2,
-	0xc7, 0x7e, 0xdf, 0x1a, 0x63, 0x75, 0x38, 0x95, 0x79, 0xdb, 0xaf, 0x9f, 0x4a, 0xef, 0xf9, 0x17,
-	0x64, 0xa5, 0xb0, 0xed, 0x48, 0x05, 0x31, 0xd3, 0x66, 0xd2, 0x7c, 0xde, 0x9a, 0x2f, 0x38, 0xf3,
-	0x83, 0x11, 0xa4, 0x64, 0x18, 0xf3, 0xee, 0x0f, 0xd5, 0xb8, 0xf9, 0x83, 0x71, 0xef, 0xfe, 0x38,
-	0x8d, 0x6c, 0x9f, 0x92, 0x7a, 0xac, 0x80, 0x19, 0xf0, 0x2d, 0xaa, 0x03, 0x10, 0x86, 0xaf, 0xd8,
-	0x36, 0x66, 0x1c, 0x93, 0xed, 0x4d, 0x07, 0x00, 0xc1, 0x27, 0x64, 0xb1, 0xac, 0x86, 0x09, 0x68,
-	0xbb, 0x9d, 0x18, 0x28, 0xc7, 0x08, 0xc2, 0x05, 0x97, 0xd2, 0x02, 0xb1, 0xe7, 0x00, 0x47, 0x6c,
-	0xd8, 0xc6, 0xe5, 0xe0, 0x37, 0xa4, 0xae, 0xa0, 0xcb, 0xb5, 0x51, 0x0c, 0x0b, 0x91, 0x0d, 0x6c,
-	0xf9, 0x15, 0x03, 0x9b, 0x1d, 0x67, 0xc2, 0xc8, 0xde, 0x23, 0x41, 0x02, 0x1d, 0xd6, 0x4f, 0x0d,
-	0xcd, 0x59, 0x17, 0x68, 0xca, 0x33, 0x6e, 0xc2, 0x15, 0x1b, 0x51, 0xdd, 0xaf, 0x1c, 0xb3, 0x2e,
-	0x3c, 0xc6, 0xf7, 0xc1, 0x06, 0x99, 0xc1, 0xb0, 0xc7, 0x90, 0x0d, 0x8b, 0xbc, 0x9b, 0xb1, 0xe1,
-	0x08, 0x85, 0xfb, 0xf8, 0x42, 0x8f, 0xa3, 0x0a, 0x62, 0xa9, 0x12, 0x6f, 0xb4, 0x6a, 0x1b, 0xde,
-	0xc2, 0x64, 0xc3, 0x8b, 0x2c, 0xc2, 0x31, 0x34, 0x49, 0xfd, 0x3c, 0x95, 0xf1, 0x85, 0x46, 0xf1,
-	0xd3, 0x4c, 0x0a, 0xd3, 0x0b, 0xd7, 0xac, 0xa7, 0x19, 0xf7, 0xfe, 0x18, 0xd4, 0x11, 0xbe, 0xc5,
-	0x0a, 0x90, 0x17, 0xe7, 0xd2, 0x09, 0x0e, 0xeb, 0xce, 0xdb, 0x15, 0x2b, 0x40, 0xee, 0x34, 0xd1,
-	0x2e, 0x08, 0xb1, 0x02, 0x94, 0x6e, 0x0a, 0x6d, 0x86, 0x0f, 0x2b, 0x56, 0x00, 0xef, 0xa5, 0x10,
-	0x32, 0x4e, 0x48, 0xa5, 0x13, 0x2f, 0xdf, 0x70, 0xbd, 0xe2, 0x84, 0xe4, 0x7d, 0x78, 0xb5, 0x63,
-	0xba, 0xe2, 0xeb, 0xe9, 0xda, 0xa8, 0x98, 0xae, 0xf8, 0x86, 0x74, 0xc5, 0xd7, 0xd2, 0xf5, 0x4e,
-	0xc5, 0x74, 0xc5, 0x2f, 0xa4, 0xeb, 0x09, 0xb9, 0x13, 0x53, 0x21, 0x55, 0x16, 0x7e, 0xaf, 0x1a,
-	0xf3, 0xed, 0xf8, 0x89, 0x54, 0x59, 0xf0, 0x7b, 0x32, 0x0b, 0xb9, 0xe6, 0xa9, 0x14, 0x65, 0xf6,
-	0x9b, 0x15, 0xb3, 0xef, 0xf9, 0x8a, 0xec, 0x7f, 0x4e, 0xde, 0xed, 0xb1, 0xb4, 0x63, 0x8f, 0x7e,
-	0xae, 0x64, 0x0c, 0x5a, 0xfb, 0xb6, 0x6d, 0xa7, 0x45, 0x96, 0x6a, 0x0a, 0x22, 0xa1, 0x56, 0xe2,
-	0xe1, 0xa6, 0xd5, 0xfb, 0x3a, 0x1a, 0x1c, 0xb1, 0xe1, 0xb1, 0x83, 0xdb, 0x46, 0x1c, 0x79, 0xf0,
-	0xbe, 0x48, 0x76, 0x11, 0x8a, 0xc2, 0x29, 0x22, 0xd7, 0xac, 0x03, 0x34, 0xe1, 0x83, 0xf0, 0x07,
-	0xdf, 0x4e, 0xe8, 0x27, 0xac, 0x03, 0x7b, 0x7c, 0x80, 0xd5, 0x31, 0x61, 0x86, 0x51, 0x0d, 0x22,
-	0xc1, 0xa9, 0x11, 0x8b, 0xd0, 0x7b, 0xaf, 0x5a, 0x1d, 0x91, 0xe9, 0xc4, 0x11, 0x61, 0x0d, 0xf2,
-	0x97, 0x0a, 0x48, 0x21, 0x03, 0x61, 0xdc, 0x99, 0x2f, 0x55, 0xf3, 0xa8, 0x2c, 0xda, 0xfb, 0x7e,
-	0xfd, 0x18, 0x54, 0xa9, 0x01, 0xdf, 0xac, 0x70, 0x44, 0x1b, 0xf8, 0xc2, 0xed, 0xec, 0x5d, 0x0e,
-	0xb7, 0xca, 0x66, 0xb5, 0x63, 0x11, 0xb6, 0x20, 0x23, 0x81, 0xcb, 0xdb, 0x26, 0xb9, 0x67, 0x5b,
-	0x9d, 0x51, 0xf8, 0x49, 0x7e, 0x1a, 0x6f, 0x59, 0x9b, 0x59, 0x6c, 0x70, 0xf6, 0xbd, 0x1f, 0xc7,
-	0x25, 0x79, 0xc0, 0x05, 0x37, 0x9c, 0xa5, 0x54, 0x41, 0x57, 0x81, 0xa1, 0x5f, 0xf4, 0x99, 0x30,
-	0x3c, 0x85, 0xf0, 0xfd, 0x6a, 0xa9, 0xbe, 0xef, 0x79, 0x23, 0x4b, 0xfb, 0x2b, 0xcf, 0x1a, 0xfc,
-	0x8e, 0xcc, 0xe6, 0x56, 0xde, 0xa3, 0x3d, 0xfd, 0x61, 0xc5, 0x29, 0x3d, 0x47, 0x9d, 0x17, 0x3b,
-	0xfa, 0x73, 0x12, 0x76, 0x53, 0x79, 0xce, 0x52, 0x7a, 0xd9, 0xe3, 0x06, 0x52, 0xae, 0x0d, 0x05,
-	0xc1, 0xce, 0x53, 0x48, 0xc2, 0x1f, 0xad, 0xd5, 0x9a, 0xd3, 0xbb, 0xb7, 0xdd, 0x76, 0xcd, 0x3b,
-	0xd8, 0x59, 0x81, 0xda, 0x77, 0xa0, 0xe0, 0x31, 0x71, 0xf3, 0x19, 0xb5, 0xcd, 0x4e, 0xaa, 0x1b,
-	0x78, 0x3e, 0x18, 0xe7, 0x59, 0xb6, 0xe8, 0x4f, 0x1d, 0xf8, 0x1a, 0xdb, 0xa7, 0xa4, 0x61, 0x9b,
-	0xc6, 0x30, 0x07, 0xc5, 0xb1, 0x88, 0x8c, 0x8d, 0xd7, 0x98, 0x17, 0x1d, 0xfe, 0xd8, 0x6e, 0xcc,
-	0x12, 0x76, 0x8d, 0x11, 0xa8, 0x98, 0xae, 0x2d, 0x24, 0xf8, 0x53, 0x8d, 0xbc, 0x53, 0x96, 0x35,
-	0x2a, 0xfb, 0x26, 0xe5, 0xa0, 0x68, 0x02, 0x06, 0xec, 0x0c, 0x3f, 0x76, 0x13, 0xf9, 0x49, 0xb5,
-	0x54, 0x3e, 0x2c, 0xbd, 0xfc, 0xd2, 0x39, 0xd9, 0x2b, 0x7c, 0x8c, 0xee, 0x25, 0x7f, 0xa8, 0x91,
-	0xb7, 0x5f, 0x16, 0x8c, 0x1b, 0x23, 0x7f, 0x5a, 0x2d, 0x90, 0xc6, 0xff, 0x0c, 0xc4, 0x4d, 0x95,
-	0x9c, 0xcc, 0xa5, 0x2c, 0x3b, 0x4f, 0x18, 0x2d, 0xd4, 0x6b, 0xe7, 0xb8, 0x70, 0xbb, 0x9a, 0xdb,
-	0xc0, 0x91, 0xb6, 0x1d, 0xa7, 0x9d, 0xfd, 0x50, 0x0f, 0x85, 0xa0, 0xdc, 0xc6, 0x5d, 0xd7, 0xc3,
-	0x87, 0x13, 0x7a, 0xf0, 0xba, 0xb2, 0xe0, 0x6b, 0x7a, 0x78, 0x42, 0xd6, 0x3c, 0x5b, 0x71, 0x3d,
-	0xba, 0x4e, 0xf7, 0xd1, 0x38, 0xdd, 0x8a, 0x83, 0xfb, 0x5a, 0x7b, 0x93, 0x5a, 0x3d, 0x1f, 0x4b,
-	0x50, 0x68, 0x23, 0x36, 0x96, 0xe7, 0x20, 0x12, 0x48, 0xc2, 0x8f, 0x6f, 0x88, 0x6e, 0x07, 0xc1,
-	0x25, 0xd9, 0x8e, 0x87, 0x06, 0x47, 0xee, 0x16, 0x37, 0x22, 0xe1, 0x22, 0xef, 0x1b, 0xca, 0x94,
-	0x62, 0x57, 0x45, 0x21, 0xf9, 0x04, 0xf5, 0x5a, 0xd2, 0x65, 0x6c, 0x58, 0xd2, 0xb4, 0x11, 0xbc,
-	0x83, 0x58, 0x57, 0x5c, 0x0e, 0xa7, 0xa6, 0xa7, 0xea, 0xb7, 0x0f, 0xa7, 0xa6, 0x17, 0xeb, 0x4b,
-	0x87, 0x53, 0xd3, 0x4b, 0xf5, 0xe5, 0xc3, 0xa9, 0xe9, 0xef, 0xd7, 0x9b, 0x87, 0x53, 0xd3, 0xef,
-	0xd6, 0x37, 0xed, 0xb5, 0xf7, 0x5a, 0x6d, 0xb3, 0x07, 0x81, 0x42, 0xa7, 0x03, 0x63, 0xb5, 0xaf,
-	0xb8, 0x83, 0x45, 0xeb, 0x68, 0xa2, 0xc0, 0x28, 0xee, 0x46, 0x78, 0x77, 0x8b, 0xa4, 0x42, 0x8a,
-	0x18, 0xb4, 0xdf, 0x1f, 0x7f, 0x20, 0x27, 0xee, 0x6e, 0x09, 0xc4, 0xec, 0xca, 0xfe, 0x24, 0x10,
-	0x6d, 0xbc, 0x94, 0xc2, 0x6f, 0xca, 0x6e, 0xf4, 0xd5, 0xb3, 0x46, 0xed, 0xeb, 0x67, 0x8d, 0xda,
-	0xbf, 0x9f, 0x35, 0x6a, 0x7f, 0x7e, 0xde, 0xb8, 0xf5, 0xf5, 0xf3, 0xc6, 0xad, 0x7f, 0x3e, 0x6f,
-	0xdc, 0x7a, 0xfa, 0xe1, 0xff, 0xa9, 0xa9, 0x61, 0x6b, 0xf4, 0x2b, 0x97, 0xb9, 0xca, 0x41, 0x9f,
-	0xdf, 0xb1, 0x3f, 0x48, 0x7d, 0xf0, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc5, 0xa1, 0x16, 0xa7,
-	0xff, 0x12, 0x00, 0x00,
+	// 1985 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x73, 0x1c, 0x47,
+	0x15, 0xf7, 0xc6, 0xb2, 0xa3, 0xb4, 0x6d, 0x69, 0x3d, 0xf8, 0xcf, 0x48, 0xb2, 0x56, 0x8a, 0xec,
+	0x80, 0xe2, 0xc4, 0xda, 0x90, 0x00, 0xca, 0x3f, 0x48, 0xf4, 0xcf, 0x41, 0xc2, 0x32, 0x62, 0x24,
+	0xe2, 0x2a, 0x43, 0xd1, 0xb4, 0x66, 0xde, 0xee, 0x76, 0x69, 0xa6, 0x7b, 0xd2, 0xdd, 0xbb, 0x5a,
+	0xe5, 0x08, 0xc5, 0x85, 0x13, 0x27, 0x3e, 0x03, 0x47, 0x0e, 0x7c, 0x88, 0x1c, 0x53, 0x9c, 0x28,
+	0x0e, 0x29, 0xb0, 0x0f, 0x7c, 0x0d, 0xea, 0x75, 0xf7, 0xcc, 0xce, 0x4a, 0x4a, 0x2a, 0xe5, 0xc9,
+	0x45, 0xa5, 0x9d, 0xfe, 0xf5, 0xef, 0xbd, 0xf9, 0xbd, 0xd7, 0xef, 0xbd, 0x69, 0x32, 0x03, 0x19,
+	0xd7, 0x9a, 0x4b, 0xa1, 0xdb, 0x83, 0xd5, 0x76, 0xce, 0x14, 0xcb, 0xf4, 0x4a, 0xae, 0xa4, 0x91,
+	0xc1, 0xd5, 0x72, 0x69, 0x65, 0xb0, 0x3a, 0x7b, 0x9d, 0x65, 0x5c, 0xc8, 0xb6, 0xfd, 0xeb, 0x00,
+	0xb3, 0x33, 0xb1, 0xd4, 0x99, 0xd4, 0xd4, 0xfe, 0x6a, 0xbb, 0x1f, 0x7e, 0xe9, 0x46, 0x57, 0x76,
+	0xa5, 0x7b, 0x8e, 0xff, 0xb9, 0xa7, 0x4b, 0xff, 0x7d, 0x83, 0x5c, 0xde, 0xb3, 0x26, 0x82, 0x90,
+	0xbc, 0x3c, 0x00, 0x85, 0xec, 0x61, 0x63, 0xb1, 0xb1, 0xfc, 0x4a, 0x54, 0xfc, 0x0c, 0xde, 0x23,
+	0x33, 0x19, 0x1b, 0x52, 0x0d, 0x8a, 0xb3, 0x94, 0x7f, 0x0e, 0x09, 0xcd, 0x74, 0x97, 0xa6, 0x20,
+	0xba, 0xa6, 0x17, 0xbe, 0xb4, 0xd8, 0x58, 0xbe, 0x18, 0xdd, 0xca, 0xd8, 0x70, 0xbf, 0x5c, 0xdf,
+	0xd5, 0xdd, 0x47, 0x76, 0x35, 0x60, 0xa4, 0x99, 0x71, 0x41, 0x8d, 0xcc, 0x79, 0x4c, 0x8f, 0x81,
+	0x77, 0x7b, 0x26, 0xbc, 0x88, 0xec, 0xeb, 0xab, 0x5f, 0x7c, 0xb5, 0x70, 0xe1, 0xdf, 0x5f, 0x2d,
+	0xb4, 0xbb, 0xdc, 0xf4, 0xfa, 0x87, 0x2b, 0xb1, 0xcc, 0xda, 0x2c, 0x4d, 0xa5, 0x62, 0x0f, 0x04,
+	0x98, 0x63, 0xa9, 0x8e, 0x8a, 0x9f, 0x71, 0x8f, 0x71, 0xd1, 0xce, 0x98, 0xe9, 0xad, 0x6c, 0x42,
+	0x1c, 0x4d, 0x65, 0x5c, 0x1c, 0x20, 0xdf, 0x13, 0x4b, 0x17, 0x74, 0xc8, 0x2d, 0x05, 0x9f, 0xf5,
+	0xb9, 0x42, 0xbf, 0xb8, 0xe0, 0x59, 0x3f, 0xa3, 0xda, 0xb0, 0x23, 0x08, 0x2f, 0x59, 0x43, 0x6f,
+	0x79, 0x43, 0x37, 0x9d, 0x1c, 0x3a, 0x39, 0x5a, 0xe1, 0xd2, 0xd1, 0x6d, 0x0b, 0xf3, 0xcf, 0x7f,
+	0x3c, 0x20, 0x5e, 0xa7, 0x6d, 0x61, 0xfe, 0xf6, 0xbf, 0xbf, 0xdf, 0x6f, 0x44, 0x37, 0x0a, 0xbe,
+	0x5d, 0x47, 0xb7, 0x8f, 0x6c, 0xa8, 0x82, 0x82, 0x4c, 0x0e, 0xc0, 0xb1, 0xd3, 0x04, 0x52, 0x76,
+	0x42, 0x8f, 0xb9, 0x48, 0xe4, 0x71, 0x78, 0xd9, 0xa9, 0xe0, 0x00, 0x16, 0xbf, 0x89, 0xcb, 0x4f,
+	0xec, 0x6a, 0xb0, 0xec, 0x54, 0x80, 0x5c, 0xc6, 0xbd, 0x42, 0xb7, 0x97, 0xed, 0x0e, 0x7c, 0x99,
+	0x2d, 0x7c, 0xec, 0xf5, 0x7a, 0x4a, 0xae, 0x1e, 0x82, 0x61, 0x14, 0x84, 0x51, 0x32, 0x3f, 0x09,
+	0x27, 0xeb, 0x69, 0x75, 0x05, 0xc9, 0xb6, 0x1c, 0x57, 0xf0, 0x5b, 0x72, 0x2d, 0x05, 0xa6, 0x04,
+	0x17, 0x5d, 0xaa, 0x98, 0x81, 0xf0, 0x95, 0x7a, 0xe4, 0x57, 0x0b, 0xb6, 0x88, 0x19, 0x08, 0x32,
+	0x82, 0x39, 0x40, 0xbb, 0x8a, 0x25, 0x1c, 0x84, 0xa1, 0xa6, 0xa7, 0x40, 0xf7, 0x64, 0x9a, 0x84,
+	0xa4, 0x9e, 0x99, 0x1b, 0x19, 0x1b, 0x7e, 0xe2, 0x59, 0x0f, 0x0a, 0xd2, 0x00, 0x48, 0x80, 0x92,
+	0xba, 0x50, 0x74, 0x14, 0x8b, 0x0d, 0x26, 0xee, 0x95, 0x7a, 0xa6, 0x30, 0x4a, 0x36, 0x78, 0x0f,
+	0x3d, 0x61, 0xb0, 0x45, 0x16, 0xf0, 0xad, 0xfa, 0xa2, 0xd3, 0x4f, 0x3b, 0x3c, 0x4d, 0x21, 0xa1,
+	0xb8, 0x1f, 0x14, 0xc5, 0x1c, 0x01, 0x6d, 0x74, 0x78, 0x6d, 0xb1, 0xb1, 0x3c, 0x11, 0xdd, 0xc9,
+	0xd8, 0xf0, 0xd7, 0x23, 0xd4, 0x13, 0x0b, 0x8a, 0x3c, 0x26, 0xf8, 0x84, 0x2c, 0x9e, 0xa6, 0x51,
+	0x90, 0xf7, 0x4d, 0x95, 0x67, 0xca, 0xf2, 0xcc, 0x8f, 0xf3, 0x44, 0x0e, 0x55, 0x12, 0x7d, 0x4e,
+	0xe6, 0xdd, 0x59, 0x52, 0x70, 0xcc, 0x54, 0xe2, 0xdf, 0x9f, 0x67, 0xb9, 0x54, 0x86, 0x89, 0x18,
+	0xc2, 0xe9, 0x7a, 0x0a, 0xcc, 0x5a, 0xf6, 0xc8, 0x92, 0x5b, 0x25, 0xb6, 0x4b, 0xea, 0xe0, 0x4f,
+	0x0d, 0x72, 0x77, 0xcc, 0x78, 0x07, 0x80, 0x2a, 0x18, 0x80, 0xe8, 0x8f, 0xb9, 0xd0, 0xac, 0xe7,
+	0xc2, 0x42, 0xc5, 0x85, 0x87, 0x00, 0x91, 0x33, 0x50, 0xf1, 0x03, 0x48, 0x30, 0xe6, 0x06, 0x4b,
+	0xf3, 0x1e, 0x0b, 0xaf, 0xd7, 0x0c, 0x7d, 0xc5, 0xea, 0x1a, 0x12, 0x06, 0x31, 0xb9, 0x6e, 0x98,
+	0x3e, 0x1a, 0xb7, 0x12, 0xd4, 0xb3, 0x32, 0x8d, 0x8c, 0x55, 0x23, 0xa8, 0xe9, 0x80, 0xa5, 0x3c,
+	0x61, 0x46, 0x2a, 0x4d, 0x07, 0x9a, 0xba, 0x8d, 0x34, 0x07, 0x15, 0xe3, 0x31, 0x72, 0xd6, 0xc3,
+	0xef, 0xd5, 0xd4, 0x74, 0x64, 0xe3, 0x53, 0xbd, 0x66, 0x21, 0x7b, 0xce, 0x80, 0x73, 0x26, 0xf8,
+	0x90, 0xcc, 0xd9, 0x12, 0xcf, 0xb2, 0x3c, 0x05, 0x4d, 0x8d, 0xa4, 0x3a, 0x66, 0x29, 0x50, 0x1d,
+	0x4b, 0x05, 0x3a, 0xbc, 0x61, 0x73, 0xf3, 0x36, 0x16, 0x79, 0x87, 0x38, 0x90, 0xfb, 0xb8, 0xbe,
+	0x6f, 0x97, 0x83, 0xf7, 0xc9, 0x2c, 0xee, 0x36, 0x32, 0xa7, 0x5c, 0x74, 0x40, 0x81, 0xb2, 0x14,
+	0xde, 0xf7, 0x9b, 0x76, 0x33, 0x56, 0x87, 0x03, 0x99, 0x6f, 0xfb, 0xf5, 0x03, 0xe9, 0x2d, 0x7f,
+	0x4c, 0xe6, 0x8b, 0xbd, 0x1d, 0xa9, 0x20, 0x66, 0xda, 0x8c, 0x6f, 0xbf, 0x65, 0xb7, 0xcf, 0xb8,
+	0xed, 0x0f, 0x47, 0x90, 0x92, 0xa1, 0x62, 0xdd, 0x1f, 0xaa, 0xea, 0xf6, 0xdb, 0x55, 0xeb, 0xfe,
+	0x38, 0x8d, 0xf6, 0x3e, 0x25, 0xcd, 0x58, 0x01, 0x33, 0xe0, 0x5b, 0x54, 0x07, 0x20, 0x0c, 0x5f,
+	0xb0, 0x6d, 0x4c, 0x39, 0x26, 0xdb, 0x9b, 0x1e, 0x02, 0x04, 0x1f, 0x90, 0xd9, 0xb2, 0x1a, 0x26,
+	0xa0, 0x6d, 0x38, 0xd1, 0x51, 0x8e, 0x1e, 0x84, 0x33, 0x4e, 0xd2, 0x02, 0xb1, 0xe9, 0x00, 0xbb,
+	0x6c, 0xb8, 0x8d, 0xcb, 0xc1, 0x6f, 0x48, 0x53, 0x41, 0x97, 0x6b, 0xa3, 0x18, 0x16, 0x22, 0xeb,
+	0xd8, 0x9d, 0x17, 0x74, 0x6c, 0xba, 0xca, 0x84, 0x9e, 0xbd, 0x49, 0x82, 0x04, 0x3a, 0xac, 0x9f,
+	0x1a, 0x9a, 0xb3, 0x2e, 0xd0, 0x94, 0x67, 0xdc, 0x84, 0xf3, 0xd6, 0xa3, 0xa6, 0x5f, 0xd9, 0x63,
+	0x5d, 0x78, 0x84, 0xcf, 0x83, 0x7b, 0x64, 0x0a, 0xdd, 0xae, 0x20, 0x5b, 0x16, 0x79, 0x35, 0x63,
+	0xc3, 0x11, 0x0a, 0xe3, 0x78, 0xaa, 0xc7, 0x51, 0x05, 0xb1, 0x54, 0x89, 0xdf, 0xb4, 0x60, 0x1b,
+	0xde, 0xcc, 0x78, 0xc3, 0x8b, 0x2c, 0xc2, 0x31, 0x2c, 0x93, 0xe6, 0x61, 0x2a, 0xe3, 0x23, 0x8d,
+	0xc9, 0x4f, 0x33, 0x29, 0x4c, 0x2f, 0x5c, 0xb4, 0x96, 0xa6, 0xdc, 0xf3, 0x3d, 0x50, 0xbb, 0xf8,
+	0x14, 0x2b, 0x40, 0x5e, 0x9c, 0x4b, 0x97, 0x70, 0x58, 0x77, 0x5e, 0xad, 0x59, 0x01, 0x72, 0x97,
+	0x13, 0xdb, 0x05, 0x21, 0x56, 0x80, 0xd2, 0x4c, 0x91, 0x9b, 0xe1, 0x52, 0xcd, 0x0a, 0xe0, 0xad,
+	0x14, 0x89, 0x8c, 0x13, 0x52, 0x69, 0xc4, 0xa7, 0x6f, 0x78, 0xb7, 0xe6, 0x84, 0xe4, 0x6d, 0xf8,
+	0x6c, 0x47, 0xb9, 0xe2, 0xb3, 0x72, 0xdd, 0xab, 0x29, 0x57, 0x7c, 0x8e, 0x5c, 0xf1, 0x19, 0xb9,
+	0x5e, 0xab, 0x29, 0x57, 0x7c, 0x4a, 0xae, 0xc7, 0xe4, 0x72, 0x4c, 0x85, 0x54, 0x59, 0xf8, 0xfd,
+	0x7a, 0xcc, 0x97, 0xe2, 0xc7, 0x52, 0x65, 0xc1, 0xef, 0xc9, 0x34, 0xe4, 0x9a, 0xa7, 0x52, 0x94,
+	0xea, 0x2f, 0xd7, 0x54, 0xdf, 0xf3, 0x15, 0xea, 0x7f, 0x4a, 0x5e, 0xef, 0xb1, 0xb4, 0x63, 0x8f,
+	0x7e, 0xae, 0x64, 0x0c, 0x5a, 0xfb, 0xb6, 0x6d, 0xa7, 0x45, 0x96, 0x6a, 0x0a, 0x22, 0xa1, 0x36,
+	0xc5, 0xc3, 0xfb, 0x36, 0xdf, 0xef, 0xe2, 0x86, 0x5d, 0x36, 0xdc, 0x73, 0x70, 0xdb, 0x88, 0x23,
+	0x0f, 0xde, 0x12, 0xc9, 0x3a, 0x42, 0x31, 0x71, 0x0a, 0xcf, 0x35, 0xeb, 0x00, 0x4d, 0xf8, 0x20,
+	0x7c, 0xe3, 0xbb, 0x71, 0x7d, 0x9f, 0x75, 0x60, 0x93, 0x0f, 0xb0, 0x3a, 0x26, 0xcc, 0x30, 0xaa,
+	0x41, 0x24, 0x38, 0x35, 0x62, 0x11, 0x7a, 0xf3, 0x45, 0xab, 0x23, 0x32, 0xed, 0x3b, 0x22, 0xac,
+	0x41, 0xfe, 0xa3, 0x02, 0x52, 0xc8, 0x40, 0x18, 0x77, 0xe6, 0xcb, 0xac, 0x79, 0x50, 0x16, 0xed,
+	0x2d, 0xbf, 0xbe, 0x07, 0xaa, 0xcc, 0x01, 0xdf, 0xac, 0x70, 0x44, 0x1b, 0xf8, 0xc2, 0xed, 0xf6,
+	0x3b, 0x0d, 0x57, 0xca, 0x66, 0xb5, 0x66, 0x11, 0xb6, 0x20, 0x23, 0x81, 0xd3, 0xed, 0x3e, 0xb9,
+	0x8e, 0xbb, 0xb5, 0x51, 0xf8, 0x4a, 0x7e, 0x1a, 0x6f, 0xdb, 0x3d, 0xd3, 0xd8, 0xe0, 0xec, 0x73,
+	0x3f, 0x8e, 0x4b, 0x72, 0x9b, 0x0b, 0x6e, 0x38, 0x4b, 0xa9, 0x82, 0xae, 0x02, 0x43, 0x3f, 0xeb,
+	0x33, 0x61, 0x78, 0x0a, 0xe1, 0x5b, 0xf5, 0xa4, 0xbe, 0xe9, 0x79, 0x23, 0x4b, 0xfb, 0x2b, 0xcf,
+	0x1a, 0xfc, 0x8e, 0x4c, 0xe7, 0x36, 0xbd, 0x47, 0x31, 0xfd, 0x61, 0xcd, 0x29, 0x3d, 0xc7, 0x3c,
+	0x2f, 0x22, 0xfa, 0x11, 0x09, 0xbb, 0xa9, 0x3c, 0x64, 0x29, 0x3d, 0xee, 0x71, 0x03, 0x29, 0xd7,
+	0x86, 0x82, 0x60, 0x87, 0x29, 0x24, 0xe1, 0xdb, 0x8b, 0x8d, 0xe5, 0xc9, 0xf5, 0x4b, 0x2e, 0x5c,
+	0xb7, 0x1c, 0xec, 0x49, 0x81, 0xda, 0x72, 0xa0, 0xe0, 0x11, 0x71, 0xf3, 0x19, 0xb5, 0xcd, 0x4e,
+	0xaa, 0x73, 0x78, 0xde, 0xa9, 0xf2, 0xdc, 0xb1, 0xe8, 0x0d, 0x07, 0x3e, 0xc3, 0xb6, 0x41, 0x5a,
+	0xb6, 0x69, 0x0c, 0x73, 0x50, 0x1c, 0x8b, 0x48, 0x65, 0xbc, 0x46, 0x5d, 0x74, 0xf8, 0x23, 0x1b,
+	0x98, 0x39, 0xec, 0x1a, 0x23, 0x50, 0x31, 0x5d, 0x5b, 0x48, 0xf0, 0xe7, 0x06, 0x79, 0xad, 0x2c,
+	0x6b, 0x54, 0xf6, 0x4d, 0xca, 0x41, 0xd1, 0x04, 0x0c, 0xd8, 0x19, 0xbe, 0xf2, 0x25, 0xf2, 0xe3,
+	0x7a, 0x52, 0x2e, 0x95, 0x56, 0x7e, 0xe9, 0x8c, 0x6c, 0x16, 0x36, 0x46, 0xdf, 0x25, 0x7f, 0x68,
+	0x90, 0x57, 0xbf, 0xc9, 0x19, 0x37, 0x46, 0xfe, 0xa4, 0x9e, 0x23, 0xad, 0xaf, 0x75, 0xc4, 0x4d,
+	0x95, 0x9c, 0xdc, 0x48, 0x59, 0x76, 0x98, 0x30, 0x5a, 0x64, 0xaf, 0x9d, 0xe3, 0xc2, 0xd5, 0x7a,
+	0x66, 0x03, 0x47, 0xba, 0xed, 0x38, 0xed, 0xec, 0x87, 0xf9, 0x50, 0x24, 0x94, 0x0b, 0xdc, 0xd9,
+	0x7c, 0x78, 0x77, 0x2c, 0x1f, 0x7c, 0x5e, 0x59, 0xf0, 0x99, 0x7c, 0x78, 0x4c, 0x16, 0x3d, 0x5b,
+	0xf1, 0x79, 0x74, 0x96, 0xee, 0xbd, 0x2a, 0xdd, 0xbc, 0x83, 0xfb, 0x5a, 0x7b, 0x5e, 0xb6, 0x7a,
+	0x3e, 0x96, 0x60, 0xa2, 0x8d, 0xd8, 0x58, 0x9e, 0x83, 0x48, 0x20, 0x09, 0xdf, 0x3f, 0xc7, 0xbb,
+	0x35, 0x04, 0x97, 0x64, 0x6b, 0x1e, 0x1a, 0xec, 0xba, 0xaf, 0xb8, 0x11, 0x09, 0x17, 0x79, 0xdf,
+	0x50, 0xa6, 0x14, 0x3b, 0x29, 0x0a, 0xc9, 0x07, 0x98, 0xaf, 0x25, 0x5d, 0xc6, 0x86, 0x25, 0xcd,
+	0x36, 0x82, 0xd7, 0x10, 0xeb, 0x8b, 0x4b, 0x44, 0xee, 0x22, 0x1d, 0x53, 0x71, 0x8f, 0x0f, 0x20,
+	0xf1, 0x13, 0x68, 0xae, 0xfa, 0x02, 0xaa, 0xe5, 0xec, 0xc3, 0x2a, 0x63, 0x0b, 0xab, 0x9a, 0xdf,
+	0x60, 0xeb, 0xda, 0x9e, 0x85, 0x97, 0xc5, 0x4d, 0x10, 0xac, 0x7b, 0xa5, 0x7a, 0xae, 0xcf, 0xe8,
+	0x1e, 0x53, 0x10, 0xfe, 0xb4, 0xfe, 0x67, 0xb8, 0x97, 0xd9, 0xf6, 0xa3, 0x7d, 0x24, 0x0d, 0x72,
+	0x12, 0x3a, 0x1b, 0xec, 0x10, 0x6f, 0x46, 0x62, 0x96, 0xe3, 0x61, 0x96, 0x02, 0x84, 0x09, 0x7f,
+	0x56, 0xb3, 0x42, 0x5a, 0xe2, 0x35, 0xe4, 0xdd, 0x60, 0xf9, 0x96, 0x67, 0x0d, 0x7e, 0x41, 0x9a,
+	0xce, 0x22, 0xbe, 0x2f, 0x35, 0x1c, 0x67, 0xe9, 0x8f, 0x16, 0x2f, 0x2e, 0x5f, 0x79, 0x7b, 0x6e,
+	0xa5, 0x7a, 0x3d, 0xb6, 0x62, 0xbd, 0x7c, 0x24, 0xe3, 0xa3, 0x03, 0x0e, 0x6a, 0x7d, 0x02, 0xdd,
+	0x88, 0xa6, 0x74, 0xf5, 0xa1, 0xc6, 0xfc, 0xf0, 0x03, 0x4b, 0x8f, 0x6b, 0x23, 0xd5, 0x09, 0x55,
+	0x60, 0x40, 0xd8, 0x93, 0xea, 0x66, 0xce, 0xf0, 0x63, 0x1c, 0x5b, 0xcb, 0x80, 0x3a, 0xf4, 0xcf,
+	0x1d, 0x38, 0x2a, 0xb0, 0x56, 0x7b, 0x1d, 0x6c, 0x91, 0x3b, 0x4e, 0x7c, 0xcb, 0x98, 0xb3, 0x13,
+	0xd9, 0x37, 0xd5, 0x48, 0xae, 0x55, 0x23, 0x19, 0x5a, 0x3d, 0x11, 0xb9, 0xe7, 0x80, 0x65, 0x0c,
+	0x7d, 0x5e, 0x9c, 0x72, 0xec, 0x4c, 0x5e, 0xac, 0x9f, 0xce, 0x8b, 0xa8, 0xea, 0xdb, 0xa9, 0xbc,
+	0x18, 0x90, 0xb9, 0x6a, 0x5e, 0xc4, 0x32, 0xf3, 0x52, 0xb9, 0x9b, 0xa0, 0x8d, 0x7a, 0xa1, 0x0a,
+	0x47, 0xb9, 0xb1, 0x51, 0x32, 0xdb, 0x5b, 0xa1, 0x3f, 0x36, 0xc8, 0xd2, 0xd7, 0x18, 0x8e, 0x7b,
+	0x4c, 0x74, 0xc1, 0xd9, 0xdf, 0xac, 0x59, 0x0f, 0xcf, 0xb3, 0xbf, 0x61, 0xf9, 0xad, 0x17, 0xeb,
+	0x64, 0xb6, 0xa2, 0xa8, 0x0b, 0x0c, 0x65, 0xc6, 0x40, 0x96, 0x1b, 0x1d, 0x6e, 0x55, 0x85, 0xbc,
+	0x7d, 0x2a, 0x2c, 0x6b, 0x1e, 0xb5, 0x33, 0x31, 0x39, 0xd1, 0xbc, 0xb4, 0x33, 0x31, 0x39, 0xdb,
+	0x9c, 0xdb, 0x99, 0x98, 0x9c, 0x6b, 0xde, 0xd9, 0x99, 0x98, 0xfc, 0x41, 0x73, 0x79, 0x67, 0x62,
+	0xf2, 0xf5, 0xe6, 0x7d, 0x7b, 0x3a, 0xce, 0x4c, 0x22, 0xb6, 0x6d, 0x51, 0xe8, 0x74, 0xa0, 0x32,
+	0xa9, 0x14, 0x37, 0x26, 0x91, 0x0f, 0xb2, 0x51, 0xdc, 0x7d, 0x70, 0xbb, 0x3b, 0x1f, 0x2a, 0xa4,
+	0x88, 0x41, 0xfb, 0x6a, 0xea, 0xdb, 0xe7, 0xd8, 0x4d, 0x4b, 0x02, 0x31, 0x3b, 0xb1, 0xb2, 0x45,
+	0xf7, 0xbe, 0x91, 0xc2, 0x6b, 0xbe, 0xf4, 0xd7, 0x06, 0xb9, 0x36, 0x76, 0x18, 0x82, 0x05, 0x72,
+	0xc5, 0x9e, 0x1e, 0xfb, 0x8d, 0xa5, 0xed, 0x75, 0xef, 0x44, 0x44, 0xf0, 0x91, 0xfd, 0xbe, 0xd2,
+	0x41, 0x42, 0xae, 0x7b, 0xb1, 0xb2, 0x7e, 0x6a, 0x78, 0x8e, 0x3d, 0x26, 0x7c, 0xa9, 0x5e, 0x90,
+	0x9a, 0x8e, 0x71, 0xb7, 0x24, 0x5c, 0xda, 0x26, 0x53, 0xfb, 0xe3, 0xe7, 0x71, 0x95, 0x5c, 0x72,
+	0x27, 0xba, 0xf1, 0x6d, 0x4f, 0xb4, 0xc3, 0xaf, 0x47, 0x5f, 0x3c, 0x6b, 0x35, 0xbe, 0x7c, 0xd6,
+	0x6a, 0xfc, 0xe7, 0x59, 0xab, 0xf1, 0x97, 0xe7, 0xad, 0x0b, 0x5f, 0x3e, 0x6f, 0x5d, 0xf8, 0xd7,
+	0xf3, 0xd6, 0x85, 0xa7, 0xef, 0x7e, 0x4b, 0x3f, 0x87, 0xed, 0xd2, 0x56, 0xdb, 0x9c, 0xe4, 0xa0,
+	0x0f, 0x2f, 0xdb, 0x2b, 0xf2, 0x77, 0xfe, 0x3f, 0x00, 0xb0, 0x66, 0xf9, 0x2f, 0x91, 0x17, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -452,6 +619,105 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRewardPayoutAttempts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRewardPayoutAttempts))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xa8
+	}
+	{
+		size := m.MaxReputerCommissionChangeRate.Size()
+		i -= size
+		if _, err := m.MaxReputerCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.MaxReputerCommissionRate.Size()
+		i -= size
+		if _, err := m.MaxReputerCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4
+	i--
+	dAtA[i] = 0x9a
+	if m.MaxRewardHistoryPrunesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRewardHistoryPrunesPerBlock))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxRewardPayoutsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRewardPayoutsPerBlock))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.RewardHistoryRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardHistoryRetentionBlocks))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.StakeLockTiers) > 0 {
+		for iNdEx := len(m.StakeLockTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeLockTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	{
+		size := m.StakeAboveCapExponent.Size()
+		i -= size
+		if _, err := m.StakeAboveCapExponent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xf2
+	{
+		size := m.MaxReputerStakeShare.Size()
+		i -= size
+		if _, err := m.MaxReputerStakeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xea
+	if m.MaxArchivedTopicPrunesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxArchivedTopicPrunesPerBlock))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe0
+	}
 	if m.MaxWhitelistInputArrayLength != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxWhitelistInputArrayLength))
 		i--
@@ -962,6 +1228,81 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StakeLockTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeLockTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeLockTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardMultiplier.Size()
+		i -= size
+		if _, err := m.RewardMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.LockMonths != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LockMonths))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakeLockTiers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeLockTiers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeLockTiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -1112,6 +1453,64 @@ func (m *Params) Size() (n int) {
 	if m.MaxWhitelistInputArrayLength != 0 {
 		n += 2 + sovParams(uint64(m.MaxWhitelistInputArrayLength))
 	}
+	if m.MaxArchivedTopicPrunesPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxArchivedTopicPrunesPerBlock))
+	}
+	l = m.MaxReputerStakeShare.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.StakeAboveCapExponent.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if len(m.StakeLockTiers) > 0 {
+		for _, e := range m.StakeLockTiers {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.RewardHistoryRetentionBlocks != 0 {
+		n += 2 + sovParams(uint64(m.RewardHistoryRetentionBlocks))
+	}
+	if m.MaxRewardPayoutsPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxRewardPayoutsPerBlock))
+	}
+	if m.MaxRewardHistoryPrunesPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxRewardHistoryPrunesPerBlock))
+	}
+	l = m.MaxReputerCommissionRate.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MaxReputerCommissionChangeRate.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxRewardPayoutAttempts != 0 {
+		n += 2 + sovParams(uint64(m.MaxRewardPayoutAttempts))
+	}
+	return n
+}
+
+func (m *StakeLockTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockMonths != 0 {
+		n += 1 + sovParams(uint64(m.LockMonths))
+	}
+	l = m.RewardMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *StakeLockTiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2580,6 +2979,458 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 60:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxArchivedTopicPrunesPerBlock", wireType)
+			}
+			m.MaxArchivedTopicPrunesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxArchivedTopicPrunesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReputerStakeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxReputerStakeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeAboveCapExponent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakeAboveCapExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeLockTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeLockTiers = append(m.StakeLockTiers, StakeLockTier{})
+			if err := m.StakeLockTiers[len(m.StakeLockTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 64:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistoryRetentionBlocks", wireType)
+			}
+			m.RewardHistoryRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardHistoryRetentionBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 65:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardPayoutsPerBlock", wireType)
+			}
+			m.MaxRewardPayoutsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRewardPayoutsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 66:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardHistoryPrunesPerBlock", wireType)
+			}
+			m.MaxRewardHistoryPrunesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRewardHistoryPrunesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 67:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReputerCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxReputerCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 68:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReputerCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxReputerCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 69:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardPayoutAttempts", wireType)
+			}
+			m.MaxRewardPayoutAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRewardPayoutAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeLockTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeLockTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeLockTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockMonths", wireType)
+			}
+			m.LockMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockMonths |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeLockTiers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeLockTiers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeLockTiers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, StakeLockTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
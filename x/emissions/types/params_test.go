@@ -63,6 +63,20 @@ func TestDefaultParams(t *testing.T) {
 		GlobalReputerWhitelistEnabled:       true,
 		GlobalAdminWhitelistAppended:        true,
 		MaxWhitelistInputArrayLength:        uint64(2000),
+		MaxArchivedTopicPrunesPerBlock:      uint64(500),
+		MaxReputerStakeShare:                alloraMath.ZeroDec(),
+		StakeAboveCapExponent:               alloraMath.OneDec(),
+		StakeLockTiers: []StakeLockTier{
+			{LockMonths: 1, RewardMultiplier: alloraMath.MustNewDecFromString("1.1")},
+			{LockMonths: 3, RewardMultiplier: alloraMath.MustNewDecFromString("1.25")},
+			{LockMonths: 6, RewardMultiplier: alloraMath.MustNewDecFromString("1.5")},
+		},
+		RewardHistoryRetentionBlocks:   int64(864000),
+		MaxRewardPayoutsPerBlock:       uint64(500),
+		MaxRewardHistoryPrunesPerBlock: uint64(500),
+		MaxReputerCommissionRate:       alloraMath.MustNewDecFromString("0.2"),
+		MaxReputerCommissionChangeRate: alloraMath.MustNewDecFromString("0.01"),
+		MaxRewardPayoutAttempts:        uint64(5),
 	}
 
 	params := DefaultParams()
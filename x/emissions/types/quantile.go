@@ -0,0 +1,85 @@
+package types
+
+import (
+	"slices"
+
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// Loss methods that are proper scoring rules for predictive distributions given as quantiles
+const (
+	LossMethodPinball = "pinball"
+	LossMethodCRPS    = "crps"
+)
+
+// IsProbabilistic returns true if inferences of the topic are quantiles of a predictive distribution
+func (topic Topic) IsProbabilistic() bool {
+	return len(topic.QuantileLevels) > 0
+}
+
+// ValidateQuantileLevels checks that the quantile levels of a probabilistic topic are strictly
+// increasing in (0, 1), that there is one per output component and that the topic is scored
+// with a proper scoring rule
+func ValidateQuantileLevels(levels []alloraMath.Dec, outputDimension uint64, lossMethod string) error {
+	if len(levels) == 0 {
+		return nil
+	}
+	if uint64(len(levels)) != outputDimension {
+		return errors.Wrapf(ErrInvalidQuantiles, "%d quantile levels for an output dimension of %d", len(levels), outputDimension)
+	}
+	for i, level := range levels {
+		if err := ValidateDec(level); err != nil {
+			return err
+		}
+		if !level.IsPositive() || level.Gte(alloraMath.OneDec()) {
+			return errors.Wrapf(ErrInvalidQuantiles, "quantile level %s is not between 0 and 1 exclusive", level)
+		}
+		if i > 0 && level.Lte(levels[i-1]) {
+			return errors.Wrap(ErrInvalidQuantiles, "quantile levels must be strictly increasing")
+		}
+	}
+	if lossMethod != LossMethodPinball && lossMethod != LossMethodCRPS {
+		return errors.Wrapf(ErrInvalidQuantiles, "probabilistic topics must use the %s or %s loss method", LossMethodPinball, LossMethodCRPS)
+	}
+	return nil
+}
+
+// Checks that quantiles do not decrease with their level
+func validateQuantilesOrder(quantiles []alloraMath.Dec) error {
+	for i := 1; i < len(quantiles); i++ {
+		if quantiles[i].Lt(quantiles[i-1]) {
+			return errors.Wrapf(ErrInvalidQuantiles, "quantile %d is below quantile %d", i, i-1)
+		}
+	}
+	return nil
+}
+
+// Sorts quantiles in place. Combining the quantiles of several distributions
+// per level does not always keep them ordered, so combined quantiles are
+// rearranged into a valid distribution
+func rearrangeQuantiles(quantiles []alloraMath.Dec) {
+	slices.SortStableFunc(quantiles, func(x, y alloraMath.Dec) int {
+		return x.Cmp(y)
+	})
+}
+
+// RearrangeQuantiles sorts every set of quantiles held by a bundle of network inferences
+func (bundle *ValueBundle) RearrangeQuantiles() {
+	rearrangeQuantiles(bundle.CombinedValues)
+	rearrangeQuantiles(bundle.NaiveValues)
+	for _, list := range [][]*WorkerAttributedValue{bundle.InfererValues, bundle.ForecasterValues, bundle.OneInForecasterValues} {
+		for _, value := range list {
+			rearrangeQuantiles(value.Values)
+		}
+	}
+	withheldLists := [][]*WithheldWorkerAttributedValue{bundle.OneOutInfererValues, bundle.OneOutForecasterValues}
+	for _, forecasterValues := range bundle.OneOutInfererForecasterValues {
+		withheldLists = append(withheldLists, forecasterValues.OneOutInfererValues)
+	}
+	for _, list := range withheldLists {
+		for _, value := range list {
+			rearrangeQuantiles(value.Values)
+		}
+	}
+}
@@ -28,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type WorkerAttributedValue struct {
 	Worker string                                          `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
 	Value  github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
+	// per-component values for topics synthesized per component
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *WorkerAttributedValue) Reset()         { *m = WorkerAttributedValue{} }
@@ -73,6 +75,8 @@ func (m *WorkerAttributedValue) GetWorker() string {
 type WithheldWorkerAttributedValue struct {
 	Worker string                                          `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
 	Value  github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
+	// per-component values for topics synthesized per component
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *WithheldWorkerAttributedValue) Reset()         { *m = WithheldWorkerAttributedValue{} }
@@ -195,6 +199,10 @@ type ValueBundle struct {
 	// R^-_ilm || log10 L^-_ilm || I^-_il where l = any j
 	// log10 L^-_j'ikm || I^-_j'ik
 	OneOutInfererForecasterValues []*OneOutInfererForecasterValues `protobuf:"bytes,12,rep,name=one_out_inferer_forecaster_values,json=oneOutInfererForecasterValues,proto3" json:"one_out_inferer_forecaster_values,omitempty"`
+	// per-component counterparts of combined_value and naive_value for topics
+	// with a vector output
+	CombinedValues []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,13,rep,name=combined_values,json=combinedValues,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"combined_values"`
+	NaiveValues    []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,14,rep,name=naive_values,json=naiveValues,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"naive_values"`
 }
 
 func (m *ValueBundle) Reset()         { *m = ValueBundle{} }
@@ -406,6 +414,70 @@ func (m *ReputerValueBundles) GetReputerValueBundles() []*ReputerValueBundle {
 	return nil
 }
 
+// Ground truth of a topic for the inferences made at a nonce. Holds one value
+// per output component for vector topics, and a single value otherwise.
+// The reputer is empty for the stake-weighted consensus of all reputers
+type GroundTruth struct {
+	TopicId     uint64                                            `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64                                             `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Reputer     string                                            `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Values      []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
+}
+
+func (m *GroundTruth) Reset()         { *m = GroundTruth{} }
+func (m *GroundTruth) String() string { return proto.CompactTextString(m) }
+func (*GroundTruth) ProtoMessage()    {}
+func (*GroundTruth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_314531a75ebe144f, []int{6}
+}
+func (m *GroundTruth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroundTruth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroundTruth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroundTruth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroundTruth.Merge(m, src)
+}
+func (m *GroundTruth) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroundTruth) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroundTruth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroundTruth proto.InternalMessageInfo
+
+func (m *GroundTruth) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *GroundTruth) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GroundTruth) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*WorkerAttributedValue)(nil), "emissions.v3.WorkerAttributedValue")
 	proto.RegisterType((*WithheldWorkerAttributedValue)(nil), "emissions.v3.WithheldWorkerAttributedValue")
@@ -413,54 +485,61 @@ func init() {
 	proto.RegisterType((*ValueBundle)(nil), "emissions.v3.ValueBundle")
 	proto.RegisterType((*ReputerValueBundle)(nil), "emissions.v3.ReputerValueBundle")
 	proto.RegisterType((*ReputerValueBundles)(nil), "emissions.v3.ReputerValueBundles")
+	proto.RegisterType((*GroundTruth)(nil), "emissions.v3.GroundTruth")
 }
 
 func init() { proto.RegisterFile("emissions/v3/reputer.proto", fileDescriptor_314531a75ebe144f) }
 
 var fileDescriptor_314531a75ebe144f = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x41, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x4a, 0xa1, 0xf4, 0x6d, 0x21, 0x3a, 0x08, 0x59, 0x88, 0x5d, 0x0a, 0x5e, 0x48, 0x8c,
-	0xdd, 0x04, 0x0e, 0x1a, 0xe3, 0x45, 0x42, 0x4c, 0x30, 0x51, 0xcc, 0x46, 0xc5, 0x18, 0xe3, 0x3a,
-	0xdd, 0x7d, 0x6d, 0x27, 0xb4, 0x33, 0x75, 0x76, 0xb6, 0xc2, 0xdd, 0xa3, 0x31, 0xfe, 0x04, 0x6f,
-	0xfe, 0x02, 0xff, 0x03, 0x47, 0x8e, 0xc6, 0x03, 0x31, 0x70, 0xf1, 0x67, 0x98, 0x9d, 0xdd, 0xd2,
-	0xa5, 0xad, 0xa4, 0x06, 0x0f, 0xde, 0xf6, 0xbd, 0x79, 0xf3, 0xbe, 0xef, 0x7d, 0xf3, 0xde, 0x5b,
-	0x58, 0xc2, 0x36, 0x0b, 0x43, 0x26, 0x78, 0xe8, 0x74, 0x37, 0x1c, 0x89, 0x9d, 0x48, 0xa1, 0xac,
-	0x76, 0xa4, 0x50, 0x82, 0x94, 0xce, 0xce, 0xaa, 0xdd, 0x8d, 0x25, 0xeb, 0x5c, 0x24, 0x17, 0xdc,
-	0xc7, 0x24, 0x6e, 0xe9, 0x7a, 0x43, 0x34, 0x84, 0xfe, 0x74, 0xe2, 0xaf, 0xc4, 0xbb, 0xfa, 0xc1,
-	0x80, 0xf9, 0x5d, 0x21, 0xf7, 0x50, 0x3e, 0x50, 0x4a, 0xb2, 0x5a, 0xa4, 0x30, 0x78, 0x41, 0x5b,
-	0x11, 0x92, 0x05, 0x98, 0x7a, 0xaf, 0x0f, 0x2c, 0xa3, 0x62, 0xac, 0x15, 0xdd, 0xd4, 0x22, 0x8f,
-	0x61, 0xb2, 0x1b, 0x07, 0x58, 0x57, 0x62, 0xf7, 0xe6, 0x9d, 0xc3, 0xe3, 0xe5, 0xdc, 0x8f, 0xe3,
-	0x65, 0xa7, 0xc1, 0x54, 0x33, 0xaa, 0x55, 0x7d, 0xd1, 0x76, 0x68, 0xab, 0x25, 0x24, 0xbd, 0xcd,
-	0x51, 0xc5, 0x77, 0x7a, 0xa6, 0xdf, 0xa4, 0x8c, 0x3b, 0x6d, 0xaa, 0x9a, 0xd5, 0x2d, 0xf4, 0xdd,
-	0x24, 0xcb, 0xbd, 0xfc, 0xaf, 0x2f, 0xcb, 0xc6, 0xea, 0x47, 0x03, 0xca, 0xbb, 0x4c, 0x35, 0x9b,
-	0xd8, 0x0a, 0xfe, 0x03, 0x3a, 0x5f, 0x0d, 0x28, 0xef, 0x70, 0xdc, 0x89, 0xd4, 0x36, 0xaf, 0xa3,
-	0x44, 0xf9, 0x50, 0x48, 0xf4, 0x69, 0xa8, 0x50, 0x6a, 0x36, 0x21, 0xb1, 0x01, 0xea, 0x67, 0x3e,
-	0x6b, 0x42, 0x53, 0xca, 0x78, 0xc8, 0x5b, 0x58, 0x10, 0x1c, 0x3d, 0x11, 0x29, 0x8f, 0x25, 0x29,
-	0x3c, 0x0d, 0x10, 0x5a, 0xf9, 0xca, 0xc4, 0x9a, 0xb9, 0x7e, 0xab, 0x9a, 0x7d, 0xb6, 0xea, 0x85,
-	0xb5, 0xbb, 0x73, 0x22, 0xcb, 0x25, 0x61, 0x90, 0x32, 0xfd, 0x56, 0x00, 0x53, 0x3b, 0x36, 0x23,
-	0x1e, 0xb4, 0x90, 0x2c, 0xc2, 0xb4, 0x12, 0x1d, 0xe6, 0x7b, 0x2c, 0xd0, 0x42, 0xe5, 0xdd, 0x82,
-	0xb6, 0xb7, 0x03, 0xf2, 0x1c, 0xe6, 0xd3, 0xce, 0xf1, 0x24, 0xbe, 0x8b, 0x30, 0x54, 0x9e, 0xee,
-	0x0f, 0xad, 0x9c, 0xb9, 0xbe, 0x72, 0x9e, 0x91, 0x9b, 0x84, 0xba, 0x49, 0xe4, 0x93, 0x38, 0xd0,
-	0x9d, 0x93, 0xc3, 0x4e, 0x62, 0x41, 0x21, 0x75, 0xa7, 0x32, 0xf4, 0x4c, 0x52, 0x06, 0xc0, 0x7d,
-	0x25, 0xa9, 0x17, 0x50, 0x45, 0xad, 0x7c, 0xc5, 0x58, 0x2b, 0xb9, 0x45, 0xed, 0xd9, 0xa2, 0x8a,
-	0x92, 0x37, 0x30, 0xeb, 0x8b, 0x76, 0x8d, 0x71, 0x0c, 0x12, 0x6d, 0xac, 0xc9, 0xcb, 0x3d, 0xe1,
-	0x4c, 0x2f, 0x5d, 0xd2, 0x31, 0x8f, 0x60, 0x76, 0x40, 0xfa, 0x29, 0x2d, 0xfd, 0xcd, 0x01, 0xe9,
-	0x47, 0x4a, 0x3e, 0xc3, 0xb2, 0x62, 0x93, 0xa7, 0x70, 0xad, 0xff, 0xb8, 0xbd, 0x74, 0x85, 0xf1,
-	0xd3, 0x5d, 0xad, 0x0f, 0x36, 0xd0, 0x4b, 0x30, 0x39, 0x65, 0x5d, 0x4c, 0x4b, 0x9f, 0xbe, 0x5c,
-	0xe9, 0xa0, 0x73, 0x25, 0x75, 0xff, 0xb9, 0xf5, 0x8a, 0xff, 0xa6, 0xf5, 0x48, 0x1d, 0x16, 0x7b,
-	0x08, 0xc3, 0xaa, 0xc0, 0xdf, 0x83, 0x2c, 0x24, 0x20, 0x43, 0x43, 0xf6, 0x1a, 0xac, 0x18, 0x87,
-	0xf1, 0x11, 0x30, 0xe6, 0xf8, 0xe2, 0xcf, 0x0b, 0x8e, 0xdb, 0x7c, 0x28, 0x7b, 0x04, 0x2b, 0x83,
-	0x3a, 0x0d, 0xc3, 0x94, 0x46, 0x55, 0x73, 0xe1, 0x6a, 0x70, 0xcb, 0xe2, 0xa2, 0xe3, 0x74, 0x6e,
-	0x3f, 0x19, 0x40, 0xd2, 0x11, 0xcb, 0x8e, 0xef, 0x7d, 0x28, 0x69, 0x60, 0xaf, 0xa6, 0x6d, 0x3d,
-	0xc2, 0xe6, 0xfa, 0xe2, 0x79, 0xf8, 0xcc, 0x05, 0xd7, 0xec, 0x66, 0x6e, 0xdf, 0x80, 0x62, 0xc8,
-	0x1a, 0x9c, 0xaa, 0x48, 0x26, 0x53, 0x5d, 0x72, 0xfb, 0x8e, 0x78, 0x83, 0x76, 0xa2, 0xda, 0x1e,
-	0x1e, 0xa4, 0x73, 0x9a, 0x5a, 0x29, 0xa1, 0x3d, 0x98, 0x1b, 0xe6, 0x13, 0x92, 0x67, 0xfd, 0xa5,
-	0x91, 0x25, 0x16, 0x5a, 0x86, 0x16, 0xa6, 0x32, 0x72, 0x69, 0x64, 0x09, 0xf6, 0x76, 0x46, 0x36,
-	0xeb, 0xa6, 0x7b, 0x78, 0x62, 0x1b, 0x47, 0x27, 0xb6, 0xf1, 0xf3, 0xc4, 0x36, 0x3e, 0x9f, 0xda,
-	0xb9, 0xa3, 0x53, 0x3b, 0xf7, 0xfd, 0xd4, 0xce, 0xbd, 0xba, 0x3b, 0x66, 0xe7, 0xef, 0x3b, 0xfd,
-	0x1f, 0x9d, 0x3a, 0xe8, 0x60, 0x58, 0x9b, 0xd2, 0x3f, 0xb4, 0x8d, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xe0, 0x07, 0x4c, 0xeb, 0x2c, 0x07, 0x00, 0x00,
+	// 756 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8e, 0x6f, 0xd2, 0xb4, 0x39, 0x4e, 0x73, 0xef, 0x9d, 0xde, 0x56, 0x6e, 0x75, 0x93, 0xa6,
+	0x65, 0x53, 0x09, 0x91, 0x48, 0xed, 0x02, 0x84, 0xd8, 0x50, 0x55, 0x40, 0x91, 0xa0, 0xc8, 0x2a,
+	0x2d, 0xaa, 0x10, 0xae, 0x63, 0x4f, 0xe2, 0x51, 0x92, 0x99, 0x30, 0x33, 0x0e, 0xed, 0x4b, 0x20,
+	0x1e, 0x81, 0x1d, 0x6f, 0xc0, 0x33, 0x74, 0xd9, 0x0d, 0x12, 0x42, 0xa2, 0x42, 0xed, 0x86, 0x3d,
+	0x2f, 0x80, 0x3c, 0xb6, 0x1b, 0xe7, 0x87, 0xa8, 0x28, 0x5d, 0xb1, 0xf3, 0x39, 0x39, 0xfe, 0xce,
+	0x39, 0xdf, 0x7c, 0xf9, 0xc6, 0xb0, 0x84, 0xdb, 0x44, 0x08, 0xc2, 0xa8, 0xa8, 0x76, 0x37, 0xaa,
+	0x1c, 0x77, 0x7c, 0x89, 0x79, 0xa5, 0xc3, 0x99, 0x64, 0x28, 0x7f, 0xf9, 0x5b, 0xa5, 0xbb, 0xb1,
+	0x64, 0xf4, 0x55, 0x52, 0x46, 0x1d, 0x1c, 0xd6, 0x2d, 0xfd, 0xd7, 0x60, 0x0d, 0xa6, 0x1e, 0xab,
+	0xc1, 0x53, 0x98, 0x5d, 0xfd, 0xa4, 0xc1, 0xfc, 0x3e, 0xe3, 0x4d, 0xcc, 0xef, 0x4b, 0xc9, 0x49,
+	0xcd, 0x97, 0xd8, 0xdd, 0xb3, 0x5b, 0x3e, 0x46, 0x0b, 0x90, 0x7d, 0xa3, 0x7e, 0x30, 0xb4, 0xb2,
+	0xb6, 0x96, 0x33, 0xa3, 0x08, 0x3d, 0x81, 0xa9, 0x6e, 0x50, 0x60, 0xfc, 0x15, 0xa4, 0x37, 0x6f,
+	0x9f, 0x9c, 0x2d, 0xa7, 0xbe, 0x9c, 0x2d, 0x57, 0x1b, 0x44, 0x7a, 0x7e, 0xad, 0xe2, 0xb0, 0x76,
+	0xd5, 0x6e, 0xb5, 0x18, 0xb7, 0x6f, 0x51, 0x2c, 0x83, 0x77, 0xe2, 0xd0, 0xf1, 0x6c, 0x42, 0xab,
+	0x6d, 0x5b, 0x7a, 0x95, 0x2d, 0xec, 0x98, 0x21, 0x0a, 0xda, 0x81, 0xac, 0x7a, 0x10, 0x46, 0xba,
+	0x9c, 0x9e, 0x04, 0x2f, 0x82, 0xb9, 0x9b, 0xf9, 0xfe, 0x7e, 0x59, 0x5b, 0xfd, 0xaa, 0x41, 0x71,
+	0x9f, 0x48, 0xcf, 0xc3, 0x2d, 0xf7, 0x4f, 0xdc, 0xef, 0x83, 0x06, 0xc5, 0x1d, 0x8a, 0x77, 0x7c,
+	0xb9, 0x4d, 0xeb, 0x98, 0x63, 0xfe, 0x80, 0x71, 0xec, 0xd8, 0x42, 0x62, 0xae, 0xd6, 0x13, 0xa8,
+	0x04, 0x50, 0xbf, 0xcc, 0x19, 0x69, 0xb5, 0x63, 0x22, 0x83, 0x0e, 0x61, 0x81, 0x51, 0x6c, 0x31,
+	0x5f, 0x5a, 0x24, 0x84, 0xb0, 0xa2, 0x41, 0x33, 0xe5, 0xf4, 0x9a, 0xbe, 0x7e, 0xb3, 0x92, 0x14,
+	0x56, 0x65, 0x2c, 0x99, 0xe6, 0x1c, 0x4b, 0xce, 0xb2, 0x97, 0x9c, 0xf4, 0xc7, 0x0c, 0xe8, 0x2a,
+	0xb1, 0xe9, 0x53, 0xb7, 0x85, 0xd1, 0x22, 0xcc, 0x48, 0xd6, 0x21, 0x8e, 0x45, 0x5c, 0xc5, 0x7c,
+	0xc6, 0x9c, 0x56, 0xf1, 0xb6, 0x8b, 0x9e, 0xc3, 0x7c, 0xa4, 0x6d, 0x8b, 0xe3, 0xd7, 0x3e, 0x16,
+	0xd2, 0x52, 0x0a, 0x56, 0x47, 0xa1, 0xaf, 0xaf, 0xf4, 0x4f, 0x64, 0x86, 0xa5, 0x66, 0x58, 0xf9,
+	0x34, 0x28, 0x34, 0xe7, 0xf8, 0x70, 0x12, 0x19, 0x30, 0x1d, 0xa5, 0x23, 0x1a, 0xe2, 0x10, 0x15,
+	0x01, 0xf0, 0x91, 0xe4, 0xb6, 0xe5, 0xda, 0xd2, 0x36, 0x32, 0x65, 0x6d, 0x2d, 0x6f, 0xe6, 0x54,
+	0x66, 0xcb, 0x96, 0x36, 0x7a, 0x05, 0x05, 0x87, 0xb5, 0x6b, 0x84, 0x62, 0x37, 0xe4, 0xc6, 0x98,
+	0x9a, 0x4c, 0x13, 0xb3, 0x31, 0x5c, 0x28, 0xc1, 0xc7, 0x50, 0x18, 0xa0, 0x3e, 0xab, 0xa8, 0xbf,
+	0x31, 0x40, 0xfd, 0x48, 0xca, 0x67, 0x49, 0x92, 0x6c, 0xf4, 0x0c, 0xfe, 0xed, 0x1d, 0x6e, 0x0c,
+	0x37, 0x7d, 0x75, 0xb8, 0x7f, 0xea, 0x83, 0x02, 0x7a, 0x01, 0x3a, 0xb5, 0x49, 0x17, 0x47, 0xab,
+	0xcf, 0x4c, 0xb6, 0x3a, 0x28, 0xac, 0x70, 0xef, 0x5f, 0x4b, 0x2f, 0x77, 0x3d, 0xd2, 0x43, 0x75,
+	0x58, 0x8c, 0x3b, 0x0c, 0xb3, 0x02, 0xbf, 0xdf, 0x64, 0x21, 0x6c, 0x32, 0xf4, 0x27, 0x7b, 0x09,
+	0x46, 0xd0, 0x87, 0xd0, 0x11, 0x6d, 0xf4, 0xab, 0x93, 0x3f, 0xcf, 0x28, 0xde, 0xa6, 0x43, 0xe8,
+	0x3e, 0xac, 0x0c, 0xf2, 0x34, 0xdc, 0x26, 0x3f, 0x6a, 0x9b, 0xb1, 0xd6, 0x60, 0x16, 0xd9, 0x58,
+	0xe7, 0x38, 0x84, 0xbf, 0xfb, 0x65, 0x2f, 0x8c, 0xd9, 0xc9, 0xbc, 0xab, 0xd0, 0xa7, 0x7b, 0x81,
+	0x0e, 0x20, 0x9f, 0x90, 0x96, 0x30, 0x0a, 0x93, 0xc1, 0xeb, 0x3d, 0x6d, 0xc5, 0xae, 0xf3, 0x56,
+	0x03, 0x14, 0x19, 0x44, 0xd2, 0x7c, 0xee, 0x41, 0x5e, 0xb5, 0xb4, 0x6a, 0x2a, 0x56, 0x06, 0xa4,
+	0xaf, 0x2f, 0xf6, 0x93, 0x97, 0x78, 0xc1, 0xd4, 0xbb, 0x89, 0xb7, 0xff, 0x87, 0x9c, 0x20, 0x0d,
+	0x6a, 0x4b, 0x9f, 0x87, 0x9e, 0x94, 0x37, 0x7b, 0x89, 0xe0, 0x42, 0xe9, 0xf8, 0xb5, 0x26, 0x3e,
+	0x8e, 0x5c, 0x26, 0x8a, 0xa2, 0x81, 0x9a, 0x30, 0x37, 0x3c, 0x8f, 0x40, 0xbb, 0x3d, 0xcb, 0x4b,
+	0x0e, 0x26, 0x0c, 0x4d, 0x1d, 0x6b, 0x79, 0xa4, 0xe5, 0x25, 0x07, 0x8c, 0x1d, 0x2f, 0x89, 0xba,
+	0xfa, 0x51, 0x03, 0xfd, 0x21, 0x67, 0x3e, 0x75, 0x77, 0xb9, 0x2f, 0xbd, 0x71, 0x9e, 0xbb, 0x02,
+	0xf9, 0x5a, 0x8b, 0x39, 0x4d, 0xcb, 0xc3, 0xa4, 0xe1, 0x49, 0xb5, 0x56, 0xda, 0xd4, 0x55, 0xee,
+	0x91, 0x4a, 0x8d, 0xf1, 0xcf, 0xde, 0xe5, 0x96, 0xb9, 0x96, 0xcb, 0x6d, 0xd3, 0x3c, 0x39, 0x2f,
+	0x69, 0xa7, 0xe7, 0x25, 0xed, 0xdb, 0x79, 0x49, 0x7b, 0x77, 0x51, 0x4a, 0x9d, 0x5e, 0x94, 0x52,
+	0x9f, 0x2f, 0x4a, 0xa9, 0x83, 0x3b, 0x57, 0x84, 0x3c, 0xaa, 0xf6, 0xbe, 0x80, 0xe4, 0x71, 0x07,
+	0x8b, 0x5a, 0x56, 0x7d, 0xe9, 0x6c, 0xfc, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x29, 0x4a, 0xea, 0xc2,
+	0x45, 0x09, 0x00, 0x00,
 }
 
 func (this *WorkerAttributedValue) Equal(that interface{}) bool {
@@ -488,6 +567,14 @@ func (this *WorkerAttributedValue) Equal(that interface{}) bool {
 	if !this.Value.Equal(that1.Value) {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *WithheldWorkerAttributedValue) Equal(that interface{}) bool {
@@ -515,6 +602,14 @@ func (this *WithheldWorkerAttributedValue) Equal(that interface{}) bool {
 	if !this.Value.Equal(that1.Value) {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *OneOutInfererForecasterValues) Equal(that interface{}) bool {
@@ -634,6 +729,22 @@ func (this *ValueBundle) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.CombinedValues) != len(that1.CombinedValues) {
+		return false
+	}
+	for i := range this.CombinedValues {
+		if !this.CombinedValues[i].Equal(that1.CombinedValues[i]) {
+			return false
+		}
+	}
+	if len(this.NaiveValues) != len(that1.NaiveValues) {
+		return false
+	}
+	for i := range this.NaiveValues {
+		if !this.NaiveValues[i].Equal(that1.NaiveValues[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ReputerValueBundle) Equal(that interface{}) bool {
@@ -686,6 +797,20 @@ func (m *WorkerAttributedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -726,6 +851,20 @@ func (m *WithheldWorkerAttributedValue) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -810,6 +949,34 @@ func (m *ValueBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NaiveValues) > 0 {
+		for iNdEx := len(m.NaiveValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.NaiveValues[iNdEx].Size()
+				i -= size
+				if _, err := m.NaiveValues[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.CombinedValues) > 0 {
+		for iNdEx := len(m.CombinedValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CombinedValues[iNdEx].Size()
+				i -= size
+				if _, err := m.CombinedValues[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.OneOutInfererForecasterValues) > 0 {
 		for iNdEx := len(m.OneOutInfererForecasterValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1034,6 +1201,60 @@ func (m *ReputerValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GroundTruth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroundTruth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroundTruth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintReputer(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintReputer(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintReputer(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintReputer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovReputer(v)
 	base := offset
@@ -1057,6 +1278,12 @@ func (m *WorkerAttributedValue) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovReputer(uint64(l))
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1072,6 +1299,12 @@ func (m *WithheldWorkerAttributedValue) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovReputer(uint64(l))
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1155,6 +1388,18 @@ func (m *ValueBundle) Size() (n int) {
 			n += 1 + l + sovReputer(uint64(l))
 		}
 	}
+	if len(m.CombinedValues) > 0 {
+		for _, e := range m.CombinedValues {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
+	if len(m.NaiveValues) > 0 {
+		for _, e := range m.NaiveValues {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1194,6 +1439,31 @@ func (m *ReputerValueBundles) Size() (n int) {
 	return n
 }
 
+func (m *GroundTruth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovReputer(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovReputer(uint64(m.BlockHeight))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovReputer(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovReputer(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1295,6 +1565,42 @@ func (m *WorkerAttributedValue) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
@@ -1411,6 +1717,42 @@ func (m *WithheldWorkerAttributedValue) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
@@ -1970,6 +2312,78 @@ func (m *ValueBundle) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CombinedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CombinedValues = append(m.CombinedValues, v)
+			if err := m.CombinedValues[len(m.CombinedValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NaiveValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.NaiveValues = append(m.NaiveValues, v)
+			if err := m.NaiveValues[len(m.NaiveValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
@@ -2227,6 +2641,162 @@ func (m *ReputerValueBundles) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GroundTruth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReputer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroundTruth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroundTruth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReputer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipReputer(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SlashesReputers returns true if reputers of the topic are slashed for repeatedly
+// reporting losses far from the loss consensus
+func (topic Topic) SlashesReputers() bool {
+	return topic.ReputerSlashEpochs > 0
+}
+
+// IsDeviatingFromConsensus returns true if a report at the given normalized distance
+// from the loss consensus counts towards slashing the reputer that made it
+func (topic Topic) IsDeviatingFromConsensus(distance alloraMath.Dec) bool {
+	return topic.SlashesReputers() && distance.Gt(topic.ReputerSlashDistanceThreshold)
+}
+
+// ValidateReputerSlashing checks the reputer slashing settings of a topic. The threshold and
+// fractions are only checked when the topic slashes reputers
+func ValidateReputerSlashing(
+	distanceThreshold alloraMath.Dec,
+	slashEpochs int64,
+	selfSlashFraction alloraMath.Dec,
+	delegateSlashFraction alloraMath.Dec,
+) error {
+	if slashEpochs < 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "reputer slash epochs cannot be negative")
+	}
+	if slashEpochs == 0 {
+		return nil
+	}
+	if err := ValidateDec(distanceThreshold); err != nil {
+		return errors.Wrap(err, "reputer slash distance threshold is invalid")
+	}
+	if !distanceThreshold.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "reputer slash distance threshold must be greater than zero")
+	}
+	if err := validateSlashFraction(selfSlashFraction); err != nil {
+		return errors.Wrap(err, "reputer self slash fraction is invalid")
+	}
+	if err := validateSlashFraction(delegateSlashFraction); err != nil {
+		return errors.Wrap(err, "reputer delegate slash fraction is invalid")
+	}
+	return nil
+}
+
+func validateSlashFraction(fraction alloraMath.Dec) error {
+	if err := ValidateDec(fraction); err != nil {
+		return err
+	}
+	if fraction.IsNegative() || fraction.Gt(alloraMath.OneDec()) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "must be between 0 and 1 inclusive")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EscrowsRewards returns true if part of the worker and reputer rewards of the topic vest in escrow.
+// Topics created before reward escrows existed have no vesting blocks and pay rewards out in full
+func (topic Topic) EscrowsRewards() bool {
+	return topic.RewardEscrowVestingBlocks > 0 && topic.RewardEscrowFraction.IsPositive()
+}
+
+// RewardEscrowAmount returns the part of a reward of the topic held in escrow, rounded down
+func (topic Topic) RewardEscrowAmount(reward cosmosMath.Int) (cosmosMath.Int, error) {
+	if !topic.EscrowsRewards() {
+		return cosmosMath.ZeroInt(), nil
+	}
+	return SlashAmount(reward, topic.RewardEscrowFraction)
+}
+
+// VestedAmount returns the part of the escrowed amount vested at the block height, whether
+// claimed or not. The amount vests linearly from the block it was escrowed, rounded down
+func (escrow RewardEscrow) VestedAmount(blockHeight BlockHeight) (cosmosMath.Int, error) {
+	if blockHeight >= escrow.BlockVested {
+		return escrow.Amount, nil
+	}
+	if blockHeight <= escrow.BlockEscrowed {
+		return cosmosMath.ZeroInt(), nil
+	}
+	elapsed := alloraMath.NewDecFromInt64(blockHeight - escrow.BlockEscrowed)
+	vesting := alloraMath.NewDecFromInt64(escrow.BlockVested - escrow.BlockEscrowed)
+	fraction, err := elapsed.Quo(vesting)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return SlashAmount(escrow.Amount, fraction)
+}
+
+// ValidateRewardEscrow checks the reward escrow settings of a topic. The fraction is only
+// checked when the topic escrows rewards
+func ValidateRewardEscrow(fraction alloraMath.Dec, vestingBlocks int64) error {
+	if vestingBlocks < 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "reward escrow vesting blocks cannot be negative")
+	}
+	if vestingBlocks == 0 {
+		return nil
+	}
+	if err := ValidateDec(fraction); err != nil {
+		return errors.Wrap(err, "reward escrow fraction is invalid")
+	}
+	if fraction.IsNegative() || fraction.Gt(alloraMath.OneDec()) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "reward escrow fraction must be between 0 and 1 inclusive")
+	}
+	return nil
+}
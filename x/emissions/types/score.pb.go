@@ -129,34 +129,108 @@ func (m *Scores) GetScores() []*Score {
 	return nil
 }
 
+// A reward paid out to an actor in a topic at a block, kept in its reward history
+type RewardRecord struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Address     string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// one of the task reward types: reputer, inferer or forecaster
+	Type   string                                          `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Reward github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,opt,name=reward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reward"`
+}
+
+func (m *RewardRecord) Reset()         { *m = RewardRecord{} }
+func (m *RewardRecord) String() string { return proto.CompactTextString(m) }
+func (*RewardRecord) ProtoMessage()    {}
+func (*RewardRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3083e397971c3f10, []int{2}
+}
+func (m *RewardRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardRecord.Merge(m, src)
+}
+func (m *RewardRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardRecord proto.InternalMessageInfo
+
+func (m *RewardRecord) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *RewardRecord) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *RewardRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RewardRecord) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Score)(nil), "emissions.v3.Score")
 	proto.RegisterType((*Scores)(nil), "emissions.v3.Scores")
+	proto.RegisterType((*RewardRecord)(nil), "emissions.v3.RewardRecord")
 }
 
 func init() { proto.RegisterFile("emissions/v3/score.proto", fileDescriptor_3083e397971c3f10) }
 
 var fileDescriptor_3083e397971c3f10 = []byte{
-	// 292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
-	0x00, 0xc4, 0xe3, 0xaf, 0xff, 0x3e, 0xdc, 0x4e, 0x86, 0xc1, 0x30, 0xb8, 0xa1, 0x53, 0x24, 0x84,
-	0x2d, 0x51, 0x21, 0x98, 0x2b, 0x06, 0x18, 0x58, 0xcc, 0xc6, 0x52, 0x25, 0x8e, 0x95, 0x58, 0x4d,
-	0xe2, 0x28, 0x36, 0x05, 0xde, 0x82, 0x27, 0xe1, 0x39, 0x3a, 0x76, 0x44, 0x0c, 0x15, 0x4a, 0x5e,
-	0x04, 0xc5, 0xa5, 0xc0, 0xc8, 0xe6, 0xfb, 0xdd, 0xd9, 0x3a, 0x1f, 0xc4, 0x32, 0x57, 0xc6, 0x28,
-	0x5d, 0x18, 0xb6, 0x9c, 0x32, 0x23, 0x74, 0x25, 0x69, 0x59, 0x69, 0xab, 0xd1, 0xe8, 0xdb, 0xa1,
-	0xcb, 0xe9, 0xd1, 0x41, 0xa2, 0x13, 0xed, 0x0c, 0xd6, 0x9e, 0xb6, 0x99, 0xc9, 0x2b, 0x80, 0xbd,
-	0xbb, 0xf6, 0x0e, 0x3a, 0x84, 0xff, 0xad, 0x2e, 0x95, 0x98, 0xab, 0x18, 0x03, 0x1f, 0x04, 0x5d,
-	0x3e, 0x70, 0xfa, 0x26, 0x46, 0xc7, 0x70, 0x14, 0x65, 0x5a, 0x2c, 0xe6, 0xa9, 0x54, 0x49, 0x6a,
-	0xf1, 0x3f, 0x1f, 0x04, 0x1d, 0x3e, 0x74, 0xec, 0xda, 0x21, 0x84, 0xe1, 0x20, 0x8c, 0xe3, 0x4a,
-	0x1a, 0x83, 0x3b, 0x3e, 0x08, 0xf6, 0xf8, 0x4e, 0xa2, 0x5b, 0xd8, 0x73, 0xa5, 0x70, 0xb7, 0xe5,
-	0xb3, 0x8b, 0xd5, 0x66, 0xec, 0xbd, 0x6f, 0xc6, 0x2c, 0x51, 0x36, 0x7d, 0x88, 0xa8, 0xd0, 0x39,
-	0x0b, 0xb3, 0x4c, 0x57, 0xe1, 0x69, 0x21, 0xed, 0xa3, 0xae, 0x16, 0x3b, 0x29, 0xd2, 0x50, 0x15,
-	0x2c, 0x0f, 0x6d, 0x4a, 0xaf, 0xa4, 0xe0, 0xdb, 0x57, 0x26, 0xe7, 0xb0, 0xef, 0xfa, 0x1a, 0x74,
-	0x02, 0xfb, 0x0e, 0x19, 0x0c, 0xfc, 0x4e, 0x30, 0x3c, 0xdb, 0xa7, 0xbf, 0xff, 0x4b, 0x5d, 0x8a,
-	0x7f, 0x45, 0x66, 0x7c, 0x55, 0x13, 0xb0, 0xae, 0x09, 0xf8, 0xa8, 0x09, 0x78, 0x69, 0x88, 0xb7,
-	0x6e, 0x88, 0xf7, 0xd6, 0x10, 0xef, 0xfe, 0xf2, 0x8f, 0x45, 0x9e, 0xd8, 0xcf, 0xd0, 0xf6, 0xb9,
-	0x94, 0x26, 0xea, 0xbb, 0x09, 0xa7, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x57, 0x3c, 0x3c, 0xa4,
-	0x82, 0x01, 0x00, 0x00,
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x8d, 0xbf, 0xb6, 0xe9, 0x87, 0xdb, 0xc9, 0x30, 0x18, 0x86, 0x34, 0x74, 0x8a, 0x84, 0x88,
+	0x25, 0x2a, 0x04, 0x73, 0xc5, 0x00, 0x03, 0x42, 0x32, 0x1b, 0x4b, 0x95, 0x3a, 0x56, 0x62, 0xf5,
+	0xe7, 0x56, 0xb6, 0x69, 0xe1, 0x2d, 0x78, 0x12, 0x9e, 0xa3, 0x12, 0x4b, 0x47, 0xc4, 0x50, 0xa1,
+	0xf6, 0x45, 0x50, 0xdc, 0x14, 0x18, 0x91, 0x10, 0xdb, 0x3d, 0xe7, 0x9e, 0x9c, 0x9c, 0x63, 0x5d,
+	0x4c, 0xe5, 0x48, 0x19, 0xa3, 0x60, 0x6c, 0xd8, 0xb4, 0xc3, 0x8c, 0x00, 0x2d, 0xe3, 0x89, 0x06,
+	0x0b, 0xa4, 0xf9, 0xb9, 0x89, 0xa7, 0x9d, 0x83, 0xbd, 0x0c, 0x32, 0x70, 0x0b, 0x56, 0x4c, 0x1b,
+	0x4d, 0xfb, 0x19, 0xe1, 0xda, 0x6d, 0xf1, 0x0d, 0xd9, 0xc7, 0xff, 0x2d, 0x4c, 0x94, 0xe8, 0xa9,
+	0x94, 0xa2, 0x10, 0x45, 0x55, 0x5e, 0x77, 0xf8, 0x2a, 0x25, 0x87, 0xb8, 0xd9, 0x1f, 0x82, 0x18,
+	0xf4, 0x72, 0xa9, 0xb2, 0xdc, 0xd2, 0x7f, 0x21, 0x8a, 0x2a, 0xbc, 0xe1, 0xb8, 0x4b, 0x47, 0x11,
+	0x8a, 0xeb, 0x49, 0x9a, 0x6a, 0x69, 0x0c, 0xad, 0x84, 0x28, 0xda, 0xe1, 0x5b, 0x48, 0xae, 0x71,
+	0xcd, 0x85, 0xa2, 0xd5, 0x82, 0xef, 0x9e, 0xcd, 0x97, 0x2d, 0xef, 0x6d, 0xd9, 0x62, 0x99, 0xb2,
+	0xf9, 0x7d, 0x3f, 0x16, 0x30, 0x62, 0xc9, 0x70, 0x08, 0x3a, 0x39, 0x1e, 0x4b, 0x3b, 0x03, 0x3d,
+	0xd8, 0x42, 0x91, 0x27, 0x6a, 0xcc, 0x46, 0x89, 0xcd, 0xe3, 0x0b, 0x29, 0xf8, 0xc6, 0xa5, 0x7d,
+	0x8a, 0x7d, 0x97, 0xd7, 0x90, 0x23, 0xec, 0x3b, 0xca, 0x50, 0x14, 0x56, 0xa2, 0xc6, 0xc9, 0x6e,
+	0xfc, 0xbd, 0x6f, 0xec, 0x54, 0xbc, 0x94, 0xb4, 0x5f, 0x10, 0x6e, 0x72, 0x39, 0x4b, 0x74, 0xca,
+	0xa5, 0x00, 0x9d, 0xfe, 0x59, 0x5d, 0x82, 0xab, 0xf6, 0x71, 0x52, 0xb6, 0xe5, 0x6e, 0x26, 0x37,
+	0xd8, 0xd7, 0xee, 0xdf, 0xb4, 0xf6, 0xbb, 0x37, 0x28, 0x6d, 0xba, 0x7c, 0xbe, 0x0a, 0xd0, 0x62,
+	0x15, 0xa0, 0xf7, 0x55, 0x80, 0x9e, 0xd6, 0x81, 0xb7, 0x58, 0x07, 0xde, 0xeb, 0x3a, 0xf0, 0xee,
+	0xce, 0x7f, 0x68, 0xf9, 0xc0, 0xbe, 0xce, 0xa6, 0xc8, 0x68, 0xfa, 0xbe, 0x3b, 0x88, 0xce, 0x47,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x87, 0x26, 0xea, 0x50, 0x02, 0x00, 0x00,
 }
 
 func (m *Score) Marshal() (dAtA []byte, err error) {
@@ -246,6 +320,63 @@ func (m *Scores) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RewardRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Reward.Size()
+		i -= size
+		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintScore(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintScore(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintScore(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintScore(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintScore(dAtA []byte, offset int, v uint64) int {
 	offset -= sovScore(v)
 	base := offset
@@ -293,6 +424,31 @@ func (m *Scores) Size() (n int) {
 	return n
 }
 
+func (m *RewardRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovScore(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovScore(uint64(m.BlockHeight))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovScore(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovScore(uint64(l))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovScore(uint64(l))
+	return n
+}
+
 func sovScore(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -537,6 +693,192 @@ func (m *Scores) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RewardRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipScore(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -365,23 +365,27 @@ func (m *DelegateStakeRemovalInfo) GetBlockRemovalCompleted() int64 {
 	return 0
 }
 
-type DelegatorInfo struct {
-	Amount     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"amount"`
-	RewardDebt github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=reward_debt,json=rewardDebt,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reward_debt"`
+type WorkerBond struct {
+	TopicId uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Worker  string                `protobuf:"bytes,2,opt,name=worker,proto3" json:"worker,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// height from which missed epochs are counted when the worker has
+	// not submitted since, i.e. when the bond was added or last slashed
+	LivenessBlockHeight int64 `protobuf:"varint,4,opt,name=liveness_block_height,json=livenessBlockHeight,proto3" json:"liveness_block_height,omitempty"`
 }
 
-func (m *DelegatorInfo) Reset()         { *m = DelegatorInfo{} }
-func (m *DelegatorInfo) String() string { return proto.CompactTextString(m) }
-func (*DelegatorInfo) ProtoMessage()    {}
-func (*DelegatorInfo) Descriptor() ([]byte, []int) {
+func (m *WorkerBond) Reset()         { *m = WorkerBond{} }
+func (m *WorkerBond) String() string { return proto.CompactTextString(m) }
+func (*WorkerBond) ProtoMessage()    {}
+func (*WorkerBond) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cdee6632fec3e740, []int{5}
 }
-func (m *DelegatorInfo) XXX_Unmarshal(b []byte) error {
+func (m *WorkerBond) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegatorInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WorkerBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegatorInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WorkerBond.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -391,523 +395,3491 @@ func (m *DelegatorInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *DelegatorInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegatorInfo.Merge(m, src)
+func (m *WorkerBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerBond.Merge(m, src)
 }
-func (m *DelegatorInfo) XXX_Size() int {
+func (m *WorkerBond) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegatorInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegatorInfo.DiscardUnknown(m)
+func (m *WorkerBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerBond.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegatorInfo proto.InternalMessageInfo
+var xxx_messageInfo_WorkerBond proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*StakePlacement)(nil), "emissions.v3.StakePlacement")
-	proto.RegisterType((*DelegateStakePlacement)(nil), "emissions.v3.DelegateStakePlacement")
-	proto.RegisterType((*StakeInfo)(nil), "emissions.v3.StakeInfo")
-	proto.RegisterType((*StakeRemovalInfo)(nil), "emissions.v3.StakeRemovalInfo")
-	proto.RegisterType((*DelegateStakeRemovalInfo)(nil), "emissions.v3.DelegateStakeRemovalInfo")
-	proto.RegisterType((*DelegatorInfo)(nil), "emissions.v3.DelegatorInfo")
+func (m *WorkerBond) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("emissions/v3/stake.proto", fileDescriptor_cdee6632fec3e740) }
-
-var fileDescriptor_cdee6632fec3e740 = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x14, 0xc7, 0x73, 0x49, 0x9b, 0xfe, 0x72, 0x3f, 0x40, 0x60, 0x08, 0xb8, 0x15, 0x72, 0xa3, 0x4c,
-	0x11, 0x52, 0x6d, 0x44, 0x24, 0x60, 0x2e, 0x19, 0xc8, 0x04, 0x72, 0x17, 0xc4, 0x62, 0x9d, 0xed,
-	0x47, 0x62, 0xc5, 0x77, 0xcf, 0xba, 0xbb, 0xa4, 0xf0, 0x2f, 0x30, 0xf1, 0x3f, 0xb0, 0x30, 0x32,
-	0x30, 0xb1, 0xb2, 0x74, 0xac, 0x98, 0x10, 0x43, 0x85, 0x92, 0x01, 0xf1, 0x47, 0x20, 0xa1, 0xdc,
-	0x39, 0x69, 0x33, 0xb4, 0x42, 0x54, 0xa2, 0x62, 0xb1, 0xfc, 0xde, 0xd7, 0x7e, 0xf7, 0xf9, 0x7e,
-	0xf5, 0x74, 0xd4, 0x05, 0x9e, 0x29, 0x95, 0xa1, 0x50, 0xc1, 0xa4, 0x1b, 0x28, 0xcd, 0x46, 0xe0,
-	0x17, 0x12, 0x35, 0x3a, 0x97, 0x96, 0x8a, 0x3f, 0xe9, 0x6e, 0x5d, 0x63, 0x3c, 0x13, 0x18, 0x98,
-	0xa7, 0xfd, 0x60, 0x6b, 0x33, 0x41, 0xc5, 0x51, 0x45, 0xa6, 0x0a, 0x6c, 0x51, 0x4a, 0x37, 0x06,
-	0x38, 0x40, 0xdb, 0x9f, 0xbf, 0xd9, 0x6e, 0xfb, 0x13, 0xa1, 0x57, 0xf6, 0xe6, 0x27, 0x3c, 0xcd,
-	0x59, 0x02, 0x1c, 0x84, 0x76, 0xee, 0xd1, 0x66, 0x9c, 0x63, 0x32, 0x8a, 0x24, 0x70, 0x9c, 0xb0,
-	0x3c, 0x52, 0x9a, 0x49, 0x0d, 0xa9, 0x4b, 0x5a, 0xa4, 0x53, 0x0b, 0xaf, 0x1b, 0x31, 0xb4, 0xda,
-	0x9e, 0x95, 0x9c, 0x4d, 0xfa, 0x9f, 0xc6, 0x22, 0x4b, 0xa2, 0x2c, 0x75, 0xab, 0x2d, 0xd2, 0x59,
-	0x0b, 0x37, 0x4c, 0xdd, 0x4f, 0x1d, 0x97, 0x6e, 0x48, 0x28, 0xc6, 0x1a, 0xa4, 0x5b, 0x6b, 0x91,
-	0x4e, 0x23, 0x5c, 0x94, 0xce, 0x63, 0x5a, 0x67, 0x1c, 0xc7, 0x42, 0xbb, 0x6b, 0x73, 0x61, 0xf7,
-	0xee, 0xc1, 0xd1, 0x76, 0xe5, 0xeb, 0xd1, 0x76, 0xd3, 0x72, 0xab, 0x74, 0xe4, 0x67, 0x18, 0x70,
-	0xa6, 0x87, 0x7e, 0x5f, 0xe8, 0xcf, 0x1f, 0x76, 0x68, 0x69, 0xa8, 0x2f, 0xf4, 0xbb, 0xef, 0xef,
-	0xef, 0x90, 0xb0, 0xfc, 0xbf, 0xfd, 0x83, 0xd0, 0x9b, 0x3d, 0xc8, 0x61, 0xc0, 0x34, 0x5c, 0x94,
-	0x9b, 0xdb, 0xb4, 0x91, 0x5a, 0x04, 0x94, 0xd6, 0x50, 0x78, 0xdc, 0x38, 0xe1, 0x75, 0xfd, 0x9c,
-	0x5e, 0x5f, 0x13, 0xda, 0x30, 0x1e, 0xfb, 0xe2, 0x05, 0xae, 0xa0, 0x92, 0x53, 0x51, 0xab, 0xa7,
-	0x05, 0x5f, 0x3b, 0x27, 0xcc, 0x4f, 0x42, 0xaf, 0x1a, 0x98, 0x32, 0x41, 0xc3, 0xf4, 0xef, 0x2d,
-	0x90, 0x73, 0x9f, 0xde, 0x5a, 0x45, 0x4e, 0x90, 0x17, 0x39, 0xcc, 0xa1, 0xd7, 0x0d, 0x74, 0xf3,
-	0x24, 0xf4, 0xa3, 0x85, 0xd8, 0x7e, 0x5b, 0xa5, 0xee, 0xca, 0xe2, 0x5d, 0x48, 0x0e, 0x7f, 0x69,
-	0xf5, 0xce, 0x4a, 0xa9, 0x7e, 0x56, 0x4a, 0x1f, 0x09, 0xbd, 0xdc, 0x5b, 0xf0, 0x98, 0x68, 0x9e,
-	0x2c, 0x99, 0x88, 0x61, 0x7a, 0x50, 0x32, 0x05, 0x83, 0x4c, 0x0f, 0xc7, 0xb1, 0x9f, 0x20, 0x0f,
-	0x58, 0x9e, 0xa3, 0x64, 0x3b, 0x02, 0xf4, 0x3e, 0xca, 0xd1, 0xa2, 0x4c, 0x86, 0x2c, 0x13, 0x96,
-	0xb6, 0x07, 0xc9, 0x12, 0xed, 0x19, 0xfd, 0x5f, 0xc2, 0x3e, 0x93, 0x69, 0x94, 0x42, 0xac, 0xed,
-	0xc2, 0xff, 0xf9, 0x54, 0x6a, 0x67, 0xf5, 0x20, 0xd6, 0xbb, 0xe1, 0xc1, 0xd4, 0x23, 0x87, 0x53,
-	0x8f, 0x7c, 0x9b, 0x7a, 0xe4, 0xcd, 0xcc, 0xab, 0x1c, 0xce, 0xbc, 0xca, 0x97, 0x99, 0x57, 0x79,
-	0xfe, 0xf0, 0x37, 0xc7, 0xbe, 0x0c, 0x8e, 0x2f, 0x74, 0xfd, 0xaa, 0x00, 0x15, 0xd7, 0xcd, 0xe5,
-	0xdb, 0xfd, 0x15, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x21, 0xcd, 0x79, 0xea, 0x05, 0x00, 0x00,
+func (m *WorkerBond) GetWorker() string {
+	if m != nil {
+		return m.Worker
+	}
+	return ""
 }
 
-func (m *StakePlacement) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WorkerBond) GetLivenessBlockHeight() int64 {
+	if m != nil {
+		return m.LivenessBlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StakePlacement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// A share of a reward paid out to a worker or reputer of a topic held in escrow, vesting
+// linearly from the block it was escrowed until block_vested
+type RewardEscrow struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount escrowed, less any forfeited unvested amount
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// part of the amount already claimed
+	Claimed       cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+	BlockEscrowed int64                 `protobuf:"varint,5,opt,name=block_escrowed,json=blockEscrowed,proto3" json:"block_escrowed,omitempty"`
+	BlockVested   int64                 `protobuf:"varint,6,opt,name=block_vested,json=blockVested,proto3" json:"block_vested,omitempty"`
 }
 
-func (m *StakePlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *RewardEscrow) Reset()         { *m = RewardEscrow{} }
+func (m *RewardEscrow) String() string { return proto.CompactTextString(m) }
+func (*RewardEscrow) ProtoMessage()    {}
+func (*RewardEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdee6632fec3e740, []int{6}
+}
+func (m *RewardEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStake(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *RewardEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardEscrow.Merge(m, src)
+}
+func (m *RewardEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardEscrow proto.InternalMessageInfo
+
+func (m *RewardEscrow) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *RewardEscrow) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.BlockRemovalStarted != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *RewardEscrow) GetBlockEscrowed() int64 {
+	if m != nil {
+		return m.BlockEscrowed
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DelegateStakePlacement) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RewardEscrow) GetBlockVested() int64 {
+	if m != nil {
+		return m.BlockVested
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DelegateStakePlacement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// A reward of a worker or reputer of a topic waiting in the reward payout queue, paid out
+// by the ABCI endBlocker in ascending id order within the max reward payouts per block
+type QueuedRewardPayout struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// one of the task reward types: reputer, inferer or forecaster
+	Type        string                `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Amount      cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	BlockQueued int64                 `protobuf:"varint,6,opt,name=block_queued,json=blockQueued,proto3" json:"block_queued,omitempty"`
+	// number of times the reward failed to be paid out
+	FailedAttempts uint64 `protobuf:"varint,7,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
 }
 
-func (m *DelegateStakePlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueuedRewardPayout) Reset()         { *m = QueuedRewardPayout{} }
+func (m *QueuedRewardPayout) String() string { return proto.CompactTextString(m) }
+func (*QueuedRewardPayout) ProtoMessage()    {}
+func (*QueuedRewardPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdee6632fec3e740, []int{7}
+}
+func (m *QueuedRewardPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedRewardPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedRewardPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStake(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintStake(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.BlockRemovalStarted != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueuedRewardPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedRewardPayout.Merge(m, src)
+}
+func (m *QueuedRewardPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedRewardPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedRewardPayout.DiscardUnknown(m)
 }
 
-func (m *StakeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueuedRewardPayout proto.InternalMessageInfo
+
+func (m *QueuedRewardPayout) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StakeInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueuedRewardPayout) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
 
-func (m *StakeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStake(dAtA, i, uint64(size))
+func (m *QueuedRewardPayout) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *QueuedRewardPayout) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *QueuedRewardPayout) GetBlockQueued() int64 {
+	if m != nil {
+		return m.BlockQueued
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *StakeRemovalInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueuedRewardPayout) GetFailedAttempts() uint64 {
+	if m != nil {
+		return m.FailedAttempts
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type WorkerBondRemovalInfo struct {
+	BlockRemovalStarted   int64                 `protobuf:"varint,1,opt,name=block_removal_started,json=blockRemovalStarted,proto3" json:"block_removal_started,omitempty"`
+	TopicId               uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Worker                string                `protobuf:"bytes,3,opt,name=worker,proto3" json:"worker,omitempty"`
+	Amount                cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	BlockRemovalCompleted int64                 `protobuf:"varint,5,opt,name=block_removal_completed,json=blockRemovalCompleted,proto3" json:"block_removal_completed,omitempty"`
 }
 
-func (m *StakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockRemovalCompleted != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalCompleted))
-		i--
-		dAtA[i] = 0x28
+func (m *WorkerBondRemovalInfo) Reset()         { *m = WorkerBondRemovalInfo{} }
+func (m *WorkerBondRemovalInfo) String() string { return proto.CompactTextString(m) }
+func (*WorkerBondRemovalInfo) ProtoMessage()    {}
+func (*WorkerBondRemovalInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdee6632fec3e740, []int{8}
+}
+func (m *WorkerBondRemovalInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerBondRemovalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerBondRemovalInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStake(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *WorkerBondRemovalInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerBondRemovalInfo.Merge(m, src)
+}
+func (m *WorkerBondRemovalInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerBondRemovalInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerBondRemovalInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerBondRemovalInfo proto.InternalMessageInfo
+
+func (m *WorkerBondRemovalInfo) GetBlockRemovalStarted() int64 {
+	if m != nil {
+		return m.BlockRemovalStarted
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *WorkerBondRemovalInfo) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.BlockRemovalStarted != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *WorkerBondRemovalInfo) GetWorker() string {
+	if m != nil {
+		return m.Worker
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DelegateStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WorkerBondRemovalInfo) GetBlockRemovalCompleted() int64 {
+	if m != nil {
+		return m.BlockRemovalCompleted
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DelegateStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// run of consecutive reports in which a reputer's losses were further from the
+// loss consensus than its topic allows, with the evidence of the latest report
+type ReputerConsensusDeviation struct {
+	TopicId           uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer           string `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	ConsecutiveEpochs int64  `protobuf:"varint,3,opt,name=consecutive_epochs,json=consecutiveEpochs,proto3" json:"consecutive_epochs,omitempty"`
+	// normalized distance to consensus of the latest deviating report
+	Distance github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=distance,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"distance"`
+	// reputer nonce of the latest deviating report
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *DelegateStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockRemovalCompleted != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalCompleted))
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ReputerConsensusDeviation) Reset()         { *m = ReputerConsensusDeviation{} }
+func (m *ReputerConsensusDeviation) String() string { return proto.CompactTextString(m) }
+func (*ReputerConsensusDeviation) ProtoMessage()    {}
+func (*ReputerConsensusDeviation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdee6632fec3e740, []int{9}
+}
+func (m *ReputerConsensusDeviation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReputerConsensusDeviation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReputerConsensusDeviation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStake(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintStake(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *ReputerConsensusDeviation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputerConsensusDeviation.Merge(m, src)
+}
+func (m *ReputerConsensusDeviation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReputerConsensusDeviation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputerConsensusDeviation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputerConsensusDeviation proto.InternalMessageInfo
+
+func (m *ReputerConsensusDeviation) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *ReputerConsensusDeviation) GetReputer() string {
+	if m != nil {
+		return m.Reputer
 	}
-	if m.BlockRemovalStarted != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *ReputerConsensusDeviation) GetConsecutiveEpochs() int64 {
+	if m != nil {
+		return m.ConsecutiveEpochs
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DelegatorInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReputerConsensusDeviation) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DelegatorInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// stake a delegator moved from one reputer to another that can still be slashed
+// for faults of the source reputer until the redelegation completes
+type RedelegationInfo struct {
+	TopicId                    uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Delegator                  string                `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	SrcReputer                 string                `protobuf:"bytes,3,opt,name=src_reputer,json=srcReputer,proto3" json:"src_reputer,omitempty"`
+	DstReputer                 string                `protobuf:"bytes,4,opt,name=dst_reputer,json=dstReputer,proto3" json:"dst_reputer,omitempty"`
+	Amount                     cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	BlockRedelegationStarted   int64                 `protobuf:"varint,6,opt,name=block_redelegation_started,json=blockRedelegationStarted,proto3" json:"block_redelegation_started,omitempty"`
+	BlockRedelegationCompleted int64                 `protobuf:"varint,7,opt,name=block_redelegation_completed,json=blockRedelegationCompleted,proto3" json:"block_redelegation_completed,omitempty"`
 }
 
-func (m *DelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RewardDebt.Size()
-		i -= size
-		if _, err := m.RewardDebt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStake(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *RedelegationInfo) Reset()         { *m = RedelegationInfo{} }
+func (m *RedelegationInfo) String() string { return proto.CompactTextString(m) }
+func (*RedelegationInfo) ProtoMessage()    {}
+func (*RedelegationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdee6632fec3e740, []int{10}
+}
+func (m *RedelegationInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStake(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *RedelegationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationInfo.Merge(m, src)
+}
+func (m *RedelegationInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationInfo.DiscardUnknown(m)
 }
 
-func encodeVarintStake(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStake(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_RedelegationInfo proto.InternalMessageInfo
+
+func (m *RedelegationInfo) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *StakePlacement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockRemovalStarted != 0 {
-		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovStake(uint64(m.TopicId))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+
+func (m *RedelegationInfo) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	return n
+	return ""
 }
 
-func (m *DelegateStakePlacement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockRemovalStarted != 0 {
-		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovStake(uint64(m.TopicId))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
-	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+func (m *RedelegationInfo) GetSrcReputer() string {
+	if m != nil {
+		return m.SrcReputer
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	return n
+	return ""
 }
 
-func (m *StakeInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovStake(uint64(m.TopicId))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+func (m *RedelegationInfo) GetDstReputer() string {
+	if m != nil {
+		return m.DstReputer
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	return n
+	return ""
 }
 
-func (m *StakeRemovalInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RedelegationInfo) GetBlockRedelegationStarted() int64 {
+	if m != nil {
+		return m.BlockRedelegationStarted
 	}
-	var l int
-	_ = l
-	if m.BlockRemovalStarted != 0 {
-		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	return 0
+}
+
+func (m *RedelegationInfo) GetBlockRedelegationCompleted() int64 {
+	if m != nil {
+		return m.BlockRedelegationCompleted
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovStake(uint64(m.TopicId))
+	return 0
+}
+
+type DelegatorInfo struct {
+	Amount     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"amount"`
+	RewardDebt github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=reward_debt,json=rewardDebt,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reward_debt"`
+}
+
+func (m *DelegatorInfo) Reset()         { *m = DelegatorInfo{} }
+func (m *DelegatorInfo) String() string { return proto.CompactTextString(m) }
+func (*DelegatorInfo) ProtoMessage()    {}
+func (*DelegatorInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdee6632fec3e740, []int{11}
+}
+func (m *DelegatorInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+}
+func (m *DelegatorInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorInfo.Merge(m, src)
+}
+func (m *DelegatorInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorInfo proto.InternalMessageInfo
+
+// cut of the rewards of its delegators that a reputer keeps on a topic. The
+// bounds are fixed when the commission is first set so that delegators know
+// how far the rate can ever go and how fast it can get there.
+type ReputerCommission struct {
+	TopicId uint64                                          `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string                                          `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Rate    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"rate"`
+	MaxRate github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=max_rate,json=maxRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_rate"`
+	// largest change of the rate allowed in a topic epoch
+	MaxChangeRate github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_change_rate"`
+	// block at which the rate was last set
+	UpdateBlockHeight int64 `protobuf:"varint,6,opt,name=update_block_height,json=updateBlockHeight,proto3" json:"update_block_height,omitempty"`
+}
+
+func (m *ReputerCommission) Reset()         { *m = ReputerCommission{} }
+func (m *ReputerCommission) String() string { return proto.CompactTextString(m) }
+func (*ReputerCommission) ProtoMessage()    {}
+func (*ReputerCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdee6632fec3e740, []int{12}
+}
+func (m *ReputerCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReputerCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReputerCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	if m.BlockRemovalCompleted != 0 {
-		n += 1 + sovStake(uint64(m.BlockRemovalCompleted))
+}
+func (m *ReputerCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputerCommission.Merge(m, src)
+}
+func (m *ReputerCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReputerCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputerCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputerCommission proto.InternalMessageInfo
+
+func (m *ReputerCommission) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return n
+	return 0
 }
 
-func (m *DelegateStakeRemovalInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReputerCommission) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
+func (m *ReputerCommission) GetUpdateBlockHeight() int64 {
+	if m != nil {
+		return m.UpdateBlockHeight
+	}
+	return 0
+}
+
+// Stake locked until a block height, which can't be removed before then and is weighted by the
+// reward multiplier of its lock tier in reputer reward fractions
+type StakeLock struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	// empty for stake of the reputer itself
+	Delegator        string                                          `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Amount           cosmossdk_io_math.Int                           `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	RewardMultiplier github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,opt,name=reward_multiplier,json=rewardMultiplier,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reward_multiplier"`
+	BlockLocked      int64                                           `protobuf:"varint,6,opt,name=block_locked,json=blockLocked,proto3" json:"block_locked,omitempty"`
+	BlockUnlocked    int64                                           `protobuf:"varint,7,opt,name=block_unlocked,json=blockUnlocked,proto3" json:"block_unlocked,omitempty"`
+}
+
+func (m *StakeLock) Reset()         { *m = StakeLock{} }
+func (m *StakeLock) String() string { return proto.CompactTextString(m) }
+func (*StakeLock) ProtoMessage()    {}
+func (*StakeLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdee6632fec3e740, []int{13}
+}
+func (m *StakeLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakeLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakeLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakeLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeLock.Merge(m, src)
+}
+func (m *StakeLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakeLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeLock proto.InternalMessageInfo
+
+func (m *StakeLock) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *StakeLock) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
+func (m *StakeLock) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
+	return ""
+}
+
+func (m *StakeLock) GetBlockLocked() int64 {
+	if m != nil {
+		return m.BlockLocked
+	}
+	return 0
+}
+
+func (m *StakeLock) GetBlockUnlocked() int64 {
+	if m != nil {
+		return m.BlockUnlocked
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*StakePlacement)(nil), "emissions.v3.StakePlacement")
+	proto.RegisterType((*DelegateStakePlacement)(nil), "emissions.v3.DelegateStakePlacement")
+	proto.RegisterType((*StakeInfo)(nil), "emissions.v3.StakeInfo")
+	proto.RegisterType((*StakeRemovalInfo)(nil), "emissions.v3.StakeRemovalInfo")
+	proto.RegisterType((*DelegateStakeRemovalInfo)(nil), "emissions.v3.DelegateStakeRemovalInfo")
+	proto.RegisterType((*WorkerBond)(nil), "emissions.v3.WorkerBond")
+	proto.RegisterType((*RewardEscrow)(nil), "emissions.v3.RewardEscrow")
+	proto.RegisterType((*QueuedRewardPayout)(nil), "emissions.v3.QueuedRewardPayout")
+	proto.RegisterType((*WorkerBondRemovalInfo)(nil), "emissions.v3.WorkerBondRemovalInfo")
+	proto.RegisterType((*ReputerConsensusDeviation)(nil), "emissions.v3.ReputerConsensusDeviation")
+	proto.RegisterType((*RedelegationInfo)(nil), "emissions.v3.RedelegationInfo")
+	proto.RegisterType((*DelegatorInfo)(nil), "emissions.v3.DelegatorInfo")
+	proto.RegisterType((*ReputerCommission)(nil), "emissions.v3.ReputerCommission")
+	proto.RegisterType((*StakeLock)(nil), "emissions.v3.StakeLock")
+}
+
+func init() { proto.RegisterFile("emissions/v3/stake.proto", fileDescriptor_cdee6632fec3e740) }
+
+var fileDescriptor_cdee6632fec3e740 = []byte{
+	// 1016 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xbf, 0x6f, 0xe4, 0x44,
+	0x14, 0x8e, 0xbd, 0x49, 0x36, 0x79, 0xf9, 0x71, 0x59, 0x87, 0x1c, 0x4e, 0x74, 0xda, 0x84, 0x95,
+	0x10, 0x11, 0x52, 0x76, 0x11, 0x91, 0x80, 0x82, 0x02, 0x36, 0x1b, 0xe9, 0x02, 0x87, 0x38, 0x1c,
+	0xf1, 0x43, 0x34, 0xd6, 0xac, 0xfd, 0x6e, 0x77, 0xb4, 0xb6, 0x67, 0xf1, 0x8c, 0x37, 0xb9, 0x0e,
+	0x89, 0x8e, 0x8a, 0xff, 0x81, 0x86, 0x92, 0x82, 0x0a, 0x89, 0x8a, 0xe6, 0xca, 0x13, 0x0d, 0x27,
+	0x8a, 0x13, 0x4a, 0x0a, 0xc4, 0x5f, 0x80, 0x84, 0x84, 0x84, 0x3c, 0x33, 0xb6, 0x77, 0xef, 0x48,
+	0x82, 0xe2, 0x40, 0x74, 0x8d, 0xe5, 0x79, 0x6f, 0xe6, 0xf9, 0x7d, 0xdf, 0x7c, 0xef, 0xcd, 0x18,
+	0x6c, 0x0c, 0x29, 0xe7, 0x94, 0x45, 0xbc, 0x35, 0xda, 0x6d, 0x71, 0x41, 0x06, 0xd8, 0x1c, 0xc6,
+	0x4c, 0x30, 0x6b, 0x31, 0xf7, 0x34, 0x47, 0xbb, 0x1b, 0x35, 0x12, 0xd2, 0x88, 0xb5, 0xe4, 0x53,
+	0x4d, 0xd8, 0x58, 0xf7, 0x18, 0x0f, 0x19, 0x77, 0xe5, 0xa8, 0xa5, 0x06, 0xda, 0xf5, 0x5c, 0x8f,
+	0xf5, 0x98, 0xb2, 0xa7, 0x6f, 0xca, 0xda, 0xf8, 0xd1, 0x80, 0xe5, 0xc3, 0xf4, 0x0b, 0x77, 0x03,
+	0xe2, 0x61, 0x88, 0x91, 0xb0, 0x5e, 0x85, 0xb5, 0x6e, 0xc0, 0xbc, 0x81, 0x1b, 0x63, 0xc8, 0x46,
+	0x24, 0x70, 0xb9, 0x20, 0xb1, 0x40, 0xdf, 0x36, 0xb6, 0x8c, 0xed, 0x8a, 0xb3, 0x2a, 0x9d, 0x8e,
+	0xf2, 0x1d, 0x2a, 0x97, 0xb5, 0x0e, 0x73, 0x82, 0x0d, 0xa9, 0xe7, 0x52, 0xdf, 0x36, 0xb7, 0x8c,
+	0xed, 0x69, 0xa7, 0x2a, 0xc7, 0x07, 0xbe, 0x65, 0x43, 0x35, 0xc6, 0x61, 0x22, 0x30, 0xb6, 0x2b,
+	0x5b, 0xc6, 0xf6, 0xbc, 0x93, 0x0d, 0xad, 0xdb, 0x30, 0x4b, 0x42, 0x96, 0x44, 0xc2, 0x9e, 0x4e,
+	0x1d, 0xed, 0x57, 0x1e, 0x3c, 0xde, 0x9c, 0xfa, 0xe5, 0xf1, 0xe6, 0x9a, 0xca, 0x9b, 0xfb, 0x83,
+	0x26, 0x65, 0xad, 0x90, 0x88, 0x7e, 0xf3, 0x20, 0x12, 0x3f, 0x7d, 0xb7, 0x03, 0x1a, 0xd0, 0x41,
+	0x24, 0xbe, 0xf9, 0xed, 0xdb, 0x97, 0x0d, 0x47, 0xaf, 0x6f, 0xfc, 0x6e, 0xc0, 0xcd, 0x0e, 0x06,
+	0xd8, 0x23, 0x02, 0xaf, 0x0b, 0xcd, 0x2d, 0x98, 0xf7, 0x55, 0x0a, 0x2c, 0x56, 0x80, 0x9c, 0xc2,
+	0x30, 0x86, 0x75, 0xa6, 0x24, 0xd6, 0x2f, 0x0d, 0x98, 0x97, 0x18, 0x0f, 0xa2, 0x7b, 0x6c, 0x22,
+	0x55, 0xe3, 0xcc, 0x54, 0xcd, 0xb3, 0x88, 0xaf, 0x94, 0x4c, 0xe6, 0x2f, 0x03, 0x56, 0x64, 0x32,
+	0x9a, 0x41, 0x99, 0xd3, 0xb3, 0x27, 0x20, 0xeb, 0x35, 0x78, 0x7e, 0x32, 0x65, 0x8f, 0x85, 0xc3,
+	0x00, 0xd3, 0xa4, 0x67, 0x64, 0xd2, 0x6b, 0xe3, 0x49, 0xef, 0x65, 0xce, 0xc6, 0xd7, 0x26, 0xd8,
+	0x13, 0xc2, 0xbb, 0x16, 0x1e, 0xfe, 0x27, 0xe9, 0x9d, 0xc7, 0xd2, 0xec, 0x79, 0x2c, 0xfd, 0x60,
+	0x00, 0x7c, 0xcc, 0xe2, 0x01, 0xc6, 0x6d, 0x16, 0xf9, 0xe7, 0x69, 0xf6, 0x26, 0xcc, 0x1e, 0xc9,
+	0x89, 0x5a, 0xb2, 0x7a, 0x74, 0x75, 0x8a, 0x4d, 0x37, 0x25, 0xa0, 0x23, 0x8c, 0x90, 0x73, 0x57,
+	0x81, 0xe9, 0x23, 0xed, 0xf5, 0x95, 0x84, 0x2a, 0xce, 0x6a, 0xe6, 0x6c, 0xa7, 0xbe, 0xdb, 0xd2,
+	0x95, 0xee, 0xf2, 0xa2, 0x83, 0x47, 0x24, 0xf6, 0xf7, 0xb9, 0x17, 0xb3, 0xa3, 0x0b, 0xaa, 0x8e,
+	0xf8, 0x7e, 0x8c, 0x9c, 0x67, 0x55, 0xa7, 0x87, 0x57, 0x88, 0xe1, 0x1d, 0xa8, 0x7a, 0x01, 0xa1,
+	0x21, 0xfa, 0x97, 0x16, 0x7e, 0x16, 0xc0, 0x7a, 0x11, 0x96, 0x15, 0x0d, 0x28, 0xa1, 0xe5, 0x82,
+	0x5f, 0x92, 0xd6, 0x7d, 0x6d, 0xb4, 0x5e, 0x80, 0x45, 0x35, 0x6d, 0x84, 0xbc, 0xd8, 0xef, 0x05,
+	0x69, 0xfb, 0x48, 0x9a, 0x1a, 0x5f, 0x98, 0x60, 0x7d, 0x90, 0x60, 0x82, 0xbe, 0xe2, 0xea, 0x2e,
+	0xb9, 0xcf, 0x12, 0x61, 0x2d, 0x83, 0x99, 0xb3, 0x64, 0xd2, 0x8b, 0x14, 0x9e, 0x71, 0x57, 0x99,
+	0xe4, 0xce, 0x82, 0x69, 0x71, 0x7f, 0x88, 0x5a, 0xdc, 0xf2, 0xfd, 0x0a, 0x75, 0x9d, 0x83, 0xfb,
+	0x4c, 0xa6, 0x3f, 0x01, 0x4e, 0x21, 0xb2, 0x5e, 0x82, 0x1b, 0xf7, 0x08, 0x0d, 0xd0, 0x77, 0x89,
+	0x10, 0x18, 0x0e, 0x05, 0xb7, 0xab, 0x32, 0xf9, 0x65, 0x65, 0x7e, 0x5b, 0x5b, 0x1b, 0x9f, 0x9b,
+	0xb0, 0x56, 0x68, 0xfd, 0x3f, 0x6c, 0x07, 0x45, 0xa9, 0x54, 0xce, 0x28, 0x95, 0xeb, 0x6a, 0x8a,
+	0x7f, 0x18, 0xb0, 0xee, 0xa8, 0xd6, 0xb4, 0xc7, 0x22, 0x8e, 0x11, 0x4f, 0x78, 0x07, 0x47, 0x94,
+	0x08, 0xca, 0xa2, 0xcb, 0x9d, 0x58, 0x3b, 0x60, 0x79, 0x69, 0x28, 0x2f, 0x11, 0x74, 0x84, 0x2e,
+	0x0e, 0x99, 0xd7, 0x57, 0x22, 0xa9, 0x38, 0xb5, 0x31, 0xcf, 0xbe, 0x74, 0x58, 0x87, 0x30, 0xe7,
+	0x53, 0x2e, 0x48, 0xe4, 0x69, 0xc9, 0xb4, 0x5f, 0xd7, 0x2c, 0xb4, 0x7a, 0x54, 0xf4, 0x93, 0x6e,
+	0xd3, 0x63, 0x61, 0x8b, 0x04, 0x01, 0x8b, 0xc9, 0x4e, 0x84, 0x22, 0xa5, 0x2e, 0x1b, 0x7a, 0x7d,
+	0x42, 0x23, 0xc5, 0x4f, 0x07, 0x3d, 0x27, 0x0f, 0x54, 0xa8, 0x44, 0x37, 0x8c, 0x99, 0x31, 0x95,
+	0xe8, 0x46, 0xf1, 0xb3, 0x09, 0x2b, 0x0e, 0xea, 0xd6, 0x4b, 0x59, 0x74, 0xd1, 0x11, 0x3d, 0xd1,
+	0xb8, 0xcd, 0x27, 0x1b, 0xf7, 0x26, 0x2c, 0xf0, 0xd8, 0x73, 0x27, 0x9b, 0x3e, 0xf0, 0xd8, 0xd3,
+	0xe4, 0xa6, 0x13, 0x7c, 0x2e, 0xf2, 0x09, 0xaa, 0x38, 0xc0, 0xe7, 0xc2, 0x79, 0xea, 0x80, 0x2c,
+	0x5b, 0x22, 0x6f, 0xc2, 0x46, 0xa6, 0x85, 0x02, 0x5e, 0xae, 0x60, 0x55, 0x30, 0xb6, 0x96, 0x43,
+	0x31, 0x21, 0x93, 0xf1, 0x5b, 0x70, 0xeb, 0x1f, 0x56, 0x17, 0x72, 0xaa, 0xca, 0xf5, 0x1b, 0x4f,
+	0xad, 0x2f, 0x34, 0xf5, 0xbd, 0x01, 0x4b, 0x9d, 0x8c, 0x19, 0x49, 0xeb, 0xfb, 0x39, 0x36, 0xa3,
+	0xdc, 0x0e, 0x67, 0x10, 0x3f, 0x81, 0x85, 0x58, 0x36, 0x2e, 0xd7, 0xc7, 0xae, 0xb0, 0xcd, 0x72,
+	0x51, 0x41, 0xc5, 0xea, 0x60, 0x57, 0x34, 0xfe, 0x34, 0xa1, 0x96, 0x17, 0x44, 0xa8, 0xaf, 0xf0,
+	0x97, 0x2b, 0x84, 0x77, 0x61, 0x3a, 0x26, 0x02, 0xed, 0x4a, 0xb9, 0xec, 0x64, 0x10, 0xcb, 0x81,
+	0xb9, 0x90, 0x1c, 0xbb, 0x32, 0x60, 0xc9, 0x32, 0xa9, 0x86, 0xe4, 0xd8, 0x49, 0x63, 0xba, 0x70,
+	0x23, 0x8d, 0xe9, 0xf5, 0x49, 0xd4, 0x43, 0x15, 0x7a, 0xa6, 0x5c, 0xe8, 0xa5, 0x90, 0x1c, 0xef,
+	0xc9, 0x70, 0xf2, 0x03, 0x4d, 0x58, 0x4d, 0x86, 0x3e, 0x11, 0x38, 0x79, 0x7c, 0x2b, 0x09, 0xd6,
+	0x94, 0x6b, 0xfc, 0xf0, 0x7e, 0x64, 0xea, 0xfb, 0xf2, 0x1d, 0xe6, 0x0d, 0x2e, 0x47, 0xfa, 0x44,
+	0x99, 0x56, 0xce, 0xbe, 0x5f, 0x95, 0x6d, 0xb8, 0x3e, 0xd4, 0xb4, 0x02, 0xc3, 0x24, 0x10, 0x74,
+	0x18, 0x50, 0x8c, 0xcb, 0xb2, 0xb7, 0xa2, 0x22, 0xbe, 0x97, 0x07, 0x2c, 0xfa, 0x58, 0xfa, 0x78,
+	0xe2, 0xb4, 0xbb, 0x23, 0x4d, 0xc5, 0xa5, 0x20, 0x89, 0xf4, 0xa4, 0xea, 0xd8, 0xa5, 0xe0, 0x43,
+	0x6d, 0x6c, 0x3b, 0x0f, 0x4e, 0xea, 0xc6, 0xc3, 0x93, 0xba, 0xf1, 0xeb, 0x49, 0xdd, 0xf8, 0xea,
+	0xb4, 0x3e, 0xf5, 0xf0, 0xb4, 0x3e, 0xf5, 0xe8, 0xb4, 0x3e, 0xf5, 0xe9, 0x1b, 0xff, 0x32, 0xcd,
+	0xe3, 0x56, 0xf1, 0xaf, 0x9b, 0x1e, 0xea, 0xbc, 0x3b, 0x2b, 0xff, 0x4b, 0x77, 0xff, 0x1e, 0x00,
+	0xba, 0x00, 0xca, 0xa8, 0x05, 0x0f, 0x00, 0x00,
+}
+
+func (m *StakePlacement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakePlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakePlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockRemovalStarted != 0 {
-		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.TopicId != 0 {
-		n += 1 + sovStake(uint64(m.TopicId))
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+	if m.BlockRemovalStarted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateStakePlacement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	if m.BlockRemovalCompleted != 0 {
-		n += 1 + sovStake(uint64(m.BlockRemovalCompleted))
+	return dAtA[:n], nil
+}
+
+func (m *DelegateStakePlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateStakePlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockRemovalStarted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakeRemovalInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockRemovalCompleted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalCompleted))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockRemovalStarted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockRemovalCompleted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalCompleted))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockRemovalStarted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LivenessBlockHeight != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.LivenessBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockVested != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockVested))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockEscrowed != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockEscrowed))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedRewardPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedRewardPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedRewardPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedAttempts != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.FailedAttempts))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockQueued != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockQueued))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerBondRemovalInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerBondRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerBondRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockRemovalCompleted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalCompleted))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockRemovalStarted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReputerConsensusDeviation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReputerConsensusDeviation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReputerConsensusDeviation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Distance.Size()
+		i -= size
+		if _, err := m.Distance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ConsecutiveEpochs != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.ConsecutiveEpochs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockRedelegationCompleted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRedelegationCompleted))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockRedelegationStarted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRedelegationStarted))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DstReputer) > 0 {
+		i -= len(m.DstReputer)
+		copy(dAtA[i:], m.DstReputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.DstReputer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SrcReputer) > 0 {
+		i -= len(m.SrcReputer)
+		copy(dAtA[i:], m.SrcReputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.SrcReputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardDebt.Size()
+		i -= size
+		if _, err := m.RewardDebt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReputerCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReputerCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReputerCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdateBlockHeight != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.UpdateBlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakeLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockUnlocked != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockUnlocked))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockLocked != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockLocked))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.RewardMultiplier.Size()
+		i -= size
+		if _, err := m.RewardMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStake(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStake(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StakePlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *DelegateStakePlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *StakeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *StakeRemovalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockRemovalCompleted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalCompleted))
+	}
+	return n
+}
+
+func (m *DelegateStakeRemovalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockRemovalCompleted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalCompleted))
+	}
+	return n
+}
+
+func (m *WorkerBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.LivenessBlockHeight != 0 {
+		n += 1 + sovStake(uint64(m.LivenessBlockHeight))
+	}
+	return n
+}
+
+func (m *RewardEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockEscrowed != 0 {
+		n += 1 + sovStake(uint64(m.BlockEscrowed))
+	}
+	if m.BlockVested != 0 {
+		n += 1 + sovStake(uint64(m.BlockVested))
+	}
+	return n
+}
+
+func (m *QueuedRewardPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStake(uint64(m.Id))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockQueued != 0 {
+		n += 1 + sovStake(uint64(m.BlockQueued))
+	}
+	if m.FailedAttempts != 0 {
+		n += 1 + sovStake(uint64(m.FailedAttempts))
+	}
+	return n
+}
+
+func (m *WorkerBondRemovalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockRemovalCompleted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalCompleted))
+	}
+	return n
+}
+
+func (m *ReputerConsensusDeviation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	if m.ConsecutiveEpochs != 0 {
+		n += 1 + sovStake(uint64(m.ConsecutiveEpochs))
+	}
+	l = m.Distance.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovStake(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *RedelegationInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = len(m.SrcReputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = len(m.DstReputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockRedelegationStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRedelegationStarted))
+	}
+	if m.BlockRedelegationCompleted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRedelegationCompleted))
+	}
+	return n
+}
+
+func (m *DelegatorInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.RewardDebt.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *ReputerCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.UpdateBlockHeight != 0 {
+		n += 1 + sovStake(uint64(m.UpdateBlockHeight))
+	}
+	return n
+}
+
+func (m *StakeLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.RewardMultiplier.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockLocked != 0 {
+		n += 1 + sovStake(uint64(m.BlockLocked))
+	}
+	if m.BlockUnlocked != 0 {
+		n += 1 + sovStake(uint64(m.BlockUnlocked))
+	}
+	return n
+}
+
+func sovStake(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStake(x uint64) (n int) {
+	return sovStake(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StakePlacement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakePlacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakePlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+			}
+			m.BlockRemovalStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateStakePlacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateStakePlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+			}
+			m.BlockRemovalStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeRemovalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+			}
+			m.BlockRemovalStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+			}
+			m.BlockRemovalCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateStakeRemovalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateStakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+			}
+			m.BlockRemovalStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+			}
+			m.BlockRemovalCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessBlockHeight", wireType)
+			}
+			m.LivenessBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LivenessBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockEscrowed", wireType)
+			}
+			m.BlockEscrowed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockEscrowed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockVested", wireType)
+			}
+			m.BlockVested = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockVested |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedRewardPayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedRewardPayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedRewardPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockQueued", wireType)
+			}
+			m.BlockQueued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockQueued |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
+			}
+			m.FailedAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerBondRemovalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerBondRemovalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerBondRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+			}
+			m.BlockRemovalStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+			}
+			m.BlockRemovalCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *DelegatorInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	l = m.RewardDebt.Size()
-	n += 1 + l + sovStake(uint64(l))
-	return n
-}
-
-func sovStake(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStake(x uint64) (n int) {
-	return sovStake(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *StakePlacement) Unmarshal(dAtA []byte) error {
+func (m *ReputerConsensusDeviation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -930,32 +3902,13 @@ func (m *StakePlacement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakePlacement: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReputerConsensusDeviation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakePlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReputerConsensusDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
-			}
-			m.BlockRemovalStarted = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStake
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockRemovalStarted |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -974,7 +3927,7 @@ func (m *StakePlacement) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
@@ -1006,9 +3959,28 @@ func (m *StakePlacement) Unmarshal(dAtA []byte) error {
 			}
 			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveEpochs", wireType)
+			}
+			m.ConsecutiveEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Distance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1036,10 +4008,29 @@ func (m *StakePlacement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Distance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStake(dAtA[iNdEx:])
@@ -1061,7 +4052,7 @@ func (m *StakePlacement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
+func (m *RedelegationInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1084,17 +4075,17 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateStakePlacement: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateStakePlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.BlockRemovalStarted = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1104,16 +4095,16 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1123,14 +4114,27 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcReputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1158,11 +4162,11 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			m.SrcReputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstReputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1190,7 +4194,7 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.DstReputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -1206,26 +4210,64 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRedelegationStarted", wireType)
+			}
+			m.BlockRedelegationStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRedelegationStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRedelegationCompleted", wireType)
+			}
+			m.BlockRedelegationCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRedelegationCompleted |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStake
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStake
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStake(dAtA[iNdEx:])
@@ -1247,7 +4289,7 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakeInfo) Unmarshal(dAtA []byte) error {
+func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1270,34 +4312,15 @@ func (m *StakeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStake
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1325,11 +4348,13 @@ func (m *StakeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDebt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1357,7 +4382,7 @@ func (m *StakeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1382,7 +4407,7 @@ func (m *StakeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
+func (m *ReputerCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1405,17 +4430,17 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakeRemovalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReputerCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReputerCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.BlockRemovalStarted = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1425,16 +4450,16 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1444,14 +4469,27 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1479,11 +4517,13 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1511,15 +4551,49 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateBlockHeight", wireType)
 			}
-			m.BlockRemovalCompleted = 0
+			m.UpdateBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1529,7 +4603,7 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
+				m.UpdateBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1555,7 +4629,7 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
+func (m *StakeLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1578,32 +4652,13 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateStakeRemovalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: StakeLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateStakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StakeLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
-			}
-			m.BlockRemovalStarted = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStake
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockRemovalStarted |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -1622,7 +4677,7 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
@@ -1654,7 +4709,7 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
@@ -1686,7 +4741,7 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1720,11 +4775,11 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardMultiplier", wireType)
 			}
-			m.BlockRemovalCompleted = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1734,66 +4789,31 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStake(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStake
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStake
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.RewardMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockLocked", wireType)
 			}
-			var stringLen uint64
+			m.BlockLocked = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1803,31 +4823,16 @@ func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockLocked |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStake
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStake
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDebt", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockUnlocked", wireType)
 			}
-			var stringLen uint64
+			m.BlockUnlocked = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1837,26 +4842,11 @@ func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockUnlocked |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStake
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStake
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RewardDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStake(dAtA[iNdEx:])
@@ -0,0 +1,78 @@
+package types
+
+// Maximum number of tags a topic can be created with
+const MaxTopicTags = 10
+
+// Maximum number of values a single inference of a topic can hold
+const MaxTopicOutputDimension = 32
+
+// IsEmpty returns true if no topic parameter is set
+func (p OptionalTopicParams) IsEmpty() bool {
+	return len(p.EpochLength) == 0 &&
+		len(p.WorkerSubmissionWindow) == 0 &&
+		len(p.AlphaRegret) == 0 &&
+		len(p.PNorm) == 0 &&
+		len(p.MeritSortitionAlpha) == 0 &&
+		len(p.ActiveInfererQuantile) == 0 &&
+		len(p.ActiveForecasterQuantile) == 0 &&
+		len(p.ActiveReputerQuantile) == 0
+}
+
+// ApplyToTopic returns a copy of the topic with every set parameter overwritten
+func (p OptionalTopicParams) ApplyToTopic(topic Topic) Topic {
+	if len(p.EpochLength) == 1 {
+		topic.EpochLength = p.EpochLength[0]
+	}
+	if len(p.WorkerSubmissionWindow) == 1 {
+		topic.WorkerSubmissionWindow = p.WorkerSubmissionWindow[0]
+	}
+	if len(p.AlphaRegret) == 1 {
+		topic.AlphaRegret = p.AlphaRegret[0]
+	}
+	if len(p.PNorm) == 1 {
+		topic.PNorm = p.PNorm[0]
+	}
+	if len(p.MeritSortitionAlpha) == 1 {
+		topic.MeritSortitionAlpha = p.MeritSortitionAlpha[0]
+	}
+	if len(p.ActiveInfererQuantile) == 1 {
+		topic.ActiveInfererQuantile = p.ActiveInfererQuantile[0]
+	}
+	if len(p.ActiveForecasterQuantile) == 1 {
+		topic.ActiveForecasterQuantile = p.ActiveForecasterQuantile[0]
+	}
+	if len(p.ActiveReputerQuantile) == 1 {
+		topic.ActiveReputerQuantile = p.ActiveReputerQuantile[0]
+	}
+	return topic
+}
+
+// MergeInto returns the pending update with every parameter set in p overwritten,
+// so that the latest UpdateTopic request wins for each field
+func (p OptionalTopicParams) MergeInto(pending OptionalTopicParams) OptionalTopicParams {
+	if len(p.EpochLength) == 1 {
+		pending.EpochLength = p.EpochLength
+	}
+	if len(p.WorkerSubmissionWindow) == 1 {
+		pending.WorkerSubmissionWindow = p.WorkerSubmissionWindow
+	}
+	if len(p.AlphaRegret) == 1 {
+		pending.AlphaRegret = p.AlphaRegret
+	}
+	if len(p.PNorm) == 1 {
+		pending.PNorm = p.PNorm
+	}
+	if len(p.MeritSortitionAlpha) == 1 {
+		pending.MeritSortitionAlpha = p.MeritSortitionAlpha
+	}
+	if len(p.ActiveInfererQuantile) == 1 {
+		pending.ActiveInfererQuantile = p.ActiveInfererQuantile
+	}
+	if len(p.ActiveForecasterQuantile) == 1 {
+		pending.ActiveForecasterQuantile = p.ActiveForecasterQuantile
+	}
+	if len(p.ActiveReputerQuantile) == 1 {
+		pending.ActiveReputerQuantile = p.ActiveReputerQuantile
+	}
+	return pending
+}
@@ -4,8 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_allora_network_allora_chain_math "github.com/allora-network/allora-chain/math"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -24,6 +27,64 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// How inference synthesis treats topics with a vector output
+type VectorSynthesisMode int32
+
+const (
+	// Losses are reported over the whole vector (per the loss method) and a
+	// single set of weights is applied to every component
+	VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED VectorSynthesisMode = 0
+	// Losses, regrets and weights are tracked separately for each component
+	VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_PER_COMPONENT VectorSynthesisMode = 1
+)
+
+var VectorSynthesisMode_name = map[int32]string{
+	0: "VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED",
+	1: "VECTOR_SYNTHESIS_MODE_PER_COMPONENT",
+}
+
+var VectorSynthesisMode_value = map[string]int32{
+	"VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED": 0,
+	"VECTOR_SYNTHESIS_MODE_PER_COMPONENT":    1,
+}
+
+func (x VectorSynthesisMode) String() string {
+	return proto.EnumName(VectorSynthesisMode_name, int32(x))
+}
+
+func (VectorSynthesisMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6320c39e204beb2b, []int{0}
+}
+
+// Who computes the losses of a topic's inferences
+type LossComputationMode int32
+
+const (
+	// Reputers compute losses off-chain and submit them in value bundles
+	LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED LossComputationMode = 0
+	// Reputers submit the ground truth and the chain computes losses with the
+	// built-in loss function named by the topic loss method
+	LossComputationMode_LOSS_COMPUTATION_MODE_CHAIN LossComputationMode = 1
+)
+
+var LossComputationMode_name = map[int32]string{
+	0: "LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED",
+	1: "LOSS_COMPUTATION_MODE_CHAIN",
+}
+
+var LossComputationMode_value = map[string]int32{
+	"LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED": 0,
+	"LOSS_COMPUTATION_MODE_CHAIN":               1,
+}
+
+func (x LossComputationMode) String() string {
+	return proto.EnumName(LossComputationMode_name, int32(x))
+}
+
+func (LossComputationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6320c39e204beb2b, []int{1}
+}
+
 type Topic struct {
 	Id                     uint64                                          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Creator                string                                          `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -44,6 +105,37 @@ type Topic struct {
 	ActiveInfererQuantile    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,19,opt,name=active_inferer_quantile,json=activeInfererQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_inferer_quantile"`
 	ActiveForecasterQuantile github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,20,opt,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_forecaster_quantile"`
 	ActiveReputerQuantile    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,21,opt,name=active_reputer_quantile,json=activeReputerQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_reputer_quantile"`
+	// number of values each inference holds. 0 and 1 both mean a scalar output
+	OutputDimension     uint64              `protobuf:"varint,22,opt,name=output_dimension,json=outputDimension,proto3" json:"output_dimension,omitempty"`
+	VectorSynthesisMode VectorSynthesisMode `protobuf:"varint,23,opt,name=vector_synthesis_mode,json=vectorSynthesisMode,proto3,enum=emissions.v3.VectorSynthesisMode" json:"vector_synthesis_mode,omitempty"`
+	// strictly increasing levels in (0, 1) of the quantiles that inferences hold
+	// for probabilistic topics. Empty for point estimate topics
+	QuantileLevels      []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,24,rep,name=quantile_levels,json=quantileLevels,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"quantile_levels"`
+	LossComputationMode LossComputationMode                               `protobuf:"varint,25,opt,name=loss_computation_mode,json=lossComputationMode,proto3,enum=emissions.v3.LossComputationMode" json:"loss_computation_mode,omitempty"`
+	// number of blocks after the worker submission window during which workers reveal
+	// the payloads they committed to. 0 means workers submit their payloads in the clear
+	WorkerRevealWindow int64 `protobuf:"varint,26,opt,name=worker_reveal_window,json=workerRevealWindow,proto3" json:"worker_reveal_window,omitempty"`
+	// amount each worker must have bonded on the topic to submit payloads.
+	// 0 means workers do not need to bond
+	WorkerBond cosmossdk_io_math.Int `protobuf:"bytes,27,opt,name=worker_bond,json=workerBond,proto3,customtype=cosmossdk.io/math.Int" json:"worker_bond"`
+	// number of contiguous epochs a bonded worker may miss before its bond is slashed
+	WorkerBondSlashMissedEpochs int64 `protobuf:"varint,28,opt,name=worker_bond_slash_missed_epochs,json=workerBondSlashMissedEpochs,proto3" json:"worker_bond_slash_missed_epochs,omitempty"`
+	// fraction of the bond slashed each time a worker misses that many epochs
+	WorkerBondSlashFraction github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,29,opt,name=worker_bond_slash_fraction,json=workerBondSlashFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"worker_bond_slash_fraction"`
+	// normalized distance from the loss consensus above which a reputer report counts as deviating
+	ReputerSlashDistanceThreshold github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,30,opt,name=reputer_slash_distance_threshold,json=reputerSlashDistanceThreshold,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_slash_distance_threshold"`
+	// number of consecutive deviating reports after which a reputer is slashed.
+	// 0 means reputers are never slashed
+	ReputerSlashEpochs int64 `protobuf:"varint,31,opt,name=reputer_slash_epochs,json=reputerSlashEpochs,proto3" json:"reputer_slash_epochs,omitempty"`
+	// fraction of the reputer's own stake slashed
+	ReputerSelfSlashFraction github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,32,opt,name=reputer_self_slash_fraction,json=reputerSelfSlashFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_self_slash_fraction"`
+	// fraction of the stake delegated to the reputer slashed
+	ReputerDelegateSlashFraction github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,33,opt,name=reputer_delegate_slash_fraction,json=reputerDelegateSlashFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_delegate_slash_fraction"`
+	// fraction of each worker and reputer reward held in escrow
+	RewardEscrowFraction github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,34,opt,name=reward_escrow_fraction,json=rewardEscrowFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reward_escrow_fraction"`
+	// number of blocks escrowed rewards vest linearly over.
+	// 0 means rewards are paid out in full without escrow
+	RewardEscrowVestingBlocks int64 `protobuf:"varint,35,opt,name=reward_escrow_vesting_blocks,json=rewardEscrowVestingBlocks,proto3" json:"reward_escrow_vesting_blocks,omitempty"`
 }
 
 func (m *Topic) Reset()         { *m = Topic{} }
@@ -142,6 +234,55 @@ func (m *Topic) GetWorkerSubmissionWindow() int64 {
 	return 0
 }
 
+func (m *Topic) GetOutputDimension() uint64 {
+	if m != nil {
+		return m.OutputDimension
+	}
+	return 0
+}
+
+func (m *Topic) GetVectorSynthesisMode() VectorSynthesisMode {
+	if m != nil {
+		return m.VectorSynthesisMode
+	}
+	return VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED
+}
+
+func (m *Topic) GetLossComputationMode() LossComputationMode {
+	if m != nil {
+		return m.LossComputationMode
+	}
+	return LossComputationMode_LOSS_COMPUTATION_MODE_REPUTER_UNSPECIFIED
+}
+
+func (m *Topic) GetWorkerRevealWindow() int64 {
+	if m != nil {
+		return m.WorkerRevealWindow
+	}
+	return 0
+}
+
+func (m *Topic) GetWorkerBondSlashMissedEpochs() int64 {
+	if m != nil {
+		return m.WorkerBondSlashMissedEpochs
+	}
+	return 0
+}
+
+func (m *Topic) GetReputerSlashEpochs() int64 {
+	if m != nil {
+		return m.ReputerSlashEpochs
+	}
+	return 0
+}
+
+func (m *Topic) GetRewardEscrowVestingBlocks() int64 {
+	if m != nil {
+		return m.RewardEscrowVestingBlocks
+	}
+	return 0
+}
+
 type TopicList struct {
 	Topics []*Topic `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
 }
@@ -327,67 +468,168 @@ func (m *TopicIdWeightPair) GetTopicId() uint64 {
 	return 0
 }
 
+// Topic parameters that may be changed after creation. Each field is either
+// empty (keep the current value) or holds exactly one new value.
+type OptionalTopicParams struct {
+	EpochLength              []int64                                           `protobuf:"varint,1,rep,packed,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	WorkerSubmissionWindow   []int64                                           `protobuf:"varint,2,rep,packed,name=worker_submission_window,json=workerSubmissionWindow,proto3" json:"worker_submission_window,omitempty"`
+	AlphaRegret              []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,rep,name=alpha_regret,json=alphaRegret,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"alpha_regret"`
+	PNorm                    []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,rep,name=p_norm,json=pNorm,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_norm"`
+	MeritSortitionAlpha      []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,rep,name=merit_sortition_alpha,json=meritSortitionAlpha,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"merit_sortition_alpha"`
+	ActiveInfererQuantile    []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,6,rep,name=active_inferer_quantile,json=activeInfererQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_inferer_quantile"`
+	ActiveForecasterQuantile []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,7,rep,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_forecaster_quantile"`
+	ActiveReputerQuantile    []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,8,rep,name=active_reputer_quantile,json=activeReputerQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_reputer_quantile"`
+}
+
+func (m *OptionalTopicParams) Reset()         { *m = OptionalTopicParams{} }
+func (m *OptionalTopicParams) String() string { return proto.CompactTextString(m) }
+func (*OptionalTopicParams) ProtoMessage()    {}
+func (*OptionalTopicParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6320c39e204beb2b, []int{5}
+}
+func (m *OptionalTopicParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptionalTopicParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptionalTopicParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OptionalTopicParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptionalTopicParams.Merge(m, src)
+}
+func (m *OptionalTopicParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptionalTopicParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptionalTopicParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptionalTopicParams proto.InternalMessageInfo
+
+func (m *OptionalTopicParams) GetEpochLength() []int64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return nil
+}
+
+func (m *OptionalTopicParams) GetWorkerSubmissionWindow() []int64 {
+	if m != nil {
+		return m.WorkerSubmissionWindow
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("emissions.v3.VectorSynthesisMode", VectorSynthesisMode_name, VectorSynthesisMode_value)
+	proto.RegisterEnum("emissions.v3.LossComputationMode", LossComputationMode_name, LossComputationMode_value)
 	proto.RegisterType((*Topic)(nil), "emissions.v3.Topic")
 	proto.RegisterType((*TopicList)(nil), "emissions.v3.TopicList")
 	proto.RegisterType((*TimestampedActorNonce)(nil), "emissions.v3.TimestampedActorNonce")
 	proto.RegisterType((*TopicIds)(nil), "emissions.v3.TopicIds")
 	proto.RegisterType((*TopicIdWeightPair)(nil), "emissions.v3.TopicIdWeightPair")
+	proto.RegisterType((*OptionalTopicParams)(nil), "emissions.v3.OptionalTopicParams")
 }
 
 func init() { proto.RegisterFile("emissions/v3/topic.proto", fileDescriptor_6320c39e204beb2b) }
 
 var fileDescriptor_6320c39e204beb2b = []byte{
-	// 777 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x8e, 0x1b, 0x35,
-	0x18, 0xc7, 0x77, 0x92, 0x49, 0x32, 0x71, 0xb2, 0xe9, 0xd4, 0xdb, 0x80, 0x09, 0x52, 0x12, 0x22,
-	0x21, 0x05, 0xa1, 0x26, 0x12, 0x7b, 0x60, 0xc5, 0xad, 0x15, 0x20, 0x1a, 0x2d, 0x81, 0x4e, 0x57,
-	0xaa, 0xd4, 0x03, 0x96, 0x33, 0xe3, 0x9d, 0xb1, 0x76, 0xc6, 0x1e, 0x6c, 0xcf, 0xa6, 0x9c, 0x78,
-	0x05, 0x1e, 0x81, 0x37, 0xe1, 0xda, 0x63, 0x8f, 0x88, 0x43, 0x85, 0x76, 0x2f, 0x3c, 0x06, 0xb2,
-	0x3d, 0xc9, 0xb6, 0xe2, 0x82, 0x94, 0xde, 0xc6, 0xff, 0xef, 0xf3, 0xef, 0xff, 0xd9, 0x33, 0xfa,
-	0x0f, 0x40, 0xb4, 0x60, 0x4a, 0x31, 0xc1, 0xd5, 0xf2, 0xfa, 0x74, 0xa9, 0x45, 0xc9, 0xe2, 0x45,
-	0x29, 0x85, 0x16, 0xb0, 0xbf, 0xaf, 0x2c, 0xae, 0x4f, 0x47, 0xef, 0xf6, 0x71, 0xc1, 0x63, 0xea,
-	0xfa, 0x46, 0x0f, 0x52, 0x91, 0x0a, 0xfb, 0xb8, 0x34, 0x4f, 0x4e, 0x9d, 0xfd, 0xd1, 0x05, 0xad,
-	0x0b, 0x43, 0x83, 0x03, 0xd0, 0x60, 0x09, 0xf2, 0xa6, 0xde, 0xdc, 0x8f, 0x1a, 0x2c, 0x81, 0x08,
-	0x74, 0x62, 0x49, 0x89, 0x16, 0x12, 0x35, 0xa6, 0xde, 0xbc, 0x1b, 0xed, 0x96, 0x70, 0x04, 0x82,
-	0x82, 0x6a, 0x92, 0x10, 0x4d, 0x50, 0xd3, 0x96, 0xf6, 0x6b, 0x38, 0x01, 0xbd, 0x5c, 0x28, 0x85,
-	0x0b, 0xaa, 0x33, 0x91, 0xa0, 0x96, 0x2d, 0x03, 0x23, 0x7d, 0x6f, 0x15, 0x38, 0x07, 0x21, 0x2d,
-	0x45, 0x9c, 0xe1, 0x9c, 0x28, 0x8d, 0x29, 0x4f, 0x68, 0x82, 0x82, 0xa9, 0x37, 0x6f, 0x46, 0x03,
-	0xab, 0x9f, 0x13, 0xa5, 0xbf, 0x31, 0x2a, 0xfc, 0x04, 0xf4, 0xeb, 0x4e, 0xca, 0x53, 0x9d, 0xa1,
-	0xae, 0xed, 0xea, 0xb9, 0x2e, 0x2b, 0x19, 0x58, 0x2a, 0x45, 0xc5, 0x13, 0xac, 0x65, 0xa5, 0x0d,
-	0x33, 0x45, 0xc0, 0xc1, 0x9c, 0x7e, 0x61, 0xe4, 0x73, 0x92, 0xc2, 0x35, 0x68, 0x97, 0x98, 0x0b,
-	0x59, 0xa0, 0xbe, 0x19, 0xe9, 0xf1, 0x97, 0xaf, 0xde, 0x4c, 0x8e, 0xfe, 0x7a, 0x33, 0x59, 0xa6,
-	0x4c, 0x67, 0xd5, 0x66, 0x11, 0x8b, 0x62, 0x49, 0xf2, 0x5c, 0x48, 0xf2, 0x90, 0x53, 0xbd, 0x15,
-	0xf2, 0x6a, 0xb7, 0x8c, 0x33, 0xc2, 0xf8, 0xb2, 0x20, 0x3a, 0x5b, 0x7c, 0x4d, 0xe3, 0xa8, 0x55,
-	0xae, 0x85, 0x2c, 0xe0, 0x0b, 0xd0, 0x27, 0x79, 0x99, 0x11, 0x2c, 0x69, 0x2a, 0xa9, 0x46, 0xc7,
-	0x87, 0x51, 0x7b, 0x16, 0x16, 0x59, 0x16, 0xfc, 0x14, 0x0c, 0x4c, 0xd7, 0x16, 0x73, 0x9a, 0x12,
-	0xcd, 0xae, 0x29, 0x1a, 0x4c, 0xbd, 0x79, 0x10, 0x1d, 0x5b, 0x75, 0x5d, 0x8b, 0xf0, 0x29, 0xe8,
-	0xd0, 0x52, 0xb1, 0x5c, 0x70, 0x74, 0xef, 0x30, 0xf7, 0x1d, 0x07, 0xfe, 0x04, 0x06, 0x8c, 0x33,
-	0xcd, 0x48, 0xbe, 0x3b, 0x57, 0x78, 0x18, 0xf9, 0xb8, 0xc6, 0xd5, 0x27, 0x3b, 0x03, 0xc8, 0x34,
-	0x53, 0x89, 0x55, 0xb5, 0xa9, 0x3f, 0x54, 0xbc, 0x65, 0x3c, 0x11, 0x5b, 0x74, 0xdf, 0xbe, 0xb7,
-	0x0f, 0x5c, 0xfd, 0xd9, 0xbe, 0xfc, 0xdc, 0x56, 0xe1, 0x15, 0x18, 0x16, 0x54, 0x32, 0x8d, 0x95,
-	0x90, 0x9a, 0x69, 0xb3, 0xcf, 0x5e, 0x19, 0x82, 0x87, 0x0d, 0x78, 0x62, 0xa9, 0xcf, 0x76, 0xd0,
-	0x47, 0x86, 0x09, 0x05, 0xf8, 0x90, 0xc4, 0xe6, 0x8e, 0x31, 0xe3, 0x97, 0x54, 0x52, 0x89, 0x7f,
-	0xae, 0x08, 0xd7, 0x2c, 0xa7, 0xe8, 0xe4, 0x30, 0xbb, 0xa1, 0xe3, 0x3e, 0x71, 0xd8, 0xa7, 0x35,
-	0x15, 0x56, 0x60, 0x54, 0x1b, 0x5e, 0x0a, 0x49, 0x63, 0xa2, 0xf4, 0xdb, 0x9e, 0x0f, 0x0e, 0xf3,
-	0x44, 0x0e, 0xfd, 0xed, 0x9e, 0xbc, 0xb7, 0xbd, 0x3b, 0xa7, 0xa4, 0x65, 0xf5, 0x8e, 0xe7, 0xf0,
-	0xbd, 0x9c, 0x33, 0x72, 0xd8, 0x9d, 0xe1, 0xca, 0x0f, 0xfc, 0xb0, 0xb5, 0xf2, 0x83, 0x76, 0xd8,
-	0x59, 0xf9, 0x41, 0x27, 0x0c, 0x56, 0x7e, 0xd0, 0x0b, 0xfb, 0x2e, 0x20, 0x70, 0x2e, 0x52, 0x16,
-	0x47, 0xf7, 0xdc, 0x9d, 0xf3, 0x98, 0xd6, 0x42, 0x78, 0x27, 0xb8, 0x54, 0x89, 0x7a, 0x09, 0xbd,
-	0x24, 0x55, 0xae, 0x31, 0x91, 0xe9, 0xec, 0x0c, 0x74, 0x6d, 0x80, 0x9d, 0x33, 0xa5, 0xe1, 0xe7,
-	0xa0, 0x6d, 0xb3, 0x51, 0x21, 0x6f, 0xda, 0x9c, 0xf7, 0xbe, 0x38, 0x59, 0xbc, 0x9d, 0x8e, 0x0b,
-	0xdb, 0x18, 0xd5, 0x2d, 0xb3, 0x97, 0x60, 0x78, 0xc1, 0x0a, 0xaa, 0x34, 0x29, 0x4a, 0x9a, 0x3c,
-	0x8a, 0xb5, 0x90, 0x6b, 0x13, 0x98, 0x26, 0x79, 0x36, 0xb9, 0x88, 0xaf, 0x70, 0x46, 0x59, 0x9a,
-	0x69, 0x1b, 0x8a, 0xcd, 0xa8, 0x67, 0xb5, 0xef, 0xac, 0x04, 0x3f, 0x03, 0x2d, 0x1b, 0xae, 0x36,
-	0x00, 0xff, 0xe3, 0x63, 0x31, 0x91, 0xeb, 0xf8, 0xca, 0xff, 0xe7, 0xf7, 0x89, 0xb7, 0xf2, 0x83,
-	0x46, 0xd8, 0x8c, 0x5a, 0xc4, 0xb8, 0xcc, 0x1e, 0x82, 0xc0, 0x8e, 0xf2, 0x24, 0x51, 0xf0, 0x63,
-	0xd0, 0xb5, 0xf3, 0x60, 0x96, 0xb8, 0xa9, 0xfd, 0x28, 0xd0, 0x75, 0xd1, 0xed, 0x9d, 0xfd, 0x0a,
-	0xee, 0xd7, 0xed, 0xcf, 0xad, 0xfb, 0x8f, 0x84, 0x49, 0xf8, 0x11, 0x08, 0x76, 0xfb, 0xea, 0xd4,
-	0xee, 0xd4, 0xdb, 0xe0, 0x0f, 0xa0, 0xbd, 0x75, 0x93, 0x37, 0x0e, 0x7b, 0x8d, 0x35, 0xe6, 0x71,
-	0xf4, 0xea, 0x66, 0xec, 0xbd, 0xbe, 0x19, 0x7b, 0x7f, 0xdf, 0x8c, 0xbd, 0xdf, 0x6e, 0xc7, 0x47,
-	0xaf, 0x6f, 0xc7, 0x47, 0x7f, 0xde, 0x8e, 0x8f, 0x5e, 0x9c, 0xfd, 0x4f, 0xe4, 0xcb, 0xe5, 0xdd,
-	0x8f, 0x49, 0xff, 0x52, 0x52, 0xb5, 0x69, 0xdb, 0x1f, 0xd0, 0xe9, 0xbf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xe3, 0x0b, 0xb9, 0xfe, 0xda, 0x06, 0x00, 0x00,
+	// 1382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x53, 0x1b, 0x37,
+	0x1b, 0x66, 0xc1, 0x06, 0x23, 0x13, 0x70, 0x64, 0x48, 0x04, 0x24, 0xd8, 0x21, 0xf3, 0x7d, 0x1f,
+	0xe1, 0x1b, 0x70, 0x26, 0x39, 0x34, 0xd3, 0x4b, 0x87, 0x1f, 0xce, 0xc4, 0x0c, 0xd8, 0x64, 0x6d,
+	0x92, 0x69, 0x0e, 0x55, 0xc5, 0xae, 0xb0, 0x55, 0x76, 0x57, 0xae, 0x24, 0x9b, 0xe4, 0xd2, 0xf6,
+	0x4f, 0xe8, 0xb1, 0xc7, 0x1e, 0x7b, 0xec, 0xa1, 0x7f, 0x44, 0x8e, 0x99, 0x9e, 0x3a, 0x3d, 0x64,
+	0x3a, 0x49, 0x67, 0xda, 0x3f, 0xa3, 0xb3, 0x92, 0xd6, 0x60, 0x20, 0x9d, 0x36, 0x9b, 0x0b, 0xc3,
+	0xbe, 0xef, 0xf3, 0x3e, 0xcf, 0xfb, 0x4a, 0x5a, 0xed, 0x63, 0x80, 0x68, 0xc8, 0xa4, 0x64, 0x3c,
+	0x92, 0x95, 0xfe, 0xfd, 0x8a, 0xe2, 0x5d, 0xe6, 0xad, 0x77, 0x05, 0x57, 0x1c, 0x4e, 0x0d, 0x32,
+	0xeb, 0xfd, 0xfb, 0x0b, 0x57, 0x49, 0xc8, 0x22, 0x5e, 0xd1, 0x7f, 0x0d, 0x60, 0x61, 0xde, 0xe3,
+	0x32, 0xe4, 0x12, 0xeb, 0xa7, 0x8a, 0x79, 0xb0, 0xa9, 0x61, 0xd6, 0x88, 0x47, 0x1e, 0xb5, 0x99,
+	0xd9, 0x36, 0x6f, 0x73, 0x53, 0x11, 0xff, 0x67, 0xa2, 0xcb, 0xdf, 0x15, 0x41, 0xb6, 0x15, 0x6b,
+	0xc3, 0x69, 0x30, 0xca, 0x7c, 0xe4, 0x94, 0x9d, 0x95, 0x8c, 0x3b, 0xca, 0x7c, 0x88, 0xc0, 0x84,
+	0x27, 0x28, 0x51, 0x5c, 0xa0, 0xd1, 0xb2, 0xb3, 0x32, 0xe9, 0x26, 0x8f, 0x70, 0x01, 0xe4, 0x42,
+	0xaa, 0x88, 0x4f, 0x14, 0x41, 0x63, 0x3a, 0x35, 0x78, 0x86, 0x25, 0x90, 0x0f, 0xb8, 0x94, 0x38,
+	0xa4, 0xaa, 0xc3, 0x7d, 0x94, 0xd5, 0x69, 0x10, 0x87, 0xf6, 0x74, 0x04, 0xae, 0x80, 0x02, 0xed,
+	0x72, 0xaf, 0x83, 0x03, 0x22, 0x15, 0xa6, 0x91, 0x4f, 0x7d, 0x94, 0x2b, 0x3b, 0x2b, 0x63, 0xee,
+	0xb4, 0x8e, 0xef, 0x12, 0xa9, 0xaa, 0x71, 0x14, 0xde, 0x02, 0x53, 0x16, 0x49, 0xa3, 0xb6, 0xea,
+	0xa0, 0x49, 0x8d, 0xca, 0x1b, 0x94, 0x0e, 0xc5, 0x64, 0x6d, 0xc1, 0x7b, 0x91, 0x8f, 0x95, 0xe8,
+	0xa9, 0x98, 0xb3, 0x8d, 0x80, 0x21, 0x33, 0xf1, 0x56, 0x1c, 0xde, 0x25, 0x6d, 0x58, 0x07, 0xe3,
+	0x5d, 0x1c, 0x71, 0x11, 0xa2, 0xa9, 0xb8, 0xa5, 0xcd, 0x8f, 0x5e, 0xbe, 0x2e, 0x8d, 0xfc, 0xfa,
+	0xba, 0x54, 0x69, 0x33, 0xd5, 0xe9, 0x1d, 0xae, 0x7b, 0x3c, 0xac, 0x90, 0x20, 0xe0, 0x82, 0xac,
+	0x45, 0x54, 0x9d, 0x70, 0x71, 0x9c, 0x3c, 0x7a, 0x1d, 0xc2, 0xa2, 0x4a, 0x48, 0x54, 0x67, 0x7d,
+	0x9b, 0x7a, 0x6e, 0xb6, 0x5b, 0xe7, 0x22, 0x84, 0xcf, 0xc0, 0x14, 0x09, 0xba, 0x1d, 0x82, 0x05,
+	0x6d, 0x0b, 0xaa, 0xd0, 0x95, 0x74, 0xac, 0x79, 0x4d, 0xe6, 0x6a, 0x2e, 0xf8, 0x1f, 0x30, 0x1d,
+	0xa3, 0x4e, 0x70, 0x44, 0xdb, 0x44, 0xb1, 0x3e, 0x45, 0xd3, 0x65, 0x67, 0x25, 0xe7, 0x5e, 0xd1,
+	0xd1, 0xba, 0x0d, 0xc2, 0xc7, 0x60, 0x82, 0x76, 0x25, 0x0b, 0x78, 0x84, 0x66, 0xd2, 0xa9, 0x27,
+	0x3c, 0xf0, 0x33, 0x30, 0xcd, 0x22, 0xa6, 0x18, 0x09, 0x92, 0xb9, 0x0a, 0xe9, 0x98, 0xaf, 0x58,
+	0x3a, 0x3b, 0xd9, 0x03, 0x80, 0x62, 0x30, 0x15, 0x58, 0xf6, 0x0e, 0xed, 0x41, 0xc5, 0x27, 0x2c,
+	0xf2, 0xf9, 0x09, 0xba, 0xaa, 0xf7, 0xed, 0x9a, 0xc9, 0x37, 0x07, 0xe9, 0xa7, 0x3a, 0x0b, 0x8f,
+	0xc1, 0x5c, 0x48, 0x05, 0x53, 0x58, 0x72, 0xa1, 0x98, 0x8a, 0xeb, 0xf4, 0x92, 0x21, 0x98, 0xae,
+	0xc1, 0xa2, 0x66, 0x6d, 0x26, 0xa4, 0x1b, 0x31, 0x27, 0xe4, 0xe0, 0x3a, 0xf1, 0xe2, 0x35, 0xc6,
+	0x2c, 0x3a, 0xa2, 0x82, 0x0a, 0xfc, 0x65, 0x8f, 0x44, 0x8a, 0x05, 0x14, 0x15, 0xd3, 0xc9, 0xcd,
+	0x19, 0xde, 0x9a, 0xa1, 0x7d, 0x6c, 0x59, 0x61, 0x0f, 0x2c, 0x58, 0xc1, 0x23, 0x2e, 0xa8, 0x47,
+	0xa4, 0x3a, 0xab, 0x39, 0x9b, 0x4e, 0x13, 0x19, 0xea, 0x87, 0x03, 0xe6, 0x81, 0xec, 0xe9, 0x9c,
+	0x82, 0x76, 0x7b, 0x43, 0x9a, 0x73, 0x1f, 0x64, 0x4e, 0xd7, 0xd0, 0x0e, 0x04, 0xef, 0x80, 0x02,
+	0xef, 0xa9, 0x6e, 0x4f, 0x61, 0x9f, 0x85, 0x34, 0x8a, 0xf7, 0x17, 0x5d, 0xd3, 0x37, 0xce, 0x8c,
+	0x89, 0x6f, 0x27, 0x61, 0x78, 0x00, 0xe6, 0xfa, 0xd4, 0x53, 0x5c, 0x60, 0xf9, 0x22, 0x52, 0x1d,
+	0x2a, 0x99, 0xc4, 0x21, 0xf7, 0x29, 0xba, 0x5e, 0x76, 0x56, 0xa6, 0xef, 0xdd, 0x5a, 0x3f, 0x7b,
+	0x49, 0xae, 0x3f, 0xd1, 0xd0, 0x66, 0x82, 0xdc, 0xe3, 0x3e, 0x75, 0x8b, 0xfd, 0x8b, 0x41, 0xf8,
+	0x39, 0x98, 0x49, 0x66, 0xc4, 0x01, 0xed, 0xd3, 0x40, 0x22, 0x54, 0x1e, 0x4b, 0x33, 0xea, 0x74,
+	0xc2, 0xb7, 0xab, 0xe9, 0xe2, 0xc6, 0xf5, 0x0d, 0xe8, 0xf1, 0xb0, 0xdb, 0x53, 0x44, 0x1f, 0x55,
+	0xdd, 0xf8, 0xfc, 0x65, 0x8d, 0xef, 0x72, 0x29, 0xb7, 0x4e, 0x91, 0xa6, 0xf1, 0xe0, 0x62, 0x10,
+	0xde, 0x05, 0xb3, 0xf6, 0xd5, 0x11, 0xb4, 0x4f, 0x49, 0x90, 0xbc, 0x36, 0x0b, 0xfa, 0xb5, 0x81,
+	0x26, 0xe7, 0xea, 0x94, 0x7d, 0x65, 0x1e, 0x83, 0xbc, 0xad, 0x38, 0xe4, 0x91, 0x8f, 0x16, 0xf5,
+	0x8e, 0xde, 0xb5, 0x63, 0xce, 0x99, 0xaf, 0x86, 0xf4, 0x8f, 0xd7, 0x19, 0x37, 0xc3, 0xd4, 0x22,
+	0xf5, 0xf3, 0x4f, 0x6b, 0xc0, 0x7e, 0x4e, 0x6a, 0x91, 0xfa, 0xe1, 0x8f, 0x1f, 0x57, 0x1d, 0x17,
+	0x18, 0x92, 0x4d, 0x1e, 0xf9, 0x70, 0x1b, 0x94, 0xce, 0x50, 0x62, 0x19, 0x10, 0xd9, 0xc1, 0xf1,
+	0x38, 0xd4, 0xc7, 0xfa, 0x5e, 0x96, 0xe8, 0x86, 0xee, 0x67, 0xf1, 0xb4, 0xa8, 0x19, 0x83, 0xf6,
+	0x34, 0xa6, 0xaa, 0x21, 0x50, 0x81, 0x85, 0x8b, 0x2c, 0x47, 0x22, 0x3e, 0x32, 0x3c, 0x42, 0x37,
+	0xd3, 0x9d, 0xbc, 0xeb, 0xe7, 0x94, 0x1f, 0x5a, 0x5e, 0xf8, 0x8d, 0x03, 0xca, 0xc9, 0x31, 0x37,
+	0x92, 0x3e, 0x93, 0x8a, 0x44, 0x1e, 0xc5, 0xaa, 0x23, 0xa8, 0xec, 0xf0, 0xc0, 0x47, 0x4b, 0xe9,
+	0xc4, 0x6f, 0x5a, 0x01, 0xad, 0xbc, 0x6d, 0xe9, 0x5b, 0x09, 0x7b, 0xbc, 0x87, 0xc3, 0x1d, 0xd8,
+	0x35, 0x2b, 0x99, 0x3d, 0x3c, 0x5b, 0x6c, 0x97, 0xaa, 0x0f, 0x16, 0x07, 0x15, 0x34, 0x38, 0x3a,
+	0xbf, 0x56, 0xe5, 0x94, 0x37, 0x43, 0xa2, 0x48, 0x83, 0xa3, 0xe1, 0xc5, 0xfa, 0x0a, 0x94, 0x12,
+	0x5d, 0x9f, 0x06, 0xf1, 0x17, 0x87, 0x9e, 0xd7, 0xbe, 0x95, 0x4e, 0xfb, 0x86, 0xe5, 0xdf, 0xb6,
+	0xf4, 0xc3, 0xfa, 0x21, 0xb8, 0x26, 0xe8, 0x09, 0x11, 0x3e, 0xa6, 0xd2, 0x13, 0xfc, 0xe4, 0x54,
+	0x76, 0x39, 0x9d, 0xec, 0xac, 0xa1, 0xad, 0x6a, 0xd6, 0x81, 0xdc, 0x27, 0xe0, 0xc6, 0xb0, 0x5c,
+	0x9f, 0x4a, 0xc5, 0xa2, 0x36, 0x3e, 0x0c, 0xb8, 0x77, 0x2c, 0xd1, 0x6d, 0xbd, 0x41, 0xf3, 0x67,
+	0x6b, 0x9f, 0x18, 0xc4, 0xa6, 0x06, 0xec, 0x64, 0x72, 0x99, 0x42, 0x76, 0x27, 0x93, 0x1b, 0x2f,
+	0x4c, 0xec, 0x64, 0x72, 0x13, 0x85, 0xdc, 0x4e, 0x26, 0x97, 0x2f, 0x4c, 0x19, 0xe7, 0x83, 0x03,
+	0xde, 0x66, 0x9e, 0x3b, 0x63, 0x3e, 0x26, 0xf1, 0x81, 0x33, 0x81, 0xc2, 0x69, 0xc0, 0xd8, 0x25,
+	0x37, 0xef, 0xd3, 0x23, 0xd2, 0x0b, 0x14, 0x26, 0xa2, 0xbd, 0xfc, 0x00, 0x4c, 0x6a, 0x67, 0xb6,
+	0xcb, 0xa4, 0x82, 0xff, 0x07, 0xe3, 0xda, 0x22, 0x4a, 0xe4, 0x94, 0xc7, 0x56, 0xf2, 0xf7, 0x8a,
+	0xc3, 0xd7, 0x88, 0x06, 0xba, 0x16, 0xb2, 0xfc, 0x1c, 0xcc, 0xb5, 0x58, 0x48, 0xa5, 0x22, 0x61,
+	0x97, 0xfa, 0x1b, 0xf1, 0x2d, 0x58, 0x8f, 0x9d, 0x60, 0x6c, 0xa9, 0xf4, 0x44, 0xb8, 0x43, 0x59,
+	0xbb, 0xa3, 0xb4, 0xdb, 0x1b, 0x73, 0xf3, 0x3a, 0xf6, 0x48, 0x87, 0xe0, 0x1d, 0x90, 0xd5, 0xae,
+	0x51, 0x3b, 0xbb, 0x0b, 0x3a, 0x9a, 0xc6, 0x35, 0x88, 0x8f, 0x33, 0x7f, 0x7e, 0x5f, 0x72, 0x76,
+	0x32, 0xb9, 0xd1, 0xc2, 0x98, 0x9b, 0x25, 0xb1, 0xca, 0xf2, 0x1a, 0xc8, 0xe9, 0x56, 0x6a, 0xbe,
+	0x84, 0x8b, 0x60, 0x52, 0xf7, 0x83, 0x99, 0x6f, 0xba, 0xce, 0xb8, 0x39, 0x65, 0x93, 0xa6, 0x76,
+	0xf9, 0x6b, 0x70, 0xd5, 0xc2, 0x9f, 0x6a, 0xf5, 0x7d, 0xc2, 0x04, 0x9c, 0x07, 0xb9, 0xa4, 0xce,
+	0xda, 0xd1, 0x09, 0x5b, 0x06, 0x1b, 0x60, 0xfc, 0xc4, 0x74, 0x3e, 0x9a, 0xee, 0x18, 0x58, 0x9a,
+	0xe5, 0xdf, 0xb3, 0xa0, 0xd8, 0xe8, 0xc6, 0x67, 0x80, 0x04, 0xba, 0x93, 0x7d, 0x22, 0x48, 0x28,
+	0x2f, 0x78, 0xcf, 0xb8, 0xfd, 0x73, 0xde, 0xf3, 0xef, 0xbc, 0xcc, 0xa8, 0x86, 0xbf, 0xcb, 0xcb,
+	0x9c, 0xf7, 0x8e, 0x63, 0xe9, 0x3e, 0x40, 0x43, 0xde, 0xf1, 0xd4, 0xe7, 0x66, 0xd2, 0xb1, 0x5a,
+	0x9f, 0xfb, 0x4e, 0xdf, 0x95, 0x4d, 0x47, 0xff, 0x6f, 0x7d, 0xd7, 0x78, 0x3a, 0xb9, 0xf7, 0xf2,
+	0x5d, 0x13, 0xe9, 0x34, 0xdf, 0xcb, 0x77, 0xe5, 0x3e, 0xc8, 0x9c, 0xe7, 0x7c, 0xd7, 0xea, 0x17,
+	0xa0, 0x78, 0x89, 0x43, 0x82, 0xab, 0xe0, 0xbf, 0x4f, 0xaa, 0x5b, 0xad, 0x86, 0x8b, 0x9b, 0x9f,
+	0xd6, 0x5b, 0x8f, 0xaa, 0xcd, 0x5a, 0x13, 0xef, 0x35, 0xb6, 0xab, 0xb8, 0xde, 0x70, 0xf7, 0xf0,
+	0x41, 0xbd, 0xb9, 0x5f, 0xdd, 0xaa, 0x3d, 0xac, 0x55, 0xb7, 0x0b, 0x23, 0xf0, 0x7f, 0xe0, 0xf6,
+	0xe5, 0xd8, 0xfd, 0xaa, 0x8b, 0xb7, 0x1a, 0x7b, 0xfb, 0x8d, 0x7a, 0xb5, 0xde, 0x2a, 0x38, 0xab,
+	0x14, 0x14, 0x2f, 0x31, 0x35, 0x70, 0x0d, 0xdc, 0xd9, 0x6d, 0x34, 0x9b, 0x1a, 0x7a, 0xd0, 0xda,
+	0x68, 0xd5, 0x1a, 0x75, 0x53, 0xef, 0x56, 0xf7, 0x0f, 0x5a, 0x55, 0xf7, 0x9c, 0x5c, 0x09, 0x2c,
+	0x5e, 0x0e, 0xdf, 0x7a, 0xb4, 0x51, 0xab, 0x17, 0x9c, 0x4d, 0xf7, 0xe5, 0x9b, 0x25, 0xe7, 0xd5,
+	0x9b, 0x25, 0xe7, 0xb7, 0x37, 0x4b, 0xce, 0xb7, 0x6f, 0x97, 0x46, 0x5e, 0xbd, 0x5d, 0x1a, 0xf9,
+	0xe5, 0xed, 0xd2, 0xc8, 0xb3, 0x07, 0xff, 0x70, 0xd1, 0x9e, 0x57, 0x4e, 0x7f, 0x2b, 0xab, 0x17,
+	0x5d, 0x2a, 0x0f, 0xc7, 0xf5, 0x6f, 0xe2, 0xfb, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x4e,
+	0xee, 0x0d, 0x9b, 0x0f, 0x00, 0x00,
 }
 
 func (this *TimestampedActorNonce) Equal(that interface{}) bool {
@@ -466,6 +708,143 @@ func (m *Topic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RewardEscrowVestingBlocks != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.RewardEscrowVestingBlocks))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size := m.RewardEscrowFraction.Size()
+		i -= size
+		if _, err := m.RewardEscrowFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.ReputerDelegateSlashFraction.Size()
+		i -= size
+		if _, err := m.ReputerDelegateSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.ReputerSelfSlashFraction.Size()
+		i -= size
+		if _, err := m.ReputerSelfSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x82
+	if m.ReputerSlashEpochs != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.ReputerSlashEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	{
+		size := m.ReputerSlashDistanceThreshold.Size()
+		i -= size
+		if _, err := m.ReputerSlashDistanceThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	{
+		size := m.WorkerBondSlashFraction.Size()
+		i -= size
+		if _, err := m.WorkerBondSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if m.WorkerBondSlashMissedEpochs != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.WorkerBondSlashMissedEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	{
+		size := m.WorkerBond.Size()
+		i -= size
+		if _, err := m.WorkerBond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	if m.WorkerRevealWindow != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.WorkerRevealWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.LossComputationMode != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.LossComputationMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.QuantileLevels) > 0 {
+		for iNdEx := len(m.QuantileLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.QuantileLevels[iNdEx].Size()
+				i -= size
+				if _, err := m.QuantileLevels[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.VectorSynthesisMode != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.VectorSynthesisMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.OutputDimension != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.OutputDimension))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
 	{
 		size := m.ActiveReputerQuantile.Size()
 		i -= size
@@ -773,45 +1152,190 @@ func (m *TopicIdWeightPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTopic(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTopic(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OptionalTopicParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Topic) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *OptionalTopicParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptionalTopicParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTopic(uint64(m.Id))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	if len(m.ActiveReputerQuantile) > 0 {
+		for iNdEx := len(m.ActiveReputerQuantile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ActiveReputerQuantile[iNdEx].Size()
+				i -= size
+				if _, err := m.ActiveReputerQuantile[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	l = len(m.LossMethod)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	if len(m.ActiveForecasterQuantile) > 0 {
+		for iNdEx := len(m.ActiveForecasterQuantile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ActiveForecasterQuantile[iNdEx].Size()
+				i -= size
+				if _, err := m.ActiveForecasterQuantile[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if m.EpochLastEnded != 0 {
-		n += 1 + sovTopic(uint64(m.EpochLastEnded))
+	if len(m.ActiveInfererQuantile) > 0 {
+		for iNdEx := len(m.ActiveInfererQuantile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ActiveInfererQuantile[iNdEx].Size()
+				i -= size
+				if _, err := m.ActiveInfererQuantile[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if m.EpochLength != 0 {
-		n += 1 + sovTopic(uint64(m.EpochLength))
+	if len(m.MeritSortitionAlpha) > 0 {
+		for iNdEx := len(m.MeritSortitionAlpha) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MeritSortitionAlpha[iNdEx].Size()
+				i -= size
+				if _, err := m.MeritSortitionAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.GroundTruthLag != 0 {
+	if len(m.PNorm) > 0 {
+		for iNdEx := len(m.PNorm) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PNorm[iNdEx].Size()
+				i -= size
+				if _, err := m.PNorm[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AlphaRegret) > 0 {
+		for iNdEx := len(m.AlphaRegret) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.AlphaRegret[iNdEx].Size()
+				i -= size
+				if _, err := m.AlphaRegret[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.WorkerSubmissionWindow) > 0 {
+		dAtA5 := make([]byte, len(m.WorkerSubmissionWindow)*10)
+		var j4 int
+		for _, num1 := range m.WorkerSubmissionWindow {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTopic(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EpochLength) > 0 {
+		dAtA7 := make([]byte, len(m.EpochLength)*10)
+		var j6 int
+		for _, num1 := range m.EpochLength {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTopic(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTopic(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTopic(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Topic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTopic(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.LossMethod)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	if m.EpochLastEnded != 0 {
+		n += 1 + sovTopic(uint64(m.EpochLastEnded))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovTopic(uint64(m.EpochLength))
+	}
+	if m.GroundTruthLag != 0 {
 		n += 1 + sovTopic(uint64(m.GroundTruthLag))
 	}
 	l = m.PNorm.Size()
@@ -836,6 +1360,45 @@ func (m *Topic) Size() (n int) {
 	n += 2 + l + sovTopic(uint64(l))
 	l = m.ActiveReputerQuantile.Size()
 	n += 2 + l + sovTopic(uint64(l))
+	if m.OutputDimension != 0 {
+		n += 2 + sovTopic(uint64(m.OutputDimension))
+	}
+	if m.VectorSynthesisMode != 0 {
+		n += 2 + sovTopic(uint64(m.VectorSynthesisMode))
+	}
+	if len(m.QuantileLevels) > 0 {
+		for _, e := range m.QuantileLevels {
+			l = e.Size()
+			n += 2 + l + sovTopic(uint64(l))
+		}
+	}
+	if m.LossComputationMode != 0 {
+		n += 2 + sovTopic(uint64(m.LossComputationMode))
+	}
+	if m.WorkerRevealWindow != 0 {
+		n += 2 + sovTopic(uint64(m.WorkerRevealWindow))
+	}
+	l = m.WorkerBond.Size()
+	n += 2 + l + sovTopic(uint64(l))
+	if m.WorkerBondSlashMissedEpochs != 0 {
+		n += 2 + sovTopic(uint64(m.WorkerBondSlashMissedEpochs))
+	}
+	l = m.WorkerBondSlashFraction.Size()
+	n += 2 + l + sovTopic(uint64(l))
+	l = m.ReputerSlashDistanceThreshold.Size()
+	n += 2 + l + sovTopic(uint64(l))
+	if m.ReputerSlashEpochs != 0 {
+		n += 2 + sovTopic(uint64(m.ReputerSlashEpochs))
+	}
+	l = m.ReputerSelfSlashFraction.Size()
+	n += 2 + l + sovTopic(uint64(l))
+	l = m.ReputerDelegateSlashFraction.Size()
+	n += 2 + l + sovTopic(uint64(l))
+	l = m.RewardEscrowFraction.Size()
+	n += 2 + l + sovTopic(uint64(l))
+	if m.RewardEscrowVestingBlocks != 0 {
+		n += 2 + sovTopic(uint64(m.RewardEscrowVestingBlocks))
+	}
 	return n
 }
 
@@ -900,6 +1463,65 @@ func (m *TopicIdWeightPair) Size() (n int) {
 	return n
 }
 
+func (m *OptionalTopicParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochLength) > 0 {
+		l = 0
+		for _, e := range m.EpochLength {
+			l += sovTopic(uint64(e))
+		}
+		n += 1 + sovTopic(uint64(l)) + l
+	}
+	if len(m.WorkerSubmissionWindow) > 0 {
+		l = 0
+		for _, e := range m.WorkerSubmissionWindow {
+			l += sovTopic(uint64(e))
+		}
+		n += 1 + sovTopic(uint64(l)) + l
+	}
+	if len(m.AlphaRegret) > 0 {
+		for _, e := range m.AlphaRegret {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.PNorm) > 0 {
+		for _, e := range m.PNorm {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.MeritSortitionAlpha) > 0 {
+		for _, e := range m.MeritSortitionAlpha {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.ActiveInfererQuantile) > 0 {
+		for _, e := range m.ActiveInfererQuantile {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.ActiveForecasterQuantile) > 0 {
+		for _, e := range m.ActiveForecasterQuantile {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.ActiveReputerQuantile) > 0 {
+		for _, e := range m.ActiveReputerQuantile {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTopic(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1418,61 +2040,49 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTopic(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTopic
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDimension", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TopicList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTopic
+			m.OutputDimension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputDimension |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VectorSynthesisMode", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.VectorSynthesisMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VectorSynthesisMode |= VectorSynthesisMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TopicList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantileLevels", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1482,54 +2092,544 @@ func (m *TopicList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTopic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTopic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, &Topic{})
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.QuantileLevels = append(m.QuantileLevels, v)
+			if err := m.QuantileLevels[len(m.QuantileLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTopic(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossComputationMode", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTopic
+			m.LossComputationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LossComputationMode |= LossComputationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerRevealWindow", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TimestampedActorNonce) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+			m.WorkerRevealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerRevealWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerBond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WorkerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerBondSlashMissedEpochs", wireType)
+			}
+			m.WorkerBondSlashMissedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerBondSlashMissedEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerBondSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WorkerBondSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashDistanceThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerSlashDistanceThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashEpochs", wireType)
+			}
+			m.ReputerSlashEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReputerSlashEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerSelfSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerSelfSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerDelegateSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerDelegateSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEscrowFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardEscrowFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEscrowVestingBlocks", wireType)
+			}
+			m.RewardEscrowVestingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardEscrowVestingBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, &Topic{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimestampedActorNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimestampedActorNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimestampedActorNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nonce == nil {
+				m.Nonce = &Nonce{}
+			}
+			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowTopic
 			}
@@ -1546,17 +2646,143 @@ func (m *TimestampedActorNonce) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TimestampedActorNonce: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TimestampedActorNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TopicIds = append(m.TopicIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTopic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTopic
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TopicIds) == 0 {
+					m.TopicIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTopic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TopicIds = append(m.TopicIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdWeightPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdWeightPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdWeightPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.BlockHeight = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1566,16 +2792,16 @@ func (m *TimestampedActorNonce) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1585,25 +2811,23 @@ func (m *TimestampedActorNonce) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTopic
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTopic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nonce == nil {
-				m.Nonce = &Nonce{}
-			}
-			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1628,7 +2852,7 @@ func (m *TimestampedActorNonce) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIds) Unmarshal(dAtA []byte) error {
+func (m *OptionalTopicParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1647,19 +2871,95 @@ func (m *TopicIds) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIds: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIds: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionalTopicParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionalTopicParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EpochLength = append(m.EpochLength, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTopic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTopic
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.EpochLength) == 0 {
+					m.EpochLength = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTopic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EpochLength = append(m.EpochLength, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+		case 2:
 			if wireType == 0 {
-				var v uint64
+				var v int64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowTopic
@@ -1669,12 +2969,12 @@ func (m *TopicIds) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= uint64(b&0x7F) << shift
+					v |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				m.TopicIds = append(m.TopicIds, v)
+				m.WorkerSubmissionWindow = append(m.WorkerSubmissionWindow, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -1709,11 +3009,11 @@ func (m *TopicIds) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.TopicIds) == 0 {
-					m.TopicIds = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.WorkerSubmissionWindow) == 0 {
+					m.WorkerSubmissionWindow = make([]int64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
-					var v uint64
+					var v int64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowTopic
@@ -1723,71 +3023,129 @@ func (m *TopicIds) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= uint64(b&0x7F) << shift
+						v |= int64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.TopicIds = append(m.TopicIds, v)
+					m.WorkerSubmissionWindow = append(m.WorkerSubmissionWindow, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerSubmissionWindow", wireType)
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTopic(dAtA[iNdEx:])
-			if err != nil {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.AlphaRegret = append(m.AlphaRegret, v)
+			if err := m.AlphaRegret[len(m.AlphaRegret)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTopic
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TopicIdWeightPair) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTopic
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PNorm = append(m.PNorm, v)
+			if err := m.PNorm[len(m.PNorm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeritSortitionAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MeritSortitionAlpha = append(m.MeritSortitionAlpha, v)
+			if err := m.MeritSortitionAlpha[len(m.MeritSortitionAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdWeightPair: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdWeightPair: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveInfererQuantile", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1797,14 +3155,31 @@ func (m *TopicIdWeightPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ActiveInfererQuantile = append(m.ActiveInfererQuantile, v)
+			if err := m.ActiveInfererQuantile[len(m.ActiveInfererQuantile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasterQuantile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1832,7 +3207,45 @@ func (m *TopicIdWeightPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ActiveForecasterQuantile = append(m.ActiveForecasterQuantile, v)
+			if err := m.ActiveForecasterQuantile[len(m.ActiveForecasterQuantile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveReputerQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ActiveReputerQuantile = append(m.ActiveReputerQuantile, v)
+			if err := m.ActiveReputerQuantile[len(m.ActiveReputerQuantile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -32,6 +32,16 @@ func ValidateDec(value alloraMath.Dec) error {
 	return nil
 }
 
+// ValidateDecs checks that every value of a vector is a valid Dec
+func ValidateDecs(values []alloraMath.Dec) error {
+	for _, value := range values {
+		if err := ValidateDec(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ValidateSdkInt checks if the given value is a valid cosmosMath.Int
 // according to our needs / standards
 func ValidateSdkInt(value cosmosMath.Int) error {
@@ -120,6 +130,9 @@ func (inference *Inference) Validate() error {
 	if err := ValidateDec(inference.Value); err != nil {
 		return errors.Wrap(err, "inference value is invalid")
 	}
+	if err := ValidateDecs(inference.Values); err != nil {
+		return errors.Wrap(err, "inference values are invalid")
+	}
 	// ExtraData not validated as it is not used by the chain
 	// Proof not validated as it is not used by the chain
 	return nil
@@ -149,6 +162,9 @@ func (forecast *Forecast) Validate() error {
 		if err := ValidateDec(elem.Value); err != nil {
 			return errors.Wrap(err, "forecast value is invalid")
 		}
+		if err := ValidateDecs(elem.Values); err != nil {
+			return errors.Wrap(err, "forecast values are invalid")
+		}
 	}
 	// ExtraData not validated as it is not used by the chain
 	return nil
@@ -168,12 +184,10 @@ func (bundle *WorkerDataBundle) Validate() error {
 	if len(bundle.Pubkey) == 0 {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "public key cannot be empty")
 	}
-	pk, err := hex.DecodeString(bundle.Pubkey)
-	if err != nil || len(pk) != secp256k1.PubKeySize {
-		return errors.Wrap(sdkerrors.ErrInvalidRequest, "invalid pubkey")
+	pubkey, err := decodePubkey(bundle.Pubkey)
+	if err != nil {
+		return err
 	}
-	pubkey := secp256k1.PubKey(pk)
-	pubKeyConvertedToAddress := sdk.AccAddress(pubkey.Address().Bytes()).String()
 
 	if len(bundle.InferencesForecastsBundleSignature) == 0 {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "signature cannot be empty")
@@ -190,11 +204,6 @@ func (bundle *WorkerDataBundle) Validate() error {
 		if err := bundle.InferenceForecastsBundle.Inference.Validate(); err != nil {
 			return err
 		}
-		if bundle.InferenceForecastsBundle.Inference.Inferer != pubKeyConvertedToAddress {
-			return errors.Wrapf(sdkerrors.ErrUnauthorized,
-				"Inference.Inferer %s does not match pubkey %s",
-				bundle.InferenceForecastsBundle.Inference.Inferer, pubKeyConvertedToAddress)
-		}
 		if bundle.Worker != bundle.InferenceForecastsBundle.Inference.Inferer {
 			return errors.Wrapf(sdkerrors.ErrUnauthorized,
 				"Inference.Inferer %s does not match worker address %s",
@@ -205,11 +214,6 @@ func (bundle *WorkerDataBundle) Validate() error {
 		if err := bundle.InferenceForecastsBundle.Forecast.Validate(); err != nil {
 			return err
 		}
-		if bundle.InferenceForecastsBundle.Forecast.Forecaster != pubKeyConvertedToAddress {
-			return errors.Wrapf(sdkerrors.ErrUnauthorized,
-				"Forecast.Forecaster %s does not match pubkey %s",
-				bundle.InferenceForecastsBundle.Forecast.Forecaster, pubKeyConvertedToAddress)
-		}
 		if bundle.Worker != bundle.InferenceForecastsBundle.Forecast.Forecaster {
 			return errors.Wrapf(sdkerrors.ErrUnauthorized,
 				"Forecast.Forecaster %s does not match worker address %s",
@@ -227,14 +231,31 @@ func (bundle *WorkerDataBundle) Validate() error {
 	if !pubkey.VerifySignature(marshaled, bundle.InferencesForecastsBundleSignature) {
 		return errors.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed")
 	}
-	// Source: https://docs.cosmos.network/v0.46/basics/accounts.html#addresses
-	if pubKeyConvertedToAddress != bundle.Worker {
-		return errors.Wrap(sdkerrors.ErrUnauthorized, "worker address does not match signature")
-	}
+	// Whether the signer may sign for the worker is checked against the
+	// operators of the worker when the bundle is submitted
 
 	return nil
 }
 
+// Returns the address of the key that signed the bundle.
+// This is the worker itself or one of its operators.
+func (bundle *WorkerDataBundle) Signer() (string, error) {
+	pubkey, err := decodePubkey(bundle.Pubkey)
+	if err != nil {
+		return "", err
+	}
+	// Source: https://docs.cosmos.network/v0.46/basics/accounts.html#addresses
+	return sdk.AccAddress(pubkey.Address().Bytes()).String(), nil
+}
+
+func decodePubkey(pubkeyHex string) (secp256k1.PubKey, error) {
+	pk, err := hex.DecodeString(pubkeyHex)
+	if err != nil || len(pk) != secp256k1.PubKeySize {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pubkey %d", len(pk))
+	}
+	return secp256k1.PubKey(pk), nil
+}
+
 // validate that a value bundle follows the expected format
 func (bundle *ValueBundle) Validate() error {
 	if bundle == nil {
@@ -254,6 +275,9 @@ func (bundle *ValueBundle) Validate() error {
 	if err := ValidateDec(bundle.CombinedValue); err != nil {
 		return errors.Wrap(err, "value bundle combined value is invalid")
 	}
+	if err := ValidateDecs(bundle.CombinedValues); err != nil {
+		return errors.Wrap(err, "value bundle combined values are invalid")
+	}
 
 	// nil values for bundle.InfererValues are interpreted to mean that there
 	// are no inferer values for this bundle, and are allowed
@@ -274,6 +298,9 @@ func (bundle *ValueBundle) Validate() error {
 	if err := ValidateDec(bundle.NaiveValue); err != nil {
 		return errors.Wrap(err, "value bundle naive value is invalid")
 	}
+	if err := ValidateDecs(bundle.NaiveValues); err != nil {
+		return errors.Wrap(err, "value bundle naive values are invalid")
+	}
 
 	// nil values for bundle.OneOutInfererValues are interpreted to mean that there
 	// are no one out inferer values for this bundle, and are allowed
@@ -314,15 +341,9 @@ func (bundle *ReputerValueBundle) Validate() error {
 	if bundle.ValueBundle == nil {
 		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "value bundle cannot be nil")
 	}
-	pk, err := hex.DecodeString(bundle.Pubkey)
-	if err != nil || len(pk) != secp256k1.PubKeySize {
-		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pubkey %d", len(pk))
-	}
-	pubkey := secp256k1.PubKey(pk)
-	pubKeyConvertedToAddress := sdk.AccAddress(pubkey.Address().Bytes()).String()
-
-	if bundle.ValueBundle.Reputer != pubKeyConvertedToAddress {
-		return errors.Wrapf(sdkerrors.ErrUnauthorized, "Reputer does not match pubkey")
+	pubkey, err := decodePubkey(bundle.Pubkey)
+	if err != nil {
+		return err
 	}
 
 	// validate the value bundle
@@ -343,6 +364,16 @@ func (bundle *ReputerValueBundle) Validate() error {
 	return nil
 }
 
+// Returns the address of the key that signed the bundle.
+// This is the reputer itself or one of its operators.
+func (bundle *ReputerValueBundle) Signer() (string, error) {
+	pubkey, err := decodePubkey(bundle.Pubkey)
+	if err != nil {
+		return "", err
+	}
+	return sdk.AccAddress(pubkey.Address().Bytes()).String(), nil
+}
+
 // Validate checks if the given Topic is valid
 func (topic Topic) Validate(params Params) error {
 	if topic.Id == 0 {
@@ -384,6 +415,12 @@ func (topic Topic) Validate(params Params) error {
 	if topic.WorkerSubmissionWindow > topic.EpochLength {
 		return errors.Wrap(sdkerrors.ErrInvalidType, "topic worker submission window cannot be higher than epoch length")
 	}
+	if topic.WorkerRevealWindow < 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "topic worker reveal window cannot be negative")
+	}
+	if topic.WorkerSubmissionWindow+topic.WorkerRevealWindow > topic.EpochLength {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "topic worker submission and reveal windows cannot be higher than epoch length")
+	}
 	if err := ValidateDec(topic.AlphaRegret); err != nil {
 		return errors.Wrap(err, "topic alpha regret is invalid")
 	}
@@ -429,10 +466,47 @@ func (topic Topic) Validate(params Params) error {
 	if !isAlloraDecZeroOrLessThanOne(topic.ActiveReputerQuantile) {
 		return errors.Wrap(sdkerrors.ErrInvalidType, "topic active reputer quantile must be between 0 and 1 inclusive")
 	}
+	if err := ValidateOutputDimension(topic.OutputDimension, topic.VectorSynthesisMode); err != nil {
+		return err
+	}
+	if err := ValidateQuantileLevels(topic.QuantileLevels, topic.OutputDimension, topic.LossMethod); err != nil {
+		return err
+	}
+	if err := ValidateLossComputation(topic.LossComputationMode, topic.LossMethod, topic.QuantileLevels); err != nil {
+		return err
+	}
+	if err := ValidateWorkerBond(topic.WorkerBond, topic.WorkerBondSlashMissedEpochs, topic.WorkerBondSlashFraction); err != nil {
+		return err
+	}
+	if err := ValidateReputerSlashing(
+		topic.ReputerSlashDistanceThreshold,
+		topic.ReputerSlashEpochs,
+		topic.ReputerSelfSlashFraction,
+		topic.ReputerDelegateSlashFraction,
+	); err != nil {
+		return err
+	}
+	if err := ValidateRewardEscrow(topic.RewardEscrowFraction, topic.RewardEscrowVestingBlocks); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// ValidateOutputDimension checks the output dimension and vector synthesis mode of a topic
+func ValidateOutputDimension(outputDimension uint64, mode VectorSynthesisMode) error {
+	if outputDimension > MaxTopicOutputDimension {
+		return errors.Wrapf(ErrInvalidOutputDimension, "topic output dimension cannot exceed %d", MaxTopicOutputDimension)
+	}
+	if _, ok := VectorSynthesisMode_name[int32(mode)]; !ok {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "topic vector synthesis mode is unknown")
+	}
+	if mode != VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_NORM_UNSPECIFIED && outputDimension <= 1 {
+		return errors.Wrap(ErrInvalidOutputDimension, "topic vector synthesis mode requires an output dimension above 1")
+	}
+	return nil
+}
+
 // validate that a worker attributed value follows the expected format
 func (workerValue *WorkerAttributedValue) Validate() error {
 	_, err := sdk.AccAddressFromBech32(workerValue.Worker)
@@ -443,6 +517,9 @@ func (workerValue *WorkerAttributedValue) Validate() error {
 	if err := ValidateDec(workerValue.Value); err != nil {
 		return err
 	}
+	if err := ValidateDecs(workerValue.Values); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -457,6 +534,9 @@ func (withheldWorkerValue *WithheldWorkerAttributedValue) Validate() error {
 	if err := ValidateDec(withheldWorkerValue.Value); err != nil {
 		return err
 	}
+	if err := ValidateDecs(withheldWorkerValue.Values); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -483,6 +563,13 @@ func (bundle *ReputerValueBundles) Validate() error {
 		return errors.Wrapf(sdkerrors.ErrInvalidType, "reputer value bundles cannot be nil")
 	}
 	for i, reputerValueBundle := range bundle.ReputerValueBundles {
+		// bundles computed by the chain from ground truths are not signed by their reputer
+		if reputerValueBundle.IsChainComputed() {
+			if err := reputerValueBundle.ValueBundle.Validate(); err != nil {
+				return errors.Wrapf(err, "chain computed value bundle at index %d is invalid", i)
+			}
+			continue
+		}
 		if err := reputerValueBundle.Validate(); err != nil {
 			return errors.Wrapf(err, "reputer value bundle at index %d is invalid", i)
 		}
@@ -613,6 +700,100 @@ func (stakeRemovalInfo *StakeRemovalInfo) Validate() error {
 	return nil
 }
 
+// validate that a WorkerBond follows expected format
+func (bond *WorkerBond) Validate() error {
+	if err := ValidateTopicId(bond.TopicId); err != nil {
+		return errors.Wrap(err, "workerBond topic id is invalid")
+	}
+	if err := ValidateBech32(bond.Worker); err != nil {
+		return errors.Wrap(err, "workerBond worker is invalid")
+	}
+	if err := ValidateSdkIntRepresentingMonetaryValue(bond.Amount); err != nil {
+		return errors.Wrap(err, "workerBond amount is invalid")
+	}
+	if err := ValidateBlockHeight(bond.LivenessBlockHeight); err != nil {
+		return errors.Wrap(err, "workerBond liveness block height is invalid")
+	}
+	return nil
+}
+
+// validate that a WorkerBondRemovalInfo follows expected format
+func (removalInfo *WorkerBondRemovalInfo) Validate() error {
+	if err := ValidateTopicId(removalInfo.TopicId); err != nil {
+		return errors.Wrap(err, "workerBondRemovalInfo topic id is invalid")
+	}
+	if err := ValidateBech32(removalInfo.Worker); err != nil {
+		return errors.Wrap(err, "workerBondRemovalInfo worker is invalid")
+	}
+	if err := ValidateSdkIntRepresentingMonetaryValue(removalInfo.Amount); err != nil {
+		return errors.Wrap(err, "workerBondRemovalInfo amount is invalid")
+	}
+	if err := ValidateBlockHeight(removalInfo.BlockRemovalStarted); err != nil {
+		return errors.Wrap(err, "workerBondRemovalInfo block removal started is invalid")
+	}
+	if err := ValidateBlockHeight(removalInfo.BlockRemovalCompleted); err != nil {
+		return errors.Wrap(err, "workerBondRemovalInfo block removal completed is invalid")
+	}
+	if removalInfo.BlockRemovalStarted > removalInfo.BlockRemovalCompleted {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest,
+			"workerBondRemovalInfo block removal started cannot be greater than block removal completed")
+	}
+	return nil
+}
+
+// validate that a ReputerConsensusDeviation follows expected format
+func (deviation *ReputerConsensusDeviation) Validate() error {
+	if err := ValidateTopicId(deviation.TopicId); err != nil {
+		return errors.Wrap(err, "reputerConsensusDeviation topic id is invalid")
+	}
+	if err := ValidateBech32(deviation.Reputer); err != nil {
+		return errors.Wrap(err, "reputerConsensusDeviation reputer is invalid")
+	}
+	if deviation.ConsecutiveEpochs <= 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "reputerConsensusDeviation consecutive epochs must be greater than zero")
+	}
+	if err := ValidateDec(deviation.Distance); err != nil {
+		return errors.Wrap(err, "reputerConsensusDeviation distance is invalid")
+	}
+	if err := ValidateBlockHeight(deviation.BlockHeight); err != nil {
+		return errors.Wrap(err, "reputerConsensusDeviation block height is invalid")
+	}
+	return nil
+}
+
+// validate that a ReputerCommission follows expected format
+func (commission *ReputerCommission) Validate() error {
+	if err := ValidateTopicId(commission.TopicId); err != nil {
+		return errors.Wrap(err, "reputerCommission topic id is invalid")
+	}
+	if err := ValidateBech32(commission.Reputer); err != nil {
+		return errors.Wrap(err, "reputerCommission reputer is invalid")
+	}
+	if err := ValidateBlockHeight(commission.UpdateBlockHeight); err != nil {
+		return errors.Wrap(err, "reputerCommission update block height is invalid")
+	}
+	return validateCommissionRates(commission.Rate, commission.MaxRate, commission.MaxChangeRate)
+}
+
+// checks that commission rates are fractions and the rate and its changes are within the maximums
+func validateCommissionRates(rate, maxRate, maxChangeRate alloraMath.Dec) error {
+	for _, value := range []alloraMath.Dec{rate, maxRate, maxChangeRate} {
+		if err := ValidateDec(value); err != nil {
+			return errors.Wrap(err, "commission rate is invalid")
+		}
+		if !isAlloraDecBetweenZeroAndOneInclusive(value) {
+			return errors.Wrap(ErrInvalidReputerCommission, "commission rates must be between 0 and 1")
+		}
+	}
+	if rate.Gt(maxRate) {
+		return errors.Wrap(ErrInvalidReputerCommission, "rate cannot exceed max rate")
+	}
+	if maxChangeRate.Gt(maxRate) {
+		return errors.Wrap(ErrInvalidReputerCommission, "max change rate cannot exceed max rate")
+	}
+	return nil
+}
+
 // validate that a DelegateStakeRemovalInfo follows expected format
 func (delegateStakeRemovalInfo *DelegateStakeRemovalInfo) Validate() error {
 	if err := ValidateTopicId(delegateStakeRemovalInfo.TopicId); err != nil {
@@ -640,6 +821,153 @@ func (delegateStakeRemovalInfo *DelegateStakeRemovalInfo) Validate() error {
 	return nil
 }
 
+// validate that a RedelegationInfo follows expected format
+func (redelegation *RedelegationInfo) Validate() error {
+	if err := ValidateTopicId(redelegation.TopicId); err != nil {
+		return errors.Wrap(err, "redelegationInfo topic id is invalid")
+	}
+	if err := ValidateBech32(redelegation.Delegator); err != nil {
+		return errors.Wrap(err, "redelegationInfo delegator is invalid")
+	}
+	if err := ValidateBech32(redelegation.SrcReputer); err != nil {
+		return errors.Wrap(err, "redelegationInfo source reputer is invalid")
+	}
+	if err := ValidateBech32(redelegation.DstReputer); err != nil {
+		return errors.Wrap(err, "redelegationInfo destination reputer is invalid")
+	}
+	if redelegation.SrcReputer == redelegation.DstReputer {
+		return errors.Wrap(ErrSelfRedelegation, "redelegationInfo source and destination reputers are the same")
+	}
+	if err := ValidateSdkIntRepresentingMonetaryValue(redelegation.Amount); err != nil {
+		return errors.Wrap(err, "redelegationInfo amount is invalid")
+	}
+	if err := ValidateBlockHeight(redelegation.BlockRedelegationStarted); err != nil {
+		return errors.Wrap(err, "redelegationInfo block redelegation started is invalid")
+	}
+	if err := ValidateBlockHeight(redelegation.BlockRedelegationCompleted); err != nil {
+		return errors.Wrap(err, "redelegationInfo block redelegation completed is invalid")
+	}
+	if redelegation.BlockRedelegationStarted > redelegation.BlockRedelegationCompleted {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest,
+			"redelegationInfo block redelegation started cannot be greater than block redelegation completed")
+	}
+	return nil
+}
+
+// validate that a StakeLock follows expected format
+func (lock *StakeLock) Validate() error {
+	if err := ValidateTopicId(lock.TopicId); err != nil {
+		return errors.Wrap(err, "stakeLock topic id is invalid")
+	}
+	if err := ValidateBech32(lock.Reputer); err != nil {
+		return errors.Wrap(err, "stakeLock reputer is invalid")
+	}
+	if lock.Delegator != "" {
+		if err := ValidateBech32(lock.Delegator); err != nil {
+			return errors.Wrap(err, "stakeLock delegator is invalid")
+		}
+	}
+	if err := ValidateSdkIntRepresentingMonetaryValue(lock.Amount); err != nil {
+		return errors.Wrap(err, "stakeLock amount is invalid")
+	}
+	if err := ValidateDec(lock.RewardMultiplier); err != nil {
+		return errors.Wrap(err, "stakeLock reward multiplier is invalid")
+	}
+	if lock.RewardMultiplier.Lt(alloraMath.OneDec()) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "stakeLock reward multiplier cannot be less than one")
+	}
+	if err := ValidateBlockHeight(lock.BlockLocked); err != nil {
+		return errors.Wrap(err, "stakeLock block locked is invalid")
+	}
+	if err := ValidateBlockHeight(lock.BlockUnlocked); err != nil {
+		return errors.Wrap(err, "stakeLock block unlocked is invalid")
+	}
+	if lock.BlockLocked > lock.BlockUnlocked {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest,
+			"stakeLock block locked cannot be greater than block unlocked")
+	}
+	return nil
+}
+
+// Validate checks that a reward held in escrow is well formed
+func (escrow *RewardEscrow) Validate() error {
+	if err := ValidateTopicId(escrow.TopicId); err != nil {
+		return errors.Wrap(err, "rewardEscrow topic id is invalid")
+	}
+	if err := ValidateBech32(escrow.Address); err != nil {
+		return errors.Wrap(err, "rewardEscrow address is invalid")
+	}
+	if err := ValidateSdkIntRepresentingMonetaryValue(escrow.Amount); err != nil {
+		return errors.Wrap(err, "rewardEscrow amount is invalid")
+	}
+	if err := ValidateSdkIntRepresentingMonetaryValue(escrow.Claimed); err != nil {
+		return errors.Wrap(err, "rewardEscrow claimed is invalid")
+	}
+	if escrow.Claimed.GT(escrow.Amount) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "rewardEscrow claimed cannot be greater than amount")
+	}
+	if err := ValidateBlockHeight(escrow.BlockEscrowed); err != nil {
+		return errors.Wrap(err, "rewardEscrow block escrowed is invalid")
+	}
+	if err := ValidateBlockHeight(escrow.BlockVested); err != nil {
+		return errors.Wrap(err, "rewardEscrow block vested is invalid")
+	}
+	if escrow.BlockEscrowed > escrow.BlockVested {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest,
+			"rewardEscrow block escrowed cannot be greater than block vested")
+	}
+	return nil
+}
+
+// Validate checks that a record of a paid out reward is well formed
+func (record *RewardRecord) Validate() error {
+	if err := ValidateTopicId(record.TopicId); err != nil {
+		return errors.Wrap(err, "rewardRecord topic id is invalid")
+	}
+	if err := ValidateBlockHeight(record.BlockHeight); err != nil {
+		return errors.Wrap(err, "rewardRecord block height is invalid")
+	}
+	if err := ValidateBech32(record.Address); err != nil {
+		return errors.Wrap(err, "rewardRecord address is invalid")
+	}
+	switch TaskRewardType(record.Type) {
+	case ReputerAndDelegatorRewardType, WorkerInferenceRewardType, WorkerForecastRewardType:
+	default:
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "rewardRecord type is invalid: %s", record.Type)
+	}
+	if err := ValidateDec(record.Reward); err != nil {
+		return errors.Wrap(err, "rewardRecord reward is invalid")
+	}
+	if record.Reward.IsNegative() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "rewardRecord reward cannot be negative")
+	}
+	return nil
+}
+
+func (payout *QueuedRewardPayout) Validate() error {
+	if err := ValidateTopicId(payout.TopicId); err != nil {
+		return errors.Wrap(err, "queuedRewardPayout topic id is invalid")
+	}
+	if err := ValidateBech32(payout.Address); err != nil {
+		return errors.Wrap(err, "queuedRewardPayout address is invalid")
+	}
+	switch TaskRewardType(payout.Type) {
+	case ReputerAndDelegatorRewardType, WorkerInferenceRewardType, WorkerForecastRewardType:
+	default:
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "queuedRewardPayout type is invalid: %s", payout.Type)
+	}
+	if err := ValidateSdkIntRepresentingMonetaryValue(payout.Amount); err != nil {
+		return errors.Wrap(err, "queuedRewardPayout amount is invalid")
+	}
+	if !payout.Amount.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "queuedRewardPayout amount must be positive")
+	}
+	if err := ValidateBlockHeight(payout.BlockQueued); err != nil {
+		return errors.Wrap(err, "queuedRewardPayout block queued is invalid")
+	}
+	return nil
+}
+
 // validate that a OffchainNode follows the expected format
 func (oc *OffchainNode) Validate() error {
 	if err := ValidateBech32(oc.NodeAddress); err != nil {
@@ -706,6 +1034,14 @@ func (msg *RemoveStakeRequest) Validate() error {
 	return stakeValidateHelper([]string{msg.Sender}, msg.Amount, false)
 }
 
+// validate that a move stake request follows the expected format
+func (msg *MoveStakeRequest) Validate() error {
+	if msg.FromTopicId == msg.ToTopicId {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "cannot move stake to the same topic")
+	}
+	return stakeValidateHelper([]string{msg.Sender}, msg.Amount, false)
+}
+
 // validate that a delegate stake request follows the expected format
 func (msg *DelegateStakeRequest) Validate() error {
 	if msg.Reputer == msg.Sender {
@@ -725,16 +1061,104 @@ func (msg *CancelRemoveDelegateStakeRequest) Validate() error {
 	return stakeValidateHelper([]string{msg.Sender}, cosmosMath.ZeroInt(), true)
 }
 
+// validate that a redelegate stake request follows the expected format
+func (msg *RedelegateStakeRequest) Validate() error {
+	if msg.DstReputer == msg.Sender {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "cannot self delegate")
+	}
+	if msg.SrcReputer == msg.DstReputer {
+		return errors.Wrap(ErrSelfRedelegation, "source and destination reputers are the same")
+	}
+	return stakeValidateHelper([]string{msg.Sender, msg.SrcReputer, msg.DstReputer}, msg.Amount, false)
+}
+
+// validate that a set auto compound request follows the expected format
+func (msg *SetAutoCompoundRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "sender is invalid")
+	}
+	return ValidateTopicId(msg.TopicId)
+}
+
+// validate that a set reputer commission request follows the expected format
+func (msg *SetReputerCommissionRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "sender is invalid")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return err
+	}
+	if err := ValidateDec(msg.Rate); err != nil {
+		return errors.Wrap(err, "commission rate is invalid")
+	}
+	if !isAlloraDecBetweenZeroAndOneInclusive(msg.Rate) {
+		return errors.Wrap(ErrInvalidReputerCommission, "commission rate must be between 0 and 1")
+	}
+	return nil
+}
+
 // validate that a reward delegate stake request follows the expected format
 func (msg *RewardDelegateStakeRequest) Validate() error {
 	return stakeValidateHelper([]string{msg.Sender, msg.Reputer}, cosmosMath.ZeroInt(), true)
 }
 
+// validate that a claim all delegate rewards request follows the expected format
+func (msg *ClaimAllDelegateRewardsRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "sender is invalid")
+	}
+	return nil
+}
+
 // validate that a cancel remove stake request follows the expected format
 func (msg *CancelRemoveStakeRequest) Validate() error {
 	return stakeValidateHelper([]string{msg.Sender}, cosmosMath.ZeroInt(), true)
 }
 
+// validate that an amend remove stake request follows the expected format
+func (msg *AmendRemoveStakeRequest) Validate() error {
+	return stakeValidateHelper([]string{msg.Sender}, msg.Amount, false)
+}
+
+// validate that an amend remove delegate stake request follows the expected format
+func (msg *AmendRemoveDelegateStakeRequest) Validate() error {
+	return stakeValidateHelper([]string{msg.Sender, msg.Reputer}, msg.Amount, false)
+}
+
+// validate that an add worker bond request follows the expected format
+func (msg *AddWorkerBondRequest) Validate() error {
+	return stakeValidateHelper([]string{msg.Sender}, msg.Amount, false)
+}
+
+// validate that a claim vested rewards request follows the expected format
+func (msg *ClaimVestedRewardsRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "sender is invalid")
+	}
+	return ValidateTopicId(msg.TopicId)
+}
+
+// validate that a forfeit unvested rewards request follows the expected format
+func (msg *ForfeitUnvestedRewardsRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "sender is invalid")
+	}
+	if err := ValidateBech32(msg.Address); err != nil {
+		return errors.Wrap(err, "address is invalid")
+	}
+	return ValidateTopicId(msg.TopicId)
+}
+
+// validate that a remove worker bond request follows the expected format
+func (msg *RemoveWorkerBondRequest) Validate() error {
+	return stakeValidateHelper([]string{msg.Sender}, msg.Amount, false)
+}
+
+// validate that a cancel remove worker bond request follows the expected format
+func (msg *CancelRemoveWorkerBondRequest) Validate() error {
+	return stakeValidateHelper([]string{msg.Sender}, cosmosMath.ZeroInt(), true)
+}
+
 // Validate checks if the given CreateNewTopicRequest is valid
 func (msg *CreateNewTopicRequest) Validate(maxStringLen uint64) error {
 	if err := ValidateBech32(msg.Creator); err != nil {
@@ -756,6 +1180,12 @@ func (msg *CreateNewTopicRequest) Validate(maxStringLen uint64) error {
 	if msg.WorkerSubmissionWindow > msg.EpochLength {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker submission window cannot be higher than epoch length")
 	}
+	if msg.WorkerRevealWindow < 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker reveal window cannot be negative")
+	}
+	if msg.WorkerSubmissionWindow+msg.WorkerRevealWindow > msg.EpochLength {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker submission and reveal windows cannot be higher than epoch length")
+	}
 	if msg.AlphaRegret.Lte(alloraMath.ZeroDec()) || msg.AlphaRegret.Gt(alloraMath.OneDec()) || ValidateDec(msg.AlphaRegret) != nil {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "alpha regret must be greater than 0 and less than or equal to 1")
 	}
@@ -768,6 +1198,32 @@ func (msg *CreateNewTopicRequest) Validate(maxStringLen uint64) error {
 	if uint64(len(msg.Metadata)) > maxStringLen {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "metadata invalid")
 	}
+	if err := ValidateTopicTags(msg.Tags, maxStringLen); err != nil {
+		return err
+	}
+	if err := ValidateOutputDimension(msg.OutputDimension, msg.VectorSynthesisMode); err != nil {
+		return err
+	}
+	if err := ValidateQuantileLevels(msg.QuantileLevels, msg.OutputDimension, msg.LossMethod); err != nil {
+		return err
+	}
+	if err := ValidateLossComputation(msg.LossComputationMode, msg.LossMethod, msg.QuantileLevels); err != nil {
+		return err
+	}
+	if err := ValidateWorkerBond(msg.WorkerBond, msg.WorkerBondSlashMissedEpochs, msg.WorkerBondSlashFraction); err != nil {
+		return err
+	}
+	if err := ValidateReputerSlashing(
+		msg.ReputerSlashDistanceThreshold,
+		msg.ReputerSlashEpochs,
+		msg.ReputerSelfSlashFraction,
+		msg.ReputerDelegateSlashFraction,
+	); err != nil {
+		return err
+	}
+	if err := ValidateRewardEscrow(msg.RewardEscrowFraction, msg.RewardEscrowVestingBlocks); err != nil {
+		return err
+	}
 	// no validation on AllowNegative because either it is true or false
 	// and both are valid values
 	//	AllowNegative            bool
@@ -787,3 +1243,238 @@ func (msg *CreateNewTopicRequest) Validate(maxStringLen uint64) error {
 
 	return nil
 }
+
+// Validate checks that every optional topic parameter holds at most one value
+// and that at least one of them is set
+func (p *OptionalTopicParams) Validate() error {
+	if p == nil || p.IsEmpty() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "at least one topic parameter must be set")
+	}
+	if len(p.EpochLength) > 1 ||
+		len(p.WorkerSubmissionWindow) > 1 ||
+		len(p.AlphaRegret) > 1 ||
+		len(p.PNorm) > 1 ||
+		len(p.MeritSortitionAlpha) > 1 ||
+		len(p.ActiveInfererQuantile) > 1 ||
+		len(p.ActiveForecasterQuantile) > 1 ||
+		len(p.ActiveReputerQuantile) > 1 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "each topic parameter can hold at most one value")
+	}
+	return nil
+}
+
+func (msg *UpdateTopicRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	return msg.Params.Validate()
+}
+
+func (msg *ArchiveTopicRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	return nil
+}
+
+func (msg *ProposeTopicOwnerRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if err := ValidateBech32(msg.NewOwner); err != nil {
+		return errors.Wrap(err, "invalid new owner address")
+	}
+	return nil
+}
+
+func (msg *AcceptTopicOwnershipRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	return nil
+}
+
+func (msg *InsertReputerGroundTruthRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if msg.ReputerRequestNonce == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "reputer request nonce cannot be nil")
+	}
+	if err := msg.ReputerRequestNonce.Validate(); err != nil {
+		return errors.Wrap(err, "invalid reputer request nonce")
+	}
+	if len(msg.Values) == 0 {
+		return errors.Wrap(ErrInvalidGroundTruth, "ground truth must hold at least one value")
+	}
+	if err := ValidateDecs(msg.Values); err != nil {
+		return errors.Wrap(ErrInvalidGroundTruth, err.Error())
+	}
+	if msg.Reputer != "" {
+		if err := ValidateBech32(msg.Reputer); err != nil {
+			return errors.Wrap(err, "invalid reputer address")
+		}
+	}
+	return nil
+}
+
+func (msg *CommitWorkerPayloadRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if msg.Nonce == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "nonce cannot be nil")
+	}
+	if err := msg.Nonce.Validate(); err != nil {
+		return errors.Wrap(err, "invalid nonce")
+	}
+	if err := validateCommitment(msg.Commitment); err != nil {
+		return err
+	}
+	if msg.Worker != "" {
+		if err := ValidateBech32(msg.Worker); err != nil {
+			return errors.Wrap(err, "invalid worker address")
+		}
+	}
+	return nil
+}
+
+func (msg *RevealWorkerPayloadRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := msg.WorkerDataBundle.Validate(); err != nil {
+		return errors.Wrap(err, "invalid worker data bundle")
+	}
+	if len(msg.Salt) > MaxWorkerPayloadSaltLength {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "salt cannot be longer than %d bytes", MaxWorkerPayloadSaltLength)
+	}
+	return nil
+}
+
+func (msg *AuthorizeOperatorRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if err := ValidateBech32(msg.Operator); err != nil {
+		return errors.Wrap(err, "invalid operator address")
+	}
+	if msg.Operator == msg.Sender {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "an actor cannot be its own operator")
+	}
+	return nil
+}
+
+func (msg *RevokeOperatorRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if err := ValidateBech32(msg.Operator); err != nil {
+		return errors.Wrap(err, "invalid operator address")
+	}
+	return nil
+}
+
+func (msg *RotateOperatorRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if err := ValidateBech32(msg.OldOperator); err != nil {
+		return errors.Wrap(err, "invalid old operator address")
+	}
+	if err := ValidateBech32(msg.NewOperator); err != nil {
+		return errors.Wrap(err, "invalid new operator address")
+	}
+	if msg.NewOperator == msg.Sender {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "an actor cannot be its own operator")
+	}
+	if msg.NewOperator == msg.OldOperator {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "new operator must differ from the old operator")
+	}
+	return nil
+}
+
+// Validate checks that an operator authorization is well formed
+func (operator *TopicActorOperator) Validate() error {
+	if err := ValidateTopicId(operator.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if err := ValidateBech32(operator.Actor); err != nil {
+		return errors.Wrap(err, "invalid actor address")
+	}
+	if err := ValidateBech32(operator.Operator); err != nil {
+		return errors.Wrap(err, "invalid operator address")
+	}
+	if operator.Operator == operator.Actor {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "an actor cannot be its own operator")
+	}
+	return nil
+}
+
+// Validate checks that a stored ground truth is well formed. The reputer is
+// empty for consensus ground truths
+func (groundTruth *GroundTruth) Validate() error {
+	if err := ValidateTopicId(groundTruth.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if err := ValidateBlockHeight(groundTruth.BlockHeight); err != nil {
+		return errors.Wrap(err, "invalid block height")
+	}
+	if groundTruth.Reputer != "" {
+		if err := ValidateBech32(groundTruth.Reputer); err != nil {
+			return errors.Wrap(err, "invalid reputer address")
+		}
+	}
+	if len(groundTruth.Values) == 0 {
+		return errors.Wrap(ErrInvalidGroundTruth, "ground truth must hold at least one value")
+	}
+	if err := ValidateDecs(groundTruth.Values); err != nil {
+		return errors.Wrap(ErrInvalidGroundTruth, err.Error())
+	}
+	return nil
+}
+
+// Tags must be non-empty, unique and no longer than the max string length
+func ValidateTopicTags(tags []string, maxStringLen uint64) error {
+	if len(tags) > MaxTopicTags {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "a topic can have at most %d tags", MaxTopicTags)
+	}
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		if len(tag) == 0 || uint64(len(tag)) > maxStringLen {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "tag invalid")
+		}
+		if _, ok := seen[tag]; ok {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate tag %s", tag)
+		}
+		seen[tag] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,319 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// HasVectorOutput returns true if inferences of the topic hold several values
+func (topic Topic) HasVectorOutput() bool {
+	return topic.OutputDimension > 1
+}
+
+// SynthesizesPerComponent returns true if losses, regrets and weights of the
+// topic are tracked separately for each component of its output
+func (topic Topic) SynthesizesPerComponent() bool {
+	return topic.HasVectorOutput() &&
+		topic.VectorSynthesisMode == VectorSynthesisMode_VECTOR_SYNTHESIS_MODE_PER_COMPONENT
+}
+
+// Returns the number of values expected in inferences of the topic
+func (topic Topic) inferenceDimension() int {
+	if !topic.HasVectorOutput() {
+		return 0
+	}
+	return int(topic.OutputDimension)
+}
+
+// Returns the number of per-component values expected in forecasts and losses of the topic
+func (topic Topic) lossDimension() int {
+	if !topic.SynthesizesPerComponent() {
+		return 0
+	}
+	return int(topic.OutputDimension)
+}
+
+func checkDimension(values []alloraMath.Dec, dimension int, what string) error {
+	if len(values) != dimension {
+		return errors.Wrapf(ErrInvalidOutputDimension, "%s has %d values, expected %d", what, len(values), dimension)
+	}
+	return nil
+}
+
+// ValidateInferenceOutput checks that the inference holds as many values as the topic output dimension,
+// and that they are ordered quantiles if the topic is probabilistic
+func (topic Topic) ValidateInferenceOutput(inference *Inference) error {
+	if err := checkDimension(inference.Values, topic.inferenceDimension(), "inference"); err != nil {
+		return err
+	}
+	if topic.IsProbabilistic() {
+		return validateQuantilesOrder(inference.Values)
+	}
+	return nil
+}
+
+// ValidateForecastOutput checks that every forecast element holds a loss per component
+// if the topic is synthesized per component, and none otherwise
+func (topic Topic) ValidateForecastOutput(forecast *Forecast) error {
+	dimension := topic.lossDimension()
+	for _, el := range forecast.ForecastElements {
+		if err := checkDimension(el.Values, dimension, "forecast element"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateValueBundleOutput checks that every loss of the bundle holds a value per component
+// if the topic is synthesized per component, and none otherwise
+func (topic Topic) ValidateValueBundleOutput(bundle *ValueBundle) error {
+	dimension := topic.lossDimension()
+	if err := checkDimension(bundle.CombinedValues, dimension, "combined loss"); err != nil {
+		return err
+	}
+	if err := checkDimension(bundle.NaiveValues, dimension, "naive loss"); err != nil {
+		return err
+	}
+	for _, lists := range [][]*WorkerAttributedValue{bundle.InfererValues, bundle.ForecasterValues, bundle.OneInForecasterValues} {
+		for _, value := range lists {
+			if err := checkDimension(value.Values, dimension, "worker loss"); err != nil {
+				return err
+			}
+		}
+	}
+	withheldLists := [][]*WithheldWorkerAttributedValue{bundle.OneOutInfererValues, bundle.OneOutForecasterValues}
+	for _, forecasterValues := range bundle.OneOutInfererForecasterValues {
+		withheldLists = append(withheldLists, forecasterValues.OneOutInfererValues)
+	}
+	for _, list := range withheldLists {
+		for _, value := range list {
+			if err := checkDimension(value.Values, dimension, "one-out loss"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns the value of component c, falling back to the scalar value
+// for values that are not tracked per component
+func componentValue(value alloraMath.Dec, values []alloraMath.Dec, c int) alloraMath.Dec {
+	if c < len(values) {
+		return values[c]
+	}
+	return value
+}
+
+// ComponentValue returns the regret of component c, or the overall regret if
+// regrets are not tracked per component
+func (v TimestampedValue) ComponentValue(c int) alloraMath.Dec {
+	return componentValue(v.Value, v.Values, c)
+}
+
+// Component returns the inferences with the value of component c in place of the vector
+func (inferences *Inferences) Component(c int) *Inferences {
+	projected := make([]*Inference, len(inferences.Inferences))
+	for i, inference := range inferences.Inferences {
+		projected[i] = &Inference{
+			TopicId:     inference.TopicId,
+			BlockHeight: inference.BlockHeight,
+			Inferer:     inference.Inferer,
+			Value:       componentValue(inference.Value, inference.Values, c),
+			ExtraData:   inference.ExtraData,
+			Proof:       inference.Proof,
+			Values:      nil,
+		}
+	}
+	return &Inferences{Inferences: projected}
+}
+
+// Component returns the forecasts with the forecasted losses of component c
+func (forecasts *Forecasts) Component(c int) *Forecasts {
+	projected := make([]*Forecast, len(forecasts.Forecasts))
+	for i, forecast := range forecasts.Forecasts {
+		elements := make([]*ForecastElement, len(forecast.ForecastElements))
+		for j, el := range forecast.ForecastElements {
+			elements[j] = &ForecastElement{
+				Inferer: el.Inferer,
+				Value:   componentValue(el.Value, el.Values, c),
+				Values:  nil,
+			}
+		}
+		projected[i] = &Forecast{
+			TopicId:          forecast.TopicId,
+			BlockHeight:      forecast.BlockHeight,
+			Forecaster:       forecast.Forecaster,
+			ForecastElements: elements,
+			ExtraData:        forecast.ExtraData,
+		}
+	}
+	return &Forecasts{Forecasts: projected}
+}
+
+func attributedComponent(values []*WorkerAttributedValue, c int) []*WorkerAttributedValue {
+	projected := make([]*WorkerAttributedValue, len(values))
+	for i, v := range values {
+		projected[i] = &WorkerAttributedValue{
+			Worker: v.Worker,
+			Value:  componentValue(v.Value, v.Values, c),
+			Values: nil,
+		}
+	}
+	return projected
+}
+
+func withheldComponent(values []*WithheldWorkerAttributedValue, c int) []*WithheldWorkerAttributedValue {
+	projected := make([]*WithheldWorkerAttributedValue, len(values))
+	for i, v := range values {
+		projected[i] = &WithheldWorkerAttributedValue{
+			Worker: v.Worker,
+			Value:  componentValue(v.Value, v.Values, c),
+			Values: nil,
+		}
+	}
+	return projected
+}
+
+// Component returns the bundle with every value replaced by its value for component c
+func (bundle *ValueBundle) Component(c int) *ValueBundle {
+	oneOutInfererForecasterValues := make([]*OneOutInfererForecasterValues, len(bundle.OneOutInfererForecasterValues))
+	for i, v := range bundle.OneOutInfererForecasterValues {
+		oneOutInfererForecasterValues[i] = &OneOutInfererForecasterValues{
+			Forecaster:          v.Forecaster,
+			OneOutInfererValues: withheldComponent(v.OneOutInfererValues, c),
+		}
+	}
+	return &ValueBundle{
+		TopicId:                       bundle.TopicId,
+		ReputerRequestNonce:           bundle.ReputerRequestNonce,
+		Reputer:                       bundle.Reputer,
+		ExtraData:                     bundle.ExtraData,
+		CombinedValue:                 componentValue(bundle.CombinedValue, bundle.CombinedValues, c),
+		InfererValues:                 attributedComponent(bundle.InfererValues, c),
+		ForecasterValues:              attributedComponent(bundle.ForecasterValues, c),
+		NaiveValue:                    componentValue(bundle.NaiveValue, bundle.NaiveValues, c),
+		OneOutInfererValues:           withheldComponent(bundle.OneOutInfererValues, c),
+		OneOutForecasterValues:        withheldComponent(bundle.OneOutForecasterValues, c),
+		OneInForecasterValues:         attributedComponent(bundle.OneInForecasterValues, c),
+		OneOutInfererForecasterValues: oneOutInfererForecasterValues,
+		CombinedValues:                nil,
+		NaiveValues:                   nil,
+	}
+}
+
+// Component returns the reputer bundles with every loss replaced by its loss for component c
+func (bundles ReputerValueBundles) Component(c int) ReputerValueBundles {
+	projected := make([]*ReputerValueBundle, len(bundles.ReputerValueBundles))
+	for i, bundle := range bundles.ReputerValueBundles {
+		var valueBundle *ValueBundle
+		if bundle.ValueBundle != nil {
+			valueBundle = bundle.ValueBundle.Component(c)
+		}
+		projected[i] = &ReputerValueBundle{
+			ValueBundle: valueBundle,
+			Signature:   bundle.Signature,
+			Pubkey:      bundle.Pubkey,
+		}
+	}
+	return ReputerValueBundles{ReputerValueBundles: projected}
+}
+
+func setAttributedComponents(
+	values []*WorkerAttributedValue,
+	components [][]*WorkerAttributedValue,
+) error {
+	byWorker := make([]map[string]alloraMath.Dec, len(components))
+	for c, list := range components {
+		byWorker[c] = make(map[string]alloraMath.Dec, len(list))
+		for _, v := range list {
+			byWorker[c][v.Worker] = v.Value
+		}
+	}
+	for _, v := range values {
+		v.Values = make([]alloraMath.Dec, len(components))
+		for c := range components {
+			componentValue, ok := byWorker[c][v.Worker]
+			if !ok {
+				return errors.Wrapf(ErrInvalidValue, "no value of worker %s for component %d", v.Worker, c)
+			}
+			v.Values[c] = componentValue
+		}
+	}
+	return nil
+}
+
+func setWithheldComponents(
+	values []*WithheldWorkerAttributedValue,
+	components [][]*WithheldWorkerAttributedValue,
+) error {
+	byWorker := make([]map[string]alloraMath.Dec, len(components))
+	for c, list := range components {
+		byWorker[c] = make(map[string]alloraMath.Dec, len(list))
+		for _, v := range list {
+			byWorker[c][v.Worker] = v.Value
+		}
+	}
+	for _, v := range values {
+		v.Values = make([]alloraMath.Dec, len(components))
+		for c := range components {
+			componentValue, ok := byWorker[c][v.Worker]
+			if !ok {
+				return errors.Wrapf(ErrInvalidValue, "no value of withheld worker %s for component %d", v.Worker, c)
+			}
+			v.Values[c] = componentValue
+		}
+	}
+	return nil
+}
+
+// SetComponents fills the per-component values of the bundle from bundles
+// computed separately for each component, in component order
+func (bundle *ValueBundle) SetComponents(components []*ValueBundle) error {
+	bundle.CombinedValues = make([]alloraMath.Dec, len(components))
+	bundle.NaiveValues = make([]alloraMath.Dec, len(components))
+	inferers := make([][]*WorkerAttributedValue, len(components))
+	forecasters := make([][]*WorkerAttributedValue, len(components))
+	oneInForecasters := make([][]*WorkerAttributedValue, len(components))
+	oneOutInferers := make([][]*WithheldWorkerAttributedValue, len(components))
+	oneOutForecasters := make([][]*WithheldWorkerAttributedValue, len(components))
+	oneOutInfererForecasters := make(map[string][][]*WithheldWorkerAttributedValue)
+	for _, v := range bundle.OneOutInfererForecasterValues {
+		oneOutInfererForecasters[v.Forecaster] = make([][]*WithheldWorkerAttributedValue, len(components))
+	}
+	for c, component := range components {
+		bundle.CombinedValues[c] = component.CombinedValue
+		bundle.NaiveValues[c] = component.NaiveValue
+		inferers[c] = component.InfererValues
+		forecasters[c] = component.ForecasterValues
+		oneInForecasters[c] = component.OneInForecasterValues
+		oneOutInferers[c] = component.OneOutInfererValues
+		oneOutForecasters[c] = component.OneOutForecasterValues
+		for _, v := range component.OneOutInfererForecasterValues {
+			if _, ok := oneOutInfererForecasters[v.Forecaster]; ok {
+				oneOutInfererForecasters[v.Forecaster][c] = v.OneOutInfererValues
+			}
+		}
+	}
+
+	if err := setAttributedComponents(bundle.InfererValues, inferers); err != nil {
+		return err
+	}
+	if err := setAttributedComponents(bundle.ForecasterValues, forecasters); err != nil {
+		return err
+	}
+	if err := setAttributedComponents(bundle.OneInForecasterValues, oneInForecasters); err != nil {
+		return err
+	}
+	if err := setWithheldComponents(bundle.OneOutInfererValues, oneOutInferers); err != nil {
+		return err
+	}
+	if err := setWithheldComponents(bundle.OneOutForecasterValues, oneOutForecasters); err != nil {
+		return err
+	}
+	for _, v := range bundle.OneOutInfererForecasterValues {
+		if err := setWithheldComponents(v.OneOutInfererValues, oneOutInfererForecasters[v.Forecaster]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
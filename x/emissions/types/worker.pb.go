@@ -28,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type TimestampedValue struct {
 	BlockHeight int64                                           `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	Value       github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
+	// per-component values for topics with a vector output
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *TimestampedValue) Reset()         { *m = TimestampedValue{} }
@@ -77,6 +79,9 @@ type Inference struct {
 	Value       github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
 	ExtraData   []byte                                          `protobuf:"bytes,5,opt,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
 	Proof       string                                          `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+	// the predicted vector for topics with an output dimension above 1.
+	// `value` is unused for those topics.
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,7,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *Inference) Reset()         { *m = Inference{} }
@@ -194,6 +199,8 @@ func (m *Inferences) GetInferences() []*Inference {
 type ForecastElement struct {
 	Inferer string                                          `protobuf:"bytes,1,opt,name=inferer,proto3" json:"inferer,omitempty"`
 	Value   github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
+	// per-component forecasted losses for topics synthesized per component
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *ForecastElement) Reset()         { *m = ForecastElement{} }
@@ -536,6 +543,77 @@ func (m *WorkerDataBundles) GetWorkerDataBundles() []*WorkerDataBundle {
 	return nil
 }
 
+// Hash of the inference forecast bundle a worker commits to during the worker
+// submission window of a topic using commit-reveal
+type WorkerPayloadCommit struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Worker      string `protobuf:"bytes,3,opt,name=worker,proto3" json:"worker,omitempty"`
+	// sha256 of the marshaled inference forecast bundle followed by the salt
+	Commitment []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *WorkerPayloadCommit) Reset()         { *m = WorkerPayloadCommit{} }
+func (m *WorkerPayloadCommit) String() string { return proto.CompactTextString(m) }
+func (*WorkerPayloadCommit) ProtoMessage()    {}
+func (*WorkerPayloadCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60e5359cc895864b, []int{9}
+}
+func (m *WorkerPayloadCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerPayloadCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerPayloadCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkerPayloadCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerPayloadCommit.Merge(m, src)
+}
+func (m *WorkerPayloadCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerPayloadCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerPayloadCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerPayloadCommit proto.InternalMessageInfo
+
+func (m *WorkerPayloadCommit) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *WorkerPayloadCommit) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *WorkerPayloadCommit) GetWorker() string {
+	if m != nil {
+		return m.Worker
+	}
+	return ""
+}
+
+func (m *WorkerPayloadCommit) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TimestampedValue)(nil), "emissions.v3.TimestampedValue")
 	proto.RegisterType((*Inference)(nil), "emissions.v3.Inference")
@@ -546,53 +624,58 @@ func init() {
 	proto.RegisterType((*InferenceForecastBundle)(nil), "emissions.v3.InferenceForecastBundle")
 	proto.RegisterType((*WorkerDataBundle)(nil), "emissions.v3.WorkerDataBundle")
 	proto.RegisterType((*WorkerDataBundles)(nil), "emissions.v3.WorkerDataBundles")
+	proto.RegisterType((*WorkerPayloadCommit)(nil), "emissions.v3.WorkerPayloadCommit")
 }
 
 func init() { proto.RegisterFile("emissions/v3/worker.proto", fileDescriptor_60e5359cc895864b) }
 
 var fileDescriptor_60e5359cc895864b = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x4f, 0x13, 0x41,
-	0x14, 0xef, 0xd0, 0x16, 0xe8, 0x83, 0x44, 0x18, 0x08, 0x2c, 0x24, 0x2c, 0xb5, 0x09, 0x49, 0x3d,
-	0xd8, 0x4d, 0x40, 0x83, 0xf1, 0x26, 0x01, 0x23, 0x26, 0x92, 0x38, 0x1a, 0x4d, 0xbc, 0x6c, 0xa6,
-	0xdb, 0x69, 0x3b, 0xa1, 0xdd, 0xd9, 0xec, 0x4c, 0xf9, 0x73, 0xf3, 0xec, 0xc9, 0x8f, 0xe0, 0xa7,
-	0x31, 0x1c, 0x39, 0xaa, 0x07, 0x62, 0xe0, 0xe2, 0xc5, 0xef, 0x60, 0x76, 0x76, 0x76, 0x97, 0x5d,
-	0xac, 0x9a, 0xc8, 0x6d, 0xdf, 0xdb, 0x5f, 0xdf, 0xfb, 0xfd, 0xd9, 0x99, 0xc2, 0x0a, 0x1b, 0x72,
-	0x29, 0xb9, 0xf0, 0xa5, 0x73, 0xb4, 0xe5, 0x1c, 0x8b, 0xf0, 0x90, 0x85, 0xad, 0x20, 0x14, 0x4a,
-	0xe0, 0xd9, 0xf4, 0x55, 0xeb, 0x68, 0x6b, 0xd5, 0xca, 0x01, 0x7d, 0xe1, 0x7b, 0x2c, 0xc6, 0xad,
-	0x2e, 0xf6, 0x44, 0x4f, 0xe8, 0x47, 0x27, 0x7a, 0x8a, 0xbb, 0x8d, 0x0f, 0x08, 0xe6, 0x5e, 0xf3,
-	0x21, 0x93, 0x8a, 0x0e, 0x03, 0xd6, 0x79, 0x43, 0x07, 0x23, 0x86, 0xef, 0xc2, 0x6c, 0x7b, 0x20,
-	0xbc, 0x43, 0xb7, 0xcf, 0x78, 0xaf, 0xaf, 0x2c, 0x54, 0x47, 0xcd, 0x32, 0x99, 0xd1, 0xbd, 0x67,
-	0xba, 0x85, 0x5f, 0x40, 0xf5, 0x28, 0xc2, 0x5a, 0x13, 0x75, 0xd4, 0xac, 0xed, 0x6c, 0x9f, 0x5d,
-	0xac, 0x97, 0xbe, 0x5d, 0xac, 0x3b, 0x3d, 0xae, 0xfa, 0xa3, 0x76, 0xcb, 0x13, 0x43, 0x87, 0x0e,
-	0x06, 0x22, 0xa4, 0xf7, 0x7d, 0xa6, 0x22, 0xbe, 0x49, 0xe9, 0xf5, 0x29, 0xf7, 0x9d, 0x21, 0x55,
-	0xfd, 0xd6, 0x2e, 0xf3, 0x48, 0x3c, 0xe5, 0x71, 0xe5, 0xc7, 0xa7, 0x75, 0xd4, 0xf8, 0x89, 0xa0,
-	0xb6, 0xef, 0x77, 0x59, 0xc8, 0x7c, 0x8f, 0xe1, 0x15, 0x98, 0x56, 0x22, 0xe0, 0x9e, 0xcb, 0x3b,
-	0x9a, 0x41, 0x85, 0x4c, 0xe9, 0x7a, 0xbf, 0x73, 0x83, 0xe0, 0xc4, 0x4d, 0x82, 0x16, 0x4c, 0x71,
-	0x3d, 0x2a, 0xb4, 0xca, 0x11, 0x45, 0x92, 0x94, 0x19, 0xf5, 0xca, 0x6d, 0x50, 0xc7, 0x6b, 0x00,
-	0xec, 0x44, 0x85, 0xd4, 0xed, 0x50, 0x45, 0xad, 0x6a, 0x1d, 0x35, 0x67, 0x49, 0x4d, 0x77, 0x76,
-	0xa9, 0xa2, 0x78, 0x11, 0xaa, 0x41, 0x28, 0x44, 0xd7, 0x9a, 0xd4, 0x2c, 0xe2, 0xc2, 0xe8, 0xdd,
-	0x03, 0x48, 0xe5, 0x4a, 0xbc, 0x0d, 0xc0, 0xd3, 0xca, 0x42, 0xf5, 0x72, 0x73, 0x66, 0x73, 0xb9,
-	0x75, 0x3d, 0xdd, 0x56, 0x8a, 0x26, 0xd7, 0xa0, 0x8d, 0xf7, 0x08, 0xee, 0x3c, 0x15, 0x21, 0xf3,
-	0xa8, 0x54, 0x7b, 0x03, 0x36, 0x64, 0x7e, 0x4e, 0x3e, 0x1a, 0x23, 0xff, 0x36, 0x93, 0xfb, 0x8a,
-	0x60, 0x3a, 0xa1, 0xf0, 0x9f, 0xc1, 0xd9, 0x00, 0x5d, 0x33, 0x29, 0xcd, 0xee, 0x5a, 0x07, 0x3f,
-	0x87, 0xf9, 0xa4, 0x72, 0x59, 0xac, 0x56, 0x5a, 0x15, 0xed, 0xd6, 0x5a, 0xde, 0xad, 0x82, 0x27,
-	0x64, 0xae, 0x9b, 0x6f, 0xc8, 0xbf, 0x64, 0x67, 0xb4, 0x3d, 0x81, 0x5a, 0x32, 0x49, 0xe2, 0x07,
-	0x50, 0x4b, 0xa6, 0x24, 0x19, 0x2d, 0xfd, 0x7e, 0x2b, 0xc9, 0x80, 0xd1, 0x29, 0x5b, 0x4e, 0xb3,
-	0x4b, 0x00, 0x3b, 0x23, 0xbf, 0x33, 0x60, 0xf8, 0x21, 0xd4, 0xd2, 0x2c, 0xb5, 0x5d, 0x7f, 0x48,
-	0x3d, 0x43, 0xe2, 0x4d, 0x98, 0x4e, 0xe6, 0x6b, 0x17, 0xc7, 0xf3, 0x48, 0x71, 0x46, 0xcf, 0xe7,
-	0x09, 0x98, 0x7b, 0xab, 0x6f, 0x90, 0x48, 0xa4, 0x61, 0xb1, 0x04, 0x93, 0xf1, 0xad, 0x62, 0x3e,
-	0x17, 0x53, 0xe1, 0x7b, 0x50, 0xd5, 0x97, 0x88, 0xd9, 0xb1, 0x90, 0xdf, 0x71, 0x10, 0xbd, 0x22,
-	0x31, 0x22, 0x17, 0x7b, 0x39, 0x1f, 0xbb, 0x07, 0xab, 0x29, 0x73, 0x37, 0xb5, 0xc5, 0x6d, 0xeb,
-	0xdd, 0xfa, 0x1c, 0xce, 0x6c, 0x6e, 0x8c, 0x11, 0x9d, 0xb7, 0x8b, 0x58, 0xbc, 0xf8, 0x42, 0x1a,
-	0x09, 0x2f, 0x61, 0x23, 0x3b, 0x14, 0x37, 0xb6, 0xb8, 0x92, 0xf7, 0x7c, 0xaa, 0x46, 0x21, 0x33,
-	0x39, 0x37, 0x32, 0x70, 0x61, 0xd2, 0xab, 0x04, 0x19, 0xb9, 0x12, 0x8c, 0xda, 0x87, 0xec, 0xd4,
-	0x9c, 0x5e, 0x53, 0x19, 0x23, 0x3d, 0x98, 0x2f, 0xfa, 0x28, 0xf1, 0x01, 0x2c, 0xc4, 0xd6, 0xe9,
-	0x6f, 0xca, 0x2c, 0x4f, 0x3e, 0x15, 0x3b, 0xaf, 0xb1, 0xf8, 0x6b, 0x32, 0x7f, 0x5c, 0x9c, 0xb7,
-	0x43, 0xce, 0x2e, 0x6d, 0x74, 0x7e, 0x69, 0xa3, 0xef, 0x97, 0x36, 0xfa, 0x78, 0x65, 0x97, 0xce,
-	0xaf, 0xec, 0xd2, 0x97, 0x2b, 0xbb, 0xf4, 0xee, 0xd1, 0x3f, 0x9e, 0xd8, 0x13, 0x27, 0xfb, 0x4f,
-	0x50, 0xa7, 0x01, 0x93, 0xed, 0x49, 0x7d, 0xf7, 0x6f, 0xfd, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xae,
-	0x9e, 0x56, 0xa4, 0x56, 0x06, 0x00, 0x00,
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xcd, 0xc4, 0x49, 0xda, 0xdc, 0x46, 0xfa, 0x5a, 0xb7, 0x6a, 0xdd, 0x4a, 0x75, 0xf3, 0x45,
+	0xaa, 0x14, 0x16, 0xc4, 0x52, 0x0b, 0x2a, 0x62, 0x47, 0x69, 0x11, 0x45, 0xa2, 0x80, 0x41, 0x20,
+	0xb1, 0xb1, 0x26, 0xce, 0x24, 0x19, 0xd5, 0xf6, 0x44, 0x9e, 0x49, 0x7f, 0x5e, 0x01, 0x36, 0x3c,
+	0x02, 0x4f, 0xc2, 0x12, 0x75, 0x59, 0x76, 0xfc, 0x48, 0x15, 0x6a, 0x37, 0x3c, 0x06, 0xf2, 0x78,
+	0x6c, 0xc7, 0x29, 0x05, 0xa4, 0x76, 0xc1, 0xce, 0xf7, 0xce, 0xc9, 0xbd, 0x73, 0xce, 0x3d, 0x73,
+	0x03, 0x8b, 0xc4, 0xa7, 0x9c, 0x53, 0x16, 0x70, 0x6b, 0x7f, 0xdd, 0x3a, 0x60, 0xe1, 0x1e, 0x09,
+	0x5b, 0x83, 0x90, 0x09, 0xa6, 0xd7, 0xd2, 0xa3, 0xd6, 0xfe, 0xfa, 0x92, 0x91, 0x03, 0x06, 0x2c,
+	0x70, 0x49, 0x8c, 0x5b, 0x9a, 0xeb, 0xb1, 0x1e, 0x93, 0x9f, 0x56, 0xf4, 0x15, 0x67, 0x1b, 0xdf,
+	0x10, 0x4c, 0xbf, 0xa0, 0x3e, 0xe1, 0x02, 0xfb, 0x03, 0xd2, 0x79, 0x89, 0xbd, 0x21, 0xd1, 0xff,
+	0x87, 0x5a, 0xdb, 0x63, 0xee, 0x9e, 0xd3, 0x27, 0xb4, 0xd7, 0x17, 0x06, 0xaa, 0xa3, 0xa6, 0x66,
+	0x4f, 0xc9, 0xdc, 0x43, 0x99, 0xd2, 0x1f, 0x43, 0x79, 0x3f, 0xc2, 0x1a, 0xc5, 0x3a, 0x6a, 0x56,
+	0x37, 0x37, 0x8e, 0x4f, 0x57, 0x0a, 0x5f, 0x4f, 0x57, 0xac, 0x1e, 0x15, 0xfd, 0x61, 0xbb, 0xe5,
+	0x32, 0xdf, 0xc2, 0x9e, 0xc7, 0x42, 0x7c, 0x33, 0x20, 0x22, 0xba, 0x6f, 0x12, 0xba, 0x7d, 0x4c,
+	0x03, 0xcb, 0xc7, 0xa2, 0xdf, 0xda, 0x22, 0xae, 0x1d, 0x57, 0xd1, 0x9f, 0x40, 0x45, 0x7e, 0x70,
+	0x43, 0xab, 0x6b, 0x57, 0xa9, 0xa7, 0xca, 0xdc, 0x2d, 0xfd, 0x78, 0xbf, 0x82, 0x1a, 0x1f, 0x8a,
+	0x50, 0xdd, 0x09, 0xba, 0x24, 0x24, 0x81, 0x4b, 0xf4, 0x45, 0x98, 0x14, 0x6c, 0x40, 0x5d, 0x87,
+	0x76, 0x24, 0xa5, 0x92, 0x3d, 0x21, 0xe3, 0x9d, 0xce, 0x05, 0xc6, 0xc5, 0x8b, 0x8c, 0x0d, 0x98,
+	0xa0, 0xb2, 0x54, 0x68, 0x68, 0x11, 0x67, 0x3b, 0x09, 0x33, 0x2d, 0x4a, 0xd7, 0xa2, 0xc5, 0x32,
+	0x00, 0x39, 0x14, 0x21, 0x76, 0x3a, 0x58, 0x60, 0xa3, 0x5c, 0x47, 0xcd, 0x9a, 0x5d, 0x95, 0x99,
+	0x2d, 0x2c, 0xb0, 0x3e, 0x07, 0xe5, 0x41, 0xc8, 0x58, 0xd7, 0xa8, 0xc8, 0x5b, 0xc4, 0xc1, 0x88,
+	0x80, 0x13, 0xd7, 0x29, 0xe0, 0x36, 0x40, 0xaa, 0x1f, 0xd7, 0x37, 0x00, 0x68, 0x1a, 0x19, 0xa8,
+	0xae, 0x35, 0xa7, 0xd6, 0x16, 0x5a, 0xa3, 0xfe, 0x6b, 0xa5, 0x68, 0x7b, 0x04, 0xda, 0xf8, 0x84,
+	0xe0, 0xbf, 0x07, 0x2c, 0x24, 0x2e, 0xe6, 0x62, 0xdb, 0x23, 0x3e, 0x09, 0x72, 0x7a, 0xa2, 0x4b,
+	0xf4, 0xfc, 0xa7, 0xbd, 0xf5, 0x05, 0xc1, 0x64, 0xc2, 0xe9, 0x8a, 0xd6, 0x32, 0x01, 0xba, 0xaa,
+	0x52, 0xea, 0xae, 0x91, 0x8c, 0xfe, 0x08, 0x66, 0x92, 0xc8, 0x21, 0xb1, 0x7c, 0xdc, 0x28, 0x49,
+	0xf9, 0x97, 0xf3, 0xf2, 0x8f, 0x89, 0x6c, 0x4f, 0x77, 0xf3, 0x09, 0xfe, 0x07, 0x77, 0x29, 0x6e,
+	0xf7, 0xa0, 0x9a, 0x54, 0xe2, 0xfa, 0x2d, 0xa8, 0x26, 0x55, 0x92, 0xa1, 0xcf, 0xff, 0xba, 0xab,
+	0x9d, 0x01, 0x1b, 0x6f, 0x10, 0x2c, 0xa4, 0x66, 0x48, 0x00, 0x9b, 0xc3, 0xa0, 0xe3, 0x11, 0xfd,
+	0x36, 0x54, 0x53, 0x73, 0x48, 0xb9, 0x7e, 0x63, 0xa3, 0x0c, 0xa9, 0xaf, 0xc1, 0x64, 0x52, 0x5f,
+	0xaa, 0x78, 0xf9, 0x3d, 0x52, 0x9c, 0xe2, 0xf3, 0xb1, 0x08, 0xd3, 0xaf, 0xe4, 0xd2, 0x8c, 0x48,
+	0xaa, 0x5b, 0xcc, 0x43, 0x25, 0x5e, 0xa4, 0xca, 0x7f, 0x2a, 0xd2, 0x6f, 0x40, 0x59, 0xee, 0x4d,
+	0xd5, 0x63, 0x36, 0xdf, 0x63, 0x37, 0x3a, 0xb2, 0x63, 0x44, 0x6e, 0xec, 0x5a, 0x7e, 0xec, 0x2e,
+	0x2c, 0xa5, 0x37, 0x77, 0x52, 0x59, 0x9c, 0xb6, 0xec, 0x2d, 0x37, 0xc5, 0xd4, 0xda, 0xea, 0x25,
+	0xa4, 0xf3, 0x72, 0xd9, 0x06, 0x1d, 0x3f, 0xe0, 0x8a, 0xc2, 0x33, 0x58, 0xcd, 0x5e, 0xd9, 0x85,
+	0x2e, 0x0e, 0xa7, 0xbd, 0x00, 0x8b, 0x61, 0x48, 0xd4, 0x9c, 0x1b, 0x19, 0x78, 0xac, 0xd2, 0xf3,
+	0x04, 0x19, 0xa9, 0x32, 0x18, 0xb6, 0xf7, 0xc8, 0x91, 0xda, 0x2f, 0x2a, 0x52, 0x42, 0xba, 0x30,
+	0x33, 0xae, 0x23, 0xd7, 0x77, 0x61, 0x36, 0x96, 0x4e, 0x7a, 0x4a, 0x35, 0x4f, 0xac, 0x62, 0xe6,
+	0x39, 0x8e, 0xff, 0xda, 0x9e, 0x39, 0x18, 0xaf, 0xd7, 0x78, 0x8b, 0x60, 0x36, 0xc6, 0x3d, 0xc5,
+	0x47, 0x1e, 0xc3, 0x9d, 0xfb, 0xcc, 0xf7, 0xe9, 0x55, 0x1f, 0x59, 0x36, 0x6e, 0x2d, 0x37, 0x6e,
+	0x13, 0xc0, 0x95, 0xf5, 0xa3, 0xf7, 0x21, 0x07, 0x53, 0xb3, 0x47, 0x32, 0x9b, 0xf6, 0xf1, 0x99,
+	0x89, 0x4e, 0xce, 0x4c, 0xf4, 0xfd, 0xcc, 0x44, 0xef, 0xce, 0xcd, 0xc2, 0xc9, 0xb9, 0x59, 0xf8,
+	0x7c, 0x6e, 0x16, 0x5e, 0xdf, 0xf9, 0xcb, 0x05, 0x72, 0x68, 0x65, 0x7f, 0xca, 0xe2, 0x68, 0x40,
+	0x78, 0xbb, 0x22, 0xff, 0x7c, 0xd7, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x41, 0x29, 0xb3,
+	0xd7, 0x07, 0x00, 0x00,
 }
 
 func (this *TimestampedValue) Equal(that interface{}) bool {
@@ -620,6 +703,14 @@ func (this *TimestampedValue) Equal(that interface{}) bool {
 	if !this.Value.Equal(that1.Value) {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Inference) Equal(that interface{}) bool {
@@ -659,6 +750,14 @@ func (this *Inference) Equal(that interface{}) bool {
 	if this.Proof != that1.Proof {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ForecastElement) Equal(that interface{}) bool {
@@ -686,6 +785,14 @@ func (this *ForecastElement) Equal(that interface{}) bool {
 	if !this.Value.Equal(that1.Value) {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Forecast) Equal(that interface{}) bool {
@@ -815,6 +922,20 @@ func (m *TimestampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintWorker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -853,6 +974,20 @@ func (m *Inference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintWorker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Proof) > 0 {
 		i -= len(m.Proof)
 		copy(dAtA[i:], m.Proof)
@@ -954,6 +1089,20 @@ func (m *ForecastElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintWorker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -1229,6 +1378,53 @@ func (m *WorkerDataBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkerPayloadCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerPayloadCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerPayloadCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintWorker(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintWorker(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintWorker(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintWorker(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintWorker(dAtA []byte, offset int, v uint64) int {
 	offset -= sovWorker(v)
 	base := offset
@@ -1251,6 +1447,12 @@ func (m *TimestampedValue) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovWorker(uint64(l))
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovWorker(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1280,6 +1482,12 @@ func (m *Inference) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorker(uint64(l))
 	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovWorker(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1310,6 +1518,12 @@ func (m *ForecastElement) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovWorker(uint64(l))
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovWorker(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1421,6 +1635,29 @@ func (m *WorkerDataBundles) Size() (n int) {
 	return n
 }
 
+func (m *WorkerPayloadCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovWorker(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovWorker(uint64(m.BlockHeight))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovWorker(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovWorker(uint64(l))
+	}
+	return n
+}
+
 func sovWorker(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1509,6 +1746,42 @@ func (m *TimestampedValue) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorker(dAtA[iNdEx:])
@@ -1729,6 +2002,42 @@ func (m *Inference) Unmarshal(dAtA []byte) error {
 			}
 			m.Proof = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorker(dAtA[iNdEx:])
@@ -1929,6 +2238,42 @@ func (m *ForecastElement) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorker(dAtA[iNdEx:])
@@ -2667,6 +3012,160 @@ func (m *WorkerDataBundles) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkerPayloadCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerPayloadCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerPayloadCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWorker(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
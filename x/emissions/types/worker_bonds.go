@@ -0,0 +1,54 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RequiresWorkerBond returns true if workers must bond on the topic to submit payloads.
+// Topics created before worker bonds existed have a nil bond and do not require one
+func (topic Topic) RequiresWorkerBond() bool {
+	return !topic.WorkerBond.IsNil() && topic.WorkerBond.IsPositive()
+}
+
+// WorkerBondSlashAmount returns the part of a bond slashed when a worker of the topic
+// misses too many epochs, rounded down
+func (topic Topic) WorkerBondSlashAmount(bond cosmosMath.Int) (cosmosMath.Int, error) {
+	return SlashAmount(bond, topic.WorkerBondSlashFraction)
+}
+
+// SlashAmount returns the given fraction of amount, rounded down
+func SlashAmount(amount cosmosMath.Int, fraction alloraMath.Dec) (cosmosMath.Int, error) {
+	amountDec, err := alloraMath.NewDecFromSdkInt(amount)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	slashed, err := amountDec.Mul(fraction)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return slashed.SdkIntTrim()
+}
+
+// ValidateWorkerBond checks the worker bond settings of a topic. The slashing settings
+// are only checked when the topic requires a bond
+func ValidateWorkerBond(bond cosmosMath.Int, slashMissedEpochs int64, slashFraction alloraMath.Dec) error {
+	if bond.IsNil() || bond.IsZero() {
+		return nil
+	}
+	if bond.IsNegative() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker bond cannot be negative")
+	}
+	if slashMissedEpochs <= 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker bond slash missed epochs must be greater than zero")
+	}
+	if err := ValidateDec(slashFraction); err != nil {
+		return errors.Wrap(err, "worker bond slash fraction is invalid")
+	}
+	if slashFraction.IsNegative() || slashFraction.Gt(alloraMath.OneDec()) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker bond slash fraction must be between 0 and 1 inclusive")
+	}
+	return nil
+}
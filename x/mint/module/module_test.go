@@ -89,6 +89,7 @@ func (s *MintModuleTestSuite) SetupTest() {
 		"mint":                                  {"minter"},
 		emissionstypes.AlloraRewardsAccountName: nil,
 		emissionstypes.AlloraPendingRewardForDelegatorAccountName: nil,
+		emissionstypes.AlloraRewardEscrowAccountName:              nil,
 		emissionstypes.AlloraStakingAccountName:                   {"burner", "minter", "staking"},
 		"bonded_tokens_pool":                                      {"burner", "staking"},
 		"not_bonded_tokens_pool":                                  {"burner", "staking"},